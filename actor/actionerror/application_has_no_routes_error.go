@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationHasNoRoutesError is returned when a path of an app is requested
+// but the app has no route to request it on.
+type ApplicationHasNoRoutesError struct {
+	AppName string
+}
+
+func (e ApplicationHasNoRoutesError) Error() string {
+	return fmt.Sprintf("App %s has no routes to request the path on", e.AppName)
+}
@@ -0,0 +1,20 @@
+package actionerror
+
+import "fmt"
+
+// CapabilityNotSupportedError is returned when the targeted Cloud Controller
+// does not provide a capability that an action needs. Either MinimumVersion
+// or Link is set, depending on what the capability was missing.
+type CapabilityNotSupportedError struct {
+	Capability     string
+	APIVersion     string
+	MinimumVersion string
+	Link           string
+}
+
+func (err CapabilityNotSupportedError) Error() string {
+	if err.MinimumVersion != "" {
+		return fmt.Sprintf("%s requires CF API version %s or higher. Your target is %s.", err.Capability, err.MinimumVersion, err.APIVersion)
+	}
+	return fmt.Sprintf("%s is not available because the targeted API does not advertise the '%s' endpoint.", err.Capability, err.Link)
+}
@@ -9,3 +9,13 @@ type ActiveDeploymentNotFoundError struct {
 func (e ActiveDeploymentNotFoundError) Error() string {
 	return "No active deployment found for app."
 }
+
+// DeploymentNotFoundError is an error wrapper that represents the case when
+// the app has never been deployed.
+type DeploymentNotFoundError struct {
+}
+
+// Error method to display the error message.
+func (e DeploymentNotFoundError) Error() string {
+	return "No deployment found for app."
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// DrainNotFoundError is returned when no user-provided service instance with
+// a syslog drain URL exists with the given name.
+type DrainNotFoundError struct {
+	Name string
+}
+
+func (e DrainNotFoundError) Error() string {
+	return fmt.Sprintf("Drain '%s' not found.", e.Name)
+}
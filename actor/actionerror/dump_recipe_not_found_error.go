@@ -0,0 +1,20 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DumpRecipeNotFoundError is returned when a requested dump recipe does not
+// exist or does not apply to the app's buildpacks.
+type DumpRecipeNotFoundError struct {
+	Name      string
+	Available []string
+}
+
+func (e DumpRecipeNotFoundError) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("Dump recipe '%s' not found. No recipes apply to this app.", e.Name)
+	}
+	return fmt.Sprintf("Dump recipe '%s' not found. Available recipes: %s", e.Name, strings.Join(e.Available, ", "))
+}
@@ -0,0 +1,17 @@
+package actionerror
+
+import "fmt"
+
+// InsufficientHealthyInstancesError is returned when fewer instances of a
+// process are running than the required share.
+type InsufficientHealthyInstancesError struct {
+	AppName           string
+	ProcessType       string
+	Running           int
+	Total             int
+	MinHealthyPercent int
+}
+
+func (e InsufficientHealthyInstancesError) Error() string {
+	return fmt.Sprintf("Only %d of %d instances of process %s of app %s are running; at least %d%% must be healthy", e.Running, e.Total, e.ProcessType, e.AppName, e.MinHealthyPercent)
+}
@@ -0,0 +1,19 @@
+package actionerror
+
+import "fmt"
+
+// InvalidNetworkPolicyBundleError is returned when a network policy bundle
+// cannot be parsed, or one of its policies is invalid.
+type InvalidNetworkPolicyBundleError struct {
+	// Policy is the position of the invalid policy in the bundle, starting at
+	// 1. It is 0 when the bundle itself is invalid.
+	Policy  int
+	Message string
+}
+
+func (e InvalidNetworkPolicyBundleError) Error() string {
+	if e.Policy == 0 {
+		return fmt.Sprintf("Invalid network policy bundle: %s", e.Message)
+	}
+	return fmt.Sprintf("Invalid network policy %d: %s", e.Policy, e.Message)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// JobNotFoundError is an error wrapper that represents the case when the job
+// is not found.
+type JobNotFoundError struct {
+	GUID string
+}
+
+// Error method to display the error message.
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("Job '%s' not found.", e.GUID)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// MaxInFlightExceedsInstancesError is returned when a deployment would replace
+// more instances at a time than the web process of the app has.
+type MaxInFlightExceedsInstancesError struct {
+	MaxInFlight int
+	Instances   int
+}
+
+func (e MaxInFlightExceedsInstancesError) Error() string {
+	return fmt.Sprintf("--max-in-flight %d exceeds the %d instance(s) of the web process.", e.MaxInFlight, e.Instances)
+}
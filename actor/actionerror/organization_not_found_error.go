@@ -10,5 +10,9 @@ type OrganizationNotFoundError struct {
 }
 
 func (e OrganizationNotFoundError) Error() string {
+	if e.Name == "" && e.GUID != "" {
+		return fmt.Sprintf("Organization with GUID '%s' not found.", e.GUID)
+	}
+
 	return fmt.Sprintf("Organization '%s' not found.", e.Name)
 }
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PackageNotReadyError is returned when a package cannot be staged because
+// its bits have not finished processing or have failed to.
+type PackageNotReadyError struct {
+	GUID  string
+	State string
+}
+
+func (e PackageNotReadyError) Error() string {
+	return fmt.Sprintf("Package with guid '%s' is in state %s; only READY packages can be staged.", e.GUID, e.State)
+}
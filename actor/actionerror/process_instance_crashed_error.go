@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// ProcessInstanceCrashedError is returned when an instance of an app crashes
+// while the app's health is being verified.
+type ProcessInstanceCrashedError struct {
+	AppName       string
+	ProcessType   string
+	InstanceIndex int64
+}
+
+func (e ProcessInstanceCrashedError) Error() string {
+	return fmt.Sprintf("Instance %d of process %s of app %s crashed while its health was being verified", e.InstanceIndex, e.ProcessType, e.AppName)
+}
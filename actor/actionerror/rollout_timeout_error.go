@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RolloutTimeoutError is returned when the timeout is reached waiting for an
+// application's deployment to finish.
+type RolloutTimeoutError struct {
+	AppName string
+}
+
+func (e RolloutTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for the rollout of app '%s' to finish", e.AppName)
+}
@@ -4,6 +4,12 @@ package actionerror
 // space than the one requesting it.
 type RouteInDifferentSpaceError struct {
 	Route string
+	// OrgName and SpaceName are the org and space owning the route, when the
+	// user can see them.
+	OrgName   string
+	SpaceName string
+	// SuggestedHost is a similar hostname that is free on the same domain.
+	SuggestedHost string
 }
 
 func (RouteInDifferentSpaceError) Error() string {
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// SBOMNotFoundError is returned when a droplet contains no software bill of
+// materials in the requested format.
+type SBOMNotFoundError struct {
+	DropletGUID string
+}
+
+func (e SBOMNotFoundError) Error() string {
+	return fmt.Sprintf("No SBOM found in droplet '%s'. Only droplets staged with Cloud Native Buildpacks that generate SBOMs contain one.", e.DropletGUID)
+}
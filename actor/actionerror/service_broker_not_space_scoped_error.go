@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceBrokerNotSpaceScopedError is returned when promoting a service broker
+// that is already global.
+type ServiceBrokerNotSpaceScopedError struct {
+	Name string
+}
+
+func (e ServiceBrokerNotSpaceScopedError) Error() string {
+	return fmt.Sprintf("Service broker '%s' is not space-scoped.", e.Name)
+}
@@ -0,0 +1,43 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServiceBrokerPromotionFailedError is returned when promoting a space-scoped
+// service broker fails after it was renamed. It tells what the promotion left
+// changed when undoing it did not succeed.
+type ServiceBrokerPromotionFailedError struct {
+	Name string
+	Err  error
+
+	// SpaceScopedName is the name the space-scoped broker is left renamed to,
+	// if any.
+	SpaceScopedName string
+	// GlobalBrokerRegistered is true when the global broker is left
+	// registered, with VisiblePlans of its plans visible in the org.
+	GlobalBrokerRegistered bool
+	VisiblePlans           int
+	// UndoErr is the error that stopped undoing the promotion.
+	UndoErr error
+}
+
+func (e ServiceBrokerPromotionFailedError) Error() string {
+	if e.SpaceScopedName == "" && !e.GlobalBrokerRegistered {
+		return fmt.Sprintf("Promoting service broker '%s' failed and its changes were undone: %s", e.Name, e.Err)
+	}
+
+	lines := []string{fmt.Sprintf("Promoting service broker '%s' failed: %s", e.Name, e.Err)}
+	if e.UndoErr != nil {
+		lines = append(lines, fmt.Sprintf("Undoing the promotion failed: %s", e.UndoErr))
+	}
+	lines = append(lines, "These changes are left in place:")
+	if e.GlobalBrokerRegistered {
+		lines = append(lines, fmt.Sprintf("   global service broker '%s' is registered, with %d service plan(s) made visible", e.Name, e.VisiblePlans))
+	}
+	if e.SpaceScopedName != "" {
+		lines = append(lines, fmt.Sprintf("   the space-scoped service broker is renamed to '%s'", e.SpaceScopedName))
+	}
+	return strings.Join(lines, "\n")
+}
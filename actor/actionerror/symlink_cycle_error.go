@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// SymlinkCycleError is returned when following symlinks while gathering
+// resources would loop back into a directory that is already being packaged.
+type SymlinkCycleError struct {
+	Path string
+}
+
+func (e SymlinkCycleError) Error() string {
+	return fmt.Sprintf("symlink %s creates a cycle when followed", e.Path)
+}
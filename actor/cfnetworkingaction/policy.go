@@ -236,6 +236,15 @@ func (actor Actor) getPoliciesForApplications(applications []resources.Applicati
 	// It needs to be further filtered to only get policies with the app guids in the source.
 	v1Policies = filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, srcAppGUIDs)
 
+	return actor.namePolicies(applications, v1Policies)
+}
+
+// namePolicies returns the policies with the names of their apps, and of the
+// space and org of their destination apps. The applications are the source
+// apps of the policies.
+func (actor Actor) namePolicies(applications []resources.Application, v1Policies []cfnetv1.Policy) ([]Policy, ccv3.Warnings, error) {
+	var allWarnings ccv3.Warnings
+
 	destAppGUIDs := uniqueDestGUIDs(v1Policies)
 
 	var destApplications []resources.Application
@@ -0,0 +1,463 @@
+package cfnetworkingaction
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultPolicyProtocol = "tcp"
+	defaultPolicyPort     = 8080
+)
+
+// PolicyChanges are the policies that applying a policy bundle added and
+// removed.
+type PolicyChanges struct {
+	Added   []Policy
+	Removed []Policy
+}
+
+// policyBundle is the YAML document that network policies are exported to
+// and applied from. The destination space and org of a policy are left out
+// when they are the ones of its source app, so that a bundle can be applied
+// to a space with another name.
+type policyBundle struct {
+	Policies []bundledPolicy `yaml:"policies"`
+}
+
+type bundledPolicy struct {
+	Source           string `yaml:"source"`
+	Destination      string `yaml:"destination"`
+	DestinationSpace string `yaml:"destination_space,omitempty"`
+	DestinationOrg   string `yaml:"destination_org,omitempty"`
+	Protocol         string `yaml:"protocol"`
+	Ports            string `yaml:"ports"`
+}
+
+// GetRawNetworkPoliciesBySpace returns the network policies of the source
+// apps in the space as a YAML policy bundle.
+func (actor Actor) GetRawNetworkPoliciesBySpace(spaceGUID string) ([]byte, Warnings, error) {
+	var allWarnings Warnings
+
+	space, orgName, warnings, err := actor.spaceAndOrgName(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	policies, warnings, err := actor.NetworkPoliciesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sortPolicies(policies)
+
+	bundle := policyBundle{Policies: []bundledPolicy{}}
+	for _, policy := range policies {
+		bundled := bundledPolicy{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       formatPorts(policy.StartPort, policy.EndPort),
+		}
+		if policy.DestinationOrgName != orgName {
+			bundled.DestinationOrg = policy.DestinationOrgName
+			bundled.DestinationSpace = policy.DestinationSpaceName
+		} else if policy.DestinationSpaceName != space.Name {
+			bundled.DestinationSpace = policy.DestinationSpaceName
+		}
+		bundle.Policies = append(bundle.Policies, bundled)
+	}
+
+	rawPolicies, err := yaml.Marshal(bundle)
+	return rawPolicies, allWarnings, err
+}
+
+// ApplyRawNetworkPolicies creates the policies of the YAML policy bundle that
+// the source apps in the space do not have yet. When prune is true, it also
+// removes the policies of the source apps in the space that the bundle does
+// not have.
+func (actor Actor) ApplyRawNetworkPolicies(spaceGUID string, rawPolicies []byte, prune bool) (PolicyChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	desired, err := parsePolicyBundle(rawPolicies)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	space, orgName, warnings, err := actor.spaceAndOrgName(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	applications, ccWarnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{
+		Key:    ccv3.SpaceGUIDFilter,
+		Values: []string{spaceGUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+
+	existingV1Policies, err := actor.listSourcePolicies(applications)
+	if err != nil {
+		return PolicyChanges{}, allWarnings, err
+	}
+	existing := map[cfnetv1.Policy]bool{}
+	for _, v1Policy := range existingV1Policies {
+		existing[v1Policy] = true
+	}
+
+	appsByName := map[string]resources.Application{}
+	for _, app := range applications {
+		appsByName[app.Name] = app
+	}
+	resolver := policyResolver{
+		actor:        actor,
+		space:        space,
+		orgName:      orgName,
+		applications: appsByName,
+		spaceGUIDs:   map[string]string{},
+		orgGUIDs:     map[string]string{},
+	}
+
+	var (
+		changes           PolicyChanges
+		v1PoliciesToAdd   []cfnetv1.Policy
+		desiredV1Policies = map[cfnetv1.Policy]bool{}
+	)
+	for i := range desired {
+		policy := &desired[i]
+		if policy.DestinationOrgName == "" {
+			policy.DestinationOrgName = orgName
+		}
+		if policy.DestinationSpaceName == "" {
+			policy.DestinationSpaceName = space.Name
+		}
+
+		v1Policy, warnings, err := resolver.resolve(*policy)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+
+		if desiredV1Policies[v1Policy] {
+			continue
+		}
+		desiredV1Policies[v1Policy] = true
+
+		if !existing[v1Policy] {
+			v1PoliciesToAdd = append(v1PoliciesToAdd, v1Policy)
+			changes.Added = append(changes.Added, *policy)
+		}
+	}
+
+	var v1PoliciesToRemove []cfnetv1.Policy
+	if prune {
+		for _, v1Policy := range existingV1Policies {
+			if !desiredV1Policies[v1Policy] {
+				v1PoliciesToRemove = append(v1PoliciesToRemove, v1Policy)
+			}
+		}
+
+		if len(v1PoliciesToRemove) > 0 {
+			changes.Removed, ccWarnings, err = actor.namePolicies(applications, v1PoliciesToRemove)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return PolicyChanges{}, allWarnings, err
+			}
+		}
+	}
+
+	if len(v1PoliciesToAdd) > 0 {
+		err = actor.NetworkingClient.CreatePolicies(v1PoliciesToAdd)
+		if err != nil {
+			return PolicyChanges{}, allWarnings, err
+		}
+	}
+
+	if len(v1PoliciesToRemove) > 0 {
+		err = actor.NetworkingClient.RemovePolicies(v1PoliciesToRemove)
+		if err != nil {
+			return PolicyChanges{Added: changes.Added}, allWarnings, err
+		}
+	}
+
+	sortPolicies(changes.Added)
+	sortPolicies(changes.Removed)
+	return changes, allWarnings, nil
+}
+
+// listSourcePolicies returns the policies whose source is one of the
+// applications.
+func (actor Actor) listSourcePolicies(applications []resources.Application) ([]cfnetv1.Policy, error) {
+	var appGUIDs []string
+	for _, app := range applications {
+		appGUIDs = append(appGUIDs, app.GUID)
+	}
+
+	var v1Policies []cfnetv1.Policy
+	_, err := batcher.RequestByGUID(appGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, err := actor.NetworkingClient.ListPolicies(guids...)
+		v1Policies = append(v1Policies, batch...)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterPoliciesWithoutMatchingSourceGUIDs(v1Policies, appGUIDs), nil
+}
+
+func (actor Actor) spaceAndOrgName(spaceGUID string) (resources.Space, string, Warnings, error) {
+	var allWarnings Warnings
+
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{
+		Key:    ccv3.GUIDFilter,
+		Values: []string{spaceGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return resources.Space{}, "", allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return resources.Space{}, "", allWarnings, actionerror.SpaceNotFoundError{GUID: spaceGUID}
+	}
+
+	orgNames, warnings, err := actor.orgNamesBySpaceGUID(spaces)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return resources.Space{}, "", allWarnings, err
+	}
+
+	return spaces[0], orgNames[spaceGUID], allWarnings, nil
+}
+
+// policyResolver looks up the apps of policies by name, remembering the
+// spaces and orgs it has found.
+type policyResolver struct {
+	actor        Actor
+	space        resources.Space
+	orgName      string
+	applications map[string]resources.Application
+	spaceGUIDs   map[string]string
+	orgGUIDs     map[string]string
+}
+
+func (resolver policyResolver) resolve(policy Policy) (cfnetv1.Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	source, ok := resolver.applications[policy.SourceName]
+	if !ok {
+		return cfnetv1.Policy{}, allWarnings, actionerror.ApplicationNotFoundError{Name: policy.SourceName}
+	}
+
+	destSpaceGUID, warnings, err := resolver.spaceGUID(policy.DestinationOrgName, policy.DestinationSpaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return cfnetv1.Policy{}, allWarnings, err
+	}
+
+	var destination resources.Application
+	if destSpaceGUID == resolver.space.GUID {
+		destination, ok = resolver.applications[policy.DestinationName]
+		if !ok {
+			return cfnetv1.Policy{}, allWarnings, actionerror.ApplicationNotFoundError{Name: policy.DestinationName}
+		}
+	} else {
+		var ccWarnings ccv3.Warnings
+		destination, ccWarnings, err = resolver.actor.CloudControllerClient.GetApplicationByNameAndSpace(policy.DestinationName, destSpaceGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return cfnetv1.Policy{}, allWarnings, err
+		}
+	}
+
+	return cfnetv1.Policy{
+		Source: cfnetv1.PolicySource{
+			ID: source.GUID,
+		},
+		Destination: cfnetv1.PolicyDestination{
+			ID:       destination.GUID,
+			Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+			Ports: cfnetv1.Ports{
+				Start: policy.StartPort,
+				End:   policy.EndPort,
+			},
+		},
+	}, allWarnings, nil
+}
+
+func (resolver policyResolver) spaceGUID(orgName string, spaceName string) (string, Warnings, error) {
+	if orgName == resolver.orgName && spaceName == resolver.space.Name {
+		return resolver.space.GUID, nil, nil
+	}
+
+	key := orgName + "/" + spaceName
+	if guid, ok := resolver.spaceGUIDs[key]; ok {
+		return guid, nil, nil
+	}
+
+	var allWarnings Warnings
+
+	orgGUID, warnings, err := resolver.orgGUID(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	spaces, _, ccWarnings, err := resolver.actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{spaceName}},
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return "", allWarnings, actionerror.SpaceNotFoundError{Name: spaceName}
+	}
+
+	resolver.spaceGUIDs[key] = spaces[0].GUID
+	return spaces[0].GUID, allWarnings, nil
+}
+
+func (resolver policyResolver) orgGUID(orgName string) (string, Warnings, error) {
+	if orgName == resolver.orgName {
+		return resolver.space.Relationships[constant.RelationshipTypeOrganization].GUID, nil, nil
+	}
+
+	if guid, ok := resolver.orgGUIDs[orgName]; ok {
+		return guid, nil, nil
+	}
+
+	orgs, warnings, err := resolver.actor.CloudControllerClient.GetOrganizations(ccv3.Query{
+		Key:    ccv3.NameFilter,
+		Values: []string{orgName},
+	})
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+	if len(orgs) == 0 {
+		return "", Warnings(warnings), actionerror.OrganizationNotFoundError{Name: orgName}
+	}
+
+	resolver.orgGUIDs[orgName] = orgs[0].GUID
+	return orgs[0].GUID, Warnings(warnings), nil
+}
+
+// parsePolicyBundle returns the policies of a YAML policy bundle. Policies
+// without a protocol and ports allow TCP traffic to port 8080, like
+// add-network-policy.
+func parsePolicyBundle(rawPolicies []byte) ([]Policy, error) {
+	var bundle policyBundle
+	err := yaml.UnmarshalStrict(rawPolicies, &bundle)
+	if err != nil {
+		return nil, actionerror.InvalidNetworkPolicyBundleError{Message: err.Error()}
+	}
+
+	var policies []Policy
+	for i, bundled := range bundle.Policies {
+		invalid := func(message string) error {
+			return actionerror.InvalidNetworkPolicyBundleError{Policy: i + 1, Message: message}
+		}
+
+		if bundled.Source == "" || bundled.Destination == "" {
+			return nil, invalid("source and destination are required")
+		}
+		if bundled.DestinationOrg != "" && bundled.DestinationSpace == "" {
+			return nil, invalid("destination_org requires destination_space")
+		}
+
+		policy := Policy{
+			SourceName:           bundled.Source,
+			DestinationName:      bundled.Destination,
+			DestinationSpaceName: bundled.DestinationSpace,
+			DestinationOrgName:   bundled.DestinationOrg,
+			Protocol:             strings.ToLower(bundled.Protocol),
+			StartPort:            defaultPolicyPort,
+			EndPort:              defaultPolicyPort,
+		}
+
+		switch {
+		case policy.Protocol == "" && bundled.Ports == "":
+			policy.Protocol = defaultPolicyProtocol
+		case policy.Protocol == "" || bundled.Ports == "":
+			return nil, invalid("protocol and ports must be given together")
+		case policy.Protocol != "tcp" && policy.Protocol != "udp":
+			return nil, invalid(`protocol must be "tcp" or "udp"`)
+		default:
+			policy.StartPort, policy.EndPort, err = parsePorts(bundled.Ports)
+			if err != nil {
+				return nil, invalid(err.Error())
+			}
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func parsePorts(ports string) (int, int, error) {
+	bounds := strings.Split(ports, "-")
+	if len(bounds) > 2 {
+		return 0, 0, fmt.Errorf("ports must be a port or a range of ports, like 8080-8090")
+	}
+
+	var parsed []int
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(strings.TrimSpace(bound))
+		if err != nil || port < 1 || port > 65535 {
+			return 0, 0, fmt.Errorf("ports must be between 1 and 65535")
+		}
+		parsed = append(parsed, port)
+	}
+
+	if len(parsed) == 1 {
+		return parsed[0], parsed[0], nil
+	}
+	if parsed[0] > parsed[1] {
+		return 0, 0, fmt.Errorf("ports must be a range that starts with the lower port")
+	}
+	return parsed[0], parsed[1], nil
+}
+
+func formatPorts(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
+
+func sortPolicies(policies []Policy) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		a, b := policies[i], policies[j]
+		switch {
+		case a.SourceName != b.SourceName:
+			return a.SourceName < b.SourceName
+		case a.DestinationName != b.DestinationName:
+			return a.DestinationName < b.DestinationName
+		case a.DestinationOrgName != b.DestinationOrgName:
+			return a.DestinationOrgName < b.DestinationOrgName
+		case a.DestinationSpaceName != b.DestinationSpaceName:
+			return a.DestinationSpaceName < b.DestinationSpaceName
+		case a.Protocol != b.Protocol:
+			return a.Protocol < b.Protocol
+		default:
+			return a.StartPort < b.StartPort
+		}
+	})
+}
@@ -0,0 +1,270 @@
+package cfnetworkingaction_test
+
+import (
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy Bundle", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *cfnetworkingactionfakes.FakeCloudControllerClient
+		fakeNetworkingClient      *cfnetworkingactionfakes.FakeNetworkingClient
+
+		toBackend cfnetv1.Policy
+		toBilling cfnetv1.Policy
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	newPolicy := func(sourceGUID string, destinationGUID string, protocol string, startPort int, endPort int) cfnetv1.Policy {
+		return cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: sourceGUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destinationGUID,
+				Protocol: cfnetv1.PolicyProtocol(protocol),
+				Ports:    cfnetv1.Ports{Start: startPort, End: endPort},
+			},
+		}
+	}
+
+	queryValues := func(key ccv3.QueryKey, queries []ccv3.Query) []string {
+		for _, query := range queries {
+			if query.Key == key {
+				return query.Values
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(cfnetworkingactionfakes.FakeCloudControllerClient)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeCloudControllerClient)
+
+		spaces := []resources.Space{
+			{GUID: "dev-guid", Name: "dev", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "web-guid"}}},
+			{GUID: "payments-guid", Name: "payments", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "finance-guid"}}},
+		}
+		fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+			var found []resources.Space
+			for _, space := range spaces {
+				for _, value := range append(queryValues(ccv3.GUIDFilter, queries), queryValues(ccv3.NameFilter, queries)...) {
+					if value == space.GUID || value == space.Name {
+						found = append(found, space)
+					}
+				}
+			}
+			return found, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil
+		}
+
+		orgs := []resources.Organization{{GUID: "web-guid", Name: "web"}, {GUID: "finance-guid", Name: "finance"}}
+		fakeCloudControllerClient.GetOrganizationsStub = func(queries ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
+			var found []resources.Organization
+			for _, org := range orgs {
+				for _, value := range append(queryValues(ccv3.GUIDFilter, queries), queryValues(ccv3.NameFilter, queries)...) {
+					if value == org.GUID || value == org.Name {
+						found = append(found, org)
+					}
+				}
+			}
+			return found, nil, nil
+		}
+
+		apps := []resources.Application{
+			{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "dev-guid"},
+			{GUID: "backend-guid", Name: "backend", SpaceGUID: "dev-guid"},
+			{GUID: "billing-guid", Name: "billing", SpaceGUID: "payments-guid"},
+		}
+		fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+			var found []resources.Application
+			for _, app := range apps {
+				for _, value := range append(queryValues(ccv3.GUIDFilter, queries), queryValues(ccv3.SpaceGUIDFilter, queries)...) {
+					if value == app.GUID || value == app.SpaceGUID {
+						found = append(found, app)
+					}
+				}
+			}
+			return found, nil, nil
+		}
+		fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(apps[2], ccv3.Warnings{"get-app-warning"}, nil)
+
+		toBackend = newPolicy("frontend-guid", "backend-guid", "tcp", 8080, 8080)
+		toBilling = newPolicy("frontend-guid", "billing-guid", "udp", 9000, 9010)
+		fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+			toBilling,
+			toBackend,
+			newPolicy("billing-guid", "frontend-guid", "tcp", 8080, 8080),
+		}, nil)
+	})
+
+	Describe("GetRawNetworkPoliciesBySpace", func() {
+		var rawPolicies []byte
+
+		JustBeforeEach(func() {
+			rawPolicies, warnings, executeErr = actor.GetRawNetworkPoliciesBySpace("dev-guid")
+		})
+
+		It("returns the policies of the apps in the space as a policy bundle", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-spaces-warning"))
+			Expect(string(rawPolicies)).To(Equal(`policies:
+- source: frontend
+  destination: backend
+  protocol: tcp
+  ports: "8080"
+- source: frontend
+  destination: billing
+  destination_space: payments
+  destination_org: finance
+  protocol: udp
+  ports: 9000-9010
+`))
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+				fakeCloudControllerClient.GetSpacesStub = nil
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "dev-guid"}))
+			})
+		})
+	})
+
+	Describe("ApplyRawNetworkPolicies", func() {
+		var (
+			rawPolicies string
+			prune       bool
+			changes     PolicyChanges
+		)
+
+		BeforeEach(func() {
+			rawPolicies = `policies:
+- source: frontend
+  destination: backend
+- source: frontend
+  destination: billing
+  destination_space: payments
+  destination_org: finance
+  protocol: TCP
+  ports: 7000
+`
+			prune = false
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.ApplyRawNetworkPolicies("dev-guid", []byte(rawPolicies), prune)
+		})
+
+		It("creates the policies that do not exist yet", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-spaces-warning", "get-app-warning"))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				newPolicy("frontend-guid", "billing-guid", "tcp", 7000, 7000),
+			}))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+
+			appName, spaceGUID := fakeCloudControllerClient.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("billing"))
+			Expect(spaceGUID).To(Equal("payments-guid"))
+
+			Expect(changes).To(Equal(PolicyChanges{
+				Added: []Policy{{
+					SourceName:           "frontend",
+					DestinationName:      "billing",
+					Protocol:             "tcp",
+					DestinationSpaceName: "payments",
+					DestinationOrgName:   "finance",
+					StartPort:            7000,
+					EndPort:              7000,
+				}},
+			}))
+		})
+
+		When("pruning", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("also removes the policies that the bundle does not have", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{toBilling}))
+				Expect(changes.Removed).To(Equal([]Policy{{
+					SourceName:           "frontend",
+					DestinationName:      "billing",
+					Protocol:             "udp",
+					DestinationSpaceName: "payments",
+					DestinationOrgName:   "finance",
+					StartPort:            9000,
+					EndPort:              9010,
+				}}))
+			})
+		})
+
+		When("the policies exist already", func() {
+			BeforeEach(func() {
+				rawPolicies = "policies:\n- source: frontend\n  destination: backend\n  protocol: tcp\n  ports: 8080\n"
+			})
+
+			It("changes nothing", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(changes).To(Equal(PolicyChanges{}))
+			})
+		})
+
+		When("a source app is not in the space", func() {
+			BeforeEach(func() {
+				rawPolicies = "policies:\n- source: billing\n  destination: backend\n"
+			})
+
+			It("returns an ApplicationNotFoundError without changing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "billing"}))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the destination org does not exist", func() {
+			BeforeEach(func() {
+				rawPolicies = "policies:\n- source: frontend\n  destination: billing\n  destination_space: payments\n  destination_org: accounting\n"
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "accounting"}))
+			})
+		})
+
+		DescribeTable("invalid bundles",
+			func(bundle string, expectedErr error) {
+				_, _, err := actor.ApplyRawNetworkPolicies("dev-guid", []byte(bundle), false)
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("without a destination", "policies:\n- source: frontend\n",
+				actionerror.InvalidNetworkPolicyBundleError{Policy: 1, Message: "source and destination are required"}),
+			Entry("with an org but no space", "policies:\n- source: a\n  destination: b\n  destination_org: o\n",
+				actionerror.InvalidNetworkPolicyBundleError{Policy: 1, Message: "destination_org requires destination_space"}),
+			Entry("with a protocol but no ports", "policies:\n- source: a\n  destination: b\n  protocol: tcp\n",
+				actionerror.InvalidNetworkPolicyBundleError{Policy: 1, Message: "protocol and ports must be given together"}),
+			Entry("with an unknown protocol", "policies:\n- source: a\n  destination: b\n  protocol: icmp\n  ports: 1\n",
+				actionerror.InvalidNetworkPolicyBundleError{Policy: 1, Message: `protocol must be "tcp" or "udp"`}),
+			Entry("with invalid ports", "policies:\n- source: a\n  destination: b\n  protocol: tcp\n  ports: 9000-8000\n",
+				actionerror.InvalidNetworkPolicyBundleError{Policy: 1, Message: "ports must be a range that starts with the lower port"}),
+		)
+	})
+})
@@ -0,0 +1,12 @@
+package sharedaction
+
+import "os"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . FileHashCache
+
+// FileHashCache remembers the SHA1s of the files of a directory by their
+// paths relative to it.
+type FileHashCache interface {
+	SHA1(path string, info os.FileInfo) (string, bool)
+	SetSHA1(path string, info os.FileInfo, sha1 string)
+}
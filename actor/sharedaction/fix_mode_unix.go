@@ -10,3 +10,9 @@ import "os"
 func fixMode(mode os.FileMode) os.FileMode {
 	return mode
 }
+
+// normalizeFileMode applies the packaging permission rules, see the windows
+// version for why they are not applied there.
+func normalizeFileMode(mode os.FileMode) os.FileMode {
+	return NormalizeFileMode(mode)
+}
@@ -10,3 +10,9 @@ import "os"
 func fixMode(mode os.FileMode) os.FileMode {
 	return mode | 0700
 }
+
+// normalizeFileMode leaves the mode untouched because windows file modes are
+// synthesized by fixMode rather than read from the file system.
+func normalizeFileMode(mode os.FileMode) os.FileMode {
+	return mode
+}
@@ -67,6 +67,16 @@ type EnvironmentVariable struct {
 	DefaultValue string
 }
 
+// CommandSearchResult is a command that matched a help search.
+type CommandSearchResult struct {
+	Name        string
+	Description string
+
+	// MatchedFlags are the flags whose names or descriptions matched, in the
+	// form they are displayed in help, such as "--force, -f".
+	MatchedFlags []string
+}
+
 // HasUsage is an interface that commands may implement if they want to define their usage
 // text in a Usage() method, which gives them more flexibility than a struct tag.
 type HasUsage interface {
@@ -148,7 +158,7 @@ func (Actor) CommandInfoByName(commandList interface{}, commandName string) (Com
 		}
 
 		if cmd.Examples == "" && fieldTag.Get("examples") != "" {
-			cmd.Examples = fieldTag.Get("examples")
+			cmd.Examples = strings.ReplaceAll(fieldTag.Get("examples"), "\n", "\n"+CommandIndent)
 			continue
 		}
 
@@ -198,3 +208,102 @@ func (Actor) CommandInfos(commandList interface{}) map[string]CommandInfo {
 
 	return infos
 }
+
+// SearchCommands returns the commands in commandList whose name, alias,
+// description or flags contain term, ignoring case. Commands whose name
+// or alias matches come first, and each group is sorted by name.
+func (actor Actor) SearchCommands(commandList interface{}, term string) []CommandSearchResult {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return nil
+	}
+	contains := func(text string) bool {
+		return strings.Contains(strings.ToLower(text), term)
+	}
+
+	var nameMatches, otherMatches []CommandSearchResult
+	handler := reflect.TypeOf(commandList)
+	for i := 0; i < handler.NumField(); i++ {
+		commandName := handler.Field(i).Tag.Get("command")
+		if commandName == "" {
+			continue
+		}
+
+		info, err := actor.CommandInfoByName(commandList, commandName)
+		if err != nil {
+			continue
+		}
+
+		result := CommandSearchResult{Name: info.Name, Description: info.Description}
+		for _, flag := range info.Flags {
+			if contains(flag.Long) || contains(flag.Short) || contains(flag.Description) {
+				result.MatchedFlags = append(result.MatchedFlags, flagNames(flag))
+			}
+		}
+
+		switch {
+		case contains(info.Name) || contains(info.Alias):
+			nameMatches = append(nameMatches, result)
+		case contains(info.Description) || len(result.MatchedFlags) > 0:
+			otherMatches = append(otherMatches, result)
+		}
+	}
+
+	byName := func(results []CommandSearchResult) {
+		sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	}
+	byName(nameMatches)
+	byName(otherMatches)
+
+	return append(nameMatches, otherMatches...)
+}
+
+// ExampleLines returns the examples of the command, one per line, with
+// CF_NAME in place of the binary name. They are taken from the command's
+// examples or, when it has none, from the EXAMPLES section of its usage.
+func (info CommandInfo) ExampleLines() []string {
+	examples := info.Examples
+	if examples == "" {
+		examples = usageSection(info.Usage, "EXAMPLES:")
+	}
+
+	var lines []string
+	for _, line := range strings.Split(examples, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// usageSection returns the indented lines that follow the header in usage, up
+// to the first line that is blank or not indented.
+func usageSection(usage string, header string) string {
+	lines := strings.Split(usage, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != header {
+			continue
+		}
+
+		var section []string
+		for _, sectionLine := range lines[i+1:] {
+			if strings.TrimSpace(sectionLine) == "" || strings.TrimLeft(sectionLine, " \t") == sectionLine {
+				break
+			}
+			section = append(section, sectionLine)
+		}
+		return strings.Join(section, "\n")
+	}
+	return ""
+}
+
+func flagNames(flag CommandFlag) string {
+	var names []string
+	if flag.Long != "" {
+		names = append(names, "--"+flag.Long)
+	}
+	if flag.Short != "" {
+		names = append(names, "-"+flag.Short)
+	}
+	return strings.Join(names, ", ")
+}
@@ -192,4 +192,47 @@ var _ = Describe("Help Actions", func() {
 			}))
 		})
 	})
+
+	Describe("SearchCommands", func() {
+		It("returns the commands whose names or aliases match first", func() {
+			results := actor.SearchCommands(commandList{}, "A")
+
+			Expect(results).To(Equal([]CommandSearchResult{
+				{Name: "app", Description: "Display health and status for an app", MatchedFlags: []string{"--guid"}},
+				{Name: "fancy"},
+				{Name: "restage", Description: "Restage an app"},
+				{Name: "help", Description: "Show help", MatchedFlags: []string{"-a"}},
+			}))
+		})
+
+		It("matches flag names and descriptions", func() {
+			results := actor.SearchCommands(commandList{}, "guid")
+
+			Expect(results).To(Equal([]CommandSearchResult{
+				{Name: "app", Description: "Display health and status for an app", MatchedFlags: []string{"--guid"}},
+			}))
+		})
+
+		It("returns nothing for an empty term", func() {
+			Expect(actor.SearchCommands(commandList{}, " ")).To(BeEmpty())
+		})
+	})
+
+	Describe("ExampleLines", func() {
+		It("returns the lines of the examples", func() {
+			info := CommandInfo{Examples: "\n   CF_NAME app one\n\n   CF_NAME app two\n"}
+
+			Expect(info.ExampleLines()).To(Equal([]string{"CF_NAME app one", "CF_NAME app two"}))
+		})
+
+		It("falls back to the EXAMPLES section of the usage", func() {
+			info := CommandInfo{Usage: "CF_NAME app APP_NAME\n\nEXAMPLES:\n   CF_NAME app my-app\n   CF_NAME app my-app --guid\n\nTIP: something else"}
+
+			Expect(info.ExampleLines()).To(Equal([]string{"CF_NAME app my-app", "CF_NAME app my-app --guid"}))
+		})
+
+		It("returns nothing when there are no examples", func() {
+			Expect(CommandInfo{Usage: "CF_NAME app APP_NAME"}.ExampleLines()).To(BeEmpty())
+		})
+	})
 })
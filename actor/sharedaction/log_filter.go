@@ -0,0 +1,66 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogFilter selects the log messages of an app. The zero value selects every
+// message.
+type LogFilter struct {
+	// SourceTypes are the source types (e.g. APP, STG, RTR) of the messages to
+	// keep. APP also keeps the messages of every app process and task, whose
+	// source types are APP/PROC/<TYPE> and APP/TASK/<NAME>.
+	SourceTypes []string
+	// Instances are the instance indexes of the messages to keep.
+	Instances []string
+	// Patterns are the regular expressions every message body must match.
+	Patterns []*regexp.Regexp
+	// Since is the time of the oldest recent message to keep. Log Cache is only
+	// asked for the messages after it.
+	Since time.Time
+}
+
+// Matches returns true when the message is selected by the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(message.SourceType(), filter.SourceTypes) {
+		return false
+	}
+
+	if len(filter.Instances) > 0 && !contains(filter.Instances, message.SourceInstance()) {
+		return false
+	}
+
+	for _, pattern := range filter.Patterns {
+		if !pattern.MatchString(message.Message()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceType string, sourceTypes []string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, wanted := range sourceTypes {
+		wanted = strings.ToUpper(wanted)
+		if sourceType == wanted || strings.HasPrefix(sourceType, wanted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
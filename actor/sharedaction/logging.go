@@ -28,6 +28,7 @@ type LogMessage struct {
 	timestamp      time.Time
 	sourceType     string
 	sourceInstance string
+	tags           map[string]string
 }
 
 func (log LogMessage) Message() string {
@@ -54,13 +55,24 @@ func (log LogMessage) SourceInstance() string {
 	return log.sourceInstance
 }
 
+// Tags returns the tags of the envelope of the message, such as its source
+// type or the name of its process.
+func (log LogMessage) Tags() map[string]string {
+	return log.tags
+}
+
 func NewLogMessage(message string, messageType string, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
+	return NewLogMessageWithTags(message, messageType, timestamp, sourceType, sourceInstance, nil)
+}
+
+func NewLogMessageWithTags(message string, messageType string, timestamp time.Time, sourceType string, sourceInstance string, tags map[string]string) *LogMessage {
 	return &LogMessage{
 		message:        message,
 		messageType:    messageType,
 		timestamp:      timestamp,
 		sourceType:     sourceType,
 		sourceInstance: sourceInstance,
+		tags:           tags,
 	}
 }
 
@@ -122,6 +134,12 @@ func (b *cliRetryBackoff) Reset() {
 }
 
 func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+	return GetFilteredStreamingLogs(appGUID, client, LogFilter{})
+}
+
+// GetFilteredStreamingLogs streams the logs of an app that match the filter.
+// The filter is applied as the logs arrive.
+func GetFilteredStreamingLogs(appGUID string, client LogCacheClient, filter LogFilter) (<-chan LogMessage, <-chan error, context.CancelFunc) {
 
 	logrus.Info("Start Tailing Logs")
 
@@ -148,6 +166,9 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 			logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
 				logMessages := convertEnvelopesToLogMessages(envelopes)
 				for _, logMessage := range logMessages {
+					if !filter.Matches(*logMessage) {
+						continue
+					}
 					select {
 					case <-ctx.Done():
 						return false
@@ -209,6 +230,13 @@ func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context
 }
 
 func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error) {
+	return GetFilteredRecentLogs(appGUID, client, LogFilter{})
+}
+
+// GetFilteredRecentLogs returns the recent logs of an app that match the
+// filter. Log Cache is only asked for the logs since filter.Since, the other
+// criteria are applied to the RecentLogsLines logs it returns.
+func GetFilteredRecentLogs(appGUID string, client LogCacheClient, filter LogFilter) ([]LogMessage, error) {
 	logLineRequestCount := RecentLogsLines
 	var envelopes []*loggregator_v2.Envelope
 	var err error
@@ -217,7 +245,7 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 		envelopes, err = client.Read(
 			context.Background(),
 			appGUID,
-			time.Time{},
+			filter.Since,
 			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
 			logcache.WithLimit(logLineRequestCount),
 			logcache.WithDescending(),
@@ -234,7 +262,9 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 	logMessages := convertEnvelopesToLogMessages(envelopes)
 	var reorderedLogMessages []LogMessage
 	for i := len(logMessages) - 1; i >= 0; i-- {
-		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+		if filter.Matches(*logMessages[i]) {
+			reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+		}
 	}
 
 	return reorderedLogMessages, nil
@@ -249,12 +279,13 @@ func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogM
 		}
 		log := logEnvelope.Log
 
-		logMessages = append(logMessages, NewLogMessage(
+		logMessages = append(logMessages, NewLogMessageWithTags(
 			string(log.Payload),
 			loggregator_v2.Log_Type_name[int32(log.Type)],
 			time.Unix(0, envelope.GetTimestamp()),
 			envelope.GetTags()["source_type"],
 			envelope.GetInstanceId(),
+			envelope.GetTags(),
 		))
 	}
 	return logMessages
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -58,6 +59,7 @@ var _ = Describe("Logging Actions", func() {
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
+			filter          sharedaction.LogFilter
 
 			messages              <-chan sharedaction.LogMessage
 			errs                  <-chan error
@@ -69,6 +71,7 @@ var _ = Describe("Logging Actions", func() {
 
 		BeforeEach(func() {
 			expectedAppGUID = "some-app-guid"
+			filter = sharedaction.LogFilter{}
 			// 2 seconds in the past to get past Walk delay
 			// Walk delay context: https://github.com/cloudfoundry/cli/blob/283d5fcdefa1806b24f4242adea1fb85871b4c6b/vendor/code.cloudfoundry.org/go-log-cache/walk.go#L74
 			mostRecentTime = time.Now().Add(-2 * time.Second)
@@ -112,7 +115,11 @@ var _ = Describe("Logging Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			messages, errs, stopStreaming = sharedaction.GetStreamingLogs(expectedAppGUID, fakeLogCacheClient)
+			if filter.Patterns == nil {
+				messages, errs, stopStreaming = sharedaction.GetStreamingLogs(expectedAppGUID, fakeLogCacheClient)
+			} else {
+				messages, errs, stopStreaming = sharedaction.GetFilteredStreamingLogs(expectedAppGUID, fakeLogCacheClient, filter)
+			}
 		})
 
 		When("receiving logs", func() {
@@ -154,6 +161,19 @@ var _ = Describe("Logging Actions", func() {
 
 				Expect(errs).ToNot(Receive())
 			})
+
+			When("a filter is given", func() {
+				BeforeEach(func() {
+					filter = sharedaction.LogFilter{Patterns: []*regexp.Regexp{regexp.MustCompile("-2$")}}
+				})
+
+				It("only passes the matching log messages through the messages channel", func() {
+					var message sharedaction.LogMessage
+					Eventually(messages).Should(Receive(&message))
+					Expect(message.Message()).To(Equal("message-2"))
+					Eventually(messages).Should(BeClosed())
+				})
+			})
 		})
 
 		When("cancelling log streaming", func() {
@@ -295,6 +315,7 @@ var _ = Describe("Logging Actions", func() {
 					Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
 					Expect(messages[0].SourceType()).To(Equal("some-source-type"))
 					Expect(messages[0].SourceInstance()).To(Equal("some-source-instance"))
+					Expect(messages[0].Tags()).To(Equal(map[string]string{"source_type": "some-source-type"}))
 
 					Expect(messages[1].Message()).To(Equal("message-2"))
 					Expect(messages[1].Type()).To(Equal("OUT"))
@@ -304,6 +325,58 @@ var _ = Describe("Logging Actions", func() {
 				})
 			})
 
+			When("a filter is given", func() {
+				var since time.Time
+
+				BeforeEach(func() {
+					since = time.Unix(0, 15)
+					messages := []*loggregator_v2.Envelope{
+						{
+							Timestamp:  int64(40),
+							InstanceId: "1",
+							Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("GET /health 200")}},
+							Tags:       map[string]string{"source_type": "RTR"},
+						},
+						{
+							Timestamp:  int64(30),
+							InstanceId: "1",
+							Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("GET /api 500")}},
+							Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						},
+						{
+							Timestamp:  int64(20),
+							InstanceId: "0",
+							Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("GET /api 500")}},
+							Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						},
+						{
+							Timestamp:  int64(10),
+							InstanceId: "1",
+							Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("GET /api 500")}},
+							Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						},
+					}
+
+					fakeLogCacheClient.ReadReturns(messages, nil)
+				})
+
+				It("only asks Log Cache for the logs since the lookback time and returns the matching ones", func() {
+					messages, err := sharedaction.GetFilteredRecentLogs("some-app-guid", fakeLogCacheClient, sharedaction.LogFilter{
+						SourceTypes: []string{"app"},
+						Instances:   []string{"1"},
+						Patterns:    []*regexp.Regexp{regexp.MustCompile(` 5\d\d$`)},
+						Since:       since,
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+					Expect(start).To(Equal(since))
+
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 30)))
+				})
+			})
+
 			When("Log Cache returns non-log envelopes", func() {
 				BeforeEach(func() {
 					messages := []*loggregator_v2.Envelope{
@@ -3,12 +3,15 @@ package sharedaction
 import (
 	"archive/zip"
 	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
@@ -36,6 +39,22 @@ var DefaultIgnoreLines = []string{
 	"manifest.yml",
 }
 
+// ReproducibleModTime is the modification time recorded for every file in a
+// zip unless timestamps are preserved. It is the earliest time a zip can hold.
+var ReproducibleModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ZipOptions controls how resources are written into a zip.
+type ZipOptions struct {
+	// PreserveTimestamps keeps the modification times of the source files
+	// instead of zeroing them, so the zip is no longer reproducible.
+	PreserveTimestamps bool
+
+	// Progress, when set, receives the content of every file as it is zipped,
+	// so that progress can be measured against the size of the source files
+	// rather than the size of the compressed zip, which is not known upfront.
+	Progress io.Writer
+}
+
 type Resource struct {
 	Filename string      `json:"fn"`
 	Mode     os.FileMode `json:"mode"`
@@ -65,6 +84,23 @@ func (r V3Resource) ToV2Resource() Resource {
 	}
 }
 
+// ResourcesDigest returns a sha256 digest of the path, mode and contents of the
+// given resources. It does not depend on the order of the resources, so the
+// same source always produces the same digest.
+func ResourcesDigest(resources []Resource) string {
+	sorted := make([]Resource, len(resources))
+	copy(sorted, resources)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Filename < sorted[j].Filename
+	})
+
+	sum := sha256.New()
+	for _, resource := range sorted {
+		fmt.Fprintf(sum, "%s %o %s\n", resource.SHA1, resource.Mode, resource.Filename)
+	}
+	return fmt.Sprintf("sha256:%x", sum.Sum(nil))
+}
+
 // GatherArchiveResources returns a list of resources for an archive.
 func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error) {
 	var resources []Resource
@@ -127,86 +163,197 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
+// GatherOptions controls how GatherDirectoryResourcesWithOptions packages
+// the contents of a directory.
+type GatherOptions struct {
+	// FollowSymlinks packages the files and directories that symlinks point
+	// to instead of the symlinks themselves.
+	FollowSymlinks bool
+	// PreserveFileModes keeps the modes of regular files as they are in the
+	// directory instead of normalizing them with NormalizeFileMode.
+	PreserveFileModes bool
+	// HashCache, when set, provides the SHA1s of the files that did not change
+	// since they were last hashed, and remembers the SHA1s of the others.
+	HashCache FileHashCache
+}
+
+// ModeChange records a file whose mode was altered by NormalizeFileMode while
+// gathering resources.
+type ModeChange struct {
+	Filename   string
+	Original   os.FileMode
+	Normalized os.FileMode
+}
+
+// NormalizeFileMode applies the packaging permission rules to the mode of a
+// regular file:
+//   - setuid, setgid and sticky bits are dropped
+//   - the file is always readable by its owner
+//   - the file is never world writable
+func NormalizeFileMode(mode os.FileMode) os.FileMode {
+	normalized := mode &^ (os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+	normalized |= 0400
+	normalized &^= 0002
+	return normalized
+}
+
 // GatherDirectoryResources returns a list of resources for a directory.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
+	resources, _, err := actor.GatherDirectoryResourcesWithOptions(sourceDir, GatherOptions{})
+	return resources, err
+}
+
+// GatherDirectoryResourcesWithOptions returns a list of resources for a
+// directory, along with the files whose modes were normalized.
+func (actor Actor) GatherDirectoryResourcesWithOptions(sourceDir string, options GatherOptions) ([]Resource, []ModeChange, error) {
 	var (
-		resources []Resource
-		gitIgnore *ignore.GitIgnore
+		resources   []Resource
+		modeChanges []ModeChange
+		gitIgnore   *ignore.GitIgnore
 	)
 
 	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	evalDir, err := filepath.EvalSymlinks(sourceDir)
 	if err != nil {
 		log.Errorln("evaluating symlink:", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+	var walkDir func(dir string, prefix string, ancestors []string) error
+	walkDir = func(dir string, prefix string, ancestors []string) error {
+		return filepath.Walk(dir, func(fullPath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
 
-		relPath, err := filepath.Rel(evalDir, fullPath)
-		if err != nil {
-			return err
-		}
+			relPath, err := filepath.Rel(dir, fullPath)
+			if err != nil {
+				return err
+			}
 
-		// if file ignored continue to the next file
-		if gitIgnore.MatchesPath(relPath) {
-			return nil
-		}
+			// the root of a followed symlink has already been added under the
+			// name of the symlink
+			if relPath == "." {
+				return nil
+			}
+			relPath = filepath.Join(prefix, relPath)
 
-		if relPath == "." {
-			return nil
-		}
+			// if file ignored continue to the next file
+			if gitIgnore.MatchesPath(relPath) {
+				return nil
+			}
 
-		resource := Resource{
-			Filename: filepath.ToSlash(relPath),
-		}
+			if options.FollowSymlinks && info.Mode()&os.ModeSymlink == os.ModeSymlink {
+				targetInfo, err := os.Stat(fullPath)
+				if err != nil {
+					log.WithField("fullPath", fullPath).Errorln("following symlink:", err)
+					return err
+				}
+
+				if targetInfo.IsDir() {
+					target, err := filepath.EvalSymlinks(fullPath)
+					if err != nil {
+						return err
+					}
+					for _, ancestor := range ancestors {
+						if target == ancestor || strings.HasPrefix(ancestor, target+string(filepath.Separator)) {
+							return actionerror.SymlinkCycleError{Path: filepath.ToSlash(relPath)}
+						}
+					}
+
+					resources = append(resources, Resource{
+						Filename: filepath.ToSlash(relPath),
+						Mode:     DefaultFolderPermissions,
+					})
+					return walkDir(target, relPath, append(ancestors, target))
+				}
+
+				log.WithField("fullPath", fullPath).Debug("following symlink to file")
+				info = targetInfo
+			}
 
-		switch {
-		case info.IsDir():
-			// If the file is a directory
-			resource.Mode = DefaultFolderPermissions
-		case info.Mode()&os.ModeSymlink == os.ModeSymlink:
-			// If the file is a Symlink we just set the mode of the file
-			// We won't be using any sha information since we don't do
-			// any resource matching on symlinks.
-			resource.Mode = fixMode(info.Mode())
-		default:
-			// If the file is regular we want to open
-			// and calculate the sha of the file
-			file, err := os.Open(fullPath)
-			if err != nil {
-				return err
+			resource := Resource{
+				Filename: filepath.ToSlash(relPath),
 			}
-			defer file.Close()
 
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
-			if err != nil {
-				return err
+			switch {
+			case info.IsDir():
+				// If the file is a directory
+				resource.Mode = DefaultFolderPermissions
+			case info.Mode()&os.ModeSymlink == os.ModeSymlink:
+				// If the file is a Symlink we just set the mode of the file
+				// We won't be using any sha information since we don't do
+				// any resource matching on symlinks.
+				resource.Mode = fixMode(info.Mode())
+			default:
+				// If the file is regular we want to open
+				// and calculate the sha of the file, unless it is cached
+				sha, err := fileSHA1(fullPath, resource.Filename, info, options.HashCache)
+				if err != nil {
+					return err
+				}
+
+				mode := fixMode(info.Mode())
+				resource.Mode = mode
+				if !options.PreserveFileModes {
+					resource.Mode = normalizeFileMode(mode)
+				}
+				if resource.Mode != mode {
+					modeChanges = append(modeChanges, ModeChange{
+						Filename:   resource.Filename,
+						Original:   mode,
+						Normalized: resource.Mode,
+					})
+				}
+				resource.SHA1 = sha
+				resource.Size = info.Size()
 			}
 
-			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
-			resource.Size = info.Size()
-		}
+			resources = append(resources, resource)
+			return nil
+		})
+	}
 
-		resources = append(resources, resource)
-		return nil
-	})
+	walkErr := walkDir(evalDir, "", []string{evalDir})
 
 	if len(resources) == 0 {
-		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
+		return nil, nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
-	return resources, walkErr
+	return resources, modeChanges, walkErr
+}
+
+// fileSHA1 returns the SHA1 of the file at fullPath, from the cache when it
+// has it for the file at path.
+func fileSHA1(fullPath string, path string, info os.FileInfo, cache FileHashCache) (string, error) {
+	if cache != nil {
+		if sha, ok := cache.SHA1(path, info); ok {
+			return sha, nil
+		}
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	sum := sha1.New()
+	_, err = io.Copy(sum, file)
+	if err != nil {
+		return "", err
+	}
+
+	sha := fmt.Sprintf("%x", sum.Sum(nil))
+	if cache != nil {
+		cache.SetSHA1(path, info, sha)
+	}
+	return sha, nil
 }
 
 // ZipArchiveResources zips an archive and a sorted (based on full
@@ -221,18 +368,33 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 	defer zipFile.Close()
 	zipPath := zipFile.Name()
 
-	writer := zip.NewWriter(zipFile)
-	defer writer.Close()
+	err = actor.ZipArchiveResourcesToWriter(sourceArchivePath, filesToInclude, zipFile, ZipOptions{})
+	if err != nil {
+		return zipPath, err
+	}
+
+	log.WithFields(log.Fields{
+		"zip_file_location": zipFile.Name(),
+		"zipped_file_count": len(filesToInclude),
+	}).Info("zip file created")
+	return zipPath, nil
+}
+
+// ZipArchiveResourcesToWriter zips an archive and a sorted (based on full
+// path/filename) list of resources into dest. Unless options preserve them,
+// timestamps are zeroed so the same files always produce the same zip.
+func (actor Actor) ZipArchiveResourcesToWriter(sourceArchivePath string, filesToInclude []Resource, dest io.Writer, options ZipOptions) error {
+	writer := zip.NewWriter(dest)
 
 	source, err := os.Open(sourceArchivePath)
 	if err != nil {
-		return zipPath, err
+		return err
 	}
 	defer source.Close()
 
 	reader, err := actor.newArchiveReader(source)
 	if err != nil {
-		return zipPath, err
+		return err
 	}
 
 	for _, archiveFile := range reader.File {
@@ -247,26 +409,22 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 		reader, openErr := archiveFile.Open()
 		if openErr != nil {
 			log.WithField("archiveFile", archiveFile.Name).Errorln("opening path in dir:", openErr)
-			return zipPath, openErr
+			return openErr
 		}
 		defer reader.Close()
 
 		err = actor.addFileToZipFromFileSystem(
 			resource.Filename, reader, archiveFile.FileInfo(),
-			resource, writer,
+			resource, writer, options,
 		)
 		if err != nil {
 			log.WithField("archiveFileName", archiveFile.Name).Errorln("zipping file:", err)
-			return zipPath, err
+			return err
 		}
 		reader.Close()
 	}
 
-	log.WithFields(log.Fields{
-		"zip_file_location": zipFile.Name(),
-		"zipped_file_count": len(filesToInclude),
-	}).Info("zip file created")
-	return zipPath, nil
+	return writer.Close()
 }
 
 // ZipDirectoryResources zips a directory and a sorted (based on full
@@ -281,8 +439,23 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	defer zipFile.Close()
 	zipPath := zipFile.Name()
 
-	writer := zip.NewWriter(zipFile)
-	defer writer.Close()
+	err = actor.ZipDirectoryResourcesToWriter(sourceDir, filesToInclude, zipFile, ZipOptions{})
+	if err != nil {
+		return zipPath, err
+	}
+
+	log.WithFields(log.Fields{
+		"zip_file_location": zipFile.Name(),
+		"zipped_file_count": len(filesToInclude),
+	}).Info("zip file created")
+	return zipPath, nil
+}
+
+// ZipDirectoryResourcesToWriter zips a directory and a sorted (based on full
+// path/filename) list of resources into dest. Unless options preserve them,
+// timestamps are zeroed so the same files always produce the same zip.
+func (actor Actor) ZipDirectoryResourcesToWriter(sourceDir string, filesToInclude []Resource, dest io.Writer, options ZipOptions) error {
+	writer := zip.NewWriter(dest)
 
 	for _, resource := range filesToInclude {
 		fullPath := filepath.Join(sourceDir, resource.Filename)
@@ -291,47 +464,86 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 		fileInfo, err := os.Lstat(fullPath)
 		if err != nil {
 			log.WithField("fullPath", fullPath).Errorln("stat error in dir:", err)
-			return zipPath, err
+			return err
+		}
+
+		// a symlink that was followed while gathering resources is packaged
+		// as the file it points to
+		if fileInfo.Mode()&os.ModeSymlink == os.ModeSymlink && resource.Mode&os.ModeSymlink == 0 {
+			fileInfo, err = os.Stat(fullPath)
+			if err != nil {
+				log.WithField("fullPath", fullPath).Errorln("stat error in dir:", err)
+				return err
+			}
 		}
 
 		log.WithField("file-mode", fileInfo.Mode().String()).Debug("resource file info")
 		if fileInfo.Mode()&os.ModeSymlink == os.ModeSymlink {
 			// we need to user os.Readlink to read a symlink file from a directory
-			err = actor.addLinkToZipFromFileSystem(fullPath, fileInfo, resource, writer)
+			err = actor.addLinkToZipFromFileSystem(fullPath, fileInfo, resource, writer, options)
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("zipping file:", err)
-				return zipPath, err
+				return err
 			}
 		} else {
 			srcFile, err := os.Open(fullPath)
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("opening path in dir:", err)
-				return zipPath, err
+				return err
 			}
 			defer srcFile.Close()
 
 			err = actor.addFileToZipFromFileSystem(
 				fullPath, srcFile, fileInfo,
-				resource, writer,
+				resource, writer, options,
 			)
 			srcFile.Close()
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("zipping file:", err)
-				return zipPath, err
+				return err
 			}
 		}
 	}
 
-	log.WithFields(log.Fields{
-		"zip_file_location": zipFile.Name(),
-		"zipped_file_count": len(filesToInclude),
-	}).Info("zip file created")
-	return zipPath, nil
+	return writer.Close()
+}
+
+// StreamArchiveResources returns a reader that produces the zip of an archive
+// and a sorted list of resources as it is read, without writing a temporary
+// file to disk. Any error encountered while zipping is returned from Read.
+func (actor Actor) StreamArchiveResources(sourceArchivePath string, filesToInclude []Resource, options ZipOptions) io.ReadCloser {
+	log.WithField("sourceArchive", sourceArchivePath).Info("streaming source files from archive")
+	return streamZip(func(dest io.Writer) error {
+		return actor.ZipArchiveResourcesToWriter(sourceArchivePath, filesToInclude, dest, options)
+	})
+}
+
+// StreamDirectoryResources returns a reader that produces the zip of a
+// directory and a sorted list of resources as it is read, without writing a
+// temporary file to disk. Any error encountered while zipping is returned from
+// Read.
+func (actor Actor) StreamDirectoryResources(sourceDir string, filesToInclude []Resource, options ZipOptions) io.ReadCloser {
+	log.WithField("sourceDir", sourceDir).Info("streaming source files from directory")
+	return streamZip(func(dest io.Writer) error {
+		return actor.ZipDirectoryResourcesToWriter(sourceDir, filesToInclude, dest, options)
+	})
+}
+
+func streamZip(zipTo func(io.Writer) error) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		err := zipTo(writer)
+		if err != nil {
+			log.Errorln("streaming zip:", err)
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	return reader
 }
 
 func (Actor) addLinkToZipFromFileSystem(srcPath string,
 	fileInfo os.FileInfo, resource Resource,
-	zipFile *zip.Writer,
+	zipFile *zip.Writer, options ZipOptions,
 ) error {
 	header, err := zip.FileInfoHeader(fileInfo)
 	if err != nil {
@@ -341,6 +553,7 @@ func (Actor) addLinkToZipFromFileSystem(srcPath string,
 
 	header.Name = resource.Filename
 	header.Method = zip.Deflate
+	setZipModTime(header, options)
 
 	log.WithFields(log.Fields{
 		"srcPath":  srcPath,
@@ -360,7 +573,7 @@ func (Actor) addLinkToZipFromFileSystem(srcPath string,
 	}
 	log.WithField("path", pathInSymlink).Debug("resolving symlink")
 	symLinkContents := strings.NewReader(pathInSymlink)
-	if _, err := io.Copy(destFileWriter, symLinkContents); err != nil {
+	if _, err := io.Copy(withProgress(destFileWriter, options), symLinkContents); err != nil {
 		log.WithField("srcPath", srcPath).Errorln("copying data in dir:", err)
 		return err
 	}
@@ -370,7 +583,7 @@ func (Actor) addLinkToZipFromFileSystem(srcPath string,
 
 func (Actor) addFileToZipFromFileSystem(srcPath string,
 	srcFile io.Reader, fileInfo os.FileInfo, resource Resource,
-	zipFile *zip.Writer,
+	zipFile *zip.Writer, options ZipOptions,
 ) error {
 	header, err := zip.FileInfoHeader(fileInfo)
 	if err != nil {
@@ -386,6 +599,7 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	}
 	header.Method = zip.Deflate
 	header.SetMode(resource.Mode)
+	setZipModTime(header, options)
 
 	log.WithFields(log.Fields{
 		"srcPath":  srcPath,
@@ -401,7 +615,7 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 
 	if fileInfo.Mode().IsRegular() {
 		sum := sha1.New()
-		multi := io.MultiWriter(sum, destFileWriter)
+		multi := io.MultiWriter(sum, withProgress(destFileWriter, options))
 
 		if _, err := io.Copy(multi, srcFile); err != nil {
 			log.WithField("srcPath", srcPath).Errorln("copying data in dir:", err)
@@ -416,7 +630,7 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 			return actionerror.FileChangedError{Filename: srcPath}
 		}
 	} else if fileInfo.Mode()&os.ModeSymlink == os.ModeSymlink {
-		_, err = io.Copy(destFileWriter, srcFile)
+		_, err = io.Copy(withProgress(destFileWriter, options), srcFile)
 		if err != nil {
 			return err
 		}
@@ -425,6 +639,21 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	return nil
 }
 
+// withProgress returns a writer that also copies what is written to dest to
+// the progress writer of the options, if any.
+func withProgress(dest io.Writer, options ZipOptions) io.Writer {
+	if options.Progress == nil {
+		return dest
+	}
+	return io.MultiWriter(dest, options.Progress)
+}
+
+func setZipModTime(header *zip.FileHeader, options ZipOptions) {
+	if !options.PreserveTimestamps {
+		header.Modified = ReproducibleModTime
+	}
+}
+
 func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
 	for _, item := range files {
 		if strings.HasSuffix(item.Name, ".cfignore") {
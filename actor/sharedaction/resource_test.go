@@ -2,10 +2,12 @@ package sharedaction_test
 
 import (
 	"archive/zip"
+	"bytes"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
@@ -422,6 +424,147 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 	})
+
+	Describe("StreamDirectoryResources", func() {
+		var (
+			resources  []Resource
+			options    ZipOptions
+			zipBytes   []byte
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			options = ZipOptions{}
+		})
+
+		JustBeforeEach(func() {
+			stream := actor.StreamDirectoryResources(srcDir, resources, options)
+			defer stream.Close()
+			zipBytes, executeErr = ioutil.ReadAll(stream)
+		})
+
+		When("the files have not been changed since scanning them", func() {
+			BeforeEach(func() {
+				resources = []Resource{
+					{Filename: "level1"},
+					{Filename: "level1/level2"},
+					{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4"},
+					{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95"},
+				}
+			})
+
+			It("streams a zip of the resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				reader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(reader.File).To(HaveLen(4))
+				Expect(reader.File[0].Name).To(Equal("level1/"))
+				Expect(reader.File[1].Name).To(Equal("level1/level2/"))
+				Expect(reader.File[2].Name).To(Equal("level1/level2/tmpFile1"))
+				expectFileContentsToEqual(reader.File[2], "why hello")
+				Expect(reader.File[3].Name).To(Equal("tmpFile2"))
+				expectFileContentsToEqual(reader.File[3], "Hello, Binky")
+			})
+
+			It("zeroes the timestamps so the zip is reproducible", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				reader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+				Expect(err).ToNot(HaveOccurred())
+				for _, file := range reader.File {
+					Expect(file.Modified.Equal(ReproducibleModTime)).To(BeTrue(), file.Name)
+				}
+
+				later := time.Now().Add(time.Hour)
+				Expect(os.Chtimes(filepath.Join(srcDir, "tmpFile2"), later, later)).To(Succeed())
+
+				stream := actor.StreamDirectoryResources(srcDir, resources, options)
+				defer stream.Close()
+				rezipped, err := ioutil.ReadAll(stream)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rezipped).To(Equal(zipBytes))
+			})
+
+			When("a progress writer is given", func() {
+				var progress *bytes.Buffer
+
+				BeforeEach(func() {
+					progress = new(bytes.Buffer)
+					options.Progress = progress
+				})
+
+				It("writes the content of the source files to it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(progress.String()).To(Equal("why helloHello, Binky"))
+				})
+			})
+
+			When("timestamps are preserved", func() {
+				var modTime time.Time
+
+				BeforeEach(func() {
+					options.PreserveTimestamps = true
+					modTime = time.Date(2020, time.March, 4, 5, 6, 8, 0, time.UTC)
+					Expect(os.Chtimes(filepath.Join(srcDir, "tmpFile2"), modTime, modTime)).To(Succeed())
+				})
+
+				It("keeps the modification times of the source files", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					reader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reader.File[3].Modified.UTC()).To(Equal(modTime))
+				})
+			})
+		})
+
+		When("the files have changed since the scanning", func() {
+			BeforeEach(func() {
+				resources = []Resource{
+					{Filename: "tmpFile3", SHA1: "i dunno, 7?"},
+				}
+			})
+
+			It("returns an FileChangedError from the stream", func() {
+				Expect(executeErr).To(Equal(actionerror.FileChangedError{Filename: filepath.Join(srcDir, "tmpFile3")}))
+			})
+		})
+	})
+
+	Describe("ResourcesDigest", func() {
+		var resources []Resource
+
+		BeforeEach(func() {
+			resources = []Resource{
+				{Filename: "level1", Mode: DefaultFolderPermissions | os.ModeDir},
+				{Filename: "level1/tmpFile1", Mode: 0644, SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4"},
+				{Filename: "tmpFile2", Mode: 0755, SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95"},
+			}
+		})
+
+		It("returns a sha256 digest", func() {
+			Expect(ResourcesDigest(resources)).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+		})
+
+		It("does not depend on the order of the resources", func() {
+			reordered := []Resource{resources[2], resources[0], resources[1]}
+			Expect(ResourcesDigest(reordered)).To(Equal(ResourcesDigest(resources)))
+		})
+
+		It("changes when the contents or mode of a file change", func() {
+			digest := ResourcesDigest(resources)
+
+			changedContents := append([]Resource{}, resources...)
+			changedContents[2].SHA1 = "0000000000000000000000000000000000000000"
+			Expect(ResourcesDigest(changedContents)).ToNot(Equal(digest))
+
+			changedMode := append([]Resource{}, resources...)
+			changedMode[2].Mode = 0644
+			Expect(ResourcesDigest(changedMode)).ToNot(Equal(digest))
+		})
+	})
 })
 
 func expectFileContentsToEqual(file *zip.File, expectedContents string) {
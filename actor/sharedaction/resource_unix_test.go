@@ -341,6 +341,150 @@ var _ = Describe("Resource Actions", func() {
 		})
 	})
 
+	Describe("GatherDirectoryResourcesWithOptions", func() {
+		var (
+			options           GatherOptions
+			gatheredResources []Resource
+			modeChanges       []ModeChange
+			executeErr        error
+			outsideDir        string
+		)
+
+		BeforeEach(func() {
+			options = GatherOptions{}
+
+			var err error
+			outsideDir, err = ioutil.TempDir("", "v2-resource-actions-outside")
+			Expect(err).ToNot(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(outsideDir, "shared"), []byte("why hello"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(os.Symlink(filepath.Join(outsideDir, "shared"), filepath.Join(srcDir, "file-link"))).To(Succeed())
+			Expect(os.Symlink(outsideDir, filepath.Join(srcDir, "dir-link"))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(outsideDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			gatheredResources, modeChanges, executeErr = actor.GatherDirectoryResourcesWithOptions(srcDir, options)
+		})
+
+		When("symlinks are preserved", func() {
+			It("gathers the symlinks themselves", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "file-link", Mode: os.ModeSymlink | 0777}))
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "dir-link", Mode: os.ModeSymlink | 0777}))
+				Expect(gatheredResources).ToNot(ContainElement(HaveField("Filename", "dir-link/shared")))
+			})
+		})
+
+		When("symlinks are followed", func() {
+			BeforeEach(func() {
+				options.FollowSymlinks = true
+			})
+
+			It("gathers the files and directories the symlinks point to", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "file-link", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644}))
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "dir-link", Mode: DefaultFolderPermissions}))
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "dir-link/shared", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644}))
+			})
+
+			It("zips the followed files as regular files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				zipPath, err := actor.ZipDirectoryResources(srcDir, gatheredResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(zipPath)
+
+				zipFile, err := os.Open(zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				defer zipFile.Close()
+				zipInfo, err := zipFile.Stat()
+				Expect(err).ToNot(HaveOccurred())
+
+				reader, err := ykk.NewReader(zipFile, zipInfo.Size())
+				Expect(err).ToNot(HaveOccurred())
+				for _, file := range reader.File {
+					Expect(file.Mode() & os.ModeSymlink).To(BeZero())
+				}
+			})
+
+			When("a followed symlink points back at one of its parents", func() {
+				BeforeEach(func() {
+					Expect(os.Symlink(srcDir, filepath.Join(srcDir, "level1", "loop"))).To(Succeed())
+				})
+
+				It("returns a SymlinkCycleError", func() {
+					Expect(executeErr).To(MatchError(actionerror.SymlinkCycleError{Path: "level1/loop"}))
+				})
+			})
+		})
+
+		When("a hash cache is given", func() {
+			var fakeHashCache *sharedactionfakes.FakeFileHashCache
+
+			BeforeEach(func() {
+				fakeHashCache = new(sharedactionfakes.FakeFileHashCache)
+				fakeHashCache.SHA1Stub = func(path string, info os.FileInfo) (string, bool) {
+					if path == "tmpFile2" {
+						return "cached-sha", true
+					}
+					return "", false
+				}
+				options.HashCache = fakeHashCache
+			})
+
+			It("uses the cached SHA1s and caches the SHA1s of the other files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "tmpFile2", SHA1: "cached-sha", Size: 12, Mode: 0751}))
+				Expect(gatheredResources).To(ContainElement(Resource{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644}))
+
+				var cachedPaths []string
+				for i := 0; i < fakeHashCache.SetSHA1CallCount(); i++ {
+					path, info, sha := fakeHashCache.SetSHA1ArgsForCall(i)
+					Expect(info.Name()).To(Equal(filepath.Base(path)))
+					if path == "level1/level2/tmpFile1" {
+						Expect(sha).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+					}
+					cachedPaths = append(cachedPaths, path)
+				}
+				Expect(cachedPaths).To(ConsistOf("level1/level2/tmpFile1", "tmpFile3"))
+			})
+		})
+
+		When("files have modes that do not follow the packaging rules", func() {
+			BeforeEach(func() {
+				Expect(os.Chmod(filepath.Join(srcDir, "tmpFile2"), 0757|os.ModeSetuid)).To(Succeed())
+				Expect(os.Chmod(filepath.Join(srcDir, "tmpFile3"), 0066)).To(Succeed())
+			})
+
+			It("normalizes the modes and reports the altered files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(gatheredResources).To(ContainElement(HaveField("Mode", os.FileMode(0755))))
+				Expect(gatheredResources).To(ContainElement(HaveField("Mode", os.FileMode(0464))))
+				Expect(modeChanges).To(ConsistOf(
+					ModeChange{Filename: "tmpFile2", Original: 0757 | os.ModeSetuid, Normalized: 0755},
+					ModeChange{Filename: "tmpFile3", Original: 0066, Normalized: 0464},
+				))
+			})
+
+			When("file modes are preserved", func() {
+				BeforeEach(func() {
+					options.PreserveFileModes = true
+				})
+
+				It("keeps the modes and reports no altered files", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(gatheredResources).To(ContainElement(HaveField("Mode", 0757|os.ModeSetuid)))
+					Expect(gatheredResources).To(ContainElement(HaveField("Mode", os.FileMode(0066))))
+					Expect(modeChanges).To(BeEmpty())
+				})
+			})
+		})
+	})
+
 	Describe("ZipDirectoryResources", func() {
 		var (
 			resultZip  string
@@ -0,0 +1,160 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sharedactionfakes
+
+import (
+	"io/fs"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+type FakeFileHashCache struct {
+	SHA1Stub        func(string, fs.FileInfo) (string, bool)
+	sHA1Mutex       sync.RWMutex
+	sHA1ArgsForCall []struct {
+		arg1 string
+		arg2 fs.FileInfo
+	}
+	sHA1Returns struct {
+		result1 string
+		result2 bool
+	}
+	sHA1ReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	SetSHA1Stub        func(string, fs.FileInfo, string)
+	setSHA1Mutex       sync.RWMutex
+	setSHA1ArgsForCall []struct {
+		arg1 string
+		arg2 fs.FileInfo
+		arg3 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFileHashCache) SHA1(arg1 string, arg2 fs.FileInfo) (string, bool) {
+	fake.sHA1Mutex.Lock()
+	ret, specificReturn := fake.sHA1ReturnsOnCall[len(fake.sHA1ArgsForCall)]
+	fake.sHA1ArgsForCall = append(fake.sHA1ArgsForCall, struct {
+		arg1 string
+		arg2 fs.FileInfo
+	}{arg1, arg2})
+	fake.recordInvocation("SHA1", []interface{}{arg1, arg2})
+	fake.sHA1Mutex.Unlock()
+	if fake.SHA1Stub != nil {
+		return fake.SHA1Stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.sHA1Returns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileHashCache) SHA1CallCount() int {
+	fake.sHA1Mutex.RLock()
+	defer fake.sHA1Mutex.RUnlock()
+	return len(fake.sHA1ArgsForCall)
+}
+
+func (fake *FakeFileHashCache) SHA1Calls(stub func(string, fs.FileInfo) (string, bool)) {
+	fake.sHA1Mutex.Lock()
+	defer fake.sHA1Mutex.Unlock()
+	fake.SHA1Stub = stub
+}
+
+func (fake *FakeFileHashCache) SHA1ArgsForCall(i int) (string, fs.FileInfo) {
+	fake.sHA1Mutex.RLock()
+	defer fake.sHA1Mutex.RUnlock()
+	argsForCall := fake.sHA1ArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileHashCache) SHA1Returns(result1 string, result2 bool) {
+	fake.sHA1Mutex.Lock()
+	defer fake.sHA1Mutex.Unlock()
+	fake.SHA1Stub = nil
+	fake.sHA1Returns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeFileHashCache) SHA1ReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.sHA1Mutex.Lock()
+	defer fake.sHA1Mutex.Unlock()
+	fake.SHA1Stub = nil
+	if fake.sHA1ReturnsOnCall == nil {
+		fake.sHA1ReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.sHA1ReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeFileHashCache) SetSHA1(arg1 string, arg2 fs.FileInfo, arg3 string) {
+	fake.setSHA1Mutex.Lock()
+	fake.setSHA1ArgsForCall = append(fake.setSHA1ArgsForCall, struct {
+		arg1 string
+		arg2 fs.FileInfo
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetSHA1", []interface{}{arg1, arg2, arg3})
+	fake.setSHA1Mutex.Unlock()
+	if fake.SetSHA1Stub != nil {
+		fake.SetSHA1Stub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeFileHashCache) SetSHA1CallCount() int {
+	fake.setSHA1Mutex.RLock()
+	defer fake.setSHA1Mutex.RUnlock()
+	return len(fake.setSHA1ArgsForCall)
+}
+
+func (fake *FakeFileHashCache) SetSHA1Calls(stub func(string, fs.FileInfo, string)) {
+	fake.setSHA1Mutex.Lock()
+	defer fake.setSHA1Mutex.Unlock()
+	fake.SetSHA1Stub = stub
+}
+
+func (fake *FakeFileHashCache) SetSHA1ArgsForCall(i int) (string, fs.FileInfo, string) {
+	fake.setSHA1Mutex.RLock()
+	defer fake.setSHA1Mutex.RUnlock()
+	argsForCall := fake.setSHA1ArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileHashCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.sHA1Mutex.RLock()
+	defer fake.sHA1Mutex.RUnlock()
+	fake.setSHA1Mutex.RLock()
+	defer fake.setSHA1Mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFileHashCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sharedaction.FileHashCache = new(FakeFileHashCache)
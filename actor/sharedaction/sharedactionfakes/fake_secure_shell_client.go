@@ -2,6 +2,7 @@
 package sharedactionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -34,6 +35,31 @@ type FakeSecureShellClient struct {
 	connectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DownloadStub        func(string, io.Writer) error
+	downloadMutex       sync.RWMutex
+	downloadArgsForCall []struct {
+		arg1 string
+		arg2 io.Writer
+	}
+	downloadReturns struct {
+		result1 error
+	}
+	downloadReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExecStub        func([]string, io.Writer, io.Writer) error
+	execMutex       sync.RWMutex
+	execArgsForCall []struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}
+	execReturns struct {
+		result1 error
+	}
+	execReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func([]string, clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -187,6 +213,134 @@ func (fake *FakeSecureShellClient) ConnectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) Download(arg1 string, arg2 io.Writer) error {
+	fake.downloadMutex.Lock()
+	ret, specificReturn := fake.downloadReturnsOnCall[len(fake.downloadArgsForCall)]
+	fake.downloadArgsForCall = append(fake.downloadArgsForCall, struct {
+		arg1 string
+		arg2 io.Writer
+	}{arg1, arg2})
+	fake.recordInvocation("Download", []interface{}{arg1, arg2})
+	fake.downloadMutex.Unlock()
+	if fake.DownloadStub != nil {
+		return fake.DownloadStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.downloadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DownloadCallCount() int {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	return len(fake.downloadArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DownloadCalls(stub func(string, io.Writer) error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = stub
+}
+
+func (fake *FakeSecureShellClient) DownloadArgsForCall(i int) (string, io.Writer) {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	argsForCall := fake.downloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecureShellClient) DownloadReturns(result1 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	fake.downloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DownloadReturnsOnCall(i int, result1 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	if fake.downloadReturnsOnCall == nil {
+		fake.downloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) Exec(arg1 []string, arg2 io.Writer, arg3 io.Writer) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.execMutex.Lock()
+	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
+	fake.execArgsForCall = append(fake.execArgsForCall, struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("Exec", []interface{}{arg1Copy, arg2, arg3})
+	fake.execMutex.Unlock()
+	if fake.ExecStub != nil {
+		return fake.ExecStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.execReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) ExecCallCount() int {
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	return len(fake.execArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) ExecCalls(stub func([]string, io.Writer, io.Writer) error) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = stub
+}
+
+func (fake *FakeSecureShellClient) ExecArgsForCall(i int) ([]string, io.Writer, io.Writer) {
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	argsForCall := fake.execArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecureShellClient) ExecReturns(result1 error) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = nil
+	fake.execReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) ExecReturnsOnCall(i int, result1 error) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = nil
+	if fake.execReturnsOnCall == nil {
+		fake.execReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.execReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh.TTYRequest) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -377,6 +531,10 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -45,6 +49,54 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureShellCommand runs the commands in the app instance without an
+// interactive session, writing their output to stdout and stderr.
+func (actor Actor) ExecuteSecureShellCommand(sshClient SecureShellClient, sshOptions SSHOptions, stdout io.Writer, stderr io.Writer) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	return sshClient.Exec(sshOptions.Commands, stdout, stderr)
+}
+
+// ExecuteSecureShellAndDownload runs the commands in the app instance, when
+// there are any, and then downloads each of the remote files into the writer
+// returned by createFile, all over a single connection.
+func (actor Actor) ExecuteSecureShellAndDownload(sshClient SecureShellClient, sshOptions SSHOptions, stdout io.Writer, stderr io.Writer, remotePaths []string, createFile func(remotePath string) (io.WriteCloser, error)) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if len(sshOptions.Commands) > 0 {
+		err = sshClient.Exec(sshOptions.Commands, stdout, stderr)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, remotePath := range remotePaths {
+		dest, err := createFile(remotePath)
+		if err != nil {
+			return err
+		}
+
+		err = sshClient.Download(remotePath, dest)
+		closeErr := dest.Close()
+		if err != nil {
+			return err
+		}
+		if closeErr != nil {
+			return closeErr
+		}
+	}
+
+	return nil
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -1,7 +1,9 @@
 package sharedaction_test
 
 import (
+	"bytes"
 	"errors"
+	"io"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
@@ -163,4 +165,168 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellCommand", func() {
+		var (
+			sshOptions SSHOptions
+			stdout     *bytes.Buffer
+			stderr     *bytes.Buffer
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Commands:           []string{"some-command", "some-arg"},
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+			}
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureShellCommand(fakeSecureShellClient, sshOptions, stdout, stderr)
+		})
+
+		It("connects and runs the commands with the given writers", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeFalse())
+
+			Expect(fakeSecureShellClient.ExecCallCount()).To(Equal(1))
+			commandsArg, stdoutArg, stderrArg := fakeSecureShellClient.ExecArgsForCall(0)
+			Expect(commandsArg).To(Equal([]string{"some-command", "some-arg"}))
+			Expect(stdoutArg).To(Equal(stdout))
+			Expect(stderrArg).To(Equal(stderr))
+
+			Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without running the commands", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.ExecCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("running the commands fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ExecReturns(errors.New("some-exec-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-exec-error"))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("ExecuteSecureShellAndDownload", func() {
+		var (
+			sshOptions  SSHOptions
+			remotePaths []string
+			createdFor  []string
+			files       []*closableBuffer
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Commands: []string{"some-command"},
+				Username: "some-user",
+				Passcode: "some-passcode",
+				Endpoint: "some-endpoint",
+			}
+			remotePaths = []string{"/tmp/file-1", "/tmp/file-2"}
+			createdFor = nil
+			files = nil
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureShellAndDownload(fakeSecureShellClient, sshOptions, new(bytes.Buffer), new(bytes.Buffer), remotePaths, func(remotePath string) (io.WriteCloser, error) {
+				createdFor = append(createdFor, remotePath)
+				file := new(closableBuffer)
+				files = append(files, file)
+				return file, nil
+			})
+		})
+
+		It("runs the commands and downloads each file over one connection", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShellClient.ExecCallCount()).To(Equal(1))
+			commandsArg, _, _ := fakeSecureShellClient.ExecArgsForCall(0)
+			Expect(commandsArg).To(Equal([]string{"some-command"}))
+
+			Expect(createdFor).To(Equal(remotePaths))
+			Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(2))
+			remotePathArg, destArg := fakeSecureShellClient.DownloadArgsForCall(1)
+			Expect(remotePathArg).To(Equal("/tmp/file-2"))
+			Expect(destArg).To(Equal(files[1]))
+			Expect(files[0].closed).To(BeTrue())
+			Expect(files[1].closed).To(BeTrue())
+
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("there are no commands", func() {
+			BeforeEach(func() {
+				sshOptions.Commands = nil
+			})
+
+			It("only downloads the files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.ExecCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the commands fail", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ExecReturns(errors.New("some-exec-error"))
+			})
+
+			It("returns the error without downloading", func() {
+				Expect(executeErr).To(MatchError("some-exec-error"))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a download fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.DownloadReturns(errors.New("some-download-error"))
+			})
+
+			It("closes the file and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-download-error"))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(1))
+				Expect(files[0].closed).To(BeTrue())
+			})
+		})
+	})
 })
+
+type closableBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closableBuffer) Close() error {
+	b.closed = true
+	return nil
+}
@@ -0,0 +1,198 @@
+package v7action
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// APIResource is a resource of the Cloud Controller V3 API of the targeted
+// foundation.
+type APIResource struct {
+	Name string
+	// Path is the path of the resource for 'cf curl', e.g. /v3/apps.
+	Path string
+	// QueryParameters are the filters the CLI knows the endpoint that lists
+	// the resource to accept. They are empty for resources the CLI does not
+	// know.
+	QueryParameters []string
+	// Commands are the commands of the CLI that use the resource.
+	Commands []string
+}
+
+// KnownAPIResource is what the CLI knows about a resource of the Cloud
+// Controller V3 API.
+type KnownAPIResource struct {
+	QueryParameters []string
+	Commands        []string
+}
+
+// KnownAPIResources are the resources of the Cloud Controller V3 API that the
+// CLI knows, by the name the API root links them with.
+var KnownAPIResources = map[string]KnownAPIResource{
+	"app_usage_events": {
+		QueryParameters: []string{"after_guid", "guids"},
+	},
+	"apps": {
+		QueryParameters: []string{"names", "guids", "space_guids", "organization_guids", "stacks", "lifecycle_type", "include", "label_selector"},
+		Commands:        []string{"apps", "app", "create-app", "push", "delete", "rename", "start", "stop", "restart", "restage", "env", "set-env"},
+	},
+	"audit_events": {
+		QueryParameters: []string{"types", "target_guids", "space_guids", "organization_guids"},
+		Commands:        []string{"events"},
+	},
+	"buildpacks": {
+		QueryParameters: []string{"names", "stacks", "lifecycle", "label_selector"},
+		Commands:        []string{"buildpacks", "create-buildpack", "update-buildpack", "delete-buildpack"},
+	},
+	"builds": {
+		QueryParameters: []string{"states", "app_guids", "package_guids", "label_selector"},
+		Commands:        []string{"stage-package", "push"},
+	},
+	"deployments": {
+		QueryParameters: []string{"app_guids", "states", "status_reasons", "status_values", "label_selector"},
+		Commands:        []string{"cancel-deployment", "continue-deployment", "rollout-status", "push"},
+	},
+	"domains": {
+		QueryParameters: []string{"guids", "names", "organization_guids", "label_selector"},
+		Commands:        []string{"domains", "create-private-domain", "create-shared-domain", "delete-private-domain", "delete-shared-domain"},
+	},
+	"droplets": {
+		QueryParameters: []string{"guids", "states", "app_guids", "space_guids", "organization_guids", "label_selector"},
+		Commands:        []string{"droplets", "set-droplet", "download-droplet"},
+	},
+	"environment_variable_groups": {
+		Commands: []string{"running-environment-variable-group", "staging-environment-variable-group", "set-running-environment-variable-group", "set-staging-environment-variable-group"},
+	},
+	"feature_flags": {
+		Commands: []string{"feature-flags", "feature-flag", "enable-feature-flag", "disable-feature-flag"},
+	},
+	"isolation_segments": {
+		QueryParameters: []string{"guids", "names", "organization_guids", "label_selector"},
+		Commands:        []string{"isolation-segments", "create-isolation-segment", "delete-isolation-segment", "enable-org-isolation", "disable-org-isolation", "set-org-default-isolation-segment", "set-space-isolation-segment"},
+	},
+	"organization_quotas": {
+		QueryParameters: []string{"guids", "names", "organization_guids"},
+		Commands:        []string{"org-quotas", "org-quota", "create-org-quota", "update-org-quota", "delete-org-quota", "set-org-quota"},
+	},
+	"organizations": {
+		QueryParameters: []string{"names", "guids", "label_selector"},
+		Commands:        []string{"orgs", "org", "create-org", "delete-org", "rename-org"},
+	},
+	"packages": {
+		QueryParameters: []string{"guids", "states", "types", "app_guids", "space_guids", "organization_guids", "label_selector"},
+		Commands:        []string{"packages", "create-package", "push"},
+	},
+	"processes": {
+		QueryParameters: []string{"guids", "types", "app_guids", "space_guids", "organization_guids", "label_selector"},
+		Commands:        []string{"processes", "scale", "set-health-check"},
+	},
+	"resource_matches": {
+		Commands: []string{"push"},
+	},
+	"roles": {
+		QueryParameters: []string{"guids", "types", "organization_guids", "space_guids", "user_guids", "include"},
+		Commands:        []string{"org-users", "space-users", "set-org-role", "set-space-role", "unset-org-role", "unset-space-role"},
+	},
+	"routes": {
+		QueryParameters: []string{"hosts", "paths", "ports", "domain_guids", "app_guids", "space_guids", "organization_guids", "include", "label_selector"},
+		Commands:        []string{"routes", "create-route", "delete-route", "map-route", "unmap-route", "check-route"},
+	},
+	"security_groups": {
+		QueryParameters: []string{"guids", "names", "globally_enabled_running", "globally_enabled_staging", "running_space_guids", "staging_space_guids"},
+		Commands:        []string{"security-groups", "security-group", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
+	},
+	"service_brokers": {
+		QueryParameters: []string{"names", "space_guids", "label_selector"},
+		Commands:        []string{"service-brokers", "create-service-broker", "update-service-broker", "rename-service-broker", "delete-service-broker"},
+	},
+	"service_credential_bindings": {
+		QueryParameters: []string{"names", "service_instance_guids", "service_instance_names", "app_guids", "app_names", "type", "include", "label_selector"},
+		Commands:        []string{"bind-service", "unbind-service", "service-keys", "service-key", "create-service-key", "delete-service-key"},
+	},
+	"service_instances": {
+		QueryParameters: []string{"names", "guids", "type", "space_guids", "organization_guids", "service_plan_guids", "service_plan_names", "label_selector"},
+		Commands:        []string{"services", "service", "create-service", "update-service", "rename-service", "delete-service", "share-service", "unshare-service", "create-user-provided-service", "update-user-provided-service"},
+	},
+	"service_offerings": {
+		QueryParameters: []string{"names", "available", "service_broker_guids", "service_broker_names", "space_guids", "organization_guids", "label_selector"},
+		Commands:        []string{"marketplace", "service-access", "enable-service-access", "disable-service-access", "purge-service-offering"},
+	},
+	"service_plans": {
+		QueryParameters: []string{"names", "available", "service_broker_guids", "service_broker_names", "service_offering_guids", "service_offering_names", "service_instance_guids", "space_guids", "organization_guids", "include", "label_selector"},
+		Commands:        []string{"marketplace", "service-access", "enable-service-access", "disable-service-access"},
+	},
+	"service_route_bindings": {
+		QueryParameters: []string{"route_guids", "service_instance_guids", "service_instance_names", "include", "label_selector"},
+		Commands:        []string{"bind-route-service", "unbind-route-service"},
+	},
+	"service_usage_events": {
+		QueryParameters: []string{"after_guid", "guids", "service_instance_types", "service_offering_guids"},
+	},
+	"space_quotas": {
+		QueryParameters: []string{"guids", "names", "organization_guids", "space_guids"},
+		Commands:        []string{"space-quotas", "space-quota", "create-space-quota", "update-space-quota", "delete-space-quota", "set-space-quota", "unset-space-quota"},
+	},
+	"spaces": {
+		QueryParameters: []string{"names", "guids", "organization_guids", "include", "label_selector"},
+		Commands:        []string{"spaces", "space", "create-space", "rename-space", "delete-space"},
+	},
+	"stacks": {
+		QueryParameters: []string{"names", "default", "label_selector"},
+		Commands:        []string{"stacks", "stack"},
+	},
+	"tasks": {
+		QueryParameters: []string{"guids", "names", "states", "app_guids", "space_guids", "organization_guids", "label_selector"},
+		Commands:        []string{"tasks", "run-task", "terminate-task"},
+	},
+	"users": {
+		QueryParameters: []string{"guids", "usernames", "origins", "label_selector"},
+		Commands:        []string{"create-user", "delete-user"},
+	},
+}
+
+// GetAPIResources returns the resources that the Cloud Controller V3 API of
+// the targeted foundation links from its root, sorted by name, with what the
+// CLI knows about them.
+func (actor Actor) GetAPIResources() ([]APIResource, Warnings, error) {
+	links, warnings, err := actor.CloudControllerClient.GetResourceLinks()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var apiResources []APIResource
+	for name, link := range links {
+		if name == "self" {
+			continue
+		}
+
+		known := KnownAPIResources[name]
+		apiResources = append(apiResources, APIResource{
+			Name:            name,
+			Path:            apiResourcePath(link.HREF, actor.Config.Target()),
+			QueryParameters: known.QueryParameters,
+			Commands:        known.Commands,
+		})
+	}
+
+	sort.Slice(apiResources, func(i, j int) bool {
+		return apiResources[i].Name < apiResources[j].Name
+	})
+
+	return apiResources, Warnings(warnings), nil
+}
+
+// apiResourcePath returns the path of the link relative to the targeted API,
+// which is what 'cf curl' takes, or its URL path when the link is elsewhere.
+func apiResourcePath(href string, target string) string {
+	target = strings.TrimSuffix(target, "/")
+	if target != "" && strings.HasPrefix(href, target+"/") {
+		return strings.TrimPrefix(href, target)
+	}
+
+	linkURL, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return linkURL.Path
+}
@@ -0,0 +1,88 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Resource Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.example.com/")
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, nil)
+	})
+
+	Describe("GetAPIResources", func() {
+		var (
+			apiResources []APIResource
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			apiResources, warnings, executeErr = actor.GetAPIResources()
+		})
+
+		When("getting the resource links succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetResourceLinksReturns(
+					ccv3.ResourceLinks{
+						"self":      resources.APILink{HREF: "https://api.example.com/v3"},
+						"stacks":    resources.APILink{HREF: "https://api.example.com/v3/stacks"},
+						"apps":      resources.APILink{HREF: "https://api.example.com/v3/apps"},
+						"new_thing": resources.APILink{HREF: "https://other.example.com/prefix/v3/new_things"},
+					},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the resources by name with what the CLI knows about them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(apiResources).To(Equal([]APIResource{
+					{
+						Name:            "apps",
+						Path:            "/v3/apps",
+						QueryParameters: KnownAPIResources["apps"].QueryParameters,
+						Commands:        KnownAPIResources["apps"].Commands,
+					},
+					{
+						Name: "new_thing",
+						Path: "/prefix/v3/new_things",
+					},
+					{
+						Name:            "stacks",
+						Path:            "/v3/stacks",
+						QueryParameters: []string{"names", "default", "label_selector"},
+						Commands:        []string{"stacks", "stack"},
+					},
+				}))
+			})
+		})
+
+		When("getting the resource links fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetResourceLinksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package v7action
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+)
+
+// AppCrashEventType is the type of the audit event that the Cloud Controller
+// records when an app instance crashes.
+const AppCrashEventType = "audit.app.process.crash"
+
+// appUsageGaugeWindow is how far back the gauges of an app are read from Log
+// Cache; the containers report their metrics every few seconds.
+const appUsageGaugeWindow = 2 * time.Minute
+
+// AppUsage is the current resource usage of the instances of an app. The
+// usage is summed over the instances of all its processes, so CPU can exceed
+// 100 percent.
+type AppUsage struct {
+	Name             string
+	State            constant.ApplicationState
+	RunningInstances int
+	Instances        int
+	// CPU is the CPU usage in percent.
+	CPU         float64
+	MemoryUsage uint64
+	MemoryQuota uint64
+	DiskUsage   uint64
+	DiskQuota   uint64
+	// Crashes is the number of times that an instance crashed since the
+	// time given to GetAppUsagesForSpace.
+	Crashes int
+}
+
+// GetAppUsagesForSpace returns the usage of every app of the space, ordered
+// by name. The usage comes from the latest CPU, memory and disk gauges in Log
+// Cache, and from the process stats of the Cloud Controller for the apps
+// without recent gauges. Failing to read the gauges of an app is reported as
+// a warning.
+func (actor Actor) GetAppUsagesForSpace(spaceGUID string, client sharedaction.LogCacheClient, crashesSince time.Time) ([]AppUsage, Warnings, error) {
+	summaries, allWarnings, err := actor.GetAppSummariesForSpace(spaceGUID, "", false, false)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	crashEvents, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{AppCrashEventType}},
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{crashesSince.UTC().Format(time.RFC3339)}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	crashesByAppGUID := map[string]int{}
+	for _, event := range crashEvents {
+		crashesByAppGUID[event.TargetGUID]++
+	}
+
+	usages := make([]AppUsage, 0, len(summaries))
+	for _, summary := range summaries {
+		usage := AppUsage{
+			Name:    summary.Name,
+			State:   summary.State,
+			Crashes: crashesByAppGUID[summary.GUID],
+		}
+
+		for _, process := range summary.ProcessSummaries {
+			usage.Instances += process.TotalInstanceCount()
+			usage.RunningInstances += process.HealthyInstanceCount()
+			for _, instance := range process.InstanceDetails {
+				usage.CPU += instance.CPU * 100
+				usage.MemoryUsage += instance.MemoryUsage
+				usage.MemoryQuota += instance.MemoryQuota
+				usage.DiskUsage += instance.DiskUsage
+				usage.DiskQuota += instance.DiskQuota
+			}
+		}
+
+		if usage.RunningInstances > 0 {
+			gaugeErr := actor.applyAppUsageGauges(&usage, summary.GUID, client)
+			if gaugeErr != nil {
+				allWarnings = append(allWarnings, fmt.Sprintf("Unable to read the metrics of app %s from Log Cache: %s", summary.Name, gaugeErr))
+			}
+		}
+
+		usages = append(usages, usage)
+	}
+
+	return usages, allWarnings, nil
+}
+
+// applyAppUsageGauges replaces the CPU, memory and disk usage of the app with
+// the sum of the latest gauges of each of its instances in Log Cache. The
+// usage is left as it is when Log Cache has no recent gauges of the app.
+func (actor Actor) applyAppUsageGauges(usage *AppUsage, appGUID string, client sharedaction.LogCacheClient) error {
+	envelopes, err := client.Read(
+		context.Background(),
+		appGUID,
+		actor.Clock.Now().Add(-appUsageGaugeWindow),
+		logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_GAUGE),
+		logcache.WithDescending(),
+		logcache.WithLimit(1000),
+	)
+	if err != nil {
+		return err
+	}
+
+	// The envelopes are read newest first, so the first gauge of a metric of
+	// an instance is its latest value.
+	latest := map[string]map[string]float64{"cpu": {}, "memory": {}, "disk": {}}
+	found := false
+	for _, envelope := range envelopes {
+		metrics := envelope.GetGauge().GetMetrics()
+		instance := envelope.GetTags()["process_type"] + "/" + envelope.GetInstanceId()
+		for name, values := range latest {
+			value, ok := metrics[name]
+			if _, seen := values[instance]; !ok || seen {
+				continue
+			}
+			values[instance] = value.GetValue()
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	usage.CPU, usage.MemoryUsage, usage.DiskUsage = 0, 0, 0
+	for _, value := range latest["cpu"] {
+		usage.CPU += value
+	}
+	for _, value := range latest["memory"] {
+		usage.MemoryUsage += uint64(value)
+	}
+	for _, value := range latest["disk"] {
+		usage.DiskUsage += uint64(value)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, fakeClock = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetAppUsagesForSpace", func() {
+		var (
+			crashesSince time.Time
+			usages       []AppUsage
+			warnings     Warnings
+			executeErr   error
+		)
+
+		gauge := func(instanceID string, processType string, metrics map[string]float64) *loggregator_v2.Envelope {
+			values := map[string]*loggregator_v2.GaugeValue{}
+			for name, value := range metrics {
+				values[name] = &loggregator_v2.GaugeValue{Value: value}
+			}
+			return &loggregator_v2.Envelope{
+				InstanceId: instanceID,
+				Tags:       map[string]string{"process_type": processType},
+				Message:    &loggregator_v2.Envelope_Gauge{Gauge: &loggregator_v2.Gauge{Metrics: values}},
+			}
+		}
+
+		BeforeEach(func() {
+			crashesSince = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+				{GUID: "api-guid", Name: "api", State: constant.ApplicationStarted},
+				{GUID: "worker-guid", Name: "worker", State: constant.ApplicationStopped},
+			}, ccv3.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetProcessesReturnsOnCall(0, []resources.Process{{GUID: "api-web-guid", Type: "web", AppGUID: "api-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetProcessesReturnsOnCall(1, []resources.Process{{GUID: "worker-web-guid", Type: "web", AppGUID: "worker-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, []ccv3.ProcessInstance{
+				{Index: 0, State: constant.ProcessInstanceRunning, CPU: 0.1, MemoryUsage: 100, MemoryQuota: 1000, DiskUsage: 10, DiskQuota: 2000},
+				{Index: 1, State: constant.ProcessInstanceCrashed, MemoryQuota: 1000, DiskQuota: 2000},
+			}, nil, nil)
+			fakeCloudControllerClient.GetEventsReturns([]ccv3.Event{
+				{TargetGUID: "api-guid"},
+				{TargetGUID: "api-guid"},
+			}, ccv3.Warnings{"get-events-warning"}, nil)
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				gauge("0", "web", map[string]float64{"cpu": 12.5, "memory": 300, "disk": 30}),
+				gauge("0", "web", map[string]float64{"cpu": 99, "memory": 999, "disk": 99}),
+				gauge("1", "web", map[string]float64{"spike_start": 1}),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, executeErr = actor.GetAppUsagesForSpace("space-guid", fakeLogCacheClient, crashesSince)
+		})
+
+		It("returns the latest gauges and the crashes of each app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-apps-warning", "get-events-warning"))
+
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{AppCrashEventType}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{"2026-01-02T03:04:05Z"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("api-guid"))
+			Expect(start).To(Equal(fakeClock.Now().Add(-2 * time.Minute)))
+
+			Expect(usages).To(Equal([]AppUsage{
+				{
+					Name:             "api",
+					State:            constant.ApplicationStarted,
+					RunningInstances: 1,
+					Instances:        2,
+					CPU:              12.5,
+					MemoryUsage:      300,
+					MemoryQuota:      2000,
+					DiskUsage:        30,
+					DiskQuota:        4000,
+					Crashes:          2,
+				},
+				{Name: "worker", State: constant.ApplicationStopped},
+			}))
+		})
+
+		When("Log Cache has no gauges of an app", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, nil)
+			})
+
+			It("uses the process stats", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(usages[0].CPU).To(BeNumerically("~", 10))
+				Expect(usages[0].MemoryUsage).To(BeEquivalentTo(100))
+				Expect(usages[0].DiskUsage).To(BeEquivalentTo(10))
+			})
+		})
+
+		When("reading the gauges fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("log cache down"))
+			})
+
+			It("uses the process stats and returns a warning", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("Unable to read the metrics of app api from Log Cache: log cache down"))
+				Expect(usages[0].MemoryUsage).To(BeEquivalentTo(100))
+			})
+		})
+
+		When("getting the crash events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"get-events-warning"}, errors.New("events failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events failed"))
+				Expect(warnings).To(ContainElement("get-events-warning"))
+			})
+		})
+	})
+})
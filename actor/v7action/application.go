@@ -140,6 +140,22 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 	return apps[0], warnings, nil
 }
 
+// GetApplicationByGUID returns the application with the given guid.
+func (actor Actor) GetApplicationByGUID(appGUID string) (resources.Application, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{appGUID}},
+	)
+	if err != nil {
+		return resources.Application{}, Warnings(warnings), err
+	}
+
+	if len(apps) == 0 {
+		return resources.Application{}, Warnings(warnings), actionerror.ApplicationNotFoundError{GUID: appGUID}
+	}
+
+	return apps[0], Warnings(warnings), nil
+}
+
 // GetApplicationsBySpace returns all applications in a space.
 func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]resources.Application, Warnings, error) {
 	apps, warnings, err := actor.CloudControllerClient.GetApplications(
@@ -200,6 +216,18 @@ func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(
 	return app, append(getWarnings, setWarnings...), err
 }
 
+// SetApplicationProcessPortsByNameAndSpace sets the ports that the instances
+// of the process of the app listen on.
+func (actor Actor) SetApplicationProcessPortsByNameAndSpace(appName string, spaceGUID string, processType string, ports []int) (resources.Application, Warnings, error) {
+	app, getWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Application{}, getWarnings, err
+	}
+
+	setWarnings, err := actor.UpdateProcessByTypeAndApplication(processType, app.GUID, resources.Process{Ports: ports})
+	return app, append(getWarnings, setWarnings...), err
+}
+
 // StopApplication stops an application.
 func (actor Actor) StopApplication(appGUID string) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
@@ -299,12 +327,13 @@ func (actor Actor) PollStart(app resources.Application, noWait bool, handleInsta
 }
 
 // PollStartForRolling polls a deploying application's processes until some are started. It does the same thing as PollStart, except it accounts for rolling deployments and whether
-// they have failed or been canceled during polling.
+// they have failed or been canceled during polling. A canary deployment reports every step it reaches and stops the polling once it pauses.
 func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID string, noWait bool, handleInstanceDetails func(string)) (Warnings, error) {
 	var (
 		deployment  resources.Deployment
 		processes   []resources.Process
 		allWarnings Warnings
+		canaryStep  int
 	)
 
 	timer := actor.Clock.NewTimer(time.Millisecond)
@@ -328,6 +357,13 @@ func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID
 					return allWarnings, err
 				}
 				deployment = ccDeployment
+				if deployment.CanarySteps > 0 && deployment.CanaryStep != canaryStep {
+					canaryStep = deployment.CanaryStep
+					handleInstanceDetails(fmt.Sprintf("Canary step %d of %d", deployment.CanaryStep, deployment.CanarySteps))
+				}
+				if isPaused(deployment) {
+					return allWarnings, nil
+				}
 				processes, warnings, err = actor.getProcesses(deployment, app.GUID, noWait)
 				allWarnings = append(allWarnings, warnings...)
 				if err != nil {
@@ -352,6 +388,10 @@ func isDeployed(d resources.Deployment) bool {
 	return d.StatusValue == constant.DeploymentStatusValueFinalized && d.StatusReason == constant.DeploymentStatusReasonDeployed
 }
 
+func isPaused(d resources.Deployment) bool {
+	return d.StatusValue == constant.DeploymentStatusValueActive && d.StatusReason == constant.DeploymentStatusReasonPaused
+}
+
 // PollProcesses - return true if there's no need to keep polling
 func (actor Actor) PollProcesses(processes []resources.Process, handleInstanceDetails func(string)) (bool, Warnings, error) {
 	numProcesses := len(processes)
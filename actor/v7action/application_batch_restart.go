@@ -0,0 +1,124 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// RestartApplicationInstancesInBatches restarts the instances of each process
+// of the started app, batchSize instances at a time. The next batch is only
+// restarted once every instance of the previous batch is running again, so
+// that the app keeps serving without a deployment. handleBatch is called with
+// the indexes of each batch before it is restarted.
+func (actor Actor) RestartApplicationInstancesInBatches(app resources.Application, batchSize int, handleBatch func(processType string, indexes []int64)) (Warnings, error) {
+	var allWarnings Warnings
+	processes, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, process := range processes {
+		instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		for start := 0; start < len(instances); start += batchSize {
+			end := start + batchSize
+			if end > len(instances) {
+				end = len(instances)
+			}
+
+			var indexes []int64
+			for _, instance := range instances[start:end] {
+				indexes = append(indexes, instance.Index)
+			}
+			handleBatch(process.Type, indexes)
+
+			warnings, err := actor.restartInstanceBatch(app, process, indexes)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// restartInstanceBatch restarts the instances with the indexes and waits
+// until all of them are running again.
+func (actor Actor) restartInstanceBatch(app resources.Application, process resources.Process, indexes []int64) (Warnings, error) {
+	var allWarnings Warnings
+	restartedAt := actor.Clock.Now()
+
+	for _, index := range indexes {
+		ccWarnings, err := actor.CloudControllerClient.DeleteApplicationProcessInstance(app.GUID, process.Type, int(index))
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	timer := actor.Clock.NewTimer(actor.Config.PollingInterval())
+	defer timer.Stop()
+	timeout := actor.Clock.After(actor.Config.StartupTimeout())
+
+	for {
+		select {
+		case <-timeout:
+			return allWarnings, actionerror.StartupTimeoutError{Name: app.Name}
+		case <-timer.C():
+			instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			restarted, err := instancesRestarted(app, process, instances, indexes, actor.Clock.Since(restartedAt))
+			if err != nil || restarted {
+				return allWarnings, err
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+// instancesRestarted returns true when every instance with one of the indexes
+// is running and has been up for no longer than the time since it was
+// restarted, and an error when one of them has crashed.
+func instancesRestarted(app resources.Application, process resources.Process, instances []ccv3.ProcessInstance, indexes []int64, sinceRestart time.Duration) (bool, error) {
+	restarted := true
+	for _, index := range indexes {
+		var found bool
+		for _, instance := range instances {
+			if instance.Index != index {
+				continue
+			}
+			found = true
+
+			if instance.State == constant.ProcessInstanceCrashed {
+				return false, actionerror.ProcessInstanceCrashedError{
+					AppName:       app.Name,
+					ProcessType:   process.Type,
+					InstanceIndex: index,
+				}
+			}
+			if instance.State != constant.ProcessInstanceRunning || instance.Uptime > sinceRestart {
+				restarted = false
+			}
+		}
+
+		if !found {
+			restarted = false
+		}
+	}
+	return restarted, nil
+}
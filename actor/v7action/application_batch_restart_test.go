@@ -0,0 +1,171 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Batch Restart Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+	})
+
+	Describe("RestartApplicationInstancesInBatches", func() {
+		var (
+			app     resources.Application
+			batches [][]int64
+
+			done       chan bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		instance := func(index int64, state constant.ProcessInstanceState, uptime time.Duration) ccv3.ProcessInstance {
+			return ccv3.ProcessInstance{Index: index, State: state, Uptime: uptime}
+		}
+
+		BeforeEach(func() {
+			app = resources.Application{GUID: "some-app-guid", Name: "some-app"}
+			batches = nil
+			fakeConfig.PollingIntervalReturns(time.Second)
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{{GUID: "web-guid", Type: "web"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, []ccv3.ProcessInstance{
+				instance(0, constant.ProcessInstanceRunning, time.Hour),
+				instance(1, constant.ProcessInstanceRunning, time.Hour),
+				instance(2, constant.ProcessInstanceRunning, time.Hour),
+			}, ccv3.Warnings{"get-instances-warning"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.ProcessInstance{
+				instance(0, constant.ProcessInstanceRunning, 0),
+				instance(1, constant.ProcessInstanceRunning, 0),
+				instance(2, constant.ProcessInstanceRunning, time.Hour),
+			}, nil, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2, []ccv3.ProcessInstance{
+				instance(0, constant.ProcessInstanceRunning, time.Second),
+				instance(1, constant.ProcessInstanceRunning, time.Second),
+				instance(2, constant.ProcessInstanceRunning, 0),
+			}, nil, nil)
+			fakeCloudControllerClient.DeleteApplicationProcessInstanceReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan bool)
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.RestartApplicationInstancesInBatches(app, 2, func(processType string, indexes []int64) {
+					batches = append(batches, indexes)
+				})
+				done <- true
+			}()
+		})
+
+		It("restarts the next batch once the previous one is running again", func() {
+			fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+			Eventually(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount).Should(Equal(3))
+			fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+			Eventually(done).Should(Receive(BeTrue()))
+
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-processes-warning", "get-instances-warning", "delete-warning"))
+			Expect(batches).To(Equal([][]int64{{0, 1}, {2}}))
+
+			appGUID, processType, index := fakeCloudControllerClient.DeleteApplicationProcessInstanceArgsForCall(2)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(index).To(Equal(2))
+		})
+
+		When("an instance of the batch has not been restarted yet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.ProcessInstance{
+					instance(0, constant.ProcessInstanceRunning, 0),
+					instance(1, constant.ProcessInstanceRunning, time.Hour),
+					instance(2, constant.ProcessInstanceRunning, time.Hour),
+				}, nil, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2, []ccv3.ProcessInstance{
+					instance(0, constant.ProcessInstanceRunning, time.Second),
+					instance(1, constant.ProcessInstanceStarting, 0),
+					instance(2, constant.ProcessInstanceRunning, time.Hour),
+				}, nil, nil)
+			})
+
+			It("keeps waiting for it", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+				Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(2))
+				fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+				Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(3))
+				Consistently(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount).Should(Equal(2))
+			})
+		})
+
+		When("an instance of the batch crashes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.ProcessInstance{
+					instance(0, constant.ProcessInstanceRunning, 0),
+					instance(1, constant.ProcessInstanceCrashed, 0),
+					instance(2, constant.ProcessInstanceRunning, time.Hour),
+				}, nil, nil)
+			})
+
+			It("stops restarting instances", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceCrashedError{
+					AppName:       "some-app",
+					ProcessType:   "web",
+					InstanceIndex: 1,
+				}))
+				Expect(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the batch does not start in time", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{Name: "some-app"}))
+			})
+		})
+
+		When("restarting an instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationProcessInstanceReturns(ccv3.Warnings{"delete-warning"}, errors.New("delete failed"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("delete failed"))
+				Expect(warnings).To(ContainElement("delete-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// ApplicationHealthCriteria describes when an app that was just started is
+// considered healthy.
+type ApplicationHealthCriteria struct {
+	// MinHealthyPercent is the share of the instances of each process that
+	// must be running. Zero skips the check.
+	MinHealthyPercent int
+	// StabilityWindow is how long the instances are watched for crashes.
+	StabilityWindow time.Duration
+}
+
+// IsSet returns true when any criteria have been given.
+func (criteria ApplicationHealthCriteria) IsSet() bool {
+	return criteria.MinHealthyPercent > 0 || criteria.StabilityWindow > 0
+}
+
+type processInstanceKey struct {
+	processType string
+	index       int64
+}
+
+// VerifyApplicationHealth watches the instances of the app's processes for
+// the stability window, failing as soon as one of them crashes or restarts,
+// and then checks that enough instances of each process are running.
+func (actor Actor) VerifyApplicationHealth(app resources.Application, criteria ApplicationHealthCriteria) (Warnings, error) {
+	var allWarnings Warnings
+	processes, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	uptimes := map[processInstanceKey]time.Duration{}
+	instancesByProcess, warnings, err := actor.pollApplicationHealth(app, processes, uptimes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if criteria.StabilityWindow > 0 {
+		timer := actor.Clock.NewTimer(actor.Config.PollingInterval())
+		defer timer.Stop()
+		windowEnd := actor.Clock.After(criteria.StabilityWindow)
+
+	polling:
+		for {
+			select {
+			case <-windowEnd:
+				instancesByProcess, warnings, err = actor.pollApplicationHealth(app, processes, uptimes)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+				break polling
+			case <-timer.C():
+				instancesByProcess, warnings, err = actor.pollApplicationHealth(app, processes, uptimes)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+				timer.Reset(actor.Config.PollingInterval())
+			}
+		}
+	}
+
+	if criteria.MinHealthyPercent == 0 {
+		return allWarnings, nil
+	}
+
+	for _, process := range processes {
+		instances := instancesByProcess[process.Type]
+		if len(instances) == 0 {
+			continue
+		}
+
+		running := 0
+		for _, instance := range instances {
+			if instance.State == constant.ProcessInstanceRunning {
+				running++
+			}
+		}
+
+		if running*100 < criteria.MinHealthyPercent*len(instances) {
+			return allWarnings, actionerror.InsufficientHealthyInstancesError{
+				AppName:           app.Name,
+				ProcessType:       process.Type,
+				Running:           running,
+				Total:             len(instances),
+				MinHealthyPercent: criteria.MinHealthyPercent,
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// pollApplicationHealth gets the instances of each process, returning an
+// error when an instance has crashed or its uptime went down since the last
+// poll, which means it was restarted after crashing.
+func (actor Actor) pollApplicationHealth(app resources.Application, processes []resources.Process, uptimes map[processInstanceKey]time.Duration) (map[string]ProcessInstances, Warnings, error) {
+	var allWarnings Warnings
+	instancesByProcess := map[string]ProcessInstances{}
+
+	for _, process := range processes {
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, instance := range instances {
+			key := processInstanceKey{processType: process.Type, index: instance.Index}
+			lastUptime, seen := uptimes[key]
+			if instance.State == constant.ProcessInstanceCrashed || (seen && instance.Uptime < lastUptime) {
+				return nil, allWarnings, actionerror.ProcessInstanceCrashedError{
+					AppName:       app.Name,
+					ProcessType:   process.Type,
+					InstanceIndex: instance.Index,
+				}
+			}
+			uptimes[key] = instance.Uptime
+		}
+
+		instancesByProcess[process.Type] = instances
+	}
+
+	return instancesByProcess, allWarnings, nil
+}
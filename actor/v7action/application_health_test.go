@@ -0,0 +1,234 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Health Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+	})
+
+	Describe("ApplicationHealthCriteria", func() {
+		It("is set when either criterion is given", func() {
+			Expect(ApplicationHealthCriteria{}.IsSet()).To(BeFalse())
+			Expect(ApplicationHealthCriteria{MinHealthyPercent: 50}.IsSet()).To(BeTrue())
+			Expect(ApplicationHealthCriteria{StabilityWindow: time.Minute}.IsSet()).To(BeTrue())
+		})
+	})
+
+	Describe("VerifyApplicationHealth", func() {
+		var (
+			app      resources.Application
+			criteria ApplicationHealthCriteria
+
+			done       chan bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		running := func(index int64, uptime time.Duration) ccv3.ProcessInstance {
+			return ccv3.ProcessInstance{Index: index, State: constant.ProcessInstanceRunning, Uptime: uptime}
+		}
+
+		BeforeEach(func() {
+			app = resources.Application{GUID: "some-app-guid", Name: "some-app"}
+			criteria = ApplicationHealthCriteria{}
+			fakeConfig.PollingIntervalReturns(10 * time.Second)
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{{GUID: "web-guid", Type: "web"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{running(0, time.Minute), running(1, time.Minute)},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan bool)
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.VerifyApplicationHealth(app, criteria)
+				done <- true
+			}()
+		})
+
+		When("there is no stability window", func() {
+			BeforeEach(func() {
+				criteria.MinHealthyPercent = 75
+			})
+
+			When("enough instances are running", func() {
+				It("checks the instances once and succeeds", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("web-guid"))
+				})
+			})
+
+			When("too few instances are running", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{
+							running(0, time.Minute),
+							running(1, time.Minute),
+							{Index: 2, State: constant.ProcessInstanceStarting},
+							{Index: 3, State: constant.ProcessInstanceDown},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns an InsufficientHealthyInstancesError", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.InsufficientHealthyInstancesError{
+						AppName:           "some-app",
+						ProcessType:       "web",
+						Running:           2,
+						Total:             4,
+						MinHealthyPercent: 75,
+					}))
+				})
+			})
+
+			When("an instance has crashed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{running(0, time.Minute), {Index: 1, State: constant.ProcessInstanceCrashed}},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ProcessInstanceCrashedError", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceCrashedError{
+						AppName:       "some-app",
+						ProcessType:   "web",
+						InstanceIndex: 1,
+					}))
+				})
+			})
+		})
+
+		When("there is a stability window", func() {
+			BeforeEach(func() {
+				criteria.StabilityWindow = time.Minute
+				fakeConfig.PollingIntervalReturns(time.Second)
+			})
+
+			When("the instances stay up for the whole window", func() {
+				BeforeEach(func() {
+					fakeConfig.PollingIntervalReturns(2 * time.Minute)
+				})
+
+				It("checks the instances again at the end of the window and succeeds", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+				})
+			})
+
+			When("an instance crashes during the window", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.ProcessInstance{running(0, time.Minute), {Index: 1, State: constant.ProcessInstanceCrashed}},
+						ccv3.Warnings{"crashed-warning"},
+						nil,
+					)
+				})
+
+				It("returns a ProcessInstanceCrashedError before the window ends", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceCrashedError{
+						AppName:       "some-app",
+						ProcessType:   "web",
+						InstanceIndex: 1,
+					}))
+					Expect(warnings).To(ContainElement("crashed-warning"))
+				})
+			})
+
+			When("an instance restarts during the window", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.ProcessInstance{running(0, time.Minute), running(1, time.Second)},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ProcessInstanceCrashedError", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceCrashedError{
+						AppName:       "some-app",
+						ProcessType:   "web",
+						InstanceIndex: 1,
+					}))
+				})
+			})
+		})
+
+		When("getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, errors.New("get-processes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("get-processes-error"))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("get-instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("get-instances-error"))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+})
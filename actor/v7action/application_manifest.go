@@ -3,6 +3,7 @@ package v7action
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"gopkg.in/yaml.v2"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
@@ -68,3 +69,41 @@ func (actor Actor) GetRawApplicationManifestByNameAndSpace(appName string, space
 	rawManifest, manifestWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
 	return rawManifest, append(warnings, manifestWarnings...), err
 }
+
+// GetRawApplicationsManifestByNamesAndSpace returns a manifest of the apps in
+// the space that have the given names, as the Cloud Controller generates them.
+// Apps that do not exist are left out, and no manifest is returned when none
+// of them exist.
+func (actor Actor) GetRawApplicationsManifestByNamesAndSpace(appNames []string, spaceGUID string) ([]byte, Warnings, error) {
+	var (
+		allWarnings  Warnings
+		applications []interface{}
+	)
+
+	for _, appName := range appNames {
+		rawManifest, warnings, err := actor.GetRawApplicationManifestByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+				continue
+			}
+			return nil, allWarnings, err
+		}
+
+		var manifest struct {
+			Applications []interface{} `yaml:"applications"`
+		}
+		err = yaml.Unmarshal(rawManifest, &manifest)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		applications = append(applications, manifest.Applications...)
+	}
+
+	if len(applications) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	rawManifest, err := yaml.Marshal(map[string]interface{}{"applications": applications})
+	return rawManifest, allWarnings, err
+}
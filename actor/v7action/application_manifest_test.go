@@ -307,4 +307,62 @@ var _ = Describe("Application Manifest Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRawApplicationsManifestByNamesAndSpace", func() {
+		var (
+			appNames []string
+
+			manifestBytes []byte
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			appNames = []string{"app-1", "missing-app", "app-2"}
+
+			fakeCloudControllerClient.GetApplicationsStub = func(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				name := query[0].Values[0]
+				if name == "missing-app" {
+					return nil, ccv3.Warnings{"get-" + name + "-warning"}, nil
+				}
+				return []resources.Application{{Name: name, GUID: name + "-guid"}}, ccv3.Warnings{"get-" + name + "-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationManifestStub = func(appGUID string) ([]byte, ccv3.Warnings, error) {
+				return []byte("applications:\n- name: " + appGUID + "\n"), ccv3.Warnings{"manifest-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			manifestBytes, warnings, executeErr = actor.GetRawApplicationsManifestByNamesAndSpace(appNames, "some-space-guid")
+		})
+
+		It("combines the manifests of the apps that exist", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-1-warning", "manifest-warning", "get-missing-app-warning", "get-app-2-warning", "manifest-warning"))
+			Expect(string(manifestBytes)).To(Equal("applications:\n- name: app-1-guid\n- name: app-2-guid\n"))
+		})
+
+		When("none of the apps exist", func() {
+			BeforeEach(func() {
+				appNames = []string{"missing-app"}
+			})
+
+			It("returns no manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(manifestBytes).To(BeNil())
+			})
+		})
+
+		When("getting a manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+				fakeCloudControllerClient.GetApplicationManifestStub = nil
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ConsistOf("get-app-1-warning", "manifest-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,203 @@
+package v7action
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HTTPClient
+
+// HTTPClient sends the requests that check the endpoint of an app.
+type HTTPClient interface {
+	Do(request *http.Request) (*http.Response, error)
+}
+
+// ApplicationSLO is what an app must keep to while it is verified.
+type ApplicationSLO struct {
+	// Duration is how long the app is watched for.
+	Duration time.Duration
+	// MaxRestarts is how many times the instances may restart in total.
+	MaxRestarts int
+	// URL is requested on every poll. A path is requested on the first route
+	// of the app. Empty skips the requests.
+	URL string
+	// ExpectedStatus is the status code that every request must return.
+	ExpectedStatus int
+}
+
+// InstanceRestart is a restart of an app instance seen while verifying the
+// app.
+type InstanceRestart struct {
+	ProcessType   string
+	InstanceIndex int64
+	Time          time.Time
+	// Crashed is true when the instance was seen crashed, rather than only
+	// running again with a lower uptime.
+	Crashed bool
+}
+
+// EndpointCheckFailure is a request to the endpoint of an app that did not
+// return the expected status code. StatusCode is zero when Err is set.
+type EndpointCheckFailure struct {
+	Time       time.Time
+	StatusCode int
+	Err        error
+}
+
+// ApplicationSLOReport is what VerifyApplicationSLO saw of an app.
+type ApplicationSLOReport struct {
+	// URL is the URL the requests are sent to, empty without requests.
+	URL          string
+	Polls        int
+	Restarts     []InstanceRestart
+	FailedChecks []EndpointCheckFailure
+	// Violated is true when the app restarted too often or a request failed.
+	Violated bool
+}
+
+type instanceObservation struct {
+	state  constant.ProcessInstanceState
+	uptime time.Duration
+}
+
+// VerifyApplicationSLO watches the app for the duration of the SLO, polling
+// the instances of its processes and requesting its endpoint with the client
+// every polling interval. It stops early as soon as the SLO is violated, and
+// returns what it saw either way; the error is only for failing to poll.
+func (actor Actor) VerifyApplicationSLO(app resources.Application, slo ApplicationSLO, client HTTPClient) (ApplicationSLOReport, Warnings, error) {
+	var (
+		report      ApplicationSLOReport
+		allWarnings Warnings
+	)
+
+	processes, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return report, allWarnings, err
+	}
+
+	if slo.URL != "" {
+		url, warnings, err := actor.applicationEndpointURL(app, slo.URL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return report, allWarnings, err
+		}
+		report.URL = url
+	}
+
+	observations := map[processInstanceKey]instanceObservation{}
+	poll := func() error {
+		warnings, err := actor.pollApplicationSLO(processes, observations, slo, client, &report)
+		allWarnings = append(allWarnings, warnings...)
+		return err
+	}
+
+	err = poll()
+	if err != nil || report.Violated {
+		return report, allWarnings, err
+	}
+
+	timer := actor.Clock.NewTimer(actor.Config.PollingInterval())
+	defer timer.Stop()
+	windowEnd := actor.Clock.After(slo.Duration)
+
+	for {
+		select {
+		case <-windowEnd:
+			err = poll()
+			return report, allWarnings, err
+		case <-timer.C():
+			err = poll()
+			if err != nil || report.Violated {
+				return report, allWarnings, err
+			}
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+// applicationEndpointURL returns the URL, or the HTTPS URL of the path on the
+// first route of the app.
+func (actor Actor) applicationEndpointURL(app resources.Application, url string) (string, Warnings, error) {
+	if strings.Contains(url, "://") {
+		return url, nil, nil
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetApplicationRoutes(app.GUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+	if len(routes) == 0 {
+		return "", Warnings(warnings), actionerror.ApplicationHasNoRoutesError{AppName: app.Name}
+	}
+
+	return "https://" + strings.TrimSuffix(routes[0].URL, "/") + "/" + strings.TrimPrefix(url, "/"), Warnings(warnings), nil
+}
+
+// pollApplicationSLO records the restarts of the instances since the last
+// poll and the result of requesting the endpoint in the report. An instance
+// restarted when it is seen crashed or with a lower uptime than before.
+func (actor Actor) pollApplicationSLO(processes []resources.Process, observations map[processInstanceKey]instanceObservation, slo ApplicationSLO, client HTTPClient, report *ApplicationSLOReport) (Warnings, error) {
+	var allWarnings Warnings
+	now := actor.Clock.Now()
+
+	for _, process := range processes {
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		for _, instance := range instances {
+			key := processInstanceKey{processType: process.Type, index: instance.Index}
+			last, seen := observations[key]
+			crashed := instance.State == constant.ProcessInstanceCrashed
+			wasCrashed := seen && last.state == constant.ProcessInstanceCrashed
+
+			if (crashed && !wasCrashed) || (!crashed && !wasCrashed && seen && instance.Uptime < last.uptime) {
+				report.Restarts = append(report.Restarts, InstanceRestart{
+					ProcessType:   process.Type,
+					InstanceIndex: instance.Index,
+					Time:          now,
+					Crashed:       crashed,
+				})
+			}
+			observations[key] = instanceObservation{state: instance.State, uptime: instance.Uptime}
+		}
+	}
+
+	if report.URL != "" {
+		failure := checkEndpoint(client, report.URL, slo.ExpectedStatus)
+		if failure != nil {
+			failure.Time = now
+			report.FailedChecks = append(report.FailedChecks, *failure)
+		}
+	}
+
+	report.Polls++
+	report.Violated = len(report.Restarts) > slo.MaxRestarts || len(report.FailedChecks) > 0
+	return allWarnings, nil
+}
+
+func checkEndpoint(client HTTPClient, url string, expectedStatus int) *EndpointCheckFailure {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return &EndpointCheckFailure{Err: err}
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return &EndpointCheckFailure{Err: err}
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != expectedStatus {
+		return &EndpointCheckFailure{StatusCode: response.StatusCode}
+	}
+	return nil
+}
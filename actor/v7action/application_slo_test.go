@@ -0,0 +1,246 @@
+package v7action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Application SLO Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+		fakeHTTPClient            *v7actionfakes.FakeHTTPClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+		fakeHTTPClient = new(v7actionfakes.FakeHTTPClient)
+	})
+
+	Describe("VerifyApplicationSLO", func() {
+		var (
+			app resources.Application
+			slo ApplicationSLO
+
+			done       chan bool
+			report     ApplicationSLOReport
+			warnings   Warnings
+			executeErr error
+		)
+
+		running := func(index int64, uptime time.Duration) ccv3.ProcessInstance {
+			return ccv3.ProcessInstance{Index: index, State: constant.ProcessInstanceRunning, Uptime: uptime}
+		}
+
+		respond := func(statusCode int) (*http.Response, error) {
+			return &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		}
+
+		BeforeEach(func() {
+			app = resources.Application{GUID: "some-app-guid", Name: "some-app"}
+			slo = ApplicationSLO{Duration: time.Minute, ExpectedStatus: http.StatusOK}
+			fakeConfig.PollingIntervalReturns(2 * time.Minute)
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{{GUID: "web-guid", Type: "web"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{running(0, time.Minute), running(1, time.Minute)},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationRoutesReturns(
+				[]resources.Route{{URL: "some-app.example.com"}},
+				ccv3.Warnings{"get-routes-warning"},
+				nil,
+			)
+			fakeHTTPClient.DoReturns(respond(http.StatusOK))
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan bool)
+			go func() {
+				defer close(done)
+				report, warnings, executeErr = actor.VerifyApplicationSLO(app, slo, fakeHTTPClient)
+				done <- true
+			}()
+		})
+
+		When("the instances stay up for the whole duration", func() {
+			It("polls the instances at the start and end of the duration and reports no violation", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning", "get-instances-warning"))
+				Expect(report).To(Equal(ApplicationSLOReport{Polls: 2}))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("web-guid"))
+				Expect(fakeHTTPClient.DoCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an instance restarts", func() {
+			BeforeEach(func() {
+				fakeConfig.PollingIntervalReturns(time.Second)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.ProcessInstance{running(0, time.Minute), {Index: 1, State: constant.ProcessInstanceCrashed}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2,
+					[]ccv3.ProcessInstance{running(0, time.Minute), running(1, time.Second)},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(3,
+					[]ccv3.ProcessInstance{running(0, time.Second), running(1, 2*time.Second)},
+					nil,
+					nil,
+				)
+			})
+
+			When("the restarts are within the maximum", func() {
+				BeforeEach(func() {
+					slo.MaxRestarts = 2
+				})
+
+				It("records each restart once and reports no violation", func() {
+					for i := 0; i < 3; i++ {
+						fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+						Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(i + 2))
+					}
+					fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(report.Violated).To(BeFalse())
+					Expect(report.Restarts).To(HaveLen(2))
+					Expect(report.Restarts[0]).To(MatchFields(IgnoreExtras, Fields{"ProcessType": Equal("web"), "InstanceIndex": BeEquivalentTo(1), "Crashed": BeTrue()}))
+					Expect(report.Restarts[1]).To(MatchFields(IgnoreExtras, Fields{"ProcessType": Equal("web"), "InstanceIndex": BeEquivalentTo(0), "Crashed": BeFalse()}))
+				})
+			})
+
+			When("the restarts exceed the maximum", func() {
+				It("stops at the first restart and reports a violation", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(report.Violated).To(BeTrue())
+					Expect(report.Polls).To(Equal(2))
+					Expect(report.Restarts).To(HaveLen(1))
+				})
+			})
+		})
+
+		When("a path is given", func() {
+			BeforeEach(func() {
+				slo.URL = "/healthz"
+			})
+
+			It("requests it on the first route of the app", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-routes-warning"))
+				Expect(report.URL).To(Equal("https://some-app.example.com/healthz"))
+				Expect(report.Violated).To(BeFalse())
+
+				Expect(fakeCloudControllerClient.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeHTTPClient.DoCallCount()).To(Equal(2))
+				request := fakeHTTPClient.DoArgsForCall(0)
+				Expect(request.Method).To(Equal(http.MethodGet))
+				Expect(request.URL.String()).To(Equal("https://some-app.example.com/healthz"))
+			})
+
+			When("the endpoint returns another status", func() {
+				BeforeEach(func() {
+					fakeHTTPClient.DoReturns(respond(http.StatusServiceUnavailable))
+				})
+
+				It("stops at once and reports the failed request", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(report.Violated).To(BeTrue())
+					Expect(report.FailedChecks).To(HaveLen(1))
+					Expect(report.FailedChecks[0].StatusCode).To(Equal(http.StatusServiceUnavailable))
+				})
+			})
+
+			When("the request fails", func() {
+				BeforeEach(func() {
+					fakeHTTPClient.DoReturns(nil, errors.New("connection refused"))
+				})
+
+				It("reports the error of the request", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(report.Violated).To(BeTrue())
+					Expect(report.FailedChecks[0].Err).To(MatchError("connection refused"))
+				})
+			})
+
+			When("the app has no routes", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, nil)
+				})
+
+				It("returns an ApplicationHasNoRoutesError", func() {
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.ApplicationHasNoRoutesError{AppName: "some-app"}))
+					Expect(warnings).To(ContainElement("get-routes-warning"))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a URL is given", func() {
+			BeforeEach(func() {
+				slo.URL = "http://other.example.com/status"
+			})
+
+			It("requests the URL as it is", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(report.URL).To(Equal("http://other.example.com/status"))
+				Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("instances failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("instances failed"))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/batcher"
 )
@@ -12,6 +13,9 @@ type ApplicationSummary struct {
 	resources.Application
 	ProcessSummaries ProcessSummaries
 	Routes           []resources.Route
+	// LastUploaded is when the newest staged droplet of the app was created.
+	// It is only set by GetAppSummariesForSpace when asked for.
+	LastUploaded string
 }
 
 // v7action.DetailedApplicationSummary represents an application with its processes and droplet.
@@ -33,7 +37,11 @@ func (a ApplicationSummary) hasIsolationSegment() bool {
 		len(a.ProcessSummaries[0].InstanceDetails[0].IsolationSegment) > 0
 }
 
-func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector string, omitStats bool) ([]ApplicationSummary, Warnings, error) {
+// GetAppSummariesForSpace returns the apps of the space matching the label
+// selector with their routes, the summaries of their processes unless
+// omitStats is set, and when their bits were last uploaded when
+// withLastUploaded is set.
+func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector string, omitStats bool, withLastUploaded bool) ([]ApplicationSummary, Warnings, error) {
 	var allWarnings Warnings
 	var allSummaries []ApplicationSummary
 
@@ -84,6 +92,29 @@ func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector strin
 		}
 	}
 
+	lastUploadedByAppGUID := make(map[string]string)
+
+	if withLastUploaded {
+		ccv3Warnings, err = batcher.RequestByGUID(toAppGUIDs(apps), func(guids []string) (ccv3.Warnings, error) {
+			droplets, warnings, err := actor.CloudControllerClient.GetDroplets(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: guids},
+				ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.DropletStaged)}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			for _, droplet := range droplets {
+				if _, ok := lastUploadedByAppGUID[droplet.AppGUID]; !ok {
+					lastUploadedByAppGUID[droplet.AppGUID] = droplet.CreatedAt
+				}
+			}
+			return warnings, err
+		})
+		allWarnings = append(allWarnings, ccv3Warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
 	for _, app := range apps {
 		processSummariesByAppGUID[app.GUID].Sort()
 
@@ -91,6 +122,7 @@ func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector strin
 			Application:      app,
 			ProcessSummaries: processSummariesByAppGUID[app.GUID],
 			Routes:           routesByAppGUID[app.GUID],
+			LastUploaded:     lastUploadedByAppGUID[app.GUID],
 		}
 
 		allSummaries = append(allSummaries, summary)
@@ -100,14 +132,20 @@ func (actor Actor) GetAppSummariesForSpace(spaceGUID string, labelSelector strin
 }
 
 func (actor Actor) GetDetailedAppSummary(appName, spaceGUID string, withObfuscatedValues bool) (DetailedApplicationSummary, Warnings, error) {
-	var allWarnings Warnings
-
 	app, actorWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	allWarnings = append(allWarnings, actorWarnings...)
 	if err != nil {
 		return DetailedApplicationSummary{}, actorWarnings, err
 	}
 
+	summary, warnings, err := actor.GetDetailedAppSummaryForApp(app, withObfuscatedValues)
+	return summary, append(actorWarnings, warnings...), err
+}
+
+// GetDetailedAppSummaryForApp returns the detailed summary of an app that has
+// already been fetched.
+func (actor Actor) GetDetailedAppSummaryForApp(app resources.Application, withObfuscatedValues bool) (DetailedApplicationSummary, Warnings, error) {
+	var allWarnings Warnings
+
 	summary, warnings, err := actor.createSummary(app, withObfuscatedValues)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
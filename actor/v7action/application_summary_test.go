@@ -70,9 +70,10 @@ var _ = Describe("Application Summary Actions", func() {
 
 	Describe("GetAppSummariesForSpace", func() {
 		var (
-			spaceGUID     string
-			labelSelector string
-			omitStats     bool
+			spaceGUID        string
+			labelSelector    string
+			omitStats        bool
+			withLastUploaded bool
 
 			summaries  []ApplicationSummary
 			warnings   Warnings
@@ -83,10 +84,11 @@ var _ = Describe("Application Summary Actions", func() {
 			spaceGUID = "some-space-guid"
 			labelSelector = "some-key=some-value"
 			omitStats = false
+			withLastUploaded = false
 		})
 
 		JustBeforeEach(func() {
-			summaries, warnings, executeErr = actor.GetAppSummariesForSpace(spaceGUID, labelSelector, omitStats)
+			summaries, warnings, executeErr = actor.GetAppSummariesForSpace(spaceGUID, labelSelector, omitStats, withLastUploaded)
 		})
 
 		When("getting the application is successful", func() {
@@ -403,6 +405,55 @@ var _ = Describe("Application Summary Actions", func() {
 			})
 		})
 
+		When("the last upload is asked for", func() {
+			BeforeEach(func() {
+				omitStats = true
+				withLastUploaded = true
+
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{
+						{Name: "app-1", GUID: "app-1-guid"},
+						{Name: "app-2", GUID: "app-2-guid"},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetDropletsReturns(
+					[]resources.Droplet{
+						{AppGUID: "app-1-guid", CreatedAt: "2026-10-02T00:00:00Z"},
+						{AppGUID: "app-1-guid", CreatedAt: "2026-10-01T00:00:00Z"},
+					},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+			})
+
+			It("sets when the newest staged droplet of each app was created", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-droplets-warning"))
+
+				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"app-1-guid", "app-2-guid"}},
+					ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"STAGED"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+				Expect(summaries[0].LastUploaded).To(Equal("2026-10-02T00:00:00Z"))
+				Expect(summaries[1].LastUploaded).To(BeEmpty())
+			})
+
+			When("getting the droplets fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, errors.New("droplets-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("droplets-error"))
+					Expect(warnings).To(ContainElement("get-droplets-warning"))
+				})
+			})
+		})
+
 		When("an application is deleted in between", func() {
 
 			BeforeEach(func() {
@@ -454,6 +505,28 @@ var _ = Describe("Application Summary Actions", func() {
 		})
 	})
 
+	Describe("GetDetailedAppSummaryForApp", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-app-processes-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				resources.Droplet{Stack: "some-stack"},
+				ccv3.Warnings{"get-app-droplet-warning"},
+				nil,
+			)
+		})
+
+		It("summarizes the app without looking it up again", func() {
+			summary, warnings, err := actor.GetDetailedAppSummaryForApp(resources.Application{Name: "some-app-name", GUID: "some-app-guid"}, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-processes-warning", "get-app-droplet-warning"))
+			Expect(summary.Application.Name).To(Equal("some-app-name"))
+			Expect(summary.CurrentDroplet.Stack).To(Equal("some-stack"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
 	Describe("GetDetailedAppSummary", func() {
 		var (
 			appName              string
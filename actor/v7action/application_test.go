@@ -547,6 +547,54 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationByGUID", func() {
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app-name", GUID: "some-app-guid", SpaceGUID: "some-space-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the application and warnings", func() {
+				app, warnings, err := actor.GetApplicationByGUID("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(resources.Application{Name: "some-app-name", GUID: "some-app-guid", SpaceGUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetApplicationByGUID("some-app-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError("get-apps-error"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationByGUID("some-app-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{GUID: "some-app-guid"}))
+			})
+		})
+	})
+
 	Describe("GetApplicationsBySpace", func() {
 		When("the there are applications in the space", func() {
 			BeforeEach(func() {
@@ -1521,6 +1569,50 @@ var _ = Describe("Application Actions", func() {
 
 			})
 
+			When("the deployment is a canary", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+						resources.Deployment{StatusValue: constant.DeploymentStatusValueActive, CanaryStep: 1, CanarySteps: 2},
+						ccv3.Warnings{"get-deployment-warning-1"},
+						nil,
+					)
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+						resources.Deployment{StatusValue: constant.DeploymentStatusValueActive, CanaryStep: 1, CanarySteps: 2},
+						ccv3.Warnings{"get-deployment-warning-2"},
+						nil,
+					)
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(2,
+						resources.Deployment{
+							StatusValue:  constant.DeploymentStatusValueActive,
+							StatusReason: constant.DeploymentStatusReasonPaused,
+							CanaryStep:   2,
+							CanarySteps:  2,
+						},
+						ccv3.Warnings{"get-deployment-warning-3"},
+						nil,
+					)
+				})
+
+				It("reports every step it reaches and stops polling once it pauses", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(1))
+
+					fakeClock.Increment(1 * time.Second)
+					Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(2))
+
+					fakeClock.Increment(1 * time.Second)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-deployment-warning-1", "get-deployment-warning-2", "get-deployment-warning-3"))
+					Expect(reportedInstanceDetails).To(Equal([]string{"Canary step 1 of 2", "Canary step 2 of 2"}))
+
+					Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(3))
+					Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(0))
+				})
+			})
+
 		})
 	})
 
@@ -1640,6 +1732,65 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("SetApplicationProcessPortsByNameAndSpace", func() {
+		var (
+			warnings Warnings
+			err      error
+			app      resources.Application
+		)
+
+		JustBeforeEach(func() {
+			app, warnings, err = actor.SetApplicationProcessPortsByNameAndSpace("some-app-name", "some-space-guid", "some-process-type", []int{8080, 9090})
+		})
+
+		When("getting application returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("application process exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					resources.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"some-process-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateProcessReturns(
+					resources.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"some-ports-warning"},
+					nil,
+				)
+			})
+
+			It("updates the ports of the process", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "some-ports-warning"))
+				Expect(app.GUID).To(Equal("some-app-guid"))
+
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("some-process-type"))
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(resources.Process{
+					GUID:  "some-process-guid",
+					Ports: []int{8080, 9090},
+				}))
+			})
+		})
+	})
+
 	Describe("StopApplication", func() {
 		var (
 			warnings   Warnings
@@ -39,7 +39,9 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	actor.config.SetTokenInformation(accessToken, refreshToken, "")
 
-	if grantType == constant.GrantTypePassword {
+	// Tokens of a device authorization grant are refreshed like the tokens of
+	// a password grant.
+	if grantType == constant.GrantTypePassword || grantType == constant.GrantTypeDeviceCode {
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
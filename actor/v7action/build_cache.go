@@ -0,0 +1,18 @@
+package v7action
+
+// ClearApplicationBuildCache deletes the buildpack cache of the app with the
+// given GUID, so that its next build starts from a clean cache.
+func (actor Actor) ClearApplicationBuildCache(appGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteApplicationBuildpackCache(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
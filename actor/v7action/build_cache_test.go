@@ -0,0 +1,87 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Cache Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("ClearApplicationBuildCache", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ClearApplicationBuildCache("some-app-guid")
+		})
+
+		When("deleting the cache succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationBuildpackCacheReturns(
+					ccv3.JobURL("some-job-url"),
+					ccv3.Warnings{"delete-cache-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, nil)
+			})
+
+			It("deletes the cache of the app and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-cache-warning", "poll-job-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationBuildpackCacheCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteApplicationBuildpackCacheArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+			})
+		})
+
+		When("deleting the cache fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationBuildpackCacheReturns(
+					"",
+					ccv3.Warnings{"delete-cache-warning"},
+					errors.New("delete-cache-error"),
+				)
+			})
+
+			It("returns the error and warnings without polling", func() {
+				Expect(executeErr).To(MatchError("delete-cache-error"))
+				Expect(warnings).To(ConsistOf("delete-cache-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		When("polling the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationBuildpackCacheReturns(
+					ccv3.JobURL("some-job-url"),
+					ccv3.Warnings{"delete-cache-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, errors.New("poll-job-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("poll-job-error"))
+				Expect(warnings).To(ConsistOf("delete-cache-warning", "poll-job-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"github.com/blang/semver/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+// Capability is a feature that not every targeted Cloud Controller provides.
+// It is provided when the API version is at least MinimumVersion and, if Link
+// is set, the root document advertises that link.
+type Capability struct {
+	Name           string
+	MinimumVersion string
+	Link           string
+}
+
+var (
+	CapabilityHTTP2Routes        = Capability{Name: "HTTP/2 routes", MinimumVersion: ccversion.MinVersionHTTP2RoutingV3}
+	CapabilityLogRateLimits      = Capability{Name: "Log rate limits", MinimumVersion: ccversion.MinVersionLogRateLimitingV3}
+	CapabilityRoutingAPI         = Capability{Name: "The routing API", Link: "routing"}
+	CapabilitySpaceSupporterRole = Capability{Name: "The space supporter role", MinimumVersion: ccversion.MinVersionSpaceSupporterV3}
+)
+
+// RequireCapability returns a CapabilityNotSupportedError when the targeted
+// API does not provide the capability. The API version and root links are
+// read from the target information that was stored in the config when the
+// API was targeted, so no requests are made. An API version that is unknown
+// or cannot be parsed is assumed to provide every capability.
+func (actor Actor) RequireCapability(capability Capability) error {
+	apiVersion := actor.Config.APIVersion()
+
+	if capability.MinimumVersion != "" && isVersionBelow(apiVersion, capability.MinimumVersion) {
+		log.WithFields(log.Fields{"capability": capability.Name, "api_version": apiVersion, "minimum": capability.MinimumVersion}).Debug("capability not supported")
+		return actionerror.CapabilityNotSupportedError{
+			Capability:     capability.Name,
+			APIVersion:     apiVersion,
+			MinimumVersion: capability.MinimumVersion,
+		}
+	}
+
+	if capability.Link != "" && actor.linkEndpoint(capability.Link) == "" {
+		log.WithFields(log.Fields{"capability": capability.Name, "link": capability.Link}).Debug("capability not advertised")
+		return actionerror.CapabilityNotSupportedError{
+			Capability: capability.Name,
+			APIVersion: apiVersion,
+			Link:       capability.Link,
+		}
+	}
+
+	return nil
+}
+
+func (actor Actor) linkEndpoint(link string) string {
+	switch link {
+	case "routing":
+		return actor.Config.RoutingEndpoint()
+	}
+	return ""
+}
+
+func isVersionBelow(current string, minimum string) bool {
+	currentSemver, err := semver.Make(current)
+	if err != nil {
+		return false
+	}
+	minimumSemver, err := semver.Make(minimum)
+	if err != nil {
+		return false
+	}
+	return currentSemver.LT(minimumSemver)
+}
@@ -0,0 +1,99 @@
+package v7action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Capability Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, _ = NewTestActor()
+		fakeConfig.APIVersionReturns("3.110.0")
+		fakeConfig.RoutingEndpointReturns("https://api.example.com/routing")
+	})
+
+	Describe("RequireCapability", func() {
+		It("allows capabilities of older API versions", func() {
+			Expect(actor.RequireCapability(CapabilityHTTP2Routes)).To(Succeed())
+		})
+
+		It("rejects capabilities of newer API versions", func() {
+			Expect(actor.RequireCapability(CapabilityLogRateLimits)).To(MatchError(actionerror.CapabilityNotSupportedError{
+				Capability:     "Log rate limits",
+				APIVersion:     "3.110.0",
+				MinimumVersion: "3.124.0",
+			}))
+		})
+
+		It("allows capabilities whose link is advertised", func() {
+			Expect(actor.RequireCapability(CapabilityRoutingAPI)).To(Succeed())
+		})
+
+		It("rejects capabilities whose link is not advertised", func() {
+			fakeConfig.RoutingEndpointReturns("")
+			Expect(actor.RequireCapability(CapabilityRoutingAPI)).To(MatchError(actionerror.CapabilityNotSupportedError{
+				Capability: "The routing API",
+				APIVersion: "3.110.0",
+				Link:       "routing",
+			}))
+		})
+
+		When("the API version is unknown", func() {
+			BeforeEach(func() {
+				fakeConfig.APIVersionReturns("")
+			})
+
+			It("allows every version-based capability", func() {
+				Expect(actor.RequireCapability(CapabilityLogRateLimits)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("actions that need a capability", func() {
+		BeforeEach(func() {
+			fakeConfig.APIVersionReturns("3.100.0")
+		})
+
+		It("fails fast when scaling a log rate limit", func() {
+			_, err := actor.ScaleProcessByApplication("app-guid", resources.Process{LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 1024}})
+			Expect(err).To(BeAssignableToTypeOf(actionerror.CapabilityNotSupportedError{}))
+			Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(0))
+		})
+
+		It("fails fast when mapping an HTTP/2 route", func() {
+			_, err := actor.MapRoute("route-guid", "app-guid", "http2")
+			Expect(err).To(BeAssignableToTypeOf(actionerror.CapabilityNotSupportedError{}))
+			Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(0))
+		})
+
+		It("fails fast when creating a space supporter role", func() {
+			_, err := actor.CreateSpaceRole(constant.SpaceSupporterRole, "org-guid", "space-guid", "some-user", "uaa", false)
+			Expect(err).To(BeAssignableToTypeOf(actionerror.CapabilityNotSupportedError{}))
+			Expect(fakeCloudControllerClient.CreateRoleCallCount()).To(Equal(0))
+		})
+
+		It("fails fast when setting a quota log volume", func() {
+			_, err := actor.CreateOrganizationQuota("quota", QuotaLimits{TotalLogVolume: &types.NullInt{IsSet: true, Value: 1024}})
+			Expect(err).To(BeAssignableToTypeOf(actionerror.CapabilityNotSupportedError{}))
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(0))
+		})
+
+		It("still maps HTTP/1 routes", func() {
+			_, err := actor.MapRoute("route-guid", "app-guid", "http1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -18,10 +18,10 @@ type CloudControllerClient interface {
 	ApplySpaceQuota(quotaGUID string, spaceGUID string) (resources.RelationshipList, ccv3.Warnings, error)
 	CheckRoute(domainGUID string, hostname string, path string, port int) (bool, ccv3.Warnings, error)
 	CancelDeployment(deploymentGUID string) (ccv3.Warnings, error)
+	ContinueDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CopyPackage(sourcePackageGUID string, targetAppGUID string) (resources.Package, ccv3.Warnings, error)
 	CreateApplication(app resources.Application) (resources.Application, ccv3.Warnings, error)
-	CreateApplicationDeployment(appGUID string, dropletGUID string) (string, ccv3.Warnings, error)
-	CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (string, ccv3.Warnings, error)
+	CreateApplicationDeployment(dep resources.Deployment) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process resources.Process) (resources.Process, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task resources.Task) (resources.Task, ccv3.Warnings, error)
 	CreateBuild(build resources.Build) (resources.Build, ccv3.Warnings, error)
@@ -43,6 +43,7 @@ type CloudControllerClient interface {
 	CreateSpaceQuota(spaceQuota resources.SpaceQuota) (resources.SpaceQuota, ccv3.Warnings, error)
 	CreateUser(userGUID string) (resources.User, ccv3.Warnings, error)
 	DeleteApplication(guid string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteApplicationBuildpackCache(appGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
 	DeleteBuildpack(buildpackGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteDomain(domainGUID string) (ccv3.JobURL, ccv3.Warnings, error)
@@ -63,6 +64,7 @@ type CloudControllerClient interface {
 	DeleteUser(userGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DownloadDroplet(dropletGUID string) ([]byte, ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (resources.RelationshipList, ccv3.Warnings, error)
+	GetAppUsageEventsPage(page int, query ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error)
 	GetApplicationDropletCurrent(appGUID string) (resources.Droplet, ccv3.Warnings, error)
 	GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
@@ -91,6 +93,7 @@ type CloudControllerClient interface {
 	GetIsolationSegment(guid string) (resources.IsolationSegment, ccv3.Warnings, error)
 	GetIsolationSegmentOrganizations(isolationSegmentGUID string) ([]resources.Organization, ccv3.Warnings, error)
 	GetIsolationSegments(query ...ccv3.Query) ([]resources.IsolationSegment, ccv3.Warnings, error)
+	GetJobByGUID(jobGUID string) (ccv3.Job, ccv3.Warnings, error)
 	GetNewApplicationProcesses(appGUID string, deploymentGUID string) ([]resources.Process, ccv3.Warnings, error)
 	GetOrganization(orgGUID string) (resources.Organization, ccv3.Warnings, error)
 	GetOrganizationDefaultIsolationSegment(orgGUID string) (resources.Relationship, ccv3.Warnings, error)
@@ -105,8 +108,10 @@ type CloudControllerClient interface {
 	GetProcesses(query ...ccv3.Query) ([]resources.Process, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
 	GetProcessSidecars(processGUID string) ([]resources.Sidecar, ccv3.Warnings, error)
+	GetResourceLinks() (ccv3.ResourceLinks, ccv3.Warnings, error)
 	GetRoles(query ...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetRouteBindings(query ...ccv3.Query) ([]resources.RouteBinding, ccv3.IncludedResources, ccv3.Warnings, error)
+	GetRoute(routeGUID string) (resources.Route, ccv3.Warnings, error)
 	GetRouteDestinations(routeGUID string) ([]resources.RouteDestination, ccv3.Warnings, error)
 	GetRoutes(query ...ccv3.Query) ([]resources.Route, ccv3.Warnings, error)
 	GetRunningSecurityGroups(spaceGUID string, queries ...ccv3.Query) ([]resources.SecurityGroup, ccv3.Warnings, error)
@@ -141,6 +146,7 @@ type CloudControllerClient interface {
 	GetUsers(query ...ccv3.Query) ([]resources.User, ccv3.Warnings, error)
 	MakeRequestSendReceiveRaw(Method string, URL string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (ccv3.Warnings, error)
+	MapRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (ccv3.Warnings, error)
 	MoveRoute(routeGUID string, spaceGUID string) (ccv3.Warnings, error)
 	PollJob(jobURL ccv3.JobURL) (ccv3.Warnings, error)
 	PollJobForState(jobURL ccv3.JobURL, state constant.JobState) (ccv3.Warnings, error)
@@ -176,6 +182,7 @@ type CloudControllerClient interface {
 	UpdateOrganizationQuota(orgQuota resources.OrganizationQuota) (resources.OrganizationQuota, ccv3.Warnings, error)
 	UpdateProcess(process resources.Process) (resources.Process, ccv3.Warnings, error)
 	UpdateResourceMetadata(resource string, resourceGUID string, metadata resources.Metadata) (ccv3.JobURL, ccv3.Warnings, error)
+	UpdateRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (ccv3.Warnings, error)
 	UpdateSecurityGroupRunningSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
 	UpdateSecurityGroup(securityGroup resources.SecurityGroup) (resources.SecurityGroup, ccv3.Warnings, error)
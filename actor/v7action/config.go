@@ -13,8 +13,10 @@ type Config interface {
 	APIVersion() string
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
+	LogCacheEndpoint() string
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RoutingEndpoint() string
 	SSHOAuthClient() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
@@ -26,6 +28,7 @@ type Config interface {
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
+	UAAEndpoint() string
 	UAAGrantType() string
 	UnsetOrganizationAndSpaceInformation()
 	SetKubernetesAuthInfo(authInfo string)
@@ -11,14 +11,76 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
+// CurlTarget is the API that a curl request is sent to.
+type CurlTarget string
+
+const (
+	// CurlTargetCloudController sends the request to the Cloud Controller.
+	CurlTargetCloudController CurlTarget = "cc"
+	// CurlTargetUAA sends the request to the UAA.
+	CurlTargetUAA CurlTarget = "uaa"
+	// CurlTargetRouting sends the request to the routing API.
+	CurlTargetRouting CurlTarget = "routing"
+	// CurlTargetLogCache sends the request to Log Cache.
+	CurlTargetLogCache CurlTarget = "logcache"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . CurlRequester
+
+// CurlRequester sends the raw requests of curl to an API.
+type CurlRequester interface {
+	MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error)
+}
+
+// MakeCurlRequest sends a request for the path to the targeted API. The Cloud
+// Controller is targeted when the target is empty.
 func (actor Actor) MakeCurlRequest(
+	target CurlTarget,
+	method string,
+	path string,
+	customHeaders []string,
+	data string,
+	failOnHTTPError bool,
+) ([]byte, *http.Response, error) {
+	var (
+		requester CurlRequester
+		endpoint  string
+	)
+	switch target {
+	case CurlTargetUAA:
+		requester, endpoint = actor.UAAClient, actor.Config.UAAEndpoint()
+	case CurlTargetRouting:
+		requester, endpoint = actor.RoutingClient, actor.Config.RoutingEndpoint()
+	default:
+		requester, endpoint = actor.CloudControllerClient, actor.Config.Target()
+	}
+
+	return actor.makeCurlRequest(requester, endpoint, method, path, customHeaders, data, failOnHTTPError)
+}
+
+// MakeLogCacheCurlRequest sends a request for the path to Log Cache with the
+// client.
+func (actor Actor) MakeLogCacheCurlRequest(
+	client CurlRequester,
 	method string,
 	path string,
 	customHeaders []string,
 	data string,
 	failOnHTTPError bool,
 ) ([]byte, *http.Response, error) {
-	url := fmt.Sprintf("%s/%s", actor.Config.Target(), strings.TrimLeft(path, "/"))
+	return actor.makeCurlRequest(client, actor.Config.LogCacheEndpoint(), method, path, customHeaders, data, failOnHTTPError)
+}
+
+func (actor Actor) makeCurlRequest(
+	requester CurlRequester,
+	endpoint string,
+	method string,
+	path string,
+	customHeaders []string,
+	data string,
+	failOnHTTPError bool,
+) ([]byte, *http.Response, error) {
+	url := fmt.Sprintf("%s/%s", strings.TrimRight(endpoint, "/"), strings.TrimLeft(path, "/"))
 
 	requestHeaders, err := buildRequestHeaders(customHeaders)
 	if err != nil {
@@ -40,7 +102,7 @@ func (actor Actor) MakeCurlRequest(
 		}
 	}
 
-	responseBody, httpResponse, err := actor.CloudControllerClient.MakeRequestSendReceiveRaw(
+	responseBody, httpResponse, err := requester.MakeRequestSendReceiveRaw(
 		method,
 		url,
 		requestHeaders,
@@ -48,6 +110,9 @@ func (actor Actor) MakeCurlRequest(
 	)
 
 	if err != nil && failOnHTTPError {
+		if httpResponse == nil {
+			return nil, nil, err
+		}
 		return nil, nil, translatableerror.CurlExit22Error{StatusCode: httpResponse.StatusCode}
 	}
 
@@ -19,7 +19,10 @@ var _ = Describe("Curl Actions", func() {
 			actor                     *Actor
 			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
 			fakeConfig                *v7actionfakes.FakeConfig
+			fakeUAAClient             *v7actionfakes.FakeUAAClient
+			fakeRoutingClient         *v7actionfakes.FakeRoutingClient
 
+			target          CurlTarget
 			method          string
 			path            string
 			customHeaders   []string
@@ -36,14 +39,17 @@ var _ = Describe("Curl Actions", func() {
 		)
 
 		BeforeEach(func() {
-			actor, fakeCloudControllerClient, fakeConfig, _, _, _, _ = NewTestActor()
+			actor, fakeCloudControllerClient, fakeConfig, _, fakeUAAClient, fakeRoutingClient, _ = NewTestActor()
 
 			fakeConfig.TargetReturns("api.com")
+			fakeConfig.UAAEndpointReturns("uaa.com/")
+			fakeConfig.RoutingEndpointReturns("routing.com")
 
 			mockResponseBody = []byte(`{"response":"yep"}`)
 			mockHTTPResponse = &http.Response{}
 			mockErr = nil
 
+			target = ""
 			method = ""
 			path = "/v3/is/great"
 			customHeaders = []string{}
@@ -58,7 +64,7 @@ var _ = Describe("Curl Actions", func() {
 				mockErr,
 			)
 
-			responseBody, httpResponse, executeErr = actor.MakeCurlRequest(method, path, customHeaders, data, failOnHTTPError)
+			responseBody, httpResponse, executeErr = actor.MakeCurlRequest(target, method, path, customHeaders, data, failOnHTTPError)
 		})
 
 		When("no method is given", func() {
@@ -77,6 +83,48 @@ var _ = Describe("Curl Actions", func() {
 			})
 		})
 
+		When("the UAA is targeted", func() {
+			BeforeEach(func() {
+				target = CurlTargetUAA
+				fakeUAAClient.MakeRequestSendReceiveRawReturns([]byte(`{"user_name":"me"}`), mockHTTPResponse, nil)
+			})
+
+			It("sends the request to the UAA", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.MakeRequestSendReceiveRawCallCount()).To(Equal(0))
+				Expect(fakeUAAClient.MakeRequestSendReceiveRawCallCount()).To(Equal(1))
+
+				_, givenURL, _, _ := fakeUAAClient.MakeRequestSendReceiveRawArgsForCall(0)
+				Expect(givenURL).To(Equal("uaa.com/v3/is/great"))
+				Expect(responseBody).To(Equal([]byte(`{"user_name":"me"}`)))
+			})
+		})
+
+		When("the routing API is targeted", func() {
+			BeforeEach(func() {
+				target = CurlTargetRouting
+				fakeRoutingClient.MakeRequestSendReceiveRawReturns(nil, nil, errors.New("connection refused"))
+			})
+
+			It("sends the request to the routing API", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeRoutingClient.MakeRequestSendReceiveRawCallCount()).To(Equal(1))
+
+				_, givenURL, _, _ := fakeRoutingClient.MakeRequestSendReceiveRawArgsForCall(0)
+				Expect(givenURL).To(Equal("routing.com/v3/is/great"))
+			})
+
+			When("the fail-on-http-errors flag is set and there is no response", func() {
+				BeforeEach(func() {
+					failOnHTTPError = true
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("connection refused"))
+				})
+			})
+		})
+
 		When("method and data are given", func() {
 			BeforeEach(func() {
 				method = "PATCH"
@@ -249,4 +297,37 @@ var _ = Describe("Curl Actions", func() {
 			})
 		})
 	})
+
+	Describe("MakeLogCacheCurlRequest", func() {
+		var (
+			actor         *Actor
+			fakeConfig    *v7actionfakes.FakeConfig
+			fakeRequester *v7actionfakes.FakeCurlRequester
+			responseBody  []byte
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			actor, _, fakeConfig, _, _, _, _ = NewTestActor()
+			fakeConfig.LogCacheEndpointReturns("https://log-cache.com")
+
+			fakeRequester = new(v7actionfakes.FakeCurlRequester)
+			fakeRequester.MakeRequestSendReceiveRawReturns([]byte(`{"version":"2.0"}`), &http.Response{}, nil)
+		})
+
+		JustBeforeEach(func() {
+			responseBody, _, executeErr = actor.MakeLogCacheCurlRequest(fakeRequester, "", "/api/v1/meta", []string{"Accept: text/plain"}, "", false)
+		})
+
+		It("sends the request to Log Cache with the client", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(responseBody).To(Equal([]byte(`{"version":"2.0"}`)))
+
+			Expect(fakeRequester.MakeRequestSendReceiveRawCallCount()).To(Equal(1))
+			givenMethod, givenURL, givenHeaders, _ := fakeRequester.MakeRequestSendReceiveRawArgsForCall(0)
+			Expect(givenMethod).To(Equal(""))
+			Expect(givenURL).To(Equal("https://log-cache.com/api/v1/meta"))
+			Expect(givenHeaders).To(Equal(http.Header{"Accept": {"text/plain"}}))
+		})
+	})
 })
@@ -2,21 +2,66 @@ package v7action
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 )
 
-func (actor Actor) CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, Warnings, error) {
-	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+// DeploymentOptions are how a restart-like operation replaces the running
+// instances of an app.
+type DeploymentOptions struct {
+	Strategy    constant.DeploymentStrategy
+	MaxInFlight int
+	// CanaryWeights are the percentages of instances a canary deployment
+	// replaces at each step.
+	CanaryWeights []int
+}
+
+// UsesDeployment returns true when the instances are replaced by a
+// deployment, without downtime, instead of stopping and starting the app.
+func (opts DeploymentOptions) UsesDeployment() bool {
+	return opts.Strategy == constant.DeploymentStrategyRolling || opts.Strategy == constant.DeploymentStrategyCanary
+}
 
-	return deploymentGUID, Warnings(warnings), err
+// Deployment returns a deployment of the app with the strategy and options.
+// The caller sets the droplet or revision GUID.
+func (opts DeploymentOptions) Deployment(appGUID string) resources.Deployment {
+	return resources.Deployment{
+		Strategy: opts.Strategy,
+		Options:  resources.DeploymentOpts{MaxInFlight: opts.MaxInFlight, CanaryWeights: opts.CanaryWeights},
+		Relationships: resources.Relationships{
+			constant.RelationshipTypeApplication: resources.Relationship{GUID: appGUID},
+		},
+	}
 }
 
-func (actor Actor) CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
-	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revisionGUID)
+// CreateDeployment creates a deployment that replaces the instances of an app
+// with its strategy and options. The deployment must have an app relationship
+// and a droplet or revision GUID. A max in flight larger than the instance
+// count of the web process of the app is rejected.
+func (actor Actor) CreateDeployment(dep resources.Deployment) (string, Warnings, error) {
+	var allWarnings Warnings
+	if dep.Options.MaxInFlight > 0 {
+		appGUID := dep.Relationships[constant.RelationshipTypeApplication].GUID
+		process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, constant.ProcessTypeWeb)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+
+		if process.Instances.IsSet && dep.Options.MaxInFlight > process.Instances.Value {
+			return "", allWarnings, actionerror.MaxInFlightExceedsInstancesError{
+				MaxInFlight: dep.Options.MaxInFlight,
+				Instances:   process.Instances.Value,
+			}
+		}
+	}
 
-	return deploymentGUID, Warnings(warnings), err
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(dep)
+	allWarnings = append(allWarnings, warnings...)
+
+	return deploymentGUID, allWarnings, err
 }
 
 func (actor Actor) GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, Warnings, error) {
@@ -43,3 +88,31 @@ func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
 	return Warnings(warnings), err
 }
+
+// GetDeploymentForApp returns the deployment of the app with the given GUID,
+// or its latest active deployment when the GUID is empty.
+func (actor Actor) GetDeploymentForApp(appGUID string, deploymentGUID string) (resources.Deployment, Warnings, error) {
+	if deploymentGUID == "" {
+		return actor.GetLatestActiveDeploymentForApp(appGUID)
+	}
+
+	deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{}
+	}
+	if err != nil {
+		return resources.Deployment{}, Warnings(warnings), err
+	}
+
+	if deployment.Relationships[constant.RelationshipTypeApplication].GUID != appGUID {
+		return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{}
+	}
+
+	return deployment, Warnings(warnings), nil
+}
+
+// ContinueDeployment resumes a paused deployment.
+func (actor Actor) ContinueDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.ContinueDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
@@ -7,9 +7,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -25,22 +27,33 @@ var _ = Describe("Deployment Actions", func() {
 
 	BeforeEach(func() {
 		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
-		fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
-			"some-deployment-guid",
-			ccv3.Warnings{"create-warning-1", "create-warning-2"},
-			errors.New("create-error"),
-		)
 	})
 
-	Describe("CreateDeploymentByApplicationAndRevision", func() {
+	Describe("CreateDeployment", func() {
+		var deployment resources.Deployment
+
+		BeforeEach(func() {
+			deployment = resources.Deployment{
+				DropletGUID:   "some-droplet-guid",
+				Strategy:      constant.DeploymentStrategyCanary,
+				Options:       resources.DeploymentOpts{MaxInFlight: 2},
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+			}
+			fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+				"some-deployment-guid",
+				ccv3.Warnings{"create-warning-1", "create-warning-2"},
+				nil,
+			)
+		})
+
 		JustBeforeEach(func() {
-			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeploymentByApplicationAndRevision("some-app-guid", "some-revision-guid")
+			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeployment(deployment)
 		})
 
 		When("the client fails", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
-					"some-deployment-guid",
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					"",
 					ccv3.Warnings{"create-warning-1", "create-warning-2"},
 					errors.New("create-deployment-error"),
 				)
@@ -52,34 +65,49 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 
-		It("delegates to the cloud controller client", func() {
-
-			Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1), "CreateApplicationDeploymentByRevision call count")
-			givenAppGUID, givenRevisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
+		When("the max in flight exceeds the instances of the web process", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					resources.Process{Instances: types.NullInt{IsSet: true, Value: 1}},
+					ccv3.Warnings{"get-process-warning"},
+					nil,
+				)
+			})
 
-			Expect(givenAppGUID).To(Equal("some-app-guid"))
-			Expect(givenRevisionGUID).To(Equal("some-revision-guid"))
+			It("returns an error without creating the deployment", func() {
+				Expect(executeErr).To(MatchError(actionerror.MaxInFlightExceedsInstancesError{MaxInFlight: 2, Instances: 1}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(0))
 
-			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
-			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal(constant.ProcessTypeWeb))
+			})
 		})
-	})
 
-	Describe("CreateDeploymentByApplicationAndDroplet", func() {
-		It("delegates to the cloud controller client", func() {
-			fakeCloudControllerClient.CreateApplicationDeploymentReturns("some-deployment-guid", ccv3.Warnings{"create-warning-1", "create-warning-2"}, errors.New("create-error"))
+		When("the max in flight does not exceed the instances of the web process", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					resources.Process{Instances: types.NullInt{IsSet: true, Value: 2}},
+					ccv3.Warnings{"get-process-warning"},
+					nil,
+				)
+			})
 
-			returnedDeploymentGUID, warnings, executeErr := actor.CreateDeploymentByApplicationAndDroplet("some-app-guid", "some-droplet-guid")
+			It("creates the deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning", "create-warning-1", "create-warning-2"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+			})
+		})
 
+		It("delegates to the cloud controller client", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
 			Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
-			givenAppGUID, givenDropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
-
-			Expect(givenAppGUID).To(Equal("some-app-guid"))
-			Expect(givenDropletGUID).To(Equal("some-droplet-guid"))
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)).To(Equal(deployment))
 
 			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
 			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
-			Expect(executeErr).To(MatchError("create-error"))
 		})
 	})
 
@@ -206,4 +234,106 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDeploymentForApp", func() {
+		var (
+			deploymentGUID string
+
+			deployment resources.Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.GetDeploymentForApp("some-app-guid", deploymentGUID)
+		})
+
+		When("no deployment GUID is given", func() {
+			BeforeEach(func() {
+				deploymentGUID = ""
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]resources.Deployment{{GUID: "active-deployment-guid"}},
+					ccv3.Warnings{"get-deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns the latest active deployment of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+				Expect(deployment.GUID).To(Equal("active-deployment-guid"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a deployment GUID is given", func() {
+			BeforeEach(func() {
+				deploymentGUID = "some-deployment-guid"
+			})
+
+			When("the deployment belongs to the app", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturns(
+						resources.Deployment{
+							GUID:          "some-deployment-guid",
+							Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+						},
+						ccv3.Warnings{"get-deployment-warning"},
+						nil,
+					)
+				})
+
+				It("returns the deployment", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-deployment-warning"))
+					Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+					Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				})
+			})
+
+			When("the deployment belongs to another app", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturns(
+						resources.Deployment{
+							GUID:          "some-deployment-guid",
+							Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "other-app-guid"}},
+						},
+						ccv3.Warnings{"get-deployment-warning"},
+						nil,
+					)
+				})
+
+				It("returns a DeploymentNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+					Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				})
+			})
+
+			When("the deployment does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturns(
+						resources.Deployment{},
+						ccv3.Warnings{"get-deployment-warning"},
+						ccerror.ResourceNotFoundError{},
+					)
+				})
+
+				It("returns a DeploymentNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+					Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("ContinueDeployment", func() {
+		It("continues the deployment and returns the warnings", func() {
+			fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-warning"}, nil)
+
+			warnings, err := actor.ContinueDeployment("dep-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("continue-warning"))
+			Expect(fakeCloudControllerClient.ContinueDeploymentArgsForCall(0)).To(Equal("dep-guid"))
+		})
+	})
 })
@@ -0,0 +1,61 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+const (
+	// defaultDeviceAuthorizationInterval is the polling interval of a device
+	// authorization grant when UAA does not give one.
+	defaultDeviceAuthorizationInterval = 5 * time.Second
+
+	// deviceAuthorizationSlowDown is added to the polling interval each time
+	// UAA asks to poll less often.
+	deviceAuthorizationSlowDown = 5 * time.Second
+)
+
+// DeviceAuthorization is a pending device authorization grant, which the user
+// approves by entering the user code at the verification URI.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// RequestDeviceAuthorization starts a device authorization grant.
+func (actor Actor) RequestDeviceAuthorization() (DeviceAuthorization, error) {
+	authorization, err := actor.UAAClient.RequestDeviceAuthorization()
+	return DeviceAuthorization(authorization), err
+}
+
+// AuthenticateWithDeviceAuthorization polls UAA for the tokens of the device
+// authorization grant until the user approves or declines it, or until the
+// device code expires.
+func (actor Actor) AuthenticateWithDeviceAuthorization(authorization DeviceAuthorization) error {
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceAuthorizationInterval
+	}
+
+	timer := actor.Clock.NewTimer(interval)
+	defer timer.Stop()
+	timeout := actor.Clock.After(time.Duration(authorization.ExpiresIn) * time.Second)
+
+	credentials := map[string]string{"device_code": authorization.DeviceCode}
+	for {
+		select {
+		case <-timeout:
+			return uaa.ExpiredDeviceCodeError{}
+		case <-timer.C():
+			err := actor.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+			switch err.(type) {
+			case uaa.AuthorizationPendingError:
+			case uaa.SlowDownError:
+				interval += deviceAuthorizationSlowDown
+			default:
+				return err
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Authorization Actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeConfig, _, fakeUAAClient, _, fakeClock = NewTestActor()
+	})
+
+	Describe("RequestDeviceAuthorization", func() {
+		It("returns the device authorization of UAA", func() {
+			fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{DeviceCode: "device-code", UserCode: "USER-CODE"}, nil)
+
+			authorization, err := actor.RequestDeviceAuthorization()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authorization).To(Equal(DeviceAuthorization{DeviceCode: "device-code", UserCode: "USER-CODE"}))
+		})
+	})
+
+	Describe("AuthenticateWithDeviceAuthorization", func() {
+		var (
+			authorization DeviceAuthorization
+			done          chan bool
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			authorization = DeviceAuthorization{DeviceCode: "device-code", ExpiresIn: 600, Interval: 5}
+			fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+			fakeUAAClient.AuthenticateReturnsOnCall(1, "access-token", "refresh-token", nil)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan bool)
+			go func() {
+				defer close(done)
+				executeErr = actor.AuthenticateWithDeviceAuthorization(authorization)
+				done <- true
+			}()
+		})
+
+		It("polls UAA until the authorization is approved", func() {
+			fakeClock.WaitForNWatchersAndIncrement(5*time.Second, 2)
+			Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+			fakeClock.WaitForNWatchersAndIncrement(5*time.Second, 2)
+			Eventually(done).Should(Receive(BeTrue()))
+
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(2))
+			credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(1)
+			Expect(credentials).To(Equal(map[string]string{"device_code": "device-code"}))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypeDeviceCode))
+
+			accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(fakeConfig.SetTokenInformationCallCount() - 1)
+			Expect(accessToken).To(Equal("bearer access-token"))
+			Expect(refreshToken).To(Equal("refresh-token"))
+			Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+		})
+
+		When("UAA asks to poll less often", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.SlowDownError{})
+			})
+
+			It("increases the polling interval", func() {
+				fakeClock.WaitForNWatchersAndIncrement(5*time.Second, 2)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+
+				fakeClock.WaitForNWatchersAndIncrement(5*time.Second, 2)
+				Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+
+				fakeClock.Increment(5 * time.Second)
+				Eventually(done).Should(Receive(BeTrue()))
+				Expect(executeErr).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				authorization.ExpiresIn = 3
+			})
+
+			It("returns an ExpiredDeviceCodeError", func() {
+				fakeClock.WaitForNWatchersAndIncrement(3*time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(uaa.ExpiredDeviceCodeError{}))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the authorization is declined", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", errors.New("access denied"))
+			})
+
+			It("returns the error", func() {
+				fakeClock.WaitForNWatchersAndIncrement(5*time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("access denied"))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -17,11 +17,13 @@ var _ = Describe("Domain Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
 		fakeRoutingClient         *v7actionfakes.FakeRoutingClient
 	)
 
 	BeforeEach(func() {
-		actor, fakeCloudControllerClient, _, _, _, fakeRoutingClient, _ = NewTestActor()
+		actor, fakeCloudControllerClient, fakeConfig, _, _, fakeRoutingClient, _ = NewTestActor()
+		fakeConfig.RoutingEndpointReturns("https://api.example.com/routing")
 	})
 
 	Describe("CheckRoute", func() {
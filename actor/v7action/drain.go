@@ -0,0 +1,115 @@
+package v7action
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+)
+
+// DefaultDrainType is the type of a drain whose URL does not specify one.
+const DefaultDrainType = "logs"
+
+// Drain is a user-provided service instance with a syslog drain URL, which
+// streams the logs of the apps bound to it.
+type Drain struct {
+	Name string
+	URL  string
+	Type string
+	Apps []string
+}
+
+// GetDrainsForSpace returns the drains in the given space, with the names of
+// the apps bound to them.
+func (actor Actor) GetDrainsForSpace(spaceGUID string) ([]Drain, Warnings, error) {
+	return actor.getDrains(spaceGUID)
+}
+
+// GetDrainByNameAndSpace returns the drain with the given name in the given
+// space.
+func (actor Actor) GetDrainByNameAndSpace(drainName string, spaceGUID string) (Drain, Warnings, error) {
+	drains, warnings, err := actor.getDrains(spaceGUID, ccv3.Query{Key: ccv3.NameFilter, Values: []string{drainName}})
+	if err != nil {
+		return Drain{}, warnings, err
+	}
+
+	if len(drains) == 0 {
+		return Drain{}, warnings, actionerror.DrainNotFoundError{Name: drainName}
+	}
+
+	return drains[0], warnings, nil
+}
+
+func (actor Actor) getDrains(spaceGUID string, queries ...ccv3.Query) ([]Drain, Warnings, error) {
+	queries = append(queries,
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.UserProvidedServiceInstance)}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+
+	instances, _, ccWarnings, err := actor.CloudControllerClient.GetServiceInstances(queries...)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var drainInstances []resources.ServiceInstance
+	for _, instance := range instances {
+		if instance.SyslogDrainURL.IsSet && instance.SyslogDrainURL.Value != "" {
+			drainInstances = append(drainInstances, instance)
+		}
+	}
+
+	if len(drainInstances) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	var bindings []resources.ServiceCredentialBinding
+	ccWarnings, err = batcher.RequestByGUID(
+		extract.UniqueList("GUID", drainInstances),
+		func(guids []string) (ccv3.Warnings, error) {
+			batch, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindings(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: guids},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"app"}},
+			)
+			bindings = append(bindings, batch...)
+			return warnings, err
+		},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	boundApps := buildBoundAppsLookup(bindings, spaceGUID)
+
+	drains := make([]Drain, len(drainInstances))
+	for i, instance := range drainInstances {
+		drains[i] = Drain{
+			Name: instance.Name,
+			URL:  instance.SyslogDrainURL.Value,
+			Type: drainType(instance.SyslogDrainURL.Value),
+			Apps: boundApps[instance.GUID],
+		}
+	}
+
+	return drains, allWarnings, nil
+}
+
+// drainType returns the type set by the drain-type parameter of a drain URL.
+func drainType(drainURL string) string {
+	parsedURL, err := url.Parse(drainURL)
+	if err != nil {
+		return DefaultDrainType
+	}
+
+	if drainType := parsedURL.Query().Get("drain-type"); drainType != "" {
+		return drainType
+	}
+
+	return DefaultDrainType
+}
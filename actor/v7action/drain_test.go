@@ -0,0 +1,217 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drain Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetServiceInstancesReturns(
+			[]resources.ServiceInstance{
+				{
+					GUID:           "drain-guid-1",
+					Name:           "drain-1",
+					Type:           resources.UserProvidedServiceInstance,
+					SyslogDrainURL: types.NewOptionalString("syslog-tls://logs.example.com:6514"),
+				},
+				{
+					GUID: "upsi-guid",
+					Name: "upsi-without-drain",
+					Type: resources.UserProvidedServiceInstance,
+				},
+				{
+					GUID:           "drain-guid-2",
+					Name:           "drain-2",
+					Type:           resources.UserProvidedServiceInstance,
+					SyslogDrainURL: types.NewOptionalString("https://metrics.example.com/ingest?drain-type=metrics"),
+				},
+			},
+			ccv3.IncludedResources{},
+			ccv3.Warnings{"get-instances-warning"},
+			nil,
+		)
+
+		fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+			[]resources.ServiceCredentialBinding{
+				{Type: resources.AppBinding, ServiceInstanceGUID: "drain-guid-1", AppName: "app-1", AppSpaceGUID: "some-space-guid"},
+				{Type: resources.AppBinding, ServiceInstanceGUID: "drain-guid-1", AppName: "app-2", AppSpaceGUID: "some-space-guid"},
+				{Type: resources.AppBinding, ServiceInstanceGUID: "drain-guid-2", AppName: "app-3", AppSpaceGUID: "some-space-guid"},
+			},
+			ccv3.Warnings{"get-bindings-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetDrainsForSpace", func() {
+		var (
+			drains     []Drain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			drains, warnings, executeErr = actor.GetDrainsForSpace("some-space-guid")
+		})
+
+		It("lists the user-provided service instances of the space", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"user-provided"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("gets the app bindings of the drains only", func() {
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{"drain-guid-1", "drain-guid-2"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"app"}},
+			))
+		})
+
+		It("returns the drains with their type and bound apps", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-instances-warning", "get-bindings-warning"))
+			Expect(drains).To(Equal([]Drain{
+				{
+					Name: "drain-1",
+					URL:  "syslog-tls://logs.example.com:6514",
+					Type: "logs",
+					Apps: []string{"app-1", "app-2"},
+				},
+				{
+					Name: "drain-2",
+					URL:  "https://metrics.example.com/ingest?drain-type=metrics",
+					Type: "metrics",
+					Apps: []string{"app-3"},
+				},
+			}))
+		})
+
+		When("no instance has a drain URL", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{{GUID: "upsi-guid", Name: "upsi"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns no drains without getting bindings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(drains).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					nil,
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instances-warning"},
+					errors.New("get-instances-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-instances-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning"))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					nil,
+					ccv3.Warnings{"get-bindings-warning"},
+					errors.New("get-bindings-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-bindings-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning", "get-bindings-warning"))
+			})
+		})
+	})
+
+	Describe("GetDrainByNameAndSpace", func() {
+		var (
+			drain      Drain
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						GUID:           "drain-guid-1",
+						Name:           "drain-1",
+						SyslogDrainURL: types.NewOptionalString("syslog://logs.example.com:514?drain-type=all"),
+					},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			drain, warnings, executeErr = actor.GetDrainByNameAndSpace("drain-1", "some-space-guid")
+		})
+
+		It("filters the instances by name", func() {
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"drain-1"}},
+			))
+		})
+
+		It("returns the drain", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-instances-warning", "get-bindings-warning"))
+			Expect(drain).To(Equal(Drain{
+				Name: "drain-1",
+				URL:  "syslog://logs.example.com:514?drain-type=all",
+				Type: "all",
+				Apps: []string{"app-1", "app-2"},
+			}))
+		})
+
+		When("the instance does not exist or has no drain URL", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{{GUID: "upsi-guid", Name: "drain-1"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DrainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DrainNotFoundError{Name: "drain-1"}))
+				Expect(warnings).To(ConsistOf("get-instances-warning"))
+			})
+		})
+	})
+})
@@ -1,7 +1,13 @@
 package v7action
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"io"
+	"io/ioutil"
+	"path"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -176,3 +182,91 @@ func (actor Actor) DownloadDropletByGUIDAndAppName(dropletGUID string, appName s
 
 	return rawDropletBytes, allWarnings, nil
 }
+
+// SBOMFormat is the format of a software bill of materials.
+type SBOMFormat string
+
+const (
+	// SBOMFormatCycloneDX is the CycloneDX JSON format.
+	SBOMFormatCycloneDX SBOMFormat = "cyclonedx"
+	// SBOMFormatSPDX is the SPDX JSON format.
+	SBOMFormatSPDX SBOMFormat = "spdx"
+)
+
+// sbomFileNames are the names Cloud Native Buildpacks give the SBOM files of
+// a layer in each format.
+var sbomFileNames = map[SBOMFormat]string{
+	SBOMFormatCycloneDX: "sbom.cdx.json",
+	SBOMFormatSPDX:      "sbom.spdx.json",
+}
+
+// SBOM is a software bill of materials found in a droplet.
+type SBOM struct {
+	// Path is the path of the SBOM in the droplet.
+	Path    string
+	Content []byte
+}
+
+// DownloadCurrentDropletSBOMsByAppName returns the SBOMs in the given format
+// found in the current droplet of the app, along with the GUID of the droplet.
+func (actor Actor) DownloadCurrentDropletSBOMsByAppName(appName string, spaceGUID string, format SBOMFormat) ([]SBOM, string, Warnings, error) {
+	rawDropletBytes, dropletGUID, warnings, err := actor.DownloadCurrentDropletByAppName(appName, spaceGUID)
+	if err != nil {
+		return nil, "", warnings, err
+	}
+
+	sboms, err := dropletSBOMs(rawDropletBytes, dropletGUID, format)
+	return sboms, dropletGUID, warnings, err
+}
+
+// DownloadDropletSBOMsByGUIDAndAppName returns the SBOMs in the given format
+// found in the given droplet of the app.
+func (actor Actor) DownloadDropletSBOMsByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string, format SBOMFormat) ([]SBOM, Warnings, error) {
+	rawDropletBytes, warnings, err := actor.DownloadDropletByGUIDAndAppName(dropletGUID, appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sboms, err := dropletSBOMs(rawDropletBytes, dropletGUID, format)
+	return sboms, warnings, err
+}
+
+// dropletSBOMs returns the SBOMs in the given format of a droplet tarball.
+// The Cloud Controller has no SBOM endpoint; Cloud Native Buildpacks write the
+// SBOM of each layer they contribute to sbom/launch/BUILDPACK_ID/LAYER in the
+// layers directory, which ends up in the droplet.
+func dropletSBOMs(rawDropletBytes []byte, dropletGUID string, format SBOMFormat) ([]SBOM, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(rawDropletBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	var sboms []SBOM
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean("/" + header.Name)
+		if header.Typeflag != tar.TypeReg || path.Base(name) != sbomFileNames[format] || !strings.Contains(name, "/sbom/") {
+			continue
+		}
+
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+		sboms = append(sboms, SBOM{Path: strings.TrimPrefix(name, "/"), Content: content})
+	}
+
+	if len(sboms) == 0 {
+		return nil, actionerror.SBOMNotFoundError{DropletGUID: dropletGUID}
+	}
+	return sboms, nil
+}
@@ -1,6 +1,9 @@
 package v7action_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"errors"
 	"io"
 	"strings"
@@ -826,4 +829,163 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("DownloadCurrentDropletSBOMsByAppName", func() {
+		var (
+			sboms        []SBOM
+			dropletGUID  string
+			warnings     Warnings
+			executionErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{GUID: "some-droplet-guid"}, ccv3.Warnings{"some-warning"}, nil)
+			fakeCloudControllerClient.DownloadDropletReturns(dropletTarball(map[string]string{
+				"./app/server.js": "some-app-code",
+				"./layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json":  "some-cdx-sbom",
+				"./layers/sbom/launch/some-buildpack/some-layer/sbom.spdx.json": "some-spdx-sbom",
+				"./layers/sbom/launch/other-buildpack/sbom.spdx.json":           "other-spdx-sbom",
+				"./app/sbom.spdx.json": "not-an-sbom",
+			}), ccv3.Warnings{"some-droplet-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sboms, dropletGUID, warnings, executionErr = actor.DownloadCurrentDropletSBOMsByAppName("some-app-name", "some-space-guid", SBOMFormatSPDX)
+		})
+
+		It("returns the SBOMs in the current droplet", func() {
+			Expect(executionErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(sboms).To(ConsistOf(
+				SBOM{Path: "layers/sbom/launch/some-buildpack/some-layer/sbom.spdx.json", Content: []byte("some-spdx-sbom")},
+				SBOM{Path: "layers/sbom/launch/other-buildpack/sbom.spdx.json", Content: []byte("other-spdx-sbom")},
+			))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+		})
+
+		When("the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"some-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError and warnings", func() {
+				Expect(executionErr).To(MatchError(actionerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(BeZero())
+			})
+		})
+
+		When("the droplet has no SBOM in the format", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletReturns(dropletTarball(map[string]string{
+					"./app/server.js": "some-app-code",
+					"./layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json": "some-cdx-sbom",
+				}), ccv3.Warnings{"some-droplet-warning"}, nil)
+			})
+
+			It("returns an SBOMNotFoundError and warnings", func() {
+				Expect(executionErr).To(MatchError(actionerror.SBOMNotFoundError{DropletGUID: "some-droplet-guid"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			})
+		})
+
+		When("the droplet is not a gzipped tarball", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletReturns([]byte("some-droplet"), ccv3.Warnings{"some-droplet-warning"}, nil)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionErr).To(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			})
+		})
+
+		When("downloading the droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletReturns(nil, ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executionErr).To(MatchError("droplet-download-err"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			})
+		})
+	})
+
+	Describe("DownloadDropletSBOMsByGUIDAndAppName", func() {
+		var (
+			sboms        []SBOM
+			warnings     Warnings
+			executionErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDropletsReturns([]resources.Droplet{{GUID: "some-droplet-guid"}}, ccv3.Warnings{"some-warning"}, nil)
+			fakeCloudControllerClient.DownloadDropletReturns(dropletTarball(map[string]string{
+				"./layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json": "some-cdx-sbom",
+			}), ccv3.Warnings{"some-droplet-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sboms, warnings, executionErr = actor.DownloadDropletSBOMsByGUIDAndAppName("some-droplet-guid", "some-app-name", "some-space-guid", SBOMFormatCycloneDX)
+		})
+
+		It("returns the SBOMs in the droplet", func() {
+			Expect(executionErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			Expect(sboms).To(ConsistOf(
+				SBOM{Path: "layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json", Content: []byte("some-cdx-sbom")},
+			))
+
+			Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+			))
+			Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+		})
+
+		When("the droplet does not belong to the app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletsReturns([]resources.Droplet{}, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a DropletNotFoundError and warnings", func() {
+				Expect(executionErr).To(MatchError(actionerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(BeZero())
+			})
+		})
+	})
 })
+
+func dropletTarball(files map[string]string) []byte {
+	buffer := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		Expect(tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
@@ -0,0 +1,154 @@
+package v7action
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"gopkg.in/yaml.v2"
+)
+
+// DumpRecipe describes how to collect diagnostics from an app instance: a
+// shell command that is run in the instance and the files it leaves behind.
+type DumpRecipe struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Buildpacks restricts the recipe to apps whose current droplet was built
+	// by a buildpack whose name contains one of these values. An empty list
+	// applies to every app.
+	Buildpacks []string `yaml:"buildpacks"`
+	// Default recipes are run when no recipe is requested by name.
+	Default bool     `yaml:"default"`
+	Command string   `yaml:"command"`
+	Files   []string `yaml:"files"`
+	// Message is displayed after the recipe has run.
+	Message string `yaml:"message"`
+}
+
+// DefaultDumpRecipes are the recipes that are available without a recipes
+// file.
+var DefaultDumpRecipes = []DumpRecipe{
+	{
+		Name:        "thread-dump",
+		Description: "Java thread dump taken with jstack",
+		Buildpacks:  []string{"java"},
+		Default:     true,
+		Command:     `PID=$(pgrep -o java) && JAVA_BIN=$(dirname "$(readlink -f /proc/$PID/exe)") && "$JAVA_BIN/jstack" -l $PID > /home/vcap/tmp/thread-dump.txt`,
+		Files:       []string{"/home/vcap/tmp/thread-dump.txt"},
+	},
+	{
+		Name:        "heap-dump",
+		Description: "Java heap dump taken with jmap",
+		Buildpacks:  []string{"java"},
+		Command:     `PID=$(pgrep -o java) && JAVA_BIN=$(dirname "$(readlink -f /proc/$PID/exe)") && rm -f /home/vcap/tmp/heap-dump.hprof && "$JAVA_BIN/jmap" -dump:format=b,file=/home/vcap/tmp/heap-dump.hprof $PID`,
+		Files:       []string{"/home/vcap/tmp/heap-dump.hprof"},
+	},
+	{
+		Name:        "inspect",
+		Description: "Enable the Node.js inspector with SIGUSR1",
+		Buildpacks:  []string{"node"},
+		Default:     true,
+		Command:     `kill -USR1 $(pgrep -o node)`,
+		Message:     "The Node.js inspector is listening on port 9229 of the instance. Forward it with '{{.BinaryName}} ssh {{.AppName}} -L 9229:localhost:9229'.",
+	},
+	{
+		Name:        "core-dump",
+		Description: "Core dump of the app process taken with gcore",
+		Command:     `PID=$(ps -o pid=,comm= --ppid 1 | grep -v -e diego-sshd -e healthcheck | awk 'NR==1 {print $1}') && gcore -o /home/vcap/tmp/core $PID > /dev/null && mv /home/vcap/tmp/core.$PID /home/vcap/tmp/core`,
+		Files:       []string{"/home/vcap/tmp/core"},
+	},
+}
+
+type dumpRecipesFile struct {
+	Recipes []DumpRecipe `yaml:"recipes"`
+}
+
+// ParseDumpRecipes parses a YAML document with a top-level 'recipes' list.
+func ParseDumpRecipes(raw []byte) ([]DumpRecipe, error) {
+	var file dumpRecipesFile
+	err := yaml.UnmarshalStrict(raw, &file)
+	if err != nil {
+		return nil, err
+	}
+	return file.Recipes, nil
+}
+
+// GetDumpRecipesForApp returns the recipes that apply to the app's current
+// droplet, out of the custom recipes followed by the default ones; a custom
+// recipe replaces a default recipe with the same name. When names are given
+// those recipes are returned in the given order, otherwise the default
+// recipes are.
+func (actor Actor) GetDumpRecipesForApp(appGUID string, customRecipes []DumpRecipe, names []string) ([]DumpRecipe, Warnings, error) {
+	var buildpackNames []string
+	droplet, warnings, err := actor.GetCurrentDropletByApplication(appGUID)
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); !ok {
+			return nil, warnings, err
+		}
+	}
+	for _, buildpack := range droplet.Buildpacks {
+		buildpackNames = append(buildpackNames, strings.ToLower(buildpack.Name), strings.ToLower(buildpack.BuildpackName))
+	}
+
+	allRecipes := make([]DumpRecipe, 0, len(customRecipes)+len(DefaultDumpRecipes))
+	allRecipes = append(allRecipes, customRecipes...)
+	allRecipes = append(allRecipes, DefaultDumpRecipes...)
+
+	var applicable []DumpRecipe
+	seen := map[string]bool{}
+	for _, recipe := range allRecipes {
+		if seen[recipe.Name] || !recipeAppliesTo(recipe, buildpackNames) {
+			continue
+		}
+		seen[recipe.Name] = true
+		applicable = append(applicable, recipe)
+	}
+
+	if len(names) == 0 {
+		var defaults []DumpRecipe
+		for _, recipe := range applicable {
+			if recipe.Default {
+				defaults = append(defaults, recipe)
+			}
+		}
+		return defaults, warnings, nil
+	}
+
+	var selected []DumpRecipe
+	for _, name := range names {
+		recipe, found := findDumpRecipe(applicable, name)
+		if !found {
+			var available []string
+			for _, recipe := range applicable {
+				available = append(available, recipe.Name)
+			}
+			return nil, warnings, actionerror.DumpRecipeNotFoundError{Name: name, Available: available}
+		}
+		selected = append(selected, recipe)
+	}
+
+	return selected, warnings, nil
+}
+
+func findDumpRecipe(recipes []DumpRecipe, name string) (DumpRecipe, bool) {
+	for _, recipe := range recipes {
+		if recipe.Name == name {
+			return recipe, true
+		}
+	}
+	return DumpRecipe{}, false
+}
+
+func recipeAppliesTo(recipe DumpRecipe, buildpackNames []string) bool {
+	if len(recipe.Buildpacks) == 0 {
+		return true
+	}
+
+	for _, wanted := range recipe.Buildpacks {
+		for _, name := range buildpackNames {
+			if name != "" && strings.Contains(name, strings.ToLower(wanted)) {
+				return true
+			}
+		}
+	}
+	return false
+}
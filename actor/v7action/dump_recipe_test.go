@@ -0,0 +1,151 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dump Recipe Actions", func() {
+	Describe("ParseDumpRecipes", func() {
+		It("parses the recipes list", func() {
+			recipes, err := ParseDumpRecipes([]byte(`---
+recipes:
+- name: gc-log
+  buildpacks: [java]
+  default: true
+  command: cp /home/vcap/logs/gc.log /home/vcap/tmp/gc.log
+  files:
+  - /home/vcap/tmp/gc.log
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recipes).To(Equal([]DumpRecipe{{
+				Name:       "gc-log",
+				Buildpacks: []string{"java"},
+				Default:    true,
+				Command:    "cp /home/vcap/logs/gc.log /home/vcap/tmp/gc.log",
+				Files:      []string{"/home/vcap/tmp/gc.log"},
+			}}))
+		})
+
+		It("rejects unknown fields", func() {
+			_, err := ParseDumpRecipes([]byte("recipes:\n- name: x\n  cmd: ls\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("GetDumpRecipesForApp", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+			customRecipes []DumpRecipe
+			names         []string
+
+			recipes    []DumpRecipe
+			warnings   Warnings
+			executeErr error
+		)
+
+		recipeNames := func(recipes []DumpRecipe) []string {
+			var names []string
+			for _, recipe := range recipes {
+				names = append(names, recipe.Name)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+			customRecipes = nil
+			names = nil
+
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				resources.Droplet{Buildpacks: []resources.DropletBuildpack{{Name: "java_buildpack_offline", BuildpackName: "java"}}},
+				ccv3.Warnings{"get-droplet-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			recipes, warnings, executeErr = actor.GetDumpRecipesForApp("some-app-guid", customRecipes, names)
+		})
+
+		It("returns the default recipes for the app's buildpacks", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-droplet-warning"))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(recipeNames(recipes)).To(Equal([]string{"thread-dump"}))
+		})
+
+		When("recipes are requested by name", func() {
+			BeforeEach(func() {
+				names = []string{"core-dump", "heap-dump"}
+			})
+
+			It("returns them in the requested order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(recipeNames(recipes)).To(Equal([]string{"core-dump", "heap-dump"}))
+			})
+		})
+
+		When("a requested recipe does not apply to the app", func() {
+			BeforeEach(func() {
+				names = []string{"inspect"}
+			})
+
+			It("returns a DumpRecipeNotFoundError listing the available recipes", func() {
+				Expect(executeErr).To(MatchError(actionerror.DumpRecipeNotFoundError{
+					Name:      "inspect",
+					Available: []string{"thread-dump", "heap-dump", "core-dump"},
+				}))
+			})
+		})
+
+		When("custom recipes are given", func() {
+			BeforeEach(func() {
+				customRecipes = []DumpRecipe{
+					{Name: "thread-dump", Command: "custom", Buildpacks: []string{"JAVA"}},
+					{Name: "ruby-only", Buildpacks: []string{"ruby"}, Default: true},
+				}
+				names = []string{"thread-dump"}
+			})
+
+			It("prefers them over the default recipes with the same name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(recipes).To(HaveLen(1))
+				Expect(recipes[0].Command).To(Equal("custom"))
+			})
+		})
+
+		When("the app has no droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, nil, ccerror.DropletNotFoundError{})
+				names = []string{"core-dump"}
+			})
+
+			It("only offers recipes that apply to every app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(recipeNames(recipes)).To(Equal([]string{"core-dump"}))
+			})
+		})
+
+		When("getting the droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"get-droplet-warning"}, errors.New("get-droplet-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-droplet-error"))
+				Expect(warnings).To(ConsistOf("get-droplet-warning"))
+			})
+		})
+	})
+})
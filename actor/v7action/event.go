@@ -2,6 +2,7 @@ package v7action
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,9 +16,22 @@ type Event struct {
 	Time        time.Time
 	Type        string
 	ActorName   string
+	TargetName  string
 	Description string
 }
 
+// EventQuery selects the events that GetEvents returns. Only the non-empty
+// fields filter the events.
+type EventQuery struct {
+	TargetGUID       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Types            []string
+	// Since selects the events created after this time. Without it, only the
+	// most recent page of events is returned.
+	Since time.Time
+}
+
 func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
 	var allWarnings Warnings
 
@@ -53,6 +67,83 @@ func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spac
 	return events, allWarnings, nil
 }
 
+// GetRecentEventsByOrganizationName returns the most recent events of the
+// org and of the resources in it, oldest first.
+func (actor Actor) GetRecentEventsByOrganizationName(orgName string) ([]Event, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	events, warnings, err := actor.GetEvents(EventQuery{OrganizationGUID: org.GUID})
+	return events, append(allWarnings, warnings...), err
+}
+
+// GetRecentEventsBySpaceNameAndOrganization returns the most recent events of
+// the space and of the resources in it, oldest first.
+func (actor Actor) GetRecentEventsBySpaceNameAndOrganization(spaceName string, orgGUID string) ([]Event, Warnings, error) {
+	space, allWarnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	events, warnings, err := actor.GetEvents(EventQuery{SpaceGUID: space.GUID})
+	return events, append(allWarnings, warnings...), err
+}
+
+// GetEvents returns the events that match the query, oldest first.
+func (actor Actor) GetEvents(query EventQuery) ([]Event, Warnings, error) {
+	var queries []ccv3.Query
+	if query.TargetGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{query.TargetGUID}})
+	}
+	if query.SpaceGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{query.SpaceGUID}})
+	}
+	if query.OrganizationGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{query.OrganizationGUID}})
+	}
+	if len(query.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: query.Types})
+	}
+
+	if query.Since.IsZero() {
+		queries = append(queries,
+			ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+			ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+		)
+	} else {
+		queries = append(queries,
+			ccv3.Query{Key: ccv3.CreatedAtsAfterFilter, Values: []string{query.Since.UTC().Format(time.RFC3339)}},
+			ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	events := make([]Event, 0, len(ccEvents))
+	for _, ccEvent := range ccEvents {
+		events = append(events, Event{
+			GUID:        ccEvent.GUID,
+			Time:        ccEvent.CreatedAt,
+			Type:        ccEvent.Type,
+			ActorName:   ccEvent.ActorName,
+			TargetName:  ccEvent.TargetName,
+			Description: generateDescription(ccEvent.Data),
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	return events, Warnings(warnings), nil
+}
+
 var knownMetadataKeys = []string{
 	"index",
 	"reason",
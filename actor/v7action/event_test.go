@@ -2,7 +2,9 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -123,4 +125,210 @@ var _ = Describe("Event Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetEvents", func() {
+		var (
+			query    EventQuery
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			query = EventQuery{SpaceGUID: "some-space-guid"}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetEvents(query)
+		})
+
+		When("the events are found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{
+						{
+							GUID:       "newer-event-guid",
+							CreatedAt:  time.Date(2021, 3, 4, 5, 6, 8, 0, time.UTC),
+							Type:       "audit.app.update",
+							ActorName:  "some-user",
+							TargetName: "some-app",
+							Data:       map[string]interface{}{"request": map[string]interface{}{"state": "STARTED"}},
+						},
+						{
+							GUID:       "older-event-guid",
+							CreatedAt:  time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+							Type:       "audit.space.update",
+							ActorName:  "some-admin",
+							TargetName: "some-space",
+						},
+					},
+					ccv3.Warnings{"get-events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the most recent events of the space oldest first", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:       "older-event-guid",
+						Time:       time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+						Type:       "audit.space.update",
+						ActorName:  "some-admin",
+						TargetName: "some-space",
+					},
+					{
+						GUID:        "newer-event-guid",
+						Time:        time.Date(2021, 3, 4, 5, 6, 8, 0, time.UTC),
+						Type:        "audit.app.update",
+						ActorName:   "some-user",
+						TargetName:  "some-app",
+						Description: "state: STARTED",
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					{Key: ccv3.Page, Values: []string{"1"}},
+				}))
+			})
+		})
+
+		When("filtering by target, org, types and time", func() {
+			BeforeEach(func() {
+				query = EventQuery{
+					TargetGUID:       "some-app-guid",
+					OrganizationGUID: "some-org-guid",
+					Types:            []string{"audit.app.update", "audit.app.crash"},
+					Since:            time.Date(2021, 3, 4, 6, 6, 7, 0, time.FixedZone("", 3600)),
+				}
+			})
+
+			It("requests every event created after the time", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(Equal([]ccv3.Query{
+					{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+					{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+					{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.crash"}},
+					{Key: ccv3.CreatedAtsAfterFilter, Values: []string{"2021-03-04T05:06:07Z"}},
+					{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+					{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				}))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"get-events-warning"}, errors.New("failed to get events"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("failed to get events"))
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+			})
+		})
+	})
+
+	Describe("GetRecentEventsByOrganizationName", func() {
+		var (
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetRecentEventsByOrganizationName("some-org")
+		})
+
+		When("the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]resources.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{{GUID: "some-event-guid", Type: "audit.space.create", TargetName: "some-space"}},
+					ccv3.Warnings{"get-events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events of the org", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-events-warning"))
+				Expect(events).To(Equal([]Event{{GUID: "some-event-guid", Type: "audit.space.create", TargetName: "some-space"}}))
+
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and the warnings", func() {
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRecentEventsBySpaceNameAndOrganization", func() {
+		var (
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetRecentEventsBySpaceNameAndOrganization("some-space", "some-org-guid")
+		})
+
+		When("the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-space-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{{GUID: "some-event-guid", Type: "audit.app.create", TargetName: "some-app"}},
+					ccv3.Warnings{"get-events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events of the space", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-warning", "get-events-warning"))
+				Expect(events).To(Equal([]Event{{GUID: "some-event-guid", Type: "audit.app.create", TargetName: "some-app"}}))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("getting the space fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"get-space-warning"}, errors.New("spaces failed"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(err).To(MatchError("spaces failed"))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+			})
+		})
+	})
 })
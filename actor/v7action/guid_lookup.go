@@ -0,0 +1,256 @@
+package v7action
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// GUIDResourceType is the kind of resource that a GUID identifies.
+type GUIDResourceType string
+
+const (
+	GUIDResourceApp             GUIDResourceType = "app"
+	GUIDResourceSpace           GUIDResourceType = "space"
+	GUIDResourceOrganization    GUIDResourceType = "org"
+	GUIDResourceRoute           GUIDResourceType = "route"
+	GUIDResourceServiceInstance GUIDResourceType = "service instance"
+	GUIDResourceTask            GUIDResourceType = "task"
+	GUIDResourceBuild           GUIDResourceType = "build"
+)
+
+// GUIDLookupResult is a resource that a GUID identifies, along with the names
+// of the app, space and org that it belongs to.
+type GUIDLookupResult struct {
+	Type      GUIDResourceType
+	GUID      string
+	Name      string
+	AppName   string
+	SpaceName string
+	OrgName   string
+}
+
+type guidProbeResult struct {
+	found     bool
+	result    GUIDLookupResult
+	appGUID   string
+	spaceGUID string
+	orgGUID   string
+	warnings  Warnings
+	err       error
+}
+
+// LookupGUID finds out what a GUID refers to by requesting it from the
+// endpoints of the resources it is most likely to identify, all at the same
+// time. Endpoints that do not know the GUID, or do not let the user see it,
+// are skipped, so no results are returned when nothing matches.
+func (actor Actor) LookupGUID(guid string) ([]GUIDLookupResult, Warnings, error) {
+	probes := []func(string) guidProbeResult{
+		actor.probeApplicationGUID,
+		actor.probeSpaceGUID,
+		actor.probeOrganizationGUID,
+		actor.probeRouteGUID,
+		actor.probeServiceInstanceGUID,
+		actor.probeTaskGUID,
+		actor.probeBuildGUID,
+	}
+
+	probeResults := make([]guidProbeResult, len(probes))
+	var wg sync.WaitGroup
+	for i, probe := range probes {
+		wg.Add(1)
+		go func(i int, probe func(string) guidProbeResult) {
+			defer wg.Done()
+			probeResults[i] = probe(guid)
+		}(i, probe)
+	}
+	wg.Wait()
+
+	var allWarnings Warnings
+	for _, probeResult := range probeResults {
+		allWarnings = append(allWarnings, probeResult.warnings...)
+		if probeResult.err != nil && !isNotVisible(probeResult.err) {
+			return nil, allWarnings, probeResult.err
+		}
+	}
+
+	var results []GUIDLookupResult
+	for _, probeResult := range probeResults {
+		if !probeResult.found {
+			continue
+		}
+
+		result, warnings, err := actor.resolveGUIDParents(probeResult)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		results = append(results, result)
+	}
+
+	return results, allWarnings, nil
+}
+
+func (actor Actor) probeApplicationGUID(guid string) guidProbeResult {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{guid}},
+	)
+	if err != nil || len(apps) == 0 {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:     true,
+		result:    GUIDLookupResult{Type: GUIDResourceApp, GUID: guid, Name: apps[0].Name},
+		spaceGUID: apps[0].SpaceGUID,
+		warnings:  Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeSpaceGUID(guid string) guidProbeResult {
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{guid}},
+	)
+	if err != nil || len(spaces) == 0 {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:    true,
+		result:   GUIDLookupResult{Type: GUIDResourceSpace, GUID: guid, Name: spaces[0].Name},
+		orgGUID:  spaces[0].Relationships[constant.RelationshipTypeOrganization].GUID,
+		warnings: Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeOrganizationGUID(guid string) guidProbeResult {
+	org, warnings, err := actor.CloudControllerClient.GetOrganization(guid)
+	if err != nil {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:    true,
+		result:   GUIDLookupResult{Type: GUIDResourceOrganization, GUID: guid, Name: org.Name},
+		warnings: Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeRouteGUID(guid string) guidProbeResult {
+	route, warnings, err := actor.CloudControllerClient.GetRoute(guid)
+	if err != nil {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:     true,
+		result:    GUIDLookupResult{Type: GUIDResourceRoute, GUID: guid, Name: route.URL},
+		spaceGUID: route.SpaceGUID,
+		warnings:  Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeServiceInstanceGUID(guid string) guidProbeResult {
+	serviceInstances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{guid}},
+	)
+	if err != nil || len(serviceInstances) == 0 {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:     true,
+		result:    GUIDLookupResult{Type: GUIDResourceServiceInstance, GUID: guid, Name: serviceInstances[0].Name},
+		spaceGUID: serviceInstances[0].SpaceGUID,
+		warnings:  Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeTaskGUID(guid string) guidProbeResult {
+	task, warnings, err := actor.CloudControllerClient.GetTask(guid)
+	if err != nil {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:    true,
+		result:   GUIDLookupResult{Type: GUIDResourceTask, GUID: guid, Name: task.Name},
+		appGUID:  task.Relationships[constant.RelationshipTypeApplication].GUID,
+		warnings: Warnings(warnings),
+	}
+}
+
+func (actor Actor) probeBuildGUID(guid string) guidProbeResult {
+	build, warnings, err := actor.CloudControllerClient.GetBuild(guid)
+	if err != nil {
+		return guidProbeResult{warnings: Warnings(warnings), err: err}
+	}
+
+	return guidProbeResult{
+		found:    true,
+		result:   GUIDLookupResult{Type: GUIDResourceBuild, GUID: guid},
+		appGUID:  build.AppGUID,
+		warnings: Warnings(warnings),
+	}
+}
+
+// resolveGUIDParents fills in the names of the app, space and org that the
+// probed resource belongs to. Parents that the user cannot see are left blank.
+func (actor Actor) resolveGUIDParents(probeResult guidProbeResult) (GUIDLookupResult, Warnings, error) {
+	var allWarnings Warnings
+	result := probeResult.result
+	spaceGUID := probeResult.spaceGUID
+	orgGUID := probeResult.orgGUID
+
+	if probeResult.appGUID != "" {
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{probeResult.appGUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotVisible(err) {
+			return GUIDLookupResult{}, allWarnings, err
+		}
+		if len(apps) > 0 {
+			result.AppName = apps[0].Name
+			spaceGUID = apps[0].SpaceGUID
+		}
+	}
+
+	if spaceGUID != "" {
+		spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotVisible(err) {
+			return GUIDLookupResult{}, allWarnings, err
+		}
+		if len(spaces) > 0 {
+			result.SpaceName = spaces[0].Name
+			orgGUID = spaces[0].Relationships[constant.RelationshipTypeOrganization].GUID
+		}
+	}
+
+	if orgGUID != "" {
+		org, warnings, err := actor.CloudControllerClient.GetOrganization(orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotVisible(err) {
+			return GUIDLookupResult{}, allWarnings, err
+		}
+		result.OrgName = org.Name
+	}
+
+	return result, allWarnings, nil
+}
+
+// isNotVisible returns true when the error means that the resource does not
+// exist or that the user is not allowed to see it.
+func isNotVisible(err error) bool {
+	switch err.(type) {
+	case ccerror.ResourceNotFoundError, ccerror.ForbiddenError:
+		return true
+	default:
+		return false
+	}
+}
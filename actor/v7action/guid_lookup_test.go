@@ -0,0 +1,193 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GUID Lookup Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("LookupGUID", func() {
+		var (
+			guid       string
+			results    []GUIDLookupResult
+			warnings   Warnings
+			executeErr error
+		)
+
+		guidOf := func(query []ccv3.Query) string {
+			for _, q := range query {
+				if q.Key == ccv3.GUIDFilter {
+					return q.Values[0]
+				}
+			}
+			return ""
+		}
+
+		BeforeEach(func() {
+			guid = "some-guid"
+
+			fakeCloudControllerClient.GetApplicationsStub = func(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				if guidOf(query) == "app-guid" {
+					return []resources.Application{{GUID: "app-guid", Name: "some-app", SpaceGUID: "space-guid"}}, ccv3.Warnings{"get-apps-warning"}, nil
+				}
+				return nil, ccv3.Warnings{"get-apps-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSpacesStub = func(query ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+				if guidOf(query) == "space-guid" {
+					return []resources.Space{{
+						GUID: "space-guid",
+						Name: "some-space",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid"},
+						},
+					}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil
+				}
+				return nil, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil
+			}
+			fakeCloudControllerClient.GetOrganizationStub = func(orgGUID string) (resources.Organization, ccv3.Warnings, error) {
+				if orgGUID == "org-guid" {
+					return resources.Organization{GUID: "org-guid", Name: "some-org"}, ccv3.Warnings{"get-org-warning"}, nil
+				}
+				return resources.Organization{}, ccv3.Warnings{"get-org-warning"}, ccerror.ResourceNotFoundError{}
+			}
+			fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			fakeCloudControllerClient.GetRouteReturns(resources.Route{}, nil, ccerror.ResourceNotFoundError{})
+			fakeCloudControllerClient.GetTaskReturns(resources.Task{}, nil, ccerror.ResourceNotFoundError{})
+			fakeCloudControllerClient.GetBuildReturns(resources.Build{}, nil, ccerror.ResourceNotFoundError{})
+		})
+
+		JustBeforeEach(func() {
+			results, warnings, executeErr = actor.LookupGUID(guid)
+		})
+
+		It("requests the GUID from every likely endpoint", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetOrganizationArgsForCall(0)).To(Equal("some-guid"))
+			Expect(fakeCloudControllerClient.GetRouteArgsForCall(0)).To(Equal("some-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetTaskArgsForCall(0)).To(Equal("some-guid"))
+			Expect(fakeCloudControllerClient.GetBuildArgsForCall(0)).To(Equal("some-guid"))
+		})
+
+		When("nothing has the GUID", func() {
+			It("returns no results and the warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				Expect(warnings).To(ContainElements("get-apps-warning", "get-spaces-warning", "get-org-warning"))
+			})
+		})
+
+		When("the GUID is a task", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(
+					resources.Task{
+						GUID: "some-guid",
+						Name: "some-task",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeApplication: resources.Relationship{GUID: "app-guid"},
+						},
+					},
+					ccv3.Warnings{"get-task-warning"},
+					nil,
+				)
+			})
+
+			It("returns the task with its app, space and org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(results).To(Equal([]GUIDLookupResult{{
+					Type:      GUIDResourceTask,
+					GUID:      "some-guid",
+					Name:      "some-task",
+					AppName:   "some-app",
+					SpaceName: "some-space",
+					OrgName:   "some-org",
+				}}))
+				Expect(warnings).To(ContainElement("get-task-warning"))
+			})
+		})
+
+		When("the GUID is a space", func() {
+			BeforeEach(func() {
+				guid = "space-guid"
+			})
+
+			It("returns the space with its org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(results).To(Equal([]GUIDLookupResult{{
+					Type:    GUIDResourceSpace,
+					GUID:    "space-guid",
+					Name:    "some-space",
+					OrgName: "some-org",
+				}}))
+			})
+		})
+
+		When("the GUID is a route in a space the user cannot see", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteReturns(
+					resources.Route{GUID: "some-guid", URL: "some-host.example.com", SpaceGUID: "hidden-space-guid"},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns the route without its parents", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(results).To(Equal([]GUIDLookupResult{{
+					Type: GUIDResourceRoute,
+					GUID: "some-guid",
+					Name: "some-host.example.com",
+				}}))
+			})
+		})
+
+		When("an endpoint does not let the user see the GUID", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturns(resources.Build{}, ccv3.Warnings{"get-build-warning"}, ccerror.ForbiddenError{})
+			})
+
+			It("skips that endpoint", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+				Expect(warnings).To(ContainElement("get-build-warning"))
+			})
+		})
+
+		When("an endpoint fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturns(resources.Build{}, ccv3.Warnings{"get-build-warning"}, errors.New("get-build-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-build-error"))
+				Expect(warnings).To(ContainElements("get-apps-warning", "get-build-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+)
+
+// InternalRouteProbeTimeout is the number of seconds the probe of an internal
+// route waits for a connection to the destination app.
+const InternalRouteProbeTimeout = 3
+
+// InternalRoute is an app mapped to a route of an internal domain, which the
+// container network resolves for other apps, e.g. backend.apps.internal.
+type InternalRoute struct {
+	AppName  string
+	AppGUID  string
+	Hostname string
+	Port     int
+}
+
+// InternalRouteCheck is the result of probing an internal route from a source
+// app. PolicyPort is the port that the network policies of the source app
+// allow on the destination app, or 0 when they do not allow the route port.
+type InternalRouteCheck struct {
+	InternalRoute
+	Resolved   bool
+	PolicyPort int
+	Reachable  bool
+}
+
+// GetInternalRoutesBySpace returns the apps of the space that are mapped to a
+// route of an internal domain of the org. Each destination of a route is
+// returned as its own internal route.
+func (actor Actor) GetInternalRoutesBySpace(spaceGUID string, orgGUID string) ([]InternalRoute, Warnings, error) {
+	domains, allWarnings, err := actor.GetOrganizationDomains(orgGUID, "")
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var internalDomainGUIDs []string
+	for _, domain := range domains {
+		if domain.Internal.IsSet && domain.Internal.Value {
+			internalDomainGUIDs = append(internalDomainGUIDs, domain.GUID)
+		}
+	}
+	if len(internalDomainGUIDs) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: internalDomainGUIDs},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var appGUIDs []string
+	for _, route := range routes {
+		for _, destination := range route.Destinations {
+			appGUIDs = append(appGUIDs, destination.App.GUID)
+		}
+	}
+	if len(appGUIDs) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	apps, appWarnings, err := actor.GetApplicationsByGUIDs(appGUIDs)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	appNamesByGUID := lookuptable.NameFromGUID(apps)
+
+	var internalRoutes []InternalRoute
+	for _, route := range routes {
+		for _, destination := range route.Destinations {
+			internalRoutes = append(internalRoutes, InternalRoute{
+				AppName:  appNamesByGUID[destination.App.GUID],
+				AppGUID:  destination.App.GUID,
+				Hostname: route.URL,
+				Port:     destination.Port,
+			})
+		}
+	}
+
+	sort.Slice(internalRoutes, func(i, j int) bool {
+		if internalRoutes[i].AppName != internalRoutes[j].AppName {
+			return internalRoutes[i].AppName < internalRoutes[j].AppName
+		}
+		return internalRoutes[i].Hostname < internalRoutes[j].Hostname
+	})
+
+	return internalRoutes, allWarnings, nil
+}
+
+// InternalRouteProbeCommand returns the shell command that checks from inside
+// a source app instance whether the hostnames of the checks resolve and, when
+// a policy allows it, whether their policy port accepts connections. Each
+// check prints "INDEX resolved|unresolved" and "INDEX reachable|unreachable"
+// lines that ParseInternalRouteProbe reads.
+func InternalRouteProbeCommand(checks []InternalRouteCheck) string {
+	var probes []string
+	for i, check := range checks {
+		probes = append(probes, fmt.Sprintf(
+			"if getent hosts %[2]s >/dev/null 2>&1; then echo '%[1]d resolved'; else echo '%[1]d unresolved'; fi",
+			i, check.Hostname,
+		))
+		if check.PolicyPort != 0 {
+			probes = append(probes, fmt.Sprintf(
+				"if timeout %[4]d bash -c '</dev/tcp/%[2]s/%[3]d' >/dev/null 2>&1; then echo '%[1]d reachable'; else echo '%[1]d unreachable'; fi",
+				i, check.Hostname, check.PolicyPort, InternalRouteProbeTimeout,
+			))
+		}
+	}
+	return strings.Join(probes, "; ")
+}
+
+// ParseInternalRouteProbe sets the results of the output of the command of
+// InternalRouteProbeCommand on the checks. Lines it does not recognize are
+// ignored, so checks without results stay unresolved and unreachable.
+func ParseInternalRouteProbe(checks []InternalRouteCheck, output string) []InternalRouteCheck {
+	results := make([]InternalRouteCheck, len(checks))
+	copy(results, checks)
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		i, err := strconv.Atoi(fields[0])
+		if err != nil || i < 0 || i >= len(results) {
+			continue
+		}
+
+		switch fields[1] {
+		case "resolved":
+			results[i].Resolved = true
+		case "reachable":
+			results[i].Reachable = true
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,126 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Internal Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetInternalRoutesBySpace", func() {
+		var (
+			internalRoutes []InternalRoute
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationDomainsReturns([]resources.Domain{
+				{GUID: "shared-guid", Name: "example.com"},
+				{GUID: "internal-guid", Name: "apps.internal", Internal: types.NullBool{IsSet: true, Value: true}},
+			}, ccv3.Warnings{"get-domains-warning"}, nil)
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{
+				{GUID: "route-1", URL: "backend.apps.internal", Destinations: []resources.RouteDestination{
+					{App: resources.RouteDestinationApp{GUID: "backend-guid"}, Port: 8080},
+				}},
+				{GUID: "route-2", URL: "api.apps.internal", Destinations: []resources.RouteDestination{
+					{App: resources.RouteDestinationApp{GUID: "frontend-guid"}, Port: 9000},
+					{App: resources.RouteDestinationApp{GUID: "backend-guid"}, Port: 8080},
+				}},
+				{GUID: "route-3", URL: "unmapped.apps.internal"},
+			}, ccv3.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+				{GUID: "backend-guid", Name: "backend"},
+				{GUID: "frontend-guid", Name: "frontend"},
+			}, ccv3.Warnings{"get-apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			internalRoutes, warnings, executeErr = actor.GetInternalRoutesBySpace("space-guid", "org-guid")
+		})
+
+		It("returns the destinations of the routes of the internal domains", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning", "get-apps-warning"))
+
+			orgGUID, _ := fakeCloudControllerClient.GetOrganizationDomainsArgsForCall(0)
+			Expect(orgGUID).To(Equal("org-guid"))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"internal-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(internalRoutes).To(Equal([]InternalRoute{
+				{AppName: "backend", AppGUID: "backend-guid", Hostname: "api.apps.internal", Port: 8080},
+				{AppName: "backend", AppGUID: "backend-guid", Hostname: "backend.apps.internal", Port: 8080},
+				{AppName: "frontend", AppGUID: "frontend-guid", Hostname: "api.apps.internal", Port: 9000},
+			}))
+		})
+
+		When("the org has no internal domains", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDomainsReturns([]resources.Domain{{GUID: "shared-guid"}}, nil, nil)
+			})
+
+			It("returns no internal routes without listing routes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(internalRoutes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("routes failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("routes failed"))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("InternalRouteProbeCommand and ParseInternalRouteProbe", func() {
+		var checks []InternalRouteCheck
+
+		BeforeEach(func() {
+			checks = []InternalRouteCheck{
+				{InternalRoute: InternalRoute{AppName: "backend", Hostname: "backend.apps.internal", Port: 8080}, PolicyPort: 8080},
+				{InternalRoute: InternalRoute{AppName: "billing", Hostname: "billing.apps.internal", Port: 9000}},
+			}
+		})
+
+		It("resolves every hostname and connects only to the ports that policies allow", func() {
+			Expect(InternalRouteProbeCommand(checks)).To(Equal(
+				"if getent hosts backend.apps.internal >/dev/null 2>&1; then echo '0 resolved'; else echo '0 unresolved'; fi; " +
+					"if timeout 3 bash -c '</dev/tcp/backend.apps.internal/8080' >/dev/null 2>&1; then echo '0 reachable'; else echo '0 unreachable'; fi; " +
+					"if getent hosts billing.apps.internal >/dev/null 2>&1; then echo '1 resolved'; else echo '1 unresolved'; fi",
+			))
+		})
+
+		It("sets the results of the probe output on the checks", func() {
+			results := ParseInternalRouteProbe(checks, "0 resolved\n0 unreachable\nbash: warning\n1 resolved\n7 reachable\n")
+			Expect(results[0].Resolved).To(BeTrue())
+			Expect(results[0].Reachable).To(BeFalse())
+			Expect(results[1].Resolved).To(BeTrue())
+			Expect(checks[0].Resolved).To(BeFalse())
+		})
+	})
+})
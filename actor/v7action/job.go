@@ -1,6 +1,11 @@
 package v7action
 
 import (
+	"net/url"
+	"path"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
@@ -14,6 +19,17 @@ const (
 	JobProcessing = JobState(constant.JobProcessing)
 )
 
+// Job is an operation that the Cloud Controller runs in the background.
+type Job struct {
+	GUID      string
+	Operation string
+	State     JobState
+	// Errors are the reasons the job failed.
+	Errors    []error
+	CreatedAt string
+	UpdatedAt string
+}
+
 type PollJobEvent struct {
 	State    JobState
 	Err      error
@@ -46,3 +62,49 @@ func (actor Actor) PollJobToEventStream(jobURL ccv3.JobURL) chan PollJobEvent {
 
 	return output
 }
+
+// GetJob returns the job with the given GUID.
+func (actor Actor) GetJob(jobGUID string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJobByGUID(jobGUID)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return Job{}, Warnings(warnings), actionerror.JobNotFoundError{GUID: jobGUID}
+		}
+		return Job{}, Warnings(warnings), err
+	}
+
+	return Job{
+		GUID:      job.GUID,
+		Operation: job.Operation,
+		State:     JobState(job.State),
+		Errors:    job.Errors(),
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}, Warnings(warnings), nil
+}
+
+// WaitForJob polls the job with the given GUID until it has finished. It
+// returns the error the job failed with, if any.
+func (actor Actor) WaitForJob(jobGUID string) (Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJobByGUID(jobGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return allWarnings, actionerror.JobNotFoundError{GUID: jobGUID}
+		}
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(ccv3.JobURL(job.Links["self"].HREF))
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// jobGUID returns the GUID of the job that the job URL points to.
+func jobGUID(jobURL ccv3.JobURL) string {
+	parsedURL, err := url.Parse(string(jobURL))
+	if err != nil {
+		return path.Base(string(jobURL))
+	}
+	return path.Base(parsedURL.Path)
+}
@@ -3,11 +3,13 @@ package v7action_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -130,4 +132,113 @@ var _ = Describe("Job Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetJob", func() {
+		var (
+			job      Job
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetJobByGUIDReturns(
+				ccv3.Job{
+					GUID:      "some-job-guid",
+					Operation: "space.delete",
+					State:     constant.JobFailed,
+					RawErrors: []ccv3.JobErrorDetails{{Code: 10008, Detail: "space is busy", Title: "CF-UnprocessableEntity"}},
+					CreatedAt: "2026-10-01T00:00:00Z",
+					UpdatedAt: "2026-10-01T00:01:00Z",
+				},
+				ccv3.Warnings{"get-job-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			job, warnings, err = actor.GetJob("some-job-guid")
+		})
+
+		It("returns the job", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-job-warning"))
+			Expect(fakeCloudControllerClient.GetJobByGUIDArgsForCall(0)).To(Equal("some-job-guid"))
+			Expect(job).To(Equal(Job{
+				GUID:      "some-job-guid",
+				Operation: "space.delete",
+				State:     JobFailed,
+				Errors: []error{ccerror.V3JobFailedError{
+					JobGUID: "some-job-guid",
+					Code:    10008,
+					Detail:  "space is busy",
+					Title:   "CF-UnprocessableEntity",
+				}},
+				CreatedAt: "2026-10-01T00:00:00Z",
+				UpdatedAt: "2026-10-01T00:01:00Z",
+			}))
+		})
+
+		When("the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobByGUIDReturns(ccv3.Job{}, ccv3.Warnings{"get-job-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a JobNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+	})
+
+	Describe("WaitForJob", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetJobByGUIDReturns(
+				ccv3.Job{
+					GUID:  "some-job-guid",
+					State: constant.JobProcessing,
+					Links: resources.APILinks{"self": {HREF: "https://api.example.com/v3/jobs/some-job-guid"}},
+				},
+				ccv3.Warnings{"get-job-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.WaitForJob("some-job-guid")
+		})
+
+		It("polls the job until it has finished", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-job-warning", "poll-warning"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("https://api.example.com/v3/jobs/some-job-guid")))
+		})
+
+		When("the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, errors.New("job-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("job-error"))
+				Expect(warnings).To(ConsistOf("get-job-warning", "poll-warning"))
+			})
+		})
+
+		When("the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobByGUIDReturns(ccv3.Job{}, nil, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a JobNotFoundError without polling", func() {
+				Expect(err).To(MatchError(actionerror.JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
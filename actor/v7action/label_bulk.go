@@ -0,0 +1,116 @@
+package v7action
+
+import (
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// bulkMetadataConcurrency is the number of metadata updates that
+// UpdateLabelsBySelector sends at a time.
+const bulkMetadataConcurrency = 4
+
+// BulkLabelUpdate is a resource that UpdateLabelsBySelector updated the
+// labels of, with the error when updating them failed.
+type BulkLabelUpdate struct {
+	ResourceName string
+	Err          error
+}
+
+type namedResource struct {
+	name string
+	guid string
+}
+
+// UpdateLabelsBySelector updates the labels of every resource of the type
+// that matches the label selector, or of every resource when the selector is
+// empty. Apps, routes and service instances are looked up in the space of
+// scopeGUID and spaces in the org of scopeGUID. The updates are sent
+// concurrently and the failure of one does not stop the others, so the error
+// of each resource is returned with it, ordered by resource name.
+func (actor *Actor) UpdateLabelsBySelector(resourceType string, scopeGUID string, labelSelector string, labels map[string]types.NullString) ([]BulkLabelUpdate, Warnings, error) {
+	targets, warnings, err := actor.listResourcesBySelector(resourceType, scopeGUID, labelSelector)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	updates := make([]BulkLabelUpdate, len(targets))
+	updateWarnings := make([]Warnings, len(targets))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < bulkMetadataConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				updates[index].ResourceName = targets[index].name
+				updateWarnings[index], updates[index].Err = actor.updateResourceMetadata(resourceType, targets[index].guid, resources.Metadata{Labels: labels}, nil)
+			}
+		}()
+	}
+	for index := range targets {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, warnings := range updateWarnings {
+		allWarnings = append(allWarnings, warnings...)
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].ResourceName < updates[j].ResourceName
+	})
+
+	return updates, allWarnings, nil
+}
+
+func (actor *Actor) listResourcesBySelector(resourceType string, scopeGUID string, labelSelector string) ([]namedResource, ccv3.Warnings, error) {
+	scopeKey := ccv3.SpaceGUIDFilter
+	if resourceType == "space" {
+		scopeKey = ccv3.OrganizationGUIDFilter
+	}
+
+	queries := []ccv3.Query{
+		{Key: scopeKey, Values: []string{scopeGUID}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if labelSelector != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	var targets []namedResource
+	switch resourceType {
+	case "app":
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(queries...)
+		for _, app := range apps {
+			targets = append(targets, namedResource{name: app.Name, guid: app.GUID})
+		}
+		return targets, warnings, err
+	case "route":
+		routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries...)
+		for _, route := range routes {
+			targets = append(targets, namedResource{name: route.URL, guid: route.GUID})
+		}
+		return targets, warnings, err
+	case "service-instance":
+		serviceInstances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(queries...)
+		for _, serviceInstance := range serviceInstances {
+			targets = append(targets, namedResource{name: serviceInstance.Name, guid: serviceInstance.GUID})
+		}
+		return targets, warnings, err
+	case "space":
+		spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(queries...)
+		for _, space := range spaces {
+			targets = append(targets, namedResource{name: space.Name, guid: space.GUID})
+		}
+		return targets, warnings, err
+	}
+
+	return nil, nil, nil
+}
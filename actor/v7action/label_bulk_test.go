@@ -0,0 +1,154 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bulk labels", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		resourceType              string
+		labelSelector             string
+		labels                    map[string]types.NullString
+		updates                   []BulkLabelUpdate
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		resourceType = "app"
+		labelSelector = "env=dev"
+		labels = map[string]types.NullString{"team": types.NewNullString("payments")}
+	})
+
+	Describe("UpdateLabelsBySelector", func() {
+		JustBeforeEach(func() {
+			updates, warnings, executeErr = actor.UpdateLabelsBySelector(resourceType, "some-scope-guid", labelSelector, labels)
+		})
+
+		When("updating apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{
+						{Name: "web", GUID: "web-guid"},
+						{Name: "api", GUID: "api-guid"},
+						{Name: "worker", GUID: "worker-guid"},
+					},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataStub = func(resource string, guid string, metadata resources.Metadata) (ccv3.JobURL, ccv3.Warnings, error) {
+					if guid == "worker-guid" {
+						return "", ccv3.Warnings{"update-warning-" + guid}, errors.New("forbidden")
+					}
+					return "", ccv3.Warnings{"update-warning-" + guid}, nil
+				}
+			})
+
+			It("lists the apps of the space that match the selector", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-scope-guid"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+				))
+			})
+
+			It("updates the labels of every app", func() {
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(3))
+				var guids []string
+				for i := 0; i < 3; i++ {
+					resource, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(i)
+					Expect(resource).To(Equal("app"))
+					Expect(metadata).To(Equal(resources.Metadata{Labels: labels}))
+					guids = append(guids, guid)
+				}
+				Expect(guids).To(ConsistOf("web-guid", "api-guid", "worker-guid"))
+			})
+
+			It("returns the result of each update ordered by name and all the warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(updates).To(Equal([]BulkLabelUpdate{
+					{ResourceName: "api"},
+					{ResourceName: "web"},
+					{ResourceName: "worker", Err: errors.New("forbidden")},
+				}))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "update-warning-web-guid", "update-warning-api-guid", "update-warning-worker-guid"))
+			})
+		})
+
+		When("no selector is given", func() {
+			BeforeEach(func() {
+				labelSelector = ""
+			})
+
+			It("lists every app of the space", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-scope-guid"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		When("updating routes", func() {
+			BeforeEach(func() {
+				resourceType = "route"
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "route-guid", URL: "web.example.com"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("names the routes by URL", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(updates).To(Equal([]BulkLabelUpdate{{ResourceName: "web.example.com"}}))
+				resource, guid, _ := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resource).To(Equal("route"))
+				Expect(guid).To(Equal("route-guid"))
+			})
+		})
+
+		When("updating spaces", func() {
+			BeforeEach(func() {
+				resourceType = "space"
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{Name: "dev", GUID: "dev-guid"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("lists the spaces of the org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-scope-guid"}},
+				))
+				Expect(updates).To(Equal([]BulkLabelUpdate{{ResourceName: "dev"}}))
+			})
+		})
+
+		When("listing the resources fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, errors.New("list failed"))
+			})
+
+			It("returns the error and warnings without updating anything", func() {
+				Expect(executeErr).To(MatchError("list failed"))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
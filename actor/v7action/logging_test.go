@@ -99,6 +99,19 @@ var _ = Describe("Logging Actions", func() {
 					Expect(messages[1].SourceType()).To(Equal("some-source-type"))
 					Expect(messages[1].SourceInstance()).To(Equal("some-source-instance"))
 				})
+
+				It("only returns the logs that match the filter", func() {
+					messages, warnings, err := actor.GetFilteredRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{
+						Since: time.Unix(0, 15),
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+					_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+					Expect(start).To(Equal(time.Unix(0, 15)))
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-2"))
+				})
 			})
 
 			When("Log Cache errors", func() {
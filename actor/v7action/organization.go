@@ -2,6 +2,7 @@ package v7action
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
 )
@@ -25,6 +26,9 @@ func (actor Actor) GetOrganizations(labelSelector string) ([]resources.Organizat
 func (actor Actor) GetOrganizationByGUID(orgGUID string) (resources.Organization, Warnings, error) {
 	ccOrg, warnings, err := actor.CloudControllerClient.GetOrganization(orgGUID)
 	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return resources.Organization{}, Warnings(warnings), actionerror.OrganizationNotFoundError{GUID: orgGUID}
+		}
 		return resources.Organization{}, Warnings(warnings), err
 	}
 
@@ -88,24 +92,41 @@ func (actor Actor) RenameOrganization(oldOrgName, newOrgName string) (resources.
 }
 
 func (actor Actor) DeleteOrganization(name string) (Warnings, error) {
+	jobURL, allWarnings, err := actor.startDeleteOrganization(name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, Warnings(ccWarnings)...)
+
+	return allWarnings, err
+}
+
+// StartDeleteOrganization starts deleting the organization with the given
+// name and returns the GUID of the job deleting it without waiting for it.
+func (actor Actor) StartDeleteOrganization(name string) (string, Warnings, error) {
+	jobURL, warnings, err := actor.startDeleteOrganization(name)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	return jobGUID(jobURL), warnings, nil
+}
+
+func (actor Actor) startDeleteOrganization(name string) (ccv3.JobURL, Warnings, error) {
 	var allWarnings Warnings
 
 	org, warnings, err := actor.GetOrganizationByName(name)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return "", allWarnings, err
 	}
 
 	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
 	allWarnings = append(allWarnings, Warnings(deleteWarnings)...)
-	if err != nil {
-		return allWarnings, err
-	}
 
-	ccWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
-	allWarnings = append(allWarnings, Warnings(ccWarnings)...)
-
-	return allWarnings, err
+	return jobURL, allWarnings, err
 }
 
 func (actor Actor) GetDefaultDomain(orgGUID string) (resources.Domain, Warnings, error) {
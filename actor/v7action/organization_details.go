@@ -0,0 +1,109 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// OrganizationDetails is an organization along with the name of its quota and
+// the number of apps and service instances in it.
+type OrganizationDetails struct {
+	resources.Organization
+	QuotaName            string
+	AppCount             int
+	ServiceInstanceCount int
+}
+
+// GetOrganizationsWithDetails returns the organizations matching the label
+// selector along with their quota names and app and service instance counts.
+func (actor Actor) GetOrganizationsWithDetails(labelSelector string) ([]OrganizationDetails, Warnings, error) {
+	orgs, warnings, err := actor.GetOrganizations(labelSelector)
+	if err != nil || len(orgs) == 0 {
+		return nil, warnings, err
+	}
+
+	var (
+		quotas           []resources.OrganizationQuota
+		spaces           []resources.Space
+		apps             []resources.Application
+		serviceInstances []resources.ServiceInstance
+	)
+
+	orgGUIDs := make([]string, len(orgs))
+	for i, org := range orgs {
+		orgGUIDs[i] = org.GUID
+	}
+
+	ccWarnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			quotas, warnings, err = actor.CloudControllerClient.GetOrganizationQuotas()
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(orgGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				spaces = append(spaces, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(orgGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetApplications(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				apps = append(apps, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(orgGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				serviceInstances = append(serviceInstances, batch...)
+				return warnings, err
+			})
+		},
+	)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	quotaNames := lookuptable.NameFromGUID(quotas)
+	spacesByGUID := lookuptable.SpaceFromGUID(spaces)
+	orgGUIDOfSpace := func(spaceGUID string) string {
+		return spacesByGUID[spaceGUID].Relationships[constant.RelationshipTypeOrganization].GUID
+	}
+
+	appCounts := map[string]int{}
+	for _, app := range apps {
+		appCounts[orgGUIDOfSpace(app.SpaceGUID)]++
+	}
+
+	serviceInstanceCounts := map[string]int{}
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceCounts[orgGUIDOfSpace(serviceInstance.SpaceGUID)]++
+	}
+
+	details := make([]OrganizationDetails, len(orgs))
+	for i, org := range orgs {
+		details[i] = OrganizationDetails{
+			Organization:         org,
+			QuotaName:            quotaNames[org.QuotaGUID],
+			AppCount:             appCounts[org.GUID],
+			ServiceInstanceCount: serviceInstanceCounts[org.GUID],
+		}
+	}
+
+	return details, warnings, nil
+}
@@ -0,0 +1,126 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization Details Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationsWithDetails", func() {
+		var (
+			details    []OrganizationDetails
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{
+					{GUID: "org-1-guid", Name: "org-1", QuotaGUID: "quota-1-guid", CreatedAt: "2020-01-01T00:00:00Z"},
+					{GUID: "org-2-guid", Name: "org-2", QuotaGUID: "quota-2-guid"},
+				},
+				ccv3.Warnings{"get-orgs-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]resources.OrganizationQuota{
+					{Quota: resources.Quota{GUID: "quota-1-guid", Name: "default"}},
+					{Quota: resources.Quota{GUID: "quota-2-guid", Name: "large"}},
+				},
+				ccv3.Warnings{"get-quotas-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "space-1-guid", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-1-guid"}}},
+					{GUID: "space-2-guid", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-2-guid"}}},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{SpaceGUID: "space-1-guid"}, {SpaceGUID: "space-1-guid"}, {SpaceGUID: "space-2-guid"}},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{SpaceGUID: "space-2-guid"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-service-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = actor.GetOrganizationsWithDetails("env=prod")
+		})
+
+		It("returns the orgs with their quota names and counts", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-orgs-warning", "get-quotas-warning", "get-spaces-warning", "get-apps-warning", "get-service-instances-warning"))
+			Expect(details).To(Equal([]OrganizationDetails{
+				{
+					Organization: resources.Organization{GUID: "org-1-guid", Name: "org-1", QuotaGUID: "quota-1-guid", CreatedAt: "2020-01-01T00:00:00Z"},
+					QuotaName:    "default",
+					AppCount:     2,
+				},
+				{
+					Organization:         resources.Organization{GUID: "org-2-guid", Name: "org-2", QuotaGUID: "quota-2-guid"},
+					QuotaName:            "large",
+					AppCount:             1,
+					ServiceInstanceCount: 1,
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-1-guid", "org-2-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		When("there are no orgs", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-orgs-warning"}, nil)
+			})
+
+			It("does not look up the details", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(details).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("get-orgs-warning", "get-quotas-warning", "get-spaces-warning", "get-apps-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -35,6 +35,11 @@ func (actor Actor) ApplyOrganizationQuotaByName(quotaName string, orgGUID string
 
 // CreateOrganization creates a new organization with the given name
 func (actor Actor) CreateOrganizationQuota(name string, limits QuotaLimits) (Warnings, error) {
+	err := actor.requireQuotaCapabilities(limits)
+	if err != nil {
+		return nil, err
+	}
+
 	orgQuota := createQuotaStruct(name, limits)
 	setZeroDefaultsForQuotaCreation(&orgQuota.Apps, &orgQuota.Routes, &orgQuota.Services)
 	convertUnlimitedToNil(&orgQuota.Apps, &orgQuota.Routes, &orgQuota.Services)
@@ -95,6 +100,11 @@ func (actor Actor) GetOrganizationQuotaByName(orgQuotaName string) (resources.Or
 func (actor Actor) UpdateOrganizationQuota(quotaName string, newName string, limits QuotaLimits) (Warnings, error) {
 	var allWarnings Warnings
 
+	err := actor.requireQuotaCapabilities(limits)
+	if err != nil {
+		return nil, err
+	}
+
 	quota, warnings, err := actor.GetOrganizationQuotaByName(quotaName)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -180,3 +190,12 @@ func convertUnlimitedToNil(apps *resources.AppLimit, routes *resources.RouteLimi
 		}
 	}
 }
+
+// requireQuotaCapabilities checks that the targeted API supports the limits
+// that are being set.
+func (actor Actor) requireQuotaCapabilities(limits QuotaLimits) error {
+	if limits.TotalLogVolume != nil && limits.TotalLogVolume.IsSet {
+		return actor.RequireCapability(CapabilityLogRateLimits)
+	}
+	return nil
+}
@@ -18,14 +18,20 @@ type OrganizationSummary struct {
 }
 
 func (actor Actor) GetOrganizationSummaryByName(orgName string) (OrganizationSummary, Warnings, error) {
-	var allWarnings Warnings
-
-	org, warnings, err := actor.GetOrganizationByName(orgName)
-	allWarnings = append(allWarnings, warnings...)
+	org, orgWarnings, err := actor.GetOrganizationByName(orgName)
 	if err != nil {
-		return OrganizationSummary{}, allWarnings, err
+		return OrganizationSummary{}, orgWarnings, err
 	}
 
+	summary, warnings, err := actor.GetOrganizationSummaryForOrg(org)
+	return summary, append(orgWarnings, warnings...), err
+}
+
+// GetOrganizationSummaryForOrg returns the summary of an organization that
+// has already been fetched.
+func (actor Actor) GetOrganizationSummaryForOrg(org resources.Organization) (OrganizationSummary, Warnings, error) {
+	var allWarnings Warnings
+
 	domains, warnings, err := actor.GetOrganizationDomains(org.GUID, "")
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -28,11 +28,11 @@ var _ = Describe("Organization Summary Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, clock.NewClock())
 	})
 
-	JustBeforeEach(func() {
-		orgSummary, warnings, err = actor.GetOrganizationSummaryByName("some-org")
-	})
-
 	Describe("GetOrganizationSummaryByName", func() {
+		JustBeforeEach(func() {
+			orgSummary, warnings, err = actor.GetOrganizationSummaryByName("some-org")
+		})
+
 		When("no errors are encountered", func() {
 			BeforeEach(func() {
 				fakeCloudControllerClient.GetOrganizationsReturns(
@@ -204,4 +204,29 @@ var _ = Describe("Organization Summary Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationSummaryForOrg", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				resources.OrganizationQuota{Quota: resources.Quota{Name: "my-quota"}},
+				ccv3.Warnings{"get-quota-warning"}, nil)
+		})
+
+		It("summarizes the org without looking it up again", func() {
+			orgSummary, warnings, err = actor.GetOrganizationSummaryForOrg(resources.Organization{
+				GUID:      "some-org-guid",
+				Name:      "some-org",
+				QuotaGUID: "org-quota-guid",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-quota-warning"))
+			Expect(orgSummary.Name).To(Equal("some-org"))
+			Expect(orgSummary.QuotaName).To(Equal("my-quota"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("org-quota-guid"))
+			orgGUID, _ := fakeCloudControllerClient.GetOrganizationDomainsArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
 })
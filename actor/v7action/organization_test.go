@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
 
@@ -162,6 +163,22 @@ var _ = Describe("Organization Actions", func() {
 			})
 		})
 
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationReturns(
+					resources.Organization{},
+					ccv3.Warnings{"some-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns an OrganizationNotFoundError with the GUID and the warnings", func() {
+				_, warnings, err := actor.GetOrganizationByGUID("some-org-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{GUID: "some-org-guid"}))
+			})
+		})
+
 		When("the cloud controller client returns an error", func() {
 			var expectedError error
 
@@ -453,6 +470,51 @@ var _ = Describe("Organization Actions", func() {
 		})
 	})
 
+	Describe("StartDeleteOrganization", func() {
+		var (
+			jobGUID  string
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+				ccv3.Warnings{"get-org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteOrganizationReturns(
+				"https://api.example.com/v3/jobs/some-job-guid",
+				ccv3.Warnings{"delete-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			jobGUID, warnings, err = actor.StartDeleteOrganization("some-org")
+		})
+
+		It("starts deleting the org and returns the GUID of the job without polling it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobGUID).To(Equal("some-job-guid"))
+			Expect(warnings).To(ConsistOf("get-org-warning", "delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+		})
+
+		When("the org is not found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+	})
+
 	Describe("RenameOrganization", func() {
 		var (
 			oldOrgName = "old-and-stale-org-name"
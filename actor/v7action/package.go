@@ -149,6 +149,31 @@ func (actor Actor) GetNewestReadyPackageForApplication(app resources.Application
 	return resources.Package(ccv3Packages[0]), Warnings(warnings), nil
 }
 
+// GetReadyPackageForApplication returns the package of the app with the given
+// GUID, provided that it is ready to be staged.
+func (actor Actor) GetReadyPackageForApplication(app resources.Application, packageGUID string) (resources.Package, Warnings, error) {
+	ccv3Packages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{packageGUID}},
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+	)
+	if err != nil {
+		return resources.Package{}, Warnings(warnings), err
+	}
+
+	if len(ccv3Packages) == 0 {
+		return resources.Package{}, Warnings(warnings), actionerror.PackageNotFoundInAppError{GUID: packageGUID, AppName: app.Name}
+	}
+
+	pkg := resources.Package(ccv3Packages[0])
+	if pkg.State != constant.PackageReady {
+		return resources.Package{}, Warnings(warnings), actionerror.PackageNotReadyError{GUID: pkg.GUID, State: string(pkg.State)}
+	}
+
+	return pkg, Warnings(warnings), nil
+}
+
 // GetApplicationPackages returns a list of package of an app.
 func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]resources.Package, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
@@ -248,6 +248,99 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("GetReadyPackageForApplication", func() {
+		var (
+			app        resources.Application
+			pkg        resources.Package
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = resources.Application{
+				GUID: "some-app-guid",
+				Name: "some-app",
+			}
+		})
+
+		JustBeforeEach(func() {
+			pkg, warnings, executeErr = actor.GetReadyPackageForApplication(app, "some-package-guid")
+		})
+
+		When("the package is ready", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]resources.Package{
+						{GUID: "some-package-guid", State: constant.PackageReady},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns the package scoped to the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(pkg).To(Equal(resources.Package{GUID: "some-package-guid", State: constant.PackageReady}))
+
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-package-guid"}},
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("the package is not ready", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]resources.Package{
+						{GUID: "some-package-guid", State: constant.PackageFailed},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns a PackageNotReadyError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotReadyError{GUID: "some-package-guid", State: "FAILED"}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+
+		When("the package does not belong to the app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]resources.Package{},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns a PackageNotFoundInAppError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotFoundInAppError{GUID: "some-package-guid", AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+
+		When("getting the packages fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					nil,
+					ccv3.Warnings{"get-packages-warning"},
+					errors.New("get-packages-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-packages-error"))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+	})
+
 	Describe("CreateDockerPackageByApplicationNameAndSpace", func() {
 		var (
 			dockerPackage resources.Package
@@ -24,6 +24,13 @@ func (actor Actor) GetProcessByTypeAndApplication(processType string, appGUID st
 }
 
 func (actor Actor) ScaleProcessByApplication(appGUID string, process resources.Process) (Warnings, error) {
+	if process.LogRateLimitInBPS.IsSet {
+		err := actor.RequireCapability(CapabilityLogRateLimits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	_, warnings, err := actor.CloudControllerClient.CreateApplicationProcessScale(appGUID, resources.Process(process))
 	allWarnings := Warnings(warnings)
 	if err != nil {
@@ -35,6 +35,22 @@ func (p ProcessSummary) HealthyInstanceCount() int {
 	return count
 }
 
+// RoutableInstanceCount returns the number of instances that receive
+// traffic, and whether the Cloud Controller reports it.
+func (p ProcessSummary) RoutableInstanceCount() (int, bool) {
+	count := 0
+	reported := false
+	for _, instance := range p.InstanceDetails {
+		if instance.Routable.IsSet {
+			reported = true
+			if instance.Routable.Value {
+				count++
+			}
+		}
+	}
+	return count, reported
+}
+
 func (ps ProcessSummaries) Sort() {
 	sort.Slice(ps, func(i int, j int) bool {
 		var iScore int
@@ -72,6 +88,19 @@ func (ps ProcessSummaries) String() string {
 	return strings.Join(summaries, ", ")
 }
 
+// GetApplicationProcessSummariesByNameAndSpace returns every process of the
+// app, with its full command, sidecars and instances, web first.
+func (actor Actor) GetApplicationProcessSummariesByNameAndSpace(appName string, spaceGUID string) (ProcessSummaries, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processSummaries, warnings, err := actor.getProcessSummariesForApp(app.GUID, true)
+	allWarnings = append(allWarnings, warnings...)
+	return processSummaries, allWarnings, err
+}
+
 func (actor Actor) getProcessSummariesForApp(appGUID string, withObfuscatedValues bool) (ProcessSummaries, Warnings, error) {
 	log.WithFields(log.Fields{
 		"appGUID":              appGUID,
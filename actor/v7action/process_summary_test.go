@@ -1,9 +1,15 @@
 package v7action_test
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -33,6 +39,86 @@ var _ = Describe("Process Actions", func() {
 				Expect(summary.HealthyInstanceCount()).To(Equal(2))
 			})
 		})
+
+		Describe("RoutableInstanceCount", func() {
+			It("reports that routability is unknown when no instance reports it", func() {
+				_, reported := summary.RoutableInstanceCount()
+				Expect(reported).To(BeFalse())
+			})
+
+			When("the instances report whether they are routable", func() {
+				BeforeEach(func() {
+					summary.InstanceDetails[0].Routable = types.NullBool{IsSet: true, Value: true}
+					summary.InstanceDetails[1].Routable = types.NullBool{IsSet: true, Value: false}
+				})
+
+				It("returns the number of routable instances", func() {
+					count, reported := summary.RoutableInstanceCount()
+					Expect(reported).To(BeTrue())
+					Expect(count).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("GetApplicationProcessSummariesByNameAndSpace", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+			summaries                 ProcessSummaries
+			warnings                  Warnings
+			executeErr                error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "app-guid", Name: "some-app"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{{GUID: "worker-guid", Type: "worker"}, {GUID: "web-guid", Type: "web"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessStub = func(guid string) (resources.Process, ccv3.Warnings, error) {
+				return resources.Process{GUID: guid, Type: strings.TrimSuffix(guid, "-guid"), Command: *types.NewFilteredString("start " + guid)}, nil, nil
+			}
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetApplicationProcessSummariesByNameAndSpace("some-app", "space-guid")
+		})
+
+		It("returns every process of the app with its full command and instances, web first", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-app-warning", "get-processes-warning", "get-instances-warning"))
+
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].Type).To(Equal("web"))
+			Expect(summaries[0].Command.Value).To(Equal("start web-guid"))
+			Expect(summaries[0].InstanceDetails).To(HaveLen(1))
+			Expect(summaries[1].Type).To(Equal("worker"))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an app not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
 	})
 
 	Describe("ProcessSummaries", func() {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/progressbar"
 	"gopkg.in/cheggaaa/pb.v1"
 )
 
@@ -17,12 +18,21 @@ type SimpleProgressBar interface {
 
 type ProgressBar struct {
 	bar *pb.ProgressBar
+
+	// plainOut is where progress lines are written instead of drawing a bar.
+	plainOut io.Writer
 }
 
 func NewProgressBar() *ProgressBar {
 	return &ProgressBar{}
 }
 
+// NewPlainProgressBar returns a ProgressBar that writes timestamped progress
+// lines to out instead of drawing an animated bar.
+func NewPlainProgressBar(out io.Writer) *ProgressBar {
+	return &ProgressBar{plainOut: out}
+}
+
 func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -34,14 +44,21 @@ func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 		return nil, 0, err
 	}
 
+	if p.plainOut != nil {
+		return progressbar.NewPlainReader(p.plainOut, file, fileInfo.Size()), fileInfo.Size(), nil
+	}
+
 	p.bar = pb.New(int(fileInfo.Size())).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
 	p.bar.Start()
 	return p.bar.NewProxyReader(file), fileInfo.Size(), nil
-
 }
 
 func (p *ProgressBar) Terminate() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
@@ -32,6 +32,13 @@ func (actor Actor) CreateOrgRole(roleType constant.RoleType, orgGUID string, use
 }
 
 func (actor Actor) CreateSpaceRole(roleType constant.RoleType, orgGUID string, spaceGUID string, userNameOrGUID string, userOrigin string, isClient bool) (Warnings, error) {
+	if roleType == constant.SpaceSupporterRole {
+		err := actor.RequireCapability(CapabilitySpaceSupporterRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	roleToCreate := resources.Role{
 		Type:      roleType,
 		SpaceGUID: spaceGUID,
@@ -0,0 +1,120 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// RolloutStatus is the progress of a deployment, counted over the instances
+// of the web process it creates.
+type RolloutStatus struct {
+	Deployment resources.Deployment
+	Routable   int
+	Starting   int
+	Failing    int
+	Total      int
+}
+
+// IsFinished returns true once the deployment is no longer active.
+func (status RolloutStatus) IsFinished() bool {
+	return status.Deployment.StatusValue == constant.DeploymentStatusValueFinalized
+}
+
+// GetLatestDeploymentForApp returns the most recent deployment of the app,
+// whether it is still active or not.
+func (actor Actor) GetLatestDeploymentForApp(appGUID string) (resources.Deployment, Warnings, error) {
+	ccDeployments, warnings, err := actor.CloudControllerClient.GetDeployments(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+	)
+	if err != nil {
+		return resources.Deployment{}, Warnings(warnings), err
+	}
+
+	if len(ccDeployments) == 0 {
+		return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{}
+	}
+
+	return ccDeployments[0], Warnings(warnings), nil
+}
+
+// PollRollout reports the status of the deployment every polling interval
+// until it has deployed, returning an error if it is canceled, superseded or
+// does not finish before the timeout. When noWait is true the status is
+// reported once.
+func (actor Actor) PollRollout(app resources.Application, deploymentGUID string, timeout time.Duration, noWait bool, handleStatus func(RolloutStatus)) (Warnings, error) {
+	var allWarnings Warnings
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	timeoutChan := actor.Clock.After(timeout)
+
+	for {
+		select {
+		case <-timeoutChan:
+			return allWarnings, actionerror.RolloutTimeoutError{AppName: app.Name}
+		case <-timer.C():
+			deployment, warnings, err := actor.getDeployment(deploymentGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			status, warnings, err := actor.GetRolloutStatus(deployment)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			handleStatus(status)
+			if noWait || status.IsFinished() {
+				return allWarnings, nil
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+// GetRolloutStatus counts the instances of the web process the deployment
+// creates by whether they are routable, starting or failing.
+func (actor Actor) GetRolloutStatus(deployment resources.Deployment) (RolloutStatus, Warnings, error) {
+	var allWarnings Warnings
+	status := RolloutStatus{Deployment: deployment}
+
+	for _, process := range deployment.NewProcesses {
+		if process.Type != constant.ProcessTypeWeb {
+			continue
+		}
+
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return RolloutStatus{}, allWarnings, err
+		}
+
+		for _, instance := range instances {
+			status.Total++
+			switch instance.State {
+			case constant.ProcessInstanceRunning:
+				if !instance.Routable.IsSet || instance.Routable.Value {
+					status.Routable++
+				} else {
+					status.Starting++
+				}
+			case constant.ProcessInstanceStarting:
+				status.Starting++
+			case constant.ProcessInstanceCrashed, constant.ProcessInstanceDown:
+				status.Failing++
+			}
+		}
+	}
+
+	return status, allWarnings, nil
+}
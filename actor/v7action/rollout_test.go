@@ -0,0 +1,229 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollout Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+	})
+
+	Describe("GetLatestDeploymentForApp", func() {
+		var (
+			deployment resources.Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.GetLatestDeploymentForApp("some-app-guid")
+		})
+
+		It("queries for the newest deployment of the app in any state", func() {
+			Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+				ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+			))
+		})
+
+		When("there is a deployment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]resources.Deployment{{GUID: "some-deployment-guid"}},
+					ccv3.Warnings{"get-deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+				Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		When("the app has no deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, nil)
+			})
+
+			It("returns a DeploymentNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+
+		When("getting the deployments fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, errors.New("get-deployments-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-deployments-error"))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+	})
+
+	Describe("PollRollout", func() {
+		var (
+			app      resources.Application
+			noWait   bool
+			statuses []RolloutStatus
+
+			done       chan bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		activeDeployment := resources.Deployment{
+			GUID:         "some-deployment-guid",
+			State:        constant.DeploymentDeploying,
+			StatusValue:  constant.DeploymentStatusValueActive,
+			NewProcesses: []resources.Process{{GUID: "worker-guid", Type: "worker"}, {GUID: "web-guid", Type: constant.ProcessTypeWeb}},
+		}
+
+		BeforeEach(func() {
+			app = resources.Application{GUID: "some-app-guid", Name: "some-app"}
+			noWait = false
+			statuses = []RolloutStatus{}
+			fakeConfig.PollingIntervalReturns(time.Second)
+
+			fakeCloudControllerClient.GetDeploymentReturns(activeDeployment, ccv3.Warnings{"get-deployment-warning"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{
+					{Index: 0, State: constant.ProcessInstanceRunning, Routable: types.NullBool{IsSet: true, Value: true}},
+					{Index: 1, State: constant.ProcessInstanceRunning},
+					{Index: 2, State: constant.ProcessInstanceRunning, Routable: types.NullBool{IsSet: true, Value: false}},
+					{Index: 3, State: constant.ProcessInstanceStarting},
+					{Index: 4, State: constant.ProcessInstanceCrashed},
+				},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan bool)
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.PollRollout(app, "some-deployment-guid", time.Minute, noWait, func(status RolloutStatus) {
+					statuses = append(statuses, status)
+				})
+				done <- true
+			}()
+		})
+
+		When("the deployment finishes", func() {
+			BeforeEach(func() {
+				deployed := activeDeployment
+				deployed.StatusValue = constant.DeploymentStatusValueFinalized
+				deployed.StatusReason = constant.DeploymentStatusReasonDeployed
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, deployed, ccv3.Warnings{"get-deployment-warning"}, nil)
+			})
+
+			It("reports the instances of the new web process until the deployment is finalized", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-deployment-warning", "get-instances-warning",
+					"get-deployment-warning", "get-instances-warning",
+				))
+
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("web-guid"))
+
+				Expect(statuses).To(HaveLen(2))
+				Expect(statuses[0].IsFinished()).To(BeFalse())
+				Expect(statuses[0].Routable).To(Equal(2))
+				Expect(statuses[0].Starting).To(Equal(2))
+				Expect(statuses[0].Failing).To(Equal(1))
+				Expect(statuses[0].Total).To(Equal(5))
+				Expect(statuses[1].IsFinished()).To(BeTrue())
+			})
+		})
+
+		When("noWait is true", func() {
+			BeforeEach(func() {
+				noWait = true
+			})
+
+			It("reports the status once", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(statuses).To(HaveLen(1))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the deployment is canceled", func() {
+			BeforeEach(func() {
+				canceled := activeDeployment
+				canceled.StatusValue = constant.DeploymentStatusValueFinalized
+				canceled.StatusReason = constant.DeploymentStatusReasonCanceled
+				fakeCloudControllerClient.GetDeploymentReturns(canceled, ccv3.Warnings{"get-deployment-warning"}, nil)
+			})
+
+			It("returns the error and warnings", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("Deployment has been canceled"))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				Expect(statuses).To(BeEmpty())
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("get-instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("get-instances-error"))
+				Expect(warnings).To(ConsistOf("get-deployment-warning", "get-instances-warning"))
+			})
+		})
+
+		When("the deployment does not finish before the timeout", func() {
+			It("returns a RolloutTimeoutError", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.RolloutTimeoutError{AppName: "some-app"}))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -324,6 +325,60 @@ func (actor Actor) DeleteOrphanedRoutes(spaceGUID string) (Warnings, error) {
 	return allWarnings, err
 }
 
+// GetOrphanedRoutes returns the routes that have no destinations. When
+// orphanedFor is non-zero, only routes whose most recent audit event is older
+// than orphanedFor are returned; routes without any audit events are assumed
+// to have been orphaned for longer than the event retention period.
+func (actor Actor) GetOrphanedRoutes(routes []resources.Route, orphanedFor time.Duration) ([]resources.Route, Warnings, error) {
+	var (
+		allWarnings Warnings
+		orphaned    []resources.Route
+	)
+
+	cutoff := actor.Clock.Now().Add(-orphanedFor)
+	for _, route := range routes {
+		if len(route.Destinations) > 0 {
+			continue
+		}
+
+		if orphanedFor > 0 {
+			events, warnings, err := actor.CloudControllerClient.GetEvents(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{route.GUID}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+				ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+			)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			if len(events) > 0 && events[0].CreatedAt.After(cutoff) {
+				continue
+			}
+		}
+
+		orphaned = append(orphaned, route)
+	}
+
+	return orphaned, allWarnings, nil
+}
+
+func (actor Actor) DeleteRouteByGUID(routeGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
 func (actor Actor) DeleteRoute(domainName, hostname, path string, port int) (Warnings, error) {
 	allWarnings := Warnings{}
 	domain, warnings, err := actor.GetDomainByName(domainName)
@@ -397,14 +452,65 @@ func (actor Actor) GetRouteByAttributes(domain resources.Domain, hostname string
 }
 
 func (actor Actor) MapRoute(routeGUID string, appGUID string, destinationProtocol string) (Warnings, error) {
+	if destinationProtocol == "http2" {
+		err := actor.RequireCapability(CapabilityHTTP2Routes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	warnings, err := actor.CloudControllerClient.MapRoute(routeGUID, appGUID, destinationProtocol)
 	return Warnings(warnings), err
 }
 
+// MapRouteToAppPort maps the route to the web process of the app like
+// MapRoute, with the route traffic sent to the given port of its instances
+// instead of the default one.
+func (actor Actor) MapRouteToAppPort(routeGUID string, appGUID string, destinationProtocol string, appPort int) (Warnings, error) {
+	if destinationProtocol == "http2" {
+		err := actor.RequireCapability(CapabilityHTTP2Routes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	destination := resources.RouteDestination{Port: appPort, Protocol: destinationProtocol}
+	destination.App.GUID = appGUID
+
+	warnings, err := actor.CloudControllerClient.MapRouteDestinations(routeGUID, []resources.RouteDestination{destination})
+	return Warnings(warnings), err
+}
+
 func (actor Actor) UpdateDestination(routeGUID string, destinationGUID string, protocol string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.UpdateDestination(routeGUID, destinationGUID, protocol)
 	return Warnings(warnings), err
 }
+
+// DrainRouteDestination waits for the drain period so that the requests in
+// flight to the destination of the route complete before it is unmapped. On a
+// weighted route, the weight of the destination is set to 0 first so that it
+// stops receiving new requests while it drains.
+func (actor Actor) DrainRouteDestination(route resources.Route, destinationGUID string, drain time.Duration) (Warnings, error) {
+	var warnings Warnings
+	for i, destination := range route.Destinations {
+		if destination.GUID != destinationGUID || !destination.Weight.IsSet {
+			continue
+		}
+
+		destinations := append([]resources.RouteDestination{}, route.Destinations...)
+		destinations[i].Weight.Value = 0
+		ccWarnings, err := actor.CloudControllerClient.UpdateRouteDestinations(route.GUID, destinations)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+		break
+	}
+
+	actor.Clock.Sleep(drain)
+	return warnings, nil
+}
+
 func (actor Actor) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.UnmapRoute(routeGUID, destinationGUID)
 	return Warnings(warnings), err
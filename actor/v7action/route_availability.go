@@ -0,0 +1,124 @@
+package v7action
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// RouteProblem is why a route cannot be mapped to an app of a space.
+type RouteProblem struct {
+	Route string
+	Err   error
+}
+
+// CheckRoutesAvailability checks that every route either belongs to the space
+// already or can be created in it: its domain exists, a host and path are only
+// given for an HTTP domain and a port only for a TCP domain, and no other
+// space uses the host or port. It returns the problems of all the routes at
+// once, in the order of the routes.
+func (actor Actor) CheckRoutesAvailability(routePaths []string, spaceGUID string) ([]RouteProblem, Warnings, error) {
+	var (
+		problems    []RouteProblem
+		allWarnings Warnings
+	)
+
+	checked := map[string]bool{}
+	for _, routePath := range routePaths {
+		if checked[routePath] {
+			continue
+		}
+		checked[routePath] = true
+
+		problem, warnings, err := actor.checkRouteAvailability(routePath, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if problem != nil {
+			problems = append(problems, *problem)
+		}
+	}
+
+	return problems, allWarnings, nil
+}
+
+// checkRouteAvailability returns the problem of a route, nil when it has
+// none, or an error when it cannot be checked.
+func (actor Actor) checkRouteAvailability(routePath string, spaceGUID string) (*RouteProblem, Warnings, error) {
+	problem := func(err error) *RouteProblem {
+		return &RouteProblem{Route: routePath, Err: err}
+	}
+
+	host, path, port, domain, warnings, err := actor.parseRoutePath(routePath)
+	if err != nil {
+		if _, ok := err.(actionerror.DomainNotFoundError); ok {
+			return problem(err), warnings, nil
+		}
+		return nil, warnings, err
+	}
+
+	queries := []ccv3.Query{
+		{Key: ccv3.DomainGUIDFilter, Values: []string{domain.GUID}},
+	}
+
+	if domain.IsTCP() {
+		if host != "" || path != "" {
+			return problem(actionerror.InvalidTCPRouteSettings{Domain: domain.Name}), warnings, nil
+		}
+		if port == "" {
+			// The Cloud Controller picks a free port.
+			return nil, warnings, nil
+		}
+		if _, err := strconv.Atoi(port); err != nil {
+			return problem(actionerror.InvalidRouteError{Route: routePath}), warnings, nil
+		}
+		queries = append(queries, ccv3.Query{Key: ccv3.PortsFilter, Values: []string{port}})
+	} else {
+		if port != "" {
+			return problem(actionerror.InvalidHTTPRouteSettings{Domain: domain.Name}), warnings, nil
+		}
+		if host == "" && domain.Shared() {
+			return problem(actionerror.NoHostnameAndSharedDomainError{}), warnings, nil
+		}
+		// Another space owning the host takes it for every path.
+		queries = append(queries, ccv3.Query{Key: ccv3.HostsFilter, Values: []string{host}})
+	}
+
+	routes, ccWarnings, err := actor.CloudControllerClient.GetRoutes(queries...)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	ownerSpaceGUID := ""
+	for _, route := range routes {
+		if route.SpaceGUID != spaceGUID {
+			ownerSpaceGUID = route.SpaceGUID
+			break
+		}
+	}
+
+	if ownerSpaceGUID == "" {
+		if len(routes) > 0 {
+			return nil, warnings, nil
+		}
+
+		// Routes of spaces hidden from the user are still reserved.
+		portNumber, _ := strconv.Atoi(port)
+		taken, ccWarnings, err := actor.CloudControllerClient.CheckRoute(domain.GUID, host, path, portNumber)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil || !taken {
+			return nil, warnings, err
+		}
+	}
+
+	collision, collisionWarnings, err := actor.routeCollision(routePath, domain, host, path, ownerSpaceGUID)
+	warnings = append(warnings, collisionWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return problem(collision), warnings, nil
+}
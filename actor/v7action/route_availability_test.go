@@ -0,0 +1,176 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Availability Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+
+		domains := map[string]resources.Domain{
+			"shared.com":  {GUID: "shared-domain-guid", Name: "shared.com"},
+			"private.com": {GUID: "private-domain-guid", Name: "private.com", OrganizationGUID: "some-org-guid"},
+			"tcp.com":     {GUID: "tcp-domain-guid", Name: "tcp.com", Protocols: []string{"tcp"}},
+		}
+		fakeCloudControllerClient.GetDomainsStub = func(queries ...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error) {
+			for _, query := range queries {
+				if query.Key == ccv3.NameFilter {
+					if domain, ok := domains[query.Values[0]]; ok {
+						return []resources.Domain{domain}, ccv3.Warnings{"get-domains-warning"}, nil
+					}
+				}
+			}
+			return nil, ccv3.Warnings{"get-domains-warning"}, nil
+		}
+	})
+
+	Describe("CheckRoutesAvailability", func() {
+		var (
+			routePaths []string
+			problems   []RouteProblem
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			problems, warnings, executeErr = actor.CheckRoutesAvailability(routePaths, "some-space-guid")
+		})
+
+		When("every route is available", func() {
+			BeforeEach(func() {
+				routePaths = []string{"app.shared.com/api", "private.com", "tcp.com", "app.shared.com/api"}
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "route-guid", SpaceGUID: "some-space-guid"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("returns no problems and checks each route once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(problems).To(BeEmpty())
+				Expect(warnings).To(ContainElements("get-domains-warning", "get-routes-warning"))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"shared-domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{"app"}},
+				))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(1)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"private-domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{""}},
+				))
+			})
+		})
+
+		When("routes have problems", func() {
+			BeforeEach(func() {
+				routePaths = []string{
+					"app.missing.com",
+					"shared.com",
+					"app.shared.com:8080",
+					"app.tcp.com:1024",
+					"tcp.com:port",
+					"taken.shared.com",
+					"tcp.com:1024",
+				}
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "route-guid", SpaceGUID: "other-space-guid"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{
+						GUID: "other-space-guid",
+						Name: "other-space",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "other-org-guid"},
+						},
+					}},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetOrganizationReturns(resources.Organization{Name: "other-org"}, nil, nil)
+				fakeCloudControllerClient.CheckRouteStub = func(_ string, hostname string, _ string, _ int) (bool, ccv3.Warnings, error) {
+					return hostname == "taken-2", nil, nil
+				}
+			})
+
+			It("returns the problem of every route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(problems).To(Equal([]RouteProblem{
+					{Route: "app.missing.com", Err: actionerror.DomainNotFoundError{Name: "missing.com"}},
+					{Route: "shared.com", Err: actionerror.NoHostnameAndSharedDomainError{}},
+					{Route: "app.shared.com:8080", Err: actionerror.InvalidHTTPRouteSettings{Domain: "shared.com"}},
+					{Route: "app.tcp.com:1024", Err: actionerror.InvalidTCPRouteSettings{Domain: "tcp.com"}},
+					{Route: "tcp.com:port", Err: actionerror.InvalidRouteError{Route: "tcp.com:port"}},
+					{Route: "taken.shared.com", Err: actionerror.RouteInDifferentSpaceError{
+						Route:         "taken.shared.com",
+						OrgName:       "other-org",
+						SpaceName:     "other-space",
+						SuggestedHost: "taken-3",
+					}},
+					{Route: "tcp.com:1024", Err: actionerror.RouteInDifferentSpaceError{
+						Route:     "tcp.com:1024",
+						OrgName:   "other-org",
+						SpaceName: "other-space",
+					}},
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(1)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"tcp-domain-guid"}},
+					ccv3.Query{Key: ccv3.PortsFilter, Values: []string{"1024"}},
+				))
+			})
+		})
+
+		When("a route is reserved by a space hidden from the user", func() {
+			BeforeEach(func() {
+				routePaths = []string{"hidden.shared.com"}
+				fakeCloudControllerClient.CheckRouteStub = func(_ string, hostname string, _ string, _ int) (bool, ccv3.Warnings, error) {
+					return hostname == "hidden", ccv3.Warnings{"check-route-warning"}, nil
+				}
+			})
+
+			It("returns the problem without an owner", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(problems).To(Equal([]RouteProblem{
+					{Route: "hidden.shared.com", Err: actionerror.RouteInDifferentSpaceError{
+						Route:         "hidden.shared.com",
+						SuggestedHost: "hidden-2",
+					}},
+				}))
+				Expect(warnings).To(ContainElement("check-route-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				routePaths = []string{"app.shared.com"}
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ContainElement("get-routes-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v7action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// routeHostSuggestions is how many alternative hostnames are tried when
+// suggesting a free one.
+const routeHostSuggestions = 5
+
+// GetRouteCollision explains why a route is taken by another space: it
+// returns the org and space owning the route when the user is permitted to
+// see them and, for an HTTP route, a similar hostname that is free.
+func (actor Actor) GetRouteCollision(domain resources.Domain, hostname string, path string, port int) (actionerror.RouteInDifferentSpaceError, Warnings, error) {
+	var ownerSpaceGUID string
+
+	route, allWarnings, err := actor.GetRouteByAttributes(domain, hostname, path, port)
+	switch err.(type) {
+	case nil:
+		ownerSpaceGUID = route.SpaceGUID
+	case actionerror.RouteNotFoundError:
+		// The route is hidden from the user.
+	default:
+		return actionerror.RouteInDifferentSpaceError{}, allWarnings, err
+	}
+
+	collision, warnings, err := actor.routeCollision(desiredRouteURL(domain.Name, hostname, path, port), domain, hostname, path, ownerSpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return collision, allWarnings, err
+}
+
+// routeCollision returns the error of a route owned by another space. The
+// owner is only looked up when its space GUID is known.
+func (actor Actor) routeCollision(routeURL string, domain resources.Domain, hostname string, path string, ownerSpaceGUID string) (actionerror.RouteInDifferentSpaceError, Warnings, error) {
+	var allWarnings Warnings
+	collision := actionerror.RouteInDifferentSpaceError{Route: routeURL}
+
+	if ownerSpaceGUID != "" {
+		collision.OrgName, collision.SpaceName, allWarnings = actor.routeOwner(ownerSpaceGUID, allWarnings)
+	}
+
+	if domain.IsTCP() || hostname == "" {
+		return collision, allWarnings, nil
+	}
+
+	for i := 2; i < 2+routeHostSuggestions; i++ {
+		suggestedHost := fmt.Sprintf("%s-%d", hostname, i)
+		taken, warnings, err := actor.CloudControllerClient.CheckRoute(domain.GUID, suggestedHost, path, 0)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return actionerror.RouteInDifferentSpaceError{}, allWarnings, err
+		}
+		if !taken {
+			collision.SuggestedHost = suggestedHost
+			break
+		}
+	}
+
+	return collision, allWarnings, nil
+}
+
+// routeOwner returns the names of a space and its org, or empty names when
+// the user cannot see them.
+func (actor Actor) routeOwner(spaceGUID string, allWarnings Warnings) (string, string, Warnings) {
+	space, warnings, err := actor.GetSpaceByGUID(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", "", allWarnings
+	}
+
+	org, warnings, err := actor.GetOrganizationByGUID(space.Relationships[constant.RelationshipTypeOrganization].GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", space.Name, allWarnings
+	}
+
+	return org.Name, space.Name, allWarnings
+}
+
+func desiredRouteURL(domainName string, hostname string, path string, port int) string {
+	url := domainName
+	if hostname != "" {
+		url = hostname + "." + url
+	}
+	if port != 0 {
+		url = fmt.Sprintf("%s:%d", url, port)
+	}
+	return url + path
+}
@@ -0,0 +1,148 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Collision Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetRouteCollision", func() {
+		var (
+			domain     resources.Domain
+			collision  actionerror.RouteInDifferentSpaceError
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			domain = resources.Domain{GUID: "domain-guid", Name: "example.com"}
+			fakeCloudControllerClient.CheckRouteStub = func(_ string, hostname string, _ string, _ int) (bool, ccv3.Warnings, error) {
+				return hostname == "app-2", ccv3.Warnings{"check-route-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			collision, warnings, executeErr = actor.GetRouteCollision(domain, "app", "/api", 0)
+		})
+
+		When("the user can see the route", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "route-guid", SpaceGUID: "other-space-guid"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{
+						Name: "other-space",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeOrganization: resources.Relationship{GUID: "other-org-guid"},
+						},
+					}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetOrganizationReturns(resources.Organization{Name: "other-org"}, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns the owner of the route and a free hostname", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(collision).To(Equal(actionerror.RouteInDifferentSpaceError{
+					Route:         "app.example.com/api",
+					OrgName:       "other-org",
+					SpaceName:     "other-space",
+					SuggestedHost: "app-3",
+				}))
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-spaces-warning", "get-org-warning", "check-route-warning", "check-route-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"other-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetOrganizationArgsForCall(0)).To(Equal("other-org-guid"))
+				domainGUID, hostname, path, port := fakeCloudControllerClient.CheckRouteArgsForCall(1)
+				Expect([]interface{}{domainGUID, hostname, path, port}).To(Equal([]interface{}{"domain-guid", "app-3", "/api", 0}))
+			})
+
+			When("the user cannot see the org", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationReturns(resources.Organization{}, nil, ccerror.ResourceNotFoundError{})
+				})
+
+				It("only returns the space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(collision.OrgName).To(BeEmpty())
+					Expect(collision.SpaceName).To(Equal("other-space"))
+				})
+			})
+		})
+
+		When("the route is hidden from the user", func() {
+			It("only returns a free hostname", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(collision).To(Equal(actionerror.RouteInDifferentSpaceError{
+					Route:         "app.example.com/api",
+					SuggestedHost: "app-3",
+				}))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no similar hostname is free", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CheckRouteReturns(true, nil, nil)
+			})
+
+			It("does not suggest a hostname", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(collision.SuggestedHost).To(BeEmpty())
+				Expect(fakeCloudControllerClient.CheckRouteCallCount()).To(Equal(5))
+			})
+		})
+
+		When("the domain is a TCP domain", func() {
+			BeforeEach(func() {
+				domain.Protocols = []string{"tcp"}
+			})
+
+			JustBeforeEach(func() {
+				collision, warnings, executeErr = actor.GetRouteCollision(domain, "", "", 1024)
+			})
+
+			It("does not suggest a hostname", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(collision).To(Equal(actionerror.RouteInDifferentSpaceError{Route: "example.com:1024"}))
+				Expect(fakeCloudControllerClient.CheckRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+})
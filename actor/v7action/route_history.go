@@ -0,0 +1,92 @@
+package v7action
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+const (
+	mapRouteEventType   = "audit.app.map-route"
+	unmapRouteEventType = "audit.app.unmap-route"
+	routeEventPrefix    = "audit.route."
+)
+
+// RouteHistoryEntry is a change to a route recorded by an audit event. The
+// app, process and port are only set when a destination was mapped or
+// unmapped.
+type RouteHistoryEntry struct {
+	Time time.Time
+	// Action is "map" and "unmap" for destinations, and the action of the
+	// event, such as "create", "update" or "share", for the route itself.
+	Action      string
+	AppName     string
+	ProcessType string
+	AppPort     int
+	ActorName   string
+}
+
+// GetRouteHistory returns the changes to the route and its destinations that
+// the audit events still record, oldest first.
+func (actor Actor) GetRouteHistory(routeGUID string) ([]RouteHistoryEntry, Warnings, error) {
+	var mappingEvents, routeEvents []ccv3.Event
+
+	ccWarnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			mappingEvents, warnings, err = actor.CloudControllerClient.GetEvents(
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{mapRouteEventType, unmapRouteEventType}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			routeEvents, warnings, err = actor.CloudControllerClient.GetEvents(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{routeGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+	)
+	if err != nil {
+		return nil, Warnings(ccWarnings), err
+	}
+
+	var history []RouteHistoryEntry
+	for _, event := range mappingEvents {
+		if eventRouteGUID, _ := event.Data["route_guid"].(string); eventRouteGUID != routeGUID {
+			continue
+		}
+
+		entry := RouteHistoryEntry{
+			Time:      event.CreatedAt,
+			Action:    strings.TrimSuffix(strings.TrimPrefix(event.Type, "audit.app."), "-route"),
+			AppName:   event.TargetName,
+			ActorName: event.ActorName,
+		}
+		entry.ProcessType, _ = event.Data["process_type"].(string)
+		if port, ok := event.Data["app_port"].(float64); ok {
+			entry.AppPort = int(port)
+		}
+		history = append(history, entry)
+	}
+
+	for _, event := range routeEvents {
+		if !strings.HasPrefix(event.Type, routeEventPrefix) {
+			continue
+		}
+		history = append(history, RouteHistoryEntry{
+			Time:      event.CreatedAt,
+			Action:    strings.TrimPrefix(event.Type, routeEventPrefix),
+			ActorName: event.ActorName,
+		})
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Time.Before(history[j].Time)
+	})
+
+	return history, Warnings(ccWarnings), nil
+}
@@ -0,0 +1,104 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route History Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetRouteHistory", func() {
+		var (
+			history    []RouteHistoryEntry
+			warnings   Warnings
+			executeErr error
+		)
+
+		at := func(hour int) time.Time {
+			return time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC)
+		}
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetEventsReturnsOnCall(0,
+				[]ccv3.Event{
+					{
+						CreatedAt: at(2), Type: "audit.app.map-route", ActorName: "alice", TargetName: "app-1",
+						Data: map[string]interface{}{"route_guid": "route-guid", "process_type": "web", "app_port": float64(8080)},
+					},
+					{
+						CreatedAt: at(3), Type: "audit.app.map-route", ActorName: "alice", TargetName: "app-2",
+						Data: map[string]interface{}{"route_guid": "other-route-guid", "process_type": "web"},
+					},
+					{
+						CreatedAt: at(5), Type: "audit.app.unmap-route", ActorName: "bob", TargetName: "app-1",
+						Data: map[string]interface{}{"route_guid": "route-guid", "process_type": "web", "app_port": float64(8080)},
+					},
+				},
+				ccv3.Warnings{"mapping-events-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturnsOnCall(1,
+				[]ccv3.Event{
+					{CreatedAt: at(1), Type: "audit.route.create", ActorName: "alice"},
+					{CreatedAt: at(4), Type: "audit.route.share", ActorName: "carol"},
+				},
+				ccv3.Warnings{"route-events-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			history, warnings, executeErr = actor.GetRouteHistory("route-guid")
+		})
+
+		It("requests the mapping events and the events of the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.map-route", "audit.app.unmap-route"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(1)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"route-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("returns the changes to the route, oldest first", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("mapping-events-warning", "route-events-warning"))
+			Expect(history).To(Equal([]RouteHistoryEntry{
+				{Time: at(1), Action: "create", ActorName: "alice"},
+				{Time: at(2), Action: "map", AppName: "app-1", ProcessType: "web", AppPort: 8080, ActorName: "alice"},
+				{Time: at(4), Action: "share", ActorName: "carol"},
+				{Time: at(5), Action: "unmap", AppName: "app-1", ProcessType: "web", AppPort: 8080, ActorName: "bob"},
+			}))
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturnsOnCall(0, nil, ccv3.Warnings{"mapping-events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("mapping-events-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetRoutesByDomain returns the routes of a domain that the user can see,
+// with their destinations.
+func (actor Actor) GetRoutesByDomain(domainGUID string) ([]resources.Route, Warnings, error) {
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{domainGUID}},
+	)
+	return routes, Warnings(warnings), err
+}
+
+// MigrateRoute creates a route with the host, path and port of the given
+// route on another domain, in the same space, and maps it to the same
+// destinations. A route that already exists on that domain in the same space
+// is reused, so that an interrupted migration can be run again.
+func (actor Actor) MigrateRoute(route resources.Route, toDomain resources.Domain) (resources.Route, Warnings, error) {
+	newRoute, ccWarnings, err := actor.CloudControllerClient.CreateRoute(resources.Route{
+		SpaceGUID:  route.SpaceGUID,
+		DomainGUID: toDomain.GUID,
+		Host:       route.Host,
+		Path:       route.Path,
+		Port:       route.Port,
+	})
+	allWarnings := Warnings(ccWarnings)
+
+	if _, ok := err.(ccerror.RouteNotUniqueError); ok {
+		var warnings Warnings
+		newRoute, warnings, err = actor.GetRouteByAttributes(toDomain, route.Host, route.Path, route.Port)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil && newRoute.SpaceGUID != route.SpaceGUID {
+			err = actionerror.RouteInDifferentSpaceError{Route: newRoute.URL}
+		}
+	}
+	if err != nil {
+		return resources.Route{}, allWarnings, err
+	}
+
+	var missingDestinations []resources.RouteDestination
+	for _, destination := range route.Destinations {
+		if !hasRouteDestination(newRoute.Destinations, destination) {
+			missingDestinations = append(missingDestinations, destination)
+		}
+	}
+
+	if len(missingDestinations) > 0 {
+		ccWarnings, err = actor.CloudControllerClient.MapRouteDestinations(newRoute.GUID, missingDestinations)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return resources.Route{}, allWarnings, err
+		}
+	}
+
+	return newRoute, allWarnings, nil
+}
+
+func hasRouteDestination(destinations []resources.RouteDestination, destination resources.RouteDestination) bool {
+	for _, d := range destinations {
+		if d.App.GUID == destination.App.GUID && d.App.Process.Type == destination.App.Process.Type {
+			return true
+		}
+	}
+	return false
+}
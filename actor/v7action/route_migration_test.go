@@ -0,0 +1,147 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Migration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetRoutesByDomain", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{{GUID: "route-guid"}}, ccv3.Warnings{"get-routes-warning"}, nil)
+		})
+
+		It("returns the routes of the domain", func() {
+			routes, warnings, err := actor.GetRoutesByDomain("domain-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-routes-warning"))
+			Expect(routes).To(Equal([]resources.Route{{GUID: "route-guid"}}))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"domain-guid"}},
+			))
+		})
+	})
+
+	Describe("MigrateRoute", func() {
+		var (
+			route      resources.Route
+			toDomain   resources.Domain
+			newRoute   resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			worker := resources.RouteDestination{App: resources.RouteDestinationApp{GUID: "app-guid-2"}, Port: 9000}
+			worker.App.Process.Type = "worker"
+			route = resources.Route{
+				GUID:      "old-route-guid",
+				SpaceGUID: "space-guid",
+				Host:      "app",
+				Path:      "/api",
+				Destinations: []resources.RouteDestination{
+					{GUID: "destination-guid-1", App: resources.RouteDestinationApp{GUID: "app-guid-1"}, Protocol: "http2"},
+					worker,
+				},
+			}
+			toDomain = resources.Domain{GUID: "new-domain-guid", Name: "new.com"}
+
+			fakeCloudControllerClient.CreateRouteReturns(
+				resources.Route{GUID: "new-route-guid", SpaceGUID: "space-guid", URL: "app.new.com/api"},
+				ccv3.Warnings{"create-route-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.MapRouteDestinationsReturns(ccv3.Warnings{"map-destinations-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			newRoute, warnings, executeErr = actor.MigrateRoute(route, toDomain)
+		})
+
+		It("creates the route on the new domain and maps the same destinations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-route-warning", "map-destinations-warning"))
+			Expect(newRoute.GUID).To(Equal("new-route-guid"))
+
+			Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(resources.Route{
+				SpaceGUID:  "space-guid",
+				DomainGUID: "new-domain-guid",
+				Host:       "app",
+				Path:       "/api",
+			}))
+
+			routeGUID, destinations := fakeCloudControllerClient.MapRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("new-route-guid"))
+			Expect(destinations).To(Equal(route.Destinations))
+		})
+
+		When("the route already exists on the new domain in the same space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, nil, ccerror.RouteNotUniqueError{})
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{
+						GUID:         "new-route-guid",
+						SpaceGUID:    "space-guid",
+						Destinations: []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "app-guid-1"}}},
+					}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("reuses it and only maps the missing destinations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "map-destinations-warning"))
+
+				routeGUID, destinations := fakeCloudControllerClient.MapRouteDestinationsArgsForCall(0)
+				Expect(routeGUID).To(Equal("new-route-guid"))
+				Expect(destinations).To(Equal(route.Destinations[1:]))
+			})
+		})
+
+		When("the route already exists on the new domain in another space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, nil, ccerror.RouteNotUniqueError{})
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "new-route-guid", SpaceGUID: "other-space-guid", URL: "app.new.com/api"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a RouteInDifferentSpaceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "app.new.com/api"}))
+				Expect(fakeCloudControllerClient.MapRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("mapping the destinations fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MapRouteDestinationsReturns(ccv3.Warnings{"map-destinations-warning"}, errors.New("map-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("map-error"))
+				Expect(warnings).To(ConsistOf("create-route-warning", "map-destinations-warning"))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v7action_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
@@ -11,7 +12,9 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -20,10 +23,11 @@ var _ = Describe("Route Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeClock                 *fakeclock.FakeClock
 	)
 
 	BeforeEach(func() {
-		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		actor, fakeCloudControllerClient, _, _, _, _, fakeClock = NewTestActor()
 	})
 
 	Describe("CreateRoute", func() {
@@ -1620,6 +1624,47 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("MapRouteToAppPort", func() {
+		var (
+			executeErr error
+			warnings   Warnings
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRouteToAppPort("route-guid", "app-guid", "http1", 9090)
+		})
+
+		When("the cloud controller client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MapRouteDestinationsReturns(ccv3.Warnings{"map-route-warning"}, errors.New("map-route-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("map-route-error"))
+				Expect(warnings).To(ConsistOf("map-route-warning"))
+			})
+		})
+
+		When("the cloud controller client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MapRouteDestinationsReturns(ccv3.Warnings{"map-route-warning"}, nil)
+			})
+
+			It("maps a destination with the app port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("map-route-warning"))
+
+				Expect(fakeCloudControllerClient.MapRouteDestinationsCallCount()).To(Equal(1))
+				routeGUID, destinations := fakeCloudControllerClient.MapRouteDestinationsArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(destinations).To(HaveLen(1))
+				Expect(destinations[0].App.GUID).To(Equal("app-guid"))
+				Expect(destinations[0].Port).To(Equal(9090))
+				Expect(destinations[0].Protocol).To(Equal("http1"))
+			})
+		})
+	})
+
 	Describe("UnshareRoute", func() {
 		var (
 			routeGUID string
@@ -1660,6 +1705,78 @@ var _ = Describe("Route Actions", func() {
 			})
 		})
 	})
+	Describe("DrainRouteDestination", func() {
+		var (
+			route      resources.Route
+			warnings   Warnings
+			executeErr error
+			done       chan struct{}
+		)
+
+		BeforeEach(func() {
+			route = resources.Route{
+				GUID: "route-guid",
+				Destinations: []resources.RouteDestination{
+					{GUID: "destination-1-guid", App: resources.RouteDestinationApp{GUID: "app-1-guid"}},
+					{GUID: "destination-2-guid", App: resources.RouteDestinationApp{GUID: "app-2-guid"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				warnings, executeErr = actor.DrainRouteDestination(route, "destination-2-guid", 30*time.Second)
+			}()
+		})
+
+		It("waits for the drain period before returning", func() {
+			Consistently(done).ShouldNot(BeClosed())
+			fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+			Eventually(done).Should(BeClosed())
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.UpdateRouteDestinationsCallCount()).To(Equal(0))
+		})
+
+		When("the route is weighted", func() {
+			BeforeEach(func() {
+				route.Destinations[0].Weight = types.NullInt{IsSet: true, Value: 70}
+				route.Destinations[1].Weight = types.NullInt{IsSet: true, Value: 30}
+				fakeCloudControllerClient.UpdateRouteDestinationsReturns(ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("sets the weight of the destination to 0 before waiting", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateRouteDestinationsCallCount()).To(Equal(1))
+				routeGUID, destinations := fakeCloudControllerClient.UpdateRouteDestinationsArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(destinations[0].Weight).To(Equal(types.NullInt{IsSet: true, Value: 70}))
+				Expect(destinations[1].Weight).To(Equal(types.NullInt{IsSet: true, Value: 0}))
+				Expect(route.Destinations[1].Weight.Value).To(Equal(30))
+			})
+
+			When("updating the weights fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateRouteDestinationsReturns(ccv3.Warnings{"update-warning"}, errors.New("invalid weights"))
+				})
+
+				It("returns the error without waiting", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(executeErr).To(MatchError("invalid weights"))
+					Expect(warnings).To(ConsistOf("update-warning"))
+				})
+			})
+		})
+	})
+
 	Describe("UnmapRoute", func() {
 		var (
 			routeGUID       string
@@ -1744,6 +1861,118 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetOrphanedRoutes", func() {
+		var (
+			routes      []resources.Route
+			orphanedFor time.Duration
+
+			orphaned   []resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			routes = []resources.Route{
+				{GUID: "mapped-route-guid", Destinations: []resources.RouteDestination{{GUID: "destination-guid"}}},
+				{GUID: "orphaned-route-guid-1"},
+				{GUID: "orphaned-route-guid-2"},
+			}
+			orphanedFor = 0
+		})
+
+		JustBeforeEach(func() {
+			orphaned, warnings, executeErr = actor.GetOrphanedRoutes(routes, orphanedFor)
+		})
+
+		It("returns the routes without destinations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(orphaned).To(Equal([]resources.Route{
+				{GUID: "orphaned-route-guid-1"},
+				{GUID: "orphaned-route-guid-2"},
+			}))
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(BeZero())
+		})
+
+		When("a minimum orphaned duration is given", func() {
+			BeforeEach(func() {
+				orphanedFor = 24 * time.Hour
+
+				fakeCloudControllerClient.GetEventsStub = func(queries ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error) {
+					switch queries[0].Values[0] {
+					case "orphaned-route-guid-1":
+						return []ccv3.Event{{Type: "audit.route.unmapped", CreatedAt: fakeClock.Now().Add(-time.Hour)}}, ccv3.Warnings{"events-warning-1"}, nil
+					default:
+						return nil, ccv3.Warnings{"events-warning-2"}, nil
+					}
+				}
+			})
+
+			It("only returns routes whose latest audit event is older than the duration", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("events-warning-1", "events-warning-2"))
+				Expect(orphaned).To(Equal([]resources.Route{{GUID: "orphaned-route-guid-2"}}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"orphaned-route-guid-1"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+
+			When("getting the events fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetEventsStub = nil
+					fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("events-error"))
+					Expect(warnings).To(ConsistOf("events-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("DeleteRouteByGUID", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteRouteByGUID("route-guid")
+		})
+
+		When("the route is deleted", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteReturns(ccv3.JobURL("job"), ccv3.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+			})
+
+			It("deletes the route and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("job")))
+			})
+		})
+
+		When("deleting the route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteReturns("", ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("DeleteOrphanedRoutes", func() {
 		var (
 			spaceGUID string
@@ -11,6 +11,11 @@ type RouterGroup router.RouterGroup
 func (actor Actor) GetRouterGroups() ([]RouterGroup, error) {
 	var routerGroups []RouterGroup
 
+	err := actor.RequireCapability(CapabilityRoutingAPI)
+	if err != nil {
+		return nil, err
+	}
+
 	apiRouterGroups, err := actor.RoutingClient.GetRouterGroups()
 	if err != nil {
 		return nil, err
@@ -24,6 +29,11 @@ func (actor Actor) GetRouterGroups() ([]RouterGroup, error) {
 }
 
 func (actor Actor) GetRouterGroupByName(name string) (RouterGroup, error) {
+	err := actor.RequireCapability(CapabilityRoutingAPI)
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
 	apiRouterGroup, err := actor.RoutingClient.GetRouterGroupByName(name)
 	if err != nil {
 		if _, ok := err.(routererror.ResourceNotFoundError); ok {
@@ -15,13 +15,16 @@ import (
 var _ = Describe("Router Group Actions", func() {
 	var (
 		actor             *Actor
+		fakeConfig        *v7actionfakes.FakeConfig
 		fakeRoutingClient *v7actionfakes.FakeRoutingClient
 		executeErr        error
 	)
 
 	BeforeEach(func() {
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeConfig.RoutingEndpointReturns("https://api.example.com/routing")
 		fakeRoutingClient = new(v7actionfakes.FakeRoutingClient)
-		actor = NewActor(nil, nil, nil, nil, fakeRoutingClient, nil)
+		actor = NewActor(nil, fakeConfig, nil, nil, fakeRoutingClient, nil)
 	})
 
 	Describe("GetRouterGroups", func() {
@@ -77,6 +80,24 @@ var _ = Describe("Router Group Actions", func() {
 				Expect(executeErr).To(MatchError(expectedError))
 			})
 		})
+
+		When("the targeted API does not advertise the routing API", func() {
+			BeforeEach(func() {
+				fakeConfig.RoutingEndpointReturns("")
+			})
+
+			JustBeforeEach(func() {
+				routerGroups, executeErr = actor.GetRouterGroups()
+			})
+
+			It("fails without calling the routing API", func() {
+				Expect(executeErr).To(MatchError(actionerror.CapabilityNotSupportedError{
+					Capability: "The routing API",
+					Link:       "routing",
+				}))
+				Expect(fakeRoutingClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Describe("GetRouterGroupByName", func() {
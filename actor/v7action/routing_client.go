@@ -1,10 +1,15 @@
 package v7action
 
-import "code.cloudfoundry.org/cli/api/router"
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/router"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RoutingClient
 
 type RoutingClient interface {
 	GetRouterGroups() ([]router.RouterGroup, error)
 	GetRouterGroupByName(name string) (router.RouterGroup, error)
+	MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error)
 }
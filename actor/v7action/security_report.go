@@ -0,0 +1,231 @@
+package v7action
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// SecurityFindingSeverity is how much attention a security finding needs.
+type SecurityFindingSeverity string
+
+const (
+	SecurityFindingHigh   SecurityFindingSeverity = "high"
+	SecurityFindingMedium SecurityFindingSeverity = "medium"
+	SecurityFindingLow    SecurityFindingSeverity = "low"
+	SecurityFindingInfo   SecurityFindingSeverity = "info"
+)
+
+var securityFindingSeverityOrder = map[SecurityFindingSeverity]int{
+	SecurityFindingHigh:   0,
+	SecurityFindingMedium: 1,
+	SecurityFindingLow:    2,
+	SecurityFindingInfo:   3,
+}
+
+// SecurityFinding is a single security-relevant observation about an app.
+type SecurityFinding struct {
+	Severity SecurityFindingSeverity
+	// Check is the area of the app that the finding is about, such as "ssh"
+	// or "env".
+	Check   string
+	Message string
+}
+
+// EgressRule is a rule of a security group that applies to an app's running
+// containers.
+type EgressRule struct {
+	SecurityGroupName string
+	resources.Rule
+}
+
+// AppSecurityReport contains the security-relevant facts about an app and the
+// findings drawn from them, ordered from most to least severe.
+type AppSecurityReport struct {
+	AppName       string
+	SSHEnabled    bool
+	LifecycleType constant.AppLifecycleType
+	StackName     string
+	Routes        []resources.Route
+	EgressRules   []EgressRule
+	Findings      []SecurityFinding
+}
+
+// endOfLifeStacks maps stacks that are no longer receiving security updates
+// to the operating system they are based on.
+var endOfLifeStacks = map[string]string{
+	"cflinuxfs2":    "Ubuntu 14.04",
+	"cflinuxfs3":    "Ubuntu 18.04",
+	"windows2012R2": "Windows Server 2012 R2",
+	"windows2016":   "Windows Server 2016",
+}
+
+var (
+	secretNamePattern  = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|credential|api_?key|access_?key|private_?key)`)
+	secretValuePattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----|\bAKIA[0-9A-Z]{16}\b`)
+)
+
+// GetAppSecurityReport gathers the app's SSH setting, lifecycle, stack,
+// routes, egress rules and environment variables and reports findings about
+// them. Environment variable values are only used for matching and are never
+// included in the report.
+func (actor Actor) GetAppSecurityReport(appName string, spaceGUID string) (AppSecurityReport, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return AppSecurityReport{}, allWarnings, err
+	}
+
+	report := AppSecurityReport{
+		AppName:       app.Name,
+		LifecycleType: app.LifecycleType,
+		StackName:     app.StackName,
+	}
+
+	sshEnabled, ccWarnings, err := actor.CloudControllerClient.GetSSHEnabled(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return AppSecurityReport{}, allWarnings, err
+	}
+	report.SSHEnabled = sshEnabled.Enabled
+
+	report.Routes, ccWarnings, err = actor.CloudControllerClient.GetApplicationRoutes(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return AppSecurityReport{}, allWarnings, err
+	}
+
+	securityGroups, ccWarnings, err := actor.CloudControllerClient.GetRunningSecurityGroups(spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return AppSecurityReport{}, allWarnings, err
+	}
+	for _, securityGroup := range securityGroups {
+		for _, rule := range securityGroup.Rules {
+			report.EgressRules = append(report.EgressRules, EgressRule{SecurityGroupName: securityGroup.Name, Rule: rule})
+		}
+	}
+
+	environment, ccWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return AppSecurityReport{}, allWarnings, err
+	}
+
+	report.Findings = append(report.Findings, sshFindings(report.SSHEnabled)...)
+	report.Findings = append(report.Findings, lifecycleFindings(report.LifecycleType, report.StackName)...)
+	report.Findings = append(report.Findings, routeFindings(report.Routes)...)
+	report.Findings = append(report.Findings, egressFindings(report.EgressRules)...)
+	report.Findings = append(report.Findings, environmentFindings(environment.EnvironmentVariables)...)
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return securityFindingSeverityOrder[report.Findings[i].Severity] < securityFindingSeverityOrder[report.Findings[j].Severity]
+	})
+
+	return report, allWarnings, nil
+}
+
+func sshFindings(enabled bool) []SecurityFinding {
+	if !enabled {
+		return nil
+	}
+	return []SecurityFinding{{
+		Severity: SecurityFindingMedium,
+		Check:    "ssh",
+		Message:  "SSH is enabled, so space developers can open a shell in the app's containers",
+	}}
+}
+
+func lifecycleFindings(lifecycleType constant.AppLifecycleType, stackName string) []SecurityFinding {
+	if lifecycleType == constant.AppLifecycleTypeDocker {
+		return []SecurityFinding{{
+			Severity: SecurityFindingLow,
+			Check:    "lifecycle",
+			Message:  "App runs a Docker image, which is not patched when the platform's stacks are updated",
+		}}
+	}
+
+	if operatingSystem, ok := endOfLifeStacks[stackName]; ok {
+		return []SecurityFinding{{
+			Severity: SecurityFindingHigh,
+			Check:    "stack",
+			Message:  fmt.Sprintf("Stack %s is based on %s, which no longer receives security updates", stackName, operatingSystem),
+		}}
+	}
+
+	return nil
+}
+
+func routeFindings(routes []resources.Route) []SecurityFinding {
+	if len(routes) == 0 {
+		return []SecurityFinding{{
+			Severity: SecurityFindingInfo,
+			Check:    "routes",
+			Message:  "App has no routes and is not reachable through the router",
+		}}
+	}
+
+	var findings []SecurityFinding
+	for _, route := range routes {
+		if route.Protocol == "tcp" {
+			findings = append(findings, SecurityFinding{
+				Severity: SecurityFindingLow,
+				Check:    "routes",
+				Message:  fmt.Sprintf("Route %s uses TCP, so TLS is not terminated by the platform", route.URL),
+			})
+		}
+	}
+	return findings
+}
+
+func egressFindings(rules []EgressRule) []SecurityFinding {
+	var findings []SecurityFinding
+	for _, rule := range rules {
+		switch rule.Destination {
+		case "0.0.0.0/0", "0.0.0.0-255.255.255.255", "::/0":
+		default:
+			continue
+		}
+
+		if rule.Protocol == "all" {
+			findings = append(findings, SecurityFinding{
+				Severity: SecurityFindingMedium,
+				Check:    "egress",
+				Message:  fmt.Sprintf("Security group %s allows all traffic to any destination", rule.SecurityGroupName),
+			})
+		} else {
+			findings = append(findings, SecurityFinding{
+				Severity: SecurityFindingLow,
+				Check:    "egress",
+				Message:  fmt.Sprintf("Security group %s allows %s traffic to any destination", rule.SecurityGroupName, rule.Protocol),
+			})
+		}
+	}
+	return findings
+}
+
+func environmentFindings(environmentVariables map[string]interface{}) []SecurityFinding {
+	names := make([]string, 0, len(environmentVariables))
+	for name := range environmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var findings []SecurityFinding
+	for _, name := range names {
+		value := fmt.Sprint(environmentVariables[name])
+		if secretNamePattern.MatchString(name) || secretValuePattern.MatchString(value) {
+			findings = append(findings, SecurityFinding{
+				Severity: SecurityFindingHigh,
+				Check:    "env",
+				Message:  fmt.Sprintf("Environment variable %s appears to contain a secret in plain text; use a service binding instead", name),
+			})
+		}
+	}
+	return findings
+}
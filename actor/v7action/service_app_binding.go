@@ -15,6 +15,10 @@ type CreateServiceAppBindingParams struct {
 	AppName             string
 	BindingName         string
 	Parameters          types.OptionalObject
+	// PropagatedLabels are the keys of service instance labels that are
+	// copied onto the binding. Keys that the service instance does not have
+	// are ignored.
+	PropagatedLabels []string
 }
 
 type DeleteServiceAppBindingParams struct {
@@ -41,7 +45,7 @@ func (actor Actor) CreateServiceAppBinding(params CreateServiceAppBindingParams)
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
-			jobURL, warnings, err = actor.createServiceAppBinding(serviceInstance.GUID, app.GUID, params.BindingName, params.Parameters)
+			jobURL, warnings, err = actor.createServiceAppBinding(serviceInstance.GUID, app.GUID, params.BindingName, params.Parameters, propagatedLabels(serviceInstance.Metadata, params.PropagatedLabels))
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
@@ -102,13 +106,14 @@ func (actor Actor) DeleteServiceAppBinding(params DeleteServiceAppBindingParams)
 	}
 }
 
-func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
+func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject, metadata *resources.Metadata) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.AppBinding,
 		Name:                bindingName,
 		ServiceInstanceGUID: serviceInstanceGUID,
 		AppGUID:             appGUID,
 		Parameters:          parameters,
+		Metadata:            metadata,
 	})
 	switch err.(type) {
 	case nil:
@@ -141,3 +146,21 @@ func (actor Actor) getServiceAppBinding(serviceInstanceGUID, appGUID string) (re
 		return bindings[0], warnings, nil
 	}
 }
+
+func propagatedLabels(serviceInstanceMetadata *resources.Metadata, keys []string) *resources.Metadata {
+	if serviceInstanceMetadata == nil || len(keys) == 0 {
+		return nil
+	}
+
+	labels := map[string]types.NullString{}
+	for _, key := range keys {
+		if value, ok := serviceInstanceMetadata.Labels[key]; ok && value.IsSet {
+			labels[key] = value
+		}
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+
+	return &resources.Metadata{Labels: labels}
+}
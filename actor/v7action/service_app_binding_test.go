@@ -205,6 +205,32 @@ var _ = Describe("Service App Binding Action", func() {
 				}))
 			})
 
+			When("labels are propagated", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Name: serviceInstanceName,
+							GUID: serviceInstanceGUID,
+							Metadata: &resources.Metadata{Labels: map[string]types.NullString{
+								"env":  types.NewNullString("prod"),
+								"team": types.NewNullString("payments"),
+							}},
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"get instance warning"},
+						nil,
+					)
+					params.PropagatedLabels = []string{"env", "missing"}
+				})
+
+				It("copies the selected service instance labels onto the binding", func() {
+					binding := fakeCloudControllerClient.CreateServiceCredentialBindingArgsForCall(0)
+					Expect(binding.Metadata).To(Equal(&resources.Metadata{Labels: map[string]types.NullString{
+						"env": types.NewNullString("prod"),
+					}}))
+				})
+			})
+
 			When("binding already exists", func() {
 				BeforeEach(func() {
 					fakeCloudControllerClient.CreateServiceCredentialBindingReturns(
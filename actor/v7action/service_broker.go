@@ -46,6 +46,21 @@ func (actor Actor) UpdateServiceBroker(serviceBrokerGUID string, model resources
 	})
 }
 
+// RotateServiceBrokerCredentials replaces the basic auth credentials of the
+// service broker, leaving its URL as it is.
+func (actor Actor) RotateServiceBrokerCredentials(serviceBrokerName, username, password string) (Warnings, error) {
+	serviceBroker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	if err != nil {
+		return warnings, err
+	}
+
+	updateWarnings, err := actor.UpdateServiceBroker(serviceBroker.GUID, resources.ServiceBroker{
+		Username: username,
+		Password: password,
+	})
+	return append(warnings, updateWarnings...), err
+}
+
 func (actor Actor) DeleteServiceBroker(serviceBrokerGUID string) (Warnings, error) {
 	return actor.performAndPoll(func() (ccv3.JobURL, ccv3.Warnings, error) {
 		return actor.CloudControllerClient.DeleteServiceBroker(serviceBrokerGUID)
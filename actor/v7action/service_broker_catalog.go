@@ -0,0 +1,181 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
+)
+
+type ServicePlanChangeType string
+
+const (
+	ServicePlanAdded   ServicePlanChangeType = "added"
+	ServicePlanRemoved ServicePlanChangeType = "removed"
+	ServicePlanChanged ServicePlanChangeType = "changed"
+)
+
+// ServicePlanChange is a difference between the plans a broker registered with
+// the Cloud Controller and the plans of its catalog.
+type ServicePlanChange struct {
+	ServiceOfferingName string
+	ServicePlanName     string
+	Type                ServicePlanChangeType
+	// Details lists the changed fields of a changed plan.
+	Details []string
+	// ServiceInstances is the number of instances of a removed plan.
+	ServiceInstances int
+}
+
+// GetServiceBrokerCatalogDiff compares the plans registered for the service
+// broker with the plans of the given catalog, matching them by service offering
+// and plan name. The changes are sorted by service offering and plan name.
+func (actor Actor) GetServiceBrokerCatalogDiff(serviceBrokerName string, catalog brokercatalog.Catalog) ([]ServicePlanChange, Warnings, error) {
+	offerings, ccWarnings, err := actor.CloudControllerClient.GetServicePlansWithOfferings(
+		ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{serviceBrokerName}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	warnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	type planKey struct{ offering, plan string }
+	currentPlans := make(map[planKey]resources.ServicePlan)
+	for _, offering := range offerings {
+		for _, plan := range offering.Plans {
+			currentPlans[planKey{offering.Name, plan.Name}] = plan
+		}
+	}
+
+	var changes []ServicePlanChange
+	for _, service := range catalog.Services {
+		for _, plan := range service.Plans {
+			key := planKey{service.Name, plan.Name}
+			currentPlan, exists := currentPlans[key]
+			delete(currentPlans, key)
+
+			if !exists {
+				changes = append(changes, ServicePlanChange{
+					ServiceOfferingName: service.Name,
+					ServicePlanName:     plan.Name,
+					Type:                ServicePlanAdded,
+				})
+				continue
+			}
+
+			if details := servicePlanDetailChanges(currentPlan, plan); len(details) > 0 {
+				changes = append(changes, ServicePlanChange{
+					ServiceOfferingName: service.Name,
+					ServicePlanName:     plan.Name,
+					Type:                ServicePlanChanged,
+					Details:             details,
+				})
+			}
+		}
+	}
+
+	if len(currentPlans) > 0 {
+		var removedPlanGUIDs []string
+		for _, plan := range currentPlans {
+			removedPlanGUIDs = append(removedPlanGUIDs, plan.GUID)
+		}
+		sort.Strings(removedPlanGUIDs)
+
+		instances, _, ccWarnings, err := actor.CloudControllerClient.GetServiceInstances(
+			ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: removedPlanGUIDs},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		instancesPerPlan := make(map[string]int)
+		for _, instance := range instances {
+			instancesPerPlan[instance.ServicePlanGUID]++
+		}
+
+		for key, plan := range currentPlans {
+			changes = append(changes, ServicePlanChange{
+				ServiceOfferingName: key.offering,
+				ServicePlanName:     key.plan,
+				Type:                ServicePlanRemoved,
+				ServiceInstances:    instancesPerPlan[plan.GUID],
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].ServiceOfferingName != changes[j].ServiceOfferingName {
+			return changes[i].ServiceOfferingName < changes[j].ServiceOfferingName
+		}
+		return changes[i].ServicePlanName < changes[j].ServicePlanName
+	})
+
+	return changes, warnings, nil
+}
+
+// GetServiceBrokerCatalog returns the catalog of the service broker as the
+// Cloud Controller registered it.
+func (actor Actor) GetServiceBrokerCatalog(serviceBrokerName string) (brokercatalog.Catalog, Warnings, error) {
+	offerings, warnings, err := actor.CloudControllerClient.GetServicePlansWithOfferings(
+		ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{serviceBrokerName}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return brokercatalog.Catalog{}, Warnings(warnings), err
+	}
+
+	var catalog brokercatalog.Catalog
+	for _, offering := range offerings {
+		service := brokercatalog.Service{
+			Name:           offering.Name,
+			Description:    offering.Description,
+			Bindable:       offering.Bindable,
+			PlanUpdateable: offering.PlanUpdateable,
+		}
+
+		for _, plan := range offering.Plans {
+			catalogPlan := brokercatalog.Plan{
+				Name:           plan.Name,
+				Description:    plan.Description,
+				Free:           boolPointer(plan.Free),
+				Bindable:       boolPointer(plan.Bindable),
+				PlanUpdateable: boolPointer(plan.PlanUpdateable),
+			}
+			if plan.MaintenanceInfoVersion != "" {
+				catalogPlan.MaintenanceInfo = &brokercatalog.MaintenanceInfo{
+					Version:     plan.MaintenanceInfoVersion,
+					Description: plan.MaintenanceInfoDescription,
+				}
+			}
+			service.Plans = append(service.Plans, catalogPlan)
+		}
+
+		catalog.Services = append(catalog.Services, service)
+	}
+
+	return catalog, Warnings(warnings), nil
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
+
+func servicePlanDetailChanges(current resources.ServicePlan, plan brokercatalog.Plan) []string {
+	var details []string
+	if current.Description != plan.Description {
+		details = append(details, fmt.Sprintf("description: %q -> %q", current.Description, plan.Description))
+	}
+	if current.Free != plan.IsFree() {
+		details = append(details, fmt.Sprintf("free: %s -> %s", strconv.FormatBool(current.Free), strconv.FormatBool(plan.IsFree())))
+	}
+	if current.MaintenanceInfoVersion != plan.MaintenanceInfoVersion() {
+		details = append(details, fmt.Sprintf("maintenance_info.version: %q -> %q", current.MaintenanceInfoVersion, plan.MaintenanceInfoVersion()))
+	}
+	return details
+}
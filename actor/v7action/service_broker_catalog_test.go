@@ -0,0 +1,210 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Catalog Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceBrokerCatalogDiff", func() {
+		var (
+			catalog    brokercatalog.Catalog
+			changes    []ServicePlanChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			notFree := false
+			catalog = brokercatalog.Catalog{
+				Services: []brokercatalog.Service{
+					{
+						Name: "offering-1",
+						Plans: []brokercatalog.Plan{
+							{Name: "unchanged", Description: "same"},
+							{Name: "new-plan"},
+							{
+								Name:            "changed",
+								Description:     "new description",
+								Free:            &notFree,
+								MaintenanceInfo: &brokercatalog.MaintenanceInfo{Version: "2.0.0"},
+							},
+						},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(
+				[]ccv3.ServiceOfferingWithPlans{
+					{
+						Name: "offering-1",
+						Plans: []resources.ServicePlan{
+							{GUID: "unchanged-guid", Name: "unchanged", Description: "same", Free: true},
+							{GUID: "changed-guid", Name: "changed", Description: "old description", Free: true, MaintenanceInfoVersion: "1.0.0"},
+							{GUID: "gone-guid", Name: "gone", Free: true},
+						},
+					},
+					{
+						Name: "offering-2",
+						Plans: []resources.ServicePlan{
+							{GUID: "other-gone-guid", Name: "also-gone", Free: true},
+						},
+					},
+				},
+				ccv3.Warnings{"plans-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{ServicePlanGUID: "gone-guid"},
+					{ServicePlanGUID: "gone-guid"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetServiceBrokerCatalogDiff("some-broker", catalog)
+		})
+
+		It("returns the added, removed and changed plans in order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("plans-warning", "instances-warning"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansWithOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"some-broker"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: []string{"gone-guid", "other-gone-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(changes).To(Equal([]ServicePlanChange{
+				{
+					ServiceOfferingName: "offering-1",
+					ServicePlanName:     "changed",
+					Type:                ServicePlanChanged,
+					Details: []string{
+						`description: "old description" -> "new description"`,
+						"free: true -> false",
+						`maintenance_info.version: "1.0.0" -> "2.0.0"`,
+					},
+				},
+				{ServiceOfferingName: "offering-1", ServicePlanName: "gone", Type: ServicePlanRemoved, ServiceInstances: 2},
+				{ServiceOfferingName: "offering-1", ServicePlanName: "new-plan", Type: ServicePlanAdded},
+				{ServiceOfferingName: "offering-2", ServicePlanName: "also-gone", Type: ServicePlanRemoved},
+			}))
+		})
+
+		When("no plan is removed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(nil, nil, nil)
+			})
+
+			It("does not look for service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+				Expect(changes).To(HaveLen(3))
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(nil, ccv3.Warnings{"plans-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("plans-warning"))
+			})
+		})
+
+		When("getting the service instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("plans-warning", "instances-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceBrokerCatalog", func() {
+		It("returns the plans registered for the broker as a catalog", func() {
+			fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(
+				[]ccv3.ServiceOfferingWithPlans{{
+					Name:           "offering-1",
+					Description:    "an offering",
+					Bindable:       true,
+					PlanUpdateable: true,
+					Plans: []resources.ServicePlan{
+						{Name: "small", Description: "a small plan", Free: true, Bindable: true},
+						{Name: "large", MaintenanceInfoVersion: "2.0.0", MaintenanceInfoDescription: "upgrade"},
+					},
+				}},
+				ccv3.Warnings{"get-plans-warning"},
+				nil,
+			)
+
+			catalog, warnings, err := actor.GetServiceBrokerCatalog("some-broker")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-plans-warning"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansWithOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"some-broker"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			yes, no := true, false
+			Expect(catalog).To(Equal(brokercatalog.Catalog{Services: []brokercatalog.Service{{
+				Name:           "offering-1",
+				Description:    "an offering",
+				Bindable:       true,
+				PlanUpdateable: true,
+				Plans: []brokercatalog.Plan{
+					{Name: "small", Description: "a small plan", Free: &yes, Bindable: &yes, PlanUpdateable: &no},
+					{
+						Name:            "large",
+						Free:            &no,
+						Bindable:        &no,
+						PlanUpdateable:  &no,
+						MaintenanceInfo: &brokercatalog.MaintenanceInfo{Version: "2.0.0", Description: "upgrade"},
+					},
+				},
+			}}}))
+		})
+
+		When("getting the plans fails", func() {
+			It("returns the error and warnings", func() {
+				fakeCloudControllerClient.GetServicePlansWithOfferingsReturns(nil, ccv3.Warnings{"get-plans-warning"}, errors.New("get-plans-error"))
+
+				_, warnings, err := actor.GetServiceBrokerCatalog("some-broker")
+				Expect(err).To(MatchError("get-plans-error"))
+				Expect(warnings).To(ConsistOf("get-plans-warning"))
+			})
+		})
+	})
+})
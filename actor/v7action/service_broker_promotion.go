@@ -0,0 +1,272 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// SpaceScopedServiceBrokerSuffix is appended to the name of a space-scoped
+// service broker that is kept after its promotion for its service instances.
+const SpaceScopedServiceBrokerSuffix = "-space-scoped"
+
+// ServiceBrokerPromotion is the result of registering a space-scoped service
+// broker globally.
+type ServiceBrokerPromotion struct {
+	// OrgName is the org of the space of the space-scoped broker, which the
+	// plans of the global broker are made visible in.
+	OrgName string
+	// ServicePlans is the number of plans made visible in the org.
+	ServicePlans int
+	// SpaceScopedBrokerName is the name the space-scoped broker is kept under
+	// for its service instances. It is empty when the broker had no instances
+	// and was deleted.
+	SpaceScopedBrokerName string
+	// ServiceInstances are the instances of the plans of the space-scoped
+	// broker, sorted by name.
+	ServiceInstances []PromotedServiceInstance
+}
+
+// PromotedServiceInstance is an instance of a plan of a promoted space-scoped
+// service broker.
+type PromotedServiceInstance struct {
+	Name string
+	// Resolvable is true when the instance is still found by name in its
+	// space, with its plan of the kept space-scoped broker, after the
+	// promotion.
+	Resolvable bool
+}
+
+// PromoteServiceBroker registers a space-scoped service broker globally with
+// the same URL and the given credentials, and makes its plans visible in the
+// org of its space. Service instances cannot be moved to another broker, so a
+// space-scoped broker with instances is renamed and kept for them. A broker
+// without instances is deleted.
+func (actor Actor) PromoteServiceBroker(serviceBrokerName, username, password string) (ServiceBrokerPromotion, Warnings, error) {
+	var promotion ServiceBrokerPromotion
+
+	broker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	if err != nil {
+		return promotion, warnings, err
+	}
+	if broker.SpaceGUID == "" {
+		return promotion, warnings, actionerror.ServiceBrokerNotSpaceScopedError{Name: serviceBrokerName}
+	}
+
+	space, spaceWarnings, err := actor.GetSpaceByGUID(broker.SpaceGUID)
+	warnings = append(warnings, spaceWarnings...)
+	if err != nil {
+		return promotion, warnings, err
+	}
+
+	org, orgWarnings, err := actor.GetOrganizationByGUID(space.Relationships[constant.RelationshipTypeOrganization].GUID)
+	warnings = append(warnings, orgWarnings...)
+	if err != nil {
+		return promotion, warnings, err
+	}
+	promotion.OrgName = org.Name
+
+	planGUIDs, planWarnings, err := actor.servicePlanGUIDsOfBroker(serviceBrokerName)
+	warnings = append(warnings, planWarnings...)
+	if err != nil {
+		return promotion, warnings, err
+	}
+
+	instances, instanceWarnings, err := actor.serviceInstancesOfPlans(planGUIDs)
+	warnings = append(warnings, instanceWarnings...)
+	if err != nil {
+		return promotion, warnings, err
+	}
+
+	spaceScopedName := serviceBrokerName + SpaceScopedServiceBrokerSuffix
+	updateWarnings, err := actor.UpdateServiceBroker(broker.GUID, resources.ServiceBroker{Name: spaceScopedName})
+	warnings = append(warnings, updateWarnings...)
+	if err != nil {
+		return promotion, warnings, err
+	}
+
+	createWarnings, err := actor.CreateServiceBroker(resources.ServiceBroker{
+		Name:     serviceBrokerName,
+		Username: username,
+		Password: password,
+		URL:      broker.URL,
+	})
+	warnings = append(warnings, createWarnings...)
+	if err != nil {
+		undoWarnings, err := actor.undoServiceBrokerPromotion(broker, "", 0, err)
+		return promotion, append(warnings, undoWarnings...), err
+	}
+
+	globalBroker, globalWarnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	warnings = append(warnings, globalWarnings...)
+	if err != nil {
+		return promotion, warnings, actionerror.ServiceBrokerPromotionFailedError{
+			Name:                   serviceBrokerName,
+			Err:                    err,
+			SpaceScopedName:        spaceScopedName,
+			GlobalBrokerRegistered: true,
+		}
+	}
+
+	offerings, ccWarnings, err := actor.CloudControllerClient.GetServicePlansWithOfferings(
+		ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{serviceBrokerName}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		undoWarnings, err := actor.undoServiceBrokerPromotion(broker, globalBroker.GUID, 0, err)
+		return promotion, append(warnings, undoWarnings...), err
+	}
+
+	visibility := resources.ServicePlanVisibility{
+		Type:          resources.ServicePlanVisibilityOrganization,
+		Organizations: []resources.ServicePlanVisibilityDetail{{GUID: org.GUID}},
+	}
+	for _, offering := range offerings {
+		for _, plan := range offering.Plans {
+			_, ccWarnings, err = actor.CloudControllerClient.UpdateServicePlanVisibility(plan.GUID, visibility)
+			warnings = append(warnings, ccWarnings...)
+			if err != nil {
+				undoWarnings, err := actor.undoServiceBrokerPromotion(broker, globalBroker.GUID, promotion.ServicePlans, err)
+				return promotion, append(warnings, undoWarnings...), err
+			}
+			promotion.ServicePlans++
+		}
+	}
+
+	if len(instances) == 0 {
+		deleteWarnings, err := actor.DeleteServiceBroker(broker.GUID)
+		return promotion, append(warnings, deleteWarnings...), err
+	}
+	promotion.SpaceScopedBrokerName = spaceScopedName
+
+	sortedInstances := make([]resources.ServiceInstance, 0, len(instances))
+	for _, instance := range instances {
+		sortedInstances = append(sortedInstances, instance)
+	}
+	sort.Slice(sortedInstances, func(i, j int) bool {
+		return sortedInstances[i].Name < sortedInstances[j].Name
+	})
+
+	for _, instance := range sortedInstances {
+		resolvable, resolveWarnings, err := actor.serviceInstanceResolvesToBroker(instance, broker.GUID)
+		warnings = append(warnings, resolveWarnings...)
+		if err != nil {
+			return promotion, warnings, err
+		}
+		promotion.ServiceInstances = append(promotion.ServiceInstances, PromotedServiceInstance{
+			Name:       instance.Name,
+			Resolvable: resolvable,
+		})
+	}
+
+	return promotion, warnings, nil
+}
+
+// undoServiceBrokerPromotion deletes the global broker, if it was registered,
+// and then gives the space-scoped broker back its name. It always returns a
+// ServiceBrokerPromotionFailedError for cause, telling what is still changed.
+func (actor Actor) undoServiceBrokerPromotion(broker resources.ServiceBroker, globalBrokerGUID string, visiblePlans int, cause error) (Warnings, error) {
+	failure := actionerror.ServiceBrokerPromotionFailedError{
+		Name:            broker.Name,
+		Err:             cause,
+		SpaceScopedName: broker.Name + SpaceScopedServiceBrokerSuffix,
+	}
+
+	var warnings Warnings
+	if globalBrokerGUID != "" {
+		deleteWarnings, err := actor.DeleteServiceBroker(globalBrokerGUID)
+		warnings = append(warnings, deleteWarnings...)
+		if err != nil {
+			failure.GlobalBrokerRegistered = true
+			failure.VisiblePlans = visiblePlans
+			failure.UndoErr = err
+			return warnings, failure
+		}
+	}
+
+	renameWarnings, err := actor.UpdateServiceBroker(broker.GUID, resources.ServiceBroker{Name: broker.Name})
+	warnings = append(warnings, renameWarnings...)
+	if err != nil {
+		failure.UndoErr = err
+		return warnings, failure
+	}
+	failure.SpaceScopedName = ""
+	return warnings, failure
+}
+
+// serviceInstanceResolvesToBroker looks the instance up by name in its space
+// and tells whether it is still found with its plan of the given broker.
+func (actor Actor) serviceInstanceResolvesToBroker(instance resources.ServiceInstance, brokerGUID string) (bool, Warnings, error) {
+	found, included, warnings, err := actor.getServiceInstanceByNameAndSpace(
+		instance.Name,
+		instance.SpaceGUID,
+		ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"name", "guid"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"name", "guid"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"name", "guid"}},
+	)
+	switch err.(type) {
+	case nil:
+	case actionerror.ServiceInstanceNotFoundError:
+		return false, Warnings(warnings), nil
+	default:
+		return false, Warnings(warnings), err
+	}
+
+	if found.GUID != instance.GUID || found.ServicePlanGUID != instance.ServicePlanGUID {
+		return false, Warnings(warnings), nil
+	}
+	for _, plan := range included.ServicePlans {
+		if plan.GUID != instance.ServicePlanGUID {
+			continue
+		}
+		for _, includedBroker := range included.ServiceBrokers {
+			if includedBroker.GUID == brokerGUID {
+				return true, Warnings(warnings), nil
+			}
+		}
+	}
+	return false, Warnings(warnings), nil
+}
+
+func (actor Actor) servicePlanGUIDsOfBroker(serviceBrokerName string) ([]string, Warnings, error) {
+	offerings, warnings, err := actor.CloudControllerClient.GetServicePlansWithOfferings(
+		ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{serviceBrokerName}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var planGUIDs []string
+	for _, offering := range offerings {
+		for _, plan := range offering.Plans {
+			planGUIDs = append(planGUIDs, plan.GUID)
+		}
+	}
+	return planGUIDs, Warnings(warnings), nil
+}
+
+// serviceInstancesOfPlans returns the service instances of the plans by GUID.
+func (actor Actor) serviceInstancesOfPlans(planGUIDs []string) (map[string]resources.ServiceInstance, Warnings, error) {
+	instancesByGUID := make(map[string]resources.ServiceInstance)
+	if len(planGUIDs) == 0 {
+		return instancesByGUID, nil, nil
+	}
+
+	instances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: planGUIDs},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	for _, instance := range instances {
+		instancesByGUID[instance.GUID] = instance
+	}
+	return instancesByGUID, Warnings(warnings), nil
+}
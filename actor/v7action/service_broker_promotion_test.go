@@ -0,0 +1,293 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Promotion Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("PromoteServiceBroker", func() {
+		var (
+			promotion  ServiceBrokerPromotion
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns([]resources.ServiceBroker{
+				{GUID: "broker-guid", Name: "my-broker", URL: "https://broker.example.com", SpaceGUID: "space-guid"},
+			}, ccv3.Warnings{"get-broker-warning"}, nil)
+			fakeCloudControllerClient.GetServiceBrokersReturnsOnCall(1, []resources.ServiceBroker{
+				{GUID: "global-broker-guid", Name: "my-broker", URL: "https://broker.example.com"},
+			}, ccv3.Warnings{"get-global-broker-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{
+				GUID: "space-guid",
+				Relationships: map[constant.RelationshipType]resources.Relationship{
+					constant.RelationshipTypeOrganization: {GUID: "org-guid"},
+				},
+			}}, ccv3.IncludedResources{}, nil, nil)
+			fakeCloudControllerClient.GetOrganizationReturns(resources.Organization{GUID: "org-guid", Name: "my-org"}, nil, nil)
+			fakeCloudControllerClient.GetServicePlansWithOfferingsReturnsOnCall(0, []ccv3.ServiceOfferingWithPlans{
+				{Name: "offering", Plans: []resources.ServicePlan{{GUID: "old-plan-1"}, {GUID: "old-plan-2"}}},
+			}, nil, nil)
+			fakeCloudControllerClient.GetServicePlansWithOfferingsReturnsOnCall(1, []ccv3.ServiceOfferingWithPlans{
+				{Name: "offering", Plans: []resources.ServicePlan{{GUID: "new-plan-1"}, {GUID: "new-plan-2"}}},
+			}, ccv3.Warnings{"get-plans-warning"}, nil)
+			instances := []resources.ServiceInstance{
+				{GUID: "queue-guid", Name: "queue", SpaceGUID: "space-guid", ServicePlanGUID: "old-plan-2"},
+				{GUID: "db-guid", Name: "db", SpaceGUID: "other-space-guid", ServicePlanGUID: "old-plan-1"},
+			}
+			fakeCloudControllerClient.GetServiceInstancesReturns(instances, ccv3.IncludedResources{}, nil, nil)
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceStub = func(name, spaceGUID string, query ...ccv3.Query) (resources.ServiceInstance, ccv3.IncludedResources, ccv3.Warnings, error) {
+				for _, instance := range instances {
+					if instance.Name == name && instance.SpaceGUID == spaceGUID {
+						return instance, ccv3.IncludedResources{
+							ServicePlans:   []resources.ServicePlan{{GUID: instance.ServicePlanGUID}},
+							ServiceBrokers: []resources.ServiceBroker{{GUID: "broker-guid", Name: "my-broker-space-scoped"}},
+						}, ccv3.Warnings{"get-instance-warning"}, nil
+					}
+				}
+				return resources.ServiceInstance{}, ccv3.IncludedResources{}, nil, ccerror.ServiceInstanceNotFoundError{Name: name}
+			}
+			fakeCloudControllerClient.CreateServiceBrokerReturns("create-job", ccv3.Warnings{"create-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			promotion, warnings, executeErr = actor.PromoteServiceBroker("my-broker", "user", "password")
+		})
+
+		It("registers the broker globally and keeps the space-scoped broker for its instances", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-broker-warning",
+				"create-warning",
+				"get-global-broker-warning",
+				"get-plans-warning",
+				"get-instance-warning",
+				"get-instance-warning",
+			))
+
+			Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(1))
+			brokerGUID, update := fakeCloudControllerClient.UpdateServiceBrokerArgsForCall(0)
+			Expect(brokerGUID).To(Equal("broker-guid"))
+			Expect(update).To(Equal(resources.ServiceBroker{Name: "my-broker-space-scoped"}))
+
+			Expect(fakeCloudControllerClient.CreateServiceBrokerArgsForCall(0)).To(Equal(resources.ServiceBroker{
+				Name:     "my-broker",
+				Username: "user",
+				Password: "password",
+				URL:      "https://broker.example.com",
+			}))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: []string{"old-plan-1", "old-plan-2"}},
+			))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(2))
+			name, spaceGUID, query := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("db"))
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+			Expect(query).To(ContainElement(
+				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"name", "guid"}},
+			))
+
+			Expect(fakeCloudControllerClient.UpdateServicePlanVisibilityCallCount()).To(Equal(2))
+			planGUID, visibility := fakeCloudControllerClient.UpdateServicePlanVisibilityArgsForCall(1)
+			Expect(planGUID).To(Equal("new-plan-2"))
+			Expect(visibility).To(Equal(resources.ServicePlanVisibility{
+				Type:          resources.ServicePlanVisibilityOrganization,
+				Organizations: []resources.ServicePlanVisibilityDetail{{GUID: "org-guid"}},
+			}))
+
+			Expect(fakeCloudControllerClient.DeleteServiceBrokerCallCount()).To(Equal(0))
+			Expect(promotion).To(Equal(ServiceBrokerPromotion{
+				OrgName:               "my-org",
+				ServicePlans:          2,
+				SpaceScopedBrokerName: "my-broker-space-scoped",
+				ServiceInstances: []PromotedServiceInstance{
+					{Name: "db", Resolvable: true},
+					{Name: "queue", Resolvable: true},
+				},
+			}))
+		})
+
+		When("the broker has no service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("deletes the space-scoped broker", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteServiceBrokerCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceBrokerArgsForCall(0)).To(Equal("broker-guid"))
+				Expect(promotion.SpaceScopedBrokerName).To(BeEmpty())
+			})
+		})
+
+		When("an instance cannot be found in its space after the promotion", func() {
+			BeforeEach(func() {
+				stubInstanceLookup(fakeCloudControllerClient, "queue", resources.ServiceInstance{}, ccv3.IncludedResources{}, nil, ccerror.ServiceInstanceNotFoundError{Name: "queue"})
+			})
+
+			It("reports it as not resolvable", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(promotion.ServiceInstances).To(Equal([]PromotedServiceInstance{
+					{Name: "db", Resolvable: true},
+					{Name: "queue", Resolvable: false},
+				}))
+			})
+		})
+
+		When("an instance resolves through another broker after the promotion", func() {
+			BeforeEach(func() {
+				stubInstanceLookup(fakeCloudControllerClient, "db", resources.ServiceInstance{GUID: "db-guid", Name: "db", ServicePlanGUID: "old-plan-1"}, ccv3.IncludedResources{
+					ServicePlans:   []resources.ServicePlan{{GUID: "old-plan-1"}},
+					ServiceBrokers: []resources.ServiceBroker{{GUID: "global-broker-guid", Name: "my-broker"}},
+				}, nil, nil)
+			})
+
+			It("reports it as not resolvable", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(promotion.ServiceInstances).To(Equal([]PromotedServiceInstance{
+					{Name: "db", Resolvable: false},
+					{Name: "queue", Resolvable: true},
+				}))
+			})
+		})
+
+		When("looking up an instance after the promotion fails", func() {
+			BeforeEach(func() {
+				stubInstanceLookup(fakeCloudControllerClient, "queue", resources.ServiceInstance{}, ccv3.IncludedResources{}, ccv3.Warnings{"get-instance-warning"}, errors.New("lookup failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("lookup failed"))
+				Expect(warnings).To(ContainElement("get-instance-warning"))
+			})
+		})
+
+		When("the broker is not space-scoped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns([]resources.ServiceBroker{{GUID: "broker-guid", Name: "my-broker"}}, nil, nil)
+			})
+
+			It("returns an error without changing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotSpaceScopedError{Name: "my-broker"}))
+				Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateServiceBrokerCallCount()).To(Equal(0))
+			})
+		})
+
+		When("registering the global broker fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBrokerReturns("", ccv3.Warnings{"create-warning"}, errors.New("create failed"))
+			})
+
+			It("restores the name of the space-scoped broker", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerPromotionFailedError{
+					Name: "my-broker",
+					Err:  errors.New("create failed"),
+				}))
+				Expect(warnings).To(ContainElement("create-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(2))
+				brokerGUID, update := fakeCloudControllerClient.UpdateServiceBrokerArgsForCall(1)
+				Expect(brokerGUID).To(Equal("broker-guid"))
+				Expect(update).To(Equal(resources.ServiceBroker{Name: "my-broker"}))
+				Expect(fakeCloudControllerClient.DeleteServiceBrokerCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateServicePlanVisibilityCallCount()).To(Equal(0))
+			})
+
+			When("restoring the name fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateServiceBrokerReturnsOnCall(1, "", ccv3.Warnings{"restore-warning"}, errors.New("restore failed"))
+				})
+
+				It("reports that the broker is left renamed", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceBrokerPromotionFailedError{
+						Name:            "my-broker",
+						Err:             errors.New("create failed"),
+						SpaceScopedName: "my-broker-space-scoped",
+						UndoErr:         errors.New("restore failed"),
+					}))
+					Expect(warnings).To(ContainElement("restore-warning"))
+				})
+			})
+		})
+
+		When("making a plan visible fails partway", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateServicePlanVisibilityReturnsOnCall(1, resources.ServicePlanVisibility{}, ccv3.Warnings{"visibility-warning"}, errors.New("visibility failed"))
+				fakeCloudControllerClient.DeleteServiceBrokerReturns("", ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the global broker and then restores the name of the space-scoped broker", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerPromotionFailedError{
+					Name: "my-broker",
+					Err:  errors.New("visibility failed"),
+				}))
+				Expect(warnings).To(ContainElements("visibility-warning", "delete-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceBrokerCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceBrokerArgsForCall(0)).To(Equal("global-broker-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(2))
+				brokerGUID, update := fakeCloudControllerClient.UpdateServiceBrokerArgsForCall(1)
+				Expect(brokerGUID).To(Equal("broker-guid"))
+				Expect(update).To(Equal(resources.ServiceBroker{Name: "my-broker"}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+
+			When("deleting the global broker fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteServiceBrokerReturns("", nil, errors.New("delete failed"))
+				})
+
+				It("reports the global broker and the renamed broker as left in place", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceBrokerPromotionFailedError{
+						Name:                   "my-broker",
+						Err:                    errors.New("visibility failed"),
+						SpaceScopedName:        "my-broker-space-scoped",
+						GlobalBrokerRegistered: true,
+						VisiblePlans:           1,
+						UndoErr:                errors.New("delete failed"),
+					}))
+					Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
+
+// stubInstanceLookup makes the lookup of the named service instance return the
+// given values, and leaves the lookup of other instances to the current stub.
+func stubInstanceLookup(fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient, instanceName string, instance resources.ServiceInstance, included ccv3.IncludedResources, warnings ccv3.Warnings, err error) {
+	lookup := fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceStub
+	fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceStub = func(name, spaceGUID string, query ...ccv3.Query) (resources.ServiceInstance, ccv3.IncludedResources, ccv3.Warnings, error) {
+		if name == instanceName {
+			return instance, included, warnings, err
+		}
+		return lookup(name, spaceGUID, query...)
+	}
+}
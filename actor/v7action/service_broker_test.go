@@ -386,6 +386,64 @@ var _ = Describe("Service Broker Actions", func() {
 		})
 	})
 
+	Describe("RotateServiceBrokerCredentials", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]resources.ServiceBroker{{Name: "broker-name", GUID: "broker-guid", URL: "broker-url"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceBrokerReturns("some-job-url", ccv3.Warnings{"update-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RotateServiceBrokerCredentials("broker-name", "new-username", "new-password")
+		})
+
+		It("updates only the credentials of the broker", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "update-warning", "poll-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(1))
+			guid, serviceBroker := fakeCloudControllerClient.UpdateServiceBrokerArgsForCall(0)
+			Expect(guid).To(Equal("broker-guid"))
+			Expect(serviceBroker).To(Equal(resources.ServiceBroker{
+				Username: "new-username",
+				Password: "new-password",
+			}))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+
+		When("the service broker is not found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns the error without updating", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "broker-name"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateServiceBrokerCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, errors.New("job failed"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("job failed"))
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning", "poll-warning"))
+			})
+		})
+	})
+
 	Describe("DeleteServiceBroker", func() {
 		var (
 			serviceBrokerGUID = "some-service-broker-guid"
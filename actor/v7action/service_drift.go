@@ -0,0 +1,89 @@
+package v7action
+
+// DeclaredServiceInstance is a service instance that is required in a space,
+// along with the apps that must be bound to it. Offering and plan are only
+// checked when they are set.
+type DeclaredServiceInstance struct {
+	Name      string
+	Offering  string
+	Plan      string
+	BoundApps []string
+}
+
+// ServicePlanDrift is a service instance whose offering or plan differs from
+// the declared one.
+type ServicePlanDrift struct {
+	ServiceInstanceName string
+	DeclaredOffering    string
+	DeclaredPlan        string
+	ActualOffering      string
+	ActualPlan          string
+}
+
+// ServiceBindingDrift is an app that is not bound to a service instance it is
+// declared to be bound to.
+type ServiceBindingDrift struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+// ServiceDrift is how the service instances of a space differ from the
+// declared ones.
+type ServiceDrift struct {
+	MissingServiceInstances []string
+	PlanDrifts              []ServicePlanDrift
+	// MissingBindings only lists the bindings of service instances that
+	// exist.
+	MissingBindings []ServiceBindingDrift
+}
+
+// Count returns the number of differences.
+func (drift ServiceDrift) Count() int {
+	return len(drift.MissingServiceInstances) + len(drift.PlanDrifts) + len(drift.MissingBindings)
+}
+
+// GetServiceDrift compares the declared service instances with the ones in
+// the space.
+func (actor Actor) GetServiceDrift(spaceGUID string, declared []DeclaredServiceInstance) (ServiceDrift, Warnings, error) {
+	instances, warnings, err := actor.GetServiceInstancesForSpace(spaceGUID, false, "")
+	if err != nil {
+		return ServiceDrift{}, warnings, err
+	}
+
+	instancesByName := map[string]ServiceInstance{}
+	for _, instance := range instances {
+		instancesByName[instance.Name] = instance
+	}
+
+	var drift ServiceDrift
+	for _, service := range declared {
+		instance, ok := instancesByName[service.Name]
+		if !ok {
+			drift.MissingServiceInstances = append(drift.MissingServiceInstances, service.Name)
+			continue
+		}
+
+		if (service.Offering != "" && service.Offering != instance.ServiceOfferingName) ||
+			(service.Plan != "" && service.Plan != instance.ServicePlanName) {
+			drift.PlanDrifts = append(drift.PlanDrifts, ServicePlanDrift{
+				ServiceInstanceName: service.Name,
+				DeclaredOffering:    service.Offering,
+				DeclaredPlan:        service.Plan,
+				ActualOffering:      instance.ServiceOfferingName,
+				ActualPlan:          instance.ServicePlanName,
+			})
+		}
+
+		boundApps := map[string]bool{}
+		for _, appName := range instance.BoundApps {
+			boundApps[appName] = true
+		}
+		for _, appName := range service.BoundApps {
+			if !boundApps[appName] {
+				drift.MissingBindings = append(drift.MissingBindings, ServiceBindingDrift{AppName: appName, ServiceInstanceName: service.Name})
+			}
+		}
+	}
+
+	return drift, warnings, nil
+}
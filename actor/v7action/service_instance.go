@@ -33,6 +33,22 @@ func (actor Actor) GetServiceInstanceByNameAndSpace(serviceInstanceName string,
 	return serviceInstance, Warnings(warnings), err
 }
 
+// GetServiceInstanceByGUID returns the service instance with the given guid.
+func (actor Actor) GetServiceInstanceByGUID(serviceInstanceGUID string) (resources.ServiceInstance, Warnings, error) {
+	serviceInstances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{serviceInstanceGUID}},
+	)
+	if err != nil {
+		return resources.ServiceInstance{}, Warnings(warnings), err
+	}
+
+	if len(serviceInstances) == 0 {
+		return resources.ServiceInstance{}, Warnings(warnings), actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID}
+	}
+
+	return serviceInstances[0], Warnings(warnings), nil
+}
+
 func (actor Actor) CreateUserProvidedServiceInstance(serviceInstance resources.ServiceInstance) (Warnings, error) {
 	serviceInstance.Type = resources.UserProvidedServiceInstance
 	_, warnings, err := actor.CloudControllerClient.CreateServiceInstance(serviceInstance)
@@ -184,6 +200,40 @@ func (actor Actor) UpgradeManagedServiceInstance(serviceInstanceName string, spa
 	return stream, Warnings(warnings), err
 }
 
+// ServiceInstanceUpgrade compares the maintenance_info version of a service
+// instance with the version of its service plan.
+type ServiceInstanceUpgrade struct {
+	CurrentVersion   string
+	AvailableVersion string
+	// Description is the description the broker gives of the available
+	// version.
+	Description string
+	Available   bool
+}
+
+// GetServiceInstanceUpgrade returns the maintenance_info version of the
+// service instance and the version that upgrade-service would upgrade it to.
+// User-provided service instances have no upgrades.
+func (actor Actor) GetServiceInstanceUpgrade(serviceInstanceName string, spaceGUID string) (ServiceInstanceUpgrade, Warnings, error) {
+	serviceInstance, _, warnings, err := actor.getServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil || serviceInstance.Type == resources.UserProvidedServiceInstance {
+		return ServiceInstanceUpgrade{}, Warnings(warnings), err
+	}
+
+	servicePlan, planWarnings, err := actor.CloudControllerClient.GetServicePlanByGUID(serviceInstance.ServicePlanGUID)
+	warnings = append(warnings, planWarnings...)
+	if err != nil {
+		return ServiceInstanceUpgrade{}, Warnings(warnings), err
+	}
+
+	return ServiceInstanceUpgrade{
+		CurrentVersion:   serviceInstance.MaintenanceInfoVersion,
+		AvailableVersion: servicePlan.MaintenanceInfoVersion,
+		Description:      servicePlan.MaintenanceInfoDescription,
+		Available:        serviceInstance.UpgradeAvailable.Value,
+	}, Warnings(warnings), nil
+}
+
 func (actor Actor) RenameServiceInstance(currentServiceInstanceName, spaceGUID, newServiceInstanceName string) (Warnings, error) {
 	var (
 		serviceInstance resources.ServiceInstance
@@ -59,11 +59,25 @@ type ServiceInstanceDetails struct {
 }
 
 func (actor Actor) GetServiceInstanceDetails(serviceInstanceName string, spaceGUID string, omitApps bool) (ServiceInstanceDetails, Warnings, error) {
+	return actor.serviceInstanceDetails(func() (ServiceInstanceDetails, ccv3.Warnings, error) {
+		return actor.getServiceInstanceDetails(serviceInstanceName, spaceGUID)
+	}, spaceGUID, omitApps)
+}
+
+// GetServiceInstanceDetailsByGUID returns the details of the service instance
+// with the given GUID, which must be in the given space.
+func (actor Actor) GetServiceInstanceDetailsByGUID(serviceInstanceGUID string, spaceGUID string, omitApps bool) (ServiceInstanceDetails, Warnings, error) {
+	return actor.serviceInstanceDetails(func() (ServiceInstanceDetails, ccv3.Warnings, error) {
+		return actor.getServiceInstanceDetailsByGUID(serviceInstanceGUID, spaceGUID)
+	}, spaceGUID, omitApps)
+}
+
+func (actor Actor) serviceInstanceDetails(getDetails func() (ServiceInstanceDetails, ccv3.Warnings, error), spaceGUID string, omitApps bool) (ServiceInstanceDetails, Warnings, error) {
 	var serviceInstanceDetails ServiceInstanceDetails
 
 	warnings, err := railway.Sequentially(
 		func() (warnings ccv3.Warnings, err error) {
-			serviceInstanceDetails, warnings, err = actor.getServiceInstanceDetails(serviceInstanceName, spaceGUID)
+			serviceInstanceDetails, warnings, err = getDetails()
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
@@ -109,8 +123,15 @@ func (actor Actor) GetServiceInstanceParameters(serviceInstanceName string, spac
 	return parameters, Warnings(warnings), nil
 }
 
-func (actor Actor) getServiceInstanceDetails(serviceInstanceName string, spaceGUID string) (ServiceInstanceDetails, ccv3.Warnings, error) {
-	query := []ccv3.Query{
+// GetServiceInstanceParametersByGUID returns the parameters of the service
+// instance with the given GUID.
+func (actor Actor) GetServiceInstanceParametersByGUID(serviceInstanceGUID string) (ServiceInstanceParameters, Warnings, error) {
+	parameters, warnings, err := actor.getServiceInstanceParameters(serviceInstanceGUID)
+	return parameters, Warnings(warnings), err
+}
+
+func serviceInstanceDetailsQuery() []ccv3.Query {
+	return []ccv3.Query{
 		{
 			Key:    ccv3.FieldsServicePlan,
 			Values: []string{"name", "guid"},
@@ -132,8 +153,10 @@ func (actor Actor) getServiceInstanceDetails(serviceInstanceName string, spaceGU
 			Values: []string{"name", "guid"},
 		},
 	}
+}
 
-	serviceInstance, included, warnings, err := actor.CloudControllerClient.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID, query...)
+func (actor Actor) getServiceInstanceDetails(serviceInstanceName string, spaceGUID string) (ServiceInstanceDetails, ccv3.Warnings, error) {
+	serviceInstance, included, warnings, err := actor.CloudControllerClient.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID, serviceInstanceDetailsQuery()...)
 	switch err.(type) {
 	case nil:
 	case ccerror.ServiceInstanceNotFoundError:
@@ -142,7 +165,30 @@ func (actor Actor) getServiceInstanceDetails(serviceInstanceName string, spaceGU
 		return ServiceInstanceDetails{}, warnings, err
 	}
 
-	result := ServiceInstanceDetails{
+	return newServiceInstanceDetails(serviceInstance, included), warnings, nil
+}
+
+func (actor Actor) getServiceInstanceDetailsByGUID(serviceInstanceGUID string, spaceGUID string) (ServiceInstanceDetails, ccv3.Warnings, error) {
+	query := append(
+		serviceInstanceDetailsQuery(),
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{serviceInstanceGUID}},
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+
+	serviceInstances, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(query...)
+	if err != nil {
+		return ServiceInstanceDetails{}, warnings, err
+	}
+
+	if len(serviceInstances) == 0 {
+		return ServiceInstanceDetails{}, warnings, actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID}
+	}
+
+	return newServiceInstanceDetails(serviceInstances[0], included), warnings, nil
+}
+
+func newServiceInstanceDetails(serviceInstance resources.ServiceInstance, included ccv3.IncludedResources) ServiceInstanceDetails {
+	return ServiceInstanceDetails{
 		ServiceInstance:   serviceInstance,
 		ServicePlan:       extractServicePlan(included),
 		ServiceOffering:   extractServiceOffering(included),
@@ -150,8 +196,6 @@ func (actor Actor) getServiceInstanceDetails(serviceInstanceName string, spaceGU
 		SpaceName:         extract.First("Name", included.Spaces),
 		OrganizationName:  extract.First("Name", included.Organizations),
 	}
-
-	return result, warnings, nil
 }
 
 func (actor Actor) getServiceInstanceParameters(serviceInstanceGUID string) (ServiceInstanceParameters, ccv3.Warnings, error) {
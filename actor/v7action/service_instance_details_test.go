@@ -708,6 +708,59 @@ var _ = Describe("Service Instance Details Action", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceDetailsByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{
+					Type:      resources.UserProvidedServiceInstance,
+					Name:      "some-service-instance-name",
+					GUID:      "some-service-instance-guid",
+					SpaceGUID: "some-space-guid",
+				}},
+				ccv3.IncludedResources{
+					Spaces:        []resources.Space{{Name: "some-space-name"}},
+					Organizations: []resources.Organization{{Name: "some-org-name"}},
+				},
+				ccv3.Warnings{"some-service-instance-warning"},
+				nil,
+			)
+		})
+
+		It("gets the service instance with its details in a single request", func() {
+			serviceInstance, warnings, err := actor.GetServiceInstanceDetailsByGUID("some-service-instance-guid", "some-space-guid", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-service-instance-warning"))
+			Expect(serviceInstance.Name).To(Equal("some-service-instance-name"))
+			Expect(serviceInstance.SpaceName).To(Equal("some-space-name"))
+			Expect(serviceInstance.OrganizationName).To(Equal("some-org-name"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElements(
+				ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"name", "guid"}},
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-service-instance-guid"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+
+		When("there is no service instance with that GUID in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					nil,
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"some-service-instance-warning"},
+					nil,
+				)
+			})
+
+			It("returns a not found error and warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceDetailsByGUID("some-service-instance-guid", "some-space-guid", true)
+				Expect(err).To(MatchError(actionerror.ServiceInstanceNotFoundError{GUID: "some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf("some-service-instance-warning"))
+			})
+		})
+	})
+
 	Describe("GetServiceInstanceParameters", func() {
 		const (
 			serviceInstanceName = "some-service-instance-name"
@@ -865,4 +918,24 @@ var _ = Describe("Service Instance Details Action", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstanceParametersByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+				map[string]interface{}{"foo": "bar"},
+				ccv3.Warnings{"some-parameters-warning"},
+				nil,
+			)
+		})
+
+		It("gets the parameters without looking up the service instance", func() {
+			params, warnings, err := actor.GetServiceInstanceParametersByGUID("some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-parameters-warning"))
+			Expect(params).To(Equal(ServiceInstanceParameters{"foo": "bar"}))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+		})
+	})
 })
@@ -163,14 +163,16 @@ var _ = Describe("Service Instance List Action", func() {
 			warnings         Warnings
 			executionError   error
 			omitApps         bool
+			labelSelector    string
 		)
 
 		BeforeEach(func() {
 			omitApps = false
+			labelSelector = ""
 		})
 
 		JustBeforeEach(func() {
-			serviceInstances, warnings, executionError = actor.GetServiceInstancesForSpace(spaceGUID, omitApps)
+			serviceInstances, warnings, executionError = actor.GetServiceInstancesForSpace(spaceGUID, omitApps, labelSelector)
 		})
 
 		It("makes the correct call to get service instances", func() {
@@ -200,6 +202,18 @@ var _ = Describe("Service Instance List Action", func() {
 			))
 		})
 
+		When("a label selector is given", func() {
+			BeforeEach(func() {
+				labelSelector = "env=prod"
+			})
+
+			It("filters the service instances by the label selector", func() {
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+				))
+			})
+		})
+
 		When("omit apps is set to true", func() {
 			BeforeEach(func() {
 				omitApps = true
@@ -387,4 +401,68 @@ var _ = Describe("Service Instance List Action", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceDrift", func() {
+		var (
+			declared   []DeclaredServiceInstance
+			drift      ServiceDrift
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			drift, warnings, executeErr = actor.GetServiceDrift(spaceGUID, declared)
+		})
+
+		When("the space matches the declared service instances", func() {
+			BeforeEach(func() {
+				declared = []DeclaredServiceInstance{
+					{Name: "msi1", Offering: "fake-offering-1", Plan: "fake-plan-1", BoundApps: []string{"great-app-1"}},
+					{Name: "upsi"},
+				}
+			})
+
+			It("returns no drift", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("a warning", "bindings warning"))
+				Expect(drift.Count()).To(Equal(0))
+			})
+		})
+
+		When("the space differs from the declared service instances", func() {
+			BeforeEach(func() {
+				declared = []DeclaredServiceInstance{
+					{Name: "missing-db", BoundApps: []string{"great-app-1"}},
+					{Name: "msi1", Plan: "fake-plan-2", BoundApps: []string{"great-app-1", "new-app"}},
+					{Name: "msi2", Offering: "other-offering"},
+				}
+			})
+
+			It("reports the missing instances, plan differences and missing bindings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(drift).To(Equal(ServiceDrift{
+					MissingServiceInstances: []string{"missing-db"},
+					PlanDrifts: []ServicePlanDrift{
+						{ServiceInstanceName: "msi1", DeclaredPlan: "fake-plan-2", ActualOffering: "fake-offering-1", ActualPlan: "fake-plan-1"},
+						{ServiceInstanceName: "msi2", DeclaredOffering: "other-offering", ActualOffering: "fake-offering-2", ActualPlan: "fake-plan-2"},
+					},
+					MissingBindings: []ServiceBindingDrift{
+						{AppName: "new-app", ServiceInstanceName: "msi1"},
+					},
+				}))
+				Expect(drift.Count()).To(Equal(4))
+			})
+		})
+
+		When("getting the service instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"some-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
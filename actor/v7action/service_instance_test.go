@@ -96,6 +96,65 @@ var _ = Describe("Service Instance Actions", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceByGUID", func() {
+		var (
+			serviceInstance resources.ServiceInstance
+			warnings        Warnings
+			executionError  error
+		)
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executionError = actor.GetServiceInstanceByGUID("some-service-instance-guid")
+		})
+
+		When("the cloud controller request is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns([]resources.ServiceInstance{{
+					Name:      "some-service-instance",
+					GUID:      "some-service-instance-guid",
+					SpaceGUID: "some-space-guid",
+				}}, ccv3.IncludedResources{}, ccv3.Warnings{"some-service-instance-warning"}, nil)
+			})
+
+			It("returns the service instance and warnings", func() {
+				Expect(executionError).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(resources.ServiceInstance{
+					Name:      "some-service-instance",
+					GUID:      "some-service-instance-guid",
+					SpaceGUID: "some-space-guid",
+				}))
+				Expect(warnings).To(ConsistOf("some-service-instance-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-service-instance-guid"}},
+				))
+			})
+		})
+
+		When("the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"some-service-instance-warning"}, nil)
+			})
+
+			It("returns an actor error and warnings", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceInstanceNotFoundError{GUID: "some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf("some-service-instance-warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"some-service-instance-warning"}, errors.New("no service instance"))
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(executionError).To(MatchError("no service instance"))
+				Expect(warnings).To(ConsistOf("some-service-instance-warning"))
+			})
+		})
+	})
+
 	Describe("CreateUserProvidedServiceInstance", func() {
 		When("the service instance is created successfully", func() {
 			It("returns warnings", func() {
@@ -1427,4 +1486,91 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstanceUpgrade", func() {
+		var (
+			upgrade    ServiceInstanceUpgrade
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{
+					Type:                   resources.ManagedServiceInstance,
+					ServicePlanGUID:        "plan-guid",
+					MaintenanceInfoVersion: "1.0.0",
+					UpgradeAvailable:       types.NewOptionalBoolean(true),
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get SI warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlanByGUIDReturns(
+				resources.ServicePlan{MaintenanceInfoVersion: "1.1.0", MaintenanceInfoDescription: "Adds TLS"},
+				ccv3.Warnings{"get plan warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			upgrade, warnings, executeErr = actor.GetServiceInstanceUpgrade("some-instance", "some-space-guid")
+		})
+
+		It("compares the version of the instance with the version of its plan", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get SI warning", "get plan warning"))
+			Expect(fakeCloudControllerClient.GetServicePlanByGUIDArgsForCall(0)).To(Equal("plan-guid"))
+			Expect(upgrade).To(Equal(ServiceInstanceUpgrade{
+				CurrentVersion:   "1.0.0",
+				AvailableVersion: "1.1.0",
+				Description:      "Adds TLS",
+				Available:        true,
+			}))
+		})
+
+		When("the service instance is user-provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{Type: resources.UserProvidedServiceInstance},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns no upgrade", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(upgrade).To(Equal(ServiceInstanceUpgrade{}))
+				Expect(fakeCloudControllerClient.GetServicePlanByGUIDCallCount()).To(BeZero())
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get SI warning"},
+					ccerror.ServiceInstanceNotFoundError{Name: "some-instance"},
+				)
+			})
+
+			It("returns the appropriate error", func() {
+				Expect(warnings).To(ConsistOf("get SI warning"))
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "some-instance"}))
+			})
+		})
+
+		When("getting the plan fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanByGUIDReturns(resources.ServicePlan{}, ccv3.Warnings{"get plan warning"}, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(warnings).To(ConsistOf("get SI warning", "get plan warning"))
+				Expect(executeErr).To(MatchError("boom"))
+			})
+		})
+	})
 })
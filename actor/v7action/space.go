@@ -96,6 +96,22 @@ func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID strin
 	return resources.Space(ccv3Spaces[0]), Warnings(warnings), nil
 }
 
+// GetSpaceByGUID returns the space with the given guid.
+func (actor Actor) GetSpaceByGUID(spaceGUID string) (resources.Space, Warnings, error) {
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return resources.Space{}, Warnings(warnings), err
+	}
+
+	if len(spaces) == 0 {
+		return resources.Space{}, Warnings(warnings), actionerror.SpaceNotFoundError{GUID: spaceGUID}
+	}
+
+	return spaces[0], Warnings(warnings), nil
+}
+
 func (actor Actor) GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUID string) (SpaceSummary, Warnings, error) {
 	var allWarnings Warnings
 
@@ -111,6 +127,15 @@ func (actor Actor) GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUI
 		return SpaceSummary{}, allWarnings, err
 	}
 
+	summary, warnings, err := actor.GetSpaceSummaryForSpace(space, org)
+	return summary, append(allWarnings, warnings...), err
+}
+
+// GetSpaceSummaryForSpace returns the summary of a space that has already
+// been fetched, in the given organization.
+func (actor Actor) GetSpaceSummaryForSpace(space resources.Space, org resources.Organization) (SpaceSummary, Warnings, error) {
+	var allWarnings Warnings
+
 	apps, warnings, err := actor.GetApplicationsBySpace(space.GUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -243,30 +268,48 @@ func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]resources.Space, War
 }
 
 func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Warnings, error) {
+	jobURL, allWarnings, err := actor.startDeleteSpace(spaceName, orgName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, Warnings(ccWarnings)...)
+
+	return allWarnings, err
+}
+
+// StartDeleteSpaceByNameAndOrganizationName starts deleting the space with the
+// given name in the organization and returns the GUID of the job deleting it
+// without waiting for it.
+func (actor Actor) StartDeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (string, Warnings, error) {
+	jobURL, warnings, err := actor.startDeleteSpace(spaceName, orgName)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	return jobGUID(jobURL), warnings, nil
+}
+
+func (actor Actor) startDeleteSpace(spaceName string, orgName string) (ccv3.JobURL, Warnings, error) {
 	var allWarnings Warnings
 
 	org, actorWarnings, err := actor.GetOrganizationByName(orgName)
 	allWarnings = append(allWarnings, actorWarnings...)
 	if err != nil {
-		return allWarnings, err
+		return "", allWarnings, err
 	}
 
 	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return "", allWarnings, err
 	}
 
 	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
 	allWarnings = append(allWarnings, Warnings(deleteWarnings)...)
-	if err != nil {
-		return allWarnings, err
-	}
 
-	ccWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
-	allWarnings = append(allWarnings, Warnings(ccWarnings)...)
-
-	return allWarnings, err
+	return jobURL, allWarnings, err
 }
 
 func (actor Actor) RenameSpaceByNameAndOrganizationGUID(oldSpaceName, newSpaceName, orgGUID string) (resources.Space, Warnings, error) {
@@ -0,0 +1,97 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// SpaceDetails is a space along with the name of its quota and the number of
+// apps and service instances in it.
+type SpaceDetails struct {
+	resources.Space
+	QuotaName            string
+	AppCount             int
+	ServiceInstanceCount int
+}
+
+// GetOrganizationSpacesWithDetails returns the spaces of the organization
+// matching the label selector along with their quota names and app and
+// service instance counts.
+func (actor Actor) GetOrganizationSpacesWithDetails(orgGUID string, labelSelector string) ([]SpaceDetails, Warnings, error) {
+	spaces, warnings, err := actor.GetOrganizationSpacesWithLabelSelector(orgGUID, labelSelector)
+	if err != nil || len(spaces) == 0 {
+		return nil, warnings, err
+	}
+
+	var (
+		quotas           []resources.SpaceQuota
+		apps             []resources.Application
+		serviceInstances []resources.ServiceInstance
+	)
+
+	spaceGUIDs := make([]string, len(spaces))
+	for i, space := range spaces {
+		spaceGUIDs[i] = space.GUID
+	}
+
+	ccWarnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			quotas, warnings, err = actor.CloudControllerClient.GetSpaceQuotas(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+			)
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetApplications(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				apps = append(apps, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+				batch, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				serviceInstances = append(serviceInstances, batch...)
+				return warnings, err
+			})
+		},
+	)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	quotaNames := lookuptable.NameFromGUID(quotas)
+
+	appCounts := map[string]int{}
+	for _, app := range apps {
+		appCounts[app.SpaceGUID]++
+	}
+
+	serviceInstanceCounts := map[string]int{}
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceCounts[serviceInstance.SpaceGUID]++
+	}
+
+	details := make([]SpaceDetails, len(spaces))
+	for i, space := range spaces {
+		details[i] = SpaceDetails{
+			Space:                space,
+			QuotaName:            quotaNames[space.Relationships[constant.RelationshipTypeQuota].GUID],
+			AppCount:             appCounts[space.GUID],
+			ServiceInstanceCount: serviceInstanceCounts[space.GUID],
+		}
+	}
+
+	return details, warnings, nil
+}
@@ -0,0 +1,101 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Details Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationSpacesWithDetails", func() {
+		var (
+			space1     resources.Space
+			space2     resources.Space
+			details    []SpaceDetails
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			space1 = resources.Space{
+				GUID:          "space-1-guid",
+				Name:          "space-1",
+				CreatedAt:     "2020-01-01T00:00:00Z",
+				Relationships: resources.Relationships{constant.RelationshipTypeQuota: resources.Relationship{GUID: "quota-guid"}},
+			}
+			space2 = resources.Space{GUID: "space-2-guid", Name: "space-2"}
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{space1, space2},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]resources.SpaceQuota{{Quota: resources.Quota{GUID: "quota-guid", Name: "small"}}},
+				ccv3.Warnings{"get-quotas-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{SpaceGUID: "space-1-guid"}, {SpaceGUID: "space-2-guid"}, {SpaceGUID: "space-2-guid"}},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{SpaceGUID: "space-1-guid"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-service-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = actor.GetOrganizationSpacesWithDetails("org-guid", "")
+		})
+
+		It("returns the spaces with their quota names and counts", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning", "get-quotas-warning", "get-apps-warning", "get-service-instances-warning"))
+			Expect(details).To(Equal([]SpaceDetails{
+				{Space: space1, QuotaName: "small", AppCount: 1, ServiceInstanceCount: 1},
+				{Space: space2, AppCount: 2},
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-1-guid", "space-2-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		When("getting the space quotas fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv3.Warnings{"get-quotas-warning"}, errors.New("get-quotas-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-quotas-error"))
+				Expect(warnings).To(ConsistOf("get-spaces-warning", "get-quotas-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,9 +1,12 @@
 package v7action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/resources"
+	"gopkg.in/yaml.v2"
 )
 
 func (actor Actor) DiffSpaceManifest(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, Warnings, error) {
@@ -36,3 +39,58 @@ func (actor Actor) SetSpaceManifest(spaceGUID string, rawManifest []byte) (Warni
 	}
 	return allWarnings, nil
 }
+
+// GetRawSpaceManifest returns a manifest of every app in the space, as the
+// Cloud Controller generates them, with the apps ordered by name. The routes
+// and the services of the apps are left out unless asked for. No manifest is
+// returned when the space has no apps.
+func (actor Actor) GetRawSpaceManifest(spaceGUID string, withRoutes bool, withServices bool) ([]byte, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+
+	var applications []yaml.MapSlice
+	for _, app := range apps {
+		rawManifest, warnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var manifest struct {
+			Applications []yaml.MapSlice `yaml:"applications"`
+		}
+		err = yaml.Unmarshal(rawManifest, &manifest)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, application := range manifest.Applications {
+			var kept yaml.MapSlice
+			for _, item := range application {
+				switch item.Key {
+				case "routes", "no-route", "random-route", "default-route":
+					if !withRoutes {
+						continue
+					}
+				case "services":
+					if !withServices {
+						continue
+					}
+				}
+				kept = append(kept, item)
+			}
+			applications = append(applications, kept)
+		}
+	}
+
+	if len(applications) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	rawManifest, err := yaml.Marshal(yaml.MapSlice{{Key: "applications", Value: applications}})
+	return rawManifest, allWarnings, err
+}
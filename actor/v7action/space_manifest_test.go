@@ -189,4 +189,102 @@ var _ = Describe("Space Manifest Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRawSpaceManifest", func() {
+		var (
+			withRoutes   bool
+			withServices bool
+
+			manifestBytes []byte
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			withRoutes = true
+			withServices = true
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{Name: "web", GUID: "web-guid"},
+					{Name: "api", GUID: "api-guid"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationManifestStub = func(appGUID string) ([]byte, ccv3.Warnings, error) {
+				name := appGUID[:len(appGUID)-len("-guid")]
+				return []byte("applications:\n- name: " + name + "\n  routes:\n  - route: " + name + ".example.com\n  services:\n  - " + name + "-db\n  stack: cflinuxfs4\n"), ccv3.Warnings{name + "-manifest-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			manifestBytes, warnings, executeErr = actor.GetRawSpaceManifest("some-space-guid", withRoutes, withServices)
+		})
+
+		It("combines the manifests of the apps in the space ordered by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "api-manifest-warning", "web-manifest-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationManifestArgsForCall(0)).To(Equal("api-guid"))
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: api
+  routes:
+  - route: api.example.com
+  services:
+  - api-db
+  stack: cflinuxfs4
+- name: web
+  routes:
+  - route: web.example.com
+  services:
+  - web-db
+  stack: cflinuxfs4
+`))
+		})
+
+		When("the routes and services are left out", func() {
+			BeforeEach(func() {
+				withRoutes = false
+				withServices = false
+			})
+
+			It("removes them from every app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: api
+  stack: cflinuxfs4
+- name: web
+  stack: cflinuxfs4
+`))
+			})
+		})
+
+		When("the space has no apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns no manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(manifestBytes).To(BeNil())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+
+		When("getting a manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestStub = nil
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "manifest-warning"))
+			})
+		})
+	})
 })
@@ -24,6 +24,11 @@ func (actor Actor) ApplySpaceQuotaByName(quotaName, spaceGUID, orgGUID string) (
 func (actor Actor) CreateSpaceQuota(spaceQuotaName string, orgGuid string, limits QuotaLimits) (Warnings, error) {
 	allWarnings := Warnings{}
 
+	err := actor.requireQuotaCapabilities(limits)
+	if err != nil {
+		return nil, err
+	}
+
 	spaceQuota := resources.SpaceQuota{
 		Quota: resources.Quota{
 			Name: spaceQuotaName,
@@ -116,6 +121,11 @@ func (actor Actor) GetSpaceQuotasByOrgGUID(orgGUID string) ([]resources.SpaceQuo
 func (actor Actor) UpdateSpaceQuota(currentName, orgGUID, newName string, limits QuotaLimits) (Warnings, error) {
 	var allWarnings Warnings
 
+	err := actor.requireQuotaCapabilities(limits)
+	if err != nil {
+		return nil, err
+	}
+
 	oldSpaceQuota, warnings, err := actor.GetSpaceQuotaByName(currentName, orgGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -212,6 +212,72 @@ var _ = Describe("Space", func() {
 		})
 	})
 
+	Describe("GetSpaceByGUID", func() {
+		var (
+			space      resources.Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = actor.GetSpaceByGUID("some-space-guid")
+		})
+
+		When("the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"some-space-warning"},
+					nil,
+				)
+			})
+
+			It("returns the space and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(space).To(Equal(resources.Space{GUID: "some-space-guid", Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"some-space-warning"},
+					nil,
+				)
+			})
+
+			It("returns a SpaceNotFoundError with the GUID", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					nil,
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"some-space-warning"},
+					errors.New("get-spaces-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-spaces-error"))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+			})
+		})
+	})
+
 	Describe("GetSpaceByNameAndOrganization", func() {
 		var (
 			spaceName string
@@ -554,6 +620,58 @@ var _ = Describe("Space", func() {
 		})
 	})
 
+	Describe("StartDeleteSpaceByNameAndOrganizationName", func() {
+		var (
+			jobGUID  string
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+				ccv3.Warnings{"get-org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{Name: "some-space", GUID: "some-space-guid"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteSpaceReturns(
+				"https://api.example.com/v3/jobs/some-job-guid",
+				ccv3.Warnings{"delete-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			jobGUID, warnings, err = actor.StartDeleteSpaceByNameAndOrganizationName("some-space", "some-org")
+		})
+
+		It("starts deleting the space and returns the GUID of the job without polling it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobGUID).To(Equal("some-job-guid"))
+			Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+		})
+
+		When("deleting the space fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSpaceReturns("", ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("delete-error"))
+				Expect(jobGUID).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "delete-warning"))
+			})
+		})
+	})
+
 	Describe("RenameSpaceByNameAndOrganizationGUID", func() {
 		var (
 			oldSpaceName string
@@ -647,6 +765,24 @@ var _ = Describe("Space", func() {
 		})
 	})
 
+	Describe("GetSpaceSummaryForSpace", func() {
+		It("summarizes the space without looking it up again", func() {
+			spaceSummary, warnings, err := actor.GetSpaceSummaryForSpace(
+				resources.Space{GUID: "some-space-guid", Name: "some-space-name"},
+				resources.Organization{GUID: "some-org-guid", Name: "some-org-name"},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(spaceSummary.Name).To(Equal("some-space-name"))
+			Expect(spaceSummary.OrgName).To(Equal("some-org-name"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+	})
+
 	Describe("GetSpaceSummaryByNameAndOrganization", func() {
 		var (
 			spaceSummary SpaceSummary
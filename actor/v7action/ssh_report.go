@@ -0,0 +1,61 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// AppSSHStatus reports whether SSH is enabled for an app and whether it is
+// allowed in the app's space.
+type AppSSHStatus struct {
+	SpaceName       string
+	SpaceSSHAllowed bool
+	AppName         string
+	Enabled         bool
+	Reason          string
+}
+
+// GetSSHStatusesForSpaces returns the SSH status of every app in the given
+// spaces, ordered by space and then by app name.
+func (actor Actor) GetSSHStatusesForSpaces(spaces []resources.Space) ([]AppSSHStatus, Warnings, error) {
+	var (
+		allWarnings Warnings
+		statuses    []AppSSHStatus
+	)
+
+	for _, space := range spaces {
+		spaceSSHAllowed, warnings, err := actor.CloudControllerClient.GetSpaceFeature(space.GUID, "ssh")
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(
+			ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{space.GUID}},
+			ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			sshEnabled, warnings, err := actor.CloudControllerClient.GetSSHEnabled(app.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			statuses = append(statuses, AppSSHStatus{
+				SpaceName:       space.Name,
+				SpaceSSHAllowed: spaceSSHAllowed,
+				AppName:         app.Name,
+				Enabled:         sshEnabled.Enabled,
+				Reason:          sshEnabled.Reason,
+			})
+		}
+	}
+
+	return statuses, allWarnings, nil
+}
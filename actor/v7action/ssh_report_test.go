@@ -0,0 +1,131 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSH Report Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetSSHStatusesForSpaces", func() {
+		var (
+			spaces     []resources.Space
+			statuses   []AppSSHStatus
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			spaces = []resources.Space{
+				{GUID: "space-1-guid", Name: "space-1"},
+				{GUID: "space-2-guid", Name: "space-2"},
+			}
+
+			fakeCloudControllerClient.GetSpaceFeatureStub = func(spaceGUID string, _ string) (bool, ccv3.Warnings, error) {
+				return spaceGUID == "space-1-guid", ccv3.Warnings{"space-feature-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				if queries[0].Values[0] == "space-1-guid" {
+					return []resources.Application{
+						{GUID: "app-1-guid", Name: "app-1"},
+						{GUID: "app-2-guid", Name: "app-2"},
+					}, ccv3.Warnings{"get-apps-warning"}, nil
+				}
+				return []resources.Application{{GUID: "app-3-guid", Name: "app-3"}}, ccv3.Warnings{"get-apps-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSSHEnabledStub = func(appGUID string) (ccv3.SSHEnabled, ccv3.Warnings, error) {
+				switch appGUID {
+				case "app-1-guid":
+					return ccv3.SSHEnabled{Enabled: true}, ccv3.Warnings{"ssh-enabled-warning"}, nil
+				case "app-2-guid":
+					return ccv3.SSHEnabled{Enabled: false, Reason: "Disabled for this app"}, nil, nil
+				default:
+					return ccv3.SSHEnabled{Enabled: false, Reason: "Disabled for this space"}, nil, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			statuses, warnings, executeErr = actor.GetSSHStatusesForSpaces(spaces)
+		})
+
+		It("returns the SSH status of every app in the spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(statuses).To(Equal([]AppSSHStatus{
+				{SpaceName: "space-1", SpaceSSHAllowed: true, AppName: "app-1", Enabled: true},
+				{SpaceName: "space-1", SpaceSSHAllowed: true, AppName: "app-2", Enabled: false, Reason: "Disabled for this app"},
+				{SpaceName: "space-2", SpaceSSHAllowed: false, AppName: "app-3", Enabled: false, Reason: "Disabled for this space"},
+			}))
+			Expect(warnings).To(ConsistOf(
+				"space-feature-warning", "get-apps-warning", "ssh-enabled-warning",
+				"space-feature-warning", "get-apps-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetSpaceFeatureCallCount()).To(Equal(2))
+			spaceGUID, featureName := fakeCloudControllerClient.GetSpaceFeatureArgsForCall(0)
+			Expect(spaceGUID).To(Equal("space-1-guid"))
+			Expect(featureName).To(Equal("ssh"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(1)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-2-guid"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(fakeCloudControllerClient.GetSSHEnabledCallCount()).To(Equal(3))
+		})
+
+		When("getting the space feature fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceFeatureStub = nil
+				fakeCloudControllerClient.GetSpaceFeatureReturns(false, ccv3.Warnings{"space-feature-warning"}, errors.New("space-feature-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("space-feature-error"))
+				Expect(warnings).To(ConsistOf("space-feature-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsStub = nil
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("space-feature-warning", "get-apps-warning"))
+			})
+		})
+
+		When("getting whether SSH is enabled fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSSHEnabledStub = nil
+				fakeCloudControllerClient.GetSSHEnabledReturns(ccv3.SSHEnabled{}, ccv3.Warnings{"ssh-enabled-warning"}, errors.New("ssh-enabled-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("ssh-enabled-error"))
+				Expect(warnings).To(ConsistOf("space-feature-warning", "get-apps-warning", "ssh-enabled-warning"))
+			})
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package v7action
 
 import (
+	"strings"
+	"unicode"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
@@ -46,3 +49,41 @@ func (actor Actor) GetStacks(labelSelector string) ([]resources.Stack, Warnings,
 
 	return stacks, Warnings(warnings), nil
 }
+
+// StackArchitectureLabel is the label of a stack that gives its CPU
+// architecture, e.g. arm64, when its name and description do not.
+const StackArchitectureLabel = "arch"
+
+// stackArchitectures maps the words that name a CPU architecture to the
+// name it is reported with.
+var stackArchitectures = map[string]string{
+	"amd64":   "amd64",
+	"x86_64":  "amd64",
+	"x64":     "amd64",
+	"arm64":   "arm64",
+	"aarch64": "arm64",
+}
+
+// StackArchitecture returns the CPU architecture of the stack, from its
+// StackArchitectureLabel label or else from a word of its name or
+// description such as arm64 or x86_64. It returns "" when neither tells.
+func StackArchitecture(stack resources.Stack) string {
+	if stack.Metadata != nil {
+		if label, ok := stack.Metadata.Labels[StackArchitectureLabel]; ok && label.IsSet && label.Value != "" {
+			if arch, known := stackArchitectures[strings.ToLower(label.Value)]; known {
+				return arch
+			}
+			return label.Value
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(stack.Name+" "+stack.Description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, word := range words {
+		if arch, ok := stackArchitectures[word]; ok {
+			return arch
+		}
+	}
+	return ""
+}
@@ -8,7 +8,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -196,4 +198,23 @@ var _ = Describe("Stack", func() {
 			})
 		})
 	})
+
+	Describe("StackArchitecture", func() {
+		DescribeTable("returns the architecture of the stack",
+			func(stack resources.Stack, expectedArch string) {
+				Expect(StackArchitecture(stack)).To(Equal(expectedArch))
+			},
+			Entry("from the arch label", resources.Stack{
+				Name:     "cflinuxfs4",
+				Metadata: &resources.Metadata{Labels: map[string]types.NullString{"arch": types.NewNullString("aarch64")}},
+			}, "arm64"),
+			Entry("from an unknown arch label", resources.Stack{
+				Name:     "cflinuxfs4",
+				Metadata: &resources.Metadata{Labels: map[string]types.NullString{"arch": types.NewNullString("riscv64")}},
+			}, "riscv64"),
+			Entry("from the name", resources.Stack{Name: "cflinuxfs4-arm64"}, "arm64"),
+			Entry("from the description", resources.Stack{Name: "cflinuxfs4", Description: "Ubuntu 22.04 for x86_64"}, "amd64"),
+			Entry("when nothing tells", resources.Stack{Name: "cflinuxfs4", Description: "Cloud Foundry Linux-based filesystem"}, ""),
+		)
+	})
 })
@@ -1,6 +1,8 @@
 package v7action
 
 import (
+	"net/url"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -8,7 +10,9 @@ import (
 type TargetSettings ccv3.TargetSettings
 
 // SetTarget targets the Cloud Controller using the client and sets target
-// information in the config based on the response.
+// information in the config based on the response. Unless a base URL is
+// given, API routes are resolved against the path prefix of the V3 link in
+// the root document when it is on the same host as the target.
 func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -20,8 +24,16 @@ func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 		return allWarnings, err
 	}
 
+	if settings.BaseURL == "" {
+		if baseURL := apiBaseURL(settings.URL, rootInfo); baseURL != settings.URL {
+			settings.BaseURL = baseURL
+			actor.CloudControllerClient.TargetCF(ccv3.TargetSettings(settings))
+		}
+	}
+
 	actor.Config.SetTargetInformation(configv3.TargetInformationArgs{
 		Api:               settings.URL,
+		ApiBaseURL:        settings.BaseURL,
 		ApiVersion:        rootInfo.CloudControllerAPIVersion(),
 		Auth:              rootInfo.Login(),
 		MinCLIVersion:     "", // Oldest supported V3 version should be OK
@@ -40,6 +52,25 @@ func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 	return allWarnings, nil
 }
 
+// apiBaseURL returns the base URL advertised by the root document's V3 link,
+// or the target URL when the link is missing or points at a different host.
+func apiBaseURL(targetURL string, rootInfo ccv3.Info) string {
+	baseURL := rootInfo.CloudControllerBaseURL()
+	target, err := url.Parse(targetURL)
+	if err != nil {
+		return targetURL
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil || base.Scheme != target.Scheme || base.Host != target.Host {
+		return targetURL
+	}
+
+	if base.Path == "" || base.Path == target.Path {
+		return targetURL
+	}
+	return baseURL
+}
+
 // ClearTarget clears target information from the config.
 func (actor Actor) ClearTarget() {
 	actor.Config.SetTargetInformation(configv3.TargetInformationArgs{})
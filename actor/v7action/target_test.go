@@ -21,6 +21,7 @@ var _ = Describe("Targeting", func() {
 		settings          TargetSettings
 		skipSSLValidation bool
 		targetedURL       string
+		baseURL           string
 	)
 
 	BeforeEach(func() {
@@ -50,6 +51,7 @@ var _ = Describe("Targeting", func() {
 
 			skipSSLValidation = true
 			targetedURL = expectedAPI
+			baseURL = ""
 			var meta struct {
 				Version            string `json:"version"`
 				HostKeyFingerprint string `json:"host_key_fingerprint"`
@@ -83,10 +85,30 @@ var _ = Describe("Targeting", func() {
 			settings = TargetSettings{
 				SkipSSLValidation: skipSSLValidation,
 				URL:               targetedURL,
+				BaseURL:           baseURL,
 			}
 			warnings, err = actor.SetTarget(settings)
 		})
 
+		When("a base URL is given", func() {
+			BeforeEach(func() {
+				baseURL = "https://api.foo.com/override"
+				fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+					Links: ccv3.InfoLinks{
+						CCV3: resources.APILink{HREF: "https://api.foo.com/cf/v3"},
+					},
+				}, nil, nil)
+			})
+
+			It("targets CF with the given base URL only", func() {
+				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.TargetCFArgsForCall(0).BaseURL).To(Equal(baseURL))
+
+				targetInfoArgs := fakeConfig.SetTargetInformationArgsForCall(0)
+				Expect(targetInfoArgs.ApiBaseURL).To(Equal(baseURL))
+			})
+		})
+
 		It("targets CF with the expected arguments", func() {
 			Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
 			connectionSettings := fakeCloudControllerClient.TargetCFArgsForCall(0)
@@ -120,6 +142,50 @@ var _ = Describe("Targeting", func() {
 			Expect(targetInfoArgs.Routing).To(Equal(expectedRouting))
 			Expect(targetInfoArgs.SkipSSLValidation).To(Equal(skipSSLValidation))
 			Expect(targetInfoArgs.CFOnK8s).To(BeFalse())
+			Expect(targetInfoArgs.ApiBaseURL).To(BeEmpty())
+		})
+
+		When("the V3 link in the root document has a path prefix", func() {
+			When("the link is on the targeted host", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+						Links: ccv3.InfoLinks{
+							CCV3: resources.APILink{HREF: "https://api.foo.com/cf/v3"},
+						},
+					}, nil, nil)
+				})
+
+				It("retargets CF with the base URL from the link", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(2))
+					connectionSettings := fakeCloudControllerClient.TargetCFArgsForCall(1)
+					Expect(connectionSettings.URL).To(Equal(expectedAPI))
+					Expect(connectionSettings.BaseURL).To(Equal("https://api.foo.com/cf"))
+				})
+
+				It("saves the base URL in the config", func() {
+					targetInfoArgs := fakeConfig.SetTargetInformationArgsForCall(0)
+					Expect(targetInfoArgs.Api).To(Equal(expectedAPI))
+					Expect(targetInfoArgs.ApiBaseURL).To(Equal("https://api.foo.com/cf"))
+				})
+			})
+
+			When("the link is on a different host", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+						Links: ccv3.InfoLinks{
+							CCV3: resources.APILink{HREF: "https://cloud-controller.internal/cf/v3"},
+						},
+					}, nil, nil)
+				})
+
+				It("keeps using the targeted URL", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+					targetInfoArgs := fakeConfig.SetTargetInformationArgsForCall(0)
+					Expect(targetInfoArgs.ApiBaseURL).To(BeEmpty())
+				})
+			})
 		})
 
 		It("clears all the token information", func() {
@@ -1,6 +1,8 @@
 package v7action
 
 import (
+	"net/http"
+
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
@@ -15,7 +17,9 @@ type UAAClient interface {
 	GetLoginPrompts() (map[string][]string, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	ListUsers(userName, origin string) ([]uaa.User, error)
+	MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	RequestDeviceAuthorization() (uaa.DeviceAuthorization, error)
 	UpdatePassword(userGUID string, oldPassword string, newPassword string) error
 	ValidateClientUser(clientID string) error
 	Revoke(token string) error
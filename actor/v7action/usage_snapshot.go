@@ -0,0 +1,256 @@
+package v7action
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// appUsageEventPageConcurrency is the number of pages of app usage events
+// that are requested at the same time.
+const appUsageEventPageConcurrency = 4
+
+// SpaceUsage is how much a space of an organization used during a period.
+type SpaceUsage struct {
+	SpaceGUID string
+	SpaceName string
+	// AppMemoryGBHours is the memory of the running app instances multiplied
+	// by the hours they ran.
+	AppMemoryGBHours float64
+	// TaskMemoryGBHours is the memory of the tasks multiplied by the hours they
+	// ran.
+	TaskMemoryGBHours float64
+	// TaskCount is the number of tasks started during the period.
+	TaskCount int
+	// ServiceInstanceCount is the number of service instances in the space
+	// now; service instances are not tracked over time.
+	ServiceInstanceCount int
+}
+
+// GetOrganizationUsageSnapshot returns the usage of each space of the
+// organization from from until to, ordered by space name. Usage is worked out
+// from the app usage events the Cloud Controller keeps, so processes that
+// started before the oldest of them are only counted from their first event.
+// Spaces that were deleted are included when they have usage.
+func (actor Actor) GetOrganizationUsageSnapshot(orgGUID string, from time.Time, to time.Time) ([]SpaceUsage, Warnings, error) {
+	var (
+		spaces           []resources.Space
+		serviceInstances []resources.ServiceInstance
+	)
+
+	ccWarnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			spaces, _, warnings, err = actor.CloudControllerClient.GetSpaces(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstances, _, warnings, err = actor.CloudControllerClient.GetServiceInstances(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+	)
+	warnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	events, eventWarnings, err := actor.getAppUsageEventsBefore(to)
+	warnings = append(warnings, eventWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	usages := map[string]*SpaceUsage{}
+	spaceUsage := func(spaceGUID string, spaceName string) *SpaceUsage {
+		usage, ok := usages[spaceGUID]
+		if !ok {
+			usage = &SpaceUsage{SpaceGUID: spaceGUID, SpaceName: spaceName}
+			usages[spaceGUID] = usage
+		}
+		return usage
+	}
+
+	for _, space := range spaces {
+		spaceUsage(space.GUID, space.Name)
+	}
+	for _, serviceInstance := range serviceInstances {
+		spaceUsage(serviceInstance.SpaceGUID, "").ServiceInstanceCount++
+	}
+
+	appMeter := newUsageMeter(from, to)
+	taskMeter := newUsageMeter(from, to)
+	for _, event := range events {
+		if event.OrganizationGUID != orgGUID {
+			continue
+		}
+		usage := spaceUsage(event.SpaceGUID, event.SpaceName)
+
+		previousMemoryInMB := event.PreviousInstanceCount * event.PreviousMemoryInMBPerInstance
+		if previousMemoryInMB == 0 {
+			previousMemoryInMB = event.InstanceCount * event.MemoryInMBPerInstance
+		}
+		wasRunning := event.PreviousState == constant.AppUsageEventStarted
+
+		switch event.State {
+		case constant.AppUsageEventStarted:
+			usage.AppMemoryGBHours += appMeter.update(event.ProcessGUID, event.SpaceGUID, event.CreatedAt, wasRunning, previousMemoryInMB, event.InstanceCount*event.MemoryInMBPerInstance)
+		case constant.AppUsageEventStopped:
+			usage.AppMemoryGBHours += appMeter.update(event.ProcessGUID, event.SpaceGUID, event.CreatedAt, wasRunning, previousMemoryInMB, 0)
+		case constant.AppUsageEventTaskStarted:
+			usage.TaskMemoryGBHours += taskMeter.update(event.TaskGUID, event.SpaceGUID, event.CreatedAt, false, 0, event.MemoryInMBPerInstance)
+			if !event.CreatedAt.Before(from) {
+				usage.TaskCount++
+			}
+		case constant.AppUsageEventTaskStopped:
+			usage.TaskMemoryGBHours += taskMeter.update(event.TaskGUID, event.SpaceGUID, event.CreatedAt, true, event.MemoryInMBPerInstance, 0)
+		}
+	}
+
+	appMeter.finish(func(spaceGUID string, gbHours float64) {
+		usages[spaceGUID].AppMemoryGBHours += gbHours
+	})
+	taskMeter.finish(func(spaceGUID string, gbHours float64) {
+		usages[spaceGUID].TaskMemoryGBHours += gbHours
+	})
+
+	result := make([]SpaceUsage, 0, len(usages))
+	for _, usage := range usages {
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SpaceName != result[j].SpaceName {
+			return result[i].SpaceName < result[j].SpaceName
+		}
+		return result[i].SpaceGUID < result[j].SpaceGUID
+	})
+
+	return result, warnings, nil
+}
+
+// getAppUsageEventsBefore returns the app usage events created before to,
+// oldest first. The first page says how many pages there are, and the rest of
+// them are requested concurrently.
+func (actor Actor) getAppUsageEventsBefore(to time.Time) ([]resources.AppUsageEvent, Warnings, error) {
+	query := []ccv3.Query{
+		{Key: ccv3.CreatedAtsBeforeFilter, Values: []string{to.UTC().Format(time.RFC3339)}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+
+	events, totalPages, ccWarnings, err := actor.CloudControllerClient.GetAppUsageEventsPage(1, query...)
+	warnings := Warnings(ccWarnings)
+	if err != nil || totalPages <= 1 {
+		return events, warnings, err
+	}
+
+	type pageResult struct {
+		events   []resources.AppUsageEvent
+		warnings ccv3.Warnings
+		err      error
+	}
+	results := make([]pageResult, totalPages-1)
+
+	pages := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < appUsageEventPageConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				result := &results[page-2]
+				result.events, _, result.warnings, result.err = actor.CloudControllerClient.GetAppUsageEventsPage(page, query...)
+			}
+		}()
+	}
+	for page := 2; page <= totalPages; page++ {
+		pages <- page
+	}
+	close(pages)
+	wg.Wait()
+
+	for _, result := range results {
+		warnings = append(warnings, result.warnings...)
+		if result.err != nil {
+			return nil, warnings, result.err
+		}
+		events = append(events, result.events...)
+	}
+
+	return events, warnings, nil
+}
+
+type runningUsage struct {
+	spaceGUID  string
+	since      time.Time
+	memoryInMB int
+}
+
+// usageMeter adds up the memory used by processes or tasks between from and
+// to, in GB-hours.
+type usageMeter struct {
+	from    time.Time
+	to      time.Time
+	running map[string]runningUsage
+	seen    map[string]bool
+}
+
+func newUsageMeter(from time.Time, to time.Time) usageMeter {
+	return usageMeter{
+		from:    from,
+		to:      to,
+		running: map[string]runningUsage{},
+		seen:    map[string]bool{},
+	}
+}
+
+// update records that key uses memoryInMB from at on, or nothing when it is
+// 0, and returns the GB-hours it used since its last update. When key has not
+// been seen before and wasRunning is set, it is taken to have used
+// previousMemoryInMB since from.
+func (meter usageMeter) update(key string, spaceGUID string, at time.Time, wasRunning bool, previousMemoryInMB int, memoryInMB int) float64 {
+	var gbHours float64
+	if usage, ok := meter.running[key]; ok {
+		gbHours = meter.gbHours(usage.memoryInMB, usage.since, at)
+	} else if !meter.seen[key] && wasRunning {
+		gbHours = meter.gbHours(previousMemoryInMB, meter.from, at)
+	}
+
+	meter.seen[key] = true
+	delete(meter.running, key)
+	if memoryInMB > 0 {
+		meter.running[key] = runningUsage{spaceGUID: spaceGUID, since: at, memoryInMB: memoryInMB}
+	}
+
+	return gbHours
+}
+
+// finish adds the GB-hours used until to by everything that is still
+// running.
+func (meter usageMeter) finish(add func(spaceGUID string, gbHours float64)) {
+	for _, usage := range meter.running {
+		add(usage.spaceGUID, meter.gbHours(usage.memoryInMB, usage.since, meter.to))
+	}
+}
+
+func (meter usageMeter) gbHours(memoryInMB int, start time.Time, end time.Time) float64 {
+	if start.Before(meter.from) {
+		start = meter.from
+	}
+	if end.After(meter.to) {
+		end = meter.to
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return float64(memoryInMB) / 1024 * end.Sub(start).Hours()
+}
@@ -0,0 +1,209 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Snapshot Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationUsageSnapshot", func() {
+		var (
+			from       time.Time
+			to         time.Time
+			usages     []SpaceUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		at := func(day int, hour int, minute int) time.Time {
+			return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+		}
+
+		BeforeEach(func() {
+			from = at(1, 0, 0)
+			to = at(2, 0, 0)
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{
+					{GUID: "space-1-guid", Name: "dev"},
+					{GUID: "space-2-guid", Name: "prod"},
+					{GUID: "space-3-guid", Name: "empty"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{GUID: "si-1-guid", SpaceGUID: "space-1-guid"},
+					{GUID: "si-2-guid", SpaceGUID: "space-1-guid"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-service-instances-warning"},
+				nil,
+			)
+
+			pages := [][]resources.AppUsageEvent{
+				{
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", ProcessGUID: "process-1-guid",
+						CreatedAt: at(0, 12, 0), State: constant.AppUsageEventStarted,
+						InstanceCount: 2, MemoryInMBPerInstance: 512,
+					},
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-2-guid", ProcessGUID: "process-2-guid",
+						CreatedAt: at(1, 6, 0), State: constant.AppUsageEventStopped, PreviousState: constant.AppUsageEventStarted,
+						InstanceCount: 1, MemoryInMBPerInstance: 1024, PreviousInstanceCount: 1, PreviousMemoryInMBPerInstance: 1024,
+					},
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", TaskGUID: "task-1-guid",
+						CreatedAt: at(1, 10, 0), State: constant.AppUsageEventTaskStarted, MemoryInMBPerInstance: 2048,
+					},
+				},
+				{
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", TaskGUID: "task-1-guid",
+						CreatedAt: at(1, 11, 30), State: constant.AppUsageEventTaskStopped, MemoryInMBPerInstance: 2048,
+					},
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", ProcessGUID: "process-1-guid",
+						CreatedAt: at(1, 12, 0), State: constant.AppUsageEventStarted, PreviousState: constant.AppUsageEventStarted,
+						InstanceCount: 4, MemoryInMBPerInstance: 512, PreviousInstanceCount: 2, PreviousMemoryInMBPerInstance: 512,
+					},
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", ProcessGUID: "process-1-guid",
+						CreatedAt: at(1, 18, 0), State: constant.AppUsageEventStopped, PreviousState: constant.AppUsageEventStarted,
+						InstanceCount: 4, MemoryInMBPerInstance: 512,
+					},
+				},
+				{
+					{
+						OrganizationGUID: "org-guid", SpaceGUID: "space-1-guid", TaskGUID: "task-2-guid",
+						CreatedAt: at(1, 23, 0), State: constant.AppUsageEventTaskStarted, MemoryInMBPerInstance: 1024,
+					},
+					{
+						OrganizationGUID: "other-org-guid", SpaceGUID: "other-space-guid", ProcessGUID: "process-3-guid",
+						CreatedAt: at(1, 23, 30), State: constant.AppUsageEventStarted,
+						InstanceCount: 10, MemoryInMBPerInstance: 1024,
+					},
+				},
+			}
+			fakeCloudControllerClient.GetAppUsageEventsPageStub = func(page int, _ ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error) {
+				return pages[page-1], len(pages), ccv3.Warnings{"get-events-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, executeErr = actor.GetOrganizationUsageSnapshot("org-guid", from, to)
+		})
+
+		It("requests the spaces and service instances of the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("requests every page of the app usage events created before the end of the period", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeCloudControllerClient.GetAppUsageEventsPageCallCount()).To(Equal(3))
+
+			var requestedPages []int
+			for i := 0; i < 3; i++ {
+				page, query := fakeCloudControllerClient.GetAppUsageEventsPageArgsForCall(i)
+				requestedPages = append(requestedPages, page)
+				Expect(query).To(ConsistOf(
+					ccv3.Query{Key: ccv3.CreatedAtsBeforeFilter, Values: []string{"2024-01-02T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			}
+			Expect(requestedPages[0]).To(Equal(1))
+			Expect(requestedPages).To(ConsistOf(1, 2, 3))
+		})
+
+		It("returns the usage of each space ordered by name, and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{
+				"get-spaces-warning", "get-service-instances-warning",
+				"get-events-warning", "get-events-warning", "get-events-warning",
+			}))
+			Expect(usages).To(Equal([]SpaceUsage{
+				{
+					SpaceGUID:            "space-1-guid",
+					SpaceName:            "dev",
+					AppMemoryGBHours:     24,
+					TaskMemoryGBHours:    4,
+					TaskCount:            2,
+					ServiceInstanceCount: 2,
+				},
+				{SpaceGUID: "space-3-guid", SpaceName: "empty"},
+				{SpaceGUID: "space-2-guid", SpaceName: "prod", AppMemoryGBHours: 6},
+			}))
+		})
+
+		When("there is a single page of events", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsPageStub = nil
+				fakeCloudControllerClient.GetAppUsageEventsPageReturns(nil, 1, ccv3.Warnings{"get-events-warning"}, nil)
+			})
+
+			It("does not request more pages", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetAppUsageEventsPageCallCount()).To(Equal(1))
+			})
+		})
+
+		When("getting a page of events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsPageStub = func(page int, _ ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error) {
+					if page == 3 {
+						return nil, 0, ccv3.Warnings{"get-events-warning"}, errors.New("events-error")
+					}
+					return nil, 3, ccv3.Warnings{"get-events-warning"}, nil
+				}
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events-error"))
+				Expect(warnings).To(ContainElement("get-events-warning"))
+			})
+		})
+
+		When("getting the spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, errors.New("spaces-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("spaces-error"))
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(fakeCloudControllerClient.GetAppUsageEventsPageCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
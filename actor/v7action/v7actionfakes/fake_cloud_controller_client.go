@@ -77,6 +77,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	ContinueDeploymentStub        func(string) (ccv3.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CopyPackageStub        func(string, string) (resources.Package, ccv3.Warnings, error)
 	copyPackageMutex       sync.RWMutex
 	copyPackageArgsForCall []struct {
@@ -108,11 +121,10 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	CreateApplicationDeploymentStub        func(string, string) (string, ccv3.Warnings, error)
+	CreateApplicationDeploymentStub        func(resources.Deployment) (string, ccv3.Warnings, error)
 	createApplicationDeploymentMutex       sync.RWMutex
 	createApplicationDeploymentArgsForCall []struct {
-		arg1 string
-		arg2 string
+		arg1 resources.Deployment
 	}
 	createApplicationDeploymentReturns struct {
 		result1 string
@@ -124,22 +136,6 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	CreateApplicationDeploymentByRevisionStub        func(string, string) (string, ccv3.Warnings, error)
-	createApplicationDeploymentByRevisionMutex       sync.RWMutex
-	createApplicationDeploymentByRevisionArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createApplicationDeploymentByRevisionReturns struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}
-	createApplicationDeploymentByRevisionReturnsOnCall map[int]struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}
 	CreateApplicationProcessScaleStub        func(string, resources.Process) (resources.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -457,6 +453,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeleteApplicationBuildpackCacheStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteApplicationBuildpackCacheMutex       sync.RWMutex
+	deleteApplicationBuildpackCacheArgsForCall []struct {
+		arg1 string
+	}
+	deleteApplicationBuildpackCacheReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteApplicationBuildpackCacheReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteApplicationProcessInstanceStub        func(string, string, int) (ccv3.Warnings, error)
 	deleteApplicationProcessInstanceMutex       sync.RWMutex
 	deleteApplicationProcessInstanceArgsForCall []struct {
@@ -786,6 +797,24 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAppUsageEventsPageStub        func(int, ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error)
+	getAppUsageEventsPageMutex       sync.RWMutex
+	getAppUsageEventsPageArgsForCall []struct {
+		arg1 int
+		arg2 []ccv3.Query
+	}
+	getAppUsageEventsPageReturns struct {
+		result1 []resources.AppUsageEvent
+		result2 int
+		result3 ccv3.Warnings
+		result4 error
+	}
+	getAppUsageEventsPageReturnsOnCall map[int]struct {
+		result1 []resources.AppUsageEvent
+		result2 int
+		result3 ccv3.Warnings
+		result4 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, ccv3.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1208,6 +1237,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetJobByGUIDStub        func(string) (ccv3.Job, ccv3.Warnings, error)
+	getJobByGUIDMutex       sync.RWMutex
+	getJobByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getJobByGUIDReturns struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getJobByGUIDReturnsOnCall map[int]struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetNewApplicationProcessesStub        func(string, string) ([]resources.Process, ccv3.Warnings, error)
 	getNewApplicationProcessesMutex       sync.RWMutex
 	getNewApplicationProcessesArgsForCall []struct {
@@ -1421,6 +1465,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetResourceLinksStub        func() (ccv3.ResourceLinks, ccv3.Warnings, error)
+	getResourceLinksMutex       sync.RWMutex
+	getResourceLinksArgsForCall []struct {
+	}
+	getResourceLinksReturns struct {
+		result1 ccv3.ResourceLinks
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getResourceLinksReturnsOnCall map[int]struct {
+		result1 ccv3.ResourceLinks
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetRolesStub        func(...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error)
 	getRolesMutex       sync.RWMutex
 	getRolesArgsForCall []struct {
@@ -1438,6 +1496,21 @@ type FakeCloudControllerClient struct {
 		result3 ccv3.Warnings
 		result4 error
 	}
+	GetRouteStub        func(string) (resources.Route, ccv3.Warnings, error)
+	getRouteMutex       sync.RWMutex
+	getRouteArgsForCall []struct {
+		arg1 string
+	}
+	getRouteReturns struct {
+		result1 resources.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetRouteBindingsStub        func(...ccv3.Query) ([]resources.RouteBinding, ccv3.IncludedResources, ccv3.Warnings, error)
 	getRouteBindingsMutex       sync.RWMutex
 	getRouteBindingsArgsForCall []struct {
@@ -1981,6 +2054,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	MapRouteDestinationsStub        func(string, []resources.RouteDestination) (ccv3.Warnings, error)
+	mapRouteDestinationsMutex       sync.RWMutex
+	mapRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	mapRouteDestinationsReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	mapRouteDestinationsReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	MoveRouteStub        func(string, string) (ccv3.Warnings, error)
 	moveRouteMutex       sync.RWMutex
 	moveRouteArgsForCall []struct {
@@ -2500,6 +2587,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateRouteDestinationsStub        func(string, []resources.RouteDestination) (ccv3.Warnings, error)
+	updateRouteDestinationsMutex       sync.RWMutex
+	updateRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	updateRouteDestinationsReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	updateRouteDestinationsReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateSecurityGroupStub        func(resources.SecurityGroup) (resources.SecurityGroup, ccv3.Warnings, error)
 	updateSecurityGroupMutex       sync.RWMutex
 	updateSecurityGroupArgsForCall []struct {
@@ -3037,6 +3138,69 @@ func (fake *FakeCloudControllerClient) CheckRouteReturnsOnCall(i int, result1 bo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) ContinueDeployment(arg1 string) (ccv3.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CopyPackage(arg1 string, arg2 string) (resources.Package, ccv3.Warnings, error) {
 	fake.copyPackageMutex.Lock()
 	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
@@ -3170,17 +3334,16 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeployment(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(arg1 resources.Deployment) (string, ccv3.Warnings, error) {
 	fake.createApplicationDeploymentMutex.Lock()
 	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
 	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateApplicationDeployment", []interface{}{arg1, arg2})
+		arg1 resources.Deployment
+	}{arg1})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{arg1})
 	fake.createApplicationDeploymentMutex.Unlock()
 	if fake.CreateApplicationDeploymentStub != nil {
-		return fake.CreateApplicationDeploymentStub(arg1, arg2)
+		return fake.CreateApplicationDeploymentStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -3195,17 +3358,17 @@ func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() in
 	return len(fake.createApplicationDeploymentArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCalls(stub func(resources.Deployment) (string, ccv3.Warnings, error)) {
 	fake.createApplicationDeploymentMutex.Lock()
 	defer fake.createApplicationDeploymentMutex.Unlock()
 	fake.CreateApplicationDeploymentStub = stub
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) resources.Deployment {
 	fake.createApplicationDeploymentMutex.RLock()
 	defer fake.createApplicationDeploymentMutex.RUnlock()
 	argsForCall := fake.createApplicationDeploymentArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1
 }
 
 func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 string, result2 ccv3.Warnings, result3 error) {
@@ -3237,73 +3400,6 @@ func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevision(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	ret, specificReturn := fake.createApplicationDeploymentByRevisionReturnsOnCall[len(fake.createApplicationDeploymentByRevisionArgsForCall)]
-	fake.createApplicationDeploymentByRevisionArgsForCall = append(fake.createApplicationDeploymentByRevisionArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateApplicationDeploymentByRevision", []interface{}{arg1, arg2})
-	fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	if fake.CreateApplicationDeploymentByRevisionStub != nil {
-		return fake.CreateApplicationDeploymentByRevisionStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.createApplicationDeploymentByRevisionReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCallCount() int {
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
-	return len(fake.createApplicationDeploymentByRevisionArgsForCall)
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = stub
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionArgsForCall(i int) (string, string) {
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
-	argsForCall := fake.createApplicationDeploymentByRevisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturns(result1 string, result2 ccv3.Warnings, result3 error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = nil
-	fake.createApplicationDeploymentByRevisionReturns = struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = nil
-	if fake.createApplicationDeploymentByRevisionReturnsOnCall == nil {
-		fake.createApplicationDeploymentByRevisionReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 ccv3.Warnings
-			result3 error
-		})
-	}
-	fake.createApplicationDeploymentByRevisionReturnsOnCall[i] = struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(arg1 string, arg2 resources.Process) (resources.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -4692,6 +4788,72 @@ func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCache(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteApplicationBuildpackCacheMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationBuildpackCacheReturnsOnCall[len(fake.deleteApplicationBuildpackCacheArgsForCall)]
+	fake.deleteApplicationBuildpackCacheArgsForCall = append(fake.deleteApplicationBuildpackCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteApplicationBuildpackCache", []interface{}{arg1})
+	fake.deleteApplicationBuildpackCacheMutex.Unlock()
+	if fake.DeleteApplicationBuildpackCacheStub != nil {
+		return fake.DeleteApplicationBuildpackCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteApplicationBuildpackCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCacheCallCount() int {
+	fake.deleteApplicationBuildpackCacheMutex.RLock()
+	defer fake.deleteApplicationBuildpackCacheMutex.RUnlock()
+	return len(fake.deleteApplicationBuildpackCacheArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCacheCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteApplicationBuildpackCacheMutex.Lock()
+	defer fake.deleteApplicationBuildpackCacheMutex.Unlock()
+	fake.DeleteApplicationBuildpackCacheStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCacheArgsForCall(i int) string {
+	fake.deleteApplicationBuildpackCacheMutex.RLock()
+	defer fake.deleteApplicationBuildpackCacheMutex.RUnlock()
+	argsForCall := fake.deleteApplicationBuildpackCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCacheReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteApplicationBuildpackCacheMutex.Lock()
+	defer fake.deleteApplicationBuildpackCacheMutex.Unlock()
+	fake.DeleteApplicationBuildpackCacheStub = nil
+	fake.deleteApplicationBuildpackCacheReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationBuildpackCacheReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteApplicationBuildpackCacheMutex.Lock()
+	defer fake.deleteApplicationBuildpackCacheMutex.Unlock()
+	fake.DeleteApplicationBuildpackCacheStub = nil
+	if fake.deleteApplicationBuildpackCacheReturnsOnCall == nil {
+		fake.deleteApplicationBuildpackCacheReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteApplicationBuildpackCacheReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstance(arg1 string, arg2 string, arg3 int) (ccv3.Warnings, error) {
 	fake.deleteApplicationProcessInstanceMutex.Lock()
 	ret, specificReturn := fake.deleteApplicationProcessInstanceReturnsOnCall[len(fake.deleteApplicationProcessInstanceArgsForCall)]
@@ -6144,6 +6306,76 @@ func (fake *FakeCloudControllerClient) GetAppFeatureReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPage(arg1 int, arg2 ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error) {
+	fake.getAppUsageEventsPageMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsPageReturnsOnCall[len(fake.getAppUsageEventsPageArgsForCall)]
+	fake.getAppUsageEventsPageArgsForCall = append(fake.getAppUsageEventsPageArgsForCall, struct {
+		arg1 int
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetAppUsageEventsPage", []interface{}{arg1, arg2})
+	fake.getAppUsageEventsPageMutex.Unlock()
+	if fake.GetAppUsageEventsPageStub != nil {
+		return fake.GetAppUsageEventsPageStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getAppUsageEventsPageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPageCallCount() int {
+	fake.getAppUsageEventsPageMutex.RLock()
+	defer fake.getAppUsageEventsPageMutex.RUnlock()
+	return len(fake.getAppUsageEventsPageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPageCalls(stub func(int, ...ccv3.Query) ([]resources.AppUsageEvent, int, ccv3.Warnings, error)) {
+	fake.getAppUsageEventsPageMutex.Lock()
+	defer fake.getAppUsageEventsPageMutex.Unlock()
+	fake.GetAppUsageEventsPageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPageArgsForCall(i int) (int, []ccv3.Query) {
+	fake.getAppUsageEventsPageMutex.RLock()
+	defer fake.getAppUsageEventsPageMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPageReturns(result1 []resources.AppUsageEvent, result2 int, result3 ccv3.Warnings, result4 error) {
+	fake.getAppUsageEventsPageMutex.Lock()
+	defer fake.getAppUsageEventsPageMutex.Unlock()
+	fake.GetAppUsageEventsPageStub = nil
+	fake.getAppUsageEventsPageReturns = struct {
+		result1 []resources.AppUsageEvent
+		result2 int
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsPageReturnsOnCall(i int, result1 []resources.AppUsageEvent, result2 int, result3 ccv3.Warnings, result4 error) {
+	fake.getAppUsageEventsPageMutex.Lock()
+	defer fake.getAppUsageEventsPageMutex.Unlock()
+	fake.GetAppUsageEventsPageStub = nil
+	if fake.getAppUsageEventsPageReturnsOnCall == nil {
+		fake.getAppUsageEventsPageReturnsOnCall = make(map[int]struct {
+			result1 []resources.AppUsageEvent
+			result2 int
+			result3 ccv3.Warnings
+			result4 error
+		})
+	}
+	fake.getAppUsageEventsPageReturnsOnCall[i] = struct {
+		result1 []resources.AppUsageEvent
+		result2 int
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, ccv3.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -7980,6 +8212,72 @@ func (fake *FakeCloudControllerClient) GetIsolationSegmentsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetJobByGUID(arg1 string) (ccv3.Job, ccv3.Warnings, error) {
+	fake.getJobByGUIDMutex.Lock()
+	ret, specificReturn := fake.getJobByGUIDReturnsOnCall[len(fake.getJobByGUIDArgsForCall)]
+	fake.getJobByGUIDArgsForCall = append(fake.getJobByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetJobByGUID", []interface{}{arg1})
+	fake.getJobByGUIDMutex.Unlock()
+	if fake.GetJobByGUIDStub != nil {
+		return fake.GetJobByGUIDStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getJobByGUIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetJobByGUIDCallCount() int {
+	fake.getJobByGUIDMutex.RLock()
+	defer fake.getJobByGUIDMutex.RUnlock()
+	return len(fake.getJobByGUIDArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetJobByGUIDCalls(stub func(string) (ccv3.Job, ccv3.Warnings, error)) {
+	fake.getJobByGUIDMutex.Lock()
+	defer fake.getJobByGUIDMutex.Unlock()
+	fake.GetJobByGUIDStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetJobByGUIDArgsForCall(i int) string {
+	fake.getJobByGUIDMutex.RLock()
+	defer fake.getJobByGUIDMutex.RUnlock()
+	argsForCall := fake.getJobByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetJobByGUIDReturns(result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.getJobByGUIDMutex.Lock()
+	defer fake.getJobByGUIDMutex.Unlock()
+	fake.GetJobByGUIDStub = nil
+	fake.getJobByGUIDReturns = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJobByGUIDReturnsOnCall(i int, result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.getJobByGUIDMutex.Lock()
+	defer fake.getJobByGUIDMutex.Unlock()
+	fake.GetJobByGUIDStub = nil
+	if fake.getJobByGUIDReturnsOnCall == nil {
+		fake.getJobByGUIDReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Job
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getJobByGUIDReturnsOnCall[i] = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetNewApplicationProcesses(arg1 string, arg2 string) ([]resources.Process, ccv3.Warnings, error) {
 	fake.getNewApplicationProcessesMutex.Lock()
 	ret, specificReturn := fake.getNewApplicationProcessesReturnsOnCall[len(fake.getNewApplicationProcessesArgsForCall)]
@@ -8907,6 +9205,64 @@ func (fake *FakeCloudControllerClient) GetProcessesReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetResourceLinks() (ccv3.ResourceLinks, ccv3.Warnings, error) {
+	fake.getResourceLinksMutex.Lock()
+	ret, specificReturn := fake.getResourceLinksReturnsOnCall[len(fake.getResourceLinksArgsForCall)]
+	fake.getResourceLinksArgsForCall = append(fake.getResourceLinksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetResourceLinks", []interface{}{})
+	fake.getResourceLinksMutex.Unlock()
+	if fake.GetResourceLinksStub != nil {
+		return fake.GetResourceLinksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getResourceLinksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetResourceLinksCallCount() int {
+	fake.getResourceLinksMutex.RLock()
+	defer fake.getResourceLinksMutex.RUnlock()
+	return len(fake.getResourceLinksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetResourceLinksCalls(stub func() (ccv3.ResourceLinks, ccv3.Warnings, error)) {
+	fake.getResourceLinksMutex.Lock()
+	defer fake.getResourceLinksMutex.Unlock()
+	fake.GetResourceLinksStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetResourceLinksReturns(result1 ccv3.ResourceLinks, result2 ccv3.Warnings, result3 error) {
+	fake.getResourceLinksMutex.Lock()
+	defer fake.getResourceLinksMutex.Unlock()
+	fake.GetResourceLinksStub = nil
+	fake.getResourceLinksReturns = struct {
+		result1 ccv3.ResourceLinks
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetResourceLinksReturnsOnCall(i int, result1 ccv3.ResourceLinks, result2 ccv3.Warnings, result3 error) {
+	fake.getResourceLinksMutex.Lock()
+	defer fake.getResourceLinksMutex.Unlock()
+	fake.GetResourceLinksStub = nil
+	if fake.getResourceLinksReturnsOnCall == nil {
+		fake.getResourceLinksReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ResourceLinks
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getResourceLinksReturnsOnCall[i] = struct {
+		result1 ccv3.ResourceLinks
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoles(arg1 ...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getRolesMutex.Lock()
 	ret, specificReturn := fake.getRolesReturnsOnCall[len(fake.getRolesArgsForCall)]
@@ -8976,6 +9332,72 @@ func (fake *FakeCloudControllerClient) GetRolesReturnsOnCall(i int, result1 []re
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeCloudControllerClient) GetRoute(arg1 string) (resources.Route, ccv3.Warnings, error) {
+	fake.getRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteReturnsOnCall[len(fake.getRouteArgsForCall)]
+	fake.getRouteArgsForCall = append(fake.getRouteArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRoute", []interface{}{arg1})
+	fake.getRouteMutex.Unlock()
+	if fake.GetRouteStub != nil {
+		return fake.GetRouteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCallCount() int {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	return len(fake.getRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCalls(stub func(string) (resources.Route, ccv3.Warnings, error)) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRouteArgsForCall(i int) string {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	argsForCall := fake.getRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRouteReturns(result1 resources.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	fake.getRouteReturns = struct {
+		result1 resources.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteReturnsOnCall(i int, result1 resources.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	if fake.getRouteReturnsOnCall == nil {
+		fake.getRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRouteBindings(arg1 ...ccv3.Query) ([]resources.RouteBinding, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getRouteBindingsMutex.Lock()
 	ret, specificReturn := fake.getRouteBindingsReturnsOnCall[len(fake.getRouteBindingsArgsForCall)]
@@ -11317,6 +11739,75 @@ func (fake *FakeCloudControllerClient) MapRouteReturnsOnCall(i int, result1 ccv3
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) MapRouteDestinations(arg1 string, arg2 []resources.RouteDestination) (ccv3.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.mapRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.mapRouteDestinationsReturnsOnCall[len(fake.mapRouteDestinationsArgsForCall)]
+	fake.mapRouteDestinationsArgsForCall = append(fake.mapRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("MapRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.mapRouteDestinationsMutex.Unlock()
+	if fake.MapRouteDestinationsStub != nil {
+		return fake.MapRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.mapRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationsCallCount() int {
+	fake.mapRouteDestinationsMutex.RLock()
+	defer fake.mapRouteDestinationsMutex.RUnlock()
+	return len(fake.mapRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationsCalls(stub func(string, []resources.RouteDestination) (ccv3.Warnings, error)) {
+	fake.mapRouteDestinationsMutex.Lock()
+	defer fake.mapRouteDestinationsMutex.Unlock()
+	fake.MapRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.mapRouteDestinationsMutex.RLock()
+	defer fake.mapRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.mapRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationsReturns(result1 ccv3.Warnings, result2 error) {
+	fake.mapRouteDestinationsMutex.Lock()
+	defer fake.mapRouteDestinationsMutex.Unlock()
+	fake.MapRouteDestinationsStub = nil
+	fake.mapRouteDestinationsReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) MapRouteDestinationsReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.mapRouteDestinationsMutex.Lock()
+	defer fake.mapRouteDestinationsMutex.Unlock()
+	fake.MapRouteDestinationsStub = nil
+	if fake.mapRouteDestinationsReturnsOnCall == nil {
+		fake.mapRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteDestinationsReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) MoveRoute(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.moveRouteMutex.Lock()
 	ret, specificReturn := fake.moveRouteReturnsOnCall[len(fake.moveRouteArgsForCall)]
@@ -13638,6 +14129,75 @@ func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateRouteDestinations(arg1 string, arg2 []resources.RouteDestination) (ccv3.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.updateRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteDestinationsReturnsOnCall[len(fake.updateRouteDestinationsArgsForCall)]
+	fake.updateRouteDestinationsArgsForCall = append(fake.updateRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UpdateRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.updateRouteDestinationsMutex.Unlock()
+	if fake.UpdateRouteDestinationsStub != nil {
+		return fake.UpdateRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsCallCount() int {
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
+	return len(fake.updateRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsCalls(stub func(string, []resources.RouteDestination) (ccv3.Warnings, error)) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.updateRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsReturns(result1 ccv3.Warnings, result2 error) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = nil
+	fake.updateRouteDestinationsReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = nil
+	if fake.updateRouteDestinationsReturnsOnCall == nil {
+		fake.updateRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.updateRouteDestinationsReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSecurityGroup(arg1 resources.SecurityGroup) (resources.SecurityGroup, ccv3.Warnings, error) {
 	fake.updateSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
@@ -14793,14 +15353,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.copyPackageMutex.RLock()
 	defer fake.copyPackageMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createApplicationDeploymentMutex.RLock()
 	defer fake.createApplicationDeploymentMutex.RUnlock()
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -14843,6 +15403,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteApplicationMutex.RLock()
 	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteApplicationBuildpackCacheMutex.RLock()
+	defer fake.deleteApplicationBuildpackCacheMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
 	fake.deleteBuildpackMutex.RLock()
@@ -14887,6 +15449,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppUsageEventsPageMutex.RLock()
+	defer fake.getAppUsageEventsPageMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
@@ -14943,6 +15507,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getIsolationSegmentOrganizationsMutex.RUnlock()
 	fake.getIsolationSegmentsMutex.RLock()
 	defer fake.getIsolationSegmentsMutex.RUnlock()
+	fake.getJobByGUIDMutex.RLock()
+	defer fake.getJobByGUIDMutex.RUnlock()
 	fake.getNewApplicationProcessesMutex.RLock()
 	defer fake.getNewApplicationProcessesMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -14971,8 +15537,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getProcessSidecarsMutex.RUnlock()
 	fake.getProcessesMutex.RLock()
 	defer fake.getProcessesMutex.RUnlock()
+	fake.getResourceLinksMutex.RLock()
+	defer fake.getResourceLinksMutex.RUnlock()
 	fake.getRolesMutex.RLock()
 	defer fake.getRolesMutex.RUnlock()
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
 	fake.getRouteBindingsMutex.RLock()
 	defer fake.getRouteBindingsMutex.RUnlock()
 	fake.getRouteDestinationsMutex.RLock()
@@ -15043,6 +15613,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
 	defer fake.mapRouteMutex.RUnlock()
+	fake.mapRouteDestinationsMutex.RLock()
+	defer fake.mapRouteDestinationsMutex.RUnlock()
 	fake.moveRouteMutex.RLock()
 	defer fake.moveRouteMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -15115,6 +15687,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateProcessMutex.RUnlock()
 	fake.updateResourceMetadataMutex.RLock()
 	defer fake.updateResourceMetadataMutex.RUnlock()
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
 	fake.updateSecurityGroupMutex.RLock()
 	defer fake.updateSecurityGroupMutex.RUnlock()
 	fake.updateSecurityGroupRunningSpaceMutex.RLock()
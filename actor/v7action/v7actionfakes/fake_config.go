@@ -62,6 +62,16 @@ type FakeConfig struct {
 	isCFOnK8sReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LogCacheEndpointStub        func() string
+	logCacheEndpointMutex       sync.RWMutex
+	logCacheEndpointArgsForCall []struct {
+	}
+	logCacheEndpointReturns struct {
+		result1 string
+	}
+	logCacheEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PollingIntervalStub        func() time.Duration
 	pollingIntervalMutex       sync.RWMutex
 	pollingIntervalArgsForCall []struct {
@@ -82,6 +92,16 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RoutingEndpointStub        func() string
+	routingEndpointMutex       sync.RWMutex
+	routingEndpointArgsForCall []struct {
+	}
+	routingEndpointReturns struct {
+		result1 string
+	}
+	routingEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SSHOAuthClientStub        func() string
 	sSHOAuthClientMutex       sync.RWMutex
 	sSHOAuthClientArgsForCall []struct {
@@ -170,6 +190,16 @@ type FakeConfig struct {
 	targetReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UAAEndpointStub        func() string
+	uAAEndpointMutex       sync.RWMutex
+	uAAEndpointArgsForCall []struct {
+	}
+	uAAEndpointReturns struct {
+		result1 string
+	}
+	uAAEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAGrantTypeStub        func() string
 	uAAGrantTypeMutex       sync.RWMutex
 	uAAGrantTypeArgsForCall []struct {
@@ -451,6 +481,58 @@ func (fake *FakeConfig) IsCFOnK8sReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) LogCacheEndpoint() string {
+	fake.logCacheEndpointMutex.Lock()
+	ret, specificReturn := fake.logCacheEndpointReturnsOnCall[len(fake.logCacheEndpointArgsForCall)]
+	fake.logCacheEndpointArgsForCall = append(fake.logCacheEndpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LogCacheEndpoint", []interface{}{})
+	fake.logCacheEndpointMutex.Unlock()
+	if fake.LogCacheEndpointStub != nil {
+		return fake.LogCacheEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.logCacheEndpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) LogCacheEndpointCallCount() int {
+	fake.logCacheEndpointMutex.RLock()
+	defer fake.logCacheEndpointMutex.RUnlock()
+	return len(fake.logCacheEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) LogCacheEndpointCalls(stub func() string) {
+	fake.logCacheEndpointMutex.Lock()
+	defer fake.logCacheEndpointMutex.Unlock()
+	fake.LogCacheEndpointStub = stub
+}
+
+func (fake *FakeConfig) LogCacheEndpointReturns(result1 string) {
+	fake.logCacheEndpointMutex.Lock()
+	defer fake.logCacheEndpointMutex.Unlock()
+	fake.LogCacheEndpointStub = nil
+	fake.logCacheEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LogCacheEndpointReturnsOnCall(i int, result1 string) {
+	fake.logCacheEndpointMutex.Lock()
+	defer fake.logCacheEndpointMutex.Unlock()
+	fake.LogCacheEndpointStub = nil
+	if fake.logCacheEndpointReturnsOnCall == nil {
+		fake.logCacheEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logCacheEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) PollingInterval() time.Duration {
 	fake.pollingIntervalMutex.Lock()
 	ret, specificReturn := fake.pollingIntervalReturnsOnCall[len(fake.pollingIntervalArgsForCall)]
@@ -555,6 +637,58 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RoutingEndpoint() string {
+	fake.routingEndpointMutex.Lock()
+	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
+	fake.routingEndpointArgsForCall = append(fake.routingEndpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RoutingEndpoint", []interface{}{})
+	fake.routingEndpointMutex.Unlock()
+	if fake.RoutingEndpointStub != nil {
+		return fake.RoutingEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.routingEndpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RoutingEndpointCallCount() int {
+	fake.routingEndpointMutex.RLock()
+	defer fake.routingEndpointMutex.RUnlock()
+	return len(fake.routingEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) RoutingEndpointCalls(stub func() string) {
+	fake.routingEndpointMutex.Lock()
+	defer fake.routingEndpointMutex.Unlock()
+	fake.RoutingEndpointStub = stub
+}
+
+func (fake *FakeConfig) RoutingEndpointReturns(result1 string) {
+	fake.routingEndpointMutex.Lock()
+	defer fake.routingEndpointMutex.Unlock()
+	fake.RoutingEndpointStub = nil
+	fake.routingEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RoutingEndpointReturnsOnCall(i int, result1 string) {
+	fake.routingEndpointMutex.Lock()
+	defer fake.routingEndpointMutex.Unlock()
+	fake.RoutingEndpointStub = nil
+	if fake.routingEndpointReturnsOnCall == nil {
+		fake.routingEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.routingEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SSHOAuthClient() string {
 	fake.sSHOAuthClientMutex.Lock()
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
@@ -1035,6 +1169,58 @@ func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAEndpoint() string {
+	fake.uAAEndpointMutex.Lock()
+	ret, specificReturn := fake.uAAEndpointReturnsOnCall[len(fake.uAAEndpointArgsForCall)]
+	fake.uAAEndpointArgsForCall = append(fake.uAAEndpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UAAEndpoint", []interface{}{})
+	fake.uAAEndpointMutex.Unlock()
+	if fake.UAAEndpointStub != nil {
+		return fake.UAAEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uAAEndpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UAAEndpointCallCount() int {
+	fake.uAAEndpointMutex.RLock()
+	defer fake.uAAEndpointMutex.RUnlock()
+	return len(fake.uAAEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) UAAEndpointCalls(stub func() string) {
+	fake.uAAEndpointMutex.Lock()
+	defer fake.uAAEndpointMutex.Unlock()
+	fake.UAAEndpointStub = stub
+}
+
+func (fake *FakeConfig) UAAEndpointReturns(result1 string) {
+	fake.uAAEndpointMutex.Lock()
+	defer fake.uAAEndpointMutex.Unlock()
+	fake.UAAEndpointStub = nil
+	fake.uAAEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAEndpointReturnsOnCall(i int, result1 string) {
+	fake.uAAEndpointMutex.Lock()
+	defer fake.uAAEndpointMutex.Unlock()
+	fake.UAAEndpointStub = nil
+	if fake.uAAEndpointReturnsOnCall == nil {
+		fake.uAAEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAGrantType() string {
 	fake.uAAGrantTypeMutex.Lock()
 	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
@@ -1123,10 +1309,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.isCFOnK8sMutex.RLock()
 	defer fake.isCFOnK8sMutex.RUnlock()
+	fake.logCacheEndpointMutex.RLock()
+	defer fake.logCacheEndpointMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.routingEndpointMutex.RLock()
+	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
@@ -1151,6 +1341,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.startupTimeoutMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
+	fake.uAAEndpointMutex.RLock()
+	defer fake.uAAEndpointMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
 	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.unsetOrganizationAndSpaceInformationMutex.RLock()
@@ -0,0 +1,132 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+)
+
+type FakeCurlRequester struct {
+	MakeRequestSendReceiveRawStub        func(string, string, http.Header, []byte) ([]byte, *http.Response, error)
+	makeRequestSendReceiveRawMutex       sync.RWMutex
+	makeRequestSendReceiveRawArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}
+	makeRequestSendReceiveRawReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	makeRequestSendReceiveRawReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRaw(arg1 string, arg2 string, arg3 http.Header, arg4 []byte) ([]byte, *http.Response, error) {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	ret, specificReturn := fake.makeRequestSendReceiveRawReturnsOnCall[len(fake.makeRequestSendReceiveRawArgsForCall)]
+	fake.makeRequestSendReceiveRawArgsForCall = append(fake.makeRequestSendReceiveRawArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("MakeRequestSendReceiveRaw", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.makeRequestSendReceiveRawMutex.Unlock()
+	if fake.MakeRequestSendReceiveRawStub != nil {
+		return fake.MakeRequestSendReceiveRawStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.makeRequestSendReceiveRawReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRawCallCount() int {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	return len(fake.makeRequestSendReceiveRawArgsForCall)
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRawCalls(stub func(string, string, http.Header, []byte) ([]byte, *http.Response, error)) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = stub
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRawArgsForCall(i int) (string, string, http.Header, []byte) {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	argsForCall := fake.makeRequestSendReceiveRawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRawReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	fake.makeRequestSendReceiveRawReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCurlRequester) MakeRequestSendReceiveRawReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	if fake.makeRequestSendReceiveRawReturnsOnCall == nil {
+		fake.makeRequestSendReceiveRawReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.makeRequestSendReceiveRawReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCurlRequester) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCurlRequester) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.CurlRequester = new(FakeCurlRequester)
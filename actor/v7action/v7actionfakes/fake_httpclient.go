@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+)
+
+type FakeHTTPClient struct {
+	DoStub        func(*http.Request) (*http.Response, error)
+	doMutex       sync.RWMutex
+	doArgsForCall []struct {
+		arg1 *http.Request
+	}
+	doReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	doReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHTTPClient) Do(arg1 *http.Request) (*http.Response, error) {
+	fake.doMutex.Lock()
+	ret, specificReturn := fake.doReturnsOnCall[len(fake.doArgsForCall)]
+	fake.doArgsForCall = append(fake.doArgsForCall, struct {
+		arg1 *http.Request
+	}{arg1})
+	fake.recordInvocation("Do", []interface{}{arg1})
+	fake.doMutex.Unlock()
+	if fake.DoStub != nil {
+		return fake.DoStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.doReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHTTPClient) DoCallCount() int {
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	return len(fake.doArgsForCall)
+}
+
+func (fake *FakeHTTPClient) DoCalls(stub func(*http.Request) (*http.Response, error)) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = stub
+}
+
+func (fake *FakeHTTPClient) DoArgsForCall(i int) *http.Request {
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	argsForCall := fake.doArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPClient) DoReturns(result1 *http.Response, result2 error) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = nil
+	fake.doReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHTTPClient) DoReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = nil
+	if fake.doReturnsOnCall == nil {
+		fake.doReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.doReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHTTPClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeHTTPClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.HTTPClient = new(FakeHTTPClient)
@@ -2,6 +2,7 @@
 package v7actionfakes
 
 import (
+	"net/http"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -34,6 +35,24 @@ type FakeRoutingClient struct {
 		result1 []router.RouterGroup
 		result2 error
 	}
+	MakeRequestSendReceiveRawStub        func(string, string, http.Header, []byte) ([]byte, *http.Response, error)
+	makeRequestSendReceiveRawMutex       sync.RWMutex
+	makeRequestSendReceiveRawArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}
+	makeRequestSendReceiveRawReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	makeRequestSendReceiveRawReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -156,6 +175,80 @@ func (fake *FakeRoutingClient) GetRouterGroupsReturnsOnCall(i int, result1 []rou
 	}{result1, result2}
 }
 
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRaw(arg1 string, arg2 string, arg3 http.Header, arg4 []byte) ([]byte, *http.Response, error) {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	ret, specificReturn := fake.makeRequestSendReceiveRawReturnsOnCall[len(fake.makeRequestSendReceiveRawArgsForCall)]
+	fake.makeRequestSendReceiveRawArgsForCall = append(fake.makeRequestSendReceiveRawArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("MakeRequestSendReceiveRaw", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.makeRequestSendReceiveRawMutex.Unlock()
+	if fake.MakeRequestSendReceiveRawStub != nil {
+		return fake.MakeRequestSendReceiveRawStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.makeRequestSendReceiveRawReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRawCallCount() int {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	return len(fake.makeRequestSendReceiveRawArgsForCall)
+}
+
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRawCalls(stub func(string, string, http.Header, []byte) ([]byte, *http.Response, error)) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = stub
+}
+
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRawArgsForCall(i int) (string, string, http.Header, []byte) {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	argsForCall := fake.makeRequestSendReceiveRawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRawReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	fake.makeRequestSendReceiveRawReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutingClient) MakeRequestSendReceiveRawReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	if fake.makeRequestSendReceiveRawReturnsOnCall == nil {
+		fake.makeRequestSendReceiveRawReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.makeRequestSendReceiveRawReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRoutingClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -163,6 +256,8 @@ func (fake *FakeRoutingClient) Invocations() map[string][][]interface{} {
 	defer fake.getRouterGroupByNameMutex.RUnlock()
 	fake.getRouterGroupsMutex.RLock()
 	defer fake.getRouterGroupsMutex.RUnlock()
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
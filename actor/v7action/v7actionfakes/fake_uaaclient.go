@@ -2,6 +2,7 @@
 package v7actionfakes
 
 import (
+	"net/http"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -107,6 +108,24 @@ type FakeUAAClient struct {
 		result1 []uaa.User
 		result2 error
 	}
+	MakeRequestSendReceiveRawStub        func(string, string, http.Header, []byte) ([]byte, *http.Response, error)
+	makeRequestSendReceiveRawMutex       sync.RWMutex
+	makeRequestSendReceiveRawArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}
+	makeRequestSendReceiveRawReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	makeRequestSendReceiveRawReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
 	RefreshAccessTokenStub        func(string) (uaa.RefreshedTokens, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -120,6 +139,18 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
+	RequestDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	requestDeviceAuthorizationMutex       sync.RWMutex
+	requestDeviceAuthorizationArgsForCall []struct {
+	}
+	requestDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	requestDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	RevokeStub        func(string) error
 	revokeMutex       sync.RWMutex
 	revokeArgsForCall []struct {
@@ -593,6 +624,80 @@ func (fake *FakeUAAClient) ListUsersReturnsOnCall(i int, result1 []uaa.User, res
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) MakeRequestSendReceiveRaw(arg1 string, arg2 string, arg3 http.Header, arg4 []byte) ([]byte, *http.Response, error) {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	ret, specificReturn := fake.makeRequestSendReceiveRawReturnsOnCall[len(fake.makeRequestSendReceiveRawArgsForCall)]
+	fake.makeRequestSendReceiveRawArgsForCall = append(fake.makeRequestSendReceiveRawArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 http.Header
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("MakeRequestSendReceiveRaw", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.makeRequestSendReceiveRawMutex.Unlock()
+	if fake.MakeRequestSendReceiveRawStub != nil {
+		return fake.MakeRequestSendReceiveRawStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.makeRequestSendReceiveRawReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) MakeRequestSendReceiveRawCallCount() int {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	return len(fake.makeRequestSendReceiveRawArgsForCall)
+}
+
+func (fake *FakeUAAClient) MakeRequestSendReceiveRawCalls(stub func(string, string, http.Header, []byte) ([]byte, *http.Response, error)) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = stub
+}
+
+func (fake *FakeUAAClient) MakeRequestSendReceiveRawArgsForCall(i int) (string, string, http.Header, []byte) {
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
+	argsForCall := fake.makeRequestSendReceiveRawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUAAClient) MakeRequestSendReceiveRawReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	fake.makeRequestSendReceiveRawReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) MakeRequestSendReceiveRawReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeRequestSendReceiveRawMutex.Lock()
+	defer fake.makeRequestSendReceiveRawMutex.Unlock()
+	fake.MakeRequestSendReceiveRawStub = nil
+	if fake.makeRequestSendReceiveRawReturnsOnCall == nil {
+		fake.makeRequestSendReceiveRawReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.makeRequestSendReceiveRawReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUAAClient) RefreshAccessToken(arg1 string) (uaa.RefreshedTokens, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -656,6 +761,61 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) RequestDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.requestDeviceAuthorizationReturnsOnCall[len(fake.requestDeviceAuthorizationArgsForCall)]
+	fake.requestDeviceAuthorizationArgsForCall = append(fake.requestDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestDeviceAuthorization", []interface{}{})
+	fake.requestDeviceAuthorizationMutex.Unlock()
+	if fake.RequestDeviceAuthorizationStub != nil {
+		return fake.RequestDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCallCount() int {
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
+	return len(fake.requestDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	fake.requestDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	if fake.requestDeviceAuthorizationReturnsOnCall == nil {
+		fake.requestDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.requestDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Revoke(arg1 string) error {
 	fake.revokeMutex.Lock()
 	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
@@ -855,8 +1015,12 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.listUsersMutex.RLock()
 	defer fake.listUsersMutex.RUnlock()
+	fake.makeRequestSendReceiveRawMutex.RLock()
+	defer fake.makeRequestSendReceiveRawMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
 	fake.revokeMutex.RLock()
 	defer fake.revokeMutex.RUnlock()
 	fake.updatePasswordMutex.RLock()
@@ -70,8 +70,10 @@ func NewActor(v3Actor V7Actor, sharedActor SharedActor) *Actor {
 	actor.PreparePushPlanSequence = []UpdatePushPlanFunc{
 		SetDefaultBitsPathForPushPlan,
 		SetupDropletPathForPushPlan,
+		SetupResumeForPushPlan,
 		actor.SetupAllResourcesForPushPlan,
 		SetupDeploymentStrategyForPushPlan,
+		SetupNoBuildCacheForPushPlan,
 		SetupNoStartForPushPlan,
 		SetupNoWaitForPushPlan,
 		SetupTaskAppForPushPlan,
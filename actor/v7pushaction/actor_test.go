@@ -21,8 +21,10 @@ var _ = Describe("Actor", func() {
 			Expect(actor.PreparePushPlanSequence).To(matchers.MatchFuncsByName(
 				SetDefaultBitsPathForPushPlan,
 				SetupDropletPathForPushPlan,
+				SetupResumeForPushPlan,
 				actor.SetupAllResourcesForPushPlan,
 				SetupDeploymentStrategyForPushPlan,
+				SetupNoBuildCacheForPushPlan,
 				SetupNoStartForPushPlan,
 				SetupNoWaitForPushPlan,
 				SetupTaskAppForPushPlan,
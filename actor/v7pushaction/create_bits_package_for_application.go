@@ -1,7 +1,8 @@
 package v7pushaction
 
 import (
-	"os"
+	"io"
+	"io/ioutil"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -16,16 +17,35 @@ const PushRetries = 3
 func (actor Actor) CreateBitsPackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
 	pkg, warnings, err := actor.CreateAndUploadApplicationBits(pushPlan, eventStream, progressBar)
 	if err != nil {
+		forgetResourceMatches(pushPlan)
 		return pushPlan, warnings, err
 	}
 
 	polledPackage, pollWarnings, err := actor.V7Actor.PollPackage(pkg)
+	if err != nil {
+		forgetResourceMatches(pushPlan)
+	}
 
 	pushPlan.PackageGUID = polledPackage.GUID
+	pushPlan.PackageChecksum = polledPackage.Checksum
+	if err == nil {
+		eventStream <- &PushEvent{Plan: pushPlan, Event: PackageProcessed}
+	}
 
 	return pushPlan, append(warnings, pollWarnings...), err
 }
 
+// forgetResourceMatches makes the next push ask the API again about every
+// resource, in case the package failed because the API no longer has one of
+// the resources the resource cache says it has.
+func forgetResourceMatches(pushPlan PushPlan) {
+	if pushPlan.ResourceCache == nil {
+		return
+	}
+	pushPlan.ResourceCache.ForgetMatches()
+	writeResourceCache(pushPlan)
+}
+
 func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (resources.Package, Warnings, error) {
 	log.WithField("Path", pushPlan.BitsPath).Info("creating archive")
 
@@ -48,7 +68,7 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 		var warnings Warnings
 		var err error
 
-		matchedResources, unmatchedResources, warnings, err = actor.MatchResources(pushPlan.AllResources)
+		matchedResources, unmatchedResources, warnings, err = actor.MatchResourcesWithCache(pushPlan)
 		allWarnings = append(allWarnings, warnings...)
 		if err != nil {
 			return resources.Package{}, allWarnings, err
@@ -66,67 +86,169 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 		return resources.Package{}, allWarnings, err
 	}
 
-	if len(unmatchedResources) > 0 {
-		eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
-		archivePath, archiveErr := actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
-		if archiveErr != nil {
-			return resources.Package{}, allWarnings, archiveErr
+	if len(unmatchedResources) == 0 {
+		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplication}
+		var uploadWarnings v7action.Warnings
+		pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, nil, 0)
+		allWarnings = append(allWarnings, uploadWarnings...)
+		if err != nil {
+			return resources.Package{}, allWarnings, err
 		}
-		defer os.RemoveAll(archivePath)
-
-		// Uploading package/app bits
-		for count := 0; count < PushRetries; count++ {
-			eventStream <- &PushEvent{Plan: pushPlan, Event: ReadingArchive}
-			log.WithField("GUID", pushPlan.Application.GUID).Info("reading archive")
-			file, size, readErr := actor.SharedActor.ReadArchive(archivePath)
-			if readErr != nil {
-				return resources.Package{}, allWarnings, readErr
+		return pkg, allWarnings, nil
+	}
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
+
+	// Uploading package/app bits; the archive is zipped while it is being
+	// uploaded, so a retry recreates the stream from the source files. The CC
+	// cannot resume an interrupted upload, but it may have kept some of the
+	// files, e.g. when the connection broke after the whole archive was sent,
+	// so a retry matches the resources again and only sends the ones it still
+	// lacks.
+	withArchive := true
+	for count := 0; count < PushRetries; count++ {
+		if count > 0 && shouldResourceMatch {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: ResourceMatching}
+			var warnings Warnings
+			matchedResources, unmatchedResources, warnings, err = actor.rematchResources(pushPlan, matchedResources, unmatchedResources)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return resources.Package{}, allWarnings, err
 			}
-			defer file.Close()
+		}
 
-			eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplicationWithArchive}
-			progressReader := progressBar.NewProgressBarWrapper(file, size)
-			var uploadWarnings v7action.Warnings
-			pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, progressReader, size)
-			allWarnings = append(allWarnings, uploadWarnings...)
+		var uploadWarnings v7action.Warnings
+		withArchive = len(unmatchedResources) > 0
+		if withArchive {
+			pkg, uploadWarnings, err = actor.uploadArchive(pushPlan, pkg, matchedResources, unmatchedResources, eventStream, progressBar)
+		} else {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplication}
+			pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, nil, 0)
+		}
+		allWarnings = append(allWarnings, uploadWarnings...)
 
-			if _, ok := err.(ccerror.PipeSeekError); ok {
-				eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
-				continue
-			}
-			break
+		if _, interrupted := interruptedUploadError(err); interrupted {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
+			continue
 		}
+		break
+	}
 
-		if err != nil {
-			if e, ok := err.(ccerror.PipeSeekError); ok {
-				return resources.Package{}, allWarnings, actionerror.UploadFailedError{Err: e.Err}
-			}
-			return resources.Package{}, allWarnings, err
+	if err != nil {
+		if uploadErr, interrupted := interruptedUploadError(err); interrupted {
+			return resources.Package{}, allWarnings, actionerror.UploadFailedError{Err: uploadErr}
 		}
+		return resources.Package{}, allWarnings, err
+	}
 
+	if withArchive {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadWithArchiveComplete}
-	} else {
-		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplication}
-		var uploadWarnings v7action.Warnings
-		pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, nil, 0)
-		allWarnings = append(allWarnings, uploadWarnings...)
-		if err != nil {
-			return resources.Package{}, allWarnings, err
+	}
+	return pkg, allWarnings, nil
+}
+
+// uploadArchive streams the zip of the unmatched resources into the package,
+// moving the progress bar forward as the resources are zipped.
+func (actor Actor) uploadArchive(pushPlan PushPlan, pkg resources.Package, matchedResources []sharedaction.V3Resource, unmatchedResources []sharedaction.V3Resource, eventStream chan<- *PushEvent, progressBar ProgressBar) (resources.Package, v7action.Warnings, error) {
+	var estimatedSize int64
+	for _, resource := range unmatchedResources {
+		estimatedSize += resource.SizeInBytes
+	}
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: ReadingArchive}
+	log.WithField("GUID", pushPlan.Application.GUID).Info("streaming archive")
+	progress, progressDone := trackArchiveProgress(progressBar, estimatedSize)
+	stream := actor.CreateArchiveStream(pushPlan, unmatchedResources, progress)
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplicationWithArchive}
+	pkg, warnings, err := actor.V7Actor.UploadBitsPackage(pkg, matchedResources, stream, -1)
+	stream.Close()
+	progress.Close()
+	<-progressDone
+
+	return pkg, warnings, err
+}
+
+// rematchResources asks the API again about the resources an interrupted
+// upload was sending, and returns the matched resources with the ones the API
+// now has added, and the resources that still have to be uploaded.
+func (actor Actor) rematchResources(pushPlan PushPlan, matchedResources []sharedaction.V3Resource, unmatchedResources []sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, Warnings, error) {
+	_, stillUnmatched, warnings, err := actor.MatchResources(unmatchedResources)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	stillUnmatchedChecksums := map[string]bool{}
+	for _, resource := range stillUnmatched {
+		stillUnmatchedChecksums[resource.Checksum.Value] = true
+	}
+
+	var newMatches []sharedaction.V3Resource
+	for _, resource := range unmatchedResources {
+		if !stillUnmatchedChecksums[resource.Checksum.Value] {
+			newMatches = append(newMatches, resource)
 		}
 	}
+	if len(newMatches) == 0 {
+		return matchedResources, unmatchedResources, warnings, nil
+	}
 
-	return pkg, allWarnings, nil
+	log.WithField("matched", len(newMatches)).Info("resources kept from the interrupted upload")
+	if cache := pushPlan.ResourceCache; cache != nil {
+		for _, resource := range newMatches {
+			cache.SetMatched(resource.Checksum.Value, resource.SizeInBytes, true)
+		}
+		writeResourceCache(pushPlan)
+	}
+
+	return append(matchedResources, newMatches...), stillUnmatched, warnings, nil
+}
+
+// trackArchiveProgress returns a writer that moves the progress bar forward
+// by the source bytes written to it, and a channel that is closed once the
+// writer is closed and the progress bar has read everything written to it.
+// The bar is sized by the unmatched resources, which the compressed stream
+// being uploaded would never add up to.
+func trackArchiveProgress(progressBar ProgressBar, size int64) (*io.PipeWriter, <-chan struct{}) {
+	reader, writer := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var progressReader io.Reader = reader
+		if wrapped := progressBar.NewProgressBarWrapper(reader, size); wrapped != nil {
+			progressReader = wrapped
+		}
+		_, _ = io.Copy(ioutil.Discard, progressReader)
+	}()
+	return writer, done
 }
 
-func (actor Actor) CreateAndReturnArchivePath(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource) (string, error) {
+// CreateArchiveStream returns a reader producing the zip of the unmatched
+// resources as it is read. The content of the resources is also written to
+// progress as it is zipped.
+func (actor Actor) CreateArchiveStream(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource, progress io.Writer) io.ReadCloser {
 	// translate between v3 and v2 resources
 	var v2Resources []sharedaction.Resource
 	for _, resource := range unmatchedResources {
 		v2Resources = append(v2Resources, resource.ToV2Resource())
 	}
 
+	options := sharedaction.ZipOptions{PreserveTimestamps: pushPlan.PreserveTimestamps, Progress: progress}
 	if pushPlan.Archive {
-		return actor.SharedActor.ZipArchiveResources(pushPlan.BitsPath, v2Resources)
+		return actor.SharedActor.StreamArchiveResources(pushPlan.BitsPath, v2Resources, options)
+	}
+	return actor.SharedActor.StreamDirectoryResources(pushPlan.BitsPath, v2Resources, options)
+}
+
+// interruptedUploadError returns the error that interrupted an upload, and
+// whether the upload should be retried: when the request body could not be
+// rewound for a retry or the connection failed before a response arrived.
+func interruptedUploadError(err error) (error, bool) {
+	switch e := err.(type) {
+	case ccerror.PipeSeekError:
+		return e.Err, true
+	case ccerror.RequestError:
+		return e.Err, true
 	}
-	return actor.SharedActor.ZipDirectoryResources(pushPlan.BitsPath, v2Resources)
+	return err, false
 }
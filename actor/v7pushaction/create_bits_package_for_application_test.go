@@ -3,6 +3,10 @@ package v7pushaction_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -12,10 +16,17 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
 func buildEmptyV3Resource(name string) sharedaction.V3Resource {
 	return sharedaction.V3Resource{
 		FilePath:    name,
@@ -45,7 +56,8 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 
 		fakeProgressBar = new(v7pushactionfakes.FakeProgressBar)
 
-		fakeSharedActor.ReadArchiveReturns(new(v7pushactionfakes.FakeReadCloser), 0, nil)
+		fakeSharedActor.StreamDirectoryResourcesReturns(new(v7pushactionfakes.FakeReadCloser))
+		fakeSharedActor.StreamArchiveResourcesReturns(new(v7pushactionfakes.FakeReadCloser))
 
 		paramPlan = PushPlan{
 			Application: resources.Application{
@@ -142,28 +154,64 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 						paramPlan.Archive = true
 					})
 
-					It("creates the archive with the unmatched resources", func() {
-						Expect(fakeSharedActor.ZipArchiveResourcesCallCount()).To(Equal(1))
-						bitsPath, resources := fakeSharedActor.ZipArchiveResourcesArgsForCall(0)
+					It("streams the archive with the unmatched resources", func() {
+						Expect(fakeSharedActor.StreamArchiveResourcesCallCount()).To(Equal(1))
+						bitsPath, resources, options := fakeSharedActor.StreamArchiveResourcesArgsForCall(0)
 						Expect(bitsPath).To(Equal("/some-bits-path"))
 						Expect(resources).To(HaveLen(1))
 						Expect(resources[0].ToV3Resource()).To(Equal(unmatches[0]))
+						Expect(options.PreserveTimestamps).To(BeFalse())
+						Expect(options.Progress).ToNot(BeNil())
 					})
 				})
 
 				When("The bits path is a directory", func() {
-					It("creates the archive", func() {
-						Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(1))
-						bitsPath, resources := fakeSharedActor.ZipDirectoryResourcesArgsForCall(0)
+					It("streams the archive", func() {
+						Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(Equal(1))
+						bitsPath, resources, options := fakeSharedActor.StreamDirectoryResourcesArgsForCall(0)
 						Expect(bitsPath).To(Equal("/some-bits-path"))
 						Expect(resources).To(HaveLen(1))
 						Expect(resources[0].ToV3Resource()).To(Equal(unmatches[0]))
+						Expect(options.PreserveTimestamps).To(BeFalse())
+						Expect(options.Progress).ToNot(BeNil())
+					})
+
+					When("timestamps should be preserved", func() {
+						BeforeEach(func() {
+							paramPlan.PreserveTimestamps = true
+						})
+
+						It("streams the archive preserving timestamps", func() {
+							_, _, options := fakeSharedActor.StreamDirectoryResourcesArgsForCall(0)
+							Expect(options.PreserveTimestamps).To(BeTrue())
+						})
+					})
+
+					It("does not write a temporary archive", func() {
+						Expect(fakeSharedActor.ReadArchiveCallCount()).To(BeZero())
 					})
 				})
 
-				When("the archive creation is successful", func() {
+				When("the archive stream is created", func() {
+					var (
+						fakeStream *v7pushactionfakes.FakeReadCloser
+						progressed []byte
+					)
+
 					BeforeEach(func() {
-						fakeSharedActor.ZipDirectoryResourcesReturns("/some/archive/path", nil)
+						fakeStream = new(v7pushactionfakes.FakeReadCloser)
+						fakeSharedActor.StreamDirectoryResourcesStub = func(_ string, _ []sharedaction.Resource, options sharedaction.ZipOptions) io.ReadCloser {
+							_, err := options.Progress.Write([]byte("some-source-bytes"))
+							Expect(err).ToNot(HaveOccurred())
+							return fakeStream
+						}
+						progressed = nil
+						fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+							return io.TeeReader(reader, writerFunc(func(p []byte) (int, error) {
+								progressed = append(progressed, p...)
+								return len(p), nil
+							}))
+						}
 						fakeV7Actor.UpdateApplicationReturns(
 							resources.Application{
 								Name: "some-app",
@@ -183,109 +231,159 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 							fakeV7Actor.CreateBitsPackageByApplicationReturns(resources.Package{GUID: "some-guid"}, v7action.Warnings{"some-create-package-warning"}, nil)
 						})
 
-						It("reads the archive", func() {
-							Expect(fakeSharedActor.ReadArchiveCallCount()).To(Equal(1))
-							Expect(fakeSharedActor.ReadArchiveArgsForCall(0)).To(Equal("/some/archive/path"))
+						It("measures progress over the source files, against the size of the unmatched resources", func() {
+							Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+							_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+							Expect(size).To(BeNumerically("==", unmatches[0].SizeInBytes))
+							Expect(string(progressed)).To(Equal("some-source-bytes"))
 						})
 
-						When("reading the archive is successful", func() {
+						It("uploads the bits package with an unknown length and closes the stream", func() {
+							Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(1))
+							pkg, resource, reader, size := fakeV7Actor.UploadBitsPackageArgsForCall(0)
+
+							Expect(pkg).To(Equal(resources.Package{GUID: "some-guid"}))
+							Expect(resource).To(Equal(matches))
+							Expect(reader).To(Equal(fakeStream))
+							Expect(size).To(BeNumerically("==", -1))
+
+							Expect(fakeStream.CloseCallCount()).To(Equal(1))
+						})
+
+						When("the upload is successful", func() {
 							BeforeEach(func() {
-								fakeReadCloser := new(v7pushactionfakes.FakeReadCloser)
-								fakeSharedActor.ReadArchiveReturns(fakeReadCloser, 6, nil)
+								fakeV7Actor.UploadBitsPackageReturns(resources.Package{GUID: "some-guid"}, v7action.Warnings{"some-upload-package-warning"}, nil)
 							})
 
-							It("uploads the bits package", func() {
-								Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(1))
-								pkg, resource, _, size := fakeV7Actor.UploadBitsPackageArgsForCall(0)
-
-								Expect(pkg).To(Equal(resources.Package{GUID: "some-guid"}))
-								Expect(resource).To(Equal(matches))
-								Expect(size).To(BeNumerically("==", 6))
+							It("returns an upload complete event and warnings", func() {
+								Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete, PackageProcessed))
+								Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "some-upload-package-warning"))
 							})
+						})
+
+						When("the upload errors", func() {
+							When("the upload error is a retryable error", func() {
+								var someErr error
 
-							When("the upload is successful", func() {
 								BeforeEach(func() {
-									fakeV7Actor.UploadBitsPackageReturns(resources.Package{GUID: "some-guid"}, v7action.Warnings{"some-upload-package-warning"}, nil)
+									someErr = errors.New("I AM A BANANA")
+									fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, v7action.Warnings{"upload-warnings-1", "upload-warnings-2"}, ccerror.PipeSeekError{Err: someErr})
 								})
 
-								It("returns an upload complete event and warnings", func() {
-									Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete))
-									Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "some-upload-package-warning"))
+								It("should send a RetryUpload event and retry uploading with a new stream", func() {
+									Expect(events).To(Equal([]Event{
+										ResourceMatching, CreatingPackage, CreatingArchive,
+										ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+										ResourceMatching, ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+										ResourceMatching, ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+									}))
+
+									Expect(warnings).To(ConsistOf(
+										"some-good-good-resource-match-warnings", "some-create-package-warning", "upload-warnings-1", "upload-warnings-2",
+										"some-good-good-resource-match-warnings", "upload-warnings-1", "upload-warnings-2",
+										"some-good-good-resource-match-warnings", "upload-warnings-1", "upload-warnings-2",
+									))
+
+									Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(Equal(3))
+									Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(3))
+									Expect(executeErr).To(MatchError(actionerror.UploadFailedError{Err: someErr}))
 								})
+							})
 
-								When("the upload errors", func() {
-									When("the upload error is a retryable error", func() {
-										var someErr error
+							When("the connection fails during the upload", func() {
+								var someErr error
 
-										BeforeEach(func() {
-											someErr = errors.New("I AM A BANANA")
-											fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, v7action.Warnings{"upload-warnings-1", "upload-warnings-2"}, ccerror.PipeSeekError{Err: someErr})
-										})
+								BeforeEach(func() {
+									someErr = errors.New("connection reset by peer")
+									fakeV7Actor.UploadBitsPackageReturnsOnCall(0, resources.Package{}, nil, ccerror.RequestError{Err: someErr})
+									fakeV7Actor.UploadBitsPackageReturnsOnCall(1, resources.Package{GUID: "some-guid"}, v7action.Warnings{"some-upload-package-warning"}, nil)
+								})
 
-										It("should send a RetryUpload event and retry uploading", func() {
-											Expect(events).To(ConsistOf(
-												ResourceMatching, CreatingPackage, CreatingArchive,
-												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
-												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
-												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
-											))
+								It("matches the unmatched resources again before retrying the upload", func() {
+									Expect(executeErr).NotTo(HaveOccurred())
+									Expect(events).To(Equal([]Event{
+										ResourceMatching, CreatingPackage, CreatingArchive,
+										ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+										ResourceMatching, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete, PackageProcessed,
+									}))
 
-											Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2"))
+									Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(2))
+									Expect(fakeV7Actor.ResourceMatchArgsForCall(1)).To(Equal(unmatches))
+								})
 
-											Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(3))
-											Expect(executeErr).To(MatchError(actionerror.UploadFailedError{Err: someErr}))
-										})
+								When("the API still lacks the resources", func() {
+									It("uploads them again with a new stream", func() {
+										Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(Equal(2))
+										_, resources, _ := fakeSharedActor.StreamDirectoryResourcesArgsForCall(1)
+										Expect(resources).To(HaveLen(1))
+										Expect(resources[0].ToV3Resource()).To(Equal(unmatches[0]))
 
+										Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(2))
+										_, matched, _, _ := fakeV7Actor.UploadBitsPackageArgsForCall(1)
+										Expect(matched).To(Equal(matches))
 									})
+								})
 
-									When("the upload error is not a retryable error", func() {
-										BeforeEach(func() {
-											fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, v7action.Warnings{"upload-warnings-1", "upload-warnings-2"}, errors.New("dios mio"))
-										})
+								When("the API kept the resources from the interrupted upload", func() {
+									BeforeEach(func() {
+										fakeV7Actor.ResourceMatchReturnsOnCall(1, unmatches, v7action.Warnings{"some-rematch-warning"}, nil)
+									})
 
-										It("sends warnings and errors, then stops", func() {
-											Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive))
-											Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "upload-warnings-1", "upload-warnings-2"))
-											Expect(executeErr).To(MatchError("dios mio"))
-										})
+									It("uploads the package with only matched resources", func() {
+										Expect(executeErr).NotTo(HaveOccurred())
+										Expect(events).To(Equal([]Event{
+											ResourceMatching, CreatingPackage, CreatingArchive,
+											ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+											ResourceMatching, UploadingApplication, PackageProcessed,
+										}))
+										Expect(warnings).To(ContainElement("some-rematch-warning"))
+
+										Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(Equal(1))
+										Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(2))
+										_, matched, reader, size := fakeV7Actor.UploadBitsPackageArgsForCall(1)
+										Expect(matched).To(Equal(append(matches, unmatches...)))
+										Expect(reader).To(BeNil())
+										Expect(size).To(BeNumerically("==", 0))
 									})
 								})
-							})
 
-							When("reading the archive fails", func() {
-								BeforeEach(func() {
-									fakeSharedActor.ReadArchiveReturns(nil, 0, errors.New("the bits"))
-								})
+								When("matching the resources again fails", func() {
+									BeforeEach(func() {
+										fakeV7Actor.ResourceMatchReturnsOnCall(1, nil, v7action.Warnings{"some-rematch-warning"}, errors.New("rematch-error"))
+									})
 
-								It("returns an error", func() {
-									Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive))
-									Expect(executeErr).To(MatchError("the bits"))
+									It("returns the error and warnings without uploading again", func() {
+										Expect(executeErr).To(MatchError("rematch-error"))
+										Expect(warnings).To(ContainElement("some-rematch-warning"))
+										Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(1))
+									})
 								})
 							})
-						})
-
-						When("the package creation errors", func() {
-							BeforeEach(func() {
-								fakeV7Actor.CreateBitsPackageByApplicationReturns(resources.Package{}, v7action.Warnings{"package-creation-warning"}, errors.New("the package"))
-							})
 
-							It("it returns errors and warnings", func() {
-								Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage))
+							When("the upload error is not a retryable error", func() {
+								BeforeEach(func() {
+									fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, v7action.Warnings{"upload-warnings-1", "upload-warnings-2"}, errors.New("dios mio"))
+								})
 
-								Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "package-creation-warning"))
-								Expect(executeErr).To(MatchError("the package"))
+								It("sends warnings and errors, then stops", func() {
+									Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive))
+									Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "upload-warnings-1", "upload-warnings-2"))
+									Expect(executeErr).To(MatchError("dios mio"))
+								})
 							})
 						})
 					})
 
-					When("the archive creation errors", func() {
+					When("the package creation errors", func() {
 						BeforeEach(func() {
-							fakeSharedActor.ZipDirectoryResourcesReturns("", errors.New("oh no"))
+							fakeV7Actor.CreateBitsPackageByApplicationReturns(resources.Package{}, v7action.Warnings{"package-creation-warning"}, errors.New("the package"))
 						})
 
-						It("returns an error and exits", func() {
-							Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive))
-							Expect(executeErr).To(MatchError("oh no"))
+						It("it returns errors and warnings", func() {
+							Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage))
+
+							Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "package-creation-warning"))
+							Expect(executeErr).To(MatchError("the package"))
 						})
 					})
 				})
@@ -319,11 +417,10 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 					})
 
 					It("Uploads the package without a zip", func() {
-						Expect(fakeSharedActor.ZipArchiveResourcesCallCount()).To(BeZero())
-						Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(BeZero())
-						Expect(fakeSharedActor.ReadArchiveCallCount()).To(BeZero())
+						Expect(fakeSharedActor.StreamArchiveResourcesCallCount()).To(BeZero())
+						Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(BeZero())
 
-						Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, UploadingApplication))
+						Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, UploadingApplication, PackageProcessed))
 						Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(1))
 						_, actualMatchedResources, actualProgressReader, actualSize := fakeV7Actor.UploadBitsPackageArgsForCall(0)
 
@@ -343,9 +440,8 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 					})
 
 					It("returns an error", func() {
-						Expect(fakeSharedActor.ZipArchiveResourcesCallCount()).To(BeZero())
-						Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(BeZero())
-						Expect(fakeSharedActor.ReadArchiveCallCount()).To(BeZero())
+						Expect(fakeSharedActor.StreamArchiveResourcesCallCount()).To(BeZero())
+						Expect(fakeSharedActor.StreamDirectoryResourcesCallCount()).To(BeZero())
 
 						Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, UploadingApplication))
 						Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(1))
@@ -399,16 +495,17 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 
 		When("the the polling is successful", func() {
 			BeforeEach(func() {
-				fakeV7Actor.PollPackageReturns(resources.Package{GUID: "some-package-guid"}, v7action.Warnings{"some-poll-package-warning"}, nil)
+				fakeV7Actor.PollPackageReturns(resources.Package{GUID: "some-package-guid", Checksum: "sha256:some-checksum"}, v7action.Warnings{"some-poll-package-warning"}, nil)
 			})
 
 			It("returns warnings", func() {
-				Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete))
+				Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete, PackageProcessed))
 				Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-poll-package-warning"))
 			})
 
-			It("sets the package guid on push plan", func() {
+			It("sets the package guid and checksum on push plan", func() {
 				Expect(returnedPushPlan.PackageGUID).To(Equal("some-package-guid"))
+				Expect(returnedPushPlan.PackageChecksum).To(Equal("sha256:some-checksum"))
 			})
 		})
 
@@ -424,6 +521,32 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 				Expect(events).To(ConsistOf(ResourceMatching, CreatingPackage, CreatingArchive, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete))
 				Expect(executeErr).To(MatchError(someErr))
 			})
+
+			When("the plan has a resource cache", func() {
+				var cacheDir string
+
+				BeforeEach(func() {
+					var err error
+					cacheDir, err = ioutil.TempDir("", "bits-resource-cache")
+					Expect(err).ToNot(HaveOccurred())
+
+					paramPlan.ResourceCache = resourcecache.New()
+					paramPlan.ResourceCachePath = filepath.Join(cacheDir, "some-app.json")
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(cacheDir)).To(Succeed())
+				})
+
+				It("forgets the cached matches so that the next push asks about every resource", func() {
+					Expect(executeErr).To(MatchError(someErr))
+					Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(1))
+
+					savedCache, err := resourcecache.Read(paramPlan.ResourceCachePath)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(savedCache.Matches).To(BeEmpty())
+				})
+			})
 		})
 	})
 })
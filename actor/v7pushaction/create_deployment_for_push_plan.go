@@ -3,11 +3,15 @@ package v7pushaction
 func (actor Actor) CreateDeploymentForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
 	eventStream <- &PushEvent{Plan: pushPlan, Event: StartingDeployment}
 
-	deploymentGUID, warnings, err := actor.V7Actor.CreateDeploymentByApplicationAndDroplet(pushPlan.Application.GUID, pushPlan.DropletGUID)
+	dep := pushPlan.DeploymentOptions().Deployment(pushPlan.Application.GUID)
+	dep.DropletGUID = pushPlan.DropletGUID
+
+	deploymentGUID, warnings, err := actor.V7Actor.CreateDeployment(dep)
 
 	if err != nil {
 		return pushPlan, Warnings(warnings), err
 	}
+	pushPlan.DeploymentGUID = deploymentGUID
 
 	eventStream <- &PushEvent{Plan: pushPlan, Event: WaitingForDeployment}
 
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,6 +36,10 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			Application: resources.Application{
 				GUID: "some-app-guid",
 			},
+			DropletGUID:   "some-droplet-guid",
+			Strategy:      constant.DeploymentStrategyCanary,
+			MaxInFlight:   2,
+			CanaryWeights: []int{10, 50},
 		}
 	})
 
@@ -52,13 +57,25 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 					return nil, nil
 				})
 
-				fakeV7Actor.CreateDeploymentByApplicationAndDropletReturns(
+				fakeV7Actor.CreateDeploymentReturns(
 					"some-deployment-guid",
 					v7action.Warnings{"some-deployment-warning"},
 					nil,
 				)
 			})
 
+			It("creates a deployment of the droplet with the strategy, max in flight and canary weights", func() {
+				Expect(fakeV7Actor.CreateDeploymentCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.CreateDeploymentArgsForCall(0)).To(Equal(resources.Deployment{
+					DropletGUID: "some-droplet-guid",
+					Strategy:    constant.DeploymentStrategyCanary,
+					Options:     resources.DeploymentOpts{MaxInFlight: 2, CanaryWeights: []int{10, 50}},
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"},
+					},
+				}))
+			})
+
 			It("waits for the app to start", func() {
 				Expect(fakeV7Actor.PollStartForRollingCallCount()).To(Equal(1))
 				givenApp, givenDeploymentGUID, noWait, _ := fakeV7Actor.PollStartForRollingArgsForCall(0)
@@ -68,8 +85,10 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 				Expect(events).To(ConsistOf(StartingDeployment, InstanceDetails, WaitingForDeployment))
 			})
 
-			It("returns errors and warnings", func() {
-				Expect(returnedPushPlan).To(Equal(paramPlan))
+			It("returns the push plan with the deployment guid, and warnings", func() {
+				expectedPlan := paramPlan
+				expectedPlan.DeploymentGUID = "some-deployment-guid"
+				Expect(returnedPushPlan).To(Equal(expectedPlan))
 				Expect(executeErr).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf("some-deployment-warning"))
 			})
@@ -85,7 +104,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			BeforeEach(func() {
 				someErr = errors.New("failed to create deployment")
 
-				fakeV7Actor.CreateDeploymentByApplicationAndDropletReturns(
+				fakeV7Actor.CreateDeploymentReturns(
 					"",
 					v7action.Warnings{"some-deployment-warning"},
 					someErr,
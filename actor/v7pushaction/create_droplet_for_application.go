@@ -3,7 +3,6 @@ package v7pushaction
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
-	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
 const UploadRetries = 3
@@ -32,7 +31,7 @@ func (actor Actor) CreateDropletForApplication(pushPlan PushPlan, eventStream ch
 		uploadWarnings, err = actor.V7Actor.UploadDroplet(droplet.GUID, pushPlan.DropletPath, progressReader, size)
 		allWarnings = append(allWarnings, uploadWarnings...)
 
-		if _, ok := err.(ccerror.PipeSeekError); ok {
+		if _, interrupted := interruptedUploadError(err); interrupted {
 			eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
 			continue
 		}
@@ -41,8 +40,8 @@ func (actor Actor) CreateDropletForApplication(pushPlan PushPlan, eventStream ch
 	}
 
 	if err != nil {
-		if e, ok := err.(ccerror.PipeSeekError); ok {
-			return pushPlan, allWarnings, actionerror.UploadFailedError{Err: e.Err}
+		if uploadErr, interrupted := interruptedUploadError(err); interrupted {
+			return pushPlan, allWarnings, actionerror.UploadFailedError{Err: uploadErr}
 		}
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadDropletComplete}
 
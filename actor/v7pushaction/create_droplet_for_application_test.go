@@ -267,6 +267,62 @@ var _ = Describe("CreateDropletForApplication", func() {
 			})
 		})
 
+		When("the connection fails during the upload", func() {
+			var connectionErr = errors.New("connection reset by peer")
+
+			BeforeEach(func() {
+				fakeV7Actor.CreateApplicationDropletReturns(
+					resources.Droplet{GUID: "created-droplet-guid"},
+					v7action.Warnings{"create-droplet-warning"},
+					nil,
+				)
+
+				fakeSharedActor.ReadArchiveReturns(
+					new(v7pushactionfakes.FakeReadCloser),
+					int64(128),
+					nil,
+				)
+
+				fakeV7Actor.UploadDropletReturnsOnCall(0,
+					v7action.Warnings{"upload-droplet-warning"},
+					ccerror.RequestError{Err: connectionErr},
+				)
+			})
+
+			It("reads the droplet again and retries the upload", func() {
+				Expect(events).To(Equal([]Event{
+					CreatingDroplet,
+					ReadingArchive,
+					UploadingDroplet,
+					RetryUpload,
+					ReadingArchive,
+					UploadingDroplet,
+					UploadDropletComplete,
+				}))
+				Expect(fakeSharedActor.ReadArchiveCallCount()).To(Equal(2))
+				Expect(fakeV7Actor.UploadDropletCallCount()).To(Equal(2))
+				Expect(executeErr).NotTo(HaveOccurred())
+			})
+
+			When("every upload attempt is interrupted", func() {
+				BeforeEach(func() {
+					fakeV7Actor.UploadDropletReturns(
+						v7action.Warnings{"upload-droplet-warning"},
+						ccerror.RequestError{Err: connectionErr},
+					)
+					fakeV7Actor.UploadDropletReturnsOnCall(0,
+						v7action.Warnings{"upload-droplet-warning"},
+						ccerror.RequestError{Err: connectionErr},
+					)
+				})
+
+				It("returns an UploadFailedError with the connection error", func() {
+					Expect(fakeV7Actor.UploadDropletCallCount()).To(Equal(UploadRetries))
+					Expect(executeErr).To(Equal(actionerror.UploadFailedError{Err: connectionErr}))
+				})
+			})
+		})
+
 		When("upload completes successfully", func() {
 			var createdDroplet = resources.Droplet{GUID: "created-droplet-guid"}
 			var progressReader = strings.NewReader("123456")
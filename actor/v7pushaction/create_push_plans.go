@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/manifestparser"
+	log "github.com/sirupsen/logrus"
 )
 
 // CreatePushPlans returns a set of PushPlan objects based off the inputs
@@ -48,6 +49,14 @@ func (actor Actor) CreatePushPlans(
 			}
 		}
 
+		log.WithFields(log.Fields{
+			"app":       manifestApplication.Name,
+			"exists":    plan.Application.GUID != "",
+			"bits_path": plan.BitsPath,
+			"docker":    plan.DockerImageCredentials.Path != "",
+			"strategy":  plan.Strategy,
+		}).Debug("created push plan")
+
 		pushPlans = append(pushPlans, plan)
 	}
 
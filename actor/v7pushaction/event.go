@@ -12,16 +12,19 @@ type Event string
 const (
 	ApplyManifest                   Event = "Applying manifest"
 	ApplyManifestComplete           Event = "Applying manifest Complete"
+	ClearingBuildCache              Event = "clearing build cache"
 	CreatingArchive                 Event = "creating archive"
 	CreatingDroplet                 Event = "creating droplet"
 	CreatingPackage                 Event = "creating package"
 	InstanceDetails                 Event = "instance details"
+	PackageProcessed                Event = "package processed"
 	PollingBuild                    Event = "polling build"
 	ReadingArchive                  Event = "reading archive"
 	ResourceMatching                Event = "resource matching"
 	RestartingApplication           Event = "restarting application"
 	RestartingApplicationComplete   Event = "restarting application complete"
 	RetryUpload                     Event = "retry upload"
+	ReusingPackage                  Event = "reusing package"
 	SetDockerImage                  Event = "setting docker properties"
 	SetDockerImageComplete          Event = "completed setting docker properties"
 	SetDropletComplete              Event = "set droplet complete"
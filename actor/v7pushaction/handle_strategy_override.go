@@ -6,7 +6,7 @@ import (
 )
 
 func HandleStrategyOverride(manifest manifestparser.Manifest, overrides FlagOverrides) (manifestparser.Manifest, error) {
-	if overrides.Strategy != "" {
+	if overrides.Strategy != "" || overrides.MaxInFlight != 0 || len(overrides.CanaryWeights) > 0 {
 		if manifest.ContainsMultipleApps() {
 			return manifest, translatableerror.CommandLineArgsWithMultipleAppsError{}
 		}
@@ -63,6 +63,22 @@ var _ = Describe("HandleStrategyOverride", func() {
 		})
 	})
 
+	When("the max in flight flag override is set with multiple apps in the manifest", func() {
+		BeforeEach(func() {
+			flagOverrides = FlagOverrides{MaxInFlight: 2}
+			parsedManifest = manifestparser.Manifest{
+				Applications: []manifestparser.Application{
+					{},
+					{},
+				},
+			}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.CommandLineArgsWithMultipleAppsError{}))
+		})
+	})
+
 	When("the strategy flag override is not set", func() {
 		BeforeEach(func() {
 			flagOverrides = FlagOverrides{}
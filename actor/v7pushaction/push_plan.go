@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
@@ -17,9 +18,15 @@ type PushPlan struct {
 
 	Application resources.Application
 
-	NoStart             bool
-	NoWait              bool
+	NoBuildCache bool
+	NoStart      bool
+	NoWait       bool
+	// Resume stages the newest ready package of the app instead of creating
+	// one from its files.
+	Resume              bool
 	Strategy            constant.DeploymentStrategy
+	MaxInFlight         int
+	CanaryWeights       []int
 	TaskTypeApplication bool
 
 	DockerImageCredentials v7action.DockerImageCredentials
@@ -28,9 +35,23 @@ type PushPlan struct {
 	BitsPath     string
 	DropletPath  string
 	AllResources []sharedaction.V3Resource
+	ModeChanges  []sharedaction.ModeChange
+	// SourceDigest identifies the files of the app by their path, mode and
+	// SHA1, so the same source always has the same digest.
+	SourceDigest       string
+	PreserveTimestamps bool
+	// ResourceCache is what was remembered about the files of the app by its
+	// previous pushes, saved to ResourceCachePath. It is nil when the files
+	// are hashed and matched from scratch.
+	ResourceCache     *resourcecache.Cache
+	ResourceCachePath string
 
 	PackageGUID string
-	DropletGUID string
+	// PackageChecksum is the checksum of the package reported by the API once
+	// it has processed the bits.
+	PackageChecksum string
+	DropletGUID     string
+	DeploymentGUID  string
 }
 
 type FlagOverrides struct {
@@ -42,26 +63,41 @@ type FlagOverrides struct {
 	DockerImage         string
 	DockerPassword      string
 	DockerUsername      string
+	FollowSymlinks      bool
 	HealthCheckEndpoint string
 	HealthCheckTimeout  int64
 	HealthCheckType     constant.HealthCheckType
 	Instances           types.NullInt
 	Memory              string
+	NoBuildCache        bool
 	NoStart             bool
 	NoWait              bool
 	ProvidedAppPath     string
 	NoRoute             bool
 	RandomRoute         bool
+	Resume              bool
 	StartCommand        types.FilteredString
 	Strategy            constant.DeploymentStrategy
+	MaxInFlight         int
+	CanaryWeights       []int
 	ManifestPath        string
+	PathsToOverlays     []string
 	PathsToVarsFiles    []string
+	PreserveFileModes   bool
+	PreserveTimestamps  bool
+	ResourceCacheDir    string
 	Vars                []template.VarKV
 	NoManifest          bool
 	Task                bool
 	LogRateLimit        string
 }
 
+// DeploymentOptions returns how the instances of the app are replaced when it
+// is started.
+func (state PushPlan) DeploymentOptions() v7action.DeploymentOptions {
+	return v7action.DeploymentOptions{Strategy: state.Strategy, MaxInFlight: state.MaxInFlight, CanaryWeights: state.CanaryWeights}
+}
+
 func (state PushPlan) String() string {
 	return fmt.Sprintf(
 		"Application: %#v - Space GUID: %s, Org GUID: %s, Archive: %t, Bits Path: %s",
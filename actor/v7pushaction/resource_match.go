@@ -1,6 +1,9 @@
 package v7pushaction
 
-import "code.cloudfoundry.org/cli/actor/sharedaction"
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	log "github.com/sirupsen/logrus"
+)
 
 // MatchResources returns back a list of matched and unmatched resources for the provided resources.
 func (actor Actor) MatchResources(resources []sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, Warnings, error) {
@@ -20,3 +23,51 @@ func (actor Actor) MatchResources(resources []sharedaction.V3Resource) ([]shared
 
 	return matches, unmatches, Warnings(warnings), err
 }
+
+// MatchResourcesWithCache returns back a list of matched and unmatched
+// resources for the resources of the plan, like MatchResources, asking the API
+// only about the resources the resource cache of the plan has no recent answer
+// for, and not at all when it has one for every resource.
+func (actor Actor) MatchResourcesWithCache(pushPlan PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, Warnings, error) {
+	cache := pushPlan.ResourceCache
+	if cache == nil {
+		return actor.MatchResources(pushPlan.AllResources)
+	}
+
+	var matches, unmatches, unknown []sharedaction.V3Resource
+	for _, resource := range pushPlan.AllResources {
+		matched, known := cache.Matched(resource.Checksum.Value, resource.SizeInBytes)
+		switch {
+		case !known:
+			unknown = append(unknown, resource)
+		case matched:
+			matches = append(matches, resource)
+		default:
+			unmatches = append(unmatches, resource)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"cached":  len(matches) + len(unmatches),
+		"unknown": len(unknown),
+	}).Info("matching resources with the resource cache")
+	if len(unknown) == 0 {
+		return matches, unmatches, nil, nil
+	}
+
+	newMatches, newUnmatches, warnings, err := actor.MatchResources(unknown)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	matchedChecksums := map[string]bool{}
+	for _, resource := range newMatches {
+		matchedChecksums[resource.Checksum.Value] = true
+	}
+	for _, resource := range unknown {
+		cache.SetMatched(resource.Checksum.Value, resource.SizeInBytes, matchedChecksums[resource.Checksum.Value])
+	}
+	writeResourceCache(pushPlan)
+
+	return append(matches, newMatches...), append(unmatches, newUnmatches...), warnings, nil
+}
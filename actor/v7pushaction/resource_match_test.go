@@ -2,12 +2,16 @@ package v7pushaction_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -135,3 +139,112 @@ var _ = Describe("MatchResources", func() {
 		})
 	})
 })
+
+var _ = Describe("MatchResourcesWithCache", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		pushPlan   PushPlan
+		cache      *resourcecache.Cache
+		cacheDir   string
+		executeErr error
+
+		matched   []sharedaction.V3Resource
+		unmatched []sharedaction.V3Resource
+		warnings  Warnings
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		var err error
+		cacheDir, err = ioutil.TempDir("", "match-resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		cache = resourcecache.New()
+		pushPlan = PushPlan{
+			AllResources: []sharedaction.V3Resource{
+				{FilePath: "file-1", Checksum: ccv3.Checksum{Value: "sha-1"}, SizeInBytes: 1},
+				{FilePath: "file-2", Checksum: ccv3.Checksum{Value: "sha-2"}, SizeInBytes: 2},
+				{FilePath: "file-3", Checksum: ccv3.Checksum{Value: "sha-3"}, SizeInBytes: 3},
+			},
+			ResourceCache:     cache,
+			ResourceCachePath: filepath.Join(cacheDir, "some-app.json"),
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		matched, unmatched, warnings, executeErr = actor.MatchResourcesWithCache(pushPlan)
+	})
+
+	When("the plan has no resource cache", func() {
+		BeforeEach(func() {
+			pushPlan.ResourceCache = nil
+			fakeV7Actor.ResourceMatchReturns(pushPlan.AllResources[:1], v7action.Warnings{"match-warning"}, nil)
+		})
+
+		It("asks about every resource", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal(pushPlan.AllResources))
+			Expect(matched).To(Equal(pushPlan.AllResources[:1]))
+			Expect(unmatched).To(Equal(pushPlan.AllResources[1:]))
+			Expect(warnings).To(Equal(Warnings{"match-warning"}))
+		})
+	})
+
+	When("the cache knows about some of the resources", func() {
+		BeforeEach(func() {
+			cache.SetMatched("sha-1", 1, true)
+			cache.SetMatched("sha-2", 2, false)
+			fakeV7Actor.ResourceMatchReturns(pushPlan.AllResources[2:], v7action.Warnings{"match-warning"}, nil)
+		})
+
+		It("asks only about the others and caches the answers", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(1))
+			Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal(pushPlan.AllResources[2:]))
+
+			Expect(matched).To(ConsistOf(pushPlan.AllResources[0], pushPlan.AllResources[2]))
+			Expect(unmatched).To(ConsistOf(pushPlan.AllResources[1]))
+			Expect(warnings).To(Equal(Warnings{"match-warning"}))
+
+			savedCache, err := resourcecache.Read(pushPlan.ResourceCachePath)
+			Expect(err).ToNot(HaveOccurred())
+			isMatched, known := savedCache.Matched("sha-3", 3)
+			Expect(known).To(BeTrue())
+			Expect(isMatched).To(BeTrue())
+		})
+	})
+
+	When("the cache knows about every resource", func() {
+		BeforeEach(func() {
+			cache.SetMatched("sha-1", 1, true)
+			cache.SetMatched("sha-2", 2, false)
+			cache.SetMatched("sha-3", 3, false)
+		})
+
+		It("does not ask the API", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+			Expect(matched).To(ConsistOf(pushPlan.AllResources[0]))
+			Expect(unmatched).To(ConsistOf(pushPlan.AllResources[1], pushPlan.AllResources[2]))
+		})
+	})
+
+	When("matching the resources fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(nil, v7action.Warnings{"match-warning"}, errors.New("match failed"))
+		})
+
+		It("returns the error and warnings without caching anything", func() {
+			Expect(executeErr).To(MatchError("match failed"))
+			Expect(warnings).To(Equal(Warnings{"match-warning"}))
+			Expect(cache.Matches).To(BeEmpty())
+		})
+	})
+})
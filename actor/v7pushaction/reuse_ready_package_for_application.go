@@ -0,0 +1,24 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// ReuseReadyPackageForApplication stages the newest ready package of the app,
+// e.g. the one uploaded by a push whose staging failed, instead of uploading
+// the files of the app again.
+func (actor Actor) ReuseReadyPackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	eventStream <- &PushEvent{Plan: pushPlan, Event: ReusingPackage}
+
+	pkg, warnings, err := actor.V7Actor.GetNewestReadyPackageForApplication(pushPlan.Application)
+	if err != nil {
+		return pushPlan, Warnings(warnings), err
+	}
+	log.WithField("GUID", pkg.GUID).Info("reusing package")
+
+	pushPlan.PackageGUID = pkg.GUID
+	pushPlan.PackageChecksum = pkg.Checksum
+	eventStream <- &PushEvent{Plan: pushPlan, Event: PackageProcessed}
+
+	return pushPlan, Warnings(warnings), nil
+}
@@ -0,0 +1,94 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReuseReadyPackageForApplication", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		returnedPushPlan PushPlan
+		paramPlan        PushPlan
+		fakeProgressBar  *v7pushactionfakes.FakeProgressBar
+
+		warnings   Warnings
+		executeErr error
+
+		events []Event
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		fakeProgressBar = new(v7pushactionfakes.FakeProgressBar)
+
+		paramPlan = PushPlan{
+			Application: resources.Application{
+				Name: "some-app",
+				GUID: "some-app-guid",
+			},
+			Resume: true,
+		}
+	})
+
+	JustBeforeEach(func() {
+		events = EventFollower(func(eventStream chan<- *PushEvent) {
+			returnedPushPlan, warnings, executeErr = actor.ReuseReadyPackageForApplication(paramPlan, eventStream, fakeProgressBar)
+		})
+	})
+
+	When("the app has a ready package", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetNewestReadyPackageForApplicationReturns(
+				resources.Package{GUID: "some-package-guid", Checksum: "some-checksum"},
+				v7action.Warnings{"get-package-warning"},
+				nil,
+			)
+		})
+
+		It("sets the package on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-package-warning"))
+			Expect(returnedPushPlan.PackageGUID).To(Equal("some-package-guid"))
+			Expect(returnedPushPlan.PackageChecksum).To(Equal("some-checksum"))
+
+			Expect(fakeV7Actor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(1))
+			Expect(fakeV7Actor.GetNewestReadyPackageForApplicationArgsForCall(0)).To(Equal(paramPlan.Application))
+		})
+
+		It("does not upload anything", func() {
+			Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(0))
+		})
+
+		It("sends the reusing and processed events", func() {
+			Expect(events).To(ConsistOf(ReusingPackage, PackageProcessed))
+		})
+	})
+
+	When("getting the ready package fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetNewestReadyPackageForApplicationReturns(
+				resources.Package{},
+				v7action.Warnings{"get-package-warning"},
+				errors.New("get-package-error"),
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("get-package-error"))
+			Expect(warnings).To(ConsistOf("get-package-warning"))
+			Expect(returnedPushPlan.PackageGUID).To(BeEmpty())
+			Expect(events).To(ConsistOf(ReusingPackage))
+		})
+	})
+})
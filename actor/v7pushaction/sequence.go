@@ -2,8 +2,13 @@ package v7pushaction
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	log "github.com/sirupsen/logrus"
 )
 
+func ShouldReusePackage(plan PushPlan) bool {
+	return plan.Resume
+}
+
 func ShouldCreateBitsPackage(plan PushPlan) bool {
 	return plan.DropletPath == "" && plan.DockerImageCredentials.Path == ""
 }
@@ -21,7 +26,7 @@ func ShouldStagePackage(plan PushPlan) bool {
 }
 
 func ShouldCreateDeployment(plan PushPlan) bool {
-	return plan.Strategy == constant.DeploymentStrategyRolling
+	return plan.DeploymentOptions().UsesDeployment()
 }
 
 func ShouldStopApplication(plan PushPlan) bool {
@@ -38,14 +43,22 @@ func ShouldRestart(plan PushPlan) bool {
 
 func (actor Actor) GetPrepareApplicationSourceSequence(plan PushPlan) []ChangeApplicationFunc {
 	var prepareSourceSequence []ChangeApplicationFunc
+	var steps []string
 	switch {
+	case ShouldReusePackage(plan):
+		prepareSourceSequence = append(prepareSourceSequence, actor.ReuseReadyPackageForApplication)
+		steps = append(steps, "reuse ready package")
 	case ShouldCreateBitsPackage(plan):
 		prepareSourceSequence = append(prepareSourceSequence, actor.CreateBitsPackageForApplication)
+		steps = append(steps, "create bits package")
 	case ShouldCreateDockerPackage(plan):
 		prepareSourceSequence = append(prepareSourceSequence, actor.CreateDockerPackageForApplication)
+		steps = append(steps, "create docker package")
 	case ShouldCreateDroplet(plan):
 		prepareSourceSequence = append(prepareSourceSequence, actor.CreateDropletForApplication)
+		steps = append(steps, "upload droplet")
 	}
+	logSequence(plan, "prepare source", steps)
 	return prepareSourceSequence
 }
 
@@ -60,27 +73,34 @@ func (actor Actor) GetRuntimeSequence(plan PushPlan) []ChangeApplicationFunc {
 
 func (actor Actor) getDefaultRuntimeSequence(plan PushPlan) []ChangeApplicationFunc {
 	var runtimeSequence []ChangeApplicationFunc
+	var steps []string
 
 	if ShouldStagePackage(plan) {
 		runtimeSequence = append(runtimeSequence, actor.StagePackageForApplication)
+		steps = append(steps, "stage package")
 	}
 
 	if ShouldCreateDeployment(plan) {
 		runtimeSequence = append(runtimeSequence, actor.CreateDeploymentForApplication)
+		steps = append(steps, "create deployment")
 	} else {
 		if ShouldStopApplication(plan) {
 			runtimeSequence = append(runtimeSequence, actor.StopApplication)
+			steps = append(steps, "stop application")
 		}
 
 		if ShouldSetDroplet(plan) {
 			runtimeSequence = append(runtimeSequence, actor.SetDropletForApplication)
+			steps = append(steps, "set droplet")
 		}
 
 		if ShouldRestart(plan) {
 			runtimeSequence = append(runtimeSequence, actor.RestartApplication)
+			steps = append(steps, "restart application")
 		}
 	}
 
+	logSequence(plan, "runtime", steps)
 	return runtimeSequence
 }
 
@@ -91,5 +111,22 @@ func (actor Actor) getTaskAppRuntimeSequence(plan PushPlan) []ChangeApplicationF
 	runtimeSequence = append(runtimeSequence, actor.StopApplication)
 	runtimeSequence = append(runtimeSequence, actor.SetDropletForApplication)
 
+	logSequence(plan, "runtime", []string{"stage package", "stop application", "set droplet"})
 	return runtimeSequence
 }
+
+// logSequence logs the steps chosen for a part of the push of an app, along
+// with the parts of the plan that the choice was based on.
+func logSequence(plan PushPlan, sequence string, steps []string) {
+	log.WithFields(log.Fields{
+		"app":          plan.Application.Name,
+		"sequence":     sequence,
+		"steps":        steps,
+		"docker_image": plan.DockerImageCredentials.Path,
+		"droplet_path": plan.DropletPath,
+		"no_start":     plan.NoStart,
+		"resume":       plan.Resume,
+		"strategy":     plan.Strategy,
+		"task":         plan.TaskTypeApplication,
+	}).Debug("chose push steps")
+}
@@ -59,6 +59,18 @@ var _ = Describe("Actor", func() {
 				Expect(sequence).To(matchers.MatchFuncsByName(actor.CreateDropletForApplication))
 			})
 		})
+
+		When("the plan resumes from the last uploaded package", func() {
+			BeforeEach(func() {
+				plan = PushPlan{
+					Resume: true,
+				}
+			})
+
+			It("returns a sequence including reusing the ready package", func() {
+				Expect(sequence).To(matchers.MatchFuncsByName(actor.ReuseReadyPackageForApplication))
+			})
+		})
 	})
 
 	Describe("GetRuntimeSequence", func() {
@@ -113,6 +125,18 @@ var _ = Describe("Actor", func() {
 			})
 		})
 
+		When("the plan has strategy 'canary'", func() {
+			BeforeEach(func() {
+				plan = PushPlan{
+					Strategy: constant.DeploymentStrategyCanary,
+				}
+			})
+
+			It("returns a sequence that creates a deployment without stopping/restarting the app", func() {
+				Expect(sequence).To(matchers.MatchFuncsByName(actor.StagePackageForApplication, actor.CreateDeploymentForApplication))
+			})
+		})
+
 		When("the plan has task application type", func() {
 			BeforeEach(func() {
 				plan = PushPlan{
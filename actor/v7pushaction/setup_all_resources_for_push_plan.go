@@ -2,14 +2,18 @@ package v7pushaction
 
 import (
 	"errors"
+	"net/url"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/util/resourcecache"
+	log "github.com/sirupsen/logrus"
 )
 
 func (actor Actor) SetupAllResourcesForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
-	if pushPlan.DropletPath != "" {
+	if pushPlan.DropletPath != "" || pushPlan.Resume {
 		return pushPlan, nil
 	}
 
@@ -27,10 +31,20 @@ func (actor Actor) SetupAllResourcesForPushPlan(pushPlan PushPlan, overrides Fla
 		return PushPlan{}, err
 	}
 
+	pushPlan.ResourceCache, pushPlan.ResourceCachePath = readResourceCache(pushPlan, overrides)
+
 	var archive bool
 	var resources []sharedaction.Resource
+	var modeChanges []sharedaction.ModeChange
 	if info.IsDir() {
-		resources, err = actor.SharedActor.GatherDirectoryResources(path)
+		options := sharedaction.GatherOptions{
+			FollowSymlinks:    overrides.FollowSymlinks,
+			PreserveFileModes: overrides.PreserveFileModes,
+		}
+		if pushPlan.ResourceCache != nil {
+			options.HashCache = pushPlan.ResourceCache
+		}
+		resources, modeChanges, err = actor.SharedActor.GatherDirectoryResourcesWithOptions(path, options)
 	} else {
 		archive = true
 		resources, err = actor.SharedActor.GatherArchiveResources(path)
@@ -39,6 +53,9 @@ func (actor Actor) SetupAllResourcesForPushPlan(pushPlan PushPlan, overrides Fla
 		return PushPlan{}, err
 	}
 
+	pushPlan.SourceDigest = sharedaction.ResourcesDigest(resources)
+	pushPlan.PreserveTimestamps = overrides.PreserveTimestamps
+
 	var v3Resources []sharedaction.V3Resource
 	for _, resource := range resources {
 		v3Resources = append(v3Resources, resource.ToV3Resource())
@@ -46,6 +63,45 @@ func (actor Actor) SetupAllResourcesForPushPlan(pushPlan PushPlan, overrides Fla
 
 	pushPlan.Archive = archive
 	pushPlan.AllResources = v3Resources
+	pushPlan.ModeChanges = modeChanges
+
+	if pushPlan.ResourceCache != nil {
+		var paths []string
+		for _, resource := range resources {
+			paths = append(paths, resource.Filename)
+		}
+		pushPlan.ResourceCache.KeepFiles(paths)
+		writeResourceCache(pushPlan)
+	}
 
 	return pushPlan, nil
 }
+
+// readResourceCache returns the resource cache of the app and its path, or
+// nil when the resource caches are not used. A cache that cannot be read is
+// started over.
+func readResourceCache(pushPlan PushPlan, overrides FlagOverrides) (*resourcecache.Cache, string) {
+	if overrides.ResourceCacheDir == "" || pushPlan.SpaceGUID == "" || pushPlan.Application.Name == "" {
+		return nil, ""
+	}
+
+	path := filepath.Join(overrides.ResourceCacheDir, pushPlan.SpaceGUID, url.PathEscape(pushPlan.Application.Name)+".json")
+	cache, err := resourcecache.Read(path)
+	if err != nil {
+		log.WithField("path", path).WithError(err).Warn("could not read the resource cache")
+	}
+	return cache, path
+}
+
+// writeResourceCache saves the resource cache of the plan. It only speeds up
+// the next push, so an error does not fail the push.
+func writeResourceCache(pushPlan PushPlan) {
+	if pushPlan.ResourceCache == nil {
+		return
+	}
+
+	err := pushPlan.ResourceCache.Write(pushPlan.ResourceCachePath)
+	if err != nil {
+		log.WithField("path", pushPlan.ResourceCachePath).WithError(err).Warn("could not write the resource cache")
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -48,7 +50,21 @@ var _ = Describe("SetupAllResourcesForPushPlan", func() {
 			Expect(pushPlan.AllResources).To(BeEmpty())
 
 			Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(0))
-			Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+			Expect(fakeSharedActor.GatherDirectoryResourcesWithOptionsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the plan resumes from the last uploaded package", func() {
+		BeforeEach(func() {
+			pushPlan.Resume = true
+		})
+
+		It("skips settings the resources", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.AllResources).To(BeEmpty())
+
+			Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(0))
+			Expect(fakeSharedActor.GatherDirectoryResourcesWithOptionsCallCount()).To(Equal(0))
 		})
 	})
 
@@ -62,7 +78,7 @@ var _ = Describe("SetupAllResourcesForPushPlan", func() {
 			Expect(pushPlan.AllResources).To(BeEmpty())
 
 			Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(0))
-			Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+			Expect(fakeSharedActor.GatherDirectoryResourcesWithOptionsCallCount()).To(Equal(0))
 		})
 	})
 
@@ -72,7 +88,7 @@ var _ = Describe("SetupAllResourcesForPushPlan", func() {
 				Expect(executeErr).To(MatchError("developer error: Bits Path needs to be set prior to generating app resources"))
 
 				Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(0))
-				Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+				Expect(fakeSharedActor.GatherDirectoryResourcesWithOptionsCallCount()).To(Equal(0))
 			})
 		})
 
@@ -95,25 +111,110 @@ var _ = Describe("SetupAllResourcesForPushPlan", func() {
 							Filename: "fake-app-file",
 						},
 					}
-					fakeSharedActor.GatherDirectoryResourcesReturns(resources, nil)
+					fakeSharedActor.GatherDirectoryResourcesWithOptionsReturns(resources, []sharedaction.ModeChange{{Filename: "fake-app-file", Original: 0777, Normalized: 0775}}, nil)
 				})
 
 				It("adds the gathered resources to the push plan", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(1))
-					Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(0)).To(Equal(pwd))
+					Expect(fakeSharedActor.GatherDirectoryResourcesWithOptionsCallCount()).To(Equal(1))
+					path, options := fakeSharedActor.GatherDirectoryResourcesWithOptionsArgsForCall(0)
+					Expect(path).To(Equal(pwd))
+					Expect(options).To(Equal(sharedaction.GatherOptions{}))
 					Expect(expectedPushPlan.AllResources[0]).To(Equal(resources[0].ToV3Resource()))
 				})
 
+				It("records the digest of the package on the push plan", func() {
+					Expect(expectedPushPlan.SourceDigest).To(Equal(sharedaction.ResourcesDigest(resources)))
+				})
+
+				When("timestamps should be preserved", func() {
+					BeforeEach(func() {
+						overrides.PreserveTimestamps = true
+					})
+
+					It("records it on the push plan", func() {
+						Expect(expectedPushPlan.PreserveTimestamps).To(BeTrue())
+					})
+				})
+
+				It("records the normalized file modes on the push plan", func() {
+					Expect(expectedPushPlan.ModeChanges).To(ConsistOf(sharedaction.ModeChange{Filename: "fake-app-file", Original: 0777, Normalized: 0775}))
+				})
+
+				When("symlinks should be followed", func() {
+					BeforeEach(func() {
+						overrides.FollowSymlinks = true
+					})
+
+					It("gathers the resources following symlinks", func() {
+						_, options := fakeSharedActor.GatherDirectoryResourcesWithOptionsArgsForCall(0)
+						Expect(options).To(Equal(sharedaction.GatherOptions{FollowSymlinks: true}))
+					})
+				})
+
+				When("file modes should be preserved", func() {
+					BeforeEach(func() {
+						overrides.PreserveFileModes = true
+					})
+
+					It("gathers the resources without normalizing their modes", func() {
+						_, options := fakeSharedActor.GatherDirectoryResourcesWithOptionsArgsForCall(0)
+						Expect(options).To(Equal(sharedaction.GatherOptions{PreserveFileModes: true}))
+					})
+				})
+
 				It("sets Archive to false", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(expectedPushPlan.Archive).To(BeFalse())
 				})
+
+				It("does not use a resource cache", func() {
+					Expect(expectedPushPlan.ResourceCache).To(BeNil())
+				})
+
+				When("a resource cache directory is given", func() {
+					var cacheDir string
+
+					BeforeEach(func() {
+						var err error
+						cacheDir, err = ioutil.TempDir("", "push-resource-cache")
+						Expect(err).ToNot(HaveOccurred())
+
+						overrides.ResourceCacheDir = cacheDir
+						pushPlan.SpaceGUID = "some-space-guid"
+						pushPlan.Application.Name = "some app"
+
+						cache := resourcecache.New()
+						cache.Files["deleted-file"] = resourcecache.Fingerprint{SHA1: "some-sha"}
+						cache.Files["fake-app-file"] = resourcecache.Fingerprint{SHA1: "fake-sha"}
+						Expect(cache.Write(filepath.Join(cacheDir, "some-space-guid", "some%20app.json"))).To(Succeed())
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(cacheDir)).To(Succeed())
+					})
+
+					It("gathers the resources with the cache of the app and saves it without the deleted files", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						cachePath := filepath.Join(cacheDir, "some-space-guid", "some%20app.json")
+						Expect(expectedPushPlan.ResourceCachePath).To(Equal(cachePath))
+						Expect(expectedPushPlan.ResourceCache).ToNot(BeNil())
+
+						_, options := fakeSharedActor.GatherDirectoryResourcesWithOptionsArgsForCall(0)
+						Expect(options.HashCache).To(Equal(expectedPushPlan.ResourceCache))
+
+						savedCache, err := resourcecache.Read(cachePath)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(savedCache.Files).To(HaveKey("fake-app-file"))
+						Expect(savedCache.Files).ToNot(HaveKey("deleted-file"))
+					})
+				})
 			})
 
 			When("gathering the resources errors", func() {
 				BeforeEach(func() {
-					fakeSharedActor.GatherDirectoryResourcesReturns(nil, errors.New("kaboom"))
+					fakeSharedActor.GatherDirectoryResourcesWithOptionsReturns(nil, nil, errors.New("kaboom"))
 				})
 
 				It("returns the error", func() {
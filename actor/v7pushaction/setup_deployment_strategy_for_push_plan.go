@@ -2,6 +2,8 @@ package v7pushaction
 
 func SetupDeploymentStrategyForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
 	pushPlan.Strategy = overrides.Strategy
+	pushPlan.MaxInFlight = overrides.MaxInFlight
+	pushPlan.CanaryWeights = overrides.CanaryWeights
 
 	return pushPlan, nil
 }
@@ -29,12 +29,16 @@ var _ = Describe("SetupDeploymentStrategyForPushPlan", func() {
 
 	When("flag overrides specifies strategy", func() {
 		BeforeEach(func() {
-			overrides.Strategy = "rolling"
+			overrides.Strategy = "canary"
+			overrides.MaxInFlight = 3
+			overrides.CanaryWeights = []int{20, 60}
 		})
 
-		It("sets the strategy on the push plan", func() {
+		It("sets the strategy, max in flight and canary weights on the push plan", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
-			Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+			Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(expectedPushPlan.MaxInFlight).To(Equal(3))
+			Expect(expectedPushPlan.CanaryWeights).To(Equal([]int{20, 60}))
 		})
 	})
 
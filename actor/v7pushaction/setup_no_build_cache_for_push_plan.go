@@ -0,0 +1,7 @@
+package v7pushaction
+
+func SetupNoBuildCacheForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
+	pushPlan.NoBuildCache = overrides.NoBuildCache
+
+	return pushPlan, nil
+}
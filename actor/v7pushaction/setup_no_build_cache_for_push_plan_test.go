@@ -0,0 +1,45 @@
+package v7pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetupNoBuildCacheForPushPlan", func() {
+	var (
+		pushPlan  PushPlan
+		overrides FlagOverrides
+
+		expectedPushPlan PushPlan
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		pushPlan = PushPlan{}
+		overrides = FlagOverrides{}
+	})
+
+	JustBeforeEach(func() {
+		expectedPushPlan, executeErr = SetupNoBuildCacheForPushPlan(pushPlan, overrides)
+	})
+
+	When("flag override specifies no-build-cache", func() {
+		BeforeEach(func() {
+			overrides.NoBuildCache = true
+		})
+
+		It("sets the no-build-cache flag on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.NoBuildCache).To(Equal(true))
+		})
+	})
+
+	When("flag overrides does not specify no-build-cache", func() {
+		It("leaves the no-build-cache flag as false on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.NoBuildCache).To(Equal(false))
+		})
+	})
+})
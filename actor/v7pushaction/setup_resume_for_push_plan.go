@@ -0,0 +1,7 @@
+package v7pushaction
+
+func SetupResumeForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
+	pushPlan.Resume = overrides.Resume
+
+	return pushPlan, nil
+}
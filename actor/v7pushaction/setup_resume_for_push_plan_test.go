@@ -0,0 +1,45 @@
+package v7pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetupResumeForPushPlan", func() {
+	var (
+		pushPlan  PushPlan
+		overrides FlagOverrides
+
+		expectedPushPlan PushPlan
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		pushPlan = PushPlan{}
+		overrides = FlagOverrides{}
+	})
+
+	JustBeforeEach(func() {
+		expectedPushPlan, executeErr = SetupResumeForPushPlan(pushPlan, overrides)
+	})
+
+	When("flag override specifies resume", func() {
+		BeforeEach(func() {
+			overrides.Resume = true
+		})
+
+		It("sets the resume flag on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.Resume).To(Equal(true))
+		})
+	})
+
+	When("flag overrides does not specify resume", func() {
+		It("leaves the resume flag as false on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.Resume).To(Equal(false))
+		})
+	})
+})
@@ -11,8 +11,8 @@ import (
 
 type SharedActor interface {
 	GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error)
-	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
+	GatherDirectoryResourcesWithOptions(sourceDir string, options sharedaction.GatherOptions) ([]sharedaction.Resource, []sharedaction.ModeChange, error)
 	ReadArchive(archivePath string) (io.ReadCloser, int64, error)
-	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
-	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
+	StreamArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource, options sharedaction.ZipOptions) io.ReadCloser
+	StreamDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource, options sharedaction.ZipOptions) io.ReadCloser
 }
@@ -1,9 +1,20 @@
 package v7pushaction
 
 func (actor Actor) StagePackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	var allWarnings Warnings
+
+	if pushPlan.NoBuildCache {
+		eventStream <- &PushEvent{Plan: pushPlan, Event: ClearingBuildCache}
+
+		warnings, err := actor.V7Actor.ClearApplicationBuildCache(pushPlan.Application.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return pushPlan, allWarnings, err
+		}
+	}
+
 	eventStream <- &PushEvent{Plan: pushPlan, Event: StartingStaging}
 
-	var allWarnings Warnings
 	build, warnings, err := actor.V7Actor.StageApplicationPackage(pushPlan.PackageGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -73,6 +73,42 @@ var _ = Describe("StagePackageForApplication", func() {
 		})
 	})
 
+	Describe("clearing the build cache", func() {
+		When("the plan does not disable the build cache", func() {
+			It("does not clear the cache", func() {
+				Expect(fakeV7Actor.ClearApplicationBuildCacheCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the plan disables the build cache", func() {
+			BeforeEach(func() {
+				paramPlan.NoBuildCache = true
+				fakeV7Actor.ClearApplicationBuildCacheReturns(v7action.Warnings{"some-clear-cache-warning"}, nil)
+			})
+
+			It("clears the cache of the app before staging", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV7Actor.ClearApplicationBuildCacheCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.ClearApplicationBuildCacheArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(events).To(ConsistOf(ClearingBuildCache, StartingStaging, PollingBuild, StagingComplete))
+				Expect(warnings).To(ContainElement("some-clear-cache-warning"))
+			})
+
+			When("clearing the cache fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.ClearApplicationBuildCacheReturns(v7action.Warnings{"some-clear-cache-warning"}, errors.New("clear-cache-error"))
+				})
+
+				It("returns the error and warnings without staging", func() {
+					Expect(executeErr).To(MatchError("clear-cache-error"))
+					Expect(warnings).To(ConsistOf("some-clear-cache-warning"))
+					Expect(events).To(ConsistOf(ClearingBuildCache))
+					Expect(fakeV7Actor.StageApplicationPackageCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
 	Describe("polling build", func() {
 		When("the the polling is successful", func() {
 			BeforeEach(func() {
@@ -11,10 +11,11 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7Actor
 
 type V7Actor interface {
+	ClearApplicationBuildCache(appGUID string) (v7action.Warnings, error)
 	CreateApplicationDroplet(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (resources.Package, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
+	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
 	CreateDockerPackageByApplication(appGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
@@ -23,6 +24,7 @@ type V7Actor interface {
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
+	GetNewestReadyPackageForApplication(app resources.Application) (resources.Package, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
@@ -23,18 +23,21 @@ type FakeSharedActor struct {
 		result1 []sharedaction.Resource
 		result2 error
 	}
-	GatherDirectoryResourcesStub        func(string) ([]sharedaction.Resource, error)
-	gatherDirectoryResourcesMutex       sync.RWMutex
-	gatherDirectoryResourcesArgsForCall []struct {
+	GatherDirectoryResourcesWithOptionsStub        func(string, sharedaction.GatherOptions) ([]sharedaction.Resource, []sharedaction.ModeChange, error)
+	gatherDirectoryResourcesWithOptionsMutex       sync.RWMutex
+	gatherDirectoryResourcesWithOptionsArgsForCall []struct {
 		arg1 string
+		arg2 sharedaction.GatherOptions
 	}
-	gatherDirectoryResourcesReturns struct {
+	gatherDirectoryResourcesWithOptionsReturns struct {
 		result1 []sharedaction.Resource
-		result2 error
+		result2 []sharedaction.ModeChange
+		result3 error
 	}
-	gatherDirectoryResourcesReturnsOnCall map[int]struct {
+	gatherDirectoryResourcesWithOptionsReturnsOnCall map[int]struct {
 		result1 []sharedaction.Resource
-		result2 error
+		result2 []sharedaction.ModeChange
+		result3 error
 	}
 	ReadArchiveStub        func(string) (io.ReadCloser, int64, error)
 	readArchiveMutex       sync.RWMutex
@@ -51,33 +54,31 @@ type FakeSharedActor struct {
 		result2 int64
 		result3 error
 	}
-	ZipArchiveResourcesStub        func(string, []sharedaction.Resource) (string, error)
-	zipArchiveResourcesMutex       sync.RWMutex
-	zipArchiveResourcesArgsForCall []struct {
+	StreamArchiveResourcesStub        func(string, []sharedaction.Resource, sharedaction.ZipOptions) io.ReadCloser
+	streamArchiveResourcesMutex       sync.RWMutex
+	streamArchiveResourcesArgsForCall []struct {
 		arg1 string
 		arg2 []sharedaction.Resource
+		arg3 sharedaction.ZipOptions
 	}
-	zipArchiveResourcesReturns struct {
-		result1 string
-		result2 error
+	streamArchiveResourcesReturns struct {
+		result1 io.ReadCloser
 	}
-	zipArchiveResourcesReturnsOnCall map[int]struct {
-		result1 string
-		result2 error
+	streamArchiveResourcesReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
 	}
-	ZipDirectoryResourcesStub        func(string, []sharedaction.Resource) (string, error)
-	zipDirectoryResourcesMutex       sync.RWMutex
-	zipDirectoryResourcesArgsForCall []struct {
+	StreamDirectoryResourcesStub        func(string, []sharedaction.Resource, sharedaction.ZipOptions) io.ReadCloser
+	streamDirectoryResourcesMutex       sync.RWMutex
+	streamDirectoryResourcesArgsForCall []struct {
 		arg1 string
 		arg2 []sharedaction.Resource
+		arg3 sharedaction.ZipOptions
 	}
-	zipDirectoryResourcesReturns struct {
-		result1 string
-		result2 error
+	streamDirectoryResourcesReturns struct {
+		result1 io.ReadCloser
 	}
-	zipDirectoryResourcesReturnsOnCall map[int]struct {
-		result1 string
-		result2 error
+	streamDirectoryResourcesReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -146,67 +147,71 @@ func (fake *FakeSharedActor) GatherArchiveResourcesReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResources(arg1 string) ([]sharedaction.Resource, error) {
-	fake.gatherDirectoryResourcesMutex.Lock()
-	ret, specificReturn := fake.gatherDirectoryResourcesReturnsOnCall[len(fake.gatherDirectoryResourcesArgsForCall)]
-	fake.gatherDirectoryResourcesArgsForCall = append(fake.gatherDirectoryResourcesArgsForCall, struct {
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptions(arg1 string, arg2 sharedaction.GatherOptions) ([]sharedaction.Resource, []sharedaction.ModeChange, error) {
+	fake.gatherDirectoryResourcesWithOptionsMutex.Lock()
+	ret, specificReturn := fake.gatherDirectoryResourcesWithOptionsReturnsOnCall[len(fake.gatherDirectoryResourcesWithOptionsArgsForCall)]
+	fake.gatherDirectoryResourcesWithOptionsArgsForCall = append(fake.gatherDirectoryResourcesWithOptionsArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	fake.recordInvocation("GatherDirectoryResources", []interface{}{arg1})
-	fake.gatherDirectoryResourcesMutex.Unlock()
-	if fake.GatherDirectoryResourcesStub != nil {
-		return fake.GatherDirectoryResourcesStub(arg1)
+		arg2 sharedaction.GatherOptions
+	}{arg1, arg2})
+	fake.recordInvocation("GatherDirectoryResourcesWithOptions", []interface{}{arg1, arg2})
+	fake.gatherDirectoryResourcesWithOptionsMutex.Unlock()
+	if fake.GatherDirectoryResourcesWithOptionsStub != nil {
+		return fake.GatherDirectoryResourcesWithOptionsStub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.gatherDirectoryResourcesReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.gatherDirectoryResourcesWithOptionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResourcesCallCount() int {
-	fake.gatherDirectoryResourcesMutex.RLock()
-	defer fake.gatherDirectoryResourcesMutex.RUnlock()
-	return len(fake.gatherDirectoryResourcesArgsForCall)
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptionsCallCount() int {
+	fake.gatherDirectoryResourcesWithOptionsMutex.RLock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.RUnlock()
+	return len(fake.gatherDirectoryResourcesWithOptionsArgsForCall)
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResourcesCalls(stub func(string) ([]sharedaction.Resource, error)) {
-	fake.gatherDirectoryResourcesMutex.Lock()
-	defer fake.gatherDirectoryResourcesMutex.Unlock()
-	fake.GatherDirectoryResourcesStub = stub
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptionsCalls(stub func(string, sharedaction.GatherOptions) ([]sharedaction.Resource, []sharedaction.ModeChange, error)) {
+	fake.gatherDirectoryResourcesWithOptionsMutex.Lock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.Unlock()
+	fake.GatherDirectoryResourcesWithOptionsStub = stub
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResourcesArgsForCall(i int) string {
-	fake.gatherDirectoryResourcesMutex.RLock()
-	defer fake.gatherDirectoryResourcesMutex.RUnlock()
-	argsForCall := fake.gatherDirectoryResourcesArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptionsArgsForCall(i int) (string, sharedaction.GatherOptions) {
+	fake.gatherDirectoryResourcesWithOptionsMutex.RLock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.RUnlock()
+	argsForCall := fake.gatherDirectoryResourcesWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResourcesReturns(result1 []sharedaction.Resource, result2 error) {
-	fake.gatherDirectoryResourcesMutex.Lock()
-	defer fake.gatherDirectoryResourcesMutex.Unlock()
-	fake.GatherDirectoryResourcesStub = nil
-	fake.gatherDirectoryResourcesReturns = struct {
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptionsReturns(result1 []sharedaction.Resource, result2 []sharedaction.ModeChange, result3 error) {
+	fake.gatherDirectoryResourcesWithOptionsMutex.Lock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.Unlock()
+	fake.GatherDirectoryResourcesWithOptionsStub = nil
+	fake.gatherDirectoryResourcesWithOptionsReturns = struct {
 		result1 []sharedaction.Resource
-		result2 error
-	}{result1, result2}
+		result2 []sharedaction.ModeChange
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeSharedActor) GatherDirectoryResourcesReturnsOnCall(i int, result1 []sharedaction.Resource, result2 error) {
-	fake.gatherDirectoryResourcesMutex.Lock()
-	defer fake.gatherDirectoryResourcesMutex.Unlock()
-	fake.GatherDirectoryResourcesStub = nil
-	if fake.gatherDirectoryResourcesReturnsOnCall == nil {
-		fake.gatherDirectoryResourcesReturnsOnCall = make(map[int]struct {
+func (fake *FakeSharedActor) GatherDirectoryResourcesWithOptionsReturnsOnCall(i int, result1 []sharedaction.Resource, result2 []sharedaction.ModeChange, result3 error) {
+	fake.gatherDirectoryResourcesWithOptionsMutex.Lock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.Unlock()
+	fake.GatherDirectoryResourcesWithOptionsStub = nil
+	if fake.gatherDirectoryResourcesWithOptionsReturnsOnCall == nil {
+		fake.gatherDirectoryResourcesWithOptionsReturnsOnCall = make(map[int]struct {
 			result1 []sharedaction.Resource
-			result2 error
+			result2 []sharedaction.ModeChange
+			result3 error
 		})
 	}
-	fake.gatherDirectoryResourcesReturnsOnCall[i] = struct {
+	fake.gatherDirectoryResourcesWithOptionsReturnsOnCall[i] = struct {
 		result1 []sharedaction.Resource
-		result2 error
-	}{result1, result2}
+		result2 []sharedaction.ModeChange
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeSharedActor) ReadArchive(arg1 string) (io.ReadCloser, int64, error) {
@@ -275,142 +280,138 @@ func (fake *FakeSharedActor) ReadArchiveReturnsOnCall(i int, result1 io.ReadClos
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSharedActor) ZipArchiveResources(arg1 string, arg2 []sharedaction.Resource) (string, error) {
+func (fake *FakeSharedActor) StreamArchiveResources(arg1 string, arg2 []sharedaction.Resource, arg3 sharedaction.ZipOptions) io.ReadCloser {
 	var arg2Copy []sharedaction.Resource
 	if arg2 != nil {
 		arg2Copy = make([]sharedaction.Resource, len(arg2))
 		copy(arg2Copy, arg2)
 	}
-	fake.zipArchiveResourcesMutex.Lock()
-	ret, specificReturn := fake.zipArchiveResourcesReturnsOnCall[len(fake.zipArchiveResourcesArgsForCall)]
-	fake.zipArchiveResourcesArgsForCall = append(fake.zipArchiveResourcesArgsForCall, struct {
+	fake.streamArchiveResourcesMutex.Lock()
+	ret, specificReturn := fake.streamArchiveResourcesReturnsOnCall[len(fake.streamArchiveResourcesArgsForCall)]
+	fake.streamArchiveResourcesArgsForCall = append(fake.streamArchiveResourcesArgsForCall, struct {
 		arg1 string
 		arg2 []sharedaction.Resource
-	}{arg1, arg2Copy})
-	fake.recordInvocation("ZipArchiveResources", []interface{}{arg1, arg2Copy})
-	fake.zipArchiveResourcesMutex.Unlock()
-	if fake.ZipArchiveResourcesStub != nil {
-		return fake.ZipArchiveResourcesStub(arg1, arg2)
+		arg3 sharedaction.ZipOptions
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("StreamArchiveResources", []interface{}{arg1, arg2Copy, arg3})
+	fake.streamArchiveResourcesMutex.Unlock()
+	if fake.StreamArchiveResourcesStub != nil {
+		return fake.StreamArchiveResourcesStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	fakeReturns := fake.zipArchiveResourcesReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.streamArchiveResourcesReturns
+	return fakeReturns.result1
 }
 
-func (fake *FakeSharedActor) ZipArchiveResourcesCallCount() int {
-	fake.zipArchiveResourcesMutex.RLock()
-	defer fake.zipArchiveResourcesMutex.RUnlock()
-	return len(fake.zipArchiveResourcesArgsForCall)
+func (fake *FakeSharedActor) StreamArchiveResourcesCallCount() int {
+	fake.streamArchiveResourcesMutex.RLock()
+	defer fake.streamArchiveResourcesMutex.RUnlock()
+	return len(fake.streamArchiveResourcesArgsForCall)
 }
 
-func (fake *FakeSharedActor) ZipArchiveResourcesCalls(stub func(string, []sharedaction.Resource) (string, error)) {
-	fake.zipArchiveResourcesMutex.Lock()
-	defer fake.zipArchiveResourcesMutex.Unlock()
-	fake.ZipArchiveResourcesStub = stub
+func (fake *FakeSharedActor) StreamArchiveResourcesCalls(stub func(string, []sharedaction.Resource, sharedaction.ZipOptions) io.ReadCloser) {
+	fake.streamArchiveResourcesMutex.Lock()
+	defer fake.streamArchiveResourcesMutex.Unlock()
+	fake.StreamArchiveResourcesStub = stub
 }
 
-func (fake *FakeSharedActor) ZipArchiveResourcesArgsForCall(i int) (string, []sharedaction.Resource) {
-	fake.zipArchiveResourcesMutex.RLock()
-	defer fake.zipArchiveResourcesMutex.RUnlock()
-	argsForCall := fake.zipArchiveResourcesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeSharedActor) StreamArchiveResourcesArgsForCall(i int) (string, []sharedaction.Resource, sharedaction.ZipOptions) {
+	fake.streamArchiveResourcesMutex.RLock()
+	defer fake.streamArchiveResourcesMutex.RUnlock()
+	argsForCall := fake.streamArchiveResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeSharedActor) ZipArchiveResourcesReturns(result1 string, result2 error) {
-	fake.zipArchiveResourcesMutex.Lock()
-	defer fake.zipArchiveResourcesMutex.Unlock()
-	fake.ZipArchiveResourcesStub = nil
-	fake.zipArchiveResourcesReturns = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
+func (fake *FakeSharedActor) StreamArchiveResourcesReturns(result1 io.ReadCloser) {
+	fake.streamArchiveResourcesMutex.Lock()
+	defer fake.streamArchiveResourcesMutex.Unlock()
+	fake.StreamArchiveResourcesStub = nil
+	fake.streamArchiveResourcesReturns = struct {
+		result1 io.ReadCloser
+	}{result1}
 }
 
-func (fake *FakeSharedActor) ZipArchiveResourcesReturnsOnCall(i int, result1 string, result2 error) {
-	fake.zipArchiveResourcesMutex.Lock()
-	defer fake.zipArchiveResourcesMutex.Unlock()
-	fake.ZipArchiveResourcesStub = nil
-	if fake.zipArchiveResourcesReturnsOnCall == nil {
-		fake.zipArchiveResourcesReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 error
+func (fake *FakeSharedActor) StreamArchiveResourcesReturnsOnCall(i int, result1 io.ReadCloser) {
+	fake.streamArchiveResourcesMutex.Lock()
+	defer fake.streamArchiveResourcesMutex.Unlock()
+	fake.StreamArchiveResourcesStub = nil
+	if fake.streamArchiveResourcesReturnsOnCall == nil {
+		fake.streamArchiveResourcesReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
 		})
 	}
-	fake.zipArchiveResourcesReturnsOnCall[i] = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
+	fake.streamArchiveResourcesReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+	}{result1}
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResources(arg1 string, arg2 []sharedaction.Resource) (string, error) {
+func (fake *FakeSharedActor) StreamDirectoryResources(arg1 string, arg2 []sharedaction.Resource, arg3 sharedaction.ZipOptions) io.ReadCloser {
 	var arg2Copy []sharedaction.Resource
 	if arg2 != nil {
 		arg2Copy = make([]sharedaction.Resource, len(arg2))
 		copy(arg2Copy, arg2)
 	}
-	fake.zipDirectoryResourcesMutex.Lock()
-	ret, specificReturn := fake.zipDirectoryResourcesReturnsOnCall[len(fake.zipDirectoryResourcesArgsForCall)]
-	fake.zipDirectoryResourcesArgsForCall = append(fake.zipDirectoryResourcesArgsForCall, struct {
+	fake.streamDirectoryResourcesMutex.Lock()
+	ret, specificReturn := fake.streamDirectoryResourcesReturnsOnCall[len(fake.streamDirectoryResourcesArgsForCall)]
+	fake.streamDirectoryResourcesArgsForCall = append(fake.streamDirectoryResourcesArgsForCall, struct {
 		arg1 string
 		arg2 []sharedaction.Resource
-	}{arg1, arg2Copy})
-	fake.recordInvocation("ZipDirectoryResources", []interface{}{arg1, arg2Copy})
-	fake.zipDirectoryResourcesMutex.Unlock()
-	if fake.ZipDirectoryResourcesStub != nil {
-		return fake.ZipDirectoryResourcesStub(arg1, arg2)
+		arg3 sharedaction.ZipOptions
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("StreamDirectoryResources", []interface{}{arg1, arg2Copy, arg3})
+	fake.streamDirectoryResourcesMutex.Unlock()
+	if fake.StreamDirectoryResourcesStub != nil {
+		return fake.StreamDirectoryResourcesStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	fakeReturns := fake.zipDirectoryResourcesReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.streamDirectoryResourcesReturns
+	return fakeReturns.result1
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResourcesCallCount() int {
-	fake.zipDirectoryResourcesMutex.RLock()
-	defer fake.zipDirectoryResourcesMutex.RUnlock()
-	return len(fake.zipDirectoryResourcesArgsForCall)
+func (fake *FakeSharedActor) StreamDirectoryResourcesCallCount() int {
+	fake.streamDirectoryResourcesMutex.RLock()
+	defer fake.streamDirectoryResourcesMutex.RUnlock()
+	return len(fake.streamDirectoryResourcesArgsForCall)
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResourcesCalls(stub func(string, []sharedaction.Resource) (string, error)) {
-	fake.zipDirectoryResourcesMutex.Lock()
-	defer fake.zipDirectoryResourcesMutex.Unlock()
-	fake.ZipDirectoryResourcesStub = stub
+func (fake *FakeSharedActor) StreamDirectoryResourcesCalls(stub func(string, []sharedaction.Resource, sharedaction.ZipOptions) io.ReadCloser) {
+	fake.streamDirectoryResourcesMutex.Lock()
+	defer fake.streamDirectoryResourcesMutex.Unlock()
+	fake.StreamDirectoryResourcesStub = stub
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResourcesArgsForCall(i int) (string, []sharedaction.Resource) {
-	fake.zipDirectoryResourcesMutex.RLock()
-	defer fake.zipDirectoryResourcesMutex.RUnlock()
-	argsForCall := fake.zipDirectoryResourcesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeSharedActor) StreamDirectoryResourcesArgsForCall(i int) (string, []sharedaction.Resource, sharedaction.ZipOptions) {
+	fake.streamDirectoryResourcesMutex.RLock()
+	defer fake.streamDirectoryResourcesMutex.RUnlock()
+	argsForCall := fake.streamDirectoryResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResourcesReturns(result1 string, result2 error) {
-	fake.zipDirectoryResourcesMutex.Lock()
-	defer fake.zipDirectoryResourcesMutex.Unlock()
-	fake.ZipDirectoryResourcesStub = nil
-	fake.zipDirectoryResourcesReturns = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
+func (fake *FakeSharedActor) StreamDirectoryResourcesReturns(result1 io.ReadCloser) {
+	fake.streamDirectoryResourcesMutex.Lock()
+	defer fake.streamDirectoryResourcesMutex.Unlock()
+	fake.StreamDirectoryResourcesStub = nil
+	fake.streamDirectoryResourcesReturns = struct {
+		result1 io.ReadCloser
+	}{result1}
 }
 
-func (fake *FakeSharedActor) ZipDirectoryResourcesReturnsOnCall(i int, result1 string, result2 error) {
-	fake.zipDirectoryResourcesMutex.Lock()
-	defer fake.zipDirectoryResourcesMutex.Unlock()
-	fake.ZipDirectoryResourcesStub = nil
-	if fake.zipDirectoryResourcesReturnsOnCall == nil {
-		fake.zipDirectoryResourcesReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 error
+func (fake *FakeSharedActor) StreamDirectoryResourcesReturnsOnCall(i int, result1 io.ReadCloser) {
+	fake.streamDirectoryResourcesMutex.Lock()
+	defer fake.streamDirectoryResourcesMutex.Unlock()
+	fake.StreamDirectoryResourcesStub = nil
+	if fake.streamDirectoryResourcesReturnsOnCall == nil {
+		fake.streamDirectoryResourcesReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
 		})
 	}
-	fake.zipDirectoryResourcesReturnsOnCall[i] = struct {
-		result1 string
-		result2 error
-	}{result1, result2}
+	fake.streamDirectoryResourcesReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+	}{result1}
 }
 
 func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
@@ -418,14 +419,14 @@ func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.gatherArchiveResourcesMutex.RLock()
 	defer fake.gatherArchiveResourcesMutex.RUnlock()
-	fake.gatherDirectoryResourcesMutex.RLock()
-	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	fake.gatherDirectoryResourcesWithOptionsMutex.RLock()
+	defer fake.gatherDirectoryResourcesWithOptionsMutex.RUnlock()
 	fake.readArchiveMutex.RLock()
 	defer fake.readArchiveMutex.RUnlock()
-	fake.zipArchiveResourcesMutex.RLock()
-	defer fake.zipArchiveResourcesMutex.RUnlock()
-	fake.zipDirectoryResourcesMutex.RLock()
-	defer fake.zipDirectoryResourcesMutex.RUnlock()
+	fake.streamArchiveResourcesMutex.RLock()
+	defer fake.streamArchiveResourcesMutex.RUnlock()
+	fake.streamDirectoryResourcesMutex.RLock()
+	defer fake.streamDirectoryResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
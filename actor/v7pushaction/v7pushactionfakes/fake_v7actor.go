@@ -12,6 +12,19 @@ import (
 )
 
 type FakeV7Actor struct {
+	ClearApplicationBuildCacheStub        func(string) (v7action.Warnings, error)
+	clearApplicationBuildCacheMutex       sync.RWMutex
+	clearApplicationBuildCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearApplicationBuildCacheReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	clearApplicationBuildCacheReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CreateApplicationDropletStub        func(string) (resources.Droplet, v7action.Warnings, error)
 	createApplicationDropletMutex       sync.RWMutex
 	createApplicationDropletArgsForCall []struct {
@@ -58,18 +71,17 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	CreateDeploymentByApplicationAndDropletStub        func(string, string) (string, v7action.Warnings, error)
-	createDeploymentByApplicationAndDropletMutex       sync.RWMutex
-	createDeploymentByApplicationAndDropletArgsForCall []struct {
-		arg1 string
-		arg2 string
+	CreateDeploymentStub        func(resources.Deployment) (string, v7action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		arg1 resources.Deployment
 	}
-	createDeploymentByApplicationAndDropletReturns struct {
+	createDeploymentReturns struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}
-	createDeploymentByApplicationAndDropletReturnsOnCall map[int]struct {
+	createDeploymentReturnsOnCall map[int]struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -202,6 +214,21 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetNewestReadyPackageForApplicationStub        func(resources.Application) (resources.Package, v7action.Warnings, error)
+	getNewestReadyPackageForApplicationMutex       sync.RWMutex
+	getNewestReadyPackageForApplicationArgsForCall []struct {
+		arg1 resources.Application
+	}
+	getNewestReadyPackageForApplicationReturns struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
+	getNewestReadyPackageForApplicationReturnsOnCall map[int]struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -506,6 +533,69 @@ type FakeV7Actor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV7Actor) ClearApplicationBuildCache(arg1 string) (v7action.Warnings, error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	ret, specificReturn := fake.clearApplicationBuildCacheReturnsOnCall[len(fake.clearApplicationBuildCacheArgsForCall)]
+	fake.clearApplicationBuildCacheArgsForCall = append(fake.clearApplicationBuildCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearApplicationBuildCache", []interface{}{arg1})
+	fake.clearApplicationBuildCacheMutex.Unlock()
+	if fake.ClearApplicationBuildCacheStub != nil {
+		return fake.ClearApplicationBuildCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearApplicationBuildCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheCallCount() int {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	return len(fake.clearApplicationBuildCacheArgsForCall)
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = stub
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheArgsForCall(i int) string {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	argsForCall := fake.clearApplicationBuildCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheReturns(result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	fake.clearApplicationBuildCacheReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	if fake.clearApplicationBuildCacheReturnsOnCall == nil {
+		fake.clearApplicationBuildCacheReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.clearApplicationBuildCacheReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) CreateApplicationDroplet(arg1 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.createApplicationDropletMutex.Lock()
 	ret, specificReturn := fake.createApplicationDropletReturnsOnCall[len(fake.createApplicationDropletArgsForCall)]
@@ -705,67 +795,66 @@ func (fake *FakeV7Actor) CreateBitsPackageByApplicationReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDroplet(arg1 string, arg2 string) (string, v7action.Warnings, error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	ret, specificReturn := fake.createDeploymentByApplicationAndDropletReturnsOnCall[len(fake.createDeploymentByApplicationAndDropletArgsForCall)]
-	fake.createDeploymentByApplicationAndDropletArgsForCall = append(fake.createDeploymentByApplicationAndDropletArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateDeploymentByApplicationAndDroplet", []interface{}{arg1, arg2})
-	fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	if fake.CreateDeploymentByApplicationAndDropletStub != nil {
-		return fake.CreateDeploymentByApplicationAndDropletStub(arg1, arg2)
+func (fake *FakeV7Actor) CreateDeployment(arg1 resources.Deployment) (string, v7action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		arg1 resources.Deployment
+	}{arg1})
+	fake.recordInvocation("CreateDeployment", []interface{}{arg1})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.createDeploymentByApplicationAndDropletReturns
+	fakeReturns := fake.createDeploymentReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDropletCallCount() int {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	return len(fake.createDeploymentByApplicationAndDropletArgsForCall)
+func (fake *FakeV7Actor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDropletCalls(stub func(string, string) (string, v7action.Warnings, error)) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = stub
+func (fake *FakeV7Actor) CreateDeploymentCalls(stub func(resources.Deployment) (string, v7action.Warnings, error)) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = stub
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDropletArgsForCall(i int) (string, string) {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	argsForCall := fake.createDeploymentByApplicationAndDropletArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeV7Actor) CreateDeploymentArgsForCall(i int) resources.Deployment {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	argsForCall := fake.createDeploymentArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDropletReturns(result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	fake.createDeploymentByApplicationAndDropletReturns = struct {
+func (fake *FakeV7Actor) CreateDeploymentReturns(result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV7Actor) CreateDeploymentByApplicationAndDropletReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	if fake.createDeploymentByApplicationAndDropletReturnsOnCall == nil {
-		fake.createDeploymentByApplicationAndDropletReturnsOnCall = make(map[int]struct {
+func (fake *FakeV7Actor) CreateDeploymentReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
 			result1 string
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.createDeploymentByApplicationAndDropletReturnsOnCall[i] = struct {
+	fake.createDeploymentReturnsOnCall[i] = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -1313,6 +1402,72 @@ func (fake *FakeV7Actor) GetDomainReturnsOnCall(i int, result1 resources.Domain,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplication(arg1 resources.Application) (resources.Package, v7action.Warnings, error) {
+	fake.getNewestReadyPackageForApplicationMutex.Lock()
+	ret, specificReturn := fake.getNewestReadyPackageForApplicationReturnsOnCall[len(fake.getNewestReadyPackageForApplicationArgsForCall)]
+	fake.getNewestReadyPackageForApplicationArgsForCall = append(fake.getNewestReadyPackageForApplicationArgsForCall, struct {
+		arg1 resources.Application
+	}{arg1})
+	fake.recordInvocation("GetNewestReadyPackageForApplication", []interface{}{arg1})
+	fake.getNewestReadyPackageForApplicationMutex.Unlock()
+	if fake.GetNewestReadyPackageForApplicationStub != nil {
+		return fake.GetNewestReadyPackageForApplicationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getNewestReadyPackageForApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplicationCallCount() int {
+	fake.getNewestReadyPackageForApplicationMutex.RLock()
+	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
+	return len(fake.getNewestReadyPackageForApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplicationCalls(stub func(resources.Application) (resources.Package, v7action.Warnings, error)) {
+	fake.getNewestReadyPackageForApplicationMutex.Lock()
+	defer fake.getNewestReadyPackageForApplicationMutex.Unlock()
+	fake.GetNewestReadyPackageForApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplicationArgsForCall(i int) resources.Application {
+	fake.getNewestReadyPackageForApplicationMutex.RLock()
+	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
+	argsForCall := fake.getNewestReadyPackageForApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplicationReturns(result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getNewestReadyPackageForApplicationMutex.Lock()
+	defer fake.getNewestReadyPackageForApplicationMutex.Unlock()
+	fake.GetNewestReadyPackageForApplicationStub = nil
+	fake.getNewestReadyPackageForApplicationReturns = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetNewestReadyPackageForApplicationReturnsOnCall(i int, result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getNewestReadyPackageForApplicationMutex.Lock()
+	defer fake.getNewestReadyPackageForApplicationMutex.Unlock()
+	fake.GetNewestReadyPackageForApplicationStub = nil
+	if fake.getNewestReadyPackageForApplicationReturnsOnCall == nil {
+		fake.getNewestReadyPackageForApplicationReturnsOnCall = make(map[int]struct {
+			result1 resources.Package
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getNewestReadyPackageForApplicationReturnsOnCall[i] = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -2643,14 +2798,16 @@ func (fake *FakeV7Actor) UploadDropletReturnsOnCall(i int, result1 v7action.Warn
 func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
 	fake.createApplicationDropletMutex.RLock()
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.createDockerPackageByApplicationMutex.RLock()
 	defer fake.createDockerPackageByApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
@@ -2667,6 +2824,8 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.getDefaultDomainMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getNewestReadyPackageForApplicationMutex.RLock()
+	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -0,0 +1,32 @@
+package ccv3
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetAppUsageEventsPage returns the given page of app usage events along with
+// the total number of pages, so that the remaining pages can be requested at
+// the same time.
+func (client *Client) GetAppUsageEventsPage(page int, query ...Query) ([]resources.AppUsageEvent, int, Warnings, error) {
+	var responseBody struct {
+		Pagination struct {
+			TotalPages int `json:"total_pages"`
+		} `json:"pagination"`
+		Resources []resources.AppUsageEvent `json:"resources"`
+	}
+
+	pageQuery := make([]Query, 0, len(query)+1)
+	pageQuery = append(pageQuery, query...)
+	pageQuery = append(pageQuery, Query{Key: Page, Values: []string{strconv.Itoa(page)}})
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetAppUsageEventsRequest,
+		Query:        pageQuery,
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody.Resources, responseBody.Pagination.TotalPages, warnings, err
+}
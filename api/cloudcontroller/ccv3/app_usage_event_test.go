@@ -0,0 +1,118 @@
+package ccv3_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("AppUsageEvent", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetAppUsageEventsPage", func() {
+		var (
+			events     []resources.AppUsageEvent
+			totalPages int
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, totalPages, warnings, executeErr = client.GetAppUsageEventsPage(2,
+				Query{Key: CreatedAtsBeforeFilter, Values: []string{"2024-02-01T00:00:00Z"}},
+				Query{Key: OrderBy, Values: []string{CreatedAtAscendingOrder}},
+			)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+  "pagination": {
+    "total_results": 3,
+    "total_pages": 3,
+    "next": {"href": "https://api.example.org/v3/app_usage_events?page=3"}
+  },
+  "resources": [
+    {
+      "guid": "event-guid",
+      "created_at": "2024-01-10T12:00:00Z",
+      "state": {"current": "STARTED", "previous": "STOPPED"},
+      "app": {"guid": "app-guid", "name": "my-app"},
+      "process": {"guid": "process-guid", "type": "web"},
+      "space": {"guid": "space-guid", "name": "my-space"},
+      "organization": {"guid": "org-guid"},
+      "task": {"guid": null, "name": null},
+      "instance_count": {"current": 2, "previous": 1},
+      "memory_in_mb_per_instance": {"current": 512, "previous": 256}
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "created_ats[lt]=2024-02-01T00:00:00Z&order_by=created_at&page=2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the events of the page and the total number of pages", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+				Expect(totalPages).To(Equal(3))
+				Expect(events).To(Equal([]resources.AppUsageEvent{{
+					GUID:                          "event-guid",
+					CreatedAt:                     time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+					State:                         constant.AppUsageEventStarted,
+					PreviousState:                 constant.AppUsageEventStopped,
+					AppGUID:                       "app-guid",
+					AppName:                       "my-app",
+					ProcessGUID:                   "process-guid",
+					ProcessType:                   "web",
+					SpaceGUID:                     "space-guid",
+					SpaceName:                     "my-space",
+					OrganizationGUID:              "org-guid",
+					InstanceCount:                 2,
+					PreviousInstanceCount:         1,
+					MemoryInMBPerInstance:         512,
+					PreviousMemoryInMBPerInstance: 256,
+				}}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10003,
+      "detail": "You are not authorized to perform the requested action",
+      "title": "CF-NotAuthorized"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+})
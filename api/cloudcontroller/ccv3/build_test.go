@@ -113,6 +113,13 @@ var _ = Describe("Build", func() {
 					"error": "some error",
 					"droplet": {
 						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
 					}
 				}`
 				server.AppendHandlers(
@@ -133,6 +140,7 @@ var _ = Describe("Build", func() {
 					State:       constant.BuildFailed,
 					Error:       "some error",
 					DropletGUID: "some-droplet-guid",
+					AppGUID:     "some-app-guid",
 				}
 				Expect(build).To(Equal(expectedBuild))
 				Expect(warnings).To(ConsistOf("this is a warning"))
@@ -109,6 +109,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationWorkers is the number of pages of a list that are fetched at
+	// the same time once the first page is known. The pages are fetched one
+	// after the other when it is 0 or 1.
+	PaginationWorkers int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
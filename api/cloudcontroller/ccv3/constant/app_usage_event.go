@@ -0,0 +1,17 @@
+package constant
+
+// AppUsageEventState is the state an app usage event records.
+type AppUsageEventState string
+
+const (
+	// AppUsageEventStarted is when a process is started or scaled.
+	AppUsageEventStarted AppUsageEventState = "STARTED"
+	// AppUsageEventStopped is when a process is stopped.
+	AppUsageEventStopped AppUsageEventState = "STOPPED"
+	// AppUsageEventBuildpackSet is when the buildpack of an app is set.
+	AppUsageEventBuildpackSet AppUsageEventState = "BUILDPACK_SET"
+	// AppUsageEventTaskStarted is when a task is started.
+	AppUsageEventTaskStarted AppUsageEventState = "TASK_STARTED"
+	// AppUsageEventTaskStopped is when a task stops.
+	AppUsageEventTaskStopped AppUsageEventState = "TASK_STOPPED"
+)
@@ -22,6 +22,9 @@ const (
 
 	// DeploymentFailed means the deployment is in state 'FAILED'
 	DeploymentFailed DeploymentState = "FAILED"
+
+	// DeploymentPaused means the deployment is in state 'PAUSED'
+	DeploymentPaused DeploymentState = "PAUSED"
 )
 
 // DeploymentStatusReason describes the status reasons a deployment can have
@@ -39,6 +42,10 @@ const (
 	// DeploymentStatusReasonSuperseded means the deployment's status.value is
 	// 'SUPERSEDED'
 	DeploymentStatusReasonSuperseded DeploymentStatusReason = "SUPERSEDED"
+
+	// DeploymentStatusReasonPaused means the deployment's status.reason is
+	// 'PAUSED'
+	DeploymentStatusReasonPaused DeploymentStatusReason = "PAUSED"
 )
 
 // DeploymentStatusValue describes the status values a deployment can have
@@ -9,4 +9,7 @@ const (
 
 	// Rolling means a new web process will be created for the app and instances will roll from the old one to the new one.
 	DeploymentStrategyRolling DeploymentStrategy = "rolling"
+
+	// Canary means instances of a new web process will be started in steps, pausing after each one.
+	DeploymentStrategyCanary DeploymentStrategy = "canary"
 )
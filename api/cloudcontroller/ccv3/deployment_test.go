@@ -165,22 +165,73 @@ var _ = Describe("Deployment", func() {
 		})
 	})
 
+	Describe("ContinueDeployment", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.ContinueDeployment("some-deployment-guid")
+		})
+
+		When("continuing the deployment succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusAccepted, "", http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("continues the deployment and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("the deployment cannot be continued", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusUnprocessableEntity, `{
+							"errors": [{"code": 10008, "detail": "Cannot continue a deployment in state DEPLOYED", "title": "CF-UnprocessableEntity"}]
+						}`, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Cannot continue a deployment in state DEPLOYED"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationDeployment", func() {
 		var (
 			deploymentGUID string
 			warnings       Warnings
 			executeErr     error
-			dropletGUID    string
+			deployment     resources.Deployment
 		)
 
+		BeforeEach(func() {
+			deployment = resources.Deployment{
+				DropletGUID:   "some-droplet-guid",
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+			}
+		})
+
 		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid", dropletGUID)
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment(deployment)
 		})
 
 		Context("when the application exists", func() {
 			var response string
 			BeforeEach(func() {
-				dropletGUID = "some-droplet-guid"
 				response = `{
   "guid": "some-deployment-guid",
   "created_at": "2018-04-25T22:42:10Z",
@@ -215,7 +266,7 @@ var _ = Describe("Deployment", func() {
 
 			Context("when no droplet guid is provided", func() {
 				BeforeEach(func() {
-					dropletGUID = ""
+					deployment.DropletGUID = ""
 					server.AppendHandlers(
 						CombineHandlers(
 							VerifyRequest(http.MethodPost, "/v3/deployments"),
@@ -230,51 +281,60 @@ var _ = Describe("Deployment", func() {
 					Expect(warnings).To(ConsistOf("warning"))
 				})
 			})
-		})
-	})
 
-	Describe("CreateApplicationDeploymentByRevision", func() {
-		var (
-			deploymentGUID string
-			warnings       Warnings
-			executeErr     error
-			revisionGUID   string
-		)
+			Context("when a revision guid is provided", func() {
+				BeforeEach(func() {
+					deployment.DropletGUID = ""
+					deployment.RevisionGUID = "some-revision-guid"
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v3/deployments"),
+							VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+						),
+					)
+				})
 
-		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentByRevision("some-app-guid", revisionGUID)
-		})
+				It("creates the deployment of the revision", func() {
+					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning"))
+				})
+			})
 
-		Context("when the application exists", func() {
-			var response string
-			BeforeEach(func() {
-				revisionGUID = "some-revision-guid"
-				response = `{
-  "guid": "some-deployment-guid",
-  "created_at": "2018-04-25T22:42:10Z",
-  "relationships": {
-    "app": {
-      "data": {
-        "guid": "some-app-guid"
-      }
-    }
-  }
-}`
+			Context("when a strategy and max in flight are provided", func() {
+				BeforeEach(func() {
+					deployment.Strategy = constant.DeploymentStrategyCanary
+					deployment.Options.MaxInFlight = 3
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v3/deployments"),
+							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "strategy":"canary", "options":{"max_in_flight":3}, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+						),
+					)
+				})
+
+				It("includes them in the JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning"))
+				})
 			})
 
-			Context("when creating the deployment succeeds", func() {
+			Context("when canary weights are provided", func() {
 				BeforeEach(func() {
+					deployment.Strategy = constant.DeploymentStrategyCanary
+					deployment.Options.CanaryWeights = []int{10, 50}
 					server.AppendHandlers(
 						CombineHandlers(
 							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "strategy":"canary", "options":{"canary":{"steps":[{"instance_weight":10},{"instance_weight":50}]}}, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
 							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
 						),
 					)
 				})
 
-				It("creates the deployment with no errors and returns all warnings", func() {
-					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				It("includes them as the steps of the canary", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("warning"))
 				})
@@ -289,9 +349,25 @@ var _ = Describe("Deployment", func() {
 				response = `{
 				    "guid": "some-deployment-guid",
 					"state": "DEPLOYED",
+					"strategy": "canary",
 					"status": {
 						"value": "FINALIZED",
-						"reason": "SUPERSEDED"
+						"reason": "SUPERSEDED",
+						"canary": {
+							"steps": {
+								"current": 2,
+								"total": 3
+							}
+						}
+					},
+					"options": {
+						"max_in_flight": 1,
+						"canary": {
+							"steps": [
+								{"instance_weight": 20},
+								{"instance_weight": 60}
+							]
+						}
 					},
 					"droplet": {
  					  "guid": "some-droplet-guid"
@@ -325,6 +401,10 @@ var _ = Describe("Deployment", func() {
 				Expect(deployment.State).To(Equal(constant.DeploymentDeployed))
 				Expect(deployment.StatusValue).To(Equal(constant.DeploymentStatusValueFinalized))
 				Expect(deployment.StatusReason).To(Equal(constant.DeploymentStatusReasonSuperseded))
+				Expect(deployment.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(deployment.CanaryStep).To(Equal(2))
+				Expect(deployment.CanarySteps).To(Equal(3))
+				Expect(deployment.Options.CanaryWeights).To(Equal([]int{20, 60}))
 			})
 		})
 
@@ -8,11 +8,13 @@ import (
 )
 
 type Event struct {
-	GUID      string
-	CreatedAt time.Time
-	Type      string
-	ActorName string
-	Data      map[string]interface{}
+	GUID       string
+	CreatedAt  time.Time
+	Type       string
+	ActorName  string
+	TargetGUID string
+	TargetName string
+	Data       map[string]interface{}
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
@@ -23,6 +25,10 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		Actor     struct {
 			Name string `json:"name"`
 		} `json:"actor"`
+		Target struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"target"`
 		Data map[string]interface{} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccEvent)
@@ -34,6 +40,8 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 	e.CreatedAt = ccEvent.CreatedAt
 	e.Type = ccEvent.Type
 	e.ActorName = ccEvent.Actor.Name
+	e.TargetGUID = ccEvent.Target.GUID
+	e.TargetName = ccEvent.Target.Name
 	e.Data = ccEvent.Data
 
 	return nil
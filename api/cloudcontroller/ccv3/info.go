@@ -2,9 +2,11 @@ package ccv3
 
 import (
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 	"code.cloudfoundry.org/cli/resources"
 )
 
@@ -95,6 +97,13 @@ func (info Info) Login() string {
 	return info.Links.Login.HREF
 }
 
+// CloudControllerBaseURL returns the URL that the Cloud Controller's routes
+// are relative to according to the V3 link, which includes any path prefix
+// the API is served under. It is empty when there is no V3 link.
+func (info Info) CloudControllerBaseURL() string {
+	return strings.TrimSuffix(strings.TrimSuffix(info.ccV3Link(), "/"), "/v3")
+}
+
 // ccv3Link returns the HREF of the CloudController v3 API.
 func (info Info) ccV3Link() string {
 	return info.Links.CCV3.HREF
@@ -120,6 +129,19 @@ func (links ResourceLinks) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GetResourceLinks returns the links to the resources of the Cloud
+// Controller V3 API, by resource name, from /v3.
+func (client *Client) GetResourceLinks() (ResourceLinks, Warnings, error) {
+	links := ResourceLinks{}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetResourceLinksRequest,
+		ResponseBody: &links,
+	})
+
+	return links, warnings, err
+}
+
 // GetInfo returns endpoint and API information from /v3.
 func (client *Client) GetInfo() (Info, Warnings, error) {
 	rootResponse, warnings, err := client.RootResponse()
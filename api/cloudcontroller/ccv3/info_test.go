@@ -92,6 +92,7 @@ var _ = Describe("Info", func() {
 			Expect(info.AppSSHHostKeyFingerprint()).To(Equal("some-fingerprint"))
 			Expect(info.AppSSHEndpoint()).To(Equal("ssh.bosh-lite.com:2222"))
 			Expect(info.OAuthClient()).To(Equal("some-client"))
+			Expect(info.CloudControllerBaseURL()).To(Equal(server.URL()))
 			Expect(info.CFOnK8s).To(BeFalse())
 		})
 
@@ -142,3 +143,73 @@ var _ = Describe("Info", func() {
 		})
 	})
 })
+
+var _ = Describe("GetResourceLinks", func() {
+	var (
+		client *Client
+
+		links      ResourceLinks
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	JustBeforeEach(func() {
+		links, warnings, executeErr = client.GetResourceLinks()
+	})
+
+	When("the request succeeds", func() {
+		BeforeEach(func() {
+			response := strings.Replace(`{
+				"links": {
+					"self": {
+						"href": "SERVER_URL/v3"
+					},
+					"apps": {
+						"href": "SERVER_URL/v3/apps"
+					},
+					"spaces": {
+						"href": "SERVER_URL/v3/spaces",
+						"meta": {
+							"version": "3.1.0"
+						}
+					}
+				}
+			}`, "SERVER_URL", server.URL(), -1)
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning 1"}}),
+				),
+			)
+		})
+
+		It("returns the links by resource name and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning 1"))
+			Expect(links).To(HaveLen(3))
+			Expect(links["apps"].HREF).To(Equal(server.URL() + "/v3/apps"))
+			Expect(links["spaces"].Meta.Version).To(Equal("3.1.0"))
+		})
+	})
+
+	When("the cloud controller returns an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3"),
+					RespondWith(http.StatusNotFound, `{"errors": [{}]}`, http.Header{"X-Cf-Warnings": {"warning 2"}}),
+				),
+			)
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+			Expect(warnings).To(ConsistOf("warning 2"))
+		})
+	})
+})
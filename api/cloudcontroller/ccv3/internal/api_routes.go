@@ -9,6 +9,7 @@ import "net/http"
 // If the request returns a single entity by GUID, use the singular (for example
 // /v3/organizations/:organization_guid is GetOrganization).
 const (
+	DeleteApplicationBuildpackCacheRequest                      = "DeleteApplicationBuildpackCache"
 	DeleteApplicationProcessInstanceRequest                     = "DeleteApplicationProcessInstance"
 	DeleteApplicationRequest                                    = "DeleteApplication"
 	DeleteBuildpackRequest                                      = "DeleteBuildpack"
@@ -46,6 +47,7 @@ const (
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
+	GetAppUsageEventsRequest                                    = "GetAppUsageEvents"
 	GetBuildRequest                                             = "GetBuild"
 	GetBuildpacksRequest                                        = "GetBuildpacks"
 	GetDefaultDomainRequest                                     = "GetDefaultDomain"
@@ -64,6 +66,7 @@ const (
 	GetIsolationSegmentOrganizationsRequest                     = "GetIsolationSegmentOrganizations"
 	GetIsolationSegmentRequest                                  = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                                 = "GetIsolationSegments"
+	GetJobRequest                                               = "GetJob"
 	GetOrganizationDomainsRequest                               = "GetOrganizationDomains"
 	GetOrganizationQuotasRequest                                = "GetOrganizationQuotas"
 	GetOrganizationQuotaRequest                                 = "GetOrganizationQuota"
@@ -77,9 +80,11 @@ const (
 	GetProcessesRequest                                         = "GetProcesses"
 	GetProcessStatsRequest                                      = "GetProcessStats"
 	GetProcessSidecarsRequest                                   = "GetProcessSidecars"
+	GetResourceLinksRequest                                     = "GetResourceLinks"
 	GetRolesRequest                                             = "GetRoles"
 	GetRouteBindingsRequest                                     = "GetRouteBindings"
 	GetRouteDestinationsRequest                                 = "GetRouteDestinations"
+	GetRouteRequest                                             = "GetRoute"
 	GetRoutesRequest                                            = "GetRoutes"
 	GetSecurityGroupsRequest                                    = "GetSecurityGroups"
 	GetServiceBrokersRequest                                    = "GetServiceBrokers"
@@ -122,6 +127,7 @@ const (
 	PatchOrganizationQuotaRequest                               = "PatchOrganizationQuota"
 	PatchProcessRequest                                         = "PatchProcess"
 	PatchRouteRequest                                           = "PatchRoute"
+	PatchRouteDestinationsRequest                               = "PatchRouteDestinations"
 	PatchSecurityGroupRequest                                   = "PatchSecurityGroup"
 	PatchServiceBrokerRequest                                   = "PatchServiceBrokerRequest"
 	PatchServiceInstanceRequest                                 = "PatchServiceInstance"
@@ -138,6 +144,7 @@ const (
 	PostApplicationActionStartRequest                           = "PostApplicationActionStart"
 	PostApplicationActionStopRequest                            = "PostApplicationActionStop"
 	PostApplicationDeploymentActionCancelRequest                = "PostApplicationDeploymentActionCancel"
+	PostApplicationDeploymentActionContinueRequest              = "PostApplicationDeploymentActionContinue"
 	PostApplicationDeploymentRequest                            = "PostApplicationDeployment"
 	PostApplicationProcessActionScaleRequest                    = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                      = "PostApplication"
@@ -188,11 +195,13 @@ var APIRoutes = map[string]Route{
 	DeleteApplicationRequest:                                    {Path: "/v3/apps/:app_guid", Method: http.MethodDelete},
 	PatchApplicationRequest:                                     {Path: "/v3/apps/:app_guid", Method: http.MethodPatch},
 	PatchApplicationFeaturesRequest:                             {Path: "/v3/apps/:app_guid/features/:name", Method: http.MethodPatch},
+	GetAppUsageEventsRequest:                                    {Path: "/v3/app_usage_events", Method: http.MethodGet},
 	GetApplicationFeaturesRequest:                               {Path: "/v3/apps/:app_guid/features/:name", Method: http.MethodGet},
 	PostApplicationActionApplyManifest:                          {Path: "/v3/apps/:app_guid/actions/apply_manifest", Method: http.MethodPost},
 	PostApplicationActionRestartRequest:                         {Path: "/v3/apps/:app_guid/actions/restart", Method: http.MethodPost},
 	PostApplicationActionStartRequest:                           {Path: "/v3/apps/:app_guid/actions/start", Method: http.MethodPost},
 	PostApplicationActionStopRequest:                            {Path: "/v3/apps/:app_guid/actions/stop", Method: http.MethodPost},
+	DeleteApplicationBuildpackCacheRequest:                      {Path: "/v3/apps/:app_guid/buildpack_cache", Method: http.MethodDelete},
 	GetApplicationDropletCurrentRequest:                         {Path: "/v3/apps/:app_guid/droplets/current", Method: http.MethodGet},
 	GetApplicationEnvRequest:                                    {Path: "/v3/apps/:app_guid/env", Method: http.MethodGet},
 	PatchApplicationEnvironmentVariablesRequest:                 {Path: "/v3/apps/:app_guid/environment_variables", Method: http.MethodPatch},
@@ -219,6 +228,7 @@ var APIRoutes = map[string]Route{
 	PostApplicationDeploymentRequest:                            {Path: "/v3/deployments", Method: http.MethodPost},
 	GetDeploymentRequest:                                        {Path: "/v3/deployments/:deployment_guid", Method: http.MethodGet},
 	PostApplicationDeploymentActionCancelRequest:                {Path: "/v3/deployments/:deployment_guid/actions/cancel", Method: http.MethodPost},
+	PostApplicationDeploymentActionContinueRequest:              {Path: "/v3/deployments/:deployment_guid/actions/continue", Method: http.MethodPost},
 	GetDomainsRequest:                                           {Path: "/v3/domains", Method: http.MethodGet},
 	PostDomainRequest:                                           {Path: "/v3/domains", Method: http.MethodPost},
 	DeleteDomainRequest:                                         {Path: "/v3/domains/:domain_guid", Method: http.MethodDelete},
@@ -239,6 +249,7 @@ var APIRoutes = map[string]Route{
 	GetFeatureFlagRequest:                                       {Path: "/v3/feature_flags/:name", Method: http.MethodGet},
 	PatchFeatureFlagRequest:                                     {Path: "/v3/feature_flags/:name", Method: http.MethodPatch},
 	GetIsolationSegmentsRequest:                                 {Path: "/v3/isolation_segments", Method: http.MethodGet},
+	GetJobRequest:                                               {Path: "/v3/jobs/:job_guid", Method: http.MethodGet},
 	PostIsolationSegmentsRequest:                                {Path: "/v3/isolation_segments", Method: http.MethodPost},
 	DeleteIsolationSegmentRequest:                               {Path: "/v3/isolation_segments/:isolation_segment_guid", Method: http.MethodDelete},
 	GetIsolationSegmentRequest:                                  {Path: "/v3/isolation_segments/:isolation_segment_guid", Method: http.MethodGet},
@@ -270,10 +281,12 @@ var APIRoutes = map[string]Route{
 	PatchProcessRequest:                                         {Path: "/v3/processes/:process_guid", Method: http.MethodPatch},
 	GetProcessStatsRequest:                                      {Path: "/v3/processes/:process_guid/stats", Method: http.MethodGet},
 	GetProcessSidecarsRequest:                                   {Path: "/v3/processes/:process_guid/sidecars", Method: http.MethodGet},
+	GetResourceLinksRequest:                                     {Path: "/v3", Method: http.MethodGet},
 	PostResourceMatchesRequest:                                  {Path: "/v3/resource_matches", Method: http.MethodPost},
 	GetRolesRequest:                                             {Path: "/v3/roles", Method: http.MethodGet},
 	PostRoleRequest:                                             {Path: "/v3/roles", Method: http.MethodPost},
 	DeleteRoleRequest:                                           {Path: "/v3/roles/:role_guid", Method: http.MethodDelete},
+	GetRouteRequest:                                             {Path: "/v3/routes/:route_guid", Method: http.MethodGet},
 	GetRoutesRequest:                                            {Path: "/v3/routes", Method: http.MethodGet},
 	PostRouteRequest:                                            {Path: "/v3/routes", Method: http.MethodPost},
 	DeleteRouteRequest:                                          {Path: "/v3/routes/:route_guid", Method: http.MethodDelete},
@@ -282,6 +295,7 @@ var APIRoutes = map[string]Route{
 	MapRouteRequest:                                             {Path: "/v3/routes/:route_guid/destinations", Method: http.MethodPost},
 	UnmapRouteRequest:                                           {Path: "/v3/routes/:route_guid/destinations/:destination_guid", Method: http.MethodDelete},
 	PatchDestinationRequest:                                     {Path: "/v3/routes/:route_guid/destinations/:destination_guid", Method: http.MethodPatch},
+	PatchRouteDestinationsRequest:                               {Path: "/v3/routes/:route_guid/destinations", Method: http.MethodPatch},
 	ShareRouteRequest:                                           {Path: "/v3/routes/:route_guid/relationships/shared_spaces", Method: http.MethodPost},
 	UnshareRouteRequest:                                         {Path: "/v3/routes/:route_guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete},
 	PatchMoveRouteRequest:                                       {Path: "/v3/routes/:route_guid/relationships/space", Method: http.MethodPatch},
@@ -5,6 +5,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 // Job represents a Cloud Controller Job.
@@ -13,10 +15,18 @@ type Job struct {
 	RawErrors []JobErrorDetails `json:"errors"`
 	// GUID is a unique identifier for the job.
 	GUID string `json:"guid"`
+	// Operation is the operation the job performs, such as space.delete.
+	Operation string `json:"operation"`
 	// State is the state of the job.
 	State constant.JobState `json:"state"`
 	// Warnings are the warnings emitted by the job during its processing.
 	Warnings []jobWarning `json:"warnings"`
+	// CreatedAt is when the job was created.
+	CreatedAt string `json:"created_at"`
+	// UpdatedAt is when the job was last updated.
+	UpdatedAt string `json:"updated_at"`
+	// Links are the links of the job, such as the link to poll it.
+	Links resources.APILinks `json:"links"`
 }
 
 // Errors returns back a list of
@@ -91,6 +101,23 @@ func (client *Client) GetJob(jobURL JobURL) (Job, Warnings, error) {
 	return responseBody, warnings, err
 }
 
+// GetJobByGUID returns the job with the given GUID.
+func (client *Client) GetJobByGUID(jobGUID string) (Job, Warnings, error) {
+	var responseBody Job
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetJobRequest,
+		URIParams:    internal.Params{"job_guid": jobGUID},
+		ResponseBody: &responseBody,
+	})
+
+	for _, jobWarning := range responseBody.Warnings {
+		warnings = append(warnings, jobWarning.Detail)
+	}
+
+	return responseBody, warnings, err
+}
+
 // PollJob will keep polling the given job until the job has terminated, an
 // error is encountered, or config.OverallPollingTimeout is reached. In the
 // last case, a JobTimeoutError is returned.
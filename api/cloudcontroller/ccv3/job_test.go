@@ -181,6 +181,72 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("GetJobByGUID", func() {
+		var (
+			job        Job
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			client, _ = NewTestClient()
+		})
+
+		JustBeforeEach(func() {
+			job, warnings, executeErr = client.GetJobByGUID("job-guid")
+		})
+
+		When("the job exists", func() {
+			BeforeEach(func() {
+				jsonResponse := `{
+					"guid": "job-guid",
+					"created_at": "2016-06-08T16:41:27Z",
+					"updated_at": "2016-06-08T16:41:28Z",
+					"operation": "space.delete",
+					"state": "PROCESSING",
+					"warnings": [{"detail": "a warning"}],
+					"links": {
+						"self": {
+							"href": "https://api.example.com/v3/jobs/job-guid"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/jobs/job-guid"),
+						RespondWith(http.StatusOK, jsonResponse, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the job with all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "a warning"))
+				Expect(job.GUID).To(Equal("job-guid"))
+				Expect(job.Operation).To(Equal("space.delete"))
+				Expect(job.State).To(Equal(constant.JobProcessing))
+				Expect(job.CreatedAt).To(Equal("2016-06-08T16:41:27Z"))
+				Expect(job.UpdatedAt).To(Equal("2016-06-08T16:41:28Z"))
+				Expect(job.Links["self"].HREF).To(Equal("https://api.example.com/v3/jobs/job-guid"))
+			})
+		})
+
+		When("the job does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/jobs/job-guid"),
+						RespondWith(http.StatusNotFound, `{"errors": [{"code": 10010, "detail": "Job not found", "title": "CF-ResourceNotFound"}]}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns a ResourceNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Job not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	When("Polling jobs", func() {
 		var (
 			jobLocation JobURL
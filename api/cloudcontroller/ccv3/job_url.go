@@ -18,6 +18,18 @@ func (client *Client) DeleteApplication(appGUID string) (JobURL, Warnings, error
 	return jobURL, warnings, err
 }
 
+// DeleteApplicationBuildpackCache deletes the buildpack cache kept between
+// builds of the app with the given app GUID. Returns back a resulting job URL
+// to poll.
+func (client *Client) DeleteApplicationBuildpackCache(appGUID string) (JobURL, Warnings, error) {
+	jobURL, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeleteApplicationBuildpackCacheRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+	})
+
+	return jobURL, warnings, err
+}
+
 // UpdateApplicationApplyManifest applies the manifest to the given
 // application. Returns back a resulting job URL to poll.
 func (client *Client) UpdateApplicationApplyManifest(appGUID string, rawManifest []byte) (JobURL, Warnings, error) {
@@ -128,6 +128,65 @@ var _ = Describe("Job URL", func() {
 		})
 	})
 
+	Describe("DeleteApplicationBuildpackCache", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeleteApplicationBuildpackCache("some-app-guid")
+		})
+
+		When("the cache is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/apps/some-app-guid/buildpack_cache"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/apps/some-app-guid/buildpack_cache"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplicationApplyManifest", func() {
 		var (
 			manifestBody []byte
@@ -68,6 +68,15 @@ func (client *Client) GetPackages(query ...Query) ([]resources.Package, Warnings
 //   - io.Reader: Will return a ccerror.PipeSeekError on retry.
 //   - nil: Will not add the "application" section to the request. The newResourcesLength is ignored in this case.
 //
+// A negative newResourcesLength indicates that the size of newResources is not
+// known ahead of time (e.g. it is being zipped while it is uploaded); the
+// request is then sent using chunked transfer encoding.
+//
+// The bits are sent in a single request: the upload endpoint of the cloud
+// controller takes the whole package at once and cannot resume an interrupted
+// upload. A caller retrying after a ccerror.RequestError can match the
+// resources again and only send the ones the cloud controller still lacks.
+//
 // Note: In order to determine if package creation is successful, poll the
 // Package's state field for more information.
 func (client *Client) UploadBitsPackage(pkg resources.Package, matchedResources []Resource, newResources io.Reader, newResourcesLength int64) (resources.Package, Warnings, error) {
@@ -122,6 +131,10 @@ func (client *Client) CopyPackage(sourcePkgGUID string, targetAppGUID string) (r
 }
 
 func (client *Client) calculateAppBitsRequestSize(matchedResources []Resource, newResourcesLength int64) (int64, error) {
+	if newResourcesLength < 0 {
+		return -1, nil
+	}
+
 	body := &bytes.Buffer{}
 	form := multipart.NewWriter(body)
 
@@ -266,6 +266,34 @@ var _ = Describe("Package", func() {
 			})
 		})
 
+		When("the bits of the package have been processed", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-pkg-guid",
+  "type": "bits",
+  "state": "READY",
+  "data": {
+    "checksum": {
+      "type": "sha256",
+      "value": "some-checksum"
+    },
+    "error": null
+  }
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the checksum of the package", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(pkg.Checksum).To(Equal("sha256:some-checksum"))
+			})
+		})
+
 		When("the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -524,6 +552,43 @@ var _ = Describe("Package", func() {
 				})
 			})
 
+			When("the size of the application bits is not known", func() {
+				var reader io.Reader
+
+				BeforeEach(func() {
+					readerBody = []byte("hello world")
+					reader = bytes.NewReader(readerBody)
+
+					verifyHeaderAndBody = func(_ http.ResponseWriter, req *http.Request) {
+						Expect(req.TransferEncoding).To(ConsistOf("chunked"))
+
+						contentType := req.Header.Get("Content-Type")
+						Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+
+						defer req.Body.Close()
+						requestReader := multipart.NewReader(req.Body, contentType[30:])
+
+						resourcesPart, err := requestReader.NextPart()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resourcesPart.FormName()).To(Equal("resources"))
+
+						resourcesPart, err = requestReader.NextPart()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resourcesPart.FormName()).To(Equal("bits"))
+
+						defer resourcesPart.Close()
+						Expect(ioutil.ReadAll(resourcesPart)).To(Equal(readerBody))
+					}
+				})
+
+				It("streams the application bits and returns warnings", func() {
+					pkg, warnings, err := client.UploadBitsPackage(inputPackage, inputResources, reader, -1)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+					Expect(pkg.GUID).To(Equal("some-package-guid"))
+				})
+			})
+
 			When("there are no application bits to upload", func() {
 				BeforeEach(func() {
 					verifyHeaderAndBody = func(_ http.ResponseWriter, req *http.Request) {
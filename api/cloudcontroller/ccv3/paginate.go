@@ -2,6 +2,9 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
@@ -17,19 +20,22 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 			return IncludedResources{}, fullWarningsList, err
 		}
 
-		includes.Apps = append(includes.Apps, wrapper.IncludedResources.Apps...)
-		includes.Users = append(includes.Users, wrapper.IncludedResources.Users...)
-		includes.Organizations = append(includes.Organizations, wrapper.IncludedResources.Organizations...)
-		includes.Spaces = append(includes.Spaces, wrapper.IncludedResources.Spaces...)
-		includes.ServiceBrokers = append(includes.ServiceBrokers, wrapper.IncludedResources.ServiceBrokers...)
-		includes.ServiceInstances = append(includes.ServiceInstances, wrapper.IncludedResources.ServiceInstances...)
-		includes.ServiceOfferings = append(includes.ServiceOfferings, wrapper.IncludedResources.ServiceOfferings...)
-		includes.ServicePlans = append(includes.ServicePlans, wrapper.IncludedResources.ServicePlans...)
+		includes = appendIncludedResources(includes, wrapper.IncludedResources)
 
 		if specificPage || wrapper.NextPage() == "" {
 			break
 		}
 
+		if requester.paginationWorkers > 1 && wrapper.Pagination.TotalPages > 2 {
+			remainingIncludes, warnings, err := requester.fetchRemainingPages(wrapper, obj, appendToExternalList)
+			fullWarningsList = append(fullWarningsList, warnings...)
+			if err != nil {
+				return IncludedResources{}, fullWarningsList, err
+			}
+			includes = appendIncludedResources(includes, remainingIncludes)
+			break
+		}
+
 		request, err = requester.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
@@ -42,20 +48,88 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 	return includes, fullWarningsList, nil
 }
 
-func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
-	warnings := Warnings{}
-	wrapper := NewPaginatedResources(obj)
-	response := cloudcontroller.Response{
-		DecodeJSONResponseInto: &wrapper,
+// pageResult is a page fetched by fetchRemainingPages.
+type pageResult struct {
+	wrapper  *PaginatedResources
+	items    []interface{}
+	warnings Warnings
+	err      error
+}
+
+// fetchRemainingPages fetches the pages after the first one with up to
+// paginationWorkers requests at a time. The URL of every page is derived from
+// the link to the second page. The items are appended in page order once all
+// pages are fetched, and the first failing page in that order ends the list.
+func (requester RealRequester) fetchRemainingPages(firstPage *PaginatedResources, obj interface{}, appendToExternalList func(interface{}) error) (IncludedResources, Warnings, error) {
+	nextPageURL, err := url.Parse(firstPage.NextPage())
+	if err != nil {
+		return IncludedResources{}, nil, err
 	}
 
-	err := requester.connection.Make(request, &response)
-	warnings = append(warnings, response.Warnings...)
+	remainingPages := firstPage.Pagination.TotalPages - 1
+	results := make([]pageResult, remainingPages)
+	pageIndexes := make(chan int)
+
+	workers := requester.paginationWorkers
+	if workers > remainingPages {
+		workers = remainingPages
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range pageIndexes {
+				results[index] = requester.fetchPage(pageURL(nextPageURL, index+2), obj)
+			}
+		}()
+	}
+
+	for index := 0; index < remainingPages; index++ {
+		pageIndexes <- index
+	}
+	close(pageIndexes)
+	wg.Wait()
+
+	var (
+		includes    IncludedResources
+		allWarnings Warnings
+	)
+	for _, result := range results {
+		allWarnings = append(allWarnings, result.warnings...)
+		if result.err != nil {
+			return IncludedResources{}, allWarnings, result.err
+		}
+
+		for _, item := range result.items {
+			err = appendToExternalList(item)
+			if err != nil {
+				return IncludedResources{}, allWarnings, err
+			}
+		}
+
+		includes = appendIncludedResources(includes, result.wrapper.IncludedResources)
+	}
+
+	return includes, allWarnings, nil
+}
+
+func (requester RealRequester) fetchPage(pageURL string, obj interface{}) pageResult {
+	request, err := requester.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
 	if err != nil {
-		return nil, warnings, err
+		return pageResult{err: err}
 	}
 
-	list, err := wrapper.Resources()
+	wrapper, items, warnings, err := requester.wrapPage(request, obj)
+	return pageResult{wrapper: wrapper, items: items, warnings: warnings, err: err}
+}
+
+func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
+	wrapper, list, warnings, err := requester.wrapPage(request, obj)
 	if err != nil {
 		return nil, warnings, err
 	}
@@ -69,3 +143,46 @@ func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, o
 
 	return wrapper, warnings, nil
 }
+
+func (requester RealRequester) wrapPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, []interface{}, Warnings, error) {
+	warnings := Warnings{}
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &wrapper,
+	}
+
+	err := requester.connection.Make(request, &response)
+	warnings = append(warnings, response.Warnings...)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	list, err := wrapper.Resources()
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	return wrapper, list, warnings, nil
+}
+
+// pageURL returns the link to the next page with its page parameter set to
+// the given page.
+func pageURL(nextPageURL *url.URL, page int) string {
+	pageURL := *nextPageURL
+	query := pageURL.Query()
+	query.Set(string(Page), strconv.Itoa(page))
+	pageURL.RawQuery = query.Encode()
+	return pageURL.String()
+}
+
+func appendIncludedResources(includes IncludedResources, page IncludedResources) IncludedResources {
+	includes.Apps = append(includes.Apps, page.Apps...)
+	includes.Users = append(includes.Users, page.Users...)
+	includes.Organizations = append(includes.Organizations, page.Organizations...)
+	includes.Spaces = append(includes.Spaces, page.Spaces...)
+	includes.ServiceBrokers = append(includes.ServiceBrokers, page.ServiceBrokers...)
+	includes.ServiceInstances = append(includes.ServiceInstances, page.ServiceInstances...)
+	includes.ServiceOfferings = append(includes.ServiceOfferings, page.ServiceOfferings...)
+	includes.ServicePlans = append(includes.ServicePlans, page.ServicePlans...)
+	return includes
+}
@@ -134,6 +134,7 @@ func (client *Client) UpdateProcess(process resources.Process) (resources.Proces
 			HealthCheckEndpoint:          process.HealthCheckEndpoint,
 			HealthCheckTimeout:           process.HealthCheckTimeout,
 			HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+			Ports:                        process.Ports,
 		},
 		ResponseBody: &responseBody,
 	})
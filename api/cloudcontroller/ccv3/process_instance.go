@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // ProcessInstance represents a single process instance for a particular
@@ -35,6 +36,9 @@ type ProcessInstance struct {
 	LogRateLimit int64
 	// LogRate is the current rate that the instance is logging.
 	LogRate uint64
+	// Routable is whether the instance receives traffic. It is only reported
+	// by Cloud Controllers that support readiness health checks.
+	Routable types.NullBool
 	// State is the state of the instance.
 	State constant.ProcessInstanceState
 	// Type is the process type for the instance.
@@ -52,6 +56,7 @@ func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
 		IsolationSegment string `json:"isolation_segment"`
 		MemQuota         uint64 `json:"mem_quota"`
 		LogRateLimit     int64  `json:"log_rate_limit"`
+		Routable         *bool  `json:"routable"`
 		State            string `json:"state"`
 		Type             string `json:"type"`
 		Uptime           int64  `json:"uptime"`
@@ -78,6 +83,7 @@ func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
 	instance.MemoryUsage = inputInstance.Usage.Mem
 	instance.LogRateLimit = inputInstance.LogRateLimit
 	instance.LogRate = inputInstance.Usage.LogRate
+	instance.Routable.ParseBoolValue(inputInstance.Routable)
 	instance.State = constant.ProcessInstanceState(inputInstance.State)
 	instance.Type = inputInstance.Type
 	instance.Uptime, err = time.ParseDuration(fmt.Sprintf("%ds", inputInstance.Uptime))
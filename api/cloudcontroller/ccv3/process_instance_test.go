@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -102,6 +103,7 @@ var _ = Describe("ProcessInstance", func() {
 							"isolation_segment": "example_iso_segment",
 							"index": 0,
 							"uptime": 123,
+							"routable": true,
 							"details": "some details"
 						},
 						{
@@ -145,6 +147,7 @@ var _ = Describe("ProcessInstance", func() {
 						MemoryUsage:      1000000,
 						LogRateLimit:     10000,
 						LogRate:          5000,
+						Routable:         types.NullBool{IsSet: true, Value: true},
 						State:            constant.ProcessInstanceRunning,
 						Type:             "web",
 						Uptime:           123 * time.Second,
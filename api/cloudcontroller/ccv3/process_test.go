@@ -42,6 +42,7 @@ var _ = Describe("Process", func() {
 					"memory_in_mb": 32,
 					"disk_in_mb": 1024,
 					"log_rate_limit_in_bytes_per_second": 512, 
+					"ports": [8080, 9090],
 					"relationships": {
 						"app": {
 							"data": {
@@ -70,18 +71,23 @@ var _ = Describe("Process", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(process).To(MatchAllFields(Fields{
-					"GUID":                         Equal("process-1-guid"),
-					"Type":                         Equal("some-type"),
-					"AppGUID":                      Equal("some-app-guid"),
-					"Command":                      Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
-					"Instances":                    Equal(types.NullInt{Value: 22, IsSet: true}),
-					"MemoryInMB":                   Equal(types.NullUint64{Value: 32, IsSet: true}),
-					"DiskInMB":                     Equal(types.NullUint64{Value: 1024, IsSet: true}),
-					"LogRateLimitInBPS":            Equal(types.NullInt{Value: 512, IsSet: true}),
-					"HealthCheckType":              Equal(constant.HTTP),
-					"HealthCheckEndpoint":          Equal("/health"),
-					"HealthCheckInvocationTimeout": BeEquivalentTo(42),
-					"HealthCheckTimeout":           BeEquivalentTo(90),
+					"GUID":                                  Equal("process-1-guid"),
+					"Type":                                  Equal("some-type"),
+					"AppGUID":                               Equal("some-app-guid"),
+					"Command":                               Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
+					"Instances":                             Equal(types.NullInt{Value: 22, IsSet: true}),
+					"MemoryInMB":                            Equal(types.NullUint64{Value: 32, IsSet: true}),
+					"DiskInMB":                              Equal(types.NullUint64{Value: 1024, IsSet: true}),
+					"LogRateLimitInBPS":                     Equal(types.NullInt{Value: 512, IsSet: true}),
+					"HealthCheckType":                       Equal(constant.HTTP),
+					"HealthCheckEndpoint":                   Equal("/health"),
+					"HealthCheckInvocationTimeout":          BeEquivalentTo(42),
+					"HealthCheckTimeout":                    BeEquivalentTo(90),
+					"ReadinessHealthCheckType":              BeEmpty(),
+					"ReadinessHealthCheckEndpoint":          BeEmpty(),
+					"ReadinessHealthCheckInvocationTimeout": BeZero(),
+					"ReadinessHealthCheckInterval":          BeZero(),
+					"Ports":                                 Equal([]int{8080, 9090}),
 				}))
 			})
 		})
@@ -331,18 +337,23 @@ var _ = Describe("Process", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(process).To(MatchAllFields(Fields{
-					"GUID":                         Equal("process-1-guid"),
-					"Type":                         Equal("some-type"),
-					"AppGUID":                      Equal("some-app-guid"),
-					"Command":                      Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
-					"Instances":                    Equal(types.NullInt{Value: 22, IsSet: true}),
-					"MemoryInMB":                   Equal(types.NullUint64{Value: 32, IsSet: true}),
-					"DiskInMB":                     Equal(types.NullUint64{Value: 1024, IsSet: true}),
-					"LogRateLimitInBPS":            Equal(types.NullInt{Value: 64, IsSet: true}),
-					"HealthCheckType":              Equal(constant.HTTP),
-					"HealthCheckEndpoint":          Equal("/health"),
-					"HealthCheckInvocationTimeout": BeEquivalentTo(42),
-					"HealthCheckTimeout":           BeEquivalentTo(90),
+					"GUID":                                  Equal("process-1-guid"),
+					"Type":                                  Equal("some-type"),
+					"AppGUID":                               Equal("some-app-guid"),
+					"Command":                               Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
+					"Instances":                             Equal(types.NullInt{Value: 22, IsSet: true}),
+					"MemoryInMB":                            Equal(types.NullUint64{Value: 32, IsSet: true}),
+					"DiskInMB":                              Equal(types.NullUint64{Value: 1024, IsSet: true}),
+					"LogRateLimitInBPS":                     Equal(types.NullInt{Value: 64, IsSet: true}),
+					"HealthCheckType":                       Equal(constant.HTTP),
+					"HealthCheckEndpoint":                   Equal("/health"),
+					"HealthCheckInvocationTimeout":          BeEquivalentTo(42),
+					"HealthCheckTimeout":                    BeEquivalentTo(90),
+					"ReadinessHealthCheckType":              BeEmpty(),
+					"ReadinessHealthCheckEndpoint":          BeEmpty(),
+					"ReadinessHealthCheckInvocationTimeout": BeZero(),
+					"ReadinessHealthCheckInterval":          BeZero(),
+					"Ports":                                 BeEmpty(),
 				}))
 			})
 		})
@@ -753,6 +764,34 @@ var _ = Describe("Process", func() {
 		})
 
 		When("patching the process succeeds", func() {
+			When("the ports are set", func() {
+				BeforeEach(func() {
+					inputProcess.Ports = []int{8080, 9090}
+
+					expectedBody := `{
+						"ports": [8080, 9090]
+					}`
+
+					expectedResponse := `{
+						"ports": [8080, 9090]
+					}`
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+							VerifyJSON(expectedBody),
+							RespondWith(http.StatusOK, expectedResponse, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("patches the ports of the process", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+					Expect(process.Ports).To(Equal([]int{8080, 9090}))
+				})
+			})
+
 			When("the command is set", func() {
 				When("the start command is an arbitrary command", func() {
 					BeforeEach(func() {
@@ -51,6 +51,8 @@ const (
 	PortFilter QueryKey = "port"
 	// PortsFilter is a query param for getting an object with the given ports (TCP routes)
 	PortsFilter QueryKey = "ports"
+	// EventTypesFilter is a query param for listing audit events by type
+	EventTypesFilter QueryKey = "types"
 	// RoleTypesFilter is a query param for getting a role by type
 	RoleTypesFilter QueryKey = "types"
 	// StackFilter is a query parameter for listing objects by stack name
@@ -75,6 +77,8 @@ const (
 	ServiceOfferingNamesFilter QueryKey = "service_offering_names"
 	// ServiceOfferingGUIDsFilter is a query parameter when getting resources according to service offering GUIDs
 	ServiceOfferingGUIDsFilter QueryKey = "service_offering_guids"
+	// ServicePlanGUIDsFilter is a query parameter when getting service instances according to the service plans they use
+	ServicePlanGUIDsFilter QueryKey = "service_plan_guids"
 	// FieldsServiceOfferingServiceBroker is a query parameter to include specific fields from a service broker in a plan response
 	FieldsServiceOfferingServiceBroker QueryKey = "fields[service_offering.service_broker]"
 	// FieldsServiceBroker is a query parameter to include specific fields from a service broker in an offering response
@@ -90,6 +94,13 @@ const (
 	// FieldsSpaceOrganization is a query parameter to include specific fields from a organization
 	FieldsSpaceOrganization QueryKey = "fields[space.organization]"
 
+	// CreatedAtsBeforeFilter is a query parameter for listing objects created
+	// before the given timestamp.
+	CreatedAtsBeforeFilter QueryKey = "created_ats[lt]"
+	// CreatedAtsAfterFilter is a query parameter for listing objects created
+	// after the given timestamp.
+	CreatedAtsAfterFilter QueryKey = "created_ats[gt]"
+
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
 	// PerPage is a query parameter for specifying the number of results per page.
@@ -114,6 +125,10 @@ const (
 	// used in conjunction with the OrderBy QueryKey.
 	PositionOrder = "position"
 
+	// CreatedAtAscendingOrder is a query value for ordering by created_at
+	// timestamp, in ascending order.
+	CreatedAtAscendingOrder = "created_at"
+
 	// CreatedAtDescendingOrder is a query value for ordering by created_at timestamp,
 	// in descending order.
 	CreatedAtDescendingOrder = "-created_at"
@@ -68,15 +68,19 @@ type Requester interface {
 }
 
 type RealRequester struct {
-	connection cloudcontroller.Connection
-	router     *internal.Router
-	userAgent  string
-	wrappers   []ConnectionWrapper
+	connection        cloudcontroller.Connection
+	router            *internal.Router
+	userAgent         string
+	wrappers          []ConnectionWrapper
+	paginationWorkers int
 }
 
 func (requester *RealRequester) InitializeConnection(settings TargetSettings) {
 	requester.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
+		ClientCertificate: settings.ClientCertificate,
 		DialTimeout:       settings.DialTimeout,
+		IPFamily:          settings.IPFamily,
+		ResolveOverrides:  settings.ResolveOverrides,
 		SkipSSLValidation: settings.SkipSSLValidation,
 	})
 
@@ -229,8 +233,9 @@ func NewRequester(config Config) *RealRequester {
 	)
 
 	return &RealRequester{
-		userAgent: userAgent,
-		wrappers:  append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:         userAgent,
+		wrappers:          append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		paginationWorkers: config.PaginationWorkers,
 	}
 }
 
@@ -1032,6 +1032,84 @@ var _ = Describe("shared request helpers", func() {
 				})
 			})
 		})
+
+		Context("with pagination workers", func() {
+			var (
+				resourceList []resources.Stack
+				failingPage  string
+			)
+
+			respondWithPage := func(w http.ResponseWriter, r *http.Request) {
+				page := r.URL.Query().Get("page")
+				if page == "" {
+					page = "1"
+				}
+				w.Header().Set("X-Cf-Warnings", "warning-"+page)
+
+				if page == failingPage {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"errors": [{"code": 10008, "detail": "page failed", "title": "CF-UnprocessableEntity"}]}`))
+					return
+				}
+
+				next := "null"
+				if page != "4" {
+					next = fmt.Sprintf(`{"href": "%s/v3/stacks?per_page=1&page=%s"}`, server.URL(), "2")
+				}
+				_, _ = fmt.Fprintf(w, `{
+					"pagination": {"total_pages": 4, "next": %s},
+					"resources": [{"guid": "stack-guid-%s"}]
+				}`, next, page)
+			}
+
+			BeforeEach(func() {
+				client, _ = NewTestClient(Config{PaginationWorkers: 2})
+				resourceList = []resources.Stack{}
+				failingPage = ""
+				requestParams = RequestParams{
+					RequestName:  internal.GetStacksRequest,
+					Query:        []Query{{Key: PerPage, Values: []string{"1"}}},
+					ResponseBody: resources.Stack{},
+					AppendToList: func(item interface{}) error {
+						resourceList = append(resourceList, item.(resources.Stack))
+						return nil
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/stacks", "per_page=1"),
+						respondWithPage,
+					),
+				)
+				for i := 0; i < 3; i++ {
+					server.AppendHandlers(respondWithPage)
+				}
+			})
+
+			It("fetches the remaining pages at the same time and returns the resources in page order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+				Expect(resourceList).To(Equal([]resources.Stack{
+					{GUID: "stack-guid-1"},
+					{GUID: "stack-guid-2"},
+					{GUID: "stack-guid-3"},
+					{GUID: "stack-guid-4"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			})
+
+			When("one of the remaining pages fails", func() {
+				BeforeEach(func() {
+					failingPage = "3"
+				})
+
+				It("returns the error and the warnings up to that page", func() {
+					Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "page failed"}))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+				})
+			})
+		})
 	})
 
 	Describe("MakeRequestReceiveRaw", func() {
@@ -66,6 +66,18 @@ func (client Client) GetRouteDestinations(routeGUID string) ([]resources.RouteDe
 	return responseBody.Destinations, warnings, err
 }
 
+func (client Client) GetRoute(routeGUID string) (resources.Route, Warnings, error) {
+	var responseBody resources.Route
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetRouteRequest,
+		URIParams:    internal.Params{"route_guid": routeGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
+
 func (client Client) GetRoutes(query ...Query) ([]resources.Route, Warnings, error) {
 	var routes []resources.Route
 
@@ -120,6 +132,94 @@ func (client Client) MapRoute(routeGUID string, appGUID string, destinationProto
 	return warnings, err
 }
 
+// MapRouteDestinations adds the given destinations, with their process type,
+// port and protocol, to a route.
+func (client Client) MapRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	type destinationProcess struct {
+		Type string `json:"type"`
+	}
+
+	type destinationApp struct {
+		GUID    string              `json:"guid"`
+		Process *destinationProcess `json:"process,omitempty"`
+	}
+	type destination struct {
+		App      destinationApp `json:"app"`
+		Port     int            `json:"port,omitempty"`
+		Protocol string         `json:"protocol,omitempty"`
+	}
+
+	var requestBody struct {
+		Destinations []destination `json:"destinations"`
+	}
+	for _, d := range destinations {
+		newDestination := destination{
+			App:      destinationApp{GUID: d.App.GUID},
+			Port:     d.Port,
+			Protocol: d.Protocol,
+		}
+		if d.App.Process.Type != "" {
+			newDestination.App.Process = &destinationProcess{Type: d.App.Process.Type}
+		}
+		requestBody.Destinations = append(requestBody.Destinations, newDestination)
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.MapRouteRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		RequestBody: &requestBody,
+	})
+
+	return warnings, err
+}
+
+// UpdateRouteDestinations replaces the destinations of a route with the given
+// ones, which keeps the destinations that are unchanged and is how the
+// weights of a weighted route are changed.
+func (client Client) UpdateRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	type destinationProcess struct {
+		Type string `json:"type"`
+	}
+
+	type destinationApp struct {
+		GUID    string              `json:"guid"`
+		Process *destinationProcess `json:"process,omitempty"`
+	}
+	type destination struct {
+		App      destinationApp `json:"app"`
+		Port     int            `json:"port,omitempty"`
+		Protocol string         `json:"protocol,omitempty"`
+		Weight   *int           `json:"weight,omitempty"`
+	}
+
+	requestBody := struct {
+		Destinations []destination `json:"destinations"`
+	}{Destinations: []destination{}}
+	for _, d := range destinations {
+		newDestination := destination{
+			App:      destinationApp{GUID: d.App.GUID},
+			Port:     d.Port,
+			Protocol: d.Protocol,
+		}
+		if d.App.Process.Type != "" {
+			newDestination.App.Process = &destinationProcess{Type: d.App.Process.Type}
+		}
+		if d.Weight.IsSet {
+			weight := d.Weight.Value
+			newDestination.Weight = &weight
+		}
+		requestBody.Destinations = append(requestBody.Destinations, newDestination)
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.PatchRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		RequestBody: &requestBody,
+	})
+
+	return warnings, err
+}
+
 func (client Client) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	var responseBody resources.Build
 
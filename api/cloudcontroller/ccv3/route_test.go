@@ -310,6 +310,75 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("GetRoute", func() {
+		var (
+			route      resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = client.GetRoute("some-route-guid")
+		})
+
+		When("the route exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-route-guid",
+					"host": "some-host",
+					"url": "some-host.example.com",
+					"relationships": {
+						"space": { "data": { "guid": "some-space-guid" } },
+						"domain": { "data": { "guid": "some-domain-guid" } }
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/some-route-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the route and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(route.GUID).To(Equal("some-route-guid"))
+				Expect(route.Host).To(Equal("some-host"))
+				Expect(route.URL).To(Equal("some-host.example.com"))
+				Expect(route.SpaceGUID).To(Equal("some-space-guid"))
+				Expect(route.DomainGUID).To(Equal("some-domain-guid"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Route not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/some-route-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Route not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetRoutes", func() {
 		var (
 			query      Query
@@ -646,6 +715,114 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("MapRouteDestinations", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			destinations := []resources.RouteDestination{
+				{App: resources.RouteDestinationApp{GUID: "app-guid-1"}},
+				{App: resources.RouteDestinationApp{GUID: "app-guid-2"}, Port: 9000, Protocol: "http2"},
+			}
+			destinations[1].App.Process.Type = "worker"
+			warnings, executeErr = client.MapRouteDestinations("route-guid", destinations)
+		})
+
+		When("the request is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(`{
+							"destinations": [
+								{"app": {"guid": "app-guid-1"}},
+								{"app": {"guid": "app-guid-2", "process": {"type": "worker"}}, "port": 9000, "protocol": "http2"}
+							]
+						}`),
+						RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("adds every destination with its process type, port and protocol", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, `{"errors": [{"code": 10008, "detail": "invalid destination", "title": "CF-UnprocessableEntity"}]}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "invalid destination"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateRouteDestinations", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			destinations := []resources.RouteDestination{
+				{App: resources.RouteDestinationApp{GUID: "app-guid-1"}, Weight: types.NullInt{IsSet: true, Value: 0}},
+				{App: resources.RouteDestinationApp{GUID: "app-guid-2"}, Port: 9000, Weight: types.NullInt{IsSet: true, Value: 100}},
+			}
+			destinations[1].App.Process.Type = "web"
+			warnings, executeErr = client.UpdateRouteDestinations("route-guid", destinations)
+		})
+
+		When("the request is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(`{
+							"destinations": [
+								{"app": {"guid": "app-guid-1"}, "weight": 0},
+								{"app": {"guid": "app-guid-2", "process": {"type": "web"}}, "port": 9000, "weight": 100}
+							]
+						}`),
+						RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("replaces the destinations with their weights", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, `{"errors": [{"code": 10008, "detail": "invalid weights", "title": "CF-UnprocessableEntity"}]}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "invalid weights"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetRouteDestinations", func() {
 		var (
 			routeGUID    = "some-route-guid"
@@ -683,7 +860,8 @@ var _ = Describe("Route", func() {
 								"process": {
 									"type": "worker"
 								}
-							}
+							},
+							"weight": 25
 						}
 					]
 				}`
@@ -709,8 +887,9 @@ var _ = Describe("Route", func() {
 							App:  resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
 						},
 						{
-							GUID: "destination-2-guid",
-							App:  resources.RouteDestinationApp{GUID: "app-2-guid", Process: struct{ Type string }{Type: "worker"}},
+							GUID:   "destination-2-guid",
+							App:    resources.RouteDestinationApp{GUID: "app-2-guid", Process: struct{ Type string }{Type: "worker"}},
+							Weight: types.NullInt{IsSet: true, Value: 25},
 						},
 					}))
 				})
@@ -102,6 +102,10 @@ type ServiceOfferingWithPlans struct {
 	Description string
 	// ServiceBrokerName is the name of the service broker
 	ServiceBrokerName string
+	// Bindable is whether instances of the service offering can be bound
+	Bindable bool
+	// PlanUpdateable is whether instances of the service offering can change plans
+	PlanUpdateable bool
 
 	// List of service plans that this service offering provides
 	Plans []resources.ServicePlan
@@ -151,6 +155,8 @@ func (client *Client) GetServicePlansWithOfferings(query ...Query) ([]ServiceOff
 		offeringsWithPlans[i].Name = o.Name
 		offeringsWithPlans[i].Description = o.Description
 		offeringsWithPlans[i].ServiceBrokerName = brokerNameLookup[o.ServiceBrokerGUID]
+		offeringsWithPlans[i].Bindable = o.Bindable
+		offeringsWithPlans[i].PlanUpdateable = o.PlanUpdateable
 	}
 
 	return offeringsWithPlans, warnings, nil
@@ -584,6 +584,12 @@ var _ = Describe("Service Plan", func() {
 									"name": "service-offering-1",
 									"guid": "79d428b9-75b4-44db-addf-19c85c7f0f1e",
 									"description": "something about service offering 1",
+									"broker_catalog": {
+										"features": {
+											"bindable": true,
+											"plan_updateable": false
+										}
+									},
 									"relationships": {
 										"service_broker": {
 											"data": {
@@ -633,6 +639,12 @@ var _ = Describe("Service Plan", func() {
 								"description": "service-plan-3-description",
 								"available": true,
 								"free": true,
+								"broker_catalog": {
+									"features": {
+										"bindable": true,
+										"plan_updateable": true
+									}
+								},
 								"relationships": {
 									"service_offering": {
 									   "data": {
@@ -648,6 +660,12 @@ var _ = Describe("Service Plan", func() {
 									"name": "service-offering-1",
 									"guid": "79d428b9-75b4-44db-addf-19c85c7f0f1e",
 									"description": "something about service offering 1",
+									"broker_catalog": {
+										"features": {
+											"bindable": true,
+											"plan_updateable": false
+										}
+									},
 									"relationships": {
 										"service_broker": {
 											"data": {
@@ -698,6 +716,7 @@ var _ = Describe("Service Plan", func() {
 						Name:              "service-offering-1",
 						Description:       "something about service offering 1",
 						ServiceBrokerName: "service-broker-1",
+						Bindable:          true,
 						Plans: []resources.ServicePlan{
 							{
 								GUID:                "service-plan-1-guid",
@@ -713,6 +732,8 @@ var _ = Describe("Service Plan", func() {
 								Description:         "service-plan-3-description",
 								Available:           true,
 								Free:                true,
+								Bindable:            true,
+								PlanUpdateable:      true,
 								ServiceOfferingGUID: "79d428b9-75b4-44db-addf-19c85c7f0f1e",
 							},
 						},
@@ -2,15 +2,29 @@ package ccv3
 
 import (
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
 )
 
 // TargetSettings represents configuration for establishing a connection to the
 // Cloud Controller server.
 type TargetSettings struct {
+	// ClientCertificate is the certificate and private key presented to the
+	// Cloud Controller when it requires mutual TLS.
+	ClientCertificate util.ClientCertificate
+
 	// DialTimeout is the DNS timeout used to make all requests to the Cloud
 	// Controller.
 	DialTimeout time.Duration
 
+	// IPFamily is the address family that connections to the Cloud
+	// Controller are attempted over first.
+	IPFamily util.IPFamily
+
+	// ResolveOverrides are the addresses that connections to specific hosts
+	// and ports are made to instead of resolving the hosts.
+	ResolveOverrides []util.ResolveOverride
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -22,6 +36,11 @@ type TargetSettings struct {
 
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
+
+	// BaseURL is the URL that API routes such as /v3/apps are resolved
+	// against, for Cloud Controllers served under a different path than URL.
+	// It defaults to URL.
+	BaseURL string
 }
 
 // TargetCF sets the client to use the Cloud Controller specified in the
@@ -29,5 +48,9 @@ type TargetSettings struct {
 func (client *Client) TargetCF(settings TargetSettings) {
 	client.CloudControllerURL = settings.URL
 	client.InitializeConnection(settings)
-	client.InitializeRouter(settings.URL)
+	baseURL := settings.BaseURL
+	if baseURL == "" {
+		baseURL = settings.URL
+	}
+	client.InitializeRouter(baseURL)
 }
@@ -98,5 +98,29 @@ var _ = Describe("Target", func() {
 				Expect(fakeWrapper2.WrapArgsForCall(0)).To(Equal(fakeWrapper1))
 			})
 		})
+
+		When("a base URL is given", func() {
+			BeforeEach(func() {
+				server.Reset()
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/cf/v3/apps"),
+						RespondWith(http.StatusOK, `{"pagination": {}, "resources": []}`),
+					),
+				)
+			})
+
+			It("routes requests relative to the base URL", func() {
+				client.TargetCF(TargetSettings{
+					SkipSSLValidation: true,
+					URL:               server.URL(),
+					BaseURL:           server.URL() + "/cf",
+				})
+
+				_, _, err := client.GetApplications()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
 	})
 })
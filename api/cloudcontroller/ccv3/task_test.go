@@ -453,7 +453,14 @@ var _ = Describe("Task", func() {
 					"name": "task-1",
 					"command": "some-command",
 					"state": "SUCCEEDED",
-					"created_at": "2016-11-07T05:59:01Z"
+					"created_at": "2016-11-07T05:59:01Z",
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
 				}`
 
 				server.AppendHandlers(
@@ -475,6 +482,9 @@ var _ = Describe("Task", func() {
 					State:      constant.TaskSucceeded,
 					CreatedAt:  "2016-11-07T05:59:01Z",
 					Command:    "some-command",
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"},
+					},
 				}
 
 				Expect(task).To(Equal(expectedTask))
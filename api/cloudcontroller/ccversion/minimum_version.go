@@ -3,6 +3,7 @@ package ccversion
 const (
 	MinSupportedV2ClientVersion = "2.128.0"
 	MinSupportedClientVersionV8 = "3.99.0"
+	MaxSupportedClientVersionV8 = "3.150.0"
 
 	MinVersionUpdateServiceNameWhenPlanNotVisibleV2  = "2.131.0"
 	MinVersionUpdateServiceInstanceMaintenanceInfoV2 = "2.135.0"
@@ -3,7 +3,6 @@ package cloudcontroller
 import (
 	"crypto/x509"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -16,7 +15,10 @@ import (
 
 // Config is for configuring a CloudControllerConnection.
 type Config struct {
+	ClientCertificate util.ClientCertificate
 	DialTimeout       time.Duration
+	IPFamily          util.IPFamily
+	ResolveOverrides  []util.ResolveOverride
 	SkipSSLValidation bool
 }
 
@@ -31,12 +33,9 @@ type CloudControllerConnection struct {
 // configuration.
 func NewConnection(config Config) *CloudControllerConnection {
 	tr := &http.Transport{
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation),
+		TLSClientConfig: util.NewClientTLSConfig(config.SkipSSLValidation, config.ClientCertificate),
 		Proxy:           http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   config.DialTimeout,
-		}).DialContext,
+		DialContext:     util.NewDialer(config.DialTimeout, config.IPFamily, config.ResolveOverrides).DialContext,
 	}
 
 	return &CloudControllerConnection{
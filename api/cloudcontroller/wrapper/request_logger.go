@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/shared"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RequestLoggerOutput
@@ -32,6 +34,11 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection cloudcontroller.Connection
 	output     RequestLoggerOutput
+
+	// exchangeLock keeps a request and its response together in the output
+	// when requests are made at the same time, such as the pages of a list.
+	// Requests are then made one at a time while they are logged.
+	exchangeLock sync.Mutex
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -43,15 +50,22 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	logger.exchangeLock.Lock()
+	defer logger.exchangeLock.Unlock()
+
 	err := logger.displayRequest(request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
 
+	untracedRequest := request.Request
+	var connectionTrace *shared.ConnectionTrace
+	request.Request, connectionTrace = shared.TraceConnection(request.Request)
 	err = logger.connection.Make(request, passedResponse)
+	request.Request = untracedRequest
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, connectionTrace)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -118,7 +132,7 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response, connectionTrace *shared.ConnectionTrace) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -133,6 +147,12 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
+	if connection := connectionTrace.String(); connection != "" {
+		err = logger.output.DisplayMessage(connection)
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.displaySortedHeaders(passedResponse.HTTPResponse.Header)
 	if err != nil {
 		return err
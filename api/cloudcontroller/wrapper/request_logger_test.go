@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -348,6 +351,47 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		When("requests are made at the same time", func() {
+			It("outputs every request next to its response", func() {
+				var (
+					lock  sync.Mutex
+					types []string
+				)
+				fakeOutput.DisplayTypeStub = func(name string, _ time.Time) error {
+					lock.Lock()
+					defer lock.Unlock()
+					types = append(types, name)
+					return nil
+				}
+				fakeConnection.MakeStub = func(*cloudcontroller.Request, *cloudcontroller.Response) error {
+					time.Sleep(10 * time.Millisecond)
+					return nil
+				}
+
+				var wg sync.WaitGroup
+				for i := 0; i < 3; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						defer GinkgoRecover()
+						req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+						Expect(err).NotTo(HaveOccurred())
+						response := &cloudcontroller.Response{HTTPResponse: &http.Response{}}
+						Expect(wrapper.Make(cloudcontroller.NewRequest(req, nil), response)).To(Succeed())
+					}()
+				}
+				wg.Wait()
+
+				lock.Lock()
+				defer lock.Unlock()
+				Expect(types).To(Equal([]string{
+					"REQUEST", "RESPONSE",
+					"REQUEST", "RESPONSE",
+					"REQUEST", "RESPONSE",
+				}))
+			})
+		})
+
 		When("an error occurs while trying to log the response", func() {
 			var (
 				originalErr error
@@ -378,6 +422,28 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		When("the connection is traced", func() {
+			BeforeEach(func() {
+				response.HTTPResponse = &http.Response{Proto: "HTTP/1.1", Status: "200 OK"}
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, _ *cloudcontroller.Response) error {
+					trace := httptrace.ContextClientTrace(request.Context())
+					trace.DNSDone(httptrace.DNSDoneInfo{Addrs: []net.IPAddr{{IP: net.ParseIP("2001:db8::1")}, {IP: net.ParseIP("192.0.2.1")}}})
+					trace.GotConn(httptrace.GotConnInfo{Conn: tracedConn{remoteAddr: &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 443}}})
+					return nil
+				}
+			})
+
+			It("outputs the addresses of the connection with the response", func() {
+				Expect(makeErr).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Connected to [2001:db8::1]:443, resolved from 2001:db8::1, 192.0.2.1]"))
+			})
+
+			It("does not leave the trace on the request", func() {
+				Expect(httptrace.ContextClientTrace(request.Context())).To(BeNil())
+			})
+		})
+
 		It("starts and stops the output", func() {
 			Expect(fakeOutput.StartCallCount()).To(Equal(2))
 			Expect(fakeOutput.StopCallCount()).To(Equal(2))
@@ -400,3 +466,12 @@ var _ = Describe("Request Logger", func() {
 		})
 	})
 })
+
+type tracedConn struct {
+	net.Conn
+	remoteAddr net.Addr
+}
+
+func (conn tracedConn) RemoteAddr() net.Addr {
+	return conn.remoteAddr
+}
@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/requeststats"
+)
+
+// RequestStats is the wrapper that records the requests to the Cloud
+// Controller for the --stats summary.
+type RequestStats struct {
+	connection cloudcontroller.Connection
+	collector  *requeststats.Collector
+}
+
+// NewRequestStats returns a pointer to a RequestStats wrapper.
+func NewRequestStats(collector *requeststats.Collector) *RequestStats {
+	return &RequestStats{
+		collector: collector,
+	}
+}
+
+// Make records how long the request took and how much data it received.
+func (stats *RequestStats) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	start := time.Now()
+	err := stats.connection.Make(request, passedResponse)
+	stats.collector.Record(request.Request, int64(len(passedResponse.RawResponse)), time.Since(start))
+	return err
+}
+
+// Wrap sets the connection in the RequestStats and returns itself.
+func (stats *RequestStats) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	stats.connection = innerconnection
+	return stats
+}
@@ -0,0 +1,65 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/util/requeststats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Request Stats", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		collector      *requeststats.Collector
+		wrapper        cloudcontroller.Connection
+		request        *cloudcontroller.Request
+		response       *cloudcontroller.Response
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte(`{"name":"some-app"}`)
+			return nil
+		}
+		collector = requeststats.NewCollector()
+		wrapper = NewRequestStats(collector).Wrap(fakeConnection)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v3/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+	})
+
+	It("makes the request and records it", func() {
+		Expect(wrapper.Make(request, response)).To(Succeed())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+		summary := collector.Summary()
+		Expect(summary.Calls).To(Equal(1))
+		Expect(summary.BytesReceived).To(Equal(int64(len(`{"name":"some-app"}`))))
+		Expect(summary.SlowestEndpoints).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"Method": Equal(http.MethodGet), "Endpoint": Equal("api.example.com/v3/apps")}),
+		))
+	})
+
+	It("records failed requests and returns their error", func() {
+		fakeConnection.MakeStub = nil
+		fakeConnection.MakeReturns(errors.New("some-error"))
+
+		Expect(wrapper.Make(request, response)).To(MatchError("some-error"))
+		Expect(collector.Summary().Calls).To(Equal(1))
+	})
+
+	It("counts the request being made again as a retry", func() {
+		Expect(wrapper.Make(request, response)).To(Succeed())
+		Expect(wrapper.Make(request, response)).To(Succeed())
+		Expect(collector.Summary().Retries).To(Equal(1))
+	})
+})
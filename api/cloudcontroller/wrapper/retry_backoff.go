@@ -0,0 +1,155 @@
+package wrapper
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// MaxRetryBackoffDelay is the longest that RetryBackoff waits before retrying
+// a request, whatever the Retry-After header of the response asks for.
+const MaxRetryBackoffDelay = time.Minute
+
+// RetryBackoff is a wrapper that retries requests that were rate limited or
+// failed with a transient gateway error, waiting longer before every retry.
+// Unlike RetryRequest, it also retries rate limited POST requests, since the
+// Cloud Controller did not process them.
+type RetryBackoff struct {
+	maxRetries int
+	baseDelay  time.Duration
+	outputs    []RequestLoggerOutput
+	connection cloudcontroller.Connection
+}
+
+// NewRetryBackoff returns a pointer to a RetryBackoff wrapper. The first retry
+// waits about baseDelay, and every following retry twice as long as the one
+// before, unless the response has a Retry-After header. The retries are
+// displayed on the outputs.
+func NewRetryBackoff(maxRetries int, baseDelay time.Duration, outputs ...RequestLoggerOutput) *RetryBackoff {
+	return &RetryBackoff{
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+		outputs:    outputs,
+	}
+}
+
+// Make retries the request if it comes back with a 429 status code, or a 502
+// or 503 status code for a request other than a POST.
+func (retry *RetryBackoff) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	var err error
+
+	for i := 0; ; i++ {
+		err = retry.connection.Make(request, passedResponse)
+		if err == nil || i == retry.maxRetries || !retry.shouldRetry(request.Method, passedResponse.HTTPResponse) {
+			return err
+		}
+
+		delay := retry.delay(i, passedResponse.HTTPResponse)
+		retry.displayRetry(request, passedResponse.HTTPResponse, delay, i+1)
+
+		// Reset the request body prior to the next retry
+		resetErr := request.ResetBody()
+		if resetErr != nil {
+			if _, ok := resetErr.(ccerror.PipeSeekError); ok {
+				return ccerror.PipeSeekError{Err: err}
+			}
+			return resetErr
+		}
+
+		time.Sleep(delay)
+	}
+}
+
+// Wrap sets the connection in the RetryBackoff and returns itself.
+func (retry *RetryBackoff) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	retry.connection = innerconnection
+	return retry
+}
+
+func (*RetryBackoff) shouldRetry(httpMethod string, response *http.Response) bool {
+	if response == nil {
+		return false
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return httpMethod != http.MethodPost
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the retry after the given number of
+// previous retries. The Retry-After header of the response is honored, and
+// otherwise the delay doubles with every retry and half of it is random, so
+// that clients that were limited at the same time spread their retries.
+func (retry *RetryBackoff) delay(retries int, response *http.Response) time.Duration {
+	if delay, ok := retryAfter(response.Header.Get("Retry-After")); ok {
+		if delay > MaxRetryBackoffDelay {
+			return MaxRetryBackoffDelay
+		}
+		return delay
+	}
+
+	delay := retry.baseDelay << uint(retries)
+	if delay <= 0 || delay > MaxRetryBackoffDelay {
+		delay = MaxRetryBackoffDelay
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+func (retry *RetryBackoff) displayRetry(request *cloudcontroller.Request, response *http.Response, delay time.Duration, attempt int) {
+	message := fmt.Sprintf("RETRYING %s %s in %s after %d %s (retry %d of %d)",
+		request.Method, request.URL.RequestURI(), delay.Round(time.Millisecond),
+		response.StatusCode, http.StatusText(response.StatusCode), attempt, retry.maxRetries)
+
+	for _, output := range retry.outputs {
+		if err := output.Start(); err != nil {
+			output.HandleInternalError(err)
+			continue
+		}
+		if err := output.DisplayMessage(message); err != nil {
+			output.HandleInternalError(err)
+		}
+		if err := output.Stop(); err != nil {
+			output.HandleInternalError(err)
+		}
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,134 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retry Backoff", func() {
+	var (
+		rawRequestBody string
+		request        *cloudcontroller.Request
+		response       *cloudcontroller.Response
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeRequestLoggerOutput
+	)
+
+	newRequest := func(method string) *cloudcontroller.Request {
+		body := strings.NewReader(rawRequestBody)
+		req, err := http.NewRequest(method, "https://foo.bar.com/v3/apps?page=2", body)
+		Expect(err).NotTo(HaveOccurred())
+		return cloudcontroller.NewRequest(req, body)
+	}
+
+	respondWith := func(statusCode int, header http.Header) {
+		fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			defer req.Body.Close()
+			body, readErr := ioutil.ReadAll(req.Body)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(rawRequestBody))
+
+			passedResponse.HTTPResponse = &http.Response{StatusCode: statusCode, Header: header}
+			return ccerror.RawHTTPStatusError{StatusCode: statusCode}
+		}
+	}
+
+	BeforeEach(func() {
+		rawRequestBody = "banana pants"
+		response = &cloudcontroller.Response{}
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeRequestLoggerOutput)
+	})
+
+	DescribeTable("number of attempts",
+		func(requestMethod string, responseStatusCode int, expectedNumberOfAttempts int) {
+			request = newRequest(requestMethod)
+			respondWith(responseStatusCode, http.Header{})
+
+			err := NewRetryBackoff(2, time.Millisecond).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: responseStatusCode}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfAttempts))
+		},
+
+		Entry("maxRetries for Get (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodPut, http.StatusBadGateway, 3),
+		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
+
+		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
+		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
+		Entry("1 for Get (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 1),
+		Entry("1 for Get (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 1),
+		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+	)
+
+	It("does not retry on success", func() {
+		request = newRequest(http.MethodGet)
+
+		err := NewRetryBackoff(2, time.Millisecond).Wrap(fakeConnection).Make(request, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	It("does not retry when there is no response", func() {
+		request = newRequest(http.MethodGet)
+		fakeConnection.MakeReturns(errors.New("connection refused"))
+
+		err := NewRetryBackoff(2, time.Millisecond).Wrap(fakeConnection).Make(request, response)
+		Expect(err).To(MatchError("connection refused"))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	When("the response has a Retry-After header", func() {
+		BeforeEach(func() {
+			request = newRequest(http.MethodGet)
+			respondWith(http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+		})
+
+		It("waits as long as it asks for and displays the retries", func() {
+			start := time.Now()
+			err := NewRetryBackoff(1, time.Hour, fakeOutput).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+			Expect(fakeOutput.StartCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("RETRYING GET /v3/apps?page=2 in 0s after 429 Too Many Requests (retry 1 of 1)"))
+			Expect(fakeOutput.StopCallCount()).To(Equal(1))
+		})
+	})
+
+	When("a PipeSeekError is returned from ResetBody", func() {
+		BeforeEach(func() {
+			body, _ := cloudcontroller.NewPipeBomb()
+			req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", body)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, body)
+			response = &cloudcontroller.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+				},
+			}
+			fakeConnection.MakeReturns(errors.New("oh noes"))
+		})
+
+		It("sets the err on PipeSeekError", func() {
+			err := NewRetryBackoff(2, time.Millisecond).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(ccerror.PipeSeekError{Err: errors.New("oh noes")}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+})
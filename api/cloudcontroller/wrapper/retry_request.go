@@ -7,8 +7,9 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a 500
+// or 504 status code. 502 and 503 status codes are left to RetryBackoff, which
+// waits before retrying them.
 type RetryRequest struct {
 	maxRetries int
 	connection cloudcontroller.Connection
@@ -21,7 +22,7 @@ func NewRetryRequest(maxRetries int) *RetryRequest {
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 500 or 504 status code.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
@@ -54,12 +55,10 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 }
 
 // skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
+// code that is not one of following http status codes: 500, 504.
 func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
 	return httpMethod == http.MethodPost ||
 		response != nil &&
 			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
 			response.StatusCode != http.StatusGatewayTimeout
 }
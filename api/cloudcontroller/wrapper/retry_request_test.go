@@ -50,8 +50,6 @@ var _ = Describe("Retry Request", func() {
 		},
 
 		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
-		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
-		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
@@ -60,6 +58,8 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+		Entry("1 for Get (502) Bad Gateway, which RetryBackoff retries", http.MethodGet, http.StatusBadGateway, 1),
+		Entry("1 for Get (503) Service Unavailable, which RetryBackoff retries", http.MethodGet, http.StatusServiceUnavailable, 1),
 	)
 
 	It("does not retry on success", func() {
@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -35,6 +36,11 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock makes requests made at the same time, such as the pages of a
+	// list, check and refresh the token one at a time, so that it is
+	// refreshed only once.
+	tokenLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -50,14 +56,15 @@ func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	if request.Header.Get("Authorization") == "" && (t.cache.AccessToken() != "" || t.cache.RefreshToken() != "") {
-		// assert a valid access token for authenticated requests
-		err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if request.Header.Get("Authorization") == "" {
+		accessToken, authenticated, err := t.accessToken()
 		if nil != err {
 			return err
 		}
 
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		if authenticated {
+			request.Header.Set("Authorization", accessToken)
+		}
 	}
 
 	err := t.connection.Make(request, passedResponse)
@@ -75,6 +82,25 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 	return t
 }
 
+// accessToken returns a valid access token, refreshing it first if necessary.
+// It returns false when there are no tokens, for unauthenticated requests.
+func (t *UAAAuthentication) accessToken() (string, bool, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if t.cache.AccessToken() == "" && t.cache.RefreshToken() == "" {
+		return "", false, nil
+	}
+
+	// assert a valid access token for authenticated requests
+	err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if err != nil {
+		return "", false, err
+	}
+
+	return t.cache.AccessToken(), true, nil
+}
+
 // refreshToken refreshes the JWT access token if it is expired or about to expire.
 // If the access token is not yet expired, no action is performed.
 func (t *UAAAuthentication) refreshTokenIfNecessary(accessToken string) error {
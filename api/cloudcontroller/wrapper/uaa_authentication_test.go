@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -221,6 +222,59 @@ var _ = Describe("UAA Authentication", func() {
 			})
 
 		})
+
+		When("several requests are made at the same time with an expired access token", func() {
+			var (
+				requests       []*cloudcontroller.Request
+				newAccessToken string
+			)
+
+			BeforeEach(func() {
+				expiredAccessToken, err := buildTokenString(time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+				newAccessToken, err = buildTokenString(time.Now().AddDate(0, 1, 1))
+				Expect(err).ToNot(HaveOccurred())
+
+				inMemoryCache.SetAccessToken(expiredAccessToken)
+				inMemoryCache.SetRefreshToken("some refresh token")
+
+				fakeClient.RefreshAccessTokenStub = func(string) (uaa.RefreshedTokens, error) {
+					time.Sleep(10 * time.Millisecond)
+					return uaa.RefreshedTokens{
+						AccessToken:  newAccessToken,
+						RefreshToken: "newRefreshToken",
+						Type:         "bearer",
+					}, nil
+				}
+
+				requests = nil
+				for i := 0; i < 5; i++ {
+					requests = append(requests, &cloudcontroller.Request{
+						Request: &http.Request{Header: http.Header{}},
+					})
+				}
+			})
+
+			JustBeforeEach(func() {
+				var wg sync.WaitGroup
+				for _, request := range requests {
+					wg.Add(1)
+					go func(request *cloudcontroller.Request) {
+						defer wg.Done()
+						defer GinkgoRecover()
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+					}(request)
+				}
+				wg.Wait()
+			})
+
+			It("refreshes the token once and uses it for every request", func() {
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				for _, request := range requests {
+					Expect(request.Header.Get("Authorization")).To(ContainSubstring(newAccessToken))
+				}
+			})
+		})
 	})
 })
 
@@ -1,9 +1,11 @@
 package logcache
 
 import (
+	"bytes"
 	"fmt"
-	"net"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"runtime"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 	logcache "code.cloudfoundry.org/go-log-cache/v2"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/shared"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/util"
@@ -86,6 +89,18 @@ func (c *tokenHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	return c.c.Do(req)
 }
 
+// basePathHTTPClient prefixes request paths with the path of the Log Cache
+// endpoint, which the Log Cache client drops when building request URLs.
+type basePathHTTPClient struct {
+	c        logcache.HTTPClient
+	basePath string
+}
+
+func (c *basePathHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Path = c.basePath + req.URL.Path
+	return c.c.Do(req)
+}
+
 type httpDebugClient struct {
 	printer DebugPrinter
 	c       logcache.HTTPClient
@@ -107,13 +122,78 @@ func (c *httpDebugClient) Do(req *http.Request) (*http.Response, error) {
 
 // NewClient returns back a configured Log Cache Client.
 func NewClient(logCacheEndpoint string, config command.Config, ui command.UI, k8sConfigGetter v7action.KubernetesConfigGetter) (*logcache.Client, error) {
+	client, err := newHTTPClient(config, ui, k8sConfigGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint, err := url.Parse(logCacheEndpoint); err == nil {
+		if basePath := strings.TrimSuffix(endpoint.Path, "/"); basePath != "" {
+			client = &basePathHTTPClient{c: client, basePath: basePath}
+		}
+	}
+
+	return logcache.NewClient(
+		logCacheEndpoint,
+		logcache.WithHTTPClient(client),
+	), nil
+}
+
+// RawClient sends requests to Log Cache that the Log Cache client has no
+// method for, authenticated like the requests of the Log Cache client.
+type RawClient struct {
+	client logcache.HTTPClient
+}
+
+// NewRawClient returns back a configured RawClient.
+func NewRawClient(config command.Config, ui command.UI, k8sConfigGetter v7action.KubernetesConfigGetter) (*RawClient, error) {
+	client, err := newHTTPClient(config, ui, k8sConfigGetter)
+	if err != nil {
+		return nil, err
+	}
+	return &RawClient{client: client}, nil
+}
+
+// MakeRequestSendReceiveRaw sends a request to the URL with the headers and
+// body, and returns the body and HTTP response without interpreting them.
+// Responses with an error status are also returned as a RawHTTPStatusError.
+func (client *RawClient) MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error) {
+	request, err := http.NewRequest(method, url, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, values := range headers {
+		request.Header[name] = values
+	}
+
+	response, err := client.client.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+
+	rawResponse, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode >= 400 {
+		return rawResponse, response, ccerror.RawHTTPStatusError{
+			StatusCode:  response.StatusCode,
+			RawResponse: rawResponse,
+		}
+	}
+
+	return rawResponse, response, nil
+}
+
+// newHTTPClient returns an HTTP client that sets the user agent and the
+// authorization of the requests to Log Cache, and logs them when verbose.
+func newHTTPClient(config command.Config, ui command.UI, k8sConfigGetter v7action.KubernetesConfigGetter) (logcache.HTTPClient, error) {
 	var tr http.RoundTripper = &http.Transport{
 		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation()),
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   config.DialTimeout(),
-		}).DialContext,
+		TLSClientConfig: util.NewClientTLSConfig(config.SkipSSLValidation(), config.ClientCertificate()),
+		DialContext:     util.NewDialer(config.DialTimeout(), config.IPFamily(), config.ResolveOverrides()).DialContext,
 	}
 
 	if config.IsCFOnK8s() {
@@ -148,10 +228,7 @@ func NewClient(logCacheEndpoint string, config command.Config, ui command.UI, k8
 		}
 	}
 
-	return logcache.NewClient(
-		logCacheEndpoint,
-		logcache.WithHTTPClient(client),
-	), nil
+	return client, nil
 }
 
 func headersString(header http.Header) string {
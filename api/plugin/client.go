@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"runtime"
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
 )
 
 // Client is a client that can be used to make HTTP requests to plugin
@@ -25,6 +27,14 @@ type Config struct {
 	// infinite.
 	DialTimeout time.Duration
 
+	// IPFamily is the address family that connections are attempted over
+	// first when a host has both IPv4 and IPv6 addresses.
+	IPFamily util.IPFamily
+
+	// ResolveOverrides are the addresses that connections to specific hosts
+	// and ports are made to instead of resolving the hosts.
+	ResolveOverrides []util.ResolveOverride
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -46,7 +56,7 @@ func NewClient(config Config) *Client {
 	)
 	client := Client{
 		userAgent:  userAgent,
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout, config.IPFamily, config.ResolveOverrides),
 	}
 
 	return &client
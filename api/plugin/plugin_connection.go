@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"time"
@@ -23,14 +22,11 @@ type PluginConnection struct {
 }
 
 // NewConnection returns a new PluginConnection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *PluginConnection {
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration, ipFamily util.IPFamily, resolveOverrides []util.ResolveOverride) *PluginConnection {
 	tr := &http.Transport{
 		TLSClientConfig: util.NewTLSConfig(nil, skipSSLValidation),
 		Proxy:           http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   dialTimeout,
-		}).DialContext,
+		DialContext:     util.NewDialer(dialTimeout, ipFamily, resolveOverrides).DialContext,
 	}
 
 	return &PluginConnection{
@@ -11,6 +11,7 @@ import (
 	. "code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -29,7 +30,7 @@ var _ = Describe("Plugin Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, 0)
+		connection = NewConnection(true, 0, util.IPFamilyAny, nil)
 		fakeProxyReader = new(pluginfakes.FakeProxyReader)
 
 		fakeProxyReader.WrapStub = func(reader io.Reader) io.ReadCloser {
@@ -135,7 +136,7 @@ var _ = Describe("Plugin Connection", func() {
 		Describe("Request errors", func() {
 			When("the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, 0)
+					connection = NewConnection(false, 0, util.IPFamilyAny, nil)
 				})
 
 				It("returns a RequestError", func() {
@@ -164,7 +165,7 @@ var _ = Describe("Plugin Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(false, 0, util.IPFamilyAny, nil)
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -190,7 +191,7 @@ var _ = Describe("Plugin Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(false, 0, util.IPFamilyAny, nil)
 					})
 
 					// loopback.cli.fun is a custom DNS record setup to point to 127.0.0.1
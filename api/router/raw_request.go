@@ -0,0 +1,30 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// MakeRequestSendReceiveRaw sends a request to the URL with the headers and
+// body, and returns the body and HTTP response without interpreting them.
+// Headers given replace the defaults that the client sets.
+func (client Client) MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error) {
+	body := bytes.NewReader(requestBody)
+	request, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Connection", "close")
+	request.Header.Set("User-Agent", client.userAgent)
+	for name, values := range headers {
+		request.Header[name] = values
+	}
+
+	response := Response{}
+	err = client.connection.Make(NewRequest(request, body), &response)
+
+	return response.RawResponse, response.HTTPResponse, err
+}
@@ -0,0 +1,39 @@
+package router_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/router"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("MakeRequestSendReceiveRaw", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestRouterClient(NewTestConfig())
+	})
+
+	It("sends the request with the headers and returns the raw response", func() {
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/routing/v1/tcp_routes"),
+				VerifyHeaderKV("Content-Type", "application/json"),
+				VerifyHeaderKV("X-Custom", "value"),
+				RespondWith(http.StatusOK, `[]`),
+			),
+		)
+
+		body, response, err := client.MakeRequestSendReceiveRaw(
+			http.MethodGet,
+			server.URL()+"/routing/v1/tcp_routes",
+			http.Header{"X-Custom": {"value"}},
+			nil,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`[]`))
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+})
@@ -5,7 +5,6 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"time"
@@ -17,7 +16,10 @@ import (
 
 // ConnectionConfig is for configuring the RouterConnection
 type ConnectionConfig struct {
+	ClientCertificate util.ClientCertificate
 	DialTimeout       time.Duration
+	IPFamily          util.IPFamily
+	ResolveOverrides  []util.ResolveOverride
 	SkipSSLValidation bool
 }
 
@@ -29,12 +31,9 @@ type RouterConnection struct {
 // NewConnection returns a pointer to a new RouterConnection with the provided configuration
 func NewConnection(config ConnectionConfig) *RouterConnection {
 	tr := &http.Transport{
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation),
+		TLSClientConfig: util.NewClientTLSConfig(config.SkipSSLValidation, config.ClientCertificate),
 		Proxy:           http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   config.DialTimeout,
-		}).DialContext,
+		DialContext:     util.NewDialer(config.DialTimeout, config.IPFamily, config.ResolveOverrides).DialContext,
 	}
 
 	return &RouterConnection{
@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/util/requeststats"
+)
+
+// RequestStats is the wrapper that records the requests to the routing API
+// for the --stats summary.
+type RequestStats struct {
+	connection router.Connection
+	collector  *requeststats.Collector
+}
+
+// NewRequestStats returns a pointer to a RequestStats wrapper.
+func NewRequestStats(collector *requeststats.Collector) *RequestStats {
+	return &RequestStats{
+		collector: collector,
+	}
+}
+
+// Make records how long the request took and how much data it received.
+func (stats *RequestStats) Make(request *router.Request, passedResponse *router.Response) error {
+	start := time.Now()
+	err := stats.connection.Make(request, passedResponse)
+	stats.collector.Record(request.Request, int64(len(passedResponse.RawResponse)), time.Since(start))
+	return err
+}
+
+// Wrap sets the connection in the RequestStats and returns itself.
+func (stats *RequestStats) Wrap(innerconnection router.Connection) router.Connection {
+	stats.connection = innerconnection
+	return stats
+}
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+)
+
+// ConnectionTrace records the addresses that a request's host resolved to and
+// the address of the connection that the request was sent over.
+type ConnectionTrace struct {
+	mutex      sync.Mutex
+	resolved   []string
+	remoteAddr string
+	reused     bool
+}
+
+// TraceConnection returns a copy of the request that records its connection
+// in the returned ConnectionTrace.
+func TraceConnection(request *http.Request) (*http.Request, *ConnectionTrace) {
+	connectionTrace := new(ConnectionTrace)
+	clientTrace := &httptrace.ClientTrace{
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			connectionTrace.mutex.Lock()
+			defer connectionTrace.mutex.Unlock()
+			for _, addr := range info.Addrs {
+				connectionTrace.resolved = append(connectionTrace.resolved, addr.String())
+			}
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			connectionTrace.mutex.Lock()
+			defer connectionTrace.mutex.Unlock()
+			if info.Conn != nil {
+				connectionTrace.remoteAddr = info.Conn.RemoteAddr().String()
+			}
+			connectionTrace.reused = info.Reused
+		},
+	}
+
+	return request.WithContext(httptrace.WithClientTrace(request.Context(), clientTrace)), connectionTrace
+}
+
+// String describes the recorded connection, or returns an empty string when
+// no connection was recorded.
+func (connectionTrace *ConnectionTrace) String() string {
+	connectionTrace.mutex.Lock()
+	defer connectionTrace.mutex.Unlock()
+
+	if connectionTrace.remoteAddr == "" {
+		return ""
+	}
+
+	description := fmt.Sprintf("Connected to %s", connectionTrace.remoteAddr)
+	if connectionTrace.reused {
+		description = fmt.Sprintf("Reused connection to %s", connectionTrace.remoteAddr)
+	}
+	if len(connectionTrace.resolved) > 0 {
+		description += fmt.Sprintf(", resolved from %s", strings.Join(connectionTrace.resolved, ", "))
+	}
+	return fmt.Sprintf("[%s]", description)
+}
@@ -0,0 +1,67 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("TraceConnection", func() {
+	var (
+		server *ghttp.Server
+		client *http.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AllowUnhandledRequests = true
+		client = &http.Client{Transport: &http.Transport{}}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	send := func() *shared.ConnectionTrace {
+		request, err := http.NewRequest(http.MethodGet, strings.Replace(server.URL(), "127.0.0.1", "localhost", 1), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		request, connectionTrace := shared.TraceConnection(request)
+		response, err := client.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body.Close()).To(Succeed())
+
+		return connectionTrace
+	}
+
+	It("records the resolved addresses and the connected address", func() {
+		connectionTrace := send()
+		Expect(connectionTrace.String()).To(MatchRegexp(`^\[Connected to .+, resolved from .+\]$`))
+		Expect(connectionTrace.String()).To(ContainSubstring(server.Addr()))
+	})
+
+	When("the connection is reused", func() {
+		It("says so and does not resolve the host again", func() {
+			send()
+			Expect(send().String()).To(Equal("[Reused connection to " + server.Addr() + "]"))
+		})
+	})
+
+	When("no request was sent", func() {
+		It("describes nothing", func() {
+			request, err := http.NewRequest(http.MethodGet, server.URL(), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, connectionTrace := shared.TraceConnection(request)
+			Expect(connectionTrace.String()).To(BeEmpty())
+		})
+	})
+})
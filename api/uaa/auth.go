@@ -60,7 +60,7 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	if grantType == constant.GrantTypePassword || grantType == constant.GrantTypeDeviceCode {
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
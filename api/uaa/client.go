@@ -36,7 +36,7 @@ func NewClient(config Config) *Client {
 	client := Client{
 		config: config,
 
-		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
+		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout(), config.IPFamily(), config.ResolveOverrides(), config.ClientCertificate()),
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
@@ -1,6 +1,10 @@
 package uaa
 
-import "time"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Config
 
@@ -12,10 +16,22 @@ type Config interface {
 	// BinaryVersion is the version of the application/process using the client.
 	BinaryVersion() string
 
+	// ClientCertificate is the certificate and private key presented to
+	// servers that require mutual TLS.
+	ClientCertificate() util.ClientCertificate
+
 	// DialTimeout is the DNS lookup timeout for the client. If not set, it is
 	// infinite.
 	DialTimeout() time.Duration
 
+	// IPFamily is the address family that connections are attempted over
+	// first when a host has both IPv4 and IPv6 addresses.
+	IPFamily() util.IPFamily
+
+	// ResolveOverrides are the addresses that connections to specific hosts
+	// and ports are made to instead of resolving the hosts.
+	ResolveOverrides() []util.ResolveOverride
+
 	// SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 	// <AuthorizationEndpoint>/login.
 	SetUAAEndpoint(uaaEndpoint string)
@@ -0,0 +1,51 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization is the response of UAA to a device authorization
+// request. The user approves the device by entering the user code at the
+// verification URI, while the CLI polls for the tokens with the device code.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the lifetime of the device code in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds between two polls.
+	Interval int `json:"interval"`
+}
+
+// RequestDeviceAuthorization starts an OAuth 2.0 device authorization grant
+// for the CLI client.
+func (client Client) RequestDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
@@ -45,8 +45,17 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 
 	switch rawHTTPStatusErr.StatusCode {
 	case http.StatusBadRequest: // 400
-		if uaaErrorResponse.Type == "invalid_scim_resource" {
+		switch uaaErrorResponse.Type {
+		case "invalid_scim_resource":
 			return InvalidSCIMResourceError{Message: uaaErrorResponse.Description}
+		case "authorization_pending":
+			return AuthorizationPendingError{}
+		case "slow_down":
+			return SlowDownError{}
+		case "expired_token":
+			return ExpiredDeviceCodeError{}
+		case "access_denied":
+			return AccessDeniedError{Message: uaaErrorResponse.Description}
 		}
 		return rawHTTPStatusErr
 	case http.StatusUnauthorized: // 401
@@ -1,11 +1,13 @@
 package uaa_test
 
 import (
+	"fmt"
 	"net/http"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -83,6 +85,22 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("device authorization errors", func() {
+					DescribeTable("returns the device authorization error",
+						func(errorType string, expectedErr error) {
+							fakeConnectionErr.RawResponse = []byte(fmt.Sprintf(`{"error": %q, "error_description": "some description"}`, errorType))
+							fakeConnection.MakeReturns(fakeConnectionErr)
+
+							makeErr = wrapper.Make(request, response)
+							Expect(makeErr).To(MatchError(expectedErr))
+						},
+						Entry("authorization pending", "authorization_pending", AuthorizationPendingError{}),
+						Entry("slow down", "slow_down", SlowDownError{}),
+						Entry("expired token", "expired_token", ExpiredDeviceCodeError{}),
+						Entry("access denied", "access_denied", AccessDeniedError{Message: "some description"}),
+					)
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
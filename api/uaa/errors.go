@@ -104,3 +104,37 @@ type InvalidPasswordError struct {
 func (e InvalidPasswordError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned while polling for the tokens of a
+// device authorization grant that the user has not approved yet.
+type AuthorizationPendingError struct{}
+
+func (e AuthorizationPendingError) Error() string {
+	return "The device authorization is pending."
+}
+
+// SlowDownError is returned when a device authorization grant is polled too
+// often.
+type SlowDownError struct{}
+
+func (e SlowDownError) Error() string {
+	return "The device authorization is polled too often."
+}
+
+// ExpiredDeviceCodeError is returned when the device code of a device
+// authorization grant has expired before the user approved it.
+type ExpiredDeviceCodeError struct{}
+
+func (e ExpiredDeviceCodeError) Error() string {
+	return "The device code has expired."
+}
+
+// AccessDeniedError is returned when the user declined a device authorization
+// grant.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
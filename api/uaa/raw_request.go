@@ -0,0 +1,29 @@
+package uaa
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// MakeRequestSendReceiveRaw sends a request to the URL with the headers and
+// body, and returns the body and HTTP response without interpreting them.
+// Headers given replace the defaults that the client sets.
+func (client *Client) MakeRequestSendReceiveRaw(method string, url string, headers http.Header, requestBody []byte) ([]byte, *http.Response, error) {
+	request, err := client.newRequest(requestOptions{
+		Method: method,
+		URL:    url,
+		Body:   bytes.NewReader(requestBody),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name, values := range headers {
+		request.Header[name] = values
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.RawResponse, response.HTTPResponse, err
+}
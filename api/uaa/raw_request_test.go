@@ -0,0 +1,51 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("MakeRequestSendReceiveRaw", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewClient(NewTestConfig())
+	})
+
+	It("sends the request with the headers and returns the raw response", func() {
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/Users", "filter=userName"),
+				VerifyHeaderKV("Accept", "text/plain"),
+				VerifyHeaderKV("X-Custom", "value"),
+				VerifyBody([]byte(`{"some":"body"}`)),
+				RespondWith(http.StatusCreated, `{"id":"user-guid"}`),
+			),
+		)
+
+		body, response, err := client.MakeRequestSendReceiveRaw(
+			http.MethodPost,
+			server.URL()+"/Users?filter=userName",
+			http.Header{"Accept": {"text/plain"}, "X-Custom": {"value"}},
+			[]byte(`{"some":"body"}`),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"id":"user-guid"}`))
+		Expect(response.StatusCode).To(Equal(http.StatusCreated))
+	})
+
+	When("UAA responds with an error", func() {
+		It("returns the response along with the error", func() {
+			server.AppendHandlers(RespondWith(http.StatusNotFound, `{"error":"not_found"}`))
+
+			body, response, err := client.MakeRequestSendReceiveRaw(http.MethodGet, server.URL()+"/Users/missing", nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"error":"not_found"}`))
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -5,7 +5,6 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/url"
 	"time"
@@ -20,15 +19,12 @@ type UAAConnection struct {
 }
 
 // NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration) *UAAConnection {
+func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration, ipFamily util.IPFamily, resolveOverrides []util.ResolveOverride, clientCertificate util.ClientCertificate) *UAAConnection {
 	tr := &http.Transport{
-		DialContext: (&net.Dialer{
-			KeepAlive: 30 * time.Second,
-			Timeout:   dialTimeout,
-		}).DialContext,
+		DialContext:       util.NewDialer(dialTimeout, ipFamily, resolveOverrides).DialContext,
 		DisableKeepAlives: disableKeepAlives,
 		Proxy:             http.ProxyFromEnvironment,
-		TLSClientConfig:   util.NewTLSConfig(nil, skipSSLValidation),
+		TLSClientConfig:   util.NewClientTLSConfig(skipSSLValidation, clientCertificate),
 	}
 
 	return &UAAConnection{
@@ -6,6 +6,7 @@ import (
 	"runtime"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -23,7 +24,7 @@ var _ = Describe("UAA Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, true, 0)
+		connection = NewConnection(true, true, 0, util.IPFamilyAny, nil, util.ClientCertificate{})
 	})
 
 	Describe("Make", func() {
@@ -101,7 +102,7 @@ var _ = Describe("UAA Connection", func() {
 		Describe("Errors", func() {
 			When("the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, true, 0)
+					connection = NewConnection(false, true, 0, util.IPFamilyAny, nil, util.ClientCertificate{})
 				})
 
 				It("returns a RequestError", func() {
@@ -130,7 +131,7 @@ var _ = Describe("UAA Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, true, 0)
+						connection = NewConnection(false, true, 0, util.IPFamilyAny, nil, util.ClientCertificate{})
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util"
 )
 
 type FakeConfig struct {
@@ -29,6 +30,16 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ClientCertificateStub        func() util.ClientCertificate
+	clientCertificateMutex       sync.RWMutex
+	clientCertificateArgsForCall []struct {
+	}
+	clientCertificateReturns struct {
+		result1 util.ClientCertificate
+	}
+	clientCertificateReturnsOnCall map[int]struct {
+		result1 util.ClientCertificate
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct {
@@ -39,6 +50,26 @@ type FakeConfig struct {
 	dialTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	IPFamilyStub        func() util.IPFamily
+	iPFamilyMutex       sync.RWMutex
+	iPFamilyArgsForCall []struct {
+	}
+	iPFamilyReturns struct {
+		result1 util.IPFamily
+	}
+	iPFamilyReturnsOnCall map[int]struct {
+		result1 util.IPFamily
+	}
+	ResolveOverridesStub        func() []util.ResolveOverride
+	resolveOverridesMutex       sync.RWMutex
+	resolveOverridesArgsForCall []struct {
+	}
+	resolveOverridesReturns struct {
+		result1 []util.ResolveOverride
+	}
+	resolveOverridesReturnsOnCall map[int]struct {
+		result1 []util.ResolveOverride
+	}
 	SetUAAEndpointStub        func(string)
 	setUAAEndpointMutex       sync.RWMutex
 	setUAAEndpointArgsForCall []struct {
@@ -202,6 +233,58 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ClientCertificate() util.ClientCertificate {
+	fake.clientCertificateMutex.Lock()
+	ret, specificReturn := fake.clientCertificateReturnsOnCall[len(fake.clientCertificateArgsForCall)]
+	fake.clientCertificateArgsForCall = append(fake.clientCertificateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClientCertificate", []interface{}{})
+	fake.clientCertificateMutex.Unlock()
+	if fake.ClientCertificateStub != nil {
+		return fake.ClientCertificateStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clientCertificateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ClientCertificateCallCount() int {
+	fake.clientCertificateMutex.RLock()
+	defer fake.clientCertificateMutex.RUnlock()
+	return len(fake.clientCertificateArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertificateCalls(stub func() util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = stub
+}
+
+func (fake *FakeConfig) ClientCertificateReturns(result1 util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = nil
+	fake.clientCertificateReturns = struct {
+		result1 util.ClientCertificate
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertificateReturnsOnCall(i int, result1 util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = nil
+	if fake.clientCertificateReturnsOnCall == nil {
+		fake.clientCertificateReturnsOnCall = make(map[int]struct {
+			result1 util.ClientCertificate
+		})
+	}
+	fake.clientCertificateReturnsOnCall[i] = struct {
+		result1 util.ClientCertificate
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -254,6 +337,110 @@ func (fake *FakeConfig) DialTimeoutReturnsOnCall(i int, result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IPFamily() util.IPFamily {
+	fake.iPFamilyMutex.Lock()
+	ret, specificReturn := fake.iPFamilyReturnsOnCall[len(fake.iPFamilyArgsForCall)]
+	fake.iPFamilyArgsForCall = append(fake.iPFamilyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IPFamily", []interface{}{})
+	fake.iPFamilyMutex.Unlock()
+	if fake.IPFamilyStub != nil {
+		return fake.IPFamilyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iPFamilyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) IPFamilyCallCount() int {
+	fake.iPFamilyMutex.RLock()
+	defer fake.iPFamilyMutex.RUnlock()
+	return len(fake.iPFamilyArgsForCall)
+}
+
+func (fake *FakeConfig) IPFamilyCalls(stub func() util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = stub
+}
+
+func (fake *FakeConfig) IPFamilyReturns(result1 util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = nil
+	fake.iPFamilyReturns = struct {
+		result1 util.IPFamily
+	}{result1}
+}
+
+func (fake *FakeConfig) IPFamilyReturnsOnCall(i int, result1 util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = nil
+	if fake.iPFamilyReturnsOnCall == nil {
+		fake.iPFamilyReturnsOnCall = make(map[int]struct {
+			result1 util.IPFamily
+		})
+	}
+	fake.iPFamilyReturnsOnCall[i] = struct {
+		result1 util.IPFamily
+	}{result1}
+}
+
+func (fake *FakeConfig) ResolveOverrides() []util.ResolveOverride {
+	fake.resolveOverridesMutex.Lock()
+	ret, specificReturn := fake.resolveOverridesReturnsOnCall[len(fake.resolveOverridesArgsForCall)]
+	fake.resolveOverridesArgsForCall = append(fake.resolveOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResolveOverrides", []interface{}{})
+	fake.resolveOverridesMutex.Unlock()
+	if fake.ResolveOverridesStub != nil {
+		return fake.ResolveOverridesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resolveOverridesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ResolveOverridesCallCount() int {
+	fake.resolveOverridesMutex.RLock()
+	defer fake.resolveOverridesMutex.RUnlock()
+	return len(fake.resolveOverridesArgsForCall)
+}
+
+func (fake *FakeConfig) ResolveOverridesCalls(stub func() []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = stub
+}
+
+func (fake *FakeConfig) ResolveOverridesReturns(result1 []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = nil
+	fake.resolveOverridesReturns = struct {
+		result1 []util.ResolveOverride
+	}{result1}
+}
+
+func (fake *FakeConfig) ResolveOverridesReturnsOnCall(i int, result1 []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = nil
+	if fake.resolveOverridesReturnsOnCall == nil {
+		fake.resolveOverridesReturnsOnCall = make(map[int]struct {
+			result1 []util.ResolveOverride
+		})
+	}
+	fake.resolveOverridesReturnsOnCall[i] = struct {
+		result1 []util.ResolveOverride
+	}{result1}
+}
+
 func (fake *FakeConfig) SetUAAEndpoint(arg1 string) {
 	fake.setUAAEndpointMutex.Lock()
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
@@ -552,8 +739,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.clientCertificateMutex.RLock()
+	defer fake.clientCertificateMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
+	fake.iPFamilyMutex.RLock()
+	defer fake.iPFamilyMutex.RUnlock()
+	fake.resolveOverridesMutex.RLock()
+	defer fake.resolveOverridesMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/shared"
 	"code.cloudfoundry.org/cli/api/uaa"
 )
 
@@ -19,6 +20,7 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
+	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
@@ -48,10 +50,11 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *uaa.Res
 		logger.output.HandleInternalError(err)
 	}
 
-	err = logger.connection.Make(request, passedResponse)
+	tracedRequest, connectionTrace := shared.TraceConnection(request)
+	err = logger.connection.Make(tracedRequest, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, connectionTrace)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -111,7 +114,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response, connectionTrace *shared.ConnectionTrace) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -126,6 +129,12 @@ func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error
 	if err != nil {
 		return err
 	}
+	if connection := connectionTrace.String(); connection != "" {
+		err = logger.output.DisplayMessage(connection)
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.displaySortedHeaders(passedResponse.HTTPResponse.Header)
 	if err != nil {
 		return err
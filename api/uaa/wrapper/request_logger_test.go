@@ -5,7 +5,9 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"time"
 
@@ -359,6 +361,24 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		When("the connection is traced", func() {
+			BeforeEach(func() {
+				response.HTTPResponse = &http.Response{Proto: "HTTP/1.1", Status: "200 OK"}
+				fakeConnection.MakeStub = func(request *http.Request, _ *uaa.Response) error {
+					trace := httptrace.ContextClientTrace(request.Context())
+					trace.DNSDone(httptrace.DNSDoneInfo{Addrs: []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}}})
+					trace.GotConn(httptrace.GotConnInfo{Conn: tracedConn{remoteAddr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 443}}})
+					return nil
+				}
+			})
+
+			It("outputs the addresses of the connection with the response", func() {
+				Expect(makeErr).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Connected to 192.0.2.1:443, resolved from 192.0.2.1]"))
+			})
+		})
+
 		It("starts and stops the output", func() {
 			Expect(makeErr).ToNot(HaveOccurred())
 			Expect(fakeOutput.StartCallCount()).To(Equal(2))
@@ -383,3 +403,12 @@ var _ = Describe("Request Logger", func() {
 		})
 	})
 })
+
+type tracedConn struct {
+	net.Conn
+	remoteAddr net.Addr
+}
+
+func (conn tracedConn) RemoteAddr() net.Addr {
+	return conn.remoteAddr
+}
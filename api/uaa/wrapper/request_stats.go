@@ -0,0 +1,37 @@
+package wrapper
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/requeststats"
+)
+
+// RequestStats is the wrapper that records the requests to the UAA for
+// the --stats summary.
+type RequestStats struct {
+	connection uaa.Connection
+	collector  *requeststats.Collector
+}
+
+// NewRequestStats returns a pointer to a RequestStats wrapper.
+func NewRequestStats(collector *requeststats.Collector) *RequestStats {
+	return &RequestStats{
+		collector: collector,
+	}
+}
+
+// Make records how long the request took and how much data it received.
+func (stats *RequestStats) Make(request *http.Request, passedResponse *uaa.Response) error {
+	start := time.Now()
+	err := stats.connection.Make(request, passedResponse)
+	stats.collector.Record(request, int64(len(passedResponse.RawResponse)), time.Since(start))
+	return err
+}
+
+// Wrap sets the connection in the RequestStats and returns itself.
+func (stats *RequestStats) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	stats.connection = innerconnection
+	return stats
+}
@@ -54,6 +54,17 @@ type FakeRequestLoggerOutput struct {
 	displayJSONBodyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayMessageStub        func(string) error
+	displayMessageMutex       sync.RWMutex
+	displayMessageArgsForCall []struct {
+		arg1 string
+	}
+	displayMessageReturns struct {
+		result1 error
+	}
+	displayMessageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayRequestHeaderStub        func(string, string, string) error
 	displayRequestHeaderMutex       sync.RWMutex
 	displayRequestHeaderArgsForCall []struct {
@@ -371,6 +382,66 @@ func (fake *FakeRequestLoggerOutput) DisplayJSONBodyReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayMessage(arg1 string) error {
+	fake.displayMessageMutex.Lock()
+	ret, specificReturn := fake.displayMessageReturnsOnCall[len(fake.displayMessageArgsForCall)]
+	fake.displayMessageArgsForCall = append(fake.displayMessageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DisplayMessage", []interface{}{arg1})
+	fake.displayMessageMutex.Unlock()
+	if fake.DisplayMessageStub != nil {
+		return fake.DisplayMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayMessageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageCallCount() int {
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
+	return len(fake.displayMessageArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageCalls(stub func(string) error) {
+	fake.displayMessageMutex.Lock()
+	defer fake.displayMessageMutex.Unlock()
+	fake.DisplayMessageStub = stub
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageArgsForCall(i int) string {
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
+	argsForCall := fake.displayMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageReturns(result1 error) {
+	fake.displayMessageMutex.Lock()
+	defer fake.displayMessageMutex.Unlock()
+	fake.DisplayMessageStub = nil
+	fake.displayMessageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageReturnsOnCall(i int, result1 error) {
+	fake.displayMessageMutex.Lock()
+	defer fake.displayMessageMutex.Unlock()
+	fake.DisplayMessageStub = nil
+	if fake.displayMessageReturnsOnCall == nil {
+		fake.displayMessageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayMessageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayRequestHeader(arg1 string, arg2 string, arg3 string) error {
 	fake.displayRequestHeaderMutex.Lock()
 	ret, specificReturn := fake.displayRequestHeaderReturnsOnCall[len(fake.displayRequestHeaderArgsForCall)]
@@ -701,6 +772,8 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayHostMutex.RUnlock()
 	fake.displayJSONBodyMutex.RLock()
 	defer fake.displayJSONBodyMutex.RUnlock()
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
 	fake.displayRequestHeaderMutex.RLock()
 	defer fake.displayRequestHeaderMutex.RUnlock()
 	fake.displayResponseHeaderMutex.RLock()
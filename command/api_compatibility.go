@@ -0,0 +1,77 @@
+package command
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+)
+
+// APIFeature is a feature of the CLI that is only available on Cloud
+// Controller API versions at or above MinimumVersion.
+type APIFeature struct {
+	Name           string
+	MinimumVersion string
+}
+
+// APIFeatures are the features checked for by CheckAPICompatibility.
+var APIFeatures = []APIFeature{
+	{Name: "Space-scoped service brokers", MinimumVersion: ccversion.MinVersionCreateSpaceScopedServiceBrokerV3},
+	{Name: "HTTP/2 routes", MinimumVersion: ccversion.MinVersionHTTP2RoutingV3},
+	{Name: "Space supporter role", MinimumVersion: ccversion.MinVersionSpaceSupporterV3},
+	{Name: "Log rate limits", MinimumVersion: ccversion.MinVersionLogRateLimitingV3},
+}
+
+// APICompatibility is how well this CLI works with a Cloud Controller API
+// version.
+type APICompatibility struct {
+	APIVersion        string
+	MinimumVersion    string
+	MaximumVersion    string
+	Incompatibilities []string
+}
+
+// CheckAPICompatibility compares apiVersion with the API versions this CLI
+// supports and with the versions that its features need. An empty apiVersion
+// has no incompatibilities.
+func CheckAPICompatibility(apiVersion string) (APICompatibility, error) {
+	compatibility := APICompatibility{
+		APIVersion:        apiVersion,
+		MinimumVersion:    ccversion.MinSupportedClientVersionV8,
+		MaximumVersion:    ccversion.MaxSupportedClientVersionV8,
+		Incompatibilities: []string{},
+	}
+	if apiVersion == "" {
+		return compatibility, nil
+	}
+
+	tooOld, err := CheckVersionOutdated(apiVersion, compatibility.MinimumVersion)
+	if err != nil {
+		return APICompatibility{}, err
+	}
+	if tooOld {
+		compatibility.Incompatibilities = append(compatibility.Incompatibilities,
+			fmt.Sprintf("API version %s is older than the minimum supported version %s", apiVersion, compatibility.MinimumVersion))
+	}
+
+	tooNew, err := CheckVersionOutdated(compatibility.MaximumVersion, apiVersion)
+	if err != nil {
+		return APICompatibility{}, err
+	}
+	if tooNew {
+		compatibility.Incompatibilities = append(compatibility.Incompatibilities,
+			fmt.Sprintf("API version %s is newer than the maximum supported version %s, so some commands might not work as expected", apiVersion, compatibility.MaximumVersion))
+	}
+
+	for _, feature := range APIFeatures {
+		unavailable, err := CheckVersionOutdated(apiVersion, feature.MinimumVersion)
+		if err != nil {
+			return APICompatibility{}, err
+		}
+		if unavailable {
+			compatibility.Incompatibilities = append(compatibility.Incompatibilities,
+				fmt.Sprintf("%s: requires API version %s or later", feature.Name, feature.MinimumVersion))
+		}
+	}
+
+	return compatibility, nil
+}
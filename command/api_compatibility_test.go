@@ -0,0 +1,64 @@
+package command_test
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	. "code.cloudfoundry.org/cli/command"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckAPICompatibility", func() {
+	It("reports the supported API versions", func() {
+		compatibility, err := CheckAPICompatibility("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compatibility.MinimumVersion).To(Equal(ccversion.MinSupportedClientVersionV8))
+		Expect(compatibility.MaximumVersion).To(Equal(ccversion.MaxSupportedClientVersionV8))
+		Expect(compatibility.Incompatibilities).To(BeEmpty())
+	})
+
+	When("the API version supports every feature", func() {
+		It("has no incompatibilities", func() {
+			compatibility, err := CheckAPICompatibility(ccversion.MaxSupportedClientVersionV8)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(compatibility.APIVersion).To(Equal(ccversion.MaxSupportedClientVersionV8))
+			Expect(compatibility.Incompatibilities).To(BeEmpty())
+		})
+	})
+
+	When("the API version is below some feature minimums", func() {
+		It("lists the unavailable features", func() {
+			compatibility, err := CheckAPICompatibility("3.110.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(compatibility.Incompatibilities).To(ConsistOf(
+				"Log rate limits: requires API version " + ccversion.MinVersionLogRateLimitingV3 + " or later",
+			))
+		})
+	})
+
+	When("the API version is below the minimum supported version", func() {
+		It("reports that it is too old", func() {
+			compatibility, err := CheckAPICompatibility("3.80.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(compatibility.Incompatibilities[0]).To(Equal("API version 3.80.0 is older than the minimum supported version " + ccversion.MinSupportedClientVersionV8))
+			Expect(compatibility.Incompatibilities).To(ContainElement("HTTP/2 routes: requires API version " + ccversion.MinVersionHTTP2RoutingV3 + " or later"))
+		})
+	})
+
+	When("the API version is above the maximum supported version", func() {
+		It("reports that it is too new", func() {
+			compatibility, err := CheckAPICompatibility("4.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(compatibility.Incompatibilities).To(ConsistOf(
+				"API version 4.0.0 is newer than the maximum supported version " + ccversion.MaxSupportedClientVersionV8 + ", so some commands might not work as expected",
+			))
+		})
+	})
+
+	When("the API version is not a semantic version", func() {
+		It("returns an error", func() {
+			_, err := CheckAPICompatibility("not-a-version")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
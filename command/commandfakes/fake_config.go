@@ -6,10 +6,22 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/requeststats"
 )
 
 type FakeConfig struct {
+	APIBaseURLStub        func() string
+	aPIBaseURLMutex       sync.RWMutex
+	aPIBaseURLArgsForCall []struct {
+	}
+	aPIBaseURLReturns struct {
+		result1 string
+	}
+	aPIBaseURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct {
@@ -71,6 +83,17 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	BrokerCatalogSnapshotPathStub        func(string) string
+	brokerCatalogSnapshotPathMutex       sync.RWMutex
+	brokerCatalogSnapshotPathArgsForCall []struct {
+		arg1 string
+	}
+	brokerCatalogSnapshotPathReturns struct {
+		result1 string
+	}
+	brokerCatalogSnapshotPathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct {
@@ -91,6 +114,20 @@ type FakeConfig struct {
 	cFUsernameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ClearResolveOverridesStub        func()
+	clearResolveOverridesMutex       sync.RWMutex
+	clearResolveOverridesArgsForCall []struct {
+	}
+	ClientCertificateStub        func() util.ClientCertificate
+	clientCertificateMutex       sync.RWMutex
+	clientCertificateArgsForCall []struct {
+	}
+	clientCertificateReturns struct {
+		result1 util.ClientCertificate
+	}
+	clientCertificateReturnsOnCall map[int]struct {
+		result1 util.ClientCertificate
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -101,6 +138,16 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CredentialHelperStub        func() string
+	credentialHelperMutex       sync.RWMutex
+	credentialHelperArgsForCall []struct {
+	}
+	credentialHelperReturns struct {
+		result1 string
+	}
+	credentialHelperReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -155,6 +202,18 @@ type FakeConfig struct {
 	experimentalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ExperimentalFeatureEnabledStub        func(string, bool) bool
+	experimentalFeatureEnabledMutex       sync.RWMutex
+	experimentalFeatureEnabledArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	experimentalFeatureEnabledReturns struct {
+		result1 bool
+	}
+	experimentalFeatureEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -201,6 +260,16 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IPFamilyStub        func() util.IPFamily
+	iPFamilyMutex       sync.RWMutex
+	iPFamilyArgsForCall []struct {
+	}
+	iPFamilyReturns struct {
+		result1 util.IPFamily
+	}
+	iPFamilyReturnsOnCall map[int]struct {
+		result1 util.IPFamily
+	}
 	IsCFOnK8sStub        func() bool
 	isCFOnK8sMutex       sync.RWMutex
 	isCFOnK8sArgsForCall []struct {
@@ -241,6 +310,27 @@ type FakeConfig struct {
 	logCacheEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogGroupStyleStub        func() configv3.LogGroupStyle
+	logGroupStyleMutex       sync.RWMutex
+	logGroupStyleArgsForCall []struct {
+	}
+	logGroupStyleReturns struct {
+		result1 configv3.LogGroupStyle
+	}
+	logGroupStyleReturnsOnCall map[int]struct {
+		result1 configv3.LogGroupStyle
+	}
+	ManifestSnapshotPathStub        func(string) string
+	manifestSnapshotPathMutex       sync.RWMutex
+	manifestSnapshotPathArgsForCall []struct {
+		arg1 string
+	}
+	manifestSnapshotPathReturns struct {
+		result1 string
+	}
+	manifestSnapshotPathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct {
@@ -281,6 +371,36 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PagerCommandStub        func() string
+	pagerCommandMutex       sync.RWMutex
+	pagerCommandArgsForCall []struct {
+	}
+	pagerCommandReturns struct {
+		result1 string
+	}
+	pagerCommandReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PagerEnabledStub        func() bool
+	pagerEnabledMutex       sync.RWMutex
+	pagerEnabledArgsForCall []struct {
+	}
+	pagerEnabledReturns struct {
+		result1 bool
+	}
+	pagerEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PaginationWorkersStub        func() int
+	paginationWorkersMutex       sync.RWMutex
+	paginationWorkersArgsForCall []struct {
+	}
+	paginationWorkersReturns struct {
+		result1 int
+	}
+	paginationWorkersReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct {
@@ -321,6 +441,36 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ProfileStub        func() string
+	profileMutex       sync.RWMutex
+	profileArgsForCall []struct {
+	}
+	profileReturns struct {
+		result1 string
+	}
+	profileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ProfileNamesStub        func() []string
+	profileNamesMutex       sync.RWMutex
+	profileNamesArgsForCall []struct {
+	}
+	profileNamesReturns struct {
+		result1 []string
+	}
+	profileNamesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ProgressStyleStub        func() configv3.ProgressStyle
+	progressStyleMutex       sync.RWMutex
+	progressStyleArgsForCall []struct {
+	}
+	progressStyleReturns struct {
+		result1 configv3.ProgressStyle
+	}
+	progressStyleReturnsOnCall map[int]struct {
+		result1 configv3.ProgressStyle
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -346,6 +496,36 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RequestStatsStub        func() *requeststats.Collector
+	requestStatsMutex       sync.RWMutex
+	requestStatsArgsForCall []struct {
+	}
+	requestStatsReturns struct {
+		result1 *requeststats.Collector
+	}
+	requestStatsReturnsOnCall map[int]struct {
+		result1 *requeststats.Collector
+	}
+	ResolveOverridesStub        func() []util.ResolveOverride
+	resolveOverridesMutex       sync.RWMutex
+	resolveOverridesArgsForCall []struct {
+	}
+	resolveOverridesReturns struct {
+		result1 []util.ResolveOverride
+	}
+	resolveOverridesReturnsOnCall map[int]struct {
+		result1 []util.ResolveOverride
+	}
+	ResourceCacheDirStub        func() string
+	resourceCacheDirMutex       sync.RWMutex
+	resourceCacheDirArgsForCall []struct {
+	}
+	resourceCacheDirReturns struct {
+		result1 string
+	}
+	resourceCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -366,6 +546,11 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SaveProfileStub        func(string)
+	saveProfileMutex       sync.RWMutex
+	saveProfileArgsForCall []struct {
+		arg1 string
+	}
 	SetAccessTokenStub        func(string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -376,11 +561,32 @@ type FakeConfig struct {
 	setAsyncTimeoutArgsForCall []struct {
 		arg1 int
 	}
+	SetClientCertificateStub        func(util.ClientCertificate)
+	setClientCertificateMutex       sync.RWMutex
+	setClientCertificateArgsForCall []struct {
+		arg1 util.ClientCertificate
+	}
 	SetColorEnabledStub        func(string)
 	setColorEnabledMutex       sync.RWMutex
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetColorThemeStub        func(string)
+	setColorThemeMutex       sync.RWMutex
+	setColorThemeArgsForCall []struct {
+		arg1 string
+	}
+	SetCredentialHelperStub        func(string)
+	setCredentialHelperMutex       sync.RWMutex
+	setCredentialHelperArgsForCall []struct {
+		arg1 string
+	}
+	SetExperimentalFeatureEnabledStub        func(string, bool)
+	setExperimentalFeatureEnabledMutex       sync.RWMutex
+	setExperimentalFeatureEnabledArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -402,11 +608,26 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetPagerEnabledStub        func(string)
+	setPagerEnabledMutex       sync.RWMutex
+	setPagerEnabledArgsForCall []struct {
+		arg1 string
+	}
+	SetProgressStyleStub        func(string)
+	setProgressStyleMutex       sync.RWMutex
+	setProgressStyleArgsForCall []struct {
+		arg1 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
 		arg1 string
 	}
+	SetResolveOverrideStub        func(util.ResolveOverride)
+	setResolveOverrideMutex       sync.RWMutex
+	setResolveOverrideArgsForCall []struct {
+		arg1 util.ResolveOverride
+	}
 	SetSpaceInformationStub        func(string, string, bool)
 	setSpaceInformationMutex       sync.RWMutex
 	setSpaceInformationArgsForCall []struct {
@@ -426,6 +647,11 @@ type FakeConfig struct {
 		arg2 string
 		arg3 string
 	}
+	SetTokenStorageStub        func(string)
+	setTokenStorageMutex       sync.RWMutex
+	setTokenStorageArgsForCall []struct {
+		arg1 string
+	}
 	SetTraceStub        func(string)
 	setTraceMutex       sync.RWMutex
 	setTraceArgsForCall []struct {
@@ -589,6 +815,17 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UseProfileStub        func(string) bool
+	useProfileMutex       sync.RWMutex
+	useProfileArgsForCall []struct {
+		arg1 string
+	}
+	useProfileReturns struct {
+		result1 bool
+	}
+	useProfileReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	V7SetSpaceInformationStub        func(string, string)
 	v7SetSpaceInformationMutex       sync.RWMutex
 	v7SetSpaceInformationArgsForCall []struct {
@@ -631,6 +868,58 @@ type FakeConfig struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeConfig) APIBaseURL() string {
+	fake.aPIBaseURLMutex.Lock()
+	ret, specificReturn := fake.aPIBaseURLReturnsOnCall[len(fake.aPIBaseURLArgsForCall)]
+	fake.aPIBaseURLArgsForCall = append(fake.aPIBaseURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APIBaseURL", []interface{}{})
+	fake.aPIBaseURLMutex.Unlock()
+	if fake.APIBaseURLStub != nil {
+		return fake.APIBaseURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.aPIBaseURLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) APIBaseURLCallCount() int {
+	fake.aPIBaseURLMutex.RLock()
+	defer fake.aPIBaseURLMutex.RUnlock()
+	return len(fake.aPIBaseURLArgsForCall)
+}
+
+func (fake *FakeConfig) APIBaseURLCalls(stub func() string) {
+	fake.aPIBaseURLMutex.Lock()
+	defer fake.aPIBaseURLMutex.Unlock()
+	fake.APIBaseURLStub = stub
+}
+
+func (fake *FakeConfig) APIBaseURLReturns(result1 string) {
+	fake.aPIBaseURLMutex.Lock()
+	defer fake.aPIBaseURLMutex.Unlock()
+	fake.APIBaseURLStub = nil
+	fake.aPIBaseURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) APIBaseURLReturnsOnCall(i int, result1 string) {
+	fake.aPIBaseURLMutex.Lock()
+	defer fake.aPIBaseURLMutex.Unlock()
+	fake.APIBaseURLStub = nil
+	if fake.aPIBaseURLReturnsOnCall == nil {
+		fake.aPIBaseURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.aPIBaseURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -954,6 +1243,66 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) BrokerCatalogSnapshotPath(arg1 string) string {
+	fake.brokerCatalogSnapshotPathMutex.Lock()
+	ret, specificReturn := fake.brokerCatalogSnapshotPathReturnsOnCall[len(fake.brokerCatalogSnapshotPathArgsForCall)]
+	fake.brokerCatalogSnapshotPathArgsForCall = append(fake.brokerCatalogSnapshotPathArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BrokerCatalogSnapshotPath", []interface{}{arg1})
+	fake.brokerCatalogSnapshotPathMutex.Unlock()
+	if fake.BrokerCatalogSnapshotPathStub != nil {
+		return fake.BrokerCatalogSnapshotPathStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.brokerCatalogSnapshotPathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) BrokerCatalogSnapshotPathCallCount() int {
+	fake.brokerCatalogSnapshotPathMutex.RLock()
+	defer fake.brokerCatalogSnapshotPathMutex.RUnlock()
+	return len(fake.brokerCatalogSnapshotPathArgsForCall)
+}
+
+func (fake *FakeConfig) BrokerCatalogSnapshotPathCalls(stub func(string) string) {
+	fake.brokerCatalogSnapshotPathMutex.Lock()
+	defer fake.brokerCatalogSnapshotPathMutex.Unlock()
+	fake.BrokerCatalogSnapshotPathStub = stub
+}
+
+func (fake *FakeConfig) BrokerCatalogSnapshotPathArgsForCall(i int) string {
+	fake.brokerCatalogSnapshotPathMutex.RLock()
+	defer fake.brokerCatalogSnapshotPathMutex.RUnlock()
+	argsForCall := fake.brokerCatalogSnapshotPathArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) BrokerCatalogSnapshotPathReturns(result1 string) {
+	fake.brokerCatalogSnapshotPathMutex.Lock()
+	defer fake.brokerCatalogSnapshotPathMutex.Unlock()
+	fake.BrokerCatalogSnapshotPathStub = nil
+	fake.brokerCatalogSnapshotPathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) BrokerCatalogSnapshotPathReturnsOnCall(i int, result1 string) {
+	fake.brokerCatalogSnapshotPathMutex.Lock()
+	defer fake.brokerCatalogSnapshotPathMutex.Unlock()
+	fake.BrokerCatalogSnapshotPathStub = nil
+	if fake.brokerCatalogSnapshotPathReturnsOnCall == nil {
+		fake.brokerCatalogSnapshotPathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.brokerCatalogSnapshotPathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -1058,6 +1407,81 @@ func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ClearResolveOverrides() {
+	fake.clearResolveOverridesMutex.Lock()
+	fake.clearResolveOverridesArgsForCall = append(fake.clearResolveOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClearResolveOverrides", []interface{}{})
+	fake.clearResolveOverridesMutex.Unlock()
+	if fake.ClearResolveOverridesStub != nil {
+		fake.ClearResolveOverridesStub()
+	}
+}
+
+func (fake *FakeConfig) ClearResolveOverridesCallCount() int {
+	fake.clearResolveOverridesMutex.RLock()
+	defer fake.clearResolveOverridesMutex.RUnlock()
+	return len(fake.clearResolveOverridesArgsForCall)
+}
+
+func (fake *FakeConfig) ClearResolveOverridesCalls(stub func()) {
+	fake.clearResolveOverridesMutex.Lock()
+	defer fake.clearResolveOverridesMutex.Unlock()
+	fake.ClearResolveOverridesStub = stub
+}
+
+func (fake *FakeConfig) ClientCertificate() util.ClientCertificate {
+	fake.clientCertificateMutex.Lock()
+	ret, specificReturn := fake.clientCertificateReturnsOnCall[len(fake.clientCertificateArgsForCall)]
+	fake.clientCertificateArgsForCall = append(fake.clientCertificateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClientCertificate", []interface{}{})
+	fake.clientCertificateMutex.Unlock()
+	if fake.ClientCertificateStub != nil {
+		return fake.ClientCertificateStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clientCertificateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ClientCertificateCallCount() int {
+	fake.clientCertificateMutex.RLock()
+	defer fake.clientCertificateMutex.RUnlock()
+	return len(fake.clientCertificateArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertificateCalls(stub func() util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = stub
+}
+
+func (fake *FakeConfig) ClientCertificateReturns(result1 util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = nil
+	fake.clientCertificateReturns = struct {
+		result1 util.ClientCertificate
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertificateReturnsOnCall(i int, result1 util.ClientCertificate) {
+	fake.clientCertificateMutex.Lock()
+	defer fake.clientCertificateMutex.Unlock()
+	fake.ClientCertificateStub = nil
+	if fake.clientCertificateReturnsOnCall == nil {
+		fake.clientCertificateReturnsOnCall = make(map[int]struct {
+			result1 util.ClientCertificate
+		})
+	}
+	fake.clientCertificateReturnsOnCall[i] = struct {
+		result1 util.ClientCertificate
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -1110,6 +1534,58 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CredentialHelper() string {
+	fake.credentialHelperMutex.Lock()
+	ret, specificReturn := fake.credentialHelperReturnsOnCall[len(fake.credentialHelperArgsForCall)]
+	fake.credentialHelperArgsForCall = append(fake.credentialHelperArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialHelper", []interface{}{})
+	fake.credentialHelperMutex.Unlock()
+	if fake.CredentialHelperStub != nil {
+		return fake.CredentialHelperStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialHelperReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CredentialHelperCallCount() int {
+	fake.credentialHelperMutex.RLock()
+	defer fake.credentialHelperMutex.RUnlock()
+	return len(fake.credentialHelperArgsForCall)
+}
+
+func (fake *FakeConfig) CredentialHelperCalls(stub func() string) {
+	fake.credentialHelperMutex.Lock()
+	defer fake.credentialHelperMutex.Unlock()
+	fake.CredentialHelperStub = stub
+}
+
+func (fake *FakeConfig) CredentialHelperReturns(result1 string) {
+	fake.credentialHelperMutex.Lock()
+	defer fake.credentialHelperMutex.Unlock()
+	fake.CredentialHelperStub = nil
+	fake.credentialHelperReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CredentialHelperReturnsOnCall(i int, result1 string) {
+	fake.credentialHelperMutex.Lock()
+	defer fake.credentialHelperMutex.Unlock()
+	fake.CredentialHelperStub = nil
+	if fake.credentialHelperReturnsOnCall == nil {
+		fake.credentialHelperReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.credentialHelperReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1376,6 +1852,67 @@ func (fake *FakeConfig) ExperimentalReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ExperimentalFeatureEnabled(arg1 string, arg2 bool) bool {
+	fake.experimentalFeatureEnabledMutex.Lock()
+	ret, specificReturn := fake.experimentalFeatureEnabledReturnsOnCall[len(fake.experimentalFeatureEnabledArgsForCall)]
+	fake.experimentalFeatureEnabledArgsForCall = append(fake.experimentalFeatureEnabledArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("ExperimentalFeatureEnabled", []interface{}{arg1, arg2})
+	fake.experimentalFeatureEnabledMutex.Unlock()
+	if fake.ExperimentalFeatureEnabledStub != nil {
+		return fake.ExperimentalFeatureEnabledStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.experimentalFeatureEnabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ExperimentalFeatureEnabledCallCount() int {
+	fake.experimentalFeatureEnabledMutex.RLock()
+	defer fake.experimentalFeatureEnabledMutex.RUnlock()
+	return len(fake.experimentalFeatureEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) ExperimentalFeatureEnabledCalls(stub func(string, bool) bool) {
+	fake.experimentalFeatureEnabledMutex.Lock()
+	defer fake.experimentalFeatureEnabledMutex.Unlock()
+	fake.ExperimentalFeatureEnabledStub = stub
+}
+
+func (fake *FakeConfig) ExperimentalFeatureEnabledArgsForCall(i int) (string, bool) {
+	fake.experimentalFeatureEnabledMutex.RLock()
+	defer fake.experimentalFeatureEnabledMutex.RUnlock()
+	argsForCall := fake.experimentalFeatureEnabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfig) ExperimentalFeatureEnabledReturns(result1 bool) {
+	fake.experimentalFeatureEnabledMutex.Lock()
+	defer fake.experimentalFeatureEnabledMutex.Unlock()
+	fake.ExperimentalFeatureEnabledStub = nil
+	fake.experimentalFeatureEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ExperimentalFeatureEnabledReturnsOnCall(i int, result1 bool) {
+	fake.experimentalFeatureEnabledMutex.Lock()
+	defer fake.experimentalFeatureEnabledMutex.Unlock()
+	fake.ExperimentalFeatureEnabledStub = nil
+	if fake.experimentalFeatureEnabledReturnsOnCall == nil {
+		fake.experimentalFeatureEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.experimentalFeatureEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -1606,6 +2143,58 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IPFamily() util.IPFamily {
+	fake.iPFamilyMutex.Lock()
+	ret, specificReturn := fake.iPFamilyReturnsOnCall[len(fake.iPFamilyArgsForCall)]
+	fake.iPFamilyArgsForCall = append(fake.iPFamilyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IPFamily", []interface{}{})
+	fake.iPFamilyMutex.Unlock()
+	if fake.IPFamilyStub != nil {
+		return fake.IPFamilyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iPFamilyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) IPFamilyCallCount() int {
+	fake.iPFamilyMutex.RLock()
+	defer fake.iPFamilyMutex.RUnlock()
+	return len(fake.iPFamilyArgsForCall)
+}
+
+func (fake *FakeConfig) IPFamilyCalls(stub func() util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = stub
+}
+
+func (fake *FakeConfig) IPFamilyReturns(result1 util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = nil
+	fake.iPFamilyReturns = struct {
+		result1 util.IPFamily
+	}{result1}
+}
+
+func (fake *FakeConfig) IPFamilyReturnsOnCall(i int, result1 util.IPFamily) {
+	fake.iPFamilyMutex.Lock()
+	defer fake.iPFamilyMutex.Unlock()
+	fake.IPFamilyStub = nil
+	if fake.iPFamilyReturnsOnCall == nil {
+		fake.iPFamilyReturnsOnCall = make(map[int]struct {
+			result1 util.IPFamily
+		})
+	}
+	fake.iPFamilyReturnsOnCall[i] = struct {
+		result1 util.IPFamily
+	}{result1}
+}
+
 func (fake *FakeConfig) IsCFOnK8s() bool {
 	fake.isCFOnK8sMutex.Lock()
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
@@ -1814,25 +2403,137 @@ func (fake *FakeConfig) LogCacheEndpointReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeConfig) MinCLIVersion() string {
-	fake.minCLIVersionMutex.Lock()
-	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
-	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct {
+func (fake *FakeConfig) LogGroupStyle() configv3.LogGroupStyle {
+	fake.logGroupStyleMutex.Lock()
+	ret, specificReturn := fake.logGroupStyleReturnsOnCall[len(fake.logGroupStyleArgsForCall)]
+	fake.logGroupStyleArgsForCall = append(fake.logGroupStyleArgsForCall, struct {
 	}{})
-	fake.recordInvocation("MinCLIVersion", []interface{}{})
-	fake.minCLIVersionMutex.Unlock()
-	if fake.MinCLIVersionStub != nil {
-		return fake.MinCLIVersionStub()
+	fake.recordInvocation("LogGroupStyle", []interface{}{})
+	fake.logGroupStyleMutex.Unlock()
+	if fake.LogGroupStyleStub != nil {
+		return fake.LogGroupStyleStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.minCLIVersionReturns
+	fakeReturns := fake.logGroupStyleReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) MinCLIVersionCallCount() int {
-	fake.minCLIVersionMutex.RLock()
+func (fake *FakeConfig) LogGroupStyleCallCount() int {
+	fake.logGroupStyleMutex.RLock()
+	defer fake.logGroupStyleMutex.RUnlock()
+	return len(fake.logGroupStyleArgsForCall)
+}
+
+func (fake *FakeConfig) LogGroupStyleCalls(stub func() configv3.LogGroupStyle) {
+	fake.logGroupStyleMutex.Lock()
+	defer fake.logGroupStyleMutex.Unlock()
+	fake.LogGroupStyleStub = stub
+}
+
+func (fake *FakeConfig) LogGroupStyleReturns(result1 configv3.LogGroupStyle) {
+	fake.logGroupStyleMutex.Lock()
+	defer fake.logGroupStyleMutex.Unlock()
+	fake.LogGroupStyleStub = nil
+	fake.logGroupStyleReturns = struct {
+		result1 configv3.LogGroupStyle
+	}{result1}
+}
+
+func (fake *FakeConfig) LogGroupStyleReturnsOnCall(i int, result1 configv3.LogGroupStyle) {
+	fake.logGroupStyleMutex.Lock()
+	defer fake.logGroupStyleMutex.Unlock()
+	fake.LogGroupStyleStub = nil
+	if fake.logGroupStyleReturnsOnCall == nil {
+		fake.logGroupStyleReturnsOnCall = make(map[int]struct {
+			result1 configv3.LogGroupStyle
+		})
+	}
+	fake.logGroupStyleReturnsOnCall[i] = struct {
+		result1 configv3.LogGroupStyle
+	}{result1}
+}
+
+func (fake *FakeConfig) ManifestSnapshotPath(arg1 string) string {
+	fake.manifestSnapshotPathMutex.Lock()
+	ret, specificReturn := fake.manifestSnapshotPathReturnsOnCall[len(fake.manifestSnapshotPathArgsForCall)]
+	fake.manifestSnapshotPathArgsForCall = append(fake.manifestSnapshotPathArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ManifestSnapshotPath", []interface{}{arg1})
+	fake.manifestSnapshotPathMutex.Unlock()
+	if fake.ManifestSnapshotPathStub != nil {
+		return fake.ManifestSnapshotPathStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.manifestSnapshotPathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ManifestSnapshotPathCallCount() int {
+	fake.manifestSnapshotPathMutex.RLock()
+	defer fake.manifestSnapshotPathMutex.RUnlock()
+	return len(fake.manifestSnapshotPathArgsForCall)
+}
+
+func (fake *FakeConfig) ManifestSnapshotPathCalls(stub func(string) string) {
+	fake.manifestSnapshotPathMutex.Lock()
+	defer fake.manifestSnapshotPathMutex.Unlock()
+	fake.ManifestSnapshotPathStub = stub
+}
+
+func (fake *FakeConfig) ManifestSnapshotPathArgsForCall(i int) string {
+	fake.manifestSnapshotPathMutex.RLock()
+	defer fake.manifestSnapshotPathMutex.RUnlock()
+	argsForCall := fake.manifestSnapshotPathArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) ManifestSnapshotPathReturns(result1 string) {
+	fake.manifestSnapshotPathMutex.Lock()
+	defer fake.manifestSnapshotPathMutex.Unlock()
+	fake.ManifestSnapshotPathStub = nil
+	fake.manifestSnapshotPathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ManifestSnapshotPathReturnsOnCall(i int, result1 string) {
+	fake.manifestSnapshotPathMutex.Lock()
+	defer fake.manifestSnapshotPathMutex.Unlock()
+	fake.ManifestSnapshotPathStub = nil
+	if fake.manifestSnapshotPathReturnsOnCall == nil {
+		fake.manifestSnapshotPathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.manifestSnapshotPathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) MinCLIVersion() string {
+	fake.minCLIVersionMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
+	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MinCLIVersion", []interface{}{})
+	fake.minCLIVersionMutex.Unlock()
+	if fake.MinCLIVersionStub != nil {
+		return fake.MinCLIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.minCLIVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) MinCLIVersionCallCount() int {
+	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	return len(fake.minCLIVersionArgsForCall)
 }
@@ -2022,6 +2723,162 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PagerCommand() string {
+	fake.pagerCommandMutex.Lock()
+	ret, specificReturn := fake.pagerCommandReturnsOnCall[len(fake.pagerCommandArgsForCall)]
+	fake.pagerCommandArgsForCall = append(fake.pagerCommandArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PagerCommand", []interface{}{})
+	fake.pagerCommandMutex.Unlock()
+	if fake.PagerCommandStub != nil {
+		return fake.PagerCommandStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pagerCommandReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PagerCommandCallCount() int {
+	fake.pagerCommandMutex.RLock()
+	defer fake.pagerCommandMutex.RUnlock()
+	return len(fake.pagerCommandArgsForCall)
+}
+
+func (fake *FakeConfig) PagerCommandCalls(stub func() string) {
+	fake.pagerCommandMutex.Lock()
+	defer fake.pagerCommandMutex.Unlock()
+	fake.PagerCommandStub = stub
+}
+
+func (fake *FakeConfig) PagerCommandReturns(result1 string) {
+	fake.pagerCommandMutex.Lock()
+	defer fake.pagerCommandMutex.Unlock()
+	fake.PagerCommandStub = nil
+	fake.pagerCommandReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerCommandReturnsOnCall(i int, result1 string) {
+	fake.pagerCommandMutex.Lock()
+	defer fake.pagerCommandMutex.Unlock()
+	fake.PagerCommandStub = nil
+	if fake.pagerCommandReturnsOnCall == nil {
+		fake.pagerCommandReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pagerCommandReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerEnabled() bool {
+	fake.pagerEnabledMutex.Lock()
+	ret, specificReturn := fake.pagerEnabledReturnsOnCall[len(fake.pagerEnabledArgsForCall)]
+	fake.pagerEnabledArgsForCall = append(fake.pagerEnabledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PagerEnabled", []interface{}{})
+	fake.pagerEnabledMutex.Unlock()
+	if fake.PagerEnabledStub != nil {
+		return fake.PagerEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pagerEnabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PagerEnabledCallCount() int {
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
+	return len(fake.pagerEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) PagerEnabledCalls(stub func() bool) {
+	fake.pagerEnabledMutex.Lock()
+	defer fake.pagerEnabledMutex.Unlock()
+	fake.PagerEnabledStub = stub
+}
+
+func (fake *FakeConfig) PagerEnabledReturns(result1 bool) {
+	fake.pagerEnabledMutex.Lock()
+	defer fake.pagerEnabledMutex.Unlock()
+	fake.PagerEnabledStub = nil
+	fake.pagerEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PagerEnabledReturnsOnCall(i int, result1 bool) {
+	fake.pagerEnabledMutex.Lock()
+	defer fake.pagerEnabledMutex.Unlock()
+	fake.PagerEnabledStub = nil
+	if fake.pagerEnabledReturnsOnCall == nil {
+		fake.pagerEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pagerEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationWorkers() int {
+	fake.paginationWorkersMutex.Lock()
+	ret, specificReturn := fake.paginationWorkersReturnsOnCall[len(fake.paginationWorkersArgsForCall)]
+	fake.paginationWorkersArgsForCall = append(fake.paginationWorkersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PaginationWorkers", []interface{}{})
+	fake.paginationWorkersMutex.Unlock()
+	if fake.PaginationWorkersStub != nil {
+		return fake.PaginationWorkersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.paginationWorkersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PaginationWorkersCallCount() int {
+	fake.paginationWorkersMutex.RLock()
+	defer fake.paginationWorkersMutex.RUnlock()
+	return len(fake.paginationWorkersArgsForCall)
+}
+
+func (fake *FakeConfig) PaginationWorkersCalls(stub func() int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = stub
+}
+
+func (fake *FakeConfig) PaginationWorkersReturns(result1 int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = nil
+	fake.paginationWorkersReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationWorkersReturnsOnCall(i int, result1 int) {
+	fake.paginationWorkersMutex.Lock()
+	defer fake.paginationWorkersMutex.Unlock()
+	fake.PaginationWorkersStub = nil
+	if fake.paginationWorkersReturnsOnCall == nil {
+		fake.paginationWorkersReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.paginationWorkersReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -2230,6 +3087,162 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) Profile() string {
+	fake.profileMutex.Lock()
+	ret, specificReturn := fake.profileReturnsOnCall[len(fake.profileArgsForCall)]
+	fake.profileArgsForCall = append(fake.profileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Profile", []interface{}{})
+	fake.profileMutex.Unlock()
+	if fake.ProfileStub != nil {
+		return fake.ProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.profileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfileCallCount() int {
+	fake.profileMutex.RLock()
+	defer fake.profileMutex.RUnlock()
+	return len(fake.profileArgsForCall)
+}
+
+func (fake *FakeConfig) ProfileCalls(stub func() string) {
+	fake.profileMutex.Lock()
+	defer fake.profileMutex.Unlock()
+	fake.ProfileStub = stub
+}
+
+func (fake *FakeConfig) ProfileReturns(result1 string) {
+	fake.profileMutex.Lock()
+	defer fake.profileMutex.Unlock()
+	fake.ProfileStub = nil
+	fake.profileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfileReturnsOnCall(i int, result1 string) {
+	fake.profileMutex.Lock()
+	defer fake.profileMutex.Unlock()
+	fake.ProfileStub = nil
+	if fake.profileReturnsOnCall == nil {
+		fake.profileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.profileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfileNames() []string {
+	fake.profileNamesMutex.Lock()
+	ret, specificReturn := fake.profileNamesReturnsOnCall[len(fake.profileNamesArgsForCall)]
+	fake.profileNamesArgsForCall = append(fake.profileNamesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ProfileNames", []interface{}{})
+	fake.profileNamesMutex.Unlock()
+	if fake.ProfileNamesStub != nil {
+		return fake.ProfileNamesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.profileNamesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfileNamesCallCount() int {
+	fake.profileNamesMutex.RLock()
+	defer fake.profileNamesMutex.RUnlock()
+	return len(fake.profileNamesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfileNamesCalls(stub func() []string) {
+	fake.profileNamesMutex.Lock()
+	defer fake.profileNamesMutex.Unlock()
+	fake.ProfileNamesStub = stub
+}
+
+func (fake *FakeConfig) ProfileNamesReturns(result1 []string) {
+	fake.profileNamesMutex.Lock()
+	defer fake.profileNamesMutex.Unlock()
+	fake.ProfileNamesStub = nil
+	fake.profileNamesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfileNamesReturnsOnCall(i int, result1 []string) {
+	fake.profileNamesMutex.Lock()
+	defer fake.profileNamesMutex.Unlock()
+	fake.ProfileNamesStub = nil
+	if fake.profileNamesReturnsOnCall == nil {
+		fake.profileNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.profileNamesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProgressStyle() configv3.ProgressStyle {
+	fake.progressStyleMutex.Lock()
+	ret, specificReturn := fake.progressStyleReturnsOnCall[len(fake.progressStyleArgsForCall)]
+	fake.progressStyleArgsForCall = append(fake.progressStyleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ProgressStyle", []interface{}{})
+	fake.progressStyleMutex.Unlock()
+	if fake.ProgressStyleStub != nil {
+		return fake.ProgressStyleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.progressStyleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProgressStyleCallCount() int {
+	fake.progressStyleMutex.RLock()
+	defer fake.progressStyleMutex.RUnlock()
+	return len(fake.progressStyleArgsForCall)
+}
+
+func (fake *FakeConfig) ProgressStyleCalls(stub func() configv3.ProgressStyle) {
+	fake.progressStyleMutex.Lock()
+	defer fake.progressStyleMutex.Unlock()
+	fake.ProgressStyleStub = stub
+}
+
+func (fake *FakeConfig) ProgressStyleReturns(result1 configv3.ProgressStyle) {
+	fake.progressStyleMutex.Lock()
+	defer fake.progressStyleMutex.Unlock()
+	fake.ProgressStyleStub = nil
+	fake.progressStyleReturns = struct {
+		result1 configv3.ProgressStyle
+	}{result1}
+}
+
+func (fake *FakeConfig) ProgressStyleReturnsOnCall(i int, result1 configv3.ProgressStyle) {
+	fake.progressStyleMutex.Lock()
+	defer fake.progressStyleMutex.Unlock()
+	fake.ProgressStyleStub = nil
+	if fake.progressStyleReturnsOnCall == nil {
+		fake.progressStyleReturnsOnCall = make(map[int]struct {
+			result1 configv3.ProgressStyle
+		})
+	}
+	fake.progressStyleReturnsOnCall[i] = struct {
+		result1 configv3.ProgressStyle
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -2365,6 +3378,162 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RequestStats() *requeststats.Collector {
+	fake.requestStatsMutex.Lock()
+	ret, specificReturn := fake.requestStatsReturnsOnCall[len(fake.requestStatsArgsForCall)]
+	fake.requestStatsArgsForCall = append(fake.requestStatsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestStats", []interface{}{})
+	fake.requestStatsMutex.Unlock()
+	if fake.RequestStatsStub != nil {
+		return fake.RequestStatsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestStatsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestStatsCallCount() int {
+	fake.requestStatsMutex.RLock()
+	defer fake.requestStatsMutex.RUnlock()
+	return len(fake.requestStatsArgsForCall)
+}
+
+func (fake *FakeConfig) RequestStatsCalls(stub func() *requeststats.Collector) {
+	fake.requestStatsMutex.Lock()
+	defer fake.requestStatsMutex.Unlock()
+	fake.RequestStatsStub = stub
+}
+
+func (fake *FakeConfig) RequestStatsReturns(result1 *requeststats.Collector) {
+	fake.requestStatsMutex.Lock()
+	defer fake.requestStatsMutex.Unlock()
+	fake.RequestStatsStub = nil
+	fake.requestStatsReturns = struct {
+		result1 *requeststats.Collector
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestStatsReturnsOnCall(i int, result1 *requeststats.Collector) {
+	fake.requestStatsMutex.Lock()
+	defer fake.requestStatsMutex.Unlock()
+	fake.RequestStatsStub = nil
+	if fake.requestStatsReturnsOnCall == nil {
+		fake.requestStatsReturnsOnCall = make(map[int]struct {
+			result1 *requeststats.Collector
+		})
+	}
+	fake.requestStatsReturnsOnCall[i] = struct {
+		result1 *requeststats.Collector
+	}{result1}
+}
+
+func (fake *FakeConfig) ResolveOverrides() []util.ResolveOverride {
+	fake.resolveOverridesMutex.Lock()
+	ret, specificReturn := fake.resolveOverridesReturnsOnCall[len(fake.resolveOverridesArgsForCall)]
+	fake.resolveOverridesArgsForCall = append(fake.resolveOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResolveOverrides", []interface{}{})
+	fake.resolveOverridesMutex.Unlock()
+	if fake.ResolveOverridesStub != nil {
+		return fake.ResolveOverridesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resolveOverridesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ResolveOverridesCallCount() int {
+	fake.resolveOverridesMutex.RLock()
+	defer fake.resolveOverridesMutex.RUnlock()
+	return len(fake.resolveOverridesArgsForCall)
+}
+
+func (fake *FakeConfig) ResolveOverridesCalls(stub func() []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = stub
+}
+
+func (fake *FakeConfig) ResolveOverridesReturns(result1 []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = nil
+	fake.resolveOverridesReturns = struct {
+		result1 []util.ResolveOverride
+	}{result1}
+}
+
+func (fake *FakeConfig) ResolveOverridesReturnsOnCall(i int, result1 []util.ResolveOverride) {
+	fake.resolveOverridesMutex.Lock()
+	defer fake.resolveOverridesMutex.Unlock()
+	fake.ResolveOverridesStub = nil
+	if fake.resolveOverridesReturnsOnCall == nil {
+		fake.resolveOverridesReturnsOnCall = make(map[int]struct {
+			result1 []util.ResolveOverride
+		})
+	}
+	fake.resolveOverridesReturnsOnCall[i] = struct {
+		result1 []util.ResolveOverride
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheDir() string {
+	fake.resourceCacheDirMutex.Lock()
+	ret, specificReturn := fake.resourceCacheDirReturnsOnCall[len(fake.resourceCacheDirArgsForCall)]
+	fake.resourceCacheDirArgsForCall = append(fake.resourceCacheDirArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceCacheDir", []interface{}{})
+	fake.resourceCacheDirMutex.Unlock()
+	if fake.ResourceCacheDirStub != nil {
+		return fake.ResourceCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceCacheDirReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheDirCallCount() int {
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
+	return len(fake.resourceCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheDirCalls(stub func() string) {
+	fake.resourceCacheDirMutex.Lock()
+	defer fake.resourceCacheDirMutex.Unlock()
+	fake.ResourceCacheDirStub = stub
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturns(result1 string) {
+	fake.resourceCacheDirMutex.Lock()
+	defer fake.resourceCacheDirMutex.Unlock()
+	fake.ResourceCacheDirStub = nil
+	fake.resourceCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturnsOnCall(i int, result1 string) {
+	fake.resourceCacheDirMutex.Lock()
+	defer fake.resourceCacheDirMutex.Unlock()
+	fake.ResourceCacheDirStub = nil
+	if fake.resourceCacheDirReturnsOnCall == nil {
+		fake.resourceCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -2469,6 +3638,37 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SaveProfile(arg1 string) {
+	fake.saveProfileMutex.Lock()
+	fake.saveProfileArgsForCall = append(fake.saveProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SaveProfile", []interface{}{arg1})
+	fake.saveProfileMutex.Unlock()
+	if fake.SaveProfileStub != nil {
+		fake.SaveProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SaveProfileCallCount() int {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	return len(fake.saveProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SaveProfileCalls(stub func(string)) {
+	fake.saveProfileMutex.Lock()
+	defer fake.saveProfileMutex.Unlock()
+	fake.SaveProfileStub = stub
+}
+
+func (fake *FakeConfig) SaveProfileArgsForCall(i int) string {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	argsForCall := fake.saveProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetAccessToken(arg1 string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -2531,6 +3731,37 @@ func (fake *FakeConfig) SetAsyncTimeoutArgsForCall(i int) int {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetClientCertificate(arg1 util.ClientCertificate) {
+	fake.setClientCertificateMutex.Lock()
+	fake.setClientCertificateArgsForCall = append(fake.setClientCertificateArgsForCall, struct {
+		arg1 util.ClientCertificate
+	}{arg1})
+	fake.recordInvocation("SetClientCertificate", []interface{}{arg1})
+	fake.setClientCertificateMutex.Unlock()
+	if fake.SetClientCertificateStub != nil {
+		fake.SetClientCertificateStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetClientCertificateCallCount() int {
+	fake.setClientCertificateMutex.RLock()
+	defer fake.setClientCertificateMutex.RUnlock()
+	return len(fake.setClientCertificateArgsForCall)
+}
+
+func (fake *FakeConfig) SetClientCertificateCalls(stub func(util.ClientCertificate)) {
+	fake.setClientCertificateMutex.Lock()
+	defer fake.setClientCertificateMutex.Unlock()
+	fake.SetClientCertificateStub = stub
+}
+
+func (fake *FakeConfig) SetClientCertificateArgsForCall(i int) util.ClientCertificate {
+	fake.setClientCertificateMutex.RLock()
+	defer fake.setClientCertificateMutex.RUnlock()
+	argsForCall := fake.setClientCertificateArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetColorEnabled(arg1 string) {
 	fake.setColorEnabledMutex.Lock()
 	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
@@ -2562,6 +3793,100 @@ func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetColorTheme(arg1 string) {
+	fake.setColorThemeMutex.Lock()
+	fake.setColorThemeArgsForCall = append(fake.setColorThemeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetColorTheme", []interface{}{arg1})
+	fake.setColorThemeMutex.Unlock()
+	if fake.SetColorThemeStub != nil {
+		fake.SetColorThemeStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetColorThemeCallCount() int {
+	fake.setColorThemeMutex.RLock()
+	defer fake.setColorThemeMutex.RUnlock()
+	return len(fake.setColorThemeArgsForCall)
+}
+
+func (fake *FakeConfig) SetColorThemeCalls(stub func(string)) {
+	fake.setColorThemeMutex.Lock()
+	defer fake.setColorThemeMutex.Unlock()
+	fake.SetColorThemeStub = stub
+}
+
+func (fake *FakeConfig) SetColorThemeArgsForCall(i int) string {
+	fake.setColorThemeMutex.RLock()
+	defer fake.setColorThemeMutex.RUnlock()
+	argsForCall := fake.setColorThemeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetCredentialHelper(arg1 string) {
+	fake.setCredentialHelperMutex.Lock()
+	fake.setCredentialHelperArgsForCall = append(fake.setCredentialHelperArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCredentialHelper", []interface{}{arg1})
+	fake.setCredentialHelperMutex.Unlock()
+	if fake.SetCredentialHelperStub != nil {
+		fake.SetCredentialHelperStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetCredentialHelperCallCount() int {
+	fake.setCredentialHelperMutex.RLock()
+	defer fake.setCredentialHelperMutex.RUnlock()
+	return len(fake.setCredentialHelperArgsForCall)
+}
+
+func (fake *FakeConfig) SetCredentialHelperCalls(stub func(string)) {
+	fake.setCredentialHelperMutex.Lock()
+	defer fake.setCredentialHelperMutex.Unlock()
+	fake.SetCredentialHelperStub = stub
+}
+
+func (fake *FakeConfig) SetCredentialHelperArgsForCall(i int) string {
+	fake.setCredentialHelperMutex.RLock()
+	defer fake.setCredentialHelperMutex.RUnlock()
+	argsForCall := fake.setCredentialHelperArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetExperimentalFeatureEnabled(arg1 string, arg2 bool) {
+	fake.setExperimentalFeatureEnabledMutex.Lock()
+	fake.setExperimentalFeatureEnabledArgsForCall = append(fake.setExperimentalFeatureEnabledArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("SetExperimentalFeatureEnabled", []interface{}{arg1, arg2})
+	fake.setExperimentalFeatureEnabledMutex.Unlock()
+	if fake.SetExperimentalFeatureEnabledStub != nil {
+		fake.SetExperimentalFeatureEnabledStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetExperimentalFeatureEnabledCallCount() int {
+	fake.setExperimentalFeatureEnabledMutex.RLock()
+	defer fake.setExperimentalFeatureEnabledMutex.RUnlock()
+	return len(fake.setExperimentalFeatureEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetExperimentalFeatureEnabledCalls(stub func(string, bool)) {
+	fake.setExperimentalFeatureEnabledMutex.Lock()
+	defer fake.setExperimentalFeatureEnabledMutex.Unlock()
+	fake.SetExperimentalFeatureEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetExperimentalFeatureEnabledArgsForCall(i int) (string, bool) {
+	fake.setExperimentalFeatureEnabledMutex.RLock()
+	defer fake.setExperimentalFeatureEnabledMutex.RUnlock()
+	argsForCall := fake.setExperimentalFeatureEnabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoMutex.Lock()
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
@@ -2687,6 +4012,68 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPagerEnabled(arg1 string) {
+	fake.setPagerEnabledMutex.Lock()
+	fake.setPagerEnabledArgsForCall = append(fake.setPagerEnabledArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetPagerEnabled", []interface{}{arg1})
+	fake.setPagerEnabledMutex.Unlock()
+	if fake.SetPagerEnabledStub != nil {
+		fake.SetPagerEnabledStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPagerEnabledCallCount() int {
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
+	return len(fake.setPagerEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetPagerEnabledCalls(stub func(string)) {
+	fake.setPagerEnabledMutex.Lock()
+	defer fake.setPagerEnabledMutex.Unlock()
+	fake.SetPagerEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetPagerEnabledArgsForCall(i int) string {
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
+	argsForCall := fake.setPagerEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetProgressStyle(arg1 string) {
+	fake.setProgressStyleMutex.Lock()
+	fake.setProgressStyleArgsForCall = append(fake.setProgressStyleArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetProgressStyle", []interface{}{arg1})
+	fake.setProgressStyleMutex.Unlock()
+	if fake.SetProgressStyleStub != nil {
+		fake.SetProgressStyleStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetProgressStyleCallCount() int {
+	fake.setProgressStyleMutex.RLock()
+	defer fake.setProgressStyleMutex.RUnlock()
+	return len(fake.setProgressStyleArgsForCall)
+}
+
+func (fake *FakeConfig) SetProgressStyleCalls(stub func(string)) {
+	fake.setProgressStyleMutex.Lock()
+	defer fake.setProgressStyleMutex.Unlock()
+	fake.SetProgressStyleStub = stub
+}
+
+func (fake *FakeConfig) SetProgressStyleArgsForCall(i int) string {
+	fake.setProgressStyleMutex.RLock()
+	defer fake.setProgressStyleMutex.RUnlock()
+	argsForCall := fake.setProgressStyleArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -2718,6 +4105,37 @@ func (fake *FakeConfig) SetRefreshTokenArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetResolveOverride(arg1 util.ResolveOverride) {
+	fake.setResolveOverrideMutex.Lock()
+	fake.setResolveOverrideArgsForCall = append(fake.setResolveOverrideArgsForCall, struct {
+		arg1 util.ResolveOverride
+	}{arg1})
+	fake.recordInvocation("SetResolveOverride", []interface{}{arg1})
+	fake.setResolveOverrideMutex.Unlock()
+	if fake.SetResolveOverrideStub != nil {
+		fake.SetResolveOverrideStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetResolveOverrideCallCount() int {
+	fake.setResolveOverrideMutex.RLock()
+	defer fake.setResolveOverrideMutex.RUnlock()
+	return len(fake.setResolveOverrideArgsForCall)
+}
+
+func (fake *FakeConfig) SetResolveOverrideCalls(stub func(util.ResolveOverride)) {
+	fake.setResolveOverrideMutex.Lock()
+	defer fake.setResolveOverrideMutex.Unlock()
+	fake.SetResolveOverrideStub = stub
+}
+
+func (fake *FakeConfig) SetResolveOverrideArgsForCall(i int) util.ResolveOverride {
+	fake.setResolveOverrideMutex.RLock()
+	defer fake.setResolveOverrideMutex.RUnlock()
+	argsForCall := fake.setResolveOverrideArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetSpaceInformation(arg1 string, arg2 string, arg3 bool) {
 	fake.setSpaceInformationMutex.Lock()
 	fake.setSpaceInformationArgsForCall = append(fake.setSpaceInformationArgsForCall, struct {
@@ -2815,6 +4233,37 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeConfig) SetTokenStorage(arg1 string) {
+	fake.setTokenStorageMutex.Lock()
+	fake.setTokenStorageArgsForCall = append(fake.setTokenStorageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetTokenStorage", []interface{}{arg1})
+	fake.setTokenStorageMutex.Unlock()
+	if fake.SetTokenStorageStub != nil {
+		fake.SetTokenStorageStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetTokenStorageCallCount() int {
+	fake.setTokenStorageMutex.RLock()
+	defer fake.setTokenStorageMutex.RUnlock()
+	return len(fake.setTokenStorageArgsForCall)
+}
+
+func (fake *FakeConfig) SetTokenStorageCalls(stub func(string)) {
+	fake.setTokenStorageMutex.Lock()
+	defer fake.setTokenStorageMutex.Unlock()
+	fake.SetTokenStorageStub = stub
+}
+
+func (fake *FakeConfig) SetTokenStorageArgsForCall(i int) string {
+	fake.setTokenStorageMutex.RLock()
+	defer fake.setTokenStorageMutex.RUnlock()
+	argsForCall := fake.setTokenStorageArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetTrace(arg1 string) {
 	fake.setTraceMutex.Lock()
 	fake.setTraceArgsForCall = append(fake.setTraceArgsForCall, struct {
@@ -3685,6 +5134,66 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UseProfile(arg1 string) bool {
+	fake.useProfileMutex.Lock()
+	ret, specificReturn := fake.useProfileReturnsOnCall[len(fake.useProfileArgsForCall)]
+	fake.useProfileArgsForCall = append(fake.useProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UseProfile", []interface{}{arg1})
+	fake.useProfileMutex.Unlock()
+	if fake.UseProfileStub != nil {
+		return fake.UseProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.useProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UseProfileCallCount() int {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	return len(fake.useProfileArgsForCall)
+}
+
+func (fake *FakeConfig) UseProfileCalls(stub func(string) bool) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = stub
+}
+
+func (fake *FakeConfig) UseProfileArgsForCall(i int) string {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	argsForCall := fake.useProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) UseProfileReturns(result1 bool) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = nil
+	fake.useProfileReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UseProfileReturnsOnCall(i int, result1 bool) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = nil
+	if fake.useProfileReturnsOnCall == nil {
+		fake.useProfileReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.useProfileReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 	fake.v7SetSpaceInformationMutex.Lock()
 	fake.v7SetSpaceInformationArgsForCall = append(fake.v7SetSpaceInformationArgsForCall, struct {
@@ -3879,6 +5388,8 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPIBaseURLMutex.RLock()
+	defer fake.aPIBaseURLMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
@@ -3893,12 +5404,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.brokerCatalogSnapshotPathMutex.RLock()
+	defer fake.brokerCatalogSnapshotPathMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
+	fake.clearResolveOverridesMutex.RLock()
+	defer fake.clearResolveOverridesMutex.RUnlock()
+	fake.clientCertificateMutex.RLock()
+	defer fake.clientCertificateMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.credentialHelperMutex.RLock()
+	defer fake.credentialHelperMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
@@ -3909,6 +5428,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.dockerPasswordMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
+	fake.experimentalFeatureEnabledMutex.RLock()
+	defer fake.experimentalFeatureEnabledMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
@@ -3917,6 +5438,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.iPFamilyMutex.RLock()
+	defer fake.iPFamilyMutex.RUnlock()
 	fake.isCFOnK8sMutex.RLock()
 	defer fake.isCFOnK8sMutex.RUnlock()
 	fake.isTTYMutex.RLock()
@@ -3925,6 +5448,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.logCacheEndpointMutex.RLock()
 	defer fake.logCacheEndpointMutex.RUnlock()
+	fake.logGroupStyleMutex.RLock()
+	defer fake.logGroupStyleMutex.RUnlock()
+	fake.manifestSnapshotPathMutex.RLock()
+	defer fake.manifestSnapshotPathMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.nOAARequestRetryCountMutex.RLock()
@@ -3933,6 +5460,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.networkPolicyV1EndpointMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.pagerCommandMutex.RLock()
+	defer fake.pagerCommandMutex.RUnlock()
+	fake.pagerEnabledMutex.RLock()
+	defer fake.pagerEnabledMutex.RUnlock()
+	fake.paginationWorkersMutex.RLock()
+	defer fake.paginationWorkersMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -3941,22 +5474,44 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profileMutex.RLock()
+	defer fake.profileMutex.RUnlock()
+	fake.profileNamesMutex.RLock()
+	defer fake.profileNamesMutex.RUnlock()
+	fake.progressStyleMutex.RLock()
+	defer fake.progressStyleMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.requestStatsMutex.RLock()
+	defer fake.requestStatsMutex.RUnlock()
+	fake.resolveOverridesMutex.RLock()
+	defer fake.resolveOverridesMutex.RUnlock()
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setAsyncTimeoutMutex.RLock()
 	defer fake.setAsyncTimeoutMutex.RUnlock()
+	fake.setClientCertificateMutex.RLock()
+	defer fake.setClientCertificateMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setColorThemeMutex.RLock()
+	defer fake.setColorThemeMutex.RUnlock()
+	fake.setCredentialHelperMutex.RLock()
+	defer fake.setCredentialHelperMutex.RUnlock()
+	fake.setExperimentalFeatureEnabledMutex.RLock()
+	defer fake.setExperimentalFeatureEnabledMutex.RUnlock()
 	fake.setKubernetesAuthInfoMutex.RLock()
 	defer fake.setKubernetesAuthInfoMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
@@ -3965,14 +5520,22 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setMinCLIVersionMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPagerEnabledMutex.RLock()
+	defer fake.setPagerEnabledMutex.RUnlock()
+	fake.setProgressStyleMutex.RLock()
+	defer fake.setProgressStyleMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setResolveOverrideMutex.RLock()
+	defer fake.setResolveOverrideMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
 	defer fake.setSpaceInformationMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.setTokenStorageMutex.RLock()
+	defer fake.setTokenStorageMutex.RUnlock()
 	fake.setTraceMutex.RLock()
 	defer fake.setTraceMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
@@ -4013,6 +5576,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
 	fake.v7SetSpaceInformationMutex.RLock()
 	defer fake.v7SetSpaceInformationMutex.RUnlock()
 	fake.verboseMutex.RLock()
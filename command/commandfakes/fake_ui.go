@@ -99,6 +99,17 @@ type FakeUI struct {
 	displayJSONReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayJSONLineStub        func(interface{}) error
+	displayJSONLineMutex       sync.RWMutex
+	displayJSONLineArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayJSONLineReturns struct {
+		result1 error
+	}
+	displayJSONLineReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayKeyValueTableStub        func(string, [][]string, int)
 	displayKeyValueTableMutex       sync.RWMutex
 	displayKeyValueTableArgsForCall []struct {
@@ -161,6 +172,15 @@ type FakeUI struct {
 		result1 string
 		result2 error
 	}
+	DisplayTableWithColumnsStub        func(string, []ui.TableColumn, [][]string, []int, int)
+	displayTableWithColumnsMutex       sync.RWMutex
+	displayTableWithColumnsArgsForCall []struct {
+		arg1 string
+		arg2 []ui.TableColumn
+		arg3 [][]string
+		arg4 []int
+		arg5 int
+	}
 	DisplayTableWithHeaderStub        func(string, [][]string, int)
 	displayTableWithHeaderMutex       sync.RWMutex
 	displayTableWithHeaderArgsForCall []struct {
@@ -256,6 +276,16 @@ type FakeUI struct {
 	getOutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	NewScreenStub        func() *ui.Screen
+	newScreenMutex       sync.RWMutex
+	newScreenArgsForCall []struct {
+	}
+	newScreenReturns struct {
+		result1 *ui.Screen
+	}
+	newScreenReturnsOnCall map[int]struct {
+		result1 *ui.Screen
+	}
 	RequestLoggerFileWriterStub        func([]string) *ui.RequestLoggerFileWriter
 	requestLoggerFileWriterMutex       sync.RWMutex
 	requestLoggerFileWriterArgsForCall []struct {
@@ -277,6 +307,10 @@ type FakeUI struct {
 	requestLoggerTerminalDisplayReturnsOnCall map[int]struct {
 		result1 *ui.RequestLoggerTerminalDisplay
 	}
+	StartStructuredOutputStub        func()
+	startStructuredOutputMutex       sync.RWMutex
+	startStructuredOutputArgsForCall []struct {
+	}
 	TranslateTextStub        func(string, ...map[string]interface{}) string
 	translateTextMutex       sync.RWMutex
 	translateTextArgsForCall []struct {
@@ -737,15 +771,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -791,6 +826,67 @@ func (fake *FakeUI) DisplayJSONReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeUI) DisplayJSONLine(arg1 interface{}) error {
+	fake.displayJSONLineMutex.Lock()
+	ret, specificReturn := fake.displayJSONLineReturnsOnCall[len(fake.displayJSONLineArgsForCall)]
+	fake.displayJSONLineArgsForCall = append(fake.displayJSONLineArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayJSONLineStub
+	fakeReturns := fake.displayJSONLineReturns
+	fake.recordInvocation("DisplayJSONLine", []interface{}{arg1})
+	fake.displayJSONLineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayJSONLineCallCount() int {
+	fake.displayJSONLineMutex.RLock()
+	defer fake.displayJSONLineMutex.RUnlock()
+	return len(fake.displayJSONLineArgsForCall)
+}
+
+func (fake *FakeUI) DisplayJSONLineCalls(stub func(interface{}) error) {
+	fake.displayJSONLineMutex.Lock()
+	defer fake.displayJSONLineMutex.Unlock()
+	fake.DisplayJSONLineStub = stub
+}
+
+func (fake *FakeUI) DisplayJSONLineArgsForCall(i int) interface{} {
+	fake.displayJSONLineMutex.RLock()
+	defer fake.displayJSONLineMutex.RUnlock()
+	argsForCall := fake.displayJSONLineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayJSONLineReturns(result1 error) {
+	fake.displayJSONLineMutex.Lock()
+	defer fake.displayJSONLineMutex.Unlock()
+	fake.DisplayJSONLineStub = nil
+	fake.displayJSONLineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayJSONLineReturnsOnCall(i int, result1 error) {
+	fake.displayJSONLineMutex.Lock()
+	defer fake.displayJSONLineMutex.Unlock()
+	fake.DisplayJSONLineStub = nil
+	if fake.displayJSONLineReturnsOnCall == nil {
+		fake.displayJSONLineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayJSONLineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayKeyValueTable(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1118,6 +1214,57 @@ func (fake *FakeUI) DisplayPasswordPromptReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUI) DisplayTableWithColumns(arg1 string, arg2 []ui.TableColumn, arg3 [][]string, arg4 []int, arg5 int) {
+	var arg2Copy []ui.TableColumn
+	if arg2 != nil {
+		arg2Copy = make([]ui.TableColumn, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy [][]string
+	if arg3 != nil {
+		arg3Copy = make([][]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []int
+	if arg4 != nil {
+		arg4Copy = make([]int, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.displayTableWithColumnsMutex.Lock()
+	fake.displayTableWithColumnsArgsForCall = append(fake.displayTableWithColumnsArgsForCall, struct {
+		arg1 string
+		arg2 []ui.TableColumn
+		arg3 [][]string
+		arg4 []int
+		arg5 int
+	}{arg1, arg2Copy, arg3Copy, arg4Copy, arg5})
+	stub := fake.DisplayTableWithColumnsStub
+	fake.recordInvocation("DisplayTableWithColumns", []interface{}{arg1, arg2Copy, arg3Copy, arg4Copy, arg5})
+	fake.displayTableWithColumnsMutex.Unlock()
+	if stub != nil {
+		fake.DisplayTableWithColumnsStub(arg1, arg2, arg3, arg4, arg5)
+	}
+}
+
+func (fake *FakeUI) DisplayTableWithColumnsCallCount() int {
+	fake.displayTableWithColumnsMutex.RLock()
+	defer fake.displayTableWithColumnsMutex.RUnlock()
+	return len(fake.displayTableWithColumnsArgsForCall)
+}
+
+func (fake *FakeUI) DisplayTableWithColumnsCalls(stub func(string, []ui.TableColumn, [][]string, []int, int)) {
+	fake.displayTableWithColumnsMutex.Lock()
+	defer fake.displayTableWithColumnsMutex.Unlock()
+	fake.DisplayTableWithColumnsStub = stub
+}
+
+func (fake *FakeUI) DisplayTableWithColumnsArgsForCall(i int) (string, []ui.TableColumn, [][]string, []int, int) {
+	fake.displayTableWithColumnsMutex.RLock()
+	defer fake.displayTableWithColumnsMutex.RUnlock()
+	argsForCall := fake.displayTableWithColumnsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
 func (fake *FakeUI) DisplayTableWithHeader(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1621,6 +1768,59 @@ func (fake *FakeUI) GetOutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeUI) NewScreen() *ui.Screen {
+	fake.newScreenMutex.Lock()
+	ret, specificReturn := fake.newScreenReturnsOnCall[len(fake.newScreenArgsForCall)]
+	fake.newScreenArgsForCall = append(fake.newScreenArgsForCall, struct {
+	}{})
+	stub := fake.NewScreenStub
+	fakeReturns := fake.newScreenReturns
+	fake.recordInvocation("NewScreen", []interface{}{})
+	fake.newScreenMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) NewScreenCallCount() int {
+	fake.newScreenMutex.RLock()
+	defer fake.newScreenMutex.RUnlock()
+	return len(fake.newScreenArgsForCall)
+}
+
+func (fake *FakeUI) NewScreenCalls(stub func() *ui.Screen) {
+	fake.newScreenMutex.Lock()
+	defer fake.newScreenMutex.Unlock()
+	fake.NewScreenStub = stub
+}
+
+func (fake *FakeUI) NewScreenReturns(result1 *ui.Screen) {
+	fake.newScreenMutex.Lock()
+	defer fake.newScreenMutex.Unlock()
+	fake.NewScreenStub = nil
+	fake.newScreenReturns = struct {
+		result1 *ui.Screen
+	}{result1}
+}
+
+func (fake *FakeUI) NewScreenReturnsOnCall(i int, result1 *ui.Screen) {
+	fake.newScreenMutex.Lock()
+	defer fake.newScreenMutex.Unlock()
+	fake.NewScreenStub = nil
+	if fake.newScreenReturnsOnCall == nil {
+		fake.newScreenReturnsOnCall = make(map[int]struct {
+			result1 *ui.Screen
+		})
+	}
+	fake.newScreenReturnsOnCall[i] = struct {
+		result1 *ui.Screen
+	}{result1}
+}
+
 func (fake *FakeUI) RequestLoggerFileWriter(arg1 []string) *ui.RequestLoggerFileWriter {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -1740,6 +1940,30 @@ func (fake *FakeUI) RequestLoggerTerminalDisplayReturnsOnCall(i int, result1 *ui
 	}{result1}
 }
 
+func (fake *FakeUI) StartStructuredOutput() {
+	fake.startStructuredOutputMutex.Lock()
+	fake.startStructuredOutputArgsForCall = append(fake.startStructuredOutputArgsForCall, struct {
+	}{})
+	stub := fake.StartStructuredOutputStub
+	fake.recordInvocation("StartStructuredOutput", []interface{}{})
+	fake.startStructuredOutputMutex.Unlock()
+	if stub != nil {
+		fake.StartStructuredOutputStub()
+	}
+}
+
+func (fake *FakeUI) StartStructuredOutputCallCount() int {
+	fake.startStructuredOutputMutex.RLock()
+	defer fake.startStructuredOutputMutex.RUnlock()
+	return len(fake.startStructuredOutputArgsForCall)
+}
+
+func (fake *FakeUI) StartStructuredOutputCalls(stub func()) {
+	fake.startStructuredOutputMutex.Lock()
+	defer fake.startStructuredOutputMutex.Unlock()
+	fake.StartStructuredOutputStub = stub
+}
+
 func (fake *FakeUI) TranslateText(arg1 string, arg2 ...map[string]interface{}) string {
 	fake.translateTextMutex.Lock()
 	ret, specificReturn := fake.translateTextReturnsOnCall[len(fake.translateTextArgsForCall)]
@@ -1943,6 +2167,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayInstancesTableForAppMutex.RUnlock()
 	fake.displayJSONMutex.RLock()
 	defer fake.displayJSONMutex.RUnlock()
+	fake.displayJSONLineMutex.RLock()
+	defer fake.displayJSONLineMutex.RUnlock()
 	fake.displayKeyValueTableMutex.RLock()
 	defer fake.displayKeyValueTableMutex.RUnlock()
 	fake.displayKeyValueTableForAppMutex.RLock()
@@ -1959,6 +2185,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayOptionalTextPromptMutex.RUnlock()
 	fake.displayPasswordPromptMutex.RLock()
 	defer fake.displayPasswordPromptMutex.RUnlock()
+	fake.displayTableWithColumnsMutex.RLock()
+	defer fake.displayTableWithColumnsMutex.RUnlock()
 	fake.displayTableWithHeaderMutex.RLock()
 	defer fake.displayTableWithHeaderMutex.RUnlock()
 	fake.displayTextMutex.RLock()
@@ -1981,10 +2209,14 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.getInMutex.RUnlock()
 	fake.getOutMutex.RLock()
 	defer fake.getOutMutex.RUnlock()
+	fake.newScreenMutex.RLock()
+	defer fake.newScreenMutex.RUnlock()
 	fake.requestLoggerFileWriterMutex.RLock()
 	defer fake.requestLoggerFileWriterMutex.RUnlock()
 	fake.requestLoggerTerminalDisplayMutex.RLock()
 	defer fake.requestLoggerTerminalDisplayMutex.RUnlock()
+	fake.startStructuredOutputMutex.RLock()
+	defer fake.startStructuredOutputMutex.RUnlock()
 	fake.translateTextMutex.RLock()
 	defer fake.translateTextMutex.RUnlock()
 	fake.userFriendlyDateMutex.RLock()
@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
@@ -11,16 +12,24 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	Compat           bool              `long:"compat" description:"Translate flags that were removed in v7 of the CLI into their v7 equivalents where possible"`
+	PreferIPv4       bool              `long:"prefer-ipv4" description:"Connect over IPv4 first when a host has both IPv4 and IPv6 addresses"`
+	PreferIPv6       bool              `long:"prefer-ipv6" description:"Connect over IPv6 first when a host has both IPv4 and IPv6 addresses"`
+	Stats            bool              `long:"stats" description:"Print the duration, API calls, bytes transferred, retries and slowest endpoints of the command when it ends"`
+	Output           flag.OutputFormat `long:"output" choice:"table" choice:"json" description:"Output format; json prints the tables of the command as JSON on stdout and everything else on stderr"`
+	Record           flag.Path         `long:"record" description:"Write a transcript of the command line and its output, with timestamps and secrets redacted, to this file"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
 	API                                v7.APICommand                                `command:"api" description:"Set or view target api url"`
+	APIResources                       v7.APIResourcesCommand                       `command:"api-resources" description:"List the resources of the targeted API with their paths, query parameters and commands"`
 	AddNetworkPolicy                   v7.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
+	ApplyNetworkPolicies               v7.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Create the network policies of a YAML policy bundle for the apps in the targeted space"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -31,11 +40,14 @@ type commandList struct {
 	Buildpacks                         v7.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CancelDeployment                   v7.CancelDeploymentCommand                   `command:"cancel-deployment" description:"Cancel the most recent deployment for an app. Resets the current droplet to the previous deployment's droplet."`
 	CheckRoute                         v7.CheckRouteCommand                         `command:"check-route" description:"Perform a check to determine whether a route currently exists or not"`
+	ClearBuildCache                    v7.ClearBuildCacheCommand                    `command:"clear-build-cache" description:"Delete the buildpack cache of an app so its next build starts clean"`
 	Config                             v7.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	ContinueDeployment                 v7.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Continue a paused canary deployment of an app"`
 	CopySource                         v7.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application and restages that application"`
 	CreateApp                          v7.CreateAppCommand                          `command:"create-app" description:"Create an Application in the target space"`
 	CreateAppManifest                  v7.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v7.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
+	CreateDrain                        v7.CreateDrainCommand                        `command:"create-drain" description:"Create a syslog drain for an app"`
 	CreatePackage                      v7.CreatePackageCommand                      `command:"create-package" description:"Uploads a Package"`
 	CreateIsolationSegment             v7.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v7.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
@@ -54,6 +66,7 @@ type commandList struct {
 	Curl                               v7.CurlCommand                               `command:"curl" description:"Executes a request to the targeted API endpoint"`
 	Delete                             v7.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
 	DeleteBuildpack                    v7.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
+	DeleteDrain                        v7.DeleteDrainCommand                        `command:"delete-drain" description:"Delete a syslog drain"`
 	DeleteIsolationSegment             v7.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v7.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
 	DeleteOrgQuota                     v7.DeleteOrgQuotaCommand                     `command:"delete-org-quota" alias:"delete-quota" description:"Delete an organization quota"`
@@ -68,36 +81,54 @@ type commandList struct {
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	DevWatch                           v7.DevWatchCommand                           `command:"dev-watch" description:"Push an app and push it again with a rolling restart whenever its files change"`
+	DiffManifest                       v7.DiffManifestCommand                       `command:"diff-manifest" description:"Show how applying a manifest would change the apps of the space"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisableServiceAccess               v7.DisableServiceAccessCommand               `command:"disable-service-access" description:"Disable access to a service offering or service plan for one or all orgs"`
 	DisallowSpaceSSH                   v7.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
+	Docs                               DocsCommand                                  `command:"docs" description:"Print the full documentation of a command or of app manifests, optionally as a man page"`
 	Domains                            v7.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
 	DownloadDroplet                    v7.DownloadDropletCommand                    `command:"download-droplet" description:"Download an application droplet"`
+	DownloadSBOM                       v7.DownloadSBOMCommand                       `command:"download-sbom" description:"Download the software bill of materials of an application droplet"`
+	Drain                              v7.DrainCommand                              `command:"drain" description:"List the syslog drains of an app"`
+	Drains                             v7.DrainsCommand                             `command:"drains" description:"List syslog drains in the target space"`
 	Droplets                           v7.DropletsCommand                           `command:"droplets" description:"List droplets of an app"`
+	Dump                               v7.DumpCommand                               `command:"dump" description:"Collect thread, heap or core dumps from an app instance and download them"`
 	EnableFeatureFlag                  v7.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v7.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableSSH                          v7.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
-	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	Events                             v7.EventsCommand                             `command:"events" description:"Show recent events of an app, space or org"`
+	Exec                               v7.ExecCommand                               `command:"exec" description:"Run a one-off command in an app container instance without an interactive shell"`
+	Experimental                       v7.ExperimentalCommand                       `command:"experimental" description:"List experimental features and whether they are turned on"`
+	ExportImage                        v7.ExportImageCommand                        `command:"export-image" description:"Export the droplet of an app as a container image"`
+	ExportNetworkPolicies              v7.ExportNetworkPoliciesCommand              `command:"export-network-policies" description:"Write the network policies of the apps in a space as a YAML policy bundle"`
+	ExportSpaceManifest                v7.ExportSpaceManifestCommand                `command:"export-space-manifest" description:"Create a manifest of every app in the targeted space"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
+	InternalRoutes                     v7.InternalRoutesCommand                     `command:"internal-routes" description:"List the apps mapped to internal domains and check their reachability from an app"`
 	IsolationSegments                  v7.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Job                                v7.JobCommand                                `command:"job" description:"Show the state of a background job, or wait for it to finish"`
 	Labels                             v7.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v7.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
 	Logout                             v7.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
 	Logs                               v7.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
+	LogsTask                           v7.LogsTaskCommand                           `command:"logs-task" alias:"tail-task" description:"Tail or show recent logs for a task"`
+	Lookup                             v7.LookupCommand                             `command:"lookup" description:"Show what a GUID refers to"`
 	MapRoute                           v7.MapRouteCommand                           `command:"map-route" description:"Map a route to an app"`
 	Marketplace                        v7.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
+	MigrateRoutes                      v7.MigrateRoutesCommand                      `command:"migrate-routes" description:"Recreate the routes of a domain on another domain"`
 	NetworkPolicies                    v7.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	OauthToken                         v7.OauthTokenCommand                         `command:"oauth-token" description:"Display the OAuth token for the current session and refresh the token if necessary"`
 	Org                                v7.OrgCommand                                `command:"org" description:"Show org info"`
+	OrgEvents                          v7.OrgEventsCommand                          `command:"org-events" description:"Show recent events of an org and the resources in it"`
 	OrgQuotas                          v7.OrgQuotasCommand                          `command:"org-quotas" alias:"quotas" description:"List available organization quotas"`
 	OrgQuota                           v7.OrgQuotaCommand                           `command:"org-quota" alias:"quota" description:"Show organization quota"`
 	OrgUsers                           v7.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
@@ -105,6 +136,8 @@ type commandList struct {
 	Packages                           v7.PackagesCommand                           `command:"packages" description:"List packages of an app"`
 	Passwd                             v7.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Processes                          v7.ProcessesCommand                          `command:"processes" description:"List the processes of an app with their instances, limits and health checks"`
+	PromoteServiceBroker               v7.PromoteServiceBrokerCommand               `command:"promote-service-broker" description:"Register a space-scoped service broker globally"`
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -122,6 +155,8 @@ type commandList struct {
 	Revision                           v7.RevisionCommand                           `command:"revision" description:"Show details for a specific app revision"`
 	Revisions                          v7.RevisionsCommand                          `command:"revisions" description:"List revisions of an app"`
 	Rollback                           v7.RollbackCommand                           `command:"rollback" description:"Rollback to the specified revision of an app"`
+	RolloutStatus                      v7.RolloutStatusCommand                      `command:"rollout-status" description:"Display the progress of an app's latest deployment and wait for it to finish"`
+	RotateServiceBrokerCredentials     v7.RotateServiceBrokerCredentialsCommand     `command:"rotate-service-broker-credentials" description:"Replace the username and password the platform uses to reach a service broker"`
 	StagePackage                       v7.StagePackageCommand                       `command:"stage-package" alias:"stage" description:"Stage a package into a droplet"`
 	Restart                            v7.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again."`
 	RestartAppInstance                 v7.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then instantiate an app instance"`
@@ -134,18 +169,22 @@ type commandList struct {
 	SSH                                v7.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	SSHCode                            v7.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
 	SSHEnabled                         v7.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
+	SSHReport                          v7.SSHReportCommand                          `command:"ssh-report" description:"List whether SSH is enabled for the apps in the targeted space or org"`
 	Scale                              v7.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, memory limit, and log rate limit for an app"`
 	SecurityGroup                      v7.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
 	SecurityGroups                     v7.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
+	SecurityReport                     v7.SecurityReportCommand                     `command:"security-report" description:"Report security-relevant settings and findings for an app"`
 	Service                            v7.ServiceCommand                            `command:"service" description:"Show service instance info"`
 	ServiceAccess                      v7.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
 	ServiceBrokers                     v7.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKey                         v7.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	ServiceKeys                        v7.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	Services                           v7.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
+	SetBuildpacks                      v7.SetBuildpacksCommand                      `command:"set-buildpacks" description:"Set the ordered list of buildpacks used to stage an app"`
 	SetDroplet                         v7.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app"`
 	SetEnv                             v7.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v7.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app's process"`
+	SetPorts                           v7.SetPortsCommand                           `command:"set-ports" description:"Set the ports that an app's process listens on"`
 	SetLabel                           v7.SetLabelCommand                           `command:"set-label" description:"Set a label (key-value pairs) for an API resource"`
 	SetOrgDefaultIsolationSegment      v7.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v7.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
@@ -155,10 +194,12 @@ type commandList struct {
 	SetSpaceQuota                      v7.SetSpaceQuotaCommand                      `command:"set-space-quota" description:"Assign a quota to a space"`
 	SetSpaceRole                       v7.SetSpaceRoleCommand                       `command:"set-space-role" description:"Assign a space role to a user"`
 	SetStagingEnvironmentVariableGroup v7.SetStagingEnvironmentVariableGroupCommand `command:"set-staging-environment-variable-group" alias:"ssevg" description:"Pass parameters as JSON to create a staging environment variable group"`
+	SetStartCommand                    v7.SetStartCommandCommand                    `command:"set-start-command" description:"Change the start command of an app's process"`
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
+	SpaceEvents                        v7.SpaceEventsCommand                        `command:"space-events" description:"Show recent events of a space and the resources in it"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceQuotas                        v7.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space quotas"`
 	SpaceSSHAllowed                    v7.SpaceSSHAllowedCommand                    `command:"space-ssh-allowed" description:"Reports whether SSH is allowed in a space"`
@@ -171,9 +212,12 @@ type commandList struct {
 	Start                              v7.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v7.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v7.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	TargetSave                         v7.TargetSaveCommand                         `command:"target-save" description:"Save the current target as a named profile"`
+	TargetUse                          v7.TargetUseCommand                          `command:"target-use" description:"Target the API endpoint, org and space of a saved profile"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v7.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
 	MoveRoute                          v7.MoveRouteCommand                          `command:"move-route" description:"Assign a route to a different space"`
+	Top                                v7.TopCommand                                `command:"top" description:"Show a refreshing dashboard of the resource usage of the apps in the space"`
 	UnbindRouteService                 v7.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
 	UnbindRunningSecurityGroup         v7.UnbindRunningSecurityGroupCommand         `command:"unbind-running-security-group" description:"Unbind a security group from the set of security groups for running applications globally"`
 	UnbindSecurityGroup                v7.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
@@ -198,6 +242,8 @@ type commandList struct {
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UsageSnapshot                      v7.UsageSnapshotCommand                      `command:"usage-snapshot" description:"Report the memory usage, tasks and service instances of the spaces of an org over a period"`
+	Verify                             v7.VerifyCommand                             `command:"verify" description:"Watch an app for restarts and failing requests after a deployment"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -34,6 +34,18 @@ type FakeHelpActor struct {
 	commandInfosReturnsOnCall map[int]struct {
 		result1 map[string]sharedaction.CommandInfo
 	}
+	SearchCommandsStub        func(interface{}, string) []sharedaction.CommandSearchResult
+	searchCommandsMutex       sync.RWMutex
+	searchCommandsArgsForCall []struct {
+		arg1 interface{}
+		arg2 string
+	}
+	searchCommandsReturns struct {
+		result1 []sharedaction.CommandSearchResult
+	}
+	searchCommandsReturnsOnCall map[int]struct {
+		result1 []sharedaction.CommandSearchResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -162,6 +174,67 @@ func (fake *FakeHelpActor) CommandInfosReturnsOnCall(i int, result1 map[string]s
 	}{result1}
 }
 
+func (fake *FakeHelpActor) SearchCommands(arg1 interface{}, arg2 string) []sharedaction.CommandSearchResult {
+	fake.searchCommandsMutex.Lock()
+	ret, specificReturn := fake.searchCommandsReturnsOnCall[len(fake.searchCommandsArgsForCall)]
+	fake.searchCommandsArgsForCall = append(fake.searchCommandsArgsForCall, struct {
+		arg1 interface{}
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SearchCommands", []interface{}{arg1, arg2})
+	fake.searchCommandsMutex.Unlock()
+	if fake.SearchCommandsStub != nil {
+		return fake.SearchCommandsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.searchCommandsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeHelpActor) SearchCommandsCallCount() int {
+	fake.searchCommandsMutex.RLock()
+	defer fake.searchCommandsMutex.RUnlock()
+	return len(fake.searchCommandsArgsForCall)
+}
+
+func (fake *FakeHelpActor) SearchCommandsCalls(stub func(interface{}, string) []sharedaction.CommandSearchResult) {
+	fake.searchCommandsMutex.Lock()
+	defer fake.searchCommandsMutex.Unlock()
+	fake.SearchCommandsStub = stub
+}
+
+func (fake *FakeHelpActor) SearchCommandsArgsForCall(i int) (interface{}, string) {
+	fake.searchCommandsMutex.RLock()
+	defer fake.searchCommandsMutex.RUnlock()
+	argsForCall := fake.searchCommandsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeHelpActor) SearchCommandsReturns(result1 []sharedaction.CommandSearchResult) {
+	fake.searchCommandsMutex.Lock()
+	defer fake.searchCommandsMutex.Unlock()
+	fake.SearchCommandsStub = nil
+	fake.searchCommandsReturns = struct {
+		result1 []sharedaction.CommandSearchResult
+	}{result1}
+}
+
+func (fake *FakeHelpActor) SearchCommandsReturnsOnCall(i int, result1 []sharedaction.CommandSearchResult) {
+	fake.searchCommandsMutex.Lock()
+	defer fake.searchCommandsMutex.Unlock()
+	fake.SearchCommandsStub = nil
+	if fake.searchCommandsReturnsOnCall == nil {
+		fake.searchCommandsReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.CommandSearchResult
+		})
+	}
+	fake.searchCommandsReturnsOnCall[i] = struct {
+		result1 []sharedaction.CommandSearchResult
+	}{result1}
+}
+
 func (fake *FakeHelpActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -169,6 +242,8 @@ func (fake *FakeHelpActor) Invocations() map[string][][]interface{} {
 	defer fake.commandInfoByNameMutex.RUnlock()
 	fake.commandInfosMutex.RLock()
 	defer fake.commandInfosMutex.RUnlock()
+	fake.searchCommandsMutex.RLock()
+	defer fake.searchCommandsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commonfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/common"
+)
+
+type FakeVersionActor struct {
+	GetRootResponseStub        func() (v7action.Info, v7action.Warnings, error)
+	getRootResponseMutex       sync.RWMutex
+	getRootResponseArgsForCall []struct {
+	}
+	getRootResponseReturns struct {
+		result1 v7action.Info
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRootResponseReturnsOnCall map[int]struct {
+		result1 v7action.Info
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeVersionActor) GetRootResponse() (v7action.Info, v7action.Warnings, error) {
+	fake.getRootResponseMutex.Lock()
+	ret, specificReturn := fake.getRootResponseReturnsOnCall[len(fake.getRootResponseArgsForCall)]
+	fake.getRootResponseArgsForCall = append(fake.getRootResponseArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetRootResponse", []interface{}{})
+	fake.getRootResponseMutex.Unlock()
+	if fake.GetRootResponseStub != nil {
+		return fake.GetRootResponseStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRootResponseReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVersionActor) GetRootResponseCallCount() int {
+	fake.getRootResponseMutex.RLock()
+	defer fake.getRootResponseMutex.RUnlock()
+	return len(fake.getRootResponseArgsForCall)
+}
+
+func (fake *FakeVersionActor) GetRootResponseCalls(stub func() (v7action.Info, v7action.Warnings, error)) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = stub
+}
+
+func (fake *FakeVersionActor) GetRootResponseReturns(result1 v7action.Info, result2 v7action.Warnings, result3 error) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = nil
+	fake.getRootResponseReturns = struct {
+		result1 v7action.Info
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Info, result2 v7action.Warnings, result3 error) {
+	fake.getRootResponseMutex.Lock()
+	defer fake.getRootResponseMutex.Unlock()
+	fake.GetRootResponseStub = nil
+	if fake.getRootResponseReturnsOnCall == nil {
+		fake.getRootResponseReturnsOnCall = make(map[int]struct {
+			result1 v7action.Info
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRootResponseReturnsOnCall[i] = struct {
+		result1 v7action.Info
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getRootResponseMutex.RLock()
+	defer fake.getRootResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeVersionActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ common.VersionActor = new(FakeVersionActor)
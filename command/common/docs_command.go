@@ -0,0 +1,254 @@
+package common
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/common/internal"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+const docsManual = "Cloud Foundry CLI"
+
+type DocsCommand struct {
+	UI     command.UI
+	Actor  HelpActor
+	Config command.Config
+
+	OptionalArgs    flag.CommandName `positional-args:"yes"`
+	Man             bool             `long:"man" description:"Print the documentation as a man page"`
+	Manifest        bool             `long:"manifest" description:"Document the attributes of app manifests instead of a command"`
+	usage           interface{}      `usage:"CF_NAME docs [COMMAND] [--man]\n   CF_NAME docs --manifest [--man]\n\n   Print the full documentation of COMMAND, or of all the commands, including the global options and environment variables. It is part of the CLI and needs no network access."`
+	examples        interface{}      `examples:"CF_NAME docs push\nCF_NAME docs push --man | man -l -\nCF_NAME docs --manifest\nCF_NAME docs --man > cf.1"`
+	relatedCommands interface{}      `related_commands:"help"`
+}
+
+func (cmd *DocsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Actor = sharedaction.NewActor(config)
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd DocsCommand) Execute(args []string) error {
+	if cmd.Manifest && cmd.OptionalArgs.CommandName != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--manifest", "COMMAND"},
+		}
+	}
+
+	switch {
+	case cmd.Manifest && cmd.Man:
+		return cmd.write(cmd.manifestManPage())
+	case cmd.Manifest:
+		cmd.displayManifestReference()
+	case cmd.OptionalArgs.CommandName == "" && cmd.Man:
+		return cmd.write(cmd.indexManPage())
+	case cmd.OptionalArgs.CommandName == "":
+		cmd.help().displayFullHelp()
+	case cmd.Man:
+		cmdInfo, err := cmd.help().commandInfo()
+		if err != nil {
+			return err
+		}
+		return cmd.write(cmd.commandManPage(cmdInfo))
+	default:
+		return cmd.displayCommand()
+	}
+
+	return nil
+}
+
+func (cmd DocsCommand) help() HelpCommand {
+	return HelpCommand{
+		UI:           cmd.UI,
+		Actor:        cmd.Actor,
+		Config:       cmd.Config,
+		OptionalArgs: cmd.OptionalArgs,
+		AllCommands:  true,
+	}
+}
+
+// displayCommand displays the help of the command followed by the global
+// options and environment variables, which apply to every command.
+func (cmd DocsCommand) displayCommand() error {
+	help := cmd.help()
+	err := help.displayCommand()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("GLOBAL OPTIONS:")
+	cmd.UI.DisplayNonWrappingTable(sharedaction.CommandIndent, help.globalOptionsTableData(), 1)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("GLOBAL ENVIRONMENT:")
+	cmd.UI.DisplayNonWrappingTable(sharedaction.CommandIndent, help.environmentalVariablesTableData(), 1)
+
+	return nil
+}
+
+func (cmd DocsCommand) displayManifestReference() {
+	cmd.UI.DisplayText("MANIFEST:")
+	cmd.UI.DisplayText(sharedaction.CommandIndent + cmd.binaryNamed(internal.ManifestReferenceDescription))
+	cmd.UI.DisplayNewline()
+
+	table := make([][]string, 0, len(internal.ManifestReference))
+	for _, attribute := range internal.ManifestReference {
+		table = append(table, []string{attribute.Name, attribute.Type, cmd.UI.TranslateText(attribute.Description)})
+	}
+	cmd.UI.DisplayText("ATTRIBUTES:")
+	cmd.UI.DisplayNonWrappingTable(sharedaction.CommandIndent, table, 2)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("EXAMPLE:")
+	cmd.UI.DisplayText(indent(internal.ManifestExample))
+}
+
+func (cmd DocsCommand) commandManPage(cmdInfo sharedaction.CommandInfo) string {
+	binaryName := cmd.Config.BinaryName()
+	page := internal.NewManPage(binaryName+"-"+cmdInfo.Name, 1, cmd.manSource(), docsManual)
+
+	page.Section("NAME")
+	page.Paragraph(binaryName + "-" + cmdInfo.Name + " - " + cmd.UI.TranslateText(cmdInfo.Description))
+
+	page.Section("SYNOPSIS")
+	page.Preformatted(sharedaction.CommandIndent + cmd.binaryNamed(cmd.UI.TranslateText(cmdInfo.Usage)))
+
+	if len(cmdInfo.Flags) != 0 {
+		page.Section("OPTIONS")
+		for _, flag := range cmdInfo.Flags {
+			description := cmd.UI.TranslateText(flag.Description)
+			if flag.Default != "" {
+				description += cmd.UI.TranslateText(" (Default: {{.DefaultValue}})", map[string]interface{}{
+					"DefaultValue": flag.Default,
+				})
+			}
+			page.Item(internal.FlagWithHyphens(flag), description)
+		}
+	}
+
+	if len(cmdInfo.Environment) != 0 {
+		page.Section("ENVIRONMENT")
+		for _, envVar := range cmdInfo.Environment {
+			page.Item(envVar.Name+"="+envVar.DefaultValue, cmd.UI.TranslateText(envVar.Description))
+		}
+	}
+
+	if cmdInfo.Examples != "" {
+		page.Section("EXAMPLES")
+		page.Preformatted(sharedaction.CommandIndent + cmd.binaryNamed(cmdInfo.Examples))
+	}
+
+	if cmdInfo.Resources != "" {
+		page.Section("RESOURCES")
+		page.Preformatted(sharedaction.CommandIndent + cmdInfo.Resources)
+	}
+
+	if cmdInfo.Alias != "" {
+		page.Section("ALIASES")
+		page.Paragraph(cmdInfo.Alias)
+	}
+
+	references := []string{binaryName}
+	for _, related := range cmdInfo.RelatedCommands {
+		references = append(references, binaryName+"-"+related)
+	}
+	page.Section("SEE ALSO")
+	page.References(references, 1)
+
+	return page.String()
+}
+
+// indexManPage returns the man page of the CLI itself, which lists every
+// command by category along with the global options and environment
+// variables.
+func (cmd DocsCommand) indexManPage() string {
+	binaryName := cmd.Config.BinaryName()
+	help := cmd.help()
+	cmdInfo := cmd.Actor.CommandInfos(Commands)
+	page := internal.NewManPage(binaryName, 1, cmd.manSource(), docsManual)
+
+	page.Section("NAME")
+	page.Paragraph(binaryName + " - " + cmd.UI.TranslateText("A command line tool to interact with Cloud Foundry"))
+
+	page.Section("SYNOPSIS")
+	page.Preformatted(binaryName + " " + cmd.UI.TranslateText("[global options] command [arguments...] [command options]"))
+
+	page.Section("COMMANDS")
+	for _, categories := range [][]internal.HelpCategory{internal.HelpCategoryList, internal.ExperimentalHelpCategoryList} {
+		for _, category := range categories {
+			page.Subsection(strings.TrimSuffix(category.CategoryName, ":"))
+			for _, row := range category.CommandList {
+				for _, name := range row {
+					page.Item(name, cmd.UI.TranslateText(cmdInfo[name].Description))
+				}
+			}
+		}
+	}
+
+	page.Section("GLOBAL OPTIONS")
+	for _, option := range help.globalOptionsTableData() {
+		page.Item(option[0], option[1])
+	}
+
+	page.Section("ENVIRONMENT")
+	for _, envVar := range help.environmentalVariablesTableData() {
+		page.Item(envVar[0], envVar[1])
+	}
+
+	page.Section("SEE ALSO")
+	page.Paragraph(cmd.binaryNamed(cmd.UI.TranslateText("'CF_NAME docs COMMAND --man' prints the man page of a command, and 'CF_NAME docs --manifest --man' the one of app manifests.")))
+	page.References([]string{binaryName + "-manifest"}, 5)
+
+	return page.String()
+}
+
+func (cmd DocsCommand) manifestManPage() string {
+	binaryName := cmd.Config.BinaryName()
+	page := internal.NewManPage(binaryName+"-manifest", 5, cmd.manSource(), docsManual)
+
+	page.Section("NAME")
+	page.Paragraph(binaryName + "-manifest - " + cmd.UI.TranslateText("Format of the app manifests read by push"))
+
+	page.Section("DESCRIPTION")
+	page.Paragraph(cmd.binaryNamed(internal.ManifestReferenceDescription))
+
+	page.Section("ATTRIBUTES")
+	for _, attribute := range internal.ManifestReference {
+		page.Item(attribute.Name+" ("+attribute.Type+")", cmd.UI.TranslateText(attribute.Description))
+	}
+
+	page.Section("EXAMPLE")
+	page.Preformatted(internal.ManifestExample)
+
+	page.Section("SEE ALSO")
+	page.References([]string{binaryName, binaryName + "-push"}, 1)
+
+	return page.String()
+}
+
+// manSource is the source of the man pages, shown in their footer.
+func (cmd DocsCommand) manSource() string {
+	return cmd.Config.BinaryName() + " " + cmd.Config.BinaryVersion()
+}
+
+func (cmd DocsCommand) binaryNamed(text string) string {
+	return strings.Replace(text, "CF_NAME", cmd.Config.BinaryName(), -1)
+}
+
+// write writes the man page as it is, since it is not meant to be read
+// before man formats it.
+func (cmd DocsCommand) write(page string) error {
+	_, err := cmd.UI.GetOut().Write([]byte(page))
+	return err
+}
+
+// indent indents every line of the text like the help of the commands.
+func indent(text string) string {
+	return sharedaction.CommandIndent + strings.Replace(text, "\n", "\n"+sharedaction.CommandIndent, -1)
+}
@@ -0,0 +1,197 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("docs Command", func() {
+	var (
+		cmd        DocsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeHelpActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.BinaryVersionReturns("8.0.0")
+		fakeActor = new(commonfakes.FakeHelpActor)
+
+		cmd = DocsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{
+			Name:        "push",
+			Description: "Push a new app",
+			Alias:       "p",
+			Usage:       "CF_NAME push APP_NAME\n   [-b BUILDPACK_NAME]",
+			Examples:    "CF_NAME push my-app",
+			Flags: []sharedaction.CommandFlag{
+				{Short: "b", Long: "buildpack", Description: "Custom buildpack"},
+				{Long: "strategy", Description: "Deployment strategy", Default: "none"},
+			},
+			Environment: []sharedaction.EnvironmentVariable{
+				{Name: "CF_STAGING_TIMEOUT", Description: "Max wait time for staging, in minutes", DefaultValue: "15"},
+			},
+			RelatedCommands: []string{"apps", "scale"},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("a command is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.CommandName = "push"
+		})
+
+		It("displays the help of the command with the global options and environment variables", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CommandInfoByNameCallCount()).To(Equal(1))
+			_, commandName := fakeActor.CommandInfoByNameArgsForCall(0)
+			Expect(commandName).To(Equal("push"))
+
+			Expect(testUI.Out).To(Say(`NAME:\n\s+push - Push a new app`))
+			Expect(testUI.Out).To(Say(`USAGE:\n\s+faceman push APP_NAME`))
+			Expect(testUI.Out).To(Say(`EXAMPLES:\n\s+faceman push my-app`))
+			Expect(testUI.Out).To(Say(`--strategy\s+Deployment strategy \(Default: none\)`))
+			Expect(testUI.Out).To(Say(`GLOBAL OPTIONS:`))
+			Expect(testUI.Out).To(Say(`--record FILE`))
+			Expect(testUI.Out).To(Say(`GLOBAL ENVIRONMENT:`))
+			Expect(testUI.Out).To(Say(`CF_HOME=path/to/dir/`))
+		})
+
+		When("--man is given", func() {
+			BeforeEach(func() {
+				cmd.Man = true
+			})
+
+			It("prints the man page of the command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`\.TH "FACEMAN\\-PUSH" 1 "" "faceman 8\.0\.0" "Cloud Foundry CLI"\n`))
+				Expect(testUI.Out).To(Say(`\.SH "NAME"\n\.PP\nfaceman\\-push \\- Push a new app\n`))
+				Expect(testUI.Out).To(Say(`\.SH "SYNOPSIS"\n\.PP\n\.nf\n\.RS\nfaceman push APP_NAME\n\[\\-b BUILDPACK_NAME\]\n\.RE\n\.fi\n`))
+				Expect(testUI.Out).To(Say(`\.SH "OPTIONS"\n\.TP\n\\fB\\-\\-buildpack, \\-b\\fR\nCustom buildpack\n`))
+				Expect(testUI.Out).To(Say(`\.TP\n\\fB\\-\\-strategy\\fR\nDeployment strategy \(Default: none\)\n`))
+				Expect(testUI.Out).To(Say(`\.SH "ENVIRONMENT"\n\.TP\n\\fBCF_STAGING_TIMEOUT=15\\fR\n`))
+				Expect(testUI.Out).To(Say(`\.SH "EXAMPLES"\n\.PP\n\.nf\n\.RS\nfaceman push my\\-app\n`))
+				Expect(testUI.Out).To(Say(`\.SH "ALIASES"\n\.PP\np\n`))
+				Expect(testUI.Out).To(Say(`\.SH "SEE ALSO"\n\.PP\n\\fBfaceman\\fR\(1\), \\fBfaceman\\-apps\\fR\(1\), \\fBfaceman\\-scale\\fR\(1\)\n`))
+			})
+		})
+
+		When("the command does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{}, actionerror.InvalidCommandError{CommandName: "push"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidCommandError{CommandName: "push"}))
+			})
+		})
+
+		When("getting the command fails", func() {
+			BeforeEach(func() {
+				fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{}, errors.New("some-error"))
+			})
+
+			It("returns the error without printing anything", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Out).ToNot(Say("."))
+			})
+		})
+
+		When("--manifest is also given", func() {
+			BeforeEach(func() {
+				cmd.Manifest = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--manifest", "COMMAND"},
+				}))
+				Expect(fakeActor.CommandInfoByNameCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--manifest is given", func() {
+		BeforeEach(func() {
+			cmd.Manifest = true
+		})
+
+		It("displays the manifest reference and an example", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`MANIFEST:\n\s+An app manifest describes`))
+			Expect(testUI.Out).To(Say(`'faceman push' reads manifest.yml`))
+			Expect(testUI.Out).To(Say(`ATTRIBUTES:`))
+			Expect(testUI.Out).To(Say(`applications\[\]\.name\s+string\s+Name of the app\. Required\.`))
+			Expect(testUI.Out).To(Say(`services\[\]\.plan\s+string`))
+			Expect(testUI.Out).To(Say(`EXAMPLE:\n   ---\n   version: 1\n   applications:\n   - name: my-app\n     path: ./build`))
+		})
+
+		When("--man is given", func() {
+			BeforeEach(func() {
+				cmd.Man = true
+			})
+
+			It("prints the man page of manifests in section 5", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\.TH "FACEMAN\\-MANIFEST" 5 `))
+				Expect(testUI.Out).To(Say(`\.SH "ATTRIBUTES"\n\.TP\n\\fBversion \(integer\)\\fR\n`))
+				Expect(testUI.Out).To(Say(`\.SH "EXAMPLE"\n\.PP\n\.nf\n\.RS\n\\\-\\\-\\\-\nversion: 1\n`))
+				Expect(testUI.Out).To(Say(`\\fBfaceman\\fR\(1\), \\fBfaceman\\-push\\fR\(1\)`))
+			})
+		})
+	})
+
+	When("no command is given", func() {
+		BeforeEach(func() {
+			cmd.Actor = sharedaction.NewActor(fakeConfig)
+		})
+
+		It("displays the help of all the commands", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`NAME:\n\s+faceman - A command line tool to interact with Cloud Foundry`))
+			Expect(testUI.Out).To(Say(`push\s+Push a new app or sync changes to an existing app`))
+			Expect(testUI.Out).To(Say(`GLOBAL OPTIONS:`))
+		})
+
+		When("--man is given", func() {
+			BeforeEach(func() {
+				cmd.Man = true
+			})
+
+			It("prints the man page of the CLI with the commands by category", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\.TH "FACEMAN" 1 "" "faceman 8\.0\.0" "Cloud Foundry CLI"\n`))
+				Expect(testUI.Out).To(Say(`\.SH "COMMANDS"\n\.SS "GETTING STARTED"\n`))
+				Expect(testUI.Out).To(Say(`\.TP\n\\fBpush\\fR\nPush a new app or sync changes to an existing app\n`))
+				Expect(testUI.Out).To(Say(`\.SH "GLOBAL OPTIONS"\n`))
+				Expect(testUI.Out).To(Say(`\.SH "ENVIRONMENT"\n`))
+				Expect(testUI.Out).To(Say(`\\fBfaceman\\-manifest\\fR\(5\)`))
+			})
+		})
+	})
+})
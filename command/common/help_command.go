@@ -23,6 +23,10 @@ type HelpActor interface {
 
 	// CommandInfos returns a list of all commands
 	CommandInfos(interface{}) map[string]sharedaction.CommandInfo
+
+	// SearchCommands returns the commands whose name, description or flags
+	// match the search term
+	SearchCommands(interface{}, string) []sharedaction.CommandSearchResult
 }
 
 type HelpCommand struct {
@@ -32,7 +36,9 @@ type HelpCommand struct {
 
 	OptionalArgs flag.CommandName `positional-args:"yes"`
 	AllCommands  bool             `short:"a" description:"All available CLI commands"`
-	usage        interface{}      `usage:"CF_NAME help [COMMAND]"`
+	Examples     bool             `long:"examples" description:"Only show the examples of COMMAND. 'CF_NAME COMMAND --examples' does the same"`
+	Search       string           `long:"search" description:"List the commands whose names, descriptions or flags contain TERM"`
+	usage        interface{}      `usage:"CF_NAME help [COMMAND] [--examples]\n   CF_NAME help --search TERM"`
 }
 
 func (cmd *HelpCommand) Setup(config command.Config, ui command.UI) error {
@@ -45,9 +51,14 @@ func (cmd *HelpCommand) Setup(config command.Config, ui command.UI) error {
 
 func (cmd HelpCommand) Execute(args []string) error {
 	var err error
-	if cmd.OptionalArgs.CommandName == "" {
+	switch {
+	case cmd.Search != "":
+		cmd.displaySearchResults()
+	case cmd.OptionalArgs.CommandName == "":
 		cmd.displayFullHelp()
-	} else {
+	case cmd.Examples:
+		err = cmd.displayExamples()
+	default:
 		err = cmd.displayCommand()
 	}
 
@@ -211,19 +222,92 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayTextWithFlavor("TIP: Use '{{.FullHelpCommand}}' to see all commands.", map[string]interface{}{"FullHelpCommand": "cf help -a"})
 }
 
-func (cmd HelpCommand) displayCommand() error {
+func (cmd HelpCommand) displaySearchResults() {
+	term := strings.ToLower(strings.TrimSpace(cmd.Search))
+	table := [][]string{}
+
+	for _, result := range cmd.Actor.SearchCommands(Commands, term) {
+		description := cmd.UI.TranslateText(result.Description)
+		if len(result.MatchedFlags) > 0 {
+			description = fmt.Sprintf("%s (%s)", description, strings.Join(result.MatchedFlags, "; "))
+		}
+		table = append(table, []string{result.Name, description})
+	}
+
+	for _, pluginCommand := range cmd.getSortedPluginCommands() {
+		if strings.Contains(strings.ToLower(pluginCommand.Name), term) ||
+			strings.Contains(strings.ToLower(pluginCommand.Alias), term) ||
+			strings.Contains(strings.ToLower(pluginCommand.HelpText), term) {
+			table = append(table, []string{pluginCommand.Name, pluginCommand.HelpText})
+		}
+	}
+
+	if len(table) == 0 {
+		cmd.UI.DisplayText("No commands match '{{.Term}}'.", map[string]interface{}{
+			"Term": cmd.Search,
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Commands matching '{{.Term}}':", map[string]interface{}{
+		"Term": cmd.Search,
+	})
+	cmd.UI.DisplayNonWrappingTable(sharedaction.AllCommandsIndent, table, 4)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.HelpCommand}}' to see the usage and flags of a command.", map[string]interface{}{
+		"HelpCommand": cmd.Config.BinaryName() + " help COMMAND",
+	})
+}
+
+func (cmd HelpCommand) displayExamples() error {
+	cmdInfo, err := cmd.commandInfo()
+	if err != nil {
+		return err
+	}
+
+	examples := cmdInfo.ExampleLines()
+	if len(examples) == 0 {
+		cmd.UI.DisplayText("There are no examples for '{{.CommandName}}'. Use '{{.HelpCommand}}' to see its usage.", map[string]interface{}{
+			"CommandName": cmdInfo.Name,
+			"HelpCommand": cmd.Config.BinaryName() + " help " + cmdInfo.Name,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("EXAMPLES:")
+	for _, example := range examples {
+		cmd.UI.DisplayText(sharedaction.CommandIndent+"{{.Example}}", map[string]interface{}{
+			"Example": strings.Replace(example, "CF_NAME", cmd.Config.BinaryName(), -1),
+		})
+	}
+
+	return nil
+}
+
+// commandInfo returns the help information of the command or plugin command
+// named in the arguments.
+func (cmd HelpCommand) commandInfo() (sharedaction.CommandInfo, error) {
 	cmdInfo, err := cmd.Actor.CommandInfoByName(Commands, cmd.OptionalArgs.CommandName)
 	if err != nil {
-		if err, ok := err.(actionerror.InvalidCommandError); ok {
+		if _, ok := err.(actionerror.InvalidCommandError); ok {
 			var found bool
 			if cmdInfo, found = cmd.findPlugin(); !found {
-				return err
+				return sharedaction.CommandInfo{}, err
 			}
 		} else {
-			return err
+			return sharedaction.CommandInfo{}, err
 		}
 	}
 
+	return cmdInfo, nil
+}
+
+func (cmd HelpCommand) displayCommand() error {
+	cmdInfo, err := cmd.commandInfo()
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayText("NAME:")
 	cmd.UI.DisplayText(sharedaction.CommandIndent+"{{.CommandName}} - {{.CommandDescription}}",
 		map[string]interface{}{
@@ -317,10 +401,19 @@ func (cmd HelpCommand) displayCommand() error {
 
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
+		{"CF_CLI_EXPERIMENTAL=NAME[,NAME]", cmd.UI.TranslateText("Turn on the named experimental features, or all of them with true")},
+		{"CF_CLIENT_CERT=path/to/client.crt", cmd.UI.TranslateText("Present this PEM certificate to servers that require mutual TLS, with CF_CLIENT_KEY")},
+		{"CF_CLIENT_KEY=path/to/client.key", cmd.UI.TranslateText("Private key of the certificate given with CF_CLIENT_CERT")},
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=6", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_IP_FAMILY=ipv6", cmd.UI.TranslateText("Connect over this address family (ipv4 or ipv6) first when a host has both")},
+		{"CF_LOG_FILE=path/to/cf.log", cmd.UI.TranslateText("Append internal log messages to a file instead of stderr")},
+		{"CF_LOG_FORMAT=json", cmd.UI.TranslateText("Write internal log messages as JSON (text or json)")},
+		{"CF_LOG_LEVEL=debug", cmd.UI.TranslateText("Log internal decisions at this level or above (error, warn, info, debug or trace)")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_REQUEST_RETRIES=2", cmd.UI.TranslateText("Retry failed API requests this many times, waiting longer after every rate limited or unavailable response")},
+		{"CF_STATS=true", cmd.UI.TranslateText("Print API request statistics when each command ends, like --stats")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
@@ -332,6 +425,11 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--compat", cmd.UI.TranslateText("Translate flags that were removed in v7 of the CLI into their v7 equivalents where possible")},
+		{"--prefer-ipv4, --prefer-ipv6", cmd.UI.TranslateText("Connect over this address family first when a host has both IPv4 and IPv6 addresses")},
+		{"--stats", cmd.UI.TranslateText("Print the duration, API calls, bytes transferred, retries and slowest endpoints of the command when it ends")},
+		{"--output json", cmd.UI.TranslateText("Print the tables of the command as JSON on stdout and everything else on stderr")},
+		{"--record FILE", cmd.UI.TranslateText("Write a transcript of the command line and its output, with timestamps and secrets redacted, to FILE as JSON lines")},
 	}
 }
 
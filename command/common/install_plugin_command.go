@@ -70,7 +70,11 @@ func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) err
 	cmd.Config = config
 	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
 
-	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+	if config.ProgressStyle() == configv3.ProgressPlain {
+		cmd.ProgressBar = shared.NewPlainProgressBarProxyReader(cmd.UI.Writer())
+	} else {
+		cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+	}
 
 	return nil
 }
@@ -4,25 +4,28 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "GETTING STARTED:",
 		CommandList: [][]string{
-			{"help", "version", "login", "logout", "passwd", "target"},
+			{"help", "docs", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"target-save", "target-use"},
 		},
 	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app", "create-app"},
-			{"push", "scale", "delete", "rename"},
-			{"cancel-deployment"},
+			{"apps", "app", "processes", "create-app"},
+			{"push", "dev-watch", "scale", "delete", "rename"},
+			{"cancel-deployment", "continue-deployment", "rollout-status", "verify"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"clear-build-cache"},
+			{"run-task", "tasks", "logs-task", "terminate-task"},
 			{"packages", "create-package"},
-			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
+			{"droplets", "set-droplet", "download-droplet", "download-sbom", "export-image"},
+			{"events", "logs", "top"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
+			{"stacks", "stack", "set-buildpacks"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "set-start-command", "set-ports", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "exec", "dump"},
+			{"security-report"},
 		},
 	},
 	{
@@ -34,22 +37,24 @@ var HelpCategoryList = []HelpCategory{
 			{"bind-service", "unbind-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
+			{"drains", "drain", "create-drain", "delete-drain"},
 			{"share-service", "unshare-service"},
 		},
 	},
 	{
 		CategoryName: "ORGS:",
 		CommandList: [][]string{
-			{"orgs", "org"},
+			{"orgs", "org", "org-events"},
 			{"create-org", "delete-org", "rename-org"},
+			{"usage-snapshot"},
 		},
 	},
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
-			{"create-space", "delete-space", "rename-space", "apply-manifest"},
-			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
+			{"spaces", "space", "space-events"},
+			{"create-space", "delete-space", "rename-space", "apply-manifest", "diff-manifest", "export-space-manifest"},
+			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed", "ssh-report"},
 		},
 	},
 	{
@@ -65,17 +70,19 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
 			{"routes", "route"},
+			{"internal-routes"},
 			{"create-route", "check-route", "map-route", "unmap-route", "delete-route"},
 			{"delete-orphaned-routes"},
 			{"update-destination"},
 			{"share-route", "unshare-route"},
-			{"move-route"},
+			{"move-route", "migrate-routes"},
 		},
 	},
 	{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"export-network-policies", "apply-network-policies"},
 		},
 	},
 	{
@@ -112,6 +119,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "SERVICE ADMIN:",
 		CommandList: [][]string{
 			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
+			{"rotate-service-broker-credentials", "promote-service-broker"},
 			{"purge-service-offering", "purge-service-instance"},
 			{"service-access", "enable-service-access", "disable-service-access"},
 		},
@@ -151,7 +159,8 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "api-resources", "config", "experimental", "oauth-token", "ssh-code"},
+			{"lookup", "job"},
 		},
 	},
 	{
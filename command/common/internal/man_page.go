@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ManPage writes documentation in the troff format of man pages, which can
+// be read with 'man -l FILE' or installed in a man directory.
+type ManPage struct {
+	builder strings.Builder
+}
+
+// NewManPage starts the man page of name in the section, e.g. 1 for commands
+// or 5 for file formats, with source, such as the CLI version, and manual in
+// its header and footer.
+func NewManPage(name string, section int, source string, manual string) *ManPage {
+	page := new(ManPage)
+	fmt.Fprintf(&page.builder, ".TH %s %d \"\" %s %s\n",
+		manQuote(strings.ToUpper(name)), section, manQuote(source), manQuote(manual))
+	return page
+}
+
+// Section starts a section, such as NAME or OPTIONS.
+func (page *ManPage) Section(title string) {
+	fmt.Fprintf(&page.builder, ".SH %s\n", manQuote(title))
+}
+
+// Subsection starts a subsection of the current section.
+func (page *ManPage) Subsection(title string) {
+	fmt.Fprintf(&page.builder, ".SS %s\n", manQuote(title))
+}
+
+// Paragraph writes text, which is filled and justified.
+func (page *ManPage) Paragraph(text string) {
+	page.builder.WriteString(".PP\n")
+	page.lines(strings.TrimSpace(text))
+}
+
+// Preformatted writes text as it is, without filling it, for usages and
+// examples. The common indentation of its lines is removed.
+func (page *ManPage) Preformatted(text string) {
+	page.builder.WriteString(".PP\n.nf\n.RS\n")
+	page.lines(dedent(text))
+	page.builder.WriteString(".RE\n.fi\n")
+}
+
+// Item writes a term, such as a flag, in bold followed by its indented
+// description.
+func (page *ManPage) Item(term string, description string) {
+	fmt.Fprintf(&page.builder, ".TP\n\\fB%s\\fR\n", ManEscape(term))
+	page.lines(strings.TrimSpace(description))
+}
+
+// References writes the man pages of the names in section as a list, for
+// SEE ALSO.
+func (page *ManPage) References(names []string, section int) {
+	references := make([]string, 0, len(names))
+	for _, name := range names {
+		references = append(references, fmt.Sprintf("\\fB%s\\fR(%d)", ManEscape(name), section))
+	}
+	page.builder.WriteString(".PP\n" + strings.Join(references, ", ") + "\n")
+}
+
+// String returns the man page.
+func (page *ManPage) String() string {
+	return page.builder.String()
+}
+
+func (page *ManPage) lines(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		page.builder.WriteString(ManEscape(line) + "\n")
+	}
+}
+
+// ManEscape escapes the backslashes and hyphens of the text, and a leading
+// period or apostrophe that troff would take for a request.
+func ManEscape(text string) string {
+	text = strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(text)
+	if strings.HasPrefix(text, ".") || strings.HasPrefix(text, "'") {
+		text = `\&` + text
+	}
+	return text
+}
+
+func manQuote(text string) string {
+	return `"` + strings.Replace(ManEscape(text), `"`, `\(dq`, -1) + `"`
+}
+
+// dedent removes the indentation that all the non-blank lines of the text
+// have in common, and the blank lines around it.
+func dedent(text string) string {
+	lines := strings.Split(strings.Trim(text, "\n"), "\n")
+
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lineIndent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || lineIndent < indent {
+			indent = lineIndent
+		}
+	}
+
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		} else {
+			lines[i] = strings.TrimSpace(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,51 @@
+package internal_test
+
+import (
+	"code.cloudfoundry.org/cli/command/common/internal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ManPage", func() {
+	It("writes the sections, paragraphs, items and references in troff", func() {
+		page := internal.NewManPage("cf-push", 1, "cf 8.0.0", "Cloud Foundry CLI")
+		page.Section("NAME")
+		page.Paragraph("cf-push - Push an app\n")
+		page.Section("SYNOPSIS")
+		page.Preformatted("   cf push APP_NAME\n      [-b BUILDPACK]\n")
+		page.Section("OPTIONS")
+		page.Item("--buildpack, -b", "Custom buildpack")
+		page.Section("SEE ALSO")
+		page.References([]string{"cf", "cf-apps"}, 1)
+
+		Expect(page.String()).To(Equal(`.TH "CF\-PUSH" 1 "" "cf 8.0.0" "Cloud Foundry CLI"
+.SH "NAME"
+.PP
+cf\-push \- Push an app
+.SH "SYNOPSIS"
+.PP
+.nf
+.RS
+cf push APP_NAME
+   [\-b BUILDPACK]
+.RE
+.fi
+.SH "OPTIONS"
+.TP
+\fB\-\-buildpack, \-b\fR
+Custom buildpack
+.SH "SEE ALSO"
+.PP
+\fBcf\fR(1), \fBcf\-apps\fR(1)
+`))
+	})
+
+	Describe("ManEscape", func() {
+		It("escapes backslashes, hyphens and leading control characters", func() {
+			Expect(internal.ManEscape(`C:\apps`)).To(Equal(`C:\eapps`))
+			Expect(internal.ManEscape("--no-start")).To(Equal(`\-\-no\-start`))
+			Expect(internal.ManEscape(".cfignore files")).To(Equal(`\&.cfignore files`))
+			Expect(internal.ManEscape("'quoted'")).To(Equal(`\&'quoted'`))
+		})
+	})
+})
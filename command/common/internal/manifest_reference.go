@@ -0,0 +1,98 @@
+package internal
+
+// ManifestAttribute is an attribute of an app manifest, for the reference
+// shown by 'cf docs --manifest'.
+type ManifestAttribute struct {
+	// Name is the path of the attribute in the manifest, e.g.
+	// applications[].docker.image.
+	Name        string
+	Type        string
+	Description string
+}
+
+// ManifestReferenceDescription introduces the manifest reference.
+const ManifestReferenceDescription = `An app manifest describes one or more apps, and the service instances they need, in YAML. 'CF_NAME push' reads manifest.yml or manifest.yaml from the current directory, or the manifest given with -f. Values written as ((NAME)) are replaced with the variables given with --var or --vars-file. Flags given to 'CF_NAME push' override the manifest for a single app.`
+
+// ManifestReference are the attributes of app manifests, in the order they are
+// documented.
+var ManifestReference = []ManifestAttribute{
+	{"version", "integer", "Version of the manifest schema. Only 1 is supported."},
+	{"applications", "list", "The apps to push, in the order they are pushed unless depends_on says otherwise."},
+	{"applications[].name", "string", "Name of the app. Required."},
+	{"applications[].path", "string", "Directory or zip file of the app files, relative to the manifest. Defaults to the directory of the manifest."},
+	{"applications[].buildpacks", "list of strings", "Buildpacks to stage the app with, by name or URL, in order. The last one provides the start command."},
+	{"applications[].stack", "string", "Stack to stage and run the app on, e.g. cflinuxfs4."},
+	{"applications[].command", "string", "Command to start the web process with, instead of the one detected by the buildpack."},
+	{"applications[].docker.image", "string", "Docker image to run the app from, instead of staging it with buildpacks."},
+	{"applications[].docker.username", "string", "User to authenticate to the registry of the image with. The password is read from CF_DOCKER_PASSWORD."},
+	{"applications[].instances", "integer", "Number of instances of the web process."},
+	{"applications[].memory", "string", "Memory limit of each instance of the web process, with a unit of M or G, e.g. 256M or 1G."},
+	{"applications[].disk_quota", "string", "Disk limit of each instance of the web process, with a unit of M or G, e.g. 1G."},
+	{"applications[].log-rate-limit-per-second", "string", "Log rate limit of each instance of the web process, with a unit of B, K, M or G, e.g. 16K. -1 means unlimited."},
+	{"applications[].health-check-type", "string", "How the web process is checked to be healthy: port, process or http."},
+	{"applications[].health-check-http-endpoint", "string", "Path requested by the http health check, e.g. /health."},
+	{"applications[].health-check-invocation-timeout", "integer", "Seconds a single health check may take."},
+	{"applications[].timeout", "integer", "Seconds the web process may take to become healthy after its start."},
+	{"applications[].env", "map", "Environment variables of the app, by name."},
+	{"applications[].routes", "list", "Routes mapped to the app, instead of the default route."},
+	{"applications[].routes[].route", "string", "The route, as HOST.DOMAIN[:PORT][/PATH], e.g. my-app.example.com/api."},
+	{"applications[].routes[].protocol", "string", "Protocol the app speaks on the route: http1, http2 or tcp."},
+	{"applications[].default-route", "boolean", "Map the default route, my-app.<default domain>, when the app has no route."},
+	{"applications[].random-route", "boolean", "Map a route with a random host when the app has no route."},
+	{"applications[].no-route", "boolean", "Do not map any route, and unmap the existing ones."},
+	{"applications[].services", "list", "Service instances to bind the app to, by name, or as objects with a name, a binding_name and parameters."},
+	{"applications[].processes", "list", "Settings of the processes of the app, by type."},
+	{"applications[].processes[].type", "string", "Type of the process, e.g. web or worker. Required."},
+	{"applications[].processes[].command", "string", "Command to start the process with."},
+	{"applications[].processes[].instances", "integer", "Number of instances of the process."},
+	{"applications[].processes[].memory", "string", "Memory limit of each instance of the process."},
+	{"applications[].processes[].disk_quota", "string", "Disk limit of each instance of the process."},
+	{"applications[].processes[].log-rate-limit-per-second", "string", "Log rate limit of each instance of the process."},
+	{"applications[].processes[].health-check-type", "string", "How the process is checked to be healthy: port, process or http."},
+	{"applications[].processes[].health-check-http-endpoint", "string", "Path requested by the http health check of the process."},
+	{"applications[].processes[].timeout", "integer", "Seconds the process may take to become healthy after its start."},
+	{"applications[].sidecars", "list", "Additional processes that run in the same containers as the processes of the app."},
+	{"applications[].sidecars[].name", "string", "Name of the sidecar. Required."},
+	{"applications[].sidecars[].command", "string", "Command to start the sidecar with. Required."},
+	{"applications[].sidecars[].process_types", "list of strings", "Types of the processes whose containers run the sidecar. Required."},
+	{"applications[].sidecars[].memory", "string", "Memory, out of the limit of the process, reserved for the sidecar."},
+	{"applications[].metadata.labels", "map", "Labels of the app, by key."},
+	{"applications[].metadata.annotations", "map", "Annotations of the app, by key."},
+	{"applications[].depends_on", "list of strings", "Apps of the manifest that must be pushed and started before this one."},
+	{"services", "list", "Service instances the apps of the manifest need in the space. Push fails before changing anything when one is missing or different."},
+	{"services[].name", "string", "Name of the service instance. Required."},
+	{"services[].offering", "string", "Service offering the instance must be of."},
+	{"services[].plan", "string", "Service plan the instance must be of."},
+}
+
+// ManifestExample is a manifest that uses the common attributes.
+const ManifestExample = `---
+version: 1
+applications:
+- name: my-app
+  path: ./build
+  buildpacks:
+  - java_buildpack
+  instances: 2
+  memory: 1G
+  health-check-type: http
+  health-check-http-endpoint: /health
+  env:
+    SPRING_PROFILES_ACTIVE: ((profile))
+  routes:
+  - route: my-app.example.com
+  services:
+  - my-db
+  processes:
+  - type: worker
+    command: bin/worker
+    instances: 1
+  depends_on:
+  - my-api
+- name: my-api
+  docker:
+    image: registry.example.com/my-api:1.2
+services:
+- name: my-db
+  offering: postgres
+  plan: small`
@@ -1,25 +1,137 @@
 package common
 
-import "code.cloudfoundry.org/cli/command"
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . VersionActor
+
+// VersionActor handles the business logic of the version command
+type VersionActor interface {
+	GetRootResponse() (v7action.Info, v7action.Warnings, error)
+}
 
 type VersionCommand struct {
-	usage  interface{} `usage:"CF_NAME version\n\n   'cf -v' and 'cf --version' are also accepted."`
+	Check  bool              `long:"check" description:"Also print the targeted API version, the API versions this CLI supports and any known incompatibilities"`
+	Output flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the version as a single JSON object"`
+	usage  interface{}       `usage:"CF_NAME version [--check] [--output json]\n\n   'cf -v' and 'cf --version' are also accepted."`
 	UI     command.UI
 	Config command.Config
+	Actor  VersionActor
+}
+
+// versionReport is the machine-readable output of version --output json.
+type versionReport struct {
+	Version string `json:"version"`
+	*versionCheck
+}
+
+// versionCheck is the part of the version report that is only included with
+// --check.
+type versionCheck struct {
+	API                    string   `json:"api"`
+	APIVersion             string   `json:"api_version"`
+	MinimumAPIVersion      string   `json:"minimum_api_version"`
+	MaximumAPIVersion      string   `json:"maximum_api_version"`
+	KnownIncompatibilities []string `json:"known_incompatibilities"`
 }
 
 func (cmd *VersionCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+
+	if cmd.Check && config.Target() != "" {
+		ccClient, uaaClient, routingClient, err := shared.GetNewClientsAndConnectToCF(config, ui, "")
+		if err != nil {
+			return err
+		}
+		cmd.Actor = v7action.NewActor(ccClient, config, nil, uaaClient, routingClient, clock.NewClock())
+	}
+
 	return nil
 }
 
 func (cmd VersionCommand) Execute(args []string) error {
-	cmd.UI.DisplayText("{{.BinaryName}} version {{.VersionString}}",
-		map[string]interface{}{
-			"BinaryName":    cmd.Config.BinaryName(),
-			"VersionString": cmd.Config.BinaryVersion(),
+	if !cmd.Check {
+		if cmd.Output == flag.OutputFormatJSON {
+			return cmd.UI.DisplayJSON("", versionReport{Version: cmd.Config.BinaryVersion()})
+		}
+
+		cmd.UI.DisplayText("{{.BinaryName}} version {{.VersionString}}",
+			map[string]interface{}{
+				"BinaryName":    cmd.Config.BinaryName(),
+				"VersionString": cmd.Config.BinaryVersion(),
+			})
+		return nil
+	}
+
+	var apiVersion string
+	if cmd.Config.Target() != "" {
+		info, warnings, err := cmd.Actor.GetRootResponse()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		apiVersion = ccv3.Info(info).CloudControllerAPIVersion()
+	}
+
+	compatibility, err := command.CheckAPICompatibility(apiVersion)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == flag.OutputFormatJSON {
+		return cmd.UI.DisplayJSON("", versionReport{
+			Version: cmd.Config.BinaryVersion(),
+			versionCheck: &versionCheck{
+				API:                    cmd.Config.Target(),
+				APIVersion:             compatibility.APIVersion,
+				MinimumAPIVersion:      compatibility.MinimumVersion,
+				MaximumAPIVersion:      compatibility.MaximumVersion,
+				KnownIncompatibilities: compatibility.Incompatibilities,
+			},
+		})
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("CLI version:"), cmd.Config.BinaryVersion()},
+	}
+	if cmd.Config.Target() != "" {
+		table = append(table,
+			[]string{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+			[]string{cmd.UI.TranslateText("API version:"), compatibility.APIVersion},
+		)
+	}
+	table = append(table,
+		[]string{cmd.UI.TranslateText("Minimum supported API version:"), compatibility.MinimumVersion},
+		[]string{cmd.UI.TranslateText("Maximum supported API version:"), compatibility.MaximumVersion},
+	)
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	if cmd.Config.Target() == "" {
+		cmd.UI.DisplayText("No API endpoint set. Use '{{.BinaryName}} api' to check compatibility with a foundation.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+		return nil
+	}
+
+	if len(compatibility.Incompatibilities) == 0 {
+		cmd.UI.DisplayText("No known incompatibilities.")
+		return nil
+	}
+
+	cmd.UI.DisplayText("Known incompatibilities:")
+	for _, incompatibility := range compatibility.Incompatibilities {
+		cmd.UI.DisplayText("   {{.Incompatibility}}", map[string]interface{}{
+			"Incompatibility": incompatibility,
 		})
+	}
 
 	return nil
 }
@@ -1,8 +1,14 @@
 package common_test
 
 import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -15,6 +21,7 @@ var _ = Describe("Version Command", func() {
 		cmd        VersionCommand
 		testUI     *ui.UI
 		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeVersionActor
 		err        error
 	)
 
@@ -23,16 +30,118 @@ var _ = Describe("Version Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeConfig.BinaryNameReturns("faceman")
 		fakeConfig.BinaryVersionReturns("0.0.0-invalid-version")
+		fakeActor = new(commonfakes.FakeVersionActor)
 
 		cmd = VersionCommand{
 			UI:     testUI,
 			Config: fakeConfig,
+			Actor:  fakeActor,
 		}
 	})
 
-	It("displays correct version", func() {
+	JustBeforeEach(func() {
 		err = cmd.Execute(nil)
+	})
+
+	It("displays correct version", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(testUI.Out).To(Say("faceman version 0.0.0-invalid-version"))
+		Expect(fakeActor.GetRootResponseCallCount()).To(Equal(0))
+	})
+
+	When("--output json is given", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormatJSON
+		})
+
+		It("displays the version as JSON", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`\{\n  "version": "0.0.0-invalid-version"\n\}`))
+		})
+	})
+
+	When("--check is given", func() {
+		BeforeEach(func() {
+			cmd.Check = true
+		})
+
+		When("no API is targeted", func() {
+			It("displays the supported API versions without checking an API", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeActor.GetRootResponseCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say(`CLI version:\s+0.0.0-invalid-version`))
+				Expect(testUI.Out).To(Say(`Minimum supported API version:\s+%s`, ccversion.MinSupportedClientVersionV8))
+				Expect(testUI.Out).To(Say(`Maximum supported API version:\s+%s`, ccversion.MaxSupportedClientVersionV8))
+				Expect(testUI.Out).To(Say(`No API endpoint set\. Use 'faceman api' to check compatibility with a foundation\.`))
+			})
+		})
+
+		When("an API is targeted", func() {
+			var info v7action.Info
+
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("https://api.example.com")
+				info = v7action.Info{}
+				info.Links.CCV3.Meta.Version = ccversion.MaxSupportedClientVersionV8
+			})
+
+			When("the API version has no known incompatibilities", func() {
+				BeforeEach(func() {
+					fakeActor.GetRootResponseReturns(info, v7action.Warnings{"root-warning"}, nil)
+				})
+
+				It("displays the versions and no incompatibilities", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`CLI version:\s+0.0.0-invalid-version`))
+					Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.example.com`))
+					Expect(testUI.Out).To(Say(`API version:\s+%s`, ccversion.MaxSupportedClientVersionV8))
+					Expect(testUI.Out).To(Say(`Minimum supported API version:\s+%s`, ccversion.MinSupportedClientVersionV8))
+					Expect(testUI.Out).To(Say(`Maximum supported API version:\s+%s`, ccversion.MaxSupportedClientVersionV8))
+					Expect(testUI.Out).To(Say(`No known incompatibilities\.`))
+					Expect(testUI.Err).To(Say("root-warning"))
+				})
+			})
+
+			When("the API version has known incompatibilities", func() {
+				BeforeEach(func() {
+					info.Links.CCV3.Meta.Version = "3.110.0"
+					fakeActor.GetRootResponseReturns(info, nil, nil)
+				})
+
+				It("lists them", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`API version:\s+3.110.0`))
+					Expect(testUI.Out).To(Say(`Known incompatibilities:`))
+					Expect(testUI.Out).To(Say(`   Log rate limits: requires API version %s or later`, ccversion.MinVersionLogRateLimitingV3))
+				})
+
+				When("--output json is given", func() {
+					BeforeEach(func() {
+						cmd.Output = flag.OutputFormatJSON
+					})
+
+					It("displays the check as JSON", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`"version": "0.0.0-invalid-version"`))
+						Expect(testUI.Out).To(Say(`"api": "https://api.example.com"`))
+						Expect(testUI.Out).To(Say(`"api_version": "3.110.0"`))
+						Expect(testUI.Out).To(Say(`"minimum_api_version": "%s"`, ccversion.MinSupportedClientVersionV8))
+						Expect(testUI.Out).To(Say(`"maximum_api_version": "%s"`, ccversion.MaxSupportedClientVersionV8))
+						Expect(testUI.Out).To(Say(`"known_incompatibilities": \[\n\s+"Log rate limits: requires API version %s or later"\n\s+\]`, ccversion.MinVersionLogRateLimitingV3))
+					})
+				})
+			})
+
+			When("getting the root response fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetRootResponseReturns(v7action.Info{}, v7action.Warnings{"root-warning"}, errors.New("root-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(err).To(MatchError("root-error"))
+					Expect(testUI.Err).To(Say("root-warning"))
+				})
+			})
+		})
 	})
 })
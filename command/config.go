@@ -3,7 +3,9 @@ package command
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/requeststats"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Config
@@ -14,47 +16,75 @@ type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
 	AuthorizationEndpoint() string
+	APIBaseURL() string
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	BrokerCatalogSnapshotPath(brokerGUID string) string
 	CFPassword() string
 	CFUsername() string
+	ClearResolveOverrides()
+	ClientCertificate() util.ClientCertificate
 	ColorEnabled() configv3.ColorSetting
+	CredentialHelper() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
+	ExperimentalFeatureEnabled(name string, enabledByDefault bool) bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IPFamily() util.IPFamily
 	IsTTY() bool
 	Locale() string
 	LogCacheEndpoint() string
+	LogGroupStyle() configv3.LogGroupStyle
+	ManifestSnapshotPath(spaceGUID string) string
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
 	OverallPollingTimeout() time.Duration
+	PagerCommand() string
+	PagerEnabled() bool
+	PaginationWorkers() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	Profile() string
+	ProfileNames() []string
+	ProgressStyle() configv3.ProgressStyle
 	RefreshToken() string
 	RemovePlugin(string)
 	RequestRetryCount() int
+	RequestStats() *requeststats.Collector
+	ResolveOverrides() []util.ResolveOverride
+	ResourceCacheDir() string
 	RoutingEndpoint() string
+	SaveProfile(name string)
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
+	SetClientCertificate(certificate util.ClientCertificate)
 	SetColorEnabled(enabled string)
+	SetColorTheme(theme string)
+	SetCredentialHelper(commandLine string)
+	SetExperimentalFeatureEnabled(name string, enabled bool)
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
+	SetPagerEnabled(enabled string)
+	SetProgressStyle(style string)
 	SetRefreshToken(token string)
+	SetResolveOverride(override util.ResolveOverride)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
+	UseProfile(name string) bool
 	V7SetSpaceInformation(guid string, name string)
 	SetTargetInformation(args configv3.TargetInformationArgs)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetTokenStorage(storage string)
 	SetTrace(trace string)
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
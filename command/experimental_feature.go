@@ -0,0 +1,61 @@
+package command
+
+import "code.cloudfoundry.org/cli/command/translatableerror"
+
+// ExperimentalFeature is a set of commands or flags that ships turned off
+// until it is stable. It is turned on with 'cf config --enable-experimental
+// NAME' or by listing it in $CF_CLI_EXPERIMENTAL.
+type ExperimentalFeature struct {
+	Name        string
+	Description string
+	// EnabledByDefault is set for features that shipped before they could be
+	// turned off, so that turning them off is opt-in.
+	EnabledByDefault bool
+}
+
+// ExperimentalFeatures are all the experimental features, sorted by name.
+var ExperimentalFeatures = []ExperimentalFeature{
+	{
+		Name:             "revisions",
+		Description:      "The revisions, revision and rollback commands",
+		EnabledByDefault: true,
+	},
+}
+
+// ExperimentalFeatureCommand is implemented by commands that are part of an
+// experimental feature. They are only run while the feature is turned on.
+type ExperimentalFeatureCommand interface {
+	ExperimentalFeature() string
+}
+
+// LookupExperimentalFeature returns the experimental feature with the given
+// name.
+func LookupExperimentalFeature(name string) (ExperimentalFeature, bool) {
+	for _, feature := range ExperimentalFeatures {
+		if feature.Name == name {
+			return feature, true
+		}
+	}
+	return ExperimentalFeature{}, false
+}
+
+// IsExperimentalFeatureEnabled returns whether the feature is turned on.
+func IsExperimentalFeatureEnabled(config Config, feature ExperimentalFeature) bool {
+	return config.ExperimentalFeatureEnabled(feature.Name, feature.EnabledByDefault)
+}
+
+// RequireExperimentalFeature returns an ExperimentalFeatureNotEnabledError
+// unless the named feature is turned on. Commands call it when an
+// experimental flag is given.
+func RequireExperimentalFeature(config Config, name string) error {
+	feature, ok := LookupExperimentalFeature(name)
+	if !ok {
+		return translatableerror.ExperimentalFeatureNotFoundError{Name: name, BinaryName: config.BinaryName()}
+	}
+
+	if !IsExperimentalFeatureEnabled(config, feature) {
+		return translatableerror.ExperimentalFeatureNotEnabledError{Name: name, BinaryName: config.BinaryName()}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequireExperimentalFeature", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+	})
+
+	It("checks the feature with its default", func() {
+		fakeConfig.ExperimentalFeatureEnabledReturns(true)
+		Expect(RequireExperimentalFeature(fakeConfig, "revisions")).To(Succeed())
+
+		name, enabledByDefault := fakeConfig.ExperimentalFeatureEnabledArgsForCall(0)
+		Expect(name).To(Equal("revisions"))
+		Expect(enabledByDefault).To(BeTrue())
+	})
+
+	When("the feature is turned off", func() {
+		It("returns an ExperimentalFeatureNotEnabledError", func() {
+			Expect(RequireExperimentalFeature(fakeConfig, "revisions")).To(MatchError(translatableerror.ExperimentalFeatureNotEnabledError{
+				Name:       "revisions",
+				BinaryName: "faceman",
+			}))
+		})
+	})
+
+	When("the feature does not exist", func() {
+		It("returns an ExperimentalFeatureNotFoundError", func() {
+			Expect(RequireExperimentalFeature(fakeConfig, "teleport")).To(MatchError(translatableerror.ExperimentalFeatureNotFoundError{
+				Name:       "teleport",
+				BinaryName: "faceman",
+			}))
+		})
+	})
+})
@@ -29,6 +29,14 @@ type Feature struct {
 	Feature string `positional-arg-name:"FEATURE_NAME" required:"true" description:"The feature flag name"`
 }
 
+type GUID struct {
+	GUID string `positional-arg-name:"GUID" required:"true" description:"The GUID"`
+}
+
+type JobGUID struct {
+	JobGUID string `positional-arg-name:"JOB_GUID" required:"true" description:"The job guid"`
+}
+
 type ParamsAsJSON struct {
 	JSON string `positional-arg-name:"JSON" required:"true" description:"Parameters as JSON"`
 }
@@ -41,10 +49,18 @@ type ServiceInstance struct {
 	ServiceInstance TrimmedString `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance name"`
 }
 
+type OptionalServiceInstance struct {
+	ServiceInstance TrimmedString `positional-arg-name:"SERVICE_INSTANCE" description:"The service instance name"`
+}
+
 type Organization struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 }
 
+type OptionalOrganization struct {
+	Organization string `positional-arg-name:"ORG" description:"The organization"`
+}
+
 type OrganizationQuota struct {
 	OrganizationQuotaName string `positional-arg-name:"ORG_QUOTA_NAME" required:"true" description:"The organization quota name"`
 }
@@ -73,6 +89,10 @@ type Space struct {
 	Space string `positional-arg-name:"SPACE" required:"true" description:"The space"`
 }
 
+type OptionalSpace struct {
+	Space string `positional-arg-name:"SPACE" description:"The space"`
+}
+
 type Rename struct {
 	OldAppName string `positional-arg-name:"APP_NAME" required:"true" description:"The current app name"`
 	NewAppName string `positional-arg-name:"NEW_APP_NAME" required:"true" description:"The new app name"`
@@ -261,6 +281,36 @@ type SetHealthCheckArgs struct {
 	HealthCheck HealthCheckType `positional-arg-name:"HEALTH_CHECK_TYPE" required:"true" description:"Set to 'port'"`
 }
 
+type SetPortsArgs struct {
+	AppName string       `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Ports   ProcessPorts `positional-arg-name:"PORTS" required:"true" description:"Comma-separated ports that the process listens on"`
+}
+
+type CreateDrainArgs struct {
+	AppName   string   `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	DrainName string   `positional-arg-name:"DRAIN_NAME" required:"true" description:"The name of the drain"`
+	DrainURL  DrainURL `positional-arg-name:"SYSLOG_URL" required:"true" description:"The URL logs are streamed to"`
+}
+
+type DrainName struct {
+	DrainName string `positional-arg-name:"DRAIN_NAME" required:"true" description:"The name of the drain"`
+}
+
+type ExecArgs struct {
+	AppName string   `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Command []string `positional-arg-name:"COMMAND" required:"true" description:"The command to run, followed by its arguments"`
+}
+
+type SetStartCommandArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Command string `positional-arg-name:"COMMAND" description:"The start command for the process"`
+}
+
+type SetBuildpacksArgs struct {
+	AppName    string   `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Buildpacks []string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpacks, in the order they should run"`
+}
+
 type CreateBuildpackArgs struct {
 	Buildpack string                      `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 	Path      PathWithExistenceCheckOrURL `positional-arg-name:"PATH" required:"true" description:"The path to the buildpack file"`
@@ -280,13 +330,13 @@ type LabelsArgs struct {
 type SetLabelArgs struct {
 	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to label"`
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
-	Labels       []string `positional-arg-name:"KEY=VALUE" required:"true" description:"A space-separated list of labels to set on the resource"`
+	Labels       []string `positional-arg-name:"KEY=VALUE" description:"A space-separated list of labels to set on the resource"`
 }
 
 type UnsetLabelArgs struct {
 	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
-	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to unset on the resource"`
+	LabelKeys    []string `positional-arg-name:"KEY" description:"A label to unset on the resource"`
 }
 type OrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
@@ -328,6 +378,16 @@ type ServiceBrokerArgs struct {
 	URL           string `positional-arg-name:"URL" description:"The URL of the service broker"`
 }
 
+type RotateServiceBrokerCredentialsArgs struct {
+	ServiceBroker string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The service broker name"`
+	Username      string `positional-arg-name:"USERNAME" required:"true" description:"The new username"`
+}
+
+type PromoteServiceBrokerArgs struct {
+	ServiceBroker string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The space-scoped service broker name"`
+	Username      string `positional-arg-name:"USERNAME" required:"true" description:"The username of the service broker"`
+}
+
 type RenameServiceBrokerArgs struct {
 	OldServiceBrokerName string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The old service broker name"`
 	NewServiceBrokerName string `positional-arg-name:"NEW_SERVICE_BROKER" required:"true" description:"The new service broker name"`
@@ -408,3 +468,11 @@ type RemoveNetworkPolicyArgsV7 struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
+
+type ExperimentalArgs struct {
+	Action string `positional-arg-name:"list" required:"true" description:"The action to perform; only list is supported"`
+}
+
+type ProfileName struct {
+	ProfileName string `positional-arg-name:"PROFILE_NAME" required:"true" description:"The name of the profile"`
+}
@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// CanarySteps is a flag that accepts a comma-separated list of the
+// percentages of instances a canary deployment replaces at each step, e.g.
+// "10,50,80". Every weight is a whole number from 1 to 100 and larger than the
+// one before it.
+type CanarySteps struct {
+	Weights []int
+}
+
+func (s *CanarySteps) UnmarshalFlag(rawValue string) error {
+	var weights []int
+	for _, rawWeight := range strings.Split(rawValue, ",") {
+		weight, err := strconv.Atoi(strings.TrimSpace(rawWeight))
+		if err != nil || weight < 1 || weight > 100 || (len(weights) > 0 && weight <= weights[len(weights)-1]) {
+			return &flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: `Value must be a comma-separated list of increasing whole numbers between 1 and 100.`,
+			}
+		}
+		weights = append(weights, weight)
+	}
+
+	s.Weights = weights
+	return nil
+}
@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("CanarySteps", func() {
+	var steps CanarySteps
+
+	BeforeEach(func() {
+		steps = CanarySteps{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expected []int) {
+			Expect(steps.UnmarshalFlag(input)).To(Succeed())
+			Expect(steps.Weights).To(Equal(expected))
+		},
+		Entry("a single weight", "25", []int{25}),
+		Entry("several weights", "10,50,80", []int{10, 50, 80}),
+		Entry("weights with spaces", "10, 50", []int{10, 50}),
+		Entry("the lowest and highest values", "1,100", []int{1, 100}),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			Expect(steps.UnmarshalFlag(input)).To(MatchError(&flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: `Value must be a comma-separated list of increasing whole numbers between 1 and 100.`,
+			}))
+		},
+		Entry("zero", "0"),
+		Entry("more than 100", "10,101"),
+		Entry("decreasing weights", "50,10"),
+		Entry("repeated weights", "50,50"),
+		Entry("an empty weight", "10,,50"),
+		Entry("not a number", "some"),
+	)
+})
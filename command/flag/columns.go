@@ -0,0 +1,16 @@
+package flag
+
+import "strings"
+
+// Columns is a comma-separated list of the names of table columns to display.
+type Columns []string
+
+func (c *Columns) UnmarshalFlag(value string) error {
+	for _, column := range strings.Split(value, ",") {
+		trimmed := strings.ToLower(strings.TrimSpace(column))
+		if trimmed != "" {
+			*c = append(*c, trimmed)
+		}
+	}
+	return nil
+}
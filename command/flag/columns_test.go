@@ -0,0 +1,27 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Columns", func() {
+	var columns Columns
+
+	BeforeEach(func() {
+		columns = Columns{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits the value on commas", func() {
+			Expect(columns.UnmarshalFlag("name,state,memory")).To(Succeed())
+			Expect(columns).To(Equal(Columns{"name", "state", "memory"}))
+		})
+
+		It("trims, lowercases and skips empty column names", func() {
+			Expect(columns.UnmarshalFlag(" Name,, State ,")).To(Succeed())
+			Expect(columns).To(Equal(Columns{"name", "state"}))
+		})
+	})
+})
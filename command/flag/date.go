@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Date is a flag that accepts a day in the YYYY-MM-DD form, which is taken to
+// start at midnight UTC, or an RFC 3339 timestamp.
+type Date struct {
+	Value time.Time
+	IsSet bool
+}
+
+func (d *Date) UnmarshalFlag(rawValue string) error {
+	value, err := time.Parse("2006-01-02", rawValue)
+	if err != nil {
+		value, err = time.Parse(time.RFC3339, rawValue)
+	}
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Date must be a day such as 2024-01-31 or a timestamp such as 2024-01-31T12:00:00Z",
+		}
+	}
+
+	d.Value = value
+	d.IsSet = true
+	return nil
+}
@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Date", func() {
+	var date Date
+
+	BeforeEach(func() {
+		date = Date{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expected time.Time) {
+			err := date.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(date.Value.Equal(expected)).To(BeTrue())
+			Expect(date.IsSet).To(BeTrue())
+		},
+		Entry("day", "2024-01-31", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+		Entry("UTC timestamp", "2024-01-31T12:30:00Z", time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC)),
+		Entry("timestamp with offset", "2024-01-31T12:30:00+02:00", time.Date(2024, 1, 31, 10, 30, 0, 0, time.UTC)),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			err := date.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Date must be a day such as 2024-01-31 or a timestamp such as 2024-01-31T12:00:00Z",
+			}))
+			Expect(date.IsSet).To(BeFalse())
+		},
+		Entry("garbage", "yesterday"),
+		Entry("american order", "01/31/2024"),
+		Entry("day out of range", "2024-02-30"),
+	)
+})
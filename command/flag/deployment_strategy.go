@@ -7,12 +7,22 @@ import (
 	"github.com/jessevdk/go-flags"
 )
 
+// deploymentStrategyNone explicitly selects the default strategy of stopping
+// the app before starting it again.
+const deploymentStrategyNone = "none"
+
 type DeploymentStrategy struct {
 	Name constant.DeploymentStrategy
+	// IsSet tells an explicit "none" apart from a strategy that is not given.
+	IsSet bool
 }
 
 func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
-	return completions([]string{string(constant.DeploymentStrategyRolling)}, prefix, false)
+	return completions([]string{
+		string(constant.DeploymentStrategyCanary),
+		deploymentStrategyNone,
+		string(constant.DeploymentStrategyRolling),
+	}, prefix, false)
 }
 
 func (h *DeploymentStrategy) UnmarshalFlag(val string) error {
@@ -20,18 +30,19 @@ func (h *DeploymentStrategy) UnmarshalFlag(val string) error {
 
 	switch valLower {
 
-	case string(constant.DeploymentStrategyDefault):
-		// Do nothing, leave the default value
+	case string(constant.DeploymentStrategyDefault), deploymentStrategyNone:
+		h.Name = constant.DeploymentStrategyDefault
 
-	case string(constant.DeploymentStrategyRolling):
+	case string(constant.DeploymentStrategyRolling), string(constant.DeploymentStrategyCanary):
 		h.Name = constant.DeploymentStrategy(valLower)
 
 	default:
 		return &flags.Error{
 			Type:    flags.ErrInvalidChoice,
-			Message: `STRATEGY must be "rolling" or not set`,
+			Message: `STRATEGY must be "rolling", "canary" or "none"`,
 		}
 	}
 
+	h.IsSet = true
 	return nil
 }
@@ -20,6 +20,8 @@ var _ = Describe("DeploymentStrategy", func() {
 			},
 			Entry("returns 'rolling' when passed 'r'", "r",
 				[]flags.Completion{{Item: "rolling"}}),
+			Entry("returns 'canary' when passed 'c'", "c",
+				[]flags.Completion{{Item: "canary"}}),
 		)
 	})
 
@@ -33,10 +35,13 @@ var _ = Describe("DeploymentStrategy", func() {
 				err := strategy.UnmarshalFlag(settingType)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(strategy.Name).To(Equal(expectedType))
+				Expect(strategy.IsSet).To(BeTrue())
 			},
 			Entry("sets 'rolling' when passed 'rolling'", "rolling", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'rOlliNg'", "rOlliNg", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'ROLLING'", "ROLLING", constant.DeploymentStrategyRolling),
+			Entry("sets 'canary' when passed 'Canary'", "Canary", constant.DeploymentStrategyCanary),
+			Entry("sets the default when passed 'none'", "none", constant.DeploymentStrategyDefault),
 		)
 
 		When("passed anything else", func() {
@@ -44,9 +49,10 @@ var _ = Describe("DeploymentStrategy", func() {
 				err := strategy.UnmarshalFlag("banana")
 				Expect(err).To(MatchError(&flags.Error{
 					Type:    flags.ErrInvalidChoice,
-					Message: `STRATEGY must be "rolling" or not set`,
+					Message: `STRATEGY must be "rolling", "canary" or "none"`,
 				}))
 				Expect(strategy.Name).To(BeEmpty())
+				Expect(strategy.IsSet).To(BeFalse())
 			})
 		})
 	})
@@ -0,0 +1,36 @@
+package flag
+
+import (
+	"net/url"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// DrainURL is the URL of a syslog drain. It must use the syslog, syslog-tls or
+// https scheme.
+type DrainURL struct {
+	URL string
+}
+
+func (d *DrainURL) UnmarshalFlag(val string) error {
+	parsedURL, err := url.Parse(val)
+	if err != nil || parsedURL.Host == "" {
+		return d.invalidURLError()
+	}
+
+	switch parsedURL.Scheme {
+	case "syslog", "syslog-tls", "https":
+	default:
+		return d.invalidURLError()
+	}
+
+	d.URL = val
+	return nil
+}
+
+func (DrainURL) invalidURLError() error {
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `SYSLOG_URL must be a URL with the "syslog", "syslog-tls" or "https" scheme`,
+	}
+}
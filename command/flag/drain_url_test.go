@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DrainURL", func() {
+	var drainURL DrainURL
+
+	BeforeEach(func() {
+		drainURL = DrainURL{}
+	})
+
+	DescribeTable("accepts syslog, syslog-tls and https URLs",
+		func(input string) {
+			err := drainURL.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drainURL.URL).To(Equal(input))
+		},
+		Entry("syslog", "syslog://logs.example.com:514"),
+		Entry("syslog-tls", "syslog-tls://logs.example.com:6514"),
+		Entry("https with a drain type", "https://logs.example.com/ingest?drain-type=all"),
+	)
+
+	DescribeTable("rejects other values",
+		func(input string) {
+			err := drainURL.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `SYSLOG_URL must be a URL with the "syslog", "syslog-tls" or "https" scheme`,
+			}))
+			Expect(drainURL.URL).To(BeEmpty())
+		},
+		Entry("http", "http://logs.example.com"),
+		Entry("no scheme", "logs.example.com:514"),
+		Entry("no host", "syslog://"),
+	)
+})
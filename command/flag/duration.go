@@ -0,0 +1,42 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a flag that accepts Go duration strings (e.g. 90m, 12h) as well
+// as a number of days suffixed with "d" (e.g. 7d).
+type Duration struct {
+	Value time.Duration
+	IsSet bool
+}
+
+func (d *Duration) UnmarshalFlag(rawValue string) error {
+	value, err := parseDuration(rawValue)
+	if err != nil || value <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Duration must be a positive duration such as 30m, 12h or 7d",
+		}
+	}
+
+	d.Value = value
+	d.IsSet = true
+	return nil
+}
+
+func parseDuration(rawValue string) (time.Duration, error) {
+	if days := strings.TrimSuffix(rawValue, "d"); days != rawValue {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(rawValue)
+}
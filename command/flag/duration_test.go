@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	BeforeEach(func() {
+		duration = Duration{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expected time.Duration) {
+			err := duration.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(duration.Value).To(Equal(expected))
+			Expect(duration.IsSet).To(BeTrue())
+		},
+		Entry("minutes", "30m", 30*time.Minute),
+		Entry("hours", "12h", 12*time.Hour),
+		Entry("mixed units", "1h30m", 90*time.Minute),
+		Entry("days", "7d", 7*24*time.Hour),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			err := duration.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Duration must be a positive duration such as 30m, 12h or 7d",
+			}))
+			Expect(duration.IsSet).To(BeFalse())
+		},
+		Entry("no unit", "12"),
+		Entry("garbage", "soon"),
+		Entry("fractional days", "1.5d"),
+		Entry("zero", "0s"),
+		Entry("negative", "-1h"),
+	)
+})
@@ -0,0 +1,53 @@
+package flag
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogFilter is a flag that accepts a KEY=VALUE criterion on log messages:
+// source=TYPE for a source type (e.g. APP, STG, RTR), instance=INDEX for an
+// instance index or message=REGEX for a regular expression the message body
+// must match.
+type LogFilter struct {
+	SourceType string
+	Instance   string
+	Pattern    *regexp.Regexp
+}
+
+func (f *LogFilter) UnmarshalFlag(rawValue string) error {
+	key, value, found := strings.Cut(rawValue, "=")
+	if !found || value == "" {
+		return logFilterError("Filter must be source=TYPE, instance=INDEX or message=REGEX")
+	}
+
+	switch key {
+	case "source":
+		f.SourceType = strings.ToUpper(value)
+	case "instance":
+		if index, err := strconv.Atoi(value); err != nil || index < 0 {
+			return logFilterError("Filter instance must be a non-negative instance index")
+		}
+		f.Instance = value
+	case "message":
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			return logFilterError("Filter message must be a valid regular expression: " + err.Error())
+		}
+		f.Pattern = pattern
+	default:
+		return logFilterError("Filter must be source=TYPE, instance=INDEX or message=REGEX")
+	}
+
+	return nil
+}
+
+func logFilterError(message string) error {
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: message,
+	}
+}
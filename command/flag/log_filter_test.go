@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	"regexp"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	var filter LogFilter
+
+	BeforeEach(func() {
+		filter = LogFilter{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expected LogFilter) {
+			err := filter.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter).To(Equal(expected))
+		},
+		Entry("a source type", "source=rtr", LogFilter{SourceType: "RTR"}),
+		Entry("an instance index", "instance=2", LogFilter{Instance: "2"}),
+		Entry("a message pattern", "message=status=5\\d\\d", LogFilter{Pattern: regexp.MustCompile("status=5\\d\\d")}),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string, message string) {
+			err := filter.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: message,
+			}))
+		},
+		Entry("no value", "source=", "Filter must be source=TYPE, instance=INDEX or message=REGEX"),
+		Entry("an unknown key", "host=web", "Filter must be source=TYPE, instance=INDEX or message=REGEX"),
+		Entry("a negative instance", "instance=-1", "Filter instance must be a non-negative instance index"),
+		Entry("an invalid pattern", "message=(", "Filter message must be a valid regular expression: error parsing regexp: missing closing ): `(`"),
+	)
+})
@@ -0,0 +1,19 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type OutputFormat string
+
+const (
+	OutputFormatText OutputFormat = "text"
+	OutputFormatJSON OutputFormat = "json"
+	OutputFormatWide OutputFormat = "wide"
+	OutputFormatCSV  OutputFormat = "csv"
+	// OutputFormatTable is the output of the global --output flag for the
+	// usual output.
+	OutputFormatTable OutputFormat = "table"
+)
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(OutputFormatText), string(OutputFormatJSON), string(OutputFormatWide), string(OutputFormatCSV), string(OutputFormatTable)}, prefix, false)
+}
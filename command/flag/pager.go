@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Pager struct {
+	Value string
+	IsSet bool
+}
+
+func (Pager) Complete(prefix string) []flags.Completion {
+	return completions([]string{"true", "false"}, prefix, false)
+}
+
+func (p *Pager) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "true":
+		p.Value = "true"
+		p.IsSet = true
+	case "false":
+		p.Value = "false"
+		p.IsSet = true
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `PAGER must be "true" or "false"`,
+		}
+	}
+
+	return nil
+}
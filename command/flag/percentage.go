@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Percentage is a flag that accepts a whole number from 1 to 100, optionally
+// suffixed with "%".
+type Percentage struct {
+	Value int
+}
+
+func (p *Percentage) UnmarshalFlag(rawValue string) error {
+	value, err := strconv.Atoi(strings.TrimSuffix(rawValue, "%"))
+	if err != nil || value < 1 || value > 100 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be a whole number between 1 and 100.`,
+		}
+	}
+
+	p.Value = value
+	return nil
+}
@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Percentage", func() {
+	var percentage Percentage
+
+	BeforeEach(func() {
+		percentage = Percentage{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expected int) {
+			Expect(percentage.UnmarshalFlag(input)).To(Succeed())
+			Expect(percentage.Value).To(Equal(expected))
+		},
+		Entry("a whole number", "75", 75),
+		Entry("a number with a percent sign", "50%", 50),
+		Entry("the lowest value", "1", 1),
+		Entry("the highest value", "100", 100),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			Expect(percentage.UnmarshalFlag(input)).To(MatchError(&flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: `Value must be a whole number between 1 and 100.`,
+			}))
+		},
+		Entry("zero", "0"),
+		Entry("more than 100", "101"),
+		Entry("a fraction", "12.5"),
+		Entry("not a number", "half"),
+	)
+})
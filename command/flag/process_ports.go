@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ProcessPorts is a comma-separated list of the ports that a process listens
+// on, e.g. 8080,9090.
+type ProcessPorts struct {
+	Ports []int
+}
+
+func (p *ProcessPorts) UnmarshalFlag(val string) error {
+	p.Ports = nil
+	for _, value := range strings.Split(val, ",") {
+		port, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || port < 1 || port > 65535 {
+			return &flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `PORTS must be a comma-separated list of integers between 1 and 65535`,
+			}
+		}
+		p.Ports = append(p.Ports, port)
+	}
+
+	return nil
+}
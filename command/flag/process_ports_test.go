@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProcessPorts", func() {
+	var ports ProcessPorts
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			ports = ProcessPorts{}
+		})
+
+		DescribeTable("it sets the ports correctly",
+			func(input string, expectedPorts []int) {
+				err := ports.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ports.Ports).To(Equal(expectedPorts))
+			},
+			Entry("when provided '8080' it sets a single port", "8080", []int{8080}),
+			Entry("when provided '8080,9090' it sets both ports", "8080,9090", []int{8080, 9090}),
+			Entry("when provided '8080, 9090' it ignores the spaces", "8080, 9090", []int{8080, 9090}),
+		)
+
+		DescribeTable("errors correctly",
+			func(input string) {
+				err := ports.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `PORTS must be a comma-separated list of integers between 1 and 65535`,
+				}))
+			},
+			Entry("when provided 'foo'", "foo"),
+			Entry("when provided an empty port", "8080,"),
+			Entry("when provided '0'", "0"),
+			Entry("when provided '70000'", "70000"),
+		)
+	})
+})
@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type ResolveOverride struct {
+	util.ResolveOverride
+	Clear bool
+}
+
+func (r *ResolveOverride) UnmarshalFlag(val string) error {
+	if val == "CLEAR" {
+		r.Clear = true
+		return nil
+	}
+
+	override, err := util.ParseResolveOverride(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("RESOLVE must be HOST:PORT:ADDRESS[,ADDRESS] or CLEAR: %s", err),
+		}
+	}
+
+	r.ResolveOverride = override
+	return nil
+}
@@ -0,0 +1,53 @@
+package flag_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResolveOverride", func() {
+	var override ResolveOverride
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			override = ResolveOverride{}
+		})
+
+		When("passed HOST:PORT:ADDRESS", func() {
+			It("extracts the override", func() {
+				err := override.UnmarshalFlag("api.example.com:443:10.0.0.5")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(override).To(Equal(ResolveOverride{
+					ResolveOverride: util.ResolveOverride{
+						Host:      "api.example.com",
+						Port:      "443",
+						Addresses: []net.IP{net.ParseIP("10.0.0.5")},
+					},
+				}))
+			})
+		})
+
+		When("passed CLEAR", func() {
+			It("sets Clear", func() {
+				err := override.UnmarshalFlag("CLEAR")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(override).To(Equal(ResolveOverride{Clear: true}))
+			})
+		})
+
+		When("passed an invalid override", func() {
+			It("returns an error", func() {
+				err := override.UnmarshalFlag("api.example.com:443")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `RESOLVE must be HOST:PORT:ADDRESS[,ADDRESS] or CLEAR: "api.example.com:443" is not in the HOST:PORT:ADDRESS form`,
+				}))
+			})
+		})
+	})
+})
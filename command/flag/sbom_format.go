@@ -0,0 +1,9 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type SBOMFormat string
+
+func (SBOMFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"cyclonedx", "spdx"}, prefix, false)
+}
@@ -0,0 +1,31 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SBOMFormat", func() {
+	var format SBOMFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := format.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'cyclonedx' when passed 'c'", "c",
+				[]flags.Completion{{Item: "cyclonedx"}}),
+			Entry("completes to 'spdx' when passed 'S'", "S",
+				[]flags.Completion{{Item: "spdx"}}),
+			Entry("returns 'cyclonedx' and 'spdx' when passed nothing", "",
+				[]flags.Completion{{Item: "cyclonedx"}, {Item: "spdx"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+})
@@ -0,0 +1,9 @@
+package command
+
+// PagedCommand is implemented by commands whose output can be long enough to
+// be shown through a pager, such as listings. PagedOutput returns false when
+// the command is going to prompt for input, since the pager takes over the
+// terminal while the command runs.
+type PagedCommand interface {
+	PagedOutput() bool
+}
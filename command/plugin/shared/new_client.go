@@ -16,6 +16,8 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
 		DialTimeout:       config.DialTimeout(),
+		IPFamily:          config.IPFamily(),
+		ResolveOverrides:  config.ResolveOverrides(),
 		SkipSSLValidation: skipSSLValidation,
 	})
 
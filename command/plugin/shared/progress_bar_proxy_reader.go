@@ -3,29 +3,49 @@ package shared
 import (
 	"io"
 
+	"code.cloudfoundry.org/cli/util/progressbar"
 	pb "gopkg.in/cheggaaa/pb.v1"
 )
 
 // ProgressBarProxyReader wraps a progress bar in a ProxyReader interface.
+// When plain is set, timestamped progress lines are written instead of an
+// animated bar.
 type ProgressBarProxyReader struct {
 	writer io.Writer
 	bar    *pb.ProgressBar
+	plain  bool
+	size   int64
 }
 
 func (p ProgressBarProxyReader) Wrap(reader io.Reader) io.ReadCloser {
+	if p.plain {
+		return progressbar.NewPlainReader(p.writer, reader, p.size)
+	}
 	return p.bar.NewProxyReader(reader)
 }
 
 func (p *ProgressBarProxyReader) Start(size int64) {
+	if p.plain {
+		p.size = size
+		return
+	}
+
 	p.bar = pb.New(int(size)).SetUnits(pb.U_BYTES)
 	p.bar.Output = p.writer
 	p.bar.Start()
 }
 
 func (p ProgressBarProxyReader) Finish() {
+	if p.bar == nil {
+		return
+	}
 	p.bar.Finish()
 }
 
 func NewProgressBarProxyReader(writer io.Writer) *ProgressBarProxyReader {
 	return &ProgressBarProxyReader{writer: writer}
 }
+
+func NewPlainProgressBarProxyReader(writer io.Writer) *ProgressBarProxyReader {
+	return &ProgressBarProxyReader{writer: writer, plain: true}
+}
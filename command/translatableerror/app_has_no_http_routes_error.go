@@ -0,0 +1,17 @@
+package translatableerror
+
+// AppHasNoHTTPRoutesError is returned when opening an app that has no HTTP
+// routes mapped to it.
+type AppHasNoHTTPRoutesError struct {
+	AppName string
+}
+
+func (AppHasNoHTTPRoutesError) Error() string {
+	return "App '{{.AppName}}' has no HTTP routes to open."
+}
+
+func (e AppHasNoHTTPRoutesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type AppVerificationFailedError struct {
+	AppName string
+}
+
+func (AppVerificationFailedError) Error() string {
+	return "App {{.AppName}} failed verification."
+}
+
+func (e AppVerificationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,11 @@
+package translatableerror
+
+type BrokerPasswordNotSetError struct{}
+
+func (BrokerPasswordNotSetError) Error() string {
+	return "Environment variable CF_BROKER_PASSWORD not set."
+}
+
+func (e BrokerPasswordNotSetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
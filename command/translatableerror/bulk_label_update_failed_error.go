@@ -0,0 +1,17 @@
+package translatableerror
+
+type BulkLabelUpdateFailedError struct {
+	Failed int
+	Total  int
+}
+
+func (BulkLabelUpdateFailedError) Error() string {
+	return "Failed to update the labels of {{.Failed}} of {{.Total}} resources."
+}
+
+func (e BulkLabelUpdateFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
@@ -0,0 +1,24 @@
+package translatableerror
+
+type CapabilityNotSupportedError struct {
+	Capability     string
+	APIVersion     string
+	MinimumVersion string
+	Link           string
+}
+
+func (e CapabilityNotSupportedError) Error() string {
+	if e.MinimumVersion != "" {
+		return "{{.Capability}} requires CF API version {{.MinimumVersion}} or higher. Your target is {{.APIVersion}}."
+	}
+	return "{{.Capability}} is not available because the targeted API does not advertise the '{{.Link}}' endpoint."
+}
+
+func (e CapabilityNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Capability":     e.Capability,
+		"APIVersion":     e.APIVersion,
+		"MinimumVersion": e.MinimumVersion,
+		"Link":           e.Link,
+	})
+}
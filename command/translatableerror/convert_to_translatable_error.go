@@ -34,6 +34,8 @@ func ConvertToTranslatableError(err error) error {
 		return BuildpackNotFoundError(e)
 	case actionerror.BuildpackStackChangeError:
 		return BuildpackStackChangeError(e)
+	case actionerror.CapabilityNotSupportedError:
+		return CapabilityNotSupportedError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
 	case actionerror.DockerPasswordNotSetError:
@@ -136,7 +138,7 @@ func ConvertToTranslatableError(err error) error {
 	case actionerror.SharedServiceInstanceNotFoundError:
 		return SharedServiceInstanceNotFoundError(e)
 	case actionerror.SpaceNotFoundError:
-		return SpaceNotFoundError{Name: e.Name}
+		return SpaceNotFoundError(e)
 	case actionerror.StackNotFoundError:
 		return StackNotFoundError(e)
 	case actionerror.StagingFailedError:
@@ -63,6 +63,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.BuildpackStackChangeError{},
 			BuildpackStackChangeError{}),
 
+		Entry("actionerror.CapabilityNotSupportedError -> CapabilityNotSupportedError",
+			actionerror.CapabilityNotSupportedError{Capability: "some-capability", APIVersion: "3.100.0", MinimumVersion: "3.104.0"},
+			CapabilityNotSupportedError{Capability: "some-capability", APIVersion: "3.100.0", MinimumVersion: "3.104.0"}),
+
 		Entry("actionerror.CommandLineOptionsWithMultipleAppsError -> CommandLineArgsWithMultipleAppsError",
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
@@ -235,8 +239,8 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			RevisionAmbiguousError{Version: 1}),
 
 		Entry("actionerror.RouteInDifferentSpaceError -> RouteInDifferentSpaceError",
-			actionerror.RouteInDifferentSpaceError{Route: "some-route"},
-			RouteInDifferentSpaceError{Route: "some-route"}),
+			actionerror.RouteInDifferentSpaceError{Route: "some-route", OrgName: "some-org", SpaceName: "some-space", SuggestedHost: "some-host"},
+			RouteInDifferentSpaceError{Route: "some-route", OrgName: "some-org", SpaceName: "some-space", SuggestedHost: "some-host"}),
 
 		Entry("actionerror.RoutePathWithTCPDomainError -> RoutePathWithTCPDomainError",
 			actionerror.RoutePathWithTCPDomainError{},
@@ -271,6 +275,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("actionerror.SpaceNotFoundError with a GUID -> SpaceNotFoundError",
+			actionerror.SpaceNotFoundError{GUID: "some-space-guid"},
+			SpaceNotFoundError{GUID: "some-space-guid"}),
+
 		Entry("actionerror.StackNotFoundError -> StackNotFoundError",
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
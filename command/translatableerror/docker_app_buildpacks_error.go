@@ -0,0 +1,17 @@
+package translatableerror
+
+// DockerAppBuildpacksError is returned when setting the buildpacks of an app
+// that runs a Docker image.
+type DockerAppBuildpacksError struct {
+	AppName string
+}
+
+func (DockerAppBuildpacksError) Error() string {
+	return "App '{{.AppName}}' runs a Docker image and does not use buildpacks."
+}
+
+func (e DockerAppBuildpacksError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
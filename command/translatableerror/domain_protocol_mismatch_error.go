@@ -0,0 +1,19 @@
+package translatableerror
+
+// DomainProtocolMismatchError is returned when routes cannot be moved between
+// an HTTP and a TCP domain.
+type DomainProtocolMismatchError struct {
+	FromDomain string
+	ToDomain   string
+}
+
+func (DomainProtocolMismatchError) Error() string {
+	return "Routes cannot be migrated from domain {{.FromDomain}} to domain {{.ToDomain}} because only one of them is a TCP domain."
+}
+
+func (e DomainProtocolMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FromDomain": e.FromDomain,
+		"ToDomain":   e.ToDomain,
+	})
+}
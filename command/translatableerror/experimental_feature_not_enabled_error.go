@@ -0,0 +1,19 @@
+package translatableerror
+
+// ExperimentalFeatureNotEnabledError is returned when a command or flag of an
+// experimental feature is used while the feature is turned off.
+type ExperimentalFeatureNotEnabledError struct {
+	Name       string
+	BinaryName string
+}
+
+func (ExperimentalFeatureNotEnabledError) Error() string {
+	return "The experimental feature '{{.Name}}' is turned off. Turn it on with '{{.BinaryName}} config --enable-experimental {{.Name}}' or by setting CF_CLI_EXPERIMENTAL={{.Name}}."
+}
+
+func (e ExperimentalFeatureNotEnabledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":       e.Name,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ExperimentalFeatureNotFoundError struct {
+	Name       string
+	BinaryName string
+}
+
+func (ExperimentalFeatureNotFoundError) Error() string {
+	return "Experimental feature '{{.Name}}' not found. Use '{{.BinaryName}} experimental list' to see the experimental features."
+}
+
+func (e ExperimentalFeatureNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":       e.Name,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ExportDockerImageError is returned when exporting the image of an app that
+// already runs a Docker image.
+type ExportDockerImageError struct {
+	AppName string
+	Image   string
+}
+
+func (ExportDockerImageError) Error() string {
+	return "App '{{.AppName}}' runs the Docker image '{{.Image}}'; use that image instead of exporting one."
+}
+
+func (e ExportDockerImageError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Image":   e.Image,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidClientCertificateError is returned when the certificate and private
+// key given for mutual TLS cannot be loaded.
+type InvalidClientCertificateError struct {
+	CertFile string
+	KeyFile  string
+	Err      error
+}
+
+func (InvalidClientCertificateError) Error() string {
+	return "Unable to load client certificate {{.CertFile}} with private key {{.KeyFile}}: {{.Err}}"
+}
+
+func (e InvalidClientCertificateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"CertFile": e.CertFile,
+		"KeyFile":  e.KeyFile,
+		"Err":      e.Err,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidColorThemeError is returned when a color theme, or the theme file it
+// refers to, cannot be parsed.
+type InvalidColorThemeError struct {
+	Theme  string
+	Reason string
+}
+
+func (InvalidColorThemeError) Error() string {
+	return "Invalid color theme '{{.Theme}}': {{.Reason}}"
+}
+
+func (e InvalidColorThemeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Theme":  e.Theme,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ManifestSnapshotNotFoundError is returned when apply-manifest --rollback is
+// run in a space that no manifest was applied to with this CLI.
+type ManifestSnapshotNotFoundError struct {
+	SpaceName string
+}
+
+func (ManifestSnapshotNotFoundError) Error() string {
+	return "No manifest snapshot to roll back to was found for space {{.SpaceName}}. A snapshot is saved each time apply-manifest is run."
+}
+
+func (e ManifestSnapshotNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SpaceName": e.SpaceName,
+	})
+}
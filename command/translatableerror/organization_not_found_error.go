@@ -5,12 +5,17 @@ type OrganizationNotFoundError struct {
 	Name string
 }
 
-func (OrganizationNotFoundError) Error() string {
+func (e OrganizationNotFoundError) Error() string {
+	if e.Name == "" && e.GUID != "" {
+		return "Organization with GUID '{{.GUID}}' not found."
+	}
+
 	return "Organization '{{.Name}}' not found."
 }
 
 func (e OrganizationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{
+		"GUID": e.GUID,
 		"Name": e.Name,
 	})
 }
@@ -0,0 +1,16 @@
+package translatableerror
+
+// ProfileNotFoundError is returned when no target profile has the given name.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (ProfileNotFoundError) Error() string {
+	return "Profile '{{.Name}}' not found."
+}
+
+func (e ProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ProfileSetByEnvError is returned when switching profiles while CF_PROFILE
+// selects one.
+type ProfileSetByEnvError struct {
+	Name string
+}
+
+func (ProfileSetByEnvError) Error() string {
+	return "The profile cannot be switched while CF_PROFILE is set to '{{.Name}}'. Unset CF_PROFILE or set it to the profile to use."
+}
+
+func (e ProfileSetByEnvError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
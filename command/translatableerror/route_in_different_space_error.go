@@ -1,15 +1,28 @@
 package translatableerror
 
 type RouteInDifferentSpaceError struct {
-	Route string
+	Route         string
+	OrgName       string
+	SpaceName     string
+	SuggestedHost string
 }
 
 func (e RouteInDifferentSpaceError) Error() string {
-	return "The app cannot be mapped to route {{.URL}} because the route exists in a different space."
+	message := "The app cannot be mapped to route {{.URL}} because the route exists in a different space."
+	if e.SpaceName != "" {
+		message = "The app cannot be mapped to route {{.URL}} because the route exists in org {{.OrgName}} / space {{.SpaceName}}."
+	}
+	if e.SuggestedHost != "" {
+		message += " The hostname {{.SuggestedHost}} is available."
+	}
+	return message
 }
 
 func (e RouteInDifferentSpaceError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{
-		"URL": e.Route,
+		"URL":           e.Route,
+		"OrgName":       e.OrgName,
+		"SpaceName":     e.SpaceName,
+		"SuggestedHost": e.SuggestedHost,
 	})
 }
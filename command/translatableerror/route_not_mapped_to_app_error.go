@@ -0,0 +1,19 @@
+package translatableerror
+
+// RouteNotMappedToAppError is returned when a route given for an app is not
+// one of the routes of the app.
+type RouteNotMappedToAppError struct {
+	Route   string
+	AppName string
+}
+
+func (RouteNotMappedToAppError) Error() string {
+	return "Route '{{.Route}}' is not mapped to app '{{.AppName}}'."
+}
+
+func (e RouteNotMappedToAppError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Route":   e.Route,
+		"AppName": e.AppName,
+	})
+}
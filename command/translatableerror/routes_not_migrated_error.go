@@ -0,0 +1,17 @@
+package translatableerror
+
+// RoutesNotMigratedError is returned when some routes of a domain could not
+// be migrated to another domain.
+type RoutesNotMigratedError struct {
+	Count int
+}
+
+func (RoutesNotMigratedError) Error() string {
+	return "{{.Count}} route(s) could not be migrated. Their old routes have been kept."
+}
+
+func (e RoutesNotMigratedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
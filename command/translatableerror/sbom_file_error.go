@@ -0,0 +1,15 @@
+package translatableerror
+
+type SBOMFileError struct {
+	Err error
+}
+
+func (SBOMFileError) Error() string {
+	return "Error creating SBOM file: {{.Error}}"
+}
+
+func (e SBOMFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Error": e.Err,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// SBOMPathNotDirectoryError is returned when a droplet contains more than one
+// SBOM and the given path is not a directory to write them to.
+type SBOMPathNotDirectoryError struct {
+	Path  string
+	Count int
+}
+
+func (SBOMPathNotDirectoryError) Error() string {
+	return "The droplet contains {{.Count}} SBOMs, one per buildpack layer. Use --path with an existing directory to download them: '{{.Path}}' is not a directory."
+}
+
+func (e SBOMPathNotDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+		"Path":  e.Path,
+	})
+}
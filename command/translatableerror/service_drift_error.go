@@ -0,0 +1,17 @@
+package translatableerror
+
+// ServiceDriftError is returned when the service instances of a space differ
+// from the ones a manifest declares.
+type ServiceDriftError struct {
+	Count int
+}
+
+func (ServiceDriftError) Error() string {
+	return "Found {{.Count}} difference(s) between the manifest and the service instances in the space."
+}
+
+func (e ServiceDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// ServiceInstancesNotResolvableError is returned when some service instances
+// of a promoted space-scoped service broker can no longer be found with their
+// plans.
+type ServiceInstancesNotResolvableError struct {
+	Count int
+}
+
+func (ServiceInstancesNotResolvableError) Error() string {
+	return "{{.Count}} service instance(s) of the space-scoped service broker could not be resolved after the promotion."
+}
+
+func (e ServiceInstancesNotResolvableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+// ServiceUpgradeAvailableExitCode is the exit code of 'upgrade-service
+// --check-only' when an upgrade is available.
+const ServiceUpgradeAvailableExitCode = 2
+
+// ServiceUpgradeAvailableError is returned by 'upgrade-service --check-only'
+// when the service instance can be upgraded, so that the CLI exits with
+// ServiceUpgradeAvailableExitCode.
+type ServiceUpgradeAvailableError struct {
+	ServiceInstanceName string
+}
+
+func (ServiceUpgradeAvailableError) Error() string {
+	return "An upgrade is available for service instance {{.ServiceInstanceName}}."
+}
+
+func (e ServiceUpgradeAvailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
@@ -1,15 +1,21 @@
 package translatableerror
 
 type SpaceNotFoundError struct {
+	GUID string
 	Name string
 }
 
-func (SpaceNotFoundError) Error() string {
+func (e SpaceNotFoundError) Error() string {
+	if e.Name == "" && e.GUID != "" {
+		return "Space with GUID '{{.GUID}}' not found."
+	}
+
 	return "Space '{{.Name}}' not found."
 }
 
 func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{
+		"GUID": e.GUID,
 		"Name": e.Name,
 	})
 }
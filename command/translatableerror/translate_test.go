@@ -41,15 +41,19 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("AppVerificationFailedError", AppVerificationFailedError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", UnauthorizedError{}),
+		Entry("BrokerPasswordNotSetError", BrokerPasswordNotSetError{}),
 		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
 		Entry("BuildpackStackChangeError", BuildpackStackChangeError{}),
+		Entry("BulkLabelUpdateFailedError", BulkLabelUpdateFailedError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
+		Entry("DomainProtocolMismatchError", DomainProtocolMismatchError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("EmptyBuildpacksError", EmptyBuildpacksError{}),
@@ -63,6 +67,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidClientCertificateError", InvalidClientCertificateError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
@@ -72,6 +77,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
 		Entry("ManifestCreationError", FileCreationError{}),
 		Entry("ManifestFileNotFoundInDirectoryError", ManifestFileNotFoundInDirectoryError{}),
+		Entry("ManifestSnapshotNotFoundError", ManifestSnapshotNotFoundError{}),
 		Entry("MinimumCFAPIVersionNotMetError", MinimumCFAPIVersionNotMetError{}),
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
 		Entry("MissingCredentialsError", MissingCredentialsError{}),
@@ -101,16 +107,21 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
+		Entry("ProfileNotFoundError", ProfileNotFoundError{}),
+		Entry("ProfileSetByEnvError", ProfileSetByEnvError{}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RoutePathWithTCPDomainError", RoutePathWithTCPDomainError{}),
+		Entry("RoutesNotMigratedError", RoutesNotMigratedError{}),
 		Entry("RunTaskError", RunTaskError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceInstancesNotResolvableError", ServiceInstancesNotResolvableError{}),
+		Entry("ServiceUpgradeAvailableError", ServiceUpgradeAvailableError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
@@ -123,6 +134,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
+		Entry("UnavailableRoutesError", UnavailableRoutesError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
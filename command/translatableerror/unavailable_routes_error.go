@@ -0,0 +1,17 @@
+package translatableerror
+
+// UnavailableRoutesError is returned when routes of a manifest cannot be
+// mapped, before anything is pushed.
+type UnavailableRoutesError struct {
+	Count int
+}
+
+func (UnavailableRoutesError) Error() string {
+	return "{{.Count}} route(s) of the manifest cannot be mapped. Nothing has been pushed."
+}
+
+func (e UnavailableRoutesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
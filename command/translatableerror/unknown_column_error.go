@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// UnknownColumnError is returned when a table column is requested that the
+// command does not display.
+type UnknownColumnError struct {
+	Column  string
+	Columns []string
+}
+
+func (UnknownColumnError) Error() string {
+	return "Unknown column '{{.Column}}'. Available columns: {{.Columns}}"
+}
+
+func (e UnknownColumnError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Column":  e.Column,
+		"Columns": strings.Join(e.Columns, ", "),
+	})
+}
@@ -1,8 +0,0 @@
-package translatableerror
-
-type V3V2SwitchError struct {
-}
-
-func (e V3V2SwitchError) Error() string {
-	return ""
-}
@@ -22,6 +22,7 @@ type UI interface {
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
 	DisplayJSON(name string, jsonData interface{}) error
+	DisplayJSONLine(jsonData interface{}) error
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
@@ -30,6 +31,7 @@ type UI interface {
 	DisplayOK()
 	DisplayOptionalTextPrompt(defaultValue string, template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayTableWithColumns(prefix string, columns []ui.TableColumn, rows [][]string, selected []int, padding int)
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextMenu(choices []string, promptTemplate string, templateValues ...map[string]interface{}) (string, error)
@@ -41,8 +43,10 @@ type UI interface {
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
+	NewScreen() *ui.Screen
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
+	StartStructuredOutput()
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -14,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"github.com/SermoDigital/jose/jwt"
 )
@@ -25,18 +27,21 @@ type Actor interface {
 	ApplySpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (v7action.Warnings, error)
 	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v7action.Warnings, error)
 	Authenticate(credentials map[string]string, origin string, grantType uaa.GrantType) error
+	AuthenticateWithDeviceAuthorization(authorization v7action.DeviceAuthorization) error
 	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []resources.Space, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
 	CancelDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CheckRoute(domainName string, hostname string, path string, port int) (bool, v7action.Warnings, error)
+	CheckRoutesAvailability(routePaths []string, spaceGUID string) ([]v7action.RouteProblem, v7action.Warnings, error)
+	ClearApplicationBuildCache(appGUID string) (v7action.Warnings, error)
 	ClearTarget()
+	ContinueDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CopyPackage(sourceApp resources.Application, targetApp resources.Application) (resources.Package, v7action.Warnings, error)
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (resources.Package, v7action.Warnings, error)
 	CreateApplicationDroplet(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (resources.Package, v7action.Warnings, error)
 	CreateBuildpack(buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
 	CreateDockerPackageByApplication(appGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateIsolationSegmentByName(isolationSegment resources.IsolationSegment) (v7action.Warnings, error)
@@ -66,6 +71,7 @@ type Actor interface {
 	DeleteOrganizationQuota(quotaName string) (v7action.Warnings, error)
 	DeleteOrphanedRoutes(spaceGUID string) (v7action.Warnings, error)
 	DeleteRoute(domainName, hostname, path string, port int) (v7action.Warnings, error)
+	DeleteRouteByGUID(routeGUID string) (v7action.Warnings, error)
 	DeleteRouteBinding(params v7action.DeleteRouteBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	DeleteSecurityGroup(securityGroupName string) (v7action.Warnings, error)
 	DeleteServiceAppBinding(params v7action.DeleteServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
@@ -83,12 +89,21 @@ type Actor interface {
 	DisableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
 	DownloadCurrentDropletByAppName(appName string, spaceGUID string) ([]byte, string, v7action.Warnings, error)
 	DownloadDropletByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
+	DownloadCurrentDropletSBOMsByAppName(appName string, spaceGUID string, format v7action.SBOMFormat) ([]v7action.SBOM, string, v7action.Warnings, error)
+	DownloadDropletSBOMsByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string, format v7action.SBOMFormat) ([]v7action.SBOM, v7action.Warnings, error)
+	DrainRouteDestination(route resources.Route, destinationGUID string, drain time.Duration) (v7action.Warnings, error)
 	EnableFeatureFlag(flagName string) (v7action.Warnings, error)
 	EnableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
 	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
+	GetAPIResources() ([]v7action.APIResource, v7action.Warnings, error)
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, v7action.Warnings, error)
-	GetAppSummariesForSpace(spaceGUID string, labels string, omitStats bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
+	GetAppSecurityReport(appName string, spaceGUID string) (v7action.AppSecurityReport, v7action.Warnings, error)
+	GetAppSummariesForSpace(spaceGUID string, labels string, omitStats bool, withLastUploaded bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
+	GetAppUsagesForSpace(spaceGUID string, client sharedaction.LogCacheClient, crashesSince time.Time) ([]v7action.AppUsage, v7action.Warnings, error)
+	GetApplicationByGUID(appGUID string) (resources.Application, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
+	GetApplicationProcessSummariesByNameAndSpace(appName string, spaceGUID string) (v7action.ProcessSummaries, v7action.Warnings, error)
+	GetCurrentDropletByApplication(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	GetApplicationMapForRoute(route resources.Route) (map[string]resources.Application, v7action.Warnings, error)
 	GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, v7action.Warnings, error)
 	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
@@ -102,75 +117,110 @@ type Actor interface {
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
+	GetDeploymentForApp(appGUID string, deploymentGUID string) (resources.Deployment, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
+	GetDetailedAppSummaryForApp(app resources.Application, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDomainByName(domainName string) (resources.Domain, v7action.Warnings, error)
 	GetDomainLabels(domainName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetDrainByNameAndSpace(drainName string, spaceGUID string) (v7action.Drain, v7action.Warnings, error)
+	GetDrainsForSpace(spaceGUID string) ([]v7action.Drain, v7action.Warnings, error)
+	GetDumpRecipesForApp(appGUID string, customRecipes []v7action.DumpRecipe, names []string) ([]v7action.DumpRecipe, v7action.Warnings, error)
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (resources.IsolationSegment, v7action.Warnings, error)
 	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error)
 	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
+	GetEvents(query v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error)
 	GetFeatureFlagByName(featureFlagName string) (resources.FeatureFlag, v7action.Warnings, error)
 	GetFeatureFlags() ([]resources.FeatureFlag, v7action.Warnings, error)
+	GetFilteredRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
 	GetGlobalStagingSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
+	GetInternalRoutesBySpace(spaceGUID string, orgGUID string) ([]v7action.InternalRoute, v7action.Warnings, error)
 	GetIsolationSegmentsByOrganization(orgName string) ([]resources.IsolationSegment, v7action.Warnings, error)
 	GetIsolationSegmentByName(isoSegmentName string) (resources.IsolationSegment, v7action.Warnings, error)
 	GetIsolationSegmentSummaries() ([]v7action.IsolationSegmentSummary, v7action.Warnings, error)
+	GetJob(jobGUID string) (v7action.Job, v7action.Warnings, error)
 	GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, v7action.Warnings, error)
+	GetLatestDeploymentForApp(appGUID string) (resources.Deployment, v7action.Warnings, error)
 	GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error)
 	GetNewestReadyPackageForApplication(app resources.Application) (resources.Package, v7action.Warnings, error)
 	GetOrgUsersByRoleType(orgGUID string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
+	GetOrganizationByGUID(orgGUID string) (resources.Organization, v7action.Warnings, error)
 	GetOrganizationByName(orgName string) (resources.Organization, v7action.Warnings, error)
 	GetOrganizationDomains(string, string) ([]resources.Domain, v7action.Warnings, error)
 	GetOrganizationLabels(orgName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetOrganizationQuotaByName(orgQuotaName string) (resources.OrganizationQuota, v7action.Warnings, error)
 	GetOrganizationQuotas() ([]resources.OrganizationQuota, v7action.Warnings, error)
 	GetOrganizationSpaces(orgGUID string) ([]resources.Space, v7action.Warnings, error)
+	GetOrganizationSpacesWithDetails(orgGUID string, labelSelector string) ([]v7action.SpaceDetails, v7action.Warnings, error)
 	GetOrganizationSpacesWithLabelSelector(orgGUID string, labelSelector string) ([]resources.Space, v7action.Warnings, error)
 	GetOrganizationSummaryByName(orgName string) (v7action.OrganizationSummary, v7action.Warnings, error)
+	GetOrganizationSummaryForOrg(org resources.Organization) (v7action.OrganizationSummary, v7action.Warnings, error)
+	GetOrganizationUsageSnapshot(orgGUID string, from time.Time, to time.Time) ([]v7action.SpaceUsage, v7action.Warnings, error)
 	GetOrganizations(labelSelector string) ([]resources.Organization, v7action.Warnings, error)
+	GetOrganizationsWithDetails(labelSelector string) ([]v7action.OrganizationDetails, v7action.Warnings, error)
+	GetOrphanedRoutes(routes []resources.Route, orphanedFor time.Duration) ([]resources.Route, v7action.Warnings, error)
 	GetProcessByTypeAndApplication(processType string, appGUID string) (resources.Process, v7action.Warnings, error)
 	GetRawApplicationManifestByNameAndSpace(appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
+	GetRawApplicationsManifestByNamesAndSpace(appNames []string, spaceGUID string) ([]byte, v7action.Warnings, error)
+	GetRawSpaceManifest(spaceGUID string, withRoutes bool, withServices bool) ([]byte, v7action.Warnings, error)
+	GetReadyPackageForApplication(app resources.Application, packageGUID string) (resources.Package, v7action.Warnings, error)
 	GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]v7action.Event, v7action.Warnings, error)
-	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetRecentEventsByOrganizationName(orgName string) ([]v7action.Event, v7action.Warnings, error)
+	GetRecentEventsBySpaceNameAndOrganization(spaceName string, orgGUID string) ([]v7action.Event, v7action.Warnings, error)
+	GetRolloutStatus(deployment resources.Deployment) (v7action.RolloutStatus, v7action.Warnings, error)
 	GetRootResponse() (v7action.Info, v7action.Warnings, error)
 	GetRevisionByApplicationAndVersion(appGUID string, revisionVersion int) (resources.Revision, v7action.Warnings, error)
 	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname string, path string, port int) (resources.Route, v7action.Warnings, error)
+	GetRouteCollision(domain resources.Domain, hostname string, path string, port int) (actionerror.RouteInDifferentSpaceError, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
+	GetRouteHistory(routeGUID string) ([]v7action.RouteHistoryEntry, v7action.Warnings, error)
 	GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetRouterGroups() ([]v7action.RouterGroup, error)
 	GetRouteSummaries([]resources.Route) ([]v7action.RouteSummary, v7action.Warnings, error)
+	GetRoutesByDomain(domainGUID string) ([]resources.Route, v7action.Warnings, error)
 	GetRoutesByOrg(orgGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
 	GetRoutesBySpace(spaceGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
 	GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHEnabledByAppName(appName string, spaceGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHPasscode() (string, error)
+	GetSSHStatusesForSpaces(spaces []resources.Space) ([]v7action.AppSSHStatus, v7action.Warnings, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	GetSecurityGroup(securityGroupName string) (resources.SecurityGroup, v7action.Warnings, error)
 	GetSecurityGroupSummary(securityGroupName string) (v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetSecurityGroups() ([]v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetServiceAccess(offeringName, brokerName, orgName string) ([]v7action.ServicePlanAccess, v7action.Warnings, error)
 	GetServiceBrokerByName(serviceBrokerName string) (resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceBrokerCatalog(serviceBrokerName string) (brokercatalog.Catalog, v7action.Warnings, error)
+	GetServiceBrokerCatalogDiff(serviceBrokerName string, catalog brokercatalog.Catalog) ([]v7action.ServicePlanChange, v7action.Warnings, error)
 	GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceBrokers() ([]resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceDrift(spaceGUID string, declared []v7action.DeclaredServiceInstance) (v7action.ServiceDrift, v7action.Warnings, error)
+	GetServiceInstanceUpgrade(serviceInstanceName string, spaceGUID string) (v7action.ServiceInstanceUpgrade, v7action.Warnings, error)
 	GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBindingDetails, v7action.Warnings, error)
+	GetServiceInstanceByGUID(serviceInstanceGUID string) (resources.ServiceInstance, v7action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID string) (resources.ServiceInstance, v7action.Warnings, error)
 	GetServiceInstanceDetails(serviceInstanceName, spaceGUID string, omitApps bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
+	GetServiceInstanceDetailsByGUID(serviceInstanceGUID, spaceGUID string, omitApps bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceName, spaceGUID string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
+	GetServiceInstanceParametersByGUID(serviceInstanceGUID string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
 	GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
-	GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
+	GetServiceInstancesForSpace(spaceGUID string, omitApps bool, labelSelector string) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName string) (resources.ServicePlan, v7action.Warnings, error)
+	GetSpaceByGUID(spaceGUID string) (resources.Space, v7action.Warnings, error)
 	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (resources.Space, v7action.Warnings, error)
 	GetSpaceFeature(spaceName string, orgGUID string, feature string) (bool, v7action.Warnings, error)
 	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetSpaceQuotaByName(spaceQuotaName string, orgGUID string) (resources.SpaceQuota, v7action.Warnings, error)
 	GetSpaceQuotasByOrgGUID(orgGUID string) ([]resources.SpaceQuota, v7action.Warnings, error)
 	GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUID string) (v7action.SpaceSummary, v7action.Warnings, error)
+	GetSpaceSummaryForSpace(space resources.Space, org resources.Organization) (v7action.SpaceSummary, v7action.Warnings, error)
 	GetSpaceUsersByRoleType(spaceGuid string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
 	GetStackByName(stackName string) (resources.Stack, v7action.Warnings, error)
 	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
@@ -180,18 +230,24 @@ type Actor interface {
 	GetUAAAPIVersion() (string, error)
 	GetUnstagedNewestPackageGUID(appGuid string) (string, v7action.Warnings, error)
 	GetUser(username, origin string) (resources.User, error)
-	MakeCurlRequest(httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, error)
+	LookupGUID(guid string) ([]v7action.GUIDLookupResult, v7action.Warnings, error)
+	MakeCurlRequest(target v7action.CurlTarget, httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, error)
+	MakeLogCacheCurlRequest(client v7action.CurlRequester, httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
+	MapRouteToAppPort(routeGUID string, appGUID string, destinationProtocol string, appPort int) (v7action.Warnings, error)
 	Marketplace(filter v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
+	MigrateRoute(route resources.Route, toDomain resources.Domain) (resources.Route, v7action.Warnings, error)
 	MoveRoute(routeGUID string, spaceGUID string) (v7action.Warnings, error)
 	ParseAccessToken(accessToken string) (jwt.JWT, error)
 	PollBuild(buildGUID string, appName string) (resources.Droplet, v7action.Warnings, error)
 	PollPackage(pkg resources.Package) (resources.Package, v7action.Warnings, error)
+	PollRollout(app resources.Application, deploymentGUID string, timeout time.Duration, noWait bool, handleStatus func(v7action.RolloutStatus)) (v7action.Warnings, error)
 	PollStart(app resources.Application, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	PollStartForRolling(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	PollTask(task resources.Task) (resources.Task, v7action.Warnings, error)
 	PollUploadBuildpackJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
 	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v7action.Downloader) (string, error)
+	PromoteServiceBroker(serviceBrokerName, username, password string) (v7action.ServiceBrokerPromotion, v7action.Warnings, error)
 	PurgeServiceInstance(serviceInstanceName, spaceGUID string) (v7action.Warnings, error)
 	PurgeServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName string) (v7action.Warnings, error)
 	RefreshAccessToken() (string, error)
@@ -199,11 +255,14 @@ type Actor interface {
 	RenameOrganization(oldOrgName, newOrgName string) (resources.Organization, v7action.Warnings, error)
 	RenameServiceInstance(currentServiceInstanceName, spaceGUID, newServiceInstanceName string) (v7action.Warnings, error)
 	RenameSpaceByNameAndOrganizationGUID(oldSpaceName, newSpaceName, orgGUID string) (resources.Space, v7action.Warnings, error)
+	RequestDeviceAuthorization() (v7action.DeviceAuthorization, error)
 	ResetOrganizationDefaultIsolationSegment(orgGUID string) (v7action.Warnings, error)
 	ResetSpaceIsolationSegment(orgGUID string, spaceGUID string) (string, v7action.Warnings, error)
 	ResourceMatch(resources []sharedaction.V3Resource) ([]sharedaction.V3Resource, v7action.Warnings, error)
 	RestartApplication(appGUID string, noWait bool) (v7action.Warnings, error)
+	RestartApplicationInstancesInBatches(app resources.Application, batchSize int, handleBatch func(processType string, indexes []int64)) (v7action.Warnings, error)
 	RevokeAccessAndRefreshTokens() error
+	RotateServiceBrokerCredentials(serviceBrokerName, username, password string) (v7action.Warnings, error)
 	RunTask(appGUID string, task resources.Task) (resources.Task, v7action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process resources.Process) (v7action.Warnings, error)
 	ScheduleTokenRefresh(func(time.Duration) <-chan time.Time, chan struct{}, chan struct{}) (<-chan error, error)
@@ -211,6 +270,7 @@ type Actor interface {
 	SetApplicationDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletGUID string) (v7action.Warnings, error)
 	SetApplicationManifest(appGUID string, rawManifest []byte) (v7action.Warnings, error)
 	SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType constant.HealthCheckType, httpEndpoint string, processType string, invocationTimeout int64) (resources.Application, v7action.Warnings, error)
+	SetApplicationProcessPortsByNameAndSpace(appName string, spaceGUID string, processType string, ports []int) (resources.Application, v7action.Warnings, error)
 	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v7action.EnvironmentVariablePair) (v7action.Warnings, error)
 	SetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envVars resources.EnvironmentVariables) (v7action.Warnings, error)
 	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v7action.Warnings, error)
@@ -222,6 +282,8 @@ type Actor interface {
 	StageApplicationPackage(pkgGUID string) (resources.Build, v7action.Warnings, error)
 	StagePackage(packageGUID, appName, spaceGUID string) (<-chan resources.Droplet, <-chan v7action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v7action.Warnings, error)
+	StartDeleteOrganization(name string) (string, v7action.Warnings, error)
+	StartDeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (string, v7action.Warnings, error)
 	StopApplication(appGUID string) (v7action.Warnings, error)
 	TerminateTask(taskGUID string) (resources.Task, v7action.Warnings, error)
 	UnbindSecurityGroup(securityGroupName string, orgGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
@@ -238,6 +300,7 @@ type Actor interface {
 	UpdateBuildpackLabelsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDestination(string, string, string) (v7action.Warnings, error)
 	UpdateDomainLabelsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateLabelsBySelector(resourceType string, scopeGUID string, labelSelector string, labels map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)
 	UpdateManagedServiceInstance(params v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	UpgradeManagedServiceInstance(serviceInstanceName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	UpdateOrganizationLabelsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
@@ -260,4 +323,7 @@ type Actor interface {
 	UploadBitsPackage(pkg resources.Package, matchedResources []sharedaction.V3Resource, newResources io.Reader, newResourcesLength int64) (resources.Package, v7action.Warnings, error)
 	UploadBuildpack(guid string, pathToBuildpackBits string, progressBar v7action.SimpleProgressBar) (ccv3.JobURL, v7action.Warnings, error)
 	UploadDroplet(dropletGUID string, dropletPath string, progressReader io.Reader, fileSize int64) (v7action.Warnings, error)
+	VerifyApplicationHealth(app resources.Application, criteria v7action.ApplicationHealthCriteria) (v7action.Warnings, error)
+	VerifyApplicationSLO(app resources.Application, slo v7action.ApplicationSLO, client v7action.HTTPClient) (v7action.ApplicationSLOReport, v7action.Warnings, error)
+	WaitForJob(jobGUID string) (v7action.Warnings, error)
 }
@@ -2,6 +2,7 @@ package v7
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 
 	"code.cloudfoundry.org/clock"
@@ -16,9 +17,12 @@ type APICommand struct {
 	BaseCommand
 
 	OptionalArgs      flag.APITarget `positional-args:"yes"`
+	BasePath          string         `long:"base-path" description:"Path on the API endpoint's host that API routes are served under, when it differs from the one advertised by the API endpoint"`
+	ClientCert        string         `long:"client-cert" description:"Path to the PEM certificate presented to API endpoints that require mutual TLS"`
+	ClientKey         string         `long:"client-key" description:"Path to the PEM private key of the certificate given with --client-cert"`
 	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
+	usage             interface{}    `usage:"CF_NAME api [URL] [--base-path PATH] [--client-cert CERT_FILE --client-key KEY_FILE]"`
 	relatedCommands   interface{}    `related_commands:"auth, login, target"`
 }
 
@@ -57,10 +61,19 @@ func (cmd *APICommand) setAPI() error {
 
 	apiURL := cmd.processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(v7action.TargetSettings{
+	err := shared.SaveClientCertificate(cmd.Config, cmd.ClientCert, cmd.ClientKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.SetTarget(v7action.TargetSettings{
 		URL:               apiURL,
+		BaseURL:           cmd.baseURL(apiURL),
 		SkipSSLValidation: cmd.SkipSSLValidation,
+		ClientCertificate: cmd.Config.ClientCertificate(),
 		DialTimeout:       cmd.Config.DialTimeout(),
+		IPFamily:          cmd.Config.IPFamily(),
+		ResolveOverrides:  cmd.Config.ResolveOverrides(),
 	})
 	if err != nil {
 		return err
@@ -81,6 +94,22 @@ func (cmd *APICommand) processURL(apiURL string) string {
 	return apiURL
 }
 
+// baseURL returns the URL on the API endpoint's host with the path given by
+// --base-path, or an empty string when it is not given.
+func (cmd *APICommand) baseURL(apiURL string) string {
+	if cmd.BasePath == "" {
+		return ""
+	}
+
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return ""
+	}
+	u.Path = "/" + strings.Trim(cmd.BasePath, "/")
+	u.RawQuery = ""
+	return u.String()
+}
+
 func (cmd *APICommand) viewTarget() error {
 	if cmd.Config.Target() == "" {
 		cmd.UI.DisplayText("No API endpoint set. Use '{{.Name}}' to set an endpoint", map[string]interface{}{
@@ -93,10 +122,14 @@ func (cmd *APICommand) viewTarget() error {
 }
 
 func (cmd *APICommand) displayTarget() error {
-	cmd.UI.DisplayKeyValueTable("", [][]string{
+	table := [][]string{
 		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
-		{cmd.UI.TranslateText("API version:"), cmd.Config.APIVersion()},
-	}, 3)
+	}
+	if baseURL := cmd.Config.APIBaseURL(); baseURL != "" && baseURL != cmd.Config.Target() {
+		table = append(table, []string{cmd.UI.TranslateText("API base URL:"), baseURL})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("API version:"), cmd.Config.APIVersion()})
+	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	user, err := cmd.Config.CurrentUser()
 	if user.Name == "" {
@@ -5,8 +5,10 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -112,6 +114,63 @@ API version:    100.200.300`,
 			})
 		})
 
+		When("--client-cert is passed without --client-key", func() {
+			BeforeEach(func() {
+				cmd.ClientCert = "client.crt"
+			})
+
+			It("requires both flags", func() {
+				Expect(err).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--client-cert", Arg2: "--client-key"}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a client certificate is configured", func() {
+			BeforeEach(func() {
+				fakeConfig.ClientCertificateReturns(util.ClientCertificate{CertFile: "/env/client.crt", KeyFile: "/env/client.key"})
+			})
+
+			It("presents it to the API endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetClientCertificateCallCount()).To(Equal(0))
+
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.ClientCertificate).To(Equal(util.ClientCertificate{CertFile: "/env/client.crt", KeyFile: "/env/client.key"}))
+			})
+		})
+
+		When("--base-path is passed", func() {
+			BeforeEach(func() {
+				cmd.BasePath = "cf/"
+				fakeConfig.APIBaseURLReturns("some-api-target/cf")
+			})
+
+			It("sets the base URL on the API endpoint's host", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.URL).To(Equal(CCAPI))
+				Expect(settings.BaseURL).To(Equal("https://api.foo.com/cf"))
+
+				Expect(testUI.Out).To(Say(`OK
+
+API endpoint:   some-api-target
+API base URL:   some-api-target/cf
+API version:    100.200.300`,
+				))
+			})
+		})
+
+		When("--base-path is not passed", func() {
+			It("does not set a base URL", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.BaseURL).To(BeEmpty())
+				Expect(testUI.Out).ToNot(Say("API base URL:"))
+			})
+		})
+
 		When("when the endpoint is TLS but the certificate is unverified", func() {
 			BeforeEach(func() {
 				fakeActor.SetTargetReturns(nil, ccerror.UnverifiedServerError{URL: CCAPI})
@@ -0,0 +1,53 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type APIResourcesCommand struct {
+	BaseCommand
+
+	usage           interface{} `usage:"CF_NAME api-resources\n\n   List the resources of the Cloud Controller V3 API of the targeted foundation, with the path to request each of them with 'CF_NAME curl', the query parameters that filter their lists and the commands that use them. Every endpoint that lists resources also accepts page, per_page and order_by."`
+	relatedCommands interface{} `related_commands:"api, curl"`
+}
+
+func (cmd APIResourcesCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting API resources of {{.API}}...", map[string]interface{}{
+		"API": cmd.Config.Target(),
+	})
+	cmd.UI.DisplayNewline()
+
+	apiResources, warnings, err := cmd.Actor.GetAPIResources()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(apiResources) == 0 {
+		cmd.UI.DisplayText("No API resources found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("query parameters"),
+			cmd.UI.TranslateText("commands"),
+		},
+	}
+	for _, apiResource := range apiResources {
+		table = append(table, []string{
+			apiResource.Name,
+			apiResource.Path,
+			strings.Join(apiResource.QueryParameters, ", "),
+			strings.Join(apiResource.Commands, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("api-resources Command", func() {
+	var (
+		cmd        APIResourcesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v7fakes.FakeActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = APIResourcesCommand{
+			BaseCommand: BaseCommand{
+				UI:     testUI,
+				Config: fakeConfig,
+				Actor:  fakeActor,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("getting the API resources succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIResourcesReturns([]v7action.APIResource{
+				{
+					Name:            "apps",
+					Path:            "/v3/apps",
+					QueryParameters: []string{"names", "guids"},
+					Commands:        []string{"apps", "app"},
+				},
+				{
+					Name: "new_things",
+					Path: "/v3/new_things",
+				},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the resources with their paths, query parameters and commands", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAPIResourcesCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say(`Getting API resources of https://api\.example\.com\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say(`resource\s+path\s+query parameters\s+commands`))
+			Expect(testUI.Out).To(Say(`apps\s+/v3/apps\s+names, guids\s+apps, app`))
+			Expect(testUI.Out).To(Say(`new_things\s+/v3/new_things`))
+		})
+	})
+
+	When("there are no API resources", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIResourcesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No API resources found."))
+		})
+	})
+
+	When("getting the API resources fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIResourcesReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
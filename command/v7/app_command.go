@@ -1,27 +1,86 @@
 package v7
 
 import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/browser"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BrowserOpener
+
+type BrowserOpener interface {
+	Open(url string) error
+}
+
 type AppCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid]"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	AppGUID         string               `long:"app-guid" description:"GUID of the app, instead of its name. The app must be in the targeted space"`
+	Arch            bool                 `long:"arch" description:"Retrieve and display the CPU architecture of the stack of the app, e.g. amd64 or arm64. All other health and status output for the app is suppressed."`
+	GUID            bool                 `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Open            bool                 `long:"open" description:"Open a route of the app in the default browser. When the app has several routes, you are asked which one to open."`
+	Route           string               `long:"route" description:"Route to open, for example myapp.example.com/path; used with --open"`
+	usage           interface{}          `usage:"CF_NAME app (APP_NAME | --app-guid APP_GUID) [--guid | --arch | --open [--route ROUTE]]"`
+	relatedCommands interface{}          `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+
+	BrowserOpener BrowserOpener
+}
+
+func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.BrowserOpener = browser.Opener{}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	switch {
+	case cmd.RequiredArgs.AppName != "" && cmd.AppGUID != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--app-guid"},
+		}
+	case cmd.RequiredArgs.AppName == "" && cmd.AppGUID == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.GUID && cmd.Open:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--guid", "--open"},
+		}
+	case cmd.Arch && (cmd.GUID || cmd.Open):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--arch", "--guid", "--open"},
+		}
+	case cmd.Route != "" && !cmd.Open:
+		return translatableerror.RequiredFlagsError{Arg1: "--route", Arg2: "--open"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
 
-	if cmd.GUID {
-		return cmd.displayAppGUID()
+	if cmd.GUID || cmd.Arch || cmd.Open {
+		app, err := cmd.getApp()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case cmd.GUID:
+			cmd.UI.DisplayText(app.GUID)
+			return nil
+		case cmd.Arch:
+			return cmd.displayAppArch(app)
+		default:
+			return cmd.openApp(app)
+		}
 	}
 
 	user, err := cmd.Actor.GetCurrentUser()
@@ -29,8 +88,18 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
+	appName := cmd.RequiredArgs.AppName
+	var app resources.Application
+	if cmd.AppGUID != "" {
+		app, err = cmd.getAppByGUID()
+		if err != nil {
+			return err
+		}
+		appName = app.Name
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
+		"AppName":   appName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
 		"Username":  user.Name,
@@ -38,7 +107,15 @@ func (cmd AppCommand) Execute(args []string) error {
 	cmd.UI.DisplayNewline()
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
-	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
+	var (
+		summary  v7action.DetailedApplicationSummary
+		warnings v7action.Warnings
+	)
+	if cmd.AppGUID != "" {
+		summary, warnings, err = cmd.Actor.GetDetailedAppSummaryForApp(app, false)
+	} else {
+		summary, warnings, err = cmd.Actor.GetDetailedAppSummary(appName, cmd.Config.TargetedSpace().GUID, false)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -48,13 +125,128 @@ func (cmd AppCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd AppCommand) displayAppGUID() error {
+// getApp returns the app given by name or, with --app-guid, by GUID.
+func (cmd AppCommand) getApp() (resources.Application, error) {
+	if cmd.AppGUID != "" {
+		return cmd.getAppByGUID()
+	}
+
 	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
+	return app, err
+}
+
+// getAppByGUID returns the app with the GUID, which must be in the targeted
+// space.
+func (cmd AppCommand) getAppByGUID() (resources.Application, error) {
+	app, warnings, err := cmd.Actor.GetApplicationByGUID(cmd.AppGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.Application{}, err
+	}
+
+	if app.SpaceGUID != cmd.Config.TargetedSpace().GUID {
+		return resources.Application{}, actionerror.ApplicationNotFoundError{GUID: cmd.AppGUID}
+	}
+
+	return app, nil
+}
+
+// displayAppArch displays the CPU architecture of the stack of the app, or
+// "unknown" with a warning explaining why when it cannot be told, so that the
+// output can always be used by scripts.
+func (cmd AppCommand) displayAppArch(app resources.Application) error {
+	if app.LifecycleType == constant.AppLifecycleTypeDocker {
+		cmd.UI.DisplayWarning("App {{.AppName}} runs a docker image, whose architecture is not known to the platform.", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		cmd.UI.DisplayText("unknown")
+		return nil
+	}
+
+	stack, warnings, err := cmd.Actor.GetStackByName(app.StackName)
+	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
-	cmd.UI.DisplayText(app.GUID)
+	arch := v7action.StackArchitecture(stack)
+	if arch == "" {
+		cmd.UI.DisplayWarning("The architecture of stack {{.StackName}} is not known. An admin can set it with: {{.Command}}", map[string]interface{}{
+			"StackName": stack.Name,
+			"Command":   fmt.Sprintf("%s set-label stack %s %s=arm64", cmd.Config.BinaryName(), stack.Name, v7action.StackArchitectureLabel),
+		})
+		arch = "unknown"
+	}
+
+	cmd.UI.DisplayText(arch)
 	return nil
 }
+
+// openApp opens the chosen HTTP route of the app in the browser. Without a
+// terminal only the URL is printed, so it can be used by scripts.
+func (cmd AppCommand) openApp(app resources.Application) error {
+	routes, warnings, err := cmd.Actor.GetApplicationRoutes(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	// routes are served over https unless the platform itself is reached
+	// over plain http, as on local development deployments
+	scheme := "https://"
+	if strings.HasPrefix(cmd.Config.Target(), "http://") {
+		scheme = "http://"
+	}
+
+	var urls []string
+	for _, route := range routes {
+		if route.Protocol == "tcp" {
+			continue
+		}
+		urls = append(urls, scheme+route.URL)
+	}
+	if len(urls) == 0 {
+		return translatableerror.AppHasNoHTTPRoutesError{AppName: app.Name}
+	}
+
+	url := urls[0]
+	switch {
+	case cmd.Route != "":
+		wanted := strings.TrimSuffix(cmd.Route, "/")
+		if i := strings.Index(wanted, "://"); i >= 0 {
+			wanted = wanted[i+len("://"):]
+		}
+		url = ""
+		for _, candidate := range urls {
+			if strings.TrimPrefix(candidate, scheme) == wanted {
+				url = candidate
+				break
+			}
+		}
+		if url == "" {
+			return translatableerror.RouteNotMappedToAppError{Route: cmd.Route, AppName: app.Name}
+		}
+	case len(urls) > 1 && cmd.Config.IsTTY():
+		cmd.UI.DisplayText("App {{.AppName}} has several routes.", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		choice, err := cmd.UI.DisplayTextMenu(urls, "Route to open")
+		if err != nil {
+			return err
+		}
+		if choice != "" {
+			url = choice
+		}
+	}
+
+	if !cmd.Config.IsTTY() {
+		cmd.UI.DisplayText(url)
+		return nil
+	}
+
+	cmd.UI.DisplayText("Opening {{.URL}}...", map[string]interface{}{
+		"URL": url,
+	})
+	return cmd.BrowserOpener.Open(url)
+}
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -26,29 +27,34 @@ var _ = Describe("app Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v7fakes.FakeActor
+		fakeBrowser     *v7fakes.FakeBrowserOpener
+		input           *Buffer
 		binaryName      string
 		executeErr      error
 		app             string
 	)
 
 	BeforeEach(func() {
-		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v7fakes.FakeActor)
+		fakeBrowser = new(v7fakes.FakeBrowserOpener)
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
 		app = "some-app"
 
 		cmd = v7.AppCommand{
-			RequiredArgs: flag.AppName{AppName: app},
+			RequiredArgs: flag.OptionalAppName{AppName: app},
 			BaseCommand: v7.BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
 				SharedActor: fakeSharedActor,
 				Actor:       fakeActor,
 			},
+			BrowserOpener: fakeBrowser,
 		}
 
 		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
@@ -160,6 +166,81 @@ var _ = Describe("app Command", func() {
 		})
 	})
 
+	When("the --arch flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Arch = true
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{Name: "some-app", StackName: "some-stack"},
+				v7action.Warnings{"get-app-warning"},
+				nil)
+			fakeActor.GetStackByNameReturns(
+				resources.Stack{Name: "some-stack", Description: "Ubuntu for aarch64"},
+				v7action.Warnings{"get-stack-warning"},
+				nil)
+		})
+
+		It("displays the architecture of the stack of the app and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("arm64"))
+			Expect(testUI.Out).NotTo(Say("Showing health and status"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-stack-warning"))
+
+			Expect(fakeActor.GetStackByNameArgsForCall(0)).To(Equal("some-stack"))
+			Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+		})
+
+		When("the architecture of the stack is not known", func() {
+			BeforeEach(func() {
+				fakeActor.GetStackByNameReturns(resources.Stack{Name: "some-stack"}, nil, nil)
+			})
+
+			It("displays unknown and how to label the stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("unknown"))
+				Expect(testUI.Err).To(Say("The architecture of stack some-stack is not known. An admin can set it with: faceman set-label stack some-stack arch=arm64"))
+			})
+		})
+
+		When("the app runs a docker image", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					resources.Application{Name: "some-app", LifecycleType: constant.AppLifecycleTypeDocker},
+					nil,
+					nil)
+			})
+
+			It("displays unknown without getting a stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("unknown"))
+				Expect(testUI.Err).To(Say("App some-app runs a docker image"))
+				Expect(fakeActor.GetStackByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the stack fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetStackByNameReturns(resources.Stack{}, v7action.Warnings{"get-stack-warning"}, actionerror.StackNotFoundError{Name: "some-stack"})
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "some-stack"}))
+				Expect(testUI.Err).To(Say("get-stack-warning"))
+			})
+		})
+
+		When("--guid is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--arch", "--guid", "--open"}}))
+			})
+		})
+	})
+
 	When("the --guid is not passed", func() {
 		When("getting the application summary returns an error", func() {
 			var expectedErr error
@@ -238,4 +319,241 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("the --open flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Open = true
+			fakeConfig.IsTTYReturns(true)
+			fakeConfig.TargetReturns("https://api.example.com")
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: app, GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+			fakeActor.GetApplicationRoutesReturns([]resources.Route{
+				{Protocol: "tcp", URL: "tcp.example.com:1024"},
+				{Protocol: "http", URL: "some-app.example.com"},
+				{Protocol: "http", URL: "some-app.example.com/api"},
+			}, v7action.Warnings{"routes-warning"}, nil)
+		})
+
+		When("the app has a single HTTP route", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationRoutesReturns([]resources.Route{
+					{Protocol: "http", URL: "some-app.example.com"},
+				}, v7action.Warnings{"routes-warning"}, nil)
+			})
+
+			It("opens the route over https", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeBrowser.OpenCallCount()).To(Equal(1))
+				Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://some-app.example.com"))
+				Expect(testUI.Out).To(Say(`Opening https://some-app.example.com\.\.\.`))
+				Expect(testUI.Err).To(Say("app-warning"))
+				Expect(testUI.Err).To(Say("routes-warning"))
+			})
+
+			When("the API is reached over plain http", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetReturns("http://api.bosh-lite.com")
+				})
+
+				It("opens the route over http", func() {
+					Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("http://some-app.example.com"))
+				})
+			})
+		})
+
+		When("the app has several HTTP routes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("2\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("asks which route to open", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("App some-app has several routes."))
+				Expect(testUI.Out).To(Say(`1\. https://some-app.example.com`))
+				Expect(testUI.Out).To(Say(`2\. https://some-app.example.com/api`))
+				Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://some-app.example.com/api"))
+			})
+		})
+
+		When("a route is given", func() {
+			BeforeEach(func() {
+				cmd.Route = "https://some-app.example.com/api/"
+			})
+
+			It("opens that route without asking", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("several routes"))
+				Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://some-app.example.com/api"))
+			})
+
+			When("the route is not mapped to the app", func() {
+				BeforeEach(func() {
+					cmd.Route = "other.example.com"
+				})
+
+				It("returns a RouteNotMappedToAppError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RouteNotMappedToAppError{Route: "other.example.com", AppName: "some-app"}))
+					Expect(fakeBrowser.OpenCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		When("the output is not a terminal", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(false)
+			})
+
+			It("prints the URL of the first route without opening it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`^https://some-app.example.com\n`))
+				Expect(fakeBrowser.OpenCallCount()).To(BeZero())
+			})
+		})
+
+		When("the app has no HTTP routes", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationRoutesReturns([]resources.Route{{Protocol: "tcp", URL: "tcp.example.com:1024"}}, nil, nil)
+			})
+
+			It("returns an AppHasNoHTTPRoutesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.AppHasNoHTTPRoutesError{AppName: "some-app"}))
+			})
+		})
+
+		When("opening the browser fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationRoutesReturns([]resources.Route{{Protocol: "http", URL: "some-app.example.com"}}, nil, nil)
+				fakeBrowser.OpenReturns(errors.New("no browser"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("no browser"))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationRoutesReturns(nil, v7action.Warnings{"routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(testUI.Err).To(Say("routes-warning"))
+			})
+		})
+
+		When("--guid is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--guid", "--open"}}))
+			})
+		})
+	})
+
+	When("--route is provided without --open", func() {
+		BeforeEach(func() {
+			cmd.Route = "some-app.example.com"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--route", Arg2: "--open"}))
+		})
+	})
+
+	When("the --app-guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.AppGUID = "some-app-guid"
+			fakeActor.GetApplicationByGUIDReturns(
+				resources.Application{Name: "some-app", GUID: "some-app-guid", SpaceGUID: "some-space-guid"},
+				v7action.Warnings{"app-guid-warning"},
+				nil,
+			)
+		})
+
+		It("shows the app with that GUID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("app-guid-warning"))
+			Expect(testUI.Out).To(Say(`Showing health and status for app some-app in org some-org / space some-space as steve\.\.\.`))
+
+			Expect(fakeActor.GetApplicationByGUIDCallCount()).To(Equal(1))
+			Expect(fakeActor.GetApplicationByGUIDArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+			Expect(fakeActor.GetDetailedAppSummaryForAppCallCount()).To(Equal(1))
+			summarizedApp, _ := fakeActor.GetDetailedAppSummaryForAppArgsForCall(0)
+			Expect(summarizedApp).To(Equal(resources.Application{Name: "some-app", GUID: "some-app-guid", SpaceGUID: "some-space-guid"}))
+		})
+
+		When("--guid is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("displays the guid of the app without looking it up by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("some-app-guid"))
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app is not in the targeted space", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByGUIDReturns(
+					resources.Application{Name: "some-app", GUID: "some-app-guid", SpaceGUID: "other-space-guid"},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(fakeActor.GetDetailedAppSummaryForAppCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByGUIDReturns(resources.Application{}, v7action.Warnings{"app-guid-warning"}, actionerror.ApplicationNotFoundError{GUID: "some-app-guid"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(testUI.Err).To(Say("app-guid-warning"))
+			})
+		})
+
+		When("the app name is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = "some-app"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"APP_NAME", "--app-guid"},
+				}))
+				Expect(fakeActor.GetApplicationByGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("neither the app name nor --app-guid is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
 })
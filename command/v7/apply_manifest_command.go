@@ -2,6 +2,7 @@ package v7
 
 import (
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/cf/errors"
@@ -10,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/projectconfig"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"gopkg.in/yaml.v2"
 )
@@ -18,10 +20,12 @@ type ApplyManifestCommand struct {
 	BaseCommand
 
 	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	PathsToOverlays  []flag.PathWithExistenceCheck       `long:"overlay" description:"Path to a manifest merged over the manifest before variable substitution, like with push; can specify multiple times"`
 	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	RedactEnv        bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
-	usage            interface{}                         `usage:"CF_NAME apply-manifest -f APP_MANIFEST_PATH"`
+	Rollback         bool                                `long:"rollback" description:"Apply the manifest the apps of the space had before apply-manifest was last run"`
+	usage            interface{}                         `usage:"CF_NAME apply-manifest -f APP_MANIFEST_PATH [--overlay OVERLAY_PATH]... [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n   CF_NAME apply-manifest --rollback\n\n   Without -f or --vars-file, the manifest and vars files of the push defaults in the .cf/config.yml of the project are used, like with push. Each time a manifest is applied, the manifest the apps in it had before is saved so that the change can be reverted with --rollback."`
 	relatedCommands  interface{}                         `related_commands:"create-app, create-app-manifest, push"`
 
 	ManifestLocator ManifestLocator
@@ -54,11 +58,93 @@ func (cmd ApplyManifestCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Rollback && (cmd.PathToManifest != "" || len(cmd.PathsToOverlays) > 0 || len(cmd.Vars) > 0 || len(cmd.PathsToVarsFiles) > 0) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--rollback", "-f", "--overlay", "--var", "--vars-file"},
+		}
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
 	}
 
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	snapshotPath := cmd.Config.ManifestSnapshotPath(spaceGUID)
+
+	var (
+		manifest      manifestparser.Manifest
+		manifestBytes []byte
+	)
+	if cmd.Rollback {
+		manifest, manifestBytes, err = cmd.readSnapshot(snapshotPath)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Rolling back to manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ManifestPath": snapshotPath,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+	} else {
+		err = cmd.applyProjectDefaults()
+		if err != nil {
+			return err
+		}
+
+		manifest, manifestBytes, err = cmd.readManifest(user.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	diff, warnings, err := cmd.Actor.DiffSpaceManifest(spaceGUID, manifestBytes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); isUnexpectedError {
+			cmd.UI.DisplayWarning("Unable to generate diff. Continuing to apply manifest...")
+		} else {
+			return err
+		}
+	} else {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Updating with these attributes...")
+
+		err = cmd.DiffDisplayer.DisplayDiff(manifestBytes, diff)
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshotSaved, err := cmd.saveSnapshot(manifest, spaceGUID, snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.SetSpaceManifest(spaceGUID, manifestBytes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	if snapshotSaved {
+		cmd.UI.DisplayText("The previous manifest was saved to {{.SnapshotPath}}. To revert this change, run '{{.BinaryName}} apply-manifest --rollback'.", map[string]interface{}{
+			"SnapshotPath": snapshotPath,
+			"BinaryName":   cmd.Config.BinaryName(),
+		})
+	}
+
+	return nil
+}
+
+// readManifest locates, interpolates and parses the manifest given with -f or
+// in the current directory.
+func (cmd ApplyManifestCommand) readManifest(username string) (manifestparser.Manifest, []byte, error) {
 	readPath := cmd.CWD
 	if cmd.PathToManifest != "" {
 		readPath = string(cmd.PathToManifest)
@@ -66,71 +152,99 @@ func (cmd ApplyManifestCommand) Execute(args []string) error {
 
 	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
 	if err != nil {
-		return err
+		return manifestparser.Manifest{}, nil, err
 	}
 
 	if !exists {
-		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+		return manifestparser.Manifest{}, nil, translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
 	}
 
 	cmd.UI.DisplayTextWithFlavor("Applying manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"ManifestPath": pathToManifest,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"SpaceName":    cmd.Config.TargetedSpace().Name,
-		"Username":     user.Name,
+		"Username":     username,
 	})
 
-	spaceGUID := cmd.Config.TargetedSpace().GUID
+	interpolatedManifestBytes, err := interpolateManifest(cmd.ManifestParser, pathToManifest, cmd.PathsToOverlays, cmd.PathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return manifestparser.Manifest{}, nil, err
+	}
 
-	var pathsToVarsFiles []string
-	for _, varFilePath := range cmd.PathsToVarsFiles {
-		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	return cmd.parseManifest(pathToManifest, interpolatedManifestBytes)
+}
+
+// applyProjectDefaults uses the manifest and vars files of the push defaults
+// of the project the current directory belongs to when they are not given.
+func (cmd *ApplyManifestCommand) applyProjectDefaults() error {
+	config, found, err := projectconfig.Load(cmd.CWD)
+	if err != nil || !found {
+		return err
 	}
 
-	interpolatedManifestBytes, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	applied, err := applyProjectManifestDefaults(config, &cmd.PathToManifest, &cmd.PathsToVarsFiles)
 	if err != nil {
 		return err
 	}
 
-	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, interpolatedManifestBytes)
+	if applied {
+		cmd.UI.DisplayText("Using push defaults from {{.Path}}", map[string]interface{}{
+			"Path": config.Path,
+		})
+	}
+	return nil
+}
+
+// readSnapshot reads the manifest saved the last time a manifest was applied
+// to the space.
+func (cmd ApplyManifestCommand) readSnapshot(snapshotPath string) (manifestparser.Manifest, []byte, error) {
+	snapshotBytes, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return manifestparser.Manifest{}, nil, translatableerror.ManifestSnapshotNotFoundError{SpaceName: cmd.Config.TargetedSpace().Name}
+		}
+		return manifestparser.Manifest{}, nil, err
+	}
+
+	return cmd.parseManifest(snapshotPath, snapshotBytes)
+}
+
+func (cmd ApplyManifestCommand) parseManifest(pathToManifest string, rawManifest []byte) (manifestparser.Manifest, []byte, error) {
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
 	if err != nil {
 		if _, ok := err.(*yaml.TypeError); ok {
-			return errors.New("Unable to apply manifest because its format is invalid.")
+			return manifestparser.Manifest{}, nil, errors.New("Unable to apply manifest because its format is invalid.")
 		}
-		return err
+		return manifestparser.Manifest{}, nil, err
 	}
 
 	manifestBytes, err := cmd.ManifestParser.MarshalManifest(manifest)
 	if err != nil {
-		return err
+		return manifestparser.Manifest{}, nil, err
 	}
 
-	diff, warnings, err := cmd.Actor.DiffSpaceManifest(spaceGUID, manifestBytes)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); isUnexpectedError {
-			cmd.UI.DisplayWarning("Unable to generate diff. Continuing to apply manifest...")
-		} else {
-			return err
-		}
-	} else {
-		cmd.UI.DisplayNewline()
-		cmd.UI.DisplayText("Updating with these attributes...")
+	return manifest, manifestBytes, nil
+}
 
-		err = cmd.DiffDisplayer.DisplayDiff(manifestBytes, diff)
-		if err != nil {
-			return err
-		}
+// saveSnapshot saves the current manifest of the apps in the manifest about
+// to be applied, and returns whether there was one. Apps that do not exist
+// yet are left out, as rolling back does not delete apps.
+func (cmd ApplyManifestCommand) saveSnapshot(manifest manifestparser.Manifest, spaceGUID string, snapshotPath string) (bool, error) {
+	appNames := make([]string, 0, len(manifest.Applications))
+	for _, app := range manifest.Applications {
+		appNames = append(appNames, app.Name)
 	}
 
-	warnings, err = cmd.Actor.SetSpaceManifest(spaceGUID, manifestBytes)
+	snapshotBytes, warnings, err := cmd.Actor.GetRawApplicationsManifestByNamesAndSpace(appNames, spaceGUID)
 	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
+	if err != nil || snapshotBytes == nil {
+		return false, err
 	}
 
-	cmd.UI.DisplayNewline()
-	cmd.UI.DisplayOK()
+	err = os.MkdirAll(filepath.Dir(snapshotPath), 0700)
+	if err != nil {
+		return false, err
+	}
 
-	return nil
+	return true, os.WriteFile(snapshotPath, snapshotBytes, 0600)
 }
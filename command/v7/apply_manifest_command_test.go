@@ -2,6 +2,8 @@ package v7_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"regexp"
 
 	"gopkg.in/yaml.v2"
@@ -112,6 +114,84 @@ var _ = Describe("apply-manifest Command", func() {
 			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
 		})
 
+		When("--rollback is given", func() {
+			var (
+				snapshotDir  string
+				snapshotPath string
+			)
+
+			BeforeEach(func() {
+				cmd.Rollback = true
+
+				var err error
+				snapshotDir, err = os.MkdirTemp("", "manifest-snapshots")
+				Expect(err).ToNot(HaveOccurred())
+				snapshotPath = filepath.Join(snapshotDir, "some-space-guid.yml")
+				fakeConfig.ManifestSnapshotPathReturns(snapshotPath)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(snapshotDir)).To(Succeed())
+			})
+
+			When("a snapshot was saved", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(snapshotPath, []byte("applications:\n- name: app-1\n"), 0600)).To(Succeed())
+					fakeParser.ParseManifestReturns(manifestparser.Manifest{
+						Applications: []manifestparser.Application{{Name: "app-1"}},
+					}, nil)
+					fakeParser.MarshalManifestReturns([]byte("snapshot"), nil)
+				})
+
+				It("applies the snapshot without looking for a manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Rolling back to manifest %s in org some-org / space some-space as steve...", regexp.QuoteMeta(snapshotPath)))
+
+					Expect(fakeLocator.PathCallCount()).To(Equal(0))
+					Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(0))
+					path, rawManifest := fakeParser.ParseManifestArgsForCall(0)
+					Expect(path).To(Equal(snapshotPath))
+					Expect(rawManifest).To(Equal([]byte("applications:\n- name: app-1\n")))
+
+					Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+					_, manifestBytes := fakeActor.SetSpaceManifestArgsForCall(0)
+					Expect(manifestBytes).To(Equal([]byte("snapshot")))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			When("no snapshot was saved", func() {
+				It("returns a ManifestSnapshotNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ManifestSnapshotNotFoundError{SpaceName: "some-space"}))
+					Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a manifest is given as well", func() {
+				BeforeEach(func() {
+					cmd.PathToManifest = "some-manifest-path"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--rollback", "-f", "--overlay", "--var", "--vars-file"},
+					}))
+				})
+			})
+
+			When("an overlay is given as well", func() {
+				BeforeEach(func() {
+					cmd.PathsToOverlays = []flag.PathWithExistenceCheck{"some-overlay-path"}
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--rollback", "-f", "--overlay", "--var", "--vars-file"},
+					}))
+				})
+			})
+		})
+
 		When("the manifest location is specified with `-f`", func() {
 			BeforeEach(func() {
 				providedPath = "some-manifest-path"
@@ -124,6 +204,94 @@ var _ = Describe("apply-manifest Command", func() {
 			})
 		})
 
+		When("overlays are given", func() {
+			BeforeEach(func() {
+				cmd.PathsToOverlays = []flag.PathWithExistenceCheck{"overlay-1.yml", "overlay-2.yml"}
+				cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+				cmd.Vars = []template.VarKV{{Name: "o", Value: "nice"}}
+				fakeLocator.PathReturns("/fake/manifest.yml", true, nil)
+				fakeParser.InterpolateManifestWithOverlaysReturns([]byte("interpolated!"), nil)
+			})
+
+			It("merges them over the manifest before interpolating it, like push", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(0))
+				Expect(fakeParser.InterpolateManifestWithOverlaysCallCount()).To(Equal(1))
+				path, overlays, varsFiles, vars := fakeParser.InterpolateManifestWithOverlaysArgsForCall(0)
+				Expect(path).To(Equal("/fake/manifest.yml"))
+				Expect(overlays).To(Equal([]string{"overlay-1.yml", "overlay-2.yml"}))
+				Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+				Expect(vars).To(Equal([]template.VarKV{{Name: "o", Value: "nice"}}))
+
+				_, rawManifest := fakeParser.ParseManifestArgsForCall(0)
+				Expect(rawManifest).To(Equal([]byte("interpolated!")))
+			})
+		})
+
+		When("the current directory belongs to a project with a .cf/config.yml", func() {
+			var projectDir string
+
+			BeforeEach(func() {
+				var err error
+				projectDir, err = os.MkdirTemp("", "apply-manifest-project-config")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.MkdirAll(filepath.Join(projectDir, ".cf"), 0755)).To(Succeed())
+				Expect(os.MkdirAll(filepath.Join(projectDir, "deploy"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(projectDir, "deploy", "manifest.yml"), nil, 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(projectDir, "deploy", "vars.yml"), nil, 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(projectDir, ".cf", "config.yml"), []byte(`push:
+  manifest: deploy/manifest.yml
+  vars_files: [deploy/vars.yml]
+`), 0600)).To(Succeed())
+
+				cmd.CWD = projectDir
+				fakeLocator.PathReturns("/fake/manifest.yml", true, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(projectDir)).To(Succeed())
+			})
+
+			It("uses the manifest and vars files of the push defaults", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Using push defaults from %s", regexp.QuoteMeta(filepath.Join(projectDir, ".cf", "config.yml"))))
+
+				Expect(fakeLocator.PathArgsForCall(0)).To(Equal(filepath.Join(projectDir, "deploy", "manifest.yml")))
+				_, varsFiles, _ := fakeParser.InterpolateManifestArgsForCall(0)
+				Expect(varsFiles).To(Equal([]string{filepath.Join(projectDir, "deploy", "vars.yml")}))
+			})
+
+			When("the manifest and vars files are given", func() {
+				BeforeEach(func() {
+					cmd.PathToManifest = "/other/manifest.yml"
+					cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/other/vars.yml"}
+				})
+
+				It("keeps them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Using push defaults"))
+
+					Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/other/manifest.yml"))
+					_, varsFiles, _ := fakeParser.InterpolateManifestArgsForCall(0)
+					Expect(varsFiles).To(Equal([]string{"/other/vars.yml"}))
+				})
+			})
+
+			When("a file of the push defaults does not exist", func() {
+				BeforeEach(func() {
+					Expect(os.Remove(filepath.Join(projectDir, "deploy", "vars.yml"))).To(Succeed())
+				})
+
+				It("returns a FileNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{
+						Path: filepath.Join(projectDir, "deploy", "vars.yml"),
+					}))
+					Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		When("the manifest location is not specified with `-f`", func() {
 			When("looking for the manifest file errors", func() {
 				BeforeEach(func() {
@@ -211,6 +379,54 @@ var _ = Describe("apply-manifest Command", func() {
 					})
 				})
 
+				When("some of the apps in the manifest exist", func() {
+					var (
+						snapshotDir  string
+						snapshotPath string
+					)
+
+					BeforeEach(func() {
+						var err error
+						snapshotDir, err = os.MkdirTemp("", "config")
+						Expect(err).ToNot(HaveOccurred())
+						snapshotPath = filepath.Join(snapshotDir, "manifest-snapshots", "some-space-guid.yml")
+						fakeConfig.ManifestSnapshotPathReturns(snapshotPath)
+						fakeParser.ParseManifestReturns(manifestparser.Manifest{
+							Applications: []manifestparser.Application{{Name: "app-1"}, {Name: "app-2"}},
+						}, nil)
+						fakeActor.GetRawApplicationsManifestByNamesAndSpaceReturns([]byte("applications:\n- name: app-1\n"), v7action.Warnings{"snapshot-warning"}, nil)
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(snapshotDir)).To(Succeed())
+					})
+
+					It("saves their current manifest before applying the new one", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeConfig.ManifestSnapshotPathArgsForCall(0)).To(Equal("some-space-guid"))
+						appNames, spaceGUID := fakeActor.GetRawApplicationsManifestByNamesAndSpaceArgsForCall(0)
+						Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(testUI.Err).To(Say("snapshot-warning"))
+
+						Expect(os.ReadFile(snapshotPath)).To(Equal([]byte("applications:\n- name: app-1\n")))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("The previous manifest was saved to %s. To revert this change, run 'faceman apply-manifest --rollback'.", regexp.QuoteMeta(snapshotPath)))
+					})
+
+					When("getting their manifest fails", func() {
+						BeforeEach(func() {
+							fakeActor.GetRawApplicationsManifestByNamesAndSpaceReturns(nil, nil, errors.New("snapshot-error"))
+						})
+
+						It("does not apply the manifest", func() {
+							Expect(executeErr).To(MatchError("snapshot-error"))
+							Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				When("the manifest is unparseable", func() {
 					BeforeEach(func() {
 						fakeParser.ParseManifestReturns(manifestparser.Manifest{}, &yaml.TypeError{
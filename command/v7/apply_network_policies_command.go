@@ -0,0 +1,114 @@
+package v7
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ApplyNetworkPoliciesCommand struct {
+	BaseCommand
+
+	PathToPolicies  flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a YAML policy bundle, as written by export-network-policies"`
+	Prune           bool                        `long:"prune" description:"Remove the policies of apps in the targeted space that the bundle does not have"`
+	usage           interface{}                 `usage:"CF_NAME apply-network-policies -f POLICIES_PATH [--prune]\n\n   Creates the policies of a YAML policy bundle for the apps in the targeted space. A policy without a destination space\n   or org connects to an app in the targeted space, and one without a protocol and ports allows TCP traffic to port 8080.\n\n   policies:\n   - source: frontend\n     destination: backend\n     protocol: tcp\n     ports: 8080-8090\n   - source: frontend\n     destination: billing\n     destination_space: payments\n     destination_org: finance\n     protocol: udp\n     ports: \"9000\"\n\nEXAMPLES:\n   CF_NAME apply-network-policies -f policies.yml\n   CF_NAME apply-network-policies -f policies.yml --prune"`
+	relatedCommands interface{}                 `related_commands:"add-network-policy, export-network-policies, network-policies, remove-network-policy"`
+
+	NetworkingActor NetworkPolicyBundleActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rawPolicies, err := ioutil.ReadFile(string(cmd.PathToPolicies))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} to apps in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Path":  cmd.PathToPolicies,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	changes, warnings, err := cmd.NetworkingActor.ApplyRawNetworkPolicies(cmd.Config.TargetedSpace().GUID, rawPolicies, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(changes.Added) == 0 && len(changes.Removed) == 0 {
+		cmd.UI.DisplayText("The network policies are up to date.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
+		},
+	}
+	for _, change := range []struct {
+		sign     string
+		policies []cfnetworkingaction.Policy
+	}{{"+", changes.Added}, {"-", changes.Removed}} {
+		for _, policy := range change.policies {
+			table = append(table, []string{
+				change.sign,
+				policy.SourceName,
+				policy.DestinationName,
+				policy.Protocol,
+				portsEntry(policy.StartPort, policy.EndPort),
+				policy.DestinationSpaceName,
+				policy.DestinationOrgName,
+			})
+		}
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Added}} network policies added, {{.Removed}} removed.", map[string]interface{}{
+		"Added":   len(changes.Added),
+		"Removed": len(changes.Removed),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	const rawPolicies = "policies:\n- source: frontend\n  destination: backend\n"
+
+	var (
+		cmd                 ApplyNetworkPoliciesCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkPolicyBundleActor
+		policiesPath        string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkPolicyBundleActor)
+
+		policiesFile, err := ioutil.TempFile("", "policies-*.yml")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = policiesFile.WriteString(rawPolicies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policiesFile.Close()).To(Succeed())
+		policiesPath = policiesFile.Name()
+
+		cmd = ApplyNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+			PathToPolicies:  flag.PathWithExistenceCheck(policiesPath),
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeNetworkingActor.ApplyRawNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{
+			Added: []cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+			},
+			Removed: []cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "billing", Protocol: "udp", StartPort: 9000, EndPort: 9010, DestinationSpaceName: "payments", DestinationOrgName: "finance"},
+			},
+		}, cfnetworkingaction.Warnings{"apply-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(policiesPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("applies the policies to the targeted space and displays the changes", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		spaceGUID, givenPolicies, prune := fakeNetworkingActor.ApplyRawNetworkPoliciesArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(string(givenPolicies)).To(Equal(rawPolicies))
+		Expect(prune).To(BeFalse())
+
+		Expect(testUI.Out).To(Say(`Applying network policies from .*policies-.*\.yml to apps in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`\s+source\s+destination\s+protocol\s+ports\s+destination space\s+destination org`))
+		Expect(testUI.Out).To(Say(`\+\s+frontend\s+backend\s+tcp\s+8080\s+some-space\s+some-org`))
+		Expect(testUI.Out).To(Say(`-\s+frontend\s+billing\s+udp\s+9000-9010\s+payments\s+finance`))
+		Expect(testUI.Out).To(Say(`1 network policies added, 1 removed\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("apply-warning"))
+	})
+
+	When("--prune is given", func() {
+		BeforeEach(func() {
+			cmd.Prune = true
+		})
+
+		It("prunes the policies", func() {
+			_, _, prune := fakeNetworkingActor.ApplyRawNetworkPoliciesArgsForCall(0)
+			Expect(prune).To(BeTrue())
+		})
+	})
+
+	When("nothing changes", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.ApplyRawNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{}, nil, nil)
+		})
+
+		It("says that the policies are up to date", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("The network policies are up to date."))
+			Expect(testUI.Out).NotTo(Say("source"))
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeNetworkingActor.ApplyRawNetworkPoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.ApplyRawNetworkPoliciesReturns(cfnetworkingaction.PolicyChanges{}, cfnetworkingaction.Warnings{"apply-warning"}, errors.New("apply failed"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("apply failed"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+	})
+})
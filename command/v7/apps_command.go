@@ -1,8 +1,12 @@
 package v7
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -10,15 +14,32 @@ import (
 type AppsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR] [--no-stats] [--columns COLUMNS | -o wide | -o json]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME apps --columns name,state,memory\n   CF_NAME apps -o wide\n\nCOLUMNS:\n   name, state, processes, routes, and with -o wide also guid, lifecycle, stack, memory, disk, instances, last-uploaded"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
 
-	Labels    string `long:"labels" description:"Selector to filter apps by labels"`
-	OmitStats bool   `long:"no-stats" description:"Do not retrieve process stats"`
+	Labels    string            `long:"labels" description:"Selector to filter apps by labels"`
+	OmitStats bool              `long:"no-stats" description:"Do not retrieve process stats"`
+	Columns   flag.Columns      `long:"columns" description:"Comma-separated list of the columns to display, in order"`
+	Output    flag.OutputFormat `short:"o" long:"output" choice:"text" choice:"wide" choice:"json" default:"text" description:"Output format; wide displays additional columns and json prints all columns as JSON"`
+}
+
+func (AppsCommand) PagedOutput() bool {
+	return true
 }
 
 func (cmd AppsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	columns := cmd.tableColumns()
+	selected, err := ui.SelectTableColumns(columns, cmd.Columns, cmd.Output == flag.OutputFormatWide || cmd.Output == flag.OutputFormatJSON)
+	if err != nil {
+		return err
+	}
+
+	// The own --output flag of the command replaces the global one.
+	if cmd.Output == flag.OutputFormatJSON {
+		cmd.UI.StartStructuredOutput()
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -35,7 +56,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats)
+	withLastUploaded := false
+	for _, column := range selected {
+		if columns[column].Name == "last-uploaded" {
+			withLastUploaded = true
+		}
+	}
+
+	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats, withLastUploaded)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -46,36 +74,101 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return nil
 	}
 
-	fields := []string{
-		cmd.UI.TranslateText("name"),
-		cmd.UI.TranslateText("requested state"),
-	}
-
-	if !cmd.OmitStats {
-		fields = append(fields, cmd.UI.TranslateText("processes"))
-	}
-
-	fields = append(fields, cmd.UI.TranslateText("routes"))
-
-	table := [][]string{fields}
-
+	var rows [][]string
 	for _, summary := range summaries {
-		tableRow := []string{
+		row := []string{
 			summary.Name,
 			cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
 		}
 		if !cmd.OmitStats {
-			tableRow = append(tableRow, summary.ProcessSummaries.String())
+			row = append(row, summary.ProcessSummaries.String())
+		}
+		row = append(row,
+			getURLs(summary.Routes),
+			summary.GUID,
+			string(summary.LifecycleType),
+			summary.StackName,
+		)
+		if !cmd.OmitStats {
+			row = append(row,
+				processMemory(summary.ProcessSummaries),
+				processDisk(summary.ProcessSummaries),
+				processInstances(summary.ProcessSummaries),
+			)
 		}
-		tableRow = append(tableRow, getURLs(summary.Routes))
-		table = append(table, tableRow)
+		row = append(row, cmd.lastUploaded(summary.LastUploaded))
+		rows = append(rows, row)
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayTableWithColumns("", columns, rows, selected, ui.DefaultTableSpacePadding)
 
 	return nil
 }
 
+// tableColumns returns the columns that apps can display. The process columns
+// are only available when process stats are retrieved.
+func (cmd AppsCommand) tableColumns() []ui.TableColumn {
+	columns := []ui.TableColumn{
+		{Name: "name", Header: "name"},
+		{Name: "state", Header: "requested state"},
+	}
+	if !cmd.OmitStats {
+		columns = append(columns, ui.TableColumn{Name: "processes", Header: "processes"})
+	}
+	columns = append(columns,
+		ui.TableColumn{Name: "routes", Header: "routes"},
+		ui.TableColumn{Name: "guid", Header: "guid", Wide: true},
+		ui.TableColumn{Name: "lifecycle", Header: "lifecycle", Wide: true},
+		ui.TableColumn{Name: "stack", Header: "stack", Wide: true},
+	)
+	if !cmd.OmitStats {
+		columns = append(columns,
+			ui.TableColumn{Name: "memory", Header: "memory", Wide: true},
+			ui.TableColumn{Name: "disk", Header: "disk", Wide: true},
+			ui.TableColumn{Name: "instances", Header: "instances", Wide: true},
+		)
+	}
+	columns = append(columns, ui.TableColumn{Name: "last-uploaded", Header: "last uploaded", Wide: true})
+	return columns
+}
+
+// lastUploaded returns the date of the last upload of an app in the user's
+// time zone, or nothing when it is unknown.
+func (cmd AppsCommand) lastUploaded(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return ""
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
+
+func processMemory(processSummaries v7action.ProcessSummaries) string {
+	var memory []string
+	for _, processSummary := range processSummaries {
+		memory = append(memory, fmt.Sprintf("%s:%dM", processSummary.Type, processSummary.MemoryInMB.Value))
+	}
+	return strings.Join(memory, ", ")
+}
+
+func processDisk(processSummaries v7action.ProcessSummaries) string {
+	var disk []string
+	for _, processSummary := range processSummaries {
+		disk = append(disk, fmt.Sprintf("%s:%dM", processSummary.Type, processSummary.DiskInMB.Value))
+	}
+	return strings.Join(disk, ", ")
+}
+
+// processInstances returns the number of running instances out of all
+// instances of the processes of an app.
+func processInstances(processSummaries v7action.ProcessSummaries) string {
+	var healthy, total int
+	for _, processSummary := range processSummaries {
+		healthy += processSummary.HealthyInstanceCount()
+		total += processSummary.TotalInstanceCount()
+	}
+	return fmt.Sprintf("%d/%d", healthy, total)
+}
+
 func getURLs(routes []resources.Route) string {
 	var routeURLs []string
 	for _, route := range routes {
@@ -1,16 +1,20 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -143,7 +147,13 @@ var _ = Describe("apps Command", func() {
 
 	When("the route actor does not return any errors", func() {
 		Context("with existing apps", func() {
+			var lastUploaded string
+
 			BeforeEach(func() {
+				lastUploadedTime, err := time.Parse(time.RFC3339, "2026-10-01T12:00:00Z")
+				Expect(err).ToNot(HaveOccurred())
+				lastUploaded = testUI.UserFriendlyDate(lastUploadedTime)
+
 				appSummaries := []v7action.ApplicationSummary{
 					{
 						Application: resources.Application{
@@ -198,14 +208,18 @@ var _ = Describe("apps Command", func() {
 					},
 					{
 						Application: resources.Application{
-							GUID:  "app-guid-2",
-							Name:  "some-app-2",
-							State: constant.ApplicationStopped,
+							GUID:          "app-guid-2",
+							Name:          "some-app-2",
+							State:         constant.ApplicationStopped,
+							LifecycleType: constant.AppLifecycleTypeBuildpack,
+							StackName:     "cflinuxfs4",
 						},
 						ProcessSummaries: []v7action.ProcessSummary{
 							{
 								Process: resources.Process{
-									Type: constant.ProcessTypeWeb,
+									Type:       constant.ProcessTypeWeb,
+									MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+									DiskInMB:   types.NullUint64{Value: 1024, IsSet: true},
 								},
 								InstanceDetails: []v7action.ProcessInstance{
 									v7action.ProcessInstance{
@@ -225,6 +239,7 @@ var _ = Describe("apps Command", func() {
 								URL:  "some-app-2.some-domain",
 							},
 						},
+						LastUploaded: "2026-10-01T12:00:00Z",
 					},
 				}
 				fakeActor.GetAppSummariesForSpaceReturns(appSummaries, v7action.Warnings{"warning-1", "warning-2"}, nil)
@@ -243,10 +258,108 @@ var _ = Describe("apps Command", func() {
 				Expect(testUI.Err).To(Say("warning-2"))
 
 				Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-				spaceGUID, labels, omitStats := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+				spaceGUID, labels, omitStats, withLastUploaded := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(labels).To(Equal(""))
 				Expect(omitStats).To(Equal(false))
+				Expect(withLastUploaded).To(BeFalse())
+			})
+
+			When("the output is wide", func() {
+				BeforeEach(func() {
+					cmd.Output = "wide"
+				})
+
+				It("displays the additional columns", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`name\s+requested state\s+processes\s+routes\s+guid\s+lifecycle\s+stack\s+memory\s+disk\s+instances\s+last uploaded`))
+					Expect(testUI.Out).To(Say(`some-app-1\s+started\s+web:2/2, console:0/0, worker:0/1\s+.*\s+app-guid-1\s+web:0M, console:0M, worker:0M\s+web:0M, console:0M, worker:0M\s+2/3\s+\n`))
+					Expect(testUI.Out).To(Say(`some-app-2\s+stopped\s+web:0/2\s+some-app-2.some-domain\s+app-guid-2\s+buildpack\s+cflinuxfs4\s+web:256M\s+web:1024M\s+0/2\s+%s`, lastUploaded))
+
+					_, _, _, withLastUploaded := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+					Expect(withLastUploaded).To(BeTrue())
+				})
+			})
+
+			When("the output is json", func() {
+				BeforeEach(func() {
+					cmd.Output = "json"
+				})
+
+				It("writes every column of the apps as JSON and the flavor text to stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.StopStructuredOutput(true)).To(Succeed())
+
+					Expect(testUI.Err).To(Say(`Getting apps in org some-org / space some-space as steve\.\.\.`))
+
+					var apps []map[string]string
+					Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &apps)).To(Succeed())
+					Expect(apps).To(HaveLen(2))
+					Expect(apps[1]).To(Equal(map[string]string{
+						"name":            "some-app-2",
+						"requested_state": "stopped",
+						"processes":       "web:0/2",
+						"routes":          "some-app-2.some-domain",
+						"guid":            "app-guid-2",
+						"lifecycle":       "buildpack",
+						"stack":           "cflinuxfs4",
+						"memory":          "web:256M",
+						"disk":            "web:1024M",
+						"instances":       "0/2",
+						"last_uploaded":   lastUploaded,
+					}))
+				})
+			})
+
+			When("columns are selected", func() {
+				BeforeEach(func() {
+					cmd.Columns = []string{"name", "state", "memory"}
+				})
+
+				It("displays only the selected columns in order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`name\s+requested state\s+memory\n`))
+					Expect(testUI.Out).To(Say(`some-app-1\s+started\s+web:0M, console:0M, worker:0M\n`))
+					Expect(testUI.Out).To(Say(`some-app-2\s+stopped\s+web:256M\n`))
+
+					_, _, _, withLastUploaded := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+					Expect(withLastUploaded).To(BeFalse())
+				})
+			})
+
+			When("the disk, instances and last-uploaded columns are selected", func() {
+				BeforeEach(func() {
+					cmd.Columns = []string{"name", "instances", "disk", "last-uploaded"}
+				})
+
+				It("gets when the apps were last uploaded and displays the columns", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					_, _, _, withLastUploaded := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+					Expect(withLastUploaded).To(BeTrue())
+
+					Expect(testUI.Out).To(Say(`name\s+instances\s+disk\s+last uploaded\n`))
+					Expect(testUI.Out).To(Say(`some-app-1\s+2/3\s+web:0M, console:0M, worker:0M\s+\n`))
+					Expect(testUI.Out).To(Say(`some-app-2\s+0/2\s+web:1024M\s+%s\n`, lastUploaded))
+				})
+			})
+
+			When("an unknown column is selected", func() {
+				BeforeEach(func() {
+					cmd.Columns = []string{"name", "color"}
+				})
+
+				It("returns an UnknownColumnError without getting the apps", func() {
+					Expect(executeErr).To(MatchError(translatableerror.UnknownColumnError{
+						Column:  "color",
+						Columns: []string{"name", "state", "processes", "routes", "guid", "lifecycle", "stack", "memory", "disk", "instances", "last-uploaded"},
+					}))
+
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+					Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(0))
+				})
 			})
 		})
 
@@ -275,7 +388,7 @@ var _ = Describe("apps Command", func() {
 				Expect(testUI.Err).To(Say("warning"))
 
 				Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-				spaceGUID, labelSelector, omitStats := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+				spaceGUID, labelSelector, omitStats, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(labelSelector).To(Equal(""))
 				Expect(omitStats).To(Equal(false))
@@ -303,7 +416,7 @@ var _ = Describe("apps Command", func() {
 
 		It("passes the flag to the API", func() {
 			Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-			_, labelSelector, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+			_, labelSelector, _, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 			Expect(labelSelector).To(Equal("fish=moose"))
 		})
 	})
@@ -340,9 +453,22 @@ var _ = Describe("apps Command", func() {
 			Expect(testUI.Out).To(Say(`name\s+requested state\s+routes`))
 			Expect(testUI.Out).To(Say(`some-app-1\s+started\s+some-app-1.some-domain`))
 			Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(1))
-			_, _, omitStats := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+			_, _, omitStats, _ := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
 			Expect(omitStats).To(Equal(true))
 		})
+
+		When("the memory column is selected", func() {
+			BeforeEach(func() {
+				cmd.Columns = []string{"name", "memory"}
+			})
+
+			It("returns an UnknownColumnError because process stats are not retrieved", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UnknownColumnError{
+					Column:  "memory",
+					Columns: []string{"name", "state", "routes", "guid", "lifecycle", "stack", "last-uploaded"},
+				}))
+			})
+		})
 	})
 
 })
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 )
 
 type AuthCommand struct {
@@ -17,8 +18,18 @@ type AuthCommand struct {
 	RequiredArgs      flag.Authentication `positional-args:"yes"`
 	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
 	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable or 'CF_NAME config --credential-helper' instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
 	relatedCommands   interface{}         `related_commands:"api, login, target"`
+
+	// CredentialHelper supplies the credentials that are neither given as
+	// arguments nor in the environment. It is nil when no helper is
+	// configured.
+	CredentialHelper CredentialHelper
+}
+
+func (cmd *AuthCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.CredentialHelper = newCredentialHelper(config)
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
@@ -123,6 +134,30 @@ func (cmd AuthCommand) getUsernamePassword() (string, string, error) {
 		}
 	}
 
+	if (userMissing || passwordMissing) && cmd.CredentialHelper != nil && !cmd.Config.IsCFOnK8s() {
+		purpose := credentialhelper.PurposeLogin
+		if cmd.ClientCredentials {
+			purpose = credentialhelper.PurposeClient
+		}
+		credentials, err := cmd.CredentialHelper.Get(credentialhelper.Request{
+			Purpose:  purpose,
+			Target:   cmd.Config.Target(),
+			Username: username,
+		})
+		if err != nil {
+			return "", "", err
+		}
+
+		if userMissing && credentials.Username != "" {
+			username = credentials.Username
+			userMissing = false
+		}
+		if passwordMissing {
+			password = credentials.Password
+			passwordMissing = false
+		}
+	}
+
 	if cmd.Config.IsCFOnK8s() {
 		if !passwordMissing {
 			cmd.UI.DisplayWarning("Warning: password is ignored when authenticating against Kubernetes.")
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -142,6 +143,62 @@ var _ = Describe("auth Command", func() {
 				})
 			})
 		})
+
+		When("a credential helper is configured", func() {
+			var fakeCredentialHelper *v7fakes.FakeCredentialHelper
+
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "myuser"
+				fakeConfig.TargetReturns("https://api.example.com")
+				fakeCredentialHelper = new(v7fakes.FakeCredentialHelper)
+				fakeCredentialHelper.GetReturns(credentialhelper.Credentials{Username: "other-user", Password: "helper-password"}, nil)
+				cmd.CredentialHelper = fakeCredentialHelper
+			})
+
+			It("authenticates with the missing password from the helper", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeCredentialHelper.GetArgsForCall(0)).To(Equal(credentialhelper.Request{
+					Purpose:  credentialhelper.PurposeLogin,
+					Target:   "https://api.example.com",
+					Username: "myuser",
+				}))
+
+				credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"username": "myuser",
+					"password": "helper-password",
+				}))
+			})
+
+			When("--client-credentials is set", func() {
+				BeforeEach(func() {
+					cmd.ClientCredentials = true
+				})
+
+				It("asks the helper for the client secret", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCredentialHelper.GetArgsForCall(0).Purpose).To(Equal(credentialhelper.PurposeClient))
+
+					credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+					Expect(credentials).To(Equal(map[string]string{
+						"client_id":     "myuser",
+						"client_secret": "helper-password",
+					}))
+				})
+			})
+
+			When("the helper fails", func() {
+				BeforeEach(func() {
+					fakeCredentialHelper.GetReturns(credentialhelper.Credentials{}, errors.New("helper failed"))
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(MatchError("helper failed"))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	When("there is an auth error", func() {
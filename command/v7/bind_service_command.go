@@ -14,6 +14,7 @@ type BindServiceCommand struct {
 	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
 	BindingName      flag.BindingName              `long:"binding-name" description:"Name to expose service instance to app process with (Default: service instance name)"`
 	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	PropagateLabels  flag.Tags                     `long:"propagate-labels" description:"Comma-separated list of service instance label keys to copy onto the labels of the binding. They are not in VCAP_SERVICES, so apps cannot read them"`
 	Wait             bool                          `short:"w" long:"wait" description:"Wait for the operation to complete"`
 	relatedCommands  interface{}                   `related_commands:"services"`
 }
@@ -33,6 +34,7 @@ func (cmd BindServiceCommand) Execute(args []string) error {
 		AppName:             cmd.RequiredArgs.AppName,
 		BindingName:         cmd.BindingName.Value,
 		Parameters:          types.OptionalObject(cmd.ParametersAsJSON),
+		PropagatedLabels:    cmd.PropagateLabels.Value,
 	})
 	cmd.UI.DisplayWarnings(warnings)
 
@@ -64,7 +66,7 @@ func (cmd BindServiceCommand) Execute(args []string) error {
 }
 
 func (cmd BindServiceCommand) Usage() string {
-	return `CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME]
+	return `CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--propagate-labels KEY[,KEY]]
 
 Optionally provide service-specific configuration parameters in a valid JSON object in-line:
 
@@ -82,7 +84,11 @@ Example of valid JSON object:
 
 Optionally provide a binding name for the association between an app and a service instance:
 
-CF_NAME bind-service APP_NAME SERVICE_INSTANCE --binding-name BINDING_NAME`
+CF_NAME bind-service APP_NAME SERVICE_INSTANCE --binding-name BINDING_NAME
+
+Optionally copy labels of the service instance onto the binding, so that they can be used to find the binding through the API. The labels are not added to the credentials or tags of the binding in VCAP_SERVICES, which come from the service broker, so apps cannot read them:
+
+CF_NAME bind-service APP_NAME SERVICE_INSTANCE --propagate-labels env,team`
 }
 
 func (cmd BindServiceCommand) Examples() string {
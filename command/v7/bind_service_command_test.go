@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
@@ -95,6 +96,16 @@ var _ = Describe("bind-service Command", func() {
 		}))
 	})
 
+	When("labels are propagated", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--propagate-labels", flag.Tags{IsSet: true, Value: []string{"env", "team"}})
+		})
+
+		It("passes the label keys to the actor", func() {
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).PropagatedLabels).To(Equal([]string{"env", "team"}))
+		})
+	})
+
 	Describe("intro message", func() {
 		It("prints an intro and warnings", func() {
 			Expect(executeErr).NotTo(HaveOccurred())
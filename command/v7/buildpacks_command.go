@@ -15,6 +15,10 @@ type BuildpacksCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter buildpacks by labels"`
 }
 
+func (BuildpacksCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd BuildpacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -1,9 +1,23 @@
 package v7
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/routeprobe"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RouteProber
+
+type RouteProber interface {
+	Probe(url string) (routeprobe.Result, error)
+}
+
 type CheckRouteCommand struct {
 	BaseCommand
 
@@ -11,13 +25,24 @@ type CheckRouteCommand struct {
 	Hostname        string           `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
 	Path            flag.V7RoutePath `long:"path" description:"Path used to identify the HTTP route"`
 	Port            int              `long:"port" description:"Port used to identify the TCP route"`
+	Inspect         bool             `long:"inspect" description:"Connect to the HTTP route over HTTPS and report the certificate it serves, the HTTP status and the response time"`
 	relatedCommands interface{}      `related_commands:"create-route, delete-route, routes"`
+
+	RouteProber RouteProber
+}
+
+func (cmd *CheckRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.RouteProber = routeprobe.NewProber(
+		util.NewDialer(config.DialTimeout(), config.IPFamily(), config.ResolveOverrides()),
+		config.ClientCertificate(),
+	)
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd CheckRouteCommand) Usage() string {
 	return `
 Check an HTTP route:
-   CF_NAME check-route DOMAIN [--hostname HOSTNAME] [--path PATH]
+   CF_NAME check-route DOMAIN [--hostname HOSTNAME] [--path PATH] [--inspect]
 
 Check a TCP route:
    CF_NAME check-route DOMAIN --port PORT`
@@ -28,10 +53,17 @@ func (cmd CheckRouteCommand) Examples() string {
 CF_NAME check-route example.com                      # example.com
 CF_NAME check-route example.com -n myhost --path foo # myhost.example.com/foo
 CF_NAME check-route example.com --path foo           # example.com/foo
-CF_NAME check-route example.com --port 5000          # example.com:5000`
+CF_NAME check-route example.com --port 5000          # example.com:5000
+CF_NAME check-route example.com -n myhost --inspect  # myhost.example.com`
 }
 
 func (cmd CheckRouteCommand) Execute(args []string) error {
+	if cmd.Inspect && cmd.Port != 0 {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--inspect", "--port"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
 		return err
@@ -62,7 +94,68 @@ func (cmd CheckRouteCommand) Execute(args []string) error {
 		cmd.UI.DisplayText("Route '{{.URL}}' does not exist.", formatParams)
 	}
 
+	if cmd.Inspect {
+		err = cmd.inspectRoute("https://" + desiredURL(cmd.RequiredArgs.Domain, cmd.Hostname, path, 0))
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayOK()
 
 	return nil
 }
+
+func (cmd CheckRouteCommand) inspectRoute(url string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Inspecting {{.URL}}...", map[string]interface{}{
+		"URL": url,
+	})
+	cmd.UI.DisplayNewline()
+
+	result, err := cmd.RouteProber.Probe(url)
+	if err != nil {
+		return err
+	}
+
+	certificate := result.Certificate
+	trusted := cmd.UI.TranslateText("yes")
+	if certificate.VerifyError != nil {
+		trusted = cmd.UI.TranslateText("no ({{.Reason}})", map[string]interface{}{
+			"Reason": certificate.VerifyError.Error(),
+		})
+	}
+
+	coversHost := cmd.UI.TranslateText("yes")
+	if !certificate.CoversHost {
+		coversHost = cmd.UI.TranslateText("no")
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("certificate subject:"), certificate.Subject},
+		{cmd.UI.TranslateText("certificate issuer:"), certificate.Issuer},
+		{cmd.UI.TranslateText("subject alternative names:"), strings.Join(certificate.DNSNames, ", ")},
+		{cmd.UI.TranslateText("covers host:"), coversHost},
+		{cmd.UI.TranslateText("trusted:"), trusted},
+		{cmd.UI.TranslateText("expires:"), cmd.expiry(certificate.NotAfter)},
+		{cmd.UI.TranslateText("status:"), result.Status},
+		{cmd.UI.TranslateText("response time:"), result.ResponseTime.Round(time.Millisecond).String()},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+func (cmd CheckRouteCommand) expiry(notAfter time.Time) string {
+	remaining := time.Until(notAfter)
+	days := int(remaining.Hours() / 24)
+
+	var relative string
+	if remaining < 0 {
+		relative = cmd.UI.TranslateText("expired {{.Days}} days ago", map[string]interface{}{"Days": -days})
+	} else {
+		relative = cmd.UI.TranslateText("in {{.Days}} days", map[string]interface{}{"Days": days})
+	}
+
+	return fmt.Sprintf("%s (%s)", cmd.UI.UserFriendlyDate(notAfter), relative)
+}
@@ -1,14 +1,19 @@
 package v7_test
 
 import (
+	"crypto/x509"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/routeprobe"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,6 +27,7 @@ var _ = Describe("check-route Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v7fakes.FakeActor
+		fakeProber      *v7fakes.FakeRouteProber
 		binaryName      string
 		executeErr      error
 	)
@@ -31,6 +37,7 @@ var _ = Describe("check-route Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v7fakes.FakeActor)
+		fakeProber = new(v7fakes.FakeRouteProber)
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -43,6 +50,7 @@ var _ = Describe("check-route Command", func() {
 				Actor:       fakeActor,
 			},
 			RequiredArgs: flag.Domain{Domain: "some-domain.com"},
+			RouteProber:  fakeProber,
 		}
 
 		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
@@ -195,4 +203,90 @@ var _ = Describe("check-route Command", func() {
 
 		Expect(executeErr).NotTo(HaveOccurred())
 	})
+
+	When("passing the inspect flag", func() {
+		BeforeEach(func() {
+			cmd.Inspect = true
+			cmd.Hostname = "myhost"
+			cmd.Path = flag.V7RoutePath{Path: "/foo"}
+
+			fakeProber.ProbeReturns(routeprobe.Result{
+				Host: "myhost.some-domain.com",
+				Certificate: routeprobe.Certificate{
+					Subject:    "CN=*.some-domain.com",
+					Issuer:     "CN=Some CA",
+					DNSNames:   []string{"*.some-domain.com", "some-domain.com"},
+					NotAfter:   time.Now().Add(30*24*time.Hour + time.Hour),
+					CoversHost: true,
+				},
+				StatusCode:   200,
+				Status:       "200 OK",
+				ResponseTime: 123456 * time.Microsecond,
+			}, nil)
+		})
+
+		It("reports on the certificate and response of the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeProber.ProbeCallCount()).To(Equal(1))
+			Expect(fakeProber.ProbeArgsForCall(0)).To(Equal("https://myhost.some-domain.com/foo"))
+
+			Expect(testUI.Out).To(Say(`Route 'myhost\.some-domain\.com/foo' does exist\.`))
+			Expect(testUI.Out).To(Say(`Inspecting https://myhost\.some-domain\.com/foo\.\.\.`))
+			Expect(testUI.Out).To(Say(`certificate subject:\s+CN=\*\.some-domain\.com`))
+			Expect(testUI.Out).To(Say(`certificate issuer:\s+CN=Some CA`))
+			Expect(testUI.Out).To(Say(`subject alternative names:\s+\*\.some-domain\.com, some-domain\.com`))
+			Expect(testUI.Out).To(Say(`covers host:\s+yes`))
+			Expect(testUI.Out).To(Say(`trusted:\s+yes`))
+			Expect(testUI.Out).To(Say(`expires:\s+.+ \(in 30 days\)`))
+			Expect(testUI.Out).To(Say(`status:\s+200 OK`))
+			Expect(testUI.Out).To(Say(`response time:\s+123ms`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the certificate is wrong for the route", func() {
+			BeforeEach(func() {
+				fakeProber.ProbeReturns(routeprobe.Result{
+					Certificate: routeprobe.Certificate{
+						Subject:     "CN=other.com",
+						NotAfter:    time.Now().Add(-48*time.Hour - time.Hour),
+						VerifyError: x509.UnknownAuthorityError{},
+					},
+					Status: "502 Bad Gateway",
+				}, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`covers host:\s+no`))
+				Expect(testUI.Out).To(Say(`trusted:\s+no \(x509: certificate signed by unknown authority\)`))
+				Expect(testUI.Out).To(Say(`expires:\s+.+ \(expired 2 days ago\)`))
+				Expect(testUI.Out).To(Say(`status:\s+502 Bad Gateway`))
+			})
+		})
+
+		When("the route cannot be reached", func() {
+			BeforeEach(func() {
+				fakeProber.ProbeReturns(routeprobe.Result{}, errors.New("connection refused"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("connection refused"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+
+		When("the port flag is also passed", func() {
+			BeforeEach(func() {
+				cmd.Port = 1024
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--inspect", "--port"},
+				}))
+				Expect(fakeProber.ProbeCallCount()).To(BeZero())
+			})
+		})
+	})
 })
@@ -0,0 +1,53 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ClearBuildCacheCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME clear-build-cache APP_NAME\n\n   The next build of the app starts without the files its buildpacks cached during previous builds.\n\nEXAMPLES:\n   CF_NAME clear-build-cache my-app\n   CF_NAME restage my-app --no-build-cache"`
+	relatedCommands interface{}  `related_commands:"push, restage"`
+}
+
+func (cmd ClearBuildCacheCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Clearing build cache for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.ClearApplicationBuildCache(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restage {{.AppName}}' to rebuild the app without the cache.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"AppName":    cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clear-build-cache Command", func() {
+	var (
+		cmd             ClearBuildCacheCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = ClearBuildCacheCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("get-user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get-user-error"))
+		})
+	})
+
+	When("the app exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{Name: "some-app", GUID: "some-app-guid"},
+				v7action.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeActor.ClearApplicationBuildCacheReturns(v7action.Warnings{"clear-cache-warning"}, nil)
+		})
+
+		It("clears the build cache of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.ClearApplicationBuildCacheCallCount()).To(Equal(1))
+			Expect(fakeActor.ClearApplicationBuildCacheArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(testUI.Out).To(Say(`Clearing build cache for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman restage some-app' to rebuild the app without the cache\.`))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("clear-cache-warning"))
+		})
+
+		When("clearing the cache fails", func() {
+			BeforeEach(func() {
+				fakeActor.ClearApplicationBuildCacheReturns(v7action.Warnings{"clear-cache-warning"}, errors.New("clear-cache-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("clear-cache-error"))
+				Expect(testUI.Err).To(Say("clear-cache-warning"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{},
+				v7action.Warnings{"get-app-warning"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.ClearApplicationBuildCacheCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -1,19 +1,32 @@
 package v7
 
 import (
+	"path/filepath"
+	"strings"
+
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type ConfigCommand struct {
-	UI           command.UI
-	Config       command.Config
-	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	UI                  command.UI
+	Config              command.Config
+	AsyncTimeout        flag.Timeout           `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
+	Color               flag.Color             `long:"color" description:"Enable or disable color in CLI output"`
+	ColorTheme          string                 `long:"color-theme" description:"Set the colors of entity names, headers, warnings, errors and OK as comma-separated ROLE=COLOR pairs, or the path to a JSON theme file mapping roles to colors. A COLOR is a name such as cyan or bright-blue, a 256 color palette number, #RRGGBB or none, optionally combined with bold, faint, italic or underline using '+'. If COLOR_THEME is 'CLEAR', the default colors are restored."`
+	CredentialHelper    string                 `long:"credential-helper" description:"Take the passwords that login, auth, create-service-broker and update-service-broker would otherwise prompt for from this command, e.g. a script reading a password manager. It is run by the shell with the 'get' argument appended, given purpose=, target=, name= and username= lines on stdin, and must print username= and password= lines. A failing helper fails the command. If CREDENTIAL_HELPER is 'CLEAR', the helper is removed."`
+	DisableExperimental string                 `long:"disable-experimental" description:"Turn off an experimental feature, as listed by the experimental command"`
+	EnableExperimental  string                 `long:"enable-experimental" description:"Turn on an experimental feature, as listed by the experimental command"`
+	Locale              flag.Locale            `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Pager               flag.Pager             `long:"pager" description:"Show long command output through a pager when writing to a terminal. The pager is taken from CF_PAGER or PAGER, defaulting to 'less -FRX'."`
+	Progress            string                 `long:"progress" choice:"auto" choice:"animated" choice:"plain" description:"Show the progress of uploads and downloads as animated bars or as timestamped lines. 'auto' uses timestamped lines when a CI environment is detected."`
+	Resolve             []flag.ResolveOverride `long:"resolve" description:"Connect to HOST:PORT at the given addresses instead of resolving HOST. Can be specified multiple times. If RESOLVE is 'CLEAR', all previous overrides are deleted."`
+	TokenStorage        string                 `long:"token-storage" choice:"file" choice:"keychain" description:"Keep the access and refresh tokens in config.json or in the keychain of the OS (Keychain on macOS, the Secret Service on Linux, DPAPI encryption on Windows). Tokens are written to config.json when the keychain cannot be used."`
+	Trace               flag.PathWithBool      `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
+	usage               interface{}            `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--color-theme (ROLE=COLOR[,ROLE=COLOR] | path/to/theme.json | CLEAR)] [--credential-helper (COMMAND | CLEAR)] [--enable-experimental NAME] [--disable-experimental NAME] [--locale (LOCALE | CLEAR)] [--pager (true | false)] [--progress (auto | animated | plain)] [--resolve (HOST:PORT:ADDRESS[,ADDRESS] | CLEAR)]... [--token-storage (file | keychain)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,10 +37,21 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.ColorTheme == "" && cmd.CredentialHelper == "" && cmd.EnableExperimental == "" && cmd.DisableExperimental == "" && !cmd.Pager.IsSet && cmd.Progress == "" && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && len(cmd.Resolve) == 0 && cmd.TokenStorage == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
+	colorTheme, err := cmd.colorTheme()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{cmd.EnableExperimental, cmd.DisableExperimental} {
+		if _, ok := command.LookupExperimentalFeature(name); name != "" && !ok {
+			return translatableerror.ExperimentalFeatureNotFoundError{Name: name, BinaryName: cmd.Config.BinaryName()}
+		}
+	}
+
 	cmd.UI.DisplayText("Setting values in config...")
 
 	if cmd.AsyncTimeout.IsSet {
@@ -38,10 +62,46 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetColorEnabled(cmd.Color.Value)
 	}
 
+	if colorTheme != "" {
+		cmd.Config.SetColorTheme(colorTheme)
+	}
+
+	if cmd.CredentialHelper != "" {
+		cmd.Config.SetCredentialHelper(cmd.CredentialHelper)
+	}
+
+	if cmd.EnableExperimental != "" {
+		cmd.Config.SetExperimentalFeatureEnabled(cmd.EnableExperimental, true)
+	}
+
+	if cmd.DisableExperimental != "" {
+		cmd.Config.SetExperimentalFeatureEnabled(cmd.DisableExperimental, false)
+	}
+
 	if cmd.Locale.Locale != "" {
 		cmd.Config.SetLocale(cmd.Locale.Locale)
 	}
 
+	if cmd.Pager.IsSet {
+		cmd.Config.SetPagerEnabled(cmd.Pager.Value)
+	}
+
+	if cmd.Progress != "" {
+		cmd.Config.SetProgressStyle(cmd.Progress)
+	}
+
+	for _, override := range cmd.Resolve {
+		if override.Clear {
+			cmd.Config.ClearResolveOverrides()
+		} else {
+			cmd.Config.SetResolveOverride(override.ResolveOverride)
+		}
+	}
+
+	if cmd.TokenStorage != "" {
+		cmd.Config.SetTokenStorage(cmd.TokenStorage)
+	}
+
 	if cmd.Trace != "" {
 		cmd.Config.SetTrace(string(cmd.Trace))
 	}
@@ -49,3 +109,21 @@ func (cmd ConfigCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	return nil
 }
+
+// colorTheme validates the --color-theme value and returns it with a theme
+// file path made absolute, so that the theme is found from any directory.
+func (cmd ConfigCommand) colorTheme() (string, error) {
+	if cmd.ColorTheme == "" || cmd.ColorTheme == "CLEAR" {
+		return cmd.ColorTheme, nil
+	}
+
+	_, err := ui.LoadColorTheme(cmd.ColorTheme, configv3.ColorDepthTrueColor)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(cmd.ColorTheme, "=") {
+		return cmd.ColorTheme, nil
+	}
+	return filepath.Abs(cmd.ColorTheme)
+}
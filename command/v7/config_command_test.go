@@ -1,12 +1,17 @@
 package v7_test
 
 import (
+	"net"
+	"os"
+	"path/filepath"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -69,6 +74,111 @@ var _ = Describe("ConfigCommand", func() {
 		})
 	})
 
+	When("using the color theme flag", func() {
+		When("the theme is a list of roles and colors", func() {
+			BeforeEach(func() {
+				cmd.ColorTheme = "entity=blue+bold,ok=#00af00"
+			})
+
+			It("successfully updates the config", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.SetColorThemeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetColorThemeArgsForCall(0)).To(Equal("entity=blue+bold,ok=#00af00"))
+			})
+		})
+
+		When("the theme is a theme file", func() {
+			var themeDir string
+
+			BeforeEach(func() {
+				var err error
+				themeDir, err = os.MkdirTemp("", "color-theme")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.WriteFile(filepath.Join(themeDir, "theme.json"), []byte(`{"warning": "yellow"}`), 0600)).To(Succeed())
+
+				cmd.ColorTheme = filepath.Join(themeDir, "theme.json")
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(themeDir)).To(Succeed())
+			})
+
+			It("stores the path of the theme file", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.SetColorThemeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetColorThemeArgsForCall(0)).To(Equal(filepath.Join(themeDir, "theme.json")))
+			})
+		})
+
+		When("the value is CLEAR", func() {
+			BeforeEach(func() {
+				cmd.ColorTheme = "CLEAR"
+			})
+
+			It("clears the theme", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.SetColorThemeArgsForCall(0)).To(Equal("CLEAR"))
+			})
+		})
+
+		When("the theme is invalid", func() {
+			BeforeEach(func() {
+				cmd.ColorTheme = "entity=chartreuse"
+			})
+
+			It("returns an error and does not update the config", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidColorThemeError{
+					Theme:  "entity=chartreuse",
+					Reason: `"chartreuse" is not a color name, palette number from 0 to 255 or #RRGGBB color`,
+				}))
+				Expect(fakeConfig.SetColorThemeCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("using the enable experimental flag", func() {
+		BeforeEach(func() {
+			cmd.EnableExperimental = "revisions"
+		})
+
+		It("turns on the feature in the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetExperimentalFeatureEnabledCallCount()).To(Equal(1))
+			name, enabled := fakeConfig.SetExperimentalFeatureEnabledArgsForCall(0)
+			Expect(name).To(Equal("revisions"))
+			Expect(enabled).To(BeTrue())
+		})
+
+		When("the feature does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryNameReturns("faceman")
+				cmd.EnableExperimental = "teleport"
+			})
+
+			It("returns an error and does not update the config", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ExperimentalFeatureNotFoundError{
+					Name:       "teleport",
+					BinaryName: "faceman",
+				}))
+				Expect(fakeConfig.SetExperimentalFeatureEnabledCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("using the disable experimental flag", func() {
+		BeforeEach(func() {
+			cmd.DisableExperimental = "revisions"
+		})
+
+		It("turns off the feature in the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetExperimentalFeatureEnabledCallCount()).To(Equal(1))
+			name, enabled := fakeConfig.SetExperimentalFeatureEnabledArgsForCall(0)
+			Expect(name).To(Equal("revisions"))
+			Expect(enabled).To(BeFalse())
+		})
+	})
+
 	When("using the locale flag", func() {
 		BeforeEach(func() {
 			cmd.Locale = flag.Locale{Locale: "en-US"}
@@ -82,6 +192,83 @@ var _ = Describe("ConfigCommand", func() {
 		})
 	})
 
+	When("using the pager flag", func() {
+		BeforeEach(func() {
+			cmd.Pager = flag.Pager{IsSet: true, Value: "false"}
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetPagerEnabledCallCount()).To(Equal(1))
+			value := fakeConfig.SetPagerEnabledArgsForCall(0)
+			Expect(value).To(Equal("false"))
+		})
+	})
+
+	When("using the progress flag", func() {
+		BeforeEach(func() {
+			cmd.Progress = "plain"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetProgressStyleCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetProgressStyleArgsForCall(0)).To(Equal("plain"))
+		})
+	})
+
+	When("using the token storage flag", func() {
+		BeforeEach(func() {
+			cmd.TokenStorage = "keychain"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetTokenStorageCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetTokenStorageArgsForCall(0)).To(Equal("keychain"))
+		})
+	})
+
+	When("using the credential-helper flag", func() {
+		BeforeEach(func() {
+			cmd.CredentialHelper = "/usr/local/bin/cf-op-helper --vault ops"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetCredentialHelperCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetCredentialHelperArgsForCall(0)).To(Equal("/usr/local/bin/cf-op-helper --vault ops"))
+		})
+	})
+
+	When("using the resolve flag", func() {
+		var override util.ResolveOverride
+
+		BeforeEach(func() {
+			override = util.ResolveOverride{Host: "api.example.com", Port: "443", Addresses: []net.IP{net.ParseIP("10.0.0.5")}}
+			cmd.Resolve = []flag.ResolveOverride{{ResolveOverride: override}}
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetResolveOverrideCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetResolveOverrideArgsForCall(0)).To(Equal(override))
+			Expect(fakeConfig.ClearResolveOverridesCallCount()).To(Equal(0))
+		})
+
+		When("the value is CLEAR", func() {
+			BeforeEach(func() {
+				cmd.Resolve = []flag.ResolveOverride{{Clear: true}, {ResolveOverride: override}}
+			})
+
+			It("clears the previous overrides before adding the new ones", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.ClearResolveOverridesCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetResolveOverrideCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	When("using the trace flag", func() {
 		BeforeEach(func() {
 			cmd.Trace = "my-trace-file"
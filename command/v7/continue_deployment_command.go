@@ -0,0 +1,72 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ContinueDeploymentCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	DeploymentGUID  string       `long:"guid" description:"GUID of the deployment to continue (Default: the app's active deployment)"`
+	usage           interface{}  `usage:"CF_NAME continue-deployment APP_NAME [--guid DEPLOYMENT_GUID]\n\n   Canary deployments pause after each step until they are continued.\n\nEXAMPLES:\n   cf continue-deployment my-app\n   cf continue-deployment my-app --guid 0e9bea0d-8687-4d1a-a5d4-bc4d035bdac4"`
+	relatedCommands interface{}  `related_commands:"cancel-deployment, rollout-status"`
+}
+
+func (cmd *ContinueDeploymentCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Continuing deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...",
+		map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"UserName":  user.Name,
+		},
+	)
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployment, warnings, err := cmd.Actor.GetDeploymentForApp(application.GUID, cmd.DeploymentGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	status, warnings, err := cmd.Actor.GetRolloutStatus(deployment)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Deployment {{.DeploymentGUID}} ({{.Strategy}}):", map[string]interface{}{
+		"DeploymentGUID": deployment.GUID,
+		"Strategy":       deployment.Strategy,
+	})
+	displayRolloutStatus(cmd.UI, status)
+	cmd.UI.DisplayNewline()
+
+	warnings, err = cmd.Actor.ContinueDeployment(deployment.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Run 'cf rollout-status {{.AppName}}' to follow the deployment.", map[string]interface{}{"AppName": cmd.RequiredArgs.AppName})
+	return nil
+}
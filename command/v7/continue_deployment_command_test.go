@@ -0,0 +1,142 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("continue-deployment command", func() {
+	var (
+		cmd             ContinueDeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = ContinueDeploymentCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetDeploymentForAppReturns(
+			resources.Deployment{GUID: "some-deployment-guid", Strategy: constant.DeploymentStrategyCanary, State: constant.DeploymentDeploying, CanaryStep: 1, CanarySteps: 3},
+			v7action.Warnings{"get-deployment-warning"},
+			nil,
+		)
+		fakeActor.GetRolloutStatusReturns(
+			v7action.RolloutStatus{
+				Deployment: resources.Deployment{State: constant.DeploymentDeploying, CanaryStep: 1, CanarySteps: 3},
+				Routable:   1,
+				Starting:   1,
+				Total:      2,
+			},
+			v7action.Warnings{"get-status-warning"},
+			nil,
+		)
+		fakeActor.ContinueDeploymentReturns(v7action.Warnings{"continue-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.ContinueDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the status of the deployment and then continues it", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Continuing deployment for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Deployment some-deployment-guid \(canary\):`))
+		Expect(testUI.Out).To(Say("DEPLOYING: 1 of 2 instances routable, 1 starting, 0 failing, canary step 1 of 3"))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Run 'cf rollout-status some-app'"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-deployment-warning"))
+		Expect(testUI.Err).To(Say("get-status-warning"))
+		Expect(testUI.Err).To(Say("continue-warning"))
+
+		appGUID, deploymentGUID := fakeActor.GetDeploymentForAppArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(deploymentGUID).To(BeEmpty())
+		Expect(fakeActor.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+	})
+
+	When("a deployment GUID is given", func() {
+		BeforeEach(func() {
+			cmd.DeploymentGUID = "given-deployment-guid"
+		})
+
+		It("looks up that deployment of the app", func() {
+			_, deploymentGUID := fakeActor.GetDeploymentForAppArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("given-deployment-guid"))
+		})
+	})
+
+	When("the deployment cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentForAppReturns(resources.Deployment{}, v7action.Warnings{"get-deployment-warning"}, actionerror.DeploymentNotFoundError{})
+		})
+
+		It("returns the error without acting", func() {
+			Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+			Expect(fakeActor.ContinueDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	When("continuing the deployment fails", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentReturns(v7action.Warnings{"continue-warning"}, errors.New("continue-error"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("continue-error"))
+			Expect(testUI.Err).To(Say("continue-warning"))
+		})
+	})
+})
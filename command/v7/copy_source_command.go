@@ -15,8 +15,9 @@ type CopySourceCommand struct {
 	BaseCommand
 
 	RequiredArgs        flag.CopySourceArgs     `positional-args:"yes"`
-	usage               interface{}             `usage:"CF_NAME copy-source SOURCE_APP DESTINATION_APP [-s TARGET_SPACE [-o TARGET_ORG]] [--no-restart] [--strategy STRATEGY] [--no-wait]"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null"`
+	usage               interface{}             `usage:"CF_NAME copy-source SOURCE_APP DESTINATION_APP [-s TARGET_SPACE [-o TARGET_ORG]] [--no-restart] [--strategy STRATEGY [--max-in-flight COUNT]] [--no-wait]"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling, canary or none"`
+	MaxInFlight         flag.PositiveInteger    `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time; requires --strategy rolling or canary"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
 	NoRestart           bool                    `long:"no-restart" description:"Do not restage the destination application"`
 	Organization        string                  `short:"o" long:"organization" description:"Org that contains the destination application"`
@@ -68,7 +69,12 @@ func (cmd *CopySourceCommand) Setup(config command.Config, ui command.UI) error
 }
 
 func (cmd CopySourceCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	deployment, err := shared.NewDeploymentOptions(cmd.Strategy, cmd.MaxInFlight, flag.CanarySteps{})
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -164,7 +170,7 @@ func (cmd CopySourceCommand) Execute(args []string) error {
 			targetSpace,
 			targetOrg,
 			pkg.GUID,
-			cmd.Strategy.Name,
+			deployment,
 			cmd.NoWait,
 			constant.ApplicationRestarting,
 		)
@@ -338,7 +338,7 @@ var _ = Describe("copy-source Command", func() {
 			Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 			Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 			Expect(pkgGUID).To(Equal("target-package-guid"))
-			Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
+			Expect(strategy).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyRolling}))
 			Expect(noWait).To(Equal(false))
 			Expect(appAction).To(Equal(constant.ApplicationRestarting))
 		})
@@ -356,7 +356,7 @@ var _ = Describe("copy-source Command", func() {
 			Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 			Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 			Expect(pkgGUID).To(Equal("target-package-guid"))
-			Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
+			Expect(strategy).To(Equal(v7action.DeploymentOptions{}))
 			Expect(noWait).To(Equal(true))
 			Expect(appAction).To(Equal(constant.ApplicationRestarting))
 		})
@@ -369,7 +369,7 @@ var _ = Describe("copy-source Command", func() {
 		Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 		Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 		Expect(pkgGUID).To(Equal("target-package-guid"))
-		Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
+		Expect(strategy).To(Equal(v7action.DeploymentOptions{}))
 		Expect(noWait).To(Equal(false))
 		Expect(appAction).To(Equal(constant.ApplicationRestarting))
 	})
@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/download"
 )
 
@@ -27,7 +28,11 @@ type CreateBuildpackCommand struct {
 }
 
 func (cmd *CreateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
-	cmd.ProgressBar = v7action.NewProgressBar()
+	if config.ProgressStyle() == configv3.ProgressPlain {
+		cmd.ProgressBar = v7action.NewPlainProgressBar(ui.Writer())
+	} else {
+		cmd.ProgressBar = v7action.NewProgressBar()
+	}
 	return cmd.BaseCommand.Setup(config, ui)
 }
 
@@ -0,0 +1,93 @@
+package v7
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateDrainCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.CreateDrainArgs `positional-args:"yes"`
+	Type            string               `long:"type" choice:"logs" choice:"metrics" choice:"all" description:"What the drain receives: logs (default), metrics or all"`
+	usage           interface{}          `usage:"CF_NAME create-drain APP_NAME DRAIN_NAME SYSLOG_URL [--type (logs | metrics | all)]\n\n   Creates a user-provided service instance with the syslog URL and binds it to the app.\n\nEXAMPLES:\n   CF_NAME create-drain my-app my-drain syslog-tls://logs.example.com:6514\n   CF_NAME create-drain my-app my-metrics https://metrics.example.com/ingest --type metrics"`
+	relatedCommands interface{}          `related_commands:"delete-drain, drain, drains"`
+}
+
+func (cmd CreateDrainCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating drain {{.DrainName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"DrainName": cmd.RequiredArgs.DrainName,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	drainURL, err := cmd.drainURL()
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.CreateUserProvidedServiceInstance(resources.ServiceInstance{
+		Type:           resources.UserProvidedServiceInstance,
+		Name:           cmd.RequiredArgs.DrainName,
+		SpaceGUID:      cmd.Config.TargetedSpace().GUID,
+		SyslogDrainURL: types.NewOptionalString(drainURL),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	stream, warnings, err := cmd.Actor.CreateServiceAppBinding(v7action.CreateServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.DrainName,
+		AppName:             cmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// drainURL adds the requested drain type to the syslog URL.
+func (cmd CreateDrainCommand) drainURL() (string, error) {
+	if cmd.Type == "" {
+		return cmd.RequiredArgs.DrainURL.URL, nil
+	}
+
+	parsedURL, err := url.Parse(cmd.RequiredArgs.DrainURL.URL)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsedURL.Query()
+	query.Set("drain-type", cmd.Type)
+	parsedURL.RawQuery = query.Encode()
+
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,161 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-drain Command", func() {
+	var (
+		cmd             CreateDrainCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = CreateDrainCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.CreateDrainArgs{
+				AppName:   "some-app",
+				DrainName: "some-drain",
+				DrainURL:  flag.DrainURL{URL: "syslog-tls://logs.example.com:6514"},
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CreateUserProvidedServiceInstanceReturns(v7action.Warnings{"create-instance-warning"}, nil)
+		fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"bind-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+			Expect(fakeActor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("creates a user-provided service instance with the syslog URL and binds it to the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+		Expect(fakeActor.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(resources.ServiceInstance{
+			Type:           resources.UserProvidedServiceInstance,
+			Name:           "some-drain",
+			SpaceGUID:      "some-space-guid",
+			SyslogDrainURL: types.NewOptionalString("syslog-tls://logs.example.com:6514"),
+		}))
+
+		Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+		Expect(fakeActor.CreateServiceAppBindingArgsForCall(0)).To(Equal(v7action.CreateServiceAppBindingParams{
+			SpaceGUID:           "some-space-guid",
+			ServiceInstanceName: "some-drain",
+			AppName:             "some-app",
+		}))
+
+		Expect(testUI.Out).To(Say(`Creating drain some-drain for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("create-instance-warning"))
+		Expect(testUI.Err).To(Say("bind-warning"))
+	})
+
+	When("a drain type is given", func() {
+		BeforeEach(func() {
+			cmd.Type = "metrics"
+		})
+
+		It("adds the drain type to the syslog URL", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.CreateUserProvidedServiceInstanceArgsForCall(0).SyslogDrainURL).To(Equal(
+				types.NewOptionalString("syslog-tls://logs.example.com:6514?drain-type=metrics"),
+			))
+		})
+	})
+
+	When("creating the service instance fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateUserProvidedServiceInstanceReturns(v7action.Warnings{"create-instance-warning"}, errors.New("create-instance-error"))
+		})
+
+		It("returns the error and does not bind the app", func() {
+			Expect(executeErr).To(MatchError("create-instance-error"))
+			Expect(testUI.Err).To(Say("create-instance-warning"))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(0))
+		})
+	})
+
+	When("binding the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"bind-warning"}, errors.New("bind-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("bind-error"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("the binding job fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceAppBindingCalls(func(params v7action.CreateServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+				stream := make(chan v7action.PollJobEvent)
+				go func() {
+					stream <- v7action.PollJobEvent{
+						State:    v7action.JobFailed,
+						Warnings: v7action.Warnings{"job-warning"},
+						Err:      errors.New("job-error"),
+					}
+					close(stream)
+				}()
+				return stream, v7action.Warnings{"bind-warning"}, nil
+			})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("job-error"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+			Expect(testUI.Err).To(Say("job-warning"))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 )
 
 type CreateServiceBrokerCommand struct {
@@ -19,6 +20,13 @@ type CreateServiceBrokerCommand struct {
 	usage           any                    `usage:"CF_NAME create-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--space-scoped]\n   CF_NAME create-service-broker SERVICE_BROKER USERNAME URL [--space-scoped] (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
 	relatedCommands any                    `related_commands:"enable-service-access, service-brokers, target"`
 	envPassword     any                    `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"Password associated with user. Overridden if PASSWORD argument is provided" environmentDefault:"password"`
+
+	CredentialHelper CredentialHelper
+}
+
+func (cmd *CreateServiceBrokerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.CredentialHelper = newCredentialHelper(config)
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd *CreateServiceBrokerCommand) Execute(args []string) error {
@@ -27,7 +35,7 @@ func (cmd *CreateServiceBrokerCommand) Execute(args []string) error {
 		return err
 	}
 
-	brokerName, username, password, url, err := promptUserForBrokerPasswordIfRequired(cmd.PositionalArgs, cmd.UI)
+	brokerName, username, password, url, err := promptUserForBrokerPasswordIfRequired(cmd.PositionalArgs, cmd.UI, cmd.CredentialHelper)
 	if err != nil {
 		return err
 	}
@@ -89,7 +97,11 @@ func (cmd *CreateServiceBrokerCommand) Execute(args []string) error {
 	return nil
 }
 
-func promptUserForBrokerPasswordIfRequired(args flag.ServiceBrokerArgs, ui command.UI) (string, string, string, string, error) {
+// promptUserForBrokerPasswordIfRequired returns the name, username, password
+// and URL of the broker. The password, when not given as an argument, is taken
+// from CF_BROKER_PASSWORD, then from the credential helper, and is otherwise
+// prompted for.
+func promptUserForBrokerPasswordIfRequired(args flag.ServiceBrokerArgs, ui command.UI, helper CredentialHelper) (string, string, string, string, error) {
 	if args.URL != "" {
 		return args.ServiceBroker, args.Username, args.PasswordOrURL, args.URL, nil
 	}
@@ -98,6 +110,19 @@ func promptUserForBrokerPasswordIfRequired(args flag.ServiceBrokerArgs, ui comma
 		return args.ServiceBroker, args.Username, password, args.PasswordOrURL, nil
 	}
 
+	if helper != nil {
+		credentials, err := helper.Get(credentialhelper.Request{
+			Purpose:  credentialhelper.PurposeServiceBroker,
+			Target:   args.PasswordOrURL,
+			Name:     args.ServiceBroker,
+			Username: args.Username,
+		})
+		if err != nil {
+			return "", "", "", "", err
+		}
+		return args.ServiceBroker, args.Username, credentials.Password, args.PasswordOrURL, nil
+	}
+
 	password, err := ui.DisplayPasswordPrompt("Service Broker Password")
 	if err != nil {
 		return "", "", "", "", err
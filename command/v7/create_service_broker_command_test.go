@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -228,6 +229,43 @@ var _ = Describe("create-service-broker Command", func() {
 		})
 	})
 
+	When("password is provided by the credential helper", func() {
+		var fakeCredentialHelper *v7fakes.FakeCredentialHelper
+
+		BeforeEach(func() {
+			setPositionalFlags(cmd, serviceBrokerName, username, url, "")
+			fakeCredentialHelper = new(v7fakes.FakeCredentialHelper)
+			fakeCredentialHelper.GetReturns(credentialhelper.Credentials{Password: "helper-password"}, nil)
+			cmd.CredentialHelper = fakeCredentialHelper
+		})
+
+		It("asks the helper for the broker password instead of prompting", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Service Broker Password"))
+
+			Expect(fakeCredentialHelper.GetArgsForCall(0)).To(Equal(credentialhelper.Request{
+				Purpose:  credentialhelper.PurposeServiceBroker,
+				Target:   url,
+				Name:     serviceBrokerName,
+				Username: username,
+			}))
+
+			model := fakeActor.CreateServiceBrokerArgsForCall(0)
+			Expect(model.Password).To(Equal("helper-password"))
+		})
+
+		When("the helper fails", func() {
+			BeforeEach(func() {
+				fakeCredentialHelper.GetReturns(credentialhelper.Credentials{}, errors.New("helper failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("helper failed"))
+				Expect(fakeActor.CreateServiceBrokerCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("the --update-if-exists flag is used", func() {
 		BeforeEach(func() {
 			setPositionalFlags(cmd, serviceBrokerName, username, password, url)
@@ -1,11 +1,15 @@
 package v7
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type CreateSpaceActor interface {
@@ -18,11 +22,24 @@ type CreateSpaceActor interface {
 type CreateSpaceCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.Space  `positional-args:"yes"`
-	Organization    string      `short:"o" description:"Organization"`
-	Quota           string      `long:"quota" short:"q" description:"Quota to assign to the newly created space"`
-	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q QUOTA]"`
-	relatedCommands interface{} `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
+	RequiredArgs      flag.Space  `positional-args:"yes"`
+	Organization      string      `short:"o" description:"Organization"`
+	Quota             string      `long:"quota" short:"q" description:"Quota to assign to the newly created space"`
+	IsolationSegment  string      `long:"isolation-segment" description:"Isolation segment to assign to the newly created space"`
+	SecurityGroups    []string    `long:"security-group" description:"Security group to bind to the newly created space for running apps; can be specified multiple times"`
+	Managers          []string    `long:"manager" description:"User to assign the SpaceManager role in the newly created space to; can be specified multiple times"`
+	Developers        []string    `long:"developer" description:"User to assign the SpaceDeveloper role in the newly created space to; can be specified multiple times"`
+	RollbackOnFailure bool        `long:"rollback-on-failure" description:"Delete the newly created space when configuring it fails, instead of listing the commands that finish configuring it"`
+	usage             interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q QUOTA] [--isolation-segment SEGMENT] [--security-group SECURITY_GROUP]... [--manager USERNAME]... [--developer USERNAME]... [--rollback-on-failure]\n\n   The quota, isolation segment and security groups are looked up before the space is created. The current user is\n   always assigned the SpaceManager and SpaceDeveloper roles.\n\nEXAMPLES:\n   CF_NAME create-space staging -q small --isolation-segment secure --security-group public-networks --manager alice --developer bob --developer carol"`
+	relatedCommands   interface{} `related_commands:"bind-security-group, set-space-isolation-segment, set-space-role, space-quotas, spaces, target"`
+}
+
+// spaceSetupStep is one of the steps that configure a newly created space.
+type spaceSetupStep struct {
+	// run performs the step and displays its progress.
+	run func() error
+	// command is the command that performs the step by hand.
+	command string
 }
 
 func (cmd CreateSpaceCommand) Execute(args []string) error {
@@ -55,6 +72,11 @@ func (cmd CreateSpaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	securityGroups, err := cmd.lookUpConfiguration(orgGUID)
+	if err != nil {
+		return err
+	}
+
 	spaceName := cmd.RequiredArgs.Space
 
 	cmd.UI.DisplayTextWithFlavor("Creating space {{.Space}} in org {{.Organization}} as {{.User}}...",
@@ -76,54 +98,220 @@ func (cmd CreateSpaceCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	steps := cmd.setupSteps(space, orgName, orgGUID, user, securityGroups)
+	for i, step := range steps {
+		err = step.run()
+		if err != nil {
+			return cmd.handleSetupFailure(err, steps[i:], spaceName, orgName)
+		}
+	}
+
+	cmd.UI.DisplayText(`TIP: Use 'cf target -o "{{.Organization}}" -s "{{.Space}}"' to target new space`,
+		map[string]interface{}{
+			"Organization": orgName,
+			"Space":        spaceName,
+		})
+
+	return nil
+}
+
+// lookUpConfiguration checks that the quota, isolation segment and security
+// groups exist before the space is created, and returns the security groups.
+func (cmd CreateSpaceCommand) lookUpConfiguration(orgGUID string) ([]resources.SecurityGroup, error) {
 	if cmd.Quota != "" {
-		cmd.UI.DisplayTextWithFlavor("Setting space quota {{.Quota}} to space {{.Space}} as {{.User}}...",
-			map[string]interface{}{
-				"Quota": cmd.Quota,
-				"Space": spaceName,
-				"User":  user.Name,
-			})
+		_, warnings, err := cmd.Actor.GetSpaceQuotaByName(cmd.Quota, orgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		warnings, err = cmd.Actor.ApplySpaceQuotaByName(cmd.Quota, space.GUID, orgGUID)
+	if cmd.IsolationSegment != "" {
+		_, warnings, err := cmd.Actor.GetIsolationSegmentByName(cmd.IsolationSegment)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
-			return err
+			return nil, err
 		}
+	}
 
-		cmd.UI.DisplayOK()
+	var securityGroups []resources.SecurityGroup
+	for _, securityGroupName := range cmd.SecurityGroups {
+		securityGroup, warnings, err := cmd.Actor.GetSecurityGroup(securityGroupName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		securityGroups = append(securityGroups, securityGroup)
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Assigning role SpaceManager to user {{.User}} in org {{.Organization}} / space {{.Space}} as {{.User}}...",
-		map[string]interface{}{
-			"User":         user.Name,
-			"Space":        spaceName,
-			"Organization": orgName,
+	return securityGroups, nil
+}
+
+func (cmd CreateSpaceCommand) setupSteps(space resources.Space, orgName string, orgGUID string, user configv3.User, securityGroups []resources.SecurityGroup) []spaceSetupStep {
+	binaryName := cmd.Config.BinaryName()
+	var steps []spaceSetupStep
+
+	if cmd.Quota != "" {
+		steps = append(steps, spaceSetupStep{
+			command: fmt.Sprintf("%s set-space-quota %q %q", binaryName, space.Name, cmd.Quota),
+			run: func() error {
+				cmd.UI.DisplayTextWithFlavor("Setting space quota {{.Quota}} to space {{.Space}} as {{.User}}...",
+					map[string]interface{}{
+						"Quota": cmd.Quota,
+						"Space": space.Name,
+						"User":  user.Name,
+					})
+
+				warnings, err := cmd.Actor.ApplySpaceQuotaByName(cmd.Quota, space.GUID, orgGUID)
+				cmd.UI.DisplayWarnings(warnings)
+				if err != nil {
+					return err
+				}
+
+				cmd.UI.DisplayOK()
+				return nil
+			},
 		})
-	warnings, err = cmd.Actor.CreateSpaceRole(constant.SpaceManagerRole, orgGUID, space.GUID, user.Name, user.Origin, user.IsClient)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
 	}
-	cmd.UI.DisplayOK()
 
-	cmd.UI.DisplayTextWithFlavor("Assigning role SpaceDeveloper to user {{.User}} in org {{.Organization}} / space {{.Space}} as {{.User}}...",
-		map[string]interface{}{
-			"User":         user.Name,
-			"Space":        spaceName,
-			"Organization": orgName,
+	if cmd.IsolationSegment != "" {
+		steps = append(steps, spaceSetupStep{
+			command: fmt.Sprintf("%s set-space-isolation-segment %q %q", binaryName, space.Name, cmd.IsolationSegment),
+			run: func() error {
+				cmd.UI.DisplayTextWithFlavor("Setting isolation segment {{.IsolationSegment}} to space {{.Space}} as {{.User}}...",
+					map[string]interface{}{
+						"IsolationSegment": cmd.IsolationSegment,
+						"Space":            space.Name,
+						"User":             user.Name,
+					})
+
+				warnings, err := cmd.Actor.AssignIsolationSegmentToSpaceByNameAndSpace(cmd.IsolationSegment, space.GUID)
+				cmd.UI.DisplayWarnings(warnings)
+				if err != nil {
+					return err
+				}
+
+				cmd.UI.DisplayOK()
+				return nil
+			},
 		})
-	warnings, err = cmd.Actor.CreateSpaceRole(constant.SpaceDeveloperRole, orgGUID, space.GUID, user.Name, user.Origin, user.IsClient)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
 	}
-	cmd.UI.DisplayOK()
 
-	cmd.UI.DisplayText(`TIP: Use 'cf target -o "{{.Organization}}" -s "{{.Space}}"' to target new space`,
+	for _, securityGroup := range securityGroups {
+		securityGroup := securityGroup
+		steps = append(steps, spaceSetupStep{
+			command: fmt.Sprintf("%s bind-security-group %q %q --space %q", binaryName, securityGroup.Name, orgName, space.Name),
+			run: func() error {
+				cmd.UI.DisplayTextWithFlavor("Assigning running security group {{.SecurityGroup}} to space {{.Space}} in org {{.Organization}} as {{.User}}...",
+					map[string]interface{}{
+						"SecurityGroup": securityGroup.Name,
+						"Space":         space.Name,
+						"Organization":  orgName,
+						"User":          user.Name,
+					})
+
+				warnings, err := cmd.Actor.BindSecurityGroupToSpaces(securityGroup.GUID, []resources.Space{space}, constant.SecurityGroupLifecycleRunning)
+				cmd.UI.DisplayWarnings(warnings)
+				if err != nil {
+					return err
+				}
+
+				cmd.UI.DisplayOK()
+				return nil
+			},
+		})
+	}
+
+	roleStep := func(roleType constant.RoleType, roleName string, userName string, origin string, isClient bool) spaceSetupStep {
+		return spaceSetupStep{
+			command: fmt.Sprintf("%s set-space-role %q %q %q %s", binaryName, userName, orgName, space.Name, roleName),
+			run: func() error {
+				cmd.UI.DisplayTextWithFlavor("Assigning role {{.Role}} to user {{.TargetUser}} in org {{.Organization}} / space {{.Space}} as {{.User}}...",
+					map[string]interface{}{
+						"Role":         roleName,
+						"TargetUser":   userName,
+						"User":         user.Name,
+						"Space":        space.Name,
+						"Organization": orgName,
+					})
+
+				warnings, err := cmd.Actor.CreateSpaceRole(roleType, orgGUID, space.GUID, userName, origin, isClient)
+				cmd.UI.DisplayWarnings(warnings)
+				if _, ok := err.(ccerror.RoleAlreadyExistsError); ok {
+					cmd.UI.DisplayWarning("User '{{.TargetUser}}' already has role '{{.Role}}' in org '{{.Organization}}' / space '{{.Space}}'.",
+						map[string]interface{}{
+							"Role":         roleName,
+							"TargetUser":   userName,
+							"Space":        space.Name,
+							"Organization": orgName,
+						})
+				} else if err != nil {
+					return err
+				}
+
+				cmd.UI.DisplayOK()
+				return nil
+			},
+		}
+	}
+
+	steps = append(steps,
+		roleStep(constant.SpaceManagerRole, "SpaceManager", user.Name, user.Origin, user.IsClient),
+		roleStep(constant.SpaceDeveloperRole, "SpaceDeveloper", user.Name, user.Origin, user.IsClient),
+	)
+	for _, manager := range cmd.Managers {
+		if manager != user.Name {
+			steps = append(steps, roleStep(constant.SpaceManagerRole, "SpaceManager", manager, "", false))
+		}
+	}
+	for _, developer := range cmd.Developers {
+		if developer != user.Name {
+			steps = append(steps, roleStep(constant.SpaceDeveloperRole, "SpaceDeveloper", developer, "", false))
+		}
+	}
+
+	return steps
+}
+
+// handleSetupFailure either deletes the space or lists the commands that
+// perform the remaining steps, and returns the error of the failed step.
+func (cmd CreateSpaceCommand) handleSetupFailure(setupErr error, remainingSteps []spaceSetupStep, spaceName string, orgName string) error {
+	cmd.UI.DisplayNewline()
+
+	if cmd.RollbackOnFailure {
+		cmd.UI.DisplayTextWithFlavor("Configuring space {{.Space}} failed. Deleting it...",
+			map[string]interface{}{
+				"Space": spaceName,
+			})
+
+		warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(spaceName, orgName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Space {{.Space}} could not be deleted: {{.Error}}",
+				map[string]interface{}{
+					"Space": spaceName,
+					"Error": err.Error(),
+				})
+		} else {
+			cmd.UI.DisplayOK()
+		}
+
+		return setupErr
+	}
+
+	cmd.UI.DisplayText("Space {{.Space}} was created, but configuring it failed. To finish configuring it, run:",
 		map[string]interface{}{
-			"Organization": orgName,
-			"Space":        spaceName,
+			"Space": spaceName,
+		})
+	cmd.UI.DisplayText("   {{.Command}}", map[string]interface{}{
+		"Command": fmt.Sprintf("%s target -o %q -s %q", cmd.Config.BinaryName(), orgName, spaceName),
+	})
+	for _, step := range remainingSteps {
+		cmd.UI.DisplayText("   {{.Command}}", map[string]interface{}{
+			"Command": step.command,
 		})
+	}
+	cmd.UI.DisplayNewline()
 
-	return nil
+	return setupErr
 }
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -34,6 +35,12 @@ var _ = Describe("create-space Command", func() {
 		orgName   string
 		userName  string
 		quotaName string
+
+		isolationSegment  string
+		securityGroups    []string
+		managers          []string
+		developers        []string
+		rollbackOnFailure bool
 	)
 
 	BeforeEach(func() {
@@ -50,6 +57,11 @@ var _ = Describe("create-space Command", func() {
 		orgName = ""
 		quotaName = ""
 		userName = "some-user-name"
+		isolationSegment = ""
+		securityGroups = nil
+		managers = nil
+		developers = nil
+		rollbackOnFailure = false
 
 		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
 			Name: "some-org-name",
@@ -77,6 +89,12 @@ var _ = Describe("create-space Command", func() {
 			RequiredArgs: flag.Space{Space: spaceName},
 			Organization: orgName,
 			Quota:        quotaName,
+
+			IsolationSegment:  isolationSegment,
+			SecurityGroups:    securityGroups,
+			Managers:          managers,
+			Developers:        developers,
+			RollbackOnFailure: rollbackOnFailure,
 		}
 
 		executeErr = cmd.Execute(nil)
@@ -366,4 +384,137 @@ var _ = Describe("create-space Command", func() {
 			Expect(testUI.Out).To(Say(`TIP: Use 'cf target -o "%s" -s "%s"' to target new space`, "some-org-name", spaceName))
 		})
 	})
+
+	When("configuring the whole space", func() {
+		BeforeEach(func() {
+			quotaName = "some-quota"
+			isolationSegment = "some-segment"
+			securityGroups = []string{"sg-1", "sg-2"}
+			managers = []string{"manager-1", userName}
+			developers = []string{"developer-1"}
+
+			fakeActor.GetSecurityGroupReturnsOnCall(0, resources.SecurityGroup{Name: "sg-1", GUID: "sg-1-guid"}, v7action.Warnings{"sg-warning"}, nil)
+			fakeActor.GetSecurityGroupReturnsOnCall(1, resources.SecurityGroup{Name: "sg-2", GUID: "sg-2-guid"}, nil, nil)
+		})
+
+		It("looks up the quota, isolation segment and security groups before creating the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			quota, passedOrgGUID := fakeActor.GetSpaceQuotaByNameArgsForCall(0)
+			Expect(quota).To(Equal(quotaName))
+			Expect(passedOrgGUID).To(Equal(orgGUID))
+			Expect(fakeActor.GetIsolationSegmentByNameArgsForCall(0)).To(Equal(isolationSegment))
+			Expect(fakeActor.GetSecurityGroupCallCount()).To(Equal(2))
+			Expect(fakeActor.GetSecurityGroupArgsForCall(0)).To(Equal("sg-1"))
+			Expect(fakeActor.GetSecurityGroupArgsForCall(1)).To(Equal("sg-2"))
+			Expect(testUI.Err).To(Say("sg-warning"))
+		})
+
+		It("configures the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.ApplySpaceQuotaByNameCallCount()).To(Equal(1))
+
+			segment, passedSpaceGUID := fakeActor.AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(0)
+			Expect(segment).To(Equal(isolationSegment))
+			Expect(passedSpaceGUID).To(Equal(spaceGUID))
+
+			Expect(fakeActor.BindSecurityGroupToSpacesCallCount()).To(Equal(2))
+			sgGUID, spaces, lifecycle := fakeActor.BindSecurityGroupToSpacesArgsForCall(1)
+			Expect(sgGUID).To(Equal("sg-2-guid"))
+			Expect(spaces).To(Equal([]resources.Space{{Name: spaceName, GUID: spaceGUID}}))
+			Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleRunning))
+
+			Expect(fakeActor.CreateSpaceRoleCallCount()).To(Equal(4))
+			roleType, _, _, givenUserName, givenOrigin, givenIsClient := fakeActor.CreateSpaceRoleArgsForCall(2)
+			Expect(roleType).To(Equal(constant.SpaceManagerRole))
+			Expect(givenUserName).To(Equal("manager-1"))
+			Expect(givenOrigin).To(BeEmpty())
+			Expect(givenIsClient).To(BeFalse())
+			roleType, _, _, givenUserName, _, _ = fakeActor.CreateSpaceRoleArgsForCall(3)
+			Expect(roleType).To(Equal(constant.SpaceDeveloperRole))
+			Expect(givenUserName).To(Equal("developer-1"))
+
+			Expect(testUI.Out).To(Say(`Setting isolation segment some-segment to space %s as %s\.\.\.`, spaceName, userName))
+			Expect(testUI.Out).To(Say(`Assigning running security group sg-1 to space %s in org some-org-name as %s\.\.\.`, spaceName, userName))
+			Expect(testUI.Out).To(Say(`Assigning role SpaceManager to user manager-1 in org some-org-name / space %s as %s\.\.\.`, spaceName, userName))
+			Expect(testUI.Out).To(Say(`Assigning role SpaceDeveloper to user developer-1 in org some-org-name / space %s as %s\.\.\.`, spaceName, userName))
+		})
+
+		When("a security group does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupReturnsOnCall(1, resources.SecurityGroup{}, nil, actionerror.SecurityGroupNotFoundError{Name: "sg-2"})
+			})
+
+			It("does not create the space", func() {
+				Expect(executeErr).To(MatchError(actionerror.SecurityGroupNotFoundError{Name: "sg-2"}))
+				Expect(fakeActor.CreateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a user already has a role", func() {
+			BeforeEach(func() {
+				fakeActor.CreateSpaceRoleReturnsOnCall(2, nil, ccerror.RoleAlreadyExistsError{})
+			})
+
+			It("warns and carries on", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say(`User 'manager-1' already has role 'SpaceManager' in org 'some-org-name' / space '%s'\.`, spaceName))
+				Expect(fakeActor.CreateSpaceRoleCallCount()).To(Equal(4))
+			})
+		})
+
+		When("a step fails", func() {
+			BeforeEach(func() {
+				fakeActor.BindSecurityGroupToSpacesReturnsOnCall(1, v7action.Warnings{"bind-warning"}, errors.New("bind-error"))
+			})
+
+			It("lists the commands that finish configuring the space", func() {
+				Expect(executeErr).To(MatchError("bind-error"))
+				Expect(testUI.Err).To(Say("bind-warning"))
+				Expect(fakeActor.CreateSpaceRoleCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say(`Space %s was created, but configuring it failed\. To finish configuring it, run:`, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman target -o "some-org-name" -s "%s"\n`, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman bind-security-group "sg-2" "some-org-name" --space "%s"\n`, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman set-space-role "%s" "some-org-name" "%s" SpaceManager\n`, userName, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman set-space-role "%s" "some-org-name" "%s" SpaceDeveloper\n`, userName, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman set-space-role "manager-1" "some-org-name" "%s" SpaceManager\n`, spaceName))
+				Expect(testUI.Out).To(Say(`   faceman set-space-role "developer-1" "some-org-name" "%s" SpaceDeveloper\n`, spaceName))
+				Expect(testUI.Out).NotTo(Say("sg-1"))
+			})
+
+			When("--rollback-on-failure is given", func() {
+				BeforeEach(func() {
+					rollbackOnFailure = true
+					fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v7action.Warnings{"delete-warning"}, nil)
+				})
+
+				It("deletes the space", func() {
+					Expect(executeErr).To(MatchError("bind-error"))
+					Expect(testUI.Out).To(Say(`Configuring space %s failed\. Deleting it\.\.\.`, spaceName))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+
+					Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(1))
+					deletedSpace, deletedOrg := fakeActor.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+					Expect(deletedSpace).To(Equal(spaceName))
+					Expect(deletedOrg).To(Equal("some-org-name"))
+					Expect(testUI.Out).NotTo(Say("To finish configuring it"))
+				})
+
+				When("deleting the space fails", func() {
+					BeforeEach(func() {
+						fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(nil, errors.New("delete-error"))
+					})
+
+					It("warns and returns the error of the failed step", func() {
+						Expect(executeErr).To(MatchError("bind-error"))
+						Expect(testUI.Err).To(Say(`Space %s could not be deleted: delete-error`, spaceName))
+					})
+				})
+			})
+		})
+	})
 })
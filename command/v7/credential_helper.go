@@ -0,0 +1,23 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . CredentialHelper
+
+// CredentialHelper supplies the credentials that would otherwise be prompted
+// for.
+type CredentialHelper interface {
+	Get(request credentialhelper.Request) (credentialhelper.Credentials, error)
+}
+
+// newCredentialHelper returns the credential helper set in the config, or nil
+// when none is set.
+func newCredentialHelper(config command.Config) CredentialHelper {
+	if config.CredentialHelper() == "" {
+		return nil
+	}
+	return credentialhelper.New(config.CredentialHelper())
+}
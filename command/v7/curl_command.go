@@ -2,8 +2,12 @@ package v7
 
 import (
 	"io/ioutil"
+	"net/http"
 	"net/http/httputil"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
@@ -18,18 +22,26 @@ type CurlCommand struct {
 	FailOnHTTPError        bool            `short:"f" long:"fail" description:"Server errors return exit code 22"`
 	IncludeResponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile             flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                  interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER]... [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	Target                 string          `long:"target" choice:"cc" choice:"uaa" choice:"routing" choice:"logcache" default:"cc" description:"API to send the request to, authenticated with the current token"`
+	usage                  interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER]... [-d DATA] [--output FILE] [--target cc|uaa|routing|logcache]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   The request is sent to the Cloud Controller unless --target selects the UAA,\n   the routing API or Log Cache of the targeted platform.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   CF_NAME curl \"/userinfo\" --target uaa\n   CF_NAME curl \"/routing/v1/router_groups\" --target routing\n   CF_NAME curl \"/api/v1/meta\" --target logcache"`
+
+	LogCacheClient v7action.CurlRequester
 }
 
-func (cmd CurlCommand) Execute(args []string) error {
-	responseBodyBytes, httpResponse, err := cmd.Actor.MakeCurlRequest(
-		cmd.HTTPMethod,
-		cmd.RequiredArgs.Path,
-		cmd.CustomHeaders,
-		string(cmd.HTTPData),
-		cmd.FailOnHTTPError,
-	)
+func (cmd *CurlCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
 
+	if v7action.CurlTarget(cmd.Target) == v7action.CurlTargetLogCache {
+		cmd.LogCacheClient, err = logcache.NewRawClient(config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	}
+	return err
+}
+
+func (cmd CurlCommand) Execute(args []string) error {
+	responseBodyBytes, httpResponse, err := cmd.makeRequest()
 	if err != nil {
 		return err
 	}
@@ -60,3 +72,26 @@ func (cmd CurlCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd CurlCommand) makeRequest() ([]byte, *http.Response, error) {
+	target := v7action.CurlTarget(cmd.Target)
+	if target == v7action.CurlTargetLogCache {
+		return cmd.Actor.MakeLogCacheCurlRequest(
+			cmd.LogCacheClient,
+			cmd.HTTPMethod,
+			cmd.RequiredArgs.Path,
+			cmd.CustomHeaders,
+			string(cmd.HTTPData),
+			cmd.FailOnHTTPError,
+		)
+	}
+
+	return cmd.Actor.MakeCurlRequest(
+		target,
+		cmd.HTTPMethod,
+		cmd.RequiredArgs.Path,
+		cmd.CustomHeaders,
+		string(cmd.HTTPData),
+		cmd.FailOnHTTPError,
+	)
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"os"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -73,7 +75,8 @@ var _ = Describe("curl Command", func() {
 
 	It("makes a request with the given flags", func() {
 		Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(1))
-		httpMethod, path, customHeaders, httpData, failOnHTTPError := fakeActor.MakeCurlRequestArgsForCall(0)
+		target, httpMethod, path, customHeaders, httpData, failOnHTTPError := fakeActor.MakeCurlRequestArgsForCall(0)
+		Expect(target).To(BeEmpty())
 		Expect(httpMethod).To(Equal(HTTPMethod))
 		Expect(path).To(Equal(cmd.RequiredArgs.Path))
 		Expect(customHeaders).To(Equal(CustomHeaders))
@@ -82,6 +85,42 @@ var _ = Describe("curl Command", func() {
 		Expect(executeErr).ToNot(HaveOccurred())
 	})
 
+	When("the UAA is targeted", func() {
+		BeforeEach(func() {
+			cmd.Target = "uaa"
+		})
+
+		It("makes the request to the UAA", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(1))
+			target, _, _, _, _, _ := fakeActor.MakeCurlRequestArgsForCall(0)
+			Expect(target).To(Equal(v7action.CurlTargetUAA))
+		})
+	})
+
+	When("Log Cache is targeted", func() {
+		var fakeLogCacheClient *v7actionfakes.FakeCurlRequester
+
+		BeforeEach(func() {
+			fakeLogCacheClient = new(v7actionfakes.FakeCurlRequester)
+			cmd.Target = "logcache"
+			cmd.LogCacheClient = fakeLogCacheClient
+			fakeActor.MakeLogCacheCurlRequestReturns([]byte(`{"version":"2.0"}`), &http.Response{}, nil)
+		})
+
+		It("makes the request to Log Cache with the Log Cache client", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(0))
+			Expect(fakeActor.MakeLogCacheCurlRequestCallCount()).To(Equal(1))
+
+			client, httpMethod, path, _, _, _ := fakeActor.MakeLogCacheCurlRequestArgsForCall(0)
+			Expect(client).To(Equal(fakeLogCacheClient))
+			Expect(httpMethod).To(Equal(HTTPMethod))
+			Expect(path).To(Equal(cmd.RequiredArgs.Path))
+			Expect(testUI.Out).To(Say(`{"version":"2.0"}`))
+		})
+	})
+
 	When("the verbose flag is set", func() {
 		BeforeEach(func() {
 			fakeConfig.VerboseReturns(true, nil)
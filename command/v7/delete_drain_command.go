@@ -0,0 +1,80 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+type DeleteDrainCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.DrainName `positional-args:"yes"`
+	Force           bool           `short:"f" long:"force" description:"Force deletion without confirmation"`
+	usage           interface{}    `usage:"CF_NAME delete-drain DRAIN_NAME [-f]\n\n   Unbinds the drain from its apps and deletes its user-provided service instance."`
+	relatedCommands interface{}    `related_commands:"create-drain, drains"`
+}
+
+func (cmd DeleteDrainCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteDrain, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the drain {{.DrainName}}?", cmd.drainName())
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteDrain {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting drain {{.DrainName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"DrainName": cmd.RequiredArgs.DrainName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	_, warnings, err := cmd.Actor.GetDrainByNameAndSpace(cmd.RequiredArgs.DrainName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.DrainNotFoundError:
+		cmd.UI.DisplayText("Drain {{.DrainName}} does not exist.", cmd.drainName())
+		cmd.UI.DisplayOK()
+		return nil
+	default:
+		return err
+	}
+
+	stream, warnings, err := cmd.Actor.DeleteServiceInstance(cmd.RequiredArgs.DrainName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd DeleteDrainCommand) drainName() map[string]interface{} {
+	return map[string]interface{}{
+		"DrainName": cmd.RequiredArgs.DrainName,
+	}
+}
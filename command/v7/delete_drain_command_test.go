@@ -0,0 +1,168 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-drain Command", func() {
+	var (
+		cmd             DeleteDrainCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = DeleteDrainCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		cmd.RequiredArgs.DrainName = "some-drain"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetDrainByNameAndSpaceReturns(
+			v7action.Drain{Name: "some-drain"},
+			v7action.Warnings{"get-drain-warning"},
+			nil,
+		)
+		fakeActor.DeleteServiceInstanceReturns(nil, v7action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("deletes the drain's service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Really delete the drain some-drain\?`))
+			Expect(testUI.Out).To(Say(`Deleting drain some-drain in org some-org / space some-space as some-user\.\.\.`))
+
+			Expect(fakeActor.GetDrainByNameAndSpaceCallCount()).To(Equal(1))
+			drainName, spaceGUID := fakeActor.GetDrainByNameAndSpaceArgsForCall(0)
+			Expect(drainName).To(Equal("some-drain"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+			instanceName, spaceGUID := fakeActor.DeleteServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-drain"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-drain-warning"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+
+	When("the user declines the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not delete the drain", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.GetDrainByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the force flag is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the drain without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Really delete"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		When("the drain does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetDrainByNameAndSpaceReturns(
+					v7action.Drain{},
+					v7action.Warnings{"get-drain-warning"},
+					actionerror.DrainNotFoundError{Name: "some-drain"},
+				)
+			})
+
+			It("says the drain does not exist and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Drain some-drain does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-drain-warning"))
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the drain fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetDrainByNameAndSpaceReturns(v7action.Drain{}, nil, errors.New("get-drain-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("get-drain-error"))
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceReturns(nil, v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+	})
+})
@@ -2,6 +2,8 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 )
 
@@ -10,8 +12,9 @@ type DeleteOrgCommand struct {
 
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	Force           bool              `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
-	relatedCommands interface{}       `related_commands:"create-org, orgs, quotas, set-org-role"`
+	NoWait          bool              `long:"no-wait" description:"Exit once the deletion has started, showing the job to follow it with"`
+	usage           interface{}       `usage:"CF_NAME delete-org ORG [-f] [--no-wait]"`
+	relatedCommands interface{}       `related_commands:"create-org, job, orgs, quotas, set-org-role"`
 }
 
 func (cmd *DeleteOrgCommand) Execute(args []string) error {
@@ -46,7 +49,15 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	var (
+		jobGUID  string
+		warnings v7action.Warnings
+	)
+	if cmd.NoWait {
+		jobGUID, warnings, err = cmd.Actor.StartDeleteOrganization(cmd.RequiredArgs.Organization)
+	} else {
+		warnings, err = cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -61,6 +72,10 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if jobGUID != "" {
+		displayDeletionJob(cmd.UI, cmd.Config.BinaryName(), jobGUID)
+	}
+
 	if cmd.Config.TargetedOrganization().Name == cmd.RequiredArgs.Organization {
 		cmd.UI.DisplayText("TIP: No org or space targeted, use '{{.CfTargetCommand}}' to target an org and space.",
 			map[string]interface{}{"CfTargetCommand": cmd.Config.BinaryName() + " target -o ORG -s SPACE"})
@@ -69,3 +84,14 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// displayDeletionJob tells the user which job deletes the resource in the
+// background and how to follow it.
+func displayDeletionJob(ui command.UI, binaryName string, jobGUID string) {
+	ui.DisplayText("The deletion continues in the background as job {{.JobGUID}}.", map[string]interface{}{
+		"JobGUID": jobGUID,
+	})
+	ui.DisplayText("TIP: Use '{{.JobCommand}}' to wait for it to finish.", map[string]interface{}{
+		"JobCommand": binaryName + " job " + jobGUID + " --wait",
+	})
+}
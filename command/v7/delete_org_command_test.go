@@ -119,6 +119,43 @@ var _ = Describe("delete-org Command", func() {
 						})
 					})
 
+					When("the '--no-wait' flag is provided", func() {
+						BeforeEach(func() {
+							cmd.NoWait = true
+							fakeActor.StartDeleteOrganizationReturns("some-job-guid", v7action.Warnings{"warning-1", "warning-2"}, nil)
+						})
+
+						It("starts deleting the org and displays the job to follow it with", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.StartDeleteOrganizationCallCount()).To(Equal(1))
+							Expect(fakeActor.StartDeleteOrganizationArgsForCall(0)).To(Equal("some-org"))
+
+							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say("The deletion continues in the background as job some-job-guid."))
+							Expect(testUI.Out).To(Say("TIP: Use 'faceman job some-job-guid --wait' to wait for it to finish."))
+
+							Expect(testUI.Err).To(Say("warning-1"))
+							Expect(testUI.Err).To(Say("warning-2"))
+						})
+
+						When("the organization does not exist", func() {
+							BeforeEach(func() {
+								fakeActor.StartDeleteOrganizationReturns("", nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+							})
+
+							It("displays that the org does not exist without a job", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Err).To(Say(`Org 'some-org' does not exist\.`))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).ToNot(Say("job"))
+							})
+						})
+					})
+
 					When("an error is encountered deleting the org", func() {
 						When("the organization does not exist", func() {
 							BeforeEach(func() {
@@ -2,6 +2,7 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
 )
 
@@ -11,7 +12,8 @@ type DeleteSpaceCommand struct {
 	RequiredArgs flag.Space  `positional-args:"yes"`
 	Force        bool        `short:"f" description:"Force deletion without confirmation"`
 	Org          string      `short:"o" description:"Delete space within specified org"`
-	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f]"`
+	NoWait       bool        `long:"no-wait" description:"Exit once the deletion has started, showing the job to follow it with"`
+	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f] [--no-wait]"`
 }
 
 func (cmd DeleteSpaceCommand) Execute(args []string) error {
@@ -62,7 +64,15 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 			"CurrentUser": user.Name,
 		})
 
-	warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	var (
+		jobGUID  string
+		warnings v7action.Warnings
+	)
+	if cmd.NoWait {
+		jobGUID, warnings, err = cmd.Actor.StartDeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	} else {
+		warnings, err = cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -77,6 +87,10 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if jobGUID != "" {
+		displayDeletionJob(cmd.UI, cmd.Config.BinaryName(), jobGUID)
+	}
+
 	if cmd.Config.TargetedOrganization().Name == orgName &&
 		cmd.Config.TargetedSpace().Name == cmd.RequiredArgs.Space {
 		cmd.Config.UnsetSpaceInformation()
@@ -158,6 +158,36 @@ var _ = Describe("delete-space Command", func() {
 							})
 						})
 
+						When("the '--no-wait' flag is provided", func() {
+							BeforeEach(func() {
+								cmd.NoWait = true
+								fakeActor.StartDeleteSpaceByNameAndOrganizationNameReturns("some-job-guid", v7action.Warnings{"warning-1", "warning-2"}, nil)
+								fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+								fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+							})
+
+							It("starts deleting the space, displays the job to follow it with and untargets the space", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+								Expect(fakeActor.StartDeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(1))
+								spaceArg, orgArg := fakeActor.StartDeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+								Expect(spaceArg).To(Equal("some-space"))
+								Expect(orgArg).To(Equal("some-org"))
+
+								Expect(testUI.Out).To(Say(`Deleting space some-space in org some-org as some-user\.\.\.`))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).To(Say("The deletion continues in the background as job some-job-guid."))
+								Expect(testUI.Out).To(Say("TIP: Use 'faceman job some-job-guid --wait' to wait for it to finish."))
+								Expect(testUI.Out).To(Say("TIP: No space targeted, use 'faceman target -s' to target a space."))
+
+								Expect(testUI.Err).To(Say("warning-1"))
+								Expect(testUI.Err).To(Say("warning-2"))
+
+								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+							})
+						})
+
 						When("the user was NOT targeted to the space", func() {
 							BeforeEach(func() {
 								fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
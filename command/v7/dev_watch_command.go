@@ -0,0 +1,66 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/filewatch"
+)
+
+// devWatchDebounce is how long no file must change before dev-watch pushes
+// again when --debounce is not given.
+const devWatchDebounce = time.Second
+
+type DevWatchCommand struct {
+	BaseCommand
+
+	OptionalArgs    flag.OptionalAppName                `positional-args:"yes"`
+	Debounce        flag.Duration                       `long:"debounce" description:"Push again once no file has changed for this long, e.g. 3s (Default: 1s)"`
+	Ignore          []string                            `long:"ignore" description:"Do not push again when only files matching this pattern change, in .cfignore syntax; can specify multiple times"`
+	PathToManifest  flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
+	AppPath         flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory"`
+	usage           interface{}                         `usage:"CF_NAME dev-watch [APP_NAME] [-f MANIFEST_PATH] [-p PATH] [--debounce DURATION] [--ignore PATTERN]...\n\n   Pushes the app, then watches its directory and pushes it again whenever its files change.\n   Only the changed files are uploaded, and the instances are replaced one at a time with a\n   rolling deployment, so the app keeps serving while you iterate. Stop with Ctrl-C.\n\nEXAMPLES:\n   CF_NAME dev-watch my-app\n   CF_NAME dev-watch my-app -p ./src --ignore '*.log' --ignore node_modules/ --debounce 3s"`
+	relatedCommands interface{}                         `related_commands:"push, rollout-status"`
+
+	Push PushCommand `no-flag:"true"`
+}
+
+func (cmd *DevWatchCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Push.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	watcher := filewatch.NewWatcher(watchInterval)
+	watcher.IgnoreFileName = ".cfignore"
+	watcher.IgnoreLines = append(append([]string{}, sharedaction.DefaultIgnoreLines...), cmd.Ignore...)
+	watcher.Debounce = devWatchDebounce
+	if cmd.Debounce.IsSet {
+		watcher.Debounce = cmd.Debounce.Value
+	}
+	cmd.Push.FileWatcher = watcher
+
+	return nil
+}
+
+// Execute pushes the app with a rolling deployment of one instance at a time
+// and keeps pushing it again whenever its files change.
+func (cmd DevWatchCommand) Execute(args []string) error {
+	push := cmd.Push
+	push.OptionalArgs = cmd.OptionalArgs
+	push.PathToManifest = cmd.PathToManifest
+	push.AppPath = cmd.AppPath
+	push.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling, IsSet: true}
+	push.MaxInFlight = flag.PositiveInteger{Value: 1}
+	push.Watch = true
+
+	return push.Execute(args)
+}
@@ -0,0 +1,117 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("dev-watch Command", func() {
+	var (
+		cmd             DevWatchCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakePushActor   *v7fakes.FakePushActor
+		fakeFileWatcher *v7fakes.FakeFileWatcher
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor := new(v7fakes.FakeActor)
+		fakePushActor = new(v7fakes.FakePushActor)
+		fakeManifestLocator := new(v7fakes.FakeManifestLocator)
+		fakeManifestParser := new(v7fakes.FakeManifestParser)
+		fakeFileWatcher = new(v7fakes.FakeFileWatcher)
+
+		baseCommand := BaseCommand{
+			SharedActor: fakeSharedActor,
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+		}
+		cmd = DevWatchCommand{
+			BaseCommand:  baseCommand,
+			OptionalArgs: flag.OptionalAppName{AppName: "some-app"},
+			AppPath:      "/some/app",
+			Push: PushCommand{
+				BaseCommand:     baseCommand,
+				PushActor:       fakePushActor,
+				VersionActor:    new(v7fakes.FakeV7ActorForPush),
+				ProgressBar:     new(v7fakes.FakeProgressBar),
+				CWD:             "/some/app",
+				ManifestLocator: fakeManifestLocator,
+				ManifestParser:  fakeManifestParser,
+				DiffDisplayer:   new(v7fakes.FakeDiffDisplayer),
+				FileWatcher:     fakeFileWatcher,
+			},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeManifestLocator.PathReturns("", false, nil)
+		fakePushActor.HandleFlagOverridesReturns(manifestparser.Manifest{
+			Applications: []manifestparser.Application{{Name: "some-app"}},
+		}, nil)
+		fakeManifestParser.MarshalManifestReturns([]byte("some-manifest"), nil)
+		fakePushActor.CreatePushPlansReturns([]v7pushaction.PushPlan{
+			{Application: resources.Application{Name: "some-app", GUID: "some-app-guid"}, BitsPath: "/some/app"},
+		}, nil, nil)
+		fakePushActor.ActualizeStub = func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+			return FillInEvents([]Step{})
+		}
+
+		changes := make(chan []string)
+		close(changes)
+		fakeFileWatcher.WatchReturns(changes, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("pushes the app with a rolling deployment of one instance at a time", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakePushActor.CreatePushPlansCallCount()).To(Equal(1))
+		_, _, _, overrides := fakePushActor.CreatePushPlansArgsForCall(0)
+		Expect(overrides.AppName).To(Equal("some-app"))
+		Expect(overrides.ProvidedAppPath).To(Equal("/some/app"))
+		Expect(overrides.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+		Expect(overrides.MaxInFlight).To(Equal(1))
+	})
+
+	It("watches the app directory", func() {
+		Expect(fakeFileWatcher.WatchCallCount()).To(Equal(1))
+		paths, _ := fakeFileWatcher.WatchArgsForCall(0)
+		Expect(paths).To(Equal([]string{"/some/app"}))
+		Expect(testUI.Out).To(Say(`Watching /some/app for changes\. Press Ctrl-C to stop\.`))
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeFileWatcher.WatchCallCount()).To(Equal(0))
+		})
+	})
+})
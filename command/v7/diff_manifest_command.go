@@ -0,0 +1,138 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/projectconfig"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+type DiffManifestCommand struct {
+	BaseCommand
+
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	PathsToOverlays  []flag.PathWithExistenceCheck       `long:"overlay" description:"Path to a manifest merged over the manifest before variable substitution, like with push; can specify multiple times"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	RedactEnv        bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	usage            interface{}                         `usage:"CF_NAME diff-manifest [-f APP_MANIFEST_PATH] [--overlay OVERLAY_PATH]... [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]... [--redact-env]\n\n   Show how the apps of the space would change if the manifest were applied with apply-manifest, or pushed without flags that override it, without changing anything. Lines prefixed with + are added and lines prefixed with - are removed."`
+	relatedCommands  interface{}                         `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+
+	DiffDisplayer DiffDisplayer
+	CWD           string
+}
+
+func (cmd *DiffManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{
+		UI:        ui,
+		RedactEnv: cmd.RedactEnv,
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd DiffManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	config, found, err := projectconfig.Load(cmd.CWD)
+	if err != nil {
+		return err
+	}
+	if found {
+		applied, err := applyProjectManifestDefaults(config, &cmd.PathToManifest, &cmd.PathsToVarsFiles)
+		if err != nil {
+			return err
+		}
+		if applied {
+			cmd.UI.DisplayText("Using push defaults from {{.Path}}", map[string]interface{}{
+				"Path": config.Path,
+			})
+		}
+	}
+
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} with the apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	})
+
+	interpolatedManifestBytes, err := interpolateManifest(cmd.ManifestParser, pathToManifest, cmd.PathsToOverlays, cmd.PathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, interpolatedManifestBytes)
+	if err != nil {
+		if _, ok := err.(*yaml.TypeError); ok {
+			return errors.New("Unable to compare manifest because its format is invalid.")
+		}
+		return err
+	}
+
+	manifestBytes, err := cmd.ManifestParser.MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	diff, warnings, err := cmd.Actor.DiffSpaceManifest(cmd.Config.TargetedSpace().GUID, manifestBytes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(diff.Diffs) == 0 {
+		cmd.UI.DisplayText("No changes: the apps in the space already match the manifest.")
+	} else {
+		err = cmd.DiffDisplayer.DisplayDiff(manifestBytes, diff)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-manifest Command", func() {
+	var (
+		cmd               DiffManifestCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v7fakes.FakeActor
+		fakeParser        *v7fakes.FakeManifestParser
+		fakeLocator       *v7fakes.FakeManifestLocator
+		fakeDiffDisplayer *v7fakes.FakeDiffDisplayer
+		binaryName        string
+		executeErr        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeDiffDisplayer = new(v7fakes.FakeDiffDisplayer)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DiffManifestCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			DiffDisplayer:   fakeDiffDisplayer,
+			CWD:             "fake-directory",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		})
+
+		When("the manifest location is specified with `-f`", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = "some-manifest-path"
+			})
+
+			It("tries to locate the manifest file at the given path", func() {
+				Expect(fakeLocator.PathCallCount()).To(Equal(1))
+				Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some-manifest-path"))
+			})
+		})
+
+		When("the manifest file does not exist in the current directory", func() {
+			BeforeEach(func() {
+				fakeLocator.PathReturns("", false, nil)
+			})
+
+			It("returns a descriptive error", func() {
+				Expect(fakeLocator.PathArgsForCall(0)).To(Equal("fake-directory"))
+				Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{
+					PathToManifest: "fake-directory",
+				}))
+			})
+		})
+
+		When("the manifest file exists", func() {
+			var resolvedPath = "/fake/manifest.yml"
+
+			BeforeEach(func() {
+				cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+				cmd.Vars = []template.VarKV{{Name: "o", Value: "nice"}}
+				fakeLocator.PathReturns(resolvedPath, true, nil)
+				fakeParser.InterpolateManifestReturns([]byte("interpolated!"), nil)
+				fakeParser.MarshalManifestReturns([]byte("manifesto"), nil)
+			})
+
+			When("the apps in the space differ from the manifest", func() {
+				var expectedDiff resources.ManifestDiff
+
+				BeforeEach(func() {
+					expectedDiff = resources.ManifestDiff{
+						Diffs: []resources.Diff{
+							{Op: resources.AddOperation, Path: "/path/to/field", Value: "hello"},
+						},
+					}
+					fakeActor.DiffSpaceManifestReturns(expectedDiff, v7action.Warnings{"some-diff-warning"}, nil)
+				})
+
+				It("displays the diff without applying the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Comparing manifest %s with the apps in org some-org / space some-space as steve...", regexp.QuoteMeta(resolvedPath)))
+					Expect(testUI.Err).To(Say("some-diff-warning"))
+					Expect(testUI.Out).To(Say("OK"))
+
+					path, varsFiles, vars := fakeParser.InterpolateManifestArgsForCall(0)
+					Expect(path).To(Equal(resolvedPath))
+					Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+					Expect(vars).To(Equal([]template.VarKV{{Name: "o", Value: "nice"}}))
+
+					path, rawManifest := fakeParser.ParseManifestArgsForCall(0)
+					Expect(path).To(Equal(resolvedPath))
+					Expect(rawManifest).To(Equal([]byte("interpolated!")))
+
+					Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(1))
+					spaceGUID, manifestBytes := fakeActor.DiffSpaceManifestArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(manifestBytes).To(Equal([]byte("manifesto")))
+
+					Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+					manifestBytes, diff := fakeDiffDisplayer.DisplayDiffArgsForCall(0)
+					Expect(manifestBytes).To(Equal([]byte("manifesto")))
+					Expect(diff).To(Equal(expectedDiff))
+
+					Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("overlays are given", func() {
+				BeforeEach(func() {
+					cmd.PathsToOverlays = []flag.PathWithExistenceCheck{"overlay.yml"}
+					fakeParser.InterpolateManifestWithOverlaysReturns([]byte("overlaid!"), nil)
+				})
+
+				It("merges them over the manifest before interpolating it, like push", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(0))
+					path, overlays, varsFiles, vars := fakeParser.InterpolateManifestWithOverlaysArgsForCall(0)
+					Expect(path).To(Equal(resolvedPath))
+					Expect(overlays).To(Equal([]string{"overlay.yml"}))
+					Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+					Expect(vars).To(Equal([]template.VarKV{{Name: "o", Value: "nice"}}))
+
+					_, rawManifest := fakeParser.ParseManifestArgsForCall(0)
+					Expect(rawManifest).To(Equal([]byte("overlaid!")))
+				})
+			})
+
+			When("the apps in the space match the manifest", func() {
+				It("says there are no changes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("No changes: the apps in the space already match the manifest."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the manifest is unparseable", func() {
+				BeforeEach(func() {
+					fakeParser.ParseManifestReturns(manifestparser.Manifest{}, &yaml.TypeError{
+						Errors: []string{"oooooh nooooos"},
+					})
+				})
+
+				It("returns a descriptive error", func() {
+					Expect(executeErr).To(MatchError("Unable to compare manifest because its format is invalid."))
+					Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("getting the diff fails", func() {
+				BeforeEach(func() {
+					fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"some-diff-warning"}, errors.New("some-diff-error"))
+				})
+
+				It("returns the error with the warnings", func() {
+					Expect(executeErr).To(MatchError("some-diff-error"))
+					Expect(testUI.Err).To(Say("some-diff-warning"))
+					Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+				})
+			})
+
+			When("displaying the diff fails", func() {
+				BeforeEach(func() {
+					fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{
+						Diffs: []resources.Diff{{Op: resources.RemoveOperation, Path: "/applications/0/instances"}},
+					}, nil, nil)
+					fakeDiffDisplayer.DisplayDiffReturns(errors.New("some-display-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-display-error"))
+				})
+			})
+		})
+	})
+})
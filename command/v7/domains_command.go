@@ -17,6 +17,10 @@ type DomainsCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter domains by labels"`
 }
 
+func (DomainsCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd DomainsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -0,0 +1,142 @@
+package v7
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DownloadSBOMCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName    `positional-args:"yes"`
+	Droplet         string          `long:"droplet" description:"The guid of the droplet whose SBOM to download (default: app's current droplet)."`
+	Format          flag.SBOMFormat `long:"format" choice:"cyclonedx" choice:"spdx" default:"cyclonedx" description:"Format of the SBOM"`
+	Path            string          `long:"path" short:"p" description:"File or directory path to download the SBOM to (default: current working directory)."`
+	usage           interface{}     `usage:"CF_NAME download-sbom APP_NAME [--droplet DROPLET_GUID] [--format (cyclonedx | spdx)] [--path /path/to/sbom.json]\n\nTIP:\nSBOMs are read from the droplet, where Cloud Native Buildpacks store one for each layer they contribute. When the droplet contains several SBOMs, each is written to its own file in the --path directory."`
+	relatedCommands interface{}     `related_commands:"droplets, download-droplet"`
+}
+
+func (cmd DownloadSBOMCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		sboms       []v7action.SBOM
+		dropletGUID string
+		warnings    v7action.Warnings
+	)
+
+	format := v7action.SBOMFormat(cmd.Format)
+	if format == "" {
+		format = v7action.SBOMFormatCycloneDX
+	}
+
+	if cmd.Droplet != "" {
+		dropletGUID = cmd.Droplet
+
+		cmd.UI.DisplayTextWithFlavor("Downloading SBOM of droplet {{.DropletGUID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"DropletGUID": dropletGUID,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"Username":    user.Name,
+		})
+
+		sboms, warnings, err = cmd.Actor.DownloadDropletSBOMsByGUIDAndAppName(dropletGUID, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, format)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Downloading SBOM of current droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		sboms, dropletGUID, warnings, err = cmd.Actor.DownloadCurrentDropletSBOMsByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, format)
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); ok {
+			return translatableerror.NoDropletForAppError{AppName: cmd.RequiredArgs.AppName, DropletGUID: cmd.Droplet}
+		}
+		return err
+	}
+
+	dir, pathToSBOM, err := cmd.sbomDestination(len(sboms))
+	if err != nil {
+		return err
+	}
+
+	for _, sbom := range sboms {
+		if dir != "" {
+			pathToSBOM = filepath.Join(dir, sbomFileName(dropletGUID, format, sbom.Path, len(sboms) > 1))
+		}
+
+		err = ioutil.WriteFile(pathToSBOM, sbom.Content, 0666)
+		if err != nil {
+			return translatableerror.SBOMFileError{Err: err}
+		}
+
+		cmd.UI.DisplayText("SBOM downloaded successfully at {{.FilePath}}", map[string]interface{}{
+			"FilePath": pathToSBOM,
+		})
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// sbomDestination returns either the directory to write the SBOMs to or, when
+// --path names a file, the path of that file.
+func (cmd DownloadSBOMCommand) sbomDestination(count int) (string, string, error) {
+	if cmd.Path == "" {
+		currentDir, err := os.Getwd()
+		return currentDir, "", err
+	}
+
+	stats, err := os.Stat(cmd.Path)
+	if err == nil && stats.IsDir() {
+		return cmd.Path, "", nil
+	}
+
+	if count > 1 {
+		return "", "", translatableerror.SBOMPathNotDirectoryError{Path: cmd.Path, Count: count}
+	}
+	return "", cmd.Path, nil
+}
+
+// sbomFileName names the file an SBOM is written to. When a droplet contains
+// several SBOMs, the buildpack layer the SBOM describes is part of the name.
+func sbomFileName(dropletGUID string, format v7action.SBOMFormat, sbomPath string, withLayer bool) string {
+	extension := "cdx.json"
+	if format == v7action.SBOMFormatSPDX {
+		extension = "spdx.json"
+	}
+
+	if !withLayer {
+		return fmt.Sprintf("sbom_%s.%s", dropletGUID, extension)
+	}
+
+	layer := path.Dir(sbomPath)
+	if i := strings.Index(layer, "sbom/"); i >= 0 {
+		layer = layer[i+len("sbom/"):]
+	}
+	return fmt.Sprintf("sbom_%s_%s.%s", dropletGUID, strings.Replace(layer, "/", "_", -1), extension)
+}
@@ -0,0 +1,256 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-sbom Command", func() {
+	var (
+		cmd             DownloadSBOMCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		tmpDir          string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = DownloadSBOMCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "sboms")
+		Expect(err).NotTo(HaveOccurred())
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Path = tmpDir
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(
+			configv3.User{Name: "some-user"},
+			nil)
+		fakeActor.DownloadCurrentDropletSBOMsByAppNameReturns(
+			[]v7action.SBOM{{Path: "layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json", Content: []byte("some-sbom")}},
+			"some-droplet-guid",
+			v7action.Warnings{"some-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("downloading the SBOM of the current droplet succeeds", func() {
+		It("writes a CycloneDX SBOM to the given directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.DownloadCurrentDropletSBOMsByAppNameCallCount()).To(Equal(1))
+			appArg, spaceGUIDArg, formatArg := fakeActor.DownloadCurrentDropletSBOMsByAppNameArgsForCall(0)
+			Expect(appArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(formatArg).To(Equal(v7action.SBOMFormatCycloneDX))
+
+			fileContents, err := ioutil.ReadFile(filepath.Join(tmpDir, "sbom_some-droplet-guid.cdx.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-sbom"))
+		})
+
+		It("displays the file it created and returns no errors", func() {
+			Expect(testUI.Out).To(Say("Downloading SBOM of current droplet for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Err).To(Say("some-warning"))
+			pathRegExp := regexp.QuoteMeta(filepath.Join(tmpDir, "sbom_some-droplet-guid.cdx.json"))
+			Expect(testUI.Out).To(Say(`SBOM downloaded successfully at %s`, pathRegExp))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the SPDX format is requested", func() {
+			BeforeEach(func() {
+				cmd.Format = "spdx"
+			})
+
+			It("writes an SPDX SBOM", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, formatArg := fakeActor.DownloadCurrentDropletSBOMsByAppNameArgsForCall(0)
+				Expect(formatArg).To(Equal(v7action.SBOMFormatSPDX))
+
+				_, err := os.Stat(filepath.Join(tmpDir, "sbom_some-droplet-guid.spdx.json"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	When("the droplet guid is passed in", func() {
+		BeforeEach(func() {
+			cmd.Droplet = "some-other-droplet-guid"
+			fakeActor.DownloadDropletSBOMsByGUIDAndAppNameReturns(
+				[]v7action.SBOM{{Path: "layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json", Content: []byte("some-other-sbom")}},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("downloads the SBOM of that droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.DownloadDropletSBOMsByGUIDAndAppNameCallCount()).To(Equal(1))
+			dropletGUIDArg, appArg, spaceGUIDArg, formatArg := fakeActor.DownloadDropletSBOMsByGUIDAndAppNameArgsForCall(0)
+			Expect(dropletGUIDArg).To(Equal("some-other-droplet-guid"))
+			Expect(appArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(formatArg).To(Equal(v7action.SBOMFormatCycloneDX))
+
+			fileContents, err := ioutil.ReadFile(filepath.Join(tmpDir, "sbom_some-other-droplet-guid.cdx.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-other-sbom"))
+
+			Expect(testUI.Out).To(Say("Downloading SBOM of droplet some-other-droplet-guid for app some-app in org some-org / space some-space as some-user..."))
+		})
+	})
+
+	When("a path to a file is passed in", func() {
+		BeforeEach(func() {
+			cmd.Path = filepath.Join(tmpDir, "my-sbom.json")
+		})
+
+		It("writes the SBOM to that file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			fileContents, err := ioutil.ReadFile(filepath.Join(tmpDir, "my-sbom.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-sbom"))
+		})
+	})
+
+	When("the droplet contains several SBOMs", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletSBOMsByAppNameReturns(
+				[]v7action.SBOM{
+					{Path: "layers/sbom/launch/some-buildpack/some-layer/sbom.cdx.json", Content: []byte("some-sbom")},
+					{Path: "layers/sbom/launch/other-buildpack/sbom.cdx.json", Content: []byte("other-sbom")},
+				},
+				"some-droplet-guid",
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("writes each SBOM to a file named after its layer", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			fileContents, err := ioutil.ReadFile(filepath.Join(tmpDir, "sbom_some-droplet-guid_launch_some-buildpack_some-layer.cdx.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-sbom"))
+
+			fileContents, err = ioutil.ReadFile(filepath.Join(tmpDir, "sbom_some-droplet-guid_launch_other-buildpack.cdx.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("other-sbom"))
+
+			pathRegExp := regexp.QuoteMeta(filepath.Join(tmpDir, "sbom_some-droplet-guid_launch_some-buildpack_some-layer.cdx.json"))
+			Expect(testUI.Out).To(Say(`SBOM downloaded successfully at %s`, pathRegExp))
+			pathRegExp = regexp.QuoteMeta(filepath.Join(tmpDir, "sbom_some-droplet-guid_launch_other-buildpack.cdx.json"))
+			Expect(testUI.Out).To(Say(`SBOM downloaded successfully at %s`, pathRegExp))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("a path to a file is passed in", func() {
+			BeforeEach(func() {
+				cmd.Path = filepath.Join(tmpDir, "my-sbom.json")
+			})
+
+			It("returns an SBOMPathNotDirectoryError and writes nothing", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SBOMPathNotDirectoryError{Path: cmd.Path, Count: 2}))
+
+				_, err := os.Stat(cmd.Path)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	When("a path to a file in an invalid directory is passed in", func() {
+		BeforeEach(func() {
+			cmd.Path = "not/exist/some-file.json"
+		})
+
+		It("returns an appropriate error", func() {
+			_, ok := executeErr.(translatableerror.SBOMFileError)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	When("the app does not have a current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletSBOMsByAppNameReturns(nil, "", v7action.Warnings{"some-warning"}, actionerror.DropletNotFoundError{})
+		})
+
+		It("displays warnings and returns an error", func() {
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(executeErr).To(MatchError(translatableerror.NoDropletForAppError{AppName: "some-app"}))
+		})
+	})
+
+	When("there is an error downloading the SBOM", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletSBOMsByAppNameReturns(nil, "", v7action.Warnings{"some-warning"}, errors.New("something went wrong"))
+		})
+
+		It("displays warnings and returns an error", func() {
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(executeErr).To(MatchError("something went wrong"))
+		})
+	})
+})
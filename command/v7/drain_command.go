@@ -0,0 +1,59 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DrainCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME drain APP_NAME"`
+	relatedCommands interface{}  `related_commands:"create-drain, delete-drain, drains, logs"`
+}
+
+func (cmd DrainCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting drains of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	drains, warnings, err := cmd.Actor.GetDrainsForSpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var appDrains []v7action.Drain
+	for _, drain := range drains {
+		for _, appName := range drain.Apps {
+			if appName == app.Name {
+				appDrains = append(appDrains, drain)
+				break
+			}
+		}
+	}
+
+	displayDrainsTable(cmd.UI, appDrains, false)
+	return nil
+}
@@ -0,0 +1,146 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("drain Command", func() {
+	var (
+		cmd             DrainCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = DrainCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{Name: "some-app", GUID: "some-app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetDrainsForSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app has drains", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(
+				[]v7action.Drain{
+					{Name: "drain-1", URL: "syslog://one.example.com", Type: "logs", Apps: []string{"other-app", "some-app"}},
+					{Name: "drain-2", URL: "syslog://two.example.com", Type: "logs", Apps: []string{"other-app"}},
+					{Name: "drain-3", URL: "https://three.example.com", Type: "all", Apps: []string{"some-app"}},
+				},
+				v7action.Warnings{"get-drains-warning"},
+				nil,
+			)
+		})
+
+		It("displays only the drains bound to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetDrainsForSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting drains of app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+url\s+type\n`))
+			Expect(testUI.Out).To(Say(`drain-1\s+syslog://one\.example\.com\s+logs\n`))
+			Expect(testUI.Out).To(Say(`drain-3\s+https://three\.example\.com\s+all\n`))
+			Expect(testUI.Out).NotTo(Say("drain-2"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-drains-warning"))
+		})
+	})
+
+	When("the app has no drains", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(
+				[]v7action.Drain{{Name: "drain-2", Apps: []string{"other-app"}}},
+				nil,
+				nil,
+			)
+		})
+
+		It("says no drains were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No drains found."))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{},
+				v7action.Warnings{"get-app-warning"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.GetDrainsForSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the drains fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(nil, v7action.Warnings{"get-drains-warning"}, errors.New("get-drains-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-drains-error"))
+			Expect(testUI.Err).To(Say("get-drains-warning"))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type DrainsCommand struct {
+	BaseCommand
+
+	usage           interface{} `usage:"CF_NAME drains"`
+	relatedCommands interface{} `related_commands:"create-drain, delete-drain, drain"`
+}
+
+func (cmd DrainsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting drains in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	drains, warnings, err := cmd.Actor.GetDrainsForSpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	displayDrainsTable(cmd.UI, drains, true)
+	return nil
+}
+
+func displayDrainsTable(commandUI command.UI, drains []v7action.Drain, showApps bool) {
+	if len(drains) == 0 {
+		commandUI.DisplayText("No drains found.")
+		return
+	}
+
+	headers := []string{
+		commandUI.TranslateText("name"),
+		commandUI.TranslateText("url"),
+		commandUI.TranslateText("type"),
+	}
+	if showApps {
+		headers = append(headers, commandUI.TranslateText("bound apps"))
+	}
+	table := [][]string{headers}
+
+	for _, drain := range drains {
+		row := []string{drain.Name, drain.URL, drain.Type}
+		if showApps {
+			row = append(row, strings.Join(drain.Apps, ", "))
+		}
+		table = append(table, row)
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
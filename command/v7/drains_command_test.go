@@ -0,0 +1,129 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("drains Command", func() {
+	var (
+		cmd             DrainsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = DrainsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("get-user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get-user-error"))
+		})
+	})
+
+	When("there are drains in the space", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(
+				[]v7action.Drain{
+					{Name: "drain-1", URL: "syslog://one.example.com", Type: "logs", Apps: []string{"app-1", "app-2"}},
+					{Name: "drain-2", URL: "https://two.example.com?drain-type=metrics", Type: "metrics"},
+				},
+				v7action.Warnings{"get-drains-warning"},
+				nil,
+			)
+		})
+
+		It("displays the drains with their bound apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetDrainsForSpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetDrainsForSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting drains in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+url\s+type\s+bound apps`))
+			Expect(testUI.Out).To(Say(`drain-1\s+syslog://one\.example\.com\s+logs\s+app-1, app-2`))
+			Expect(testUI.Out).To(Say(`drain-2\s+https://two\.example\.com\?drain-type=metrics\s+metrics`))
+			Expect(testUI.Err).To(Say("get-drains-warning"))
+		})
+	})
+
+	When("there are no drains in the space", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(nil, v7action.Warnings{"get-drains-warning"}, nil)
+		})
+
+		It("says no drains were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No drains found."))
+			Expect(testUI.Out).NotTo(Say("name"))
+		})
+	})
+
+	When("getting the drains fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDrainsForSpaceReturns(nil, v7action.Warnings{"get-drains-warning"}, errors.New("get-drains-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-drains-error"))
+			Expect(testUI.Err).To(Say("get-drains-warning"))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package v7
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+type DumpCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.AppName                `positional-args:"yes"`
+	ProcessIndex       uint                        `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string                      `long:"process" default:"web" description:"App process name"`
+	Recipes            []string                    `long:"recipe" short:"r" description:"Dump recipe to run; can be given multiple times (default: the default recipes for the app's buildpack)"`
+	RecipesFile        flag.PathWithExistenceCheck `long:"recipes-file" description:"Path to a YAML file of additional dump recipes"`
+	Path               string                      `long:"path" short:"p" description:"Directory to download the dump files to (default: current working directory)"`
+	SkipHostValidation bool                        `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME dump APP_NAME [--process PROCESS] [-i INDEX] [-r RECIPE]... [--recipes-file FILE] [--path DIRECTORY]\n\nDEFAULT RECIPES:\n   thread-dump   Java thread dump taken with jstack (default for Java apps)\n   heap-dump     Java heap dump taken with jmap\n   inspect       Enable the Node.js inspector with SIGUSR1 (default for Node.js apps)\n   core-dump     Core dump of the app process taken with gcore\n\nRECIPES FILE:\n   recipes:\n   - name: gc-log\n     buildpacks: [java]\n     command: cp /home/vcap/logs/gc.log /home/vcap/tmp/gc.log\n     files: [/home/vcap/tmp/gc.log]\n\nEXAMPLES:\n   cf dump my-app\n   cf dump my-app -i 1 -r heap-dump --path /tmp/dumps"`
+	relatedCommands interface{} `related_commands:"enable-ssh, exec, ssh"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor  SharedSSHActor
+	SSHClient *clissh.SecureShell
+}
+
+func (cmd *DumpCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell(config.IPFamily(), config.ResolveOverrides())
+
+	return nil
+}
+
+func (cmd DumpCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var customRecipes []v7action.DumpRecipe
+	if cmd.RecipesFile != "" {
+		raw, readErr := ioutil.ReadFile(string(cmd.RecipesFile))
+		if readErr != nil {
+			return readErr
+		}
+		customRecipes, err = v7action.ParseDumpRecipes(raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Collecting diagnostics from instance {{.Index}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Index":     cmd.ProcessIndex,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	recipes, warnings, err := cmd.Actor.GetDumpRecipesForApp(app.GUID, customRecipes, cmd.Recipes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(recipes) == 0 {
+		cmd.UI.DisplayText("No default dump recipes apply to app {{.AppName}}. Choose one with --recipe.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	for _, recipe := range recipes {
+		err = cmd.runRecipe(recipe)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd DumpCommand) runRecipe(recipe v7action.DumpRecipe) error {
+	cmd.UI.DisplayText("Running recipe {{.Recipe}}...", map[string]interface{}{
+		"Recipe": recipe.Name,
+	})
+
+	// Each connection needs a new one-time passcode.
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var commands []string
+	if recipe.Command != "" {
+		commands = []string{recipe.Command}
+	}
+
+	var downloaded []string
+	err = cmd.SSHActor.ExecuteSecureShellAndDownload(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Commands:           commands,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		cmd.UI.GetOut(),
+		cmd.UI.GetErr(),
+		recipe.Files,
+		func(remotePath string) (io.WriteCloser, error) {
+			localPath := cmd.localPath(remotePath)
+			file, createErr := os.Create(localPath)
+			if createErr != nil {
+				return nil, createErr
+			}
+			downloaded = append(downloaded, localPath)
+			return file, nil
+		},
+	)
+	if err != nil {
+		if len(downloaded) > 0 {
+			_ = os.Remove(downloaded[len(downloaded)-1])
+		}
+		return err
+	}
+
+	for _, localPath := range downloaded {
+		cmd.UI.DisplayText("Downloaded {{.Path}}", map[string]interface{}{
+			"Path": localPath,
+		})
+	}
+
+	if recipe.Message != "" {
+		cmd.UI.DisplayText(recipe.Message, map[string]interface{}{
+			"AppName":    cmd.RequiredArgs.AppName,
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+func (cmd DumpCommand) localPath(remotePath string) string {
+	dir := cmd.Path
+	if dir == "" {
+		dir = "."
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%d-%s", cmd.RequiredArgs.AppName, cmd.ProcessIndex, path.Base(remotePath)))
+}
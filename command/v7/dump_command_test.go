@@ -0,0 +1,206 @@
+package v7_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("dump Command", func() {
+	var (
+		cmd             DumpCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSSHActor    *v7fakes.FakeSharedSSHActor
+		dumpDir         string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+
+		var err error
+		dumpDir, err = ioutil.TempDir("", "cf-dump")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = DumpCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			ProcessType:  "web",
+			ProcessIndex: 2,
+			Path:         dumpDir,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor: fakeSSHActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: "some-app", GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetDumpRecipesForAppReturns(
+			[]v7action.DumpRecipe{{Name: "thread-dump", Command: "some-command", Files: []string{"/home/vcap/tmp/thread-dump.txt"}}},
+			v7action.Warnings{"get-recipes-warning"},
+			nil,
+		)
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{Endpoint: "some-endpoint", Passcode: "some-passcode", Username: "some-username"},
+			v7action.Warnings{"ssh-config-warning"},
+			nil,
+		)
+		fakeSSHActor.ExecuteSecureShellAndDownloadStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, _ io.Writer, _ io.Writer, remotePaths []string, createFile func(string) (io.WriteCloser, error)) error {
+			for _, remotePath := range remotePaths {
+				file, err := createFile(remotePath)
+				if err != nil {
+					return err
+				}
+				_, _ = file.Write([]byte("some-dump"))
+				file.Close()
+			}
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dumpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	It("runs the recipes in the instance and downloads their files", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Collecting diagnostics from instance 2 of app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Running recipe thread-dump\.\.\.`))
+		localPath := filepath.Join(dumpDir, "some-app-2-thread-dump.txt")
+		Expect(testUI.Out).To(Say(`Downloaded %s`, localPath))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-recipes-warning"))
+		Expect(testUI.Err).To(Say("ssh-config-warning"))
+
+		Expect(ioutil.ReadFile(localPath)).To(Equal([]byte("some-dump")))
+
+		appGUID, customRecipes, names := fakeActor.GetDumpRecipesForAppArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(customRecipes).To(BeEmpty())
+		Expect(names).To(BeEmpty())
+
+		_, _, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+		Expect(processType).To(Equal("web"))
+		Expect(processIndex).To(Equal(uint(2)))
+
+		_, sshOptions, _, _, remotePaths, _ := fakeSSHActor.ExecuteSecureShellAndDownloadArgsForCall(0)
+		Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+			Commands: []string{"some-command"},
+			Endpoint: "some-endpoint",
+			Passcode: "some-passcode",
+			Username: "some-username",
+		}))
+		Expect(remotePaths).To(Equal([]string{"/home/vcap/tmp/thread-dump.txt"}))
+	})
+
+	When("recipes and a recipes file are given", func() {
+		BeforeEach(func() {
+			recipesFile := filepath.Join(dumpDir, "recipes.yml")
+			Expect(ioutil.WriteFile(recipesFile, []byte("recipes:\n- name: custom\n  command: ls\n"), 0600)).To(Succeed())
+			cmd.RecipesFile = flag.PathWithExistenceCheck(recipesFile)
+			cmd.Recipes = []string{"custom"}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, customRecipes, names := fakeActor.GetDumpRecipesForAppArgsForCall(0)
+			Expect(customRecipes).To(Equal([]v7action.DumpRecipe{{Name: "custom", Command: "ls"}}))
+			Expect(names).To(Equal([]string{"custom"}))
+		})
+	})
+
+	When("a recipe has a message", func() {
+		BeforeEach(func() {
+			fakeActor.GetDumpRecipesForAppReturns([]v7action.DumpRecipe{{Name: "inspect", Command: "kill", Message: "Run '{{.BinaryName}} ssh {{.AppName}}'."}}, nil, nil)
+		})
+
+		It("displays it after the recipe runs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Run 'faceman ssh some-app'\.`))
+		})
+	})
+
+	When("no recipes apply", func() {
+		BeforeEach(func() {
+			fakeActor.GetDumpRecipesForAppReturns(nil, nil, nil)
+		})
+
+		It("says so without connecting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No default dump recipes apply to app some-app. Choose one with --recipe."))
+			Expect(fakeSSHActor.ExecuteSecureShellAndDownloadCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the recipes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDumpRecipesForAppReturns(nil, nil, actionerror.DumpRecipeNotFoundError{Name: "nope"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.DumpRecipeNotFoundError{Name: "nope"}))
+		})
+	})
+
+	When("the download fails", func() {
+		BeforeEach(func() {
+			fakeSSHActor.ExecuteSecureShellAndDownloadStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, _ io.Writer, _ io.Writer, remotePaths []string, createFile func(string) (io.WriteCloser, error)) error {
+				file, err := createFile(remotePaths[0])
+				Expect(err).ToNot(HaveOccurred())
+				file.Close()
+				return errors.New("some-download-error")
+			}
+		})
+
+		It("removes the partial file and returns the error", func() {
+			Expect(executeErr).To(MatchError("some-download-error"))
+			Expect(filepath.Join(dumpDir, "some-app-2-thread-dump.txt")).ToNot(BeAnExistingFile())
+		})
+	})
+})
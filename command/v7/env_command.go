@@ -5,14 +5,15 @@ import (
 	"sort"
 
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	log "github.com/sirupsen/logrus"
 )
 
 type EnvCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.EnvironmentArgs `positional-args:"yes"`
-	usage           interface{}          `usage:"CF_NAME env APP_NAME"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME env [APP_NAME]\n\n   APP_NAME can be left out when the manifest in the current directory declares a single app."`
 	relatedCommands interface{}          `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
 }
 
@@ -22,6 +23,11 @@ func (cmd EnvCommand) Execute(_ []string) error {
 		return err
 	}
 
+	cmd.RequiredArgs.AppName, err = shared.ResolveAppName(cmd.UI, cmd.RequiredArgs.AppName)
+	if err != nil {
+		return err
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -2,10 +2,14 @@ package v7_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -74,6 +78,52 @@ var _ = Describe("env Command", func() {
 			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
 		})
 
+		When("the app name is left out", func() {
+			var (
+				workDir string
+				prevDir string
+			)
+
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = ""
+				fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+				var err error
+				workDir, err = ioutil.TempDir("", "env-command")
+				Expect(err).ToNot(HaveOccurred())
+				prevDir, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Chdir(workDir)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(prevDir)).To(Succeed())
+				Expect(os.RemoveAll(workDir)).To(Succeed())
+			})
+
+			When("the manifest in the current directory declares a single app", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(filepath.Join(workDir, "manifest.yml"), []byte("applications:\n- name: manifest-app\n"), 0600)).To(Succeed())
+				})
+
+				It("uses the app of the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Using app manifest-app from .*manifest.yml"))
+					Expect(testUI.Out).To(Say("Getting env variables for app manifest-app"))
+
+					appArg, _ := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+					Expect(appArg).To(Equal("manifest-app"))
+				})
+			})
+
+			When("there is no manifest in the current directory", func() {
+				It("returns a RequiredArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+					Expect(fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		When("getting the current user returns an error", func() {
 			BeforeEach(func() {
 				fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("some-error"))
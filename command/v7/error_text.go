@@ -0,0 +1,25 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// errorText returns the translated message of an error, for commands that
+// report the errors of several resources in a table.
+func errorText(ui command.UI, err error) string {
+	translatableErr, ok := translatableerror.ConvertToTranslatableError(err).(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(text string, values ...interface{}) string {
+		var templateValues []map[string]interface{}
+		for _, value := range values {
+			if valueMap, ok := value.(map[string]interface{}); ok {
+				templateValues = append(templateValues, valueMap)
+			}
+		}
+		return ui.TranslateText(text, templateValues...)
+	})
+}
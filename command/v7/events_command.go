@@ -1,20 +1,42 @@
 package v7
 
 import (
+	"os"
+	"os/signal"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
+const eventTimeFormat = "2006-01-02T15:04:05.00-0700"
+
 type EventsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME events APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, logs, map-route, unmap-route"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Org             bool                 `long:"org" description:"Show the events of the targeted org instead of the targeted space"`
+	Types           []string             `long:"type" description:"Only show the events of this type, e.g. audit.app.update. Can be given multiple times"`
+	Since           flag.Duration        `long:"since" description:"Show the events of the past duration, e.g. 30m, 12h or 7d"`
+	Follow          bool                 `long:"follow" description:"Keep showing new events as they occur until Ctrl-C"`
+	usage           interface{}          `usage:"CF_NAME events [APP_NAME | --org] [--type EVENT_TYPE]... [--since DURATION] [--follow]\n\n   Shows the recent audit events of an app, or of the targeted space or org and everything in it.\n   With --follow, new events are printed as they occur until Ctrl-C.\n\nEXAMPLES:\n   CF_NAME events my-app\n   CF_NAME events my-app --since 1h --follow\n   CF_NAME events --org --type audit.space.create --type audit.user.space_developer_add"`
+	relatedCommands interface{}          `related_commands:"app, logs, map-route, unmap-route"`
+}
+
+func (cmd EventsCommand) PagedOutput() bool {
+	return !cmd.Follow
 }
 
 func (cmd EventsCommand) Execute(_ []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	appName := cmd.RequiredArgs.AppName
+	if appName != "" && cmd.Org {
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.Org)
 	if err != nil {
 		return err
 	}
@@ -24,12 +46,144 @@ func (cmd EventsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	appName := cmd.RequiredArgs.AppName
+	if appName != "" && len(cmd.Types) == 0 && !cmd.Since.IsSet && !cmd.Follow {
+		return cmd.displayRecentAppEvents(appName, user.Name)
+	}
+
+	var query v7action.EventQuery
+	switch {
+	case appName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   appName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		query.TargetGUID = app.GUID
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting events for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+		query.OrganizationGUID = cmd.Config.TargetedOrganization().GUID
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting events for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"Username":  user.Name,
+		})
+		query.SpaceGUID = cmd.Config.TargetedSpace().GUID
+	}
+
+	query.Types = cmd.Types
+	if cmd.Since.IsSet {
+		query.Since = time.Now().Add(-cmd.Since.Value)
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(query)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	withTarget := appName == ""
+	header := []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("actor"),
+	}
+	if withTarget {
+		header = append(header, cmd.UI.TranslateText("target"))
+	}
+	header = append(header, cmd.UI.TranslateText("description"))
+
+	if len(events) == 0 && !cmd.Follow {
+		cmd.UI.DisplayText("No events found.")
+		return nil
+	}
+
+	cmd.UI.DisplayTableWithHeader("", append([][]string{header}, eventRows(events, withTarget)...), ui.DefaultTableSpacePadding)
+	if !cmd.Follow {
+		return nil
+	}
+
+	return cmd.followEvents(query, events, withTarget)
+}
+
+// followEvents polls for the events created after the last of the given
+// events and prints them until Ctrl-C. The Cloud Controller records the
+// creation time of events to the second, so every poll asks again for the
+// second of the last event and skips the events that were already printed.
+func (cmd EventsCommand) followEvents(query v7action.EventQuery, events []v7action.Event, withTarget bool) error {
+	var last time.Time
+	switch {
+	case len(events) > 0:
+		last = events[len(events)-1].Time
+	case !query.Since.IsZero():
+		last = query.Since
+	default:
+		last = time.Now()
+	}
+
+	printed := map[string]time.Time{}
+	remember := func(events []v7action.Event) {
+		for _, event := range events {
+			printed[event.GUID] = event.Time
+			if event.Time.After(last) {
+				last = event.Time
+			}
+		}
+		for guid, eventTime := range printed {
+			if eventTime.Before(last.Add(-time.Second)) {
+				delete(printed, guid)
+			}
+		}
+	}
+	remember(events)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for {
+		select {
+		case <-time.After(cmd.Config.PollingInterval()):
+		case <-interrupt:
+			return nil
+		}
+
+		query.Since = last.Add(-time.Second)
+		events, warnings, err := cmd.Actor.GetEvents(query)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		var newEvents []v7action.Event
+		for _, event := range events {
+			if _, ok := printed[event.GUID]; !ok {
+				newEvents = append(newEvents, event)
+			}
+		}
+		if len(newEvents) > 0 {
+			cmd.UI.DisplayNonWrappingTable("", eventRows(newEvents, withTarget), ui.DefaultTableSpacePadding)
+		}
+		remember(newEvents)
+	}
+}
+
+func (cmd EventsCommand) displayRecentAppEvents(appName string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   appName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
+		"Username":  userName,
 	})
 
 	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
@@ -53,17 +207,43 @@ func (cmd EventsCommand) Execute(_ []string) error {
 			cmd.UI.TranslateText("description"),
 		},
 	}
+	table = append(table, eventRows(events, false)...)
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
 
+func eventRows(events []v7action.Event, withTarget bool) [][]string {
+	var rows [][]string
 	for _, event := range events {
-		table = append(table, []string{
-			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+		row := []string{
+			event.Time.Local().Format(eventTimeFormat),
 			event.Type,
 			event.ActorName,
-			event.Description,
-		})
+		}
+		if withTarget {
+			row = append(row, event.TargetName)
+		}
+		rows = append(rows, append(row, event.Description))
 	}
+	return rows
+}
 
-	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+// displayEventsWithTarget displays the events of an org or space with the
+// name of the resource of each event.
+func displayEventsWithTarget(commandUI command.UI, events []v7action.Event) {
+	if len(events) == 0 {
+		commandUI.DisplayText("No events found.")
+		return
+	}
 
-	return nil
+	table := [][]string{{
+		commandUI.TranslateText("time"),
+		commandUI.TranslateText("event"),
+		commandUI.TranslateText("actor"),
+		commandUI.TranslateText("target"),
+		commandUI.TranslateText("description"),
+	}}
+	commandUI.DisplayTableWithHeader("", append(table, eventRows(events, true)...), ui.DefaultTableSpacePadding)
 }
@@ -3,11 +3,14 @@ package v7_test
 import (
 	"errors"
 	"regexp"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -42,7 +45,7 @@ var _ = Describe("events Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		cmd = EventsCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -179,4 +182,158 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("APP_NAME and --org are both given", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no app name is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			fakeActor.GetEventsReturns(
+				[]v7action.Event{
+					{
+						GUID:        "some-event-guid",
+						Type:        "audit.space.update",
+						ActorName:   "admin",
+						TargetName:  "some-space",
+						Time:        time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+						Description: "name: some-space",
+					},
+				},
+				v7action.Warnings{"get-events-warning"},
+				nil,
+			)
+		})
+
+		It("displays the events of the targeted space with their target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events for space some-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`audit.space.update\s+admin\s+some-space\s+name: some-space`))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{SpaceGUID: "some-space-guid"}))
+			Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		When("--org is given", func() {
+			BeforeEach(func() {
+				cmd.Org = true
+			})
+
+			It("only requires an org to be targeted and displays the events of the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(testUI.Out).To(Say(`Getting events for org some-org as steve\.\.\.`))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{OrganizationGUID: "some-org-guid"}))
+			})
+		})
+
+		When("there are no events", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, nil, nil)
+			})
+
+			It("displays there are no events", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No events found."))
+			})
+		})
+	})
+
+	When("--type and --since are given", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update", "audit.app.restage"}
+			cmd.Since = flag.Duration{Value: time.Hour, IsSet: true}
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+			fakeActor.GetEventsReturns(
+				[]v7action.Event{{GUID: "some-event-guid", Type: "audit.app.update", ActorName: "user1"}},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the events of the app of those types created since then", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`audit.app.update\s+user1`))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			query := fakeActor.GetEventsArgsForCall(0)
+			Expect(query.TargetGUID).To(Equal("some-app-guid"))
+			Expect(query.Types).To(Equal([]string{"audit.app.update", "audit.app.restage"}))
+			Expect(query.Since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--follow is given", func() {
+		var firstEventTime time.Time
+
+		BeforeEach(func() {
+			cmd.Follow = true
+			firstEventTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+
+			firstEvent := v7action.Event{GUID: "event-guid-1", Type: "audit.app.start", ActorName: "user1", Time: firstEventTime}
+			secondEvent := v7action.Event{GUID: "event-guid-2", Type: "audit.app.stop", ActorName: "user2", Time: firstEventTime}
+			thirdEvent := v7action.Event{GUID: "event-guid-3", Type: "audit.app.delete-request", ActorName: "user3", Time: firstEventTime.Add(time.Minute)}
+			fakeActor.GetEventsReturnsOnCall(0, []v7action.Event{firstEvent}, nil, nil)
+			fakeActor.GetEventsReturnsOnCall(1, []v7action.Event{firstEvent, secondEvent}, v7action.Warnings{"poll-warning"}, nil)
+			fakeActor.GetEventsReturnsOnCall(2, []v7action.Event{firstEvent, secondEvent, thirdEvent}, nil, nil)
+			fakeActor.GetEventsReturnsOnCall(3, nil, nil, errors.New("poll failed"))
+		})
+
+		It("does not page the output", func() {
+			Expect(cmd.PagedOutput()).To(BeFalse())
+		})
+
+		It("prints each new event once until polling fails", func() {
+			Expect(executeErr).To(MatchError("poll failed"))
+
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`audit.app.start\s+user1`))
+			Expect(testUI.Out).To(Say(`audit.app.stop\s+user2`))
+			Expect(testUI.Out).To(Say(`audit.app.delete-request\s+user3`))
+			Expect(strings.Count(string(testUI.Out.(*Buffer).Contents()), "audit.app.start")).To(Equal(1))
+			Expect(strings.Count(string(testUI.Out.(*Buffer).Contents()), "audit.app.stop")).To(Equal(1))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(4))
+			Expect(fakeActor.GetEventsArgsForCall(0).Since).To(BeZero())
+			Expect(fakeActor.GetEventsArgsForCall(1).Since).To(Equal(firstEventTime.Add(-time.Second)))
+			Expect(fakeActor.GetEventsArgsForCall(3).Since).To(Equal(firstEventTime.Add(time.Minute - time.Second)))
+		})
+	})
 })
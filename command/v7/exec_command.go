@@ -0,0 +1,98 @@
+package v7
+
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"golang.org/x/crypto/ssh"
+)
+
+type ExecCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.ExecArgs     `positional-args:"yes"`
+	ProcessIndex       uint              `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string            `long:"process" default:"web" description:"App process name"`
+	Output             flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the command's stdout, stderr and exit code as a single JSON object"`
+	SkipHostValidation bool              `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME exec APP_NAME [--process PROCESS] [-i INDEX] [--output json] [--skip-host-validation] -- COMMAND [ARGS...]\n\nEXAMPLES:\n   cf exec my-app -- ls -la /home/vcap/app\n   cf exec my-app -i 2 --output json -- cat /proc/meminfo"`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor  SharedSSHActor
+	SSHClient *clissh.SecureShell
+}
+
+type execResult struct {
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exit_code"`
+}
+
+func (cmd *ExecCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell(config.IPFamily(), config.ResolveOverrides())
+
+	return nil
+}
+
+func (cmd ExecCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sshOptions := sharedaction.SSHOptions{
+		Commands:           cmd.RequiredArgs.Command,
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		Passcode:           sshAuth.Passcode,
+		SkipHostValidation: cmd.SkipHostValidation,
+		Username:           sshAuth.Username,
+	}
+
+	if cmd.Output != flag.OutputFormatJSON {
+		return cmd.SSHActor.ExecuteSecureShellCommand(cmd.SSHClient, sshOptions, cmd.UI.GetOut(), cmd.UI.GetErr())
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = cmd.SSHActor.ExecuteSecureShellCommand(cmd.SSHClient, sshOptions, &stdout, &stderr)
+
+	result := execResult{Stdout: stdout.String(), Stderr: stderr.String()}
+	if exitErr, ok := err.(*ssh.ExitError); ok {
+		result.ExitCode = exitErr.ExitStatus()
+	} else if err != nil {
+		return err
+	}
+
+	displayErr := cmd.UI.DisplayJSON("", result)
+	if displayErr != nil {
+		return displayErr
+	}
+
+	// The exit error is still returned so that cf exits with the command's
+	// status.
+	return err
+}
@@ -0,0 +1,168 @@
+package v7_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("exec Command", func() {
+	var (
+		cmd             ExecCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSSHActor    *v7fakes.FakeSharedSSHActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+
+		cmd = ExecCommand{
+			RequiredArgs:       flag.ExecArgs{AppName: "some-app", Command: []string{"ls", "-la"}},
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			Output:             flag.OutputFormatText,
+			SkipHostValidation: true,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor: fakeSSHActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+		fakeSSHActor.ExecuteSecureShellCommandStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, stdout io.Writer, stderr io.Writer) error {
+			fmt.Fprint(stdout, "some-output\n")
+			fmt.Fprint(stderr, "some-error-output\n")
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the secure shell authentication information fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v7action.SSHAuthentication{},
+				v7action.Warnings{"some-warnings"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+		})
+	})
+
+	It("runs the command in the app instance and streams its output", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Err).To(Say("some-warnings"))
+
+		appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+		Expect(appNameArg).To(Equal("some-app"))
+		Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+		Expect(processTypeArg).To(Equal("some-process-type"))
+		Expect(processIndexArg).To(Equal(uint(1)))
+
+		Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(1))
+		_, sshOptionsArg, _, _ := fakeSSHActor.ExecuteSecureShellCommandArgsForCall(0)
+		Expect(sshOptionsArg).To(Equal(sharedaction.SSHOptions{
+			Commands:           []string{"ls", "-la"},
+			Endpoint:           "some-endpoint",
+			HostKeyFingerprint: "some-fingerprint",
+			Passcode:           "some-passcode",
+			SkipHostValidation: true,
+			Username:           "some-username",
+		}))
+
+		Expect(testUI.Out).To(Say("some-output"))
+		Expect(testUI.Err).To(Say("some-error-output"))
+	})
+
+	When("running the command fails", func() {
+		BeforeEach(func() {
+			fakeSSHActor.ExecuteSecureShellCommandStub = nil
+			fakeSSHActor.ExecuteSecureShellCommandReturns(errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	When("the output is json", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormatJSON
+		})
+
+		It("displays the output and exit code as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`"stdout": "some-output\\n",`))
+			Expect(testUI.Out).To(Say(`"stderr": "some-error-output\\n",`))
+			Expect(testUI.Out).To(Say(`"exit_code": 0`))
+		})
+
+		When("the connection fails", func() {
+			BeforeEach(func() {
+				fakeSSHActor.ExecuteSecureShellCommandStub = nil
+				fakeSSHActor.ExecuteSecureShellCommandReturns(errors.New("some-error"))
+			})
+
+			It("returns the error without displaying JSON", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Out).ToNot(Say("exit_code"))
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ExperimentalCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ExperimentalArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME experimental list\n\n   Experimental features are turned on with 'CF_NAME config --enable-experimental NAME',\n   or for a single command with CF_CLI_EXPERIMENTAL=NAME[,NAME]. CF_CLI_EXPERIMENTAL=true\n   turns on all of them."`
+	relatedCommands interface{}           `related_commands:"config"`
+}
+
+func (cmd *ExperimentalCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ExperimentalCommand) Execute(args []string) error {
+	if cmd.RequiredArgs.Action != "list" {
+		return translatableerror.IncorrectUsageError{Message: "the only supported action is 'list'"}
+	}
+
+	cmd.UI.DisplayText("Getting experimental features...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("status"),
+		cmd.UI.TranslateText("description"),
+	}}
+	for _, feature := range command.ExperimentalFeatures {
+		status := "disabled"
+		if command.IsExperimentalFeatureEnabled(cmd.Config, feature) {
+			status = "enabled"
+		}
+		table = append(table, []string{
+			feature.Name,
+			cmd.UI.TranslateText(status),
+			cmd.UI.TranslateText(feature.Description),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
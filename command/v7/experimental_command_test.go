@@ -0,0 +1,70 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("experimental Command", func() {
+	var (
+		cmd        ExperimentalCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ExperimentalCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.ExperimentalArgs{Action: "list"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the experimental features and whether they are enabled", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting experimental features\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+status\s+description`))
+		Expect(testUI.Out).To(Say(`revisions\s+disabled\s+The revisions, revision and rollback commands`))
+
+		Expect(fakeConfig.ExperimentalFeatureEnabledCallCount()).To(Equal(1))
+		name, enabledByDefault := fakeConfig.ExperimentalFeatureEnabledArgsForCall(0)
+		Expect(name).To(Equal("revisions"))
+		Expect(enabledByDefault).To(BeTrue())
+	})
+
+	When("a feature is enabled", func() {
+		BeforeEach(func() {
+			fakeConfig.ExperimentalFeatureEnabledReturns(true)
+		})
+
+		It("shows it as enabled", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`revisions\s+enabled`))
+		})
+	})
+
+	When("the action is not list", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = "enable"
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "the only supported action is 'list'"}))
+		})
+	})
+})
@@ -0,0 +1,182 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/ociimage"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ImageExporter
+
+type ImageExporter interface {
+	Build(base ociimage.Reference, droplet []byte) (ociimage.Image, error)
+	Push(image ociimage.Image, dest ociimage.Reference, credentials ociimage.Credentials) (string, error)
+	WriteLayout(image ociimage.Image, dir string, name string) (string, error)
+}
+
+type ExportImageCommand struct {
+	BaseCommand
+
+	RequiredArgs     flag.AppName `positional-args:"yes"`
+	BaseImage        string       `long:"base-image" description:"Image to layer the droplet on (default: cloudfoundry/STACK, the image of the droplet's stack)"`
+	OCILayout        string       `long:"oci-layout" description:"Directory to write the image to as an OCI image layout, instead of pushing it to a registry"`
+	Registry         string       `long:"registry" description:"Image reference to push the image to (e.g. registry.example.com/team/app:v1)"`
+	RegistryUsername string       `long:"registry-username" description:"Registry username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	dockerPassword   interface{}  `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for the registry the image is pushed to"`
+	usage            interface{}  `usage:"Push the image to a registry:\n      CF_NAME export-image APP_NAME --registry REFERENCE [--registry-username USERNAME] [--base-image REFERENCE]\n\n   Write the image to an OCI image layout directory:\n      CF_NAME export-image APP_NAME --oci-layout DIRECTORY [--base-image REFERENCE]\n\nEXAMPLES:\n   CF_NAME export-image my-app --registry registry.example.com/team/my-app:v1\n   CF_NAME export-image my-app --oci-layout ./my-app-image"`
+	relatedCommands  interface{}  `related_commands:"download-droplet, droplets, push"`
+
+	ImageExporter ImageExporter
+}
+
+func (cmd *ExportImageCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.ImageExporter = ociimage.NewExporter(
+		util.NewDialer(config.DialTimeout(), config.IPFamily(), config.ResolveOverrides()),
+		config.ClientCertificate(),
+	)
+	return nil
+}
+
+func (cmd ExportImageCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	var dest ociimage.Reference
+	if cmd.Registry != "" {
+		dest, err = ociimage.ParseReference(cmd.Registry)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting image of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	droplet, warnings, err := cmd.Actor.GetCurrentDropletByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); ok {
+			return translatableerror.NoDropletForAppError{AppName: cmd.RequiredArgs.AppName}
+		}
+		return err
+	}
+
+	if droplet.Image != "" {
+		return translatableerror.ExportDockerImageError{AppName: cmd.RequiredArgs.AppName, Image: droplet.Image}
+	}
+
+	baseImage := cmd.BaseImage
+	if baseImage == "" {
+		baseImage = "cloudfoundry/" + droplet.Stack
+	}
+	base, err := ociimage.ParseReference(baseImage)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Downloading droplet {{.DropletGUID}}...", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+	})
+	rawDropletBytes, warnings, err := cmd.Actor.DownloadDropletByGUIDAndAppName(droplet.GUID, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Building image on base image {{.BaseImage}}...", map[string]interface{}{
+		"BaseImage": base.String(),
+	})
+	image, err := cmd.ImageExporter.Build(base, rawDropletBytes)
+	if err != nil {
+		return err
+	}
+
+	var digest string
+	if cmd.Registry != "" {
+		credentials := ociimage.Credentials{Username: cmd.RegistryUsername}
+		credentials.Password, err = cmd.getRegistryPassword()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayText("Pushing image to {{.Reference}}...", map[string]interface{}{
+			"Reference": dest.String(),
+		})
+		digest, err = cmd.ImageExporter.Push(image, dest, credentials)
+	} else {
+		cmd.UI.DisplayText("Writing OCI image layout to {{.Directory}}...", map[string]interface{}{
+			"Directory": cmd.OCILayout,
+		})
+		digest, err = cmd.ImageExporter.WriteLayout(image, cmd.OCILayout, cmd.RequiredArgs.AppName)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Image digest: {{.Digest}}", map[string]interface{}{
+		"Digest": digest,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ExportImageCommand) validateFlags() error {
+	switch {
+	case cmd.Registry != "" && cmd.OCILayout != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--registry", "--oci-layout"},
+		}
+	case cmd.RegistryUsername != "" && cmd.OCILayout != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--registry-username", "--oci-layout"},
+		}
+	case cmd.Registry == "" && cmd.OCILayout == "":
+		return translatableerror.IncorrectUsageError{Message: "one of --registry or --oci-layout must be provided"}
+	}
+	return nil
+}
+
+func (cmd ExportImageCommand) getRegistryPassword() (string, error) {
+	if cmd.RegistryUsername == "" {
+		return "", nil
+	}
+
+	if cmd.Config.DockerPassword() == "" {
+		cmd.UI.DisplayText("Environment variable CF_DOCKER_PASSWORD not set.")
+		return cmd.UI.DisplayPasswordPrompt("Registry password")
+	}
+
+	cmd.UI.DisplayText("Using registry password from environment variable CF_DOCKER_PASSWORD.")
+	return cmd.Config.DockerPassword(), nil
+}
@@ -0,0 +1,273 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ociimage"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-image Command", func() {
+	var (
+		cmd               ExportImageCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v7fakes.FakeActor
+		fakeImageExporter *v7fakes.FakeImageExporter
+		input             *Buffer
+		binaryName        string
+		executeErr        error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeImageExporter = new(v7fakes.FakeImageExporter)
+
+		cmd = ExportImageCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ImageExporter: fakeImageExporter,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Registry = "registry.example.com/team/some-app:v1"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+		fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{GUID: "some-droplet-guid", Stack: "cflinuxfs4"}, v7action.Warnings{"droplet-warning"}, nil)
+		fakeActor.DownloadDropletByGUIDAndAppNameReturns([]byte("some-droplet"), v7action.Warnings{"download-warning"}, nil)
+		fakeImageExporter.BuildReturns(ociimage.Image{Manifest: []byte("some-manifest")}, nil)
+		fakeImageExporter.PushReturns("sha256:some-digest", nil)
+		fakeImageExporter.WriteLayoutReturns("sha256:some-digest", nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("pushing to a registry", func() {
+		It("builds the image from the current droplet on the stack image", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			dropletGUID, appName, spaceGUID := fakeActor.DownloadDropletByGUIDAndAppNameArgsForCall(0)
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			base, droplet := fakeImageExporter.BuildArgsForCall(0)
+			Expect(base).To(Equal(ociimage.Reference{Host: "docker.io", Repository: "cloudfoundry/cflinuxfs4", Tag: "latest"}))
+			Expect(droplet).To(Equal([]byte("some-droplet")))
+		})
+
+		It("pushes the image anonymously and displays its digest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			image, dest, credentials := fakeImageExporter.PushArgsForCall(0)
+			Expect(image.Manifest).To(Equal([]byte("some-manifest")))
+			Expect(dest).To(Equal(ociimage.Reference{Host: "registry.example.com", Repository: "team/some-app", Tag: "v1"}))
+			Expect(credentials).To(Equal(ociimage.Credentials{}))
+			Expect(fakeImageExporter.WriteLayoutCallCount()).To(BeZero())
+
+			Expect(testUI.Out).To(Say(`Exporting image of app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`Downloading droplet some-droplet-guid\.\.\.`))
+			Expect(testUI.Out).To(Say(`Building image on base image docker.io/cloudfoundry/cflinuxfs4:latest\.\.\.`))
+			Expect(testUI.Out).To(Say(`Pushing image to registry.example.com/team/some-app:v1\.\.\.`))
+			Expect(testUI.Out).To(Say(`Image digest: sha256:some-digest`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("droplet-warning"))
+			Expect(testUI.Err).To(Say("download-warning"))
+		})
+
+		When("a base image is given", func() {
+			BeforeEach(func() {
+				cmd.BaseImage = "registry.example.com/base:1"
+			})
+
+			It("builds on that image", func() {
+				base, _ := fakeImageExporter.BuildArgsForCall(0)
+				Expect(base).To(Equal(ociimage.Reference{Host: "registry.example.com", Repository: "base", Tag: "1"}))
+			})
+		})
+
+		When("a registry username is given", func() {
+			BeforeEach(func() {
+				cmd.RegistryUsername = "some-user"
+			})
+
+			When("the password is set in the environment", func() {
+				BeforeEach(func() {
+					fakeConfig.DockerPasswordReturns("some-password")
+				})
+
+				It("pushes with the credentials", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Using registry password from environment variable CF_DOCKER_PASSWORD."))
+					_, _, credentials := fakeImageExporter.PushArgsForCall(0)
+					Expect(credentials).To(Equal(ociimage.Credentials{Username: "some-user", Password: "some-password"}))
+				})
+			})
+
+			When("the password is not set in the environment", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("prompted-password\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("prompts for the password", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Environment variable CF_DOCKER_PASSWORD not set."))
+					Expect(testUI.Out).To(Say("Registry password"))
+					_, _, credentials := fakeImageExporter.PushArgsForCall(0)
+					Expect(credentials).To(Equal(ociimage.Credentials{Username: "some-user", Password: "prompted-password"}))
+				})
+			})
+		})
+
+		When("pushing fails", func() {
+			BeforeEach(func() {
+				fakeImageExporter.PushReturns("", errors.New("push-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("push-error"))
+			})
+		})
+	})
+
+	When("writing an OCI layout", func() {
+		BeforeEach(func() {
+			cmd.Registry = ""
+			cmd.OCILayout = "some-dir"
+		})
+
+		It("writes the image to the directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			image, dir, name := fakeImageExporter.WriteLayoutArgsForCall(0)
+			Expect(image.Manifest).To(Equal([]byte("some-manifest")))
+			Expect(dir).To(Equal("some-dir"))
+			Expect(name).To(Equal("some-app"))
+			Expect(fakeImageExporter.PushCallCount()).To(BeZero())
+
+			Expect(testUI.Out).To(Say(`Writing OCI image layout to some-dir\.\.\.`))
+			Expect(testUI.Out).To(Say(`Image digest: sha256:some-digest`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the app has no current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns a NoDropletForAppError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoDropletForAppError{AppName: "some-app"}))
+			Expect(fakeImageExporter.BuildCallCount()).To(BeZero())
+		})
+	})
+
+	When("the app runs a Docker image", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{GUID: "some-droplet-guid", Image: "some/image"}, nil, nil)
+		})
+
+		It("returns an ExportDockerImageError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ExportDockerImageError{AppName: "some-app", Image: "some/image"}))
+			Expect(fakeActor.DownloadDropletByGUIDAndAppNameCallCount()).To(BeZero())
+		})
+	})
+
+	When("building the image fails", func() {
+		BeforeEach(func() {
+			fakeImageExporter.BuildReturns(ociimage.Image{}, ociimage.MissingStartCommandError{})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(ociimage.MissingStartCommandError{}))
+			Expect(fakeImageExporter.PushCallCount()).To(BeZero())
+		})
+	})
+
+	When("the registry reference is invalid", func() {
+		BeforeEach(func() {
+			cmd.Registry = "Not A Reference"
+		})
+
+		It("returns an InvalidReferenceError before contacting the API", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(ociimage.InvalidReferenceError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	DescribeTable("flag validation",
+		func(setup func(), expectedErr error) {
+			setup()
+			Expect(cmd.Execute(nil)).To(MatchError(expectedErr))
+		},
+
+		Entry("neither --registry nor --oci-layout",
+			func() {
+				cmd.Registry = ""
+			},
+			translatableerror.IncorrectUsageError{Message: "one of --registry or --oci-layout must be provided"}),
+
+		Entry("both --registry and --oci-layout",
+			func() {
+				cmd.OCILayout = "some-dir"
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--registry", "--oci-layout"}}),
+
+		Entry("--registry-username with --oci-layout",
+			func() {
+				cmd.Registry = ""
+				cmd.OCILayout = "some-dir"
+				cmd.RegistryUsername = "some-user"
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--registry-username", "--oci-layout"}}),
+	)
+})
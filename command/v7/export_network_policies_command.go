@@ -0,0 +1,99 @@
+package v7
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NetworkPolicyBundleActor
+
+type NetworkPolicyBundleActor interface {
+	ApplyRawNetworkPolicies(spaceGUID string, rawPolicies []byte, prune bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)
+	GetRawNetworkPoliciesBySpace(spaceGUID string) ([]byte, cfnetworkingaction.Warnings, error)
+}
+
+type ExportNetworkPoliciesCommand struct {
+	BaseCommand
+
+	Space           string      `short:"s" description:"Space whose apps are the sources of the policies (Default: targeted space)"`
+	OutputFile      flag.Path   `short:"o" description:"Write the policies to FILE instead of stdout"`
+	usage           interface{} `usage:"CF_NAME export-network-policies [-s SPACE] [-o FILE]\n\n   Writes the network policies of the apps in a space as a YAML policy bundle that apply-network-policies reads.\n   The destination space and org of a policy are left out when they are the ones of its source app.\n\nEXAMPLES:\n   CF_NAME export-network-policies -s staging -o policies.yml\n   CF_NAME export-network-policies > policies.yml"`
+	relatedCommands interface{} `related_commands:"add-network-policy, apply-network-policies, network-policies"`
+
+	NetworkingActor NetworkPolicyBundleActor
+}
+
+func (cmd *ExportNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ExportNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+	if cmd.Space != "" {
+		spaceResource, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		space.GUID, space.Name = spaceResource.GUID, spaceResource.Name
+	}
+
+	if cmd.OutputFile != "" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Exporting network policies of apps in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"Org":   cmd.Config.TargetedOrganization().Name,
+			"Space": space.Name,
+			"User":  user.Name,
+		})
+	}
+
+	rawPolicies, warnings, err := cmd.NetworkingActor.GetRawNetworkPoliciesBySpace(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile == "" {
+		_, err = cmd.UI.GetOut().Write(rawPolicies)
+		return err
+	}
+
+	err = ioutil.WriteFile(cmd.OutputFile.String(), rawPolicies, 0666)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayText("Network policies written to {{.FilePath}}", map[string]interface{}{
+		"FilePath": cmd.OutputFile.String(),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-network-policies Command", func() {
+	const rawPolicies = "policies:\n- source: frontend\n  destination: backend\n  protocol: tcp\n  ports: \"8080\"\n"
+
+	var (
+		cmd                 ExportNetworkPoliciesCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkPolicyBundleActor
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkPolicyBundleActor)
+
+		cmd = ExportNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeNetworkingActor.GetRawNetworkPoliciesBySpaceReturns([]byte(rawPolicies), cfnetworkingaction.Warnings{"export-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("writes the policies of the targeted space to stdout", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		Expect(fakeNetworkingActor.GetRawNetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(rawPolicies))
+		Expect(testUI.Err).To(Say("export-warning"))
+	})
+
+	When("a space and an output file are given", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "export-network-policies")
+			Expect(err).NotTo(HaveOccurred())
+
+			cmd.Space = "other-space"
+			cmd.OutputFile = flag.Path(filepath.Join(dir, "policies.yml"))
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{Name: "other-space", GUID: "other-space-guid"}, v7action.Warnings{"get-space-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes the policies of the space to the file", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(fakeNetworkingActor.GetRawNetworkPoliciesBySpaceArgsForCall(0)).To(Equal("other-space-guid"))
+
+			written, err := ioutil.ReadFile(cmd.OutputFile.String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(written)).To(Equal(rawPolicies))
+
+			Expect(testUI.Out).To(Say(`Exporting network policies of apps in org some-org / space other-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("Network policies written to .*policies.yml"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{}, nil, actionerror.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "other-space"}))
+				Expect(fakeNetworkingActor.GetRawNetworkPoliciesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("exporting the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.GetRawNetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"export-warning"}, errors.New("export failed"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("export failed"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package v7
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type ExportSpaceManifestCommand struct {
+	BaseCommand
+
+	FilePath        flag.Path   `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
+	NoRoutes        bool        `long:"no-routes" description:"Leave the routes of the apps out of the manifest"`
+	NoServices      bool        `long:"no-services" description:"Leave the service bindings of the apps out of the manifest"`
+	usage           interface{} `usage:"CF_NAME export-space-manifest [-p /path/to/<space-name>_manifest.yml] [--no-routes] [--no-services]\n\nEXAMPLES:\n   CF_NAME export-space-manifest\n   CF_NAME export-space-manifest -p ~/staging.yml --no-routes"`
+	relatedCommands interface{} `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	PWD string
+}
+
+func (cmd *ExportSpaceManifestCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+	currentDir, err := os.Getwd()
+	cmd.PWD = currentDir
+
+	return err
+}
+
+func (cmd ExportSpaceManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaceName := cmd.Config.TargetedSpace().Name
+	cmd.UI.DisplayTextWithFlavor("Creating a manifest from current settings of the apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": spaceName,
+		"Username":  user.Name,
+	})
+
+	manifestBytes, warnings, err := cmd.Actor.GetRawSpaceManifest(cmd.Config.TargetedSpace().GUID, !cmd.NoRoutes, !cmd.NoServices)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if manifestBytes == nil {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	var pathToYAMLFile string
+	if len(cmd.FilePath) > 0 {
+		pathToYAMLFile = cmd.FilePath.String()
+	} else {
+		pathToYAMLFile = filepath.Join(cmd.PWD, fmt.Sprintf("%s_manifest.yml", spaceName))
+	}
+
+	err = ioutil.WriteFile(pathToYAMLFile, manifestBytes, 0666)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": pathToYAMLFile,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space-manifest Command", func() {
+	var (
+		cmd             ExportSpaceManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		tempDir         string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "export-space-manifest-unit")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = ExportSpaceManifestCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			PWD: tempDir,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetRawSpaceManifestReturns([]byte("applications:\n- name: some-app\n"), v7action.Warnings{"some-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("creates the manifest of the space in the current directory as <space-name>_manifest.yml", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		spaceGUID, withRoutes, withServices := fakeActor.GetRawSpaceManifestArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(withRoutes).To(BeTrue())
+		Expect(withServices).To(BeTrue())
+
+		pathToYAMLFile := filepath.Join(tempDir, "some-space_manifest.yml")
+		fileContents, err := ioutil.ReadFile(pathToYAMLFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(fileContents)).To(Equal("applications:\n- name: some-app\n"))
+
+		Expect(testUI.Out).To(Say("Creating a manifest from current settings of the apps in org some-org / space some-space as some-user..."))
+		Expect(testUI.Err).To(Say("some-warning"))
+		Expect(testUI.Out).To(Say("Manifest file created successfully at %s", regexp.QuoteMeta(pathToYAMLFile)))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("a filepath and the flags leaving routes and services out are provided", func() {
+		var flagPath string
+
+		BeforeEach(func() {
+			flagPath = filepath.Join(tempDir, "my-space.yml")
+			cmd.FilePath = flag.Path(flagPath)
+			cmd.NoRoutes = true
+			cmd.NoServices = true
+		})
+
+		It("creates the manifest without routes and services at the specified location", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, withRoutes, withServices := fakeActor.GetRawSpaceManifestArgsForCall(0)
+			Expect(withRoutes).To(BeFalse())
+			Expect(withServices).To(BeFalse())
+
+			_, err := os.Stat(flagPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Manifest file created successfully at %s", regexp.QuoteMeta(flagPath)))
+		})
+	})
+
+	When("the space has no apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetRawSpaceManifestReturns(nil, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("says so without creating a file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found"))
+
+			files, err := ioutil.ReadDir(tempDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+	})
+
+	When("creating the manifest errors", func() {
+		BeforeEach(func() {
+			fakeActor.GetRawSpaceManifestReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("writing the file errors", func() {
+		BeforeEach(func() {
+			cmd.PWD = filepath.Join("should", "be", "unwritable")
+		})
+
+		It("returns a 'FileCreationError' error", func() {
+			Expect(executeErr.Error()).To(ContainSubstring("Error creating file:"))
+		})
+	})
+})
@@ -0,0 +1,208 @@
+package v7
+
+import (
+	"bytes"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type InternalRoutesCommand struct {
+	BaseCommand
+
+	Space              string `short:"s" description:"Space to list the internal routes of (default: the targeted space)"`
+	Check              string `long:"check" description:"Source app to check the resolution and reachability of the internal routes from, using SSH"`
+	SkipHostValidation bool   `long:"skip-host-validation" short:"k" description:"With --check, skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME internal-routes [-s SPACE] [--check SOURCE_APP [--skip-host-validation]]\n\n   Lists the apps mapped to routes of internal domains and the hostnames that other apps reach them at.\n   Each instance of an app is also reachable at INDEX.HOSTNAME, e.g. 0.backend.apps.internal.\n\n   With --check, runs a probe in the first instance of SOURCE_APP over SSH that resolves each hostname,\n   and connects to the route port when the network policies of SOURCE_APP allow it.\n\nEXAMPLES:\n   CF_NAME internal-routes\n   CF_NAME internal-routes -s backend-space --check frontend"`
+	relatedCommands interface{} `related_commands:"add-network-policy, enable-ssh, map-route, network-policies, routes"`
+
+	NetworkingActor NetworkPoliciesActor
+	SSHActor        SharedSSHActor
+	SSHClient       *clissh.SecureShell
+}
+
+func (cmd *InternalRoutesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell(config.IPFamily(), config.ResolveOverrides())
+
+	if cmd.Check == "" {
+		return nil
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd InternalRoutesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	spaceName, spaceGUID := cmd.Config.TargetedSpace().Name, cmd.Config.TargetedSpace().GUID
+	if cmd.Space != "" {
+		space, warnings, spaceErr := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if spaceErr != nil {
+			return spaceErr
+		}
+		spaceName, spaceGUID = space.Name, space.GUID
+	}
+
+	if cmd.Check == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting internal routes in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"Org":   org.Name,
+			"Space": spaceName,
+			"User":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Checking internal routes in org {{.Org}} / space {{.Space}} from app {{.SourceApp}} as {{.User}}...", map[string]interface{}{
+			"Org":       org.Name,
+			"Space":     spaceName,
+			"SourceApp": cmd.Check,
+			"User":      user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	internalRoutes, warnings, err := cmd.Actor.GetInternalRoutesBySpace(spaceGUID, org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(internalRoutes) == 0 {
+		cmd.UI.DisplayText("No internal routes found.")
+		return nil
+	}
+
+	if cmd.Check == "" {
+		table := [][]string{{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("hostname"),
+			cmd.UI.TranslateText("port"),
+		}}
+		for _, route := range internalRoutes {
+			table = append(table, []string{route.AppName, route.Hostname, strconv.Itoa(route.Port)})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+		return nil
+	}
+
+	checks, err := cmd.checkInternalRoutes(internalRoutes, spaceName, spaceGUID, org.Name)
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("hostname"),
+		cmd.UI.TranslateText("port"),
+		cmd.UI.TranslateText("resolves"),
+		cmd.UI.TranslateText("policy"),
+		cmd.UI.TranslateText("reachable"),
+	}}
+	for _, check := range checks {
+		policy, reachable := cmd.UI.TranslateText("none"), "-"
+		if check.PolicyPort != 0 {
+			policy = "tcp " + strconv.Itoa(check.PolicyPort)
+			reachable = cmd.yesNo(check.Reachable)
+		}
+		table = append(table, []string{
+			check.AppName,
+			check.Hostname,
+			strconv.Itoa(check.Port),
+			cmd.yesNo(check.Resolved),
+			policy,
+			reachable,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// checkInternalRoutes matches the internal routes with the network policies of
+// the source app and probes them from its first web instance.
+func (cmd InternalRoutesCommand) checkInternalRoutes(internalRoutes []v7action.InternalRoute, spaceName string, spaceGUID string, orgName string) ([]v7action.InternalRouteCheck, error) {
+	policies, policyWarnings, err := cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(spaceGUID, cmd.Check)
+	cmd.UI.DisplayWarnings(policyWarnings)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]v7action.InternalRouteCheck, len(internalRoutes))
+	for i, route := range internalRoutes {
+		checks[i] = v7action.InternalRouteCheck{InternalRoute: route}
+		for _, policy := range policies {
+			if policy.DestinationName == route.AppName &&
+				policy.DestinationSpaceName == spaceName &&
+				policy.DestinationOrgName == orgName &&
+				policy.Protocol == "tcp" &&
+				policy.StartPort <= route.Port && route.Port <= policy.EndPort {
+				checks[i].PolicyPort = route.Port
+				break
+			}
+		}
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.Check,
+		spaceGUID,
+		"web",
+		0,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = cmd.SSHActor.ExecuteSecureShellCommand(cmd.SSHClient, sharedaction.SSHOptions{
+		Commands:           []string{v7action.InternalRouteProbeCommand(checks)},
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		Passcode:           sshAuth.Passcode,
+		SkipHostValidation: cmd.SkipHostValidation,
+		Username:           sshAuth.Username,
+	}, &stdout, &stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	return v7action.ParseInternalRouteProbe(checks, stdout.String()), nil
+}
+
+func (cmd InternalRoutesCommand) yesNo(value bool) string {
+	if value {
+		return cmd.UI.TranslateText("yes")
+	}
+	return cmd.UI.TranslateText("no")
+}
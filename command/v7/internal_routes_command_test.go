@@ -0,0 +1,201 @@
+package v7_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("internal-routes Command", func() {
+	var (
+		cmd                 InternalRoutesCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkPoliciesActor
+		fakeSSHActor        *v7fakes.FakeSharedSSHActor
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkPoliciesActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+
+		cmd = InternalRoutesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkingActor,
+			SSHActor:        fakeSSHActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetInternalRoutesBySpaceReturns([]v7action.InternalRoute{
+			{AppName: "backend", Hostname: "backend.apps.internal", Port: 8080},
+			{AppName: "billing", Hostname: "billing.apps.internal", Port: 9000},
+		}, v7action.Warnings{"get-routes-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the internal routes of the targeted space", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		spaceGUID, orgGUID := fakeActor.GetInternalRoutesBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(orgGUID).To(Equal("some-org-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting internal routes in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`app\s+hostname\s+port`))
+		Expect(testUI.Out).To(Say(`backend\s+backend\.apps\.internal\s+8080`))
+		Expect(testUI.Out).To(Say(`billing\s+billing\.apps\.internal\s+9000`))
+		Expect(testUI.Err).To(Say("get-routes-warning"))
+		Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+	})
+
+	When("a space is given", func() {
+		BeforeEach(func() {
+			cmd.Space = "other-space"
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{Name: "other-space", GUID: "other-space-guid"}, nil, nil)
+		})
+
+		It("lists the internal routes of that space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			spaceGUID, _ := fakeActor.GetInternalRoutesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+			Expect(testUI.Out).To(Say("space other-space"))
+		})
+	})
+
+	When("there are no internal routes", func() {
+		BeforeEach(func() {
+			fakeActor.GetInternalRoutesBySpaceReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No internal routes found."))
+		})
+	})
+
+	When("--check is given", func() {
+		BeforeEach(func() {
+			cmd.Check = "frontend"
+			cmd.SkipHostValidation = true
+
+			fakeNetworkingActor.NetworkPoliciesBySpaceAndAppNameReturns([]cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8000, EndPort: 9000, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+				{SourceName: "frontend", DestinationName: "billing", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+			}, cfnetworkingaction.Warnings{"policies-warning"}, nil)
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{
+				Endpoint: "some-endpoint",
+				Passcode: "some-passcode",
+				Username: "some-username",
+			}, v7action.Warnings{"ssh-warning"}, nil)
+			fakeSSHActor.ExecuteSecureShellCommandStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, stdout io.Writer, _ io.Writer) error {
+				fmt.Fprint(stdout, "0 resolved\n0 reachable\n1 unresolved\n")
+				return nil
+			}
+		})
+
+		It("probes the internal routes from the source app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			spaceGUID, sourceApp := fakeNetworkingActor.NetworkPoliciesBySpaceAndAppNameArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sourceApp).To(Equal("frontend"))
+
+			appName, spaceGUID, processType, index := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("frontend"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(index).To(BeZero())
+
+			_, sshOptions, _, _ := fakeSSHActor.ExecuteSecureShellCommandArgsForCall(0)
+			Expect(sshOptions.Endpoint).To(Equal("some-endpoint"))
+			Expect(sshOptions.SkipHostValidation).To(BeTrue())
+			Expect(sshOptions.Commands).To(HaveLen(1))
+			Expect(sshOptions.Commands[0]).To(ContainSubstring("/dev/tcp/backend.apps.internal/8080"))
+			Expect(sshOptions.Commands[0]).NotTo(ContainSubstring("/dev/tcp/billing.apps.internal"))
+
+			Expect(testUI.Out).To(Say(`Checking internal routes in org some-org / space some-space from app frontend as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`app\s+hostname\s+port\s+resolves\s+policy\s+reachable`))
+			Expect(testUI.Out).To(Say(`backend\s+backend\.apps\.internal\s+8080\s+yes\s+tcp 8080\s+yes`))
+			Expect(testUI.Out).To(Say(`billing\s+billing\.apps\.internal\s+9000\s+no\s+none\s+-`))
+			Expect(testUI.Err).To(Say("get-routes-warning"))
+			Expect(testUI.Err).To(Say("policies-warning"))
+			Expect(testUI.Err).To(Say("ssh-warning"))
+		})
+
+		When("the probe cannot be run", func() {
+			BeforeEach(func() {
+				fakeSSHActor.ExecuteSecureShellCommandReturns(errors.New("ssh failed"))
+				fakeSSHActor.ExecuteSecureShellCommandStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("ssh failed"))
+			})
+		})
+
+		When("getting the SSH configuration fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{}, nil, actionerror.ApplicationNotFoundError{Name: "frontend"})
+			})
+
+			It("returns the error without probing", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "frontend"}))
+				Expect(fakeSSHActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetInternalRoutesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type JobCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.JobGUID `positional-args:"yes"`
+	Wait            bool         `long:"wait" description:"Wait for the job to finish before showing it"`
+	usage           interface{}  `usage:"CF_NAME job JOB_GUID [--wait]\n\nEXAMPLES:\n   CF_NAME job 5e1cd4c0-1237-4a35-9a4d-2d6c7bc4d481\n   CF_NAME job 5e1cd4c0-1237-4a35-9a4d-2d6c7bc4d481 --wait"`
+	relatedCommands interface{}  `related_commands:"delete-org, delete-space"`
+}
+
+func (cmd JobCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Wait {
+		cmd.UI.DisplayTextWithFlavor("Waiting for job {{.JobGUID}} to finish as {{.Username}}...", map[string]interface{}{
+			"JobGUID":  cmd.RequiredArgs.JobGUID,
+			"Username": user.Name,
+		})
+
+		warnings, err := cmd.Actor.WaitForJob(cmd.RequiredArgs.JobGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting job {{.JobGUID}} as {{.Username}}...", map[string]interface{}{
+			"JobGUID":  cmd.RequiredArgs.JobGUID,
+			"Username": user.Name,
+		})
+	}
+
+	job, warnings, err := cmd.Actor.GetJob(cmd.RequiredArgs.JobGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{cmd.UI.TranslateText("guid:"), job.GUID},
+		{cmd.UI.TranslateText("operation:"), job.Operation},
+		{cmd.UI.TranslateText("state:"), strings.ToLower(string(job.State))},
+		{cmd.UI.TranslateText("created:"), cmd.jobDate(job.CreatedAt)},
+		{cmd.UI.TranslateText("updated:"), cmd.jobDate(job.UpdatedAt)},
+	}
+	if job.State == v7action.JobFailed {
+		var jobErrors []string
+		for _, jobErr := range job.Errors {
+			jobErrors = append(jobErrors, jobErr.Error())
+		}
+		table = append(table, []string{cmd.UI.TranslateText("errors:"), strings.Join(jobErrors, "\n")})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
+
+// jobDate returns a date of the job in the user's time zone, or the date as
+// the Cloud Controller sent it when it cannot be parsed.
+func (cmd JobCommand) jobDate(date string) string {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return date
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
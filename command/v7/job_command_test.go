@@ -0,0 +1,149 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("job Command", func() {
+	var (
+		cmd             JobCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = JobCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.JobGUID = "some-job-guid"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetJobReturns(v7action.Job{
+			GUID:      "some-job-guid",
+			Operation: "space.delete",
+			State:     v7action.JobComplete,
+			CreatedAt: "not-a-date",
+			UpdatedAt: "not-a-date-either",
+		}, v7action.Warnings{"get-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the job without waiting for it", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.WaitForJobCallCount()).To(Equal(0))
+		Expect(fakeActor.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting job some-job-guid as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`guid:\s+some-job-guid`))
+		Expect(testUI.Out).To(Say(`operation:\s+space.delete`))
+		Expect(testUI.Out).To(Say(`state:\s+complete`))
+		Expect(testUI.Out).To(Say(`created:\s+not-a-date`))
+		Expect(testUI.Out).To(Say(`updated:\s+not-a-date-either`))
+		Expect(testUI.Out).ToNot(Say("errors:"))
+		Expect(testUI.Err).To(Say("get-warning"))
+	})
+
+	When("the job failed", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v7action.Job{
+				GUID:   "some-job-guid",
+				State:  v7action.JobFailed,
+				Errors: []error{errors.New("first-error"), errors.New("second-error")},
+			}, nil, nil)
+		})
+
+		It("displays the errors of the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`state:\s+failed`))
+			Expect(testUI.Out).To(Say(`errors:\s+first-error`))
+			Expect(testUI.Out).To(Say(`second-error`))
+		})
+	})
+
+	When("getting the job errors", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v7action.Job{}, v7action.Warnings{"get-warning"}, actionerror.JobNotFoundError{GUID: "some-job-guid"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.JobNotFoundError{GUID: "some-job-guid"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("the --wait flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+			fakeActor.WaitForJobReturns(v7action.Warnings{"wait-warning"}, nil)
+		})
+
+		It("waits for the job and then displays it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.WaitForJobArgsForCall(0)).To(Equal("some-job-guid"))
+			Expect(fakeActor.GetJobCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say(`Waiting for job some-job-guid to finish as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`state:\s+complete`))
+			Expect(testUI.Err).To(Say("wait-warning"))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+
+		When("the job fails", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForJobReturns(v7action.Warnings{"wait-warning"}, errors.New("job failed"))
+			})
+
+			It("returns the error without displaying the job", func() {
+				Expect(executeErr).To(MatchError("job failed"))
+				Expect(testUI.Err).To(Say("wait-warning"))
+				Expect(fakeActor.GetJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
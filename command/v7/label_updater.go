@@ -19,6 +19,7 @@ type SetLabelActor interface {
 	UpdateApplicationLabelsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateBuildpackLabelsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDomainLabelsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateLabelsBySelector(resourceType string, scopeGUID string, labelSelector string, labels map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)
 	UpdateOrganizationLabelsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateRouteLabels(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateSpaceLabelsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
@@ -42,6 +43,11 @@ type TargetResource struct {
 	BuildpackStack  string
 	ServiceBroker   string
 	ServiceOffering string
+	// AllInSpace and Selector select the resources to update instead of
+	// ResourceName: every resource of the type, or those matching the label
+	// selector.
+	AllInSpace bool
+	Selector   string
 }
 
 type LabelUpdater struct {
@@ -77,6 +83,10 @@ func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[strin
 		return err
 	}
 
+	if cmd.targetResource.AllInSpace || cmd.targetResource.Selector != "" {
+		return cmd.executeBulk()
+	}
+
 	var warnings v7action.Warnings
 	switch ResourceType(cmd.targetResource.ResourceType) {
 	case App:
@@ -123,6 +133,55 @@ func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[strin
 	return nil
 }
 
+func (cmd *LabelUpdater) executeBulk() error {
+	scopeGUID := cmd.Config.TargetedSpace().GUID
+	if ResourceType(cmd.targetResource.ResourceType) == Space {
+		cmd.displayBulkMessageWithOrg()
+		scopeGUID = cmd.Config.TargetedOrganization().GUID
+	} else {
+		cmd.displayBulkMessageWithOrgAndSpace()
+	}
+
+	updates, warnings, err := cmd.Actor.UpdateLabelsBySelector(cmd.targetResource.ResourceType, scopeGUID, cmd.targetResource.Selector, cmd.labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(updates) == 0 {
+		cmd.UI.DisplayText("No {{.ResourceType}}s found.", map[string]interface{}{
+			"ResourceType": cmd.targetResource.ResourceType,
+		})
+		return nil
+	}
+
+	failed := 0
+	for _, update := range updates {
+		if update.Err != nil {
+			failed++
+			cmd.UI.DisplayText("{{.ResourceName}}: {{.Error}}", map[string]interface{}{
+				"ResourceName": update.ResourceName,
+				"Error":        update.Err,
+			})
+			continue
+		}
+		cmd.UI.DisplayText("{{.ResourceName}}: OK", map[string]interface{}{
+			"ResourceName": update.ResourceName,
+		})
+	}
+
+	if failed > 0 {
+		return translatableerror.BulkLabelUpdateFailedError{
+			Failed: failed,
+			Total:  len(updates),
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
 func (cmd *LabelUpdater) checkTarget() error {
 	switch ResourceType(cmd.targetResource.ResourceType) {
 	case App, ServiceInstance, Route:
@@ -166,6 +225,24 @@ func (cmd *LabelUpdater) validateFlags() error {
 		}
 	}
 
+	if cmd.targetResource.AllInSpace && cmd.targetResource.Selector != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--all-in-space", "--selector"},
+		}
+	}
+
+	if cmd.targetResource.AllInSpace && !(resourceType == App || resourceType == Route || resourceType == ServiceInstance) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{cmd.targetResource.ResourceType, "--all-in-space"},
+		}
+	}
+
+	if cmd.targetResource.Selector != "" && !(resourceType == App || resourceType == Route || resourceType == ServiceInstance || resourceType == Space) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{cmd.targetResource.ResourceType, "--selector"},
+		}
+	}
+
 	return nil
 }
 
@@ -238,3 +315,25 @@ func (cmd *LabelUpdater) displayMessageWithOrg() {
 		"User":         cmd.Username,
 	})
 }
+
+func (cmd *LabelUpdater) displayBulkMessageWithOrgAndSpace() {
+	template := actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + "s in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}..."
+	if cmd.targetResource.Selector != "" {
+		template = actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + "s matching {{.Selector}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}..."
+	}
+
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"Selector":  cmd.targetResource.Selector,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"User":      cmd.Username,
+	})
+}
+
+func (cmd *LabelUpdater) displayBulkMessageWithOrg() {
+	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+"s matching {{.Selector}} in org {{.OrgName}} as {{.User}}...", map[string]interface{}{
+		"Selector": cmd.targetResource.Selector,
+		"OrgName":  cmd.Config.TargetedOrganization().Name,
+		"User":     cmd.Username,
+	})
+}
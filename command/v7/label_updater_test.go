@@ -1540,4 +1540,150 @@ var _ = Describe("LabelUpdater", func() {
 			})
 		})
 	})
+
+	When("updating labels by selector", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			cmd.Action = Set
+			targetResource = TargetResource{
+				ResourceType: "app",
+				Selector:     "env=dev",
+			}
+			labels = map[string]types.NullString{"team": types.NewNullString("payments")}
+
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.UpdateLabelsBySelectorReturns(
+				[]v7action.BulkLabelUpdate{{ResourceName: "api"}, {ResourceName: "web"}},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(targetResource, labels)
+		})
+
+		It("updates the matching resources of the targeted space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpdateLabelsBySelectorCallCount()).To(Equal(1))
+			resourceType, scopeGUID, selector, labelsMap := fakeActor.UpdateLabelsBySelectorArgsForCall(0)
+			Expect(resourceType).To(Equal("app"))
+			Expect(scopeGUID).To(Equal("some-space-guid"))
+			Expect(selector).To(Equal("env=dev"))
+			Expect(labelsMap).To(Equal(labels))
+
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting label(s) for apps matching env=dev in org fake-org / space fake-space as some-user...")))
+			Expect(testUI.Out).To(Say("api: OK"))
+			Expect(testUI.Out).To(Say("web: OK"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("updating spaces", func() {
+			BeforeEach(func() {
+				targetResource.ResourceType = "space"
+			})
+
+			It("updates the matching spaces of the targeted org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeSharedActor.CheckTargetArgsForCall(0)).To(Equal(true))
+				_, scopeGUID, _, _ := fakeActor.UpdateLabelsBySelectorArgsForCall(0)
+				Expect(scopeGUID).To(Equal("some-org-guid"))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting label(s) for spaces matching env=dev in org fake-org as some-user...")))
+			})
+		})
+
+		When("updating every resource of the space", func() {
+			BeforeEach(func() {
+				cmd.Action = Unset
+				targetResource.Selector = ""
+				targetResource.AllInSpace = true
+			})
+
+			It("does not pass a selector", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				_, _, selector, _ := fakeActor.UpdateLabelsBySelectorArgsForCall(0)
+				Expect(selector).To(BeEmpty())
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta("Removing label(s) for apps in org fake-org / space fake-space as some-user...")))
+			})
+		})
+
+		When("no resources match", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateLabelsBySelectorReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps found."))
+			})
+		})
+
+		When("some updates fail", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateLabelsBySelectorReturns(
+					[]v7action.BulkLabelUpdate{{ResourceName: "api", Err: errors.New("forbidden")}, {ResourceName: "web"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("shows the error of each resource and returns a failure", func() {
+				Expect(testUI.Out).To(Say("api: forbidden"))
+				Expect(testUI.Out).To(Say("web: OK"))
+				Expect(executeErr).To(MatchError(translatableerror.BulkLabelUpdateFailedError{Failed: 1, Total: 2}))
+			})
+		})
+
+		When("listing the resources fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateLabelsBySelectorReturns(nil, v7action.Warnings{"some-warning"}, errors.New("list failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("list failed"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		When("both --all-in-space and --selector are given", func() {
+			BeforeEach(func() {
+				targetResource.AllInSpace = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--all-in-space", "--selector"},
+				}))
+			})
+		})
+
+		When("--all-in-space is given for spaces", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "space", AllInSpace: true}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"space", "--all-in-space"},
+				}))
+			})
+		})
+
+		When("--selector is given for an unsupported resource type", func() {
+			BeforeEach(func() {
+				targetResource.ResourceType = "buildpack"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"buildpack", "--selector"},
+				}))
+				Expect(fakeActor.UpdateLabelsBySelectorCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
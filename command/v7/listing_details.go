@@ -0,0 +1,78 @@
+package v7
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	listingSortName             = "name"
+	listingSortCreatedAt        = "created-at"
+	listingSortApps             = "apps"
+	listingSortServiceInstances = "service-instances"
+)
+
+// listingDetails is a row of orgs --details or spaces --details, and the
+// object printed for each org or space with --output json.
+type listingDetails struct {
+	Name             string `json:"name"`
+	GUID             string `json:"guid"`
+	Quota            string `json:"quota"`
+	Apps             int    `json:"apps"`
+	ServiceInstances int    `json:"service_instances"`
+	CreatedAt        string `json:"created_at"`
+}
+
+// sortListingDetails sorts the rows by name or creation date in ascending
+// order, or by app or service instance count in descending order. Rows with
+// the same value keep their order by name.
+func sortListingDetails(rows []listingDetails, sortBy string) {
+	var less func(i, j int) bool
+	switch sortBy {
+	case listingSortCreatedAt:
+		less = func(i, j int) bool { return rows[i].CreatedAt < rows[j].CreatedAt }
+	case listingSortApps:
+		less = func(i, j int) bool { return rows[i].Apps > rows[j].Apps }
+	case listingSortServiceInstances:
+		less = func(i, j int) bool { return rows[i].ServiceInstances > rows[j].ServiceInstances }
+	default:
+		return
+	}
+	sort.SliceStable(rows, less)
+}
+
+func displayListingDetails(commandUI command.UI, rows []listingDetails) error {
+	table := [][]string{{
+		commandUI.TranslateText("name"),
+		commandUI.TranslateText("quota"),
+		commandUI.TranslateText("apps"),
+		commandUI.TranslateText("service instances"),
+		commandUI.TranslateText("created"),
+	}}
+
+	for _, row := range rows {
+		var created string
+		if row.CreatedAt != "" {
+			t, err := time.Parse(time.RFC3339, row.CreatedAt)
+			if err != nil {
+				return err
+			}
+			created = commandUI.UserFriendlyDate(t)
+		}
+
+		table = append(table, []string{
+			row.Name,
+			row.Quota,
+			strconv.Itoa(row.Apps),
+			strconv.Itoa(row.ServiceInstances),
+			created,
+		})
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 
@@ -46,16 +47,23 @@ type LoginCommand struct {
 	Config        command.Config
 	ActorReloader ActorReloader
 
+	// CredentialHelper supplies the username and password when they are
+	// not given as flags. It is nil when no helper is configured.
+	CredentialHelper CredentialHelper
+
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
 	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	ClientCert        string      `long:"client-cert" description:"Path to the PEM certificate presented to API endpoints that require mutual TLS"`
+	ClientKey         string      `long:"client-key" description:"Path to the PEM private key of the certificate given with --client-cert"`
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
 	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	DeviceFlow        bool        `long:"device-flow" description:"Print a code to approve the login with in a browser on another device"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --device-flow] [--origin ORIGIN] [--client-cert CERT_FILE --client-key KEY_FILE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider taking the password from a password manager with 'CF_NAME config --credential-helper'\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --device-flow (CF_NAME will provide a url and a code to approve the login with in a browser)\n   CF_NAME login --origin ldap"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -63,6 +71,7 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
+	cmd.CredentialHelper = newCredentialHelper(config)
 
 	cmd.UI = ui
 	cmd.Config = config
@@ -83,6 +92,11 @@ func (cmd *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = shared.SaveClientCertificate(cmd.Config, cmd.ClientCert, cmd.ClientKey)
+	if err != nil {
+		return err
+	}
+
 	endpoint, err := cmd.determineAPIEndpoint()
 	if err != nil {
 		return err
@@ -123,6 +137,8 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	var authErr error
 	if cmd.SSO || cmd.SSOPasscode != "" {
 		authErr = cmd.authenticateSSO()
+	} else if cmd.DeviceFlow {
+		authErr = cmd.authenticateDeviceFlow()
 	} else {
 		authErr = cmd.authenticate()
 	}
@@ -241,7 +257,13 @@ func (cmd *LoginCommand) determineAPIEndpoint() (v7action.TargetSettings, error)
 		parsedURL.Scheme = "https"
 	}
 
-	return v7action.TargetSettings{URL: parsedURL.String(), SkipSSLValidation: skipSSLValidation}, nil
+	return v7action.TargetSettings{
+		URL:               parsedURL.String(),
+		SkipSSLValidation: skipSSLValidation,
+		ClientCertificate: cmd.Config.ClientCertificate(),
+		IPFamily:          cmd.Config.IPFamily(),
+		ResolveOverrides:  cmd.Config.ResolveOverrides(),
+	}, nil
 }
 
 func (cmd *LoginCommand) targetAPI(settings v7action.TargetSettings) error {
@@ -269,6 +291,15 @@ func (cmd *LoginCommand) authenticate() error {
 
 	nonSensitivePrompts, sensitivePrompts := cmd.groupPrompts(prompts)
 
+	if _, ok := prompts["password"]; ok && cmd.CredentialHelper != nil && (cmd.Username == "" || cmd.Password == "") {
+		err = cmd.getCredentialsFromHelper()
+		if err != nil {
+			cmd.UI.DisplayWarning(err.Error())
+			cmd.UI.DisplayNewline()
+			return err
+		}
+	}
+
 	if value, ok := prompts["username"]; ok {
 		credentials["username"], err = cmd.getFlagValOrPrompt(&cmd.Username, value, true)
 		if err != nil {
@@ -351,6 +382,61 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return err
 }
 
+func (cmd *LoginCommand) authenticateDeviceFlow() error {
+	authorization, err := cmd.Actor.RequestDeviceAuthorization()
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.VerificationURI}} and enter the code {{.UserCode}}",
+		map[string]interface{}{
+			"VerificationURI": authorization.VerificationURI,
+			"UserCode":        authorization.UserCode,
+		})
+	if authorization.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("or visit {{.VerificationURIComplete}}",
+			map[string]interface{}{
+				"VerificationURIComplete": authorization.VerificationURIComplete,
+			})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+
+	err = cmd.Actor.AuthenticateWithDeviceAuthorization(authorization)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// getCredentialsFromHelper takes the username and password that were not
+// given as flags from the credential helper.
+func (cmd *LoginCommand) getCredentialsFromHelper() error {
+	credentials, err := cmd.CredentialHelper.Get(credentialhelper.Request{
+		Purpose:  credentialhelper.PurposeLogin,
+		Target:   cmd.Config.Target(),
+		Username: cmd.Username,
+	})
+	if err != nil {
+		return err
+	}
+
+	if cmd.Username == "" {
+		cmd.Username = credentials.Username
+	}
+	if cmd.Password == "" {
+		cmd.Password = credentials.Password
+	}
+	return nil
+}
+
 func (cmd *LoginCommand) groupPrompts(prompts map[string]coreconfig.AuthPrompt) (map[string]coreconfig.AuthPrompt, map[string]coreconfig.AuthPrompt) {
 	var (
 		nonPasswordPrompts = make(map[string]coreconfig.AuthPrompt)
@@ -575,6 +661,26 @@ func (cmd *LoginCommand) validateFlags() error {
 		}
 	}
 
+	if cmd.DeviceFlow {
+		conflicts := []struct {
+			flag string
+			set  bool
+		}{
+			{"--sso", cmd.SSO},
+			{"--sso-passcode", cmd.SSOPasscode != ""},
+			{"--origin", cmd.Origin != ""},
+			{"-u", cmd.Username != ""},
+			{"-p", cmd.Password != ""},
+		}
+		for _, conflict := range conflicts {
+			if conflict.set {
+				return translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-flow", conflict.flag},
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -596,6 +702,9 @@ func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if cmd.Origin != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--origin"}
 	}
+	if cmd.DeviceFlow {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device-flow"}
+	}
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -103,6 +104,32 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		When("the --device-flow and the --sso flag are used together", func() {
+			BeforeEach(func() {
+				cmd.DeviceFlow = true
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-flow", "--sso"},
+				}))
+			})
+		})
+
+		When("the --device-flow and the -u flag are used together", func() {
+			BeforeEach(func() {
+				cmd.DeviceFlow = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-flow", "-u"},
+				}))
+			})
+		})
+
 		When("the user has manually added their client credentials", func() {
 			BeforeEach(func() {
 				fakeConfig.UAAOAuthClientReturns("some-other-client-id")
@@ -159,6 +186,16 @@ var _ = Describe("login Command", func() {
 					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--origin"}))
 				})
 			})
+
+			When("device flow flag is provided", func() {
+				BeforeEach(func() {
+					cmd.DeviceFlow = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device-flow"}))
+				})
+			})
 		})
 	})
 
@@ -385,6 +422,42 @@ var _ = Describe("login Command", func() {
 					}, nil)
 				})
 
+				When("a credential helper is configured", func() {
+					var fakeCredentialHelper *v7fakes.FakeCredentialHelper
+
+					BeforeEach(func() {
+						fakeCredentialHelper = new(v7fakes.FakeCredentialHelper)
+						fakeCredentialHelper.GetReturns(credentialhelper.Credentials{Password: "helper-password"}, nil)
+						cmd.CredentialHelper = fakeCredentialHelper
+					})
+
+					It("takes the password from the helper instead of prompting", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Your Password"))
+
+						Expect(fakeCredentialHelper.GetArgsForCall(0)).To(Equal(credentialhelper.Request{
+							Purpose: credentialhelper.PurposeLogin,
+							Target:  "https://some.random.endpoint",
+						}))
+
+						credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+						Expect(credentials["password"]).To(Equal("helper-password"))
+					})
+
+					When("the helper fails", func() {
+						BeforeEach(func() {
+							fakeCredentialHelper.GetReturns(credentialhelper.Credentials{}, errors.New("helper failed"))
+						})
+
+						It("fails to authenticate without prompting", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+							Expect(testUI.Err).To(Say("helper failed"))
+							Expect(testUI.Out).NotTo(Say("Your Password"))
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				When("the password flag is set", func() {
 					BeforeEach(func() {
 						cmd.Password = "noprompto"
@@ -896,6 +969,63 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("Device Flow", func() {
+		fakeAPI := "whatever.com"
+
+		BeforeEach(func() {
+			cmd.DeviceFlow = true
+			fakeConfig.TargetReturns(fakeAPI)
+			fakeActor.RequestDeviceAuthorizationReturns(v7action.DeviceAuthorization{
+				DeviceCode:              "device-code",
+				UserCode:                "ABCD-EFGH",
+				VerificationURI:         "https://uaa.whatever.com/device",
+				VerificationURIComplete: "https://uaa.whatever.com/device?code=ABCD-EFGH",
+			}, nil)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+		})
+
+		It("prints the code and waits for the login to be approved", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`To log in, visit https://uaa.whatever.com/device and enter the code ABCD-EFGH`))
+			Expect(testUI.Out).To(Say(`or visit https://uaa.whatever.com/device\?code=ABCD-EFGH`))
+			Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0).DeviceCode).To(Equal("device-code"))
+			Expect(fakeConfig.WriteConfigCallCount()).To(Equal(1))
+		})
+
+		When("the login is declined", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceAuthorizationReturns(uaa.AccessDeniedError{Message: "The user denied the request."})
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("displays the reason and returns an error", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("The user denied the request."))
+				Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the device authorization cannot be requested", func() {
+			BeforeEach(func() {
+				fakeActor.RequestDeviceAuthorizationReturns(v7action.DeviceAuthorization{}, errors.New("request failed"))
+			})
+
+			It("returns an error without polling", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("request failed"))
+				Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Config", func() {
 		When("a user has successfully authenticated", func() {
 			BeforeEach(func() {
@@ -1,8 +1,10 @@
 package v7
 
 import (
+	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -11,15 +13,22 @@ import (
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Recent          bool                 `long:"recent" description:"Dump recent logs instead of tailing"`
+	WithEvents      bool                 `long:"with-events" description:"Interleave the recent events of the app with its recent logs; requires --recent"`
+	Filters         []flag.LogFilter     `long:"filter" description:"Only show the logs matching source=TYPE (e.g. APP, STG, RTR), instance=INDEX or message=REGEX; can specify multiple times, a log must match one of the sources, one of the instances and every message"`
+	Since           flag.Duration        `long:"since" description:"Only show the recent logs of this lookback window, e.g. 1h or 30m; requires --recent"`
+	Output          flag.OutputFormat    `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints one JSON object per log line with its timestamp, source, instance, message and tags"`
+	usage           interface{}          `usage:"CF_NAME logs [APP_NAME] [--recent [--with-events] [--since DURATION]] [--filter KEY=VALUE]... [--output json]\n\n   APP_NAME can be left out when the manifest in the current directory declares a single app.\n\nEXAMPLES:\n   CF_NAME logs my-app\n   CF_NAME logs my-app --recent\n   CF_NAME logs my-app --recent --with-events\n   CF_NAME logs my-app --recent --since 1h --filter source=RTR --filter 'message= 50[0-9] '\n   CF_NAME logs my-app --filter source=APP --filter instance=0\n   CF_NAME logs my-app --output json | jq -r .message"`
+	relatedCommands interface{}          `related_commands:"app, apps, events, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -35,34 +44,62 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	if cmd.WithEvents && !cmd.Recent {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--with-events",
+			Arg2: "--recent",
+		}
+	}
+
+	if cmd.Since.IsSet && !cmd.Recent {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--since",
+			Arg2: "--recent",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
 
-	user, err := cmd.Actor.GetCurrentUser()
+	cmd.RequiredArgs.AppName, err = shared.ResolveAppName(cmd.UI, cmd.RequiredArgs.AppName)
 	if err != nil {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	// Only the log lines are displayed as JSON, so that they can be piped.
+	if cmd.Output != flag.OutputFormatJSON {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppName,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
 	}
 
+	return cmd.tailLogs()
+}
+
+// tailLogs streams the logs of the app, refreshing the token while it does,
+// until the user interrupts it.
+func (cmd LogsCommand) tailLogs() error {
 	if !cmd.Config.IsCFOnK8s() {
 		stop := make(chan struct{})
 		stoppedRefreshing := make(chan struct{})
 		stoppedOutputtingRefreshErrors := make(chan struct{})
-		err = cmd.refreshTokenPeriodically(stop, stoppedRefreshing, stoppedOutputtingRefreshErrors)
+		err := cmd.refreshTokenPeriodically(stop, stoppedRefreshing, stoppedOutputtingRefreshErrors)
 		if err != nil {
 			return err
 		}
@@ -73,24 +110,157 @@ func (cmd LogsCommand) Execute(args []string) error {
 		}()
 	}
 
-	err = cmd.streamLogs()
-
-	return err
+	return cmd.streamLogs()
 }
 
 func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+	messages, warnings, err := cmd.Actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
-	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+	if !cmd.WithEvents || err != nil {
+		for _, message := range messages {
+			displayErr := cmd.displayLogMessage(message)
+			if displayErr != nil {
+				return displayErr
+			}
+		}
+
+		cmd.UI.DisplayWarnings(warnings)
+		return err
 	}
+	cmd.UI.DisplayWarnings(warnings)
 
+	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+	)
 	cmd.UI.DisplayWarnings(warnings)
-	return err
+	if err != nil {
+		return err
+	}
+
+	for _, message := range eventTimeline(messages, events) {
+		err = cmd.displayLogMessage(message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// logLine is a log message displayed as JSON.
+type logLine struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Source    string            `json:"source"`
+	Instance  string            `json:"instance"`
+	Type      string            `json:"type"`
+	Message   string            `json:"message"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// displayLogMessage displays a log message in the requested output format.
+func (cmd LogsCommand) displayLogMessage(message ui.LogMessage) error {
+	if cmd.Output != flag.OutputFormatJSON {
+		cmd.UI.DisplayLogMessage(message, true)
+		return nil
+	}
+
+	line := logLine{
+		Timestamp: message.Timestamp().UTC(),
+		Source:    message.SourceType(),
+		Instance:  message.SourceInstance(),
+		Type:      message.Type(),
+		Message:   message.Message(),
+	}
+	if tagged, ok := message.(interface{ Tags() map[string]string }); ok {
+		line.Tags = tagged.Tags()
+	}
+
+	return cmd.UI.DisplayJSONLine(line)
+}
+
+// logFilter combines the --filter and --since flags into the filter of the
+// logs to show.
+func (cmd LogsCommand) logFilter() sharedaction.LogFilter {
+	var filter sharedaction.LogFilter
+	for _, f := range cmd.Filters {
+		switch {
+		case f.SourceType != "":
+			filter.SourceTypes = append(filter.SourceTypes, f.SourceType)
+		case f.Instance != "":
+			filter.Instances = append(filter.Instances, f.Instance)
+		case f.Pattern != nil:
+			filter.Patterns = append(filter.Patterns, f.Pattern)
+		}
+	}
+
+	if cmd.Since.IsSet {
+		filter.Since = time.Now().Add(-cmd.Since.Value)
+	}
+
+	return filter
+}
+
+// eventTimeline merges the events of an app into its log messages, ordered by
+// time. Events older than the oldest message are left out so that the
+// timeline only covers the period the logs do.
+func eventTimeline(messages []sharedaction.LogMessage, events []v7action.Event) []ui.LogMessage {
+	var (
+		timeline []ui.LogMessage
+		oldest   time.Time
+	)
+	for _, message := range messages {
+		timeline = append(timeline, message)
+		if oldest.IsZero() || message.Timestamp().Before(oldest) {
+			oldest = message.Timestamp()
+		}
+	}
+
+	for _, event := range events {
+		if event.Time.Before(oldest) {
+			continue
+		}
+		timeline = append(timeline, eventLogMessage{event: event})
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Timestamp().Before(timeline[j].Timestamp())
+	})
+
+	return timeline
+}
+
+// eventLogMessage displays an app event as a log line.
+type eventLogMessage struct {
+	event v7action.Event
+}
+
+func (m eventLogMessage) Message() string {
+	if m.event.Description == "" {
+		return fmt.Sprintf("%s by %s", m.event.Type, m.event.ActorName)
+	}
+	return fmt.Sprintf("%s by %s: %s", m.event.Type, m.event.ActorName, m.event.Description)
+}
+
+func (eventLogMessage) Type() string {
+	return "EVT"
+}
+
+func (m eventLogMessage) Timestamp() time.Time {
+	return m.event.Time
+}
+
+func (eventLogMessage) SourceType() string {
+	return "EVENT"
+}
+
+func (eventLogMessage) SourceInstance() string {
+	return "API"
 }
 
 func (cmd LogsCommand) refreshTokenPeriodically(
@@ -131,10 +301,11 @@ func (cmd LogsCommand) handleLogErr(logErr error) {
 }
 
 func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -153,7 +324,10 @@ func (cmd LogsCommand) streamLogs() error {
 				messagesClosed = true
 				break
 			}
-			cmd.UI.DisplayLogMessage(message, true)
+			err = cmd.displayLogMessage(message)
+			if err != nil {
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -3,6 +3,7 @@ package v7_test
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -10,6 +11,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -97,7 +100,7 @@ var _ = Describe("logs command", func() {
 				var expectedErr error
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"all your base are belong to us",
@@ -121,7 +124,7 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"i am message 1",
@@ -150,14 +153,147 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 1"))
 					Expect(testUI.Out).To(Say("i am message 2"))
 
-					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, _ := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
 				})
 			})
+
+			When("the --output json flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Output = flag.OutputFormatJSON
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
+						[]sharedaction.LogMessage{
+							*sharedaction.NewLogMessageWithTags("GET /api 200", "OUT", time.Unix(10, 0), "RTR", "1", map[string]string{"source_type": "RTR", "app_name": "some-app"}),
+							*sharedaction.NewLogMessage("oops", "ERR", time.Unix(20, 0), "APP/PROC/WEB", "0"),
+						},
+						v7action.Warnings{"some-warning"},
+						nil)
+				})
+
+				It("displays one JSON object per log line and nothing else on stdout", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`^\{"timestamp":"1970-01-01T00:00:10Z","source":"RTR","instance":"1","type":"OUT","message":"GET /api 200","tags":\{"app_name":"some-app","source_type":"RTR"\}\}\n`))
+					Expect(testUI.Out).To(Say(`^\{"timestamp":"1970-01-01T00:00:20Z","source":"APP/PROC/WEB","instance":"0","type":"ERR","message":"oops"\}\n$`))
+					Expect(testUI.Err).To(Say("some-warning"))
+					Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the --filter and --since flags are provided", func() {
+				BeforeEach(func() {
+					cmd.Filters = []flag.LogFilter{
+						{SourceType: "RTR"},
+						{SourceType: "APP"},
+						{Instance: "0"},
+						{Pattern: regexp.MustCompile(" 5\\d\\d ")},
+					}
+					cmd.Since = flag.Duration{Value: time.Hour, IsSet: true}
+				})
+
+				It("gets the recent logs matching the filters of the lookback window", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					_, _, _, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter.SourceTypes).To(Equal([]string{"RTR", "APP"}))
+					Expect(filter.Instances).To(Equal([]string{"0"}))
+					Expect(filter.Patterns).To(Equal([]*regexp.Regexp{regexp.MustCompile(" 5\\d\\d ")}))
+					Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+				})
+			})
+
+			When("the --with-events flag is provided", func() {
+				BeforeEach(func() {
+					cmd.WithEvents = true
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
+						[]sharedaction.LogMessage{
+							*sharedaction.NewLogMessage("starting", "OUT", time.Unix(100, 0), "APP/PROC/WEB", "0"),
+							*sharedaction.NewLogMessage("out of memory", "ERR", time.Unix(300, 0), "APP/PROC/WEB", "0"),
+						},
+						v7action.Warnings{"get-logs-warning"},
+						nil)
+					fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(
+						[]v7action.Event{
+							{Time: time.Unix(400, 0), Type: "audit.app.restart", ActorName: "some-user"},
+							{Time: time.Unix(200, 0), Type: "app.crash", ActorName: "some-app", Description: "index: 0, reason: CRASHED"},
+							{Time: time.Unix(50, 0), Type: "audit.app.create", ActorName: "some-user"},
+						},
+						v7action.Warnings{"get-events-warning"},
+						nil)
+				})
+
+				It("interleaves the events with the logs in time order", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					appName, spaceGUID := fakeActor.GetRecentEventsByApplicationNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/0\] OUT starting`))
+					Expect(testUI.Out).To(Say(`\[EVENT/API\] EVT app.crash by some-app: index: 0, reason: CRASHED`))
+					Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/0\] ERR out of memory`))
+					Expect(testUI.Out).To(Say(`\[EVENT/API\] EVT audit.app.restart by some-user\n`))
+					Expect(testUI.Err).To(Say("get-logs-warning"))
+					Expect(testUI.Err).To(Say("get-events-warning"))
+				})
+
+				It("leaves out events older than the logs", func() {
+					Expect(testUI.Out).NotTo(Say("audit.app.create"))
+				})
+
+				When("getting the events fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"get-events-warning"}, errors.New("events-error"))
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError("events-error"))
+						Expect(testUI.Err).To(Say("get-events-warning"))
+					})
+				})
+
+				When("getting the logs fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(nil, nil, errors.New("logs-error"))
+					})
+
+					It("returns the error without getting the events", func() {
+						Expect(executeErr).To(MatchError("logs-error"))
+						Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		When("the --with-events flag is provided without --recent", func() {
+			BeforeEach(func() {
+				cmd.WithEvents = true
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+					Arg1: "--with-events",
+					Arg2: "--recent",
+				}))
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --since flag is provided without --recent", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.Duration{Value: time.Hour, IsSet: true}
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+					Arg1: "--since",
+					Arg2: "--recent",
+				}))
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
 		})
 
 		When("the --recent flag is not provided", func() {
@@ -180,7 +316,7 @@ var _ = Describe("logs command", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil,
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(nil,
 						nil,
 						nil,
 						v7action.Warnings{"some-warning-1",
@@ -204,8 +340,8 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("banana")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(appName string, spaceGUID string, client sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error,
 							context.CancelFunc,
@@ -244,7 +380,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("firs swimming"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
@@ -267,8 +403,8 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(_ string, _ string, _ sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error, context.CancelFunc,
 							v7action.Warnings,
@@ -300,14 +436,43 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("Here are some staging logs!"))
 					Expect(testUI.Out).To(Say("Here are some other staging logs!"))
 
-					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, _ := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
 				})
 
+				When("the --output json flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Output = flag.OutputFormatJSON
+					})
+
+					It("streams the log messages as JSON lines", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`\{"timestamp":"[^"]+","source":"STG","instance":"sourceInstance","type":"OUT","message":"Here are some staging logs!"\}\n`))
+						Expect(testUI.Out).To(Say(`\{"timestamp":"[^"]+","source":"STG","instance":"sourceInstance","type":"OUT","message":"Here are some other staging logs!"\}\n`))
+					})
+				})
+
+				When("the --filter flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Filters = []flag.LogFilter{{SourceType: "STG"}, {Instance: "1"}}
+					})
+
+					It("streams the logs matching the filters", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						_, _, _, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+						Expect(filter).To(Equal(sharedaction.LogFilter{
+							SourceTypes: []string{"STG"},
+							Instances:   []string{"1"},
+						}))
+					})
+				})
+
 				When("scheduling a token refresh errors immediately", func() {
 					BeforeEach(func() {
 						cmd.Recent = false
@@ -315,15 +480,15 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("fjords pining"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
 				When("there is an error refreshing a token sometime later", func() {
 					BeforeEach(func() {
 						cmd.Recent = false
-						fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-							func(_ string, _ string, _ sharedaction.LogCacheClient) (
+						fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+							func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 								<-chan sharedaction.LogMessage,
 								<-chan error, context.CancelFunc,
 								v7action.Warnings,
@@ -355,7 +520,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
 						Expect(testUI.Err).To(Say("fjords pining"))
 					})
 				})
@@ -0,0 +1,91 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type LogsTaskCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.TerminateTaskArgs `positional-args:"yes"`
+	Recent          bool                   `long:"recent" description:"Dump recent logs instead of tailing"`
+	Output          flag.OutputFormat      `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints one JSON object per log line with its timestamp, source, instance, message and tags"`
+	usage           interface{}            `usage:"CF_NAME logs-task APP_NAME TASK_ID [--recent] [--output json]\n\n   Shows only the logs of one task of the app, leaving out the logs of its processes and other tasks.\n\nEXAMPLES:\n   CF_NAME logs-task my-app 3\n   CF_NAME logs-task my-app 3 --recent"`
+	relatedCommands interface{}            `related_commands:"logs, run-task, tasks, terminate-task"`
+
+	Logs LogsCommand `no-flag:"true"`
+}
+
+func (cmd *LogsTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Logs.Setup(config, ui)
+}
+
+// Execute shows the logs of a task, which are the app logs whose source is
+// the task and whose instance is the task GUID.
+func (cmd LogsTaskCommand) Execute(args []string) error {
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err != nil {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "TASK_ID",
+			ExpectedType: "integer",
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output != flag.OutputFormatJSON {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for task {{.TaskSequenceID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"TaskSequenceID": cmd.RequiredArgs.SequenceID,
+				"AppName":        cmd.RequiredArgs.AppName,
+				"OrgName":        cmd.Config.TargetedOrganization().Name,
+				"SpaceName":      space.Name,
+				"Username":       user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
+
+	logs := cmd.Logs
+	logs.BaseCommand = cmd.BaseCommand
+	logs.RequiredArgs = flag.OptionalAppName{AppName: cmd.RequiredArgs.AppName}
+	logs.Output = cmd.Output
+	logs.Filters = []flag.LogFilter{
+		{SourceType: "APP/TASK/" + task.Name},
+		{Instance: task.GUID},
+	}
+
+	if cmd.Recent {
+		return logs.displayRecentLogs()
+	}
+
+	return logs.tailLogs()
+}
@@ -0,0 +1,154 @@
+package v7_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("logs-task command", func() {
+	var (
+		cmd             LogsTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		logCacheClient  *sharedactionfakes.FakeLogCacheClient
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		logCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		cmd = LogsTaskCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.TerminateTaskArgs{AppName: "some-app", SequenceID: "3"},
+			Logs:         LogsCommand{LogCacheClient: logCacheClient},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.IsCFOnK8sReturns(true)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetTaskBySequenceIDAndApplicationReturns(resources.Task{GUID: "task-guid", Name: "migrate"}, v7action.Warnings{"get-task-warning"}, nil)
+		fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (
+			<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+			messages := make(chan sharedaction.LogMessage, 1)
+			messages <- *sharedaction.NewLogMessage("task output", "OUT", time.Unix(0, 0), "APP/TASK/migrate", "task-guid")
+			close(messages)
+			errs := make(chan error)
+			close(errs)
+			return messages, errs, func() {}, v7action.Warnings{"streaming-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("streams the logs of the task only", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(1))
+		sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+		Expect(sequenceID).To(Equal(3))
+		Expect(appGUID).To(Equal("some-app-guid"))
+
+		Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID, client, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(client).To(Equal(logCacheClient))
+		Expect(filter).To(Equal(sharedaction.LogFilter{
+			SourceTypes: []string{"APP/TASK/migrate"},
+			Instances:   []string{"task-guid"},
+		}))
+
+		Expect(testUI.Out).To(Say(`Retrieving logs for task 3 of app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/task-guid\] OUT task output`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-task-warning"))
+		Expect(testUI.Err).To(Say("streaming-warning"))
+	})
+
+	When("--recent is passed", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns([]sharedaction.LogMessage{
+				*sharedaction.NewLogMessage("task output", "OUT", time.Unix(0, 0), "APP/TASK/migrate", "task-guid"),
+			}, v7action.Warnings{"recent-warning"}, nil)
+		})
+
+		It("displays the recent logs of the task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+
+			_, _, _, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+			Expect(filter.Instances).To(Equal([]string{"task-guid"}))
+			Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/task-guid\] OUT task output`))
+			Expect(testUI.Err).To(Say("recent-warning"))
+		})
+	})
+
+	When("the task ID is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = "three"
+		})
+
+		It("returns a parse error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the task does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(resources.Task{}, nil, errors.New("task-not-found"))
+		})
+
+		It("returns the error without streaming", func() {
+			Expect(executeErr).To(MatchError("task-not-found"))
+			Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type LookupCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.GUID   `positional-args:"yes"`
+	usage           interface{} `usage:"CF_NAME lookup GUID\n\nEXAMPLES:\n   CF_NAME lookup 2b9c4ad7-0f6e-4a3c-9d2e-7c1e3f5a8b10"`
+	relatedCommands interface{} `related_commands:"app, curl, events, org, space"`
+}
+
+func (cmd LookupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Looking up {{.GUID}} as {{.Username}}...", map[string]interface{}{
+		"GUID":     cmd.RequiredArgs.GUID,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	results, warnings, err := cmd.Actor.LookupGUID(cmd.RequiredArgs.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		cmd.UI.DisplayText("No app, space, org, route, service instance, task or build found with GUID {{.GUID}}.", map[string]interface{}{
+			"GUID": cmd.RequiredArgs.GUID,
+		})
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("type"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("org"),
+	}}
+	for _, result := range results {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(result.Type)),
+			result.Name,
+			result.AppName,
+			result.SpaceName,
+			result.OrgName,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("lookup Command", func() {
+	var (
+		cmd             LookupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = LookupCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.GUID = "some-guid"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the GUID is found", func() {
+		BeforeEach(func() {
+			fakeActor.LookupGUIDReturns(
+				[]v7action.GUIDLookupResult{{
+					Type:      v7action.GUIDResourceTask,
+					GUID:      "some-guid",
+					Name:      "some-task",
+					AppName:   "some-app",
+					SpaceName: "some-space",
+					OrgName:   "some-org",
+				}},
+				v7action.Warnings{"lookup-warning"},
+				nil,
+			)
+		})
+
+		It("displays what the GUID refers to", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.LookupGUIDArgsForCall(0)).To(Equal("some-guid"))
+
+			Expect(testUI.Out).To(Say(`Looking up some-guid as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`type\s+name\s+app\s+space\s+org`))
+			Expect(testUI.Out).To(Say(`task\s+some-task\s+some-app\s+some-space\s+some-org`))
+			Expect(testUI.Err).To(Say("lookup-warning"))
+		})
+	})
+
+	When("nothing has the GUID", func() {
+		BeforeEach(func() {
+			fakeActor.LookupGUIDReturns(nil, v7action.Warnings{"lookup-warning"}, nil)
+		})
+
+		It("says that nothing was found", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No app, space, org, route, service instance, task or build found with GUID some-guid\.`))
+			Expect(testUI.Err).To(Say("lookup-warning"))
+		})
+	})
+
+	When("looking up the GUID fails", func() {
+		BeforeEach(func() {
+			fakeActor.LookupGUIDReturns(nil, v7action.Warnings{"lookup-warning"}, errors.New("lookup-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("lookup-error"))
+			Expect(testUI.Err).To(Say("lookup-warning"))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/projectconfig"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// applyProjectManifestDefaults sets the manifest and the vars files that are
+// not given to the ones of the push defaults of the project config, whose
+// paths are relative to the project directory. It returns whether it set any.
+func applyProjectManifestDefaults(config projectconfig.Config, pathToManifest *flag.ManifestPathWithExistenceCheck, pathsToVarsFiles *[]flag.PathWithExistenceCheck) (bool, error) {
+	defaults := config.Push
+	applied := false
+
+	if *pathToManifest == "" && defaults.Manifest != "" {
+		path := config.ResolvePath(defaults.Manifest)
+		if _, err := os.Stat(path); err != nil {
+			return false, translatableerror.FileNotFoundError{Path: path}
+		}
+		*pathToManifest = flag.ManifestPathWithExistenceCheck(path)
+		applied = true
+	}
+
+	if len(*pathsToVarsFiles) == 0 {
+		for _, varsFile := range defaults.VarsFiles {
+			path := config.ResolvePath(varsFile)
+			if _, err := os.Stat(path); err != nil {
+				return false, translatableerror.FileNotFoundError{Path: path}
+			}
+			*pathsToVarsFiles = append(*pathsToVarsFiles, flag.PathWithExistenceCheck(path))
+			applied = true
+		}
+	}
+
+	return applied, nil
+}
+
+// interpolateManifest merges the overlays over the manifest, if any are
+// given, and interpolates its variables, the way push does.
+func interpolateManifest(parser ManifestParser, pathToManifest string, pathsToOverlays []flag.PathWithExistenceCheck, pathsToVarsFiles []flag.PathWithExistenceCheck, vars []template.VarKV) ([]byte, error) {
+	var varsFiles []string
+	for _, varsFilePath := range pathsToVarsFiles {
+		varsFiles = append(varsFiles, string(varsFilePath))
+	}
+
+	if len(pathsToOverlays) == 0 {
+		return parser.InterpolateManifest(pathToManifest, varsFiles, vars)
+	}
+
+	var overlays []string
+	for _, overlayPath := range pathsToOverlays {
+		overlays = append(overlays, string(overlayPath))
+	}
+	return parser.InterpolateManifestWithOverlays(pathToManifest, overlays, varsFiles, vars)
+}
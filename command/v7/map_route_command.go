@@ -2,17 +2,22 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type MapRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port         int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	AppProtocol  string           `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	RequiredArgs        flag.AppDomain   `positional-args:"yes"`
+	Hostname            string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path                flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
+	Port                int              `long:"port" description:"Port for the TCP route (default: random port)"`
+	DestinationProtocol string           `long:"destination-protocol" choice:"http1" choice:"http2" description:"Protocol for the route destination, use http2 for gRPC apps (default: http1). Only applied to HTTP routes"`
+	AppProtocol         string           `long:"app-protocol" choice:"http1" choice:"http2" description:"Same as --destination-protocol"`
+	AppPort             int              `long:"app-port" description:"Port of the app instances to send the route traffic to, set with set-ports (default: 8080)"`
 
 	relatedCommands interface{} `related_commands:"create-route, routes, unmap-route"`
 }
@@ -20,10 +25,10 @@ type MapRouteCommand struct {
 func (cmd MapRouteCommand) Usage() string {
 	return `
 Map an HTTP route:
-   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]
+   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--destination-protocol PROTOCOL] [--app-port APP_PORT]
 
 Map a TCP route:
-   CF_NAME map-route APP_NAME DOMAIN [--port PORT]`
+   CF_NAME map-route APP_NAME DOMAIN [--port PORT] [--app-port APP_PORT]`
 }
 
 func (cmd MapRouteCommand) Examples() string {
@@ -31,12 +36,25 @@ func (cmd MapRouteCommand) Examples() string {
 CF_NAME map-route my-app example.com                                                # example.com
 CF_NAME map-route my-app example.com --hostname myhost                              # myhost.example.com
 CF_NAME map-route my-app example.com --hostname myhost --path foo                   # myhost.example.com/foo
-CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2 # myhost.example.com
+CF_NAME map-route my-app example.com --hostname myhost --destination-protocol http2 # myhost.example.com
+CF_NAME map-route my-app example.com --hostname admin --app-port 9090               # admin.example.com
 CF_NAME map-route my-app example.com --port 5000                                    # example.com:5000`
 }
 
 func (cmd MapRouteCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	protocol, err := cmd.destinationProtocol()
+	if err != nil {
+		return err
+	}
+
+	if cmd.AppPort < 0 || cmd.AppPort > 65535 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--app-port",
+			ExpectedType: "an integer between 1 and 65535",
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -83,19 +101,22 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 		)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
+			if _, ok := err.(actionerror.RouteAlreadyExistsError); ok {
+				return cmd.routeCollisionError(domain, path, err)
+			}
 			return err
 		}
 		cmd.UI.DisplayOK()
 	}
 
-	if cmd.AppProtocol != "" {
+	if protocol != "" {
 		cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}} with protocol {{.Protocol}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
 			"URL":       route.URL,
 			"AppName":   cmd.RequiredArgs.App,
 			"User":      user.Name,
 			"SpaceName": cmd.Config.TargetedSpace().Name,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"Protocol":  cmd.AppProtocol,
+			"Protocol":  protocol,
 		})
 
 	} else {
@@ -107,11 +128,9 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
 		})
 	}
-	dest, err := cmd.Actor.GetRouteDestinationByAppGUID(route, app.GUID)
+	dest, err := cmd.existingDestination(route, app.GUID)
 	if err != nil {
-		if _, ok := err.(actionerror.RouteDestinationNotFoundError); !ok {
-			return err
-		}
+		return err
 	}
 	if dest.GUID != "" {
 		cmd.UI.DisplayText("App '{{ .AppName }}' is already mapped to route '{{ .URL}}'. Nothing has been updated.", map[string]interface{}{
@@ -121,12 +140,65 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 		cmd.UI.DisplayOK()
 		return nil
 	}
-	warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, cmd.AppProtocol)
+	if cmd.AppPort != 0 {
+		warnings, err = cmd.Actor.MapRouteToAppPort(route.GUID, app.GUID, protocol, cmd.AppPort)
+	} else {
+		warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, protocol)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		if route.SpaceGUID != spaceGUID {
+			return cmd.routeCollisionError(domain, path, err)
+		}
 		return err
 	}
 	cmd.UI.DisplayOK()
 
 	return nil
 }
+
+// existingDestination returns the destination of the route that already sends
+// traffic to the web process of the app, on the --app-port when it is given.
+func (cmd MapRouteCommand) existingDestination(route resources.Route, appGUID string) (resources.RouteDestination, error) {
+	if cmd.AppPort != 0 {
+		for _, destination := range route.Destinations {
+			if destination.App.GUID == appGUID && destination.App.Process.Type == constant.ProcessTypeWeb && destination.Port == cmd.AppPort {
+				return destination, nil
+			}
+		}
+		return resources.RouteDestination{}, nil
+	}
+
+	dest, err := cmd.Actor.GetRouteDestinationByAppGUID(route, appGUID)
+	if err != nil {
+		if _, ok := err.(actionerror.RouteDestinationNotFoundError); !ok {
+			return resources.RouteDestination{}, err
+		}
+	}
+	return dest, nil
+}
+
+// routeCollisionError explains why the route is taken by another space
+// instead of returning the bare Cloud Controller error, which is kept when the
+// route cannot be looked up.
+func (cmd MapRouteCommand) routeCollisionError(domain resources.Domain, path string, err error) error {
+	collision, warnings, lookupErr := cmd.Actor.GetRouteCollision(domain, cmd.Hostname, path, cmd.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if lookupErr != nil {
+		return err
+	}
+	return collision
+}
+
+func (cmd MapRouteCommand) destinationProtocol() (string, error) {
+	if cmd.DestinationProtocol != "" && cmd.AppProtocol != "" && cmd.DestinationProtocol != cmd.AppProtocol {
+		return "", translatableerror.ArgumentCombinationError{
+			Args: []string{"--destination-protocol", "--app-protocol"},
+		}
+	}
+
+	if cmd.DestinationProtocol != "" {
+		return cmd.DestinationProtocol, nil
+	}
+	return cmd.AppProtocol, nil
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -257,12 +258,63 @@ var _ = Describe("map-route Command", func() {
 						Expect(actualPath).To(Equal(path))
 						Expect(actualPort).To(Equal(cmd.Port))
 					})
+
+					When("another space has the route", func() {
+						BeforeEach(func() {
+							fakeActor.CreateRouteReturns(resources.Route{}, nil, actionerror.RouteAlreadyExistsError{Err: errors.New("Route already exists")})
+							fakeActor.GetRouteCollisionReturns(
+								actionerror.RouteInDifferentSpaceError{Route: "host.some-domain.com/path", SuggestedHost: "host-2"},
+								v7action.Warnings{"get-route-collision-warnings"},
+								nil,
+							)
+						})
+
+						It("explains the collision", func() {
+							Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "host.some-domain.com/path", SuggestedHost: "host-2"}))
+							Expect(testUI.Err).To(Say("get-route-collision-warnings"))
+
+							Expect(fakeActor.GetRouteCollisionCallCount()).To(Equal(1))
+							actualDomain, actualHostname, actualPath, actualPort := fakeActor.GetRouteCollisionArgsForCall(0)
+							Expect(actualDomain.GUID).To(Equal("domain-guid"))
+							Expect(actualHostname).To(Equal(hostname))
+							Expect(actualPath).To(Equal(path))
+							Expect(actualPort).To(Equal(cmd.Port))
+						})
+
+						When("the collision cannot be explained", func() {
+							BeforeEach(func() {
+								fakeActor.GetRouteCollisionReturns(actionerror.RouteInDifferentSpaceError{}, nil, errors.New("get-route-collision-error"))
+							})
+
+							It("returns the error of creating the route", func() {
+								Expect(executeErr).To(MatchError("Route already exists"))
+							})
+						})
+					})
+				})
+
+				When("the requested route exists in another space", func() {
+					BeforeEach(func() {
+						fakeActor.GetRouteByAttributesReturns(resources.Route{GUID: "route-guid", SpaceGUID: "other-space-guid"}, nil, nil)
+						fakeActor.GetRouteDestinationByAppGUIDReturns(resources.RouteDestination{}, actionerror.RouteDestinationNotFoundError{})
+						fakeActor.MapRouteReturns(nil, errors.New("map-route-error"))
+						fakeActor.GetRouteCollisionReturns(
+							actionerror.RouteInDifferentSpaceError{Route: "host.some-domain.com/path", OrgName: "other-org", SpaceName: "other-space"},
+							nil,
+							nil,
+						)
+					})
+
+					It("explains the collision when mapping fails", func() {
+						Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "host.some-domain.com/path", OrgName: "other-org", SpaceName: "other-space"}))
+						Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+					})
 				})
 
 				When("the requested route exists", func() {
 					BeforeEach(func() {
 						fakeActor.GetRouteByAttributesReturns(
-							resources.Route{GUID: "route-guid"},
+							resources.Route{GUID: "route-guid", SpaceGUID: spaceGUID},
 							v7action.Warnings{"get-route-warnings"},
 							nil,
 						)
@@ -424,7 +476,7 @@ var _ = Describe("map-route Command", func() {
 				When("a tcp route is requested without a port", func() {
 					BeforeEach(func() {
 						fakeActor.GetRouteByAttributesReturns(
-							resources.Route{GUID: "route-guid"},
+							resources.Route{GUID: "route-guid", SpaceGUID: spaceGUID},
 							v7action.Warnings{"get-route-warnings"},
 							nil,
 						)
@@ -585,4 +637,111 @@ var _ = Describe("map-route Command", func() {
 			})
 		})
 	})
+
+	When("--destination-protocol is provided", func() {
+		BeforeEach(func() {
+			cmd.AppProtocol = ""
+			cmd.DestinationProtocol = "http2"
+
+			fakeActor.GetDomainByNameReturns(resources.Domain{Name: "some-domain.com", GUID: "domain-guid"}, nil, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "app-guid"}, nil, nil)
+			fakeActor.GetRouteByAttributesReturns(resources.Route{GUID: "route-guid", URL: "host.some-domain.com/path"}, nil, nil)
+			fakeActor.GetRouteDestinationByAppGUIDReturns(resources.RouteDestination{}, actionerror.RouteDestinationNotFoundError{})
+		})
+
+		It("maps the route with that protocol", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Mapping route host.some-domain.com/path to app my-app with protocol http2 in org some-org / space some-space as steve\.\.\.`))
+
+			_, _, actualProtocol := fakeActor.MapRouteArgsForCall(0)
+			Expect(actualProtocol).To(Equal("http2"))
+		})
+
+		When("--app-protocol is also provided with a different value", func() {
+			BeforeEach(func() {
+				cmd.AppProtocol = "http1"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--destination-protocol", "--app-protocol"},
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+			})
+		})
+
+		When("--app-protocol is also provided with the same value", func() {
+			BeforeEach(func() {
+				cmd.AppProtocol = "http2"
+			})
+
+			It("maps the route with that protocol", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, actualProtocol := fakeActor.MapRouteArgsForCall(0)
+				Expect(actualProtocol).To(Equal("http2"))
+			})
+		})
+	})
+
+	When("--app-port is provided", func() {
+		var route resources.Route
+
+		BeforeEach(func() {
+			cmd.AppProtocol = ""
+			cmd.AppPort = 9090
+
+			route = resources.Route{
+				GUID: "route-guid",
+				URL:  "host.some-domain.com/path",
+				Destinations: []resources.RouteDestination{
+					{GUID: "web-dest-guid", App: resources.RouteDestinationApp{GUID: "app-guid", Process: struct{ Type string }{Type: "web"}}, Port: 8080},
+				},
+			}
+			fakeActor.GetDomainByNameReturns(resources.Domain{Name: "some-domain.com", GUID: "domain-guid"}, nil, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "app-guid"}, nil, nil)
+			fakeActor.GetRouteByAttributesReturns(route, nil, nil)
+			fakeActor.MapRouteToAppPortReturns(v7action.Warnings{"map-route-warning"}, nil)
+		})
+
+		It("maps the route to that port of the app even when it is mapped to another port", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("map-route-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetRouteDestinationByAppGUIDCallCount()).To(Equal(0))
+			Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+			Expect(fakeActor.MapRouteToAppPortCallCount()).To(Equal(1))
+			routeGUID, appGUID, protocol, appPort := fakeActor.MapRouteToAppPortArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(protocol).To(BeEmpty())
+			Expect(appPort).To(Equal(9090))
+		})
+
+		When("the route is already mapped to that port of the app", func() {
+			BeforeEach(func() {
+				cmd.AppPort = 8080
+			})
+
+			It("exits 0 with a helpful message that the route is already mapped to the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`App 'my-app' is already mapped to route 'host.some-domain.com/path'\. Nothing has been updated\.`))
+				Expect(fakeActor.MapRouteToAppPortCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the port is out of range", func() {
+			BeforeEach(func() {
+				cmd.AppPort = 70000
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "--app-port",
+					ExpectedType: "an integer between 1 and 65535",
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+			})
+		})
+	})
 })
@@ -21,6 +21,10 @@ type MarketplaceCommand struct {
 	relatedCommands     interface{} `related_commands:"create-service, services"`
 }
 
+func (MarketplaceCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd MarketplaceCommand) Execute(args []string) error {
 	var username string
 
@@ -0,0 +1,169 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type MigrateRoutesCommand struct {
+	BaseCommand
+
+	FromDomain      string        `long:"from-domain" required:"true" description:"Domain whose routes are migrated"`
+	ToDomain        string        `long:"to-domain" required:"true" description:"Domain the routes are recreated on"`
+	DryRun          bool          `long:"dry-run" description:"List the routes that would be migrated without changing anything"`
+	GracePeriod     flag.Duration `long:"grace-period" description:"Wait this long after migrating before deleting the old routes, e.g. 30m or 1d"`
+	KeepOldRoutes   bool          `long:"keep-old-routes" description:"Keep the routes on the old domain mapped to their destinations"`
+	usage           interface{}   `usage:"CF_NAME migrate-routes --from-domain DOMAIN --to-domain DOMAIN [--dry-run] [--keep-old-routes | --grace-period DURATION]\n\n   Recreates every route of a domain on another domain, with the same hostname, path, port and space,\n   and maps it to the same destinations. Once all the routes are migrated, the old routes are deleted,\n   after the grace period if one is given. Running the command again resumes an interrupted migration.\n\nEXAMPLES:\n   CF_NAME migrate-routes --from-domain old.example.com --to-domain new.example.com --dry-run\n   CF_NAME migrate-routes --from-domain old.example.com --to-domain new.example.com --grace-period 1h"`
+	relatedCommands interface{}   `related_commands:"delete-orphaned-routes, domains, map-route, routes"`
+
+	After func(time.Duration) <-chan time.Time
+}
+
+func (cmd *MigrateRoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.After = time.After
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd MigrateRoutesCommand) Execute(args []string) error {
+	if cmd.KeepOldRoutes && cmd.GracePeriod.IsSet {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--keep-old-routes", "--grace-period"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	fromDomain, warnings, err := cmd.Actor.GetDomainByName(cmd.FromDomain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	toDomain, warnings, err := cmd.Actor.GetDomainByName(cmd.ToDomain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if fromDomain.IsTCP() != toDomain.IsTCP() {
+		return translatableerror.DomainProtocolMismatchError{FromDomain: fromDomain.Name, ToDomain: toDomain.Name}
+	}
+
+	message := "Migrating routes from domain {{.FromDomain}} to domain {{.ToDomain}} as {{.User}}..."
+	if cmd.DryRun {
+		message = "Checking the routes to migrate from domain {{.FromDomain}} to domain {{.ToDomain}} as {{.User}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
+		"FromDomain": fromDomain.Name,
+		"ToDomain":   toDomain.Name,
+		"User":       user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	routes, warnings, err := cmd.Actor.GetRoutesByDomain(fromDomain.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No routes found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("route"),
+		cmd.UI.TranslateText("new route"),
+		cmd.UI.TranslateText("destinations"),
+		cmd.UI.TranslateText("result"),
+	}}
+
+	var migrated []resources.Route
+	for _, route := range routes {
+		newURL := desiredURL(toDomain.Name, route.Host, route.Path, route.Port)
+		row := []string{route.URL, newURL, strconv.Itoa(len(route.Destinations))}
+
+		if cmd.DryRun {
+			table = append(table, append(row, cmd.UI.TranslateText("to migrate")))
+			continue
+		}
+
+		_, warnings, err := cmd.Actor.MigrateRoute(route, toDomain)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			table = append(table, append(row, errorText(cmd.UI, err)))
+			continue
+		}
+
+		table = append(table, append(row, cmd.UI.TranslateText("migrated")))
+		migrated = append(migrated, route)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("{{.Total}} routes would be migrated. Nothing has been changed.", map[string]interface{}{
+			"Total": len(routes),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Migrated {{.Migrated}} of {{.Total}} routes.", map[string]interface{}{
+		"Migrated": len(migrated),
+		"Total":    len(routes),
+	})
+
+	if !cmd.KeepOldRoutes && len(migrated) > 0 {
+		err = cmd.deleteOldRoutes(migrated)
+		if err != nil {
+			return err
+		}
+	}
+
+	if failed := len(routes) - len(migrated); failed > 0 {
+		return translatableerror.RoutesNotMigratedError{Count: failed}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// deleteOldRoutes deletes the routes that have been migrated, once the grace
+// period, if any, has passed.
+func (cmd MigrateRoutesCommand) deleteOldRoutes(routes []resources.Route) error {
+	if cmd.GracePeriod.IsSet {
+		cmd.UI.DisplayText("Waiting {{.GracePeriod}} before deleting the old routes...", map[string]interface{}{
+			"GracePeriod": cmd.GracePeriod.Value,
+		})
+		<-cmd.After(cmd.GracePeriod.Value)
+	}
+
+	for _, route := range routes {
+		cmd.UI.DisplayText("Deleting old route {{.URL}}...", map[string]interface{}{
+			"URL": route.URL,
+		})
+
+		warnings, err := cmd.Actor.DeleteRouteByGUID(route.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
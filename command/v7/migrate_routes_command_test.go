@@ -0,0 +1,237 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("migrate-routes Command", func() {
+	var (
+		cmd             MigrateRoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		waited          []time.Duration
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		waited = nil
+
+		cmd = MigrateRoutesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			FromDomain: "old.com",
+			ToDomain:   "new.com",
+			After: func(d time.Duration) <-chan time.Time {
+				waited = append(waited, d)
+				ch := make(chan time.Time, 1)
+				ch <- time.Time{}
+				return ch
+			},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetDomainByNameStub = func(name string) (resources.Domain, v7action.Warnings, error) {
+			return resources.Domain{Name: name, GUID: name + "-guid"}, v7action.Warnings{"get-domain-warning"}, nil
+		}
+		fakeActor.GetRoutesByDomainReturns([]resources.Route{
+			{
+				GUID:         "route-1-guid",
+				Host:         "app",
+				URL:          "app.old.com",
+				Destinations: []resources.RouteDestination{{GUID: "destination-guid"}},
+			},
+			{
+				GUID: "route-2-guid",
+				Host: "api",
+				Path: "/v1",
+				URL:  "api.old.com/v1",
+			},
+		}, v7action.Warnings{"get-routes-warning"}, nil)
+		fakeActor.MigrateRouteReturns(resources.Route{}, v7action.Warnings{"migrate-route-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("recreates every route on the new domain and deletes the old routes", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+
+		Expect(fakeActor.GetRoutesByDomainArgsForCall(0)).To(Equal("old.com-guid"))
+		Expect(fakeActor.MigrateRouteCallCount()).To(Equal(2))
+		route, toDomain := fakeActor.MigrateRouteArgsForCall(1)
+		Expect(route.GUID).To(Equal("route-2-guid"))
+		Expect(toDomain.GUID).To(Equal("new.com-guid"))
+
+		Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(2))
+		Expect(fakeActor.DeleteRouteByGUIDArgsForCall(0)).To(Equal("route-1-guid"))
+		Expect(fakeActor.DeleteRouteByGUIDArgsForCall(1)).To(Equal("route-2-guid"))
+		Expect(waited).To(BeEmpty())
+
+		Expect(testUI.Out).To(Say(`Migrating routes from domain old\.com to domain new\.com as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`route\s+new route\s+destinations\s+result`))
+		Expect(testUI.Out).To(Say(`app\.old\.com\s+app\.new\.com\s+1\s+migrated`))
+		Expect(testUI.Out).To(Say(`api\.old\.com/v1\s+api\.new\.com/v1\s+0\s+migrated`))
+		Expect(testUI.Out).To(Say(`Migrated 2 of 2 routes\.`))
+		Expect(testUI.Out).To(Say(`Deleting old route app\.old\.com\.\.\.`))
+		Expect(testUI.Out).To(Say(`OK`))
+
+		Expect(testUI.Err).To(Say("get-domain-warning"))
+		Expect(testUI.Err).To(Say("get-routes-warning"))
+		Expect(testUI.Err).To(Say("migrate-route-warning"))
+	})
+
+	When("--dry-run is passed", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("lists the routes without changing anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.MigrateRouteCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`app\.old\.com\s+app\.new\.com\s+1\s+to migrate`))
+			Expect(testUI.Out).To(Say(`2 routes would be migrated\. Nothing has been changed\.`))
+		})
+	})
+
+	When("--keep-old-routes is passed", func() {
+		BeforeEach(func() {
+			cmd.KeepOldRoutes = true
+		})
+
+		It("does not delete the old routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.MigrateRouteCallCount()).To(Equal(2))
+			Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(0))
+		})
+
+		When("--grace-period is passed too", func() {
+			BeforeEach(func() {
+				cmd.GracePeriod = flag.Duration{Value: time.Hour, IsSet: true}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--keep-old-routes", "--grace-period"},
+				}))
+			})
+		})
+	})
+
+	When("--grace-period is passed", func() {
+		BeforeEach(func() {
+			cmd.GracePeriod = flag.Duration{Value: time.Hour, IsSet: true}
+		})
+
+		It("waits before deleting the old routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(waited).To(Equal([]time.Duration{time.Hour}))
+			Expect(testUI.Out).To(Say(`Waiting 1h0m0s before deleting the old routes\.\.\.`))
+			Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(2))
+		})
+	})
+
+	When("a route cannot be migrated", func() {
+		BeforeEach(func() {
+			fakeActor.MigrateRouteReturnsOnCall(0, resources.Route{}, nil, actionerror.RouteInDifferentSpaceError{Route: "app.new.com"})
+		})
+
+		It("migrates the other routes and keeps the old route", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RoutesNotMigratedError{Count: 1}))
+
+			Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteRouteByGUIDArgsForCall(0)).To(Equal("route-2-guid"))
+
+			Expect(testUI.Out).To(Say(`app\.old\.com\s+app\.new\.com\s+1\s+The app cannot be mapped to route app\.new\.com because the route exists in a different space`))
+			Expect(testUI.Out).To(Say(`api\.old\.com/v1\s+api\.new\.com/v1\s+0\s+migrated`))
+			Expect(testUI.Out).To(Say(`Migrated 1 of 2 routes\.`))
+		})
+	})
+
+	When("the domains do not have the same protocol", func() {
+		BeforeEach(func() {
+			fakeActor.GetDomainByNameStub = func(name string) (resources.Domain, v7action.Warnings, error) {
+				domain := resources.Domain{Name: name, GUID: name + "-guid"}
+				if name == "new.com" {
+					domain.Protocols = []string{"tcp"}
+				}
+				return domain, nil, nil
+			}
+		})
+
+		It("returns a protocol mismatch error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DomainProtocolMismatchError{
+				FromDomain: "old.com",
+				ToDomain:   "new.com",
+			}))
+			Expect(fakeActor.GetRoutesByDomainCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the domain has no routes", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoutesByDomainReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No routes found\.`))
+		})
+	})
+
+	When("getting the routes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoutesByDomainReturns(nil, v7action.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-routes-error"))
+			Expect(testUI.Err).To(Say("get-routes-warning"))
+		})
+	})
+
+	When("deleting an old route fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteRouteByGUIDReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("delete-error"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+})
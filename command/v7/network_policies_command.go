@@ -95,17 +95,11 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	}
 
 	for _, policy := range policies {
-		var portEntry string
-		if policy.StartPort == policy.EndPort {
-			portEntry = strconv.Itoa(policy.StartPort)
-		} else {
-			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
-		}
 		table = append(table, []string{
 			policy.SourceName,
 			policy.DestinationName,
 			policy.Protocol,
-			portEntry,
+			portsEntry(policy.StartPort, policy.EndPort),
 			policy.DestinationSpaceName,
 			policy.DestinationOrgName,
 		})
@@ -115,3 +109,11 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// portsEntry returns a port, or the range from the start port to the end port.
+func portsEntry(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
@@ -5,19 +5,32 @@ import (
 	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type OrgCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.Organization `positional-args:"yes"`
-	GUID            bool              `long:"guid" description:"Retrieve and display the given org's guid.  All other output for the org is suppressed."`
-	usage           interface{}       `usage:"CF_NAME org ORG [--guid]"`
-	relatedCommands interface{}       `related_commands:"org-users, orgs"`
+	RequiredArgs     flag.OptionalOrganization `positional-args:"yes"`
+	GUID             bool                      `long:"guid" description:"Retrieve and display the given org's guid.  All other output for the org is suppressed."`
+	OrganizationGUID string                    `long:"org-guid" description:"GUID of the org, instead of its name"`
+	usage            interface{}               `usage:"CF_NAME org (ORG | --org-guid ORG_GUID) [--guid]"`
+	relatedCommands  interface{}               `related_commands:"org-users, orgs"`
 }
 
 func (cmd OrgCommand) Execute(args []string) error {
+	switch {
+	case cmd.RequiredArgs.Organization != "" && cmd.OrganizationGUID != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"ORG", "--org-guid"},
+		}
+	case cmd.RequiredArgs.Organization == "" && cmd.OrganizationGUID == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "ORG"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -31,8 +44,7 @@ func (cmd OrgCommand) Execute(args []string) error {
 }
 
 func (cmd OrgCommand) displayOrgGUID() error {
-	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
-	cmd.UI.DisplayWarnings(warnings)
+	org, err := cmd.getOrg()
 	if err != nil {
 		return err
 	}
@@ -42,7 +54,35 @@ func (cmd OrgCommand) displayOrgGUID() error {
 	return nil
 }
 
+func (cmd OrgCommand) getOrg() (resources.Organization, error) {
+	var (
+		org      resources.Organization
+		warnings v7action.Warnings
+		err      error
+	)
+	if cmd.OrganizationGUID != "" {
+		org, warnings, err = cmd.Actor.GetOrganizationByGUID(cmd.OrganizationGUID)
+	} else {
+		org, warnings, err = cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	return org, err
+}
+
 func (cmd OrgCommand) displayOrgSummary() error {
+	var (
+		org resources.Organization
+		err error
+	)
+	orgName := cmd.RequiredArgs.Organization
+	if cmd.OrganizationGUID != "" {
+		org, err = cmd.getOrg()
+		if err != nil {
+			return err
+		}
+		orgName = org.Name
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -51,12 +91,20 @@ func (cmd OrgCommand) displayOrgSummary() error {
 	cmd.UI.DisplayTextWithFlavor(
 		"Getting info for org {{.OrgName}} as {{.Username}}...",
 		map[string]interface{}{
-			"OrgName":  cmd.RequiredArgs.Organization,
+			"OrgName":  orgName,
 			"Username": user.Name,
 		})
 	cmd.UI.DisplayNewline()
 
-	orgSummary, warnings, err := cmd.Actor.GetOrganizationSummaryByName(cmd.RequiredArgs.Organization)
+	var (
+		orgSummary v7action.OrganizationSummary
+		warnings   v7action.Warnings
+	)
+	if cmd.OrganizationGUID != "" {
+		orgSummary, warnings, err = cmd.Actor.GetOrganizationSummaryForOrg(org)
+	} else {
+		orgSummary, warnings, err = cmd.Actor.GetOrganizationSummaryByName(orgName)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
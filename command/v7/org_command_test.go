@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -278,4 +279,91 @@ var _ = Describe("org Command", func() {
 			})
 		})
 	})
+
+	When("the --org-guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Organization = ""
+			cmd.OrganizationGUID = "some-org-guid"
+			fakeActor.GetOrganizationByGUIDReturns(
+				resources.Organization{Name: "some-org", GUID: "some-org-guid"},
+				v7action.Warnings{"org-guid-warning"},
+				nil,
+			)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetOrganizationSummaryForOrgReturns(
+				v7action.OrganizationSummary{
+					Organization: resources.Organization{Name: "some-org", GUID: "some-org-guid"},
+					QuotaName:    "some-quota",
+				},
+				v7action.Warnings{"summary-warning"},
+				nil,
+			)
+		})
+
+		It("shows the org with that GUID without looking it up by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("org-guid-warning"))
+			Expect(testUI.Err).To(Say("summary-warning"))
+			Expect(testUI.Out).To(Say(`Getting info for org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`quota:\s+some-quota`))
+
+			Expect(fakeActor.GetOrganizationByGUIDArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetOrganizationSummaryForOrgArgsForCall(0)).To(Equal(resources.Organization{Name: "some-org", GUID: "some-org-guid"}))
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			Expect(fakeActor.GetOrganizationSummaryByNameCallCount()).To(Equal(0))
+		})
+
+		When("--guid is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("displays the guid of the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("some-org-guid"))
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeActor.GetOrganizationSummaryForOrgCallCount()).To(Equal(0))
+			})
+		})
+
+		When("there is no org with that GUID", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByGUIDReturns(
+					resources.Organization{},
+					v7action.Warnings{"org-guid-warning"},
+					actionerror.OrganizationNotFoundError{GUID: "some-org-guid"},
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{GUID: "some-org-guid"}))
+				Expect(testUI.Err).To(Say("org-guid-warning"))
+				Expect(fakeActor.GetOrganizationSummaryForOrgCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the org name is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Organization = "some-org"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"ORG", "--org-guid"},
+				}))
+				Expect(fakeActor.GetOrganizationByGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("neither the org name nor --org-guid is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Organization = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ORG"}))
+		})
+	})
 })
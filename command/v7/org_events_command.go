@@ -0,0 +1,44 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type OrgEventsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Organization `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME org-events ORG"`
+	relatedCommands interface{}       `related_commands:"events, org, space-events"`
+}
+
+func (OrgEventsCommand) PagedOutput() bool {
+	return true
+}
+
+func (cmd OrgEventsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting events for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	events, warnings, err := cmd.Actor.GetRecentEventsByOrganizationName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	displayEventsWithTarget(cmd.UI, events)
+	return nil
+}
@@ -0,0 +1,114 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("org-events Command", func() {
+	var (
+		cmd             OrgEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = OrgEventsCommand{
+			RequiredArgs: flag.Organization{Organization: "some-org"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the events succeeds", func() {
+		var createdAt time.Time
+
+		BeforeEach(func() {
+			createdAt = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+			fakeActor.GetRecentEventsByOrganizationNameReturns(
+				[]v7action.Event{
+					{Type: "audit.space.create", ActorName: "admin", TargetName: "dev", Time: createdAt, Description: "name: dev"},
+				},
+				v7action.Warnings{"get-events-warning"},
+				nil,
+			)
+		})
+
+		It("displays the events of the org with their target", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events for org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`%s\s+audit.space.create\s+admin\s+dev\s+name: dev`, regexp.QuoteMeta(createdAt.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetRecentEventsByOrganizationNameArgsForCall(0)).To(Equal("some-org"))
+		})
+	})
+
+	When("there are no events", func() {
+		It("displays that there are no events", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No events found."))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRecentEventsByOrganizationNameReturns(nil, v7action.Warnings{"get-events-warning"}, errors.New("events failed"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("events failed"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,9 +9,16 @@ import (
 type OrgsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME orgs [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-org, org, org-users, set-org-role"`
-	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
+	usage           interface{}       `usage:"CF_NAME orgs [--labels SELECTOR] [--details] [--sort (name | created-at | apps | service-instances)] [--output json]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME orgs --details --sort apps"`
+	relatedCommands interface{}       `related_commands:"create-org, org, org-users, set-org-role"`
+	Labels          string            `long:"labels" description:"Selector to filter orgs by labels"`
+	Details         bool              `long:"details" description:"Show the quota, number of apps and service instances, and creation date of each org"`
+	Sort            string            `long:"sort" choice:"name" choice:"created-at" choice:"apps" choice:"service-instances" default:"name" description:"Sort orgs by name or creation date, or by number of apps or service instances with the largest first"`
+	Output          flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the orgs and their details as a JSON array"`
+}
+
+func (OrgsCommand) PagedOutput() bool {
+	return true
 }
 
 func (cmd OrgsCommand) Execute(args []string) error {
@@ -19,6 +27,15 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Output == flag.OutputFormatJSON {
+		rows, warnings, err := cmd.getOrgDetails()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		return cmd.UI.DisplayJSON("", rows)
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -29,6 +46,20 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
+	if cmd.Details || (cmd.Sort != "" && cmd.Sort != listingSortName) {
+		rows, warnings, err := cmd.getOrgDetails()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			cmd.UI.DisplayText("No orgs found.")
+			return nil
+		}
+		return displayListingDetails(cmd.UI, rows)
+	}
+
 	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -44,6 +75,28 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd OrgsCommand) getOrgDetails() ([]listingDetails, []string, error) {
+	orgs, warnings, err := cmd.Actor.GetOrganizationsWithDetails(cmd.Labels)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	rows := make([]listingDetails, 0, len(orgs))
+	for _, org := range orgs {
+		rows = append(rows, listingDetails{
+			Name:             org.Name,
+			GUID:             org.GUID,
+			Quota:            org.QuotaName,
+			Apps:             org.AppCount,
+			ServiceInstances: org.ServiceInstanceCount,
+			CreatedAt:        org.CreatedAt,
+		})
+	}
+	sortListingDetails(rows, cmd.Sort)
+
+	return rows, warnings, nil
+}
+
 func (cmd OrgsCommand) displayOrgs(orgs []resources.Organization) {
 	table := [][]string{{cmd.UI.TranslateText("name")}}
 	for _, org := range orgs {
@@ -140,6 +140,103 @@ var _ = Describe("orgs Command", func() {
 				})
 			})
 
+			When("the --details flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Details = true
+					cmd.Labels = "some-label-selector"
+					fakeActor.GetOrganizationsWithDetailsReturns(
+						[]v7action.OrganizationDetails{
+							{
+								Organization:         resources.Organization{Name: "org-1", GUID: "org-guid-1", CreatedAt: "2020-01-02T03:04:05Z"},
+								QuotaName:            "default",
+								AppCount:             1,
+								ServiceInstanceCount: 4,
+							},
+							{
+								Organization:         resources.Organization{Name: "org-2", GUID: "org-guid-2", CreatedAt: "2019-01-02T03:04:05Z"},
+								QuotaName:            "large",
+								AppCount:             3,
+								ServiceInstanceCount: 2,
+							},
+						},
+						v7action.Warnings{"get-orgs-warning"},
+						nil)
+				})
+
+				It("displays the quota, counts and creation date of each org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Getting orgs as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`name\s+quota\s+apps\s+service instances\s+created`))
+					Expect(testUI.Out).To(Say(`org-1\s+default\s+1\s+4\s+Thu 02 Jan 03:04:05 UTC 2020`))
+					Expect(testUI.Out).To(Say(`org-2\s+large\s+3\s+2\s+Wed 02 Jan 03:04:05 UTC 2019`))
+
+					Expect(testUI.Err).To(Say("get-orgs-warning"))
+
+					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+					Expect(fakeActor.GetOrganizationsWithDetailsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetOrganizationsWithDetailsArgsForCall(0)).To(Equal("some-label-selector"))
+				})
+
+				When("sorting by apps", func() {
+					BeforeEach(func() {
+						cmd.Sort = "apps"
+					})
+
+					It("displays the org with the most apps first", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`org-2\s+large\s+3`))
+						Expect(testUI.Out).To(Say(`org-1\s+default\s+1`))
+					})
+				})
+
+				When("sorting by creation date", func() {
+					BeforeEach(func() {
+						cmd.Sort = "created-at"
+					})
+
+					It("displays the oldest org first", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`org-2`))
+						Expect(testUI.Out).To(Say(`org-1`))
+					})
+				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						cmd.Output = "json"
+						cmd.Sort = "service-instances"
+					})
+
+					It("prints the sorted orgs as JSON without the intro text", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting orgs"))
+						Expect(testUI.Out).To(Say(`"name": "org-1"`))
+						Expect(testUI.Out).To(Say(`"guid": "org-guid-1"`))
+						Expect(testUI.Out).To(Say(`"quota": "default"`))
+						Expect(testUI.Out).To(Say(`"apps": 1`))
+						Expect(testUI.Out).To(Say(`"service_instances": 4`))
+						Expect(testUI.Out).To(Say(`"created_at": "2020-01-02T03:04:05Z"`))
+						Expect(testUI.Out).To(Say(`"name": "org-2"`))
+
+						Expect(testUI.Err).To(Say("get-orgs-warning"))
+						Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+					})
+				})
+
+				When("getting the org details fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetOrganizationsWithDetailsReturns(nil, v7action.Warnings{"get-orgs-warning"}, errors.New("details-error"))
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError("details-error"))
+						Expect(testUI.Err).To(Say("get-orgs-warning"))
+					})
+				})
+			})
+
 			When("a translatable error is encountered getting orgs", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationsReturns(
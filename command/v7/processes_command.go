@@ -0,0 +1,170 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProcessesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName      `positional-args:"yes"`
+	Output          flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the processes as a single JSON array"`
+	usage           interface{}       `usage:"CF_NAME processes APP_NAME [--output json]"`
+	relatedCommands interface{}       `related_commands:"app, scale, set-health-check"`
+}
+
+// processSummary is one process in the machine-readable output of processes
+// --output json.
+type processSummary struct {
+	Type              string               `json:"type"`
+	Command           string               `json:"command"`
+	Instances         int                  `json:"instances"`
+	RunningInstances  int                  `json:"running_instances"`
+	RoutableInstances *int                 `json:"routable_instances,omitempty"`
+	MemoryInMB        uint64               `json:"memory_in_mb"`
+	DiskInMB          uint64               `json:"disk_in_mb"`
+	HealthCheck       processSummaryCheck  `json:"health_check"`
+	ReadinessCheck    *processSummaryCheck `json:"readiness_check,omitempty"`
+	Sidecars          []string             `json:"sidecars"`
+	InstanceStates    map[string]int       `json:"instance_states"`
+}
+
+type processSummaryCheck struct {
+	Type              string `json:"type"`
+	Endpoint          string `json:"endpoint,omitempty"`
+	InvocationTimeout int64  `json:"invocation_timeout,omitempty"`
+	Timeout           int64  `json:"timeout,omitempty"`
+	Interval          int64  `json:"interval,omitempty"`
+}
+
+func (cmd ProcessesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == flag.OutputFormatJSON {
+		processes, warnings, err := cmd.Actor.GetApplicationProcessSummariesByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		jsonProcesses := make([]processSummary, 0, len(processes))
+		for _, process := range processes {
+			jsonProcesses = append(jsonProcesses, newProcessSummary(process))
+		}
+		return cmd.UI.DisplayJSON("", jsonProcesses)
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting processes for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	processes, warnings, err := cmd.Actor.GetApplicationProcessSummariesByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(processes) == 0 {
+		cmd.UI.DisplayText("No processes found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("type"),
+		cmd.UI.TranslateText("instances"),
+		cmd.UI.TranslateText("routable"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("disk"),
+		cmd.UI.TranslateText("health check"),
+		cmd.UI.TranslateText("readiness check"),
+		cmd.UI.TranslateText("command"),
+	}}
+	for _, process := range processes {
+		routable := ""
+		if count, reported := process.RoutableInstanceCount(); reported {
+			routable = fmt.Sprintf("%d/%d", count, process.TotalInstanceCount())
+		}
+
+		table = append(table, []string{
+			process.Type,
+			fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount()),
+			routable,
+			fmt.Sprintf("%dM", process.MemoryInMB.Value),
+			fmt.Sprintf("%dM", process.DiskInMB.Value),
+			healthCheckDescription(process.HealthCheckType, process.HealthCheckEndpoint),
+			healthCheckDescription(process.ReadinessHealthCheckType, process.ReadinessHealthCheckEndpoint),
+			process.Command.Value,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// healthCheckDescription returns the type of a health check, followed by its
+// endpoint for http checks.
+func healthCheckDescription(healthCheckType constant.HealthCheckType, endpoint string) string {
+	if healthCheckType == constant.HTTP && endpoint != "" {
+		return fmt.Sprintf("%s %s", healthCheckType, endpoint)
+	}
+	return string(healthCheckType)
+}
+
+func newProcessSummary(process v7action.ProcessSummary) processSummary {
+	jsonProcess := processSummary{
+		Type:             process.Type,
+		Command:          process.Command.Value,
+		Instances:        process.TotalInstanceCount(),
+		RunningInstances: process.HealthyInstanceCount(),
+		MemoryInMB:       process.MemoryInMB.Value,
+		DiskInMB:         process.DiskInMB.Value,
+		HealthCheck: processSummaryCheck{
+			Type:              string(process.HealthCheckType),
+			Endpoint:          process.HealthCheckEndpoint,
+			InvocationTimeout: process.HealthCheckInvocationTimeout,
+			Timeout:           process.HealthCheckTimeout,
+		},
+		Sidecars:       []string{},
+		InstanceStates: map[string]int{},
+	}
+
+	if count, reported := process.RoutableInstanceCount(); reported {
+		jsonProcess.RoutableInstances = &count
+	}
+
+	if process.ReadinessHealthCheckType != "" {
+		jsonProcess.ReadinessCheck = &processSummaryCheck{
+			Type:              string(process.ReadinessHealthCheckType),
+			Endpoint:          process.ReadinessHealthCheckEndpoint,
+			InvocationTimeout: process.ReadinessHealthCheckInvocationTimeout,
+			Interval:          process.ReadinessHealthCheckInterval,
+		}
+	}
+
+	for _, sidecar := range process.Sidecars {
+		jsonProcess.Sidecars = append(jsonProcess.Sidecars, sidecar.Name)
+	}
+
+	for _, instance := range process.InstanceDetails {
+		jsonProcess.InstanceStates[string(instance.State)]++
+	}
+
+	return jsonProcess
+}
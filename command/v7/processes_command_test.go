@@ -0,0 +1,186 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("processes command", func() {
+	var (
+		cmd             ProcessesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = ProcessesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Output:       flag.OutputFormatText,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(
+			v7action.ProcessSummaries{
+				{
+					Process: resources.Process{
+						Type:                         "web",
+						Command:                      *types.NewFilteredString("bundle exec rackup"),
+						MemoryInMB:                   types.NullUint64{IsSet: true, Value: 256},
+						DiskInMB:                     types.NullUint64{IsSet: true, Value: 1024},
+						HealthCheckType:              constant.HTTP,
+						HealthCheckEndpoint:          "/health",
+						HealthCheckInvocationTimeout: 5,
+						ReadinessHealthCheckType:     constant.Port,
+						ReadinessHealthCheckInterval: 10,
+					},
+					Sidecars: []resources.Sidecar{{Name: "envoy"}},
+					InstanceDetails: []v7action.ProcessInstance{
+						{State: constant.ProcessInstanceRunning, Routable: types.NullBool{IsSet: true, Value: true}},
+						{State: constant.ProcessInstanceStarting, Routable: types.NullBool{IsSet: true, Value: false}},
+					},
+				},
+				{
+					Process: resources.Process{
+						Type:            "worker",
+						Command:         *types.NewFilteredString("bundle exec sidekiq"),
+						MemoryInMB:      types.NullUint64{IsSet: true, Value: 512},
+						DiskInMB:        types.NullUint64{IsSet: true, Value: 1024},
+						HealthCheckType: constant.Process,
+					},
+				},
+			},
+			v7action.Warnings{"processes-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the environment is not set up correctly", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays a row for each process", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID := fakeActor.GetApplicationProcessSummariesByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting processes for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`type\s+instances\s+routable\s+memory\s+disk\s+health check\s+readiness check\s+command`))
+		Expect(testUI.Out).To(Say(`web\s+1/2\s+1/2\s+256M\s+1024M\s+http /health\s+port\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`worker\s+0/0\s+512M\s+1024M\s+process\s+bundle exec sidekiq`))
+		Expect(testUI.Err).To(Say("processes-warning"))
+	})
+
+	When("the app has no processes", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No processes found."))
+		})
+	})
+
+	When("--output json is given", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormatJSON
+		})
+
+		It("displays the processes as JSON only", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting processes"))
+
+			var processes []interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &processes)).To(Succeed())
+			Expect(processes).To(Equal([]interface{}{
+				map[string]interface{}{
+					"type":               "web",
+					"command":            "bundle exec rackup",
+					"instances":          float64(2),
+					"running_instances":  float64(1),
+					"routable_instances": float64(1),
+					"memory_in_mb":       float64(256),
+					"disk_in_mb":         float64(1024),
+					"health_check":       map[string]interface{}{"type": "http", "endpoint": "/health", "invocation_timeout": float64(5)},
+					"readiness_check":    map[string]interface{}{"type": "port", "interval": float64(10)},
+					"sidecars":           []interface{}{"envoy"},
+					"instance_states":    map[string]interface{}{"RUNNING": float64(1), "STARTING": float64(1)},
+				},
+				map[string]interface{}{
+					"type":              "worker",
+					"command":           "bundle exec sidekiq",
+					"instances":         float64(0),
+					"running_instances": float64(0),
+					"memory_in_mb":      float64(512),
+					"disk_in_mb":        float64(1024),
+					"health_check":      map[string]interface{}{"type": "process"},
+					"sidecars":          []interface{}{},
+					"instance_states":   map[string]interface{}{},
+				},
+			}))
+			Expect(testUI.Err).To(Say("processes-warning"))
+		})
+	})
+
+	When("getting the processes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(nil, v7action.Warnings{"processes-warning"}, errors.New("processes-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("processes-error"))
+			Expect(testUI.Err).To(Say("processes-warning"))
+		})
+	})
+})
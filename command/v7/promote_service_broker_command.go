@@ -0,0 +1,103 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type PromoteServiceBrokerCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.PromoteServiceBrokerArgs `positional-args:"yes"`
+	PasswordFromEnv bool                          `long:"password-from-env" description:"Read the password of the service broker from the CF_BROKER_PASSWORD environment variable instead of prompting for it"`
+	usage           any                           `usage:"CF_NAME promote-service-broker SERVICE_BROKER USERNAME [--password-from-env]\n\n   Registers a space-scoped service broker globally with the same URL and makes its service plans visible in the org of its space.\n   Service instances cannot be moved to another broker, so a space-scoped broker with service instances is renamed to SERVICE_BROKER-space-scoped and kept for them. A broker without service instances is deleted.\n\nEXAMPLES:\n   CF_NAME promote-service-broker my-broker broker-user\n   CF_BROKER_PASSWORD=password CF_NAME promote-service-broker my-broker broker-user --password-from-env"`
+	relatedCommands any                           `related_commands:"create-service-broker, enable-service-access, service-brokers"`
+	envPassword     any                           `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"Password of the service broker, read when --password-from-env is provided"`
+}
+
+func (cmd PromoteServiceBrokerCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	password, err := cmd.password()
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Promoting service broker {{.ServiceBroker}} to a global service broker as {{.Username}}...",
+		map[string]any{
+			"Username":      user.Name,
+			"ServiceBroker": cmd.RequiredArgs.ServiceBroker,
+		},
+	)
+
+	promotion, warnings, err := cmd.Actor.PromoteServiceBroker(cmd.RequiredArgs.ServiceBroker, cmd.RequiredArgs.Username, password)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Count}} service plan(s) of service broker {{.ServiceBroker}} are now visible in org {{.Org}}.",
+		map[string]any{
+			"Count":         promotion.ServicePlans,
+			"ServiceBroker": cmd.RequiredArgs.ServiceBroker,
+			"Org":           promotion.OrgName,
+		})
+
+	if promotion.SpaceScopedBrokerName == "" {
+		cmd.UI.DisplayText("The space-scoped service broker had no service instances and has been deleted.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Service instances of space-scoped service broker {{.ServiceBroker}}:",
+		map[string]any{"ServiceBroker": promotion.SpaceScopedBrokerName})
+
+	table := [][]string{{cmd.UI.TranslateText("service instance"), cmd.UI.TranslateText("status")}}
+	var unresolvable int
+	for _, instance := range promotion.ServiceInstances {
+		status := cmd.UI.TranslateText("resolvable")
+		if !instance.Resolvable {
+			status = cmd.UI.TranslateText("not found")
+			unresolvable++
+		}
+		table = append(table, []string{instance.Name, status})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Existing service instances keep using the space-scoped service broker. Delete it with '{{.BinaryName}} delete-service-broker {{.ServiceBroker}}' once they have been recreated.",
+		map[string]any{
+			"BinaryName":    cmd.Config.BinaryName(),
+			"ServiceBroker": promotion.SpaceScopedBrokerName,
+		})
+
+	if unresolvable > 0 {
+		return translatableerror.ServiceInstancesNotResolvableError{Count: unresolvable}
+	}
+	return nil
+}
+
+func (cmd PromoteServiceBrokerCommand) password() (string, error) {
+	if !cmd.PasswordFromEnv {
+		return cmd.UI.DisplayPasswordPrompt("Service Broker Password")
+	}
+
+	password, ok := os.LookupEnv("CF_BROKER_PASSWORD")
+	if !ok || password == "" {
+		return "", translatableerror.BrokerPasswordNotSetError{}
+	}
+	return password, nil
+}
@@ -0,0 +1,181 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("promote-service-broker command", func() {
+	const (
+		binaryName        = "cf-command"
+		serviceBrokerName = "my-broker"
+		username          = "broker-user"
+	)
+
+	var (
+		cmd             v7.PromoteServiceBrokerCommand
+		fakeActor       *v7fakes.FakeActor
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeConfig      *commandfakes.FakeConfig
+		input           *Buffer
+		testUI          *ui.UI
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		fakeActor = &v7fakes.FakeActor{}
+		fakeSharedActor = &commandfakes.FakeSharedActor{}
+		fakeConfig = &commandfakes.FakeConfig{}
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		cmd = v7.PromoteServiceBrokerCommand{
+			BaseCommand: v7.BaseCommand{
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Config:      fakeConfig,
+			},
+		}
+		cmd.RequiredArgs.ServiceBroker = serviceBrokerName
+		cmd.RequiredArgs.Username = username
+
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.PromoteServiceBrokerReturns(v7action.ServiceBrokerPromotion{
+			OrgName:               "my-org",
+			ServicePlans:          2,
+			SpaceScopedBrokerName: "my-broker-space-scoped",
+			ServiceInstances: []v7action.PromotedServiceInstance{
+				{Name: "db", Resolvable: true},
+				{Name: "queue", Resolvable: true},
+			},
+		}, v7action.Warnings{"promote-warning"}, nil)
+
+		_, err := input.Write([]byte("prompt-password\n"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("promotes the broker and lists the instances kept on the space-scoped broker", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+
+		brokerName, brokerUsername, brokerPassword := fakeActor.PromoteServiceBrokerArgsForCall(0)
+		Expect(brokerName).To(Equal(serviceBrokerName))
+		Expect(brokerUsername).To(Equal(username))
+		Expect(brokerPassword).To(Equal("prompt-password"))
+
+		Expect(testUI.Out).To(Say("Service Broker Password: "))
+		Expect(testUI.Out).To(Say(`Promoting service broker my-broker to a global service broker as admin\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`2 service plan\(s\) of service broker my-broker are now visible in org my-org\.`))
+		Expect(testUI.Out).To(Say(`Service instances of space-scoped service broker my-broker-space-scoped:`))
+		Expect(testUI.Out).To(Say(`service instance\s+status`))
+		Expect(testUI.Out).To(Say(`db\s+resolvable`))
+		Expect(testUI.Out).To(Say(`queue\s+resolvable`))
+		Expect(testUI.Out).To(Say(`TIP: .* 'cf-command delete-service-broker my-broker-space-scoped'`))
+		Expect(testUI.Err).To(Say("promote-warning"))
+	})
+
+	When("the space-scoped broker had no service instances", func() {
+		BeforeEach(func() {
+			fakeActor.PromoteServiceBrokerReturns(v7action.ServiceBrokerPromotion{OrgName: "my-org", ServicePlans: 1}, nil, nil)
+		})
+
+		It("says that it has been deleted", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`The space-scoped service broker had no service instances and has been deleted\.`))
+			Expect(testUI.Out).NotTo(Say("TIP"))
+		})
+	})
+
+	When("some service instances cannot be resolved", func() {
+		BeforeEach(func() {
+			fakeActor.PromoteServiceBrokerReturns(v7action.ServiceBrokerPromotion{
+				OrgName:               "my-org",
+				SpaceScopedBrokerName: "my-broker-space-scoped",
+				ServiceInstances: []v7action.PromotedServiceInstance{
+					{Name: "db", Resolvable: false},
+					{Name: "queue", Resolvable: true},
+				},
+			}, nil, nil)
+		})
+
+		It("displays them and returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstancesNotResolvableError{Count: 1}))
+			Expect(testUI.Out).To(Say(`db\s+not found`))
+		})
+	})
+
+	When("--password-from-env is provided", func() {
+		BeforeEach(func() {
+			cmd.PasswordFromEnv = true
+		})
+
+		When("CF_BROKER_PASSWORD is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_BROKER_PASSWORD", "env-password")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_BROKER_PASSWORD")).To(Succeed())
+			})
+
+			It("uses the password of the environment", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Password: "))
+
+				_, _, brokerPassword := fakeActor.PromoteServiceBrokerArgsForCall(0)
+				Expect(brokerPassword).To(Equal("env-password"))
+			})
+		})
+
+		When("CF_BROKER_PASSWORD is not set", func() {
+			It("returns a BrokerPasswordNotSetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BrokerPasswordNotSetError{}))
+				Expect(fakeActor.PromoteServiceBrokerCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.PromoteServiceBrokerCallCount()).To(Equal(0))
+		})
+	})
+
+	When("promoting the broker fails", func() {
+		BeforeEach(func() {
+			fakeActor.PromoteServiceBrokerReturns(v7action.ServiceBrokerPromotion{}, v7action.Warnings{"promote-warning"}, errors.New("promote failed"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("promote failed"))
+			Expect(testUI.Err).To(Say("promote-warning"))
+		})
+	})
+})
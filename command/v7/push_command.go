@@ -3,8 +3,10 @@ package v7
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
@@ -24,10 +26,15 @@ import (
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/filewatch"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/projectconfig"
 )
 
+// watchInterval is how often push --watch polls the app directories.
+const watchInterval = time.Second
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
 
 type ProgressBar interface {
@@ -53,12 +60,15 @@ type V7ActorForPush interface {
 	SetSpaceManifest(spaceGUID string, rawManifest []byte) (v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	RestartApplication(appGUID string, noWait bool) (v7action.Warnings, error)
+	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, v7action.Warnings, error)
+	VerifyApplicationHealth(app resources.Application, criteria v7action.ApplicationHealthCriteria) (v7action.Warnings, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
 
 type ManifestParser interface {
 	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error)
+	InterpolateManifestWithOverlays(pathToManifest string, pathsToOverlays []string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error)
 	ParseManifest(pathToManifest string, rawManifest []byte) (manifestparser.Manifest, error)
 	MarshalManifest(manifest manifestparser.Manifest) ([]byte, error)
 }
@@ -75,39 +85,64 @@ type DiffDisplayer interface {
 	DisplayDiff(rawManifest []byte, diff resources.ManifestDiff) error
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . FileWatcher
+
+type FileWatcher interface {
+	Watch(paths []string, stop <-chan struct{}) (<-chan []string, error)
+}
+
 type PushCommand struct {
 	BaseCommand
 
 	OptionalArgs            flag.OptionalAppName                `positional-args:"yes"`
 	HealthCheckTimeout      flag.PositiveInteger                `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 	Buildpacks              []string                            `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	CanarySteps             flag.CanarySteps                    `long:"canary-steps" description:"Comma-separated percentages of instances a canary deployment replaces before each pause, e.g. 10,50; requires --strategy canary"`
+	CheckRoutes             bool                                `long:"check-routes" description:"Before uploading anything, check that every route of the manifest can be mapped and report all the problems at once"`
 	Disk                    string                              `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
+	FollowSymlinks          bool                                `long:"follow-symlinks" description:"Package the files and directories that symlinks in the app directory point to, instead of the symlinks themselves"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
 	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
 	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	MaxInFlight             flag.PositiveInteger                `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time; requires --strategy rolling or canary"`
+	MinHealthyPercent       flag.Percentage                     `long:"min-healthy-percent" description:"Fail unless at least this percentage of the instances of each process are running after the app starts"`
+	NoBuildCache            bool                                `long:"no-build-cache" description:"Clear the buildpack cache of the app before staging, so the build starts clean"`
 	NoManifest              bool                                `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Push up to this many apps of the manifest at the same time, prefixing each line of their output with the app name. Apps that depend on others still wait for them"`
+	PathsToOverlays         []flag.PathWithExistenceCheck       `long:"overlay" description:"Path to a manifest merged over the manifest before variable substitution: maps are merged, applications, processes, sidecars and routes are matched by name, type, name and route, other lists are replaced and null removes a key; can specify multiple times"`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	PreserveFileModes       bool                                `long:"preserve-file-modes" description:"Keep file modes in the app package as they are; by default setuid, setgid and sticky bits and world write permissions are removed and files are made readable by their owner"`
+	PreserveSymlinks        bool                                `long:"preserve-symlinks" description:"Package symlinks in the app directory as symlinks (default)"`
+	PreserveTimestamps      bool                                `long:"preserve-timestamps" description:"Keep file modification times in the app package; by default they are zeroed so the same source always produces the same package"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
+	Rehash                  bool                                `long:"rehash" description:"Hash every file and ask the API about each of them, instead of trusting what previous pushes cached in CF_HOME"`
 	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	ResultFile              flag.Path                           `long:"result-file" description:"Write a JSON summary of the push to this file: app GUID, revision, droplet GUID, routes, deployment GUID, duration of each phase and warnings"`
+	Resume                  bool                                `long:"resume" description:"Stage the package uploaded last for the app again instead of uploading its files, e.g. after staging failed"`
+	StabilityWindow         flag.Duration                       `long:"stability-window" description:"After the app starts, watch its instances for this long (e.g. 2m) and fail if any of them crashes"`
+	ShowEffectiveManifest   bool                                `long:"show-effective-manifest" description:"Print the manifest resulting from overlays, variable substitution and flags, then exit without pushing"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy, either rolling, canary or none"`
 	Task                    bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
+	Watch                   bool                                `long:"watch" description:"After pushing, watch the app directories and push the apps again whenever their files change; stop with Ctrl-C"`
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--strategy STRATEGY [--max-in-flight COUNT] [--canary-steps WEIGHTS]]\n   [--min-healthy-percent PERCENT] [--stability-window DURATION]\n   [--follow-symlinks | --preserve-symlinks] [--preserve-file-modes] [--preserve-timestamps]\n   [--rehash] [--no-build-cache] [--resume]\n   [--overlay OVERLAY_PATH]... [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n   [--check-routes] [--show-effective-manifest] [--result-file RESULT_FILE_PATH] [--watch]\n   [--parallel NUM_APPS]\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--strategy STRATEGY [--max-in-flight COUNT] [--canary-steps WEIGHTS]]\n   [--min-healthy-percent PERCENT] [--stability-window DURATION]\n   [--overlay OVERLAY_PATH]... [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n   [--check-routes] [--show-effective-manifest] [--result-file RESULT_FILE_PATH]\n   [--parallel NUM_APPS]"`
+	examples                interface{}                         `examples:"CF_NAME push my-app\nCF_NAME push my-app -f manifest.yml --var instances=2\nCF_NAME push my-app --docker-image cloudfoundry/diego-docker-app:latest\nCF_NAME push my-app --strategy rolling --no-wait"`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	envCFLogGroups          interface{}                         `environmentName:"CF_LOG_GROUPS" environmentDescription:"Wrap the upload, staging and start of each app in collapsible CI log groups: github, gitlab or none. Detected from GITHUB_ACTIONS and GITLAB_CI when not set"`
 
 	LogCacheClient  sharedaction.LogCacheClient
 	PushActor       PushActor
@@ -117,8 +152,18 @@ type PushCommand struct {
 	ManifestLocator ManifestLocator
 	ManifestParser  ManifestParser
 	DiffDisplayer   DiffDisplayer
+	FileWatcher     FileWatcher
 
 	stopStreamingFunc func()
+	result            *pushResult
+	logGrouper        *shared.LogGrouper
+
+	// strategyFromProject is whether Strategy is the default of the project
+	// config rather than given on the command line.
+	strategyFromProject bool
+	// projectBuildpacks are the buildpacks of the project config, for the apps
+	// of the manifest that do not set any.
+	projectBuildpacks []string
 }
 
 func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -127,7 +172,11 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	if config.ProgressStyle() == configv3.ProgressPlain {
+		cmd.ProgressBar = progressbar.NewPlainProgressBar(ui.Writer())
+	} else {
+		cmd.ProgressBar = progressbar.NewProgressBar()
+	}
 	cmd.VersionActor = cmd.Actor
 	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
 
@@ -143,11 +192,18 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.ManifestParser = manifestparser.ManifestParser{}
 	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{UI: ui, RedactEnv: cmd.RedactEnv}
 
+	watcher := filewatch.NewWatcher(watchInterval)
+	watcher.IgnoreFileName = ".cfignore"
+	watcher.IgnoreLines = sharedaction.DefaultIgnoreLines
+	cmd.FileWatcher = watcher
+
 	return err
 }
 
-func (cmd PushCommand) Execute(args []string) error {
+func (cmd PushCommand) Execute(args []string) (executeErr error) {
 	cmd.stopStreamingFunc = nil
+	cmd.result = nil
+	cmd.logGrouper = shared.NewLogGrouper(cmd.UI, cmd.Config.LogGroupStyle())
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -158,6 +214,11 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = cmd.applyProjectDefaults()
+	if err != nil {
+		return err
+	}
+
 	flagOverrides, err := cmd.GetFlagOverrides()
 	if err != nil {
 		return err
@@ -173,10 +234,22 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
-	transformedManifest, err := cmd.PushActor.HandleFlagOverrides(baseManifest, flagOverrides)
+	manifestOverrides := flagOverrides
+	if cmd.strategyFromProject {
+		// Unlike --strategy, the project default applies to every app of a
+		// manifest with several apps.
+		manifestOverrides.Strategy = ""
+	}
+
+	transformedManifest, err := cmd.PushActor.HandleFlagOverrides(baseManifest, manifestOverrides)
 	if err != nil {
 		return err
 	}
+	cmd.applyProjectBuildpacks(&transformedManifest, flagOverrides)
+
+	if cmd.ShowEffectiveManifest {
+		return cmd.displayEffectiveManifest(transformedManifest)
+	}
 
 	flagOverrides.DockerPassword, err = cmd.GetDockerPassword(flagOverrides.DockerUsername, transformedManifest.ContainsPrivateDockerImages())
 	if err != nil {
@@ -190,6 +263,20 @@ func (cmd PushCommand) Execute(args []string) error {
 
 	cmd.announcePushing(transformedManifest.AppNames(), user)
 
+	if cmd.ResultFile != "" {
+		cmd.result = newPushResult()
+		defer func() {
+			executeErr = cmd.writeResult(executeErr)
+		}()
+	}
+
+	if cmd.CheckRoutes {
+		err = cmd.checkRoutes(transformedManifest)
+		if err != nil {
+			return err
+		}
+	}
+
 	hasManifest := transformedManifest.PathToManifest != ""
 
 	spaceGUID := cmd.Config.TargetedSpace().GUID
@@ -201,6 +288,7 @@ func (cmd PushCommand) Execute(args []string) error {
 		diff, warnings, err := cmd.Actor.DiffSpaceManifest(spaceGUID, transformedRawManifest)
 
 		cmd.UI.DisplayWarnings(warnings)
+		cmd.result.addWarnings(warnings)
 		if err != nil {
 			if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); isUnexpectedError {
 				cmd.UI.DisplayWarning("Unable to generate diff. Continuing to apply manifest...")
@@ -224,13 +312,72 @@ func (cmd PushCommand) Execute(args []string) error {
 	)
 
 	cmd.UI.DisplayWarnings(v7ActionWarnings)
+	cmd.result.addWarnings(v7ActionWarnings)
 	if err != nil {
+		cmd.explainRouteError(transformedManifest, err)
 		return err
 	}
 	if hasManifest {
 		cmd.UI.DisplayText("Manifest applied")
 	}
 
+	pushPlans, err := cmd.pushApps(transformedManifest, flagOverrides)
+	if err != nil || !cmd.Watch {
+		return err
+	}
+
+	return cmd.watchAndPush(pushPlans, transformedManifest, flagOverrides)
+}
+
+// watchAndPush pushes the apps of pushPlans again whenever the files they
+// were pushed from change, until the watcher stops. A failing push is
+// displayed and the next change is waited for.
+func (cmd *PushCommand) watchAndPush(pushPlans []v7pushaction.PushPlan, transformedManifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) error {
+	var paths []string
+	for _, plan := range pushPlans {
+		if plan.BitsPath != "" && plan.DropletPath == "" {
+			paths = append(paths, plan.BitsPath)
+		}
+	}
+	if len(paths) == 0 {
+		cmd.UI.DisplayText("No app is pushed from local files, nothing to watch.")
+		return nil
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	changes, err := cmd.FileWatcher.Watch(paths, stop)
+	if err != nil {
+		return err
+	}
+
+	for {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Watching {{.Paths}} for changes. Press Ctrl-C to stop.", map[string]interface{}{
+			"Paths": strings.Join(paths, ", "),
+		})
+
+		changedFiles, ok := <-changes
+		if !ok {
+			return nil
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.Count}} file(s) changed, pushing again...", map[string]interface{}{
+			"Count": len(changedFiles),
+		})
+		log.WithField("files", changedFiles).Debug("watched files changed")
+
+		_, err = cmd.pushApps(transformedManifest, flagOverrides)
+		if err != nil {
+			cmd.UI.DisplayError(err)
+		}
+	}
+}
+
+// pushApps creates the push plans of the apps of the manifest and actualizes
+// them group by group.
+func (cmd *PushCommand) pushApps(transformedManifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, error) {
 	pushPlans, warnings, err := cmd.PushActor.CreatePushPlans(
 		cmd.Config.TargetedSpace().GUID,
 		cmd.Config.TargetedOrganization().GUID,
@@ -238,34 +385,168 @@ func (cmd PushCommand) Execute(args []string) error {
 		flagOverrides,
 	)
 	cmd.UI.DisplayWarnings(warnings)
+	cmd.result.addWarnings(warnings)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	log.WithField("number of plans", len(pushPlans)).Debug("completed generating plan")
+	for _, plan := range pushPlans {
+		cmd.displayModeChanges(plan)
+	}
+
 	defer func() {
 		if cmd.stopStreamingFunc != nil {
 			cmd.stopStreamingFunc()
+			cmd.stopStreamingFunc = nil
 		}
 	}()
 
+	planGroups, err := groupPushPlans(pushPlans, transformedManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		if !transformedManifest.HasDependencies() {
+			planGroups = [][]v7pushaction.PushPlan{pushPlans}
+		}
+		for _, group := range planGroups {
+			err = cmd.actualizeInParallel(group)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return pushPlans, nil
+	}
+
+	for _, group := range planGroups {
+		eventStreams := cmd.actualizeGroup(group)
+		for i, plan := range group {
+			log.WithField("app_name", plan.Application.Name).Info("actualizing")
+			cmd.result.startApp(plan)
+			err := cmd.eventStreamHandler(eventStreams[i], i > 0)
+			cmd.logGrouper.EndGroup()
+
+			var summary v7action.DetailedApplicationSummary
+			if cmd.shouldDisplaySummary(err) {
+				var summaryErr error
+				summary, summaryErr = cmd.displayAppSummary(plan)
+				if summaryErr != nil {
+					return nil, summaryErr
+				}
+			}
+			if err == nil {
+				err = shared.VerifyAppHealth(cmd.VersionActor, cmd.UI, summary.Application, cmd.healthCriteria())
+			}
+			cmd.finishAppResult(summary, err)
+			if err != nil {
+				return nil, cmd.mapErr(plan.Application.Name, err)
+			}
+			if plan.Strategy == constant.DeploymentStrategyCanary {
+				shared.DisplayCanaryPaused(cmd.UI, cmd.Config.BinaryName(), plan.Application.Name)
+			}
+		}
+	}
+
+	return pushPlans, nil
+}
+
+// groupPushPlans returns the plans in the order their apps should be pushed.
+// Without depends_on in the manifest every plan is in a group of its own, in
+// manifest order. Otherwise the plans of each group of StartGroups can be
+// actualized at the same time.
+func groupPushPlans(pushPlans []v7pushaction.PushPlan, manifest manifestparser.Manifest) ([][]v7pushaction.PushPlan, error) {
+	if !manifest.HasDependencies() {
+		groups := make([][]v7pushaction.PushPlan, len(pushPlans))
+		for i, plan := range pushPlans {
+			groups[i] = []v7pushaction.PushPlan{plan}
+		}
+		return groups, nil
+	}
+
+	startGroups, err := manifest.StartGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	plansByName := map[string]v7pushaction.PushPlan{}
 	for _, plan := range pushPlans {
-		log.WithField("app_name", plan.Application.Name).Info("actualizing")
-		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
-		err := cmd.eventStreamHandler(eventStream)
-
-		if cmd.shouldDisplaySummary(err) {
-			summaryErr := cmd.displayAppSummary(plan)
-			if summaryErr != nil {
-				return summaryErr
+		plansByName[plan.Application.Name] = plan
+	}
+
+	var groups [][]v7pushaction.PushPlan
+	for _, startGroup := range startGroups {
+		var group []v7pushaction.PushPlan
+		for _, name := range startGroup {
+			if plan, ok := plansByName[name]; ok {
+				group = append(group, plan)
 			}
 		}
-		if err != nil {
-			return cmd.mapErr(plan.Application.Name, err)
+		if len(group) > 0 {
+			groups = append(groups, group)
 		}
 	}
+	log.WithField("groups", startGroups).Debug("ordered push plans by depends_on")
 
-	return nil
+	return groups, nil
+}
+
+// actualizeGroup starts actualizing all of the plans at once. Only the first
+// plan shows upload progress; the events of the others are buffered until
+// they are displayed, so that their pushes are not held up in the meantime.
+func (cmd PushCommand) actualizeGroup(group []v7pushaction.PushPlan) []<-chan *v7pushaction.PushEvent {
+	eventStreams := make([]<-chan *v7pushaction.PushEvent, len(group))
+	for i, plan := range group {
+		if i == 0 {
+			eventStreams[i] = cmd.PushActor.Actualize(plan, cmd.ProgressBar)
+			continue
+		}
+		eventStreams[i] = bufferPushEvents(cmd.PushActor.Actualize(plan, silentProgressBar{}))
+	}
+	return eventStreams
+}
+
+// silentProgressBar uploads without displaying progress.
+type silentProgressBar struct{}
+
+func (silentProgressBar) Ready()    {}
+func (silentProgressBar) Complete() {}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return reader
+}
+
+// bufferPushEvents receives the events of eventStream as soon as they are
+// sent and holds them until they are read from the returned channel.
+func bufferPushEvents(eventStream <-chan *v7pushaction.PushEvent) <-chan *v7pushaction.PushEvent {
+	buffered := make(chan *v7pushaction.PushEvent)
+
+	go func() {
+		defer close(buffered)
+
+		var queue []*v7pushaction.PushEvent
+		for eventStream != nil || len(queue) > 0 {
+			var out chan<- *v7pushaction.PushEvent
+			var next *v7pushaction.PushEvent
+			if len(queue) > 0 {
+				out, next = buffered, queue[0]
+			}
+
+			select {
+			case event, ok := <-eventStream:
+				if !ok {
+					eventStream = nil
+					continue
+				}
+				queue = append(queue, event)
+			case out <- next:
+				queue = queue[1:]
+			}
+		}
+	}()
+
+	return buffered
 }
 
 func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
@@ -292,12 +573,21 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	}
 
 	if !exists {
+		if len(flagOverrides.PathsToOverlays) > 0 {
+			return manifestparser.Manifest{}, translatableerror.IncorrectUsageError{Message: "--overlay requires a manifest"}
+		}
 		log.Debugf("No manifest given, generating manifest")
 		return defaultManifest, nil
 	}
 
 	log.WithField("manifestPath", pathToManifest).Debug("path to manifest")
-	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars)
+	var rawManifest []byte
+	if len(flagOverrides.PathsToOverlays) > 0 {
+		log.WithField("overlays", flagOverrides.PathsToOverlays).Debug("merging manifest overlays")
+		rawManifest, err = cmd.ManifestParser.InterpolateManifestWithOverlays(pathToManifest, flagOverrides.PathsToOverlays, flagOverrides.PathsToVarsFiles, flagOverrides.Vars)
+	} else {
+		rawManifest, err = cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars)
+	}
 	if err != nil {
 		log.Errorln("reading manifest:", err)
 		if _, ok := err.(*yaml.TypeError); ok {
@@ -315,6 +605,70 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	return manifest, nil
 }
 
+// applyProjectDefaults fills the flags that are not given with the push
+// defaults of the .cf/config.yml of the project the current directory belongs
+// to, and keeps its buildpacks for applyProjectBuildpacks. Paths in the config
+// are relative to the project directory.
+func (cmd *PushCommand) applyProjectDefaults() error {
+	config, found, err := projectconfig.Load(cmd.CWD)
+	if err != nil || !found {
+		return err
+	}
+	log.WithField("path", config.Path).Debug("using project config")
+
+	defaults := config.Push
+	if !cmd.NoManifest {
+		_, err = applyProjectManifestDefaults(config, &cmd.PathToManifest, &cmd.PathsToVarsFiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !cmd.Strategy.IsSet && !cmd.NoStart && !cmd.Task && defaults.Strategy != "" {
+		err = cmd.Strategy.UnmarshalFlag(defaults.Strategy)
+		if err != nil {
+			return err
+		}
+		cmd.strategyFromProject = true
+	}
+
+	cmd.projectBuildpacks = defaults.Buildpacks
+
+	if !cmd.ShowEffectiveManifest {
+		cmd.UI.DisplayText("Using push defaults from {{.Path}}", map[string]interface{}{
+			"Path": config.Path,
+		})
+	}
+
+	return nil
+}
+
+// applyProjectBuildpacks sets the buildpacks of the project config on the
+// apps of the manifest that neither set buildpacks nor use a docker image.
+// Unlike the flags, they sit below what the manifest says about each app.
+func (cmd PushCommand) applyProjectBuildpacks(manifest *manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) {
+	if len(cmd.projectBuildpacks) == 0 || flagOverrides.DropletPath != "" {
+		return
+	}
+
+	for i := range manifest.Applications {
+		app := &manifest.Applications[i]
+		if !app.HasBuildpacks() && app.Docker == nil {
+			app.SetBuildpacks(cmd.projectBuildpacks)
+		}
+	}
+}
+
+func (cmd PushCommand) displayEffectiveManifest(manifest manifestparser.Manifest) error {
+	rawManifest, err := cmd.ManifestParser.MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.UI.GetOut().Write(rawManifest)
+	return err
+}
+
 func (cmd PushCommand) GetDockerPassword(dockerUsername string, containsPrivateDockerImages bool) (string, error) {
 	if dockerUsername == "" && !containsPrivateDockerImages { // no need for a password without a username
 		return "", nil
@@ -335,6 +689,16 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
 	}
 
+	var pathsToOverlays []string
+	for _, overlayPath := range cmd.PathsToOverlays {
+		pathsToOverlays = append(pathsToOverlays, string(overlayPath))
+	}
+
+	var resourceCacheDir string
+	if !cmd.Rehash {
+		resourceCacheDir = cmd.Config.ResourceCacheDir()
+	}
+
 	return v7pushaction.FlagOverrides{
 		AppName:             cmd.OptionalArgs.AppName,
 		Buildpacks:          cmd.Buildpacks,
@@ -343,20 +707,29 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		DropletPath:         string(cmd.DropletPath),
 		DockerImage:         cmd.DockerImage.Path,
 		DockerUsername:      cmd.DockerUsername,
+		FollowSymlinks:      cmd.FollowSymlinks,
 		HealthCheckEndpoint: cmd.HealthCheckHTTPEndpoint,
 		HealthCheckType:     cmd.HealthCheckType.Type,
 		HealthCheckTimeout:  cmd.HealthCheckTimeout.Value,
 		Instances:           cmd.Instances.NullInt,
 		Memory:              cmd.Memory,
+		NoBuildCache:        cmd.NoBuildCache,
 		NoStart:             cmd.NoStart,
 		NoWait:              cmd.NoWait,
 		ProvidedAppPath:     string(cmd.AppPath),
 		NoRoute:             cmd.NoRoute,
 		RandomRoute:         cmd.RandomRoute,
+		Resume:              cmd.Resume,
 		StartCommand:        cmd.StartCommand.FilteredString,
 		Strategy:            cmd.Strategy.Name,
+		MaxInFlight:         int(cmd.MaxInFlight.Value),
+		CanaryWeights:       cmd.CanarySteps.Weights,
 		ManifestPath:        string(cmd.PathToManifest),
+		PathsToOverlays:     pathsToOverlays,
 		PathsToVarsFiles:    pathsToVarsFiles,
+		PreserveFileModes:   cmd.PreserveFileModes,
+		PreserveTimestamps:  cmd.PreserveTimestamps,
+		ResourceCacheDir:    resourceCacheDir,
 		Vars:                cmd.Vars,
 		NoManifest:          cmd.NoManifest,
 		Task:                cmd.Task,
@@ -412,6 +785,14 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
+	case cmd.NoManifest && len(cmd.PathsToOverlays) > 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--no-manifest",
+				"--overlay",
+			},
+		}
+
 	case cmd.NoManifest && len(cmd.Vars) > 0:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -436,22 +817,34 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
-	case cmd.NoStart && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.NoStart && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--no-start",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
-	case cmd.Task && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.Task && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--task",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
+	case cmd.MaxInFlight.Value != 0 && cmd.Strategy.Name == constant.DeploymentStrategyDefault:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--max-in-flight",
+			Arg2: "--strategy",
+		}
+
+	case len(cmd.CanarySteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--canary-steps",
+			Arg2: "--strategy canary",
+		}
+
 	case cmd.NoStart && cmd.NoWait:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -467,6 +860,106 @@ func (cmd PushCommand) ValidateFlags() error {
 				"--random-route",
 			},
 		}
+
+	case cmd.FollowSymlinks && cmd.PreserveSymlinks:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--follow-symlinks",
+				"--preserve-symlinks",
+			},
+		}
+
+	case cmd.FollowSymlinks && (cmd.DockerImage.Path != "" || cmd.DropletPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--follow-symlinks",
+				"--docker-image, -o",
+				"--droplet",
+			},
+		}
+
+	case cmd.PreserveFileModes && (cmd.DockerImage.Path != "" || cmd.DropletPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--preserve-file-modes",
+				"--docker-image, -o",
+				"--droplet",
+			},
+		}
+
+	case cmd.PreserveTimestamps && (cmd.DockerImage.Path != "" || cmd.DropletPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--preserve-timestamps",
+				"--docker-image, -o",
+				"--droplet",
+			},
+		}
+	case cmd.NoBuildCache && (cmd.DockerImage.Path != "" || cmd.DropletPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--no-build-cache",
+				"--docker-image, -o",
+				"--droplet",
+			},
+		}
+
+	case cmd.NoBuildCache && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--no-build-cache",
+				"--no-start",
+			},
+		}
+	case cmd.Resume && (cmd.DockerImage.Path != "" || cmd.DropletPath != "" || cmd.AppPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--resume",
+				"--docker-image, -o",
+				"--droplet",
+				"--path, -p",
+			},
+		}
+	case cmd.Resume && (cmd.NoStart || cmd.Watch):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--resume",
+				"--no-start",
+				"--watch",
+			},
+		}
+	case (cmd.MinHealthyPercent.Value > 0 || cmd.StabilityWindow.IsSet) && (cmd.NoStart || cmd.Task):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--min-healthy-percent",
+				"--stability-window",
+				"--no-start",
+				"--task",
+			},
+		}
+	case cmd.Watch && (cmd.DockerImage.Path != "" || cmd.DropletPath != ""):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--docker-image, -o",
+				"--droplet",
+			},
+		}
+	case cmd.Watch && (cmd.ResultFile != "" || cmd.ShowEffectiveManifest):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--result-file",
+				"--show-effective-manifest",
+			},
+		}
+	case cmd.Parallel.Value > 1 && cmd.ResultFile != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--parallel",
+				"--result-file",
+			},
+		}
 	case !cmd.validBuildpacks():
 		return translatableerror.InvalidBuildpacksError{}
 	}
@@ -483,6 +976,13 @@ func (cmd PushCommand) validBuildpacks() bool {
 	return true
 }
 
+func (cmd PushCommand) healthCriteria() v7action.ApplicationHealthCriteria {
+	return v7action.ApplicationHealthCriteria{
+		MinHealthyPercent: cmd.MinHealthyPercent.Value,
+		StabilityWindow:   cmd.StabilityWindow.Value,
+	}
+}
+
 func (cmd PushCommand) shouldDisplaySummary(err error) bool {
 	if err == nil {
 		return true
@@ -524,7 +1024,34 @@ func (cmd PushCommand) announcePushing(appNames []string, user configv3.User) {
 	}
 }
 
-func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) error {
+// maxDisplayedModeChanges is the number of files whose normalized mode is
+// listed in the warning about them; the others are only counted.
+const maxDisplayedModeChanges = 10
+
+func (cmd PushCommand) displayModeChanges(plan v7pushaction.PushPlan) {
+	if len(plan.ModeChanges) == 0 {
+		return
+	}
+
+	var files []string
+	for i, change := range plan.ModeChanges {
+		if i == maxDisplayedModeChanges {
+			files = append(files, cmd.UI.TranslateText("and {{.Count}} more", map[string]interface{}{
+				"Count": len(plan.ModeChanges) - maxDisplayedModeChanges,
+			}))
+			break
+		}
+		files = append(files, fmt.Sprintf("%s: %s -> %s", change.Filename, change.Original, change.Normalized))
+	}
+
+	cmd.UI.DisplayWarning("File modes of {{.Count}} files in app {{.AppName}} were normalized during packaging; use --preserve-file-modes to keep them:\n   {{.Files}}", map[string]interface{}{
+		"Count":   len(plan.ModeChanges),
+		"AppName": plan.Application.Name,
+		"Files":   strings.Join(files, "\n   "),
+	})
+}
+
+func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) (v7action.DetailedApplicationSummary, error) {
 	log.Info("getting application summary info")
 	summary, warnings, err := cmd.VersionActor.GetDetailedAppSummary(
 		plan.Application.Name,
@@ -533,21 +1060,57 @@ func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) error {
 	)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
-		return err
+		return v7action.DetailedApplicationSummary{}, err
 	}
 	cmd.UI.DisplayNewline()
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 	appSummaryDisplayer.AppDisplay(summary, true)
-	return nil
+	return summary, nil
 }
 
-func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.PushEvent) error {
+// finishAppResult completes the result file entry of the app that was just
+// pushed, looking up the revision it is running when the push succeeded.
+func (cmd PushCommand) finishAppResult(summary v7action.DetailedApplicationSummary, pushErr error) {
+	if cmd.result == nil {
+		return
+	}
+
+	var revisions []resources.Revision
+	if pushErr == nil && summary.GUID != "" {
+		var warnings v7action.Warnings
+		var err error
+		revisions, warnings, err = cmd.VersionActor.GetApplicationRevisionsDeployed(summary.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		cmd.result.addWarnings(warnings)
+		if err != nil {
+			log.WithField("app_guid", summary.GUID).Errorln("getting deployed revisions:", err)
+		}
+	}
+
+	cmd.result.finishApp(summary, revisions, pushErr)
+}
+
+func (cmd PushCommand) writeResult(pushErr error) error {
+	writeErr := cmd.result.write(cmd.ResultFile.String(), pushErr)
+	if pushErr != nil {
+		return pushErr
+	}
+	return writeErr
+}
+
+// eventStreamHandler displays the events of a push. When buffered is true the
+// events are of a push that ran while another was displayed, so there is no
+// upload progress to display and the staging logs have already been missed.
+func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.PushEvent, buffered bool) error {
 	for event := range eventStream {
 		cmd.UI.DisplayWarnings(event.Warnings)
+		cmd.result.recordEvent(event)
+		cmd.startLogGroup(event)
 		if event.Err != nil {
+			cmd.displayRestageTip(event.Plan, event.Err)
 			return event.Err
 		}
-		err := cmd.processEvent(event.Event, event.Plan.Application.Name)
+		err := cmd.processEvent(event.Event, event.Plan, buffered)
 		if err != nil {
 			return err
 		}
@@ -555,28 +1118,47 @@ func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.Push
 	return nil
 }
 
-func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) error {
+func (cmd *PushCommand) processEvent(event v7pushaction.Event, plan v7pushaction.PushPlan, buffered bool) error {
+	appName := plan.Application.Name
 	switch event {
 	case v7pushaction.CreatingArchive:
 		cmd.UI.DisplayText("Packaging files to upload...")
 	case v7pushaction.UploadingApplicationWithArchive:
 		cmd.UI.DisplayText("Uploading files...")
-		log.Debug("starting progress bar")
-		cmd.ProgressBar.Ready()
+		if !buffered {
+			log.Debug("starting progress bar")
+			cmd.ProgressBar.Ready()
+		}
 	case v7pushaction.UploadingApplication:
 		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
+		cmd.displaySourceDigest(plan)
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
 	case v7pushaction.RetryUpload:
 		cmd.UI.DisplayText("Retrying upload due to an error...")
 	case v7pushaction.UploadWithArchiveComplete:
-		cmd.ProgressBar.Complete()
+		if !buffered {
+			cmd.ProgressBar.Complete()
+		}
 		cmd.UI.DisplayNewline()
+		cmd.displaySourceDigest(plan)
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
+	case v7pushaction.ReusingPackage:
+		cmd.UI.DisplayText("Reusing the package uploaded last instead of uploading files...")
+	case v7pushaction.PackageProcessed:
+		if plan.PackageChecksum != "" {
+			cmd.UI.DisplayText("Package checksum: {{.Checksum}}", map[string]interface{}{
+				"Checksum": plan.PackageChecksum,
+			})
+		}
 	case v7pushaction.UploadingDroplet:
 		cmd.UI.DisplayText("Uploading droplet bits...")
-		cmd.ProgressBar.Ready()
+		if !buffered {
+			cmd.ProgressBar.Ready()
+		}
 	case v7pushaction.UploadDropletComplete:
-		cmd.ProgressBar.Complete()
+		if !buffered {
+			cmd.ProgressBar.Complete()
+		}
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
 	case v7pushaction.StoppingApplication:
@@ -587,8 +1169,15 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 		cmd.UI.DisplayText("Applying manifest...")
 	case v7pushaction.ApplyManifestComplete:
 		cmd.UI.DisplayText("Manifest applied")
+	case v7pushaction.ClearingBuildCache:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Clearing build cache...")
 	case v7pushaction.StartingStaging:
 		cmd.UI.DisplayNewline()
+		if buffered {
+			cmd.UI.DisplayText("Staging app...")
+			break
+		}
 		cmd.UI.DisplayText("Staging app and tracing logs...")
 		logStream, errStream, cancelFunc, warnings, err := cmd.VersionActor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
 		cmd.UI.DisplayWarnings(warnings)
@@ -632,6 +1221,45 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	return nil
 }
 
+// startLogGroup starts a CI log group when the event begins a new phase of
+// the push.
+func (cmd *PushCommand) startLogGroup(event *v7pushaction.PushEvent) {
+	phase, startsPhase := pushPhases[event.Event]
+	if !startsPhase {
+		return
+	}
+
+	appName := event.Plan.Application.Name
+	cmd.logGrouper.StartGroup(phase+"_"+appName, cmd.UI.TranslateText(pushPhaseTitles[phase], map[string]interface{}{
+		"AppName": appName,
+	}))
+}
+
+func (cmd PushCommand) displayRestageTip(plan v7pushaction.PushPlan, err error) {
+	if plan.PackageGUID == "" {
+		return
+	}
+
+	switch err.(type) {
+	case actionerror.StagingFailedError, actionerror.StagingTimeoutError:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: The package was uploaded successfully. Use '{{.BinaryName}} push {{.AppName}} --resume' or '{{.BinaryName}} restage {{.AppName}} --package {{.PackageGUID}}' to retry staging without uploading again.", map[string]interface{}{
+			"BinaryName":  cmd.Config.BinaryName(),
+			"AppName":     plan.Application.Name,
+			"PackageGUID": plan.PackageGUID,
+		})
+	}
+}
+
+func (cmd PushCommand) displaySourceDigest(plan v7pushaction.PushPlan) {
+	if plan.SourceDigest == "" {
+		return
+	}
+	cmd.UI.DisplayText("Source digest: {{.Digest}}", map[string]interface{}{
+		"Digest": plan.SourceDigest,
+	})
+}
+
 func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error) {
 	for {
 		select {
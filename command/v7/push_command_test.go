@@ -2,7 +2,12 @@ package v7_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -210,6 +215,128 @@ var _ = Describe("push Command", func() {
 				})
 			})
 
+			When("the current directory belongs to a project with a .cf/config.yml", func() {
+				var projectDir string
+
+				BeforeEach(func() {
+					var err error
+					projectDir, err = ioutil.TempDir("", "push-project-config")
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(os.MkdirAll(filepath.Join(projectDir, ".cf"), 0755)).To(Succeed())
+					Expect(os.MkdirAll(filepath.Join(projectDir, "deploy"), 0755)).To(Succeed())
+					Expect(os.MkdirAll(filepath.Join(projectDir, "src"), 0755)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(projectDir, "deploy", "manifest.yml"), nil, 0600)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(projectDir, "deploy", "vars.yml"), nil, 0600)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(projectDir, ".cf", "config.yml"), []byte(`push:
+  manifest: deploy/manifest.yml
+  vars_files: [deploy/vars.yml]
+  strategy: rolling
+  buildpacks: [go_buildpack]
+`), 0600)).To(Succeed())
+
+					cmd.CWD = filepath.Join(projectDir, "src")
+					fakeManifestLocator.PathReturns("", false, nil)
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(projectDir)).To(Succeed())
+				})
+
+				It("uses the project defaults for the flags that are not given", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Using push defaults from %s", filepath.Join(projectDir, ".cf", "config.yml")))
+
+					Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal(filepath.Join(projectDir, "deploy", "manifest.yml")))
+
+					_, flagOverrides := fakeActor.HandleFlagOverridesArgsForCall(0)
+					Expect(flagOverrides.PathsToVarsFiles).To(Equal([]string{filepath.Join(projectDir, "deploy", "vars.yml")}))
+					Expect(flagOverrides.Buildpacks).To(BeEmpty())
+
+					_, _, _, pushOverrides := fakeActor.CreatePushPlansArgsForCall(0)
+					Expect(pushOverrides.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+				})
+
+				When("the manifest has several apps", func() {
+					BeforeEach(func() {
+						fakeActor.HandleFlagOverridesReturns(
+							manifestparser.Manifest{
+								Applications: []manifestparser.Application{
+									{Name: "app-without-buildpacks"},
+									{Name: "app-with-buildpacks", RemainingManifestFields: map[string]interface{}{"buildpacks": []string{"ruby_buildpack"}}},
+									{Name: "docker-app", Docker: &manifestparser.Docker{Image: "some-image"}},
+								},
+							},
+							nil,
+						)
+					})
+
+					It("leaves the project strategy out of the flag overrides of the manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, flagOverrides := fakeActor.HandleFlagOverridesArgsForCall(0)
+						Expect(flagOverrides.Strategy).To(BeEmpty())
+
+						_, _, _, pushOverrides := fakeActor.CreatePushPlansArgsForCall(0)
+						Expect(pushOverrides.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+					})
+
+					It("only sets the project buildpacks on the apps that set neither buildpacks nor a docker image", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, manifest, _ := fakeActor.CreatePushPlansArgsForCall(0)
+						Expect(manifest.Applications[0].RemainingManifestFields["buildpacks"]).To(Equal([]string{"go_buildpack"}))
+						Expect(manifest.Applications[1].RemainingManifestFields["buildpacks"]).To(Equal([]string{"ruby_buildpack"}))
+						Expect(manifest.Applications[2].HasBuildpacks()).To(BeFalse())
+					})
+				})
+
+				When("a droplet is pushed", func() {
+					BeforeEach(func() {
+						cmd.DropletPath = "some-droplet.tgz"
+						fakeActor.HandleFlagOverridesReturns(
+							manifestparser.Manifest{Applications: []manifestparser.Application{{Name: "some-app"}}},
+							nil,
+						)
+					})
+
+					It("does not set the project buildpacks", func() {
+						_, _, manifest, _ := fakeActor.CreatePushPlansArgsForCall(0)
+						Expect(manifest.Applications[0].HasBuildpacks()).To(BeFalse())
+					})
+				})
+
+				When("the flags are given", func() {
+					BeforeEach(func() {
+						cmd.PathToManifest = "/other/manifest.yml"
+						cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/other/vars.yml"}
+						cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyDefault, IsSet: true}
+						cmd.Buildpacks = []string{"ruby_buildpack"}
+					})
+
+					It("keeps the flags", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal("/other/manifest.yml"))
+
+						_, flagOverrides := fakeActor.HandleFlagOverridesArgsForCall(0)
+						Expect(flagOverrides.PathsToVarsFiles).To(Equal([]string{"/other/vars.yml"}))
+						Expect(flagOverrides.Strategy).To(Equal(constant.DeploymentStrategyDefault))
+						Expect(flagOverrides.Buildpacks).To(Equal([]string{"ruby_buildpack"}))
+					})
+				})
+
+				When("the manifest of the project config does not exist", func() {
+					BeforeEach(func() {
+						Expect(os.Remove(filepath.Join(projectDir, "deploy", "manifest.yml"))).To(Succeed())
+					})
+
+					It("returns a FileNotFoundError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: filepath.Join(projectDir, "deploy", "manifest.yml")}))
+					})
+				})
+			})
+
 			When("the flags are all valid", func() {
 				It("delegating to the GetBaseManifest", func() {
 					// This tells us GetBaseManifest is being called because we dont have a fake
@@ -298,6 +425,23 @@ var _ = Describe("push Command", func() {
 							})
 						})
 
+						When("--show-effective-manifest is passed", func() {
+							BeforeEach(func() {
+								cmd.ShowEffectiveManifest = true
+								fakeManifestParser.MarshalManifestReturns([]byte("applications:\n- name: some-app-name\n"), nil)
+							})
+
+							It("prints the effective manifest without pushing", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("applications:\n- name: some-app-name\n"))
+								Expect(testUI.Out).ToNot(Say("Pushing"))
+
+								Expect(fakeConfig.DockerPasswordCallCount()).To(Equal(0))
+								Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+								Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+							})
+						})
+
 						It("delegates to the manifest parser", func() {
 							Expect(fakeManifestParser.MarshalManifestCallCount()).To(Equal(1))
 							Expect(fakeManifestParser.MarshalManifestArgsForCall(0)).To(Equal(
@@ -380,6 +524,72 @@ var _ = Describe("push Command", func() {
 									Expect(spaceGUIDArg).To(Equal("some-space-guid"))
 								})
 
+								When("--check-routes is passed", func() {
+									BeforeEach(func() {
+										cmd.CheckRoutes = true
+										fakeActor.HandleFlagOverridesReturns(
+											manifestparser.Manifest{
+												PathToManifest: "path/to/manifest",
+												Applications: []manifestparser.Application{
+													{Name: "first-app", RemainingManifestFields: map[string]interface{}{
+														"routes": []interface{}{map[interface{}]interface{}{"route": "first.example.com"}},
+													}},
+													{Name: "second-app", NoRoute: true, RemainingManifestFields: map[string]interface{}{
+														"routes": []interface{}{map[interface{}]interface{}{"route": "second.example.com"}},
+													}},
+												},
+											},
+											nil,
+										)
+									})
+
+									It("checks the routes of the apps before applying the manifest", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Out).To(Say("Checking the routes of the manifest..."))
+
+										Expect(fakeDiffActor.CheckRoutesAvailabilityCallCount()).To(Equal(1))
+										routes, spaceGUID := fakeDiffActor.CheckRoutesAvailabilityArgsForCall(0)
+										Expect(routes).To(Equal([]string{"first.example.com"}))
+										Expect(spaceGUID).To(Equal("some-space-guid"))
+
+										Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(1))
+									})
+
+									When("routes cannot be mapped", func() {
+										BeforeEach(func() {
+											fakeDiffActor.CheckRoutesAvailabilityReturns(
+												[]v7action.RouteProblem{
+													{Route: "first.example.com", Err: actionerror.RouteInDifferentSpaceError{Route: "first.example.com"}},
+													{Route: "app.missing.com", Err: actionerror.DomainNotFoundError{Name: "missing.com"}},
+												},
+												v7action.Warnings{"check-routes-warning"},
+												nil,
+											)
+										})
+
+										It("reports every problem and pushes nothing", func() {
+											Expect(executeErr).To(MatchError(translatableerror.UnavailableRoutesError{Count: 2}))
+											Expect(testUI.Err).To(Say("check-routes-warning"))
+											Expect(testUI.Out).To(Say(`route\s+problem`))
+											Expect(testUI.Out).To(Say(`first\.example\.com\s+The app cannot be mapped to route first\.example\.com because the route exists in a different space\.`))
+											Expect(testUI.Out).To(Say(`app\.missing\.com\s+Domain 'missing\.com' not found\.`))
+
+											Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+											Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+										})
+									})
+
+									When("checking the routes fails", func() {
+										BeforeEach(func() {
+											fakeDiffActor.CheckRoutesAvailabilityReturns(nil, nil, errors.New("check-routes-error"))
+										})
+
+										It("returns the error", func() {
+											Expect(executeErr).To(MatchError("check-routes-error"))
+										})
+									})
+								})
+
 								When("the manifest diff fails", func() {
 									BeforeEach(func() {
 										fakeDiffActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{}, ccerror.V3UnexpectedResponseError{})
@@ -414,6 +624,42 @@ var _ = Describe("push Command", func() {
 								It("returns an error and prints warnings", func() {
 									Expect(executeErr).To(MatchError("apply-manifest-error"))
 									Expect(testUI.Err).To(Say("apply-manifest-warnings"))
+									Expect(fakeDiffActor.CheckRoutesAvailabilityCallCount()).To(Equal(0))
+								})
+
+								When("the error is about a route", func() {
+									BeforeEach(func() {
+										fakeActor.HandleFlagOverridesReturns(
+											manifestparser.Manifest{
+												PathToManifest: "path/to/manifest",
+												Applications: []manifestparser.Application{
+													{Name: "first-app", RemainingManifestFields: map[string]interface{}{
+														"routes": []interface{}{map[interface{}]interface{}{"route": "taken.example.com"}},
+													}},
+												},
+											},
+											nil,
+										)
+										fakeVersionActor.SetSpaceManifestReturns(nil, ccerror.V3JobFailedError{Detail: "For application 'first-app': Routes cannot be mapped to destinations in different spaces"})
+										fakeDiffActor.CheckRoutesAvailabilityReturns(
+											[]v7action.RouteProblem{{
+												Route: "taken.example.com",
+												Err: actionerror.RouteInDifferentSpaceError{
+													Route:         "taken.example.com",
+													OrgName:       "other-org",
+													SpaceName:     "other-space",
+													SuggestedHost: "taken-2",
+												},
+											}},
+											nil,
+											nil,
+										)
+									})
+
+									It("displays which space owns the routes and returns the error", func() {
+										Expect(executeErr).To(MatchError(ccerror.V3JobFailedError{Detail: "For application 'first-app': Routes cannot be mapped to destinations in different spaces"}))
+										Expect(testUI.Out).To(Say(`taken\.example\.com\s+The app cannot be mapped to route taken\.example\.com because the route exists in org other-org / space other-space\. The hostname taken-2 is available\.`))
+									})
 								})
 							})
 
@@ -468,6 +714,380 @@ var _ = Describe("push Command", func() {
 										Expect(testUI.Err).To(Say("create-push-plans-warnings"))
 									})
 
+									When("the manifest orders the apps with depends_on", func() {
+										BeforeEach(func() {
+											fakeActor.HandleFlagOverridesReturns(
+												manifestparser.Manifest{
+													Applications: []manifestparser.Application{
+														{Name: "first-app", DependsOn: []string{"second-app"}},
+														{Name: "second-app"},
+														{Name: "third-app"},
+													},
+												},
+												nil,
+											)
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app", GUID: "potato"}},
+													{Application: resources.Application{Name: "second-app", GUID: "potato"}},
+													{Application: resources.Application{Name: "third-app", GUID: "potato"}},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("pushes the apps after the apps they depend on", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(3))
+
+											plan, progressBar := fakeActor.ActualizeArgsForCall(0)
+											Expect(plan.Application.Name).To(Equal("second-app"))
+											Expect(progressBar).To(Equal(fakeProgressBar))
+
+											plan, progressBar = fakeActor.ActualizeArgsForCall(1)
+											Expect(plan.Application.Name).To(Equal("third-app"))
+											Expect(progressBar).ToNot(Equal(fakeProgressBar))
+
+											plan, progressBar = fakeActor.ActualizeArgsForCall(2)
+											Expect(plan.Application.Name).To(Equal("first-app"))
+											Expect(progressBar).To(Equal(fakeProgressBar))
+										})
+									})
+
+									When("--parallel is passed", func() {
+										BeforeEach(func() {
+											cmd.Parallel = flag.PositiveInteger{Value: 2}
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app", GUID: "potato"}},
+													{Application: resources.Application{Name: "second-app", GUID: "potato"}},
+													{Application: resources.Application{Name: "third-app", GUID: "potato"}},
+												},
+												nil,
+												nil,
+											)
+											fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												return FillInEvents([]Step{
+													{Plan: pushPlan, Event: v7pushaction.CreatingArchive, Warnings: v7pushaction.Warnings{pushPlan.Application.Name + "-warning"}},
+													{Plan: pushPlan, Event: v7pushaction.UploadingApplication},
+												})
+											}
+										})
+
+										It("pushes the apps without progress bars and prefixes their output with the app name", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(3))
+											for i := 0; i < 3; i++ {
+												_, progressBar := fakeActor.ActualizeArgsForCall(i)
+												Expect(progressBar).ToNot(Equal(fakeProgressBar))
+											}
+											Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+
+											out := string(testUI.Out.(*Buffer).Contents())
+											for _, name := range []string{"first-app", "second-app", "third-app"} {
+												Expect(out).To(ContainSubstring("[" + name + "] Packaging files to upload..."))
+												Expect(out).To(ContainSubstring("[" + name + "] All files found in remote cache; nothing to upload."))
+												Expect(string(testUI.Err.(*Buffer).Contents())).To(ContainSubstring("[" + name + "] " + name + "-warning"))
+											}
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(3))
+										})
+
+										When("an app fails to push", func() {
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app", GUID: "potato"}},
+														{Application: resources.Application{Name: "second-app", GUID: "potato"}},
+													},
+													nil,
+													nil,
+												)
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													if pushPlan.Application.Name == "first-app" {
+														return FillInEvents([]Step{{Plan: pushPlan, Error: errors.New("first-app-error")}})
+													}
+													return FillInEvents([]Step{{Plan: pushPlan, Event: v7pushaction.CreatingArchive}})
+												}
+											})
+
+											It("finishes pushing the other apps and returns the error", func() {
+												Expect(executeErr).To(MatchError("first-app-error"))
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												Expect(testUI.Out).To(Say(`\[second-app\] Packaging files to upload\.\.\.`))
+
+												name, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+												Expect(name).To(Equal("second-app"))
+											})
+										})
+									})
+
+									When("an app is deployed with the canary strategy", func() {
+										BeforeEach(func() {
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app", GUID: "potato"}, Strategy: constant.DeploymentStrategyCanary},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("tells how to continue the paused deployment", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(testUI.Out).To(Say("The canary deployment of app first-app is paused."))
+											Expect(testUI.Out).To(Say("TIP: Run 'faceman continue-deployment first-app' to go on to the next step"))
+										})
+									})
+
+									When("--watch is passed", func() {
+										var fakeFileWatcher *v7fakes.FakeFileWatcher
+
+										BeforeEach(func() {
+											cmd.Watch = true
+											fakeFileWatcher = new(v7fakes.FakeFileWatcher)
+											cmd.FileWatcher = fakeFileWatcher
+
+											changes := make(chan []string, 1)
+											changes <- []string{"/app/one.go", "/app/two.go"}
+											close(changes)
+											fakeFileWatcher.WatchReturns(changes, nil)
+
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app", GUID: "potato"}, BitsPath: "/app"},
+													{Application: resources.Application{Name: "second-app", GUID: "potato"}, DropletPath: "/droplet.tgz"},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("pushes the apps again when their files change", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(fakeFileWatcher.WatchCallCount()).To(Equal(1))
+											paths, _ := fakeFileWatcher.WatchArgsForCall(0)
+											Expect(paths).To(Equal([]string{"/app"}))
+
+											Expect(testUI.Out).To(Say(`Watching /app for changes\. Press Ctrl-C to stop\.`))
+											Expect(testUI.Out).To(Say(`2 file\(s\) changed, pushing again\.\.\.`))
+											Expect(testUI.Out).To(Say(`Watching /app for changes\.`))
+
+											Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(2))
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(4))
+										})
+
+										When("pushing again fails", func() {
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturnsOnCall(1, nil, nil, errors.New("push-again-error"))
+											})
+
+											It("displays the error and keeps watching", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+												Expect(testUI.Err).To(Say("push-again-error"))
+												Expect(testUI.Out).To(Say(`2 file\(s\) changed, pushing again\.\.\.`))
+												Expect(testUI.Out).To(Say(`Watching /app for changes\.`))
+											})
+										})
+
+										When("no app is pushed from local files", func() {
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{{Application: resources.Application{Name: "first-app"}}},
+													nil,
+													nil,
+												)
+											})
+
+											It("says there is nothing to watch", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+												Expect(testUI.Out).To(Say("No app is pushed from local files, nothing to watch."))
+												Expect(fakeFileWatcher.WatchCallCount()).To(Equal(0))
+											})
+										})
+
+										When("the watcher cannot start", func() {
+											BeforeEach(func() {
+												fakeFileWatcher.WatchReturns(nil, errors.New("watch-error"))
+											})
+
+											It("returns the error", func() {
+												Expect(executeErr).To(MatchError("watch-error"))
+											})
+										})
+									})
+
+									When("--result-file is passed", func() {
+										var (
+											tmpDir         string
+											resultFilePath string
+										)
+
+										BeforeEach(func() {
+											var err error
+											tmpDir, err = ioutil.TempDir("", "push-result")
+											Expect(err).NotTo(HaveOccurred())
+											resultFilePath = filepath.Join(tmpDir, "push.json")
+											cmd.ResultFile = flag.Path(resultFilePath)
+
+											fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												packagedPlan := pushPlan
+												packagedPlan.PackageGUID = "some-package-guid"
+												stagedPlan := packagedPlan
+												stagedPlan.DropletGUID = "some-droplet-guid"
+												deployedPlan := stagedPlan
+												deployedPlan.DeploymentGUID = "some-deployment-guid"
+												return FillInEvents([]Step{
+													{Plan: pushPlan, Event: v7pushaction.CreatingArchive, Warnings: v7pushaction.Warnings{"upload-warning"}},
+													{Plan: packagedPlan, Event: v7pushaction.UploadWithArchiveComplete},
+													{Plan: packagedPlan, Event: v7pushaction.StartingStaging},
+													{Plan: stagedPlan, Event: v7pushaction.StagingComplete},
+													{Plan: deployedPlan, Event: v7pushaction.StartingDeployment},
+													{Plan: deployedPlan, Event: v7pushaction.InstanceDetails, Warnings: v7pushaction.Warnings{"instances starting"}},
+												})
+											}
+											fakeVersionActor.GetDetailedAppSummaryReturns(
+												v7action.DetailedApplicationSummary{
+													ApplicationSummary: v7action.ApplicationSummary{
+														Application: resources.Application{GUID: "potato"},
+														Routes:      []resources.Route{{URL: "first.example.com"}},
+													},
+												},
+												nil,
+												nil,
+											)
+											fakeVersionActor.GetApplicationRevisionsDeployedReturns(
+												[]resources.Revision{{GUID: "revision-2", Version: 2}, {GUID: "revision-3", Version: 3}},
+												v7action.Warnings{"get-revisions-warning"},
+												nil,
+											)
+										})
+
+										AfterEach(func() {
+											Expect(os.RemoveAll(tmpDir)).To(Succeed())
+										})
+
+										readResult := func() map[string]interface{} {
+											rawResult, err := ioutil.ReadFile(resultFilePath)
+											Expect(err).NotTo(HaveOccurred())
+											var result map[string]interface{}
+											Expect(json.Unmarshal(rawResult, &result)).To(Succeed())
+											return result
+										}
+
+										It("writes a summary of each pushed app to the file", func() {
+											Expect(executeErr).NotTo(HaveOccurred())
+
+											Expect(fakeVersionActor.GetApplicationRevisionsDeployedCallCount()).To(Equal(2))
+											Expect(fakeVersionActor.GetApplicationRevisionsDeployedArgsForCall(0)).To(Equal("potato"))
+
+											result := readResult()
+											Expect(result["warnings"]).To(ConsistOf("apply-manifest-warnings", "create-push-plans-warnings", "get-revisions-warning", "get-revisions-warning"))
+											Expect(result).NotTo(HaveKey("error"))
+
+											apps := result["applications"].([]interface{})
+											Expect(apps).To(HaveLen(2))
+
+											app := apps[0].(map[string]interface{})
+											Expect(app["name"]).To(Equal("first-app"))
+											Expect(app["guid"]).To(Equal("potato"))
+											Expect(app["package_guid"]).To(Equal("some-package-guid"))
+											Expect(app["droplet_guid"]).To(Equal("some-droplet-guid"))
+											Expect(app["deployment_guid"]).To(Equal("some-deployment-guid"))
+											Expect(app["revision"]).To(Equal(map[string]interface{}{"guid": "revision-3", "version": float64(3)}))
+											Expect(app["routes"]).To(ConsistOf("first.example.com"))
+											Expect(app["warnings"]).To(ConsistOf("upload-warning"))
+
+											phases := app["phases"].([]interface{})
+											Expect(phases).To(HaveLen(3))
+											for i, name := range []string{"upload", "staging", "start"} {
+												phase := phases[i].(map[string]interface{})
+												Expect(phase["name"]).To(Equal(name))
+												Expect(phase["duration_seconds"]).To(BeNumerically(">=", 0))
+											}
+
+											Expect(apps[1].(map[string]interface{})["name"]).To(Equal("second-app"))
+										})
+
+										When("pushing an app fails", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													return FillInEvents([]Step{
+														{Plan: pushPlan, Event: v7pushaction.StartingStaging},
+														{Plan: pushPlan, Error: errors.New("staging-error")},
+													})
+												}
+											})
+
+											It("still writes the file, recording the error", func() {
+												Expect(executeErr).To(MatchError("staging-error"))
+												Expect(fakeVersionActor.GetApplicationRevisionsDeployedCallCount()).To(Equal(0))
+
+												result := readResult()
+												Expect(result["error"]).To(Equal("staging-error"))
+												apps := result["applications"].([]interface{})
+												Expect(apps).To(HaveLen(1))
+												Expect(apps[0].(map[string]interface{})["error"]).To(Equal("staging-error"))
+											})
+										})
+
+										When("the file cannot be written", func() {
+											BeforeEach(func() {
+												cmd.ResultFile = flag.Path(filepath.Join(tmpDir, "missing-dir", "push.json"))
+											})
+
+											It("returns a file creation error", func() {
+												Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.FileCreationError{}))
+											})
+										})
+									})
+
+									When("packaging normalized file modes", func() {
+										BeforeEach(func() {
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{
+														Application: resources.Application{Name: "first-app", GUID: "potato"},
+														ModeChanges: []sharedaction.ModeChange{
+															{Filename: "bin/run", Original: 0757 | os.ModeSetuid, Normalized: 0755},
+															{Filename: "bin/setup", Original: 0777, Normalized: 0775},
+														},
+													},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("warns once about the altered files", func() {
+											Expect(testUI.Err).To(Say(`File modes of 2 files in app first-app were normalized during packaging; use --preserve-file-modes to keep them:`))
+											Expect(testUI.Err).To(Say(`bin/run: urwxr-xrwx -> -rwxr-xr-x`))
+											Expect(testUI.Err).To(Say(`bin/setup: -rwxrwxrwx -> -rwxrwxr-x`))
+											Expect(testUI.Err).ToNot(Say("File modes of"))
+										})
+
+										When("many files were altered", func() {
+											BeforeEach(func() {
+												var changes []sharedaction.ModeChange
+												for i := 0; i < 12; i++ {
+													changes = append(changes, sharedaction.ModeChange{Filename: fmt.Sprintf("file-%d", i), Original: 0777, Normalized: 0775})
+												}
+												fakeActor.CreatePushPlansReturns([]v7pushaction.PushPlan{{
+													Application: resources.Application{Name: "first-app", GUID: "potato"},
+													ModeChanges: changes,
+												}}, nil, nil)
+											})
+
+											It("lists the first of them and counts the others", func() {
+												Expect(testUI.Err).To(Say(`File modes of 12 files in app first-app`))
+												Expect(testUI.Err).To(Say(`file-9: `))
+												Expect(testUI.Err).To(Say(`and 2 more`))
+												Expect(string(testUI.Err.(*Buffer).Contents())).ToNot(ContainSubstring("file-10"))
+											})
+										})
+									})
+
 									Describe("delegating to Actor.Actualize", func() {
 										When("Actualize returns success", func() {
 											BeforeEach(func() {
@@ -497,9 +1117,13 @@ var _ = Describe("push Command", func() {
 																Warnings: v7pushaction.Warnings{"retry upload warning"},
 															},
 															{
-																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
+																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}, SourceDigest: "sha256:some-digest"},
 																Event: v7pushaction.UploadWithArchiveComplete,
 															},
+															{
+																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}, PackageChecksum: "sha256:some-checksum"},
+																Event: v7pushaction.PackageProcessed,
+															},
 															{
 																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
 																Event: v7pushaction.RestartingApplication,
@@ -530,7 +1154,9 @@ var _ = Describe("push Command", func() {
 													Expect(testUI.Out).To(Say("Retrying upload due to an error..."))
 													Expect(testUI.Err).To(Say("retry upload warning"))
 
+													Expect(testUI.Out).To(Say("Source digest: sha256:some-digest"))
 													Expect(testUI.Out).To(Say("Waiting for API to complete processing files..."))
+													Expect(testUI.Out).To(Say("Package checksum: sha256:some-checksum"))
 
 													Expect(testUI.Out).To(Say("Waiting for app first-app to start..."))
 
@@ -550,6 +1176,46 @@ var _ = Describe("push Command", func() {
 
 													Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
 												})
+
+												When("CI log groups are enabled", func() {
+													BeforeEach(func() {
+														fakeConfig.LogGroupStyleReturns(configv3.LogGroupsGitHub)
+													})
+
+													It("wraps the phases of each app in log groups", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+
+														Expect(testUI.Out).To(Say("::group::Uploading app first-app\n"))
+														Expect(testUI.Out).To(Say("Packaging files to upload..."))
+														Expect(testUI.Out).To(Say("::endgroup::\n::group::Starting app first-app\n"))
+														Expect(testUI.Out).To(Say("Waiting for app first-app to start..."))
+														Expect(testUI.Out).To(Say("::endgroup::\n"))
+														Expect(testUI.Out).To(Say("::group::Uploading app second-app\n"))
+														Expect(testUI.Out).To(Say("::endgroup::\n::group::Starting app second-app\n"))
+														Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+														Expect(testUI.Out).To(Say("::endgroup::\n"))
+													})
+												})
+											})
+
+											Describe("resume events", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														pushPlan.PackageChecksum = "sha256:some-checksum"
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Event: v7pushaction.ReusingPackage},
+															{Plan: pushPlan, Event: v7pushaction.PackageProcessed},
+														})
+													}
+												})
+
+												It("displays that the package uploaded last is reused", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+
+													Expect(testUI.Out).To(Say(`Reusing the package uploaded last instead of uploading files\.\.\.`))
+													Expect(testUI.Out).To(Say("Package checksum: sha256:some-checksum"))
+													Expect(testUI.Out).ToNot(Say("Uploading files..."))
+												})
 											})
 
 											Describe("staging logs", func() {
@@ -639,6 +1305,46 @@ var _ = Describe("push Command", func() {
 													Expect(executeErr).ToNot(HaveOccurred())
 													Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
 												})
+
+												It("does not verify the health of the apps", func() {
+													Expect(fakeVersionActor.VerifyApplicationHealthCallCount()).To(Equal(0))
+												})
+
+												When("health criteria are given", func() {
+													BeforeEach(func() {
+														cmd.MinHealthyPercent = flag.Percentage{Value: 100}
+														fakeVersionActor.GetDetailedAppSummaryReturnsOnCall(0, v7action.DetailedApplicationSummary{
+															ApplicationSummary: v7action.ApplicationSummary{
+																Application: resources.Application{Name: "first-app", GUID: "first-app-guid"},
+															},
+														}, nil, nil)
+													})
+
+													It("verifies the health of each app after pushing it", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+
+														Expect(fakeVersionActor.VerifyApplicationHealthCallCount()).To(Equal(2))
+														givenApp, criteria := fakeVersionActor.VerifyApplicationHealthArgsForCall(0)
+														Expect(givenApp).To(Equal(resources.Application{Name: "first-app", GUID: "first-app-guid"}))
+														Expect(criteria).To(Equal(v7action.ApplicationHealthCriteria{MinHealthyPercent: 100}))
+														Expect(testUI.Out).To(Say(`Checking the health of app first-app\.\.\.`))
+													})
+
+													When("an app is not healthy", func() {
+														BeforeEach(func() {
+															fakeVersionActor.VerifyApplicationHealthReturns(
+																v7action.Warnings{"health-warning"},
+																actionerror.InsufficientHealthyInstancesError{AppName: "first-app", ProcessType: "web", Running: 1, Total: 2, MinHealthyPercent: 100},
+															)
+														})
+
+														It("fails without pushing the remaining apps", func() {
+															Expect(executeErr).To(MatchError(actionerror.InsufficientHealthyInstancesError{AppName: "first-app", ProcessType: "web", Running: 1, Total: 2, MinHealthyPercent: 100}))
+															Expect(testUI.Err).To(Say("health-warning"))
+															Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+														})
+													})
+												})
 											})
 
 											When("getting the application summary fails", func() {
@@ -679,6 +1385,22 @@ var _ = Describe("push Command", func() {
 												})
 											})
 
+											When("staging fails after the package was uploaded", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														pushPlan.PackageGUID = "some-package-guid"
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Error: actionerror.StagingFailedError{Reason: "compile failed"}},
+														})
+													}
+												})
+
+												It("suggests restaging the uploaded package", func() {
+													Expect(executeErr).To(MatchError(actionerror.StagingFailedError{Reason: "compile failed"}))
+													Expect(testUI.Out).To(Say(`TIP: The package was uploaded successfully\. Use 'faceman push first-app --resume' or 'faceman restage first-app --package some-package-guid' to retry staging without uploading again\.`))
+												})
+											})
+
 											When("the error is a startup timeout error", func() {
 												BeforeEach(func() {
 													fakeActor.ActualizeStub = func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
@@ -1050,6 +1772,52 @@ var _ = Describe("push Command", func() {
 				Expect(actualVars).To(Equal(vars))
 			})
 		})
+
+		When("--overlay flags are provided", func() {
+			BeforeEach(func() {
+				fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+				flagOverrides.PathsToOverlays = []string{"prod.yml", "eu.yml"}
+				flagOverrides.PathsToVarsFiles = []string{"vars.yml"}
+				flagOverrides.Vars = []template.VarKV{{Name: "put-var-here", Value: "turtle"}}
+				fakeManifestParser.InterpolateManifestWithOverlaysReturns([]byte("merged-manifest"), nil)
+			})
+
+			It("merges the overlays before interpolating the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(0))
+				Expect(fakeManifestParser.InterpolateManifestWithOverlaysCallCount()).To(Equal(1))
+				actualManifestPath, actualOverlays, actualVarsFiles, actualVars := fakeManifestParser.InterpolateManifestWithOverlaysArgsForCall(0)
+				Expect(actualManifestPath).To(Equal("/manifest/path"))
+				Expect(actualOverlays).To(Equal([]string{"prod.yml", "eu.yml"}))
+				Expect(actualVarsFiles).To(Equal([]string{"vars.yml"}))
+				Expect(actualVars).To(Equal([]template.VarKV{{Name: "put-var-here", Value: "turtle"}}))
+
+				_, actualRawManifest := fakeManifestParser.ParseManifestArgsForCall(0)
+				Expect(actualRawManifest).To(Equal([]byte("merged-manifest")))
+			})
+
+			When("merging the overlays fails", func() {
+				BeforeEach(func() {
+					fakeManifestParser.InterpolateManifestWithOverlaysReturns(nil, errors.New("bad overlay"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("bad overlay"))
+					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("there is no manifest", func() {
+				BeforeEach(func() {
+					fakeManifestLocator.PathReturns("", false, nil)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--overlay requires a manifest"}))
+				})
+			})
+		})
 	})
 
 	Describe("GetFlagOverrides", func() {
@@ -1070,15 +1838,23 @@ var _ = Describe("push Command", func() {
 			cmd.StartCommand = flag.Command{FilteredString: types.FilteredString{IsSet: true, Value: "some-start-command"}}
 			cmd.NoRoute = true
 			cmd.RandomRoute = false
+			cmd.NoBuildCache = true
 			cmd.NoStart = true
 			cmd.NoWait = true
+			cmd.Resume = true
 			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			cmd.MaxInFlight = flag.PositiveInteger{Value: 4}
+			cmd.CanarySteps = flag.CanarySteps{Weights: []int{10, 50}}
 			cmd.Instances = flag.Instances{NullInt: types.NullInt{Value: 10, IsSet: true}}
 			cmd.PathToManifest = "/manifest/path"
+			cmd.PathsToOverlays = []flag.PathWithExistenceCheck{"/overlay1", "/overlay2"}
 			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/vars1", "/vars2"}
 			cmd.Vars = []template.VarKV{{Name: "key", Value: "val"}}
 			cmd.Task = true
 			cmd.LogRateLimit = "512M"
+			cmd.FollowSymlinks = true
+			cmd.PreserveFileModes = true
+			cmd.PreserveTimestamps = true
 		})
 
 		JustBeforeEach(func() {
@@ -1098,16 +1874,42 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.Disk).To(Equal("256M"))
 			Expect(overrides.StartCommand).To(Equal(types.FilteredString{IsSet: true, Value: "some-start-command"}))
 			Expect(overrides.NoRoute).To(BeTrue())
+			Expect(overrides.NoBuildCache).To(BeTrue())
 			Expect(overrides.NoStart).To(BeTrue())
 			Expect(overrides.NoWait).To(BeTrue())
 			Expect(overrides.RandomRoute).To(BeFalse())
+			Expect(overrides.Resume).To(BeTrue())
 			Expect(overrides.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+			Expect(overrides.MaxInFlight).To(Equal(4))
+			Expect(overrides.CanaryWeights).To(Equal([]int{10, 50}))
 			Expect(overrides.Instances).To(Equal(types.NullInt{Value: 10, IsSet: true}))
 			Expect(overrides.ManifestPath).To(Equal("/manifest/path"))
+			Expect(overrides.PathsToOverlays).To(Equal([]string{"/overlay1", "/overlay2"}))
 			Expect(overrides.PathsToVarsFiles).To(Equal([]string{"/vars1", "/vars2"}))
 			Expect(overrides.Vars).To(Equal([]template.VarKV{{Name: "key", Value: "val"}}))
 			Expect(overrides.Task).To(BeTrue())
 			Expect(overrides.LogRateLimit).To(Equal("512M"))
+			Expect(overrides.FollowSymlinks).To(BeTrue())
+			Expect(overrides.PreserveFileModes).To(BeTrue())
+			Expect(overrides.PreserveTimestamps).To(BeTrue())
+		})
+
+		It("uses the resource caches in the config directory", func() {
+			fakeConfig.ResourceCacheDirReturns("/some/config/resource-caches")
+			overrides, overridesErr = cmd.GetFlagOverrides()
+			Expect(overridesErr).ToNot(HaveOccurred())
+			Expect(overrides.ResourceCacheDir).To(Equal("/some/config/resource-caches"))
+		})
+
+		When("--rehash is provided", func() {
+			BeforeEach(func() {
+				cmd.Rehash = true
+				fakeConfig.ResourceCacheDirReturns("/some/config/resource-caches")
+			})
+
+			It("does not use the resource caches", func() {
+				Expect(overrides.ResourceCacheDir).To(BeEmpty())
+			})
 		})
 
 		When("a docker image is provided", func() {
@@ -1160,6 +1962,13 @@ var _ = Describe("push Command", func() {
 			},
 			translatableerror.ArgumentCombinationError{Args: []string{"--docker-image, -o", "--path, -p"}}),
 
+		Entry("when --no-manifest and --overlay are passed",
+			func() {
+				cmd.NoManifest = true
+				cmd.PathsToOverlays = []flag.PathWithExistenceCheck{"prod.yml"}
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--no-manifest", "--overlay"}}),
+
 		Entry("when -u http does not have a matching --endpoint",
 			func() {
 				cmd.HealthCheckType.Type = constant.HTTP
@@ -1258,6 +2067,127 @@ var _ = Describe("push Command", func() {
 			},
 			translatableerror.InvalidBuildpacksError{}),
 
+		Entry("when follow-symlinks and preserve-symlinks flags are passed",
+			func() {
+				cmd.FollowSymlinks = true
+				cmd.PreserveSymlinks = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--follow-symlinks", "--preserve-symlinks",
+				},
+			}),
+
+		Entry("when follow-symlinks and docker image flags are passed",
+			func() {
+				cmd.FollowSymlinks = true
+				cmd.DockerImage.Path = "some-docker-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--follow-symlinks", "--docker-image, -o", "--droplet",
+				},
+			}),
+
+		Entry("when preserve-file-modes and docker image flags are passed",
+			func() {
+				cmd.PreserveFileModes = true
+				cmd.DockerImage.Path = "some-docker-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--preserve-file-modes", "--docker-image, -o", "--droplet",
+				},
+			}),
+
+		Entry("when preserve-timestamps and droplet flags are passed",
+			func() {
+				cmd.PreserveTimestamps = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--preserve-timestamps", "--docker-image, -o", "--droplet",
+				},
+			}),
+
+		Entry("when no-build-cache and docker image flags are passed",
+			func() {
+				cmd.NoBuildCache = true
+				cmd.DockerImage.Path = "some-docker-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--no-build-cache", "--docker-image, -o", "--droplet",
+				},
+			}),
+
+		Entry("when no-build-cache and no-start flags are passed",
+			func() {
+				cmd.NoBuildCache = true
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--no-build-cache", "--no-start",
+				},
+			}),
+
+		Entry("when resume and droplet flags are passed",
+			func() {
+				cmd.Resume = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--resume", "--docker-image, -o", "--droplet", "--path, -p",
+				},
+			}),
+
+		Entry("when resume and path flags are passed",
+			func() {
+				cmd.Resume = true
+				cmd.AppPath = "some-path"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--resume", "--docker-image, -o", "--droplet", "--path, -p",
+				},
+			}),
+
+		Entry("when resume and watch flags are passed",
+			func() {
+				cmd.Resume = true
+				cmd.Watch = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--resume", "--no-start", "--watch",
+				},
+			}),
+
+		Entry("when min-healthy-percent and no-start flags are passed",
+			func() {
+				cmd.MinHealthyPercent = flag.Percentage{Value: 50}
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--min-healthy-percent", "--stability-window", "--no-start", "--task",
+				},
+			}),
+
+		Entry("when stability-window and task flags are passed",
+			func() {
+				cmd.StabilityWindow = flag.Duration{Value: time.Minute, IsSet: true}
+				cmd.Task = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--min-healthy-percent", "--stability-window", "--no-start", "--task",
+				},
+			}),
+
 		Entry("task and strategy flags are passed",
 			func() {
 				cmd.Task = true
@@ -1268,5 +2198,62 @@ var _ = Describe("push Command", func() {
 					"--task", "--strategy=rolling",
 				},
 			}),
+
+		Entry("when strategy 'canary' and no-start flags are passed",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--no-start", "--strategy=canary",
+				},
+			}),
+
+		Entry("max-in-flight is passed without a strategy",
+			func() {
+				cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--max-in-flight",
+				Arg2: "--strategy",
+			}),
+
+		Entry("canary-steps is passed without the canary strategy",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+				cmd.CanarySteps = flag.CanarySteps{Weights: []int{50}}
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--canary-steps",
+				Arg2: "--strategy canary",
+			}),
+
+		Entry("watch and droplet flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--watch", "--docker-image, -o", "--droplet"},
+			}),
+
+		Entry("watch and result-file flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.ResultFile = "some-result.json"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--watch", "--result-file", "--show-effective-manifest"},
+			}),
+
+		Entry("parallel and result-file flags are passed",
+			func() {
+				cmd.Parallel = flag.PositiveInteger{Value: 2}
+				cmd.ResultFile = "some-result.json"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--parallel", "--result-file"},
+			}),
 	)
 })
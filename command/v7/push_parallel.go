@@ -0,0 +1,204 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+// parallelPushEvent is an event of the push of the plan at index in its
+// group. A nil event means that the push has no more events.
+type parallelPushEvent struct {
+	index int
+	event *v7pushaction.PushEvent
+}
+
+// actualizeInParallel pushes the apps of the group, which do not depend on
+// each other, running up to --parallel of them at the same time. The output of
+// each app is prefixed with its name. Once an app fails no more are started,
+// the running ones are waited for and the first failure is returned.
+func (cmd *PushCommand) actualizeInParallel(group []v7pushaction.PushPlan) error {
+	events := make(chan parallelPushEvent)
+	appCmds := make([]*PushCommand, len(group))
+	appErrs := make([]error, len(group))
+	next, running := 0, 0
+
+	start := func() {
+		plan := group[next]
+		log.WithField("app_name", plan.Application.Name).Info("actualizing in parallel")
+
+		appCmd := *cmd
+		appCmd.UI = prefixedUI{UI: cmd.UI, prefix: "[" + plan.Application.Name + "] "}
+		appCmd.ProgressBar = silentProgressBar{}
+		appCmd.logGrouper = shared.NewLogGrouper(appCmd.UI, configv3.LogGroupsNone)
+		appCmd.stopStreamingFunc = nil
+		appCmds[next] = &appCmd
+
+		go forwardPushEvents(next, cmd.PushActor.Actualize(plan, silentProgressBar{}), events)
+		next++
+		running++
+	}
+
+	for next < len(group) && running < int(cmd.Parallel.Value) {
+		start()
+	}
+
+	var firstErr error
+	for running > 0 {
+		pushEvent := <-events
+		appCmd := appCmds[pushEvent.index]
+		plan := group[pushEvent.index]
+
+		if pushEvent.event != nil {
+			if appErrs[pushEvent.index] == nil {
+				appErrs[pushEvent.index] = appCmd.handlePushEvent(pushEvent.event)
+			}
+			continue
+		}
+
+		running--
+		if appCmd.stopStreamingFunc != nil {
+			appCmd.stopStreamingFunc()
+			appCmd.stopStreamingFunc = nil
+		}
+
+		err := appCmd.finishParallelPush(plan, appErrs[pushEvent.index])
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			} else {
+				appCmd.displayParallelPushError(err)
+			}
+		}
+
+		if firstErr == nil && next < len(group) {
+			start()
+		}
+	}
+
+	return firstErr
+}
+
+// forwardPushEvents sends the events of the stream to events, followed by a
+// nil event once the stream is closed.
+func forwardPushEvents(index int, eventStream <-chan *v7pushaction.PushEvent, events chan<- parallelPushEvent) {
+	for event := range eventStream {
+		events <- parallelPushEvent{index: index, event: event}
+	}
+	events <- parallelPushEvent{index: index}
+}
+
+// handlePushEvent displays a single event of a push, returning the error the
+// push failed with.
+func (cmd *PushCommand) handlePushEvent(event *v7pushaction.PushEvent) error {
+	cmd.UI.DisplayWarnings(event.Warnings)
+	if event.Err != nil {
+		cmd.displayRestageTip(event.Plan, event.Err)
+		return event.Err
+	}
+	return cmd.processEvent(event.Event, event.Plan, false)
+}
+
+// finishParallelPush displays the summary of an app whose push has no more
+// events and checks its health, as pushApps does for each app.
+func (cmd *PushCommand) finishParallelPush(plan v7pushaction.PushPlan, err error) error {
+	var summary v7action.DetailedApplicationSummary
+	if cmd.shouldDisplaySummary(err) {
+		var summaryErr error
+		summary, summaryErr = cmd.displayAppSummary(plan)
+		if summaryErr != nil {
+			return summaryErr
+		}
+	}
+	if err == nil {
+		err = shared.VerifyAppHealth(cmd.VersionActor, cmd.UI, summary.Application, cmd.healthCriteria())
+	}
+	if err != nil {
+		return cmd.mapErr(plan.Application.Name, err)
+	}
+	if plan.Strategy == constant.DeploymentStrategyCanary {
+		shared.DisplayCanaryPaused(cmd.UI, cmd.Config.BinaryName(), plan.Application.Name)
+	}
+	return nil
+}
+
+// displayParallelPushError displays the error of an app that failed after the
+// first failing app, whose error is the one returned by the command.
+func (cmd *PushCommand) displayParallelPushError(err error) {
+	message := err.Error()
+	if translatable, ok := translatableerror.ConvertToTranslatableError(err).(translatableerror.TranslatableError); ok {
+		message = translatable.Translate(func(template string, data ...interface{}) string {
+			var templateValues []map[string]interface{}
+			for _, value := range data {
+				if values, ok := value.(map[string]interface{}); ok {
+					templateValues = append(templateValues, values)
+				}
+			}
+			return cmd.UI.TranslateText(template, templateValues...)
+		})
+	}
+	cmd.UI.DisplayWarning("FAILED: {{.Error}}", map[string]interface{}{"Error": message})
+}
+
+// prefixedUI prefixes the text, warnings and log lines of the push of an app
+// with its name, so that the interleaved output of parallel pushes can be
+// told apart. Blank lines are dropped.
+type prefixedUI struct {
+	command.UI
+	prefix string
+}
+
+func (prefixed prefixedUI) DisplayNewline() {}
+
+func (prefixed prefixedUI) DisplayText(template string, data ...map[string]interface{}) {
+	prefixed.UI.DisplayText("{{.Prefix}}{{.Text}}", map[string]interface{}{
+		"Prefix": prefixed.prefix,
+		"Text":   prefixed.UI.TranslateText(template, data...),
+	})
+}
+
+func (prefixed prefixedUI) DisplayTextWithFlavor(template string, data ...map[string]interface{}) {
+	prefixed.DisplayText(template, data...)
+}
+
+func (prefixed prefixedUI) DisplayWarning(template string, data ...map[string]interface{}) {
+	prefixed.UI.DisplayWarning("{{.Prefix}}{{.Text}}", map[string]interface{}{
+		"Prefix": prefixed.prefix,
+		"Text":   prefixed.UI.TranslateText(template, data...),
+	})
+}
+
+func (prefixed prefixedUI) DisplayWarnings(warnings []string) {
+	for _, warning := range warnings {
+		prefixed.UI.DisplayWarning("{{.Prefix}}{{.Text}}", map[string]interface{}{
+			"Prefix": prefixed.prefix,
+			"Text":   warning,
+		})
+	}
+}
+
+func (prefixed prefixedUI) DisplayLogMessage(message ui.LogMessage, displayHeader bool) {
+	prefixed.UI.DisplayLogMessage(prefixedLogMessage{LogMessage: message, prefix: prefixed.prefix}, displayHeader)
+}
+
+// prefixedLogMessage prefixes every line of a log message.
+type prefixedLogMessage struct {
+	ui.LogMessage
+	prefix string
+}
+
+func (message prefixedLogMessage) Message() string {
+	lines := strings.Split(strings.TrimRight(message.LogMessage.Message(), "\r\n"), "\n")
+	for i, line := range lines {
+		lines[i] = message.prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,32 @@
+package v7
+
+import "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+// pushPhases maps the push events that begin a phase to the name of that
+// phase; all other events belong to whichever phase is in progress.
+var pushPhases = map[v7pushaction.Event]string{
+	v7pushaction.ApplyManifest:                   "manifest",
+	v7pushaction.SetDockerImage:                  "manifest",
+	v7pushaction.CreatingPackage:                 "upload",
+	v7pushaction.ResourceMatching:                "upload",
+	v7pushaction.ReusingPackage:                  "upload",
+	v7pushaction.CreatingArchive:                 "upload",
+	v7pushaction.ReadingArchive:                  "upload",
+	v7pushaction.UploadingApplication:            "upload",
+	v7pushaction.UploadingApplicationWithArchive: "upload",
+	v7pushaction.CreatingDroplet:                 "upload",
+	v7pushaction.UploadingDroplet:                "upload",
+	v7pushaction.ClearingBuildCache:              "staging",
+	v7pushaction.StartingStaging:                 "staging",
+	v7pushaction.StoppingApplication:             "start",
+	v7pushaction.SettingDroplet:                  "start",
+	v7pushaction.RestartingApplication:           "start",
+	v7pushaction.StartingDeployment:              "start",
+}
+
+var pushPhaseTitles = map[string]string{
+	"manifest": "Configuring app {{.AppName}}",
+	"upload":   "Uploading app {{.AppName}}",
+	"staging":  "Staging app {{.AppName}}",
+	"start":    "Starting app {{.AppName}}",
+}
@@ -0,0 +1,153 @@
+package v7
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// pushResult is the machine-readable summary written by push --result-file.
+type pushResult struct {
+	Applications []*pushAppResult `json:"applications"`
+	Warnings     []string         `json:"warnings"`
+	Error        string           `json:"error,omitempty"`
+
+	phaseStartedAt time.Time
+}
+
+type pushAppResult struct {
+	Name           string             `json:"name"`
+	GUID           string             `json:"guid"`
+	Revision       *pushRevision      `json:"revision,omitempty"`
+	PackageGUID    string             `json:"package_guid,omitempty"`
+	DropletGUID    string             `json:"droplet_guid,omitempty"`
+	DeploymentGUID string             `json:"deployment_guid,omitempty"`
+	Routes         []string           `json:"routes"`
+	Phases         []*pushPhaseResult `json:"phases"`
+	Warnings       []string           `json:"warnings"`
+	Error          string             `json:"error,omitempty"`
+}
+
+type pushRevision struct {
+	GUID    string `json:"guid"`
+	Version int    `json:"version"`
+}
+
+type pushPhaseResult struct {
+	Name            string  `json:"name"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+func newPushResult() *pushResult {
+	return &pushResult{
+		Applications: []*pushAppResult{},
+		Warnings:     []string{},
+	}
+}
+
+func (result *pushResult) addWarnings(warnings []string) {
+	if result == nil {
+		return
+	}
+	result.Warnings = append(result.Warnings, warnings...)
+}
+
+func (result *pushResult) currentApp() *pushAppResult {
+	return result.Applications[len(result.Applications)-1]
+}
+
+func (result *pushResult) startApp(plan v7pushaction.PushPlan) {
+	if result == nil {
+		return
+	}
+	result.Applications = append(result.Applications, &pushAppResult{
+		Name:     plan.Application.Name,
+		GUID:     plan.Application.GUID,
+		Routes:   []string{},
+		Phases:   []*pushPhaseResult{},
+		Warnings: []string{},
+	})
+}
+
+func (result *pushResult) recordEvent(event *v7pushaction.PushEvent) {
+	if result == nil {
+		return
+	}
+
+	app := result.currentApp()
+	app.GUID = event.Plan.Application.GUID
+	app.PackageGUID = event.Plan.PackageGUID
+	app.DropletGUID = event.Plan.DropletGUID
+	app.DeploymentGUID = event.Plan.DeploymentGUID
+	if event.Event != v7pushaction.InstanceDetails {
+		app.Warnings = append(app.Warnings, event.Warnings...)
+	}
+
+	phase, startsPhase := pushPhases[event.Event]
+	if !startsPhase {
+		return
+	}
+
+	if len(app.Phases) > 0 && app.Phases[len(app.Phases)-1].Name == phase {
+		return
+	}
+
+	result.endPhase()
+	result.phaseStartedAt = time.Now()
+	app.Phases = append(app.Phases, &pushPhaseResult{Name: phase})
+}
+
+func (result *pushResult) endPhase() {
+	app := result.currentApp()
+	if len(app.Phases) == 0 || result.phaseStartedAt.IsZero() {
+		return
+	}
+	app.Phases[len(app.Phases)-1].DurationSeconds = time.Since(result.phaseStartedAt).Seconds()
+	result.phaseStartedAt = time.Time{}
+}
+
+func (result *pushResult) finishApp(summary v7action.DetailedApplicationSummary, revisions []resources.Revision, err error) {
+	if result == nil {
+		return
+	}
+
+	result.endPhase()
+	app := result.currentApp()
+	for _, route := range summary.Routes {
+		app.Routes = append(app.Routes, route.URL)
+	}
+	for _, revision := range revisions {
+		if app.Revision == nil || revision.Version > app.Revision.Version {
+			app.Revision = &pushRevision{GUID: revision.GUID, Version: revision.Version}
+		}
+	}
+	if err != nil {
+		app.Error = err.Error()
+	}
+}
+
+func (result *pushResult) write(path string, err error) error {
+	if result == nil {
+		return nil
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	rawResult, marshalErr := json.MarshalIndent(result, "", "  ")
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	writeErr := ioutil.WriteFile(path, append(rawResult, '\n'), 0666)
+	if writeErr != nil {
+		return translatableerror.FileCreationError{Err: writeErr}
+	}
+	return nil
+}
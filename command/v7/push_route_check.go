@@ -0,0 +1,80 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// checkRoutes reports every route the manifest declares that cannot be mapped
+// in the targeted space, so that the push stops before anything is uploaded
+// instead of failing on the first route.
+func (cmd PushCommand) checkRoutes(manifest manifestparser.Manifest) error {
+	routes := manifestRoutes(manifest)
+	if len(routes) == 0 {
+		return nil
+	}
+
+	cmd.UI.DisplayText("Checking the routes of the manifest...")
+	problems, err := cmd.routeProblems(routes)
+	if err != nil {
+		return err
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	cmd.displayRouteProblems(problems)
+	return translatableerror.UnavailableRoutesError{Count: len(problems)}
+}
+
+// explainRouteError displays which routes of the manifest are taken, and by
+// whom, when applying the manifest failed because of a route. The Cloud
+// Controller error does not say.
+func (cmd PushCommand) explainRouteError(manifest manifestparser.Manifest, err error) {
+	if !strings.Contains(strings.ToLower(err.Error()), "route") {
+		return
+	}
+
+	routes := manifestRoutes(manifest)
+	if len(routes) == 0 {
+		return
+	}
+
+	problems, lookupErr := cmd.routeProblems(routes)
+	if lookupErr != nil || len(problems) == 0 {
+		return
+	}
+
+	cmd.displayRouteProblems(problems)
+}
+
+func manifestRoutes(manifest manifestparser.Manifest) []string {
+	var routes []string
+	for _, app := range manifest.Applications {
+		if !app.NoRoute {
+			routes = append(routes, app.Routes()...)
+		}
+	}
+	return routes
+}
+
+func (cmd PushCommand) routeProblems(routes []string) ([]v7action.RouteProblem, error) {
+	problems, warnings, err := cmd.Actor.CheckRoutesAvailability(routes, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	cmd.result.addWarnings(warnings)
+	return problems, err
+}
+
+func (cmd PushCommand) displayRouteProblems(problems []v7action.RouteProblem) {
+	table := [][]string{{cmd.UI.TranslateText("route"), cmd.UI.TranslateText("problem")}}
+	for _, problem := range problems {
+		table = append(table, []string{problem.Route, errorText(cmd.UI, problem.Err)})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
@@ -9,16 +9,21 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type RestageCommand struct {
 	BaseCommand
 
 	RequiredArgs        flag.AppName            `positional-args:"yes"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling, canary or none"`
+	MaxInFlight         flag.PositiveInteger    `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time; requires --strategy rolling or canary"`
+	CanarySteps         flag.CanarySteps        `long:"canary-steps" description:"Comma-separated percentages of instances a canary deployment replaces before each pause, e.g. 10,50; requires --strategy canary"`
+	NoBuildCache        bool                    `long:"no-build-cache" description:"Clear the buildpack cache of the app before staging, so the build starts clean"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
-	usage               interface{}             `usage:"CF_NAME restage APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling'.\n\nEXAMPLES:\n   CF_NAME restage APP_NAME\n   CF_NAME restage APP_NAME --strategy rolling\n   CF_NAME restage APP_NAME --strategy rolling --no-wait"`
-	relatedCommands     interface{}             `related_commands:"restart"`
+	PackageGUID         string                  `long:"package" description:"The guid of the package to stage (default: latest ready package)"`
+	usage               interface{}             `usage:"CF_NAME restage APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling' or '--strategy canary'.\n\nEXAMPLES:\n   CF_NAME restage APP_NAME\n   CF_NAME restage APP_NAME --strategy rolling\n   CF_NAME restage APP_NAME --strategy rolling --no-wait\n   CF_NAME restage APP_NAME --strategy canary --max-in-flight 2\n   CF_NAME restage APP_NAME --strategy canary --canary-steps 10,50\n   CF_NAME restage APP_NAME --package PACKAGE_GUID\n   CF_NAME restage APP_NAME --no-build-cache"`
+	relatedCommands     interface{}             `related_commands:"clear-build-cache, packages, restart"`
 	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -42,7 +47,12 @@ func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd RestageCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	deployment, err := shared.NewDeploymentOptions(cmd.Strategy, cmd.MaxInFlight, cmd.CanarySteps)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -52,7 +62,7 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return err
 	}
 
-	if cmd.Strategy.Name != constant.DeploymentStrategyRolling {
+	if !deployment.UsesDeployment() {
 		cmd.UI.DisplayWarning("This action will cause app downtime.")
 	}
 
@@ -71,18 +81,28 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return err
 	}
 
-	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(app)
+	pkg, warnings, err := cmd.getPackage(app)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return mapErr(cmd.Config, cmd.RequiredArgs.AppName, err)
 	}
 
+	if cmd.NoBuildCache {
+		cmd.UI.DisplayText("Clearing build cache...")
+		warnings, err = cmd.Actor.ClearApplicationBuildCache(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
 	err = cmd.Stager.StageAndStart(
 		app,
 		cmd.Config.TargetedSpace(),
 		cmd.Config.TargetedOrganization(),
 		pkg.GUID,
-		cmd.Strategy.Name,
+		deployment,
 		cmd.NoWait,
 		constant.ApplicationRestarting,
 	)
@@ -93,6 +113,14 @@ func (cmd RestageCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd RestageCommand) getPackage(app resources.Application) (resources.Package, v7action.Warnings, error) {
+	if cmd.PackageGUID != "" {
+		return cmd.Actor.GetReadyPackageForApplication(app, cmd.PackageGUID)
+	}
+
+	return cmd.Actor.GetNewestReadyPackageForApplication(app)
+}
+
 func mapErr(config command.Config, appName string, err error) error {
 	switch err.(type) {
 	case actionerror.AllInstancesCrashedError:
@@ -163,6 +163,75 @@ var _ = Describe("restage Command", func() {
 		})
 	})
 
+	When("a package guid is provided", func() {
+		BeforeEach(func() {
+			cmd.PackageGUID = "some-package-guid"
+			fakeActor.GetReadyPackageForApplicationReturns(
+				resources.Package{GUID: "some-package-guid"},
+				v7action.Warnings{"get-ready-package-warning"},
+				nil,
+			)
+		})
+
+		It("stages that package instead of the newest one", func() {
+			Expect(fakeActor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.GetReadyPackageForApplicationCallCount()).To(Equal(1))
+			_, packageGUID := fakeActor.GetReadyPackageForApplicationArgsForCall(0)
+			Expect(packageGUID).To(Equal("some-package-guid"))
+			Expect(testUI.Err).To(Say("get-ready-package-warning"))
+
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			_, _, _, pkgGUID, _, _, _ := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(pkgGUID).To(Equal("some-package-guid"))
+		})
+
+		When("the package is not ready", func() {
+			BeforeEach(func() {
+				fakeActor.GetReadyPackageForApplicationReturns(
+					resources.Package{},
+					nil,
+					actionerror.PackageNotReadyError{GUID: "some-package-guid", State: "FAILED"},
+				)
+			})
+
+			It("returns the error without staging", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotReadyError{GUID: "some-package-guid", State: "FAILED"}))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the build cache is disabled", func() {
+		BeforeEach(func() {
+			cmd.NoBuildCache = true
+			fakeActor.ClearApplicationBuildCacheReturns(v7action.Warnings{"clear-cache-warning"}, nil)
+		})
+
+		It("clears the build cache of the app before staging", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Clearing build cache..."))
+			Expect(testUI.Err).To(Say("clear-cache-warning"))
+			Expect(fakeActor.ClearApplicationBuildCacheCallCount()).To(Equal(1))
+			Expect(fakeActor.ClearApplicationBuildCacheArgsForCall(0)).To(Equal(app.GUID))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+		})
+
+		When("clearing the build cache fails", func() {
+			BeforeEach(func() {
+				fakeActor.ClearApplicationBuildCacheReturns(nil, errors.New("clear-cache-error"))
+			})
+
+			It("returns the error without staging", func() {
+				Expect(executeErr).To(MatchError("clear-cache-error"))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	It("does not clear the build cache by default", func() {
+		Expect(fakeActor.ClearApplicationBuildCacheCallCount()).To(Equal(0))
+	})
+
 	It("stages and starts the app", func() {
 		Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
 		returnedApp, spaceForApp, orgForApp, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
@@ -170,7 +239,7 @@ var _ = Describe("restage Command", func() {
 		Expect(spaceForApp).To(Equal(fakeConfig.TargetedSpace()))
 		Expect(orgForApp).To(Equal(fakeConfig.TargetedOrganization()))
 		Expect(pkgGUID).To(Equal("earliest-package-guid"))
-		Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
+		Expect(strategy).To(Equal(v7action.DeploymentOptions{}))
 		Expect(noWait).To(Equal(false))
 		Expect(appAction).To(Equal(constant.ApplicationRestarting))
 	})
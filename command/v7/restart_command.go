@@ -1,21 +1,32 @@
 package v7
 
 import (
+	"strconv"
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type RestartCommand struct {
 	BaseCommand
 
-	RequiredArgs        flag.AppName            `positional-args:"yes"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	RequiredArgs        flag.OptionalAppName    `positional-args:"yes"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling, canary or none"`
+	MaxInFlight         flag.PositiveInteger    `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time; requires --strategy rolling or canary"`
+	CanarySteps         flag.CanarySteps        `long:"canary-steps" description:"Comma-separated percentages of instances a canary deployment replaces before each pause, e.g. 10,50; requires --strategy canary"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
-	usage               interface{}             `usage:"CF_NAME restart APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling'.\n\n   If the app's most recent package is unstaged, restarting the app will stage and run that package.\n   Otherwise, the app's current droplet will be run."`
+	BatchSize           flag.PositiveInteger    `long:"batch-size" description:"Without a deployment, restart this many instances of each process at a time, waiting for them to be running before restarting the next ones"`
+	MinHealthyPercent   flag.Percentage         `long:"min-healthy-percent" description:"Fail unless at least this percentage of the instances of each process are running after the app starts"`
+	StabilityWindow     flag.Duration           `long:"stability-window" description:"After the app starts, watch its instances for this long (e.g. 2m) and fail if any of them crashes"`
+	usage               interface{}             `usage:"CF_NAME restart [APP_NAME] [--strategy STRATEGY [--max-in-flight COUNT] [--canary-steps WEIGHTS]] [--no-wait]\n   [--batch-size COUNT] [--min-healthy-percent PERCENT] [--stability-window DURATION]\n\n   This command will cause downtime unless you use '--strategy rolling', '--strategy canary' or '--batch-size'.\n\n   If the app's most recent package is unstaged, restarting the app will stage and run that package.\n   Otherwise, the app's current droplet will be run.\n\n   APP_NAME can be left out when the manifest in the current directory declares a single app."`
 	relatedCommands     interface{}             `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -40,7 +51,26 @@ func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd RestartCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	deployment, err := shared.NewDeploymentOptions(cmd.Strategy, cmd.MaxInFlight, cmd.CanarySteps)
+	if err != nil {
+		return err
+	}
+
+	if cmd.BatchSize.Value > 0 {
+		if deployment.UsesDeployment() {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--batch-size", "--strategy"}}
+		}
+		if cmd.NoWait {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--batch-size", "--no-wait"}}
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.RequiredArgs.AppName, err = shared.ResolveAppName(cmd.UI, cmd.RequiredArgs.AppName)
 	if err != nil {
 		return err
 	}
@@ -62,7 +92,14 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return err
 	}
 
-	if packageGUID != "" || cmd.Strategy.Name == constant.DeploymentStrategyRolling {
+	if cmd.BatchSize.Value > 0 && app.Started() {
+		if packageGUID == "" {
+			return cmd.restartInBatches(app, user)
+		}
+		cmd.UI.DisplayWarning("The app has a package that has not been staged yet, so all of its instances are restarted at once to run it.")
+	}
+
+	if packageGUID != "" || deployment.UsesDeployment() {
 		cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -73,16 +110,60 @@ func (cmd RestartCommand) Execute(args []string) error {
 	}
 
 	if packageGUID != "" {
-		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, cmd.Strategy.Name, cmd.NoWait, constant.ApplicationRestarting)
+		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, deployment, cmd.NoWait, constant.ApplicationRestarting)
 		if err != nil {
 			return err
 		}
 	} else {
-		err = cmd.Stager.StartApp(app, "", cmd.Strategy.Name, cmd.NoWait, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationRestarting)
+		err = cmd.Stager.StartApp(app, "", deployment, cmd.NoWait, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationRestarting)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return shared.VerifyAppHealth(cmd.Actor, cmd.UI, app, v7action.ApplicationHealthCriteria{
+		MinHealthyPercent: cmd.MinHealthyPercent.Value,
+		StabilityWindow:   cmd.StabilityWindow.Value,
+	})
+}
+
+// restartInBatches restarts the instances of the started app a batch at a
+// time instead of stopping and starting the whole app.
+func (cmd RestartCommand) restartInBatches(app resources.Application, user configv3.User) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} in batches of {{.BatchSize}} instances...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+		"BatchSize": cmd.BatchSize.Value,
+	})
+	cmd.UI.DisplayNewline()
+
+	warnings, err := cmd.Actor.RestartApplicationInstancesInBatches(app, int(cmd.BatchSize.Value), func(processType string, indexes []int64) {
+		formattedIndexes := make([]string, len(indexes))
+		for i, index := range indexes {
+			formattedIndexes[i] = strconv.FormatInt(index, 10)
+		}
+		cmd.UI.DisplayText("Restarting instances {{.Indexes}} of process {{.ProcessType}}...", map[string]interface{}{
+			"Indexes":     strings.Join(formattedIndexes, ", "),
+			"ProcessType": processType,
+		})
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(app.Name, cmd.Config.TargetedSpace().GUID, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	shared.NewAppSummaryDisplayer(cmd.UI).AppDisplay(summary, false)
+
+	return shared.VerifyAppHealth(cmd.Actor, cmd.UI, app, v7action.ApplicationHealthCriteria{
+		MinHealthyPercent: cmd.MinHealthyPercent.Value,
+		StabilityWindow:   cmd.StabilityWindow.Value,
+	})
 }
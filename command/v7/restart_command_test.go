@@ -2,12 +2,14 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -59,7 +61,7 @@ var _ = Describe("restart Command", func() {
 		fakeActor.GetApplicationByNameAndSpaceReturns(app, v7action.Warnings{"get-app-warning"}, nil)
 
 		cmd = v7.RestartCommand{
-			RequiredArgs: flag.AppName{AppName: app.Name},
+			RequiredArgs: flag.OptionalAppName{AppName: app.Name},
 			Strategy:     flag.DeploymentStrategy{Name: strategy},
 			NoWait:       noWait,
 
@@ -77,6 +79,111 @@ var _ = Describe("restart Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--max-in-flight is given without a deployment strategy", func() {
+		BeforeEach(func() {
+			cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+		})
+
+		It("returns an error before doing anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--strategy"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--canary-steps is given without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			cmd.CanarySteps = flag.CanarySteps{Weights: []int{50}}
+		})
+
+		It("returns an error before doing anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--canary-steps", Arg2: "--strategy canary"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--batch-size is given with a deployment strategy", func() {
+		BeforeEach(func() {
+			cmd.BatchSize = flag.PositiveInteger{Value: 2}
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+		})
+
+		It("returns an error before doing anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--batch-size", "--strategy"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--batch-size is given", func() {
+		BeforeEach(func() {
+			cmd.BatchSize = flag.PositiveInteger{Value: 2}
+			app.State = constant.ApplicationStarted
+			fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+			fakeActor.RestartApplicationInstancesInBatchesStub = func(_ resources.Application, _ int, handleBatch func(string, []int64)) (v7action.Warnings, error) {
+				handleBatch("web", []int64{0, 1})
+				handleBatch("web", []int64{2})
+				return v7action.Warnings{"batch-warning"}, nil
+			}
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{Application: app},
+			}, nil, nil)
+		})
+
+		It("restarts the instances in batches without stopping the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+
+			Expect(fakeActor.RestartApplicationInstancesInBatchesCallCount()).To(Equal(1))
+			restartedApp, batchSize, _ := fakeActor.RestartApplicationInstancesInBatchesArgsForCall(0)
+			Expect(restartedApp).To(Equal(app))
+			Expect(batchSize).To(Equal(2))
+
+			Expect(testUI.Out).To(Say(`Restarting app app-name in org some-org / space some-space as steve in batches of 2 instances\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting instances 0, 1 of process web\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting instances 2 of process web\.\.\.`))
+			Expect(testUI.Out).To(Say(`name:\s+app-name`))
+			Expect(testUI.Err).To(Say("batch-warning"))
+		})
+
+		When("the app is stopped", func() {
+			BeforeEach(func() {
+				app.State = constant.ApplicationStopped
+				fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+			})
+
+			It("starts the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RestartApplicationInstancesInBatchesCallCount()).To(Equal(0))
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the app has an unstaged package", func() {
+			BeforeEach(func() {
+				fakeActor.GetUnstagedNewestPackageGUIDReturns("package-guid", nil, nil)
+			})
+
+			It("warns and restarts all the instances to stage it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("restarted at once"))
+				Expect(fakeActor.RestartApplicationInstancesInBatchesCallCount()).To(Equal(0))
+				Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			})
+		})
+
+		When("restarting a batch fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestartApplicationInstancesInBatchesStub = nil
+				fakeActor.RestartApplicationInstancesInBatchesReturns(nil, actionerror.StartupTimeoutError{Name: "app-name"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{Name: "app-name"}))
+				Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -141,7 +248,7 @@ var _ = Describe("restart Command", func() {
 			Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 			Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
 			Expect(inputPkgGUID).To(Equal("package-guid"))
-			Expect(inputStrategy).To(Equal(strategy))
+			Expect(inputStrategy).To(Equal(v7action.DeploymentOptions{Strategy: strategy}))
 			Expect(inputNoWait).To(Equal(noWait))
 			Expect(inputAppAction).To(Equal(constant.ApplicationRestarting))
 		})
@@ -169,13 +276,42 @@ var _ = Describe("restart Command", func() {
 			inputApp, inputDropletGuid, inputStrategy, inputNoWait, inputSpace, inputOrg, inputAppAction := fakeAppStager.StartAppArgsForCall(0)
 			Expect(inputApp).To(Equal(app))
 			Expect(inputDropletGuid).To(Equal(""))
-			Expect(inputStrategy).To(Equal(strategy))
+			Expect(inputStrategy).To(Equal(v7action.DeploymentOptions{Strategy: strategy}))
 			Expect(inputNoWait).To(Equal(noWait))
 			Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 			Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
 			Expect(inputAppAction).To(Equal(constant.ApplicationRestarting))
 		})
 
+		When("the strategy is canary with max in flight", func() {
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.MaxInFlight = flag.PositiveInteger{Value: 3}
+			})
+
+			It("starts the app with a canary deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Restarting app app-name in org some-org / space some-space as steve..."))
+
+				_, _, inputDeployment, _, _, _, _ := fakeAppStager.StartAppArgsForCall(0)
+				Expect(inputDeployment).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyCanary, MaxInFlight: 3}))
+			})
+		})
+
+		When("the strategy is canary with steps", func() {
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.CanarySteps = flag.CanarySteps{Weights: []int{25, 75}}
+			})
+
+			It("starts the app with a canary deployment of those steps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, inputDeployment, _, _, _, _ := fakeAppStager.StartAppArgsForCall(0)
+				Expect(inputDeployment).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyCanary, CanaryWeights: []int{25, 75}}))
+			})
+		})
+
 		When("starting the app returns an error", func() {
 			BeforeEach(func() {
 				fakeAppStager.StartAppReturns(errors.New("start-error"))
@@ -183,6 +319,41 @@ var _ = Describe("restart Command", func() {
 
 			It("returns an error", func() {
 				Expect(executeErr).To(MatchError("start-error"))
+				Expect(fakeActor.VerifyApplicationHealthCallCount()).To(Equal(0))
+			})
+		})
+
+		It("does not verify the health of the app", func() {
+			Expect(fakeActor.VerifyApplicationHealthCallCount()).To(Equal(0))
+		})
+
+		When("health criteria are given", func() {
+			BeforeEach(func() {
+				cmd.MinHealthyPercent = flag.Percentage{Value: 90}
+				cmd.StabilityWindow = flag.Duration{Value: time.Minute, IsSet: true}
+			})
+
+			It("verifies the health of the app after starting it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.VerifyApplicationHealthCallCount()).To(Equal(1))
+				givenApp, criteria := fakeActor.VerifyApplicationHealthArgsForCall(0)
+				Expect(givenApp).To(Equal(app))
+				Expect(criteria).To(Equal(v7action.ApplicationHealthCriteria{
+					MinHealthyPercent: 90,
+					StabilityWindow:   time.Minute,
+				}))
+				Expect(testUI.Out).To(Say(`Watching app app-name for crashes for 1m0s\.\.\.`))
+			})
+
+			When("the app is not healthy", func() {
+				BeforeEach(func() {
+					fakeActor.VerifyApplicationHealthReturns(nil, actionerror.ProcessInstanceCrashedError{AppName: "app-name", ProcessType: "web", InstanceIndex: 1})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceCrashedError{AppName: "app-name", ProcessType: "web", InstanceIndex: 1}))
+				})
 			})
 		})
 	})
@@ -18,3 +18,7 @@ func (cmd RevisionCommand) Execute(_ []string) error {
 	cmd.UI.DisplayNewline()
 	return nil
 }
+
+func (RevisionCommand) ExperimentalFeature() string {
+	return "revisions"
+}
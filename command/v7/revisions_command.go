@@ -130,3 +130,7 @@ func decorateVersionWithDeployed(revision resources.Revision, deployedRevisions
 	}
 	return strconv.Itoa(revision.Version)
 }
+
+func (RevisionsCommand) ExperimentalFeature() string {
+	return "revisions"
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command"
@@ -14,11 +15,13 @@ import (
 type RollbackCommand struct {
 	BaseCommand
 
-	Force           bool          `short:"f" description:"Force rollback without confirmation"`
-	RequiredArgs    flag.AppName  `positional-args:"yes"`
-	Version         flag.Revision `long:"version" required:"true" description:"Roll back to the specified revision"`
-	relatedCommands interface{}   `related_commands:"revisions"`
-	usage           interface{}   `usage:"CF_NAME rollback APP_NAME [--version VERSION] [-f]"`
+	Force           bool                    `short:"f" description:"Force rollback without confirmation"`
+	RequiredArgs    flag.AppName            `positional-args:"yes"`
+	Version         flag.Revision           `long:"version" required:"true" description:"Roll back to the specified revision"`
+	Strategy        flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or canary (Default: rolling)"`
+	MaxInFlight     flag.PositiveInteger    `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time"`
+	relatedCommands interface{}             `related_commands:"revisions"`
+	usage           interface{}             `usage:"CF_NAME rollback APP_NAME [--version VERSION] [--strategy STRATEGY] [--max-in-flight COUNT] [-f]"`
 
 	LogCacheClient sharedaction.LogCacheClient
 	Stager         shared.AppStager
@@ -106,10 +109,16 @@ func (cmd RollbackCommand) Execute(args []string) error {
 		"Username":       user.Name,
 	})
 
+	// A revision can only be rolled back to with a deployment.
+	deployment := v7action.DeploymentOptions{Strategy: cmd.Strategy.Name, MaxInFlight: int(cmd.MaxInFlight.Value)}
+	if !deployment.UsesDeployment() {
+		deployment.Strategy = constant.DeploymentStrategyRolling
+	}
+
 	startAppErr := cmd.Stager.StartApp(
 		app,
 		revision.GUID,
-		constant.DeploymentStrategyRolling,
+		deployment,
 		false,
 		cmd.Config.TargetedSpace(),
 		cmd.Config.TargetedOrganization(),
@@ -123,3 +132,7 @@ func (cmd RollbackCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (RollbackCommand) ExperimentalFeature() string {
+	return "revisions"
+}
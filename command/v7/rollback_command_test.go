@@ -180,6 +180,20 @@ var _ = Describe("rollback Command", func() {
 				Expect(version).To(Equal(1))
 			})
 
+			When("the user passes a canary strategy with max in flight", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+					cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+				})
+
+				It("rolls back with a canary deployment", func() {
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+					_, _, deployment, _, _, _, _ := fakeAppStager.StartAppArgsForCall(0)
+					Expect(deployment).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyCanary, MaxInFlight: 2}))
+				})
+			})
+
 			When("the user passes the force flag", func() {
 				BeforeEach(func() {
 					cmd.Force = true
@@ -188,9 +202,10 @@ var _ = Describe("rollback Command", func() {
 				It("skips the prompt and executes the rollback", func() {
 					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
 
-					application, revisionGUID, _, _, _, _, appAction := fakeAppStager.StartAppArgsForCall(0)
+					application, revisionGUID, deployment, _, _, _, appAction := fakeAppStager.StartAppArgsForCall(0)
 					Expect(application.GUID).To(Equal("123"))
 					Expect(revisionGUID).To(Equal("some-1-guid"))
+					Expect(deployment).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyRolling}))
 					Expect(appAction).To(Equal(constant.ApplicationRollingBack))
 
 					Expect(testUI.Out).ToNot(Say("Rolling '%s' back to revision '1' will create a new revision. The new revision '3' will use the settings from revision '1'.", app))
@@ -0,0 +1,106 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RolloutStatusCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	NoWait          bool         `long:"no-wait" description:"Display the current status of the rollout without waiting for it to finish"`
+	Timeout         flag.Timeout `long:"timeout" short:"t" description:"Time (in seconds) to wait for the rollout to finish, defaults to the app start timeout"`
+	usage           interface{}  `usage:"CF_NAME rollout-status APP_NAME [--timeout SECONDS] [--no-wait]\n\nEXAMPLES:\n   cf push my-app --strategy rolling --no-wait\n   cf rollout-status my-app --timeout 600"`
+	relatedCommands interface{}  `related_commands:"app, cancel-deployment, continue-deployment, push, restart"`
+}
+
+func (cmd *RolloutStatusCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	message := "Waiting for rollout of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}..."
+	if cmd.NoWait {
+		message = "Getting rollout status of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"UserName":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployment, warnings, err := cmd.Actor.GetLatestDeploymentForApp(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var lastStatus v7action.RolloutStatus
+	warnings, err = cmd.Actor.PollRollout(application, deployment.GUID, cmd.timeout(), cmd.NoWait, func(status v7action.RolloutStatus) {
+		lastStatus = status
+		displayRolloutStatus(cmd.UI, status)
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if lastStatus.IsFinished() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Rollout of app {{.AppName}} is complete.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RolloutStatusCommand) timeout() time.Duration {
+	if cmd.Timeout.IsSet {
+		return time.Duration(cmd.Timeout.Value) * time.Second
+	}
+	return cmd.Config.StartupTimeout()
+}
+
+// displayRolloutStatus displays the state of a deployment, the step it is at,
+// how many instances it replaces at a time and how its new instances are
+// distributed between routable, starting and failing.
+func displayRolloutStatus(ui command.UI, status v7action.RolloutStatus) {
+	message := "{{.State}}: {{.Routable}} of {{.Total}} instances routable, {{.Starting}} starting, {{.Failing}} failing"
+	if status.Deployment.CanarySteps > 0 {
+		message += ", canary step {{.CanaryStep}} of {{.CanarySteps}}"
+	}
+	if status.Deployment.Options.MaxInFlight > 0 {
+		message += ", max in flight {{.MaxInFlight}}"
+	}
+
+	ui.DisplayText(message, map[string]interface{}{
+		"State":       status.Deployment.State,
+		"Routable":    status.Routable,
+		"Total":       status.Total,
+		"Starting":    status.Starting,
+		"Failing":     status.Failing,
+		"CanaryStep":  status.Deployment.CanaryStep,
+		"CanarySteps": status.Deployment.CanarySteps,
+		"MaxInFlight": status.Deployment.Options.MaxInFlight,
+	})
+}
@@ -0,0 +1,213 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rollout-status command", func() {
+	var (
+		cmd             RolloutStatusCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.StartupTimeoutReturns(5 * time.Minute)
+
+		cmd = RolloutStatusCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{Name: "some-app", GUID: "some-app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetLatestDeploymentForAppReturns(
+			resources.Deployment{GUID: "some-deployment-guid"},
+			v7action.Warnings{"get-deployment-warning"},
+			nil,
+		)
+		fakeActor.PollRolloutStub = func(app resources.Application, deploymentGUID string, timeout time.Duration, noWait bool, handleStatus func(v7action.RolloutStatus)) (v7action.Warnings, error) {
+			handleStatus(v7action.RolloutStatus{
+				Deployment: resources.Deployment{State: constant.DeploymentDeploying, StatusValue: constant.DeploymentStatusValueActive},
+				Routable:   1, Starting: 2, Failing: 0, Total: 3,
+			})
+			handleStatus(v7action.RolloutStatus{
+				Deployment: resources.Deployment{State: constant.DeploymentDeployed, StatusValue: constant.DeploymentStatusValueFinalized},
+				Routable:   3, Total: 3,
+			})
+			return v7action.Warnings{"poll-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("some-user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-user-error"))
+		})
+	})
+
+	It("waits for the latest deployment of the app and reports its progress", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetLatestDeploymentForAppArgsForCall(0)).To(Equal("some-app-guid"))
+
+		Expect(fakeActor.PollRolloutCallCount()).To(Equal(1))
+		app, deploymentGUID, timeout, noWait, _ := fakeActor.PollRolloutArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+		Expect(timeout).To(Equal(5 * time.Minute))
+		Expect(noWait).To(BeFalse())
+
+		Expect(testUI.Out).To(Say(`Waiting for rollout of app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`DEPLOYING: 1 of 3 instances routable, 2 starting, 0 failing\n`))
+		Expect(testUI.Out).To(Say(`DEPLOYED: 3 of 3 instances routable, 0 starting, 0 failing\n`))
+		Expect(testUI.Out).To(Say(`Rollout of app some-app is complete\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-deployment-warning"))
+		Expect(testUI.Err).To(Say("poll-warning"))
+	})
+
+	When("the timeout flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Timeout = flag.Timeout{NullInt: types.NullInt{IsSet: true, Value: 90}}
+		})
+
+		It("waits for that many seconds", func() {
+			_, _, timeout, _, _ := fakeActor.PollRolloutArgsForCall(0)
+			Expect(timeout).To(Equal(90 * time.Second))
+		})
+	})
+
+	When("the deployment is a canary", func() {
+		BeforeEach(func() {
+			fakeActor.PollRolloutStub = func(app resources.Application, deploymentGUID string, timeout time.Duration, noWait bool, handleStatus func(v7action.RolloutStatus)) (v7action.Warnings, error) {
+				handleStatus(v7action.RolloutStatus{
+					Deployment: resources.Deployment{State: constant.DeploymentDeploying, CanaryStep: 1, CanarySteps: 3},
+					Routable:   1, Total: 1,
+				})
+				return nil, nil
+			}
+			cmd.NoWait = true
+		})
+
+		It("displays the canary step and does not report completion", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, _, _, noWait, _ := fakeActor.PollRolloutArgsForCall(0)
+			Expect(noWait).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Getting rollout status of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`DEPLOYING: 1 of 1 instances routable, 0 starting, 0 failing, canary step 1 of 3`))
+			Expect(testUI.Out).ToNot(Say("is complete"))
+		})
+	})
+
+	When("the deployment has a max in flight", func() {
+		BeforeEach(func() {
+			fakeActor.PollRolloutStub = func(app resources.Application, deploymentGUID string, timeout time.Duration, noWait bool, handleStatus func(v7action.RolloutStatus)) (v7action.Warnings, error) {
+				handleStatus(v7action.RolloutStatus{
+					Deployment: resources.Deployment{State: constant.DeploymentDeploying, Options: resources.DeploymentOpts{MaxInFlight: 2}},
+					Routable:   2, Starting: 2, Total: 4,
+				})
+				return nil, nil
+			}
+		})
+
+		It("displays how many instances are replaced at a time", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`DEPLOYING: 2 of 4 instances routable, 2 starting, 0 failing, max in flight 2`))
+		})
+	})
+
+	When("the app has no deployments", func() {
+		BeforeEach(func() {
+			fakeActor.GetLatestDeploymentForAppReturns(resources.Deployment{}, v7action.Warnings{"get-deployment-warning"}, actionerror.DeploymentNotFoundError{})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+			Expect(testUI.Err).To(Say("get-deployment-warning"))
+			Expect(fakeActor.PollRolloutCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the rollout fails", func() {
+		BeforeEach(func() {
+			fakeActor.PollRolloutReturns(v7action.Warnings{"poll-warning"}, actionerror.RolloutTimeoutError{AppName: "some-app"})
+			fakeActor.PollRolloutStub = nil
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.RolloutTimeoutError{AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("poll-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
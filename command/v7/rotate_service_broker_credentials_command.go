@@ -0,0 +1,64 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type RotateServiceBrokerCredentialsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RotateServiceBrokerCredentialsArgs `positional-args:"yes"`
+	PasswordFromEnv bool                                    `long:"password-from-env" description:"Read the new password from the CF_BROKER_PASSWORD environment variable instead of prompting for it"`
+	usage           any                                     `usage:"CF_NAME rotate-service-broker-credentials SERVICE_BROKER USERNAME [--password-from-env]\n\nEXAMPLES:\n   CF_NAME rotate-service-broker-credentials my-broker new-user\n   CF_BROKER_PASSWORD=new-password CF_NAME rotate-service-broker-credentials my-broker new-user --password-from-env"`
+	relatedCommands any                                     `related_commands:"service-brokers, update-service-broker"`
+	envPassword     any                                     `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"New password of the service broker, read when --password-from-env is provided"`
+}
+
+func (cmd RotateServiceBrokerCredentialsCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	password, err := cmd.newPassword()
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Rotating credentials of service broker {{.ServiceBroker}} as {{.Username}}...",
+		map[string]any{
+			"Username":      user.Name,
+			"ServiceBroker": cmd.RequiredArgs.ServiceBroker,
+		},
+	)
+
+	warnings, err := cmd.Actor.RotateServiceBrokerCredentials(cmd.RequiredArgs.ServiceBroker, cmd.RequiredArgs.Username, password)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd RotateServiceBrokerCredentialsCommand) newPassword() (string, error) {
+	if !cmd.PasswordFromEnv {
+		return cmd.UI.DisplayPasswordPrompt("New Service Broker Password")
+	}
+
+	password, ok := os.LookupEnv("CF_BROKER_PASSWORD")
+	if !ok || password == "" {
+		return "", translatableerror.BrokerPasswordNotSetError{}
+	}
+	return password, nil
+}
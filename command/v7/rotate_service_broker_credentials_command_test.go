@@ -0,0 +1,136 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-broker-credentials command", func() {
+	const (
+		binaryName        = "cf-command"
+		serviceBrokerName = "fake-service-broker-name"
+		username          = "new-username"
+	)
+
+	var (
+		cmd             v7.RotateServiceBrokerCredentialsCommand
+		fakeActor       *v7fakes.FakeActor
+		fakeSharedActor *commandfakes.FakeSharedActor
+		input           *Buffer
+		testUI          *ui.UI
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		fakeActor = &v7fakes.FakeActor{}
+		fakeSharedActor = &commandfakes.FakeSharedActor{}
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		cmd = v7.RotateServiceBrokerCredentialsCommand{
+			BaseCommand: v7.BaseCommand{
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Config:      &commandfakes.FakeConfig{},
+			},
+		}
+		cmd.RequiredArgs.ServiceBroker = serviceBrokerName
+		cmd.RequiredArgs.Username = username
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "user"}, nil)
+		fakeActor.RotateServiceBrokerCredentialsReturns(v7action.Warnings{"rotate-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.RotateServiceBrokerCredentialsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the password is provided via prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("prompt-password\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rotates the credentials without echoing the password", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("New Service Broker Password: "))
+			Expect(testUI.Out).To(Say(`Rotating credentials of service broker %s as user\.\.\.`, serviceBrokerName))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).NotTo(Say("prompt-password"))
+			Expect(testUI.Err).To(Say("rotate-warning"))
+
+			brokerName, brokerUsername, brokerPassword := fakeActor.RotateServiceBrokerCredentialsArgsForCall(0)
+			Expect(brokerName).To(Equal(serviceBrokerName))
+			Expect(brokerUsername).To(Equal(username))
+			Expect(brokerPassword).To(Equal("prompt-password"))
+		})
+	})
+
+	When("--password-from-env is provided", func() {
+		BeforeEach(func() {
+			cmd.PasswordFromEnv = true
+		})
+
+		When("CF_BROKER_PASSWORD is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_BROKER_PASSWORD", "env-password")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_BROKER_PASSWORD")).To(Succeed())
+			})
+
+			It("rotates the credentials with the password of the environment", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Password: "))
+
+				_, _, brokerPassword := fakeActor.RotateServiceBrokerCredentialsArgsForCall(0)
+				Expect(brokerPassword).To(Equal("env-password"))
+			})
+		})
+
+		When("CF_BROKER_PASSWORD is not set", func() {
+			It("returns a BrokerPasswordNotSetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BrokerPasswordNotSetError{}))
+				Expect(fakeActor.RotateServiceBrokerCredentialsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("rotating the credentials fails", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("prompt-password\n"))
+			Expect(err).NotTo(HaveOccurred())
+			fakeActor.RotateServiceBrokerCredentialsReturns(v7action.Warnings{"rotate-warning"}, errors.New("rotate failed"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("rotate failed"))
+			Expect(testUI.Err).To(Say("rotate-warning"))
+		})
+	})
+})
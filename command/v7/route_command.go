@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 
@@ -14,16 +15,19 @@ type RouteCommand struct {
 	Hostname        string           `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
 	Path            flag.V7RoutePath `long:"path" description:"Path used to identify the HTTP route"`
 	Port            int              `long:"port" description:"Port used to identify the TCP route"`
-	relatedCommands interface{}      `related_commands:"create-route, delete-route, routes"`
+	History         bool             `long:"history" description:"Also show when the route was changed and destinations were mapped or unmapped, and by whom, as recorded by audit events"`
+	relatedCommands interface{}      `related_commands:"create-route, delete-route, events, routes"`
 }
 
 func (cmd RouteCommand) Usage() string {
 	return `
 Display an HTTP route:
-   CF_NAME route DOMAIN [--hostname HOSTNAME] [--path PATH]
+   CF_NAME route DOMAIN [--hostname HOSTNAME] [--path PATH] [--history]
 
 Display a TCP route:
-   CF_NAME route DOMAIN --port PORT`
+   CF_NAME route DOMAIN --port PORT [--history]
+
+The history only goes back as far as the audit events that the Cloud Controller keeps.`
 }
 
 func (cmd RouteCommand) Examples() string {
@@ -31,7 +35,8 @@ func (cmd RouteCommand) Examples() string {
 CF_NAME route example.com                      # example.com
 CF_NAME route example.com -n myhost --path foo # myhost.example.com/foo
 CF_NAME route example.com --path foo           # example.com/foo
-CF_NAME route example.com --port 5000          # example.com:5000`
+CF_NAME route example.com --port 5000          # example.com:5000
+CF_NAME route example.com -n myhost --history  # myhost.example.com, with its history`
 }
 
 func (cmd RouteCommand) Execute(args []string) error {
@@ -105,9 +110,53 @@ func (cmd RouteCommand) Execute(args []string) error {
 	cmd.UI.DisplayText("Destinations:")
 	cmd.displayDestinations(route, appMap)
 
+	if cmd.History {
+		history, warnings, err := cmd.Actor.GetRouteHistory(route.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("History:")
+		cmd.displayHistory(history)
+	}
+
 	return nil
 }
 
+func (cmd RouteCommand) displayHistory(history []v7action.RouteHistoryEntry) {
+	if len(history) == 0 {
+		cmd.UI.DisplayText("\tNo audit events found for this route.")
+		return
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("process"),
+		cmd.UI.TranslateText("port"),
+		cmd.UI.TranslateText("actor"),
+	}}
+	for _, entry := range history {
+		port := ""
+		if entry.AppPort != 0 {
+			port = strconv.Itoa(entry.AppPort)
+		}
+		table = append(table, []string{
+			entry.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+			entry.Action,
+			entry.AppName,
+			entry.ProcessType,
+			port,
+			entry.ActorName,
+		})
+	}
+
+	cmd.UI.DisplayKeyValueTable("\t", table, 3)
+}
+
 func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[string]resources.Application) {
 	destinations := route.Destinations
 	if len(destinations) > 0 {
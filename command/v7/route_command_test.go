@@ -1,6 +1,8 @@
 package v7_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/errors"
@@ -272,4 +274,57 @@ var _ = Describe("route Command", func() {
 			})
 		})
 	})
+
+	It("does not get the history of the route", func() {
+		Expect(fakeActor.GetRouteHistoryCallCount()).To(Equal(0))
+		Expect(testUI.Out).NotTo(Say("History:"))
+	})
+
+	When("passing the history flag", func() {
+		BeforeEach(func() {
+			cmd.History = true
+			fakeActor.GetRouteHistoryReturns(
+				[]v7action.RouteHistoryEntry{
+					{Time: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), Action: "create", ActorName: "alice"},
+					{Time: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC), Action: "map", AppName: "app-name", ProcessType: "web", AppPort: 8080, ActorName: "bob"},
+				},
+				v7action.Warnings{"get-history-warning"},
+				nil,
+			)
+		})
+
+		It("displays the changes to the route after its destinations", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetRouteHistoryArgsForCall(0)).To(Equal("route-guid"))
+			Expect(testUI.Err).To(Say("get-history-warning"))
+
+			Expect(testUI.Out).To(Say(`Destinations:`))
+			Expect(testUI.Out).To(Say(`History:`))
+			Expect(testUI.Out).To(Say(`\s+time\s+event\s+app\s+process\s+port\s+actor`))
+			Expect(testUI.Out).To(Say(`\s+create\s+alice`))
+			Expect(testUI.Out).To(Say(`\s+map\s+app-name\s+web\s+8080\s+bob`))
+		})
+
+		When("there are no events", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteHistoryReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`History:\n\s+No audit events found for this route\.`))
+			})
+		})
+
+		When("getting the history fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteHistoryReturns(nil, v7action.Warnings{"get-history-warning"}, errors.New("history-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("history-error"))
+				Expect(testUI.Err).To(Say("get-history-warning"))
+			})
+		})
+	})
 })
@@ -1,10 +1,13 @@
 package v7
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -12,10 +15,34 @@ import (
 type RoutesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME routes [--org-level]"`
-	relatedCommands interface{} `related_commands:"check-route, create-route, domains, map-route, unmap-route"`
-	Orglevel        bool        `long:"org-level" description:"List all the routes for all spaces of current organization"`
-	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
+	usage           interface{}       `usage:"CF_NAME routes [--org-level] [--labels SELECTOR] [--orphaned [--older-than DURATION] [--delete [-f]]] [--columns COLUMNS | -o wide | -o json]\n\nEXAMPLES:\n   CF_NAME routes --orphaned\n   CF_NAME routes --orphaned --older-than 7d --delete -f\n   CF_NAME routes --columns url,apps\n\nCOLUMNS:\n   space, host, domain, port, path, protocol, app-protocol, apps, service-instance, and with -o wide also guid, url"`
+	relatedCommands interface{}       `related_commands:"check-route, create-route, delete-route, domains, map-route, unmap-route"`
+	Orglevel        bool              `long:"org-level" description:"List all the routes for all spaces of current organization"`
+	Labels          string            `long:"labels" description:"Selector to filter routes by labels"`
+	Orphaned        bool              `long:"orphaned" description:"List only routes that are not mapped to any app"`
+	OlderThan       flag.Duration     `long:"older-than" description:"With --orphaned, list only routes whose last audit event is older than this duration (e.g. 12h, 7d)"`
+	Delete          bool              `long:"delete" description:"With --orphaned, delete the listed routes, confirming each one"`
+	Force           bool              `short:"f" description:"With --delete, delete the listed routes without confirmation"`
+	Columns         flag.Columns      `long:"columns" description:"Comma-separated list of the columns to display, in order"`
+	Output          flag.OutputFormat `short:"o" long:"output" choice:"text" choice:"wide" choice:"json" default:"text" description:"Output format; wide displays additional columns and json prints all columns as JSON"`
+}
+
+var routesTableColumns = []ui.TableColumn{
+	{Name: "space", Header: "space"},
+	{Name: "host", Header: "host"},
+	{Name: "domain", Header: "domain"},
+	{Name: "port", Header: "port"},
+	{Name: "path", Header: "path"},
+	{Name: "protocol", Header: "protocol"},
+	{Name: "app-protocol", Header: "app-protocol"},
+	{Name: "apps", Header: "apps"},
+	{Name: "service-instance", Header: "service instance"},
+	{Name: "guid", Header: "guid", Wide: true},
+	{Name: "url", Header: "url", Wide: true},
+}
+
+func (cmd RoutesCommand) PagedOutput() bool {
+	return !cmd.Delete || cmd.Force
 }
 
 func (cmd RoutesCommand) Execute(args []string) error {
@@ -25,6 +52,21 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		err      error
 	)
 
+	err = cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	selectedColumns, err := ui.SelectTableColumns(routesTableColumns, cmd.Columns, cmd.Output == flag.OutputFormatWide || cmd.Output == flag.OutputFormatJSON)
+	if err != nil {
+		return err
+	}
+
+	// The own --output flag of the command replaces the global one.
+	if cmd.Output == flag.OutputFormatJSON {
+		cmd.UI.StartStructuredOutput()
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -58,42 +100,102 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Orphaned {
+		routes, warnings, err = cmd.Actor.GetOrphanedRoutes(routes, cmd.OlderThan.Value)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
 	routeSummaries, warnings, err := cmd.Actor.GetRouteSummaries(routes)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
-	if len(routes) > 0 {
-		cmd.displayRoutesTable(routeSummaries)
-	} else {
-		cmd.UI.DisplayText("No routes found.")
+	if len(routes) == 0 {
+		if cmd.Orphaned {
+			cmd.UI.DisplayText("No orphaned routes found.")
+		} else {
+			cmd.UI.DisplayText("No routes found.")
+		}
+		return nil
 	}
 
+	cmd.displayRoutesTable(routeSummaries, selectedColumns)
+
+	if cmd.Delete {
+		return cmd.deleteRoutes(routes)
+	}
+
+	return nil
+}
+
+func (cmd RoutesCommand) validateFlags() error {
+	switch {
+	case cmd.OlderThan.IsSet && !cmd.Orphaned:
+		return translatableerror.RequiredFlagsError{Arg1: "--older-than", Arg2: "--orphaned"}
+	case cmd.Delete && !cmd.Orphaned:
+		return translatableerror.RequiredFlagsError{Arg1: "--delete", Arg2: "--orphaned"}
+	case cmd.Force && !cmd.Delete:
+		return translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete"}
+	}
 	return nil
 }
 
-func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSummary) {
-	var routesTable = [][]string{
-		{
-			cmd.UI.TranslateText("space"),
-			cmd.UI.TranslateText("host"),
-			cmd.UI.TranslateText("domain"),
-			cmd.UI.TranslateText("port"),
-			cmd.UI.TranslateText("path"),
-			cmd.UI.TranslateText("protocol"),
-			cmd.UI.TranslateText("app-protocol"),
-			cmd.UI.TranslateText("apps"),
-			cmd.UI.TranslateText("service instance"),
-		},
+func (cmd RoutesCommand) deleteRoutes(routes []resources.Route) error {
+	cmd.UI.DisplayNewline()
+
+	deleted := 0
+	for _, route := range routes {
+		if !cmd.Force {
+			response, err := cmd.UI.DisplayBoolPrompt(false, "Really delete the route {{.URL}}?", map[string]interface{}{
+				"URL": route.URL,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !response {
+				cmd.UI.DisplayText("Route {{.URL}} has not been deleted.", map[string]interface{}{
+					"URL": route.URL,
+				})
+				continue
+			}
+		}
+
+		cmd.UI.DisplayText("Deleting route {{.URL}}...", map[string]interface{}{
+			"URL": route.URL,
+		})
+
+		warnings, err := cmd.Actor.DeleteRouteByGUID(route.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		deleted++
 	}
 
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Deleted {{.Deleted}} of {{.Total}} orphaned routes.", map[string]interface{}{
+		"Deleted": deleted,
+		"Total":   len(routes),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSummary, selectedColumns []int) {
+	var rows [][]string
+
 	for _, routeSummary := range routeSummaries {
 		port := ""
 		if routeSummary.Port != 0 {
 			port = strconv.Itoa(routeSummary.Port)
 		}
-		routesTable = append(routesTable, []string{
+		rows = append(rows, []string{
 			routeSummary.SpaceName,
 			routeSummary.Host,
 			routeSummary.DomainName,
@@ -101,10 +203,26 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			routeSummary.Path,
 			routeSummary.Protocol,
 			strings.Join(routeSummary.AppProtocols, ", "),
-			strings.Join(routeSummary.AppNames, ", "),
+			strings.Join(appNamesWithProtocols(routeSummary), ", "),
 			routeSummary.ServiceInstanceName,
+			routeSummary.GUID,
+			routeSummary.URL,
 		})
 	}
 
-	cmd.UI.DisplayTableWithHeader("", routesTable, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayTableWithColumns("", routesTableColumns, rows, selectedColumns, ui.DefaultTableSpacePadding)
+}
+
+// appNamesWithProtocols annotates each app name with the protocol of its
+// destination when the route's destinations do not all use the same protocol.
+func appNamesWithProtocols(routeSummary v7action.RouteSummary) []string {
+	if len(routeSummary.AppProtocols) < 2 || len(routeSummary.AppNames) != len(routeSummary.Destinations) {
+		return routeSummary.AppNames
+	}
+
+	appNames := make([]string, len(routeSummary.AppNames))
+	for i, appName := range routeSummary.AppNames {
+		appNames[i] = fmt.Sprintf("%s (%s)", appName, routeSummary.Destinations[i].Protocol)
+	}
+	return appNames
 }
@@ -2,10 +2,13 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -20,6 +23,7 @@ import (
 var _ = Describe("routes Command", func() {
 	var (
 		cmd             RoutesCommand
+		input           *Buffer
 		testUI          *ui.UI
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
@@ -32,7 +36,8 @@ var _ = Describe("routes Command", func() {
 	const tableHeaders = `space\s+host\s+domain\s+port\s+path\s+protocol\s+app-protocol\s+apps\s+service instance`
 
 	BeforeEach(func() {
-		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v7fakes.FakeActor)
@@ -183,7 +188,7 @@ var _ = Describe("routes Command", func() {
 					)
 				})
 
-				It("prints routes in a table", func() {
+				It("prints routes in a table, showing the destination protocol of each app when they differ", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
 
 					Expect(testUI.Err).To(Say("actor-warning-1"))
@@ -192,10 +197,38 @@ var _ = Describe("routes Command", func() {
 					Expect(testUI.Out).To(Say(tableHeaders))
 					Expect(testUI.Out).To(Say(`space-1\s+domain1\s+si-1\s+`))
 					Expect(testUI.Out).To(Say(`space-2\s+host-3\s+domain2\s+\/path\/2`))
-					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1, app2\s+si-3`))
+					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1 \(http1\), app2 \(http2\)\s+si-3`))
 					Expect(testUI.Out).To(Say(`space-3\s+tcp\.domain\s+1024\s+app1, app2`))
 					Expect(testUI.Out).To(Say(`space-3\s+domain4\s+1024\s+http1\s+app1, app2`))
 				})
+
+				When("the output is wide", func() {
+					BeforeEach(func() {
+						cmd.Output = "wide"
+						routeSummaries[0].Route.URL = "domain1"
+					})
+
+					It("displays the guid and url columns as well", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(tableHeaders + `\s+guid\s+url`))
+						Expect(testUI.Out).To(Say(`space-1\s+domain1\s+si-1\s+route-guid-1\s+domain1`))
+					})
+				})
+
+				When("columns are selected", func() {
+					BeforeEach(func() {
+						cmd.Columns = []string{"guid", "space"}
+					})
+
+					It("displays only the selected columns in order", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`guid\s+space\n`))
+						Expect(testUI.Out).To(Say(`route-guid-1\s+space-1\n`))
+						Expect(testUI.Out).To(Say(`route-guid-2\s+space-2\n`))
+					})
+				})
 			})
 
 			When("getting route summaries fails", func() {
@@ -272,5 +305,190 @@ var _ = Describe("routes Command", func() {
 				})
 			})
 		})
+
+		When("--orphaned is passed", func() {
+			var orphanedRoutes []resources.Route
+
+			BeforeEach(func() {
+				cmd.Orphaned = true
+
+				fakeActor.GetRoutesBySpaceReturns(
+					[]resources.Route{
+						{GUID: "mapped-route-guid", Destinations: []resources.RouteDestination{{GUID: "destination-guid"}}},
+						{GUID: "orphaned-route-guid-1"},
+						{GUID: "orphaned-route-guid-2"},
+					},
+					v7action.Warnings{"routes-warning"},
+					nil,
+				)
+
+				orphanedRoutes = []resources.Route{
+					{GUID: "orphaned-route-guid-1", Host: "host-1", URL: "host-1.example.com"},
+					{GUID: "orphaned-route-guid-2", Host: "host-2", URL: "host-2.example.com"},
+				}
+				fakeActor.GetOrphanedRoutesReturns(orphanedRoutes, v7action.Warnings{"orphaned-warning"}, nil)
+				fakeActor.GetRouteSummariesReturns([]v7action.RouteSummary{
+					{Route: orphanedRoutes[0], DomainName: "example.com", SpaceName: "some-space"},
+					{Route: orphanedRoutes[1], DomainName: "example.com", SpaceName: "some-space"},
+				}, nil, nil)
+			})
+
+			It("lists only the orphaned routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetOrphanedRoutesCallCount()).To(Equal(1))
+				routes, orphanedFor := fakeActor.GetOrphanedRoutesArgsForCall(0)
+				Expect(routes).To(HaveLen(3))
+				Expect(orphanedFor).To(BeZero())
+				Expect(fakeActor.GetRouteSummariesArgsForCall(0)).To(Equal(orphanedRoutes))
+
+				Expect(testUI.Err).To(Say("routes-warning"))
+				Expect(testUI.Err).To(Say("orphaned-warning"))
+				Expect(testUI.Out).To(Say(tableHeaders))
+				Expect(testUI.Out).To(Say(`some-space\s+host-1\s+example.com`))
+				Expect(testUI.Out).To(Say(`some-space\s+host-2\s+example.com`))
+				Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(BeZero())
+			})
+
+			When("--older-than is passed", func() {
+				BeforeEach(func() {
+					cmd.OlderThan = flag.Duration{Value: 48 * time.Hour, IsSet: true}
+				})
+
+				It("passes the duration to the actor", func() {
+					_, orphanedFor := fakeActor.GetOrphanedRoutesArgsForCall(0)
+					Expect(orphanedFor).To(Equal(48 * time.Hour))
+				})
+			})
+
+			When("there are no orphaned routes", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrphanedRoutesReturns(nil, nil, nil)
+					fakeActor.GetRouteSummariesReturns(nil, nil, nil)
+				})
+
+				It("says so", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("No orphaned routes found."))
+				})
+			})
+
+			When("filtering the routes fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrphanedRoutesReturns(nil, v7action.Warnings{"orphaned-warning"}, errors.New("events-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("events-error"))
+					Expect(testUI.Err).To(Say("orphaned-warning"))
+					Expect(fakeActor.GetRouteSummariesCallCount()).To(BeZero())
+				})
+			})
+
+			When("--delete is passed", func() {
+				BeforeEach(func() {
+					cmd.Delete = true
+					fakeActor.DeleteRouteByGUIDReturns(v7action.Warnings{"delete-warning"}, nil)
+				})
+
+				When("the user confirms some of the routes", func() {
+					BeforeEach(func() {
+						_, err := input.Write([]byte("y\nn\n"))
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("deletes only the confirmed routes", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`Really delete the route host-1.example.com\?`))
+						Expect(testUI.Out).To(Say(`Deleting route host-1.example.com\.\.\.`))
+						Expect(testUI.Out).To(Say(`Really delete the route host-2.example.com\?`))
+						Expect(testUI.Out).To(Say(`Route host-2.example.com has not been deleted.`))
+						Expect(testUI.Out).To(Say(`Deleted 1 of 2 orphaned routes.`))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("delete-warning"))
+
+						Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(1))
+						Expect(fakeActor.DeleteRouteByGUIDArgsForCall(0)).To(Equal("orphaned-route-guid-1"))
+					})
+				})
+
+				When("-f is passed", func() {
+					BeforeEach(func() {
+						cmd.Force = true
+					})
+
+					It("deletes all the routes without prompting", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Really delete"))
+						Expect(testUI.Out).To(Say(`Deleting route host-1.example.com\.\.\.`))
+						Expect(testUI.Out).To(Say(`Deleting route host-2.example.com\.\.\.`))
+						Expect(testUI.Out).To(Say(`Deleted 2 of 2 orphaned routes.`))
+
+						Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(2))
+						Expect(fakeActor.DeleteRouteByGUIDArgsForCall(1)).To(Equal("orphaned-route-guid-2"))
+					})
+
+					When("deleting a route fails", func() {
+						BeforeEach(func() {
+							fakeActor.DeleteRouteByGUIDReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+						})
+
+						It("stops and returns the error", func() {
+							Expect(executeErr).To(MatchError("delete-error"))
+							Expect(testUI.Err).To(Say("delete-warning"))
+							Expect(fakeActor.DeleteRouteByGUIDCallCount()).To(Equal(1))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	When("--older-than is passed without --orphaned", func() {
+		BeforeEach(func() {
+			cmd.OlderThan = flag.Duration{Value: time.Hour, IsSet: true}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--older-than", Arg2: "--orphaned"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	When("an unknown column is selected", func() {
+		BeforeEach(func() {
+			cmd.Columns = []string{"owner"}
+		})
+
+		It("returns an UnknownColumnError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnknownColumnError{
+				Column:  "owner",
+				Columns: []string{"space", "host", "domain", "port", "path", "protocol", "app-protocol", "apps", "service-instance", "guid", "url"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--delete is passed without --orphaned", func() {
+		BeforeEach(func() {
+			cmd.Delete = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--delete", Arg2: "--orphaned"}))
+		})
+	})
+
+	When("-f is passed without --delete", func() {
+		BeforeEach(func() {
+			cmd.Orphaned = true
+			cmd.Force = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete"}))
+		})
 	})
 })
@@ -11,6 +11,10 @@ type SecurityGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group, security-group"`
 }
 
+func (SecurityGroupsCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd SecurityGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
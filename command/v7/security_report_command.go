@@ -0,0 +1,171 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SecurityReportCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName      `positional-args:"yes"`
+	Output          flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the report as a single JSON object"`
+	usage           interface{}       `usage:"CF_NAME security-report APP_NAME [--output json]"`
+	relatedCommands interface{}       `related_commands:"disable-ssh, env, running-security-groups, ssh-report, stacks"`
+}
+
+// securityReport is the machine-readable report written by security-report
+// --output json.
+type securityReport struct {
+	App             string                  `json:"app"`
+	SSHEnabled      bool                    `json:"ssh_enabled"`
+	Lifecycle       string                  `json:"lifecycle"`
+	Stack           string                  `json:"stack,omitempty"`
+	Routes          []securityReportRoute   `json:"routes"`
+	EgressRules     []securityReportRule    `json:"egress_rules"`
+	Findings        []securityReportFinding `json:"findings"`
+	HighestSeverity string                  `json:"highest_severity,omitempty"`
+}
+
+type securityReportRoute struct {
+	URL      string `json:"url"`
+	Protocol string `json:"protocol"`
+}
+
+type securityReportRule struct {
+	SecurityGroup string `json:"security_group"`
+	Protocol      string `json:"protocol"`
+	Destination   string `json:"destination"`
+	Ports         string `json:"ports,omitempty"`
+}
+
+type securityReportFinding struct {
+	Severity string `json:"severity"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+}
+
+func (cmd SecurityReportCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == flag.OutputFormatJSON {
+		report, warnings, err := cmd.Actor.GetAppSecurityReport(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		return cmd.UI.DisplayJSON("", newSecurityReport(report))
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting security report for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	report, warnings, err := cmd.Actor.GetAppSecurityReport(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	routes := make([]string, 0, len(report.Routes))
+	for _, route := range report.Routes {
+		routes = append(routes, route.URL)
+	}
+
+	securityGroups := []string{}
+	for _, rule := range report.EgressRules {
+		if len(securityGroups) == 0 || securityGroups[len(securityGroups)-1] != rule.SecurityGroupName {
+			securityGroups = append(securityGroups, rule.SecurityGroupName)
+		}
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("ssh:"), cmd.UI.TranslateText(shared.FlagBoolToString(report.SSHEnabled))},
+		{cmd.UI.TranslateText("lifecycle:"), string(report.LifecycleType)},
+		{cmd.UI.TranslateText("stack:"), report.StackName},
+		{cmd.UI.TranslateText("routes:"), strings.Join(routes, ", ")},
+		{cmd.UI.TranslateText("security groups:"), strings.Join(securityGroups, ", ")},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(report.Findings) == 0 {
+		cmd.UI.DisplayText("No findings.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("severity"),
+		cmd.UI.TranslateText("check"),
+		cmd.UI.TranslateText("finding"),
+	}}
+	for _, finding := range report.Findings {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(finding.Severity)),
+			finding.Check,
+			finding.Message,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func newSecurityReport(report v7action.AppSecurityReport) securityReport {
+	jsonReport := securityReport{
+		App:         report.AppName,
+		SSHEnabled:  report.SSHEnabled,
+		Lifecycle:   string(report.LifecycleType),
+		Stack:       report.StackName,
+		Routes:      []securityReportRoute{},
+		EgressRules: []securityReportRule{},
+		Findings:    []securityReportFinding{},
+	}
+
+	for _, route := range report.Routes {
+		jsonReport.Routes = append(jsonReport.Routes, securityReportRoute{URL: route.URL, Protocol: route.Protocol})
+	}
+
+	for _, rule := range report.EgressRules {
+		jsonRule := securityReportRule{
+			SecurityGroup: rule.SecurityGroupName,
+			Protocol:      rule.Protocol,
+			Destination:   rule.Destination,
+		}
+		if rule.Ports != nil {
+			jsonRule.Ports = *rule.Ports
+		}
+		jsonReport.EgressRules = append(jsonReport.EgressRules, jsonRule)
+	}
+
+	for _, finding := range report.Findings {
+		jsonReport.Findings = append(jsonReport.Findings, securityReportFinding{
+			Severity: string(finding.Severity),
+			Check:    finding.Check,
+			Message:  finding.Message,
+		})
+	}
+
+	// Findings are ordered from most to least severe.
+	if len(report.Findings) > 0 {
+		jsonReport.HighestSeverity = string(report.Findings[0].Severity)
+	}
+
+	return jsonReport
+}
@@ -0,0 +1,167 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("security-report command", func() {
+	var (
+		cmd             SecurityReportCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SecurityReportCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Output:       flag.OutputFormatText,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		ports := "443"
+		fakeActor.GetAppSecurityReportReturns(
+			v7action.AppSecurityReport{
+				AppName:       "some-app",
+				SSHEnabled:    true,
+				LifecycleType: constant.AppLifecycleTypeBuildpack,
+				StackName:     "cflinuxfs3",
+				Routes:        []resources.Route{{URL: "some-app.example.com", Protocol: "http"}},
+				EgressRules: []v7action.EgressRule{
+					{SecurityGroupName: "public", Rule: resources.Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: &ports}},
+				},
+				Findings: []v7action.SecurityFinding{
+					{Severity: v7action.SecurityFindingHigh, Check: "stack", Message: "stack finding"},
+					{Severity: v7action.SecurityFindingMedium, Check: "ssh", Message: "ssh finding"},
+				},
+			},
+			v7action.Warnings{"report-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the environment is not set up correctly", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays the facts and findings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID := fakeActor.GetAppSecurityReportArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting security report for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`ssh:\s+enabled`))
+		Expect(testUI.Out).To(Say(`lifecycle:\s+buildpack`))
+		Expect(testUI.Out).To(Say(`stack:\s+cflinuxfs3`))
+		Expect(testUI.Out).To(Say(`routes:\s+some-app.example.com`))
+		Expect(testUI.Out).To(Say(`security groups:\s+public`))
+		Expect(testUI.Out).To(Say(`severity\s+check\s+finding`))
+		Expect(testUI.Out).To(Say(`high\s+stack\s+stack finding`))
+		Expect(testUI.Out).To(Say(`medium\s+ssh\s+ssh finding`))
+		Expect(testUI.Err).To(Say("report-warning"))
+	})
+
+	When("there are no findings", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppSecurityReportReturns(v7action.AppSecurityReport{AppName: "some-app"}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No findings."))
+		})
+	})
+
+	When("--output json is given", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormatJSON
+		})
+
+		It("displays the report as JSON only", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting security report"))
+
+			var report map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+			Expect(report).To(Equal(map[string]interface{}{
+				"app":         "some-app",
+				"ssh_enabled": true,
+				"lifecycle":   "buildpack",
+				"stack":       "cflinuxfs3",
+				"routes": []interface{}{
+					map[string]interface{}{"url": "some-app.example.com", "protocol": "http"},
+				},
+				"egress_rules": []interface{}{
+					map[string]interface{}{"security_group": "public", "protocol": "tcp", "destination": "0.0.0.0/0", "ports": "443"},
+				},
+				"findings": []interface{}{
+					map[string]interface{}{"severity": "high", "check": "stack", "message": "stack finding"},
+					map[string]interface{}{"severity": "medium", "check": "ssh", "message": "ssh finding"},
+				},
+				"highest_severity": "high",
+			}))
+			Expect(testUI.Err).To(Say("report-warning"))
+		})
+	})
+
+	When("getting the report fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppSecurityReportReturns(v7action.AppSecurityReport{}, v7action.Warnings{"report-warning"}, errors.New("report-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("report-error"))
+			Expect(testUI.Err).To(Say("report-warning"))
+		})
+	})
+})
@@ -12,6 +12,10 @@ type ServiceBrokersCommand struct {
 	relatedCommands interface{} `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
 }
 
+func (ServiceBrokersCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd *ServiceBrokersCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -15,17 +17,28 @@ const indent = "   "
 type ServiceCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
-	ShowGUID        bool                 `long:"guid" description:"Retrieve and display the given service instances's guid. All other output is suppressed."`
-	Params          bool                 `long:"params" description:"Retrieve and display the given service instances's parameters. All other output is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
-	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+	RequiredArgs        flag.OptionalServiceInstance `positional-args:"yes"`
+	ShowGUID            bool                         `long:"guid" description:"Retrieve and display the given service instances's guid. All other output is suppressed."`
+	Params              bool                         `long:"params" description:"Retrieve and display the given service instances's parameters. All other output is suppressed."`
+	ServiceInstanceGUID string                       `long:"service-instance-guid" description:"GUID of the service instance, instead of its name. The service instance must be in the targeted space"`
+	usage               interface{}                  `usage:"CF_NAME service (SERVICE_INSTANCE | --service-instance-guid SERVICE_INSTANCE_GUID)"`
+	relatedCommands     interface{}                  `related_commands:"bind-service, rename-service, update-service"`
 }
 
 func (cmd ServiceCommand) Execute(args []string) error {
+	switch {
+	case cmd.RequiredArgs.ServiceInstance != "" && cmd.ServiceInstanceGUID != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"SERVICE_INSTANCE", "--service-instance-guid"},
+		}
+	case cmd.RequiredArgs.ServiceInstance == "" && cmd.ServiceInstanceGUID == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
+
 	switch {
 	case cmd.ShowGUID:
 		return cmd.fetchAndDisplayGUID()
@@ -36,7 +49,33 @@ func (cmd ServiceCommand) Execute(args []string) error {
 	}
 }
 
+// getServiceInstanceByGUID returns the service instance with the GUID, which
+// must be in the targeted space.
+func (cmd ServiceCommand) getServiceInstanceByGUID() (resources.ServiceInstance, error) {
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByGUID(cmd.ServiceInstanceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.ServiceInstance{}, err
+	}
+
+	if serviceInstance.SpaceGUID != cmd.Config.TargetedSpace().GUID {
+		return resources.ServiceInstance{}, actionerror.ServiceInstanceNotFoundError{GUID: cmd.ServiceInstanceGUID}
+	}
+
+	return serviceInstance, nil
+}
+
 func (cmd ServiceCommand) fetchAndDisplayGUID() error {
+	if cmd.ServiceInstanceGUID != "" {
+		serviceInstance, err := cmd.getServiceInstanceByGUID()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayText(serviceInstance.GUID)
+		return nil
+	}
+
 	serviceInstance, _, err := cmd.Actor.GetServiceInstanceByNameAndSpace(
 		string(cmd.RequiredArgs.ServiceInstance),
 		cmd.Config.TargetedSpace().GUID,
@@ -50,10 +89,24 @@ func (cmd ServiceCommand) fetchAndDisplayGUID() error {
 }
 
 func (cmd ServiceCommand) fetchAndDisplayParams() error {
-	params, warnings, err := cmd.Actor.GetServiceInstanceParameters(
-		string(cmd.RequiredArgs.ServiceInstance),
-		cmd.Config.TargetedSpace().GUID,
+	var (
+		params   v7action.ServiceInstanceParameters
+		warnings v7action.Warnings
+		err      error
 	)
+	if cmd.ServiceInstanceGUID != "" {
+		var serviceInstance resources.ServiceInstance
+		serviceInstance, err = cmd.getServiceInstanceByGUID()
+		if err != nil {
+			return err
+		}
+		params, warnings, err = cmd.Actor.GetServiceInstanceParametersByGUID(serviceInstance.GUID)
+	} else {
+		params, warnings, err = cmd.Actor.GetServiceInstanceParameters(
+			string(cmd.RequiredArgs.ServiceInstance),
+			cmd.Config.TargetedSpace().GUID,
+		)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -67,7 +120,26 @@ func (cmd ServiceCommand) fetchAndDisplayParams() error {
 }
 
 func (cmd ServiceCommand) fetchAndDisplayDetails() error {
-	if err := cmd.displayIntro(); err != nil {
+	if cmd.ServiceInstanceGUID != "" {
+		serviceInstanceWithDetails, warnings, err := cmd.Actor.GetServiceInstanceDetailsByGUID(
+			cmd.ServiceInstanceGUID,
+			cmd.Config.TargetedSpace().GUID,
+			false,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if err := cmd.displayIntro(serviceInstanceWithDetails.Name); err != nil {
+			return err
+		}
+
+		cmd.displayDetails(serviceInstanceWithDetails)
+		return nil
+	}
+
+	if err := cmd.displayIntro(string(cmd.RequiredArgs.ServiceInstance)); err != nil {
 		return err
 	}
 
@@ -81,6 +153,11 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		return err
 	}
 
+	cmd.displayDetails(serviceInstanceWithDetails)
+	return nil
+}
+
+func (cmd ServiceCommand) displayDetails(serviceInstanceWithDetails v7action.ServiceInstanceDetails) {
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
 		cmd.displayPropertiesUserProvided(serviceInstanceWithDetails)
@@ -93,11 +170,9 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		cmd.displaySharingInfo(serviceInstanceWithDetails)
 		cmd.displayUpgrades(serviceInstanceWithDetails)
 	}
-
-	return nil
 }
 
-func (cmd ServiceCommand) displayIntro() error {
+func (cmd ServiceCommand) displayIntro(serviceInstanceName string) error {
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -106,7 +181,7 @@ func (cmd ServiceCommand) displayIntro() error {
 	cmd.UI.DisplayTextWithFlavor(
 		"Showing info of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
-			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"ServiceInstanceName": serviceInstanceName,
 			"OrgName":             cmd.Config.TargetedOrganization().Name,
 			"SpaceName":           cmd.Config.TargetedSpace().Name,
 			"Username":            user.Name,
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -832,4 +834,161 @@ var _ = Describe("service command", func() {
 			Expect(executeErr).To(MatchError("explode"))
 		})
 	})
+
+	When("the --service-instance-guid flag is specified", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceDetailsByGUIDReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						GUID: serviceInstanceGUID,
+						Name: serviceInstanceName,
+						Type: resources.UserProvidedServiceInstance,
+					},
+				},
+				v7action.Warnings{"guid warning"},
+				nil,
+			)
+			fakeActor.GetServiceInstanceByGUIDReturns(
+				resources.ServiceInstance{
+					GUID:      serviceInstanceGUID,
+					Name:      serviceInstanceName,
+					SpaceGUID: spaceGUID,
+				},
+				v7action.Warnings{"guid warning"},
+				nil,
+			)
+
+			setPositionalFlags(&cmd, "")
+			setFlag(&cmd, "--service-instance-guid", serviceInstanceGUID)
+		})
+
+		It("shows the service instance with that GUID without looking it up by name", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say("guid warning"))
+			Expect(testUI.Out).To(Say(`Showing info of service %s in org %s / space %s as %s...`, serviceInstanceName, orgName, spaceName, username))
+			Expect(testUI.Out).To(Say(`name:\s+%s`, serviceInstanceName))
+
+			Expect(fakeActor.GetServiceInstanceDetailsByGUIDCallCount()).To(Equal(1))
+			actualGUID, actualSpaceGUID, actualOmitApps := fakeActor.GetServiceInstanceDetailsByGUIDArgsForCall(0)
+			Expect(actualGUID).To(Equal(serviceInstanceGUID))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+			Expect(actualOmitApps).To(BeFalse())
+
+			Expect(fakeActor.GetServiceInstanceByGUIDCallCount()).To(Equal(0))
+			Expect(fakeActor.GetServiceInstanceDetailsCallCount()).To(Equal(0))
+		})
+
+		When("getting the service instance details fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceDetailsByGUIDReturns(
+					v7action.ServiceInstanceDetails{},
+					v7action.Warnings{"guid warning"},
+					actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID},
+				)
+			})
+
+			It("prints warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID}))
+				Expect(testUI.Err).To(Say("guid warning"))
+				Expect(testUI.Out).NotTo(Say("Showing info of service"))
+			})
+		})
+
+		When("--guid is also given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--guid")
+			})
+
+			It("displays the guid without looking it up by name", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(serviceInstanceGUID))
+
+				Expect(fakeActor.GetServiceInstanceByGUIDArgsForCall(0)).To(Equal(serviceInstanceGUID))
+				Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+
+			When("the service instance is not in the targeted space", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByGUIDReturns(
+						resources.ServiceInstance{GUID: serviceInstanceGUID, Name: serviceInstanceName, SpaceGUID: "other-space-guid"},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID}))
+				})
+			})
+
+			When("getting the service instance fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByGUIDReturns(resources.ServiceInstance{}, v7action.Warnings{"guid warning"}, errors.New("boom"))
+				})
+
+				It("prints warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(testUI.Err).To(Say("guid warning"))
+				})
+			})
+		})
+
+		When("--params is also given", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--params")
+				fakeActor.GetServiceInstanceParametersByGUIDReturns(
+					v7action.ServiceInstanceParameters{"foo": "bar"},
+					v7action.Warnings{"params warning"},
+					nil,
+				)
+			})
+
+			It("displays the parameters of the service instance with that GUID", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("params warning"))
+				Expect(testUI.Out).To(Say(`"foo": "bar"`))
+
+				Expect(fakeActor.GetServiceInstanceParametersByGUIDArgsForCall(0)).To(Equal(serviceInstanceGUID))
+				Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+			})
+
+			When("the service instance is not in the targeted space", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByGUIDReturns(
+						resources.ServiceInstance{GUID: serviceInstanceGUID, Name: serviceInstanceName, SpaceGUID: "other-space-guid"},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{GUID: serviceInstanceGUID}))
+					Expect(fakeActor.GetServiceInstanceParametersByGUIDCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the service instance name is also given", func() {
+			BeforeEach(func() {
+				setPositionalFlags(&cmd, serviceInstanceName)
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"SERVICE_INSTANCE", "--service-instance-guid"},
+				}))
+				Expect(fakeActor.GetServiceInstanceDetailsByGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("neither the service instance name nor --service-instance-guid is given", func() {
+		BeforeEach(func() {
+			setPositionalFlags(&cmd, "")
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}))
+		})
+	})
 })
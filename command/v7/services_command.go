@@ -1,32 +1,72 @@
 package v7
 
 import (
+	"os"
 	"strings"
 
 	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
 type ServicesCommand struct {
 	BaseCommand
 
-	OmitApps        bool        `long:"no-apps" description:"Do not retrieve bound apps information."`
-	relatedCommands interface{} `related_commands:"create-service, marketplace"`
+	Labels           string                              `long:"labels" description:"Selector to filter service instances by labels. Named --labels like the label filter of apps, orgs, routes and the other list commands"`
+	OmitApps         bool                                `long:"no-apps" description:"Do not retrieve bound apps information."`
+	Diff             bool                                `long:"diff" description:"Compare the service instances in the space with the ones the manifest declares, and fail if they differ"`
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to the manifest to compare with; used with --diff"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	relatedCommands  interface{}                         `related_commands:"bind-service, create-service, marketplace, push"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	CWD             string
+}
+
+func (cmd *ServicesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (ServicesCommand) PagedOutput() bool {
+	return true
 }
 
 func (cmd ServicesCommand) Execute(args []string) error {
+	if err := cmd.validateFlags(); err != nil {
+		return err
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
+	if cmd.Diff {
+		return cmd.diffManifest()
+	}
+
 	if err := cmd.displayMessage(); err != nil {
 		return err
 	}
 
-	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, cmd.OmitApps)
+	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, cmd.OmitApps, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -37,7 +77,134 @@ func (cmd ServicesCommand) Execute(args []string) error {
 }
 
 func (cmd ServicesCommand) Usage() string {
-	return "CF_NAME services"
+	return "CF_NAME services [--no-apps] [--labels SELECTOR]\n   CF_NAME services --diff [-f MANIFEST_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n" +
+		"   --diff reports the service instances the manifest requires that are missing from the space, the ones whose\n" +
+		"   offering or plan differs, and the apps that are not bound to the service instances they list. Service instances\n" +
+		"   are required by the services of the apps and by a top-level services section, whose entries have a name and\n" +
+		"   optionally an offering and plan. The section is not sent to the Cloud Controller when pushing.\n\n" +
+		"EXAMPLES:\n   CF_NAME services --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME services --diff -f manifest.yml"
+}
+
+func (cmd ServicesCommand) validateFlags() error {
+	switch {
+	case cmd.PathToManifest != "" && !cmd.Diff:
+		return translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--diff"}
+	case cmd.Diff && (cmd.Labels != "" || cmd.OmitApps):
+		return translatableerror.ArgumentCombinationError{Args: []string{"--diff", "--labels", "--no-apps"}}
+	}
+	return nil
+}
+
+func (cmd ServicesCommand) diffManifest() error {
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing service instances in org {{.OrgName}} / space {{.SpaceName}} with manifest {{.ManifestPath}} as {{.UserName}}...", map[string]interface{}{
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"ManifestPath": pathToManifest,
+		"UserName":     user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
+	if err != nil {
+		return err
+	}
+
+	var declared []v7action.DeclaredServiceInstance
+	for _, requirement := range manifest.ServiceRequirements() {
+		declared = append(declared, v7action.DeclaredServiceInstance{
+			Name:      requirement.Name,
+			Offering:  requirement.Offering,
+			Plan:      requirement.Plan,
+			BoundApps: requirement.BoundApps,
+		})
+	}
+
+	drift, warnings, err := cmd.Actor.GetServiceDrift(cmd.Config.TargetedSpace().GUID, declared)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if drift.Count() == 0 {
+		cmd.UI.DisplayText("The service instances in the space match the manifest.")
+		return nil
+	}
+
+	cmd.displayDrift(drift)
+	return translatableerror.ServiceDriftError{Count: drift.Count()}
+}
+
+func (cmd ServicesCommand) displayDrift(drift v7action.ServiceDrift) {
+	if len(drift.MissingServiceInstances) > 0 {
+		cmd.UI.DisplayText("Missing service instances:")
+		for _, name := range drift.MissingServiceInstances {
+			cmd.UI.DisplayText("   {{.Name}}", map[string]interface{}{"Name": name})
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	if len(drift.PlanDrifts) > 0 {
+		table := [][]string{{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("declared offering"),
+			cmd.UI.TranslateText("declared plan"),
+			cmd.UI.TranslateText("offering"),
+			cmd.UI.TranslateText("plan"),
+		}}
+		for _, planDrift := range drift.PlanDrifts {
+			table = append(table, []string{
+				planDrift.ServiceInstanceName,
+				planDrift.DeclaredOffering,
+				planDrift.DeclaredPlan,
+				planDrift.ActualOffering,
+				planDrift.ActualPlan,
+			})
+		}
+		cmd.UI.DisplayText("Service instances with a different offering or plan:")
+		cmd.UI.DisplayTableWithHeader("   ", table, ui.DefaultTableSpacePadding)
+		cmd.UI.DisplayNewline()
+	}
+
+	if len(drift.MissingBindings) > 0 {
+		table := [][]string{{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("service instance"),
+		}}
+		for _, binding := range drift.MissingBindings {
+			table = append(table, []string{binding.AppName, binding.ServiceInstanceName})
+		}
+		cmd.UI.DisplayText("Missing bindings:")
+		cmd.UI.DisplayTableWithHeader("   ", table, ui.DefaultTableSpacePadding)
+		cmd.UI.DisplayNewline()
+	}
 }
 
 func (cmd ServicesCommand) displayMessage() error {
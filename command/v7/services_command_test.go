@@ -4,11 +4,14 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -144,6 +147,17 @@ var _ = Describe("services command", func() {
 		))
 	})
 
+	When("a label selector is given", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env=prod"
+		})
+
+		It("asks the actor to filter the service instances by the selector", func() {
+			_, _, labelSelector := fakeActor.GetServiceInstancesForSpaceArgsForCall(0)
+			Expect(labelSelector).To(Equal("env=prod"))
+		})
+	})
+
 	When("omit apps is set", func() {
 		BeforeEach(func() {
 			cmd.OmitApps = true
@@ -213,4 +227,147 @@ var _ = Describe("services command", func() {
 			Expect(executeErr).To(MatchError("a bad thing happened"))
 		})
 	})
+
+	Describe("--diff", func() {
+		var (
+			fakeLocator *v7fakes.FakeManifestLocator
+			fakeParser  *v7fakes.FakeManifestParser
+		)
+
+		BeforeEach(func() {
+			fakeLocator = new(v7fakes.FakeManifestLocator)
+			fakeParser = new(v7fakes.FakeManifestParser)
+
+			cmd.Diff = true
+			cmd.CWD = "/some/dir"
+			cmd.ManifestLocator = fakeLocator
+			cmd.ManifestParser = fakeParser
+
+			fakeLocator.PathReturns("/some/dir/manifest.yml", true, nil)
+			fakeParser.InterpolateManifestReturns([]byte("interpolated"), nil)
+			fakeParser.ParseManifestReturns(manifestparser.Manifest{
+				Applications: []manifestparser.Application{{
+					Name: "app-1",
+					RemainingManifestFields: map[string]interface{}{
+						"services": []interface{}{"db"},
+					},
+				}},
+				Services: []manifestparser.ServiceRequirement{
+					{Name: "db", Offering: "postgres", Plan: "small"},
+				},
+			}, nil)
+			fakeActor.GetServiceDriftReturns(v7action.ServiceDrift{}, v7action.Warnings{"drift warning"}, nil)
+		})
+
+		It("compares the service instances in the space with the manifest in the current directory", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/some/dir"))
+			path, _, _ := fakeParser.InterpolateManifestArgsForCall(0)
+			Expect(path).To(Equal("/some/dir/manifest.yml"))
+			path, raw := fakeParser.ParseManifestArgsForCall(0)
+			Expect(path).To(Equal("/some/dir/manifest.yml"))
+			Expect(raw).To(Equal([]byte("interpolated")))
+
+			Expect(fakeActor.GetServiceDriftCallCount()).To(Equal(1))
+			actualSpaceGUID, declared := fakeActor.GetServiceDriftArgsForCall(0)
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+			Expect(declared).To(Equal([]v7action.DeclaredServiceInstance{
+				{Name: "db", Offering: "postgres", Plan: "small", BoundApps: []string{"app-1"}},
+			}))
+			Expect(fakeActor.GetServiceInstancesForSpaceCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Comparing service instances in org %s / space %s with manifest /some/dir/manifest.yml as %s...\n\n`, org, space, username))
+			Expect(testUI.Out).To(Say(`The service instances in the space match the manifest\.`))
+			Expect(testUI.Err).To(Say("drift warning"))
+		})
+
+		When("a manifest path is given", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("/other/manifest.yml")
+				cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/vars.yml"}
+				fakeLocator.PathReturns("/other/manifest.yml", true, nil)
+			})
+
+			It("compares with that manifest", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/other/manifest.yml"))
+				_, varsFiles, _ := fakeParser.InterpolateManifestArgsForCall(0)
+				Expect(varsFiles).To(Equal([]string{"/vars.yml"}))
+			})
+		})
+
+		When("there is no manifest", func() {
+			BeforeEach(func() {
+				fakeLocator.PathReturns("", false, nil)
+			})
+
+			It("fails", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "/some/dir"}))
+				Expect(fakeActor.GetServiceDriftCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the service instances differ from the manifest", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceDriftReturns(v7action.ServiceDrift{
+					MissingServiceInstances: []string{"cache"},
+					PlanDrifts: []v7action.ServicePlanDrift{{
+						ServiceInstanceName: "db",
+						DeclaredOffering:    "postgres",
+						DeclaredPlan:        "small",
+						ActualOffering:      "postgres",
+						ActualPlan:          "large",
+					}},
+					MissingBindings: []v7action.ServiceBindingDrift{{AppName: "app-1", ServiceInstanceName: "db"}},
+				}, nil, nil)
+			})
+
+			It("displays the differences and fails", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceDriftError{Count: 3}))
+				Expect(testUI.Out).To(SatisfyAll(
+					Say(`Missing service instances:\n`),
+					Say(`   cache\n`),
+					Say(`Service instances with a different offering or plan:\n`),
+					Say(`name\s+declared offering\s+declared plan\s+offering\s+plan\n`),
+					Say(`db\s+postgres\s+small\s+postgres\s+large\n`),
+					Say(`Missing bindings:\n`),
+					Say(`app\s+service instance\n`),
+					Say(`app-1\s+db\n`),
+				))
+			})
+		})
+
+		When("comparing fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceDriftReturns(v7action.ServiceDrift{}, v7action.Warnings{"a warning"}, errors.New("bang"))
+			})
+
+			It("fails and prints warnings", func() {
+				Expect(executeErr).To(MatchError("bang"))
+				Expect(testUI.Err).To(Say("a warning"))
+			})
+		})
+
+		When("--labels is also given", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("fails before checking the target", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--diff", "--labels", "--no-apps"}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("-f is given without --diff", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = "manifest.yml"
+		})
+
+		It("fails", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--diff"}))
+		})
+	})
 })
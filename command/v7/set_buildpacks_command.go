@@ -0,0 +1,82 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type SetBuildpacksCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SetBuildpacksArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME set-buildpacks APP_NAME BUILDPACK [BUILDPACK...]\n\n   Buildpacks run in the given order; the last one supplies the start command. Use 'default' or 'null' to let the platform detect the buildpack. The app keeps running with its current droplet until it is restaged.\n\nEXAMPLES:\n   CF_NAME set-buildpacks my-app nodejs_buildpack\n   CF_NAME set-buildpacks my-app apt_buildpack python_buildpack\n   CF_NAME set-buildpacks my-app default"`
+	relatedCommands interface{}            `related_commands:"app, buildpacks, push, restage"`
+}
+
+func (cmd SetBuildpacksCommand) Execute(args []string) error {
+	buildpacks := cmd.RequiredArgs.Buildpacks
+	for _, buildpack := range buildpacks {
+		if (buildpack == constant.AutodetectBuildpackValueDefault || buildpack == constant.AutodetectBuildpackValueNull) && len(buildpacks) > 1 {
+			return translatableerror.InvalidBuildpacksError{}
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting buildpacks of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.LifecycleType == constant.AppLifecycleTypeDocker {
+		return translatableerror.DockerAppBuildpacksError{AppName: app.Name}
+	}
+
+	updatedApp, warnings, err := cmd.Actor.UpdateApplication(resources.Application{
+		GUID:                app.GUID,
+		LifecycleType:       constant.AppLifecycleTypeBuildpack,
+		LifecycleBuildpacks: buildpacks,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	declared := strings.Join(updatedApp.LifecycleBuildpacks, ", ")
+	if declared == "" {
+		declared = cmd.UI.TranslateText("detected by the platform")
+	}
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("buildpacks:"), declared},
+	}, 3)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restage {{.AppName}}' to build the app with these buildpacks.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"AppName":    cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
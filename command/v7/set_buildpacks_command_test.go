@@ -0,0 +1,168 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-buildpacks Command", func() {
+	var (
+		cmd             SetBuildpacksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SetBuildpacksCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.Buildpacks = []string{"apt_buildpack", "python_buildpack"}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{Name: "some-app", GUID: "some-app-guid", LifecycleType: constant.AppLifecycleTypeBuildpack, StackName: "cflinuxfs4"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.UpdateApplicationStub = func(app resources.Application) (resources.Application, v7action.Warnings, error) {
+			return app, v7action.Warnings{"update-app-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	It("updates the buildpacks of the app in order", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+		Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(resources.Application{
+			GUID:                "some-app-guid",
+			LifecycleType:       constant.AppLifecycleTypeBuildpack,
+			LifecycleBuildpacks: []string{"apt_buildpack", "python_buildpack"},
+		}))
+
+		Expect(testUI.Out).To(Say(`Setting buildpacks of app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`buildpacks:\s+apt_buildpack, python_buildpack`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`TIP: Use 'faceman restage some-app' to build the app with these buildpacks\.`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("update-app-warning"))
+	})
+
+	When("the buildpacks are reset to auto-detection", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Buildpacks = []string{"default"}
+			fakeActor.UpdateApplicationReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+		})
+
+		It("displays that the platform detects the buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.UpdateApplicationArgsForCall(0).LifecycleBuildpacks).To(Equal([]string{"default"}))
+			Expect(testUI.Out).To(Say(`buildpacks:\s+detected by the platform`))
+		})
+	})
+
+	When("default is combined with other buildpacks", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Buildpacks = []string{"null", "python_buildpack"}
+		})
+
+		It("returns an InvalidBuildpacksError before checking the target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidBuildpacksError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app runs a docker image", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{Name: "some-app", GUID: "some-app-guid", LifecycleType: constant.AppLifecycleTypeDocker},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns a DockerAppBuildpacksError without updating the app", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DockerAppBuildpacksError{AppName: "some-app"}))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{},
+				v7action.Warnings{"get-app-warning"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+		})
+	})
+
+	When("updating the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationReturns(resources.Application{}, v7action.Warnings{"update-app-warning"}, errors.New("update-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(testUI.Err).To(Say("update-app-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 )
 
@@ -23,6 +24,8 @@ type SetLabelCommand struct {
 	BuildpackStack  string            `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
 	ServiceBroker   string            `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
 	ServiceOffering string            `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	AllInSpace      bool              `long:"all-in-space" description:"Update every app, route or service instance of the type in the targeted space instead of RESOURCE_NAME"`
+	Selector        string            `long:"selector" description:"Update the apps, routes, service instances or spaces matching the label selector, e.g. env=dev, instead of RESOURCE_NAME"`
 
 	LabelSetter LabelSetter
 }
@@ -50,10 +53,22 @@ func (cmd SetLabelCommand) Execute(args []string) error {
 		BuildpackStack:  cmd.BuildpackStack,
 		ServiceBroker:   cmd.ServiceBroker,
 		ServiceOffering: cmd.ServiceOffering,
+		AllInSpace:      cmd.AllInSpace,
+		Selector:        cmd.Selector,
+	}
+
+	labelArgs := cmd.RequiredArgs.Labels
+	if cmd.AllInSpace || cmd.Selector != "" {
+		// There is no RESOURCE_NAME when selecting the resources, so the
+		// parser has taken the first label for it.
+		labelArgs = append([]string{targetResource.ResourceName}, labelArgs...)
+		targetResource.ResourceName = ""
+	} else if len(labelArgs) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "KEY=VALUE"}
 	}
 
 	labels := make(map[string]types.NullString)
-	for _, label := range cmd.RequiredArgs.Labels {
+	for _, label := range labelArgs {
 		parts := strings.SplitN(label, "=", 2)
 		if len(parts) < 2 {
 			return fmt.Errorf("Metadata error: no value provided for label '%s'", label)
@@ -65,14 +80,17 @@ func (cmd SetLabelCommand) Execute(args []string) error {
 }
 
 func (cmd SetLabelCommand) Usage() string {
-	return `CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...`
+	return `CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...
+CF_NAME set-label RESOURCE (--all-in-space | --selector SELECTOR) KEY=VALUE...`
 }
 
 func (cmd SetLabelCommand) Examples() string {
 	return `
 cf set-label app dora env=production
 cf set-label org business pci=true public-facing=false
-cf set-label buildpack go_buildpack go=1.12 -s cflinuxfs3`
+cf set-label buildpack go_buildpack go=1.12 -s cflinuxfs3
+cf set-label app --all-in-space team=payments
+cf set-label route --selector env=dev,team!=core tier=frontend`
 }
 
 func (cmd SetLabelCommand) Resources() string {
@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
@@ -70,4 +71,44 @@ var _ = Describe("set-label command", func() {
 			}))
 		})
 	})
+
+	When("no labels are given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: "dora",
+			}
+		})
+
+		It("returns a required argument error", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY=VALUE"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the resources are selected with --all-in-space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: "team=payments",
+				Labels:       []string{"ENV=FAKE"},
+			}
+			cmd.AllInSpace = true
+		})
+
+		It("sets the label that was parsed as the resource name", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			targetResource, labels := fakeLabelSetter.ExecuteArgsForCall(0)
+			Expect(targetResource.ResourceName).To(BeEmpty())
+			Expect(targetResource.AllInSpace).To(BeTrue())
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"team": types.NewNullString("payments"),
+				"ENV":  types.NewNullString("FAKE"),
+			}))
+		})
+	})
 })
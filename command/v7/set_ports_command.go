@@ -0,0 +1,63 @@
+package v7
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SetPortsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SetPortsArgs `positional-args:"yes"`
+	ProcessType     string            `long:"process" default:"web" description:"App process to update"`
+	usage           interface{}       `usage:"CF_NAME set-ports APP_NAME PORTS [--process PROCESS]\n\n   Sets the ports that the instances of an app process listen on. Routes are mapped to a port other than\n   the default one with map-route --app-port.\n\nEXAMPLES:\n   CF_NAME set-ports my-app 8080,9090\n   CF_NAME set-ports my-app 9000 --process worker"`
+	relatedCommands interface{}       `related_commands:"app, map-route, restart"`
+}
+
+func (cmd SetPortsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	ports := make([]string, len(cmd.RequiredArgs.Ports.Ports))
+	for i, port := range cmd.RequiredArgs.Ports.Ports {
+		ports[i] = strconv.Itoa(port)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting ports {{.Ports}} for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Ports":       strings.Join(ports, ", "),
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ProcessType": cmd.ProcessType,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.SetApplicationProcessPortsByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.RequiredArgs.Ports.Ports,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if app.Started() {
+		cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
+	}
+
+	return nil
+}
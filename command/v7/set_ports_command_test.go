@@ -0,0 +1,121 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-ports Command", func() {
+	var (
+		cmd             SetPortsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SetPortsCommand{
+			RequiredArgs: flag.SetPortsArgs{AppName: "some-app", Ports: flag.ProcessPorts{Ports: []int{8080, 9090}}},
+			ProcessType:  "web",
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not targeted"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("not targeted"))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("updating the ports succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationProcessPortsByNameAndSpaceReturns(
+				resources.Application{GUID: "some-app-guid", State: constant.ApplicationStopped},
+				v7action.Warnings{"set-ports-warning"},
+				nil,
+			)
+		})
+
+		It("sets the ports of the process", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Setting ports 8080, 9090 for app some-app process web in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).NotTo(Say("TIP"))
+			Expect(testUI.Err).To(Say("set-ports-warning"))
+
+			Expect(fakeActor.SetApplicationProcessPortsByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, processType, ports := fakeActor.SetApplicationProcessPortsByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(ports).To(Equal([]int{8080, 9090}))
+		})
+
+		When("the app is started", func() {
+			BeforeEach(func() {
+				fakeActor.SetApplicationProcessPortsByNameAndSpaceReturns(
+					resources.Application{GUID: "some-app-guid", State: constant.ApplicationStarted},
+					nil,
+					nil,
+				)
+			})
+
+			It("tells the user to restart the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take effect."))
+			})
+		})
+	})
+
+	When("updating the ports fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationProcessPortsByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"set-ports-warning"}, errors.New("ports are invalid"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("ports are invalid"))
+			Expect(testUI.Err).To(Say("set-ports-warning"))
+		})
+	})
+})
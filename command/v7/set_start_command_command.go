@@ -0,0 +1,155 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type SetStartCommandCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SetStartCommandArgs `positional-args:"yes"`
+	ProcessType     string                   `long:"process" default:"web" description:"App process to update"`
+	Reset           bool                     `long:"reset" description:"Remove the configured start command so the process uses the one detected during staging"`
+	Strategy        flag.DeploymentStrategy  `long:"strategy" description:"Restart the app with this deployment strategy after updating the start command, either rolling, canary or none"`
+	MaxInFlight     flag.PositiveInteger     `long:"max-in-flight" description:"Maximum number of instances that are replaced at the same time; requires --strategy rolling or canary"`
+	NoWait          bool                     `long:"no-wait" description:"Exit when the first instance of the web process is healthy; used with --strategy"`
+	usage           interface{}              `usage:"CF_NAME set-start-command APP_NAME (COMMAND | --reset) [--process PROCESS] [--strategy STRATEGY [--max-in-flight COUNT] [--no-wait]]\n\nEXAMPLES:\n   CF_NAME set-start-command my-app \"bundle exec rackup\"\n   CF_NAME set-start-command my-app \"bin/worker\" --process worker --strategy rolling\n   CF_NAME set-start-command my-app --reset"`
+	relatedCommands interface{}              `related_commands:"app, push, restart"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *SetStartCommandCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd SetStartCommandCommand) Execute(args []string) error {
+	deployment, err := shared.NewDeploymentOptions(cmd.Strategy, cmd.MaxInFlight, flag.CanarySteps{})
+	if err != nil {
+		return err
+	}
+
+	err = cmd.validateArgs(deployment)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ProcessType": cmd.ProcessType,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	}
+	if cmd.Reset {
+		cmd.UI.DisplayTextWithFlavor("Resetting start command for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating start command for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	detectedCommand, err := cmd.detectedCommand(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	startCommand := types.FilteredString{IsSet: true}
+	if !cmd.Reset {
+		startCommand.Value = cmd.RequiredArgs.Command
+	}
+
+	warnings, err = cmd.Actor.UpdateProcessByTypeAndApplication(cmd.ProcessType, app.GUID, resources.Process{Command: startCommand})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	configuredCommand := startCommand.Value
+	if configuredCommand == "" {
+		configuredCommand = cmd.UI.TranslateText("none, using the detected start command")
+	}
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("detected start command:"), detectedCommand},
+		{cmd.UI.TranslateText("configured start command:"), configuredCommand},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if deployment.UsesDeployment() {
+		return cmd.Stager.StartApp(app, "", deployment, cmd.NoWait, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationRestarting)
+	}
+
+	if app.Started() {
+		cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
+	}
+
+	return nil
+}
+
+func (cmd SetStartCommandCommand) validateArgs(deployment v7action.DeploymentOptions) error {
+	switch {
+	case cmd.Reset && cmd.RequiredArgs.Command != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"COMMAND", "--reset"},
+		}
+	case !cmd.Reset && cmd.RequiredArgs.Command == "":
+		return translatableerror.IncorrectUsageError{Message: "one of COMMAND or --reset must be provided"}
+	case cmd.NoWait && !deployment.UsesDeployment():
+		return translatableerror.RequiredFlagsError{Arg1: "--no-wait", Arg2: "--strategy"}
+	}
+	return nil
+}
+
+// detectedCommand returns the start command of the process type detected
+// while staging the current droplet, if there is one.
+func (cmd SetStartCommandCommand) detectedCommand(appGUID string) (string, error) {
+	droplet, warnings, err := cmd.Actor.GetCurrentDropletByApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return droplet.ProcessTypes[cmd.ProcessType], nil
+}
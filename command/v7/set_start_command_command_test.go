@@ -0,0 +1,214 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-start-command Command", func() {
+	var (
+		cmd             v7.SetStartCommandCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+
+		app        resources.Application
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		app = resources.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStarted}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(app, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{
+			ProcessTypes: map[string]string{"web": "bundle exec rackup"},
+		}, v7action.Warnings{"get-droplet-warning"}, nil)
+		fakeActor.UpdateProcessByTypeAndApplicationReturns(v7action.Warnings{"update-process-warning"}, nil)
+
+		cmd = v7.SetStartCommandCommand{
+			RequiredArgs: flag.SetStartCommandArgs{AppName: "some-app", Command: "bin/start"},
+			ProcessType:  "web",
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Stager: fakeAppStager,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("sets the start command of the process and shows the detected and configured commands", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Updating start command for app some-app process web in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`detected start command:\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`configured start command:\s+bin/start`))
+		Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take effect."))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-droplet-warning"))
+		Expect(testUI.Err).To(Say("update-process-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+		processType, appGUID, process := fakeActor.UpdateProcessByTypeAndApplicationArgsForCall(0)
+		Expect(processType).To(Equal("web"))
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(process).To(Equal(resources.Process{Command: types.FilteredString{IsSet: true, Value: "bin/start"}}))
+
+		Expect(fakeAppStager.StartAppCallCount()).To(BeZero())
+	})
+
+	When("--reset is passed", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Command = ""
+			cmd.Reset = true
+		})
+
+		It("removes the configured start command", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Resetting start command for app some-app process web`))
+			Expect(testUI.Out).To(Say(`configured start command:\s+none, using the detected start command`))
+
+			_, _, process := fakeActor.UpdateProcessByTypeAndApplicationArgsForCall(0)
+			Expect(process.Command).To(Equal(types.FilteredString{IsSet: true}))
+		})
+
+		When("a command is passed too", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Command = "bin/start"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"COMMAND", "--reset"}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	When("neither a command nor --reset is passed", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Command = ""
+		})
+
+		It("returns an IncorrectUsageError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "one of COMMAND or --reset must be provided"}))
+		})
+	})
+
+	When("--no-wait is passed without a rolling strategy", func() {
+		BeforeEach(func() {
+			cmd.NoWait = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--no-wait", Arg2: "--strategy"}))
+		})
+	})
+
+	When("--strategy rolling is passed", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			cmd.NoWait = true
+		})
+
+		It("restarts the app with a rolling deployment", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("TIP"))
+
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+			startedApp, dropletGUID, strategy, noWait, space, org, action := fakeAppStager.StartAppArgsForCall(0)
+			Expect(startedApp).To(Equal(app))
+			Expect(dropletGUID).To(BeEmpty())
+			Expect(strategy).To(Equal(v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyRolling}))
+			Expect(noWait).To(BeTrue())
+			Expect(space.Name).To(Equal("some-space"))
+			Expect(org.Name).To(Equal("some-org"))
+			Expect(action).To(Equal(constant.ApplicationRestarting))
+		})
+
+		When("restarting the app fails", func() {
+			BeforeEach(func() {
+				fakeAppStager.StartAppReturns(errors.New("start-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("start-error"))
+			})
+		})
+	})
+
+	When("the app has no droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(resources.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+		})
+
+		It("still updates the start command", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.UpdateProcessByTypeAndApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	When("updating the process fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateProcessByTypeAndApplicationReturns(v7action.Warnings{"update-process-warning"}, actionerror.ProcessNotFoundError{ProcessType: "web"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "web"}))
+			Expect(testUI.Err).To(Say("update-process-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
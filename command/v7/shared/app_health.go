@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type appHealthActor interface {
+	VerifyApplicationHealth(app resources.Application, criteria v7action.ApplicationHealthCriteria) (v7action.Warnings, error)
+}
+
+// VerifyAppHealth returns an error when an app that was just started does not
+// meet the health criteria, so that the command exits non-zero. It does
+// nothing when no criteria are set.
+func VerifyAppHealth(actor appHealthActor, ui command.UI, app resources.Application, criteria v7action.ApplicationHealthCriteria) error {
+	if !criteria.IsSet() {
+		return nil
+	}
+
+	ui.DisplayNewline()
+	if criteria.StabilityWindow > 0 {
+		ui.DisplayText("Watching app {{.AppName}} for crashes for {{.StabilityWindow}}...", map[string]interface{}{
+			"AppName":         app.Name,
+			"StabilityWindow": criteria.StabilityWindow,
+		})
+	} else {
+		ui.DisplayText("Checking the health of app {{.AppName}}...", map[string]interface{}{
+			"AppName": app.Name,
+		})
+	}
+
+	warnings, err := actor.VerifyApplicationHealth(app, criteria)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayText("App {{.AppName}} is healthy.", map[string]interface{}{
+		"AppName": app.Name,
+	})
+	return nil
+}
@@ -0,0 +1,89 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("VerifyAppHealth", func() {
+	var (
+		testUI    *ui.UI
+		fakeActor *v7fakes.FakeActor
+		app       resources.Application
+		criteria  v7action.ApplicationHealthCriteria
+		err       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeActor)
+		app = resources.Application{Name: "some-app", GUID: "some-app-guid"}
+		criteria = v7action.ApplicationHealthCriteria{}
+		fakeActor.VerifyApplicationHealthReturns(v7action.Warnings{"health-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		err = shared.VerifyAppHealth(fakeActor, testUI, app, criteria)
+	})
+
+	When("no criteria are set", func() {
+		It("does not check the app", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeActor.VerifyApplicationHealthCallCount()).To(Equal(0))
+			Expect(testUI.Out).NotTo(Say("healthy"))
+		})
+	})
+
+	When("only a minimum healthy percentage is set", func() {
+		BeforeEach(func() {
+			criteria.MinHealthyPercent = 80
+		})
+
+		It("checks the health of the app", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeActor.VerifyApplicationHealthCallCount()).To(Equal(1))
+			givenApp, givenCriteria := fakeActor.VerifyApplicationHealthArgsForCall(0)
+			Expect(givenApp).To(Equal(app))
+			Expect(givenCriteria).To(Equal(criteria))
+
+			Expect(testUI.Out).To(Say(`Checking the health of app some-app\.\.\.`))
+			Expect(testUI.Out).To(Say(`App some-app is healthy\.`))
+			Expect(testUI.Err).To(Say("health-warning"))
+		})
+	})
+
+	When("a stability window is set", func() {
+		BeforeEach(func() {
+			criteria.StabilityWindow = 2 * time.Minute
+		})
+
+		It("says how long the app is watched", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Watching app some-app for crashes for 2m0s\.\.\.`))
+			Expect(testUI.Out).To(Say(`App some-app is healthy\.`))
+		})
+	})
+
+	When("the app is not healthy", func() {
+		BeforeEach(func() {
+			criteria.MinHealthyPercent = 100
+			fakeActor.VerifyApplicationHealthReturns(v7action.Warnings{"health-warning"}, errors.New("unhealthy"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(err).To(MatchError("unhealthy"))
+			Expect(testUI.Err).To(Say("health-warning"))
+			Expect(testUI.Out).NotTo(Say("is healthy"))
+		})
+	})
+})
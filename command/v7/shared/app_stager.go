@@ -26,7 +26,7 @@ type AppStager interface {
 		space configv3.Space,
 		organization configv3.Organization,
 		packageGUID string,
-		strategy constant.DeploymentStrategy,
+		deployment v7action.DeploymentOptions,
 		noWait bool,
 		appAction constant.ApplicationAction,
 	) error
@@ -40,7 +40,7 @@ type AppStager interface {
 	StartApp(
 		app resources.Application,
 		resourceGuid string,
-		strategy constant.DeploymentStrategy,
+		deployment v7action.DeploymentOptions,
 		noWait bool,
 		space configv3.Space,
 		organization configv3.Organization,
@@ -56,8 +56,7 @@ type Stager struct {
 }
 
 type stagingAndStartActor interface {
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
@@ -83,7 +82,7 @@ func (stager *Stager) StageAndStart(
 	space configv3.Space,
 	organization configv3.Organization,
 	packageGUID string,
-	strategy constant.DeploymentStrategy,
+	deployment v7action.DeploymentOptions,
 	noWait bool,
 	appAction constant.ApplicationAction,
 ) error {
@@ -95,7 +94,7 @@ func (stager *Stager) StageAndStart(
 
 	stager.UI.DisplayNewline()
 
-	err = stager.StartApp(app, droplet.GUID, strategy, noWait, space, organization, appAction)
+	err = stager.StartApp(app, droplet.GUID, deployment, noWait, space, organization, appAction)
 	if err != nil {
 		return err
 	}
@@ -129,32 +128,29 @@ func (stager *Stager) StageApp(app resources.Application, packageGUID string, sp
 func (stager *Stager) StartApp(
 	app resources.Application,
 	resourceGuid string,
-	strategy constant.DeploymentStrategy,
+	deployment v7action.DeploymentOptions,
 	noWait bool,
 	space configv3.Space,
 	organization configv3.Organization,
 	appAction constant.ApplicationAction,
 ) error {
-	if strategy == constant.DeploymentStrategyRolling {
+	if deployment.UsesDeployment() {
 		stager.UI.DisplayText("Creating deployment for app {{.AppName}}...\n",
 			map[string]interface{}{
 				"AppName": app.Name,
 			},
 		)
 
-		var (
-			deploymentGUID string
-			warnings       v7action.Warnings
-			err            error
-		)
-
+		dep := deployment.Deployment(app.GUID)
 		switch appAction {
 		case constant.ApplicationRollingBack:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, resourceGuid)
+			dep.RevisionGUID = resourceGuid
 		default:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndDroplet(app.GUID, resourceGuid)
+			dep.DropletGUID = resourceGuid
 		}
 
+		deploymentGUID, warnings, err := stager.Actor.CreateDeployment(dep)
+
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
@@ -258,5 +254,9 @@ func (stager *Stager) StartApp(
 	appSummaryDisplayer := NewAppSummaryDisplayer(stager.UI)
 	appSummaryDisplayer.AppDisplay(summary, false)
 
+	if deployment.Strategy == constant.DeploymentStrategyCanary {
+		DisplayCanaryPaused(stager.UI, stager.Config.BinaryName(), app.Name)
+	}
+
 	return nil
 }
@@ -34,7 +34,7 @@ var _ = Describe("app stager", func() {
 		space        configv3.Space
 		organization configv3.Organization
 		pkgGUID      string
-		strategy     constant.DeploymentStrategy
+		deployment   v7action.DeploymentOptions
 		noWait       bool
 		appAction    constant.ApplicationAction
 
@@ -57,7 +57,7 @@ var _ = Describe("app stager", func() {
 			app = resources.Application{GUID: "app-guid", Name: "app-name"}
 			space = configv3.Space{Name: "some-space", GUID: "some-space-guid"}
 			organization = configv3.Organization{Name: "some-org"}
-			strategy = constant.DeploymentStrategyDefault
+			deployment = v7action.DeploymentOptions{}
 			appAction = constant.ApplicationRestarting
 
 			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
@@ -111,7 +111,7 @@ var _ = Describe("app stager", func() {
 				space,
 				organization,
 				pkgGUID,
-				strategy,
+				deployment,
 				noWait,
 				appAction,
 			)
@@ -174,7 +174,7 @@ var _ = Describe("app stager", func() {
 
 		When("The deployment strategy is rolling with nowait", func() {
 			BeforeEach(func() {
-				strategy = constant.DeploymentStrategyRolling
+				deployment = v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyRolling}
 				noWait = true
 				appStager = shared.NewAppStager(fakeActor, testUI, fakeConfig, fakeLogCacheClient)
 				executeErr = appStager.StageAndStart(
@@ -182,7 +182,7 @@ var _ = Describe("app stager", func() {
 					space,
 					organization,
 					pkgGUID,
-					strategy,
+					deployment,
 					noWait,
 					appAction,
 				)
@@ -216,7 +216,7 @@ var _ = Describe("app stager", func() {
 			app = resources.Application{GUID: "app-guid", Name: "app-name"}
 			space = configv3.Space{Name: "some-space", GUID: "some-space-guid"}
 			organization = configv3.Organization{Name: "some-org"}
-			strategy = constant.DeploymentStrategyDefault
+			deployment = v7action.DeploymentOptions{}
 
 			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 				logStream := make(chan sharedaction.LogMessage)
@@ -348,7 +348,7 @@ var _ = Describe("app stager", func() {
 			fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
 			allLogsWritten = make(chan bool)
 
-			strategy = constant.DeploymentStrategyDefault
+			deployment = v7action.DeploymentOptions{}
 			noWait = true
 			appAction = constant.ApplicationRestarting
 
@@ -366,7 +366,7 @@ var _ = Describe("app stager", func() {
 			executeErr = appStager.StartApp(
 				app,
 				resourceGUID,
-				strategy,
+				deployment,
 				noWait,
 				space,
 				organization,
@@ -376,8 +376,8 @@ var _ = Describe("app stager", func() {
 
 		When("the deployment strategy is rolling", func() {
 			BeforeEach(func() {
-				strategy = constant.DeploymentStrategyRolling
-				fakeActor.CreateDeploymentByApplicationAndDropletReturns(
+				deployment = v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyRolling}
+				fakeActor.CreateDeploymentReturns(
 					"some-deployment-guid",
 					v7action.Warnings{"create-deployment-warning"},
 					nil,
@@ -393,21 +393,17 @@ var _ = Describe("app stager", func() {
 				BeforeEach(func() {
 					appAction = constant.ApplicationRollingBack
 					resourceGUID = "revision-guid"
-					fakeActor.CreateDeploymentByApplicationAndRevisionReturns(
-						"some-deployment-guid",
-						v7action.Warnings{"create-deployment-warning"},
-						nil,
-					)
 				})
 
 				It("displays output for each step of rolling back", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
-					Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1), "CreateDeployment...")
-					appGUID, revisionGUID := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
-					Expect(appGUID).To(Equal(app.GUID))
-					Expect(revisionGUID).To(Equal("revision-guid"))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1), "CreateDeployment...")
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep.Relationships[constant.RelationshipTypeApplication].GUID).To(Equal(app.GUID))
+					Expect(dep.RevisionGUID).To(Equal("revision-guid"))
+					Expect(dep.DropletGUID).To(BeEmpty())
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
@@ -421,10 +417,11 @@ var _ = Describe("app stager", func() {
 					Expect(executeErr).To(BeNil())
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
-					Expect(fakeActor.CreateDeploymentByApplicationAndDropletCallCount()).To(Equal(1))
-					appGUID, dropletGUID := fakeActor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
-					Expect(appGUID).To(Equal(app.GUID))
-					Expect(dropletGUID).To(Equal("droplet-guid"))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep.Relationships[constant.RelationshipTypeApplication].GUID).To(Equal(app.GUID))
+					Expect(dep.DropletGUID).To(Equal("droplet-guid"))
+					Expect(dep.Strategy).To(Equal(constant.DeploymentStrategyRolling))
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
@@ -433,9 +430,42 @@ var _ = Describe("app stager", func() {
 				})
 			})
 
+			When("the deployment strategy is canary with max in flight", func() {
+				BeforeEach(func() {
+					deployment = v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyCanary, MaxInFlight: 2}
+				})
+
+				It("creates a deployment with the strategy and max in flight", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+					Expect(dep.Options.MaxInFlight).To(Equal(2))
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the deployment strategy is canary with steps", func() {
+				BeforeEach(func() {
+					deployment = v7action.DeploymentOptions{Strategy: constant.DeploymentStrategyCanary, CanaryWeights: []int{10, 50}}
+					noWait = false
+				})
+
+				It("creates a deployment with the steps and tells how to continue it", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep.Options.CanaryWeights).To(Equal([]int{10, 50}))
+
+					Expect(testUI.Out).To(Say("The canary deployment of app app-name is paused."))
+					Expect(testUI.Out).To(Say(`TIP: Run 'some-binary-name continue-deployment app-name' to go on to the next step, or 'some-binary-name cancel-deployment app-name' to roll back\.`))
+				})
+			})
+
 			When("creating a deployment fails", func() {
 				BeforeEach(func() {
-					fakeActor.CreateDeploymentByApplicationAndDropletReturns(
+					fakeActor.CreateDeploymentReturns(
 						"",
 						v7action.Warnings{"create-deployment-warning"},
 						errors.New("create-deployment-error"),
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +15,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// defaultAppPort is the port that route destinations send traffic to when
+// they are mapped without an app port.
+const defaultAppPort = 8080
+
 type AppSummaryDisplayer struct {
 	UI command.UI
 }
@@ -36,20 +41,26 @@ func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicati
 			{display.UI.TranslateText("name:"), summary.Application.Name},
 			{display.UI.TranslateText("requested state:"), strings.ToLower(string(summary.State))},
 			isoRow,
-			{display.UI.TranslateText("routes:"), routeSummary(summary.Routes)},
+			{display.UI.TranslateText("routes:"), routeSummary(summary.Routes, summary.Application.GUID)},
 			{display.UI.TranslateText("last uploaded:"), display.getCreatedTime(summary)},
 			{display.UI.TranslateText("stack:"), summary.CurrentDroplet.Stack},
 			{display.UI.TranslateText("docker image:"), summary.CurrentDroplet.Image},
 			isoRow,
 		}
 	} else {
+		var declaredBuildpacksRow []string
+		if len(summary.Application.LifecycleBuildpacks) > 0 {
+			declaredBuildpacksRow = append(declaredBuildpacksRow, display.UI.TranslateText("declared buildpacks:"), strings.Join(summary.Application.LifecycleBuildpacks, ", "))
+		}
+
 		keyValueTable = [][]string{
 			{display.UI.TranslateText("name:"), summary.Application.Name},
 			{display.UI.TranslateText("requested state:"), strings.ToLower(string(summary.State))},
 			isoRow,
-			{display.UI.TranslateText("routes:"), routeSummary(summary.Routes)},
+			{display.UI.TranslateText("routes:"), routeSummary(summary.Routes, summary.Application.GUID)},
 			{display.UI.TranslateText("last uploaded:"), display.getCreatedTime(summary)},
 			{display.UI.TranslateText("stack:"), summary.CurrentDroplet.Stack},
+			declaredBuildpacksRow,
 			{display.UI.TranslateText("buildpacks:"), ""},
 			isoRow,
 		}
@@ -59,15 +70,35 @@ func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicati
 
 	if summary.LifecycleType == constant.AppLifecycleTypeBuildpack {
 		display.displayBuildpackTable(summary.CurrentDroplet.Buildpacks)
+		display.displayPendingBuildpacks(summary)
 	}
 
 	display.displayProcessTable(summary, displayStartCommand)
 }
 
-func routeSummary(rs []resources.Route) string {
+// routeSummary lists the URLs of the routes, with the ports of the app that
+// they send traffic to when a route is not only mapped to the default port.
+func routeSummary(rs []resources.Route, appGUID string) string {
 	formattedRoutes := []string{}
 	for _, route := range rs {
-		formattedRoutes = append(formattedRoutes, route.URL)
+		var ports []string
+		custom := false
+		for _, destination := range route.Destinations {
+			if destination.App.GUID != appGUID || destination.Port == 0 {
+				continue
+			}
+			ports = append(ports, strconv.Itoa(destination.Port))
+			custom = custom || destination.Port != defaultAppPort
+		}
+
+		switch {
+		case !custom:
+			formattedRoutes = append(formattedRoutes, route.URL)
+		case len(ports) == 1:
+			formattedRoutes = append(formattedRoutes, fmt.Sprintf("%s (port %s)", route.URL, ports[0]))
+		default:
+			formattedRoutes = append(formattedRoutes, fmt.Sprintf("%s (ports %s)", route.URL, strings.Join(ports, ", ")))
+		}
 	}
 	return strings.Join(formattedRoutes, ", ")
 }
@@ -128,6 +159,15 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 			startCommandRow = append(startCommandRow, display.UI.TranslateText("start command:"), process.Command.Value)
 		}
 
+		var portsRow []string
+		if len(process.Ports) > 0 {
+			ports := make([]string, len(process.Ports))
+			for i, port := range process.Ports {
+				ports[i] = strconv.Itoa(port)
+			}
+			portsRow = append(portsRow, display.UI.TranslateText("ports:"), strings.Join(ports, ", "))
+		}
+
 		var processSidecars []string
 		for _, sidecar := range process.Sidecars {
 			processSidecars = append(processSidecars, sidecar.Name)
@@ -138,6 +178,7 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 			{display.UI.TranslateText("sidecars:"), strings.Join(processSidecars, ", ")},
 			{display.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
 			{display.UI.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB.Value)},
+			portsRow,
 			startCommandRow,
 		}
 
@@ -191,3 +232,29 @@ func (display AppSummaryDisplayer) displayBuildpackTable(buildpacks []resources.
 		display.UI.DisplayTableWithHeader("\t", keyValueTable, ui.DefaultTableSpacePadding)
 	}
 }
+
+// displayPendingBuildpacks notes when the buildpacks declared on the app no
+// longer match, in order, the ones its current droplet was built with.
+func (display AppSummaryDisplayer) displayPendingBuildpacks(summary v7action.DetailedApplicationSummary) {
+	declared := summary.Application.LifecycleBuildpacks
+	if len(declared) == 0 || summary.CurrentDroplet.GUID == "" {
+		return
+	}
+
+	built := summary.CurrentDroplet.Buildpacks
+	if len(declared) == len(built) {
+		matches := true
+		for i, buildpack := range built {
+			if buildpack.Name != declared[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return
+		}
+	}
+
+	display.UI.DisplayNewline()
+	display.UI.DisplayText("The declared buildpacks differ from those of the current droplet. Restage the app to apply them.")
+}
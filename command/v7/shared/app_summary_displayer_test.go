@@ -396,6 +396,23 @@ var _ = Describe("app summary displayer", func() {
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
 			})
 
+			It("does not display ports for processes without them", func() {
+				Expect(testUI.Out).NotTo(Say(`ports:`))
+			})
+
+			When("a process has ports", func() {
+				BeforeEach(func() {
+					summary.ProcessSummaries[0].Ports = []int{8080, 9090}
+				})
+
+				It("displays the ports of the process", func() {
+					Expect(testUI.Out).To(Say(`type:\s+web`))
+					Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
+					Expect(testUI.Out).To(Say(`ports:\s+8080, 9090`))
+					Expect(testUI.Out).To(Say(`type:\s+console`))
+				})
+			})
+
 			It("does not display the instance table", func() {
 				Expect(testUI.Out).NotTo(Say(instanceStatsTitles))
 			})
@@ -559,6 +576,29 @@ var _ = Describe("app summary displayer", func() {
 			})
 		})
 
+		When("the application routes are mapped to other ports", func() {
+			BeforeEach(func() {
+				summary.Application.GUID = "some-app-guid"
+				summary.Routes = []resources.Route{
+					{URL: "route1.example.com", Destinations: []resources.RouteDestination{
+						{App: resources.RouteDestinationApp{GUID: "some-app-guid"}, Port: 8080},
+					}},
+					{URL: "route2.example.com", Destinations: []resources.RouteDestination{
+						{App: resources.RouteDestinationApp{GUID: "some-app-guid"}, Port: 9090},
+					}},
+					{URL: "route3.example.com", Destinations: []resources.RouteDestination{
+						{App: resources.RouteDestinationApp{GUID: "some-app-guid"}, Port: 8080},
+						{App: resources.RouteDestinationApp{GUID: "other-app-guid"}, Port: 7070},
+						{App: resources.RouteDestinationApp{GUID: "some-app-guid"}, Port: 9090},
+					}},
+				}
+			})
+
+			It("displays the ports of the routes that are not only mapped to the default port", func() {
+				Expect(testUI.Out).To(Say(`routes:\s+route1\.example\.com, route2\.example\.com \(port 9090\), route3\.example\.com \(ports 8080, 9090\)`))
+			})
+		})
+
 		When("the application has a stack", func() {
 			BeforeEach(func() {
 				summary.CurrentDroplet.Stack = "some-stack"
@@ -644,6 +684,48 @@ var _ = Describe("app summary displayer", func() {
 				Expect(testUI.Out).To(Say(`ruby_buildpack\s+0.0.1\s+some-detect-output\s+ruby_buildpack_name\n`))
 				Expect(testUI.Out).To(Say(`some-buildpack`))
 			})
+
+			It("does not display declared buildpacks when none are declared", func() {
+				Expect(testUI.Out).ToNot(Say("declared buildpacks:"))
+			})
+
+			When("buildpacks are declared on the app", func() {
+				BeforeEach(func() {
+					summary.Application.LifecycleBuildpacks = []string{"ruby_buildpack", "go_buildpack_without_detect_output"}
+					summary.CurrentDroplet.GUID = "some-droplet-guid"
+					summary.CurrentDroplet.Buildpacks = summary.CurrentDroplet.Buildpacks[:2]
+				})
+
+				It("displays them in order before the buildpacks of the droplet", func() {
+					Expect(testUI.Out).To(Say(`declared buildpacks:\s+ruby_buildpack, go_buildpack_without_detect_output\n`))
+					Expect(testUI.Out).To(Say(`buildpacks:\s+\n`))
+					Expect(testUI.Out).To(Say(`ruby_buildpack\s+0.0.1`))
+				})
+
+				It("does not ask for a restage when the droplet used them", func() {
+					Expect(testUI.Out).ToNot(Say("Restage the app"))
+				})
+
+				When("they differ from the buildpacks of the current droplet", func() {
+					BeforeEach(func() {
+						summary.Application.LifecycleBuildpacks = []string{"go_buildpack_without_detect_output", "ruby_buildpack"}
+					})
+
+					It("notes that a restage is needed", func() {
+						Expect(testUI.Out).To(Say(`The declared buildpacks differ from those of the current droplet\. Restage the app to apply them\.`))
+					})
+				})
+
+				When("the app has no droplet yet", func() {
+					BeforeEach(func() {
+						summary.CurrentDroplet = resources.Droplet{}
+					})
+
+					It("does not ask for a restage", func() {
+						Expect(testUI.Out).ToNot(Say("Restage the app"))
+					})
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
+)
+
+// SaveClientCertificate checks that the certificate and private key given
+// with --client-cert and --client-key can be loaded, and saves their absolute
+// paths in the config. The config is left as is when neither flag is given.
+func SaveClientCertificate(config command.Config, certFile string, keyFile string) error {
+	if certFile == "" && keyFile == "" {
+		return nil
+	}
+	if certFile == "" || keyFile == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--client-cert", Arg2: "--client-key"}
+	}
+
+	certificate := util.ClientCertificate{CertFile: certFile, KeyFile: keyFile}
+	if _, err := certificate.Load(); err != nil {
+		return translatableerror.InvalidClientCertificateError{CertFile: certFile, KeyFile: keyFile, Err: err}
+	}
+
+	var err error
+	if certificate.CertFile, err = filepath.Abs(certFile); err != nil {
+		return err
+	}
+	if certificate.KeyFile, err = filepath.Abs(keyFile); err != nil {
+		return err
+	}
+
+	config.SetClientCertificate(certificate)
+	return nil
+}
@@ -0,0 +1,108 @@
+package shared_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SaveClientCertificate", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		dir        string
+		certFile   string
+		keyFile    string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		var err error
+		dir, err = ioutil.TempDir("", "client-certificate")
+		Expect(err).ToNot(HaveOccurred())
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "cf-client"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).ToNot(HaveOccurred())
+
+		certFile = filepath.Join(dir, "client.crt")
+		keyFile = filepath.Join(dir, "client.key")
+		Expect(ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = SaveClientCertificate(fakeConfig, certFile, keyFile)
+	})
+
+	It("saves the certificate in the config", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeConfig.SetClientCertificateCallCount()).To(Equal(1))
+		Expect(fakeConfig.SetClientCertificateArgsForCall(0)).To(Equal(util.ClientCertificate{
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		}))
+	})
+
+	When("neither flag is given", func() {
+		BeforeEach(func() {
+			certFile = ""
+			keyFile = ""
+		})
+
+		It("leaves the config as is", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetClientCertificateCallCount()).To(Equal(0))
+		})
+	})
+
+	When("only one of the flags is given", func() {
+		BeforeEach(func() {
+			keyFile = ""
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--client-cert", Arg2: "--client-key"}))
+		})
+	})
+
+	When("the private key does not match the certificate", func() {
+		BeforeEach(func() {
+			keyFile = certFile
+		})
+
+		It("returns an InvalidClientCertificateError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidClientCertificateError{}))
+			Expect(fakeConfig.SetClientCertificateCallCount()).To(Equal(0))
+		})
+	})
+})
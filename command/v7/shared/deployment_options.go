@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// NewDeploymentOptions returns how a restart-like command replaces the
+// instances of an app according to its --strategy, --max-in-flight and
+// --canary-steps flags. --max-in-flight is only valid with a strategy that
+// creates a deployment, and --canary-steps only with the canary strategy.
+func NewDeploymentOptions(strategy flag.DeploymentStrategy, maxInFlight flag.PositiveInteger, canarySteps flag.CanarySteps) (v7action.DeploymentOptions, error) {
+	opts := v7action.DeploymentOptions{
+		Strategy:      strategy.Name,
+		MaxInFlight:   int(maxInFlight.Value),
+		CanaryWeights: canarySteps.Weights,
+	}
+
+	if opts.MaxInFlight != 0 && !opts.UsesDeployment() {
+		return v7action.DeploymentOptions{}, translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--strategy"}
+	}
+
+	if len(opts.CanaryWeights) > 0 && opts.Strategy != constant.DeploymentStrategyCanary {
+		return v7action.DeploymentOptions{}, translatableerror.RequiredFlagsError{Arg1: "--canary-steps", Arg2: "--strategy canary"}
+	}
+
+	return opts, nil
+}
+
+// DisplayCanaryPaused tells how to go on with a canary deployment of an app
+// once it has paused at a step.
+func DisplayCanaryPaused(ui command.UI, binaryName string, appName string) {
+	ui.DisplayNewline()
+	ui.DisplayText("The canary deployment of app {{.AppName}} is paused.", map[string]interface{}{"AppName": appName})
+	ui.DisplayText("TIP: Run '{{.BinaryName}} continue-deployment {{.AppName}}' to go on to the next step, or '{{.BinaryName}} cancel-deployment {{.AppName}}' to roll back.", map[string]interface{}{
+		"BinaryName": binaryName,
+		"AppName":    appName,
+	})
+}
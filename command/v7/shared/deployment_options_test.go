@@ -0,0 +1,49 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewDeploymentOptions", func() {
+	DescribeTable("returns the options of the flags",
+		func(strategy constant.DeploymentStrategy, maxInFlight int64, usesDeployment bool) {
+			opts, err := shared.NewDeploymentOptions(flag.DeploymentStrategy{Name: strategy}, flag.PositiveInteger{Value: maxInFlight}, flag.CanarySteps{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts).To(Equal(v7action.DeploymentOptions{Strategy: strategy, MaxInFlight: int(maxInFlight)}))
+			Expect(opts.UsesDeployment()).To(Equal(usesDeployment))
+		},
+		Entry("no strategy", constant.DeploymentStrategyDefault, int64(0), false),
+		Entry("rolling", constant.DeploymentStrategyRolling, int64(0), true),
+		Entry("rolling with max in flight", constant.DeploymentStrategyRolling, int64(3), true),
+		Entry("canary with max in flight", constant.DeploymentStrategyCanary, int64(2), true),
+	)
+
+	When("max in flight is set without a deployment strategy", func() {
+		It("returns an error", func() {
+			_, err := shared.NewDeploymentOptions(flag.DeploymentStrategy{}, flag.PositiveInteger{Value: 2}, flag.CanarySteps{})
+			Expect(err).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--strategy"}))
+		})
+	})
+
+	When("canary steps are set", func() {
+		It("returns them as the canary weights", func() {
+			opts, err := shared.NewDeploymentOptions(flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}, flag.PositiveInteger{}, flag.CanarySteps{Weights: []int{10, 50}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts.CanaryWeights).To(Equal([]int{10, 50}))
+		})
+
+		When("the strategy is not canary", func() {
+			It("returns an error", func() {
+				_, err := shared.NewDeploymentOptions(flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}, flag.PositiveInteger{}, flag.CanarySteps{Weights: []int{10}})
+				Expect(err).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--canary-steps", Arg2: "--strategy canary"}))
+			})
+		})
+	})
+})
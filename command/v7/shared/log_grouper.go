@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+var invalidSectionNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// LogGrouper wraps the phases of a long-running command in CI-native group
+// markers so that CI UIs can collapse them. It displays nothing when no group
+// style is configured.
+type LogGrouper struct {
+	UI    command.UI
+	Style configv3.LogGroupStyle
+
+	openSection string
+}
+
+func NewLogGrouper(ui command.UI, style configv3.LogGroupStyle) *LogGrouper {
+	return &LogGrouper{
+		UI:    ui,
+		Style: style,
+	}
+}
+
+// StartGroup ends the open group, if any, and starts a new one. The name
+// identifies the group to the CI and the title is what the CI displays.
+// Starting the group that is already open does nothing.
+func (grouper *LogGrouper) StartGroup(name string, title string) {
+	name = invalidSectionNameChars.ReplaceAllString(strings.ToLower(name), "_")
+	if name == grouper.openSection {
+		return
+	}
+	grouper.EndGroup()
+
+	switch grouper.Style {
+	case configv3.LogGroupsGitHub:
+		fmt.Fprintf(grouper.UI.Writer(), "::group::%s\n", title)
+	case configv3.LogGroupsGitLab:
+		fmt.Fprintf(grouper.UI.Writer(), "\x1b[0Ksection_start:%d:%s[collapsed=true]\r\x1b[0K%s\n", time.Now().Unix(), name, title)
+	default:
+		return
+	}
+	grouper.openSection = name
+}
+
+// EndGroup ends the open group, if any.
+func (grouper *LogGrouper) EndGroup() {
+	if grouper.openSection == "" {
+		return
+	}
+
+	switch grouper.Style {
+	case configv3.LogGroupsGitHub:
+		fmt.Fprintln(grouper.UI.Writer(), "::endgroup::")
+	case configv3.LogGroupsGitLab:
+		fmt.Fprintf(grouper.UI.Writer(), "\x1b[0Ksection_end:%d:%s\r\x1b[0K\n", time.Now().Unix(), grouper.openSection)
+	}
+	grouper.openSection = ""
+}
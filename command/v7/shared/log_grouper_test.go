@@ -0,0 +1,64 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LogGrouper", func() {
+	var (
+		testUI  *ui.UI
+		grouper *shared.LogGrouper
+		style   configv3.LogGroupStyle
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	JustBeforeEach(func() {
+		grouper = shared.NewLogGrouper(testUI, style)
+		grouper.StartGroup("upload some-app", "Uploading some-app")
+		grouper.StartGroup("upload some-app", "Uploading some-app")
+		grouper.StartGroup("staging some-app", "Staging some-app")
+		grouper.EndGroup()
+		grouper.EndGroup()
+	})
+
+	When("no style is configured", func() {
+		BeforeEach(func() {
+			style = configv3.LogGroupsNone
+		})
+
+		It("displays nothing", func() {
+			Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		})
+	})
+
+	When("the style is GitHub", func() {
+		BeforeEach(func() {
+			style = configv3.LogGroupsGitHub
+		})
+
+		It("wraps each group in workflow commands", func() {
+			Expect(testUI.Out).To(Say("::group::Uploading some-app\n::endgroup::\n::group::Staging some-app\n::endgroup::\n$"))
+		})
+	})
+
+	When("the style is GitLab", func() {
+		BeforeEach(func() {
+			style = configv3.LogGroupsGitLab
+		})
+
+		It("wraps each group in collapsible sections with valid names", func() {
+			Expect(testUI.Out).To(Say(`\x1b\[0Ksection_start:\d+:upload_some_app\[collapsed=true\]\r\x1b\[0KUploading some-app\n`))
+			Expect(testUI.Out).To(Say(`\x1b\[0Ksection_end:\d+:upload_some_app\r\x1b\[0K\n`))
+			Expect(testUI.Out).To(Say(`\x1b\[0Ksection_start:\d+:staging_some_app\[collapsed=true\]\r\x1b\[0KStaging some-app\n`))
+			Expect(testUI.Out).To(Say(`\x1b\[0Ksection_end:\d+:staging_some_app\r\x1b\[0K\n$`))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
@@ -37,23 +39,33 @@ func GetNewClientsAndConnectToCF(config command.Config, ui command.UI, minVersio
 
 func NewWrappedCloudControllerClient(config command.Config, ui command.UI, extraWrappers ...ccv3.ConnectionWrapper) *ccv3.Client {
 	ccWrappers := []ccv3.ConnectionWrapper{}
+	var retryOutputs []ccWrapper.RequestLoggerOutput
 
 	verbose, location := config.Verbose()
 	if verbose {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		output := ui.RequestLoggerTerminalDisplay()
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(output))
+		retryOutputs = append(retryOutputs, output)
 	}
 	if location != nil {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		output := ui.RequestLoggerFileWriter(location)
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(output))
+		retryOutputs = append(retryOutputs, output)
 	}
 
 	ccWrappers = append(ccWrappers, extraWrappers...)
+	if stats := config.RequestStats(); stats != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestStats(stats))
+	}
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryBackoff(config.RequestRetryCount(), time.Second, retryOutputs...))
 
 	return ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
+		PaginationWorkers:  config.PaginationWorkers(),
 		Wrappers:           ccWrappers,
 	})
 }
@@ -85,6 +97,9 @@ func newWrappedUAAClient(config command.Config, ui command.UI) (*uaa.Client, err
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
+	if stats := config.RequestStats(); stats != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestStats(stats))
+	}
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
 
 	err = uaaClient.SetupResources(config.UAAEndpoint(), config.AuthorizationEndpoint())
@@ -100,7 +115,10 @@ func newWrappedRoutingClient(config command.Config, ui command.UI, uaaClient *ua
 		AppName:    config.BinaryName(),
 		AppVersion: config.BinaryVersion(),
 		ConnectionConfig: router.ConnectionConfig{
+			ClientCertificate: config.ClientCertificate(),
 			DialTimeout:       config.DialTimeout(),
+			IPFamily:          config.IPFamily(),
+			ResolveOverrides:  config.ResolveOverrides(),
 			SkipSSLValidation: config.SkipSSLValidation(),
 		},
 		RoutingEndpoint: config.RoutingEndpoint(),
@@ -121,6 +139,9 @@ func newWrappedRoutingClient(config command.Config, ui command.UI, uaaClient *ua
 	authWrapper := routingWrapper.NewUAAAuthentication(uaaClient, config)
 
 	routingWrappers = append(routingWrappers, authWrapper)
+	if stats := config.RequestStats(); stats != nil {
+		routingWrappers = append(routingWrappers, routingWrapper.NewRequestStats(stats))
+	}
 	routingConfig.Wrappers = routingWrappers
 
 	routingClient := router.NewClient(routingConfig)
@@ -137,16 +158,17 @@ func connectToCF(config command.Config, ui command.UI, ccClient *ccv3.Client, mi
 
 	ccClient.TargetCF(ccv3.TargetSettings{
 		URL:               config.Target(),
+		BaseURL:           config.APIBaseURL(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		ClientCertificate: config.ClientCertificate(),
 		DialTimeout:       config.DialTimeout(),
+		IPFamily:          config.IPFamily(),
+		ResolveOverrides:  config.ResolveOverrides(),
 	})
 
 	if minVersionV3 != "" {
 		err := command.MinimumCCAPIVersionCheck(config.APIVersion(), minVersionV3)
 		if err != nil {
-			if _, ok := err.(translatableerror.MinimumCFAPIVersionNotMetError); ok {
-				return nil, translatableerror.V3V2SwitchError{}
-			}
 			return nil, err
 		}
 	}
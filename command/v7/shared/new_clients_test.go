@@ -1,17 +1,21 @@
 package shared_test
 
 import (
+	"net/http"
 	"runtime"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("New Clients", func() {
@@ -53,6 +57,8 @@ var _ = Describe("New Clients", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeConfig.TargetCallCount()).To(Equal(2))
 			Expect(fakeConfig.DialTimeoutCallCount()).To(Equal(3))
+			Expect(fakeConfig.IPFamilyCallCount()).To(Equal(3))
+			Expect(fakeConfig.ResolveOverridesCallCount()).To(Equal(3))
 			Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(3))
 		})
 	})
@@ -64,4 +70,41 @@ var _ = Describe("New Clients", func() {
 			Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(0))
 		})
 	})
+
+	Describe("retrying failed Cloud Controller requests", func() {
+		var (
+			server   *Server
+			ccClient *ccv3.Client
+		)
+
+		BeforeEach(func() {
+			server = NewServer()
+			fakeConfig.RequestRetryCountReturns(2)
+
+			ccClient = NewWrappedCloudControllerClient(fakeConfig, testUI)
+			ccClient.TargetCF(ccv3.TargetSettings{URL: server.URL()})
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		DescribeTable("retries a request at most the configured number of times",
+			func(statusCode int) {
+				server.RouteToHandler(http.MethodGet, "/v3/apps",
+					RespondWith(statusCode, `{}`, http.Header{"Retry-After": {"0"}}),
+				)
+
+				_, _, err := ccClient.GetApplications()
+				Expect(err).To(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			},
+
+			Entry("500 Internal Server Error", http.StatusInternalServerError),
+			Entry("502 Bad Gateway", http.StatusBadGateway),
+			Entry("503 Service Unavailable", http.StatusServiceUnavailable),
+			Entry("504 Gateway Timeout", http.StatusGatewayTimeout),
+			Entry("429 Too Many Requests", http.StatusTooManyRequests),
+		)
+	})
 })
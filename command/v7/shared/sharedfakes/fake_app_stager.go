@@ -4,6 +4,7 @@ package sharedfakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
@@ -11,14 +12,14 @@ import (
 )
 
 type FakeAppStager struct {
-	StageAndStartStub        func(resources.Application, configv3.Space, configv3.Organization, string, constant.DeploymentStrategy, bool, constant.ApplicationAction) error
+	StageAndStartStub        func(resources.Application, configv3.Space, configv3.Organization, string, v7action.DeploymentOptions, bool, constant.ApplicationAction) error
 	stageAndStartMutex       sync.RWMutex
 	stageAndStartArgsForCall []struct {
 		arg1 resources.Application
 		arg2 configv3.Space
 		arg3 configv3.Organization
 		arg4 string
-		arg5 constant.DeploymentStrategy
+		arg5 v7action.DeploymentOptions
 		arg6 bool
 		arg7 constant.ApplicationAction
 	}
@@ -43,12 +44,12 @@ type FakeAppStager struct {
 		result1 resources.Droplet
 		result2 error
 	}
-	StartAppStub        func(resources.Application, string, constant.DeploymentStrategy, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) error
+	StartAppStub        func(resources.Application, string, v7action.DeploymentOptions, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) error
 	startAppMutex       sync.RWMutex
 	startAppArgsForCall []struct {
 		arg1 resources.Application
 		arg2 string
-		arg3 constant.DeploymentStrategy
+		arg3 v7action.DeploymentOptions
 		arg4 bool
 		arg5 configv3.Space
 		arg6 configv3.Organization
@@ -64,7 +65,7 @@ type FakeAppStager struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAppStager) StageAndStart(arg1 resources.Application, arg2 configv3.Space, arg3 configv3.Organization, arg4 string, arg5 constant.DeploymentStrategy, arg6 bool, arg7 constant.ApplicationAction) error {
+func (fake *FakeAppStager) StageAndStart(arg1 resources.Application, arg2 configv3.Space, arg3 configv3.Organization, arg4 string, arg5 v7action.DeploymentOptions, arg6 bool, arg7 constant.ApplicationAction) error {
 	fake.stageAndStartMutex.Lock()
 	ret, specificReturn := fake.stageAndStartReturnsOnCall[len(fake.stageAndStartArgsForCall)]
 	fake.stageAndStartArgsForCall = append(fake.stageAndStartArgsForCall, struct {
@@ -72,7 +73,7 @@ func (fake *FakeAppStager) StageAndStart(arg1 resources.Application, arg2 config
 		arg2 configv3.Space
 		arg3 configv3.Organization
 		arg4 string
-		arg5 constant.DeploymentStrategy
+		arg5 v7action.DeploymentOptions
 		arg6 bool
 		arg7 constant.ApplicationAction
 	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
@@ -94,13 +95,13 @@ func (fake *FakeAppStager) StageAndStartCallCount() int {
 	return len(fake.stageAndStartArgsForCall)
 }
 
-func (fake *FakeAppStager) StageAndStartCalls(stub func(resources.Application, configv3.Space, configv3.Organization, string, constant.DeploymentStrategy, bool, constant.ApplicationAction) error) {
+func (fake *FakeAppStager) StageAndStartCalls(stub func(resources.Application, configv3.Space, configv3.Organization, string, v7action.DeploymentOptions, bool, constant.ApplicationAction) error) {
 	fake.stageAndStartMutex.Lock()
 	defer fake.stageAndStartMutex.Unlock()
 	fake.StageAndStartStub = stub
 }
 
-func (fake *FakeAppStager) StageAndStartArgsForCall(i int) (resources.Application, configv3.Space, configv3.Organization, string, constant.DeploymentStrategy, bool, constant.ApplicationAction) {
+func (fake *FakeAppStager) StageAndStartArgsForCall(i int) (resources.Application, configv3.Space, configv3.Organization, string, v7action.DeploymentOptions, bool, constant.ApplicationAction) {
 	fake.stageAndStartMutex.RLock()
 	defer fake.stageAndStartMutex.RUnlock()
 	argsForCall := fake.stageAndStartArgsForCall[i]
@@ -195,13 +196,13 @@ func (fake *FakeAppStager) StageAppReturnsOnCall(i int, result1 resources.Drople
 	}{result1, result2}
 }
 
-func (fake *FakeAppStager) StartApp(arg1 resources.Application, arg2 string, arg3 constant.DeploymentStrategy, arg4 bool, arg5 configv3.Space, arg6 configv3.Organization, arg7 constant.ApplicationAction) error {
+func (fake *FakeAppStager) StartApp(arg1 resources.Application, arg2 string, arg3 v7action.DeploymentOptions, arg4 bool, arg5 configv3.Space, arg6 configv3.Organization, arg7 constant.ApplicationAction) error {
 	fake.startAppMutex.Lock()
 	ret, specificReturn := fake.startAppReturnsOnCall[len(fake.startAppArgsForCall)]
 	fake.startAppArgsForCall = append(fake.startAppArgsForCall, struct {
 		arg1 resources.Application
 		arg2 string
-		arg3 constant.DeploymentStrategy
+		arg3 v7action.DeploymentOptions
 		arg4 bool
 		arg5 configv3.Space
 		arg6 configv3.Organization
@@ -225,13 +226,13 @@ func (fake *FakeAppStager) StartAppCallCount() int {
 	return len(fake.startAppArgsForCall)
 }
 
-func (fake *FakeAppStager) StartAppCalls(stub func(resources.Application, string, constant.DeploymentStrategy, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) error) {
+func (fake *FakeAppStager) StartAppCalls(stub func(resources.Application, string, v7action.DeploymentOptions, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) error) {
 	fake.startAppMutex.Lock()
 	defer fake.startAppMutex.Unlock()
 	fake.StartAppStub = stub
 }
 
-func (fake *FakeAppStager) StartAppArgsForCall(i int) (resources.Application, string, constant.DeploymentStrategy, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) {
+func (fake *FakeAppStager) StartAppArgsForCall(i int) (resources.Application, string, v7action.DeploymentOptions, bool, configv3.Space, configv3.Organization, constant.ApplicationAction) {
 	fake.startAppMutex.RLock()
 	defer fake.startAppMutex.RUnlock()
 	argsForCall := fake.startAppArgsForCall[i]
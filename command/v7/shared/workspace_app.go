@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"gopkg.in/yaml.v2"
+)
+
+// WorkspaceApp returns the name of the only app declared by the manifest in
+// dir, and the path of that manifest. It returns false when dir has no
+// manifest, or when the manifest declares no app, several apps or an app whose
+// name is a variable.
+func WorkspaceApp(dir string) (string, string, bool, error) {
+	manifestPath, exists, err := manifestparser.NewLocator().Path(dir)
+	if err != nil || !exists {
+		return "", "", false, err
+	}
+
+	rawManifest, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return "", "", false, err
+	}
+
+	var manifest struct {
+		Applications []struct {
+			Name string `yaml:"name"`
+		} `yaml:"applications"`
+	}
+	if err := yaml.Unmarshal(rawManifest, &manifest); err != nil {
+		return "", "", false, nil
+	}
+
+	if len(manifest.Applications) != 1 {
+		return "", "", false, nil
+	}
+	name := manifest.Applications[0].Name
+	if name == "" || strings.Contains(name, "((") {
+		return "", "", false, nil
+	}
+
+	return name, manifestPath, true, nil
+}
+
+// ResolveAppName returns appName when it is given. Otherwise it returns the
+// only app of the manifest in the current directory and tells the user which
+// app it picked, or a RequiredArgumentError when there is no such app.
+func ResolveAppName(ui command.UI, appName string) (string, error) {
+	if appName != "" {
+		return appName, nil
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	name, manifestPath, found, err := WorkspaceApp(workDir)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
+	ui.DisplayWarning("Using app {{.AppName}} from {{.ManifestPath}}", map[string]interface{}{
+		"AppName":      name,
+		"ManifestPath": manifestPath,
+	})
+	return name, nil
+}
@@ -0,0 +1,111 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("workspace app", func() {
+	var workDir string
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = ioutil.TempDir("", "workspace-app")
+		Expect(err).NotTo(HaveOccurred())
+		workDir, err = filepath.EvalSymlinks(workDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	writeManifest := func(content string) {
+		Expect(ioutil.WriteFile(filepath.Join(workDir, "manifest.yml"), []byte(content), 0600)).To(Succeed())
+	}
+
+	Describe("WorkspaceApp", func() {
+		It("returns the only app of the manifest", func() {
+			writeManifest("applications:\n- name: my-app\n  instances: 2\n")
+
+			name, manifestPath, found, err := shared.WorkspaceApp(workDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(name).To(Equal("my-app"))
+			Expect(manifestPath).To(Equal(filepath.Join(workDir, "manifest.yml")))
+		})
+
+		It("returns false without a manifest", func() {
+			_, _, found, err := shared.WorkspaceApp(workDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		DescribeTable("returns false when the manifest does not name exactly one app",
+			func(content string) {
+				writeManifest(content)
+
+				_, _, found, err := shared.WorkspaceApp(workDir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			},
+			Entry("no apps", "applications: []\n"),
+			Entry("several apps", "applications:\n- name: app-1\n- name: app-2\n"),
+			Entry("a variable name", "applications:\n- name: ((app_name))\n"),
+			Entry("no name", "applications:\n- instances: 1\n"),
+			Entry("not yaml", "applications: [\n"),
+		)
+	})
+
+	Describe("ResolveAppName", func() {
+		var (
+			testUI  *ui.UI
+			prevDir string
+		)
+
+		BeforeEach(func() {
+			testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+
+			var err error
+			prevDir, err = os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Chdir(workDir)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Chdir(prevDir)).To(Succeed())
+		})
+
+		It("returns the given app name", func() {
+			writeManifest("applications:\n- name: my-app\n")
+
+			name, err := shared.ResolveAppName(testUI, "other-app")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("other-app"))
+			Expect(testUI.Err).NotTo(Say("Using app"))
+		})
+
+		It("returns the app of the manifest in the current directory with a notice", func() {
+			writeManifest("applications:\n- name: my-app\n")
+
+			name, err := shared.ResolveAppName(testUI, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("my-app"))
+			Expect(testUI.Err).To(Say("Using app my-app from %s", filepath.Join(workDir, "manifest.yml")))
+		})
+
+		It("returns a RequiredArgumentError when there is no app to use", func() {
+			_, err := shared.ResolveAppName(testUI, "")
+			Expect(err).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+})
@@ -4,8 +4,11 @@ import (
 	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -13,14 +16,24 @@ import (
 type SpaceCommand struct {
 	BaseCommand
 
-	RequiredArgs       flag.Space  `positional-args:"yes"`
-	GUID               bool        `long:"guid" description:"Retrieve and display the given space's guid.  All other output for the space is suppressed."`
-	SecurityGroupRules bool        `long:"security-group-rules" description:"Retrieve the rules for all the security groups associated with the space."`
-	usage              interface{} `usage:"CF_NAME space SPACE [--guid] [--security-group-rules]"`
-	relatedCommands    interface{} `related_commands:"set-space-isolation-segment, space-quota, space-users"`
+	RequiredArgs       flag.OptionalSpace `positional-args:"yes"`
+	GUID               bool               `long:"guid" description:"Retrieve and display the given space's guid.  All other output for the space is suppressed."`
+	SecurityGroupRules bool               `long:"security-group-rules" description:"Retrieve the rules for all the security groups associated with the space."`
+	SpaceGUID          string             `long:"space-guid" description:"GUID of the space, instead of its name. The space must be in the targeted org"`
+	usage              interface{}        `usage:"CF_NAME space (SPACE | --space-guid SPACE_GUID) [--guid] [--security-group-rules]"`
+	relatedCommands    interface{}        `related_commands:"set-space-isolation-segment, space-quota, space-users"`
 }
 
 func (cmd SpaceCommand) Execute(args []string) error {
+	switch {
+	case cmd.RequiredArgs.Space != "" && cmd.SpaceGUID != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"SPACE", "--space-guid"},
+		}
+	case cmd.RequiredArgs.Space == "" && cmd.SpaceGUID == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "SPACE"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
 		return err
@@ -29,7 +42,20 @@ func (cmd SpaceCommand) Execute(args []string) error {
 	spaceName := cmd.RequiredArgs.Space
 	targetedOrg := cmd.Config.TargetedOrganization()
 
+	var space resources.Space
+	if cmd.SpaceGUID != "" {
+		space, err = cmd.getSpaceByGUID(targetedOrg.GUID)
+		if err != nil {
+			return err
+		}
+		spaceName = space.Name
+	}
+
 	if cmd.GUID {
+		if cmd.SpaceGUID != "" {
+			cmd.UI.DisplayText(space.GUID)
+			return nil
+		}
 		return cmd.displaySpaceGUID(spaceName, targetedOrg.GUID)
 	}
 
@@ -45,7 +71,18 @@ func (cmd SpaceCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	spaceSummary, warnings, err := cmd.Actor.GetSpaceSummaryByNameAndOrganization(spaceName, targetedOrg.GUID)
+	var (
+		spaceSummary v7action.SpaceSummary
+		warnings     v7action.Warnings
+	)
+	if cmd.SpaceGUID != "" {
+		spaceSummary, warnings, err = cmd.Actor.GetSpaceSummaryForSpace(space, resources.Organization{
+			GUID: targetedOrg.GUID,
+			Name: targetedOrg.Name,
+		})
+	} else {
+		spaceSummary, warnings, err = cmd.Actor.GetSpaceSummaryByNameAndOrganization(spaceName, targetedOrg.GUID)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -81,6 +118,22 @@ func (cmd SpaceCommand) displaySpaceGUID(spaceName string, orgGUID string) error
 	return nil
 }
 
+// getSpaceByGUID returns the space with the GUID, which must be in the
+// targeted org.
+func (cmd SpaceCommand) getSpaceByGUID(orgGUID string) (resources.Space, error) {
+	space, warnings, err := cmd.Actor.GetSpaceByGUID(cmd.SpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.Space{}, err
+	}
+
+	if space.Relationships[constant.RelationshipTypeOrganization].GUID != orgGUID {
+		return resources.Space{}, actionerror.SpaceNotFoundError{GUID: cmd.SpaceGUID}
+	}
+
+	return space, nil
+}
+
 func formatSecurityGroupNames(groups []resources.SecurityGroup) string {
 	var names []string
 
@@ -5,8 +5,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/integration/helpers"
@@ -42,7 +44,7 @@ var _ = Describe("space command", func() {
 				SharedActor: fakeSharedActor,
 				Actor:       fakeActor,
 			},
-			RequiredArgs: flag.Space{
+			RequiredArgs: flag.OptionalSpace{
 				Space: "some-space",
 			},
 		}
@@ -352,4 +354,108 @@ var _ = Describe("space command", func() {
 			})
 		})
 	})
+
+	When("the --space-guid flag is provided", func() {
+		var space resources.Space
+
+		BeforeEach(func() {
+			cmd.RequiredArgs.Space = ""
+			cmd.SpaceGUID = "some-space-guid"
+			space = resources.Space{
+				Name: "some-space",
+				GUID: "some-space-guid",
+				Relationships: resources.Relationships{
+					constant.RelationshipTypeOrganization: resources.Relationship{GUID: "some-org-guid"},
+				},
+			}
+			fakeActor.GetSpaceByGUIDReturns(space, v7action.Warnings{"space-guid-warning"}, nil)
+			fakeActor.GetSpaceSummaryForSpaceReturns(
+				v7action.SpaceSummary{
+					Name:    "some-space",
+					OrgName: "some-org",
+				},
+				v7action.Warnings{"summary-warning"},
+				nil,
+			)
+		})
+
+		It("shows the space with that GUID without looking it up by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("space-guid-warning"))
+			Expect(testUI.Err).To(Say("summary-warning"))
+			Expect(testUI.Out).To(Say(`Getting info for space some-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name:\s+some-space`))
+
+			Expect(fakeActor.GetSpaceByGUIDArgsForCall(0)).To(Equal("some-space-guid"))
+			summarizedSpace, org := fakeActor.GetSpaceSummaryForSpaceArgsForCall(0)
+			Expect(summarizedSpace).To(Equal(space))
+			Expect(org).To(Equal(resources.Organization{Name: "some-org", GUID: "some-org-guid"}))
+			Expect(fakeActor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+			Expect(fakeActor.GetSpaceSummaryByNameAndOrganizationCallCount()).To(Equal(0))
+		})
+
+		When("--guid is also provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("displays the guid of the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("some-space-guid"))
+				Expect(fakeActor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+				Expect(fakeActor.GetSpaceSummaryForSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the space is in another org", func() {
+			BeforeEach(func() {
+				space.Relationships[constant.RelationshipTypeOrganization] = resources.Relationship{GUID: "other-org-guid"}
+				fakeActor.GetSpaceByGUIDReturns(space, nil, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(fakeActor.GetSpaceSummaryForSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("there is no space with that GUID", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByGUIDReturns(
+					resources.Space{},
+					v7action.Warnings{"space-guid-warning"},
+					actionerror.SpaceNotFoundError{GUID: "some-space-guid"},
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(testUI.Err).To(Say("space-guid-warning"))
+			})
+		})
+
+		When("the space name is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Space = "some-space"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"SPACE", "--space-guid"},
+				}))
+				Expect(fakeActor.GetSpaceByGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("neither the space name nor --space-guid is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Space = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SPACE"}))
+		})
+	})
 })
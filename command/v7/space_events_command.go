@@ -0,0 +1,45 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SpaceEventsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Space  `positional-args:"yes"`
+	usage           interface{} `usage:"CF_NAME space-events SPACE"`
+	relatedCommands interface{} `related_commands:"events, org-events, space"`
+}
+
+func (SpaceEventsCommand) PagedOutput() bool {
+	return true
+}
+
+func (cmd SpaceEventsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting events for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.Space,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	events, warnings, err := cmd.Actor.GetRecentEventsBySpaceNameAndOrganization(cmd.RequiredArgs.Space, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	displayEventsWithTarget(cmd.UI, events)
+	return nil
+}
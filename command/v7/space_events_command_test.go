@@ -0,0 +1,117 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("space-events Command", func() {
+	var (
+		cmd             SpaceEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = SpaceEventsCommand{
+			RequiredArgs: flag.Space{Space: "some-space"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the events succeeds", func() {
+		var createdAt time.Time
+
+		BeforeEach(func() {
+			createdAt = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+			fakeActor.GetRecentEventsBySpaceNameAndOrganizationReturns(
+				[]v7action.Event{
+					{Type: "audit.app.create", ActorName: "admin", TargetName: "dev", Time: createdAt, Description: "name: dev"},
+				},
+				v7action.Warnings{"get-events-warning"},
+				nil,
+			)
+		})
+
+		It("displays the events of the space with their target", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events for space some-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.create\s+admin\s+dev\s+name: dev`, regexp.QuoteMeta(createdAt.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			spaceName, orgGUID := fakeActor.GetRecentEventsBySpaceNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	When("there are no events", func() {
+		It("displays that there are no events", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No events found."))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRecentEventsBySpaceNameAndOrganizationReturns(nil, v7action.Warnings{"get-events-warning"}, errors.New("events failed"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("events failed"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+})
@@ -10,7 +10,7 @@ type SpaceSSHAllowedCommand struct {
 
 	RequiredArgs    flag.Space  `positional-args:"yes"`
 	usage           interface{} `usage:"CF_NAME space-ssh-allowed SPACE_NAME"`
-	relatedCommands interface{} `related_commands:"allow-space-ssh, ssh-enabled, ssh"`
+	relatedCommands interface{} `related_commands:"allow-space-ssh, ssh-enabled, ssh-report, ssh"`
 }
 
 func (cmd SpaceSSHAllowedCommand) Execute(args []string) error {
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,9 +9,16 @@ import (
 type SpacesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME spaces [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-space, set-space-role, space, space-users"`
-	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
+	usage           interface{}       `usage:"CF_NAME spaces [--labels SELECTOR] [--details] [--sort (name | created-at | apps | service-instances)] [--output json]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME spaces --details --sort created-at"`
+	relatedCommands interface{}       `related_commands:"create-space, set-space-role, space, space-users"`
+	Labels          string            `long:"labels" description:"Selector to filter spaces by labels"`
+	Details         bool              `long:"details" description:"Show the quota, number of apps and service instances, and creation date of each space"`
+	Sort            string            `long:"sort" choice:"name" choice:"created-at" choice:"apps" choice:"service-instances" default:"name" description:"Sort spaces by name or creation date, or by number of apps or service instances with the largest first"`
+	Output          flag.OutputFormat `long:"output" choice:"text" choice:"json" default:"text" description:"Output format; json prints the spaces and their details as a JSON array"`
+}
+
+func (SpacesCommand) PagedOutput() bool {
+	return true
 }
 
 func (cmd SpacesCommand) Execute([]string) error {
@@ -19,6 +27,15 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.Output == flag.OutputFormatJSON {
+		rows, warnings, err := cmd.getSpaceDetails()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		return cmd.UI.DisplayJSON("", rows)
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -30,6 +47,20 @@ func (cmd SpacesCommand) Execute([]string) error {
 	})
 	cmd.UI.DisplayNewline()
 
+	if cmd.Details || (cmd.Sort != "" && cmd.Sort != listingSortName) {
+		rows, warnings, err := cmd.getSpaceDetails()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			cmd.UI.DisplayText("No spaces found.")
+			return nil
+		}
+		return displayListingDetails(cmd.UI, rows)
+	}
+
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -45,6 +76,28 @@ func (cmd SpacesCommand) Execute([]string) error {
 	return nil
 }
 
+func (cmd SpacesCommand) getSpaceDetails() ([]listingDetails, []string, error) {
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithDetails(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	rows := make([]listingDetails, 0, len(spaces))
+	for _, space := range spaces {
+		rows = append(rows, listingDetails{
+			Name:             space.Name,
+			GUID:             space.GUID,
+			Quota:            space.QuotaName,
+			Apps:             space.AppCount,
+			ServiceInstances: space.ServiceInstanceCount,
+			CreatedAt:        space.CreatedAt,
+		})
+	}
+	sortListingDetails(rows, cmd.Sort)
+
+	return rows, warnings, nil
+}
+
 func (cmd SpacesCommand) displaySpaces(spaces []resources.Space) {
 	table := [][]string{{cmd.UI.TranslateText("name")}}
 
@@ -158,6 +158,88 @@ var _ = Describe("spaces Command", func() {
 				})
 			})
 
+			When("the --details flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Details = true
+					fakeActor.GetOrganizationSpacesWithDetailsReturns(
+						[]v7action.SpaceDetails{
+							{
+								Space:                resources.Space{Name: "space-1", GUID: "space-guid-1", CreatedAt: "2020-01-02T03:04:05Z"},
+								QuotaName:            "small",
+								AppCount:             2,
+								ServiceInstanceCount: 0,
+							},
+							{
+								Space:                resources.Space{Name: "space-2", GUID: "space-guid-2", CreatedAt: "2019-01-02T03:04:05Z"},
+								ServiceInstanceCount: 5,
+							},
+						},
+						v7action.Warnings{"get-spaces-warning"},
+						nil)
+				})
+
+				It("displays the quota, counts and creation date of each space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Getting spaces in org some-org as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`name\s+quota\s+apps\s+service instances\s+created`))
+					Expect(testUI.Out).To(Say(`space-1\s+small\s+2\s+0\s+Thu 02 Jan 03:04:05 UTC 2020`))
+					Expect(testUI.Out).To(Say(`space-2\s+0\s+5\s+Wed 02 Jan 03:04:05 UTC 2019`))
+
+					Expect(testUI.Err).To(Say("get-spaces-warning"))
+
+					Expect(fakeActor.GetOrganizationSpacesWithLabelSelectorCallCount()).To(Equal(0))
+					Expect(fakeActor.GetOrganizationSpacesWithDetailsCallCount()).To(Equal(1))
+					orgGUID, labelSelector := fakeActor.GetOrganizationSpacesWithDetailsArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(labelSelector).To(Equal(""))
+				})
+
+				When("sorting by service instances", func() {
+					BeforeEach(func() {
+						cmd.Sort = "service-instances"
+					})
+
+					It("displays the space with the most service instances first", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`space-2`))
+						Expect(testUI.Out).To(Say(`space-1`))
+					})
+				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						cmd.Output = "json"
+					})
+
+					It("prints the spaces as JSON without the intro text", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting spaces"))
+						Expect(testUI.Out).To(Say(`"name": "space-1"`))
+						Expect(testUI.Out).To(Say(`"guid": "space-guid-1"`))
+						Expect(testUI.Out).To(Say(`"quota": "small"`))
+						Expect(testUI.Out).To(Say(`"apps": 2`))
+						Expect(testUI.Out).To(Say(`"name": "space-2"`))
+						Expect(testUI.Out).To(Say(`"created_at": "2019-01-02T03:04:05Z"`))
+
+						Expect(testUI.Err).To(Say("get-spaces-warning"))
+					})
+				})
+			})
+
+			When("sorting without the --details flag", func() {
+				BeforeEach(func() {
+					cmd.Sort = "created-at"
+				})
+
+				It("displays the details of the spaces", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetOrganizationSpacesWithDetailsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetOrganizationSpacesWithLabelSelectorCallCount()).To(Equal(0))
+				})
+			})
+
 			When("a translatable error is encountered getting spaces", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
@@ -1,10 +1,13 @@
 package v7
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/clissh"
 )
 
@@ -12,12 +15,14 @@ import (
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellAndDownload(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, stdout io.Writer, stderr io.Writer, remotePaths []string, createFile func(remotePath string) (io.WriteCloser, error)) error
+	ExecuteSecureShellCommand(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, stdout io.Writer, stderr io.Writer) error
 }
 
 type SSHCommand struct {
 	BaseCommand
 
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
+	RequiredArgs          flag.OptionalAppName     `positional-args:"yes"`
 	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
 	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
 	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
@@ -28,7 +33,7 @@ type SSHCommand struct {
 	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
 	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
 
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	usage           interface{} `usage:"CF_NAME ssh [APP_NAME] [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   APP_NAME can be left out when the manifest in the current directory declares a single app."`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -45,7 +50,7 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
 	cmd.SSHActor = sharedActor
-	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.SSHClient = clissh.NewDefaultSecureShell(config.IPFamily(), config.ResolveOverrides())
 
 	return nil
 }
@@ -57,6 +62,11 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	cmd.RequiredArgs.AppName, err = shared.ResolveAppName(cmd.UI, cmd.RequiredArgs.AppName)
+	if err != nil {
+		return err
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -0,0 +1,86 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SSHReportCommand struct {
+	BaseCommand
+
+	AllSpaces       bool        `long:"all-spaces" description:"Report on the apps in every space of the targeted org"`
+	usage           interface{} `usage:"CF_NAME ssh-report [--all-spaces]"`
+	relatedCommands interface{} `related_commands:"disable-ssh, disallow-space-ssh, space-ssh-allowed, ssh-enabled"`
+}
+
+func (cmd SSHReportCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, !cmd.AllSpaces)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var spaces []resources.Space
+	if cmd.AllSpaces {
+		cmd.UI.DisplayTextWithFlavor("Getting SSH access for apps in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+
+		var warnings []string
+		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting SSH access for apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		spaces = []resources.Space{{
+			GUID: cmd.Config.TargetedSpace().GUID,
+			Name: cmd.Config.TargetedSpace().Name,
+		}}
+	}
+	cmd.UI.DisplayNewline()
+
+	statuses, warnings, err := cmd.Actor.GetSSHStatusesForSpaces(spaces)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("space ssh"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("ssh"),
+		cmd.UI.TranslateText("reason"),
+	}}
+	for _, status := range statuses {
+		table = append(table, []string{
+			status.SpaceName,
+			cmd.UI.TranslateText(shared.FlagBoolToString(status.SpaceSSHAllowed)),
+			status.AppName,
+			cmd.UI.TranslateText(shared.FlagBoolToString(status.Enabled)),
+			status.Reason,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
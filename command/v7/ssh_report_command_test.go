@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-report command", func() {
+	var (
+		cmd             SSHReportCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SSHReportCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetSSHStatusesForSpacesReturns(
+			[]v7action.AppSSHStatus{
+				{SpaceName: "some-space", SpaceSSHAllowed: true, AppName: "app-1", Enabled: true},
+				{SpaceName: "some-space", SpaceSSHAllowed: true, AppName: "app-2", Enabled: false, Reason: "Disabled for this app"},
+			},
+			v7action.Warnings{"ssh-status-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the environment is not set up correctly", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("reports on the apps in the targeted space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetSSHStatusesForSpacesCallCount()).To(Equal(1))
+		Expect(fakeActor.GetSSHStatusesForSpacesArgsForCall(0)).To(Equal([]resources.Space{
+			{GUID: "some-space-guid", Name: "some-space"},
+		}))
+		Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Getting SSH access for apps in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`space\s+space ssh\s+app\s+ssh\s+reason`))
+		Expect(testUI.Out).To(Say(`some-space\s+enabled\s+app-1\s+enabled`))
+		Expect(testUI.Out).To(Say(`some-space\s+enabled\s+app-2\s+disabled\s+Disabled for this app`))
+		Expect(testUI.Err).To(Say("ssh-status-warning"))
+	})
+
+	When("--all-spaces is given", func() {
+		BeforeEach(func() {
+			cmd.AllSpaces = true
+			fakeActor.GetOrganizationSpacesReturns(
+				[]resources.Space{{GUID: "space-1-guid", Name: "space-1"}, {GUID: "space-2-guid", Name: "space-2"}},
+				v7action.Warnings{"get-spaces-warning"},
+				nil,
+			)
+		})
+
+		It("only requires a targeted org", func() {
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+
+		It("reports on the apps in every space of the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetSSHStatusesForSpacesArgsForCall(0)).To(Equal([]resources.Space{
+				{GUID: "space-1-guid", Name: "space-1"},
+				{GUID: "space-2-guid", Name: "space-2"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Getting SSH access for apps in org some-org as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("get-spaces-warning"))
+		})
+
+		When("getting the spaces fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationSpacesReturns(nil, v7action.Warnings{"get-spaces-warning"}, errors.New("get-spaces-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("get-spaces-error"))
+				Expect(testUI.Err).To(Say("get-spaces-warning"))
+				Expect(fakeActor.GetSSHStatusesForSpacesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("there are no apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHStatusesForSpacesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found"))
+		})
+	})
+
+	When("getting the SSH statuses fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHStatusesForSpacesReturns(nil, v7action.Warnings{"ssh-status-warning"}, errors.New("ssh-status-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("ssh-status-error"))
+			Expect(testUI.Err).To(Say("ssh-status-warning"))
+		})
+	})
+})
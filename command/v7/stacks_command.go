@@ -16,6 +16,10 @@ type StacksCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter stacks by labels"`
 }
 
+func (StacksCommand) PagedOutput() bool {
+	return true
+}
+
 func (cmd StacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
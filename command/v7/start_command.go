@@ -71,12 +71,12 @@ func (cmd StartCommand) Execute(args []string) error {
 		})
 		cmd.UI.DisplayNewline()
 
-		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, constant.DeploymentStrategyDefault, false, constant.ApplicationStarting)
+		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, v7action.DeploymentOptions{}, false, constant.ApplicationStarting)
 		if err != nil {
 			return err
 		}
 	} else {
-		err = cmd.Stager.StartApp(app, "", constant.DeploymentStrategyDefault, false, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationStarting)
+		err = cmd.Stager.StartApp(app, "", v7action.DeploymentOptions{}, false, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationStarting)
 		if err != nil {
 			return err
 		}
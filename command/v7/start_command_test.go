@@ -145,7 +145,7 @@ var _ = Describe("start Command", func() {
 				Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 				Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
 				Expect(inputPkgGUID).To(Equal("package-guid"))
-				Expect(inputStrategy).To(Equal(constant.DeploymentStrategyDefault))
+				Expect(inputStrategy).To(Equal(v7action.DeploymentOptions{}))
 				Expect(inputNoWait).To(Equal(false))
 				Expect(inputAppAction).To(Equal(constant.ApplicationStarting))
 			})
@@ -174,7 +174,7 @@ var _ = Describe("start Command", func() {
 				inputApp, inputDropletGuid, inputStrategy, inputNoWait, inputSpace, inputOrg, inputAppAction := fakeAppStager.StartAppArgsForCall(0)
 				Expect(inputApp).To(Equal(app))
 				Expect(inputDropletGuid).To(Equal(""))
-				Expect(inputStrategy).To(Equal(constant.DeploymentStrategyDefault))
+				Expect(inputStrategy).To(Equal(v7action.DeploymentOptions{}))
 				Expect(inputNoWait).To(Equal(false))
 				Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 				Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
@@ -205,7 +205,7 @@ var _ = Describe("start Command", func() {
 			inputApp, inputDropletGuid, inputStrategy, inputNoWait, inputSpace, inputOrg, inputAppAction := fakeAppStager.StartAppArgsForCall(0)
 			Expect(inputApp).To(Equal(app))
 			Expect(inputDropletGuid).To(Equal(""))
-			Expect(inputStrategy).To(Equal(constant.DeploymentStrategyDefault))
+			Expect(inputStrategy).To(Equal(v7action.DeploymentOptions{}))
 			Expect(inputNoWait).To(Equal(false))
 			Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 			Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
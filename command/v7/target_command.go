@@ -3,6 +3,8 @@ package v7
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -10,14 +12,22 @@ import (
 type TargetCommand struct {
 	BaseCommand
 
-	Organization    string      `short:"o" description:"Organization"`
-	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
-	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
+	Organization     string      `short:"o" description:"Organization"`
+	OrganizationGUID string      `long:"org-guid" description:"GUID of the organization, instead of its name"`
+	Space            string      `short:"s" description:"Space"`
+	SpaceGUID        string      `long:"space-guid" description:"GUID of the space, instead of its name. Also targets the organization of the space when no organization is given"`
+	usage            interface{} `usage:"CF_NAME target [-o ORG | --org-guid ORG_GUID] [-s SPACE | --space-guid SPACE_GUID]"`
+	examples         interface{} `examples:"CF_NAME target\nCF_NAME target -o my-org\nCF_NAME target -o my-org -s my-space"`
+	relatedCommands  interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 }
 
 func (cmd *TargetCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(false, false)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
 	}
@@ -29,13 +39,13 @@ func (cmd *TargetCommand) Execute(args []string) error {
 	}
 
 	switch {
-	case cmd.Organization != "" && cmd.Space != "":
+	case cmd.orgProvided() && cmd.spaceProvided():
 		err = cmd.setOrgAndSpace()
 		if err != nil {
 			cmd.clearTargets()
 			return err
 		}
-	case cmd.Organization != "":
+	case cmd.orgProvided():
 		err = cmd.setOrg()
 		if err != nil {
 			cmd.clearTargets()
@@ -46,7 +56,7 @@ func (cmd *TargetCommand) Execute(args []string) error {
 			cmd.clearTargets()
 			return err
 		}
-	case cmd.Space != "":
+	case cmd.spaceProvided():
 		err = cmd.setSpace()
 		if err != nil {
 			cmd.clearTargets()
@@ -74,10 +84,28 @@ func (cmd *TargetCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd TargetCommand) validateFlags() error {
+	if cmd.Organization != "" && cmd.OrganizationGUID != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"-o", "--org-guid"}}
+	}
+	if cmd.Space != "" && cmd.SpaceGUID != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"-s", "--space-guid"}}
+	}
+	return nil
+}
+
+func (cmd TargetCommand) orgProvided() bool {
+	return cmd.Organization != "" || cmd.OrganizationGUID != ""
+}
+
+func (cmd TargetCommand) spaceProvided() bool {
+	return cmd.Space != "" || cmd.SpaceGUID != ""
+}
+
 func (cmd TargetCommand) clearTargets() {
-	if cmd.Organization != "" {
+	if cmd.orgProvided() {
 		cmd.Config.UnsetOrganizationAndSpaceInformation()
-	} else if cmd.Space != "" {
+	} else if cmd.spaceProvided() {
 		cmd.Config.UnsetSpaceInformation()
 	}
 }
@@ -99,6 +127,19 @@ func (cmd *TargetCommand) setOrgAndSpace() error {
 
 // setOrg sets organization
 func (cmd *TargetCommand) setOrg() error {
+	if cmd.OrganizationGUID != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByGUID(cmd.OrganizationGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+		cmd.Config.UnsetSpaceInformation()
+
+		return nil
+	}
+
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -130,6 +171,10 @@ func (cmd *TargetCommand) autoTargetSpace(orgGUID string) error {
 
 // setSpace sets space
 func (cmd *TargetCommand) setSpace() error {
+	if cmd.SpaceGUID != "" {
+		return cmd.setSpaceByGUID()
+	}
+
 	if !cmd.Config.HasTargetedOrganization() {
 		return translatableerror.NoOrganizationTargetedError{BinaryName: cmd.Config.BinaryName()}
 	}
@@ -145,6 +190,35 @@ func (cmd *TargetCommand) setSpace() error {
 	return nil
 }
 
+// setSpaceByGUID sets space by its GUID. When no org was provided, the org of
+// the space is targeted as well; otherwise the space must be in that org.
+func (cmd *TargetCommand) setSpaceByGUID() error {
+	space, warnings, err := cmd.Actor.GetSpaceByGUID(cmd.SpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	orgGUID := space.Relationships[constant.RelationshipTypeOrganization].GUID
+	if cmd.orgProvided() {
+		if orgGUID != cmd.Config.TargetedOrganization().GUID {
+			return actionerror.SpaceNotFoundError{GUID: cmd.SpaceGUID}
+		}
+	} else if orgGUID != cmd.Config.TargetedOrganization().GUID {
+		org, warnings, err := cmd.Actor.GetOrganizationByGUID(orgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+	}
+
+	cmd.Config.V7SetSpaceInformation(space.GUID, space.Name)
+
+	return nil
+}
+
 // displayTargetTable neatly displays target information.
 func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 	table := [][]string{
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -503,6 +504,170 @@ var _ = Describe("target Command", func() {
 						})
 					})
 				})
+
+				When("an org GUID is provided", func() {
+					BeforeEach(func() {
+						cmd.OrganizationGUID = "some-org-guid"
+					})
+
+					When("the org exists", func() {
+						BeforeEach(func() {
+							fakeActor.GetOrganizationByGUIDReturns(
+								resources.Organization{GUID: "some-org-guid", Name: "some-org"},
+								v7action.Warnings{"get-org-warning"},
+								nil)
+						})
+
+						It("targets the org without looking it up by name", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Err).To(Say("get-org-warning"))
+
+							Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+							Expect(fakeActor.GetOrganizationByGUIDCallCount()).To(Equal(1))
+							Expect(fakeActor.GetOrganizationByGUIDArgsForCall(0)).To(Equal("some-org-guid"))
+
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+							orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(orgName).To(Equal("some-org"))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("the org does not exist", func() {
+						BeforeEach(func() {
+							fakeActor.GetOrganizationByGUIDReturns(
+								resources.Organization{},
+								nil,
+								actionerror.OrganizationNotFoundError{GUID: "some-org-guid"})
+						})
+
+						It("returns an error and clears existing targets", func() {
+							Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{GUID: "some-org-guid"}))
+
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("the org name is also provided", func() {
+						BeforeEach(func() {
+							cmd.Organization = "some-org"
+						})
+
+						It("returns an ArgumentCombinationError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"-o", "--org-guid"},
+							}))
+
+							Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				When("a space GUID is provided", func() {
+					BeforeEach(func() {
+						cmd.SpaceGUID = "some-space-guid"
+						fakeActor.GetSpaceByGUIDReturns(
+							resources.Space{
+								GUID: "some-space-guid",
+								Name: "some-space",
+								Relationships: resources.Relationships{
+									constant.RelationshipTypeOrganization: resources.Relationship{GUID: "some-org-guid"},
+								},
+							},
+							v7action.Warnings{"get-space-warning"},
+							nil)
+						fakeActor.GetOrganizationByGUIDReturns(
+							resources.Organization{GUID: "some-org-guid", Name: "some-org"},
+							v7action.Warnings{"get-org-warning"},
+							nil)
+					})
+
+					When("no org is targeted", func() {
+						It("targets the space and its org", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Err).To(Say("get-space-warning"))
+							Expect(testUI.Err).To(Say("get-org-warning"))
+
+							Expect(fakeActor.GetSpaceByGUIDArgsForCall(0)).To(Equal("some-space-guid"))
+							Expect(fakeActor.GetOrganizationByGUIDArgsForCall(0)).To(Equal("some-org-guid"))
+
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+							orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(orgName).To(Equal("some-org"))
+
+							Expect(fakeConfig.V7SetSpaceInformationCallCount()).To(Equal(1))
+							spaceGUID, spaceName := fakeConfig.V7SetSpaceInformationArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(spaceName).To(Equal("some-space"))
+						})
+					})
+
+					When("the org of the space is already targeted", func() {
+						BeforeEach(func() {
+							fakeConfig.HasTargetedOrganizationReturns(true)
+							fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+						})
+
+						It("targets the space without looking up the org", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetOrganizationByGUIDCallCount()).To(Equal(0))
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.V7SetSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("an org that does not contain the space is provided", func() {
+						BeforeEach(func() {
+							cmd.Organization = "other-org"
+							fakeActor.GetOrganizationByNameReturns(
+								resources.Organization{GUID: "other-org-guid", Name: "other-org"},
+								nil,
+								nil)
+							fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "other-org-guid"})
+						})
+
+						It("returns a SpaceNotFoundError and clears existing targets", func() {
+							Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+
+							Expect(fakeConfig.V7SetSpaceInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("the space does not exist", func() {
+						BeforeEach(func() {
+							fakeActor.GetSpaceByGUIDReturns(
+								resources.Space{},
+								nil,
+								actionerror.SpaceNotFoundError{GUID: "some-space-guid"})
+						})
+
+						It("returns a SpaceNotFoundError and clears the existing space", func() {
+							Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("the space name is also provided", func() {
+						BeforeEach(func() {
+							cmd.Space = "some-space"
+						})
+
+						It("returns an ArgumentCombinationError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"-s", "--space-guid"},
+							}))
+						})
+					})
+				})
 			})
 		})
 	})
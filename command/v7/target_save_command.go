@@ -0,0 +1,40 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type TargetSaveCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME target-save PROFILE_NAME\n\n   Saves the API endpoint, org, space and login of the current target as a profile, replacing any\n   profile with that name. Switch to it with target-use, or for a single shell with CF_PROFILE.\n\nEXAMPLES:\n   CF_NAME target-save prod\n   CF_PROFILE=prod CF_NAME apps"`
+	relatedCommands interface{}      `related_commands:"login, target, target-use"`
+}
+
+func (cmd TargetSaveCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Saving target {{.API}} as profile {{.Profile}} as {{.User}}...", map[string]interface{}{
+		"API":     cmd.Config.Target(),
+		"Profile": cmd.RequiredArgs.ProfileName,
+		"User":    user.Name,
+	})
+
+	cmd.Config.SaveProfile(cmd.RequiredArgs.ProfileName)
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to target it again.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " target-use " + cmd.RequiredArgs.ProfileName,
+	})
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("target-save Command", func() {
+	var (
+		cmd             TargetSaveCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TargetSaveCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.ProfileName{ProfileName: "prod"},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.prod.com")
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("saves the target as the profile", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.SaveProfileCallCount()).To(Equal(1))
+		Expect(fakeConfig.SaveProfileArgsForCall(0)).To(Equal("prod"))
+
+		Expect(testUI.Out).To(Say(`Saving target https://api\.prod\.com as profile prod as steve\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`TIP: Use 'faceman target-use prod' to target it again\.`))
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error without saving", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeConfig.SaveProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("user-error"))
+			Expect(fakeConfig.SaveProfileCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/clock"
+)
+
+type TargetUseCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME target-use PROFILE_NAME\n\n   Targets the API endpoint, org and space saved in a profile, logged in as the user of the profile.\n\nEXAMPLES:\n   CF_NAME target-use prod"`
+	relatedCommands interface{}      `related_commands:"target, target-save"`
+}
+
+// Setup does not connect to the current target, which the profile replaces.
+func (cmd *TargetUseCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, nil, nil, clock.NewClock())
+	return nil
+}
+
+func (cmd TargetUseCommand) Execute(args []string) error {
+	if profile := cmd.Config.Profile(); profile != "" {
+		return translatableerror.ProfileSetByEnvError{Name: profile}
+	}
+
+	if !cmd.Config.UseProfile(cmd.RequiredArgs.ProfileName) {
+		return translatableerror.ProfileNotFoundError{Name: cmd.RequiredArgs.ProfileName}
+	}
+
+	cmd.UI.DisplayText("Switched to profile {{.Profile}}.", map[string]interface{}{
+		"Profile": cmd.RequiredArgs.ProfileName,
+	})
+	cmd.UI.DisplayNewline()
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	target := TargetCommand{BaseCommand: cmd.BaseCommand}
+	target.displayTargetTable(user)
+
+	return nil
+}
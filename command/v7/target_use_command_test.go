@@ -0,0 +1,90 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("target-use Command", func() {
+	var (
+		cmd             TargetUseCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TargetUseCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.ProfileName{ProfileName: "prod"},
+		}
+
+		fakeConfig.UseProfileReturns(true)
+		fakeConfig.TargetReturns("https://api.prod.com")
+		fakeConfig.APIVersionReturns("3.99.0")
+		fakeConfig.HasTargetedOrganizationReturns(true)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "prod-org"})
+		fakeConfig.HasTargetedSpaceReturns(true)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "prod-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("switches to the profile and displays its target", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.UseProfileCallCount()).To(Equal(1))
+		Expect(fakeConfig.UseProfileArgsForCall(0)).To(Equal("prod"))
+
+		Expect(testUI.Out).To(Say(`Switched to profile prod\.`))
+		Expect(testUI.Out).To(Say(`API endpoint:\s+https://api\.prod\.com`))
+		Expect(testUI.Out).To(Say(`API version:\s+3\.99\.0`))
+		Expect(testUI.Out).To(Say(`user:\s+steve`))
+		Expect(testUI.Out).To(Say(`org:\s+prod-org`))
+		Expect(testUI.Out).To(Say(`space:\s+prod-space`))
+	})
+
+	When("the profile does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseProfileReturns(false)
+		})
+
+		It("returns a profile not found error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ProfileNotFoundError{Name: "prod"}))
+		})
+	})
+
+	When("CF_PROFILE is set", func() {
+		BeforeEach(func() {
+			fakeConfig.ProfileReturns("dev")
+		})
+
+		It("refuses to switch profiles", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ProfileSetByEnvError{Name: "dev"}))
+			Expect(fakeConfig.UseProfileCallCount()).To(Equal(0))
+		})
+	})
+})
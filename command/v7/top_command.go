@@ -0,0 +1,154 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// topInterval is how often top refreshes when --interval is not given.
+const topInterval = 5 * time.Second
+
+// topCrashWindow is how far back top counts the crashes of the apps.
+const topCrashWindow = time.Hour
+
+type TopCommand struct {
+	BaseCommand
+
+	Interval        flag.Duration        `long:"interval" description:"Time between refreshes, e.g. 10s (Default: 5s)"`
+	Iterations      flag.PositiveInteger `long:"iterations" short:"n" description:"Exit after refreshing this many times instead of on Ctrl-C"`
+	usage           interface{}          `usage:"CF_NAME top [--interval DURATION] [-n ITERATIONS]\n\n   Shows a dashboard of the CPU, memory and disk usage of the apps in the targeted space and how often\n   their instances crashed in the last hour, refreshed until Ctrl-C. The usage is the sum over all the\n   instances of an app, read from the latest Log Cache metrics or else from the process stats.\n\nEXAMPLES:\n   CF_NAME top\n   CF_NAME top --interval 10s\n   CF_NAME top -n 1"`
+	relatedCommands interface{}          `related_commands:"app, apps, logs, processes"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *TopCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	interval := topInterval
+	if cmd.Interval.IsSet {
+		interval = cmd.Interval.Value
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	screen := cmd.UI.NewScreen()
+	defer screen.Close()
+
+	for refreshes := int64(1); ; refreshes++ {
+		err = cmd.refresh(screen, user.Name, interval)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Iterations.Value > 0 && refreshes >= cmd.Iterations.Value {
+			return nil
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-interrupt:
+			return nil
+		}
+	}
+}
+
+// refresh renders the current usage of the apps of the targeted space. The
+// warnings are shown on the screen since writing them to stderr would break
+// the redrawing of the dashboard.
+func (cmd TopCommand) refresh(screen *ui.Screen, userName string, interval time.Duration) error {
+	now := time.Now()
+	usages, warnings, err := cmd.Actor.GetAppUsagesForSpace(cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, now.Add(-topCrashWindow))
+	if err != nil {
+		cmd.UI.DisplayWarnings(warnings)
+		return err
+	}
+
+	lines := []string{
+		cmd.UI.TranslateText("Usage of apps in org {{.Org}} / space {{.Space}} as {{.User}} at {{.Time}}, refreshed every {{.Interval}}", map[string]interface{}{
+			"Org":      cmd.Config.TargetedOrganization().Name,
+			"Space":    cmd.Config.TargetedSpace().Name,
+			"User":     userName,
+			"Time":     now.Format("15:04:05"),
+			"Interval": interval,
+		}),
+	}
+	for _, warning := range warnings {
+		lines = append(lines, cmd.UI.TranslateText(warning))
+	}
+	lines = append(lines, "")
+
+	if len(usages) == 0 {
+		screen.Render(append(lines, cmd.UI.TranslateText("No apps found")), nil)
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("instances"),
+		cmd.UI.TranslateText("cpu"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("disk"),
+		cmd.UI.TranslateText("crashes (1h)"),
+	}}
+	for _, usage := range usages {
+		cpu, memory, disk := "-", "-", "-"
+		if usage.Instances > 0 {
+			cpu = fmt.Sprintf("%.1f%%", usage.CPU)
+			memory = cmd.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
+				"MemUsage": bytefmt.ByteSize(usage.MemoryUsage),
+				"MemQuota": bytefmt.ByteSize(usage.MemoryQuota),
+			})
+			disk = cmd.UI.TranslateText("{{.DiskUsage}} of {{.DiskQuota}}", map[string]interface{}{
+				"DiskUsage": bytefmt.ByteSize(usage.DiskUsage),
+				"DiskQuota": bytefmt.ByteSize(usage.DiskQuota),
+			})
+		}
+
+		table = append(table, []string{
+			usage.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(usage.State))),
+			fmt.Sprintf("%d/%d", usage.RunningInstances, usage.Instances),
+			cpu,
+			memory,
+			disk,
+			strconv.Itoa(usage.Crashes),
+		})
+	}
+
+	screen.Render(lines, table)
+	return nil
+}
@@ -0,0 +1,127 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("top Command", func() {
+	var (
+		cmd             TopCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TopCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Interval:   flag.Duration{Value: time.Millisecond, IsSet: true},
+			Iterations: flag.PositiveInteger{Value: 2},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetAppUsagesForSpaceReturns([]v7action.AppUsage{
+			{
+				Name:             "api",
+				State:            constant.ApplicationStarted,
+				RunningInstances: 1,
+				Instances:        2,
+				CPU:              12.5,
+				MemoryUsage:      300 * 1024 * 1024,
+				MemoryQuota:      2 * 1024 * 1024 * 1024,
+				DiskUsage:        30 * 1024 * 1024,
+				DiskQuota:        4 * 1024 * 1024 * 1024,
+				Crashes:          2,
+			},
+			{Name: "worker", State: constant.ApplicationStopped},
+		}, v7action.Warnings{"usage-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("renders the usage of the apps once per iteration", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		Expect(fakeActor.GetAppUsagesForSpaceCallCount()).To(Equal(2))
+		spaceGUID, _, crashesSince := fakeActor.GetAppUsagesForSpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(crashesSince).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+
+		for i := 0; i < 2; i++ {
+			Expect(testUI.Out).To(Say(`Usage of apps in org some-org / space some-space as some-user at \d\d:\d\d:\d\d, refreshed every 1ms`))
+			Expect(testUI.Out).To(Say("usage-warning"))
+			Expect(testUI.Out).To(Say(`name\s+state\s+instances\s+cpu\s+memory\s+disk\s+crashes \(1h\)`))
+			Expect(testUI.Out).To(Say(`api\s+started\s+1/2\s+12\.5%\s+300M of 2G\s+30M of 4G\s+2`))
+			Expect(testUI.Out).To(Say(`worker\s+stopped\s+0/0\s+-\s+-\s+-\s+0`))
+		}
+	})
+
+	When("there are no apps", func() {
+		BeforeEach(func() {
+			cmd.Iterations = flag.PositiveInteger{Value: 1}
+			fakeActor.GetAppUsagesForSpaceReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found"))
+		})
+	})
+
+	When("getting the usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppUsagesForSpaceReturns(nil, v7action.Warnings{"usage-warning"}, errors.New("usage failed"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("usage failed"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(fakeActor.GetAppUsagesForSpaceCallCount()).To(Equal(1))
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetAppUsagesForSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
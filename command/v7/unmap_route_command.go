@@ -1,27 +1,31 @@
 package v7
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type UnmapRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppDomain   `positional-args:"yes"`
-	Hostname        string           `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
-	Path            flag.V7RoutePath `long:"path" description:"Path used to identify the HTTP route"`
-	Port            int              `long:"port" description:"Port used to identify the TCP route"`
-	relatedCommands interface{}      `related_commands:"delete-route, map-route, routes"`
+	RequiredArgs    flag.AppDomain       `positional-args:"yes"`
+	Hostname        string               `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path            flag.V7RoutePath     `long:"path" description:"Path used to identify the HTTP route"`
+	Port            int                  `long:"port" description:"Port used to identify the TCP route"`
+	Drain           flag.PositiveInteger `long:"drain" description:"Seconds to wait for in-flight requests to complete before removing the destination, which first gets a weight of 0 on weighted routes"`
+	relatedCommands interface{}          `related_commands:"delete-route, map-route, routes"`
 }
 
 func (cmd UnmapRouteCommand) Usage() string {
 	return `
 Unmap an HTTP route:
-   CF_NAME unmap-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]
+   CF_NAME unmap-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--drain SECONDS]
 
 Unmap a TCP route:
-   CF_NAME unmap-route APP_NAME DOMAIN --port PORT`
+   CF_NAME unmap-route APP_NAME DOMAIN --port PORT [--drain SECONDS]`
 }
 
 func (cmd UnmapRouteCommand) Examples() string {
@@ -29,7 +33,8 @@ func (cmd UnmapRouteCommand) Examples() string {
 CF_NAME unmap-route my-app example.com                              # example.com
 CF_NAME unmap-route my-app example.com --hostname myhost            # myhost.example.com
 CF_NAME unmap-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo
-CF_NAME unmap-route my-app example.com --port 5000                  # example.com:5000`
+CF_NAME unmap-route my-app example.com --port 5000                  # example.com:5000
+CF_NAME unmap-route my-app example.com --hostname myhost --drain 30 # myhost.example.com`
 }
 
 func (cmd UnmapRouteCommand) Execute(args []string) error {
@@ -83,6 +88,19 @@ func (cmd UnmapRouteCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Drain.Value > 0 {
+		destination, err = cmd.drain(domain, route, destination, app.GUID)
+		if err != nil {
+			if _, ok := err.(actionerror.RouteDestinationNotFoundError); ok {
+				cmd.UI.DisplayText("Route to be unmapped is not currently mapped to the application.")
+				cmd.UI.DisplayOK()
+				return nil
+			}
+
+			return err
+		}
+	}
+
 	warnings, err = cmd.Actor.UnmapRoute(route.GUID, destination.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -93,3 +111,33 @@ func (cmd UnmapRouteCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// drain waits for the in-flight requests to the destination to complete and
+// returns the destination to remove. The destinations of a weighted route are
+// replaced when its weights change, so the destination is looked up again.
+func (cmd UnmapRouteCommand) drain(domain resources.Domain, route resources.Route, destination resources.RouteDestination, appGUID string) (resources.RouteDestination, error) {
+	templateValues := map[string]interface{}{"Drain": cmd.Drain.Value}
+	if destination.Weight.IsSet {
+		cmd.UI.DisplayText("Setting the weight of the destination to 0 and waiting {{.Drain}} seconds for in-flight requests to complete...", templateValues)
+	} else {
+		cmd.UI.DisplayText("Waiting {{.Drain}} seconds for in-flight requests to complete...", templateValues)
+	}
+
+	warnings, err := cmd.Actor.DrainRouteDestination(route, destination.GUID, time.Duration(cmd.Drain.Value)*time.Second)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.RouteDestination{}, err
+	}
+
+	if !destination.Weight.IsSet {
+		return destination, nil
+	}
+
+	route, warnings, err = cmd.Actor.GetRouteByAttributes(domain, cmd.Hostname, cmd.Path.Path, cmd.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return resources.RouteDestination{}, err
+	}
+
+	return cmd.Actor.GetRouteDestinationByAppGUID(route, appGUID)
+}
@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -10,6 +11,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -306,4 +308,78 @@ var _ = Describe("unmap-route Command", func() {
 		Expect(givenRouteGUID).To(Equal("route-guid"))
 		Expect(givenDestinationGUID).To(Equal("destination-guid"))
 	})
+
+	When("--drain is provided", func() {
+		BeforeEach(func() {
+			cmd.Drain = flag.PositiveInteger{Value: 30}
+			fakeActor.DrainRouteDestinationReturns(v7action.Warnings{"drain-warnings"}, nil)
+		})
+
+		It("drains the destination before unmapping it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Waiting 30 seconds for in-flight requests to complete\.\.\.`))
+			Expect(testUI.Err).To(Say("drain-warnings"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.DrainRouteDestinationCallCount()).To(Equal(1))
+			givenRoute, givenDestinationGUID, givenDrain := fakeActor.DrainRouteDestinationArgsForCall(0)
+			Expect(givenRoute.GUID).To(Equal("route-guid"))
+			Expect(givenDestinationGUID).To(Equal("destination-guid"))
+			Expect(givenDrain).To(Equal(30 * time.Second))
+
+			Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(1))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(1))
+		})
+
+		When("the route is weighted", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteDestinationByAppGUIDReturnsOnCall(0,
+					resources.RouteDestination{GUID: "destination-guid", Weight: types.NullInt{IsSet: true, Value: 50}},
+					nil,
+				)
+				fakeActor.GetRouteDestinationByAppGUIDReturnsOnCall(1,
+					resources.RouteDestination{GUID: "new-destination-guid", Weight: types.NullInt{IsSet: true, Value: 0}},
+					nil,
+				)
+			})
+
+			It("looks the destination up again after setting its weight to 0", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Setting the weight of the destination to 0 and waiting 30 seconds for in-flight requests to complete\.\.\.`))
+
+				Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(2))
+				givenDomain, givenHostname, givenPath, _ := fakeActor.GetRouteByAttributesArgsForCall(1)
+				Expect(givenDomain.GUID).To(Equal("domain-guid"))
+				Expect(givenHostname).To(Equal(hostname))
+				Expect(givenPath).To(Equal(path))
+
+				_, givenDestinationGUID := fakeActor.UnmapRouteArgsForCall(0)
+				Expect(givenDestinationGUID).To(Equal("new-destination-guid"))
+			})
+
+			When("the destination was removed while draining", func() {
+				BeforeEach(func() {
+					fakeActor.GetRouteDestinationByAppGUIDReturnsOnCall(1, resources.RouteDestination{}, actionerror.RouteDestinationNotFoundError{})
+				})
+
+				It("prints a message and returns without an error", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Route to be unmapped is not currently mapped to the application."))
+					Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("draining fails", func() {
+			BeforeEach(func() {
+				fakeActor.DrainRouteDestinationReturns(v7action.Warnings{"drain-warnings"}, errors.New("invalid weights"))
+			})
+
+			It("returns the error without unmapping the route", func() {
+				Expect(executeErr).To(MatchError("invalid weights"))
+				Expect(testUI.Err).To(Say("drain-warnings"))
+				Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
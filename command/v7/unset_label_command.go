@@ -3,6 +3,7 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 )
 
@@ -20,6 +21,8 @@ type UnsetLabelCommand struct {
 	BuildpackStack  string              `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
 	ServiceBroker   string              `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
 	ServiceOffering string              `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	AllInSpace      bool                `long:"all-in-space" description:"Update every app, route or service instance of the type in the targeted space instead of RESOURCE_NAME"`
+	Selector        string              `long:"selector" description:"Update the apps, routes, service instances or spaces matching the label selector, e.g. env=dev, instead of RESOURCE_NAME"`
 
 	LabelUnsetter LabelUnsetter
 }
@@ -41,30 +44,45 @@ func (cmd *UnsetLabelCommand) Setup(config command.Config, ui command.UI) error
 }
 
 func (cmd UnsetLabelCommand) Execute(args []string) error {
-	labels := make(map[string]types.NullString)
-	for _, value := range cmd.RequiredArgs.LabelKeys {
-		labels[value] = types.NewNullString()
-	}
-
 	targetResource := TargetResource{
 		ResourceType:    cmd.RequiredArgs.ResourceType,
 		ResourceName:    cmd.RequiredArgs.ResourceName,
 		BuildpackStack:  cmd.BuildpackStack,
 		ServiceBroker:   cmd.ServiceBroker,
 		ServiceOffering: cmd.ServiceOffering,
+		AllInSpace:      cmd.AllInSpace,
+		Selector:        cmd.Selector,
+	}
+
+	labelKeys := cmd.RequiredArgs.LabelKeys
+	if cmd.AllInSpace || cmd.Selector != "" {
+		// There is no RESOURCE_NAME when selecting the resources, so the
+		// parser has taken the first key for it.
+		labelKeys = append([]string{targetResource.ResourceName}, labelKeys...)
+		targetResource.ResourceName = ""
+	} else if len(labelKeys) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "KEY"}
 	}
+
+	labels := make(map[string]types.NullString)
+	for _, value := range labelKeys {
+		labels[value] = types.NewNullString()
+	}
+
 	return cmd.LabelUnsetter.Execute(targetResource, labels)
 }
 
 func (cmd UnsetLabelCommand) Usage() string {
-	return `CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...`
+	return `CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...
+CF_NAME unset-label RESOURCE (--all-in-space | --selector SELECTOR) KEY...`
 }
 
 func (cmd UnsetLabelCommand) Examples() string {
 	return `
 cf unset-label app dora ci_signature_sha2
 cf unset-label org business pci public-facing
-cf unset-label buildpack go_buildpack go -s cflinuxfs3`
+cf unset-label buildpack go_buildpack go -s cflinuxfs3
+cf unset-label app --selector env=dev ci_signature_sha2`
 }
 
 func (cmd UnsetLabelCommand) Resources() string {
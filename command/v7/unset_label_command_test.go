@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
@@ -50,4 +51,41 @@ var _ = Describe("unset-label command", func() {
 			"ENV": types.NewNullString(),
 		}))
 	})
+
+	When("no keys are given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.LabelKeys = nil
+		})
+
+		It("returns a required argument error", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the resources are selected with --selector", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetLabelArgs{
+				ResourceType: "app",
+				ResourceName: "FOO",
+				LabelKeys:    []string{"ENV"},
+			}
+			cmd.Selector = "env=dev"
+		})
+
+		It("unsets the key that was parsed as the resource name", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			targetResource, keys := fakeLabelSetter.ExecuteArgsForCall(0)
+			Expect(targetResource.ResourceName).To(BeEmpty())
+			Expect(targetResource.Selector).To(Equal("env=dev"))
+			Expect(keys).To(Equal(map[string]types.NullString{
+				"FOO": types.NewNullString(),
+				"ENV": types.NewNullString(),
+			}))
+		})
+	})
 })
@@ -44,7 +44,11 @@ type UpdateBuildpackCommand struct {
 }
 
 func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
-	cmd.ProgressBar = v7action.NewProgressBar()
+	if config.ProgressStyle() == configv3.ProgressPlain {
+		cmd.ProgressBar = v7action.NewPlainProgressBar(ui.Writer())
+	} else {
+		cmd.ProgressBar = v7action.NewProgressBar()
+	}
 	return cmd.BaseCommand.Setup(config, ui)
 }
 
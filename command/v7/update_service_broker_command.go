@@ -1,18 +1,44 @@
 package v7
 
 import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BrokerCatalogFetcher
+
+type BrokerCatalogFetcher interface {
+	Fetch(brokerURL string, username string, password string) (brokercatalog.Catalog, error)
+}
+
 type UpdateServiceBrokerCommand struct {
 	BaseCommand
 
 	PositionalArgs  flag.ServiceBrokerArgs `positional-args:"yes"`
-	usage           any                    `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL\n   CF_NAME update-service-broker SERVICE_BROKER USERNAME URL (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
-	relatedCommands any                    `related_commands:"rename-service-broker, service-brokers"`
+	Preview         bool                   `long:"preview" description:"Show the plans the new catalog adds, removes and changes, and ask for confirmation before updating"`
+	usage           any                    `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--preview]\n   CF_NAME update-service-broker SERVICE_BROKER USERNAME URL [--preview] (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
+	relatedCommands any                    `related_commands:"rename-service-broker, rotate-service-broker-credentials, service-brokers"`
 	envPassword     any                    `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"Password associated with user. Overridden if PASSWORD argument is provided" environmentDefault:"password"`
+
+	CatalogFetcher   BrokerCatalogFetcher
+	CredentialHelper CredentialHelper
+}
+
+func (cmd *UpdateServiceBrokerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.CatalogFetcher = brokercatalog.NewFetcher(
+		util.NewDialer(config.DialTimeout(), config.IPFamily(), config.ResolveOverrides()),
+		config.SkipSSLValidation(),
+		config.ClientCertificate(),
+	)
+	cmd.CredentialHelper = newCredentialHelper(config)
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd UpdateServiceBrokerCommand) Execute(args []string) error {
@@ -20,7 +46,7 @@ func (cmd UpdateServiceBrokerCommand) Execute(args []string) error {
 		return err
 	}
 
-	brokerName, username, password, url, err := promptUserForBrokerPasswordIfRequired(cmd.PositionalArgs, cmd.UI)
+	brokerName, username, password, url, err := promptUserForBrokerPasswordIfRequired(cmd.PositionalArgs, cmd.UI, cmd.CredentialHelper)
 	if err != nil {
 		return err
 	}
@@ -36,7 +62,172 @@ func (cmd UpdateServiceBrokerCommand) Execute(args []string) error {
 		return err
 	}
 
-	return updateServiceBroker(cmd.UI, cmd.Actor, user.Name, serviceBroker.GUID, brokerName, username, password, url)
+	if cmd.Preview {
+		confirmed, err := cmd.previewCatalog(user.Name, brokerName, username, password, url)
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	snapshotPath := cmd.Config.BrokerCatalogSnapshotPath(serviceBroker.GUID)
+	previousCatalog, hasPreviousCatalog := cmd.previousCatalog(brokerName, snapshotPath)
+
+	err = updateServiceBroker(cmd.UI, cmd.Actor, user.Name, serviceBroker.GUID, brokerName, username, password, url)
+	if err != nil {
+		return err
+	}
+
+	cmd.reportCatalogDrift(brokerName, snapshotPath, previousCatalog, hasPreviousCatalog)
+	return nil
+}
+
+// previousCatalog returns the catalog saved by the last update of the broker
+// or, when there is none, the catalog registered before this update.
+func (cmd UpdateServiceBrokerCommand) previousCatalog(brokerName, snapshotPath string) (brokercatalog.Catalog, bool) {
+	catalog, found, err := brokercatalog.ReadSnapshot(snapshotPath)
+	if err != nil {
+		cmd.UI.DisplayWarning("Could not read the catalog snapshot {{.Path}}: {{.Error}}", map[string]any{
+			"Path":  snapshotPath,
+			"Error": err.Error(),
+		})
+	}
+	if found {
+		return catalog, true
+	}
+
+	catalog, warnings, err := cmd.Actor.GetServiceBrokerCatalog(brokerName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return brokercatalog.Catalog{}, false
+	}
+
+	return catalog, true
+}
+
+// reportCatalogDrift displays how the update changed the catalog of the
+// broker and saves the new catalog for the next update to compare with. It
+// only displays warnings, as the broker has been updated already.
+func (cmd UpdateServiceBrokerCommand) reportCatalogDrift(brokerName, snapshotPath string, previous brokercatalog.Catalog, hasPrevious bool) {
+	current, warnings, err := cmd.Actor.GetServiceBrokerCatalog(brokerName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Could not get the catalog of service broker {{.ServiceBroker}}: {{.Error}}", map[string]any{
+			"ServiceBroker": brokerName,
+			"Error":         err.Error(),
+		})
+		return
+	}
+
+	if hasPrevious {
+		cmd.displayCatalogChanges(brokerName, brokercatalog.Diff(previous, current))
+	}
+
+	err = brokercatalog.WriteSnapshot(snapshotPath, current)
+	if err != nil {
+		cmd.UI.DisplayWarning("Could not save the catalog snapshot {{.Path}}: {{.Error}}", map[string]any{
+			"Path":  snapshotPath,
+			"Error": err.Error(),
+		})
+	}
+}
+
+func (cmd UpdateServiceBrokerCommand) displayCatalogChanges(brokerName string, changes []brokercatalog.Change) {
+	cmd.UI.DisplayNewline()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("The catalog of service broker {{.ServiceBroker}} has not changed.", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Catalog changes of service broker {{.ServiceBroker}}:", map[string]any{
+		"ServiceBroker": brokerName,
+	})
+	table := [][]string{{
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, change := range changes {
+		table = append(table, []string{
+			change.ServiceName,
+			change.PlanName,
+			string(change.Type),
+			strings.Join(change.Details, ", "),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+// previewCatalog displays how the catalog at url changes the plans of the
+// broker and asks whether to go on with the update.
+func (cmd UpdateServiceBrokerCommand) previewCatalog(user, brokerName, username, password, url string) (bool, error) {
+	cmd.UI.DisplayTextWithFlavor(
+		"Getting the catalog of service broker {{.ServiceBroker}} from {{.URL}} as {{.Username}}...",
+		map[string]any{
+			"Username":      user,
+			"ServiceBroker": brokerName,
+			"URL":           url,
+		},
+	)
+
+	catalog, err := cmd.CatalogFetcher.Fetch(url, username, password)
+	if err != nil {
+		return false, err
+	}
+
+	changes, warnings, err := cmd.Actor.GetServiceBrokerCatalogDiff(brokerName, catalog)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("The catalog does not change any plans.")
+	} else {
+		table := [][]string{{
+			cmd.UI.TranslateText("offering"),
+			cmd.UI.TranslateText("plan"),
+			cmd.UI.TranslateText("change"),
+			cmd.UI.TranslateText("details"),
+		}}
+		for _, change := range changes {
+			table = append(table, []string{
+				change.ServiceOfferingName,
+				change.ServicePlanName,
+				string(change.Type),
+				cmd.planChangeDetails(change),
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+	cmd.UI.DisplayNewline()
+
+	confirmed, err := cmd.UI.DisplayBoolPrompt(false, "Really update the service broker {{.ServiceBroker}}?", map[string]any{
+		"ServiceBroker": brokerName,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !confirmed {
+		cmd.UI.DisplayText("'{{.ServiceBroker}}' has not been updated.", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+	}
+
+	return confirmed, nil
+}
+
+func (cmd UpdateServiceBrokerCommand) planChangeDetails(change v7action.ServicePlanChange) string {
+	if change.Type == v7action.ServicePlanRemoved {
+		return cmd.UI.TranslateText("{{.Count}} service instance(s)", map[string]any{
+			"Count": strconv.Itoa(change.ServiceInstances),
+		})
+	}
+	return strings.Join(change.Details, ", ")
 }
 
 func updateServiceBroker(ui command.UI, actor Actor, user, brokerGUID, brokerName, username, password, url string) error {
@@ -2,7 +2,9 @@ package v7_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -11,6 +13,7 @@ import (
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -32,6 +35,7 @@ var _ = Describe("update-service-broker command", func() {
 		fakeUpdateServiceBrokerActor *v7fakes.FakeActor
 		fakeSharedActor              *commandfakes.FakeSharedActor
 		fakeConfig                   *commandfakes.FakeConfig
+		fakeCatalogFetcher           *v7fakes.FakeBrokerCatalogFetcher
 		input                        *Buffer
 		testUI                       *ui.UI
 	)
@@ -42,6 +46,7 @@ var _ = Describe("update-service-broker command", func() {
 		input = NewBuffer()
 		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
 		fakeConfig = &commandfakes.FakeConfig{}
+		fakeCatalogFetcher = &v7fakes.FakeBrokerCatalogFetcher{}
 		cmd = &v7.UpdateServiceBrokerCommand{
 			BaseCommand: v7.BaseCommand{
 				Actor:       fakeUpdateServiceBrokerActor,
@@ -49,6 +54,7 @@ var _ = Describe("update-service-broker command", func() {
 				UI:          testUI,
 				Config:      fakeConfig,
 			},
+			CatalogFetcher: fakeCatalogFetcher,
 		}
 	})
 
@@ -129,6 +135,197 @@ var _ = Describe("update-service-broker command", func() {
 			})
 		})
 
+		When("the --preview flag is provided", func() {
+			var catalog brokercatalog.Catalog
+
+			BeforeEach(func() {
+				cmd.Preview = true
+				catalog = brokercatalog.Catalog{Services: []brokercatalog.Service{{Name: "some-offering"}}}
+				fakeCatalogFetcher.FetchReturns(catalog, nil)
+				fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogDiffReturns(
+					[]v7action.ServicePlanChange{
+						{ServiceOfferingName: "some-offering", ServicePlanName: "small", Type: v7action.ServicePlanAdded},
+						{ServiceOfferingName: "some-offering", ServicePlanName: "medium", Type: v7action.ServicePlanChanged, Details: []string{"free: true -> false", `description: "a" -> "b"`}},
+						{ServiceOfferingName: "some-offering", ServicePlanName: "large", Type: v7action.ServicePlanRemoved, ServiceInstances: 3},
+					},
+					v7action.Warnings{"diff-warning"},
+					nil,
+				)
+			})
+
+			It("displays the plan changes of the catalog and updates the broker once confirmed", func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cmd.Execute(nil)).To(Succeed())
+
+				brokerURL, brokerUsername, brokerPassword := fakeCatalogFetcher.FetchArgsForCall(0)
+				Expect(brokerURL).To(Equal(url))
+				Expect(brokerUsername).To(Equal(username))
+				Expect(brokerPassword).To(Equal(password))
+
+				brokerName, diffCatalog := fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogDiffArgsForCall(0)
+				Expect(brokerName).To(Equal(serviceBrokerName))
+				Expect(diffCatalog).To(Equal(catalog))
+
+				Expect(testUI.Out).To(Say(`Getting the catalog of service broker %s from %s as user\.\.\.`, serviceBrokerName, url))
+				Expect(testUI.Out).To(Say(`offering\s+plan\s+change\s+details`))
+				Expect(testUI.Out).To(Say(`some-offering\s+small\s+added`))
+				Expect(testUI.Out).To(Say(`some-offering\s+medium\s+changed\s+free: true -> false, description: "a" -> "b"`))
+				Expect(testUI.Out).To(Say(`some-offering\s+large\s+removed\s+3 service instance\(s\)`))
+				Expect(testUI.Out).To(Say(`Really update the service broker %s\?`, serviceBrokerName))
+				Expect(testUI.Err).To(Say("diff-warning"))
+
+				Expect(fakeUpdateServiceBrokerActor.UpdateServiceBrokerCallCount()).To(Equal(1))
+			})
+
+			It("does not update the broker when the user declines", func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cmd.Execute(nil)).To(Succeed())
+
+				Expect(testUI.Out).To(Say("'%s' has not been updated.", serviceBrokerName))
+				Expect(fakeUpdateServiceBrokerActor.UpdateServiceBrokerCallCount()).To(Equal(0))
+			})
+
+			When("the catalog does not change any plans", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogDiffReturns(nil, nil, nil)
+				})
+
+				It("says so before asking for confirmation", func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(cmd.Execute(nil)).To(Succeed())
+					Expect(testUI.Out).To(Say("The catalog does not change any plans."))
+					Expect(testUI.Out).To(Say("Really update the service broker"))
+				})
+			})
+
+			When("fetching the catalog fails", func() {
+				BeforeEach(func() {
+					fakeCatalogFetcher.FetchReturns(brokercatalog.Catalog{}, errors.New("catalog unreachable"))
+				})
+
+				It("returns the error without updating the broker", func() {
+					Expect(cmd.Execute(nil)).To(MatchError("catalog unreachable"))
+					Expect(fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogDiffCallCount()).To(Equal(0))
+					Expect(fakeUpdateServiceBrokerActor.UpdateServiceBrokerCallCount()).To(Equal(0))
+				})
+			})
+
+			When("comparing the catalog fails", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogDiffReturns(nil, v7action.Warnings{"diff-warning"}, errors.New("diff failed"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(cmd.Execute(nil)).To(MatchError("diff failed"))
+					Expect(testUI.Err).To(Say("diff-warning"))
+					Expect(fakeUpdateServiceBrokerActor.UpdateServiceBrokerCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("catalog drift", func() {
+			var (
+				snapshotDir  string
+				snapshotPath string
+				newCatalog   brokercatalog.Catalog
+			)
+
+			BeforeEach(func() {
+				var err error
+				snapshotDir, err = ioutil.TempDir("", "broker-catalogs")
+				Expect(err).NotTo(HaveOccurred())
+				snapshotPath = filepath.Join(snapshotDir, guid+".json")
+				fakeConfig.BrokerCatalogSnapshotPathReturns(snapshotPath)
+
+				newCatalog = brokercatalog.Catalog{Services: []brokercatalog.Service{{
+					Name:     "some-offering",
+					Bindable: true,
+					Plans:    []brokercatalog.Plan{{Name: "small"}, {Name: "large"}},
+				}}}
+				fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogReturns(newCatalog, v7action.Warnings{"get-catalog-warning"}, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(snapshotDir)).To(Succeed())
+			})
+
+			When("a snapshot of the previous catalog was saved", func() {
+				BeforeEach(func() {
+					Expect(brokercatalog.WriteSnapshot(snapshotPath, brokercatalog.Catalog{Services: []brokercatalog.Service{{
+						Name:  "some-offering",
+						Plans: []brokercatalog.Plan{{Name: "small"}, {Name: "legacy"}},
+					}}})).To(Succeed())
+				})
+
+				It("reports the changes since the snapshot and saves the new catalog", func() {
+					Expect(cmd.Execute(nil)).To(Succeed())
+
+					Expect(fakeConfig.BrokerCatalogSnapshotPathArgsForCall(0)).To(Equal(guid))
+					Expect(fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogCallCount()).To(Equal(1))
+					Expect(fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogArgsForCall(0)).To(Equal(serviceBrokerName))
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say(`Catalog changes of service broker fake-service-broker-name:`))
+					Expect(testUI.Out).To(Say(`offering\s+plan\s+change\s+details`))
+					Expect(testUI.Out).To(Say(`some-offering\s+changed\s+bindable: false -> true`))
+					Expect(testUI.Out).To(Say(`some-offering\s+large\s+added`))
+					Expect(testUI.Out).To(Say(`some-offering\s+legacy\s+removed`))
+					Expect(testUI.Out).To(Say(`some-offering\s+small\s+changed\s+bindable: false -> true`))
+					Expect(testUI.Err).To(Say("get-catalog-warning"))
+
+					savedCatalog, found, err := brokercatalog.ReadSnapshot(snapshotPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(savedCatalog).To(Equal(newCatalog))
+				})
+			})
+
+			When("no snapshot was saved", func() {
+				It("compares with the catalog registered before the update", func() {
+					Expect(cmd.Execute(nil)).To(Succeed())
+
+					Expect(fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogCallCount()).To(Equal(2))
+					Expect(testUI.Out).To(Say(`The catalog of service broker fake-service-broker-name has not changed\.`))
+
+					_, found, err := brokercatalog.ReadSnapshot(snapshotPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+
+			When("getting the new catalog fails", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogReturnsOnCall(1, brokercatalog.Catalog{}, nil, errors.New("get-catalog-error"))
+				})
+
+				It("warns without failing the update", func() {
+					Expect(cmd.Execute(nil)).To(Succeed())
+					Expect(testUI.Err).To(Say("Could not get the catalog of service broker fake-service-broker-name: get-catalog-error"))
+
+					_, found, err := brokercatalog.ReadSnapshot(snapshotPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+
+			When("updating the broker fails", func() {
+				BeforeEach(func() {
+					fakeUpdateServiceBrokerActor.UpdateServiceBrokerReturns(nil, errors.New("update-error"))
+				})
+
+				It("does not save the catalog", func() {
+					Expect(cmd.Execute(nil)).To(MatchError("update-error"))
+					Expect(fakeUpdateServiceBrokerActor.GetServiceBrokerCatalogCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		When("password is provided as environment variable", func() {
 			const (
 				varName     = "CF_BROKER_PASSWORD"
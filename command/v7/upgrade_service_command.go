@@ -2,6 +2,7 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
@@ -11,6 +12,7 @@ type UpgradeServiceCommand struct {
 	BaseCommand
 
 	RequiredArgs flag.ServiceInstance `positional-args:"yes"`
+	CheckOnly    bool                 `long:"check-only" description:"Only show whether an upgrade is available, exiting with status 2 when it is, without upgrading"`
 	Force        bool                 `short:"f" long:"force" description:"Force upgrade without asking for confirmation"`
 	Wait         bool                 `short:"w" long:"wait" description:"Wait for the operation to complete"`
 
@@ -22,6 +24,25 @@ func (cmd UpgradeServiceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.CheckOnly && cmd.Force {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--check-only", "--force"}}
+	}
+	if cmd.CheckOnly && cmd.Wait {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--check-only", "--wait"}}
+	}
+
+	upgrade, err := cmd.displayUpgrade()
+	if err != nil {
+		return err
+	}
+
+	if cmd.CheckOnly {
+		if upgrade.Available {
+			return translatableerror.ServiceUpgradeAvailableError{ServiceInstanceName: string(cmd.RequiredArgs.ServiceInstance)}
+		}
+		return nil
+	}
+
 	if !cmd.Force {
 		upgrade, err := cmd.displayPrompt()
 		if err != nil {
@@ -72,7 +93,45 @@ func (cmd UpgradeServiceCommand) Execute(args []string) error {
 }
 
 func (cmd UpgradeServiceCommand) Usage() string {
-	return "CF_NAME upgrade-service SERVICE_INSTANCE"
+	return "CF_NAME upgrade-service SERVICE_INSTANCE [--check-only]"
+}
+
+// displayUpgrade shows the maintenance_info version of the service instance,
+// the version of its plan and the description the broker gives of it.
+func (cmd UpgradeServiceCommand) displayUpgrade() (v7action.ServiceInstanceUpgrade, error) {
+	serviceInstanceName := string(cmd.RequiredArgs.ServiceInstance)
+
+	upgrade, warnings, err := cmd.Actor.GetServiceInstanceUpgrade(serviceInstanceName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.ServiceInstanceNotFoundError:
+		return upgrade, translatableerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}
+	default:
+		return upgrade, err
+	}
+
+	if upgrade.CurrentVersion == "" && upgrade.AvailableVersion == "" {
+		return upgrade, nil
+	}
+
+	available := cmd.UI.TranslateText("no")
+	if upgrade.Available {
+		available = cmd.UI.TranslateText("yes")
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("current version:"), upgrade.CurrentVersion},
+		{cmd.UI.TranslateText("available version:"), upgrade.AvailableVersion},
+		{cmd.UI.TranslateText("upgrade available:"), available},
+	}
+	if upgrade.Available && upgrade.Description != "" {
+		table = append(table, []string{cmd.UI.TranslateText("upgrade description:"), upgrade.Description})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	return upgrade, nil
 }
 
 func (cmd UpgradeServiceCommand) displayEvent() error {
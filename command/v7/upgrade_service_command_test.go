@@ -272,6 +272,90 @@ var _ = Describe("upgrade-service command", func() {
 		testActorInteractions()
 	})
 
+	When("an upgrade is available", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceUpgradeReturns(v7action.ServiceInstanceUpgrade{
+				CurrentVersion:   "1.0.0",
+				AvailableVersion: "1.1.0",
+				Description:      "Adds TLS support",
+				Available:        true,
+			}, v7action.Warnings{"preview warning"}, nil)
+		})
+
+		It("shows the versions and the description before prompting", func() {
+			actualName, actualSpaceGUID := fakeActor.GetServiceInstanceUpgradeArgsForCall(0)
+			Expect(actualName).To(Equal(serviceInstanceName))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`current version:\s+1\.0\.0`),
+				Say(`available version:\s+1\.1\.0`),
+				Say(`upgrade available:\s+yes`),
+				Say(`upgrade description:\s+Adds TLS support`),
+				Say(`Do you really want to upgrade the service instance`),
+			))
+			Expect(testUI.Err).To(Say("preview warning"))
+		})
+
+		When("--check-only is passed", func() {
+			BeforeEach(func() {
+				setFlag(&cmd, "--check-only")
+			})
+
+			It("returns an upgrade available error without upgrading", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceUpgradeAvailableError{ServiceInstanceName: serviceInstanceName}))
+				Expect(testUI.Out).To(Say(`upgrade available:\s+yes`))
+				Expect(testUI.Out).NotTo(Say("Do you really want"))
+				Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	When("--check-only is passed and the instance is up to date", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--check-only")
+			fakeActor.GetServiceInstanceUpgradeReturns(v7action.ServiceInstanceUpgrade{
+				CurrentVersion:   "1.1.0",
+				AvailableVersion: "1.1.0",
+				Description:      "Adds TLS support",
+			}, nil, nil)
+		})
+
+		It("succeeds without upgrading", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`upgrade available:\s+no`))
+			Expect(testUI.Out).NotTo(Say("upgrade description"))
+			Expect(fakeActor.UpgradeManagedServiceInstanceCallCount()).To(BeZero())
+		})
+	})
+
+	When("--check-only is passed with --force", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--check-only")
+			setFlag(&cmd, "-f")
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--check-only", "--force"}}))
+		})
+	})
+
+	When("getting the upgrade fails because the instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceUpgradeReturns(
+				v7action.ServiceInstanceUpgrade{},
+				v7action.Warnings{"preview warning"},
+				actionerror.ServiceInstanceNotFoundError{Name: serviceInstanceName},
+			)
+		})
+
+		It("returns a translatable error without prompting", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: serviceInstanceName}))
+			Expect(testUI.Err).To(Say("preview warning"))
+			Expect(testUI.Out).NotTo(Say("Do you really want"))
+		})
+	})
+
 	When("checking the target returns an error", func() {
 		It("returns the error", func() {
 			fakeSharedActor.CheckTargetReturns(errors.New("explode"))
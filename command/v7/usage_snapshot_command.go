@@ -0,0 +1,121 @@
+package v7
+
+import (
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type UsageSnapshotCommand struct {
+	BaseCommand
+
+	Organization    string            `short:"o" description:"Org to report on (Default: targeted org)"`
+	From            flag.Date         `long:"from" required:"true" description:"Start of the period, as a day (YYYY-MM-DD, from midnight UTC) or an RFC 3339 timestamp"`
+	To              flag.Date         `long:"to" description:"End of the period, which is not included, in the same form as --from (Default: now)"`
+	Output          flag.OutputFormat `long:"output" choice:"text" choice:"csv" default:"text" description:"Output format; csv prints one row per space"`
+	usage           interface{}       `usage:"CF_NAME usage-snapshot [-o ORG] --from DATE [--to DATE] [--output csv]\n\n   Memory is reported in GB-hours: the memory of each running app instance or task multiplied by the hours it ran.\n   It is worked out from the app usage events the Cloud Controller keeps, which requires admin or global auditor\n   access; apps started before the oldest kept event are only counted from their first event. Service instances\n   are counted as they are now.\n\nEXAMPLES:\n   CF_NAME usage-snapshot -o my-org --from 2024-01-01 --to 2024-02-01 --output csv > january.csv"`
+	relatedCommands interface{}       `related_commands:"org, org-quotas, spaces"`
+}
+
+func (cmd UsageSnapshotCommand) Execute(args []string) error {
+	to := cmd.To.Value
+	if !cmd.To.IsSet {
+		to = time.Now()
+	}
+	if !to.After(cmd.From.Value) {
+		return translatableerror.IncorrectUsageError{Message: "--to must be later than --from"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Organization == "", false)
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		orgName = org.Name
+		orgGUID = org.GUID
+	}
+
+	if cmd.Output != flag.OutputFormatCSV {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting usage of org {{.OrgName}} from {{.From}} to {{.To}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"From":     cmd.From.Value.UTC().Format(time.RFC3339),
+			"To":       to.UTC().Format(time.RFC3339),
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	usages, warnings, err := cmd.Actor.GetOrganizationUsageSnapshot(orgGUID, cmd.From.Value, to)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == flag.OutputFormatCSV {
+		return cmd.displayCSV(orgName, usages)
+	}
+
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No spaces found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app memory (GB-hours)"),
+		cmd.UI.TranslateText("task memory (GB-hours)"),
+		cmd.UI.TranslateText("tasks"),
+		cmd.UI.TranslateText("service instances"),
+	}}
+	for _, usage := range usages {
+		table = append(table, []string{
+			usage.SpaceName,
+			formatGBHours(usage.AppMemoryGBHours),
+			formatGBHours(usage.TaskMemoryGBHours),
+			strconv.Itoa(usage.TaskCount),
+			strconv.Itoa(usage.ServiceInstanceCount),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd UsageSnapshotCommand) displayCSV(orgName string, usages []v7action.SpaceUsage) error {
+	writer := csv.NewWriter(cmd.UI.Writer())
+	rows := [][]string{{"org", "space", "space_guid", "app_memory_gb_hours", "task_memory_gb_hours", "tasks", "service_instances"}}
+	for _, usage := range usages {
+		rows = append(rows, []string{
+			orgName,
+			usage.SpaceName,
+			usage.SpaceGUID,
+			formatGBHours(usage.AppMemoryGBHours),
+			formatGBHours(usage.TaskMemoryGBHours),
+			strconv.Itoa(usage.TaskCount),
+			strconv.Itoa(usage.ServiceInstanceCount),
+		})
+	}
+	return writer.WriteAll(rows)
+}
+
+func formatGBHours(gbHours float64) string {
+	return strconv.FormatFloat(gbHours, 'f', 2, 64)
+}
@@ -0,0 +1,184 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage-snapshot command", func() {
+	var (
+		cmd             UsageSnapshotCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		from            time.Time
+		to              time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		cmd = UsageSnapshotCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			From:   flag.Date{Value: from, IsSet: true},
+			To:     flag.Date{Value: to, IsSet: true},
+			Output: flag.OutputFormatText,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "targeted-org-guid", Name: "targeted-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetOrganizationUsageSnapshotReturns(
+			[]v7action.SpaceUsage{
+				{SpaceGUID: "space-1-guid", SpaceName: "dev", AppMemoryGBHours: 24, TaskMemoryGBHours: 1.255, TaskCount: 3, ServiceInstanceCount: 2},
+				{SpaceGUID: "space-2-guid", SpaceName: "prod"},
+			},
+			v7action.Warnings{"usage-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks that an org is targeted", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeTrue())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("displays the usage of each space of the targeted org", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetOrganizationUsageSnapshotCallCount()).To(Equal(1))
+		orgGUID, actualFrom, actualTo := fakeActor.GetOrganizationUsageSnapshotArgsForCall(0)
+		Expect(orgGUID).To(Equal("targeted-org-guid"))
+		Expect(actualFrom).To(Equal(from))
+		Expect(actualTo).To(Equal(to))
+
+		Expect(testUI.Out).To(Say(`Getting usage of org targeted-org from 2024-01-01T00:00:00Z to 2024-02-01T00:00:00Z as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`space\s+app memory \(GB-hours\)\s+task memory \(GB-hours\)\s+tasks\s+service instances`))
+		Expect(testUI.Out).To(Say(`dev\s+24\.00\s+1\.25\s+3\s+2`))
+		Expect(testUI.Out).To(Say(`prod\s+0\.00\s+0\.00\s+0\s+0`))
+		Expect(testUI.Err).To(Say("usage-warning"))
+	})
+
+	When("an org is given", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "other-org-guid", Name: "other-org"}, v7action.Warnings{"org-warning"}, nil)
+		})
+
+		It("reports on that org without requiring a targeted org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			checkOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			orgGUID, _, _ := fakeActor.GetOrganizationUsageSnapshotArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+			Expect(testUI.Err).To(Say("org-warning"))
+		})
+
+		When("the org cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, errors.New("org-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("org-error"))
+				Expect(fakeActor.GetOrganizationUsageSnapshotCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--to is not given", func() {
+		BeforeEach(func() {
+			cmd.To = flag.Date{}
+		})
+
+		It("reports until now", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			_, _, actualTo := fakeActor.GetOrganizationUsageSnapshotArgsForCall(0)
+			Expect(actualTo).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+	})
+
+	When("--to is not later than --from", func() {
+		BeforeEach(func() {
+			cmd.To = flag.Date{Value: from, IsSet: true}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--to must be later than --from"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output is csv", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormatCSV
+		})
+
+		It("only prints the csv rows", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"org,space,space_guid,app_memory_gb_hours,task_memory_gb_hours,tasks,service_instances\n" +
+					"targeted-org,dev,space-1-guid,24.00,1.25,3,2\n" +
+					"targeted-org,prod,space-2-guid,0.00,0.00,0,0\n",
+			))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+
+	When("there are no spaces", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationUsageSnapshotReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No spaces found."))
+		})
+	})
+
+	When("getting the usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationUsageSnapshotReturns(nil, v7action.Warnings{"usage-warning"}, errors.New("usage-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("usage-error"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -17,6 +18,7 @@ import (
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"github.com/SermoDigital/jose/jwt"
 )
@@ -78,6 +80,17 @@ type FakeActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithDeviceAuthorizationStub        func(v7action.DeviceAuthorization) error
+	authenticateWithDeviceAuthorizationMutex       sync.RWMutex
+	authenticateWithDeviceAuthorizationArgsForCall []struct {
+		arg1 v7action.DeviceAuthorization
+	}
+	authenticateWithDeviceAuthorizationReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BindSecurityGroupToSpacesStub        func(string, []resources.Space, constanta.SecurityGroupLifecycle) (v7action.Warnings, error)
 	bindSecurityGroupToSpacesMutex       sync.RWMutex
 	bindSecurityGroupToSpacesArgsForCall []struct {
@@ -124,10 +137,52 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CheckRoutesAvailabilityStub        func([]string, string) ([]v7action.RouteProblem, v7action.Warnings, error)
+	checkRoutesAvailabilityMutex       sync.RWMutex
+	checkRoutesAvailabilityArgsForCall []struct {
+		arg1 []string
+		arg2 string
+	}
+	checkRoutesAvailabilityReturns struct {
+		result1 []v7action.RouteProblem
+		result2 v7action.Warnings
+		result3 error
+	}
+	checkRoutesAvailabilityReturnsOnCall map[int]struct {
+		result1 []v7action.RouteProblem
+		result2 v7action.Warnings
+		result3 error
+	}
+	ClearApplicationBuildCacheStub        func(string) (v7action.Warnings, error)
+	clearApplicationBuildCacheMutex       sync.RWMutex
+	clearApplicationBuildCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearApplicationBuildCacheReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	clearApplicationBuildCacheReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	ClearTargetStub        func()
 	clearTargetMutex       sync.RWMutex
 	clearTargetArgsForCall []struct {
 	}
+	ContinueDeploymentStub        func(string) (v7action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CopyPackageStub        func(resources.Application, resources.Application) (resources.Package, v7action.Warnings, error)
 	copyPackageMutex       sync.RWMutex
 	copyPackageArgsForCall []struct {
@@ -222,34 +277,17 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	CreateDeploymentByApplicationAndDropletStub        func(string, string) (string, v7action.Warnings, error)
-	createDeploymentByApplicationAndDropletMutex       sync.RWMutex
-	createDeploymentByApplicationAndDropletArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createDeploymentByApplicationAndDropletReturns struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}
-	createDeploymentByApplicationAndDropletReturnsOnCall map[int]struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}
-	CreateDeploymentByApplicationAndRevisionStub        func(string, string) (string, v7action.Warnings, error)
-	createDeploymentByApplicationAndRevisionMutex       sync.RWMutex
-	createDeploymentByApplicationAndRevisionArgsForCall []struct {
-		arg1 string
-		arg2 string
+	CreateDeploymentStub        func(resources.Deployment) (string, v7action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		arg1 resources.Deployment
 	}
-	createDeploymentByApplicationAndRevisionReturns struct {
+	createDeploymentReturns struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}
-	createDeploymentByApplicationAndRevisionReturnsOnCall map[int]struct {
+	createDeploymentReturnsOnCall map[int]struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -732,6 +770,19 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DeleteRouteByGUIDStub        func(string) (v7action.Warnings, error)
+	deleteRouteByGUIDMutex       sync.RWMutex
+	deleteRouteByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	deleteRouteByGUIDReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteRouteByGUIDReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteSecurityGroupStub        func(string) (v7action.Warnings, error)
 	deleteSecurityGroupMutex       sync.RWMutex
 	deleteSecurityGroupArgsForCall []struct {
@@ -929,6 +980,25 @@ type FakeActor struct {
 		result3 v7action.Warnings
 		result4 error
 	}
+	DownloadCurrentDropletSBOMsByAppNameStub        func(string, string, v7action.SBOMFormat) ([]v7action.SBOM, string, v7action.Warnings, error)
+	downloadCurrentDropletSBOMsByAppNameMutex       sync.RWMutex
+	downloadCurrentDropletSBOMsByAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.SBOMFormat
+	}
+	downloadCurrentDropletSBOMsByAppNameReturns struct {
+		result1 []v7action.SBOM
+		result2 string
+		result3 v7action.Warnings
+		result4 error
+	}
+	downloadCurrentDropletSBOMsByAppNameReturnsOnCall map[int]struct {
+		result1 []v7action.SBOM
+		result2 string
+		result3 v7action.Warnings
+		result4 error
+	}
 	DownloadDropletByGUIDAndAppNameStub        func(string, string, string) ([]byte, v7action.Warnings, error)
 	downloadDropletByGUIDAndAppNameMutex       sync.RWMutex
 	downloadDropletByGUIDAndAppNameArgsForCall []struct {
@@ -946,6 +1016,39 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DownloadDropletSBOMsByGUIDAndAppNameStub        func(string, string, string, v7action.SBOMFormat) ([]v7action.SBOM, v7action.Warnings, error)
+	downloadDropletSBOMsByGUIDAndAppNameMutex       sync.RWMutex
+	downloadDropletSBOMsByGUIDAndAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 v7action.SBOMFormat
+	}
+	downloadDropletSBOMsByGUIDAndAppNameReturns struct {
+		result1 []v7action.SBOM
+		result2 v7action.Warnings
+		result3 error
+	}
+	downloadDropletSBOMsByGUIDAndAppNameReturnsOnCall map[int]struct {
+		result1 []v7action.SBOM
+		result2 v7action.Warnings
+		result3 error
+	}
+	DrainRouteDestinationStub        func(resources.Route, string, time.Duration) (v7action.Warnings, error)
+	drainRouteDestinationMutex       sync.RWMutex
+	drainRouteDestinationArgsForCall []struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 time.Duration
+	}
+	drainRouteDestinationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	drainRouteDestinationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	EnableFeatureFlagStub        func(string) (v7action.Warnings, error)
 	enableFeatureFlagMutex       sync.RWMutex
 	enableFeatureFlagArgsForCall []struct {
@@ -991,6 +1094,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	GetAPIResourcesStub        func() ([]v7action.APIResource, v7action.Warnings, error)
+	getAPIResourcesMutex       sync.RWMutex
+	getAPIResourcesArgsForCall []struct {
+	}
+	getAPIResourcesReturns struct {
+		result1 []v7action.APIResource
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAPIResourcesReturnsOnCall map[int]struct {
+		result1 []v7action.APIResource
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetAppFeatureStub        func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)
 	getAppFeatureMutex       sync.RWMutex
 	getAppFeatureArgsForCall []struct {
@@ -1007,12 +1124,29 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetAppSummariesForSpaceStub        func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
+	GetAppSecurityReportStub        func(string, string) (v7action.AppSecurityReport, v7action.Warnings, error)
+	getAppSecurityReportMutex       sync.RWMutex
+	getAppSecurityReportArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getAppSecurityReportReturns struct {
+		result1 v7action.AppSecurityReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppSecurityReportReturnsOnCall map[int]struct {
+		result1 v7action.AppSecurityReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetAppSummariesForSpaceStub        func(string, string, bool, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	getAppSummariesForSpaceMutex       sync.RWMutex
 	getAppSummariesForSpaceArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 bool
+		arg4 bool
 	}
 	getAppSummariesForSpaceReturns struct {
 		result1 []v7action.ApplicationSummary
@@ -1024,6 +1158,38 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppUsagesForSpaceStub        func(string, sharedaction.LogCacheClient, time.Time) ([]v7action.AppUsage, v7action.Warnings, error)
+	getAppUsagesForSpaceMutex       sync.RWMutex
+	getAppUsagesForSpaceArgsForCall []struct {
+		arg1 string
+		arg2 sharedaction.LogCacheClient
+		arg3 time.Time
+	}
+	getAppUsagesForSpaceReturns struct {
+		result1 []v7action.AppUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppUsagesForSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.AppUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationByGUIDStub        func(string) (resources.Application, v7action.Warnings, error)
+	getApplicationByGUIDMutex       sync.RWMutex
+	getApplicationByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationByGUIDReturns struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationByGUIDReturnsOnCall map[int]struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1119,6 +1285,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationProcessSummariesByNameAndSpaceStub        func(string, string) (v7action.ProcessSummaries, v7action.Warnings, error)
+	getApplicationProcessSummariesByNameAndSpaceMutex       sync.RWMutex
+	getApplicationProcessSummariesByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationProcessSummariesByNameAndSpaceReturns struct {
+		result1 v7action.ProcessSummaries
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationProcessSummariesByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.ProcessSummaries
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationRevisionsDeployedStub        func(string) ([]resources.Revision, v7action.Warnings, error)
 	getApplicationRevisionsDeployedMutex       sync.RWMutex
 	getApplicationRevisionsDeployedArgsForCall []struct {
@@ -1212,6 +1394,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetCurrentDropletByApplicationStub        func(string) (resources.Droplet, v7action.Warnings, error)
+	getCurrentDropletByApplicationMutex       sync.RWMutex
+	getCurrentDropletByApplicationArgsForCall []struct {
+		arg1 string
+	}
+	getCurrentDropletByApplicationReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentDropletByApplicationReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetCurrentUserStub        func() (configv3.User, error)
 	getCurrentUserMutex       sync.RWMutex
 	getCurrentUserArgsForCall []struct {
@@ -1239,6 +1436,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDeploymentForAppStub        func(string, string) (resources.Deployment, v7action.Warnings, error)
+	getDeploymentForAppMutex       sync.RWMutex
+	getDeploymentForAppArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getDeploymentForAppReturns struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentForAppReturnsOnCall map[int]struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDetailedAppSummaryStub        func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	getDetailedAppSummaryMutex       sync.RWMutex
 	getDetailedAppSummaryArgsForCall []struct {
@@ -1256,6 +1469,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDetailedAppSummaryForAppStub        func(resources.Application, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
+	getDetailedAppSummaryForAppMutex       sync.RWMutex
+	getDetailedAppSummaryForAppArgsForCall []struct {
+		arg1 resources.Application
+		arg2 bool
+	}
+	getDetailedAppSummaryForAppReturns struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDetailedAppSummaryForAppReturnsOnCall map[int]struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDomainStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainMutex       sync.RWMutex
 	getDomainArgsForCall []struct {
@@ -1301,6 +1530,54 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDrainByNameAndSpaceStub        func(string, string) (v7action.Drain, v7action.Warnings, error)
+	getDrainByNameAndSpaceMutex       sync.RWMutex
+	getDrainByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getDrainByNameAndSpaceReturns struct {
+		result1 v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDrainByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDrainsForSpaceStub        func(string) ([]v7action.Drain, v7action.Warnings, error)
+	getDrainsForSpaceMutex       sync.RWMutex
+	getDrainsForSpaceArgsForCall []struct {
+		arg1 string
+	}
+	getDrainsForSpaceReturns struct {
+		result1 []v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDrainsForSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDumpRecipesForAppStub        func(string, []v7action.DumpRecipe, []string) ([]v7action.DumpRecipe, v7action.Warnings, error)
+	getDumpRecipesForAppMutex       sync.RWMutex
+	getDumpRecipesForAppArgsForCall []struct {
+		arg1 string
+		arg2 []v7action.DumpRecipe
+		arg3 []string
+	}
+	getDumpRecipesForAppReturns struct {
+		result1 []v7action.DumpRecipe
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDumpRecipesForAppReturnsOnCall map[int]struct {
+		result1 []v7action.DumpRecipe
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetEffectiveIsolationSegmentBySpaceStub        func(string, string) (resources.IsolationSegment, v7action.Warnings, error)
 	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
 	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
@@ -1348,6 +1625,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEventsStub        func(v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v7action.EventQuery
+	}
+	getEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetFeatureFlagByNameStub        func(string) (resources.FeatureFlag, v7action.Warnings, error)
 	getFeatureFlagByNameMutex       sync.RWMutex
 	getFeatureFlagByNameArgsForCall []struct {
@@ -1377,6 +1669,46 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetFilteredRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	getFilteredRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetFilteredStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getFilteredStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
 	GetGlobalRunningSecurityGroupsStub        func() ([]resources.SecurityGroup, v7action.Warnings, error)
 	getGlobalRunningSecurityGroupsMutex       sync.RWMutex
 	getGlobalRunningSecurityGroupsArgsForCall []struct {
@@ -1405,6 +1737,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetInternalRoutesBySpaceStub        func(string, string) ([]v7action.InternalRoute, v7action.Warnings, error)
+	getInternalRoutesBySpaceMutex       sync.RWMutex
+	getInternalRoutesBySpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getInternalRoutesBySpaceReturns struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInternalRoutesBySpaceReturnsOnCall map[int]struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetIsolationSegmentByNameStub        func(string) (resources.IsolationSegment, v7action.Warnings, error)
 	getIsolationSegmentByNameMutex       sync.RWMutex
 	getIsolationSegmentByNameArgsForCall []struct {
@@ -1449,6 +1797,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetJobStub        func(string) (v7action.Job, v7action.Warnings, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		arg1 string
+	}
+	getJobReturns struct {
+		result1 v7action.Job
+		result2 v7action.Warnings
+		result3 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 v7action.Job
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetLatestActiveDeploymentForAppStub        func(string) (resources.Deployment, v7action.Warnings, error)
 	getLatestActiveDeploymentForAppMutex       sync.RWMutex
 	getLatestActiveDeploymentForAppArgsForCall []struct {
@@ -1464,6 +1827,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetLatestDeploymentForAppStub        func(string) (resources.Deployment, v7action.Warnings, error)
+	getLatestDeploymentForAppMutex       sync.RWMutex
+	getLatestDeploymentForAppArgsForCall []struct {
+		arg1 string
+	}
+	getLatestDeploymentForAppReturns struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getLatestDeploymentForAppReturnsOnCall map[int]struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetLoginPromptsStub        func() (map[string]coreconfig.AuthPrompt, error)
 	getLoginPromptsMutex       sync.RWMutex
 	getLoginPromptsArgsForCall []struct {
@@ -1506,6 +1884,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationByGUIDStub        func(string) (resources.Organization, v7action.Warnings, error)
+	getOrganizationByGUIDMutex       sync.RWMutex
+	getOrganizationByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationByGUIDReturns struct {
+		result1 resources.Organization
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationByGUIDReturnsOnCall map[int]struct {
+		result1 resources.Organization
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationByNameStub        func(string) (resources.Organization, v7action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -1596,6 +1989,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationSpacesWithDetailsStub        func(string, string) ([]v7action.SpaceDetails, v7action.Warnings, error)
+	getOrganizationSpacesWithDetailsMutex       sync.RWMutex
+	getOrganizationSpacesWithDetailsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getOrganizationSpacesWithDetailsReturns struct {
+		result1 []v7action.SpaceDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesWithDetailsReturnsOnCall map[int]struct {
+		result1 []v7action.SpaceDetails
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationSpacesWithLabelSelectorStub        func(string, string) ([]resources.Space, v7action.Warnings, error)
 	getOrganizationSpacesWithLabelSelectorMutex       sync.RWMutex
 	getOrganizationSpacesWithLabelSelectorArgsForCall []struct {
@@ -1627,6 +2036,38 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationSummaryForOrgStub        func(resources.Organization) (v7action.OrganizationSummary, v7action.Warnings, error)
+	getOrganizationSummaryForOrgMutex       sync.RWMutex
+	getOrganizationSummaryForOrgArgsForCall []struct {
+		arg1 resources.Organization
+	}
+	getOrganizationSummaryForOrgReturns struct {
+		result1 v7action.OrganizationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationSummaryForOrgReturnsOnCall map[int]struct {
+		result1 v7action.OrganizationSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetOrganizationUsageSnapshotStub        func(string, time.Time, time.Time) ([]v7action.SpaceUsage, v7action.Warnings, error)
+	getOrganizationUsageSnapshotMutex       sync.RWMutex
+	getOrganizationUsageSnapshotArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}
+	getOrganizationUsageSnapshotReturns struct {
+		result1 []v7action.SpaceUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationUsageSnapshotReturnsOnCall map[int]struct {
+		result1 []v7action.SpaceUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(string) ([]resources.Organization, v7action.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -1642,6 +2083,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationsWithDetailsStub        func(string) ([]v7action.OrganizationDetails, v7action.Warnings, error)
+	getOrganizationsWithDetailsMutex       sync.RWMutex
+	getOrganizationsWithDetailsArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationsWithDetailsReturns struct {
+		result1 []v7action.OrganizationDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationsWithDetailsReturnsOnCall map[int]struct {
+		result1 []v7action.OrganizationDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetOrphanedRoutesStub        func([]resources.Route, time.Duration) ([]resources.Route, v7action.Warnings, error)
+	getOrphanedRoutesMutex       sync.RWMutex
+	getOrphanedRoutesArgsForCall []struct {
+		arg1 []resources.Route
+		arg2 time.Duration
+	}
+	getOrphanedRoutesReturns struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrphanedRoutesReturnsOnCall map[int]struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetProcessByTypeAndApplicationStub        func(string, string) (resources.Process, v7action.Warnings, error)
 	getProcessByTypeAndApplicationMutex       sync.RWMutex
 	getProcessByTypeAndApplicationArgsForCall []struct {
@@ -1674,6 +2146,55 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRawApplicationsManifestByNamesAndSpaceStub        func([]string, string) ([]byte, v7action.Warnings, error)
+	getRawApplicationsManifestByNamesAndSpaceMutex       sync.RWMutex
+	getRawApplicationsManifestByNamesAndSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+	}
+	getRawApplicationsManifestByNamesAndSpaceReturns struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRawApplicationsManifestByNamesAndSpaceReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRawSpaceManifestStub        func(string, bool, bool) ([]byte, v7action.Warnings, error)
+	getRawSpaceManifestMutex       sync.RWMutex
+	getRawSpaceManifestArgsForCall []struct {
+		arg1 string
+		arg2 bool
+		arg3 bool
+	}
+	getRawSpaceManifestReturns struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRawSpaceManifestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetReadyPackageForApplicationStub        func(resources.Application, string) (resources.Package, v7action.Warnings, error)
+	getReadyPackageForApplicationMutex       sync.RWMutex
+	getReadyPackageForApplicationArgsForCall []struct {
+		arg1 resources.Application
+		arg2 string
+	}
+	getReadyPackageForApplicationReturns struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
+	getReadyPackageForApplicationReturnsOnCall map[int]struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRecentEventsByApplicationNameAndSpaceStub        func(string, string) ([]v7action.Event, v7action.Warnings, error)
 	getRecentEventsByApplicationNameAndSpaceMutex       sync.RWMutex
 	getRecentEventsByApplicationNameAndSpaceArgsForCall []struct {
@@ -1690,20 +2211,34 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
-	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
-	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+	GetRecentEventsByOrganizationNameStub        func(string) ([]v7action.Event, v7action.Warnings, error)
+	getRecentEventsByOrganizationNameMutex       sync.RWMutex
+	getRecentEventsByOrganizationNameArgsForCall []struct {
+		arg1 string
+	}
+	getRecentEventsByOrganizationNameReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRecentEventsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRecentEventsBySpaceNameAndOrganizationStub        func(string, string) ([]v7action.Event, v7action.Warnings, error)
+	getRecentEventsBySpaceNameAndOrganizationMutex       sync.RWMutex
+	getRecentEventsBySpaceNameAndOrganizationArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 sharedaction.LogCacheClient
 	}
-	getRecentLogsForApplicationByNameAndSpaceReturns struct {
-		result1 []sharedaction.LogMessage
+	getRecentEventsBySpaceNameAndOrganizationReturns struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}
-	getRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
-		result1 []sharedaction.LogMessage
+	getRecentEventsBySpaceNameAndOrganizationReturnsOnCall map[int]struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}
@@ -1739,6 +2274,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRolloutStatusStub        func(resources.Deployment) (v7action.RolloutStatus, v7action.Warnings, error)
+	getRolloutStatusMutex       sync.RWMutex
+	getRolloutStatusArgsForCall []struct {
+		arg1 resources.Deployment
+	}
+	getRolloutStatusReturns struct {
+		result1 v7action.RolloutStatus
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRolloutStatusReturnsOnCall map[int]struct {
+		result1 v7action.RolloutStatus
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRootResponseStub        func() (v7action.Info, v7action.Warnings, error)
 	getRootResponseMutex       sync.RWMutex
 	getRootResponseArgsForCall []struct {
@@ -1771,6 +2321,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteCollisionStub        func(resources.Domain, string, string, int) (actionerror.RouteInDifferentSpaceError, v7action.Warnings, error)
+	getRouteCollisionMutex       sync.RWMutex
+	getRouteCollisionArgsForCall []struct {
+		arg1 resources.Domain
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	getRouteCollisionReturns struct {
+		result1 actionerror.RouteInDifferentSpaceError
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteCollisionReturnsOnCall map[int]struct {
+		result1 actionerror.RouteInDifferentSpaceError
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteDestinationByAppGUIDStub        func(resources.Route, string) (resources.RouteDestination, error)
 	getRouteDestinationByAppGUIDMutex       sync.RWMutex
 	getRouteDestinationByAppGUIDArgsForCall []struct {
@@ -1785,6 +2353,21 @@ type FakeActor struct {
 		result1 resources.RouteDestination
 		result2 error
 	}
+	GetRouteHistoryStub        func(string) ([]v7action.RouteHistoryEntry, v7action.Warnings, error)
+	getRouteHistoryMutex       sync.RWMutex
+	getRouteHistoryArgsForCall []struct {
+		arg1 string
+	}
+	getRouteHistoryReturns struct {
+		result1 []v7action.RouteHistoryEntry
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteHistoryReturnsOnCall map[int]struct {
+		result1 []v7action.RouteHistoryEntry
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getRouteLabelsMutex       sync.RWMutex
 	getRouteLabelsArgsForCall []struct {
@@ -1828,6 +2411,21 @@ type FakeActor struct {
 		result1 []v7action.RouterGroup
 		result2 error
 	}
+	GetRoutesByDomainStub        func(string) ([]resources.Route, v7action.Warnings, error)
+	getRoutesByDomainMutex       sync.RWMutex
+	getRoutesByDomainArgsForCall []struct {
+		arg1 string
+	}
+	getRoutesByDomainReturns struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRoutesByDomainReturnsOnCall map[int]struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRoutesByOrgStub        func(string, string) ([]resources.Route, v7action.Warnings, error)
 	getRoutesByOrgMutex       sync.RWMutex
 	getRoutesByOrgArgsForCall []struct {
@@ -1903,6 +2501,21 @@ type FakeActor struct {
 		result1 string
 		result2 error
 	}
+	GetSSHStatusesForSpacesStub        func([]resources.Space) ([]v7action.AppSSHStatus, v7action.Warnings, error)
+	getSSHStatusesForSpacesMutex       sync.RWMutex
+	getSSHStatusesForSpacesArgsForCall []struct {
+		arg1 []resources.Space
+	}
+	getSSHStatusesForSpacesReturns struct {
+		result1 []v7action.AppSSHStatus
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSSHStatusesForSpacesReturnsOnCall map[int]struct {
+		result1 []v7action.AppSSHStatus
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
@@ -1997,6 +2610,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceBrokerCatalogStub        func(string) (brokercatalog.Catalog, v7action.Warnings, error)
+	getServiceBrokerCatalogMutex       sync.RWMutex
+	getServiceBrokerCatalogArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBrokerCatalogReturns struct {
+		result1 brokercatalog.Catalog
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 brokercatalog.Catalog
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceBrokerCatalogDiffStub        func(string, brokercatalog.Catalog) ([]v7action.ServicePlanChange, v7action.Warnings, error)
+	getServiceBrokerCatalogDiffMutex       sync.RWMutex
+	getServiceBrokerCatalogDiffArgsForCall []struct {
+		arg1 string
+		arg2 brokercatalog.Catalog
+	}
+	getServiceBrokerCatalogDiffReturns struct {
+		result1 []v7action.ServicePlanChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerCatalogDiffReturnsOnCall map[int]struct {
+		result1 []v7action.ServicePlanChange
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerLabelsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
 	getServiceBrokerLabelsMutex       sync.RWMutex
 	getServiceBrokerLabelsArgsForCall []struct {
@@ -2026,6 +2670,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceDriftStub        func(string, []v7action.DeclaredServiceInstance) (v7action.ServiceDrift, v7action.Warnings, error)
+	getServiceDriftMutex       sync.RWMutex
+	getServiceDriftArgsForCall []struct {
+		arg1 string
+		arg2 []v7action.DeclaredServiceInstance
+	}
+	getServiceDriftReturns struct {
+		result1 v7action.ServiceDrift
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceDriftReturnsOnCall map[int]struct {
+		result1 v7action.ServiceDrift
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstanceByGUIDStub        func(string) (resources.ServiceInstance, v7action.Warnings, error)
+	getServiceInstanceByGUIDMutex       sync.RWMutex
+	getServiceInstanceByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceByGUIDReturns struct {
+		result1 resources.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceByGUIDReturnsOnCall map[int]struct {
+		result1 resources.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstanceByNameAndSpaceStub        func(string, string) (resources.ServiceInstance, v7action.Warnings, error)
 	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
 	getServiceInstanceByNameAndSpaceArgsForCall []struct {
@@ -2059,6 +2734,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceInstanceDetailsByGUIDStub        func(string, string, bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
+	getServiceInstanceDetailsByGUIDMutex       sync.RWMutex
+	getServiceInstanceDetailsByGUIDArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	getServiceInstanceDetailsByGUIDReturns struct {
+		result1 v7action.ServiceInstanceDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceDetailsByGUIDReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstanceDetails
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstanceLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getServiceInstanceLabelsMutex       sync.RWMutex
 	getServiceInstanceLabelsArgsForCall []struct {
@@ -2091,11 +2783,43 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetServiceInstancesForSpaceStub        func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
+	GetServiceInstanceParametersByGUIDStub        func(string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
+	getServiceInstanceParametersByGUIDMutex       sync.RWMutex
+	getServiceInstanceParametersByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceParametersByGUIDReturns struct {
+		result1 v7action.ServiceInstanceParameters
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceParametersByGUIDReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstanceParameters
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstanceUpgradeStub        func(string, string) (v7action.ServiceInstanceUpgrade, v7action.Warnings, error)
+	getServiceInstanceUpgradeMutex       sync.RWMutex
+	getServiceInstanceUpgradeArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceUpgradeReturns struct {
+		result1 v7action.ServiceInstanceUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceUpgradeReturnsOnCall map[int]struct {
+		result1 v7action.ServiceInstanceUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstancesForSpaceStub        func(string, bool, string) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	getServiceInstancesForSpaceMutex       sync.RWMutex
 	getServiceInstancesForSpaceArgsForCall []struct {
 		arg1 string
 		arg2 bool
+		arg3 string
 	}
 	getServiceInstancesForSpaceReturns struct {
 		result1 []v7action.ServiceInstance
@@ -2207,6 +2931,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSpaceByGUIDStub        func(string) (resources.Space, v7action.Warnings, error)
+	getSpaceByGUIDMutex       sync.RWMutex
+	getSpaceByGUIDArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceByGUIDReturns struct {
+		result1 resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceByGUIDReturnsOnCall map[int]struct {
+		result1 resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -2303,6 +3042,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSpaceSummaryForSpaceStub        func(resources.Space, resources.Organization) (v7action.SpaceSummary, v7action.Warnings, error)
+	getSpaceSummaryForSpaceMutex       sync.RWMutex
+	getSpaceSummaryForSpaceArgsForCall []struct {
+		arg1 resources.Space
+		arg2 resources.Organization
+	}
+	getSpaceSummaryForSpaceReturns struct {
+		result1 v7action.SpaceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceSummaryForSpaceReturnsOnCall map[int]struct {
+		result1 v7action.SpaceSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceUsersByRoleTypeStub        func(string) (map[constanta.RoleType][]resources.User, v7action.Warnings, error)
 	getSpaceUsersByRoleTypeMutex       sync.RWMutex
 	getSpaceUsersByRoleTypeArgsForCall []struct {
@@ -2441,14 +3196,30 @@ type FakeActor struct {
 		result1 resources.User
 		result2 error
 	}
-	MakeCurlRequestStub        func(string, string, []string, string, bool) ([]byte, *http.Response, error)
+	LookupGUIDStub        func(string) ([]v7action.GUIDLookupResult, v7action.Warnings, error)
+	lookupGUIDMutex       sync.RWMutex
+	lookupGUIDArgsForCall []struct {
+		arg1 string
+	}
+	lookupGUIDReturns struct {
+		result1 []v7action.GUIDLookupResult
+		result2 v7action.Warnings
+		result3 error
+	}
+	lookupGUIDReturnsOnCall map[int]struct {
+		result1 []v7action.GUIDLookupResult
+		result2 v7action.Warnings
+		result3 error
+	}
+	MakeCurlRequestStub        func(v7action.CurlTarget, string, string, []string, string, bool) ([]byte, *http.Response, error)
 	makeCurlRequestMutex       sync.RWMutex
 	makeCurlRequestArgsForCall []struct {
-		arg1 string
+		arg1 v7action.CurlTarget
 		arg2 string
-		arg3 []string
-		arg4 string
-		arg5 bool
+		arg3 string
+		arg4 []string
+		arg5 string
+		arg6 bool
 	}
 	makeCurlRequestReturns struct {
 		result1 []byte
@@ -2460,6 +3231,26 @@ type FakeActor struct {
 		result2 *http.Response
 		result3 error
 	}
+	MakeLogCacheCurlRequestStub        func(v7action.CurlRequester, string, string, []string, string, bool) ([]byte, *http.Response, error)
+	makeLogCacheCurlRequestMutex       sync.RWMutex
+	makeLogCacheCurlRequestArgsForCall []struct {
+		arg1 v7action.CurlRequester
+		arg2 string
+		arg3 string
+		arg4 []string
+		arg5 string
+		arg6 bool
+	}
+	makeLogCacheCurlRequestReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
+	makeLogCacheCurlRequestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}
 	MapRouteStub        func(string, string, string) (v7action.Warnings, error)
 	mapRouteMutex       sync.RWMutex
 	mapRouteArgsForCall []struct {
@@ -2475,13 +3266,29 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	MarketplaceStub        func(v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
-	marketplaceMutex       sync.RWMutex
-	marketplaceArgsForCall []struct {
-		arg1 v7action.MarketplaceFilter
-	}
-	marketplaceReturns struct {
-		result1 []v7action.ServiceOfferingWithPlans
+	MapRouteToAppPortStub        func(string, string, string, int) (v7action.Warnings, error)
+	mapRouteToAppPortMutex       sync.RWMutex
+	mapRouteToAppPortArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	mapRouteToAppPortReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	mapRouteToAppPortReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	MarketplaceStub        func(v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
+	marketplaceMutex       sync.RWMutex
+	marketplaceArgsForCall []struct {
+		arg1 v7action.MarketplaceFilter
+	}
+	marketplaceReturns struct {
+		result1 []v7action.ServiceOfferingWithPlans
 		result2 v7action.Warnings
 		result3 error
 	}
@@ -2490,6 +3297,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	MigrateRouteStub        func(resources.Route, resources.Domain) (resources.Route, v7action.Warnings, error)
+	migrateRouteMutex       sync.RWMutex
+	migrateRouteArgsForCall []struct {
+		arg1 resources.Route
+		arg2 resources.Domain
+	}
+	migrateRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	migrateRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	MoveRouteStub        func(string, string) (v7action.Warnings, error)
 	moveRouteMutex       sync.RWMutex
 	moveRouteArgsForCall []struct {
@@ -2548,6 +3371,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	PollRolloutStub        func(resources.Application, string, time.Duration, bool, func(v7action.RolloutStatus)) (v7action.Warnings, error)
+	pollRolloutMutex       sync.RWMutex
+	pollRolloutArgsForCall []struct {
+		arg1 resources.Application
+		arg2 string
+		arg3 time.Duration
+		arg4 bool
+		arg5 func(v7action.RolloutStatus)
+	}
+	pollRolloutReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pollRolloutReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	PollStartStub        func(resources.Application, bool, func(string)) (v7action.Warnings, error)
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -2622,6 +3462,23 @@ type FakeActor struct {
 		result1 string
 		result2 error
 	}
+	PromoteServiceBrokerStub        func(string, string, string) (v7action.ServiceBrokerPromotion, v7action.Warnings, error)
+	promoteServiceBrokerMutex       sync.RWMutex
+	promoteServiceBrokerArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	promoteServiceBrokerReturns struct {
+		result1 v7action.ServiceBrokerPromotion
+		result2 v7action.Warnings
+		result3 error
+	}
+	promoteServiceBrokerReturnsOnCall map[int]struct {
+		result1 v7action.ServiceBrokerPromotion
+		result2 v7action.Warnings
+		result3 error
+	}
 	PurgeServiceInstanceStub        func(string, string) (v7action.Warnings, error)
 	purgeServiceInstanceMutex       sync.RWMutex
 	purgeServiceInstanceArgsForCall []struct {
@@ -2727,6 +3584,18 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	RequestDeviceAuthorizationStub        func() (v7action.DeviceAuthorization, error)
+	requestDeviceAuthorizationMutex       sync.RWMutex
+	requestDeviceAuthorizationArgsForCall []struct {
+	}
+	requestDeviceAuthorizationReturns struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
+	requestDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
 	ResetOrganizationDefaultIsolationSegmentStub        func(string) (v7action.Warnings, error)
 	resetOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
 	resetOrganizationDefaultIsolationSegmentArgsForCall []struct {
@@ -2785,6 +3654,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	RestartApplicationInstancesInBatchesStub        func(resources.Application, int, func(processType string, indexes []int64)) (v7action.Warnings, error)
+	restartApplicationInstancesInBatchesMutex       sync.RWMutex
+	restartApplicationInstancesInBatchesArgsForCall []struct {
+		arg1 resources.Application
+		arg2 int
+		arg3 func(processType string, indexes []int64)
+	}
+	restartApplicationInstancesInBatchesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	restartApplicationInstancesInBatchesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	RevokeAccessAndRefreshTokensStub        func() error
 	revokeAccessAndRefreshTokensMutex       sync.RWMutex
 	revokeAccessAndRefreshTokensArgsForCall []struct {
@@ -2795,6 +3679,21 @@ type FakeActor struct {
 	revokeAccessAndRefreshTokensReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RotateServiceBrokerCredentialsStub        func(string, string, string) (v7action.Warnings, error)
+	rotateServiceBrokerCredentialsMutex       sync.RWMutex
+	rotateServiceBrokerCredentialsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	rotateServiceBrokerCredentialsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	rotateServiceBrokerCredentialsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	RunTaskStub        func(string, resources.Task) (resources.Task, v7action.Warnings, error)
 	runTaskMutex       sync.RWMutex
 	runTaskArgsForCall []struct {
@@ -2903,6 +3802,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	SetApplicationProcessPortsByNameAndSpaceStub        func(string, string, string, []int) (resources.Application, v7action.Warnings, error)
+	setApplicationProcessPortsByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessPortsByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []int
+	}
+	setApplicationProcessPortsByNameAndSpaceReturns struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	setApplicationProcessPortsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	SetEnvironmentVariableByApplicationNameAndSpaceStub        func(string, string, v7action.EnvironmentVariablePair) (v7action.Warnings, error)
 	setEnvironmentVariableByApplicationNameAndSpaceMutex       sync.RWMutex
 	setEnvironmentVariableByApplicationNameAndSpaceArgsForCall []struct {
@@ -3062,6 +3979,37 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	StartDeleteOrganizationStub        func(string) (string, v7action.Warnings, error)
+	startDeleteOrganizationMutex       sync.RWMutex
+	startDeleteOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	startDeleteOrganizationReturns struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}
+	startDeleteOrganizationReturnsOnCall map[int]struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}
+	StartDeleteSpaceByNameAndOrganizationNameStub        func(string, string) (string, v7action.Warnings, error)
+	startDeleteSpaceByNameAndOrganizationNameMutex       sync.RWMutex
+	startDeleteSpaceByNameAndOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	startDeleteSpaceByNameAndOrganizationNameReturns struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}
+	startDeleteSpaceByNameAndOrganizationNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}
 	StopApplicationStub        func(string) (v7action.Warnings, error)
 	stopApplicationMutex       sync.RWMutex
 	stopApplicationArgsForCall []struct {
@@ -3300,6 +4248,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateLabelsBySelectorStub        func(string, string, string, map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)
+	updateLabelsBySelectorMutex       sync.RWMutex
+	updateLabelsBySelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateLabelsBySelectorReturns struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateLabelsBySelectorReturnsOnCall map[int]struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateManagedServiceInstanceStub        func(v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	updateManagedServiceInstanceMutex       sync.RWMutex
 	updateManagedServiceInstanceArgsForCall []struct {
@@ -3633,6 +4599,50 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	VerifyApplicationHealthStub        func(resources.Application, v7action.ApplicationHealthCriteria) (v7action.Warnings, error)
+	verifyApplicationHealthMutex       sync.RWMutex
+	verifyApplicationHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationHealthCriteria
+	}
+	verifyApplicationHealthReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	verifyApplicationHealthReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	VerifyApplicationSLOStub        func(resources.Application, v7action.ApplicationSLO, v7action.HTTPClient) (v7action.ApplicationSLOReport, v7action.Warnings, error)
+	verifyApplicationSLOMutex       sync.RWMutex
+	verifyApplicationSLOArgsForCall []struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationSLO
+		arg3 v7action.HTTPClient
+	}
+	verifyApplicationSLOReturns struct {
+		result1 v7action.ApplicationSLOReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	verifyApplicationSLOReturnsOnCall map[int]struct {
+		result1 v7action.ApplicationSLOReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	WaitForJobStub        func(string) (v7action.Warnings, error)
+	waitForJobMutex       sync.RWMutex
+	waitForJobArgsForCall []struct {
+		arg1 string
+	}
+	waitForJobReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	waitForJobReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -3896,6 +4906,67 @@ func (fake *FakeActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeActor) AuthenticateWithDeviceAuthorization(arg1 v7action.DeviceAuthorization) error {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceAuthorizationReturnsOnCall[len(fake.authenticateWithDeviceAuthorizationArgsForCall)]
+	fake.authenticateWithDeviceAuthorizationArgsForCall = append(fake.authenticateWithDeviceAuthorizationArgsForCall, struct {
+		arg1 v7action.DeviceAuthorization
+	}{arg1})
+	stub := fake.AuthenticateWithDeviceAuthorizationStub
+	fakeReturns := fake.authenticateWithDeviceAuthorizationReturns
+	fake.recordInvocation("AuthenticateWithDeviceAuthorization", []interface{}{arg1})
+	fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceAuthorizationCallCount() int {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	return len(fake.authenticateWithDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceAuthorizationCalls(stub func(v7action.DeviceAuthorization) error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceAuthorizationArgsForCall(i int) v7action.DeviceAuthorization {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceAuthorizationReturns(result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	fake.authenticateWithDeviceAuthorizationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceAuthorizationReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	if fake.authenticateWithDeviceAuthorizationReturnsOnCall == nil {
+		fake.authenticateWithDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeActor) BindSecurityGroupToSpaces(arg1 string, arg2 []resources.Space, arg3 constanta.SecurityGroupLifecycle) (v7action.Warnings, error) {
 	var arg2Copy []resources.Space
 	if arg2 != nil {
@@ -4101,41 +5172,22 @@ func (fake *FakeActor) CheckRouteReturnsOnCall(i int, result1 bool, result2 v7ac
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) ClearTarget() {
-	fake.clearTargetMutex.Lock()
-	fake.clearTargetArgsForCall = append(fake.clearTargetArgsForCall, struct {
-	}{})
-	stub := fake.ClearTargetStub
-	fake.recordInvocation("ClearTarget", []interface{}{})
-	fake.clearTargetMutex.Unlock()
-	if stub != nil {
-		fake.ClearTargetStub()
+func (fake *FakeActor) CheckRoutesAvailability(arg1 []string, arg2 string) ([]v7action.RouteProblem, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
 	}
-}
-
-func (fake *FakeActor) ClearTargetCallCount() int {
-	fake.clearTargetMutex.RLock()
-	defer fake.clearTargetMutex.RUnlock()
-	return len(fake.clearTargetArgsForCall)
-}
-
-func (fake *FakeActor) ClearTargetCalls(stub func()) {
-	fake.clearTargetMutex.Lock()
-	defer fake.clearTargetMutex.Unlock()
-	fake.ClearTargetStub = stub
-}
-
-func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Application) (resources.Package, v7action.Warnings, error) {
-	fake.copyPackageMutex.Lock()
-	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
-	fake.copyPackageArgsForCall = append(fake.copyPackageArgsForCall, struct {
-		arg1 resources.Application
-		arg2 resources.Application
-	}{arg1, arg2})
-	stub := fake.CopyPackageStub
-	fakeReturns := fake.copyPackageReturns
-	fake.recordInvocation("CopyPackage", []interface{}{arg1, arg2})
-	fake.copyPackageMutex.Unlock()
+	fake.checkRoutesAvailabilityMutex.Lock()
+	ret, specificReturn := fake.checkRoutesAvailabilityReturnsOnCall[len(fake.checkRoutesAvailabilityArgsForCall)]
+	fake.checkRoutesAvailabilityArgsForCall = append(fake.checkRoutesAvailabilityArgsForCall, struct {
+		arg1 []string
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.CheckRoutesAvailabilityStub
+	fakeReturns := fake.checkRoutesAvailabilityReturns
+	fake.recordInvocation("CheckRoutesAvailability", []interface{}{arg1Copy, arg2})
+	fake.checkRoutesAvailabilityMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -4145,78 +5197,298 @@ func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Ap
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) CopyPackageCallCount() int {
-	fake.copyPackageMutex.RLock()
-	defer fake.copyPackageMutex.RUnlock()
-	return len(fake.copyPackageArgsForCall)
+func (fake *FakeActor) CheckRoutesAvailabilityCallCount() int {
+	fake.checkRoutesAvailabilityMutex.RLock()
+	defer fake.checkRoutesAvailabilityMutex.RUnlock()
+	return len(fake.checkRoutesAvailabilityArgsForCall)
 }
 
-func (fake *FakeActor) CopyPackageCalls(stub func(resources.Application, resources.Application) (resources.Package, v7action.Warnings, error)) {
-	fake.copyPackageMutex.Lock()
-	defer fake.copyPackageMutex.Unlock()
-	fake.CopyPackageStub = stub
+func (fake *FakeActor) CheckRoutesAvailabilityCalls(stub func([]string, string) ([]v7action.RouteProblem, v7action.Warnings, error)) {
+	fake.checkRoutesAvailabilityMutex.Lock()
+	defer fake.checkRoutesAvailabilityMutex.Unlock()
+	fake.CheckRoutesAvailabilityStub = stub
 }
 
-func (fake *FakeActor) CopyPackageArgsForCall(i int) (resources.Application, resources.Application) {
-	fake.copyPackageMutex.RLock()
-	defer fake.copyPackageMutex.RUnlock()
-	argsForCall := fake.copyPackageArgsForCall[i]
+func (fake *FakeActor) CheckRoutesAvailabilityArgsForCall(i int) ([]string, string) {
+	fake.checkRoutesAvailabilityMutex.RLock()
+	defer fake.checkRoutesAvailabilityMutex.RUnlock()
+	argsForCall := fake.checkRoutesAvailabilityArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) CopyPackageReturns(result1 resources.Package, result2 v7action.Warnings, result3 error) {
-	fake.copyPackageMutex.Lock()
-	defer fake.copyPackageMutex.Unlock()
-	fake.CopyPackageStub = nil
-	fake.copyPackageReturns = struct {
-		result1 resources.Package
+func (fake *FakeActor) CheckRoutesAvailabilityReturns(result1 []v7action.RouteProblem, result2 v7action.Warnings, result3 error) {
+	fake.checkRoutesAvailabilityMutex.Lock()
+	defer fake.checkRoutesAvailabilityMutex.Unlock()
+	fake.CheckRoutesAvailabilityStub = nil
+	fake.checkRoutesAvailabilityReturns = struct {
+		result1 []v7action.RouteProblem
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CopyPackageReturnsOnCall(i int, result1 resources.Package, result2 v7action.Warnings, result3 error) {
-	fake.copyPackageMutex.Lock()
-	defer fake.copyPackageMutex.Unlock()
-	fake.CopyPackageStub = nil
-	if fake.copyPackageReturnsOnCall == nil {
-		fake.copyPackageReturnsOnCall = make(map[int]struct {
-			result1 resources.Package
+func (fake *FakeActor) CheckRoutesAvailabilityReturnsOnCall(i int, result1 []v7action.RouteProblem, result2 v7action.Warnings, result3 error) {
+	fake.checkRoutesAvailabilityMutex.Lock()
+	defer fake.checkRoutesAvailabilityMutex.Unlock()
+	fake.CheckRoutesAvailabilityStub = nil
+	if fake.checkRoutesAvailabilityReturnsOnCall == nil {
+		fake.checkRoutesAvailabilityReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteProblem
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.copyPackageReturnsOnCall[i] = struct {
-		result1 resources.Package
+	fake.checkRoutesAvailabilityReturnsOnCall[i] = struct {
+		result1 []v7action.RouteProblem
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateAndUploadBitsPackageByApplicationNameAndSpace(arg1 string, arg2 string, arg3 string) (resources.Package, v7action.Warnings, error) {
-	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)]
-	fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall = append(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall, struct {
+func (fake *FakeActor) ClearApplicationBuildCache(arg1 string) (v7action.Warnings, error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	ret, specificReturn := fake.clearApplicationBuildCacheReturnsOnCall[len(fake.clearApplicationBuildCacheArgsForCall)]
+	fake.clearApplicationBuildCacheArgsForCall = append(fake.clearApplicationBuildCacheArgsForCall, struct {
 		arg1 string
-		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.CreateAndUploadBitsPackageByApplicationNameAndSpaceStub
-	fakeReturns := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturns
-	fake.recordInvocation("CreateAndUploadBitsPackageByApplicationNameAndSpace", []interface{}{arg1, arg2, arg3})
-	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Unlock()
+	}{arg1})
+	stub := fake.ClearApplicationBuildCacheStub
+	fakeReturns := fake.clearApplicationBuildCacheReturns
+	fake.recordInvocation("ClearApplicationBuildCache", []interface{}{arg1})
+	fake.clearApplicationBuildCacheMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount() int {
-	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
-	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
+func (fake *FakeActor) ClearApplicationBuildCacheCallCount() int {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	return len(fake.clearApplicationBuildCacheArgsForCall)
+}
+
+func (fake *FakeActor) ClearApplicationBuildCacheCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = stub
+}
+
+func (fake *FakeActor) ClearApplicationBuildCacheArgsForCall(i int) string {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	argsForCall := fake.clearApplicationBuildCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ClearApplicationBuildCacheReturns(result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	fake.clearApplicationBuildCacheReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ClearApplicationBuildCacheReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	if fake.clearApplicationBuildCacheReturnsOnCall == nil {
+		fake.clearApplicationBuildCacheReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.clearApplicationBuildCacheReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ClearTarget() {
+	fake.clearTargetMutex.Lock()
+	fake.clearTargetArgsForCall = append(fake.clearTargetArgsForCall, struct {
+	}{})
+	stub := fake.ClearTargetStub
+	fake.recordInvocation("ClearTarget", []interface{}{})
+	fake.clearTargetMutex.Unlock()
+	if stub != nil {
+		fake.ClearTargetStub()
+	}
+}
+
+func (fake *FakeActor) ClearTargetCallCount() int {
+	fake.clearTargetMutex.RLock()
+	defer fake.clearTargetMutex.RUnlock()
+	return len(fake.clearTargetArgsForCall)
+}
+
+func (fake *FakeActor) ClearTargetCalls(stub func()) {
+	fake.clearTargetMutex.Lock()
+	defer fake.clearTargetMutex.Unlock()
+	fake.ClearTargetStub = stub
+}
+
+func (fake *FakeActor) ContinueDeployment(arg1 string) (v7action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ContinueDeploymentStub
+	fakeReturns := fake.continueDeploymentReturns
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeActor) ContinueDeploymentCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ContinueDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ContinueDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Application) (resources.Package, v7action.Warnings, error) {
+	fake.copyPackageMutex.Lock()
+	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
+	fake.copyPackageArgsForCall = append(fake.copyPackageArgsForCall, struct {
+		arg1 resources.Application
+		arg2 resources.Application
+	}{arg1, arg2})
+	stub := fake.CopyPackageStub
+	fakeReturns := fake.copyPackageReturns
+	fake.recordInvocation("CopyPackage", []interface{}{arg1, arg2})
+	fake.copyPackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CopyPackageCallCount() int {
+	fake.copyPackageMutex.RLock()
+	defer fake.copyPackageMutex.RUnlock()
+	return len(fake.copyPackageArgsForCall)
+}
+
+func (fake *FakeActor) CopyPackageCalls(stub func(resources.Application, resources.Application) (resources.Package, v7action.Warnings, error)) {
+	fake.copyPackageMutex.Lock()
+	defer fake.copyPackageMutex.Unlock()
+	fake.CopyPackageStub = stub
+}
+
+func (fake *FakeActor) CopyPackageArgsForCall(i int) (resources.Application, resources.Application) {
+	fake.copyPackageMutex.RLock()
+	defer fake.copyPackageMutex.RUnlock()
+	argsForCall := fake.copyPackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) CopyPackageReturns(result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.copyPackageMutex.Lock()
+	defer fake.copyPackageMutex.Unlock()
+	fake.CopyPackageStub = nil
+	fake.copyPackageReturns = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CopyPackageReturnsOnCall(i int, result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.copyPackageMutex.Lock()
+	defer fake.copyPackageMutex.Unlock()
+	fake.CopyPackageStub = nil
+	if fake.copyPackageReturnsOnCall == nil {
+		fake.copyPackageReturnsOnCall = make(map[int]struct {
+			result1 resources.Package
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.copyPackageReturnsOnCall[i] = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateAndUploadBitsPackageByApplicationNameAndSpace(arg1 string, arg2 string, arg3 string) (resources.Package, v7action.Warnings, error) {
+	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)]
+	fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall = append(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateAndUploadBitsPackageByApplicationNameAndSpaceStub
+	fakeReturns := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturns
+	fake.recordInvocation("CreateAndUploadBitsPackageByApplicationNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount() int {
+	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
 	return len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)
 }
 
@@ -4531,87 +5803,18 @@ func (fake *FakeActor) CreateBuildpackReturnsOnCall(i int, result1 resources.Bui
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndDroplet(arg1 string, arg2 string) (string, v7action.Warnings, error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	ret, specificReturn := fake.createDeploymentByApplicationAndDropletReturnsOnCall[len(fake.createDeploymentByApplicationAndDropletArgsForCall)]
-	fake.createDeploymentByApplicationAndDropletArgsForCall = append(fake.createDeploymentByApplicationAndDropletArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.CreateDeploymentByApplicationAndDropletStub
-	fakeReturns := fake.createDeploymentByApplicationAndDropletReturns
-	fake.recordInvocation("CreateDeploymentByApplicationAndDroplet", []interface{}{arg1, arg2})
-	fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCallCount() int {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	return len(fake.createDeploymentByApplicationAndDropletArgsForCall)
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCalls(stub func(string, string) (string, v7action.Warnings, error)) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = stub
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletArgsForCall(i int) (string, string) {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	argsForCall := fake.createDeploymentByApplicationAndDropletArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturns(result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	fake.createDeploymentByApplicationAndDropletReturns = struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	if fake.createDeploymentByApplicationAndDropletReturnsOnCall == nil {
-		fake.createDeploymentByApplicationAndDropletReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 v7action.Warnings
-			result3 error
-		})
-	}
-	fake.createDeploymentByApplicationAndDropletReturnsOnCall[i] = struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevision(arg1 string, arg2 string) (string, v7action.Warnings, error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	ret, specificReturn := fake.createDeploymentByApplicationAndRevisionReturnsOnCall[len(fake.createDeploymentByApplicationAndRevisionArgsForCall)]
-	fake.createDeploymentByApplicationAndRevisionArgsForCall = append(fake.createDeploymentByApplicationAndRevisionArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.CreateDeploymentByApplicationAndRevisionStub
-	fakeReturns := fake.createDeploymentByApplicationAndRevisionReturns
-	fake.recordInvocation("CreateDeploymentByApplicationAndRevision", []interface{}{arg1, arg2})
-	fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
+func (fake *FakeActor) CreateDeployment(arg1 resources.Deployment) (string, v7action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		arg1 resources.Deployment
+	}{arg1})
+	stub := fake.CreateDeploymentStub
+	fakeReturns := fake.createDeploymentReturns
+	fake.recordInvocation("CreateDeployment", []interface{}{arg1})
+	fake.createDeploymentMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -4619,48 +5822,48 @@ func (fake *FakeActor) CreateDeploymentByApplicationAndRevision(arg1 string, arg
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCallCount() int {
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
-	return len(fake.createDeploymentByApplicationAndRevisionArgsForCall)
+func (fake *FakeActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCalls(stub func(string, string) (string, v7action.Warnings, error)) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = stub
+func (fake *FakeActor) CreateDeploymentCalls(stub func(resources.Deployment) (string, v7action.Warnings, error)) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = stub
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionArgsForCall(i int) (string, string) {
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
-	argsForCall := fake.createDeploymentByApplicationAndRevisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) CreateDeploymentArgsForCall(i int) resources.Deployment {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	argsForCall := fake.createDeploymentArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionReturns(result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = nil
-	fake.createDeploymentByApplicationAndRevisionReturns = struct {
+func (fake *FakeActor) CreateDeploymentReturns(result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = nil
-	if fake.createDeploymentByApplicationAndRevisionReturnsOnCall == nil {
-		fake.createDeploymentByApplicationAndRevisionReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) CreateDeploymentReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
 			result1 string
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.createDeploymentByApplicationAndRevisionReturnsOnCall[i] = struct {
+	fake.createDeploymentReturnsOnCall[i] = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -6788,6 +7991,70 @@ func (fake *FakeActor) DeleteRouteBindingReturnsOnCall(i int, result1 chan v7act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) DeleteRouteByGUID(arg1 string) (v7action.Warnings, error) {
+	fake.deleteRouteByGUIDMutex.Lock()
+	ret, specificReturn := fake.deleteRouteByGUIDReturnsOnCall[len(fake.deleteRouteByGUIDArgsForCall)]
+	fake.deleteRouteByGUIDArgsForCall = append(fake.deleteRouteByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteRouteByGUIDStub
+	fakeReturns := fake.deleteRouteByGUIDReturns
+	fake.recordInvocation("DeleteRouteByGUID", []interface{}{arg1})
+	fake.deleteRouteByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteRouteByGUIDCallCount() int {
+	fake.deleteRouteByGUIDMutex.RLock()
+	defer fake.deleteRouteByGUIDMutex.RUnlock()
+	return len(fake.deleteRouteByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) DeleteRouteByGUIDCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.deleteRouteByGUIDMutex.Lock()
+	defer fake.deleteRouteByGUIDMutex.Unlock()
+	fake.DeleteRouteByGUIDStub = stub
+}
+
+func (fake *FakeActor) DeleteRouteByGUIDArgsForCall(i int) string {
+	fake.deleteRouteByGUIDMutex.RLock()
+	defer fake.deleteRouteByGUIDMutex.RUnlock()
+	argsForCall := fake.deleteRouteByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteRouteByGUIDReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteRouteByGUIDMutex.Lock()
+	defer fake.deleteRouteByGUIDMutex.Unlock()
+	fake.DeleteRouteByGUIDStub = nil
+	fake.deleteRouteByGUIDReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteRouteByGUIDReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteRouteByGUIDMutex.Lock()
+	defer fake.deleteRouteByGUIDMutex.Unlock()
+	fake.DeleteRouteByGUIDStub = nil
+	if fake.deleteRouteByGUIDReturnsOnCall == nil {
+		fake.deleteRouteByGUIDReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteByGUIDReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteSecurityGroup(arg1 string) (v7action.Warnings, error) {
 	fake.deleteSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.deleteSecurityGroupReturnsOnCall[len(fake.deleteSecurityGroupArgsForCall)]
@@ -7660,23 +8927,95 @@ func (fake *FakeActor) DownloadCurrentDropletByAppNameReturnsOnCall(i int, resul
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) ([]byte, v7action.Warnings, error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	ret, specificReturn := fake.downloadDropletByGUIDAndAppNameReturnsOnCall[len(fake.downloadDropletByGUIDAndAppNameArgsForCall)]
-	fake.downloadDropletByGUIDAndAppNameArgsForCall = append(fake.downloadDropletByGUIDAndAppNameArgsForCall, struct {
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppName(arg1 string, arg2 string, arg3 v7action.SBOMFormat) ([]v7action.SBOM, string, v7action.Warnings, error) {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.Lock()
+	ret, specificReturn := fake.downloadCurrentDropletSBOMsByAppNameReturnsOnCall[len(fake.downloadCurrentDropletSBOMsByAppNameArgsForCall)]
+	fake.downloadCurrentDropletSBOMsByAppNameArgsForCall = append(fake.downloadCurrentDropletSBOMsByAppNameArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 string
+		arg3 v7action.SBOMFormat
 	}{arg1, arg2, arg3})
-	stub := fake.DownloadDropletByGUIDAndAppNameStub
-	fakeReturns := fake.downloadDropletByGUIDAndAppNameReturns
-	fake.recordInvocation("DownloadDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
-	fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
+	stub := fake.DownloadCurrentDropletSBOMsByAppNameStub
+	fakeReturns := fake.downloadCurrentDropletSBOMsByAppNameReturns
+	fake.recordInvocation("DownloadCurrentDropletSBOMsByAppName", []interface{}{arg1, arg2, arg3})
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppNameCallCount() int {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.RLock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.RUnlock()
+	return len(fake.downloadCurrentDropletSBOMsByAppNameArgsForCall)
+}
+
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppNameCalls(stub func(string, string, v7action.SBOMFormat) ([]v7action.SBOM, string, v7action.Warnings, error)) {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.Lock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.Unlock()
+	fake.DownloadCurrentDropletSBOMsByAppNameStub = stub
+}
+
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppNameArgsForCall(i int) (string, string, v7action.SBOMFormat) {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.RLock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.RUnlock()
+	argsForCall := fake.downloadCurrentDropletSBOMsByAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppNameReturns(result1 []v7action.SBOM, result2 string, result3 v7action.Warnings, result4 error) {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.Lock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.Unlock()
+	fake.DownloadCurrentDropletSBOMsByAppNameStub = nil
+	fake.downloadCurrentDropletSBOMsByAppNameReturns = struct {
+		result1 []v7action.SBOM
+		result2 string
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeActor) DownloadCurrentDropletSBOMsByAppNameReturnsOnCall(i int, result1 []v7action.SBOM, result2 string, result3 v7action.Warnings, result4 error) {
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.Lock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.Unlock()
+	fake.DownloadCurrentDropletSBOMsByAppNameStub = nil
+	if fake.downloadCurrentDropletSBOMsByAppNameReturnsOnCall == nil {
+		fake.downloadCurrentDropletSBOMsByAppNameReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SBOM
+			result2 string
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.downloadCurrentDropletSBOMsByAppNameReturnsOnCall[i] = struct {
+		result1 []v7action.SBOM
+		result2 string
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeActor) DownloadDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) ([]byte, v7action.Warnings, error) {
+	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
+	ret, specificReturn := fake.downloadDropletByGUIDAndAppNameReturnsOnCall[len(fake.downloadDropletByGUIDAndAppNameArgsForCall)]
+	fake.downloadDropletByGUIDAndAppNameArgsForCall = append(fake.downloadDropletByGUIDAndAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadDropletByGUIDAndAppNameStub
+	fakeReturns := fake.downloadDropletByGUIDAndAppNameReturns
+	fake.recordInvocation("DownloadDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
+	fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
 	}
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
@@ -7729,6 +9068,142 @@ func (fake *FakeActor) DownloadDropletByGUIDAndAppNameReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppName(arg1 string, arg2 string, arg3 string, arg4 v7action.SBOMFormat) ([]v7action.SBOM, v7action.Warnings, error) {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Lock()
+	ret, specificReturn := fake.downloadDropletSBOMsByGUIDAndAppNameReturnsOnCall[len(fake.downloadDropletSBOMsByGUIDAndAppNameArgsForCall)]
+	fake.downloadDropletSBOMsByGUIDAndAppNameArgsForCall = append(fake.downloadDropletSBOMsByGUIDAndAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 v7action.SBOMFormat
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DownloadDropletSBOMsByGUIDAndAppNameStub
+	fakeReturns := fake.downloadDropletSBOMsByGUIDAndAppNameReturns
+	fake.recordInvocation("DownloadDropletSBOMsByGUIDAndAppName", []interface{}{arg1, arg2, arg3, arg4})
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppNameCallCount() int {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RLock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RUnlock()
+	return len(fake.downloadDropletSBOMsByGUIDAndAppNameArgsForCall)
+}
+
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppNameCalls(stub func(string, string, string, v7action.SBOMFormat) ([]v7action.SBOM, v7action.Warnings, error)) {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Lock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Unlock()
+	fake.DownloadDropletSBOMsByGUIDAndAppNameStub = stub
+}
+
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppNameArgsForCall(i int) (string, string, string, v7action.SBOMFormat) {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RLock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RUnlock()
+	argsForCall := fake.downloadDropletSBOMsByGUIDAndAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppNameReturns(result1 []v7action.SBOM, result2 v7action.Warnings, result3 error) {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Lock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Unlock()
+	fake.DownloadDropletSBOMsByGUIDAndAppNameStub = nil
+	fake.downloadDropletSBOMsByGUIDAndAppNameReturns = struct {
+		result1 []v7action.SBOM
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DownloadDropletSBOMsByGUIDAndAppNameReturnsOnCall(i int, result1 []v7action.SBOM, result2 v7action.Warnings, result3 error) {
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Lock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.Unlock()
+	fake.DownloadDropletSBOMsByGUIDAndAppNameStub = nil
+	if fake.downloadDropletSBOMsByGUIDAndAppNameReturnsOnCall == nil {
+		fake.downloadDropletSBOMsByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SBOM
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.downloadDropletSBOMsByGUIDAndAppNameReturnsOnCall[i] = struct {
+		result1 []v7action.SBOM
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DrainRouteDestination(arg1 resources.Route, arg2 string, arg3 time.Duration) (v7action.Warnings, error) {
+	fake.drainRouteDestinationMutex.Lock()
+	ret, specificReturn := fake.drainRouteDestinationReturnsOnCall[len(fake.drainRouteDestinationArgsForCall)]
+	fake.drainRouteDestinationArgsForCall = append(fake.drainRouteDestinationArgsForCall, struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.DrainRouteDestinationStub
+	fakeReturns := fake.drainRouteDestinationReturns
+	fake.recordInvocation("DrainRouteDestination", []interface{}{arg1, arg2, arg3})
+	fake.drainRouteDestinationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DrainRouteDestinationCallCount() int {
+	fake.drainRouteDestinationMutex.RLock()
+	defer fake.drainRouteDestinationMutex.RUnlock()
+	return len(fake.drainRouteDestinationArgsForCall)
+}
+
+func (fake *FakeActor) DrainRouteDestinationCalls(stub func(resources.Route, string, time.Duration) (v7action.Warnings, error)) {
+	fake.drainRouteDestinationMutex.Lock()
+	defer fake.drainRouteDestinationMutex.Unlock()
+	fake.DrainRouteDestinationStub = stub
+}
+
+func (fake *FakeActor) DrainRouteDestinationArgsForCall(i int) (resources.Route, string, time.Duration) {
+	fake.drainRouteDestinationMutex.RLock()
+	defer fake.drainRouteDestinationMutex.RUnlock()
+	argsForCall := fake.drainRouteDestinationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DrainRouteDestinationReturns(result1 v7action.Warnings, result2 error) {
+	fake.drainRouteDestinationMutex.Lock()
+	defer fake.drainRouteDestinationMutex.Unlock()
+	fake.DrainRouteDestinationStub = nil
+	fake.drainRouteDestinationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DrainRouteDestinationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.drainRouteDestinationMutex.Lock()
+	defer fake.drainRouteDestinationMutex.Unlock()
+	fake.DrainRouteDestinationStub = nil
+	if fake.drainRouteDestinationReturnsOnCall == nil {
+		fake.drainRouteDestinationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.drainRouteDestinationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) EnableFeatureFlag(arg1 string) (v7action.Warnings, error) {
 	fake.enableFeatureFlagMutex.Lock()
 	ret, specificReturn := fake.enableFeatureFlagReturnsOnCall[len(fake.enableFeatureFlagArgsForCall)]
@@ -7928,6 +9403,65 @@ func (fake *FakeActor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetAPIResources() ([]v7action.APIResource, v7action.Warnings, error) {
+	fake.getAPIResourcesMutex.Lock()
+	ret, specificReturn := fake.getAPIResourcesReturnsOnCall[len(fake.getAPIResourcesArgsForCall)]
+	fake.getAPIResourcesArgsForCall = append(fake.getAPIResourcesArgsForCall, struct {
+	}{})
+	stub := fake.GetAPIResourcesStub
+	fakeReturns := fake.getAPIResourcesReturns
+	fake.recordInvocation("GetAPIResources", []interface{}{})
+	fake.getAPIResourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAPIResourcesCallCount() int {
+	fake.getAPIResourcesMutex.RLock()
+	defer fake.getAPIResourcesMutex.RUnlock()
+	return len(fake.getAPIResourcesArgsForCall)
+}
+
+func (fake *FakeActor) GetAPIResourcesCalls(stub func() ([]v7action.APIResource, v7action.Warnings, error)) {
+	fake.getAPIResourcesMutex.Lock()
+	defer fake.getAPIResourcesMutex.Unlock()
+	fake.GetAPIResourcesStub = stub
+}
+
+func (fake *FakeActor) GetAPIResourcesReturns(result1 []v7action.APIResource, result2 v7action.Warnings, result3 error) {
+	fake.getAPIResourcesMutex.Lock()
+	defer fake.getAPIResourcesMutex.Unlock()
+	fake.GetAPIResourcesStub = nil
+	fake.getAPIResourcesReturns = struct {
+		result1 []v7action.APIResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAPIResourcesReturnsOnCall(i int, result1 []v7action.APIResource, result2 v7action.Warnings, result3 error) {
+	fake.getAPIResourcesMutex.Lock()
+	defer fake.getAPIResourcesMutex.Unlock()
+	fake.GetAPIResourcesStub = nil
+	if fake.getAPIResourcesReturnsOnCall == nil {
+		fake.getAPIResourcesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.APIResource
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAPIResourcesReturnsOnCall[i] = struct {
+		result1 []v7action.APIResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
 	fake.getAppFeatureMutex.Lock()
 	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
@@ -7996,20 +9530,89 @@ func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.Appli
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
+func (fake *FakeActor) GetAppSecurityReport(arg1 string, arg2 string) (v7action.AppSecurityReport, v7action.Warnings, error) {
+	fake.getAppSecurityReportMutex.Lock()
+	ret, specificReturn := fake.getAppSecurityReportReturnsOnCall[len(fake.getAppSecurityReportArgsForCall)]
+	fake.getAppSecurityReportArgsForCall = append(fake.getAppSecurityReportArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAppSecurityReportStub
+	fakeReturns := fake.getAppSecurityReportReturns
+	fake.recordInvocation("GetAppSecurityReport", []interface{}{arg1, arg2})
+	fake.getAppSecurityReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppSecurityReportCallCount() int {
+	fake.getAppSecurityReportMutex.RLock()
+	defer fake.getAppSecurityReportMutex.RUnlock()
+	return len(fake.getAppSecurityReportArgsForCall)
+}
+
+func (fake *FakeActor) GetAppSecurityReportCalls(stub func(string, string) (v7action.AppSecurityReport, v7action.Warnings, error)) {
+	fake.getAppSecurityReportMutex.Lock()
+	defer fake.getAppSecurityReportMutex.Unlock()
+	fake.GetAppSecurityReportStub = stub
+}
+
+func (fake *FakeActor) GetAppSecurityReportArgsForCall(i int) (string, string) {
+	fake.getAppSecurityReportMutex.RLock()
+	defer fake.getAppSecurityReportMutex.RUnlock()
+	argsForCall := fake.getAppSecurityReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetAppSecurityReportReturns(result1 v7action.AppSecurityReport, result2 v7action.Warnings, result3 error) {
+	fake.getAppSecurityReportMutex.Lock()
+	defer fake.getAppSecurityReportMutex.Unlock()
+	fake.GetAppSecurityReportStub = nil
+	fake.getAppSecurityReportReturns = struct {
+		result1 v7action.AppSecurityReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppSecurityReportReturnsOnCall(i int, result1 v7action.AppSecurityReport, result2 v7action.Warnings, result3 error) {
+	fake.getAppSecurityReportMutex.Lock()
+	defer fake.getAppSecurityReportMutex.Unlock()
+	fake.GetAppSecurityReportStub = nil
+	if fake.getAppSecurityReportReturnsOnCall == nil {
+		fake.getAppSecurityReportReturnsOnCall = make(map[int]struct {
+			result1 v7action.AppSecurityReport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppSecurityReportReturnsOnCall[i] = struct {
+		result1 v7action.AppSecurityReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool, arg4 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
 	fake.getAppSummariesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
 	fake.getAppSummariesForSpaceArgsForCall = append(fake.getAppSummariesForSpaceArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 bool
-	}{arg1, arg2, arg3})
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.GetAppSummariesForSpaceStub
 	fakeReturns := fake.getAppSummariesForSpaceReturns
-	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getAppSummariesForSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -8023,17 +9626,17 @@ func (fake *FakeActor) GetAppSummariesForSpaceCallCount() int {
 	return len(fake.getAppSummariesForSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceCalls(stub func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)) {
+func (fake *FakeActor) GetAppSummariesForSpaceCalls(stub func(string, string, bool, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)) {
 	fake.getAppSummariesForSpaceMutex.Lock()
 	defer fake.getAppSummariesForSpaceMutex.Unlock()
 	fake.GetAppSummariesForSpaceStub = stub
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceArgsForCall(i int) (string, string, bool) {
+func (fake *FakeActor) GetAppSummariesForSpaceArgsForCall(i int) (string, string, bool, bool) {
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
 	argsForCall := fake.getAppSummariesForSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeActor) GetAppSummariesForSpaceReturns(result1 []v7action.ApplicationSummary, result2 v7action.Warnings, result3 error) {
@@ -8065,6 +9668,142 @@ func (fake *FakeActor) GetAppSummariesForSpaceReturnsOnCall(i int, result1 []v7a
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAppUsagesForSpace(arg1 string, arg2 sharedaction.LogCacheClient, arg3 time.Time) ([]v7action.AppUsage, v7action.Warnings, error) {
+	fake.getAppUsagesForSpaceMutex.Lock()
+	ret, specificReturn := fake.getAppUsagesForSpaceReturnsOnCall[len(fake.getAppUsagesForSpaceArgsForCall)]
+	fake.getAppUsagesForSpaceArgsForCall = append(fake.getAppUsagesForSpaceArgsForCall, struct {
+		arg1 string
+		arg2 sharedaction.LogCacheClient
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetAppUsagesForSpaceStub
+	fakeReturns := fake.getAppUsagesForSpaceReturns
+	fake.recordInvocation("GetAppUsagesForSpace", []interface{}{arg1, arg2, arg3})
+	fake.getAppUsagesForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppUsagesForSpaceCallCount() int {
+	fake.getAppUsagesForSpaceMutex.RLock()
+	defer fake.getAppUsagesForSpaceMutex.RUnlock()
+	return len(fake.getAppUsagesForSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetAppUsagesForSpaceCalls(stub func(string, sharedaction.LogCacheClient, time.Time) ([]v7action.AppUsage, v7action.Warnings, error)) {
+	fake.getAppUsagesForSpaceMutex.Lock()
+	defer fake.getAppUsagesForSpaceMutex.Unlock()
+	fake.GetAppUsagesForSpaceStub = stub
+}
+
+func (fake *FakeActor) GetAppUsagesForSpaceArgsForCall(i int) (string, sharedaction.LogCacheClient, time.Time) {
+	fake.getAppUsagesForSpaceMutex.RLock()
+	defer fake.getAppUsagesForSpaceMutex.RUnlock()
+	argsForCall := fake.getAppUsagesForSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetAppUsagesForSpaceReturns(result1 []v7action.AppUsage, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsagesForSpaceMutex.Lock()
+	defer fake.getAppUsagesForSpaceMutex.Unlock()
+	fake.GetAppUsagesForSpaceStub = nil
+	fake.getAppUsagesForSpaceReturns = struct {
+		result1 []v7action.AppUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppUsagesForSpaceReturnsOnCall(i int, result1 []v7action.AppUsage, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsagesForSpaceMutex.Lock()
+	defer fake.getAppUsagesForSpaceMutex.Unlock()
+	fake.GetAppUsagesForSpaceStub = nil
+	if fake.getAppUsagesForSpaceReturnsOnCall == nil {
+		fake.getAppUsagesForSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsagesForSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.AppUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationByGUID(arg1 string) (resources.Application, v7action.Warnings, error) {
+	fake.getApplicationByGUIDMutex.Lock()
+	ret, specificReturn := fake.getApplicationByGUIDReturnsOnCall[len(fake.getApplicationByGUIDArgsForCall)]
+	fake.getApplicationByGUIDArgsForCall = append(fake.getApplicationByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetApplicationByGUIDStub
+	fakeReturns := fake.getApplicationByGUIDReturns
+	fake.recordInvocation("GetApplicationByGUID", []interface{}{arg1})
+	fake.getApplicationByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationByGUIDCallCount() int {
+	fake.getApplicationByGUIDMutex.RLock()
+	defer fake.getApplicationByGUIDMutex.RUnlock()
+	return len(fake.getApplicationByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationByGUIDCalls(stub func(string) (resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationByGUIDMutex.Lock()
+	defer fake.getApplicationByGUIDMutex.Unlock()
+	fake.GetApplicationByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetApplicationByGUIDArgsForCall(i int) string {
+	fake.getApplicationByGUIDMutex.RLock()
+	defer fake.getApplicationByGUIDMutex.RUnlock()
+	argsForCall := fake.getApplicationByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetApplicationByGUIDReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByGUIDMutex.Lock()
+	defer fake.getApplicationByGUIDMutex.Unlock()
+	fake.GetApplicationByGUIDStub = nil
+	fake.getApplicationByGUIDReturns = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationByGUIDReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByGUIDMutex.Lock()
+	defer fake.getApplicationByGUIDMutex.Unlock()
+	fake.GetApplicationByGUIDStub = nil
+	if fake.getApplicationByGUIDReturnsOnCall == nil {
+		fake.getApplicationByGUIDReturnsOnCall = make(map[int]struct {
+			result1 resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByGUIDReturnsOnCall[i] = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -8472,17 +10211,85 @@ func (fake *FakeActor) GetApplicationProcessHealthChecksByNameAndSpaceReturnsOnC
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetApplicationRevisionsDeployed(arg1 string) ([]resources.Revision, v7action.Warnings, error) {
-	fake.getApplicationRevisionsDeployedMutex.Lock()
-	ret, specificReturn := fake.getApplicationRevisionsDeployedReturnsOnCall[len(fake.getApplicationRevisionsDeployedArgsForCall)]
-	fake.getApplicationRevisionsDeployedArgsForCall = append(fake.getApplicationRevisionsDeployedArgsForCall, struct {
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpace(arg1 string, arg2 string) (v7action.ProcessSummaries, v7action.Warnings, error) {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationProcessSummariesByNameAndSpaceReturnsOnCall[len(fake.getApplicationProcessSummariesByNameAndSpaceArgsForCall)]
+	fake.getApplicationProcessSummariesByNameAndSpaceArgsForCall = append(fake.getApplicationProcessSummariesByNameAndSpaceArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.GetApplicationRevisionsDeployedStub
-	fakeReturns := fake.getApplicationRevisionsDeployedReturns
-	fake.recordInvocation("GetApplicationRevisionsDeployed", []interface{}{arg1})
-	fake.getApplicationRevisionsDeployedMutex.Unlock()
-	if stub != nil {
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationProcessSummariesByNameAndSpaceStub
+	fakeReturns := fake.getApplicationProcessSummariesByNameAndSpaceReturns
+	fake.recordInvocation("GetApplicationProcessSummariesByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpaceCallCount() int {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationProcessSummariesByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpaceCalls(stub func(string, string) (v7action.ProcessSummaries, v7action.Warnings, error)) {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationProcessSummariesByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationProcessSummariesByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpaceReturns(result1 v7action.ProcessSummaries, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationProcessSummariesByNameAndSpaceStub = nil
+	fake.getApplicationProcessSummariesByNameAndSpaceReturns = struct {
+		result1 v7action.ProcessSummaries
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationProcessSummariesByNameAndSpaceReturnsOnCall(i int, result1 v7action.ProcessSummaries, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationProcessSummariesByNameAndSpaceStub = nil
+	if fake.getApplicationProcessSummariesByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationProcessSummariesByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.ProcessSummaries
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationProcessSummariesByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.ProcessSummaries
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationRevisionsDeployed(arg1 string) ([]resources.Revision, v7action.Warnings, error) {
+	fake.getApplicationRevisionsDeployedMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsDeployedReturnsOnCall[len(fake.getApplicationRevisionsDeployedArgsForCall)]
+	fake.getApplicationRevisionsDeployedArgsForCall = append(fake.getApplicationRevisionsDeployedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetApplicationRevisionsDeployedStub
+	fakeReturns := fake.getApplicationRevisionsDeployedReturns
+	fake.recordInvocation("GetApplicationRevisionsDeployed", []interface{}{arg1})
+	fake.getApplicationRevisionsDeployedMutex.Unlock()
+	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
@@ -8882,6 +10689,73 @@ func (fake *FakeActor) GetBuildpacksReturnsOnCall(i int, result1 []resources.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetCurrentDropletByApplication(arg1 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	ret, specificReturn := fake.getCurrentDropletByApplicationReturnsOnCall[len(fake.getCurrentDropletByApplicationArgsForCall)]
+	fake.getCurrentDropletByApplicationArgsForCall = append(fake.getCurrentDropletByApplicationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetCurrentDropletByApplicationStub
+	fakeReturns := fake.getCurrentDropletByApplicationReturns
+	fake.recordInvocation("GetCurrentDropletByApplication", []interface{}{arg1})
+	fake.getCurrentDropletByApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationCallCount() int {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	return len(fake.getCurrentDropletByApplicationArgsForCall)
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationCalls(stub func(string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = stub
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationArgsForCall(i int) string {
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
+	argsForCall := fake.getCurrentDropletByApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	fake.getCurrentDropletByApplicationReturns = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetCurrentDropletByApplicationReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByApplicationMutex.Lock()
+	defer fake.getCurrentDropletByApplicationMutex.Unlock()
+	fake.GetCurrentDropletByApplicationStub = nil
+	if fake.getCurrentDropletByApplicationReturnsOnCall == nil {
+		fake.getCurrentDropletByApplicationReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentDropletByApplicationReturnsOnCall[i] = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetCurrentUser() (configv3.User, error) {
 	fake.getCurrentUserMutex.Lock()
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
@@ -9005,6 +10879,74 @@ func (fake *FakeActor) GetDefaultDomainReturnsOnCall(i int, result1 resources.Do
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDeploymentForApp(arg1 string, arg2 string) (resources.Deployment, v7action.Warnings, error) {
+	fake.getDeploymentForAppMutex.Lock()
+	ret, specificReturn := fake.getDeploymentForAppReturnsOnCall[len(fake.getDeploymentForAppArgsForCall)]
+	fake.getDeploymentForAppArgsForCall = append(fake.getDeploymentForAppArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetDeploymentForAppStub
+	fakeReturns := fake.getDeploymentForAppReturns
+	fake.recordInvocation("GetDeploymentForApp", []interface{}{arg1, arg2})
+	fake.getDeploymentForAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDeploymentForAppCallCount() int {
+	fake.getDeploymentForAppMutex.RLock()
+	defer fake.getDeploymentForAppMutex.RUnlock()
+	return len(fake.getDeploymentForAppArgsForCall)
+}
+
+func (fake *FakeActor) GetDeploymentForAppCalls(stub func(string, string) (resources.Deployment, v7action.Warnings, error)) {
+	fake.getDeploymentForAppMutex.Lock()
+	defer fake.getDeploymentForAppMutex.Unlock()
+	fake.GetDeploymentForAppStub = stub
+}
+
+func (fake *FakeActor) GetDeploymentForAppArgsForCall(i int) (string, string) {
+	fake.getDeploymentForAppMutex.RLock()
+	defer fake.getDeploymentForAppMutex.RUnlock()
+	argsForCall := fake.getDeploymentForAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetDeploymentForAppReturns(result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentForAppMutex.Lock()
+	defer fake.getDeploymentForAppMutex.Unlock()
+	fake.GetDeploymentForAppStub = nil
+	fake.getDeploymentForAppReturns = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDeploymentForAppReturnsOnCall(i int, result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentForAppMutex.Lock()
+	defer fake.getDeploymentForAppMutex.Unlock()
+	fake.GetDeploymentForAppStub = nil
+	if fake.getDeploymentForAppReturnsOnCall == nil {
+		fake.getDeploymentForAppReturnsOnCall = make(map[int]struct {
+			result1 resources.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentForAppReturnsOnCall[i] = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDetailedAppSummary(arg1 string, arg2 string, arg3 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
 	fake.getDetailedAppSummaryMutex.Lock()
 	ret, specificReturn := fake.getDetailedAppSummaryReturnsOnCall[len(fake.getDetailedAppSummaryArgsForCall)]
@@ -9074,6 +11016,74 @@ func (fake *FakeActor) GetDetailedAppSummaryReturnsOnCall(i int, result1 v7actio
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDetailedAppSummaryForApp(arg1 resources.Application, arg2 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
+	fake.getDetailedAppSummaryForAppMutex.Lock()
+	ret, specificReturn := fake.getDetailedAppSummaryForAppReturnsOnCall[len(fake.getDetailedAppSummaryForAppArgsForCall)]
+	fake.getDetailedAppSummaryForAppArgsForCall = append(fake.getDetailedAppSummaryForAppArgsForCall, struct {
+		arg1 resources.Application
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.GetDetailedAppSummaryForAppStub
+	fakeReturns := fake.getDetailedAppSummaryForAppReturns
+	fake.recordInvocation("GetDetailedAppSummaryForApp", []interface{}{arg1, arg2})
+	fake.getDetailedAppSummaryForAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDetailedAppSummaryForAppCallCount() int {
+	fake.getDetailedAppSummaryForAppMutex.RLock()
+	defer fake.getDetailedAppSummaryForAppMutex.RUnlock()
+	return len(fake.getDetailedAppSummaryForAppArgsForCall)
+}
+
+func (fake *FakeActor) GetDetailedAppSummaryForAppCalls(stub func(resources.Application, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)) {
+	fake.getDetailedAppSummaryForAppMutex.Lock()
+	defer fake.getDetailedAppSummaryForAppMutex.Unlock()
+	fake.GetDetailedAppSummaryForAppStub = stub
+}
+
+func (fake *FakeActor) GetDetailedAppSummaryForAppArgsForCall(i int) (resources.Application, bool) {
+	fake.getDetailedAppSummaryForAppMutex.RLock()
+	defer fake.getDetailedAppSummaryForAppMutex.RUnlock()
+	argsForCall := fake.getDetailedAppSummaryForAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetDetailedAppSummaryForAppReturns(result1 v7action.DetailedApplicationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedAppSummaryForAppMutex.Lock()
+	defer fake.getDetailedAppSummaryForAppMutex.Unlock()
+	fake.GetDetailedAppSummaryForAppStub = nil
+	fake.getDetailedAppSummaryForAppReturns = struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDetailedAppSummaryForAppReturnsOnCall(i int, result1 v7action.DetailedApplicationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedAppSummaryForAppMutex.Lock()
+	defer fake.getDetailedAppSummaryForAppMutex.Unlock()
+	fake.GetDetailedAppSummaryForAppStub = nil
+	if fake.getDetailedAppSummaryForAppReturnsOnCall == nil {
+		fake.getDetailedAppSummaryForAppReturnsOnCall = make(map[int]struct {
+			result1 v7action.DetailedApplicationSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDetailedAppSummaryForAppReturnsOnCall[i] = struct {
+		result1 v7action.DetailedApplicationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDomain(arg1 string) (resources.Domain, v7action.Warnings, error) {
 	fake.getDomainMutex.Lock()
 	ret, specificReturn := fake.getDomainReturnsOnCall[len(fake.getDomainArgsForCall)]
@@ -9275,6 +11285,220 @@ func (fake *FakeActor) GetDomainLabelsReturnsOnCall(i int, result1 map[string]ty
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDrainByNameAndSpace(arg1 string, arg2 string) (v7action.Drain, v7action.Warnings, error) {
+	fake.getDrainByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getDrainByNameAndSpaceReturnsOnCall[len(fake.getDrainByNameAndSpaceArgsForCall)]
+	fake.getDrainByNameAndSpaceArgsForCall = append(fake.getDrainByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetDrainByNameAndSpaceStub
+	fakeReturns := fake.getDrainByNameAndSpaceReturns
+	fake.recordInvocation("GetDrainByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getDrainByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDrainByNameAndSpaceCallCount() int {
+	fake.getDrainByNameAndSpaceMutex.RLock()
+	defer fake.getDrainByNameAndSpaceMutex.RUnlock()
+	return len(fake.getDrainByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetDrainByNameAndSpaceCalls(stub func(string, string) (v7action.Drain, v7action.Warnings, error)) {
+	fake.getDrainByNameAndSpaceMutex.Lock()
+	defer fake.getDrainByNameAndSpaceMutex.Unlock()
+	fake.GetDrainByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetDrainByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getDrainByNameAndSpaceMutex.RLock()
+	defer fake.getDrainByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getDrainByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetDrainByNameAndSpaceReturns(result1 v7action.Drain, result2 v7action.Warnings, result3 error) {
+	fake.getDrainByNameAndSpaceMutex.Lock()
+	defer fake.getDrainByNameAndSpaceMutex.Unlock()
+	fake.GetDrainByNameAndSpaceStub = nil
+	fake.getDrainByNameAndSpaceReturns = struct {
+		result1 v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDrainByNameAndSpaceReturnsOnCall(i int, result1 v7action.Drain, result2 v7action.Warnings, result3 error) {
+	fake.getDrainByNameAndSpaceMutex.Lock()
+	defer fake.getDrainByNameAndSpaceMutex.Unlock()
+	fake.GetDrainByNameAndSpaceStub = nil
+	if fake.getDrainByNameAndSpaceReturnsOnCall == nil {
+		fake.getDrainByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Drain
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDrainByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDrainsForSpace(arg1 string) ([]v7action.Drain, v7action.Warnings, error) {
+	fake.getDrainsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getDrainsForSpaceReturnsOnCall[len(fake.getDrainsForSpaceArgsForCall)]
+	fake.getDrainsForSpaceArgsForCall = append(fake.getDrainsForSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDrainsForSpaceStub
+	fakeReturns := fake.getDrainsForSpaceReturns
+	fake.recordInvocation("GetDrainsForSpace", []interface{}{arg1})
+	fake.getDrainsForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDrainsForSpaceCallCount() int {
+	fake.getDrainsForSpaceMutex.RLock()
+	defer fake.getDrainsForSpaceMutex.RUnlock()
+	return len(fake.getDrainsForSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetDrainsForSpaceCalls(stub func(string) ([]v7action.Drain, v7action.Warnings, error)) {
+	fake.getDrainsForSpaceMutex.Lock()
+	defer fake.getDrainsForSpaceMutex.Unlock()
+	fake.GetDrainsForSpaceStub = stub
+}
+
+func (fake *FakeActor) GetDrainsForSpaceArgsForCall(i int) string {
+	fake.getDrainsForSpaceMutex.RLock()
+	defer fake.getDrainsForSpaceMutex.RUnlock()
+	argsForCall := fake.getDrainsForSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetDrainsForSpaceReturns(result1 []v7action.Drain, result2 v7action.Warnings, result3 error) {
+	fake.getDrainsForSpaceMutex.Lock()
+	defer fake.getDrainsForSpaceMutex.Unlock()
+	fake.GetDrainsForSpaceStub = nil
+	fake.getDrainsForSpaceReturns = struct {
+		result1 []v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDrainsForSpaceReturnsOnCall(i int, result1 []v7action.Drain, result2 v7action.Warnings, result3 error) {
+	fake.getDrainsForSpaceMutex.Lock()
+	defer fake.getDrainsForSpaceMutex.Unlock()
+	fake.GetDrainsForSpaceStub = nil
+	if fake.getDrainsForSpaceReturnsOnCall == nil {
+		fake.getDrainsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Drain
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDrainsForSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.Drain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDumpRecipesForApp(arg1 string, arg2 []v7action.DumpRecipe, arg3 []string) ([]v7action.DumpRecipe, v7action.Warnings, error) {
+	var arg2Copy []v7action.DumpRecipe
+	if arg2 != nil {
+		arg2Copy = make([]v7action.DumpRecipe, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.getDumpRecipesForAppMutex.Lock()
+	ret, specificReturn := fake.getDumpRecipesForAppReturnsOnCall[len(fake.getDumpRecipesForAppArgsForCall)]
+	fake.getDumpRecipesForAppArgsForCall = append(fake.getDumpRecipesForAppArgsForCall, struct {
+		arg1 string
+		arg2 []v7action.DumpRecipe
+		arg3 []string
+	}{arg1, arg2Copy, arg3Copy})
+	stub := fake.GetDumpRecipesForAppStub
+	fakeReturns := fake.getDumpRecipesForAppReturns
+	fake.recordInvocation("GetDumpRecipesForApp", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.getDumpRecipesForAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDumpRecipesForAppCallCount() int {
+	fake.getDumpRecipesForAppMutex.RLock()
+	defer fake.getDumpRecipesForAppMutex.RUnlock()
+	return len(fake.getDumpRecipesForAppArgsForCall)
+}
+
+func (fake *FakeActor) GetDumpRecipesForAppCalls(stub func(string, []v7action.DumpRecipe, []string) ([]v7action.DumpRecipe, v7action.Warnings, error)) {
+	fake.getDumpRecipesForAppMutex.Lock()
+	defer fake.getDumpRecipesForAppMutex.Unlock()
+	fake.GetDumpRecipesForAppStub = stub
+}
+
+func (fake *FakeActor) GetDumpRecipesForAppArgsForCall(i int) (string, []v7action.DumpRecipe, []string) {
+	fake.getDumpRecipesForAppMutex.RLock()
+	defer fake.getDumpRecipesForAppMutex.RUnlock()
+	argsForCall := fake.getDumpRecipesForAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetDumpRecipesForAppReturns(result1 []v7action.DumpRecipe, result2 v7action.Warnings, result3 error) {
+	fake.getDumpRecipesForAppMutex.Lock()
+	defer fake.getDumpRecipesForAppMutex.Unlock()
+	fake.GetDumpRecipesForAppStub = nil
+	fake.getDumpRecipesForAppReturns = struct {
+		result1 []v7action.DumpRecipe
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDumpRecipesForAppReturnsOnCall(i int, result1 []v7action.DumpRecipe, result2 v7action.Warnings, result3 error) {
+	fake.getDumpRecipesForAppMutex.Lock()
+	defer fake.getDumpRecipesForAppMutex.Unlock()
+	fake.GetDumpRecipesForAppStub = nil
+	if fake.getDumpRecipesForAppReturnsOnCall == nil {
+		fake.getDumpRecipesForAppReturnsOnCall = make(map[int]struct {
+			result1 []v7action.DumpRecipe
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDumpRecipesForAppReturnsOnCall[i] = struct {
+		result1 []v7action.DumpRecipe
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetEffectiveIsolationSegmentBySpace(arg1 string, arg2 string) (resources.IsolationSegment, v7action.Warnings, error) {
 	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
 	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
@@ -9478,7 +11702,74 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOn
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetFeatureFlagByName(arg1 string) (resources.FeatureFlag, v7action.Warnings, error) {
+func (fake *FakeActor) GetEvents(arg1 v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v7action.EventQuery
+	}{arg1})
+	stub := fake.GetEventsStub
+	fakeReturns := fake.getEventsReturns
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsCalls(stub func(v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeActor) GetEventsArgsForCall(i int) v7action.EventQuery {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFeatureFlagByName(arg1 string) (resources.FeatureFlag, v7action.Warnings, error) {
 	fake.getFeatureFlagByNameMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagByNameReturnsOnCall[len(fake.getFeatureFlagByNameArgsForCall)]
 	fake.getFeatureFlagByNameArgsForCall = append(fake.getFeatureFlagByNameArgsForCall, struct {
@@ -9604,6 +11895,152 @@ func (fake *FakeActor) GetFeatureFlagsReturnsOnCall(i int, result1 []resources.F
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
 func (fake *FakeActor) GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getGlobalRunningSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getGlobalRunningSecurityGroupsReturnsOnCall[len(fake.getGlobalRunningSecurityGroupsArgsForCall)]
@@ -9722,6 +12159,74 @@ func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetInternalRoutesBySpace(arg1 string, arg2 string) ([]v7action.InternalRoute, v7action.Warnings, error) {
+	fake.getInternalRoutesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getInternalRoutesBySpaceReturnsOnCall[len(fake.getInternalRoutesBySpaceArgsForCall)]
+	fake.getInternalRoutesBySpaceArgsForCall = append(fake.getInternalRoutesBySpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetInternalRoutesBySpaceStub
+	fakeReturns := fake.getInternalRoutesBySpaceReturns
+	fake.recordInvocation("GetInternalRoutesBySpace", []interface{}{arg1, arg2})
+	fake.getInternalRoutesBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetInternalRoutesBySpaceCallCount() int {
+	fake.getInternalRoutesBySpaceMutex.RLock()
+	defer fake.getInternalRoutesBySpaceMutex.RUnlock()
+	return len(fake.getInternalRoutesBySpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetInternalRoutesBySpaceCalls(stub func(string, string) ([]v7action.InternalRoute, v7action.Warnings, error)) {
+	fake.getInternalRoutesBySpaceMutex.Lock()
+	defer fake.getInternalRoutesBySpaceMutex.Unlock()
+	fake.GetInternalRoutesBySpaceStub = stub
+}
+
+func (fake *FakeActor) GetInternalRoutesBySpaceArgsForCall(i int) (string, string) {
+	fake.getInternalRoutesBySpaceMutex.RLock()
+	defer fake.getInternalRoutesBySpaceMutex.RUnlock()
+	argsForCall := fake.getInternalRoutesBySpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetInternalRoutesBySpaceReturns(result1 []v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRoutesBySpaceMutex.Lock()
+	defer fake.getInternalRoutesBySpaceMutex.Unlock()
+	fake.GetInternalRoutesBySpaceStub = nil
+	fake.getInternalRoutesBySpaceReturns = struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInternalRoutesBySpaceReturnsOnCall(i int, result1 []v7action.InternalRoute, result2 v7action.Warnings, result3 error) {
+	fake.getInternalRoutesBySpaceMutex.Lock()
+	defer fake.getInternalRoutesBySpaceMutex.Unlock()
+	fake.GetInternalRoutesBySpaceStub = nil
+	if fake.getInternalRoutesBySpaceReturnsOnCall == nil {
+		fake.getInternalRoutesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InternalRoute
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getInternalRoutesBySpaceReturnsOnCall[i] = struct {
+		result1 []v7action.InternalRoute
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetIsolationSegmentByName(arg1 string) (resources.IsolationSegment, v7action.Warnings, error) {
 	fake.getIsolationSegmentByNameMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
@@ -9915,6 +12420,73 @@ func (fake *FakeActor) GetIsolationSegmentsByOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetJob(arg1 string) (v7action.Job, v7action.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetJobStub
+	fakeReturns := fake.getJobReturns
+	fake.recordInvocation("GetJob", []interface{}{arg1})
+	fake.getJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeActor) GetJobCalls(stub func(string) (v7action.Job, v7action.Warnings, error)) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = stub
+}
+
+func (fake *FakeActor) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	argsForCall := fake.getJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetJobReturns(result1 v7action.Job, result2 v7action.Warnings, result3 error) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 v7action.Job
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetJobReturnsOnCall(i int, result1 v7action.Job, result2 v7action.Warnings, result3 error) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Job
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 v7action.Job
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetLatestActiveDeploymentForApp(arg1 string) (resources.Deployment, v7action.Warnings, error) {
 	fake.getLatestActiveDeploymentForAppMutex.Lock()
 	ret, specificReturn := fake.getLatestActiveDeploymentForAppReturnsOnCall[len(fake.getLatestActiveDeploymentForAppArgsForCall)]
@@ -9982,6 +12554,73 @@ func (fake *FakeActor) GetLatestActiveDeploymentForAppReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetLatestDeploymentForApp(arg1 string) (resources.Deployment, v7action.Warnings, error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	ret, specificReturn := fake.getLatestDeploymentForAppReturnsOnCall[len(fake.getLatestDeploymentForAppArgsForCall)]
+	fake.getLatestDeploymentForAppArgsForCall = append(fake.getLatestDeploymentForAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetLatestDeploymentForAppStub
+	fakeReturns := fake.getLatestDeploymentForAppReturns
+	fake.recordInvocation("GetLatestDeploymentForApp", []interface{}{arg1})
+	fake.getLatestDeploymentForAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetLatestDeploymentForAppCallCount() int {
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
+	return len(fake.getLatestDeploymentForAppArgsForCall)
+}
+
+func (fake *FakeActor) GetLatestDeploymentForAppCalls(stub func(string) (resources.Deployment, v7action.Warnings, error)) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = stub
+}
+
+func (fake *FakeActor) GetLatestDeploymentForAppArgsForCall(i int) string {
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
+	argsForCall := fake.getLatestDeploymentForAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetLatestDeploymentForAppReturns(result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = nil
+	fake.getLatestDeploymentForAppReturns = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetLatestDeploymentForAppReturnsOnCall(i int, result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getLatestDeploymentForAppMutex.Lock()
+	defer fake.getLatestDeploymentForAppMutex.Unlock()
+	fake.GetLatestDeploymentForAppStub = nil
+	if fake.getLatestDeploymentForAppReturnsOnCall == nil {
+		fake.getLatestDeploymentForAppReturnsOnCall = make(map[int]struct {
+			result1 resources.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getLatestDeploymentForAppReturnsOnCall[i] = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error) {
 	fake.getLoginPromptsMutex.Lock()
 	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
@@ -10172,6 +12811,73 @@ func (fake *FakeActor) GetOrgUsersByRoleTypeReturnsOnCall(i int, result1 map[con
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationByGUID(arg1 string) (resources.Organization, v7action.Warnings, error) {
+	fake.getOrganizationByGUIDMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByGUIDReturnsOnCall[len(fake.getOrganizationByGUIDArgsForCall)]
+	fake.getOrganizationByGUIDArgsForCall = append(fake.getOrganizationByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationByGUIDStub
+	fakeReturns := fake.getOrganizationByGUIDReturns
+	fake.recordInvocation("GetOrganizationByGUID", []interface{}{arg1})
+	fake.getOrganizationByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationByGUIDCallCount() int {
+	fake.getOrganizationByGUIDMutex.RLock()
+	defer fake.getOrganizationByGUIDMutex.RUnlock()
+	return len(fake.getOrganizationByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationByGUIDCalls(stub func(string) (resources.Organization, v7action.Warnings, error)) {
+	fake.getOrganizationByGUIDMutex.Lock()
+	defer fake.getOrganizationByGUIDMutex.Unlock()
+	fake.GetOrganizationByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationByGUIDArgsForCall(i int) string {
+	fake.getOrganizationByGUIDMutex.RLock()
+	defer fake.getOrganizationByGUIDMutex.RUnlock()
+	argsForCall := fake.getOrganizationByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationByGUIDReturns(result1 resources.Organization, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationByGUIDMutex.Lock()
+	defer fake.getOrganizationByGUIDMutex.Unlock()
+	fake.GetOrganizationByGUIDStub = nil
+	fake.getOrganizationByGUIDReturns = struct {
+		result1 resources.Organization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationByGUIDReturnsOnCall(i int, result1 resources.Organization, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationByGUIDMutex.Lock()
+	defer fake.getOrganizationByGUIDMutex.Unlock()
+	fake.GetOrganizationByGUIDStub = nil
+	if fake.getOrganizationByGUIDReturnsOnCall == nil {
+		fake.getOrganizationByGUIDReturnsOnCall = make(map[int]struct {
+			result1 resources.Organization
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByGUIDReturnsOnCall[i] = struct {
+		result1 resources.Organization
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetOrganizationByName(arg1 string) (resources.Organization, v7action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -10567,15 +13273,83 @@ func (fake *FakeActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []resou
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetOrganizationSpacesWithLabelSelector(arg1 string, arg2 string) ([]resources.Space, v7action.Warnings, error) {
-	fake.getOrganizationSpacesWithLabelSelectorMutex.Lock()
-	ret, specificReturn := fake.getOrganizationSpacesWithLabelSelectorReturnsOnCall[len(fake.getOrganizationSpacesWithLabelSelectorArgsForCall)]
-	fake.getOrganizationSpacesWithLabelSelectorArgsForCall = append(fake.getOrganizationSpacesWithLabelSelectorArgsForCall, struct {
+func (fake *FakeActor) GetOrganizationSpacesWithDetails(arg1 string, arg2 string) ([]v7action.SpaceDetails, v7action.Warnings, error) {
+	fake.getOrganizationSpacesWithDetailsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesWithDetailsReturnsOnCall[len(fake.getOrganizationSpacesWithDetailsArgsForCall)]
+	fake.getOrganizationSpacesWithDetailsArgsForCall = append(fake.getOrganizationSpacesWithDetailsArgsForCall, struct {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.GetOrganizationSpacesWithLabelSelectorStub
-	fakeReturns := fake.getOrganizationSpacesWithLabelSelectorReturns
+	stub := fake.GetOrganizationSpacesWithDetailsStub
+	fakeReturns := fake.getOrganizationSpacesWithDetailsReturns
+	fake.recordInvocation("GetOrganizationSpacesWithDetails", []interface{}{arg1, arg2})
+	fake.getOrganizationSpacesWithDetailsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithDetailsCallCount() int {
+	fake.getOrganizationSpacesWithDetailsMutex.RLock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.RUnlock()
+	return len(fake.getOrganizationSpacesWithDetailsArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithDetailsCalls(stub func(string, string) ([]v7action.SpaceDetails, v7action.Warnings, error)) {
+	fake.getOrganizationSpacesWithDetailsMutex.Lock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.Unlock()
+	fake.GetOrganizationSpacesWithDetailsStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithDetailsArgsForCall(i int) (string, string) {
+	fake.getOrganizationSpacesWithDetailsMutex.RLock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.RUnlock()
+	argsForCall := fake.getOrganizationSpacesWithDetailsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithDetailsReturns(result1 []v7action.SpaceDetails, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationSpacesWithDetailsMutex.Lock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.Unlock()
+	fake.GetOrganizationSpacesWithDetailsStub = nil
+	fake.getOrganizationSpacesWithDetailsReturns = struct {
+		result1 []v7action.SpaceDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithDetailsReturnsOnCall(i int, result1 []v7action.SpaceDetails, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationSpacesWithDetailsMutex.Lock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.Unlock()
+	fake.GetOrganizationSpacesWithDetailsStub = nil
+	if fake.getOrganizationSpacesWithDetailsReturnsOnCall == nil {
+		fake.getOrganizationSpacesWithDetailsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SpaceDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesWithDetailsReturnsOnCall[i] = struct {
+		result1 []v7action.SpaceDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationSpacesWithLabelSelector(arg1 string, arg2 string) ([]resources.Space, v7action.Warnings, error) {
+	fake.getOrganizationSpacesWithLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesWithLabelSelectorReturnsOnCall[len(fake.getOrganizationSpacesWithLabelSelectorArgsForCall)]
+	fake.getOrganizationSpacesWithLabelSelectorArgsForCall = append(fake.getOrganizationSpacesWithLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetOrganizationSpacesWithLabelSelectorStub
+	fakeReturns := fake.getOrganizationSpacesWithLabelSelectorReturns
 	fake.recordInvocation("GetOrganizationSpacesWithLabelSelector", []interface{}{arg1, arg2})
 	fake.getOrganizationSpacesWithLabelSelectorMutex.Unlock()
 	if stub != nil {
@@ -10702,6 +13476,142 @@ func (fake *FakeActor) GetOrganizationSummaryByNameReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationSummaryForOrg(arg1 resources.Organization) (v7action.OrganizationSummary, v7action.Warnings, error) {
+	fake.getOrganizationSummaryForOrgMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSummaryForOrgReturnsOnCall[len(fake.getOrganizationSummaryForOrgArgsForCall)]
+	fake.getOrganizationSummaryForOrgArgsForCall = append(fake.getOrganizationSummaryForOrgArgsForCall, struct {
+		arg1 resources.Organization
+	}{arg1})
+	stub := fake.GetOrganizationSummaryForOrgStub
+	fakeReturns := fake.getOrganizationSummaryForOrgReturns
+	fake.recordInvocation("GetOrganizationSummaryForOrg", []interface{}{arg1})
+	fake.getOrganizationSummaryForOrgMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationSummaryForOrgCallCount() int {
+	fake.getOrganizationSummaryForOrgMutex.RLock()
+	defer fake.getOrganizationSummaryForOrgMutex.RUnlock()
+	return len(fake.getOrganizationSummaryForOrgArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationSummaryForOrgCalls(stub func(resources.Organization) (v7action.OrganizationSummary, v7action.Warnings, error)) {
+	fake.getOrganizationSummaryForOrgMutex.Lock()
+	defer fake.getOrganizationSummaryForOrgMutex.Unlock()
+	fake.GetOrganizationSummaryForOrgStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationSummaryForOrgArgsForCall(i int) resources.Organization {
+	fake.getOrganizationSummaryForOrgMutex.RLock()
+	defer fake.getOrganizationSummaryForOrgMutex.RUnlock()
+	argsForCall := fake.getOrganizationSummaryForOrgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationSummaryForOrgReturns(result1 v7action.OrganizationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationSummaryForOrgMutex.Lock()
+	defer fake.getOrganizationSummaryForOrgMutex.Unlock()
+	fake.GetOrganizationSummaryForOrgStub = nil
+	fake.getOrganizationSummaryForOrgReturns = struct {
+		result1 v7action.OrganizationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationSummaryForOrgReturnsOnCall(i int, result1 v7action.OrganizationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationSummaryForOrgMutex.Lock()
+	defer fake.getOrganizationSummaryForOrgMutex.Unlock()
+	fake.GetOrganizationSummaryForOrgStub = nil
+	if fake.getOrganizationSummaryForOrgReturnsOnCall == nil {
+		fake.getOrganizationSummaryForOrgReturnsOnCall = make(map[int]struct {
+			result1 v7action.OrganizationSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSummaryForOrgReturnsOnCall[i] = struct {
+		result1 v7action.OrganizationSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshot(arg1 string, arg2 time.Time, arg3 time.Time) ([]v7action.SpaceUsage, v7action.Warnings, error) {
+	fake.getOrganizationUsageSnapshotMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsageSnapshotReturnsOnCall[len(fake.getOrganizationUsageSnapshotArgsForCall)]
+	fake.getOrganizationUsageSnapshotArgsForCall = append(fake.getOrganizationUsageSnapshotArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetOrganizationUsageSnapshotStub
+	fakeReturns := fake.getOrganizationUsageSnapshotReturns
+	fake.recordInvocation("GetOrganizationUsageSnapshot", []interface{}{arg1, arg2, arg3})
+	fake.getOrganizationUsageSnapshotMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshotCallCount() int {
+	fake.getOrganizationUsageSnapshotMutex.RLock()
+	defer fake.getOrganizationUsageSnapshotMutex.RUnlock()
+	return len(fake.getOrganizationUsageSnapshotArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshotCalls(stub func(string, time.Time, time.Time) ([]v7action.SpaceUsage, v7action.Warnings, error)) {
+	fake.getOrganizationUsageSnapshotMutex.Lock()
+	defer fake.getOrganizationUsageSnapshotMutex.Unlock()
+	fake.GetOrganizationUsageSnapshotStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshotArgsForCall(i int) (string, time.Time, time.Time) {
+	fake.getOrganizationUsageSnapshotMutex.RLock()
+	defer fake.getOrganizationUsageSnapshotMutex.RUnlock()
+	argsForCall := fake.getOrganizationUsageSnapshotArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshotReturns(result1 []v7action.SpaceUsage, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationUsageSnapshotMutex.Lock()
+	defer fake.getOrganizationUsageSnapshotMutex.Unlock()
+	fake.GetOrganizationUsageSnapshotStub = nil
+	fake.getOrganizationUsageSnapshotReturns = struct {
+		result1 []v7action.SpaceUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationUsageSnapshotReturnsOnCall(i int, result1 []v7action.SpaceUsage, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationUsageSnapshotMutex.Lock()
+	defer fake.getOrganizationUsageSnapshotMutex.Unlock()
+	fake.GetOrganizationUsageSnapshotStub = nil
+	if fake.getOrganizationUsageSnapshotReturnsOnCall == nil {
+		fake.getOrganizationUsageSnapshotReturnsOnCall = make(map[int]struct {
+			result1 []v7action.SpaceUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsageSnapshotReturnsOnCall[i] = struct {
+		result1 []v7action.SpaceUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetOrganizations(arg1 string) ([]resources.Organization, v7action.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -10769,6 +13679,146 @@ func (fake *FakeActor) GetOrganizationsReturnsOnCall(i int, result1 []resources.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationsWithDetails(arg1 string) ([]v7action.OrganizationDetails, v7action.Warnings, error) {
+	fake.getOrganizationsWithDetailsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsWithDetailsReturnsOnCall[len(fake.getOrganizationsWithDetailsArgsForCall)]
+	fake.getOrganizationsWithDetailsArgsForCall = append(fake.getOrganizationsWithDetailsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationsWithDetailsStub
+	fakeReturns := fake.getOrganizationsWithDetailsReturns
+	fake.recordInvocation("GetOrganizationsWithDetails", []interface{}{arg1})
+	fake.getOrganizationsWithDetailsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationsWithDetailsCallCount() int {
+	fake.getOrganizationsWithDetailsMutex.RLock()
+	defer fake.getOrganizationsWithDetailsMutex.RUnlock()
+	return len(fake.getOrganizationsWithDetailsArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationsWithDetailsCalls(stub func(string) ([]v7action.OrganizationDetails, v7action.Warnings, error)) {
+	fake.getOrganizationsWithDetailsMutex.Lock()
+	defer fake.getOrganizationsWithDetailsMutex.Unlock()
+	fake.GetOrganizationsWithDetailsStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationsWithDetailsArgsForCall(i int) string {
+	fake.getOrganizationsWithDetailsMutex.RLock()
+	defer fake.getOrganizationsWithDetailsMutex.RUnlock()
+	argsForCall := fake.getOrganizationsWithDetailsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationsWithDetailsReturns(result1 []v7action.OrganizationDetails, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationsWithDetailsMutex.Lock()
+	defer fake.getOrganizationsWithDetailsMutex.Unlock()
+	fake.GetOrganizationsWithDetailsStub = nil
+	fake.getOrganizationsWithDetailsReturns = struct {
+		result1 []v7action.OrganizationDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationsWithDetailsReturnsOnCall(i int, result1 []v7action.OrganizationDetails, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationsWithDetailsMutex.Lock()
+	defer fake.getOrganizationsWithDetailsMutex.Unlock()
+	fake.GetOrganizationsWithDetailsStub = nil
+	if fake.getOrganizationsWithDetailsReturnsOnCall == nil {
+		fake.getOrganizationsWithDetailsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.OrganizationDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsWithDetailsReturnsOnCall[i] = struct {
+		result1 []v7action.OrganizationDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrphanedRoutes(arg1 []resources.Route, arg2 time.Duration) ([]resources.Route, v7action.Warnings, error) {
+	var arg1Copy []resources.Route
+	if arg1 != nil {
+		arg1Copy = make([]resources.Route, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getOrphanedRoutesMutex.Lock()
+	ret, specificReturn := fake.getOrphanedRoutesReturnsOnCall[len(fake.getOrphanedRoutesArgsForCall)]
+	fake.getOrphanedRoutesArgsForCall = append(fake.getOrphanedRoutesArgsForCall, struct {
+		arg1 []resources.Route
+		arg2 time.Duration
+	}{arg1Copy, arg2})
+	stub := fake.GetOrphanedRoutesStub
+	fakeReturns := fake.getOrphanedRoutesReturns
+	fake.recordInvocation("GetOrphanedRoutes", []interface{}{arg1Copy, arg2})
+	fake.getOrphanedRoutesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrphanedRoutesCallCount() int {
+	fake.getOrphanedRoutesMutex.RLock()
+	defer fake.getOrphanedRoutesMutex.RUnlock()
+	return len(fake.getOrphanedRoutesArgsForCall)
+}
+
+func (fake *FakeActor) GetOrphanedRoutesCalls(stub func([]resources.Route, time.Duration) ([]resources.Route, v7action.Warnings, error)) {
+	fake.getOrphanedRoutesMutex.Lock()
+	defer fake.getOrphanedRoutesMutex.Unlock()
+	fake.GetOrphanedRoutesStub = stub
+}
+
+func (fake *FakeActor) GetOrphanedRoutesArgsForCall(i int) ([]resources.Route, time.Duration) {
+	fake.getOrphanedRoutesMutex.RLock()
+	defer fake.getOrphanedRoutesMutex.RUnlock()
+	argsForCall := fake.getOrphanedRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetOrphanedRoutesReturns(result1 []resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getOrphanedRoutesMutex.Lock()
+	defer fake.getOrphanedRoutesMutex.Unlock()
+	fake.GetOrphanedRoutesStub = nil
+	fake.getOrphanedRoutesReturns = struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrphanedRoutesReturnsOnCall(i int, result1 []resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getOrphanedRoutesMutex.Lock()
+	defer fake.getOrphanedRoutesMutex.Unlock()
+	fake.GetOrphanedRoutesStub = nil
+	if fake.getOrphanedRoutesReturnsOnCall == nil {
+		fake.getOrphanedRoutesReturnsOnCall = make(map[int]struct {
+			result1 []resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrphanedRoutesReturnsOnCall[i] = struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (resources.Process, v7action.Warnings, error) {
 	fake.getProcessByTypeAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
@@ -10905,6 +13955,216 @@ func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpace(arg1 []string, arg2 string) ([]byte, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getRawApplicationsManifestByNamesAndSpaceReturnsOnCall[len(fake.getRawApplicationsManifestByNamesAndSpaceArgsForCall)]
+	fake.getRawApplicationsManifestByNamesAndSpaceArgsForCall = append(fake.getRawApplicationsManifestByNamesAndSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.GetRawApplicationsManifestByNamesAndSpaceStub
+	fakeReturns := fake.getRawApplicationsManifestByNamesAndSpaceReturns
+	fake.recordInvocation("GetRawApplicationsManifestByNamesAndSpace", []interface{}{arg1Copy, arg2})
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpaceCallCount() int {
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.RLock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.RUnlock()
+	return len(fake.getRawApplicationsManifestByNamesAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpaceCalls(stub func([]string, string) ([]byte, v7action.Warnings, error)) {
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.Lock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.Unlock()
+	fake.GetRawApplicationsManifestByNamesAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpaceArgsForCall(i int) ([]string, string) {
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.RLock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.RUnlock()
+	argsForCall := fake.getRawApplicationsManifestByNamesAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpaceReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.Lock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.Unlock()
+	fake.GetRawApplicationsManifestByNamesAndSpaceStub = nil
+	fake.getRawApplicationsManifestByNamesAndSpaceReturns = struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRawApplicationsManifestByNamesAndSpaceReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.Lock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.Unlock()
+	fake.GetRawApplicationsManifestByNamesAndSpaceStub = nil
+	if fake.getRawApplicationsManifestByNamesAndSpaceReturnsOnCall == nil {
+		fake.getRawApplicationsManifestByNamesAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRawApplicationsManifestByNamesAndSpaceReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRawSpaceManifest(arg1 string, arg2 bool, arg3 bool) ([]byte, v7action.Warnings, error) {
+	fake.getRawSpaceManifestMutex.Lock()
+	ret, specificReturn := fake.getRawSpaceManifestReturnsOnCall[len(fake.getRawSpaceManifestArgsForCall)]
+	fake.getRawSpaceManifestArgsForCall = append(fake.getRawSpaceManifestArgsForCall, struct {
+		arg1 string
+		arg2 bool
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetRawSpaceManifestStub
+	fakeReturns := fake.getRawSpaceManifestReturns
+	fake.recordInvocation("GetRawSpaceManifest", []interface{}{arg1, arg2, arg3})
+	fake.getRawSpaceManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRawSpaceManifestCallCount() int {
+	fake.getRawSpaceManifestMutex.RLock()
+	defer fake.getRawSpaceManifestMutex.RUnlock()
+	return len(fake.getRawSpaceManifestArgsForCall)
+}
+
+func (fake *FakeActor) GetRawSpaceManifestCalls(stub func(string, bool, bool) ([]byte, v7action.Warnings, error)) {
+	fake.getRawSpaceManifestMutex.Lock()
+	defer fake.getRawSpaceManifestMutex.Unlock()
+	fake.GetRawSpaceManifestStub = stub
+}
+
+func (fake *FakeActor) GetRawSpaceManifestArgsForCall(i int) (string, bool, bool) {
+	fake.getRawSpaceManifestMutex.RLock()
+	defer fake.getRawSpaceManifestMutex.RUnlock()
+	argsForCall := fake.getRawSpaceManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetRawSpaceManifestReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawSpaceManifestMutex.Lock()
+	defer fake.getRawSpaceManifestMutex.Unlock()
+	fake.GetRawSpaceManifestStub = nil
+	fake.getRawSpaceManifestReturns = struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRawSpaceManifestReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawSpaceManifestMutex.Lock()
+	defer fake.getRawSpaceManifestMutex.Unlock()
+	fake.GetRawSpaceManifestStub = nil
+	if fake.getRawSpaceManifestReturnsOnCall == nil {
+		fake.getRawSpaceManifestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRawSpaceManifestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetReadyPackageForApplication(arg1 resources.Application, arg2 string) (resources.Package, v7action.Warnings, error) {
+	fake.getReadyPackageForApplicationMutex.Lock()
+	ret, specificReturn := fake.getReadyPackageForApplicationReturnsOnCall[len(fake.getReadyPackageForApplicationArgsForCall)]
+	fake.getReadyPackageForApplicationArgsForCall = append(fake.getReadyPackageForApplicationArgsForCall, struct {
+		arg1 resources.Application
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetReadyPackageForApplicationStub
+	fakeReturns := fake.getReadyPackageForApplicationReturns
+	fake.recordInvocation("GetReadyPackageForApplication", []interface{}{arg1, arg2})
+	fake.getReadyPackageForApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetReadyPackageForApplicationCallCount() int {
+	fake.getReadyPackageForApplicationMutex.RLock()
+	defer fake.getReadyPackageForApplicationMutex.RUnlock()
+	return len(fake.getReadyPackageForApplicationArgsForCall)
+}
+
+func (fake *FakeActor) GetReadyPackageForApplicationCalls(stub func(resources.Application, string) (resources.Package, v7action.Warnings, error)) {
+	fake.getReadyPackageForApplicationMutex.Lock()
+	defer fake.getReadyPackageForApplicationMutex.Unlock()
+	fake.GetReadyPackageForApplicationStub = stub
+}
+
+func (fake *FakeActor) GetReadyPackageForApplicationArgsForCall(i int) (resources.Application, string) {
+	fake.getReadyPackageForApplicationMutex.RLock()
+	defer fake.getReadyPackageForApplicationMutex.RUnlock()
+	argsForCall := fake.getReadyPackageForApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetReadyPackageForApplicationReturns(result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getReadyPackageForApplicationMutex.Lock()
+	defer fake.getReadyPackageForApplicationMutex.Unlock()
+	fake.GetReadyPackageForApplicationStub = nil
+	fake.getReadyPackageForApplicationReturns = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetReadyPackageForApplicationReturnsOnCall(i int, result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getReadyPackageForApplicationMutex.Lock()
+	defer fake.getReadyPackageForApplicationMutex.Unlock()
+	fake.GetReadyPackageForApplicationStub = nil
+	if fake.getReadyPackageForApplicationReturnsOnCall == nil {
+		fake.getReadyPackageForApplicationReturnsOnCall = make(map[int]struct {
+			result1 resources.Package
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getReadyPackageForApplicationReturnsOnCall[i] = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpace(arg1 string, arg2 string) ([]v7action.Event, v7action.Warnings, error) {
 	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)]
@@ -10973,20 +14233,86 @@ func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
-	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+func (fake *FakeActor) GetRecentEventsByOrganizationName(arg1 string) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getRecentEventsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.getRecentEventsByOrganizationNameReturnsOnCall[len(fake.getRecentEventsByOrganizationNameArgsForCall)]
+	fake.getRecentEventsByOrganizationNameArgsForCall = append(fake.getRecentEventsByOrganizationNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRecentEventsByOrganizationNameStub
+	fakeReturns := fake.getRecentEventsByOrganizationNameReturns
+	fake.recordInvocation("GetRecentEventsByOrganizationName", []interface{}{arg1})
+	fake.getRecentEventsByOrganizationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRecentEventsByOrganizationNameCallCount() int {
+	fake.getRecentEventsByOrganizationNameMutex.RLock()
+	defer fake.getRecentEventsByOrganizationNameMutex.RUnlock()
+	return len(fake.getRecentEventsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeActor) GetRecentEventsByOrganizationNameCalls(stub func(string) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getRecentEventsByOrganizationNameMutex.Lock()
+	defer fake.getRecentEventsByOrganizationNameMutex.Unlock()
+	fake.GetRecentEventsByOrganizationNameStub = stub
+}
+
+func (fake *FakeActor) GetRecentEventsByOrganizationNameArgsForCall(i int) string {
+	fake.getRecentEventsByOrganizationNameMutex.RLock()
+	defer fake.getRecentEventsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.getRecentEventsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRecentEventsByOrganizationNameReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getRecentEventsByOrganizationNameMutex.Lock()
+	defer fake.getRecentEventsByOrganizationNameMutex.Unlock()
+	fake.GetRecentEventsByOrganizationNameStub = nil
+	fake.getRecentEventsByOrganizationNameReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRecentEventsByOrganizationNameReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getRecentEventsByOrganizationNameMutex.Lock()
+	defer fake.getRecentEventsByOrganizationNameMutex.Unlock()
+	fake.GetRecentEventsByOrganizationNameStub = nil
+	if fake.getRecentEventsByOrganizationNameReturnsOnCall == nil {
+		fake.getRecentEventsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentEventsByOrganizationNameReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganization(arg1 string, arg2 string) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.Lock()
+	ret, specificReturn := fake.getRecentEventsBySpaceNameAndOrganizationReturnsOnCall[len(fake.getRecentEventsBySpaceNameAndOrganizationArgsForCall)]
+	fake.getRecentEventsBySpaceNameAndOrganizationArgsForCall = append(fake.getRecentEventsBySpaceNameAndOrganizationArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 sharedaction.LogCacheClient
-	}{arg1, arg2, arg3})
-	stub := fake.GetRecentLogsForApplicationByNameAndSpaceStub
-	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceReturns
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.GetRecentEventsBySpaceNameAndOrganizationStub
+	fakeReturns := fake.getRecentEventsBySpaceNameAndOrganizationReturns
+	fake.recordInvocation("GetRecentEventsBySpaceNameAndOrganization", []interface{}{arg1, arg2})
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -10994,49 +14320,49 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, ar
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCallCount() int {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganizationCallCount() int {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.RLock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.RUnlock()
+	return len(fake.getRecentEventsBySpaceNameAndOrganizationArgsForCall)
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganizationCalls(stub func(string, string) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.Lock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.Unlock()
+	fake.GetRecentEventsBySpaceNameAndOrganizationStub = stub
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganizationArgsForCall(i int) (string, string) {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.RLock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.RUnlock()
+	argsForCall := fake.getRecentEventsBySpaceNameAndOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
-	fake.getRecentLogsForApplicationByNameAndSpaceReturns = struct {
-		result1 []sharedaction.LogMessage
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganizationReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.Lock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.Unlock()
+	fake.GetRecentEventsBySpaceNameAndOrganizationStub = nil
+	fake.getRecentEventsBySpaceNameAndOrganizationReturns = struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
-	if fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []sharedaction.LogMessage
+func (fake *FakeActor) GetRecentEventsBySpaceNameAndOrganizationReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.Lock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.Unlock()
+	fake.GetRecentEventsBySpaceNameAndOrganizationStub = nil
+	if fake.getRecentEventsBySpaceNameAndOrganizationReturnsOnCall == nil {
+		fake.getRecentEventsBySpaceNameAndOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []sharedaction.LogMessage
+	fake.getRecentEventsBySpaceNameAndOrganizationReturnsOnCall[i] = struct {
+		result1 []v7action.Event
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -11178,6 +14504,73 @@ func (fake *FakeActor) GetRevisionsByApplicationNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRolloutStatus(arg1 resources.Deployment) (v7action.RolloutStatus, v7action.Warnings, error) {
+	fake.getRolloutStatusMutex.Lock()
+	ret, specificReturn := fake.getRolloutStatusReturnsOnCall[len(fake.getRolloutStatusArgsForCall)]
+	fake.getRolloutStatusArgsForCall = append(fake.getRolloutStatusArgsForCall, struct {
+		arg1 resources.Deployment
+	}{arg1})
+	stub := fake.GetRolloutStatusStub
+	fakeReturns := fake.getRolloutStatusReturns
+	fake.recordInvocation("GetRolloutStatus", []interface{}{arg1})
+	fake.getRolloutStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRolloutStatusCallCount() int {
+	fake.getRolloutStatusMutex.RLock()
+	defer fake.getRolloutStatusMutex.RUnlock()
+	return len(fake.getRolloutStatusArgsForCall)
+}
+
+func (fake *FakeActor) GetRolloutStatusCalls(stub func(resources.Deployment) (v7action.RolloutStatus, v7action.Warnings, error)) {
+	fake.getRolloutStatusMutex.Lock()
+	defer fake.getRolloutStatusMutex.Unlock()
+	fake.GetRolloutStatusStub = stub
+}
+
+func (fake *FakeActor) GetRolloutStatusArgsForCall(i int) resources.Deployment {
+	fake.getRolloutStatusMutex.RLock()
+	defer fake.getRolloutStatusMutex.RUnlock()
+	argsForCall := fake.getRolloutStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRolloutStatusReturns(result1 v7action.RolloutStatus, result2 v7action.Warnings, result3 error) {
+	fake.getRolloutStatusMutex.Lock()
+	defer fake.getRolloutStatusMutex.Unlock()
+	fake.GetRolloutStatusStub = nil
+	fake.getRolloutStatusReturns = struct {
+		result1 v7action.RolloutStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRolloutStatusReturnsOnCall(i int, result1 v7action.RolloutStatus, result2 v7action.Warnings, result3 error) {
+	fake.getRolloutStatusMutex.Lock()
+	defer fake.getRolloutStatusMutex.Unlock()
+	fake.GetRolloutStatusStub = nil
+	if fake.getRolloutStatusReturnsOnCall == nil {
+		fake.getRolloutStatusReturnsOnCall = make(map[int]struct {
+			result1 v7action.RolloutStatus
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRolloutStatusReturnsOnCall[i] = struct {
+		result1 v7action.RolloutStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRootResponse() (v7action.Info, v7action.Warnings, error) {
 	fake.getRootResponseMutex.Lock()
 	ret, specificReturn := fake.getRootResponseReturnsOnCall[len(fake.getRootResponseArgsForCall)]
@@ -11307,6 +14700,76 @@ func (fake *FakeActor) GetRouteByAttributesReturnsOnCall(i int, result1 resource
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRouteCollision(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (actionerror.RouteInDifferentSpaceError, v7action.Warnings, error) {
+	fake.getRouteCollisionMutex.Lock()
+	ret, specificReturn := fake.getRouteCollisionReturnsOnCall[len(fake.getRouteCollisionArgsForCall)]
+	fake.getRouteCollisionArgsForCall = append(fake.getRouteCollisionArgsForCall, struct {
+		arg1 resources.Domain
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetRouteCollisionStub
+	fakeReturns := fake.getRouteCollisionReturns
+	fake.recordInvocation("GetRouteCollision", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRouteCollisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteCollisionCallCount() int {
+	fake.getRouteCollisionMutex.RLock()
+	defer fake.getRouteCollisionMutex.RUnlock()
+	return len(fake.getRouteCollisionArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteCollisionCalls(stub func(resources.Domain, string, string, int) (actionerror.RouteInDifferentSpaceError, v7action.Warnings, error)) {
+	fake.getRouteCollisionMutex.Lock()
+	defer fake.getRouteCollisionMutex.Unlock()
+	fake.GetRouteCollisionStub = stub
+}
+
+func (fake *FakeActor) GetRouteCollisionArgsForCall(i int) (resources.Domain, string, string, int) {
+	fake.getRouteCollisionMutex.RLock()
+	defer fake.getRouteCollisionMutex.RUnlock()
+	argsForCall := fake.getRouteCollisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetRouteCollisionReturns(result1 actionerror.RouteInDifferentSpaceError, result2 v7action.Warnings, result3 error) {
+	fake.getRouteCollisionMutex.Lock()
+	defer fake.getRouteCollisionMutex.Unlock()
+	fake.GetRouteCollisionStub = nil
+	fake.getRouteCollisionReturns = struct {
+		result1 actionerror.RouteInDifferentSpaceError
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteCollisionReturnsOnCall(i int, result1 actionerror.RouteInDifferentSpaceError, result2 v7action.Warnings, result3 error) {
+	fake.getRouteCollisionMutex.Lock()
+	defer fake.getRouteCollisionMutex.Unlock()
+	fake.GetRouteCollisionStub = nil
+	if fake.getRouteCollisionReturnsOnCall == nil {
+		fake.getRouteCollisionReturnsOnCall = make(map[int]struct {
+			result1 actionerror.RouteInDifferentSpaceError
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteCollisionReturnsOnCall[i] = struct {
+		result1 actionerror.RouteInDifferentSpaceError
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouteDestinationByAppGUID(arg1 resources.Route, arg2 string) (resources.RouteDestination, error) {
 	fake.getRouteDestinationByAppGUIDMutex.Lock()
 	ret, specificReturn := fake.getRouteDestinationByAppGUIDReturnsOnCall[len(fake.getRouteDestinationByAppGUIDArgsForCall)]
@@ -11366,10 +14829,77 @@ func (fake *FakeActor) GetRouteDestinationByAppGUIDReturnsOnCall(i int, result1
 			result2 error
 		})
 	}
-	fake.getRouteDestinationByAppGUIDReturnsOnCall[i] = struct {
-		result1 resources.RouteDestination
-		result2 error
-	}{result1, result2}
+	fake.getRouteDestinationByAppGUIDReturnsOnCall[i] = struct {
+		result1 resources.RouteDestination
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetRouteHistory(arg1 string) ([]v7action.RouteHistoryEntry, v7action.Warnings, error) {
+	fake.getRouteHistoryMutex.Lock()
+	ret, specificReturn := fake.getRouteHistoryReturnsOnCall[len(fake.getRouteHistoryArgsForCall)]
+	fake.getRouteHistoryArgsForCall = append(fake.getRouteHistoryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRouteHistoryStub
+	fakeReturns := fake.getRouteHistoryReturns
+	fake.recordInvocation("GetRouteHistory", []interface{}{arg1})
+	fake.getRouteHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteHistoryCallCount() int {
+	fake.getRouteHistoryMutex.RLock()
+	defer fake.getRouteHistoryMutex.RUnlock()
+	return len(fake.getRouteHistoryArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteHistoryCalls(stub func(string) ([]v7action.RouteHistoryEntry, v7action.Warnings, error)) {
+	fake.getRouteHistoryMutex.Lock()
+	defer fake.getRouteHistoryMutex.Unlock()
+	fake.GetRouteHistoryStub = stub
+}
+
+func (fake *FakeActor) GetRouteHistoryArgsForCall(i int) string {
+	fake.getRouteHistoryMutex.RLock()
+	defer fake.getRouteHistoryMutex.RUnlock()
+	argsForCall := fake.getRouteHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouteHistoryReturns(result1 []v7action.RouteHistoryEntry, result2 v7action.Warnings, result3 error) {
+	fake.getRouteHistoryMutex.Lock()
+	defer fake.getRouteHistoryMutex.Unlock()
+	fake.GetRouteHistoryStub = nil
+	fake.getRouteHistoryReturns = struct {
+		result1 []v7action.RouteHistoryEntry
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteHistoryReturnsOnCall(i int, result1 []v7action.RouteHistoryEntry, result2 v7action.Warnings, result3 error) {
+	fake.getRouteHistoryMutex.Lock()
+	defer fake.getRouteHistoryMutex.Unlock()
+	fake.GetRouteHistoryStub = nil
+	if fake.getRouteHistoryReturnsOnCall == nil {
+		fake.getRouteHistoryReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteHistoryEntry
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteHistoryReturnsOnCall[i] = struct {
+		result1 []v7action.RouteHistoryEntry
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeActor) GetRouteLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
@@ -11568,6 +15098,73 @@ func (fake *FakeActor) GetRouterGroupsReturnsOnCall(i int, result1 []v7action.Ro
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetRoutesByDomain(arg1 string) ([]resources.Route, v7action.Warnings, error) {
+	fake.getRoutesByDomainMutex.Lock()
+	ret, specificReturn := fake.getRoutesByDomainReturnsOnCall[len(fake.getRoutesByDomainArgsForCall)]
+	fake.getRoutesByDomainArgsForCall = append(fake.getRoutesByDomainArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRoutesByDomainStub
+	fakeReturns := fake.getRoutesByDomainReturns
+	fake.recordInvocation("GetRoutesByDomain", []interface{}{arg1})
+	fake.getRoutesByDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRoutesByDomainCallCount() int {
+	fake.getRoutesByDomainMutex.RLock()
+	defer fake.getRoutesByDomainMutex.RUnlock()
+	return len(fake.getRoutesByDomainArgsForCall)
+}
+
+func (fake *FakeActor) GetRoutesByDomainCalls(stub func(string) ([]resources.Route, v7action.Warnings, error)) {
+	fake.getRoutesByDomainMutex.Lock()
+	defer fake.getRoutesByDomainMutex.Unlock()
+	fake.GetRoutesByDomainStub = stub
+}
+
+func (fake *FakeActor) GetRoutesByDomainArgsForCall(i int) string {
+	fake.getRoutesByDomainMutex.RLock()
+	defer fake.getRoutesByDomainMutex.RUnlock()
+	argsForCall := fake.getRoutesByDomainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRoutesByDomainReturns(result1 []resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRoutesByDomainMutex.Lock()
+	defer fake.getRoutesByDomainMutex.Unlock()
+	fake.GetRoutesByDomainStub = nil
+	fake.getRoutesByDomainReturns = struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRoutesByDomainReturnsOnCall(i int, result1 []resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRoutesByDomainMutex.Lock()
+	defer fake.getRoutesByDomainMutex.Unlock()
+	fake.GetRoutesByDomainStub = nil
+	if fake.getRoutesByDomainReturnsOnCall == nil {
+		fake.getRoutesByDomainReturnsOnCall = make(map[int]struct {
+			result1 []resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesByDomainReturnsOnCall[i] = struct {
+		result1 []resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRoutesByOrg(arg1 string, arg2 string) ([]resources.Route, v7action.Warnings, error) {
 	fake.getRoutesByOrgMutex.Lock()
 	ret, specificReturn := fake.getRoutesByOrgReturnsOnCall[len(fake.getRoutesByOrgArgsForCall)]
@@ -11895,6 +15492,78 @@ func (fake *FakeActor) GetSSHPasscodeReturnsOnCall(i int, result1 string, result
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetSSHStatusesForSpaces(arg1 []resources.Space) ([]v7action.AppSSHStatus, v7action.Warnings, error) {
+	var arg1Copy []resources.Space
+	if arg1 != nil {
+		arg1Copy = make([]resources.Space, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getSSHStatusesForSpacesMutex.Lock()
+	ret, specificReturn := fake.getSSHStatusesForSpacesReturnsOnCall[len(fake.getSSHStatusesForSpacesArgsForCall)]
+	fake.getSSHStatusesForSpacesArgsForCall = append(fake.getSSHStatusesForSpacesArgsForCall, struct {
+		arg1 []resources.Space
+	}{arg1Copy})
+	stub := fake.GetSSHStatusesForSpacesStub
+	fakeReturns := fake.getSSHStatusesForSpacesReturns
+	fake.recordInvocation("GetSSHStatusesForSpaces", []interface{}{arg1Copy})
+	fake.getSSHStatusesForSpacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSSHStatusesForSpacesCallCount() int {
+	fake.getSSHStatusesForSpacesMutex.RLock()
+	defer fake.getSSHStatusesForSpacesMutex.RUnlock()
+	return len(fake.getSSHStatusesForSpacesArgsForCall)
+}
+
+func (fake *FakeActor) GetSSHStatusesForSpacesCalls(stub func([]resources.Space) ([]v7action.AppSSHStatus, v7action.Warnings, error)) {
+	fake.getSSHStatusesForSpacesMutex.Lock()
+	defer fake.getSSHStatusesForSpacesMutex.Unlock()
+	fake.GetSSHStatusesForSpacesStub = stub
+}
+
+func (fake *FakeActor) GetSSHStatusesForSpacesArgsForCall(i int) []resources.Space {
+	fake.getSSHStatusesForSpacesMutex.RLock()
+	defer fake.getSSHStatusesForSpacesMutex.RUnlock()
+	argsForCall := fake.getSSHStatusesForSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetSSHStatusesForSpacesReturns(result1 []v7action.AppSSHStatus, result2 v7action.Warnings, result3 error) {
+	fake.getSSHStatusesForSpacesMutex.Lock()
+	defer fake.getSSHStatusesForSpacesMutex.Unlock()
+	fake.GetSSHStatusesForSpacesStub = nil
+	fake.getSSHStatusesForSpacesReturns = struct {
+		result1 []v7action.AppSSHStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSSHStatusesForSpacesReturnsOnCall(i int, result1 []v7action.AppSSHStatus, result2 v7action.Warnings, result3 error) {
+	fake.getSSHStatusesForSpacesMutex.Lock()
+	defer fake.getSSHStatusesForSpacesMutex.Unlock()
+	fake.GetSSHStatusesForSpacesStub = nil
+	if fake.getSSHStatusesForSpacesReturnsOnCall == nil {
+		fake.getSSHStatusesForSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppSSHStatus
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSSHStatusesForSpacesReturnsOnCall[i] = struct {
+		result1 []v7action.AppSSHStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
 	ret, specificReturn := fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
@@ -12294,6 +15963,141 @@ func (fake *FakeActor) GetServiceBrokerByNameReturnsOnCall(i int, result1 resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceBrokerCatalog(arg1 string) (brokercatalog.Catalog, v7action.Warnings, error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerCatalogReturnsOnCall[len(fake.getServiceBrokerCatalogArgsForCall)]
+	fake.getServiceBrokerCatalogArgsForCall = append(fake.getServiceBrokerCatalogArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerCatalogStub
+	fakeReturns := fake.getServiceBrokerCatalogReturns
+	fake.recordInvocation("GetServiceBrokerCatalog", []interface{}{arg1})
+	fake.getServiceBrokerCatalogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCallCount() int {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.getServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogCalls(stub func(string) (brokercatalog.Catalog, v7action.Warnings, error)) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogArgsForCall(i int) string {
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturns(result1 brokercatalog.Catalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	fake.getServiceBrokerCatalogReturns = struct {
+		result1 brokercatalog.Catalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogReturnsOnCall(i int, result1 brokercatalog.Catalog, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogMutex.Lock()
+	defer fake.getServiceBrokerCatalogMutex.Unlock()
+	fake.GetServiceBrokerCatalogStub = nil
+	if fake.getServiceBrokerCatalogReturnsOnCall == nil {
+		fake.getServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 brokercatalog.Catalog
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 brokercatalog.Catalog
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiff(arg1 string, arg2 brokercatalog.Catalog) ([]v7action.ServicePlanChange, v7action.Warnings, error) {
+	fake.getServiceBrokerCatalogDiffMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerCatalogDiffReturnsOnCall[len(fake.getServiceBrokerCatalogDiffArgsForCall)]
+	fake.getServiceBrokerCatalogDiffArgsForCall = append(fake.getServiceBrokerCatalogDiffArgsForCall, struct {
+		arg1 string
+		arg2 brokercatalog.Catalog
+	}{arg1, arg2})
+	stub := fake.GetServiceBrokerCatalogDiffStub
+	fakeReturns := fake.getServiceBrokerCatalogDiffReturns
+	fake.recordInvocation("GetServiceBrokerCatalogDiff", []interface{}{arg1, arg2})
+	fake.getServiceBrokerCatalogDiffMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiffCallCount() int {
+	fake.getServiceBrokerCatalogDiffMutex.RLock()
+	defer fake.getServiceBrokerCatalogDiffMutex.RUnlock()
+	return len(fake.getServiceBrokerCatalogDiffArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiffCalls(stub func(string, brokercatalog.Catalog) ([]v7action.ServicePlanChange, v7action.Warnings, error)) {
+	fake.getServiceBrokerCatalogDiffMutex.Lock()
+	defer fake.getServiceBrokerCatalogDiffMutex.Unlock()
+	fake.GetServiceBrokerCatalogDiffStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiffArgsForCall(i int) (string, brokercatalog.Catalog) {
+	fake.getServiceBrokerCatalogDiffMutex.RLock()
+	defer fake.getServiceBrokerCatalogDiffMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerCatalogDiffArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiffReturns(result1 []v7action.ServicePlanChange, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogDiffMutex.Lock()
+	defer fake.getServiceBrokerCatalogDiffMutex.Unlock()
+	fake.GetServiceBrokerCatalogDiffStub = nil
+	fake.getServiceBrokerCatalogDiffReturns = struct {
+		result1 []v7action.ServicePlanChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerCatalogDiffReturnsOnCall(i int, result1 []v7action.ServicePlanChange, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerCatalogDiffMutex.Lock()
+	defer fake.getServiceBrokerCatalogDiffMutex.Unlock()
+	fake.GetServiceBrokerCatalogDiffStub = nil
+	if fake.getServiceBrokerCatalogDiffReturnsOnCall == nil {
+		fake.getServiceBrokerCatalogDiffReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServicePlanChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerCatalogDiffReturnsOnCall[i] = struct {
+		result1 []v7action.ServicePlanChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceBrokerLabels(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getServiceBrokerLabelsMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokerLabelsReturnsOnCall[len(fake.getServiceBrokerLabelsArgsForCall)]
@@ -12420,6 +16224,146 @@ func (fake *FakeActor) GetServiceBrokersReturnsOnCall(i int, result1 []resources
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceDrift(arg1 string, arg2 []v7action.DeclaredServiceInstance) (v7action.ServiceDrift, v7action.Warnings, error) {
+	var arg2Copy []v7action.DeclaredServiceInstance
+	if arg2 != nil {
+		arg2Copy = make([]v7action.DeclaredServiceInstance, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getServiceDriftMutex.Lock()
+	ret, specificReturn := fake.getServiceDriftReturnsOnCall[len(fake.getServiceDriftArgsForCall)]
+	fake.getServiceDriftArgsForCall = append(fake.getServiceDriftArgsForCall, struct {
+		arg1 string
+		arg2 []v7action.DeclaredServiceInstance
+	}{arg1, arg2Copy})
+	stub := fake.GetServiceDriftStub
+	fakeReturns := fake.getServiceDriftReturns
+	fake.recordInvocation("GetServiceDrift", []interface{}{arg1, arg2Copy})
+	fake.getServiceDriftMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceDriftCallCount() int {
+	fake.getServiceDriftMutex.RLock()
+	defer fake.getServiceDriftMutex.RUnlock()
+	return len(fake.getServiceDriftArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceDriftCalls(stub func(string, []v7action.DeclaredServiceInstance) (v7action.ServiceDrift, v7action.Warnings, error)) {
+	fake.getServiceDriftMutex.Lock()
+	defer fake.getServiceDriftMutex.Unlock()
+	fake.GetServiceDriftStub = stub
+}
+
+func (fake *FakeActor) GetServiceDriftArgsForCall(i int) (string, []v7action.DeclaredServiceInstance) {
+	fake.getServiceDriftMutex.RLock()
+	defer fake.getServiceDriftMutex.RUnlock()
+	argsForCall := fake.getServiceDriftArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceDriftReturns(result1 v7action.ServiceDrift, result2 v7action.Warnings, result3 error) {
+	fake.getServiceDriftMutex.Lock()
+	defer fake.getServiceDriftMutex.Unlock()
+	fake.GetServiceDriftStub = nil
+	fake.getServiceDriftReturns = struct {
+		result1 v7action.ServiceDrift
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceDriftReturnsOnCall(i int, result1 v7action.ServiceDrift, result2 v7action.Warnings, result3 error) {
+	fake.getServiceDriftMutex.Lock()
+	defer fake.getServiceDriftMutex.Unlock()
+	fake.GetServiceDriftStub = nil
+	if fake.getServiceDriftReturnsOnCall == nil {
+		fake.getServiceDriftReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceDrift
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceDriftReturnsOnCall[i] = struct {
+		result1 v7action.ServiceDrift
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUID(arg1 string) (resources.ServiceInstance, v7action.Warnings, error) {
+	fake.getServiceInstanceByGUIDMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByGUIDReturnsOnCall[len(fake.getServiceInstanceByGUIDArgsForCall)]
+	fake.getServiceInstanceByGUIDArgsForCall = append(fake.getServiceInstanceByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceInstanceByGUIDStub
+	fakeReturns := fake.getServiceInstanceByGUIDReturns
+	fake.recordInvocation("GetServiceInstanceByGUID", []interface{}{arg1})
+	fake.getServiceInstanceByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUIDCallCount() int {
+	fake.getServiceInstanceByGUIDMutex.RLock()
+	defer fake.getServiceInstanceByGUIDMutex.RUnlock()
+	return len(fake.getServiceInstanceByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUIDCalls(stub func(string) (resources.ServiceInstance, v7action.Warnings, error)) {
+	fake.getServiceInstanceByGUIDMutex.Lock()
+	defer fake.getServiceInstanceByGUIDMutex.Unlock()
+	fake.GetServiceInstanceByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUIDArgsForCall(i int) string {
+	fake.getServiceInstanceByGUIDMutex.RLock()
+	defer fake.getServiceInstanceByGUIDMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUIDReturns(result1 resources.ServiceInstance, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceByGUIDMutex.Lock()
+	defer fake.getServiceInstanceByGUIDMutex.Unlock()
+	fake.GetServiceInstanceByGUIDStub = nil
+	fake.getServiceInstanceByGUIDReturns = struct {
+		result1 resources.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceByGUIDReturnsOnCall(i int, result1 resources.ServiceInstance, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceByGUIDMutex.Lock()
+	defer fake.getServiceInstanceByGUIDMutex.Unlock()
+	fake.GetServiceInstanceByGUIDStub = nil
+	if fake.getServiceInstanceByGUIDReturnsOnCall == nil {
+		fake.getServiceInstanceByGUIDReturnsOnCall = make(map[int]struct {
+			result1 resources.ServiceInstance
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByGUIDReturnsOnCall[i] = struct {
+		result1 resources.ServiceInstance
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceInstanceByNameAndSpace(arg1 string, arg2 string) (resources.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
@@ -12539,18 +16483,87 @@ func (fake *FakeActor) GetServiceInstanceDetailsReturns(result1 v7action.Service
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceInstanceDetailsReturnsOnCall(i int, result1 v7action.ServiceInstanceDetails, result2 v7action.Warnings, result3 error) {
-	fake.getServiceInstanceDetailsMutex.Lock()
-	defer fake.getServiceInstanceDetailsMutex.Unlock()
-	fake.GetServiceInstanceDetailsStub = nil
-	if fake.getServiceInstanceDetailsReturnsOnCall == nil {
-		fake.getServiceInstanceDetailsReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) GetServiceInstanceDetailsReturnsOnCall(i int, result1 v7action.ServiceInstanceDetails, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceDetailsMutex.Lock()
+	defer fake.getServiceInstanceDetailsMutex.Unlock()
+	fake.GetServiceInstanceDetailsStub = nil
+	if fake.getServiceInstanceDetailsReturnsOnCall == nil {
+		fake.getServiceInstanceDetailsReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstanceDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceDetailsReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstanceDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUID(arg1 string, arg2 string, arg3 bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error) {
+	fake.getServiceInstanceDetailsByGUIDMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceDetailsByGUIDReturnsOnCall[len(fake.getServiceInstanceDetailsByGUIDArgsForCall)]
+	fake.getServiceInstanceDetailsByGUIDArgsForCall = append(fake.getServiceInstanceDetailsByGUIDArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetServiceInstanceDetailsByGUIDStub
+	fakeReturns := fake.getServiceInstanceDetailsByGUIDReturns
+	fake.recordInvocation("GetServiceInstanceDetailsByGUID", []interface{}{arg1, arg2, arg3})
+	fake.getServiceInstanceDetailsByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUIDCallCount() int {
+	fake.getServiceInstanceDetailsByGUIDMutex.RLock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.RUnlock()
+	return len(fake.getServiceInstanceDetailsByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUIDCalls(stub func(string, string, bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)) {
+	fake.getServiceInstanceDetailsByGUIDMutex.Lock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.Unlock()
+	fake.GetServiceInstanceDetailsByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUIDArgsForCall(i int) (string, string, bool) {
+	fake.getServiceInstanceDetailsByGUIDMutex.RLock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceDetailsByGUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUIDReturns(result1 v7action.ServiceInstanceDetails, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceDetailsByGUIDMutex.Lock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.Unlock()
+	fake.GetServiceInstanceDetailsByGUIDStub = nil
+	fake.getServiceInstanceDetailsByGUIDReturns = struct {
+		result1 v7action.ServiceInstanceDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceDetailsByGUIDReturnsOnCall(i int, result1 v7action.ServiceInstanceDetails, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceDetailsByGUIDMutex.Lock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.Unlock()
+	fake.GetServiceInstanceDetailsByGUIDStub = nil
+	if fake.getServiceInstanceDetailsByGUIDReturnsOnCall == nil {
+		fake.getServiceInstanceDetailsByGUIDReturnsOnCall = make(map[int]struct {
 			result1 v7action.ServiceInstanceDetails
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceInstanceDetailsReturnsOnCall[i] = struct {
+	fake.getServiceInstanceDetailsByGUIDReturnsOnCall[i] = struct {
 		result1 v7action.ServiceInstanceDetails
 		result2 v7action.Warnings
 		result3 error
@@ -12693,19 +16706,155 @@ func (fake *FakeActor) GetServiceInstanceParametersReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 bool) ([]v7action.ServiceInstance, v7action.Warnings, error) {
+func (fake *FakeActor) GetServiceInstanceParametersByGUID(arg1 string) (v7action.ServiceInstanceParameters, v7action.Warnings, error) {
+	fake.getServiceInstanceParametersByGUIDMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceParametersByGUIDReturnsOnCall[len(fake.getServiceInstanceParametersByGUIDArgsForCall)]
+	fake.getServiceInstanceParametersByGUIDArgsForCall = append(fake.getServiceInstanceParametersByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceInstanceParametersByGUIDStub
+	fakeReturns := fake.getServiceInstanceParametersByGUIDReturns
+	fake.recordInvocation("GetServiceInstanceParametersByGUID", []interface{}{arg1})
+	fake.getServiceInstanceParametersByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceParametersByGUIDCallCount() int {
+	fake.getServiceInstanceParametersByGUIDMutex.RLock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.RUnlock()
+	return len(fake.getServiceInstanceParametersByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceParametersByGUIDCalls(stub func(string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)) {
+	fake.getServiceInstanceParametersByGUIDMutex.Lock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.Unlock()
+	fake.GetServiceInstanceParametersByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceParametersByGUIDArgsForCall(i int) string {
+	fake.getServiceInstanceParametersByGUIDMutex.RLock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceParametersByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceInstanceParametersByGUIDReturns(result1 v7action.ServiceInstanceParameters, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceParametersByGUIDMutex.Lock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.Unlock()
+	fake.GetServiceInstanceParametersByGUIDStub = nil
+	fake.getServiceInstanceParametersByGUIDReturns = struct {
+		result1 v7action.ServiceInstanceParameters
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceParametersByGUIDReturnsOnCall(i int, result1 v7action.ServiceInstanceParameters, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceParametersByGUIDMutex.Lock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.Unlock()
+	fake.GetServiceInstanceParametersByGUIDStub = nil
+	if fake.getServiceInstanceParametersByGUIDReturnsOnCall == nil {
+		fake.getServiceInstanceParametersByGUIDReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstanceParameters
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceParametersByGUIDReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstanceParameters
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgrade(arg1 string, arg2 string) (v7action.ServiceInstanceUpgrade, v7action.Warnings, error) {
+	fake.getServiceInstanceUpgradeMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceUpgradeReturnsOnCall[len(fake.getServiceInstanceUpgradeArgsForCall)]
+	fake.getServiceInstanceUpgradeArgsForCall = append(fake.getServiceInstanceUpgradeArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceInstanceUpgradeStub
+	fakeReturns := fake.getServiceInstanceUpgradeReturns
+	fake.recordInvocation("GetServiceInstanceUpgrade", []interface{}{arg1, arg2})
+	fake.getServiceInstanceUpgradeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCallCount() int {
+	fake.getServiceInstanceUpgradeMutex.RLock()
+	defer fake.getServiceInstanceUpgradeMutex.RUnlock()
+	return len(fake.getServiceInstanceUpgradeArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeCalls(stub func(string, string) (v7action.ServiceInstanceUpgrade, v7action.Warnings, error)) {
+	fake.getServiceInstanceUpgradeMutex.Lock()
+	defer fake.getServiceInstanceUpgradeMutex.Unlock()
+	fake.GetServiceInstanceUpgradeStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceUpgradeMutex.RLock()
+	defer fake.getServiceInstanceUpgradeMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceUpgradeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeReturns(result1 v7action.ServiceInstanceUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceUpgradeMutex.Lock()
+	defer fake.getServiceInstanceUpgradeMutex.Unlock()
+	fake.GetServiceInstanceUpgradeStub = nil
+	fake.getServiceInstanceUpgradeReturns = struct {
+		result1 v7action.ServiceInstanceUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceUpgradeReturnsOnCall(i int, result1 v7action.ServiceInstanceUpgrade, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceUpgradeMutex.Lock()
+	defer fake.getServiceInstanceUpgradeMutex.Unlock()
+	fake.GetServiceInstanceUpgradeStub = nil
+	if fake.getServiceInstanceUpgradeReturnsOnCall == nil {
+		fake.getServiceInstanceUpgradeReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceInstanceUpgrade
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceUpgradeReturnsOnCall[i] = struct {
+		result1 v7action.ServiceInstanceUpgrade
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 bool, arg3 string) ([]v7action.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesForSpaceReturnsOnCall[len(fake.getServiceInstancesForSpaceArgsForCall)]
 	fake.getServiceInstancesForSpaceArgsForCall = append(fake.getServiceInstancesForSpaceArgsForCall, struct {
 		arg1 string
 		arg2 bool
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.GetServiceInstancesForSpaceStub
 	fakeReturns := fake.getServiceInstancesForSpaceReturns
-	fake.recordInvocation("GetServiceInstancesForSpace", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetServiceInstancesForSpace", []interface{}{arg1, arg2, arg3})
 	fake.getServiceInstancesForSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -12719,17 +16868,17 @@ func (fake *FakeActor) GetServiceInstancesForSpaceCallCount() int {
 	return len(fake.getServiceInstancesForSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpaceCalls(stub func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)) {
+func (fake *FakeActor) GetServiceInstancesForSpaceCalls(stub func(string, bool, string) ([]v7action.ServiceInstance, v7action.Warnings, error)) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	defer fake.getServiceInstancesForSpaceMutex.Unlock()
 	fake.GetServiceInstancesForSpaceStub = stub
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpaceArgsForCall(i int) (string, bool) {
+func (fake *FakeActor) GetServiceInstancesForSpaceArgsForCall(i int) (string, bool, string) {
 	fake.getServiceInstancesForSpaceMutex.RLock()
 	defer fake.getServiceInstancesForSpaceMutex.RUnlock()
 	argsForCall := fake.getServiceInstancesForSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeActor) GetServiceInstancesForSpaceReturns(result1 []v7action.ServiceInstance, result2 v7action.Warnings, result3 error) {
@@ -13173,6 +17322,73 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSpaceByGUID(arg1 string) (resources.Space, v7action.Warnings, error) {
+	fake.getSpaceByGUIDMutex.Lock()
+	ret, specificReturn := fake.getSpaceByGUIDReturnsOnCall[len(fake.getSpaceByGUIDArgsForCall)]
+	fake.getSpaceByGUIDArgsForCall = append(fake.getSpaceByGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSpaceByGUIDStub
+	fakeReturns := fake.getSpaceByGUIDReturns
+	fake.recordInvocation("GetSpaceByGUID", []interface{}{arg1})
+	fake.getSpaceByGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSpaceByGUIDCallCount() int {
+	fake.getSpaceByGUIDMutex.RLock()
+	defer fake.getSpaceByGUIDMutex.RUnlock()
+	return len(fake.getSpaceByGUIDArgsForCall)
+}
+
+func (fake *FakeActor) GetSpaceByGUIDCalls(stub func(string) (resources.Space, v7action.Warnings, error)) {
+	fake.getSpaceByGUIDMutex.Lock()
+	defer fake.getSpaceByGUIDMutex.Unlock()
+	fake.GetSpaceByGUIDStub = stub
+}
+
+func (fake *FakeActor) GetSpaceByGUIDArgsForCall(i int) string {
+	fake.getSpaceByGUIDMutex.RLock()
+	defer fake.getSpaceByGUIDMutex.RUnlock()
+	argsForCall := fake.getSpaceByGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetSpaceByGUIDReturns(result1 resources.Space, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceByGUIDMutex.Lock()
+	defer fake.getSpaceByGUIDMutex.Unlock()
+	fake.GetSpaceByGUIDStub = nil
+	fake.getSpaceByGUIDReturns = struct {
+		result1 resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceByGUIDReturnsOnCall(i int, result1 resources.Space, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceByGUIDMutex.Lock()
+	defer fake.getSpaceByGUIDMutex.Unlock()
+	fake.GetSpaceByGUIDStub = nil
+	if fake.getSpaceByGUIDReturnsOnCall == nil {
+		fake.getSpaceByGUIDReturnsOnCall = make(map[int]struct {
+			result1 resources.Space
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByGUIDReturnsOnCall[i] = struct {
+		result1 resources.Space
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
 	fake.getSpaceByNameAndOrganizationMutex.Lock()
 	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
@@ -13581,6 +17797,74 @@ func (fake *FakeActor) GetSpaceSummaryByNameAndOrganizationReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSpaceSummaryForSpace(arg1 resources.Space, arg2 resources.Organization) (v7action.SpaceSummary, v7action.Warnings, error) {
+	fake.getSpaceSummaryForSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceSummaryForSpaceReturnsOnCall[len(fake.getSpaceSummaryForSpaceArgsForCall)]
+	fake.getSpaceSummaryForSpaceArgsForCall = append(fake.getSpaceSummaryForSpaceArgsForCall, struct {
+		arg1 resources.Space
+		arg2 resources.Organization
+	}{arg1, arg2})
+	stub := fake.GetSpaceSummaryForSpaceStub
+	fakeReturns := fake.getSpaceSummaryForSpaceReturns
+	fake.recordInvocation("GetSpaceSummaryForSpace", []interface{}{arg1, arg2})
+	fake.getSpaceSummaryForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSpaceSummaryForSpaceCallCount() int {
+	fake.getSpaceSummaryForSpaceMutex.RLock()
+	defer fake.getSpaceSummaryForSpaceMutex.RUnlock()
+	return len(fake.getSpaceSummaryForSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetSpaceSummaryForSpaceCalls(stub func(resources.Space, resources.Organization) (v7action.SpaceSummary, v7action.Warnings, error)) {
+	fake.getSpaceSummaryForSpaceMutex.Lock()
+	defer fake.getSpaceSummaryForSpaceMutex.Unlock()
+	fake.GetSpaceSummaryForSpaceStub = stub
+}
+
+func (fake *FakeActor) GetSpaceSummaryForSpaceArgsForCall(i int) (resources.Space, resources.Organization) {
+	fake.getSpaceSummaryForSpaceMutex.RLock()
+	defer fake.getSpaceSummaryForSpaceMutex.RUnlock()
+	argsForCall := fake.getSpaceSummaryForSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetSpaceSummaryForSpaceReturns(result1 v7action.SpaceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceSummaryForSpaceMutex.Lock()
+	defer fake.getSpaceSummaryForSpaceMutex.Unlock()
+	fake.GetSpaceSummaryForSpaceStub = nil
+	fake.getSpaceSummaryForSpaceReturns = struct {
+		result1 v7action.SpaceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceSummaryForSpaceReturnsOnCall(i int, result1 v7action.SpaceSummary, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceSummaryForSpaceMutex.Lock()
+	defer fake.getSpaceSummaryForSpaceMutex.Unlock()
+	fake.GetSpaceSummaryForSpaceStub = nil
+	if fake.getSpaceSummaryForSpaceReturnsOnCall == nil {
+		fake.getSpaceSummaryForSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpaceSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceSummaryForSpaceReturnsOnCall[i] = struct {
+		result1 v7action.SpaceSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceUsersByRoleType(arg1 string) (map[constanta.RoleType][]resources.User, v7action.Warnings, error) {
 	fake.getSpaceUsersByRoleTypeMutex.Lock()
 	ret, specificReturn := fake.getSpaceUsersByRoleTypeReturnsOnCall[len(fake.getSpaceUsersByRoleTypeArgsForCall)]
@@ -14180,27 +18464,172 @@ func (fake *FakeActor) GetUserReturnsOnCall(i int, result1 resources.User, resul
 	}{result1, result2}
 }
 
-func (fake *FakeActor) MakeCurlRequest(arg1 string, arg2 string, arg3 []string, arg4 string, arg5 bool) ([]byte, *http.Response, error) {
-	var arg3Copy []string
-	if arg3 != nil {
-		arg3Copy = make([]string, len(arg3))
-		copy(arg3Copy, arg3)
+func (fake *FakeActor) LookupGUID(arg1 string) ([]v7action.GUIDLookupResult, v7action.Warnings, error) {
+	fake.lookupGUIDMutex.Lock()
+	ret, specificReturn := fake.lookupGUIDReturnsOnCall[len(fake.lookupGUIDArgsForCall)]
+	fake.lookupGUIDArgsForCall = append(fake.lookupGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.LookupGUIDStub
+	fakeReturns := fake.lookupGUIDReturns
+	fake.recordInvocation("LookupGUID", []interface{}{arg1})
+	fake.lookupGUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) LookupGUIDCallCount() int {
+	fake.lookupGUIDMutex.RLock()
+	defer fake.lookupGUIDMutex.RUnlock()
+	return len(fake.lookupGUIDArgsForCall)
+}
+
+func (fake *FakeActor) LookupGUIDCalls(stub func(string) ([]v7action.GUIDLookupResult, v7action.Warnings, error)) {
+	fake.lookupGUIDMutex.Lock()
+	defer fake.lookupGUIDMutex.Unlock()
+	fake.LookupGUIDStub = stub
+}
+
+func (fake *FakeActor) LookupGUIDArgsForCall(i int) string {
+	fake.lookupGUIDMutex.RLock()
+	defer fake.lookupGUIDMutex.RUnlock()
+	argsForCall := fake.lookupGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) LookupGUIDReturns(result1 []v7action.GUIDLookupResult, result2 v7action.Warnings, result3 error) {
+	fake.lookupGUIDMutex.Lock()
+	defer fake.lookupGUIDMutex.Unlock()
+	fake.LookupGUIDStub = nil
+	fake.lookupGUIDReturns = struct {
+		result1 []v7action.GUIDLookupResult
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) LookupGUIDReturnsOnCall(i int, result1 []v7action.GUIDLookupResult, result2 v7action.Warnings, result3 error) {
+	fake.lookupGUIDMutex.Lock()
+	defer fake.lookupGUIDMutex.Unlock()
+	fake.LookupGUIDStub = nil
+	if fake.lookupGUIDReturnsOnCall == nil {
+		fake.lookupGUIDReturnsOnCall = make(map[int]struct {
+			result1 []v7action.GUIDLookupResult
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.lookupGUIDReturnsOnCall[i] = struct {
+		result1 []v7action.GUIDLookupResult
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MakeCurlRequest(arg1 v7action.CurlTarget, arg2 string, arg3 string, arg4 []string, arg5 string, arg6 bool) ([]byte, *http.Response, error) {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
 	}
 	fake.makeCurlRequestMutex.Lock()
 	ret, specificReturn := fake.makeCurlRequestReturnsOnCall[len(fake.makeCurlRequestArgsForCall)]
 	fake.makeCurlRequestArgsForCall = append(fake.makeCurlRequestArgsForCall, struct {
-		arg1 string
+		arg1 v7action.CurlTarget
 		arg2 string
-		arg3 []string
-		arg4 string
-		arg5 bool
-	}{arg1, arg2, arg3Copy, arg4, arg5})
+		arg3 string
+		arg4 []string
+		arg5 string
+		arg6 bool
+	}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
 	stub := fake.MakeCurlRequestStub
 	fakeReturns := fake.makeCurlRequestReturns
-	fake.recordInvocation("MakeCurlRequest", []interface{}{arg1, arg2, arg3Copy, arg4, arg5})
+	fake.recordInvocation("MakeCurlRequest", []interface{}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
 	fake.makeCurlRequestMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) MakeCurlRequestCallCount() int {
+	fake.makeCurlRequestMutex.RLock()
+	defer fake.makeCurlRequestMutex.RUnlock()
+	return len(fake.makeCurlRequestArgsForCall)
+}
+
+func (fake *FakeActor) MakeCurlRequestCalls(stub func(v7action.CurlTarget, string, string, []string, string, bool) ([]byte, *http.Response, error)) {
+	fake.makeCurlRequestMutex.Lock()
+	defer fake.makeCurlRequestMutex.Unlock()
+	fake.MakeCurlRequestStub = stub
+}
+
+func (fake *FakeActor) MakeCurlRequestArgsForCall(i int) (v7action.CurlTarget, string, string, []string, string, bool) {
+	fake.makeCurlRequestMutex.RLock()
+	defer fake.makeCurlRequestMutex.RUnlock()
+	argsForCall := fake.makeCurlRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeActor) MakeCurlRequestReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeCurlRequestMutex.Lock()
+	defer fake.makeCurlRequestMutex.Unlock()
+	fake.MakeCurlRequestStub = nil
+	fake.makeCurlRequestReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MakeCurlRequestReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeCurlRequestMutex.Lock()
+	defer fake.makeCurlRequestMutex.Unlock()
+	fake.MakeCurlRequestStub = nil
+	if fake.makeCurlRequestReturnsOnCall == nil {
+		fake.makeCurlRequestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 error
+		})
+	}
+	fake.makeCurlRequestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MakeLogCacheCurlRequest(arg1 v7action.CurlRequester, arg2 string, arg3 string, arg4 []string, arg5 string, arg6 bool) ([]byte, *http.Response, error) {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.makeLogCacheCurlRequestMutex.Lock()
+	ret, specificReturn := fake.makeLogCacheCurlRequestReturnsOnCall[len(fake.makeLogCacheCurlRequestArgsForCall)]
+	fake.makeLogCacheCurlRequestArgsForCall = append(fake.makeLogCacheCurlRequestArgsForCall, struct {
+		arg1 v7action.CurlRequester
+		arg2 string
+		arg3 string
+		arg4 []string
+		arg5 string
+		arg6 bool
+	}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
+	stub := fake.MakeLogCacheCurlRequestStub
+	fakeReturns := fake.makeLogCacheCurlRequestReturns
+	fake.recordInvocation("MakeLogCacheCurlRequest", []interface{}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
+	fake.makeLogCacheCurlRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -14208,48 +18637,48 @@ func (fake *FakeActor) MakeCurlRequest(arg1 string, arg2 string, arg3 []string,
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) MakeCurlRequestCallCount() int {
-	fake.makeCurlRequestMutex.RLock()
-	defer fake.makeCurlRequestMutex.RUnlock()
-	return len(fake.makeCurlRequestArgsForCall)
+func (fake *FakeActor) MakeLogCacheCurlRequestCallCount() int {
+	fake.makeLogCacheCurlRequestMutex.RLock()
+	defer fake.makeLogCacheCurlRequestMutex.RUnlock()
+	return len(fake.makeLogCacheCurlRequestArgsForCall)
 }
 
-func (fake *FakeActor) MakeCurlRequestCalls(stub func(string, string, []string, string, bool) ([]byte, *http.Response, error)) {
-	fake.makeCurlRequestMutex.Lock()
-	defer fake.makeCurlRequestMutex.Unlock()
-	fake.MakeCurlRequestStub = stub
+func (fake *FakeActor) MakeLogCacheCurlRequestCalls(stub func(v7action.CurlRequester, string, string, []string, string, bool) ([]byte, *http.Response, error)) {
+	fake.makeLogCacheCurlRequestMutex.Lock()
+	defer fake.makeLogCacheCurlRequestMutex.Unlock()
+	fake.MakeLogCacheCurlRequestStub = stub
 }
 
-func (fake *FakeActor) MakeCurlRequestArgsForCall(i int) (string, string, []string, string, bool) {
-	fake.makeCurlRequestMutex.RLock()
-	defer fake.makeCurlRequestMutex.RUnlock()
-	argsForCall := fake.makeCurlRequestArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+func (fake *FakeActor) MakeLogCacheCurlRequestArgsForCall(i int) (v7action.CurlRequester, string, string, []string, string, bool) {
+	fake.makeLogCacheCurlRequestMutex.RLock()
+	defer fake.makeLogCacheCurlRequestMutex.RUnlock()
+	argsForCall := fake.makeLogCacheCurlRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
-func (fake *FakeActor) MakeCurlRequestReturns(result1 []byte, result2 *http.Response, result3 error) {
-	fake.makeCurlRequestMutex.Lock()
-	defer fake.makeCurlRequestMutex.Unlock()
-	fake.MakeCurlRequestStub = nil
-	fake.makeCurlRequestReturns = struct {
+func (fake *FakeActor) MakeLogCacheCurlRequestReturns(result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeLogCacheCurlRequestMutex.Lock()
+	defer fake.makeLogCacheCurlRequestMutex.Unlock()
+	fake.MakeLogCacheCurlRequestStub = nil
+	fake.makeLogCacheCurlRequestReturns = struct {
 		result1 []byte
 		result2 *http.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) MakeCurlRequestReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
-	fake.makeCurlRequestMutex.Lock()
-	defer fake.makeCurlRequestMutex.Unlock()
-	fake.MakeCurlRequestStub = nil
-	if fake.makeCurlRequestReturnsOnCall == nil {
-		fake.makeCurlRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) MakeLogCacheCurlRequestReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 error) {
+	fake.makeLogCacheCurlRequestMutex.Lock()
+	defer fake.makeLogCacheCurlRequestMutex.Unlock()
+	fake.MakeLogCacheCurlRequestStub = nil
+	if fake.makeLogCacheCurlRequestReturnsOnCall == nil {
+		fake.makeLogCacheCurlRequestReturnsOnCall = make(map[int]struct {
 			result1 []byte
 			result2 *http.Response
 			result3 error
 		})
 	}
-	fake.makeCurlRequestReturnsOnCall[i] = struct {
+	fake.makeLogCacheCurlRequestReturnsOnCall[i] = struct {
 		result1 []byte
 		result2 *http.Response
 		result3 error
@@ -14322,6 +18751,73 @@ func (fake *FakeActor) MapRouteReturnsOnCall(i int, result1 v7action.Warnings, r
 	}{result1, result2}
 }
 
+func (fake *FakeActor) MapRouteToAppPort(arg1 string, arg2 string, arg3 string, arg4 int) (v7action.Warnings, error) {
+	fake.mapRouteToAppPortMutex.Lock()
+	ret, specificReturn := fake.mapRouteToAppPortReturnsOnCall[len(fake.mapRouteToAppPortArgsForCall)]
+	fake.mapRouteToAppPortArgsForCall = append(fake.mapRouteToAppPortArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.MapRouteToAppPortStub
+	fakeReturns := fake.mapRouteToAppPortReturns
+	fake.recordInvocation("MapRouteToAppPort", []interface{}{arg1, arg2, arg3, arg4})
+	fake.mapRouteToAppPortMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) MapRouteToAppPortCallCount() int {
+	fake.mapRouteToAppPortMutex.RLock()
+	defer fake.mapRouteToAppPortMutex.RUnlock()
+	return len(fake.mapRouteToAppPortArgsForCall)
+}
+
+func (fake *FakeActor) MapRouteToAppPortCalls(stub func(string, string, string, int) (v7action.Warnings, error)) {
+	fake.mapRouteToAppPortMutex.Lock()
+	defer fake.mapRouteToAppPortMutex.Unlock()
+	fake.MapRouteToAppPortStub = stub
+}
+
+func (fake *FakeActor) MapRouteToAppPortArgsForCall(i int) (string, string, string, int) {
+	fake.mapRouteToAppPortMutex.RLock()
+	defer fake.mapRouteToAppPortMutex.RUnlock()
+	argsForCall := fake.mapRouteToAppPortArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) MapRouteToAppPortReturns(result1 v7action.Warnings, result2 error) {
+	fake.mapRouteToAppPortMutex.Lock()
+	defer fake.mapRouteToAppPortMutex.Unlock()
+	fake.MapRouteToAppPortStub = nil
+	fake.mapRouteToAppPortReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) MapRouteToAppPortReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.mapRouteToAppPortMutex.Lock()
+	defer fake.mapRouteToAppPortMutex.Unlock()
+	fake.MapRouteToAppPortStub = nil
+	if fake.mapRouteToAppPortReturnsOnCall == nil {
+		fake.mapRouteToAppPortReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteToAppPortReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) Marketplace(arg1 v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error) {
 	fake.marketplaceMutex.Lock()
 	ret, specificReturn := fake.marketplaceReturnsOnCall[len(fake.marketplaceArgsForCall)]
@@ -14389,6 +18885,74 @@ func (fake *FakeActor) MarketplaceReturnsOnCall(i int, result1 []v7action.Servic
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) MigrateRoute(arg1 resources.Route, arg2 resources.Domain) (resources.Route, v7action.Warnings, error) {
+	fake.migrateRouteMutex.Lock()
+	ret, specificReturn := fake.migrateRouteReturnsOnCall[len(fake.migrateRouteArgsForCall)]
+	fake.migrateRouteArgsForCall = append(fake.migrateRouteArgsForCall, struct {
+		arg1 resources.Route
+		arg2 resources.Domain
+	}{arg1, arg2})
+	stub := fake.MigrateRouteStub
+	fakeReturns := fake.migrateRouteReturns
+	fake.recordInvocation("MigrateRoute", []interface{}{arg1, arg2})
+	fake.migrateRouteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) MigrateRouteCallCount() int {
+	fake.migrateRouteMutex.RLock()
+	defer fake.migrateRouteMutex.RUnlock()
+	return len(fake.migrateRouteArgsForCall)
+}
+
+func (fake *FakeActor) MigrateRouteCalls(stub func(resources.Route, resources.Domain) (resources.Route, v7action.Warnings, error)) {
+	fake.migrateRouteMutex.Lock()
+	defer fake.migrateRouteMutex.Unlock()
+	fake.MigrateRouteStub = stub
+}
+
+func (fake *FakeActor) MigrateRouteArgsForCall(i int) (resources.Route, resources.Domain) {
+	fake.migrateRouteMutex.RLock()
+	defer fake.migrateRouteMutex.RUnlock()
+	argsForCall := fake.migrateRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) MigrateRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.migrateRouteMutex.Lock()
+	defer fake.migrateRouteMutex.Unlock()
+	fake.MigrateRouteStub = nil
+	fake.migrateRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MigrateRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.migrateRouteMutex.Lock()
+	defer fake.migrateRouteMutex.Unlock()
+	fake.MigrateRouteStub = nil
+	if fake.migrateRouteReturnsOnCall == nil {
+		fake.migrateRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.migrateRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) MoveRoute(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.moveRouteMutex.Lock()
 	ret, specificReturn := fake.moveRouteReturnsOnCall[len(fake.moveRouteArgsForCall)]
@@ -14653,6 +19217,74 @@ func (fake *FakeActor) PollPackageReturnsOnCall(i int, result1 resources.Package
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) PollRollout(arg1 resources.Application, arg2 string, arg3 time.Duration, arg4 bool, arg5 func(v7action.RolloutStatus)) (v7action.Warnings, error) {
+	fake.pollRolloutMutex.Lock()
+	ret, specificReturn := fake.pollRolloutReturnsOnCall[len(fake.pollRolloutArgsForCall)]
+	fake.pollRolloutArgsForCall = append(fake.pollRolloutArgsForCall, struct {
+		arg1 resources.Application
+		arg2 string
+		arg3 time.Duration
+		arg4 bool
+		arg5 func(v7action.RolloutStatus)
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.PollRolloutStub
+	fakeReturns := fake.pollRolloutReturns
+	fake.recordInvocation("PollRollout", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.pollRolloutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) PollRolloutCallCount() int {
+	fake.pollRolloutMutex.RLock()
+	defer fake.pollRolloutMutex.RUnlock()
+	return len(fake.pollRolloutArgsForCall)
+}
+
+func (fake *FakeActor) PollRolloutCalls(stub func(resources.Application, string, time.Duration, bool, func(v7action.RolloutStatus)) (v7action.Warnings, error)) {
+	fake.pollRolloutMutex.Lock()
+	defer fake.pollRolloutMutex.Unlock()
+	fake.PollRolloutStub = stub
+}
+
+func (fake *FakeActor) PollRolloutArgsForCall(i int) (resources.Application, string, time.Duration, bool, func(v7action.RolloutStatus)) {
+	fake.pollRolloutMutex.RLock()
+	defer fake.pollRolloutMutex.RUnlock()
+	argsForCall := fake.pollRolloutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) PollRolloutReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollRolloutMutex.Lock()
+	defer fake.pollRolloutMutex.Unlock()
+	fake.PollRolloutStub = nil
+	fake.pollRolloutReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) PollRolloutReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollRolloutMutex.Lock()
+	defer fake.pollRolloutMutex.Unlock()
+	fake.PollRolloutStub = nil
+	if fake.pollRolloutReturnsOnCall == nil {
+		fake.pollRolloutReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pollRolloutReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) PollStart(arg1 resources.Application, arg2 bool, arg3 func(string)) (v7action.Warnings, error) {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -14983,6 +19615,75 @@ func (fake *FakeActor) PrepareBuildpackBitsReturnsOnCall(i int, result1 string,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) PromoteServiceBroker(arg1 string, arg2 string, arg3 string) (v7action.ServiceBrokerPromotion, v7action.Warnings, error) {
+	fake.promoteServiceBrokerMutex.Lock()
+	ret, specificReturn := fake.promoteServiceBrokerReturnsOnCall[len(fake.promoteServiceBrokerArgsForCall)]
+	fake.promoteServiceBrokerArgsForCall = append(fake.promoteServiceBrokerArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PromoteServiceBrokerStub
+	fakeReturns := fake.promoteServiceBrokerReturns
+	fake.recordInvocation("PromoteServiceBroker", []interface{}{arg1, arg2, arg3})
+	fake.promoteServiceBrokerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) PromoteServiceBrokerCallCount() int {
+	fake.promoteServiceBrokerMutex.RLock()
+	defer fake.promoteServiceBrokerMutex.RUnlock()
+	return len(fake.promoteServiceBrokerArgsForCall)
+}
+
+func (fake *FakeActor) PromoteServiceBrokerCalls(stub func(string, string, string) (v7action.ServiceBrokerPromotion, v7action.Warnings, error)) {
+	fake.promoteServiceBrokerMutex.Lock()
+	defer fake.promoteServiceBrokerMutex.Unlock()
+	fake.PromoteServiceBrokerStub = stub
+}
+
+func (fake *FakeActor) PromoteServiceBrokerArgsForCall(i int) (string, string, string) {
+	fake.promoteServiceBrokerMutex.RLock()
+	defer fake.promoteServiceBrokerMutex.RUnlock()
+	argsForCall := fake.promoteServiceBrokerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) PromoteServiceBrokerReturns(result1 v7action.ServiceBrokerPromotion, result2 v7action.Warnings, result3 error) {
+	fake.promoteServiceBrokerMutex.Lock()
+	defer fake.promoteServiceBrokerMutex.Unlock()
+	fake.PromoteServiceBrokerStub = nil
+	fake.promoteServiceBrokerReturns = struct {
+		result1 v7action.ServiceBrokerPromotion
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) PromoteServiceBrokerReturnsOnCall(i int, result1 v7action.ServiceBrokerPromotion, result2 v7action.Warnings, result3 error) {
+	fake.promoteServiceBrokerMutex.Lock()
+	defer fake.promoteServiceBrokerMutex.Unlock()
+	fake.PromoteServiceBrokerStub = nil
+	if fake.promoteServiceBrokerReturnsOnCall == nil {
+		fake.promoteServiceBrokerReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceBrokerPromotion
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.promoteServiceBrokerReturnsOnCall[i] = struct {
+		result1 v7action.ServiceBrokerPromotion
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) PurgeServiceInstance(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.purgeServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.purgeServiceInstanceReturnsOnCall[len(fake.purgeServiceInstanceArgsForCall)]
@@ -15441,6 +20142,62 @@ func (fake *FakeActor) RenameSpaceByNameAndOrganizationGUIDReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) RequestDeviceAuthorization() (v7action.DeviceAuthorization, error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.requestDeviceAuthorizationReturnsOnCall[len(fake.requestDeviceAuthorizationArgsForCall)]
+	fake.requestDeviceAuthorizationArgsForCall = append(fake.requestDeviceAuthorizationArgsForCall, struct {
+	}{})
+	stub := fake.RequestDeviceAuthorizationStub
+	fakeReturns := fake.requestDeviceAuthorizationReturns
+	fake.recordInvocation("RequestDeviceAuthorization", []interface{}{})
+	fake.requestDeviceAuthorizationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) RequestDeviceAuthorizationCallCount() int {
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
+	return len(fake.requestDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeActor) RequestDeviceAuthorizationCalls(stub func() (v7action.DeviceAuthorization, error)) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeActor) RequestDeviceAuthorizationReturns(result1 v7action.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	fake.requestDeviceAuthorizationReturns = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) RequestDeviceAuthorizationReturnsOnCall(i int, result1 v7action.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	if fake.requestDeviceAuthorizationReturnsOnCall == nil {
+		fake.requestDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.requestDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) ResetOrganizationDefaultIsolationSegment(arg1 string) (v7action.Warnings, error) {
 	fake.resetOrganizationDefaultIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.resetOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.resetOrganizationDefaultIsolationSegmentArgsForCall)]
@@ -15710,6 +20467,72 @@ func (fake *FakeActor) RestartApplicationReturnsOnCall(i int, result1 v7action.W
 	}{result1, result2}
 }
 
+func (fake *FakeActor) RestartApplicationInstancesInBatches(arg1 resources.Application, arg2 int, arg3 func(processType string, indexes []int64)) (v7action.Warnings, error) {
+	fake.restartApplicationInstancesInBatchesMutex.Lock()
+	ret, specificReturn := fake.restartApplicationInstancesInBatchesReturnsOnCall[len(fake.restartApplicationInstancesInBatchesArgsForCall)]
+	fake.restartApplicationInstancesInBatchesArgsForCall = append(fake.restartApplicationInstancesInBatchesArgsForCall, struct {
+		arg1 resources.Application
+		arg2 int
+		arg3 func(processType string, indexes []int64)
+	}{arg1, arg2, arg3})
+	stub := fake.RestartApplicationInstancesInBatchesStub
+	fakeReturns := fake.restartApplicationInstancesInBatchesReturns
+	fake.recordInvocation("RestartApplicationInstancesInBatches", []interface{}{arg1, arg2, arg3})
+	fake.restartApplicationInstancesInBatchesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) RestartApplicationInstancesInBatchesCallCount() int {
+	fake.restartApplicationInstancesInBatchesMutex.RLock()
+	defer fake.restartApplicationInstancesInBatchesMutex.RUnlock()
+	return len(fake.restartApplicationInstancesInBatchesArgsForCall)
+}
+
+func (fake *FakeActor) RestartApplicationInstancesInBatchesCalls(stub func(resources.Application, int, func(processType string, indexes []int64)) (v7action.Warnings, error)) {
+	fake.restartApplicationInstancesInBatchesMutex.Lock()
+	defer fake.restartApplicationInstancesInBatchesMutex.Unlock()
+	fake.RestartApplicationInstancesInBatchesStub = stub
+}
+
+func (fake *FakeActor) RestartApplicationInstancesInBatchesArgsForCall(i int) (resources.Application, int, func(processType string, indexes []int64)) {
+	fake.restartApplicationInstancesInBatchesMutex.RLock()
+	defer fake.restartApplicationInstancesInBatchesMutex.RUnlock()
+	argsForCall := fake.restartApplicationInstancesInBatchesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) RestartApplicationInstancesInBatchesReturns(result1 v7action.Warnings, result2 error) {
+	fake.restartApplicationInstancesInBatchesMutex.Lock()
+	defer fake.restartApplicationInstancesInBatchesMutex.Unlock()
+	fake.RestartApplicationInstancesInBatchesStub = nil
+	fake.restartApplicationInstancesInBatchesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) RestartApplicationInstancesInBatchesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.restartApplicationInstancesInBatchesMutex.Lock()
+	defer fake.restartApplicationInstancesInBatchesMutex.Unlock()
+	fake.RestartApplicationInstancesInBatchesStub = nil
+	if fake.restartApplicationInstancesInBatchesReturnsOnCall == nil {
+		fake.restartApplicationInstancesInBatchesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.restartApplicationInstancesInBatchesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) RevokeAccessAndRefreshTokens() error {
 	fake.revokeAccessAndRefreshTokensMutex.Lock()
 	ret, specificReturn := fake.revokeAccessAndRefreshTokensReturnsOnCall[len(fake.revokeAccessAndRefreshTokensArgsForCall)]
@@ -15749,18 +20572,84 @@ func (fake *FakeActor) RevokeAccessAndRefreshTokensReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeActor) RevokeAccessAndRefreshTokensReturnsOnCall(i int, result1 error) {
-	fake.revokeAccessAndRefreshTokensMutex.Lock()
-	defer fake.revokeAccessAndRefreshTokensMutex.Unlock()
-	fake.RevokeAccessAndRefreshTokensStub = nil
-	if fake.revokeAccessAndRefreshTokensReturnsOnCall == nil {
-		fake.revokeAccessAndRefreshTokensReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeActor) RevokeAccessAndRefreshTokensReturnsOnCall(i int, result1 error) {
+	fake.revokeAccessAndRefreshTokensMutex.Lock()
+	defer fake.revokeAccessAndRefreshTokensMutex.Unlock()
+	fake.RevokeAccessAndRefreshTokensStub = nil
+	if fake.revokeAccessAndRefreshTokensReturnsOnCall == nil {
+		fake.revokeAccessAndRefreshTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAccessAndRefreshTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentials(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.rotateServiceBrokerCredentialsMutex.Lock()
+	ret, specificReturn := fake.rotateServiceBrokerCredentialsReturnsOnCall[len(fake.rotateServiceBrokerCredentialsArgsForCall)]
+	fake.rotateServiceBrokerCredentialsArgsForCall = append(fake.rotateServiceBrokerCredentialsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RotateServiceBrokerCredentialsStub
+	fakeReturns := fake.rotateServiceBrokerCredentialsReturns
+	fake.recordInvocation("RotateServiceBrokerCredentials", []interface{}{arg1, arg2, arg3})
+	fake.rotateServiceBrokerCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentialsCallCount() int {
+	fake.rotateServiceBrokerCredentialsMutex.RLock()
+	defer fake.rotateServiceBrokerCredentialsMutex.RUnlock()
+	return len(fake.rotateServiceBrokerCredentialsArgsForCall)
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentialsCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.rotateServiceBrokerCredentialsMutex.Lock()
+	defer fake.rotateServiceBrokerCredentialsMutex.Unlock()
+	fake.RotateServiceBrokerCredentialsStub = stub
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentialsArgsForCall(i int) (string, string, string) {
+	fake.rotateServiceBrokerCredentialsMutex.RLock()
+	defer fake.rotateServiceBrokerCredentialsMutex.RUnlock()
+	argsForCall := fake.rotateServiceBrokerCredentialsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentialsReturns(result1 v7action.Warnings, result2 error) {
+	fake.rotateServiceBrokerCredentialsMutex.Lock()
+	defer fake.rotateServiceBrokerCredentialsMutex.Unlock()
+	fake.RotateServiceBrokerCredentialsStub = nil
+	fake.rotateServiceBrokerCredentialsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) RotateServiceBrokerCredentialsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.rotateServiceBrokerCredentialsMutex.Lock()
+	defer fake.rotateServiceBrokerCredentialsMutex.Unlock()
+	fake.RotateServiceBrokerCredentialsStub = nil
+	if fake.rotateServiceBrokerCredentialsReturnsOnCall == nil {
+		fake.rotateServiceBrokerCredentialsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
 		})
 	}
-	fake.revokeAccessAndRefreshTokensReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.rotateServiceBrokerCredentialsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeActor) RunTask(arg1 string, arg2 resources.Task) (resources.Task, v7action.Warnings, error) {
@@ -16235,6 +21124,81 @@ func (fake *FakeActor) SetApplicationProcessHealthCheckTypeByNameAndSpaceReturns
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpace(arg1 string, arg2 string, arg3 string, arg4 []int) (resources.Application, v7action.Warnings, error) {
+	var arg4Copy []int
+	if arg4 != nil {
+		arg4Copy = make([]int, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessPortsByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessPortsByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessPortsByNameAndSpaceArgsForCall = append(fake.setApplicationProcessPortsByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []int
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.SetApplicationProcessPortsByNameAndSpaceStub
+	fakeReturns := fake.setApplicationProcessPortsByNameAndSpaceReturns
+	fake.recordInvocation("SetApplicationProcessPortsByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessPortsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpaceCalls(stub func(string, string, string, []int) (resources.Application, v7action.Warnings, error)) {
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessPortsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpaceArgsForCall(i int) (string, string, string, []int) {
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.setApplicationProcessPortsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpaceReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessPortsByNameAndSpaceStub = nil
+	fake.setApplicationProcessPortsByNameAndSpaceReturns = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) SetApplicationProcessPortsByNameAndSpaceReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessPortsByNameAndSpaceStub = nil
+	if fake.setApplicationProcessPortsByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessPortsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessPortsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) SetEnvironmentVariableByApplicationNameAndSpace(arg1 string, arg2 string, arg3 v7action.EnvironmentVariablePair) (v7action.Warnings, error) {
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall)]
@@ -16962,6 +21926,141 @@ func (fake *FakeActor) StartApplicationReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) StartDeleteOrganization(arg1 string) (string, v7action.Warnings, error) {
+	fake.startDeleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.startDeleteOrganizationReturnsOnCall[len(fake.startDeleteOrganizationArgsForCall)]
+	fake.startDeleteOrganizationArgsForCall = append(fake.startDeleteOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StartDeleteOrganizationStub
+	fakeReturns := fake.startDeleteOrganizationReturns
+	fake.recordInvocation("StartDeleteOrganization", []interface{}{arg1})
+	fake.startDeleteOrganizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) StartDeleteOrganizationCallCount() int {
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
+	return len(fake.startDeleteOrganizationArgsForCall)
+}
+
+func (fake *FakeActor) StartDeleteOrganizationCalls(stub func(string) (string, v7action.Warnings, error)) {
+	fake.startDeleteOrganizationMutex.Lock()
+	defer fake.startDeleteOrganizationMutex.Unlock()
+	fake.StartDeleteOrganizationStub = stub
+}
+
+func (fake *FakeActor) StartDeleteOrganizationArgsForCall(i int) string {
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
+	argsForCall := fake.startDeleteOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) StartDeleteOrganizationReturns(result1 string, result2 v7action.Warnings, result3 error) {
+	fake.startDeleteOrganizationMutex.Lock()
+	defer fake.startDeleteOrganizationMutex.Unlock()
+	fake.StartDeleteOrganizationStub = nil
+	fake.startDeleteOrganizationReturns = struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) StartDeleteOrganizationReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
+	fake.startDeleteOrganizationMutex.Lock()
+	defer fake.startDeleteOrganizationMutex.Unlock()
+	fake.StartDeleteOrganizationStub = nil
+	if fake.startDeleteOrganizationReturnsOnCall == nil {
+		fake.startDeleteOrganizationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.startDeleteOrganizationReturnsOnCall[i] = struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationName(arg1 string, arg2 string) (string, v7action.Warnings, error) {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.startDeleteSpaceByNameAndOrganizationNameReturnsOnCall[len(fake.startDeleteSpaceByNameAndOrganizationNameArgsForCall)]
+	fake.startDeleteSpaceByNameAndOrganizationNameArgsForCall = append(fake.startDeleteSpaceByNameAndOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.StartDeleteSpaceByNameAndOrganizationNameStub
+	fakeReturns := fake.startDeleteSpaceByNameAndOrganizationNameReturns
+	fake.recordInvocation("StartDeleteSpaceByNameAndOrganizationName", []interface{}{arg1, arg2})
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationNameCallCount() int {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	return len(fake.startDeleteSpaceByNameAndOrganizationNameArgsForCall)
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationNameCalls(stub func(string, string) (string, v7action.Warnings, error)) {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.Lock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.Unlock()
+	fake.StartDeleteSpaceByNameAndOrganizationNameStub = stub
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationNameArgsForCall(i int) (string, string) {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	argsForCall := fake.startDeleteSpaceByNameAndOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationNameReturns(result1 string, result2 v7action.Warnings, result3 error) {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.Lock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.Unlock()
+	fake.StartDeleteSpaceByNameAndOrganizationNameStub = nil
+	fake.startDeleteSpaceByNameAndOrganizationNameReturns = struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) StartDeleteSpaceByNameAndOrganizationNameReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.Lock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.Unlock()
+	fake.StartDeleteSpaceByNameAndOrganizationNameStub = nil
+	if fake.startDeleteSpaceByNameAndOrganizationNameReturnsOnCall == nil {
+		fake.startDeleteSpaceByNameAndOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.startDeleteSpaceByNameAndOrganizationNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) StopApplication(arg1 string) (v7action.Warnings, error) {
 	fake.stopApplicationMutex.Lock()
 	ret, specificReturn := fake.stopApplicationReturnsOnCall[len(fake.stopApplicationArgsForCall)]
@@ -18019,6 +23118,76 @@ func (fake *FakeActor) UpdateDomainLabelsByDomainNameReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateLabelsBySelector(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	ret, specificReturn := fake.updateLabelsBySelectorReturnsOnCall[len(fake.updateLabelsBySelectorArgsForCall)]
+	fake.updateLabelsBySelectorArgsForCall = append(fake.updateLabelsBySelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateLabelsBySelectorStub
+	fakeReturns := fake.updateLabelsBySelectorReturns
+	fake.recordInvocation("UpdateLabelsBySelector", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateLabelsBySelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateLabelsBySelectorCallCount() int {
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
+	return len(fake.updateLabelsBySelectorArgsForCall)
+}
+
+func (fake *FakeActor) UpdateLabelsBySelectorCalls(stub func(string, string, string, map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = stub
+}
+
+func (fake *FakeActor) UpdateLabelsBySelectorArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
+	argsForCall := fake.updateLabelsBySelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateLabelsBySelectorReturns(result1 []v7action.BulkLabelUpdate, result2 v7action.Warnings, result3 error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = nil
+	fake.updateLabelsBySelectorReturns = struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateLabelsBySelectorReturnsOnCall(i int, result1 []v7action.BulkLabelUpdate, result2 v7action.Warnings, result3 error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = nil
+	if fake.updateLabelsBySelectorReturnsOnCall == nil {
+		fake.updateLabelsBySelectorReturnsOnCall = make(map[int]struct {
+			result1 []v7action.BulkLabelUpdate
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateLabelsBySelectorReturnsOnCall[i] = struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateManagedServiceInstance(arg1 v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error) {
 	fake.updateManagedServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.updateManagedServiceInstanceReturnsOnCall[len(fake.updateManagedServiceInstanceArgsForCall)]
@@ -19482,6 +24651,204 @@ func (fake *FakeActor) UploadDropletReturnsOnCall(i int, result1 v7action.Warnin
 	}{result1, result2}
 }
 
+func (fake *FakeActor) VerifyApplicationHealth(arg1 resources.Application, arg2 v7action.ApplicationHealthCriteria) (v7action.Warnings, error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	ret, specificReturn := fake.verifyApplicationHealthReturnsOnCall[len(fake.verifyApplicationHealthArgsForCall)]
+	fake.verifyApplicationHealthArgsForCall = append(fake.verifyApplicationHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationHealthCriteria
+	}{arg1, arg2})
+	stub := fake.VerifyApplicationHealthStub
+	fakeReturns := fake.verifyApplicationHealthReturns
+	fake.recordInvocation("VerifyApplicationHealth", []interface{}{arg1, arg2})
+	fake.verifyApplicationHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) VerifyApplicationHealthCallCount() int {
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
+	return len(fake.verifyApplicationHealthArgsForCall)
+}
+
+func (fake *FakeActor) VerifyApplicationHealthCalls(stub func(resources.Application, v7action.ApplicationHealthCriteria) (v7action.Warnings, error)) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = stub
+}
+
+func (fake *FakeActor) VerifyApplicationHealthArgsForCall(i int) (resources.Application, v7action.ApplicationHealthCriteria) {
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
+	argsForCall := fake.verifyApplicationHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) VerifyApplicationHealthReturns(result1 v7action.Warnings, result2 error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = nil
+	fake.verifyApplicationHealthReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) VerifyApplicationHealthReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = nil
+	if fake.verifyApplicationHealthReturnsOnCall == nil {
+		fake.verifyApplicationHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.verifyApplicationHealthReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) VerifyApplicationSLO(arg1 resources.Application, arg2 v7action.ApplicationSLO, arg3 v7action.HTTPClient) (v7action.ApplicationSLOReport, v7action.Warnings, error) {
+	fake.verifyApplicationSLOMutex.Lock()
+	ret, specificReturn := fake.verifyApplicationSLOReturnsOnCall[len(fake.verifyApplicationSLOArgsForCall)]
+	fake.verifyApplicationSLOArgsForCall = append(fake.verifyApplicationSLOArgsForCall, struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationSLO
+		arg3 v7action.HTTPClient
+	}{arg1, arg2, arg3})
+	stub := fake.VerifyApplicationSLOStub
+	fakeReturns := fake.verifyApplicationSLOReturns
+	fake.recordInvocation("VerifyApplicationSLO", []interface{}{arg1, arg2, arg3})
+	fake.verifyApplicationSLOMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) VerifyApplicationSLOCallCount() int {
+	fake.verifyApplicationSLOMutex.RLock()
+	defer fake.verifyApplicationSLOMutex.RUnlock()
+	return len(fake.verifyApplicationSLOArgsForCall)
+}
+
+func (fake *FakeActor) VerifyApplicationSLOCalls(stub func(resources.Application, v7action.ApplicationSLO, v7action.HTTPClient) (v7action.ApplicationSLOReport, v7action.Warnings, error)) {
+	fake.verifyApplicationSLOMutex.Lock()
+	defer fake.verifyApplicationSLOMutex.Unlock()
+	fake.VerifyApplicationSLOStub = stub
+}
+
+func (fake *FakeActor) VerifyApplicationSLOArgsForCall(i int) (resources.Application, v7action.ApplicationSLO, v7action.HTTPClient) {
+	fake.verifyApplicationSLOMutex.RLock()
+	defer fake.verifyApplicationSLOMutex.RUnlock()
+	argsForCall := fake.verifyApplicationSLOArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) VerifyApplicationSLOReturns(result1 v7action.ApplicationSLOReport, result2 v7action.Warnings, result3 error) {
+	fake.verifyApplicationSLOMutex.Lock()
+	defer fake.verifyApplicationSLOMutex.Unlock()
+	fake.VerifyApplicationSLOStub = nil
+	fake.verifyApplicationSLOReturns = struct {
+		result1 v7action.ApplicationSLOReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) VerifyApplicationSLOReturnsOnCall(i int, result1 v7action.ApplicationSLOReport, result2 v7action.Warnings, result3 error) {
+	fake.verifyApplicationSLOMutex.Lock()
+	defer fake.verifyApplicationSLOMutex.Unlock()
+	fake.VerifyApplicationSLOStub = nil
+	if fake.verifyApplicationSLOReturnsOnCall == nil {
+		fake.verifyApplicationSLOReturnsOnCall = make(map[int]struct {
+			result1 v7action.ApplicationSLOReport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.verifyApplicationSLOReturnsOnCall[i] = struct {
+		result1 v7action.ApplicationSLOReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) WaitForJob(arg1 string) (v7action.Warnings, error) {
+	fake.waitForJobMutex.Lock()
+	ret, specificReturn := fake.waitForJobReturnsOnCall[len(fake.waitForJobArgsForCall)]
+	fake.waitForJobArgsForCall = append(fake.waitForJobArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.WaitForJobStub
+	fakeReturns := fake.waitForJobReturns
+	fake.recordInvocation("WaitForJob", []interface{}{arg1})
+	fake.waitForJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) WaitForJobCallCount() int {
+	fake.waitForJobMutex.RLock()
+	defer fake.waitForJobMutex.RUnlock()
+	return len(fake.waitForJobArgsForCall)
+}
+
+func (fake *FakeActor) WaitForJobCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.waitForJobMutex.Lock()
+	defer fake.waitForJobMutex.Unlock()
+	fake.WaitForJobStub = stub
+}
+
+func (fake *FakeActor) WaitForJobArgsForCall(i int) string {
+	fake.waitForJobMutex.RLock()
+	defer fake.waitForJobMutex.RUnlock()
+	argsForCall := fake.waitForJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) WaitForJobReturns(result1 v7action.Warnings, result2 error) {
+	fake.waitForJobMutex.Lock()
+	defer fake.waitForJobMutex.Unlock()
+	fake.WaitForJobStub = nil
+	fake.waitForJobReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) WaitForJobReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.waitForJobMutex.Lock()
+	defer fake.waitForJobMutex.Unlock()
+	fake.WaitForJobStub = nil
+	if fake.waitForJobReturnsOnCall == nil {
+		fake.waitForJobReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.waitForJobReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -19493,14 +24860,22 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
 	fake.bindSecurityGroupToSpacesMutex.RLock()
 	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	fake.cancelDeploymentMutex.RLock()
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.checkRoutesAvailabilityMutex.RLock()
+	defer fake.checkRoutesAvailabilityMutex.RUnlock()
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
 	fake.clearTargetMutex.RLock()
 	defer fake.clearTargetMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.copyPackageMutex.RLock()
 	defer fake.copyPackageMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
@@ -19513,10 +24888,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
 	defer fake.createBuildpackMutex.RUnlock()
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.createDockerPackageByApplicationMutex.RLock()
 	defer fake.createDockerPackageByApplicationMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
@@ -19581,6 +24954,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteRouteBindingMutex.RLock()
 	defer fake.deleteRouteBindingMutex.RUnlock()
+	fake.deleteRouteByGUIDMutex.RLock()
+	defer fake.deleteRouteByGUIDMutex.RUnlock()
 	fake.deleteSecurityGroupMutex.RLock()
 	defer fake.deleteSecurityGroupMutex.RUnlock()
 	fake.deleteServiceAppBindingMutex.RLock()
@@ -19607,18 +24982,32 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.disableServiceAccessMutex.RUnlock()
 	fake.downloadCurrentDropletByAppNameMutex.RLock()
 	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
+	fake.downloadCurrentDropletSBOMsByAppNameMutex.RLock()
+	defer fake.downloadCurrentDropletSBOMsByAppNameMutex.RUnlock()
 	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
 	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
+	fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RLock()
+	defer fake.downloadDropletSBOMsByGUIDAndAppNameMutex.RUnlock()
+	fake.drainRouteDestinationMutex.RLock()
+	defer fake.drainRouteDestinationMutex.RUnlock()
 	fake.enableFeatureFlagMutex.RLock()
 	defer fake.enableFeatureFlagMutex.RUnlock()
 	fake.enableServiceAccessMutex.RLock()
 	defer fake.enableServiceAccessMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.getAPIResourcesMutex.RLock()
+	defer fake.getAPIResourcesMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppSecurityReportMutex.RLock()
+	defer fake.getAppSecurityReportMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	fake.getAppUsagesForSpaceMutex.RLock()
+	defer fake.getAppUsagesForSpaceMutex.RUnlock()
+	fake.getApplicationByGUIDMutex.RLock()
+	defer fake.getApplicationByGUIDMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
@@ -19631,6 +25020,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationPackagesMutex.RUnlock()
 	fake.getApplicationProcessHealthChecksByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationProcessHealthChecksByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationProcessSummariesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessSummariesByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationRevisionsDeployedMutex.RLock()
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
@@ -19643,46 +25034,72 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getCurrentDropletByApplicationMutex.RLock()
+	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
 	defer fake.getDefaultDomainMutex.RUnlock()
+	fake.getDeploymentForAppMutex.RLock()
+	defer fake.getDeploymentForAppMutex.RUnlock()
 	fake.getDetailedAppSummaryMutex.RLock()
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	fake.getDetailedAppSummaryForAppMutex.RLock()
+	defer fake.getDetailedAppSummaryForAppMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
 	fake.getDomainByNameMutex.RLock()
 	defer fake.getDomainByNameMutex.RUnlock()
 	fake.getDomainLabelsMutex.RLock()
 	defer fake.getDomainLabelsMutex.RUnlock()
+	fake.getDrainByNameAndSpaceMutex.RLock()
+	defer fake.getDrainByNameAndSpaceMutex.RUnlock()
+	fake.getDrainsForSpaceMutex.RLock()
+	defer fake.getDrainsForSpaceMutex.RUnlock()
+	fake.getDumpRecipesForAppMutex.RLock()
+	defer fake.getDumpRecipesForAppMutex.RUnlock()
 	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
 	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
 	fake.getEnvironmentVariableGroupMutex.RLock()
 	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getFeatureFlagByNameMutex.RLock()
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getGlobalRunningSecurityGroupsMutex.RLock()
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
 	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
+	fake.getInternalRoutesBySpaceMutex.RLock()
+	defer fake.getInternalRoutesBySpaceMutex.RUnlock()
 	fake.getIsolationSegmentByNameMutex.RLock()
 	defer fake.getIsolationSegmentByNameMutex.RUnlock()
 	fake.getIsolationSegmentSummariesMutex.RLock()
 	defer fake.getIsolationSegmentSummariesMutex.RUnlock()
 	fake.getIsolationSegmentsByOrganizationMutex.RLock()
 	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
 	fake.getLatestActiveDeploymentForAppMutex.RLock()
 	defer fake.getLatestActiveDeploymentForAppMutex.RUnlock()
+	fake.getLatestDeploymentForAppMutex.RLock()
+	defer fake.getLatestDeploymentForAppMutex.RUnlock()
 	fake.getLoginPromptsMutex.RLock()
 	defer fake.getLoginPromptsMutex.RUnlock()
 	fake.getNewestReadyPackageForApplicationMutex.RLock()
 	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
 	fake.getOrgUsersByRoleTypeMutex.RLock()
 	defer fake.getOrgUsersByRoleTypeMutex.RUnlock()
+	fake.getOrganizationByGUIDMutex.RLock()
+	defer fake.getOrganizationByGUIDMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
@@ -19695,36 +25112,62 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationSpacesMutex.RLock()
 	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getOrganizationSpacesWithDetailsMutex.RLock()
+	defer fake.getOrganizationSpacesWithDetailsMutex.RUnlock()
 	fake.getOrganizationSpacesWithLabelSelectorMutex.RLock()
 	defer fake.getOrganizationSpacesWithLabelSelectorMutex.RUnlock()
 	fake.getOrganizationSummaryByNameMutex.RLock()
 	defer fake.getOrganizationSummaryByNameMutex.RUnlock()
+	fake.getOrganizationSummaryForOrgMutex.RLock()
+	defer fake.getOrganizationSummaryForOrgMutex.RUnlock()
+	fake.getOrganizationUsageSnapshotMutex.RLock()
+	defer fake.getOrganizationUsageSnapshotMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationsWithDetailsMutex.RLock()
+	defer fake.getOrganizationsWithDetailsMutex.RUnlock()
+	fake.getOrphanedRoutesMutex.RLock()
+	defer fake.getOrphanedRoutesMutex.RUnlock()
 	fake.getProcessByTypeAndApplicationMutex.RLock()
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
+	fake.getRawApplicationsManifestByNamesAndSpaceMutex.RLock()
+	defer fake.getRawApplicationsManifestByNamesAndSpaceMutex.RUnlock()
+	fake.getRawSpaceManifestMutex.RLock()
+	defer fake.getRawSpaceManifestMutex.RUnlock()
+	fake.getReadyPackageForApplicationMutex.RLock()
+	defer fake.getReadyPackageForApplicationMutex.RUnlock()
 	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentEventsByOrganizationNameMutex.RLock()
+	defer fake.getRecentEventsByOrganizationNameMutex.RUnlock()
+	fake.getRecentEventsBySpaceNameAndOrganizationMutex.RLock()
+	defer fake.getRecentEventsBySpaceNameAndOrganizationMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getRolloutStatusMutex.RLock()
+	defer fake.getRolloutStatusMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
+	fake.getRouteCollisionMutex.RLock()
+	defer fake.getRouteCollisionMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
 	defer fake.getRouteDestinationByAppGUIDMutex.RUnlock()
+	fake.getRouteHistoryMutex.RLock()
+	defer fake.getRouteHistoryMutex.RUnlock()
 	fake.getRouteLabelsMutex.RLock()
 	defer fake.getRouteLabelsMutex.RUnlock()
 	fake.getRouteSummariesMutex.RLock()
 	defer fake.getRouteSummariesMutex.RUnlock()
 	fake.getRouterGroupsMutex.RLock()
 	defer fake.getRouterGroupsMutex.RUnlock()
+	fake.getRoutesByDomainMutex.RLock()
+	defer fake.getRoutesByDomainMutex.RUnlock()
 	fake.getRoutesByOrgMutex.RLock()
 	defer fake.getRoutesByOrgMutex.RUnlock()
 	fake.getRoutesBySpaceMutex.RLock()
@@ -19735,6 +25178,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSSHEnabledByAppNameMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.getSSHStatusesForSpacesMutex.RLock()
+	defer fake.getSSHStatusesForSpacesMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
 	fake.getSecurityGroupMutex.RLock()
@@ -19747,18 +25192,32 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceAccessMutex.RUnlock()
 	fake.getServiceBrokerByNameMutex.RLock()
 	defer fake.getServiceBrokerByNameMutex.RUnlock()
+	fake.getServiceBrokerCatalogMutex.RLock()
+	defer fake.getServiceBrokerCatalogMutex.RUnlock()
+	fake.getServiceBrokerCatalogDiffMutex.RLock()
+	defer fake.getServiceBrokerCatalogDiffMutex.RUnlock()
 	fake.getServiceBrokerLabelsMutex.RLock()
 	defer fake.getServiceBrokerLabelsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
 	defer fake.getServiceBrokersMutex.RUnlock()
+	fake.getServiceDriftMutex.RLock()
+	defer fake.getServiceDriftMutex.RUnlock()
+	fake.getServiceInstanceByGUIDMutex.RLock()
+	defer fake.getServiceInstanceByGUIDMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getServiceInstanceDetailsMutex.RLock()
 	defer fake.getServiceInstanceDetailsMutex.RUnlock()
+	fake.getServiceInstanceDetailsByGUIDMutex.RLock()
+	defer fake.getServiceInstanceDetailsByGUIDMutex.RUnlock()
 	fake.getServiceInstanceLabelsMutex.RLock()
 	defer fake.getServiceInstanceLabelsMutex.RUnlock()
 	fake.getServiceInstanceParametersMutex.RLock()
 	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	fake.getServiceInstanceParametersByGUIDMutex.RLock()
+	defer fake.getServiceInstanceParametersByGUIDMutex.RUnlock()
+	fake.getServiceInstanceUpgradeMutex.RLock()
+	defer fake.getServiceInstanceUpgradeMutex.RUnlock()
 	fake.getServiceInstancesForSpaceMutex.RLock()
 	defer fake.getServiceInstancesForSpaceMutex.RUnlock()
 	fake.getServiceKeyByServiceInstanceAndNameMutex.RLock()
@@ -19773,6 +25232,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServicePlanByNameOfferingAndBrokerMutex.RUnlock()
 	fake.getServicePlanLabelsMutex.RLock()
 	defer fake.getServicePlanLabelsMutex.RUnlock()
+	fake.getSpaceByGUIDMutex.RLock()
+	defer fake.getSpaceByGUIDMutex.RUnlock()
 	fake.getSpaceByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
@@ -19785,6 +25246,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceQuotasByOrgGUIDMutex.RUnlock()
 	fake.getSpaceSummaryByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceSummaryByNameAndOrganizationMutex.RUnlock()
+	fake.getSpaceSummaryForSpaceMutex.RLock()
+	defer fake.getSpaceSummaryForSpaceMutex.RUnlock()
 	fake.getSpaceUsersByRoleTypeMutex.RLock()
 	defer fake.getSpaceUsersByRoleTypeMutex.RUnlock()
 	fake.getStackByNameMutex.RLock()
@@ -19803,12 +25266,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getUnstagedNewestPackageGUIDMutex.RUnlock()
 	fake.getUserMutex.RLock()
 	defer fake.getUserMutex.RUnlock()
+	fake.lookupGUIDMutex.RLock()
+	defer fake.lookupGUIDMutex.RUnlock()
 	fake.makeCurlRequestMutex.RLock()
 	defer fake.makeCurlRequestMutex.RUnlock()
+	fake.makeLogCacheCurlRequestMutex.RLock()
+	defer fake.makeLogCacheCurlRequestMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
 	defer fake.mapRouteMutex.RUnlock()
+	fake.mapRouteToAppPortMutex.RLock()
+	defer fake.mapRouteToAppPortMutex.RUnlock()
 	fake.marketplaceMutex.RLock()
 	defer fake.marketplaceMutex.RUnlock()
+	fake.migrateRouteMutex.RLock()
+	defer fake.migrateRouteMutex.RUnlock()
 	fake.moveRouteMutex.RLock()
 	defer fake.moveRouteMutex.RUnlock()
 	fake.parseAccessTokenMutex.RLock()
@@ -19817,6 +25288,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
 	defer fake.pollPackageMutex.RUnlock()
+	fake.pollRolloutMutex.RLock()
+	defer fake.pollRolloutMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.pollStartForRollingMutex.RLock()
@@ -19827,6 +25300,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.pollUploadBuildpackJobMutex.RUnlock()
 	fake.prepareBuildpackBitsMutex.RLock()
 	defer fake.prepareBuildpackBitsMutex.RUnlock()
+	fake.promoteServiceBrokerMutex.RLock()
+	defer fake.promoteServiceBrokerMutex.RUnlock()
 	fake.purgeServiceInstanceMutex.RLock()
 	defer fake.purgeServiceInstanceMutex.RUnlock()
 	fake.purgeServiceOfferingByNameAndBrokerMutex.RLock()
@@ -19841,6 +25316,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.renameServiceInstanceMutex.RUnlock()
 	fake.renameSpaceByNameAndOrganizationGUIDMutex.RLock()
 	defer fake.renameSpaceByNameAndOrganizationGUIDMutex.RUnlock()
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
 	fake.resetOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.resetOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.resetSpaceIsolationSegmentMutex.RLock()
@@ -19849,8 +25326,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.restartApplicationMutex.RLock()
 	defer fake.restartApplicationMutex.RUnlock()
+	fake.restartApplicationInstancesInBatchesMutex.RLock()
+	defer fake.restartApplicationInstancesInBatchesMutex.RUnlock()
 	fake.revokeAccessAndRefreshTokensMutex.RLock()
 	defer fake.revokeAccessAndRefreshTokensMutex.RUnlock()
+	fake.rotateServiceBrokerCredentialsMutex.RLock()
+	defer fake.rotateServiceBrokerCredentialsMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
 	fake.scaleProcessByApplicationMutex.RLock()
@@ -19865,6 +25346,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.setApplicationManifestMutex.RUnlock()
 	fake.setApplicationProcessHealthCheckTypeByNameAndSpaceMutex.RLock()
 	defer fake.setApplicationProcessHealthCheckTypeByNameAndSpaceMutex.RUnlock()
+	fake.setApplicationProcessPortsByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessPortsByNameAndSpaceMutex.RUnlock()
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
 	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
 	fake.setEnvironmentVariableGroupMutex.RLock()
@@ -19887,6 +25370,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.stagePackageMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
+	fake.startDeleteOrganizationMutex.RLock()
+	defer fake.startDeleteOrganizationMutex.RUnlock()
+	fake.startDeleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.startDeleteSpaceByNameAndOrganizationNameMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.terminateTaskMutex.RLock()
@@ -19919,6 +25406,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateDestinationMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
 	fake.updateManagedServiceInstanceMutex.RLock()
 	defer fake.updateManagedServiceInstanceMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
@@ -19963,6 +25452,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.uploadDropletMutex.RLock()
 	defer fake.uploadDropletMutex.RUnlock()
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
+	fake.verifyApplicationSLOMutex.RLock()
+	defer fake.verifyApplicationSLOMutex.RUnlock()
+	fake.waitForJobMutex.RLock()
+	defer fake.waitForJobMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
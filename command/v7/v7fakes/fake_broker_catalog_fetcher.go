@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/brokercatalog"
+)
+
+type FakeBrokerCatalogFetcher struct {
+	FetchStub        func(string, string, string) (brokercatalog.Catalog, error)
+	fetchMutex       sync.RWMutex
+	fetchArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	fetchReturns struct {
+		result1 brokercatalog.Catalog
+		result2 error
+	}
+	fetchReturnsOnCall map[int]struct {
+		result1 brokercatalog.Catalog
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBrokerCatalogFetcher) Fetch(arg1 string, arg2 string, arg3 string) (brokercatalog.Catalog, error) {
+	fake.fetchMutex.Lock()
+	ret, specificReturn := fake.fetchReturnsOnCall[len(fake.fetchArgsForCall)]
+	fake.fetchArgsForCall = append(fake.fetchArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Fetch", []interface{}{arg1, arg2, arg3})
+	fake.fetchMutex.Unlock()
+	if fake.FetchStub != nil {
+		return fake.FetchStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.fetchReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrokerCatalogFetcher) FetchCallCount() int {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	return len(fake.fetchArgsForCall)
+}
+
+func (fake *FakeBrokerCatalogFetcher) FetchCalls(stub func(string, string, string) (brokercatalog.Catalog, error)) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = stub
+}
+
+func (fake *FakeBrokerCatalogFetcher) FetchArgsForCall(i int) (string, string, string) {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	argsForCall := fake.fetchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrokerCatalogFetcher) FetchReturns(result1 brokercatalog.Catalog, result2 error) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = nil
+	fake.fetchReturns = struct {
+		result1 brokercatalog.Catalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrokerCatalogFetcher) FetchReturnsOnCall(i int, result1 brokercatalog.Catalog, result2 error) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = nil
+	if fake.fetchReturnsOnCall == nil {
+		fake.fetchReturnsOnCall = make(map[int]struct {
+			result1 brokercatalog.Catalog
+			result2 error
+		})
+	}
+	fake.fetchReturnsOnCall[i] = struct {
+		result1 brokercatalog.Catalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrokerCatalogFetcher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBrokerCatalogFetcher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.BrokerCatalogFetcher = new(FakeBrokerCatalogFetcher)
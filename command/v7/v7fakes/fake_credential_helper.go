@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+)
+
+type FakeCredentialHelper struct {
+	GetStub        func(credentialhelper.Request) (credentialhelper.Credentials, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 credentialhelper.Request
+	}
+	getReturns struct {
+		result1 credentialhelper.Credentials
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 credentialhelper.Credentials
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCredentialHelper) Get(arg1 credentialhelper.Request) (credentialhelper.Credentials, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 credentialhelper.Request
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCredentialHelper) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeCredentialHelper) GetCalls(stub func(credentialhelper.Request) (credentialhelper.Credentials, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeCredentialHelper) GetArgsForCall(i int) credentialhelper.Request {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCredentialHelper) GetReturns(result1 credentialhelper.Credentials, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 credentialhelper.Credentials
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentialHelper) GetReturnsOnCall(i int, result1 credentialhelper.Credentials, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 credentialhelper.Credentials
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 credentialhelper.Credentials
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentialHelper) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCredentialHelper) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.CredentialHelper = new(FakeCredentialHelper)
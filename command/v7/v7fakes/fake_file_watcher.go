@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeFileWatcher struct {
+	WatchStub        func([]string, <-chan struct{}) (<-chan []string, error)
+	watchMutex       sync.RWMutex
+	watchArgsForCall []struct {
+		arg1 []string
+		arg2 <-chan struct{}
+	}
+	watchReturns struct {
+		result1 <-chan []string
+		result2 error
+	}
+	watchReturnsOnCall map[int]struct {
+		result1 <-chan []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFileWatcher) Watch(arg1 []string, arg2 <-chan struct{}) (<-chan []string, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.watchMutex.Lock()
+	ret, specificReturn := fake.watchReturnsOnCall[len(fake.watchArgsForCall)]
+	fake.watchArgsForCall = append(fake.watchArgsForCall, struct {
+		arg1 []string
+		arg2 <-chan struct{}
+	}{arg1Copy, arg2})
+	fake.recordInvocation("Watch", []interface{}{arg1Copy, arg2})
+	fake.watchMutex.Unlock()
+	if fake.WatchStub != nil {
+		return fake.WatchStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.watchReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileWatcher) WatchCallCount() int {
+	fake.watchMutex.RLock()
+	defer fake.watchMutex.RUnlock()
+	return len(fake.watchArgsForCall)
+}
+
+func (fake *FakeFileWatcher) WatchCalls(stub func([]string, <-chan struct{}) (<-chan []string, error)) {
+	fake.watchMutex.Lock()
+	defer fake.watchMutex.Unlock()
+	fake.WatchStub = stub
+}
+
+func (fake *FakeFileWatcher) WatchArgsForCall(i int) ([]string, <-chan struct{}) {
+	fake.watchMutex.RLock()
+	defer fake.watchMutex.RUnlock()
+	argsForCall := fake.watchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileWatcher) WatchReturns(result1 <-chan []string, result2 error) {
+	fake.watchMutex.Lock()
+	defer fake.watchMutex.Unlock()
+	fake.WatchStub = nil
+	fake.watchReturns = struct {
+		result1 <-chan []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileWatcher) WatchReturnsOnCall(i int, result1 <-chan []string, result2 error) {
+	fake.watchMutex.Lock()
+	defer fake.watchMutex.Unlock()
+	fake.WatchStub = nil
+	if fake.watchReturnsOnCall == nil {
+		fake.watchReturnsOnCall = make(map[int]struct {
+			result1 <-chan []string
+			result2 error
+		})
+	}
+	fake.watchReturnsOnCall[i] = struct {
+		result1 <-chan []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileWatcher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.watchMutex.RLock()
+	defer fake.watchMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFileWatcher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.FileWatcher = new(FakeFileWatcher)
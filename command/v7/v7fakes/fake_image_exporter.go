@@ -0,0 +1,287 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ociimage"
+)
+
+type FakeImageExporter struct {
+	BuildStub        func(ociimage.Reference, []byte) (ociimage.Image, error)
+	buildMutex       sync.RWMutex
+	buildArgsForCall []struct {
+		arg1 ociimage.Reference
+		arg2 []byte
+	}
+	buildReturns struct {
+		result1 ociimage.Image
+		result2 error
+	}
+	buildReturnsOnCall map[int]struct {
+		result1 ociimage.Image
+		result2 error
+	}
+	PushStub        func(ociimage.Image, ociimage.Reference, ociimage.Credentials) (string, error)
+	pushMutex       sync.RWMutex
+	pushArgsForCall []struct {
+		arg1 ociimage.Image
+		arg2 ociimage.Reference
+		arg3 ociimage.Credentials
+	}
+	pushReturns struct {
+		result1 string
+		result2 error
+	}
+	pushReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	WriteLayoutStub        func(ociimage.Image, string, string) (string, error)
+	writeLayoutMutex       sync.RWMutex
+	writeLayoutArgsForCall []struct {
+		arg1 ociimage.Image
+		arg2 string
+		arg3 string
+	}
+	writeLayoutReturns struct {
+		result1 string
+		result2 error
+	}
+	writeLayoutReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImageExporter) Build(arg1 ociimage.Reference, arg2 []byte) (ociimage.Image, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.buildMutex.Lock()
+	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
+	fake.buildArgsForCall = append(fake.buildArgsForCall, struct {
+		arg1 ociimage.Reference
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("Build", []interface{}{arg1, arg2Copy})
+	fake.buildMutex.Unlock()
+	if fake.BuildStub != nil {
+		return fake.BuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageExporter) BuildCallCount() int {
+	fake.buildMutex.RLock()
+	defer fake.buildMutex.RUnlock()
+	return len(fake.buildArgsForCall)
+}
+
+func (fake *FakeImageExporter) BuildCalls(stub func(ociimage.Reference, []byte) (ociimage.Image, error)) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = stub
+}
+
+func (fake *FakeImageExporter) BuildArgsForCall(i int) (ociimage.Reference, []byte) {
+	fake.buildMutex.RLock()
+	defer fake.buildMutex.RUnlock()
+	argsForCall := fake.buildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImageExporter) BuildReturns(result1 ociimage.Image, result2 error) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = nil
+	fake.buildReturns = struct {
+		result1 ociimage.Image
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) BuildReturnsOnCall(i int, result1 ociimage.Image, result2 error) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = nil
+	if fake.buildReturnsOnCall == nil {
+		fake.buildReturnsOnCall = make(map[int]struct {
+			result1 ociimage.Image
+			result2 error
+		})
+	}
+	fake.buildReturnsOnCall[i] = struct {
+		result1 ociimage.Image
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) Push(arg1 ociimage.Image, arg2 ociimage.Reference, arg3 ociimage.Credentials) (string, error) {
+	fake.pushMutex.Lock()
+	ret, specificReturn := fake.pushReturnsOnCall[len(fake.pushArgsForCall)]
+	fake.pushArgsForCall = append(fake.pushArgsForCall, struct {
+		arg1 ociimage.Image
+		arg2 ociimage.Reference
+		arg3 ociimage.Credentials
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Push", []interface{}{arg1, arg2, arg3})
+	fake.pushMutex.Unlock()
+	if fake.PushStub != nil {
+		return fake.PushStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pushReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageExporter) PushCallCount() int {
+	fake.pushMutex.RLock()
+	defer fake.pushMutex.RUnlock()
+	return len(fake.pushArgsForCall)
+}
+
+func (fake *FakeImageExporter) PushCalls(stub func(ociimage.Image, ociimage.Reference, ociimage.Credentials) (string, error)) {
+	fake.pushMutex.Lock()
+	defer fake.pushMutex.Unlock()
+	fake.PushStub = stub
+}
+
+func (fake *FakeImageExporter) PushArgsForCall(i int) (ociimage.Image, ociimage.Reference, ociimage.Credentials) {
+	fake.pushMutex.RLock()
+	defer fake.pushMutex.RUnlock()
+	argsForCall := fake.pushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImageExporter) PushReturns(result1 string, result2 error) {
+	fake.pushMutex.Lock()
+	defer fake.pushMutex.Unlock()
+	fake.PushStub = nil
+	fake.pushReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) PushReturnsOnCall(i int, result1 string, result2 error) {
+	fake.pushMutex.Lock()
+	defer fake.pushMutex.Unlock()
+	fake.PushStub = nil
+	if fake.pushReturnsOnCall == nil {
+		fake.pushReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.pushReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) WriteLayout(arg1 ociimage.Image, arg2 string, arg3 string) (string, error) {
+	fake.writeLayoutMutex.Lock()
+	ret, specificReturn := fake.writeLayoutReturnsOnCall[len(fake.writeLayoutArgsForCall)]
+	fake.writeLayoutArgsForCall = append(fake.writeLayoutArgsForCall, struct {
+		arg1 ociimage.Image
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("WriteLayout", []interface{}{arg1, arg2, arg3})
+	fake.writeLayoutMutex.Unlock()
+	if fake.WriteLayoutStub != nil {
+		return fake.WriteLayoutStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.writeLayoutReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageExporter) WriteLayoutCallCount() int {
+	fake.writeLayoutMutex.RLock()
+	defer fake.writeLayoutMutex.RUnlock()
+	return len(fake.writeLayoutArgsForCall)
+}
+
+func (fake *FakeImageExporter) WriteLayoutCalls(stub func(ociimage.Image, string, string) (string, error)) {
+	fake.writeLayoutMutex.Lock()
+	defer fake.writeLayoutMutex.Unlock()
+	fake.WriteLayoutStub = stub
+}
+
+func (fake *FakeImageExporter) WriteLayoutArgsForCall(i int) (ociimage.Image, string, string) {
+	fake.writeLayoutMutex.RLock()
+	defer fake.writeLayoutMutex.RUnlock()
+	argsForCall := fake.writeLayoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImageExporter) WriteLayoutReturns(result1 string, result2 error) {
+	fake.writeLayoutMutex.Lock()
+	defer fake.writeLayoutMutex.Unlock()
+	fake.WriteLayoutStub = nil
+	fake.writeLayoutReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) WriteLayoutReturnsOnCall(i int, result1 string, result2 error) {
+	fake.writeLayoutMutex.Lock()
+	defer fake.writeLayoutMutex.Unlock()
+	fake.WriteLayoutStub = nil
+	if fake.writeLayoutReturnsOnCall == nil {
+		fake.writeLayoutReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.writeLayoutReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageExporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.buildMutex.RLock()
+	defer fake.buildMutex.RUnlock()
+	fake.pushMutex.RLock()
+	defer fake.pushMutex.RUnlock()
+	fake.writeLayoutMutex.RLock()
+	defer fake.writeLayoutMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImageExporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ImageExporter = new(FakeImageExporter)
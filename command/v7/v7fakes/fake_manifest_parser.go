@@ -25,6 +25,22 @@ type FakeManifestParser struct {
 		result1 []byte
 		result2 error
 	}
+	InterpolateManifestWithOverlaysStub        func(string, []string, []string, []template.VarKV) ([]byte, error)
+	interpolateManifestWithOverlaysMutex       sync.RWMutex
+	interpolateManifestWithOverlaysArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+		arg4 []template.VarKV
+	}
+	interpolateManifestWithOverlaysReturns struct {
+		result1 []byte
+		result2 error
+	}
+	interpolateManifestWithOverlaysReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	MarshalManifestStub        func(manifestparser.Manifest) ([]byte, error)
 	marshalManifestMutex       sync.RWMutex
 	marshalManifestArgsForCall []struct {
@@ -131,6 +147,87 @@ func (fake *FakeManifestParser) InterpolateManifestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeManifestParser) InterpolateManifestWithOverlays(arg1 string, arg2 []string, arg3 []string, arg4 []template.VarKV) ([]byte, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []template.VarKV
+	if arg4 != nil {
+		arg4Copy = make([]template.VarKV, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.interpolateManifestWithOverlaysMutex.Lock()
+	ret, specificReturn := fake.interpolateManifestWithOverlaysReturnsOnCall[len(fake.interpolateManifestWithOverlaysArgsForCall)]
+	fake.interpolateManifestWithOverlaysArgsForCall = append(fake.interpolateManifestWithOverlaysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+		arg4 []template.VarKV
+	}{arg1, arg2Copy, arg3Copy, arg4Copy})
+	fake.recordInvocation("InterpolateManifestWithOverlays", []interface{}{arg1, arg2Copy, arg3Copy, arg4Copy})
+	fake.interpolateManifestWithOverlaysMutex.Unlock()
+	if fake.InterpolateManifestWithOverlaysStub != nil {
+		return fake.InterpolateManifestWithOverlaysStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.interpolateManifestWithOverlaysReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManifestParser) InterpolateManifestWithOverlaysCallCount() int {
+	fake.interpolateManifestWithOverlaysMutex.RLock()
+	defer fake.interpolateManifestWithOverlaysMutex.RUnlock()
+	return len(fake.interpolateManifestWithOverlaysArgsForCall)
+}
+
+func (fake *FakeManifestParser) InterpolateManifestWithOverlaysCalls(stub func(string, []string, []string, []template.VarKV) ([]byte, error)) {
+	fake.interpolateManifestWithOverlaysMutex.Lock()
+	defer fake.interpolateManifestWithOverlaysMutex.Unlock()
+	fake.InterpolateManifestWithOverlaysStub = stub
+}
+
+func (fake *FakeManifestParser) InterpolateManifestWithOverlaysArgsForCall(i int) (string, []string, []string, []template.VarKV) {
+	fake.interpolateManifestWithOverlaysMutex.RLock()
+	defer fake.interpolateManifestWithOverlaysMutex.RUnlock()
+	argsForCall := fake.interpolateManifestWithOverlaysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeManifestParser) InterpolateManifestWithOverlaysReturns(result1 []byte, result2 error) {
+	fake.interpolateManifestWithOverlaysMutex.Lock()
+	defer fake.interpolateManifestWithOverlaysMutex.Unlock()
+	fake.InterpolateManifestWithOverlaysStub = nil
+	fake.interpolateManifestWithOverlaysReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestParser) InterpolateManifestWithOverlaysReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.interpolateManifestWithOverlaysMutex.Lock()
+	defer fake.interpolateManifestWithOverlaysMutex.Unlock()
+	fake.InterpolateManifestWithOverlaysStub = nil
+	if fake.interpolateManifestWithOverlaysReturnsOnCall == nil {
+		fake.interpolateManifestWithOverlaysReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.interpolateManifestWithOverlaysReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManifestParser) MarshalManifest(arg1 manifestparser.Manifest) ([]byte, error) {
 	fake.marshalManifestMutex.Lock()
 	ret, specificReturn := fake.marshalManifestReturnsOnCall[len(fake.marshalManifestArgsForCall)]
@@ -268,6 +365,8 @@ func (fake *FakeManifestParser) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.interpolateManifestMutex.RLock()
 	defer fake.interpolateManifestMutex.RUnlock()
+	fake.interpolateManifestWithOverlaysMutex.RLock()
+	defer fake.interpolateManifestWithOverlaysMutex.RUnlock()
 	fake.marshalManifestMutex.RLock()
 	defer fake.marshalManifestMutex.RUnlock()
 	fake.parseManifestMutex.RLock()
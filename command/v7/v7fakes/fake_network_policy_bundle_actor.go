@@ -0,0 +1,213 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeNetworkPolicyBundleActor struct {
+	ApplyRawNetworkPoliciesStub        func(string, []byte, bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)
+	applyRawNetworkPoliciesMutex       sync.RWMutex
+	applyRawNetworkPoliciesArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}
+	applyRawNetworkPoliciesReturns struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	applyRawNetworkPoliciesReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	GetRawNetworkPoliciesBySpaceStub        func(string) ([]byte, cfnetworkingaction.Warnings, error)
+	getRawNetworkPoliciesBySpaceMutex       sync.RWMutex
+	getRawNetworkPoliciesBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getRawNetworkPoliciesBySpaceReturns struct {
+		result1 []byte
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getRawNetworkPoliciesBySpaceReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPolicies(arg1 string, arg2 []byte, arg3 bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.applyRawNetworkPoliciesMutex.Lock()
+	ret, specificReturn := fake.applyRawNetworkPoliciesReturnsOnCall[len(fake.applyRawNetworkPoliciesArgsForCall)]
+	fake.applyRawNetworkPoliciesArgsForCall = append(fake.applyRawNetworkPoliciesArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("ApplyRawNetworkPolicies", []interface{}{arg1, arg2Copy, arg3})
+	fake.applyRawNetworkPoliciesMutex.Unlock()
+	if fake.ApplyRawNetworkPoliciesStub != nil {
+		return fake.ApplyRawNetworkPoliciesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.applyRawNetworkPoliciesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPoliciesCallCount() int {
+	fake.applyRawNetworkPoliciesMutex.RLock()
+	defer fake.applyRawNetworkPoliciesMutex.RUnlock()
+	return len(fake.applyRawNetworkPoliciesArgsForCall)
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPoliciesCalls(stub func(string, []byte, bool) (cfnetworkingaction.PolicyChanges, cfnetworkingaction.Warnings, error)) {
+	fake.applyRawNetworkPoliciesMutex.Lock()
+	defer fake.applyRawNetworkPoliciesMutex.Unlock()
+	fake.ApplyRawNetworkPoliciesStub = stub
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPoliciesArgsForCall(i int) (string, []byte, bool) {
+	fake.applyRawNetworkPoliciesMutex.RLock()
+	defer fake.applyRawNetworkPoliciesMutex.RUnlock()
+	argsForCall := fake.applyRawNetworkPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPoliciesReturns(result1 cfnetworkingaction.PolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.applyRawNetworkPoliciesMutex.Lock()
+	defer fake.applyRawNetworkPoliciesMutex.Unlock()
+	fake.ApplyRawNetworkPoliciesStub = nil
+	fake.applyRawNetworkPoliciesReturns = struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyBundleActor) ApplyRawNetworkPoliciesReturnsOnCall(i int, result1 cfnetworkingaction.PolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.applyRawNetworkPoliciesMutex.Lock()
+	defer fake.applyRawNetworkPoliciesMutex.Unlock()
+	fake.ApplyRawNetworkPoliciesStub = nil
+	if fake.applyRawNetworkPoliciesReturnsOnCall == nil {
+		fake.applyRawNetworkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.PolicyChanges
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.applyRawNetworkPoliciesReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.PolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpace(arg1 string) ([]byte, cfnetworkingaction.Warnings, error) {
+	fake.getRawNetworkPoliciesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getRawNetworkPoliciesBySpaceReturnsOnCall[len(fake.getRawNetworkPoliciesBySpaceArgsForCall)]
+	fake.getRawNetworkPoliciesBySpaceArgsForCall = append(fake.getRawNetworkPoliciesBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRawNetworkPoliciesBySpace", []interface{}{arg1})
+	fake.getRawNetworkPoliciesBySpaceMutex.Unlock()
+	if fake.GetRawNetworkPoliciesBySpaceStub != nil {
+		return fake.GetRawNetworkPoliciesBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRawNetworkPoliciesBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpaceCallCount() int {
+	fake.getRawNetworkPoliciesBySpaceMutex.RLock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.RUnlock()
+	return len(fake.getRawNetworkPoliciesBySpaceArgsForCall)
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpaceCalls(stub func(string) ([]byte, cfnetworkingaction.Warnings, error)) {
+	fake.getRawNetworkPoliciesBySpaceMutex.Lock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.Unlock()
+	fake.GetRawNetworkPoliciesBySpaceStub = stub
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpaceArgsForCall(i int) string {
+	fake.getRawNetworkPoliciesBySpaceMutex.RLock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.RUnlock()
+	argsForCall := fake.getRawNetworkPoliciesBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpaceReturns(result1 []byte, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getRawNetworkPoliciesBySpaceMutex.Lock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.Unlock()
+	fake.GetRawNetworkPoliciesBySpaceStub = nil
+	fake.getRawNetworkPoliciesBySpaceReturns = struct {
+		result1 []byte
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyBundleActor) GetRawNetworkPoliciesBySpaceReturnsOnCall(i int, result1 []byte, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getRawNetworkPoliciesBySpaceMutex.Lock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.Unlock()
+	fake.GetRawNetworkPoliciesBySpaceStub = nil
+	if fake.getRawNetworkPoliciesBySpaceReturnsOnCall == nil {
+		fake.getRawNetworkPoliciesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getRawNetworkPoliciesBySpaceReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyBundleActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyRawNetworkPoliciesMutex.RLock()
+	defer fake.applyRawNetworkPoliciesMutex.RUnlock()
+	fake.getRawNetworkPoliciesBySpaceMutex.RLock()
+	defer fake.getRawNetworkPoliciesBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNetworkPolicyBundleActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.NetworkPolicyBundleActor = new(FakeNetworkPolicyBundleActor)
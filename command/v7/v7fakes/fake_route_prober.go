@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/routeprobe"
+)
+
+type FakeRouteProber struct {
+	ProbeStub        func(string) (routeprobe.Result, error)
+	probeMutex       sync.RWMutex
+	probeArgsForCall []struct {
+		arg1 string
+	}
+	probeReturns struct {
+		result1 routeprobe.Result
+		result2 error
+	}
+	probeReturnsOnCall map[int]struct {
+		result1 routeprobe.Result
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRouteProber) Probe(arg1 string) (routeprobe.Result, error) {
+	fake.probeMutex.Lock()
+	ret, specificReturn := fake.probeReturnsOnCall[len(fake.probeArgsForCall)]
+	fake.probeArgsForCall = append(fake.probeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Probe", []interface{}{arg1})
+	fake.probeMutex.Unlock()
+	if fake.ProbeStub != nil {
+		return fake.ProbeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.probeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRouteProber) ProbeCallCount() int {
+	fake.probeMutex.RLock()
+	defer fake.probeMutex.RUnlock()
+	return len(fake.probeArgsForCall)
+}
+
+func (fake *FakeRouteProber) ProbeCalls(stub func(string) (routeprobe.Result, error)) {
+	fake.probeMutex.Lock()
+	defer fake.probeMutex.Unlock()
+	fake.ProbeStub = stub
+}
+
+func (fake *FakeRouteProber) ProbeArgsForCall(i int) string {
+	fake.probeMutex.RLock()
+	defer fake.probeMutex.RUnlock()
+	argsForCall := fake.probeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRouteProber) ProbeReturns(result1 routeprobe.Result, result2 error) {
+	fake.probeMutex.Lock()
+	defer fake.probeMutex.Unlock()
+	fake.ProbeStub = nil
+	fake.probeReturns = struct {
+		result1 routeprobe.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRouteProber) ProbeReturnsOnCall(i int, result1 routeprobe.Result, result2 error) {
+	fake.probeMutex.Lock()
+	defer fake.probeMutex.Unlock()
+	fake.ProbeStub = nil
+	if fake.probeReturnsOnCall == nil {
+		fake.probeReturnsOnCall = make(map[int]struct {
+			result1 routeprobe.Result
+			result2 error
+		})
+	}
+	fake.probeReturnsOnCall[i] = struct {
+		result1 routeprobe.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRouteProber) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.probeMutex.RLock()
+	defer fake.probeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRouteProber) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.RouteProber = new(FakeRouteProber)
@@ -67,6 +67,24 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateLabelsBySelectorStub        func(string, string, string, map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)
+	updateLabelsBySelectorMutex       sync.RWMutex
+	updateLabelsBySelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateLabelsBySelectorReturns struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateLabelsBySelectorReturnsOnCall map[int]struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateOrganizationLabelsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateOrganizationLabelsByOrganizationNameMutex       sync.RWMutex
 	updateOrganizationLabelsByOrganizationNameArgsForCall []struct {
@@ -438,6 +456,75 @@ func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainNameReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateLabelsBySelector(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	ret, specificReturn := fake.updateLabelsBySelectorReturnsOnCall[len(fake.updateLabelsBySelectorArgsForCall)]
+	fake.updateLabelsBySelectorArgsForCall = append(fake.updateLabelsBySelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateLabelsBySelector", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateLabelsBySelectorMutex.Unlock()
+	if fake.UpdateLabelsBySelectorStub != nil {
+		return fake.UpdateLabelsBySelectorStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateLabelsBySelectorReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSetLabelActor) UpdateLabelsBySelectorCallCount() int {
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
+	return len(fake.updateLabelsBySelectorArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateLabelsBySelectorCalls(stub func(string, string, string, map[string]types.NullString) ([]v7action.BulkLabelUpdate, v7action.Warnings, error)) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateLabelsBySelectorArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
+	argsForCall := fake.updateLabelsBySelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSetLabelActor) UpdateLabelsBySelectorReturns(result1 []v7action.BulkLabelUpdate, result2 v7action.Warnings, result3 error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = nil
+	fake.updateLabelsBySelectorReturns = struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetLabelActor) UpdateLabelsBySelectorReturnsOnCall(i int, result1 []v7action.BulkLabelUpdate, result2 v7action.Warnings, result3 error) {
+	fake.updateLabelsBySelectorMutex.Lock()
+	defer fake.updateLabelsBySelectorMutex.Unlock()
+	fake.UpdateLabelsBySelectorStub = nil
+	if fake.updateLabelsBySelectorReturnsOnCall == nil {
+		fake.updateLabelsBySelectorReturnsOnCall = make(map[int]struct {
+			result1 []v7action.BulkLabelUpdate
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateLabelsBySelectorReturnsOnCall[i] = struct {
+		result1 []v7action.BulkLabelUpdate
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSetLabelActor) UpdateOrganizationLabelsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateOrganizationLabelsByOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationLabelsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationLabelsByOrganizationNameArgsForCall)]
@@ -967,6 +1054,8 @@ func (fake *FakeSetLabelActor) Invocations() map[string][][]interface{} {
 	defer fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
+	fake.updateLabelsBySelectorMutex.RLock()
+	defer fake.updateLabelsBySelectorMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
 	defer fake.updateOrganizationLabelsByOrganizationNameMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
@@ -2,6 +2,7 @@
 package v7fakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -21,6 +22,36 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellAndDownloadStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer, []string, func(remotePath string) (io.WriteCloser, error)) error
+	executeSecureShellAndDownloadMutex       sync.RWMutex
+	executeSecureShellAndDownloadArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+		arg5 []string
+		arg6 func(remotePath string) (io.WriteCloser, error)
+	}
+	executeSecureShellAndDownloadReturns struct {
+		result1 error
+	}
+	executeSecureShellAndDownloadReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExecuteSecureShellCommandStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) error
+	executeSecureShellCommandMutex       sync.RWMutex
+	executeSecureShellCommandArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}
+	executeSecureShellCommandReturns struct {
+		result1 error
+	}
+	executeSecureShellCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +117,148 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownload(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 io.Writer, arg4 io.Writer, arg5 []string, arg6 func(remotePath string) (io.WriteCloser, error)) error {
+	var arg5Copy []string
+	if arg5 != nil {
+		arg5Copy = make([]string, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.executeSecureShellAndDownloadMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellAndDownloadReturnsOnCall[len(fake.executeSecureShellAndDownloadArgsForCall)]
+	fake.executeSecureShellAndDownloadArgsForCall = append(fake.executeSecureShellAndDownloadArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+		arg5 []string
+		arg6 func(remotePath string) (io.WriteCloser, error)
+	}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
+	fake.recordInvocation("ExecuteSecureShellAndDownload", []interface{}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
+	fake.executeSecureShellAndDownloadMutex.Unlock()
+	if fake.ExecuteSecureShellAndDownloadStub != nil {
+		return fake.ExecuteSecureShellAndDownloadStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellAndDownloadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownloadCallCount() int {
+	fake.executeSecureShellAndDownloadMutex.RLock()
+	defer fake.executeSecureShellAndDownloadMutex.RUnlock()
+	return len(fake.executeSecureShellAndDownloadArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownloadCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer, []string, func(remotePath string) (io.WriteCloser, error)) error) {
+	fake.executeSecureShellAndDownloadMutex.Lock()
+	defer fake.executeSecureShellAndDownloadMutex.Unlock()
+	fake.ExecuteSecureShellAndDownloadStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownloadArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer, []string, func(remotePath string) (io.WriteCloser, error)) {
+	fake.executeSecureShellAndDownloadMutex.RLock()
+	defer fake.executeSecureShellAndDownloadMutex.RUnlock()
+	argsForCall := fake.executeSecureShellAndDownloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownloadReturns(result1 error) {
+	fake.executeSecureShellAndDownloadMutex.Lock()
+	defer fake.executeSecureShellAndDownloadMutex.Unlock()
+	fake.ExecuteSecureShellAndDownloadStub = nil
+	fake.executeSecureShellAndDownloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellAndDownloadReturnsOnCall(i int, result1 error) {
+	fake.executeSecureShellAndDownloadMutex.Lock()
+	defer fake.executeSecureShellAndDownloadMutex.Unlock()
+	fake.ExecuteSecureShellAndDownloadStub = nil
+	if fake.executeSecureShellAndDownloadReturnsOnCall == nil {
+		fake.executeSecureShellAndDownloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureShellAndDownloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommand(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 io.Writer, arg4 io.Writer) error {
+	fake.executeSecureShellCommandMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellCommandReturnsOnCall[len(fake.executeSecureShellCommandArgsForCall)]
+	fake.executeSecureShellCommandArgsForCall = append(fake.executeSecureShellCommandArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ExecuteSecureShellCommand", []interface{}{arg1, arg2, arg3, arg4})
+	fake.executeSecureShellCommandMutex.Unlock()
+	if fake.ExecuteSecureShellCommandStub != nil {
+		return fake.ExecuteSecureShellCommandStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellCommandReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandCallCount() int {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	return len(fake.executeSecureShellCommandArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, io.Writer, io.Writer) {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	argsForCall := fake.executeSecureShellCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandReturns(result1 error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = nil
+	fake.executeSecureShellCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandReturnsOnCall(i int, result1 error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	defer fake.executeSecureShellCommandMutex.Unlock()
+	fake.ExecuteSecureShellCommandStub = nil
+	if fake.executeSecureShellCommandReturnsOnCall == nil {
+		fake.executeSecureShellCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureShellCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellAndDownloadMutex.RLock()
+	defer fake.executeSecureShellAndDownloadMutex.RUnlock()
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
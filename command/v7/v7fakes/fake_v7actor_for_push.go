@@ -28,6 +28,21 @@ type FakeV7ActorForPush struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationRevisionsDeployedStub        func(string) ([]resources.Revision, v7action.Warnings, error)
+	getApplicationRevisionsDeployedMutex       sync.RWMutex
+	getApplicationRevisionsDeployedArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationRevisionsDeployedReturns struct {
+		result1 []resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationRevisionsDeployedReturnsOnCall map[int]struct {
+		result1 []resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDetailedAppSummaryStub        func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	getDetailedAppSummaryMutex       sync.RWMutex
 	getDetailedAppSummaryArgsForCall []struct {
@@ -94,6 +109,20 @@ type FakeV7ActorForPush struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	VerifyApplicationHealthStub        func(resources.Application, v7action.ApplicationHealthCriteria) (v7action.Warnings, error)
+	verifyApplicationHealthMutex       sync.RWMutex
+	verifyApplicationHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationHealthCriteria
+	}
+	verifyApplicationHealthReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	verifyApplicationHealthReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -165,6 +194,72 @@ func (fake *FakeV7ActorForPush) GetApplicationByNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployed(arg1 string) ([]resources.Revision, v7action.Warnings, error) {
+	fake.getApplicationRevisionsDeployedMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsDeployedReturnsOnCall[len(fake.getApplicationRevisionsDeployedArgsForCall)]
+	fake.getApplicationRevisionsDeployedArgsForCall = append(fake.getApplicationRevisionsDeployedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationRevisionsDeployed", []interface{}{arg1})
+	fake.getApplicationRevisionsDeployedMutex.Unlock()
+	if fake.GetApplicationRevisionsDeployedStub != nil {
+		return fake.GetApplicationRevisionsDeployedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRevisionsDeployedReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployedCallCount() int {
+	fake.getApplicationRevisionsDeployedMutex.RLock()
+	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
+	return len(fake.getApplicationRevisionsDeployedArgsForCall)
+}
+
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployedCalls(stub func(string) ([]resources.Revision, v7action.Warnings, error)) {
+	fake.getApplicationRevisionsDeployedMutex.Lock()
+	defer fake.getApplicationRevisionsDeployedMutex.Unlock()
+	fake.GetApplicationRevisionsDeployedStub = stub
+}
+
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployedArgsForCall(i int) string {
+	fake.getApplicationRevisionsDeployedMutex.RLock()
+	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
+	argsForCall := fake.getApplicationRevisionsDeployedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployedReturns(result1 []resources.Revision, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRevisionsDeployedMutex.Lock()
+	defer fake.getApplicationRevisionsDeployedMutex.Unlock()
+	fake.GetApplicationRevisionsDeployedStub = nil
+	fake.getApplicationRevisionsDeployedReturns = struct {
+		result1 []resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7ActorForPush) GetApplicationRevisionsDeployedReturnsOnCall(i int, result1 []resources.Revision, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRevisionsDeployedMutex.Lock()
+	defer fake.getApplicationRevisionsDeployedMutex.Unlock()
+	fake.GetApplicationRevisionsDeployedStub = nil
+	if fake.getApplicationRevisionsDeployedReturnsOnCall == nil {
+		fake.getApplicationRevisionsDeployedReturnsOnCall = make(map[int]struct {
+			result1 []resources.Revision
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsDeployedReturnsOnCall[i] = struct {
+		result1 []resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7ActorForPush) GetDetailedAppSummary(arg1 string, arg2 string, arg3 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
 	fake.getDetailedAppSummaryMutex.Lock()
 	ret, specificReturn := fake.getDetailedAppSummaryReturnsOnCall[len(fake.getDetailedAppSummaryArgsForCall)]
@@ -440,11 +535,77 @@ func (fake *FakeV7ActorForPush) SetSpaceManifestReturnsOnCall(i int, result1 v7a
 	}{result1, result2}
 }
 
+func (fake *FakeV7ActorForPush) VerifyApplicationHealth(arg1 resources.Application, arg2 v7action.ApplicationHealthCriteria) (v7action.Warnings, error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	ret, specificReturn := fake.verifyApplicationHealthReturnsOnCall[len(fake.verifyApplicationHealthArgsForCall)]
+	fake.verifyApplicationHealthArgsForCall = append(fake.verifyApplicationHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 v7action.ApplicationHealthCriteria
+	}{arg1, arg2})
+	fake.recordInvocation("VerifyApplicationHealth", []interface{}{arg1, arg2})
+	fake.verifyApplicationHealthMutex.Unlock()
+	if fake.VerifyApplicationHealthStub != nil {
+		return fake.VerifyApplicationHealthStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.verifyApplicationHealthReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7ActorForPush) VerifyApplicationHealthCallCount() int {
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
+	return len(fake.verifyApplicationHealthArgsForCall)
+}
+
+func (fake *FakeV7ActorForPush) VerifyApplicationHealthCalls(stub func(resources.Application, v7action.ApplicationHealthCriteria) (v7action.Warnings, error)) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = stub
+}
+
+func (fake *FakeV7ActorForPush) VerifyApplicationHealthArgsForCall(i int) (resources.Application, v7action.ApplicationHealthCriteria) {
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
+	argsForCall := fake.verifyApplicationHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7ActorForPush) VerifyApplicationHealthReturns(result1 v7action.Warnings, result2 error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = nil
+	fake.verifyApplicationHealthReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7ActorForPush) VerifyApplicationHealthReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.verifyApplicationHealthMutex.Lock()
+	defer fake.verifyApplicationHealthMutex.Unlock()
+	fake.VerifyApplicationHealthStub = nil
+	if fake.verifyApplicationHealthReturnsOnCall == nil {
+		fake.verifyApplicationHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.verifyApplicationHealthReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7ActorForPush) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationRevisionsDeployedMutex.RLock()
+	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getDetailedAppSummaryMutex.RLock()
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
@@ -453,6 +614,8 @@ func (fake *FakeV7ActorForPush) Invocations() map[string][][]interface{} {
 	defer fake.restartApplicationMutex.RUnlock()
 	fake.setSpaceManifestMutex.RLock()
 	defer fake.setSpaceManifestMutex.RUnlock()
+	fake.verifyApplicationHealthMutex.RLock()
+	defer fake.verifyApplicationHealthMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,181 @@
+package v7
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// verifyDuration is how long verify watches the app when --duration is not
+// given.
+const verifyDuration = 5 * time.Minute
+
+// verifyRequestTimeout is how long verify waits for the endpoint of the app
+// to respond.
+const verifyRequestTimeout = 10 * time.Second
+
+type VerifyCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	Duration        flag.Duration `long:"duration" description:"How long to watch the app for, e.g. 90s or 10m (Default: 5m)"`
+	MaxRestarts     int           `long:"max-restarts" description:"How many times the instances of the app may restart in total (Default: 0)"`
+	URL             string        `long:"url" description:"Path on the first route of the app, or full URL, to request on every poll"`
+	ExpectStatus    int           `long:"expect-status" description:"HTTP status code that every request to --url must return (Default: 200)"`
+	usage           interface{}   `usage:"CF_NAME verify APP_NAME [--duration DURATION] [--max-restarts COUNT] [--url PATH_OR_URL] [--expect-status CODE]\n\n   Watches an app for a while, typically right after a deployment, polling the states of its instances\n   and optionally requesting one of its endpoints. Fails with what went wrong when the instances restart\n   more often than allowed or the endpoint does not return the expected status code.\n\nEXAMPLES:\n   CF_NAME verify my-app\n   CF_NAME verify my-app --duration 5m --max-restarts 0 --url /healthz --expect-status 200"`
+	relatedCommands interface{}   `related_commands:"app, events, push, rollout-status"`
+
+	HTTPClient v7action.HTTPClient
+}
+
+func (cmd *VerifyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.HTTPClient = &http.Client{
+		Timeout: verifyRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: util.NewClientTLSConfig(config.SkipSSLValidation(), config.ClientCertificate()),
+			DialContext:     util.NewDialer(config.DialTimeout(), config.IPFamily(), config.ResolveOverrides()).DialContext,
+		},
+		// The status of the endpoint itself is checked, not that of the
+		// page it redirects to.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd VerifyCommand) Execute(args []string) error {
+	if cmd.MaxRestarts < 0 {
+		return translatableerror.ParseArgumentError{ArgumentName: "--max-restarts", ExpectedType: "a non-negative integer"}
+	}
+
+	slo := v7action.ApplicationSLO{
+		Duration:       verifyDuration,
+		MaxRestarts:    cmd.MaxRestarts,
+		URL:            cmd.URL,
+		ExpectedStatus: http.StatusOK,
+	}
+	if cmd.Duration.IsSet {
+		slo.Duration = cmd.Duration.Value
+	}
+	if cmd.ExpectStatus != 0 {
+		if cmd.URL == "" {
+			return translatableerror.RequiredFlagsError{Arg1: "--expect-status", Arg2: "--url"}
+		}
+		if cmd.ExpectStatus < 100 || cmd.ExpectStatus > 599 {
+			return translatableerror.ParseArgumentError{ArgumentName: "--expect-status", ExpectedType: "an HTTP status code between 100 and 599"}
+		}
+		slo.ExpectedStatus = cmd.ExpectStatus
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	cmd.UI.DisplayTextWithFlavor("Verifying app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} for {{.Duration}}...", map[string]interface{}{
+		"AppName":   appName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+		"Duration":  slo.Duration,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	report, warnings, err := cmd.Actor.VerifyApplicationSLO(app, slo, cmd.HTTPClient)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayReport(report, slo)
+
+	if report.Violated {
+		return translatableerror.AppVerificationFailedError{AppName: appName}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd VerifyCommand) displayReport(report v7action.ApplicationSLOReport, slo v7action.ApplicationSLO) {
+	summary := [][]string{
+		{cmd.UI.TranslateText("polls:"), strconv.Itoa(report.Polls)},
+		{cmd.UI.TranslateText("restarts:"), cmd.UI.TranslateText("{{.Restarts}} (max {{.MaxRestarts}})", map[string]interface{}{
+			"Restarts":    len(report.Restarts),
+			"MaxRestarts": slo.MaxRestarts,
+		})},
+	}
+	if report.URL != "" {
+		summary = append(summary, []string{cmd.UI.TranslateText("failed requests:"), cmd.UI.TranslateText("{{.Failed}} of {{.Requests}} to {{.URL}} (expected status {{.Status}})", map[string]interface{}{
+			"Failed":   len(report.FailedChecks),
+			"Requests": report.Polls,
+			"URL":      report.URL,
+			"Status":   slo.ExpectedStatus,
+		})})
+	}
+	cmd.UI.DisplayKeyValueTable("", summary, ui.DefaultTableSpacePadding)
+
+	if len(report.Restarts) > 0 {
+		cmd.UI.DisplayNewline()
+		table := [][]string{{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("reason"),
+		}}
+		for _, restart := range report.Restarts {
+			reason := cmd.UI.TranslateText("uptime reset")
+			if restart.Crashed {
+				reason = cmd.UI.TranslateText("crashed")
+			}
+			table = append(table, []string{
+				restart.Time.Local().Format(eventTimeFormat),
+				restart.ProcessType,
+				strconv.FormatInt(restart.InstanceIndex, 10),
+				reason,
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+
+	if len(report.FailedChecks) > 0 {
+		cmd.UI.DisplayNewline()
+		table := [][]string{{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("result"),
+		}}
+		for _, failure := range report.FailedChecks {
+			result := cmd.UI.TranslateText("status {{.Status}}", map[string]interface{}{"Status": failure.StatusCode})
+			if failure.Err != nil {
+				result = failure.Err.Error()
+			}
+			table = append(table, []string{
+				failure.Time.Local().Format(eventTimeFormat),
+				result,
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+}
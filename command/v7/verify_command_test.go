@@ -0,0 +1,220 @@
+package v7_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("verify Command", func() {
+	var (
+		cmd             VerifyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeHTTPClient  *v7actionfakes.FakeHTTPClient
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeHTTPClient = new(v7actionfakes.FakeHTTPClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = VerifyCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			HTTPClient: fakeHTTPClient,
+		}
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: "some-app", GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.VerifyApplicationSLOReturns(v7action.ApplicationSLOReport{Polls: 100}, v7action.Warnings{"verify-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("--max-restarts is negative", func() {
+		BeforeEach(func() {
+			cmd.MaxRestarts = -1
+		})
+
+		It("returns a parse argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "--max-restarts", ExpectedType: "a non-negative integer"}))
+			Expect(fakeActor.VerifyApplicationSLOCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--expect-status is given without --url", func() {
+		BeforeEach(func() {
+			cmd.ExpectStatus = 204
+		})
+
+		It("returns a required flags error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--expect-status", Arg2: "--url"}))
+		})
+	})
+
+	When("--expect-status is not a status code", func() {
+		BeforeEach(func() {
+			cmd.URL = "/healthz"
+			cmd.ExpectStatus = 42
+		})
+
+		It("returns a parse argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "--expect-status", ExpectedType: "an HTTP status code between 100 and 599"}))
+		})
+	})
+
+	When("the app keeps to the SLO", func() {
+		It("watches the app for the default duration and displays OK", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Verifying app some-app in org some-org / space some-space as steve for 5m0s\.\.\.`))
+			Expect(testUI.Out).To(Say(`polls:\s+100`))
+			Expect(testUI.Out).To(Say(`restarts:\s+0 \(max 0\)`))
+			Expect(testUI.Out).NotTo(Say("failed requests:"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("verify-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			app, slo, client := fakeActor.VerifyApplicationSLOArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(slo).To(Equal(v7action.ApplicationSLO{Duration: 5 * time.Minute, ExpectedStatus: http.StatusOK}))
+			Expect(client).To(Equal(fakeHTTPClient))
+		})
+	})
+
+	When("the SLO flags are given", func() {
+		BeforeEach(func() {
+			cmd.Duration = flag.Duration{Value: 90 * time.Second, IsSet: true}
+			cmd.MaxRestarts = 1
+			cmd.URL = "/healthz"
+			cmd.ExpectStatus = http.StatusNoContent
+			fakeActor.VerifyApplicationSLOReturns(v7action.ApplicationSLOReport{Polls: 30, URL: "https://some-app.example.com/healthz"}, nil, nil)
+		})
+
+		It("verifies the app against them", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`for 1m30s\.\.\.`))
+			Expect(testUI.Out).To(Say(`failed requests:\s+0 of 30 to https://some-app.example.com/healthz \(expected status 204\)`))
+
+			_, slo, _ := fakeActor.VerifyApplicationSLOArgsForCall(0)
+			Expect(slo).To(Equal(v7action.ApplicationSLO{
+				Duration:       90 * time.Second,
+				MaxRestarts:    1,
+				URL:            "/healthz",
+				ExpectedStatus: http.StatusNoContent,
+			}))
+		})
+	})
+
+	When("the app violates the SLO", func() {
+		var seenAt time.Time
+
+		BeforeEach(func() {
+			cmd.URL = "/healthz"
+			seenAt = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+			fakeActor.VerifyApplicationSLOReturns(v7action.ApplicationSLOReport{
+				URL:   "https://some-app.example.com/healthz",
+				Polls: 3,
+				Restarts: []v7action.InstanceRestart{
+					{ProcessType: "web", InstanceIndex: 1, Time: seenAt, Crashed: true},
+					{ProcessType: "worker", InstanceIndex: 0, Time: seenAt},
+				},
+				FailedChecks: []v7action.EndpointCheckFailure{
+					{Time: seenAt, StatusCode: http.StatusBadGateway},
+					{Time: seenAt, Err: errors.New("connection refused")},
+				},
+				Violated: true,
+			}, nil, nil)
+		})
+
+		It("displays the restarts and failed requests and returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.AppVerificationFailedError{AppName: "some-app"}))
+
+			Expect(testUI.Out).To(Say(`restarts:\s+2 \(max 0\)`))
+			Expect(testUI.Out).To(Say(`failed requests:\s+2 of 3 to https://some-app.example.com/healthz \(expected status 200\)`))
+			Expect(testUI.Out).To(Say(`time\s+process\s+instance\s+reason`))
+			Expect(testUI.Out).To(Say(`web\s+1\s+crashed`))
+			Expect(testUI.Out).To(Say(`worker\s+0\s+uptime reset`))
+			Expect(testUI.Out).To(Say(`time\s+result`))
+			Expect(testUI.Out).To(Say(`status 502`))
+			Expect(testUI.Out).To(Say(`connection refused`))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.VerifyApplicationSLOCallCount()).To(Equal(0))
+		})
+	})
+
+	When("verifying the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.VerifyApplicationSLOReturns(v7action.ApplicationSLOReport{}, v7action.Warnings{"verify-warning"}, errors.New("poll failed"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("poll failed"))
+			Expect(testUI.Err).To(Say("verify-warning"))
+		})
+	})
+})
@@ -41,9 +41,10 @@ var _ = Describe("app command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("app - Display health and status for an app"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf app APP_NAME"))
+				Eventually(session).Should(Say(`cf app \(APP_NAME \| --app-guid APP_GUID\)`))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("--guid      Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."))
+				Eventually(session).Should(Say(`--app-guid\s+GUID of the app, instead of its name. The app must be in the targeted space`))
+				Eventually(session).Should(Say(`--guid\s+Retrieve and display the given app's guid.  All other health and status output for the app is suppressed.`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("apps, events, logs, map-route, push, unmap-route"))
 				Eventually(session).Should(Exit(0))
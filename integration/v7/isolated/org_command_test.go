@@ -28,9 +28,10 @@ var _ = Describe("org command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("org - Show org info"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf org ORG [--guid]"))
+				Eventually(session).Should(Say(`cf org \(ORG \| --org-guid ORG_GUID\) \[--guid\]`))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--guid\s+Retrieve and display the given org's guid.  All other output for the org is suppressed.`))
+				Eventually(session).Should(Say(`--org-guid\s+GUID of the org, instead of its name`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("org-users, orgs"))
 				Eventually(session).Should(Exit(0))
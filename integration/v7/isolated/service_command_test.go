@@ -24,11 +24,12 @@ var _ = Describe("service command", func() {
 			Say(fmt.Sprintf(`\s+%s - Show service instance info\n`, serviceCommand)),
 			Say(`\n`),
 			Say(`USAGE:\n`),
-			Say(`\s+cf service SERVICE_INSTANCE\n`),
+			Say(`\s+cf service \(SERVICE_INSTANCE \| --service-instance-guid SERVICE_INSTANCE_GUID\)\n`),
 			Say(`\n`),
 			Say(`OPTIONS:\n`),
 			Say(`\s+--guid\s+Retrieve and display the given service instances's guid. All other output is suppressed.\n`),
 			Say(`\s+--params\s+Retrieve and display the given service instances's parameters. All other output is suppressed.\n`),
+			Say(`\s+--service-instance-guid\s+GUID of the service instance, instead of its name. The service instance must be in the targeted space\n`),
 			Say(`\n`),
 			Say(`SEE ALSO:\n`),
 			Say(`\s+bind-service, rename-service, update-service\n`),
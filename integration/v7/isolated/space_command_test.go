@@ -31,9 +31,10 @@ var _ = Describe("space command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("space - Show space info"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(`cf space SPACE \[--guid\]`))
+				Eventually(session).Should(Say(`cf space \(SPACE \| --space-guid SPACE_GUID\) \[--guid\]`))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--guid\s+Retrieve and display the given space's guid\.  All other output for the space is suppressed\.`))
+				Eventually(session).Should(Say(`--space-guid\s+GUID of the space, instead of its name\. The space must be in the targeted org`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("set-space-isolation-segment, space-quota, space-users"))
 				Eventually(session).Should(Exit(0))
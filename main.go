@@ -59,6 +59,23 @@ func main() {
 
 		default:
 			unknownCommandError.Suggest(plugin_util.PluginCommandNames())
+			if correction, ok := unknownCommandError.Correction(); ok && commandUI.IsTTY {
+				runCorrection, promptErr := commandUI.DisplayBoolPrompt(false, "'{{.CommandName}}' is not a registered command. Did you mean '{{.Correction}}'?", map[string]interface{}{
+					"CommandName": unknownCommandError.CommandName,
+					"Correction":  correction,
+				})
+				if promptErr == nil {
+					if !runCorrection {
+						os.Exit(1)
+					}
+					exitCode, err = p.ParseCommandFromArgs(commandUI, unknownCommandError.CorrectedArgs(os.Args[1:]))
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+						os.Exit(1)
+					}
+					break
+				}
+			}
 			fmt.Fprintf(os.Stderr, "%s\n", unknownCommandError.Error())
 			os.Exit(1)
 		}
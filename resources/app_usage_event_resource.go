@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// AppUsageEvent represents a Cloud Controller V3 app usage event. Process
+// events record the instance count and memory a process runs with from the
+// time of the event; task events record the start and end of a task.
+type AppUsageEvent struct {
+	GUID          string
+	CreatedAt     time.Time
+	State         constant.AppUsageEventState
+	PreviousState constant.AppUsageEventState
+
+	AppGUID          string
+	AppName          string
+	ProcessGUID      string
+	ProcessType      string
+	TaskGUID         string
+	TaskName         string
+	SpaceGUID        string
+	SpaceName        string
+	OrganizationGUID string
+
+	InstanceCount                 int
+	PreviousInstanceCount         int
+	MemoryInMBPerInstance         int
+	PreviousMemoryInMBPerInstance int
+}
+
+func (e *AppUsageEvent) UnmarshalJSON(data []byte) error {
+	type currentAndPrevious struct {
+		Current  int `json:"current"`
+		Previous int `json:"previous"`
+	}
+	type guidAndName struct {
+		GUID string `json:"guid"`
+		Name string `json:"name"`
+	}
+
+	var ccEvent struct {
+		GUID      string    `json:"guid"`
+		CreatedAt time.Time `json:"created_at"`
+		State     struct {
+			Current  constant.AppUsageEventState `json:"current"`
+			Previous constant.AppUsageEventState `json:"previous"`
+		} `json:"state"`
+		App     guidAndName `json:"app"`
+		Process struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
+		} `json:"process"`
+		Task         guidAndName        `json:"task"`
+		Space        guidAndName        `json:"space"`
+		Organization guidAndName        `json:"organization"`
+		Instances    currentAndPrevious `json:"instance_count"`
+		Memory       currentAndPrevious `json:"memory_in_mb_per_instance"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	*e = AppUsageEvent{
+		GUID:                          ccEvent.GUID,
+		CreatedAt:                     ccEvent.CreatedAt,
+		State:                         ccEvent.State.Current,
+		PreviousState:                 ccEvent.State.Previous,
+		AppGUID:                       ccEvent.App.GUID,
+		AppName:                       ccEvent.App.Name,
+		ProcessGUID:                   ccEvent.Process.GUID,
+		ProcessType:                   ccEvent.Process.Type,
+		TaskGUID:                      ccEvent.Task.GUID,
+		TaskName:                      ccEvent.Task.Name,
+		SpaceGUID:                     ccEvent.Space.GUID,
+		SpaceName:                     ccEvent.Space.Name,
+		OrganizationGUID:              ccEvent.Organization.GUID,
+		InstanceCount:                 ccEvent.Instances.Current,
+		PreviousInstanceCount:         ccEvent.Instances.Previous,
+		MemoryInMBPerInstance:         ccEvent.Memory.Current,
+		PreviousMemoryInMBPerInstance: ccEvent.Memory.Previous,
+	}
+
+	return nil
+}
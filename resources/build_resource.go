@@ -9,6 +9,8 @@ import (
 
 // Build represent the process of staging an application package.
 type Build struct {
+	// AppGUID is the unique identifier of the app the build belongs to.
+	AppGUID string
 	// CreatedAt is the time with zone when the build was created.
 	CreatedAt string
 	// DropletGUID is the unique identifier for the resulting droplet from the
@@ -51,6 +53,7 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 		Droplet struct {
 			GUID string `json:"guid"`
 		} `json:"droplet"`
+		Relationships Relationships `json:"relationships"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &ccBuild)
@@ -64,6 +67,7 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 	b.PackageGUID = ccBuild.Package.GUID
 	b.State = ccBuild.State
 	b.DropletGUID = ccBuild.Droplet.GUID
+	b.AppGUID = ccBuild.Relationships[constant.RelationshipTypeApplication].GUID
 
 	return nil
 }
@@ -12,12 +12,36 @@ type Deployment struct {
 	State         constant.DeploymentState
 	StatusValue   constant.DeploymentStatusValue
 	StatusReason  constant.DeploymentStatusReason
+	Strategy      constant.DeploymentStrategy
+	CanaryStep    int
+	CanarySteps   int
 	RevisionGUID  string
 	DropletGUID   string
 	CreatedAt     string
 	UpdatedAt     string
 	Relationships Relationships
 	NewProcesses  []Process
+	Options       DeploymentOpts
+}
+
+// DeploymentOpts are the options of how a deployment replaces the instances
+// of an app.
+type DeploymentOpts struct {
+	// MaxInFlight is the number of instances that are replaced at a time. The
+	// Cloud Controller replaces one at a time when it is 0.
+	MaxInFlight int
+	// CanaryWeights are the percentages of instances a canary deployment
+	// replaces at each of its steps, pausing after every one. The Cloud
+	// Controller replaces a single canary instance when there are none.
+	CanaryWeights []int
+}
+
+type canaryStep struct {
+	InstanceWeight int `json:"instance_weight"`
+}
+
+type canaryOptions struct {
+	Steps []canaryStep `json:"steps"`
 }
 
 // MarshalJSON converts a Deployment into a Cloud Controller Deployment.
@@ -29,10 +53,17 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		GUID string `json:"guid,omitempty"`
 	}
 
+	type Options struct {
+		MaxInFlight int            `json:"max_in_flight,omitempty"`
+		Canary      *canaryOptions `json:"canary,omitempty"`
+	}
+
 	var ccDeployment struct {
-		Droplet       *Droplet      `json:"droplet,omitempty"`
-		Revision      *Revision     `json:"revision,omitempty"`
-		Relationships Relationships `json:"relationships,omitempty"`
+		Droplet       *Droplet                    `json:"droplet,omitempty"`
+		Revision      *Revision                   `json:"revision,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Options       *Options                    `json:"options,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
 	}
 
 	if d.DropletGUID != "" {
@@ -43,6 +74,17 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		ccDeployment.Revision = &Revision{d.RevisionGUID}
 	}
 
+	ccDeployment.Strategy = d.Strategy
+	if d.Options.MaxInFlight > 0 || len(d.Options.CanaryWeights) > 0 {
+		ccDeployment.Options = &Options{MaxInFlight: d.Options.MaxInFlight}
+	}
+	if len(d.Options.CanaryWeights) > 0 {
+		ccDeployment.Options.Canary = &canaryOptions{}
+		for _, weight := range d.Options.CanaryWeights {
+			ccDeployment.Options.Canary.Steps = append(ccDeployment.Options.Canary.Steps, canaryStep{InstanceWeight: weight})
+		}
+	}
+
 	ccDeployment.Relationships = d.Relationships
 
 	return json.Marshal(ccDeployment)
@@ -51,16 +93,27 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
 func (d *Deployment) UnmarshalJSON(data []byte) error {
 	var ccDeployment struct {
-		GUID          string                   `json:"guid,omitempty"`
-		CreatedAt     string                   `json:"created_at,omitempty"`
-		Relationships Relationships            `json:"relationships,omitempty"`
-		State         constant.DeploymentState `json:"state,omitempty"`
+		GUID          string                      `json:"guid,omitempty"`
+		CreatedAt     string                      `json:"created_at,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
+		State         constant.DeploymentState    `json:"state,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
 		Status        struct {
 			Value  constant.DeploymentStatusValue  `json:"value"`
 			Reason constant.DeploymentStatusReason `json:"reason"`
+			Canary struct {
+				Steps struct {
+					Current int `json:"current"`
+					Total   int `json:"total"`
+				} `json:"steps"`
+			} `json:"canary"`
 		} `json:"status"`
 		Droplet      Droplet   `json:"droplet,omitempty"`
 		NewProcesses []Process `json:"new_processes,omitempty"`
+		Options      struct {
+			MaxInFlight int            `json:"max_in_flight"`
+			Canary      *canaryOptions `json:"canary"`
+		} `json:"options"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
 	if err != nil {
@@ -73,8 +126,17 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	d.State = ccDeployment.State
 	d.StatusValue = ccDeployment.Status.Value
 	d.StatusReason = ccDeployment.Status.Reason
+	d.Strategy = ccDeployment.Strategy
+	d.CanaryStep = ccDeployment.Status.Canary.Steps.Current
+	d.CanarySteps = ccDeployment.Status.Canary.Steps.Total
 	d.DropletGUID = ccDeployment.Droplet.GUID
 	d.NewProcesses = ccDeployment.NewProcesses
+	d.Options.MaxInFlight = ccDeployment.Options.MaxInFlight
+	if ccDeployment.Options.Canary != nil {
+		for _, step := range ccDeployment.Options.Canary.Steps {
+			d.Options.CanaryWeights = append(d.Options.CanaryWeights, step.InstanceWeight)
+		}
+	}
 
 	return nil
 }
@@ -19,6 +19,9 @@ type Droplet struct {
 	GUID string `json:"guid"`
 	// Image is the Docker image name.
 	Image string `json:"image"`
+	// ProcessTypes maps the process types detected during staging to their
+	// start commands.
+	ProcessTypes map[string]string `json:"process_types,omitempty"`
 	// Stack is the root filesystem to use with the buildpack.
 	Stack string `json:"stack,omitempty"`
 	// State is the current state of the droplet.
@@ -87,6 +90,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 		Buildpacks    []DropletBuildpack    `json:"buildpacks,omitempty"`
 		CreatedAt     string                `json:"created_at,omitempty"`
 		Image         string                `json:"image,omitempty"`
+		ProcessTypes  map[string]string     `json:"process_types,omitempty"`
 		Stack         string                `json:"stack,omitempty"`
 		State         constant.DropletState `json:"state,omitempty"`
 		Relationships struct {
@@ -107,6 +111,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 	d.Buildpacks = alias.Buildpacks
 	d.CreatedAt = alias.CreatedAt
 	d.Image = alias.Image
+	d.ProcessTypes = alias.ProcessTypes
 	d.Stack = alias.Stack
 	d.State = alias.State
 	d.AppGUID = alias.Relationships.App.Data.GUID
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Droplet", func() {
+	Describe("UnmarshalJSON", func() {
+		It("parses the droplet, including its detected process types", func() {
+			var droplet Droplet
+			err := json.Unmarshal([]byte(`{
+				"guid": "some-droplet-guid",
+				"state": "STAGED",
+				"stack": "cflinuxfs4",
+				"process_types": {
+					"web": "bundle exec rackup",
+					"worker": "bundle exec sidekiq"
+				},
+				"relationships": {
+					"app": {
+						"data": {
+							"guid": "some-app-guid"
+						}
+					}
+				}
+			}`), &droplet)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(droplet).To(Equal(Droplet{
+				GUID:    "some-droplet-guid",
+				AppGUID: "some-app-guid",
+				State:   constant.DropletStaged,
+				Stack:   "cflinuxfs4",
+				ProcessTypes: map[string]string{
+					"web":    "bundle exec rackup",
+					"worker": "bundle exec sidekiq",
+				},
+			}))
+		})
+	})
+})
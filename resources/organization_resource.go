@@ -14,6 +14,8 @@ type Organization struct {
 	QuotaGUID string `json:"-"`
 	//  Suspended is the status of the organization applied to this Organization
 	Suspended bool `json:"suspended"`
+	// CreatedAt is the time with zone when the organization was created.
+	CreatedAt string `json:"created_at,omitempty"`
 	// Metadata is used for custom tagging of API resources
 	Metadata *Metadata `json:"metadata,omitempty"`
 }
@@ -23,6 +23,17 @@ type Process struct {
 	DiskInMB                     types.NullUint64
 	LogRateLimitInBPS            types.NullInt
 	AppGUID                      string
+	// Ports are the ports that the instances of the process listen on and
+	// that route destinations can send traffic to.
+	Ports []int
+
+	// The readiness health check decides whether instances receive traffic.
+	// It is only reported by Cloud Controllers that support it, and is not
+	// sent when updating a process.
+	ReadinessHealthCheckType              constant.HealthCheckType
+	ReadinessHealthCheckEndpoint          string
+	ReadinessHealthCheckInvocationTimeout int64
+	ReadinessHealthCheckInterval          int64
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -34,6 +45,7 @@ func (p Process) MarshalJSON() ([]byte, error) {
 	marshalDisk(p, &ccProcess)
 	marshalLogRateLimit(p, &ccProcess)
 	marshalHealthCheck(p, &ccProcess)
+	ccProcess.Ports = p.Ports
 
 	return json.Marshal(ccProcess)
 }
@@ -46,6 +58,7 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 		Instances         types.NullInt        `json:"instances"`
 		MemoryInMB        types.NullUint64     `json:"memory_in_mb"`
 		LogRateLimitInBPS types.NullInt        `json:"log_rate_limit_in_bytes_per_second"`
+		Ports             []int                `json:"ports"`
 		Type              string               `json:"type"`
 		Relationships     Relationships        `json:"relationships"`
 
@@ -57,6 +70,15 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 				Timeout           int64  `json:"timeout"`
 			} `json:"data"`
 		} `json:"health_check"`
+
+		ReadinessHealthCheck struct {
+			Type constant.HealthCheckType `json:"type"`
+			Data struct {
+				Endpoint          string `json:"endpoint"`
+				InvocationTimeout int64  `json:"invocation_timeout"`
+				Interval          int64  `json:"interval"`
+			} `json:"data"`
+		} `json:"readiness_health_check"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &ccProcess)
@@ -71,9 +93,14 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 	p.HealthCheckInvocationTimeout = ccProcess.HealthCheck.Data.InvocationTimeout
 	p.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
 	p.HealthCheckType = ccProcess.HealthCheck.Type
+	p.ReadinessHealthCheckEndpoint = ccProcess.ReadinessHealthCheck.Data.Endpoint
+	p.ReadinessHealthCheckInvocationTimeout = ccProcess.ReadinessHealthCheck.Data.InvocationTimeout
+	p.ReadinessHealthCheckInterval = ccProcess.ReadinessHealthCheck.Data.Interval
+	p.ReadinessHealthCheckType = ccProcess.ReadinessHealthCheck.Type
 	p.Instances = ccProcess.Instances
 	p.MemoryInMB = ccProcess.MemoryInMB
 	p.LogRateLimitInBPS = ccProcess.LogRateLimitInBPS
+	p.Ports = ccProcess.Ports
 	p.Type = ccProcess.Type
 	p.AppGUID = ccProcess.Relationships[constant.RelationshipTypeApplication].GUID
 
@@ -95,6 +122,7 @@ type marshalProcess struct {
 	MemoryInMB        json.Number `json:"memory_in_mb,omitempty"`
 	DiskInMB          json.Number `json:"disk_in_mb,omitempty"`
 	LogRateLimitInBPS json.Number `json:"log_rate_limit_in_bytes_per_second,omitempty"`
+	Ports             []int       `json:"ports,omitempty"`
 
 	HealthCheck *healthCheck `json:"health_check,omitempty"`
 }
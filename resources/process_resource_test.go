@@ -184,5 +184,20 @@ var _ = Describe("Process", func() {
 				}))
 			})
 		})
+
+		When("a readiness health check is provided", func() {
+			BeforeEach(func() {
+				processBytes = []byte(`{"readiness_health_check":{"type":"http", "data": {"endpoint": "/ready", "invocation_timeout": 5, "interval": 10}}}`)
+			})
+
+			It("sets the readiness health check", func() {
+				Expect(process).To(MatchFields(IgnoreExtras, Fields{
+					"ReadinessHealthCheckType":              Equal(constant.HTTP),
+					"ReadinessHealthCheckEndpoint":          Equal("/ready"),
+					"ReadinessHealthCheckInvocationTimeout": Equal(int64(5)),
+					"ReadinessHealthCheckInterval":          Equal(int64(10)),
+				}))
+			})
+		})
 	})
 })
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type RouteDestinationApp struct {
@@ -18,6 +19,9 @@ type RouteDestination struct {
 	App      RouteDestinationApp
 	Port     int
 	Protocol string
+	// Weight is the percentage of the route traffic sent to the destination.
+	// It is only set on weighted routes.
+	Weight types.NullInt
 }
 
 type Route struct {
@@ -61,6 +61,21 @@ var _ = Describe("service credential binding resource", func() {
 				}
 			}`,
 		),
+		Entry(
+			"metadata",
+			ServiceCredentialBinding{
+				Metadata: &Metadata{Labels: map[string]types.NullString{
+					"env": types.NewNullString("prod"),
+				}},
+			},
+			`{
+				"metadata": {
+					"labels": {
+						"env": "prod"
+					}
+				}
+			}`,
+		),
 		Entry(
 			"everything",
 			ServiceCredentialBinding{
@@ -22,6 +22,12 @@ type ServiceOffering struct {
 	ServiceBrokerName string `json:"-"`
 	// Shareable if the offering support service instance sharing
 	AllowsInstanceSharing bool `json:"shareable"`
+	// Bindable is whether instances of the offering can be bound, as the
+	// broker catalog declares it.
+	Bindable bool `jsonry:"broker_catalog.features.bindable"`
+	// PlanUpdateable is whether instances of the offering can change plans,
+	// as the broker catalog declares it.
+	PlanUpdateable bool `jsonry:"broker_catalog.features.plan_updateable"`
 
 	Metadata *Metadata `json:"metadata"`
 }
@@ -31,6 +31,12 @@ type ServicePlan struct {
 	MaintenanceInfoDescription string `jsonry:"maintenance_info.description"`
 	// MaintenanceInfoVersion is the version of the service plan
 	MaintenanceInfoVersion string `jsonry:"maintenance_info.version"`
+	// Bindable is whether instances of the plan can be bound, as the broker
+	// catalog declares it.
+	Bindable bool `jsonry:"broker_catalog.features.bindable"`
+	// PlanUpdateable is whether instances of the plan can be updated to
+	// another plan, as the broker catalog declares it.
+	PlanUpdateable bool `jsonry:"broker_catalog.features.plan_updateable"`
 
 	Metadata *Metadata `json:"metadata"`
 }
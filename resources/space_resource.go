@@ -6,6 +6,8 @@ type Space struct {
 	GUID string `json:"guid,omitempty"`
 	// Name is the name of the space.
 	Name string `json:"name"`
+	// CreatedAt is the time with zone when the space was created.
+	CreatedAt string `json:"created_at,omitempty"`
 	// Relationships list the relationships to the space.
 	Relationships Relationships `json:"relationships,omitempty"`
 	// Metadata is used for custom tagging of API resources
@@ -26,6 +26,8 @@ type Task struct {
 	SequenceID int64 `json:"sequence_id,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
+	// Relationships list the relationships to the task.
+	Relationships Relationships `json:"relationships,omitempty"`
 	// Tasks can use a process as a template to fill in
 	// command, memory, disk values
 	//
@@ -0,0 +1,150 @@
+// Package brokercatalog fetches the catalog a service broker advertises
+// through the Open Service Broker API.
+package brokercatalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util"
+)
+
+// BrokerAPIVersion is the version of the Open Service Broker API the catalog
+// is requested with.
+const BrokerAPIVersion = "2.14"
+
+// Catalog is the list of services a broker offers.
+type Catalog struct {
+	Services []Service `json:"services"`
+}
+
+// Service is a service offering of a broker catalog.
+type Service struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Bindable    bool   `json:"bindable"`
+	// PlanUpdateable is whether instances can change plans.
+	PlanUpdateable bool   `json:"plan_updateable,omitempty"`
+	Plans          []Plan `json:"plans"`
+}
+
+// Plan is a service plan of a broker catalog.
+type Plan struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Free is nil when the broker leaves it out, which means the plan is free.
+	Free *bool `json:"free,omitempty"`
+	// Bindable and PlanUpdateable are nil when the plan uses the value of
+	// its service.
+	Bindable        *bool            `json:"bindable,omitempty"`
+	PlanUpdateable  *bool            `json:"plan_updateable,omitempty"`
+	MaintenanceInfo *MaintenanceInfo `json:"maintenance_info,omitempty"`
+}
+
+// MaintenanceInfo is the maintenance information of a plan.
+type MaintenanceInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// IsFree returns whether the plan is free, following the broker API default.
+func (p Plan) IsFree() bool {
+	return p.Free == nil || *p.Free
+}
+
+// IsBindable returns whether instances of the plan can be bound, falling back
+// to its service.
+func (p Plan) IsBindable(service Service) bool {
+	if p.Bindable == nil {
+		return service.Bindable
+	}
+	return *p.Bindable
+}
+
+// IsPlanUpdateable returns whether instances of the plan can change plans,
+// falling back to its service.
+func (p Plan) IsPlanUpdateable(service Service) bool {
+	if p.PlanUpdateable == nil {
+		return service.PlanUpdateable
+	}
+	return *p.PlanUpdateable
+}
+
+// MaintenanceInfoVersion returns the maintenance info version of the plan, or
+// an empty string when it has none.
+func (p Plan) MaintenanceInfoVersion() string {
+	if p.MaintenanceInfo == nil {
+		return ""
+	}
+	return p.MaintenanceInfo.Version
+}
+
+// UnexpectedResponseError is returned when the broker does not answer the
+// catalog request with a 200.
+type UnexpectedResponseError struct {
+	Status string
+	Body   []byte
+}
+
+func (e UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("Unexpected response from service broker catalog: %s\n%s", e.Status, e.Body)
+}
+
+// Fetcher fetches broker catalogs over HTTP(S).
+type Fetcher struct {
+	Client *http.Client
+}
+
+// NewFetcher returns a Fetcher that connects to brokers with the dialer and
+// presents the client certificate, if set.
+func NewFetcher(dialer *util.Dialer, skipSSLValidation bool, clientCertificate util.ClientCertificate) Fetcher {
+	return Fetcher{
+		Client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: util.NewClientTLSConfig(skipSSLValidation, clientCertificate),
+				DialContext:     dialer.DialContext,
+			},
+		},
+	}
+}
+
+// Fetch requests the catalog of the broker at brokerURL with the given basic
+// auth credentials.
+func (f Fetcher) Fetch(brokerURL string, username string, password string) (Catalog, error) {
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(brokerURL, "/")+"/v2/catalog", nil)
+	if err != nil {
+		return Catalog{}, err
+	}
+	request.SetBasicAuth(username, password)
+	request.Header.Set("X-Broker-API-Version", BrokerAPIVersion)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := f.Client.Do(request)
+	if err != nil {
+		return Catalog{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return Catalog{}, UnexpectedResponseError{Status: response.Status, Body: body}
+	}
+
+	var catalog Catalog
+	err = json.Unmarshal(body, &catalog)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	return catalog, nil
+}
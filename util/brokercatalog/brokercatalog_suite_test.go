@@ -0,0 +1,13 @@
+package brokercatalog_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBrokercatalog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Brokercatalog Suite")
+}
@@ -0,0 +1,119 @@
+package brokercatalog_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+	. "code.cloudfoundry.org/cli/util/brokercatalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fetcher", func() {
+	var (
+		server  *httptest.Server
+		handler http.HandlerFunc
+		fetcher Fetcher
+	)
+
+	BeforeEach(func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "some-user" || password != "some-password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path != "/v2/catalog" || r.Header.Get("X-Broker-API-Version") != BrokerAPIVersion {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{
+				"services": [{
+					"id": "service-id",
+					"name": "some-service",
+					"description": "a service",
+					"plans": [
+						{"id": "plan-1-id", "name": "small", "description": "a small plan"},
+						{"id": "plan-2-id", "name": "large", "free": false, "maintenance_info": {"version": "2.0.0"}}
+					]
+				}]
+			}`))
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		fetcher = NewFetcher(util.NewDialer(5*time.Second, util.IPFamilyAny, nil), false, util.ClientCertificate{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("fetches the catalog with the broker credentials", func() {
+		catalog, err := fetcher.Fetch(server.URL+"/", "some-user", "some-password")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalog.Services).To(HaveLen(1))
+		service := catalog.Services[0]
+		Expect(service.Name).To(Equal("some-service"))
+		Expect(service.Plans).To(HaveLen(2))
+
+		Expect(service.Plans[0].Name).To(Equal("small"))
+		Expect(service.Plans[0].IsFree()).To(BeTrue())
+		Expect(service.Plans[0].MaintenanceInfoVersion()).To(BeEmpty())
+
+		Expect(service.Plans[1].Name).To(Equal("large"))
+		Expect(service.Plans[1].IsFree()).To(BeFalse())
+		Expect(service.Plans[1].MaintenanceInfoVersion()).To(Equal("2.0.0"))
+	})
+
+	When("the broker serves a certificate that is not trusted", func() {
+		var tlsServer *httptest.Server
+
+		BeforeEach(func() {
+			tlsServer = httptest.NewTLSServer(server.Config.Handler)
+		})
+
+		AfterEach(func() {
+			tlsServer.Close()
+		})
+
+		It("fails to fetch the catalog", func() {
+			_, err := fetcher.Fetch(tlsServer.URL, "some-user", "some-password")
+			Expect(err).To(MatchError(ContainSubstring("certificate")))
+		})
+
+		When("SSL validation is skipped", func() {
+			BeforeEach(func() {
+				fetcher = NewFetcher(util.NewDialer(5*time.Second, util.IPFamilyAny, nil), true, util.ClientCertificate{})
+			})
+
+			It("fetches the catalog", func() {
+				catalog, err := fetcher.Fetch(tlsServer.URL, "some-user", "some-password")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(catalog.Services).To(HaveLen(1))
+			})
+		})
+	})
+
+	It("returns an UnexpectedResponseError when the broker does not return the catalog", func() {
+		_, err := fetcher.Fetch(server.URL, "some-user", "wrong-password")
+		Expect(err).To(MatchError(UnexpectedResponseError{Status: "401 Unauthorized", Body: []byte{}}))
+	})
+
+	When("the catalog is not JSON", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("not json"))
+			}
+		})
+
+		It("returns the error", func() {
+			_, err := fetcher.Fetch(server.URL, "some-user", "some-password")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package brokercatalog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ChangeType is how a service or plan differs between two catalogs.
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// Change is a difference between two catalogs of a broker. PlanName is empty
+// when the service itself changed.
+type Change struct {
+	ServiceName string
+	PlanName    string
+	Type        ChangeType
+	// Details lists the changed fields of a changed service or plan.
+	Details []string
+}
+
+// Diff compares two catalogs, matching services and plans by name. The plans
+// of an added or removed service are listed as added or removed too. The
+// changes are sorted by service and plan name, each service before its plans.
+func Diff(previous Catalog, current Catalog) []Change {
+	previousServices := make(map[string]Service)
+	for _, service := range previous.Services {
+		previousServices[service.Name] = service
+	}
+
+	var changes []Change
+	for _, service := range current.Services {
+		previousService, exists := previousServices[service.Name]
+		delete(previousServices, service.Name)
+
+		if !exists {
+			changes = append(changes, serviceChanges(service, Added)...)
+			continue
+		}
+
+		if details := serviceDetailChanges(previousService, service); len(details) > 0 {
+			changes = append(changes, Change{ServiceName: service.Name, Type: Changed, Details: details})
+		}
+		changes = append(changes, planChanges(previousService, service)...)
+	}
+
+	for _, service := range previousServices {
+		changes = append(changes, serviceChanges(service, Removed)...)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].ServiceName != changes[j].ServiceName {
+			return changes[i].ServiceName < changes[j].ServiceName
+		}
+		return changes[i].PlanName < changes[j].PlanName
+	})
+
+	return changes
+}
+
+func serviceChanges(service Service, changeType ChangeType) []Change {
+	changes := []Change{{ServiceName: service.Name, Type: changeType}}
+	for _, plan := range service.Plans {
+		changes = append(changes, Change{ServiceName: service.Name, PlanName: plan.Name, Type: changeType})
+	}
+	return changes
+}
+
+func planChanges(previousService Service, service Service) []Change {
+	previousPlans := make(map[string]Plan)
+	for _, plan := range previousService.Plans {
+		previousPlans[plan.Name] = plan
+	}
+
+	var changes []Change
+	for _, plan := range service.Plans {
+		previousPlan, exists := previousPlans[plan.Name]
+		delete(previousPlans, plan.Name)
+
+		if !exists {
+			changes = append(changes, Change{ServiceName: service.Name, PlanName: plan.Name, Type: Added})
+			continue
+		}
+
+		if details := planDetailChanges(previousService, previousPlan, service, plan); len(details) > 0 {
+			changes = append(changes, Change{ServiceName: service.Name, PlanName: plan.Name, Type: Changed, Details: details})
+		}
+	}
+
+	for name := range previousPlans {
+		changes = append(changes, Change{ServiceName: service.Name, PlanName: name, Type: Removed})
+	}
+
+	return changes
+}
+
+func serviceDetailChanges(previous Service, current Service) []string {
+	var details []string
+	if previous.Description != current.Description {
+		details = append(details, fmt.Sprintf("description: %q -> %q", previous.Description, current.Description))
+	}
+	details = appendBoolChange(details, "bindable", previous.Bindable, current.Bindable)
+	details = appendBoolChange(details, "plan_updateable", previous.PlanUpdateable, current.PlanUpdateable)
+	return details
+}
+
+func planDetailChanges(previousService Service, previous Plan, service Service, current Plan) []string {
+	var details []string
+	if previous.Description != current.Description {
+		details = append(details, fmt.Sprintf("description: %q -> %q", previous.Description, current.Description))
+	}
+	details = appendBoolChange(details, "free", previous.IsFree(), current.IsFree())
+	details = appendBoolChange(details, "bindable", previous.IsBindable(previousService), current.IsBindable(service))
+	details = appendBoolChange(details, "plan_updateable", previous.IsPlanUpdateable(previousService), current.IsPlanUpdateable(service))
+	if previous.MaintenanceInfoVersion() != current.MaintenanceInfoVersion() {
+		details = append(details, fmt.Sprintf("maintenance_info.version: %q -> %q", previous.MaintenanceInfoVersion(), current.MaintenanceInfoVersion()))
+	}
+	return details
+}
+
+func appendBoolChange(details []string, field string, previous bool, current bool) []string {
+	if previous == current {
+		return details
+	}
+	return append(details, fmt.Sprintf("%s: %s -> %s", field, strconv.FormatBool(previous), strconv.FormatBool(current)))
+}
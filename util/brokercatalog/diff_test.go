@@ -0,0 +1,97 @@
+package brokercatalog_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/brokercatalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diff", func() {
+	boolPtr := func(b bool) *bool { return &b }
+
+	It("returns the added, removed and changed services and plans", func() {
+		previous := Catalog{Services: []Service{
+			{
+				Name:     "db",
+				Bindable: true,
+				Plans: []Plan{
+					{Name: "small", Description: "a small db"},
+					{Name: "large", MaintenanceInfo: &MaintenanceInfo{Version: "1.0.0"}},
+					{Name: "legacy"},
+				},
+			},
+			{Name: "cache", Bindable: true, Plans: []Plan{{Name: "basic"}}},
+		}}
+		current := Catalog{Services: []Service{
+			{
+				Name:           "db",
+				Bindable:       true,
+				PlanUpdateable: true,
+				Plans: []Plan{
+					{Name: "small", Description: "a small db", Bindable: boolPtr(false)},
+					{Name: "large", Free: boolPtr(false), MaintenanceInfo: &MaintenanceInfo{Version: "2.0.0"}},
+					{Name: "medium"},
+				},
+			},
+			{Name: "queue", Plans: []Plan{{Name: "standard"}}},
+		}}
+
+		Expect(Diff(previous, current)).To(Equal([]Change{
+			{ServiceName: "cache", Type: Removed},
+			{ServiceName: "cache", PlanName: "basic", Type: Removed},
+			{ServiceName: "db", Type: Changed, Details: []string{"plan_updateable: false -> true"}},
+			{ServiceName: "db", PlanName: "large", Type: Changed, Details: []string{
+				"free: true -> false",
+				"plan_updateable: false -> true",
+				`maintenance_info.version: "1.0.0" -> "2.0.0"`,
+			}},
+			{ServiceName: "db", PlanName: "legacy", Type: Removed},
+			{ServiceName: "db", PlanName: "medium", Type: Added},
+			{ServiceName: "db", PlanName: "small", Type: Changed, Details: []string{
+				"bindable: true -> false",
+				"plan_updateable: false -> true",
+			}},
+			{ServiceName: "queue", Type: Added},
+			{ServiceName: "queue", PlanName: "standard", Type: Added},
+		}))
+	})
+
+	It("returns no changes for the same catalog", func() {
+		catalog := Catalog{Services: []Service{{Name: "db", Plans: []Plan{{Name: "small"}}}}}
+		Expect(Diff(catalog, catalog)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Snapshots", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "broker-catalog-snapshot")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("reads the catalog that was written", func() {
+		path := filepath.Join(dir, "broker-catalogs", "some-broker-guid.json")
+		_, found, err := ReadSnapshot(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		catalog := Catalog{Services: []Service{{Name: "db", Bindable: true, Plans: []Plan{{Name: "small"}}}}}
+		Expect(WriteSnapshot(path, catalog)).To(Succeed())
+
+		readCatalog, found, err := ReadSnapshot(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(readCatalog).To(Equal(catalog))
+	})
+})
@@ -0,0 +1,43 @@
+package brokercatalog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// ReadSnapshot reads a catalog saved with WriteSnapshot. It returns false when
+// no catalog has been saved to path.
+func ReadSnapshot(path string) (Catalog, bool, error) {
+	rawCatalog, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Catalog{}, false, nil
+	}
+	if err != nil {
+		return Catalog{}, false, err
+	}
+
+	var catalog Catalog
+	err = json.Unmarshal(rawCatalog, &catalog)
+	if err != nil {
+		return Catalog{}, false, err
+	}
+
+	return catalog, true, nil
+}
+
+// WriteSnapshot saves the catalog to path, creating its directory.
+func WriteSnapshot(path string, catalog Catalog) error {
+	rawCatalog, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, rawCatalog, 0600)
+}
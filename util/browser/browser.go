@@ -0,0 +1,29 @@
+package browser
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Opener opens URLs in the default browser of the user.
+type Opener struct{}
+
+// Open launches the default browser on the URL without waiting for it to
+// exit.
+func (Opener) Open(url string) error {
+	name, args := LaunchCommand(runtime.GOOS, url)
+	return exec.Command(name, args...).Start()
+}
+
+// LaunchCommand returns the command that opens the URL in the default browser
+// on the given operating system.
+func LaunchCommand(goos string, url string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{url}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}
+	default:
+		return "xdg-open", []string{url}
+	}
+}
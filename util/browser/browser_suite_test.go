@@ -0,0 +1,13 @@
+package browser_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBrowser(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Browser Suite")
+}
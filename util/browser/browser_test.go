@@ -0,0 +1,22 @@
+package browser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/browser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LaunchCommand", func() {
+	DescribeTable("returns the command opening the URL",
+		func(goos string, expectedName string, expectedArgs []string) {
+			name, args := LaunchCommand(goos, "https://example.com")
+			Expect(name).To(Equal(expectedName))
+			Expect(args).To(Equal(expectedArgs))
+		},
+
+		Entry("on macOS", "darwin", "open", []string{"https://example.com"}),
+		Entry("on Windows", "windows", "rundll32", []string{"url.dll,FileProtocolHandler", "https://example.com"}),
+		Entry("on Linux", "linux", "xdg-open", []string{"https://example.com"}),
+	)
+})
@@ -1,6 +1,7 @@
 package clissh
 
 import (
+	"code.cloudfoundry.org/cli/util"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/net/proxy"
 )
@@ -11,14 +12,20 @@ type SecureDialer interface {
 	Dial(network, address string, config *ssh.ClientConfig) (SecureClient, error)
 }
 
-type secureDialer struct{}
+type secureDialer struct {
+	dialer *util.Dialer
+}
 
-func DefaultSecureDialer() secureDialer {
-	return secureDialer{}
+// DefaultSecureDialer returns a SecureDialer that connects over the given
+// address family first when the SSH proxy has IPv4 and IPv6 addresses, and
+// that connects to the addresses of the matching resolve overrides instead
+// of resolving the SSH proxy's host.
+func DefaultSecureDialer(ipFamily util.IPFamily, resolveOverrides []util.ResolveOverride) secureDialer {
+	return secureDialer{dialer: util.NewDialer(0, ipFamily, resolveOverrides)}
 }
 
-func (secureDialer) Dial(network string, address string, config *ssh.ClientConfig) (SecureClient, error) {
-	conn, err := proxy.FromEnvironment().Dial(network, address)
+func (d secureDialer) Dial(network string, address string, config *ssh.ClientConfig) (SecureClient, error) {
+	conn, err := proxy.FromEnvironmentUsing(d.dialer).Dial(network, address)
 	if err != nil {
 		return secureClient{}, err
 	}
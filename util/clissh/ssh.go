@@ -1,6 +1,7 @@
 package clissh
 
 import (
+	"bufio"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -12,12 +13,14 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"github.com/moby/term"
 	log "github.com/sirupsen/logrus"
@@ -47,8 +50,8 @@ type SecureShell struct {
 	keepAliveInterval time.Duration
 }
 
-func NewDefaultSecureShell() *SecureShell {
-	defaultSecureDialer := DefaultSecureDialer()
+func NewDefaultSecureShell(ipFamily util.IPFamily, resolveOverrides []util.ResolveOverride) *SecureShell {
+	defaultSecureDialer := DefaultSecureDialer(ipFamily, resolveOverrides)
 	defaultTerminalHelper := DefaultTerminalHelper()
 	defaultListenerFactory := DefaultListenerFactory()
 	return &SecureShell{
@@ -205,6 +208,81 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// Exec runs the commands in a new session without allocating a terminal or
+// forwarding stdin, writing the command's output to stdout and stderr. When
+// the command exits with a non-zero status the returned error is an
+// *ssh.ExitError.
+func (c *SecureShell) Exec(commands []string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
+// Download copies the file at remotePath in the app instance to dest using
+// the SCP protocol.
+func (c *SecureShell) Download(remotePath string, dest io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start("scp -f " + remotePath)
+	if err != nil {
+		return err
+	}
+
+	err = receiveSCPFile(inPipe, bufio.NewReader(outPipe), dest)
+	inPipe.Close()
+	if err != nil {
+		return err
+	}
+
+	return session.Wait()
+}
+
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
 		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
@@ -335,6 +413,69 @@ func base64Sha256Fingerprint(key ssh.PublicKey) string {
 	return base64.RawStdEncoding.EncodeToString(sum[:])
 }
 
+// receiveSCPFile acts as the sink side of the SCP protocol for a single
+// file: it acknowledges the source, reads the file header and copies the file
+// contents to dest.
+func receiveSCPFile(in io.Writer, out *bufio.Reader, dest io.Writer) error {
+	_, err := in.Write([]byte{0})
+	if err != nil {
+		return err
+	}
+
+	for {
+		header, err := out.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("scp: unexpected end of response: %s", err)
+		}
+
+		switch header[0] {
+		case 'T':
+			// Modification times are not preserved.
+			_, err = in.Write([]byte{0})
+			if err != nil {
+				return err
+			}
+			continue
+		case 'C':
+		case 1, 2:
+			return fmt.Errorf("scp: %s", strings.TrimSpace(header[1:]))
+		default:
+			return fmt.Errorf("scp: unexpected response %q", strings.TrimSpace(header))
+		}
+
+		fields := strings.SplitN(strings.TrimSpace(header[1:]), " ", 3)
+		if len(fields) != 3 {
+			return fmt.Errorf("scp: invalid file header %q", strings.TrimSpace(header))
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("scp: invalid file size %q", fields[1])
+		}
+
+		_, err = in.Write([]byte{0})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.CopyN(dest, out, size)
+		if err != nil {
+			return err
+		}
+
+		status, err := out.ReadByte()
+		if err != nil {
+			return err
+		}
+		if status != 0 {
+			message, _ := out.ReadString('\n')
+			return fmt.Errorf("scp: %s", strings.TrimSpace(message))
+		}
+
+		_, err = in.Write([]byte{0})
+		return err
+	}
+}
+
 func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	_, err := io.Copy(dest, src)
 	if err != nil {
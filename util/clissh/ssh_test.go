@@ -8,6 +8,7 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -189,6 +190,12 @@ var _ = Describe("CLI SSH", func() {
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
+			// Without a stub the fake returns (0, nil) forever, leaving the
+			// stdin copy spinning and recording calls until memory runs out.
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
+
 			fakeTerminalHelper.StdStreamsReturns(stdin, stdout, stderr)
 			interactiveSessionInvoker = func(secureShell *SecureShell) {
 				sessionErr = secureShell.InteractiveSession(commands, terminalRequest)
@@ -1149,6 +1156,163 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("Exec", func() {
+		var (
+			stdout  *fake_io.FakeWriter
+			stderr  *fake_io.FakeWriter
+			execErr error
+		)
+
+		BeforeEach(func() {
+			commands = []string{"ls", "-la"}
+
+			stdout = new(fake_io.FakeWriter)
+			stderr = new(fake_io.FakeWriter)
+
+			stdoutPipe.ReadStub = func(p []byte) (int, error) {
+				p[0] = 1
+				return 1, io.EOF
+			}
+			stdout.WriteStub = func(p []byte) (int, error) {
+				defer GinkgoRecover()
+				Expect(p[0]).To(Equal(byte(1)))
+				return 1, nil
+			}
+
+			stderrPipe.ReadStub = func(p []byte) (int, error) {
+				p[0] = 2
+				return 1, io.EOF
+			}
+			stderr.WriteStub = func(p []byte) (int, error) {
+				defer GinkgoRecover()
+				Expect(p[0]).To(Equal(byte(2)))
+				return 1, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			execErr = secureShell.Exec(commands, stdout, stderr)
+		})
+
+		It("runs the command without a terminal or stdin", func() {
+			Expect(execErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("ls -la"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeTerminalHelper.StdStreamsCallCount()).To(Equal(0))
+		})
+
+		It("copies the session output to the given writers", func() {
+			Expect(stdout.WriteCallCount()).To(Equal(1))
+			Expect(stderr.WriteCallCount()).To(Equal(1))
+		})
+
+		It("closes the session", func() {
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the command fails to start", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("oh bother"))
+			})
+
+			It("returns the error", func() {
+				Expect(execErr).To(MatchError("oh bother"))
+			})
+		})
+
+		When("the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns an error", func() {
+				Expect(execErr).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+
+		When("waiting for the command returns an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("error result"))
+			})
+
+			It("returns the result from wait", func() {
+				Expect(execErr).To(MatchError("error result"))
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		var (
+			dest        *bytes.Buffer
+			downloadErr error
+		)
+
+		BeforeEach(func() {
+			dest = new(bytes.Buffer)
+			fakeSecureSession.StdoutPipeReturns(bytes.NewBufferString("T1234 0 1234 0\nC0644 5 heap.hprof\nhello\x00"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			downloadErr = secureShell.Download("/home/vcap/tmp/heap.hprof", dest)
+		})
+
+		It("requests the file with scp and copies its contents", func() {
+			Expect(downloadErr).NotTo(HaveOccurred())
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -f /home/vcap/tmp/heap.hprof"))
+			Expect(dest.String()).To(Equal("hello"))
+		})
+
+		It("acknowledges each step of the transfer and closes the session", func() {
+			Expect(stdinPipe.WriteCallCount()).To(Equal(4))
+			for i := 0; i < stdinPipe.WriteCallCount(); i++ {
+				Expect(stdinPipe.WriteArgsForCall(i)).To(Equal([]byte{0}))
+			}
+			Expect(stdinPipe.CloseCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the remote scp reports an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StdoutPipeReturns(bytes.NewBufferString("\x01scp: /home/vcap/tmp/heap.hprof: No such file or directory\n"), nil)
+			})
+
+			It("returns the error", func() {
+				Expect(downloadErr).To(MatchError("scp: scp: /home/vcap/tmp/heap.hprof: No such file or directory"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the response ends before the file is received", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StdoutPipeReturns(bytes.NewBufferString("C0644 5 heap.hprof\nhel"), nil)
+			})
+
+			It("returns an error", func() {
+				Expect(downloadErr).To(HaveOccurred())
+			})
+		})
+
+		When("the command fails to start", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("oh bother"))
+			})
+
+			It("returns the error", func() {
+				Expect(downloadErr).To(MatchError("oh bother"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/cmd"
@@ -13,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/suggest"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/jessevdk/go-flags"
 	log "github.com/sirupsen/logrus"
@@ -22,6 +24,8 @@ import (
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
+var unknownFlagPattern = regexp.MustCompile("^unknown flag `(.+)'$")
+
 type UI interface {
 	DisplayError(err error)
 	DisplayWarning(template string, templateValues ...map[string]interface{})
@@ -41,6 +45,9 @@ type TriggerLegacyMain interface {
 type CommandParser struct {
 	Config *configv3.Config
 	UI     *ui.UI
+
+	// args are the command line args, recorded by --record.
+	args []string
 }
 
 func NewCommandParser(config *configv3.Config) (CommandParser, error) {
@@ -49,13 +56,73 @@ func NewCommandParser(config *configv3.Config) (CommandParser, error) {
 
 func (p *CommandParser) ParseCommandFromArgs(ui *ui.UI, args []string) (int, error) {
 	p.UI = ui
-	return p.parse(args, &common.Commands)
+	p.args = args
+
+	args, notes := translateV6Flags(args)
+	p.displayCompatNotes(notes)
+
+	return p.parse(examplesToHelp(args), &common.Commands)
+}
+
+func (p *CommandParser) displayCompatNotes(notes []compatNote) {
+	for _, note := range notes {
+		switch {
+		case note.Translated && len(note.Translation) == 0:
+			p.UI.DisplayWarning("Ignoring the v6 flag '{{.Flag}}'. {{.Equivalent}}", map[string]interface{}{
+				"Flag":       note.Flag,
+				"Equivalent": note.Equivalent,
+			})
+		case note.Translated:
+			p.UI.DisplayWarning("Translating the v6 flag '{{.Flag}}' to '{{.Translation}}'.", map[string]interface{}{
+				"Flag":        note.Flag,
+				"Translation": strings.Join(note.Translation, " "),
+			})
+		case note.Translatable:
+			p.UI.DisplayWarning("The '{{.Flag}}' flag was removed in v7 of the CLI. {{.Equivalent}} Use {{.CompatFlag}} to translate it automatically.", map[string]interface{}{
+				"Flag":       note.Name,
+				"Equivalent": note.Equivalent,
+				"CompatFlag": compatFlag,
+			})
+		default:
+			p.UI.DisplayWarning("The '{{.Flag}}' flag was removed in v7 of the CLI. {{.Equivalent}}", map[string]interface{}{
+				"Flag":       note.Name,
+				"Equivalent": note.Equivalent,
+			})
+		}
+	}
 }
 
-func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) error {
+func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) (executeErr error) {
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Verbose:    common.Commands.VerboseOrVersion,
+		PreferIPv4: common.Commands.PreferIPv4,
+		PreferIPv6: common.Commands.PreferIPv6,
+		Stats:      common.Commands.Stats,
+	}
+
+	// The recording starts first and stops last so that the transcript has
+	// everything that is displayed, including the stats and deferred output.
+	recording := common.Commands.Record != ""
+	if recording {
+		recordErr := p.UI.StartRecording(string(common.Commands.Record), redactArgs(p.args, cmd))
+		if recordErr != nil {
+			return p.handleError(recordErr)
+		}
+		defer func() {
+			recordErr := p.UI.StopRecording(executeErr != nil)
+			if recordErr != nil {
+				fmt.Fprintf(os.Stderr, "Error writing recording: %s", recordErr.Error())
+			}
+		}()
+	}
+
+	// The collector is created first so that the duration covers the whole
+	// command, and the summary is displayed after everything else.
+	if stats := cfConfig.RequestStats(); stats != nil {
+		defer func() {
+			p.UI.DisplayRequestStats(stats.Summary())
+		}()
 	}
 	defer p.UI.FlushDeferred()
 
@@ -64,6 +131,15 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	if common.Commands.PreferIPv4 && common.Commands.PreferIPv6 {
+		// The flags are reset so that the usage that is displayed next does
+		// not fail the same way.
+		common.Commands.PreferIPv4, common.Commands.PreferIPv6 = false, false
+		return p.handleError(translatableerror.ArgumentCombinationError{
+			Args: []string{"--prefer-ipv4", "--prefer-ipv6"},
+		})
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
@@ -77,16 +153,54 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 	}()
 
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
-		log.SetOutput(os.Stderr)
-		log.SetLevel(log.Level(cfConfig.LogLevel()))
+		closeLog, logErr := configureLogging(cfConfig)
+		if logErr != nil {
+			p.UI.DisplayWarning("Could not open log file {{.Path}}: {{.Error}}", map[string]interface{}{
+				"Path":  cfConfig.LogFile(),
+				"Error": logErr.Error(),
+			})
+		}
+		defer closeLog()
+
+		if featureCmd, ok := cmd.(command.ExperimentalFeatureCommand); ok {
+			err = command.RequireExperimentalFeature(cfConfig, featureCmd.ExperimentalFeature())
+			if err != nil {
+				return p.handleError(err)
+			}
+		}
+
+		// The output is structured before the command is set up so that
+		// errors are displayed on stderr too.
+		structuredOutput := common.Commands.Output == flag.OutputFormatJSON
+		if structuredOutput {
+			p.UI.StartStructuredOutput()
+			defer p.UI.StopStructuredOutput(false)
+		}
 
 		err = extendedCmd.Setup(cfConfig, p.UI)
 		if err != nil {
 			return p.handleError(err)
 		}
 
+		// The pager writes to the terminal itself, around the recording.
+		if pagedCmd, ok := cmd.(command.PagedCommand); ok && pagedCmd.PagedOutput() && cfConfig.PagerEnabled() && !structuredOutput && !recording {
+			pagerErr := p.UI.StartPager(cfConfig.PagerCommand())
+			if pagerErr != nil {
+				log.WithError(pagerErr).Warn("could not start the pager")
+			}
+		}
+
 		err = extendedCmd.Execute(args)
-		return p.handleError(err)
+		if err == nil {
+			err = p.UI.StopStructuredOutput(true)
+		}
+		err = p.handleError(err)
+		// The structured output, which the own --output flag of a paged
+		// command may have asked for, is stopped before the pager it writes
+		// to.
+		_ = p.UI.StopStructuredOutput(false)
+		p.UI.StopPager()
+		return err
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
@@ -100,9 +214,6 @@ func (p *CommandParser) handleError(passedErr error) error {
 	translatedErr := translatableerror.ConvertToTranslatableError(passedErr)
 
 	switch typedErr := translatedErr.(type) {
-	case translatableerror.V3V2SwitchError:
-		log.Info("Received a V3V2SwitchError - switch to the V2 version of the command")
-		return passedErr
 	case TriggerLegacyMain:
 		if typedErr.Error() != "" {
 			p.UI.DisplayWarning("")
@@ -121,6 +232,8 @@ func (p *CommandParser) handleError(passedErr error) error {
 	case translatableerror.CurlExit22Error:
 		p.UI.DisplayError(translatedErr)
 		return passedErr
+	case translatableerror.ServiceUpgradeAvailableError:
+		return passedErr
 	}
 
 	p.UI.DisplayError(translatedErr)
@@ -160,6 +273,12 @@ func (p *CommandParser) handleFlagErrorAndCommandHelp(flagErr *flags.Error, flag
 			fmt.Fprintf(os.Stderr, "Incorrect Usage: %s\n\n", flagErr.Error())
 		}
 
+		if commandExists && flagErr.Type == flags.ErrUnknownFlag {
+			if suggestions := suggestFlags(flagErr, flagsParser.Active); len(suggestions) > 0 {
+				fmt.Fprintf(os.Stderr, "Did you mean?\n    %s\n\n", strings.Join(suggestions, "\n    "))
+			}
+		}
+
 		if commandExists {
 			helpExitCode, err = p.parse([]string{"help", flagsParser.Active.Name}, commandList)
 		} else {
@@ -214,13 +333,11 @@ func (p *CommandParser) handleFlagErrorAndCommandHelp(flagErr *flags.Error, flag
 }
 
 func (p *CommandParser) parse(args []string, commandList interface{}) (int, error) {
-	flagsParser := flags.NewParser(commandList, flags.HelpFlag)
+	flagsParser := flags.NewParser(commandList, flags.HelpFlag|flags.PassDoubleDash)
 	flagsParser.CommandHandler = p.executionWrapper
 	extraArgs, err := flagsParser.ParseArgs(args)
 	if err == nil {
 		return 0, nil
-	} else if _, ok := err.(translatableerror.V3V2SwitchError); ok {
-		return 1, err
 	} else if flagErr, ok := err.(*flags.Error); ok {
 		return p.handleFlagErrorAndCommandHelp(flagErr, flagsParser, extraArgs, args, commandList)
 	} else if err == ErrFailed {
@@ -233,6 +350,8 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 		return exitError.ExitStatus(), nil
 	} else if curlError, ok := err.(translatableerror.CurlExit22Error); ok {
 		return 22, curlError
+	} else if _, ok := err.(translatableerror.ServiceUpgradeAvailableError); ok {
+		return translatableerror.ServiceUpgradeAvailableExitCode, nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
@@ -248,6 +367,47 @@ func containsHelpFlag(args []string) bool {
 	return false
 }
 
+// suggestFlags returns the long flags of the command that are closest to the
+// unknown flag of the error.
+func suggestFlags(flagErr *flags.Error, command *flags.Command) []string {
+	matches := unknownFlagPattern.FindStringSubmatch(flagErr.Message)
+	if len(matches) != 2 || len(matches[1]) < 2 {
+		return nil
+	}
+
+	var longNames []string
+	for _, option := range command.Options() {
+		if option.LongName != "" && !option.Hidden {
+			longNames = append(longNames, option.LongName)
+		}
+	}
+
+	suggestions := suggest.Nearest(matches[1], longNames)
+	for i, suggestion := range suggestions {
+		suggestions[i] = "--" + suggestion
+	}
+	return suggestions
+}
+
+// examplesToHelp rewrites 'COMMAND ... --examples' into 'help COMMAND
+// --examples' so that the examples are displayed instead of running the
+// command.
+func examplesToHelp(args []string) []string {
+	if len(args) < 2 || args[0] == "help" || !isCommand(args[0]) {
+		return args
+	}
+
+	for _, arg := range args[1:] {
+		if arg == "--" {
+			break
+		}
+		if arg == "--examples" {
+			return []string{"help", args[0], "--examples"}
+		}
+	}
+	return args
+}
+
 func isCommand(s string) bool {
 	_, found := reflect.TypeOf(common.Commands).FieldByNameFunc(
 		func(fieldName string) bool {
@@ -1,12 +1,16 @@
 package command_parser_test
 
 import (
+	"os"
+	"path/filepath"
+
 	"code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/util/command_parser"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 	"io/ioutil"
 )
 
@@ -41,6 +45,37 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 	})
 
+	Describe("UnknownCommandError", func() {
+		It("suggests and corrects to the nearest command", func() {
+			unknownCommandErr := command_parser.UnknownCommandError{CommandName: "pus"}
+			unknownCommandErr.Suggest(nil)
+
+			Expect(unknownCommandErr.Error()).To(ContainSubstring("Did you mean?\n    push"))
+			correction, ok := unknownCommandErr.Correction()
+			Expect(ok).To(BeTrue())
+			Expect(correction).To(Equal("push"))
+			Expect(unknownCommandErr.CorrectedArgs([]string{"-v", "pus", "my-app"})).To(Equal([]string{"-v", "push", "my-app"}))
+		})
+
+		It("does not correct to a plugin command", func() {
+			unknownCommandErr := command_parser.UnknownCommandError{CommandName: "my-plugn"}
+			unknownCommandErr.Suggest([]string{"my-plugin"})
+
+			Expect(unknownCommandErr.Error()).To(ContainSubstring("Did you mean?\n    my-plugin"))
+			_, ok := unknownCommandErr.Correction()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not suggest anything when no command is close", func() {
+			unknownCommandErr := command_parser.UnknownCommandError{CommandName: "howdy-doody"}
+			unknownCommandErr.Suggest(nil)
+
+			Expect(unknownCommandErr.Error()).ToNot(ContainSubstring("Did you mean?"))
+			_, ok := unknownCommandErr.Correction()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("the verbose flag", func() {
 		var parser command_parser.CommandParser
 
@@ -76,5 +111,151 @@ var _ = Describe("Command 'Parser'", func() {
 			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Verbose: false}))
 		})
 
+		It("does not parse flags after a double dash", func() {
+			_, err := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--", "-v"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Verbose: false}))
+
+			// The command-table is a singleton, so the "-v" argument would
+			// otherwise be left behind for the next help command
+			common.Commands.Help.OptionalArgs.CommandName = ""
+		})
+
+	})
+
+	Describe("the examples flag", func() {
+		AfterEach(func() {
+			common.Commands.Help.OptionalArgs.CommandName = ""
+			common.Commands.Help.Examples = false
+		})
+
+		It("displays the examples of the command instead of running it", func() {
+			parser, err := command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"push", "my-app", "--examples"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+			Expect(common.Commands.Help.OptionalArgs.CommandName).To(Equal("push"))
+			Expect(common.Commands.Help.Examples).To(BeTrue())
+		})
+	})
+
+	Describe("v6 flags", func() {
+		var (
+			parser command_parser.CommandParser
+			errBuf *Buffer
+		)
+
+		BeforeEach(func() {
+			var err error
+			errBuf = NewBuffer()
+			pluginUI, err = ui.NewPluginUI(v3Config, ioutil.Discard, errBuf)
+			Expect(err).ToNot(HaveOccurred())
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.Compat = false
+			common.Commands.Marketplace.ServiceOfferingName = ""
+		})
+
+		It("explains the v7 equivalent of a removed flag", func() {
+			exitCode, _ := parser.ParseCommandFromArgs(pluginUI, []string{"marketplace", "-s", "my-service"})
+			Expect(exitCode).To(Equal(1))
+			Expect(errBuf).To(Say("The '-s' flag was removed in v7 of the CLI. Use -e SERVICE_OFFERING instead. Use --compat to translate it automatically."))
+			Expect(common.Commands.Marketplace.ServiceOfferingName).To(BeEmpty())
+		})
+
+		It("translates a removed flag with --compat", func() {
+			_, _ = parser.ParseCommandFromArgs(pluginUI, []string{"marketplace", "--compat", "-s=my-service"})
+			Expect(errBuf).To(Say("Translating the v6 flag '-s=my-service' to '-e my-service'."))
+			Expect(common.Commands.Marketplace.ServiceOfferingName).To(Equal("my-service"))
+		})
+
+		It("does not look at arguments after a double dash", func() {
+			_, _ = parser.ParseCommandFromArgs(pluginUI, []string{"marketplace", "--compat", "--", "-s", "my-service"})
+			Expect(errBuf).ToNot(Say("v6 flag"))
+			Expect(common.Commands.Marketplace.ServiceOfferingName).To(BeEmpty())
+		})
+	})
+
+	Describe("the IP family flags", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			common.Commands.VerboseOrVersion = false
+			common.Commands.PreferIPv4 = false
+			common.Commands.PreferIPv6 = false
+			var err error
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.PreferIPv4 = false
+			common.Commands.PreferIPv6 = false
+		})
+
+		It("sets the preferred IP family", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--prefer-ipv6", "help"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{PreferIPv6: true}))
+		})
+
+		It("fails when both families are preferred", func() {
+			exitCode, _ := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--prefer-ipv4", "--prefer-ipv6"})
+			Expect(exitCode).To(Equal(1))
+		})
+	})
+
+	Describe("the record flag", func() {
+		var (
+			parser command_parser.CommandParser
+			dir    string
+			path   string
+		)
+
+		BeforeEach(func() {
+			var err error
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			dir, err = ioutil.TempDir("", "cf-record")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(dir, "transcript.jsonl")
+		})
+
+		AfterEach(func() {
+			common.Commands.Record = ""
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes the command line and output of the command to the file", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--record", path, "help", "apps"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+
+			transcript, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(transcript)).To(ContainSubstring(`"type":"start","args":["--record","` + path + `","help","apps"]`))
+			Expect(string(transcript)).To(ContainSubstring(`"text":"   apps - List all apps in the target space"`))
+			Expect(string(transcript)).To(ContainSubstring(`"type":"end","status":"ok"`))
+		})
+
+		It("redacts the secret arguments and records that the command failed", func() {
+			exitCode, _ := parser.ParseCommandFromArgs(pluginUI, []string{"auth", "some-user", "some-password", "--record", path})
+			Expect(exitCode).To(Equal(1))
+
+			transcript, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(transcript)).To(ContainSubstring(`"args":["auth","some-user","[PRIVATE DATA HIDDEN]","--record",`))
+			Expect(string(transcript)).ToNot(ContainSubstring("some-password"))
+			Expect(string(transcript)).To(ContainSubstring(`"type":"end","status":"failed"`))
+		})
 	})
 })
@@ -0,0 +1,188 @@
+package command_parser
+
+import (
+	"reflect"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/common"
+)
+
+// compatFlag enables translating v6 flags into their v7 equivalents.
+const compatFlag = "--compat"
+
+// removedFlag is a flag of v6 of the CLI that v7 no longer accepts.
+type removedFlag struct {
+	command string
+	names   []string
+
+	// hasValue is true when the flag takes a value.
+	hasValue bool
+	// value limits the flag to a single removed value when it is set.
+	value string
+
+	// equivalent explains how to do the same with v7.
+	equivalent string
+	// translate returns the v7 arguments that replace the flag and its value.
+	// It is nil when the flag cannot be translated automatically.
+	translate func(value string) []string
+}
+
+var removedFlags = []removedFlag{
+	{
+		command:    "create-route",
+		names:      []string{"--random-port"},
+		equivalent: "TCP routes get a random port unless --port is given.",
+		translate:  func(string) []string { return nil },
+	},
+	{
+		command:    "marketplace",
+		names:      []string{"-s"},
+		hasValue:   true,
+		equivalent: "Use -e SERVICE_OFFERING instead.",
+		translate:  func(value string) []string { return []string{"-e", value} },
+	},
+	{
+		command:    "push",
+		names:      []string{"-d", "--domain"},
+		hasValue:   true,
+		equivalent: "Set the routes of the app with 'routes' in the manifest, or map them with 'map-route' after pushing.",
+	},
+	{
+		command:    "push",
+		names:      []string{"-n", "--hostname"},
+		hasValue:   true,
+		equivalent: "Set the routes of the app with 'routes' in the manifest, or map them with 'map-route' after pushing.",
+	},
+	{
+		command:    "push",
+		names:      []string{"--no-hostname"},
+		equivalent: "Set the routes of the app with 'routes' in the manifest, or map them with 'map-route' after pushing.",
+	},
+	{
+		command:    "push",
+		names:      []string{"--route-path"},
+		hasValue:   true,
+		equivalent: "Set the routes of the app with 'routes' in the manifest, or map them with 'map-route --path' after pushing.",
+	},
+	{
+		command:    "push",
+		names:      []string{"-u", "--health-check-type"},
+		hasValue:   true,
+		value:      "none",
+		equivalent: "Use --health-check-type process instead.",
+		translate:  func(string) []string { return []string{"--health-check-type", "process"} },
+	},
+}
+
+// compatNote describes a v6 flag found in the arguments.
+type compatNote struct {
+	// Name is the name of the flag.
+	Name string
+	// Flag is the flag and its value as they were given.
+	Flag string
+	// Equivalent explains how to do the same with v7.
+	Equivalent string
+	// Translation is the v7 arguments the flag was translated to, when it was
+	// translated.
+	Translation []string
+	Translated  bool
+	// Translatable is true when --compat would translate the flag.
+	Translatable bool
+}
+
+// translateV6Flags finds the flags in args that were removed in v7 and, when
+// args contain --compat, replaces the ones that can be translated with their
+// v7 equivalents.
+func translateV6Flags(args []string) ([]string, []compatNote) {
+	if len(args) < 2 {
+		return args, nil
+	}
+	commandName := canonicalCommandName(args[0])
+
+	compat := false
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == compatFlag {
+			compat = true
+		}
+	}
+
+	var notes []compatNote
+	translatedArgs := []string{args[0]}
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			translatedArgs = append(translatedArgs, args[i:]...)
+			break
+		}
+
+		name, value, hasInlineValue := strings.Cut(arg, "=")
+		removed, found := findRemovedFlag(commandName, name)
+		if !found {
+			translatedArgs = append(translatedArgs, arg)
+			continue
+		}
+
+		consumed := []string{arg}
+		if removed.hasValue && !hasInlineValue {
+			if i+1 >= len(args) {
+				translatedArgs = append(translatedArgs, arg)
+				continue
+			}
+			value = args[i+1]
+			consumed = append(consumed, value)
+		}
+
+		if removed.value != "" && !strings.EqualFold(value, removed.value) {
+			translatedArgs = append(translatedArgs, arg)
+			continue
+		}
+		i += len(consumed) - 1
+
+		note := compatNote{
+			Name:         name,
+			Flag:         strings.Join(consumed, " "),
+			Equivalent:   removed.equivalent,
+			Translatable: removed.translate != nil,
+		}
+		if compat && note.Translatable {
+			note.Translation = removed.translate(value)
+			note.Translated = true
+			translatedArgs = append(translatedArgs, note.Translation...)
+		} else {
+			translatedArgs = append(translatedArgs, consumed...)
+		}
+		notes = append(notes, note)
+	}
+
+	return translatedArgs, notes
+}
+
+func findRemovedFlag(commandName string, name string) (removedFlag, bool) {
+	for _, removed := range removedFlags {
+		if removed.command != commandName {
+			continue
+		}
+		for _, removedName := range removed.names {
+			if name == removedName {
+				return removed, true
+			}
+		}
+	}
+	return removedFlag{}, false
+}
+
+// canonicalCommandName returns the name of the command with the given name or
+// alias.
+func canonicalCommandName(s string) string {
+	commandListStruct := reflect.TypeOf(common.Commands)
+	for i := 0; i < commandListStruct.NumField(); i++ {
+		tag := commandListStruct.Field(i).Tag
+		if s == tag.Get("command") || s == tag.Get("alias") {
+			return tag.Get("command")
+		}
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package command_parser
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	log "github.com/sirupsen/logrus"
+)
+
+// configureLogging sets the level, format and destination of the internal
+// log messages from the config. The returned function closes the log file,
+// if one was opened. When the log file cannot be opened, messages are written
+// to stderr and the error is returned.
+func configureLogging(config *configv3.Config) (func(), error) {
+	log.SetLevel(log.Level(config.LogLevel()))
+
+	switch config.LogFormat() {
+	case configv3.LogFormatJSON:
+		log.SetFormatter(&log.JSONFormatter{})
+	default:
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true, DisableColors: config.LogFile() != ""})
+	}
+
+	log.SetOutput(os.Stderr)
+	if config.LogFile() == "" {
+		return func() {}, nil
+	}
+
+	file, err := os.OpenFile(config.LogFile(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return func() {}, err
+	}
+	log.SetOutput(file)
+
+	return func() {
+		log.SetOutput(os.Stderr)
+		file.Close()
+	}, nil
+}
@@ -0,0 +1,78 @@
+package command_parser
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// secretFieldName matches the names of the flags and arguments of a command
+// whose values are kept out of a --record transcript.
+var secretFieldName = regexp.MustCompile(`(?i)password|secret|passcode`)
+
+// redactArgs returns the command line args for the transcript of --record,
+// with the values of the secret flags and arguments of the parsed cmd, and any
+// other secret that ui.RedactRecordedText recognizes, replaced.
+func redactArgs(args []string, cmd interface{}) []string {
+	secrets := secretValues(reflect.ValueOf(cmd))
+
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		for _, secret := range secrets {
+			arg = strings.ReplaceAll(arg, secret, ui.RedactedValue)
+		}
+		redacted[i] = ui.RedactRecordedText(arg)
+	}
+	return redacted
+}
+
+// secretValues returns the non-empty string values of the fields of the
+// command struct, and of the structs of its arguments, whose names match
+// secretFieldName.
+func secretValues(value reflect.Value) []string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	// The third argument of create-service-broker is the password only when
+	// the URL is given after it.
+	if args, ok := value.Interface().(flag.ServiceBrokerArgs); ok {
+		if args.URL != "" && args.PasswordOrURL != "" {
+			return []string{args.PasswordOrURL}
+		}
+		return nil
+	}
+
+	var secrets []string
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if fieldValue.Kind() == reflect.Struct {
+			secrets = append(secrets, secretValues(fieldValue)...)
+			continue
+		}
+		if !secretFieldName.MatchString(field.Name) {
+			continue
+		}
+		if fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.Kind() == reflect.String && fieldValue.String() != "" {
+			secrets = append(secrets, fieldValue.String())
+		}
+	}
+	return secrets
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"code.cloudfoundry.org/cli/cf/util/spellcheck"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/util/suggest"
 )
 
 type UnknownCommandError struct {
@@ -26,8 +26,35 @@ func (e *UnknownCommandError) Suggest(pluginCommandNames []string) {
 			return false
 		})
 
-	cmdSuggester := spellcheck.NewCommandSuggester(append(commandNames, pluginCommandNames...))
-	e.suggestions = cmdSuggester.Recommend(e.CommandName)
+	e.suggestions = suggest.Nearest(e.CommandName, append(commandNames, pluginCommandNames...))
+}
+
+// Correction returns the command that was most likely meant when exactly one
+// command was suggested and it is not a plugin command.
+func (e UnknownCommandError) Correction() (string, bool) {
+	if len(e.suggestions) != 1 || !isCommand(e.suggestions[0]) {
+		return "", false
+	}
+	return e.suggestions[0], true
+}
+
+// CorrectedArgs returns args with the unknown command replaced by the
+// correction.
+func (e UnknownCommandError) CorrectedArgs(args []string) []string {
+	correction, ok := e.Correction()
+	if !ok {
+		return args
+	}
+
+	correctedArgs := make([]string, len(args))
+	copy(correctedArgs, args)
+	for i, arg := range correctedArgs {
+		if arg == e.CommandName {
+			correctedArgs[i] = correction
+			break
+		}
+	}
+	return correctedArgs
 }
 
 func (e UnknownCommandError) Error() string {
@@ -0,0 +1,10 @@
+package configv3
+
+import "path/filepath"
+
+// BrokerCatalogSnapshotPath returns the file that update-service-broker saves
+// the catalog of the broker to, in the broker-catalogs directory of the config
+// directory.
+func (config *Config) BrokerCatalogSnapshotPath(brokerGUID string) string {
+	return filepath.Join(configDirectory(), "broker-catalogs", brokerGUID+".json")
+}
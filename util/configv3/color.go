@@ -1,6 +1,9 @@
 package configv3
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 const (
 	// DefaultColorEnabled is the default CFConfig value for ColorEnabled.
@@ -47,3 +50,48 @@ func (config *Config) boolToColorSetting(val bool) ColorSetting {
 
 	return ColorDisabled
 }
+
+// ColorDepth is the number of colors that the terminal can display.
+type ColorDepth int
+
+const (
+	// ColorDepth16 is the 8 basic colors and their bright variants.
+	ColorDepth16 ColorDepth = 16
+
+	// ColorDepth256 is the xterm 256 color palette.
+	ColorDepth256 ColorDepth = 256
+
+	// ColorDepthTrueColor is 24-bit RGB color.
+	ColorDepthTrueColor ColorDepth = 1 << 24
+)
+
+// ColorDepth returns the number of colors the terminal supports based off:
+//   1. The $COLORTERM environment variable set to truecolor/24bit
+//   2. The $TERM environment variable containing 256color
+//   3. Defaults to ColorDepth16
+func (config *Config) ColorDepth() ColorDepth {
+	switch strings.ToLower(config.ENV.ColorTerm) {
+	case "truecolor", "24bit":
+		return ColorDepthTrueColor
+	}
+
+	if strings.Contains(config.ENV.Term, "256color") {
+		return ColorDepth256
+	}
+
+	return ColorDepth16
+}
+
+// ColorTheme returns the colors to use for the different kinds of output,
+// either as a comma-separated list of ROLE=COLOR pairs or as the path to a
+// theme file. This is based off:
+//   1. The $CF_COLOR_THEME environment variable if set
+//   2. The 'ColorTheme' value in the .cf/config.json if set
+//   3. Defaults to the empty string, which is the default theme
+func (config *Config) ColorTheme() string {
+	if config.ENV.CFColorTheme != "" {
+		return config.ENV.CFColorTheme
+	}
+
+	return config.ConfigFile.ColorTheme
+}
@@ -51,4 +51,41 @@ var _ = Describe("Config", func() {
 
 		Entry("config=unset env=unset falls back to default", "", "", ColorAuto),
 	)
+
+	DescribeTable("ColorDepth",
+		func(colorTerm string, term string, expected ColorDepth) {
+			defer os.Unsetenv("COLORTERM")
+			defer os.Unsetenv("TERM")
+			os.Setenv("COLORTERM", colorTerm)
+			os.Setenv("TERM", term)
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.ColorDepth()).To(Equal(expected))
+		},
+		Entry("COLORTERM=truecolor", "truecolor", "xterm", ColorDepthTrueColor),
+		Entry("COLORTERM=24bit", "24bit", "xterm-256color", ColorDepthTrueColor),
+		Entry("TERM=xterm-256color", "", "xterm-256color", ColorDepth256),
+		Entry("TERM=xterm", "", "xterm", ColorDepth16),
+		Entry("nothing set", "", "", ColorDepth16),
+	)
+
+	DescribeTable("ColorTheme",
+		func(configVal string, envVal string, expected string) {
+			rawConfig := fmt.Sprintf(`{"ColorTheme":"%s", "ConfigVersion": %d }`, configVal, CurrentConfigVersion)
+			setConfig(homeDir, rawConfig)
+
+			defer os.Unsetenv("CF_COLOR_THEME")
+			os.Setenv("CF_COLOR_THEME", envVal)
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.ColorTheme()).To(Equal(expected))
+		},
+		Entry("env takes precedence", "ok=blue", "ok=green", "ok=green"),
+		Entry("config is used when env is unset", "ok=blue", "", "ok=blue"),
+		Entry("defaults to the default theme", "", "", ""),
+	)
 })
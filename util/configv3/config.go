@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strconv"
 
+	"code.cloudfoundry.org/cli/util/requeststats"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -32,6 +33,20 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// requestStats collects the API request statistics of the command.
+	requestStats *requeststats.Collector
+
+	// topLevelProfile is the top-level target of the config file while the
+	// profile selected with $CF_PROFILE is used instead.
+	topLevelProfile *Profile
+
+	// tokenStore keeps the tokens when they are stored in the keychain.
+	tokenStore TokenStore
+
+	// retrievedTokens caches the tokens retrieved from the token store by the
+	// value that .cf/config.json keeps in place of them.
+	retrievedTokens map[string]string
+
 	UserConfig
 }
 
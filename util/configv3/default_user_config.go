@@ -7,12 +7,23 @@ import (
 type DefaultUserConfig struct {
 	// ConfigFile stores the configuration from the .cf/config
 	ConfigFile *JSONConfig
+
+	// RetrieveToken returns the token that the access token in the config
+	// refers to when the tokens are kept in the keychain.
+	RetrieveToken func(value string) string
 }
 
 // CurrentUser returns user information decoded from the JWT access token in
 // .cf/config.json.
 func (config DefaultUserConfig) CurrentUser() (User, error) {
-	return decodeUserFromJWT(config.ConfigFile.AccessToken)
+	return decodeUserFromJWT(config.accessToken())
+}
+
+func (config DefaultUserConfig) accessToken() string {
+	if config.RetrieveToken == nil {
+		return config.ConfigFile.AccessToken
+	}
+	return config.RetrieveToken(config.ConfigFile.AccessToken)
 }
 
 // CurrentUserName returns the name of a user as returned by CurrentUser()
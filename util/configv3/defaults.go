@@ -19,6 +19,10 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultPaginationWorkers is the default number of pages of a list that
+	// are fetched at the same time.
+	DefaultPaginationWorkers = 4
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
@@ -62,11 +66,6 @@ func (config *Config) PollingInterval() time.Duration {
 	return DefaultPollingInterval
 }
 
-// RequestRetryCount returns the number of request retries.
-func (*Config) RequestRetryCount() int {
-	return DefaultRetryCount
-}
-
 // UAADisableKeepAlives returns true when TCP connections should not be reused
 // for UAA.
 func (*Config) UAADisableKeepAlives() bool {
@@ -4,27 +4,60 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
+
+// LogFormat is the format log messages are written in.
+type LogFormat string
+
+const (
+	// LogFormatText writes each message as a line of key=value pairs.
+	LogFormatText LogFormat = "text"
+	// LogFormatJSON writes each message as a JSON object.
+	LogFormatJSON LogFormat = "json"
 )
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName          string
+	CI                  string
+	CFColor             string
+	CFClientCert        string
+	CFClientKey         string
+	CFColorTheme        string
+	CFDialTimeout       string
+	CFHome              string
+	CFIPFamily          string
+	CFLogFile           string
+	CFLogFormat         string
+	CFLogGroups         string
+	CFLogLevel          string
+	CFPager             string
+	CFPaginationWorkers string
+	CFPassword          string
+	CFPluginHome        string
+	CFProfile           string
+	CFRequestRetries    string
+	CFStagingTimeout    string
+	CFStartupTimeout    string
+	CFStats             string
+	CFTrace             string
+	CFUsername          string
+	ColorTerm           string
+	DockerPassword      string
+	Experimental        string
+	ForceTTY            string
+	GitHubActions       string
+	GitLabCI            string
+	HTTPSProxy          string
+	JenkinsURL          string
+	Lang                string
+	LCAll               string
+	Pager               string
+	TeamCityVersion     string
+	Term                string
+	TFBuild             string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -84,6 +117,26 @@ func (config *Config) HTTPSProxy() string {
 	return config.ENV.HTTPSProxy
 }
 
+// IPFamily returns the address family that connections should try first
+// when a host has both IPv4 and IPv6 addresses. This is based off of:
+//   1. The --prefer-ipv4 or --prefer-ipv6 global flag if given
+//   2. The $CF_IP_FAMILY environment variable if set to ipv4 or ipv6
+//   3. Defaults to no preference
+func (config *Config) IPFamily() util.IPFamily {
+	switch {
+	case config.Flags.PreferIPv4:
+		return util.IPFamilyIPv4
+	case config.Flags.PreferIPv6:
+		return util.IPFamilyIPv6
+	}
+
+	switch family := util.IPFamily(strings.ToLower(config.ENV.CFIPFamily)); family {
+	case util.IPFamilyIPv4, util.IPFamilyIPv6:
+		return family
+	}
+	return util.IPFamilyAny
+}
+
 // LogLevel returns the global log level. The levels follow Logrus's log level
 // scheme. This value is based off of:
 //   - The $CF_LOG_LEVEL and an int/warn/info/etc...
@@ -106,12 +159,65 @@ func (config *Config) LogLevel() int {
 			return 4
 		case "debug":
 			return 5
+		case "trace":
+			return 6
 		}
 	}
 
 	return 0
 }
 
+// LogFile returns the path of the file that log messages are appended to. An
+// empty path means that they are written to stderr. This value is based off of:
+//   - The $CF_LOG_FILE environment variable if set
+//   - Defaults to "" (ie stderr)
+func (config *Config) LogFile() string {
+	return config.ENV.CFLogFile
+}
+
+// LogFormat returns the format that log messages are written in. This value is
+// based off of:
+//   - The $CF_LOG_FORMAT environment variable if it is "text" or "json"
+//   - Defaults to LogFormatText
+func (config *Config) LogFormat() LogFormat {
+	if strings.ToLower(config.ENV.CFLogFormat) == string(LogFormatJSON) {
+		return LogFormatJSON
+	}
+	return LogFormatText
+}
+
+// PaginationWorkers returns the number of pages of a list that are fetched
+// from the Cloud Controller at the same time. The number is based off of:
+//   1. The $CF_PAGINATION_WORKERS environment variable if set to a positive
+//      number
+//   2. Defaults to the DefaultPaginationWorkers
+func (config *Config) PaginationWorkers() int {
+	if config.ENV.CFPaginationWorkers != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFPaginationWorkers)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	return DefaultPaginationWorkers
+}
+
+// RequestRetryCount returns the number of times a failed request is retried.
+// The number is based off of:
+//   1. The $CF_REQUEST_RETRIES environment variable if set to a number that
+//      is not negative
+//   2. Defaults to the DefaultRetryCount
+func (config *Config) RequestRetryCount() int {
+	if config.ENV.CFRequestRetries != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRequestRetries)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryCount
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
@@ -3,6 +3,7 @@ package configv3_test
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/util"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo"
@@ -72,6 +73,31 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	DescribeTable("PaginationWorkers",
+		func(envVal string, expected int) {
+			config.ENV.CFPaginationWorkers = envVal
+			Expect(config.PaginationWorkers()).To(Equal(expected))
+		},
+
+		Entry("uses the default when the environment value is not set", "", DefaultPaginationWorkers),
+		Entry("uses the environment value when it is a positive number", "10", 10),
+		Entry("uses the default when the environment value is not a number", "many", DefaultPaginationWorkers),
+		Entry("uses the default when the environment value is not positive", "0", DefaultPaginationWorkers),
+	)
+
+	DescribeTable("RequestRetryCount",
+		func(envVal string, expected int) {
+			config.ENV.CFRequestRetries = envVal
+			Expect(config.RequestRetryCount()).To(Equal(expected))
+		},
+
+		Entry("uses the default when the environment value is not set", "", DefaultRetryCount),
+		Entry("uses the environment value when it is a number", "5", 5),
+		Entry("uses the environment value when it is zero", "0", 0),
+		Entry("uses the default when the environment value is not a number", "many", DefaultRetryCount),
+		Entry("uses the default when the environment value is negative", "-1", DefaultRetryCount),
+	)
+
 	DescribeTable("Experimental",
 		func(envVal string, expected bool) {
 			config.ENV.Experimental = envVal
@@ -83,6 +109,33 @@ var _ = Describe("Config", func() {
 		Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
 	)
 
+	DescribeTable("IPFamily",
+		func(config Config, expected util.IPFamily) {
+			Expect(config.IPFamily()).To(Equal(expected))
+		},
+
+		Entry("defaults to no preference", Config{}, util.IPFamilyAny),
+		Entry("uses CF_IP_FAMILY when set", Config{ENV: EnvOverride{CFIPFamily: "IPv6"}}, util.IPFamilyIPv6),
+		Entry("ignores an invalid CF_IP_FAMILY", Config{ENV: EnvOverride{CFIPFamily: "ipv5"}}, util.IPFamilyAny),
+		Entry("uses --prefer-ipv4", Config{Flags: FlagOverride{PreferIPv4: true}}, util.IPFamilyIPv4),
+		Entry("lets --prefer-ipv6 override CF_IP_FAMILY", Config{ENV: EnvOverride{CFIPFamily: "ipv4"}, Flags: FlagOverride{PreferIPv6: true}}, util.IPFamilyIPv6),
+	)
+
+	DescribeTable("LogGroupStyle",
+		func(env EnvOverride, expected LogGroupStyle) {
+			config := Config{ENV: env}
+			Expect(config.LogGroupStyle()).To(Equal(expected))
+		},
+
+		Entry("defaults to no groups", EnvOverride{}, LogGroupsNone),
+		Entry("detects GitHub Actions", EnvOverride{GitHubActions: "true"}, LogGroupsGitHub),
+		Entry("detects GitLab CI", EnvOverride{GitLabCI: "true"}, LogGroupsGitLab),
+		Entry("uses CF_LOG_GROUPS when set", EnvOverride{CFLogGroups: "GitLab"}, LogGroupsGitLab),
+		Entry("lets CF_LOG_GROUPS override detection", EnvOverride{CFLogGroups: "github", GitLabCI: "true"}, LogGroupsGitHub),
+		Entry("lets CF_LOG_GROUPS disable detection", EnvOverride{CFLogGroups: "none", GitHubActions: "true"}, LogGroupsNone),
+		Entry("ignores an invalid CF_LOG_GROUPS", EnvOverride{CFLogGroups: "jenkins", GitHubActions: "true"}, LogGroupsGitHub),
+	)
+
 	DescribeTable("LogLevel",
 		func(envVal string, expectedLevel int) {
 			config := Config{ENV: EnvOverride{CFLogLevel: envVal}}
@@ -97,6 +150,18 @@ var _ = Describe("Config", func() {
 		Entry("info returns 4", "info", 4),
 		Entry("debug returns 5", "debug", 5),
 		Entry("dEbUg returns 5", "dEbUg", 5),
+		Entry("trace returns 6", "trace", 6),
+	)
+
+	DescribeTable("LogFormat",
+		func(envVal string, expected LogFormat) {
+			config := Config{ENV: EnvOverride{CFLogFormat: envVal}}
+			Expect(config.LogFormat()).To(Equal(expected))
+		},
+
+		Entry("defaults to text", "", LogFormatText),
+		Entry("returns json", "JSON", LogFormatJSON),
+		Entry("ignores an invalid format", "yaml", LogFormatText),
 	)
 
 	Describe("StagingTimeout", func() {
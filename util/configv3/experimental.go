@@ -0,0 +1,41 @@
+package configv3
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ExperimentalFeatureEnabled returns whether the named experimental feature is
+// turned on. $CF_CLI_EXPERIMENTAL is either true, which turns on every
+// feature, or a comma-separated list of feature names. This is based off of:
+//   1. The $CF_CLI_EXPERIMENTAL environment variable if it includes name
+//   2. The 'ExperimentalFeatures' value in the .cf/config.json if set for name
+//   3. Defaults to enabledByDefault
+func (config *Config) ExperimentalFeatureEnabled(name string, enabledByDefault bool) bool {
+	if config.Experimental() {
+		return true
+	}
+
+	if _, err := strconv.ParseBool(config.ENV.Experimental); err != nil {
+		for _, feature := range strings.Split(config.ENV.Experimental, ",") {
+			if strings.EqualFold(strings.TrimSpace(feature), name) {
+				return true
+			}
+		}
+	}
+
+	if enabled, ok := config.ConfigFile.ExperimentalFeatures[name]; ok {
+		return enabled
+	}
+
+	return enabledByDefault
+}
+
+// SetExperimentalFeatureEnabled turns the named experimental feature on or
+// off.
+func (config *Config) SetExperimentalFeatureEnabled(name string, enabled bool) {
+	if config.ConfigFile.ExperimentalFeatures == nil {
+		config.ConfigFile.ExperimentalFeatures = map[string]bool{}
+	}
+	config.ConfigFile.ExperimentalFeatures[name] = enabled
+}
@@ -0,0 +1,42 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Experimental features", func() {
+	DescribeTable("ExperimentalFeatureEnabled",
+		func(env string, configured map[string]bool, enabledByDefault bool, expected bool) {
+			config := Config{
+				ENV:        EnvOverride{Experimental: env},
+				ConfigFile: JSONConfig{ExperimentalFeatures: configured},
+			}
+			Expect(config.ExperimentalFeatureEnabled("some-feature", enabledByDefault)).To(Equal(expected))
+		},
+
+		Entry("defaults to off", "", nil, false, false),
+		Entry("defaults to on for features enabled by default", "", nil, true, true),
+		Entry("uses the config", "", map[string]bool{"some-feature": true}, false, true),
+		Entry("lets the config turn off features enabled by default", "", map[string]bool{"some-feature": false}, true, false),
+		Entry("ignores other features in the config", "", map[string]bool{"other-feature": true}, false, false),
+		Entry("turns on every feature when CF_CLI_EXPERIMENTAL is true", "true", map[string]bool{"some-feature": false}, false, true),
+		Entry("turns on listed features from CF_CLI_EXPERIMENTAL", "other-feature, Some-Feature", nil, false, true),
+		Entry("ignores unlisted features in CF_CLI_EXPERIMENTAL", "other-feature", nil, false, false),
+		Entry("falls back to the config when CF_CLI_EXPERIMENTAL is false", "false", map[string]bool{"some-feature": true}, false, true),
+	)
+
+	Describe("SetExperimentalFeatureEnabled", func() {
+		It("records the feature in the config", func() {
+			config := Config{}
+			config.SetExperimentalFeatureEnabled("some-feature", true)
+			config.SetExperimentalFeatureEnabled("other-feature", false)
+			Expect(config.ConfigFile.ExperimentalFeatures).To(Equal(map[string]bool{
+				"some-feature":  true,
+				"other-feature": false,
+			}))
+		})
+	})
+})
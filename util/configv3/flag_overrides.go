@@ -2,5 +2,8 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Verbose    bool
+	PreferIPv4 bool
+	PreferIPv6 bool
+	Stats      bool
 }
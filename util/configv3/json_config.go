@@ -2,31 +2,44 @@ package configv3
 
 import (
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
 )
 
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
 	AccessToken              string             `json:"AccessToken"`
+	APIBaseURL               string             `json:"APIBaseURL"`
 	APIVersion               string             `json:"APIVersion"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
 	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
 	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
+	ClientCertificate        string             `json:"ClientCertificate,omitempty"`
+	ClientKey                string             `json:"ClientKey,omitempty"`
 	ColorEnabled             string             `json:"ColorEnabled"`
+	ColorTheme               string             `json:"ColorTheme"`
 	ConfigVersion            int                `json:"ConfigVersion"`
+	CredentialHelper         string             `json:"CredentialHelper,omitempty"`
 	DopplerEndpoint          string             `json:"DopplerEndPoint"`
+	ExperimentalFeatures     map[string]bool    `json:"ExperimentalFeatures,omitempty"`
 	Locale                   string             `json:"Locale"`
 	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
 	NetworkPolicyV1Endpoint  string             `json:"NetworkPolicyV1Endpoint"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
+	Pager                    string             `json:"Pager"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	Profiles                 map[string]Profile `json:"Profiles,omitempty"`
+	ProgressStyle            string             `json:"ProgressStyle"`
 	RefreshToken             string             `json:"RefreshToken"`
+	ResolveOverrides         []string           `json:"ResolveOverrides"`
 	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SSHOAuthClient           string             `json:"SSHOAuthClient"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
 	Target                   string             `json:"Target"`
+	TokenStorage             string             `json:"TokenStorage,omitempty"`
 	Trace                    string             `json:"Trace"`
 	UAAEndpoint              string             `json:"UaaEndpoint"`
 	UAAGrantType             string             `json:"UAAGrantType"`
@@ -57,7 +70,16 @@ type User struct {
 
 // AccessToken returns the access token for making authenticated API calls.
 func (config *Config) AccessToken() string {
-	return config.ConfigFile.AccessToken
+	return config.token(config.ConfigFile.AccessToken)
+}
+
+// APIBaseURL returns the URL that CC API routes are resolved against. It is
+// the same as Target unless the CC API is served under a path prefix.
+func (config *Config) APIBaseURL() string {
+	if config.ConfigFile.APIBaseURL == "" {
+		return config.ConfigFile.Target
+	}
+	return config.ConfigFile.APIBaseURL
 }
 
 // APIVersion returns the CC API Version.
@@ -70,6 +92,30 @@ func (config *Config) AuthorizationEndpoint() string {
 	return config.ConfigFile.AuthorizationEndpoint
 }
 
+// ClientCertificate returns the certificate and private key files presented
+// to servers that require mutual TLS. This is based off of:
+//   1. The $CF_CLIENT_CERT and $CF_CLIENT_KEY environment variables if set
+//   2. Falling back to the config file's ClientCertificate and ClientKey
+func (config *Config) ClientCertificate() util.ClientCertificate {
+	if config.ENV.CFClientCert != "" || config.ENV.CFClientKey != "" {
+		return util.ClientCertificate{
+			CertFile: config.ENV.CFClientCert,
+			KeyFile:  config.ENV.CFClientKey,
+		}
+	}
+	return util.ClientCertificate{
+		CertFile: config.ConfigFile.ClientCertificate,
+		KeyFile:  config.ConfigFile.ClientKey,
+	}
+}
+
+// CredentialHelper returns the command line of the credential helper that
+// supplies the credentials that would otherwise be prompted for, or an empty
+// string when none is configured.
+func (config *Config) CredentialHelper() string {
+	return config.ConfigFile.CredentialHelper
+}
+
 // HasTargetedOrganization returns true if the organization is set.
 func (config *Config) HasTargetedOrganization() bool {
 	return config.ConfigFile.TargetedOrganization.GUID != ""
@@ -108,7 +154,22 @@ func (config *Config) NetworkPolicyV1Endpoint() string {
 
 // RefreshToken returns the refresh token for getting a new access token.
 func (config *Config) RefreshToken() string {
-	return config.ConfigFile.RefreshToken
+	return config.token(config.ConfigFile.RefreshToken)
+}
+
+// ResolveOverrides returns the addresses that connections to specific hosts
+// and ports are made to instead of resolving the hosts. Entries that cannot
+// be parsed are ignored.
+func (config *Config) ResolveOverrides() []util.ResolveOverride {
+	var overrides []util.ResolveOverride
+	for _, rawOverride := range config.ConfigFile.ResolveOverrides {
+		override, err := util.ParseResolveOverride(rawOverride)
+		if err != nil {
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides
 }
 
 // RoutingEndpoint returns the endpoint for the router API
@@ -126,11 +187,37 @@ func (config *Config) SetAccessToken(accessToken string) {
 	config.ConfigFile.AccessToken = accessToken
 }
 
+// SetClientCertificate sets the certificate and private key files presented
+// to servers that require mutual TLS.
+func (config *Config) SetClientCertificate(certificate util.ClientCertificate) {
+	config.ConfigFile.ClientCertificate = certificate.CertFile
+	config.ConfigFile.ClientKey = certificate.KeyFile
+}
+
 // SetColorEnabled sets the color enabled feature to true or false
 func (config *Config) SetColorEnabled(enabled string) {
 	config.ConfigFile.ColorEnabled = enabled
 }
 
+// SetColorTheme sets the color theme, or clears the field if requested
+func (config *Config) SetColorTheme(theme string) {
+	if theme == "CLEAR" {
+		config.ConfigFile.ColorTheme = ""
+	} else {
+		config.ConfigFile.ColorTheme = theme
+	}
+}
+
+// SetCredentialHelper sets the command line of the credential helper, or
+// clears the field if requested
+func (config *Config) SetCredentialHelper(commandLine string) {
+	if commandLine == "CLEAR" {
+		config.ConfigFile.CredentialHelper = ""
+	} else {
+		config.ConfigFile.CredentialHelper = commandLine
+	}
+}
+
 // SetLocale sets the locale, or clears the field if requested
 func (config *Config) SetLocale(locale string) {
 	if locale == "CLEAR" {
@@ -140,6 +227,11 @@ func (config *Config) SetLocale(locale string) {
 	}
 }
 
+// ClearResolveOverrides removes all of the resolve overrides.
+func (config *Config) ClearResolveOverrides() {
+	config.ConfigFile.ResolveOverrides = nil
+}
+
 // SetMinCLIVersion sets the minimum CLI version required by the CC.
 func (config *Config) SetMinCLIVersion(minVersion string) {
 	config.ConfigFile.MinCLIVersion = minVersion
@@ -156,6 +248,20 @@ func (config *Config) SetRefreshToken(refreshToken string) {
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
+// SetResolveOverride adds the resolve override, replacing any existing
+// override for the same host and port.
+func (config *Config) SetResolveOverride(override util.ResolveOverride) {
+	overrides := []string{}
+	for _, rawOverride := range config.ConfigFile.ResolveOverrides {
+		existing, err := util.ParseResolveOverride(rawOverride)
+		if err == nil && existing.Matches(override.Host, override.Port) {
+			continue
+		}
+		overrides = append(overrides, rawOverride)
+	}
+	config.ConfigFile.ResolveOverrides = append(overrides, override.String())
+}
+
 // SetSpaceInformation sets the currently targeted space.
 // The "AllowSSH" field is not returned by v3, and is never read from the config.
 // Persist `true` to maintain compatibility in the config file.
@@ -167,6 +273,7 @@ func (config *Config) SetSpaceInformation(guid string, name string, allowSSH boo
 
 type TargetInformationArgs struct {
 	Api               string
+	ApiBaseURL        string
 	ApiVersion        string
 	Auth              string
 	Doppler           string
@@ -183,6 +290,7 @@ type TargetInformationArgs struct {
 // related API URLs.
 func (config *Config) SetTargetInformation(args TargetInformationArgs) {
 	config.ConfigFile.Target = args.Api
+	config.ConfigFile.APIBaseURL = args.ApiBaseURL
 	config.ConfigFile.APIVersion = args.ApiVersion
 	config.SetMinCLIVersion(args.MinCLIVersion)
 	config.ConfigFile.DopplerEndpoint = args.Doppler
@@ -2,8 +2,10 @@ package configv3_test
 
 import (
 	"fmt"
+	"net"
 	"time"
 
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -131,6 +133,47 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("ResolveOverrides", func() {
+		BeforeEach(func() {
+			rawConfig := fmt.Sprintf(`{ "ResolveOverrides": ["api.example.com:443:10.0.0.5", "not-an-override"], "ConfigVersion": %d }`, CurrentConfigVersion)
+			setConfig(homeDir, rawConfig)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+		})
+
+		It("returns the overrides that can be parsed", func() {
+			Expect(config.ResolveOverrides()).To(Equal([]util.ResolveOverride{
+				{Host: "api.example.com", Port: "443", Addresses: []net.IP{net.ParseIP("10.0.0.5")}},
+			}))
+		})
+	})
+
+	Describe("ClientCertificate", func() {
+		BeforeEach(func() {
+			config = new(Config)
+			config.SetClientCertificate(util.ClientCertificate{CertFile: "/certs/client.crt", KeyFile: "/certs/client.key"})
+		})
+
+		It("returns the certificate of the config file", func() {
+			Expect(config.ConfigFile.ClientCertificate).To(Equal("/certs/client.crt"))
+			Expect(config.ClientCertificate()).To(Equal(util.ClientCertificate{CertFile: "/certs/client.crt", KeyFile: "/certs/client.key"}))
+		})
+
+		When("$CF_CLIENT_CERT and $CF_CLIENT_KEY are set", func() {
+			BeforeEach(func() {
+				config.ENV.CFClientCert = "/env/client.crt"
+				config.ENV.CFClientKey = "/env/client.key"
+			})
+
+			It("returns the certificate of the environment", func() {
+				Expect(config.ClientCertificate()).To(Equal(util.ClientCertificate{CertFile: "/env/client.crt", KeyFile: "/env/client.key"}))
+			})
+		})
+	})
+
 	Describe("SetAsyncTimeout", func() {
 		It("sets the async timeout", func() {
 			config = new(Config)
@@ -155,6 +198,36 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("SetColorTheme", func() {
+		It("sets the color theme field", func() {
+			config = new(Config)
+			config.SetColorTheme("ok=blue")
+			Expect(config.ConfigFile.ColorTheme).To(Equal("ok=blue"))
+		})
+
+		It("clears the color theme field if requested", func() {
+			config = new(Config)
+			config.ConfigFile.ColorTheme = "ok=blue"
+			config.SetColorTheme("CLEAR")
+			Expect(config.ConfigFile.ColorTheme).To(Equal(""))
+		})
+	})
+
+	Describe("SetCredentialHelper", func() {
+		It("sets the credential helper field", func() {
+			config = new(Config)
+			config.SetCredentialHelper("cf-op-helper --vault cf")
+			Expect(config.CredentialHelper()).To(Equal("cf-op-helper --vault cf"))
+		})
+
+		It("clears the credential helper field if requested", func() {
+			config = new(Config)
+			config.ConfigFile.CredentialHelper = "cf-op-helper"
+			config.SetCredentialHelper("CLEAR")
+			Expect(config.ConfigFile.CredentialHelper).To(Equal(""))
+		})
+	})
+
 	Describe("SetLocale", func() {
 		It("sets the locale field", func() {
 			config = new(Config)
@@ -169,6 +242,39 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("SetResolveOverride", func() {
+		BeforeEach(func() {
+			config = new(Config)
+			config.ConfigFile.ResolveOverrides = []string{"api.example.com:443:10.0.0.5", "uaa.example.com:443:10.0.0.6"}
+		})
+
+		It("adds the override", func() {
+			config.SetResolveOverride(util.ResolveOverride{Host: "ssh.example.com", Port: "2222", Addresses: []net.IP{net.ParseIP("10.0.0.7")}})
+			Expect(config.ConfigFile.ResolveOverrides).To(Equal([]string{
+				"api.example.com:443:10.0.0.5",
+				"uaa.example.com:443:10.0.0.6",
+				"ssh.example.com:2222:10.0.0.7",
+			}))
+		})
+
+		It("replaces an existing override for the same host and port", func() {
+			config.SetResolveOverride(util.ResolveOverride{Host: "api.example.com", Port: "443", Addresses: []net.IP{net.ParseIP("10.0.0.8")}})
+			Expect(config.ConfigFile.ResolveOverrides).To(Equal([]string{
+				"uaa.example.com:443:10.0.0.6",
+				"api.example.com:443:10.0.0.8",
+			}))
+		})
+	})
+
+	Describe("ClearResolveOverrides", func() {
+		It("removes all of the overrides", func() {
+			config = new(Config)
+			config.ConfigFile.ResolveOverrides = []string{"api.example.com:443:10.0.0.5"}
+			config.ClearResolveOverrides()
+			Expect(config.ConfigFile.ResolveOverrides).To(BeEmpty())
+		})
+	})
+
 	Describe("SetOrganizationInformation", func() {
 		It("sets the organization GUID and name", func() {
 			config = new(Config)
@@ -215,6 +321,7 @@ var _ = Describe("JSONConfig", func() {
 			}
 			config.SetTargetInformation(TargetInformationArgs{
 				Api:               "https://api.foo.com",
+				ApiBaseURL:        "https://api.foo.com/cf",
 				ApiVersion:        "2.59.31",
 				Auth:              "https://login.foo.com",
 				MinCLIVersion:     "2.0.0",
@@ -226,6 +333,7 @@ var _ = Describe("JSONConfig", func() {
 			})
 
 			Expect(config.ConfigFile.Target).To(Equal("https://api.foo.com"))
+			Expect(config.ConfigFile.APIBaseURL).To(Equal("https://api.foo.com/cf"))
 			Expect(config.ConfigFile.APIVersion).To(Equal("2.59.31"))
 			Expect(config.ConfigFile.AuthorizationEndpoint).To(Equal("https://login.foo.com"))
 			Expect(config.ConfigFile.MinCLIVersion).To(Equal("2.0.0"))
@@ -336,6 +444,33 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("APIBaseURL", func() {
+		When("the API base URL is set", func() {
+			BeforeEach(func() {
+				config = &Config{ConfigFile: JSONConfig{
+					Target:     "https://api.foo.com",
+					APIBaseURL: "https://api.foo.com/cf",
+				}}
+			})
+
+			It("returns the API base URL", func() {
+				Expect(config.APIBaseURL()).To(Equal("https://api.foo.com/cf"))
+			})
+		})
+
+		When("the API base URL is not set", func() {
+			BeforeEach(func() {
+				config = &Config{ConfigFile: JSONConfig{
+					Target: "https://api.foo.com",
+				}}
+			})
+
+			It("returns the target", func() {
+				Expect(config.APIBaseURL()).To(Equal("https://api.foo.com"))
+			})
+		})
+	})
+
 	Describe("TargetedOrganization", func() {
 		It("returns the organization", func() {
 			organization := Organization{
@@ -116,23 +116,46 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-	}
+		BinaryName:          filepath.Base(os.Args[0]),
+		CI:                  os.Getenv("CI"),
+		CFColor:             os.Getenv("CF_COLOR"),
+		CFClientCert:        os.Getenv("CF_CLIENT_CERT"),
+		CFClientKey:         os.Getenv("CF_CLIENT_KEY"),
+		CFColorTheme:        os.Getenv("CF_COLOR_THEME"),
+		CFDialTimeout:       os.Getenv("CF_DIAL_TIMEOUT"),
+		CFIPFamily:          os.Getenv("CF_IP_FAMILY"),
+		CFLogFile:           os.Getenv("CF_LOG_FILE"),
+		CFLogFormat:         os.Getenv("CF_LOG_FORMAT"),
+		CFLogGroups:         os.Getenv("CF_LOG_GROUPS"),
+		CFLogLevel:          os.Getenv("CF_LOG_LEVEL"),
+		CFPager:             os.Getenv("CF_PAGER"),
+		CFPaginationWorkers: os.Getenv("CF_PAGINATION_WORKERS"),
+		CFPassword:          os.Getenv("CF_PASSWORD"),
+		CFPluginHome:        os.Getenv("CF_PLUGIN_HOME"),
+		CFProfile:           os.Getenv("CF_PROFILE"),
+		CFRequestRetries:    os.Getenv("CF_REQUEST_RETRIES"),
+		CFStagingTimeout:    os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:    os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFStats:             os.Getenv("CF_STATS"),
+		CFTrace:             os.Getenv("CF_TRACE"),
+		CFUsername:          os.Getenv("CF_USERNAME"),
+		ColorTerm:           os.Getenv("COLORTERM"),
+		DockerPassword:      os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:        os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:            os.Getenv("FORCE_TTY"),
+		GitHubActions:       os.Getenv("GITHUB_ACTIONS"),
+		GitLabCI:            os.Getenv("GITLAB_CI"),
+		HTTPSProxy:          os.Getenv("https_proxy"),
+		JenkinsURL:          os.Getenv("JENKINS_URL"),
+		Lang:                os.Getenv("LANG"),
+		LCAll:               os.Getenv("LC_ALL"),
+		Pager:               os.Getenv("PAGER"),
+		TeamCityVersion:     os.Getenv("TEAMCITY_VERSION"),
+		Term:                os.Getenv("TERM"),
+		TFBuild:             os.Getenv("TF_BUILD"),
+	}
+
+	config.loadEnvProfile()
 
 	err = config.loadPluginConfig()
 	if err != nil {
@@ -168,7 +191,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 	config.UserConfig = DynamicUserConfig{
 		ConfigFile:           &config.ConfigFile,
-		DefaultUserConfig:    DefaultUserConfig{ConfigFile: &config.ConfigFile},
+		DefaultUserConfig:    DefaultUserConfig{ConfigFile: &config.ConfigFile, RetrieveToken: config.token},
 		KubernetesUserConfig: KubernetesUserConfig{ConfigFile: &config.ConfigFile},
 	}
 
@@ -20,6 +20,7 @@ var _ = Describe("Config", func() {
 		oldLang           string
 		oldLCAll          string
 		oldCfExperimental string
+		oldTerminalEnv    map[string]string
 		homeDir           string
 	)
 
@@ -35,12 +36,23 @@ var _ = Describe("Config", func() {
 		Expect(os.Unsetenv("LANG")).ToNot(HaveOccurred())
 		Expect(os.Unsetenv("LC_ALL")).ToNot(HaveOccurred())
 		Expect(os.Unsetenv("CF_CLI_EXPERIMENTAL")).To(Succeed())
+
+		// these are usually set by the terminal or CI system running the
+		// tests
+		oldTerminalEnv = map[string]string{}
+		for _, name := range []string{"CI", "COLORTERM", "PAGER", "TERM"} {
+			oldTerminalEnv[name] = os.Getenv(name)
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
 	})
 
 	AfterEach(func() {
 		os.Setenv("LANG", oldLang)
 		os.Setenv("LC_ALL", oldLCAll)
 		os.Setenv("CF_CLI_EXPERIMENTAL", oldCfExperimental)
+		for name, value := range oldTerminalEnv {
+			os.Setenv(name, value)
+		}
 		teardown(homeDir)
 	})
 
@@ -0,0 +1,51 @@
+package configv3
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// LogGroupsNone means that no group markers will be displayed.
+	LogGroupsNone LogGroupStyle = ""
+
+	// LogGroupsGitHub means that phases will be wrapped in GitHub Actions
+	// ::group:: workflow commands.
+	LogGroupsGitHub LogGroupStyle = "github"
+
+	// LogGroupsGitLab means that phases will be wrapped in GitLab CI
+	// collapsible section markers.
+	LogGroupsGitLab LogGroupStyle = "gitlab"
+)
+
+// LogGroupStyle is the kind of CI-native markers used to make the phases of
+// long-running commands collapsible in CI logs.
+type LogGroupStyle string
+
+// LogGroupStyle returns the group markers to display based off:
+//  1. The $CF_LOG_GROUPS environment variable if set (github/gitlab/none)
+//  2. Detecting GitHub Actions ($GITHUB_ACTIONS) or GitLab CI ($GITLAB_CI)
+//  3. Defaults to LogGroupsNone if nothing is detected
+func (config *Config) LogGroupStyle() LogGroupStyle {
+	switch strings.ToLower(config.ENV.CFLogGroups) {
+	case string(LogGroupsGitHub):
+		return LogGroupsGitHub
+	case string(LogGroupsGitLab):
+		return LogGroupsGitLab
+	case "none", "false":
+		return LogGroupsNone
+	}
+
+	if isTrue(config.ENV.GitHubActions) {
+		return LogGroupsGitHub
+	}
+	if isTrue(config.ENV.GitLabCI) {
+		return LogGroupsGitLab
+	}
+	return LogGroupsNone
+}
+
+func isTrue(envVal string) bool {
+	val, err := strconv.ParseBool(envVal)
+	return err == nil && val
+}
@@ -0,0 +1,10 @@
+package configv3
+
+import "path/filepath"
+
+// ManifestSnapshotPath returns the file that apply-manifest saves the
+// previous manifest of the apps of the space to, in the manifest-snapshots
+// directory of the config directory.
+func (config *Config) ManifestSnapshotPath(spaceGUID string) string {
+	return filepath.Join(configDirectory(), "manifest-snapshots", spaceGUID+".yml")
+}
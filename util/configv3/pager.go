@@ -0,0 +1,38 @@
+package configv3
+
+import "strconv"
+
+// DefaultPagerCommand is the pager used when neither $CF_PAGER nor $PAGER is
+// set. The flags make less quit when the output fits on one screen, pass
+// colors through and leave the output on the screen after quitting.
+const DefaultPagerCommand = "less -FRX"
+
+// PagerEnabled returns true when long command output should be shown through
+// a pager. This is based off of:
+//   1. The 'Pager' value in the .cf/config.json if set
+//   2. Defaults to false
+func (config *Config) PagerEnabled() bool {
+	enabled, err := strconv.ParseBool(config.ConfigFile.Pager)
+	return err == nil && enabled
+}
+
+// PagerCommand returns the command line of the pager. This is based off of:
+//   1. The $CF_PAGER environment variable if set
+//   2. The $PAGER environment variable if set
+//   3. Defaults to DefaultPagerCommand
+func (config *Config) PagerCommand() string {
+	if config.ENV.CFPager != "" {
+		return config.ENV.CFPager
+	}
+
+	if config.ENV.Pager != "" {
+		return config.ENV.Pager
+	}
+
+	return DefaultPagerCommand
+}
+
+// SetPagerEnabled sets the pager feature to true or false.
+func (config *Config) SetPagerEnabled(enabled string) {
+	config.ConfigFile.Pager = enabled
+}
@@ -0,0 +1,58 @@
+package configv3_test
+
+import (
+	"fmt"
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("PagerEnabled",
+		func(configVal string, expected bool) {
+			rawConfig := fmt.Sprintf(`{"Pager":"%s", "ConfigVersion": %d }`, configVal, CurrentConfigVersion)
+			setConfig(homeDir, rawConfig)
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.PagerEnabled()).To(Equal(expected))
+		},
+		Entry("config=true enabled", "true", true),
+		Entry("config=false disabled", "false", false),
+		Entry("config=unset falls back to disabled", "", false),
+		Entry("config=invalid falls back to disabled", "sometimes", false),
+	)
+
+	DescribeTable("PagerCommand",
+		func(cfPager string, pager string, expected string) {
+			defer os.Unsetenv("CF_PAGER")
+			defer os.Unsetenv("PAGER")
+			os.Setenv("CF_PAGER", cfPager)
+			os.Setenv("PAGER", pager)
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.PagerCommand()).To(Equal(expected))
+		},
+		Entry("CF_PAGER takes precedence", "more", "most", "more"),
+		Entry("PAGER is used when CF_PAGER is unset", "", "most", "most"),
+		Entry("falls back to the default", "", "", DefaultPagerCommand),
+	)
+})
@@ -0,0 +1,164 @@
+package configv3
+
+import "sort"
+
+// Profile is a named target saved in the config: an API endpoint with the
+// targeted org and space and the tokens of the user logged in to it.
+type Profile struct {
+	AccessToken             string       `json:"AccessToken"`
+	APIBaseURL              string       `json:"APIBaseURL"`
+	APIVersion              string       `json:"APIVersion"`
+	AuthorizationEndpoint   string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                 CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint         string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint        string       `json:"LogCacheEndPoint"`
+	MinCLIVersion           string       `json:"MinCLIVersion"`
+	NetworkPolicyV1Endpoint string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization    Organization `json:"OrganizationFields"`
+	RefreshToken            string       `json:"RefreshToken"`
+	RoutingEndpoint         string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace           Space        `json:"SpaceFields"`
+	SSHOAuthClient          string       `json:"SSHOAuthClient"`
+	SkipSSLValidation       bool         `json:"SSLDisabled"`
+	Target                  string       `json:"Target"`
+	UAAEndpoint             string       `json:"UaaEndpoint"`
+	UAAGrantType            string       `json:"UAAGrantType"`
+	UAAOAuthClient          string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret    string       `json:"UAAOAuthClientSecret"`
+}
+
+// Profile returns the name of the profile selected with $CF_PROFILE, or an
+// empty string when the top-level target of the config is used.
+func (config *Config) Profile() string {
+	return config.ENV.CFProfile
+}
+
+// ProfileNames returns the names of the saved profiles, sorted.
+func (config *Config) ProfileNames() []string {
+	var names []string
+	for name := range config.ConfigFile.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SaveProfile saves the current target, org, space and tokens as the named
+// profile, replacing any profile with that name.
+func (config *Config) SaveProfile(name string) {
+	if config.ConfigFile.Profiles == nil {
+		config.ConfigFile.Profiles = map[string]Profile{}
+	}
+	config.ConfigFile.Profiles[name] = config.ConfigFile.profile()
+}
+
+// UseProfile makes the named profile the current target. It returns false
+// when no profile has that name.
+func (config *Config) UseProfile(name string) bool {
+	profile, ok := config.ConfigFile.Profiles[name]
+	if ok {
+		config.ConfigFile.applyProfile(profile)
+	}
+	return ok
+}
+
+// loadEnvProfile makes the profile selected with $CF_PROFILE the current
+// target, keeping the top-level target aside so that writing the config saves
+// changes to the profile only. A profile that does not exist yet starts with
+// no target, so that logging in creates it.
+func (config *Config) loadEnvProfile() {
+	if config.ENV.CFProfile == "" {
+		return
+	}
+
+	topLevel := config.ConfigFile.profile()
+	config.topLevelProfile = &topLevel
+
+	profile, ok := config.ConfigFile.Profiles[config.ENV.CFProfile]
+	if !ok {
+		profile = Profile{
+			SSHOAuthClient:       DefaultSSHOAuthClient,
+			UAAOAuthClient:       DefaultUAAOAuthClient,
+			UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+		}
+	}
+	config.ConfigFile.applyProfile(profile)
+}
+
+// fileToWrite returns the config to write to .cf/config.json. When a profile
+// is selected with $CF_PROFILE, the current target is saved to it and the
+// top-level target is written unchanged. The tokens are replaced by the values
+// to write for the configured token storage.
+func (config *Config) fileToWrite() JSONConfig {
+	file := config.ConfigFile
+	file.Profiles = map[string]Profile{}
+	for name, profile := range config.ConfigFile.Profiles {
+		file.Profiles[name] = profile
+	}
+
+	if config.topLevelProfile != nil {
+		// Commands run with an unknown profile only create it once they target
+		// an API.
+		if _, ok := file.Profiles[config.ENV.CFProfile]; ok || config.ConfigFile.Target != "" {
+			file.Profiles[config.ENV.CFProfile] = config.ConfigFile.profile()
+		}
+		file.applyProfile(*config.topLevelProfile)
+	}
+
+	topLevel := config.storeTokens("", file.profile())
+	file.AccessToken = topLevel.AccessToken
+	file.RefreshToken = topLevel.RefreshToken
+	for name, profile := range file.Profiles {
+		file.Profiles[name] = config.storeTokens(name, profile)
+	}
+
+	return file
+}
+
+func (file JSONConfig) profile() Profile {
+	return Profile{
+		AccessToken:             file.AccessToken,
+		APIBaseURL:              file.APIBaseURL,
+		APIVersion:              file.APIVersion,
+		AuthorizationEndpoint:   file.AuthorizationEndpoint,
+		CFOnK8s:                 file.CFOnK8s,
+		DopplerEndpoint:         file.DopplerEndpoint,
+		LogCacheEndpoint:        file.LogCacheEndpoint,
+		MinCLIVersion:           file.MinCLIVersion,
+		NetworkPolicyV1Endpoint: file.NetworkPolicyV1Endpoint,
+		TargetedOrganization:    file.TargetedOrganization,
+		RefreshToken:            file.RefreshToken,
+		RoutingEndpoint:         file.RoutingEndpoint,
+		TargetedSpace:           file.TargetedSpace,
+		SSHOAuthClient:          file.SSHOAuthClient,
+		SkipSSLValidation:       file.SkipSSLValidation,
+		Target:                  file.Target,
+		UAAEndpoint:             file.UAAEndpoint,
+		UAAGrantType:            file.UAAGrantType,
+		UAAOAuthClient:          file.UAAOAuthClient,
+		UAAOAuthClientSecret:    file.UAAOAuthClientSecret,
+	}
+}
+
+func (file *JSONConfig) applyProfile(profile Profile) {
+	file.AccessToken = profile.AccessToken
+	file.APIBaseURL = profile.APIBaseURL
+	file.APIVersion = profile.APIVersion
+	file.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	file.CFOnK8s = profile.CFOnK8s
+	file.DopplerEndpoint = profile.DopplerEndpoint
+	file.LogCacheEndpoint = profile.LogCacheEndpoint
+	file.MinCLIVersion = profile.MinCLIVersion
+	file.NetworkPolicyV1Endpoint = profile.NetworkPolicyV1Endpoint
+	file.TargetedOrganization = profile.TargetedOrganization
+	file.RefreshToken = profile.RefreshToken
+	file.RoutingEndpoint = profile.RoutingEndpoint
+	file.TargetedSpace = profile.TargetedSpace
+	file.SSHOAuthClient = profile.SSHOAuthClient
+	file.SkipSSLValidation = profile.SkipSSLValidation
+	file.Target = profile.Target
+	file.UAAEndpoint = profile.UAAEndpoint
+	file.UAAGrantType = profile.UAAGrantType
+	file.UAAOAuthClient = profile.UAAOAuthClient
+	file.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+}
@@ -0,0 +1,121 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("SaveProfile and UseProfile", func() {
+		It("saves the target and switches back to it", func() {
+			config := Config{ConfigFile: JSONConfig{
+				Target:               "https://api.prod.com",
+				AccessToken:          "prod-token",
+				TargetedOrganization: Organization{GUID: "prod-org-guid", Name: "prod-org"},
+				TargetedSpace:        Space{GUID: "prod-space-guid", Name: "prod-space"},
+				Locale:               "fr-FR",
+			}}
+			config.SaveProfile("prod")
+
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.dev.com"})
+			config.SetAccessToken("dev-token")
+			config.SaveProfile("dev")
+			Expect(config.ProfileNames()).To(Equal([]string{"dev", "prod"}))
+
+			Expect(config.UseProfile("prod")).To(BeTrue())
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			Expect(config.AccessToken()).To(Equal("prod-token"))
+			Expect(config.TargetedOrganizationName()).To(Equal("prod-org"))
+			Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+			Expect(config.ConfigFile.Locale).To(Equal("fr-FR"))
+
+			Expect(config.UseProfile("dev")).To(BeTrue())
+			Expect(config.Target()).To(Equal("https://api.dev.com"))
+			Expect(config.TargetedOrganizationName()).To(BeEmpty())
+
+			Expect(config.UseProfile("missing")).To(BeFalse())
+			Expect(config.Target()).To(Equal("https://api.dev.com"))
+		})
+	})
+
+	When("CF_PROFILE is set", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 4,
+				"Target": "https://api.dev.com",
+				"AccessToken": "dev-token",
+				"Profiles": {
+					"prod": {
+						"Target": "https://api.prod.com",
+						"AccessToken": "prod-token",
+						"UAAOAuthClient": "cf",
+						"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"}
+					}
+				}
+			}`)
+			Expect(os.Setenv("CF_PROFILE", "prod")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+		})
+
+		It("uses the profile and writes changes to it only", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Profile()).To(Equal("prod"))
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			Expect(config.TargetedOrganizationName()).To(Equal("prod-org"))
+
+			config.SetAccessToken("new-prod-token")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			var written JSONConfig
+			Expect(json.Unmarshal(rawConfig, &written)).To(Succeed())
+			Expect(written.Target).To(Equal("https://api.dev.com"))
+			Expect(written.AccessToken).To(Equal("dev-token"))
+			Expect(written.Profiles["prod"].Target).To(Equal("https://api.prod.com"))
+			Expect(written.Profiles["prod"].AccessToken).To(Equal("new-prod-token"))
+		})
+
+		When("the profile does not exist yet", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			})
+
+			It("starts without a target and creates the profile when written", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Target()).To(BeEmpty())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+
+				config.SetTargetInformation(TargetInformationArgs{Api: "https://api.staging.com"})
+				Expect(config.WriteConfig()).To(Succeed())
+
+				reloaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded.Target()).To(Equal("https://api.staging.com"))
+				Expect(reloaded.ProfileNames()).To(Equal([]string{"prod", "staging"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package configv3
+
+import "strings"
+
+const (
+	// ProgressAuto means that progress is animated unless a CI environment is
+	// detected.
+	ProgressAuto ProgressStyle = ""
+
+	// ProgressAnimated means that progress is shown with animated bars.
+	ProgressAnimated ProgressStyle = "animated"
+
+	// ProgressPlain means that progress is shown as timestamped lines.
+	ProgressPlain ProgressStyle = "plain"
+)
+
+// ProgressStyle is how the progress of long-running operations, such as
+// uploads, is displayed.
+type ProgressStyle string
+
+// ProgressStyle returns how progress is displayed based off:
+//  1. The 'ProgressStyle' value in the .cf/config.json if set (animated/plain)
+//  2. ProgressPlain when running in a CI environment ($CI, $GITHUB_ACTIONS,
+//     $GITLAB_CI, $JENKINS_URL, $TEAMCITY_VERSION or $TF_BUILD)
+//  3. Defaults to ProgressAnimated
+func (config *Config) ProgressStyle() ProgressStyle {
+	switch style := ProgressStyle(strings.ToLower(config.ConfigFile.ProgressStyle)); style {
+	case ProgressAnimated, ProgressPlain:
+		return style
+	}
+
+	if config.IsCI() {
+		return ProgressPlain
+	}
+	return ProgressAnimated
+}
+
+// IsCI returns true when one of the environment variables set by common CI
+// systems is present.
+func (config *Config) IsCI() bool {
+	return isTrue(config.ENV.CI) ||
+		isTrue(config.ENV.GitHubActions) ||
+		isTrue(config.ENV.GitLabCI) ||
+		isTrue(config.ENV.TFBuild) ||
+		config.ENV.JenkinsURL != "" ||
+		config.ENV.TeamCityVersion != ""
+}
+
+// SetProgressStyle sets how progress is displayed. The 'auto' value clears
+// the setting so that it is detected again.
+func (config *Config) SetProgressStyle(style string) {
+	if style == "auto" {
+		config.ConfigFile.ProgressStyle = string(ProgressAuto)
+	} else {
+		config.ConfigFile.ProgressStyle = style
+	}
+}
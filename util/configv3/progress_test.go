@@ -0,0 +1,46 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	DescribeTable("ProgressStyle",
+		func(configVal string, env EnvOverride, expected ProgressStyle) {
+			config := new(Config)
+			config.ConfigFile.ProgressStyle = configVal
+			config.ENV = env
+
+			Expect(config.ProgressStyle()).To(Equal(expected))
+		},
+		Entry("config=plain", "plain", EnvOverride{}, ProgressPlain),
+		Entry("config=animated in CI", "animated", EnvOverride{CI: "true"}, ProgressAnimated),
+		Entry("$CI=true", "", EnvOverride{CI: "true"}, ProgressPlain),
+		Entry("$CI=false", "", EnvOverride{CI: "false"}, ProgressAnimated),
+		Entry("$GITHUB_ACTIONS=true", "", EnvOverride{GitHubActions: "true"}, ProgressPlain),
+		Entry("$GITLAB_CI=true", "", EnvOverride{GitLabCI: "true"}, ProgressPlain),
+		Entry("$JENKINS_URL set", "", EnvOverride{JenkinsURL: "https://jenkins.example.com"}, ProgressPlain),
+		Entry("$TEAMCITY_VERSION set", "", EnvOverride{TeamCityVersion: "2024.1"}, ProgressPlain),
+		Entry("$TF_BUILD=True", "", EnvOverride{TFBuild: "True"}, ProgressPlain),
+		Entry("nothing set", "", EnvOverride{}, ProgressAnimated),
+		Entry("invalid config value", "sparkly", EnvOverride{}, ProgressAnimated),
+	)
+
+	Describe("SetProgressStyle", func() {
+		It("sets the progress style field", func() {
+			config := new(Config)
+			config.SetProgressStyle("plain")
+			Expect(config.ConfigFile.ProgressStyle).To(Equal("plain"))
+		})
+
+		It("clears the progress style field for auto", func() {
+			config := new(Config)
+			config.ConfigFile.ProgressStyle = "plain"
+			config.SetProgressStyle("auto")
+			Expect(config.ConfigFile.ProgressStyle).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package configv3
+
+import "code.cloudfoundry.org/cli/util/requeststats"
+
+// RequestStats returns the collector of the API request statistics that are
+// summarized when the command ends, or nil when they are not. They are
+// summarized when:
+//  1. The --stats global flag is given
+//  2. The $CF_STATS environment variable is set to true
+func (config *Config) RequestStats() *requeststats.Collector {
+	if !config.Flags.Stats && !isTrue(config.ENV.CFStats) {
+		return nil
+	}
+
+	if config.requestStats == nil {
+		config.requestStats = requeststats.NewCollector()
+	}
+	return config.requestStats
+}
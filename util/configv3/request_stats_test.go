@@ -0,0 +1,35 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequestStats", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = new(Config)
+	})
+
+	It("returns nil when stats are not requested", func() {
+		Expect(config.RequestStats()).To(BeNil())
+	})
+
+	It("returns the same collector for the --stats flag", func() {
+		config.Flags.Stats = true
+		Expect(config.RequestStats()).ToNot(BeNil())
+		Expect(config.RequestStats()).To(BeIdenticalTo(config.RequestStats()))
+	})
+
+	It("returns a collector when $CF_STATS is true", func() {
+		config.ENV.CFStats = "1"
+		Expect(config.RequestStats()).ToNot(BeNil())
+	})
+
+	It("returns nil when $CF_STATS is false", func() {
+		config.ENV.CFStats = "false"
+		Expect(config.RequestStats()).To(BeNil())
+	})
+})
@@ -0,0 +1,10 @@
+package configv3
+
+import "path/filepath"
+
+// ResourceCacheDir returns the directory that push saves the SHA1s of the
+// files of the apps, and which of them the API already has, to, in the
+// config directory.
+func (config *Config) ResourceCacheDir() string {
+	return filepath.Join(configDirectory(), "resource-caches")
+}
@@ -0,0 +1,145 @@
+package configv3
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// TokenStorageFile means that the access and refresh tokens are written
+	// to .cf/config.json in plaintext.
+	TokenStorageFile TokenStorage = ""
+
+	// TokenStorageKeychain means that the access and refresh tokens are kept
+	// in the keychain of the OS, and .cf/config.json only refers to them.
+	TokenStorageKeychain TokenStorage = "keychain"
+)
+
+// storedTokenPrefix starts the values written to .cf/config.json in place of
+// tokens kept by a TokenStore. Tokens are bearer tokens, so they never start
+// with it.
+const storedTokenPrefix = "keychain:"
+
+// tokenService is the service that the tokens are kept under in the keychain.
+const tokenService = "cf-cli"
+
+// ErrTokenStoreNotSupported is returned when the OS keychain is not available
+// on this platform.
+var ErrTokenStoreNotSupported = errors.New("storing tokens in the keychain is not supported on this platform")
+
+// TokenStorage is where the access and refresh tokens are kept.
+type TokenStorage string
+
+// TokenStore keeps tokens outside of .cf/config.json.
+type TokenStore interface {
+	// Store keeps the token under the key, and returns the value that
+	// .cf/config.json keeps in place of the token.
+	Store(key string, token string) (string, error)
+
+	// Retrieve returns the token given the value that Store returned for it.
+	Retrieve(stored string) (string, error)
+}
+
+// TokenStorage returns where the access and refresh tokens are kept, based on
+// the 'TokenStorage' value in the .cf/config.json.
+func (config *Config) TokenStorage() TokenStorage {
+	if strings.ToLower(config.ConfigFile.TokenStorage) == string(TokenStorageKeychain) {
+		return TokenStorageKeychain
+	}
+	return TokenStorageFile
+}
+
+// SetTokenStorage sets where the access and refresh tokens are kept. The
+// 'file' value clears the setting, so that the tokens are written to the
+// .cf/config.json again the next time it is written.
+func (config *Config) SetTokenStorage(storage string) {
+	if storage == "file" {
+		config.ConfigFile.TokenStorage = string(TokenStorageFile)
+	} else {
+		config.ConfigFile.TokenStorage = storage
+	}
+}
+
+// SetTokenStore sets the store used for the tokens when they are kept in the
+// keychain. It defaults to the keychain of the OS.
+func (config *Config) SetTokenStore(store TokenStore) {
+	config.tokenStore = store
+}
+
+// token returns the token kept in .cf/config.json as the value, retrieving it
+// from the token store when the config only refers to it. A token that cannot
+// be retrieved is empty, so that the user is asked to log in again.
+func (config *Config) token(value string) string {
+	if !strings.HasPrefix(value, storedTokenPrefix) {
+		return value
+	}
+
+	if token, ok := config.retrievedTokens[value]; ok {
+		return token
+	}
+
+	token, err := config.getTokenStore().Retrieve(strings.TrimPrefix(value, storedTokenPrefix))
+	if err != nil {
+		token = ""
+	}
+
+	if config.retrievedTokens == nil {
+		config.retrievedTokens = map[string]string{}
+	}
+	config.retrievedTokens[value] = token
+	return token
+}
+
+// storeTokens returns the profile with its tokens replaced by the values to
+// write to .cf/config.json for the configured token storage. Tokens that are
+// already kept in the token store are not stored again, and tokens that cannot
+// be stored are written in plaintext.
+func (config *Config) storeTokens(name string, profile Profile) Profile {
+	profile.AccessToken = config.storeToken(tokenKey(name, "AccessToken"), profile.AccessToken)
+	profile.RefreshToken = config.storeToken(tokenKey(name, "RefreshToken"), profile.RefreshToken)
+	return profile
+}
+
+func (config *Config) storeToken(key string, value string) string {
+	if config.TokenStorage() == TokenStorageFile {
+		return config.token(value)
+	}
+
+	if value == "" || strings.HasPrefix(value, storedTokenPrefix) {
+		return value
+	}
+
+	stored, err := config.getTokenStore().Store(key, value)
+	if err != nil {
+		return value
+	}
+
+	stored = storedTokenPrefix + stored
+	if config.retrievedTokens == nil {
+		config.retrievedTokens = map[string]string{}
+	}
+	config.retrievedTokens[stored] = value
+	return stored
+}
+
+func (config *Config) getTokenStore() TokenStore {
+	if config.tokenStore == nil {
+		config.tokenStore = newKeychainTokenStore()
+	}
+	return config.tokenStore
+}
+
+// tokenKey returns the key a token of the named profile is kept under. The
+// top-level target of the config has no name.
+func tokenKey(profileName string, tokenName string) string {
+	if profileName == "" {
+		return tokenName
+	}
+	return profileName + "/" + tokenName
+}
+
+// tokenAccount returns the keychain account that the token of the key is kept
+// under, so that every config directory has its own tokens.
+func tokenAccount(key string) string {
+	return ConfigFilePath() + ":" + key
+}
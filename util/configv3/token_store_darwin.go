@@ -0,0 +1,44 @@
+//go:build darwin
+// +build darwin
+
+package configv3
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// keychainTokenStore keeps tokens as generic passwords in the login keychain
+// using the security tool.
+type keychainTokenStore struct{}
+
+func newKeychainTokenStore() TokenStore {
+	return keychainTokenStore{}
+}
+
+// Store adds or updates the password of the account of the key. The token is
+// passed on stdin so that it does not show in the process list.
+func (keychainTokenStore) Store(key string, token string) (string, error) {
+	account := tokenAccount(key)
+	command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		strconv.Quote(tokenService), strconv.Quote(account), strconv.Quote(token))
+
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(command)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("storing token in keychain: %s", bytes.TrimSpace(output))
+	}
+	return account, nil
+}
+
+func (keychainTokenStore) Retrieve(account string) (string, error) {
+	output, err := exec.Command("security", "find-generic-password", "-s", tokenService, "-a", account, "-w").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
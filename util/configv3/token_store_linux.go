@@ -0,0 +1,41 @@
+//go:build linux
+// +build linux
+
+package configv3
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// keychainTokenStore keeps tokens in the Secret Service, such as GNOME
+// Keyring or KWallet, using the secret-tool of libsecret.
+type keychainTokenStore struct{}
+
+func newKeychainTokenStore() TokenStore {
+	return keychainTokenStore{}
+}
+
+// Store replaces the secret of the account of the key. The token is passed on
+// stdin so that it does not show in the process list.
+func (keychainTokenStore) Store(key string, token string) (string, error) {
+	account := tokenAccount(key)
+
+	cmd := exec.Command("secret-tool", "store", "--label=Cloud Foundry CLI token", "service", tokenService, "account", account)
+	cmd.Stdin = strings.NewReader(token)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("storing token with secret-tool: %s", bytes.TrimSpace(output))
+	}
+	return account, nil
+}
+
+func (keychainTokenStore) Retrieve(account string) (string, error) {
+	output, err := exec.Command("secret-tool", "lookup", "service", tokenService, "account", account).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
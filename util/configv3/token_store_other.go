@@ -0,0 +1,20 @@
+//go:build !darwin && !linux && !windows
+// +build !darwin,!linux,!windows
+
+package configv3
+
+// unsupportedTokenStore is used on platforms without a supported keychain, so
+// that the tokens are written to .cf/config.json in plaintext.
+type unsupportedTokenStore struct{}
+
+func newKeychainTokenStore() TokenStore {
+	return unsupportedTokenStore{}
+}
+
+func (unsupportedTokenStore) Store(string, string) (string, error) {
+	return "", ErrTokenStoreNotSupported
+}
+
+func (unsupportedTokenStore) Retrieve(string) (string, error) {
+	return "", ErrTokenStoreNotSupported
+}
@@ -0,0 +1,163 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeTokenStore struct {
+	tokens    map[string]string
+	storeErr  error
+	storeKeys []string
+}
+
+func (store *fakeTokenStore) Store(key string, token string) (string, error) {
+	if store.storeErr != nil {
+		return "", store.storeErr
+	}
+	store.storeKeys = append(store.storeKeys, key)
+	store.tokens[key] = token
+	return key, nil
+}
+
+func (store *fakeTokenStore) Retrieve(stored string) (string, error) {
+	token, ok := store.tokens[stored]
+	if !ok {
+		return "", errors.New("no such token")
+	}
+	return token, nil
+}
+
+var _ = Describe("Token storage", func() {
+	var (
+		homeDir string
+		store   *fakeTokenStore
+		config  *Config
+	)
+
+	readWrittenConfig := func() JSONConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		var written JSONConfig
+		Expect(json.Unmarshal(rawConfig, &written)).To(Succeed())
+		return written
+	}
+
+	BeforeEach(func() {
+		homeDir = setup()
+		store = &fakeTokenStore{tokens: map[string]string{}}
+		config = &Config{ConfigFile: JSONConfig{
+			ConfigVersion: CurrentConfigVersion,
+			AccessToken:   "bearer access-token",
+			RefreshToken:  "refresh-token",
+			Profiles: map[string]Profile{
+				"prod": {AccessToken: "bearer prod-access-token"},
+			},
+		}}
+		config.SetTokenStore(store)
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	It("writes the tokens in plaintext by default", func() {
+		Expect(config.TokenStorage()).To(Equal(TokenStorageFile))
+		Expect(config.WriteConfig()).To(Succeed())
+
+		written := readWrittenConfig()
+		Expect(written.AccessToken).To(Equal("bearer access-token"))
+		Expect(written.TokenStorage).To(BeEmpty())
+		Expect(store.storeKeys).To(BeEmpty())
+	})
+
+	When("the tokens are kept in the keychain", func() {
+		BeforeEach(func() {
+			config.SetTokenStorage("keychain")
+		})
+
+		It("writes references to the tokens and reads the tokens back", func() {
+			Expect(config.TokenStorage()).To(Equal(TokenStorageKeychain))
+			Expect(config.WriteConfig()).To(Succeed())
+
+			written := readWrittenConfig()
+			Expect(written.TokenStorage).To(Equal("keychain"))
+			Expect(written.AccessToken).To(Equal("keychain:AccessToken"))
+			Expect(written.RefreshToken).To(Equal("keychain:RefreshToken"))
+			Expect(written.Profiles["prod"].AccessToken).To(Equal("keychain:prod/AccessToken"))
+			Expect(written.Profiles["prod"].RefreshToken).To(BeEmpty())
+			Expect(store.tokens).To(Equal(map[string]string{
+				"AccessToken":      "bearer access-token",
+				"RefreshToken":     "refresh-token",
+				"prod/AccessToken": "bearer prod-access-token",
+			}))
+
+			loaded, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			loaded.SetTokenStore(store)
+			Expect(loaded.AccessToken()).To(Equal("bearer access-token"))
+			Expect(loaded.RefreshToken()).To(Equal("refresh-token"))
+
+			store.storeKeys = nil
+			Expect(loaded.WriteConfig()).To(Succeed())
+			Expect(store.storeKeys).To(BeEmpty())
+		})
+
+		It("stores a new token when it changes", func() {
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config.SetAccessToken("bearer new-access-token")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(readWrittenConfig().AccessToken).To(Equal("keychain:AccessToken"))
+			Expect(store.tokens["AccessToken"]).To(Equal("bearer new-access-token"))
+		})
+
+		When("a token cannot be retrieved", func() {
+			BeforeEach(func() {
+				config.ConfigFile.AccessToken = "keychain:missing"
+			})
+
+			It("returns an empty token", func() {
+				Expect(config.AccessToken()).To(BeEmpty())
+			})
+		})
+
+		When("the tokens cannot be stored", func() {
+			BeforeEach(func() {
+				store.storeErr = errors.New("no keychain")
+			})
+
+			It("writes the tokens in plaintext", func() {
+				Expect(config.WriteConfig()).To(Succeed())
+				Expect(readWrittenConfig().AccessToken).To(Equal("bearer access-token"))
+			})
+		})
+
+		When("switching back to the config file", func() {
+			BeforeEach(func() {
+				Expect(config.WriteConfig()).To(Succeed())
+				loaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				loaded.SetTokenStore(store)
+				config = loaded
+				config.SetTokenStorage("file")
+			})
+
+			It("writes the tokens in plaintext again", func() {
+				Expect(config.WriteConfig()).To(Succeed())
+
+				written := readWrittenConfig()
+				Expect(written.TokenStorage).To(BeEmpty())
+				Expect(written.AccessToken).To(Equal("bearer access-token"))
+				Expect(written.Profiles["prod"].AccessToken).To(Equal("bearer prod-access-token"))
+			})
+		})
+	})
+})
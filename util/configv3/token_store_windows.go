@@ -0,0 +1,63 @@
+//go:build windows
+// +build windows
+
+package configv3
+
+import (
+	"encoding/base64"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// dpapiTokenStore encrypts tokens with the Data Protection API, so that only
+// the current Windows user can decrypt them. The encrypted token is kept in
+// .cf/config.json.
+type dpapiTokenStore struct{}
+
+func newKeychainTokenStore() TokenStore {
+	return dpapiTokenStore{}
+}
+
+func (dpapiTokenStore) Store(_ string, token string) (string, error) {
+	encrypted, err := cryptData([]byte(token), true)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func (dpapiTokenStore) Retrieve(stored string) (string, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(stored)
+	if err != nil {
+		return "", err
+	}
+
+	decrypted, err := cryptData(encrypted, false)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
+func cryptData(data []byte, protect bool) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	in := windows.DataBlob{Size: uint32(len(data)), Data: &data[0]}
+	var out windows.DataBlob
+
+	var err error
+	if protect {
+		err = windows.CryptProtectData(&in, nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out)
+	} else {
+		err = windows.CryptUnprotectData(&in, nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer windows.LocalFree(windows.Handle(unsafe.Pointer(out.Data)))
+
+	return append([]byte(nil), unsafe.Slice(out.Data, out.Size)...), nil
+}
@@ -11,9 +11,10 @@ import (
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. While a profile is selected with $CF_PROFILE, the target is
+// written to that profile.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	rawConfig, err := json.MarshalIndent(c.fileToWrite(), "", "  ")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,121 @@
+// Package credentialhelper runs the external command that is configured to
+// supply the credentials that would otherwise be prompted for, such as a
+// script that reads them from a password manager, so that they do not have
+// to be passed as arguments or environment variables.
+//
+// The command line is run by the shell, /bin/sh or cmd.exe on Windows, so it
+// can quote its arguments and use the same syntax as on a command prompt. The
+// "get" argument is appended to it, as git does for its credential helpers,
+// so a program that takes other arguments is best configured through a
+// wrapper script that ignores or consumes it. The request is given on stdin
+// as key=value lines:
+//
+//	purpose=login
+//	target=https://api.example.com
+//	username=admin
+//
+// It answers on its stdout with the same kind of lines, "username" and
+// "password" being read. Its stderr is passed through so that it can ask to
+// be unlocked. A helper that fails or answers no password fails the command
+// that asked for the credentials, rather than that command prompting for them.
+package credentialhelper
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// PurposeLogin asks for the username and password of a user of the API.
+	PurposeLogin = "login"
+	// PurposeClient asks for the ID and secret of a client of the API.
+	PurposeClient = "client"
+	// PurposeServiceBroker asks for the password of a service broker.
+	PurposeServiceBroker = "service-broker"
+)
+
+// Request describes the credentials that are asked for. Only the non-empty
+// fields are sent to the helper.
+type Request struct {
+	Purpose string
+	// Target is the URL that the credentials are used for.
+	Target string
+	// Name is the name of what the credentials are for, e.g. a broker.
+	Name     string
+	Username string
+}
+
+// Credentials are what the helper answered. A field it did not answer is
+// empty.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Helper runs a credential helper command line.
+type Helper struct {
+	CommandLine string
+	// Stderr receives the stderr of the helper. It defaults to os.Stderr.
+	Stderr io.Writer
+}
+
+// New returns a Helper for the command line.
+func New(commandLine string) Helper {
+	return Helper{CommandLine: commandLine, Stderr: os.Stderr}
+}
+
+// Get runs the helper for the request and returns the credentials it
+// answered with. It fails when the helper fails or answers no password.
+func (helper Helper) Get(request Request) (Credentials, error) {
+	if strings.TrimSpace(helper.CommandLine) == "" {
+		return Credentials{}, errors.New("no credential helper is configured")
+	}
+
+	var input bytes.Buffer
+	for _, field := range [][2]string{
+		{"purpose", request.Purpose},
+		{"target", request.Target},
+		{"name", request.Name},
+		{"username", request.Username},
+	} {
+		if field[1] != "" {
+			fmt.Fprintf(&input, "%s=%s\n", field[0], field[1])
+		}
+	}
+
+	var output bytes.Buffer
+	cmd := shellCommand(helper.CommandLine, "get")
+	cmd.Stdin = &input
+	cmd.Stdout = &output
+	cmd.Stderr = helper.Stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("credential helper %s failed: %s", helper.CommandLine, err)
+	}
+
+	var credentials Credentials
+	scanner := bufio.NewScanner(&output)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimRight(scanner.Text(), "\r"), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "username":
+			credentials.Username = value
+		case "password":
+			credentials.Password = value
+		}
+	}
+
+	if credentials.Password == "" {
+		return Credentials{}, fmt.Errorf("credential helper %s answered no password", helper.CommandLine)
+	}
+	return credentials, nil
+}
@@ -0,0 +1,13 @@
+package credentialhelper_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCredentialhelper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Credentialhelper Suite")
+}
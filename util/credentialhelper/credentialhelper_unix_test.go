@@ -0,0 +1,115 @@
+//go:build !windows
+// +build !windows
+
+package credentialhelper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Helper", func() {
+	var (
+		dir    string
+		stderr *Buffer
+	)
+
+	writeHelper := func(script string) string {
+		path := filepath.Join(dir, "helper")
+		Expect(ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script), 0700)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "credential-helper")
+		Expect(err).NotTo(HaveOccurred())
+		stderr = NewBuffer()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Get", func() {
+		It("sends the request on stdin and reads the credentials from stdout", func() {
+			requestFile := filepath.Join(dir, "request")
+			helper := Helper{
+				CommandLine: writeHelper(`echo "$@" > `+requestFile+`.args
+cat > `+requestFile+`
+echo "unrelated line"
+echo "username=admin"
+echo "password=pa=ss word"
+echo "unlocked" >&2
+`) + " --vault cf",
+				Stderr: stderr,
+			}
+
+			credentials, err := helper.Get(Request{
+				Purpose:  PurposeLogin,
+				Target:   "https://api.example.com",
+				Username: "someone",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(Credentials{Username: "admin", Password: "pa=ss word"}))
+			Expect(stderr).To(Say("unlocked"))
+
+			request, err := ioutil.ReadFile(requestFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(request)).To(Equal("purpose=login\ntarget=https://api.example.com\nusername=someone\n"))
+
+			args, err := ioutil.ReadFile(requestFile + ".args")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(args)).To(Equal("--vault cf get\n"))
+		})
+
+		It("runs the command line through the shell", func() {
+			argsFile := filepath.Join(dir, "args")
+			helper := Helper{
+				CommandLine: writeHelper(`for arg in "$@"; do echo "$arg"; done > `+argsFile+`
+echo password=secret
+`) + ` --item "cf api" --field 'pass word' "$HOME" 2>/dev/null`,
+				Stderr: stderr,
+			}
+
+			_, err := helper.Get(Request{Purpose: PurposeLogin})
+			Expect(err).NotTo(HaveOccurred())
+
+			args, err := ioutil.ReadFile(argsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(args)).To(Equal("--item\ncf api\n--field\npass word\n" + os.Getenv("HOME") + "\nget\n"))
+		})
+
+		When("the helper fails", func() {
+			It("returns an error", func() {
+				helper := Helper{CommandLine: writeHelper("exit 3\n"), Stderr: stderr}
+
+				_, err := helper.Get(Request{Purpose: PurposeServiceBroker})
+				Expect(err).To(MatchError(ContainSubstring("credential helper " + filepath.Join(dir, "helper") + " failed: exit status 3")))
+			})
+		})
+
+		When("the helper answers no password", func() {
+			It("returns an error", func() {
+				helper := Helper{CommandLine: writeHelper("echo username=admin\n"), Stderr: stderr}
+
+				_, err := helper.Get(Request{Purpose: PurposeLogin})
+				Expect(err).To(MatchError(ContainSubstring("answered no password")))
+			})
+		})
+
+		When("no command line is configured", func() {
+			It("returns an error", func() {
+				_, err := Helper{}.Get(Request{})
+				Expect(err).To(MatchError("no credential helper is configured"))
+			})
+		})
+	})
+})
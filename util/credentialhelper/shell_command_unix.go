@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package credentialhelper
+
+import "os/exec"
+
+// shellCommand runs the command line with /bin/sh, with the args appended as
+// separate arguments, in the way git runs its credential helpers.
+func shellCommand(commandLine string, args ...string) *exec.Cmd {
+	return exec.Command("/bin/sh", append([]string{"-c", commandLine + ` "$@"`, commandLine}, args...)...)
+}
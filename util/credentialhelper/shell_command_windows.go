@@ -0,0 +1,21 @@
+//go:build windows
+// +build windows
+
+package credentialhelper
+
+import (
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// shellCommand runs the command line with cmd.exe, with the args appended.
+// The command line is given to cmd.exe as it is, since its quoting differs
+// from the one Go uses for the arguments of a process.
+func shellCommand(commandLine string, args ...string) *exec.Cmd {
+	cmd := exec.Command("cmd.exe")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CmdLine: `cmd.exe /S /C "` + strings.Join(append([]string{commandLine}, args...), " ") + `"`,
+	}
+	return cmd
+}
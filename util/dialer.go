@@ -0,0 +1,240 @@
+package util
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// IPFamily is the address family that connections are attempted over first
+// when a host resolves to both IPv4 and IPv6 addresses.
+type IPFamily string
+
+const (
+	// IPFamilyAny tries addresses in the order they were resolved in.
+	IPFamilyAny IPFamily = ""
+	// IPFamilyIPv4 tries IPv4 addresses before IPv6 addresses.
+	IPFamilyIPv4 IPFamily = "ipv4"
+	// IPFamilyIPv6 tries IPv6 addresses before IPv4 addresses.
+	IPFamilyIPv6 IPFamily = "ipv6"
+)
+
+// DefaultFallbackDelay is how long the dialer waits for a connection over the
+// preferred address family before also trying the other one.
+const DefaultFallbackDelay = 300 * time.Millisecond
+
+// Dialer makes TCP connections to hosts that may resolve to both IPv4 and
+// IPv6 addresses. Addresses of the preferred family are tried first, and when
+// none of them has connected within the fallback delay the addresses of the
+// other family are raced against them ("Happy Eyeballs", RFC 8305).
+type Dialer struct {
+	// Timeout is the maximum amount of time a dial, including name
+	// resolution, will wait for a connection to complete.
+	Timeout time.Duration
+
+	// KeepAlive is the interval between keep-alive probes on connections.
+	KeepAlive time.Duration
+
+	// PreferredFamily is the address family that is tried first.
+	PreferredFamily IPFamily
+
+	// FallbackDelay is how long to wait before trying the other family.
+	FallbackDelay time.Duration
+
+	// ResolveOverrides are the addresses to use for specific hosts and ports
+	// instead of resolving them.
+	ResolveOverrides []ResolveOverride
+
+	// LookupIPAddr resolves host names. It defaults to the system resolver.
+	LookupIPAddr func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewDialer returns a Dialer with the given dial timeout, address family
+// preference and resolve overrides.
+func NewDialer(timeout time.Duration, preferredFamily IPFamily, resolveOverrides []ResolveOverride) *Dialer {
+	return &Dialer{
+		Timeout:          timeout,
+		KeepAlive:        30 * time.Second,
+		PreferredFamily:  preferredFamily,
+		FallbackDelay:    DefaultFallbackDelay,
+		ResolveOverrides: resolveOverrides,
+	}
+}
+
+// Dial connects to the address on the named network.
+func (dialer *Dialer) Dial(network string, address string) (net.Conn, error) {
+	return dialer.DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to the address on the named network using the
+// provided context.
+func (dialer *Dialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	netDialer := &net.Dialer{
+		Timeout:       dialer.Timeout,
+		KeepAlive:     dialer.KeepAlive,
+		FallbackDelay: dialer.FallbackDelay,
+	}
+
+	if network != "tcp" {
+		return netDialer.DialContext(ctx, network, address)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if dialer.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dialer.Timeout)
+		defer cancel()
+	}
+
+	addrs := dialer.overriddenAddrs(host, port)
+	if len(addrs) == 0 {
+		if dialer.PreferredFamily == IPFamilyAny || net.ParseIP(host) != nil {
+			return netDialer.DialContext(ctx, network, address)
+		}
+
+		lookupIPAddr := dialer.LookupIPAddr
+		if lookupIPAddr == nil {
+			lookupIPAddr = net.DefaultResolver.LookupIPAddr
+		}
+		addrs, err = lookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	primaries, fallbacks := partitionByFamily(addrs, dialer.PreferredFamily)
+	if len(primaries) == 0 {
+		primaries, fallbacks = fallbacks, nil
+	}
+	if len(fallbacks) == 0 {
+		return dialSerial(ctx, netDialer, primaries, port)
+	}
+	return dialer.dialParallel(ctx, netDialer, primaries, fallbacks, port)
+}
+
+// overriddenAddrs returns the addresses of the resolve overrides for the
+// host and port.
+func (dialer *Dialer) overriddenAddrs(host string, port string) []net.IPAddr {
+	var addrs []net.IPAddr
+	for _, override := range dialer.ResolveOverrides {
+		if override.Matches(host, port) {
+			for _, address := range override.Addresses {
+				addrs = append(addrs, net.IPAddr{IP: address})
+			}
+		}
+	}
+	return addrs
+}
+
+type dialResult struct {
+	conn    net.Conn
+	err     error
+	primary bool
+}
+
+// dialParallel tries the primary addresses, starting on the fallback
+// addresses when the primaries have failed or the fallback delay has passed,
+// and returns the first connection that is made.
+func (dialer *Dialer) dialParallel(ctx context.Context, netDialer *net.Dialer, primaries []net.IPAddr, fallbacks []net.IPAddr, port string) (net.Conn, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	returned := make(chan struct{})
+	defer close(returned)
+
+	results := make(chan dialResult)
+	startDialing := func(addrs []net.IPAddr, primary bool) {
+		go func() {
+			conn, err := dialSerial(ctx, netDialer, addrs, port)
+			select {
+			case results <- dialResult{conn: conn, err: err, primary: primary}:
+			case <-returned:
+				if conn != nil {
+					conn.Close()
+				}
+			}
+		}()
+	}
+
+	startDialing(primaries, true)
+	fallbackTimer := time.NewTimer(dialer.FallbackDelay)
+	defer fallbackTimer.Stop()
+
+	var (
+		primaryErr      error
+		fallbackStarted bool
+		pending         = 1
+	)
+	for {
+		select {
+		case <-fallbackTimer.C:
+			if !fallbackStarted {
+				fallbackStarted = true
+				pending++
+				startDialing(fallbacks, false)
+			}
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				return result.conn, nil
+			}
+			if result.primary {
+				primaryErr = result.err
+			}
+			if !fallbackStarted {
+				fallbackStarted = true
+				pending++
+				startDialing(fallbacks, false)
+			}
+			if pending == 0 {
+				return nil, primaryErr
+			}
+		}
+	}
+}
+
+// dialSerial tries the addresses one at a time and returns the first
+// connection that is made, or the first error when they all fail.
+func dialSerial(ctx context.Context, netDialer *net.Dialer, addrs []net.IPAddr, port string) (net.Conn, error) {
+	var firstErr error
+	for _, addr := range addrs {
+		conn, err := netDialer.DialContext(ctx, "tcp", net.JoinHostPort(addr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, firstErr
+}
+
+// partitionByFamily splits the addresses into the ones of the preferred
+// family and the others. Without a preference, the family of the first
+// address is preferred.
+func partitionByFamily(addrs []net.IPAddr, family IPFamily) ([]net.IPAddr, []net.IPAddr) {
+	if family == IPFamilyAny && len(addrs) > 0 {
+		family = IPFamilyIPv6
+		if addrs[0].IP.To4() != nil {
+			family = IPFamilyIPv4
+		}
+	}
+
+	var preferred, others []net.IPAddr
+	for _, addr := range addrs {
+		isIPv4 := addr.IP.To4() != nil
+		if isIPv4 == (family == IPFamilyIPv4) {
+			preferred = append(preferred, addr)
+		} else {
+			others = append(others, addr)
+		}
+	}
+	return preferred, others
+}
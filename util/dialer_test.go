@@ -0,0 +1,194 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	. "code.cloudfoundry.org/cli/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dialer", func() {
+	var (
+		dialer     *Dialer
+		listener   net.Listener
+		port       string
+		lookedUp   []string
+		resolvedTo []net.IPAddr
+		lookupErr  error
+		host       string
+
+		conn net.Conn
+		err  error
+	)
+
+	BeforeEach(func() {
+		listener, err = net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		_, port, err = net.SplitHostPort(listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+
+		host = "some-host"
+		lookedUp = nil
+		resolvedTo = []net.IPAddr{{IP: net.ParseIP("::1")}, {IP: net.ParseIP("127.0.0.1")}}
+		lookupErr = nil
+
+		dialer = NewDialer(0, IPFamilyIPv4, nil)
+		dialer.LookupIPAddr = func(_ context.Context, host string) ([]net.IPAddr, error) {
+			lookedUp = append(lookedUp, host)
+			return resolvedTo, lookupErr
+		}
+	})
+
+	AfterEach(func() {
+		listener.Close()
+		if conn != nil {
+			conn.Close()
+		}
+	})
+
+	JustBeforeEach(func() {
+		conn, err = dialer.Dial("tcp", net.JoinHostPort(host, port))
+	})
+
+	When("IPv4 is preferred", func() {
+		It("connects to the IPv4 address", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(Equal([]string{"some-host"}))
+			Expect(conn.RemoteAddr().String()).To(Equal(listener.Addr().String()))
+		})
+	})
+
+	When("IPv6 is preferred", func() {
+		var ipv6Listener net.Listener
+
+		BeforeEach(func() {
+			dialer.PreferredFamily = IPFamilyIPv6
+			ipv6Listener = nil
+		})
+
+		AfterEach(func() {
+			if ipv6Listener != nil {
+				ipv6Listener.Close()
+			}
+		})
+
+		When("the IPv6 address accepts connections", func() {
+			BeforeEach(func() {
+				var listenErr error
+				ipv6Listener, listenErr = net.Listen("tcp6", net.JoinHostPort("::1", port))
+				if listenErr != nil {
+					Skip("IPv6 loopback is not available")
+				}
+			})
+
+			It("connects to the IPv6 address", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(conn.RemoteAddr().String()).To(Equal(ipv6Listener.Addr().String()))
+			})
+		})
+
+		When("the IPv6 address does not accept connections", func() {
+			It("falls back to the IPv4 address", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(conn.RemoteAddr().String()).To(Equal(listener.Addr().String()))
+			})
+		})
+
+		When("the host only has IPv4 addresses", func() {
+			BeforeEach(func() {
+				resolvedTo = []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}
+			})
+
+			It("connects to the IPv4 address", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(conn.RemoteAddr().String()).To(Equal(listener.Addr().String()))
+			})
+		})
+	})
+
+	When("none of the addresses accept connections", func() {
+		BeforeEach(func() {
+			listener.Close()
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("resolving the host fails", func() {
+		BeforeEach(func() {
+			lookupErr = errors.New("no such host")
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("no such host"))
+		})
+	})
+
+	When("no address family is preferred", func() {
+		BeforeEach(func() {
+			dialer.PreferredFamily = IPFamilyAny
+			host = "localhost"
+		})
+
+		It("leaves resolving the host to the system dialer", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(BeEmpty())
+		})
+	})
+
+	When("a resolve override matches the host and port", func() {
+		BeforeEach(func() {
+			dialer.ResolveOverrides = []ResolveOverride{
+				{Host: "other-host", Port: port, Addresses: []net.IP{net.ParseIP("10.0.0.1")}},
+				{Host: "SOME-HOST", Port: port, Addresses: []net.IP{net.ParseIP("127.0.0.1")}},
+			}
+		})
+
+		It("connects to the overridden address without resolving the host", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(BeEmpty())
+			Expect(conn.RemoteAddr().String()).To(Equal(listener.Addr().String()))
+		})
+
+		When("no address family is preferred", func() {
+			BeforeEach(func() {
+				dialer.PreferredFamily = IPFamilyAny
+			})
+
+			It("still connects to the overridden address", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lookedUp).To(BeEmpty())
+				Expect(conn.RemoteAddr().String()).To(Equal(listener.Addr().String()))
+			})
+		})
+	})
+
+	When("a resolve override matches the host on a different port", func() {
+		BeforeEach(func() {
+			dialer.ResolveOverrides = []ResolveOverride{
+				{Host: "some-host", Port: "1", Addresses: []net.IP{net.ParseIP("10.0.0.1")}},
+			}
+		})
+
+		It("resolves the host", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(Equal([]string{"some-host"}))
+		})
+	})
+
+	When("the address is an IP address", func() {
+		BeforeEach(func() {
+			host = "127.0.0.1"
+		})
+
+		It("connects without resolving it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookedUp).To(BeEmpty())
+		})
+	})
+})
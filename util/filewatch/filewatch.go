@@ -0,0 +1,164 @@
+// Package filewatch reports changes to the files under a set of paths by
+// polling them, so that it works the same on every platform and file system.
+package filewatch
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// Watcher polls paths for added, removed and modified files.
+type Watcher struct {
+	// Interval is the time between two polls.
+	Interval time.Duration
+	// Debounce is how long no file must change before the changes are sent.
+	// When it is 0 they are sent after the first poll without a change.
+	Debounce time.Duration
+
+	// IgnoreFileName is the name of a gitignore-style file at the root of a
+	// watched directory listing the files to leave out, e.g. ".cfignore".
+	IgnoreFileName string
+	// IgnoreLines are patterns left out in every watched directory.
+	IgnoreLines []string
+}
+
+// NewWatcher returns a Watcher that polls every interval.
+func NewWatcher(interval time.Duration) Watcher {
+	return Watcher{Interval: interval}
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+	mode    os.FileMode
+}
+
+type snapshot map[string]fileState
+
+// Watch takes a first snapshot of paths, which are directories or files, and
+// then polls them until stop is closed. Once files have changed and none has
+// changed for the debounce time, it sends the sorted paths of the changed
+// files.
+// The returned channel is closed when the watcher stops.
+func (w Watcher) Watch(paths []string, stop <-chan struct{}) (<-chan []string, error) {
+	previous, err := w.snapshot(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan []string)
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(w.Interval)
+		defer ticker.Stop()
+
+		pending := map[string]bool{}
+		var lastChange time.Time
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			current, err := w.snapshot(paths)
+			if err != nil {
+				continue
+			}
+
+			changed := diff(previous, current)
+			previous = current
+			for _, path := range changed {
+				pending[path] = true
+			}
+			if len(changed) > 0 {
+				lastChange = time.Now()
+				continue
+			}
+
+			if len(pending) == 0 || time.Since(lastChange) < w.Debounce {
+				continue
+			}
+
+			select {
+			case changes <- sortedKeys(pending):
+				pending = map[string]bool{}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func (w Watcher) snapshot(paths []string) (snapshot, error) {
+	files := snapshot{}
+	for _, root := range paths {
+		matcher, err := w.ignoreMatcher(root)
+		if err != nil {
+			return nil, err
+		}
+
+		err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if relPath, relErr := filepath.Rel(root, path); relErr == nil && relPath != "." && matcher.MatchesPath(filepath.ToSlash(relPath)) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !info.IsDir() {
+				files[path] = fileState{modTime: info.ModTime(), size: info.Size(), mode: info.Mode()}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (w Watcher) ignoreMatcher(root string) (*ignore.GitIgnore, error) {
+	if w.IgnoreFileName != "" {
+		ignoreFile := filepath.Join(root, w.IgnoreFileName)
+		if _, err := os.Stat(ignoreFile); err == nil {
+			return ignore.CompileIgnoreFileAndLines(ignoreFile, w.IgnoreLines...)
+		}
+	}
+	return ignore.CompileIgnoreLines(w.IgnoreLines...)
+}
+
+func diff(previous snapshot, current snapshot) []string {
+	var changed []string
+	for path, state := range current {
+		if previousState, ok := previous[path]; !ok || previousState != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,100 @@
+package filewatch_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/filewatch"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watcher", func() {
+	var (
+		dir     string
+		watcher Watcher
+		stop    chan struct{}
+		changes <-chan []string
+	)
+
+	writeFile := func(name string, content string) {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "filewatch")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile("main.go", "package main")
+		writeFile("removed.txt", "soon gone")
+		writeFile(".cfignore", "tmp/\n")
+
+		watcher = NewWatcher(10 * time.Millisecond)
+		watcher.IgnoreFileName = ".cfignore"
+		watcher.IgnoreLines = []string{".git"}
+		stop = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		changes, err = watcher.Watch([]string{dir}, stop)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		close(stop)
+		Eventually(changes).Should(BeClosed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("sends the added, modified and removed files once they settle", func() {
+		writeFile("main.go", "package main // changed")
+		writeFile("pkg/new.go", "package pkg")
+		Expect(os.Remove(filepath.Join(dir, "removed.txt"))).To(Succeed())
+
+		Eventually(changes).Should(Receive(Equal([]string{
+			filepath.Join(dir, "main.go"),
+			filepath.Join(dir, "pkg", "new.go"),
+			filepath.Join(dir, "removed.txt"),
+		})))
+	})
+
+	It("leaves out the ignored files", func() {
+		writeFile("tmp/build.log", "ignored by .cfignore")
+		writeFile(".git/HEAD", "ignored by the ignore lines")
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+
+		writeFile("main.go", "package main // changed again")
+		Eventually(changes).Should(Receive(Equal([]string{filepath.Join(dir, "main.go")})))
+	})
+
+	When("a debounce time is set", func() {
+		BeforeEach(func() {
+			watcher.Debounce = 300 * time.Millisecond
+		})
+
+		It("waits until no file has changed for that long", func() {
+			writeFile("main.go", "package main // changed")
+			Consistently(changes, 150*time.Millisecond).ShouldNot(Receive())
+
+			writeFile("other.go", "package main")
+			Consistently(changes, 150*time.Millisecond).ShouldNot(Receive())
+
+			Eventually(changes).Should(Receive(Equal([]string{
+				filepath.Join(dir, "main.go"),
+				filepath.Join(dir, "other.go"),
+			})))
+		})
+	})
+
+	It("returns an error when a path does not exist", func() {
+		_, err := watcher.Watch([]string{filepath.Join(dir, "missing")}, stop)
+		Expect(err).To(HaveOccurred())
+	})
+})
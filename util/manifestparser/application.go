@@ -17,6 +17,7 @@ type Docker struct {
 // struct.
 type Application struct {
 	Name                    string                   `yaml:"name"`
+	DependsOn               []string                 `yaml:"depends_on,omitempty"`
 	DiskQuota               string                   `yaml:"disk-quota,omitempty"`
 	Docker                  *Docker                  `yaml:"docker,omitempty"`
 	HealthCheckType         constant.HealthCheckType `yaml:"health-check-type,omitempty"`
@@ -39,6 +40,26 @@ func (application Application) HasBuildpacks() bool {
 	return ok
 }
 
+// Routes returns the URLs of the routes the application declares.
+func (application Application) Routes() []string {
+	rawRoutes, _ := application.RemainingManifestFields["routes"].([]interface{})
+
+	var routes []string
+	for _, rawRoute := range rawRoutes {
+		var route interface{}
+		switch r := rawRoute.(type) {
+		case map[interface{}]interface{}:
+			route = r["route"]
+		case map[string]interface{}:
+			route = r["route"]
+		}
+		if url, ok := route.(string); ok && url != "" {
+			routes = append(routes, url)
+		}
+	}
+	return routes
+}
+
 func (application *Application) SetBuildpacks(buildpacks []string) {
 	if application.RemainingManifestFields == nil {
 		application.RemainingManifestFields = map[string]interface{}{}
@@ -534,4 +534,25 @@ log-rate-limit-per-second: 5K
 			})
 		})
 	})
+
+	Describe("Routes", func() {
+		It("returns the URLs of the declared routes", func() {
+			var app Application
+			err := yaml.Unmarshal([]byte(`
+name: some-app
+routes:
+- route: some-app.example.com
+- route: tcp.example.com:1234
+  protocol: tcp
+- protocol: http2
+`), &app)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(app.Routes()).To(Equal([]string{"some-app.example.com", "tcp.example.com:1234"}))
+		})
+
+		It("returns nothing when the app declares no routes", func() {
+			Expect(Application{}.Routes()).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,23 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DependencyNotFoundError struct {
+	App        string
+	Dependency string
+}
+
+func (e DependencyNotFoundError) Error() string {
+	return fmt.Sprintf("App '%s' depends on '%s', which is not in the manifest", e.App, e.Dependency)
+}
+
+type DependencyCycleError struct {
+	Apps []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("The depends_on of apps %s form a cycle", strings.Join(e.Apps, ", "))
+}
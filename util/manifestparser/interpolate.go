@@ -0,0 +1,48 @@
+package manifestparser
+
+import (
+	"io/ioutil"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+// Interpolate replaces the ((NAME)) variables of the raw YAML with the values
+// of the vars files, read in order, and then of vars, so that later files and
+// vars override earlier ones. It fails when a variable has no value. It is
+// what push and apply-manifest use for manifests, and works for any YAML,
+// such as the parameters of a service instance.
+func Interpolate(rawYAML []byte, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error) {
+	var err error
+	tpl := template.NewTemplate(rawYAML)
+	fileVars := template.StaticVariables{}
+
+	for _, path := range pathsToVarsFiles {
+		rawVarsFile, ioerr := ioutil.ReadFile(path)
+		if ioerr != nil {
+			return nil, ioerr
+		}
+
+		var sv template.StaticVariables
+
+		err = yaml.Unmarshal(rawVarsFile, &sv)
+		if err != nil {
+			return nil, InvalidYAMLError{Err: err}
+		}
+
+		for k, v := range sv {
+			fileVars[k] = v
+		}
+	}
+
+	for _, kv := range vars {
+		fileVars[kv.Name] = kv.Value
+	}
+
+	rawYAML, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	if err != nil {
+		return nil, InterpolationError{Err: err}
+	}
+
+	return rawYAML, nil
+}
@@ -0,0 +1,54 @@
+package manifestparser_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interpolate", func() {
+	var (
+		dir          string
+		varsFilePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "interpolate")
+		Expect(err).ToNot(HaveOccurred())
+
+		varsFilePath = filepath.Join(dir, "vars.yml")
+		Expect(os.WriteFile(varsFilePath, []byte("plan: small\nsize: 10\n"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("replaces the variables of any YAML, with vars overriding the vars files", func() {
+		interpolated, err := Interpolate(
+			[]byte("plan: ((plan))\nparameters:\n  size: ((size))\n"),
+			[]string{varsFilePath},
+			[]template.VarKV{{Name: "plan", Value: "large"}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(interpolated)).To(Equal("parameters:\n  size: 10\nplan: large\n"))
+	})
+
+	It("fails when a variable has no value", func() {
+		_, err := Interpolate([]byte("plan: ((missing))\n"), nil, nil)
+		Expect(err).To(BeAssignableToTypeOf(InterpolationError{}))
+		Expect(err).To(MatchError(ContainSubstring("missing")))
+	})
+
+	It("fails when a vars file is not valid YAML", func() {
+		Expect(os.WriteFile(varsFilePath, []byte("- not\n: valid"), 0600)).To(Succeed())
+
+		_, err := Interpolate([]byte("plan: ((plan))\n"), []string{varsFilePath}, nil)
+		Expect(err).To(BeAssignableToTypeOf(InvalidYAMLError{}))
+	})
+})
@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type InvalidOverlayError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidOverlayError) Error() string {
+	return fmt.Sprintf("The option --overlay expects a valid YAML manifest, %s is not. %s", e.Path, e.Err)
+}
@@ -1,9 +1,13 @@
 package manifestparser
 
 type Manifest struct {
-	Applications   []Application `yaml:"applications"`
-	Version        int           `yaml:"version,omitempty"`
-	PathToManifest string        `yaml:"-"`
+	Applications []Application `yaml:"applications"`
+	// Services are the service instances the manifest requires in the space.
+	// They are checked by 'services --diff' and are not sent to the Cloud
+	// Controller.
+	Services       []ServiceRequirement `yaml:"services,omitempty"`
+	Version        int                  `yaml:"version,omitempty"`
+	PathToManifest string               `yaml:"-"`
 }
 
 func (m Manifest) AppNames() []string {
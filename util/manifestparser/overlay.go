@@ -0,0 +1,139 @@
+package manifestparser
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// listMergeKeys lists the manifest keys whose lists are merged entry by entry
+// rather than replaced, along with the field identifying an entry.
+var listMergeKeys = map[string]string{
+	"applications": "name",
+	"processes":    "type",
+	"sidecars":     "name",
+	"routes":       "route",
+}
+
+// MergeManifests layers the overlays, in order, over the base manifest using
+// the following rules:
+//
+//   - Maps are merged key by key and values from the overlay win.
+//   - A key set to null (~) in an overlay is removed from the result.
+//   - applications are matched by name, processes by type, sidecars by name
+//     and routes by route; matching entries are merged and the others are
+//     appended.
+//   - Any other list (buildpacks, services, ...) is replaced by the overlay's.
+//
+// Variables are not interpolated, so overlays may contain ((placeholders))
+// that are resolved in the merged result.
+func MergeManifests(base []byte, overlays ...[]byte) ([]byte, error) {
+	var merged yaml.MapSlice
+	err := yaml.Unmarshal(base, &merged)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rawOverlay := range overlays {
+		var overlay yaml.MapSlice
+		err = yaml.Unmarshal(rawOverlay, &overlay)
+		if err != nil {
+			return nil, err
+		}
+
+		merged = mergeMaps(merged, overlay)
+	}
+
+	return yaml.Marshal(merged)
+}
+
+func mergeMaps(base yaml.MapSlice, overlay yaml.MapSlice) yaml.MapSlice {
+	result := append(yaml.MapSlice{}, base...)
+
+	for _, item := range overlay {
+		index := indexOfKey(result, item.Key)
+
+		switch {
+		case item.Value == nil:
+			if index >= 0 {
+				result = append(result[:index], result[index+1:]...)
+			}
+		case index < 0:
+			result = append(result, item)
+		default:
+			result[index].Value = mergeValues(fmt.Sprint(item.Key), result[index].Value, item.Value)
+		}
+	}
+
+	return result
+}
+
+func mergeValues(key string, base interface{}, overlay interface{}) interface{} {
+	switch overlayValue := overlay.(type) {
+	case yaml.MapSlice:
+		if baseValue, ok := base.(yaml.MapSlice); ok {
+			return mergeMaps(baseValue, overlayValue)
+		}
+	case []interface{}:
+		mergeKey, mergeable := listMergeKeys[key]
+		baseValue, ok := base.([]interface{})
+		if mergeable && ok {
+			return mergeLists(baseValue, overlayValue, mergeKey)
+		}
+	}
+
+	return overlay
+}
+
+func mergeLists(base []interface{}, overlay []interface{}, mergeKey string) []interface{} {
+	result := append([]interface{}{}, base...)
+
+	for _, overlayEntry := range overlay {
+		index := indexOfEntry(result, overlayEntry, mergeKey)
+		if index < 0 {
+			result = append(result, overlayEntry)
+			continue
+		}
+
+		result[index] = mergeMaps(result[index].(yaml.MapSlice), overlayEntry.(yaml.MapSlice))
+	}
+
+	return result
+}
+
+// indexOfEntry returns the index of the map in entries whose mergeKey field
+// equals the one of entry, or -1 if there is none.
+func indexOfEntry(entries []interface{}, entry interface{}, mergeKey string) int {
+	entryMap, ok := entry.(yaml.MapSlice)
+	if !ok {
+		return -1
+	}
+
+	id := indexOfKey(entryMap, mergeKey)
+	if id < 0 {
+		return -1
+	}
+
+	for i, candidate := range entries {
+		candidateMap, ok := candidate.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+
+		candidateID := indexOfKey(candidateMap, mergeKey)
+		if candidateID >= 0 && candidateMap[candidateID].Value == entryMap[id].Value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func indexOfKey(slice yaml.MapSlice, key interface{}) int {
+	for i, item := range slice {
+		if item.Key == key {
+			return i
+		}
+	}
+	return -1
+}
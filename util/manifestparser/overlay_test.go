@@ -0,0 +1,150 @@
+package manifestparser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MergeManifests", func() {
+	var (
+		base     string
+		overlays []string
+
+		merged     []byte
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		base = `---
+applications:
+- name: web-app
+  instances: 1
+  memory: 256M
+  buildpacks:
+  - ruby_buildpack
+  env:
+    LOG_LEVEL: debug
+    FEATURE_X: "on"
+  routes:
+  - route: web-app.dev.example.com
+  processes:
+  - type: web
+    instances: 1
+  - type: worker
+    instances: 1
+- name: other-app
+  memory: 128M
+`
+		overlays = nil
+	})
+
+	JustBeforeEach(func() {
+		var rawOverlays [][]byte
+		for _, overlay := range overlays {
+			rawOverlays = append(rawOverlays, []byte(overlay))
+		}
+		merged, executeErr = MergeManifests([]byte(base), rawOverlays...)
+	})
+
+	When("there are no overlays", func() {
+		It("returns the base manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(merged).To(MatchYAML(base))
+		})
+	})
+
+	When("an overlay is given", func() {
+		BeforeEach(func() {
+			overlays = []string{`---
+applications:
+- name: web-app
+  instances: 4
+  buildpacks:
+  - nodejs_buildpack
+  env:
+    LOG_LEVEL: info
+    FEATURE_X: ~
+  routes:
+  - route: web-app.example.com
+  processes:
+  - type: worker
+    instances: 3
+    memory: 1G
+- name: new-app
+  memory: 64M
+`}
+		})
+
+		It("merges it with the strategic-merge rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(merged).To(MatchYAML(`---
+applications:
+- name: web-app
+  instances: 4
+  memory: 256M
+  buildpacks:
+  - nodejs_buildpack
+  env:
+    LOG_LEVEL: info
+  routes:
+  - route: web-app.dev.example.com
+  - route: web-app.example.com
+  processes:
+  - type: web
+    instances: 1
+  - type: worker
+    instances: 3
+    memory: 1G
+- name: other-app
+  memory: 128M
+- name: new-app
+  memory: 64M
+`))
+		})
+	})
+
+	When("several overlays are given", func() {
+		BeforeEach(func() {
+			overlays = []string{
+				`{applications: [{name: other-app, memory: 512M, instances: 2}]}`,
+				`{applications: [{name: other-app, instances: ((other_instances))}]}`,
+			}
+		})
+
+		It("applies them in order and leaves placeholders alone", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(merged).To(MatchYAML(`---
+applications:
+- name: web-app
+  instances: 1
+  memory: 256M
+  buildpacks:
+  - ruby_buildpack
+  env:
+    LOG_LEVEL: debug
+    FEATURE_X: "on"
+  routes:
+  - route: web-app.dev.example.com
+  processes:
+  - type: web
+    instances: 1
+  - type: worker
+    instances: 1
+- name: other-app
+  memory: 512M
+  instances: ((other_instances))
+`))
+		})
+	})
+
+	When("an overlay is not valid YAML", func() {
+		BeforeEach(func() {
+			overlays = []string{"applications: [name: :"}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
@@ -24,37 +24,38 @@ func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFi
 		return nil, err
 	}
 
-	tpl := template.NewTemplate(rawManifest)
-	fileVars := template.StaticVariables{}
+	return Interpolate(rawManifest, pathsToVarsFiles, vars)
+}
+
+// InterpolateManifestWithOverlays merges the overlays at pathsToOverlays over
+// the manifest at pathToManifest, as described by MergeManifests, and then
+// interpolates variables in the merged manifest like InterpolateManifest.
+func (m ManifestParser) InterpolateManifestWithOverlays(pathToManifest string, pathsToOverlays []string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error) {
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, path := range pathsToVarsFiles {
-		rawVarsFile, ioerr := ioutil.ReadFile(path)
+	var overlays [][]byte
+	for _, path := range pathsToOverlays {
+		rawOverlay, ioerr := ioutil.ReadFile(path)
 		if ioerr != nil {
 			return nil, ioerr
 		}
 
-		var sv template.StaticVariables
-
-		err = yaml.Unmarshal(rawVarsFile, &sv)
-		if err != nil {
-			return nil, InvalidYAMLError{Err: err}
+		var overlay yaml.MapSlice
+		if err = yaml.Unmarshal(rawOverlay, &overlay); err != nil {
+			return nil, InvalidOverlayError{Path: path, Err: err}
 		}
-
-		for k, v := range sv {
-			fileVars[k] = v
-		}
-	}
-
-	for _, kv := range vars {
-		fileVars[kv.Name] = kv.Value
+		overlays = append(overlays, rawOverlay)
 	}
 
-	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	rawManifest, err = MergeManifests(rawManifest, overlays...)
 	if err != nil {
-		return nil, InterpolationError{Err: err}
+		return nil, err
 	}
 
-	return rawManifest, nil
+	return Interpolate(rawManifest, pathsToVarsFiles, vars)
 }
 
 func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte) (Manifest, error) {
@@ -68,11 +69,17 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 		return Manifest{}, errors.New("Manifest must have at least one application.")
 	}
 
+	err = parsedManifest.ValidateDependencies()
+	if err != nil {
+		return Manifest{}, err
+	}
+
 	parsedManifest.PathToManifest = pathToManifest
 
 	return parsedManifest, nil
 }
 
 func (m ManifestParser) MarshalManifest(manifest Manifest) ([]byte, error) {
+	manifest.Services = nil
 	return yaml.Marshal(manifest)
 }
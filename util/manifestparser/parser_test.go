@@ -229,6 +229,83 @@ applications:
 		})
 	})
 
+	Describe("InterpolateManifestWithOverlays", func() {
+		var (
+			dir             string
+			pathToManifest  string
+			pathsToOverlays []string
+
+			interpolatedManifest []byte
+			executeErr           error
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "manifest-overlay-test-")
+			Expect(err).ToNot(HaveOccurred())
+
+			pathToManifest = filepath.Join(dir, "manifest.yml")
+			Expect(ioutil.WriteFile(pathToManifest, []byte(`---
+applications:
+- name: spark
+  instances: 1
+  memory: ((memory))
+`), 0666)).To(Succeed())
+
+			overlayPath := filepath.Join(dir, "prod.yml")
+			Expect(ioutil.WriteFile(overlayPath, []byte(`---
+applications:
+- name: spark
+  instances: ((instances))
+`), 0666)).To(Succeed())
+			pathsToOverlays = []string{overlayPath}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			interpolatedManifest, executeErr = parser.InterpolateManifestWithOverlays(
+				pathToManifest,
+				pathsToOverlays,
+				nil,
+				[]template.VarKV{{Name: "memory", Value: "1G"}, {Name: "instances", Value: 3}},
+			)
+		})
+
+		It("merges the overlays before interpolating variables", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(interpolatedManifest).To(MatchYAML(`---
+applications:
+- name: spark
+  instances: 3
+  memory: 1G
+`))
+		})
+
+		When("an overlay is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathsToOverlays[0], []byte("applications: [name: :"), 0666)).To(Succeed())
+			})
+
+			It("returns an InvalidOverlayError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InvalidOverlayError{}))
+				Expect(executeErr.(InvalidOverlayError).Path).To(Equal(pathsToOverlays[0]))
+			})
+		})
+
+		When("an overlay does not exist", func() {
+			BeforeEach(func() {
+				pathsToOverlays = []string{filepath.Join(dir, "missing.yml")}
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("ParseManifest", func() {
 		var (
 			pathToManifest string
@@ -259,6 +336,21 @@ applications:
 			})
 		})
 
+		When("the depends_on of the apps form a cycle", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`applications:
+- name: frontend
+  depends_on: [worker]
+- name: worker
+  depends_on: [frontend]
+`)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(DependencyCycleError{Apps: []string{"frontend", "worker"}}))
+			})
+		})
+
 		When("invalid yaml is passed", func() {
 			BeforeEach(func() {
 				rawManifest = []byte("\t\t")
@@ -323,6 +415,20 @@ applications:
   processes:
   - type: web
     unknown-process-key: 2
+`))
+		})
+
+		It("does not include the services section", func() {
+			manifest := Manifest{
+				Applications: []Application{{Name: "app-1"}},
+				Services:     []ServiceRequirement{{Name: "db", Plan: "small"}},
+			}
+
+			yaml, err := parser.MarshalManifest(manifest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(yaml).To(MatchYAML(`applications:
+- name: app-1
 `))
 		})
 	})
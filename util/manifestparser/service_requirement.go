@@ -0,0 +1,65 @@
+package manifestparser
+
+// ServiceRequirement is a service instance that a manifest requires in the
+// space, along with the apps that bind to it.
+type ServiceRequirement struct {
+	Name string `yaml:"name"`
+	// Offering and Plan are only checked when they are set.
+	Offering string `yaml:"offering,omitempty"`
+	Plan     string `yaml:"plan,omitempty"`
+	// BoundApps are the apps of the manifest that list the service instance
+	// in their services.
+	BoundApps []string `yaml:"-"`
+}
+
+// ServiceNames returns the names of the service instances listed in the
+// services of the app, which are either names or objects with a name.
+func (application Application) ServiceNames() []string {
+	services, ok := application.RemainingManifestFields["services"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, service := range services {
+		switch value := service.(type) {
+		case string:
+			names = append(names, value)
+		case map[interface{}]interface{}:
+			if name, ok := value["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// ServiceRequirements returns the service instances declared in the services
+// section of the manifest, followed by the ones the apps bind to that are not
+// declared there, in the order they first appear.
+func (m Manifest) ServiceRequirements() []ServiceRequirement {
+	requirements := make([]ServiceRequirement, 0, len(m.Services))
+	indexes := map[string]int{}
+
+	for _, service := range m.Services {
+		if _, ok := indexes[service.Name]; ok {
+			continue
+		}
+		indexes[service.Name] = len(requirements)
+		requirements = append(requirements, ServiceRequirement{Name: service.Name, Offering: service.Offering, Plan: service.Plan})
+	}
+
+	for _, app := range m.Applications {
+		for _, name := range app.ServiceNames() {
+			i, ok := indexes[name]
+			if !ok {
+				i = len(requirements)
+				indexes[name] = i
+				requirements = append(requirements, ServiceRequirement{Name: name})
+			}
+			requirements[i].BoundApps = append(requirements[i].BoundApps, app.Name)
+		}
+	}
+
+	return requirements
+}
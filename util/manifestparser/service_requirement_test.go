@@ -0,0 +1,46 @@
+package manifestparser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("ServiceRequirements", func() {
+	var manifest Manifest
+
+	BeforeEach(func() {
+		err := yaml.Unmarshal([]byte(`
+applications:
+- name: web
+  services:
+  - db
+  - name: cache
+    parameters: {size: 2}
+- name: worker
+  services:
+  - db
+  - queue
+services:
+- name: db
+  offering: postgres
+  plan: small
+- name: logs
+`), &manifest)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns the declared services followed by the ones only the apps bind to", func() {
+		Expect(manifest.ServiceRequirements()).To(Equal([]ServiceRequirement{
+			{Name: "db", Offering: "postgres", Plan: "small", BoundApps: []string{"web", "worker"}},
+			{Name: "logs"},
+			{Name: "cache", BoundApps: []string{"web"}},
+			{Name: "queue", BoundApps: []string{"worker"}},
+		}))
+	})
+
+	It("reads the service names of an app whether they are names or objects", func() {
+		Expect(manifest.Applications[0].ServiceNames()).To(Equal([]string{"db", "cache"}))
+	})
+})
@@ -0,0 +1,91 @@
+package manifestparser
+
+// HasDependencies returns true when any application in the manifest sets
+// depends_on.
+func (m Manifest) HasDependencies() bool {
+	for _, app := range m.Applications {
+		if len(app.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// StartGroups returns the names of the applications in the order they should
+// be pushed and started. Every application comes after the applications it
+// depends on, and the applications in a group do not depend on each other, so
+// they can be pushed at the same time. Within a group, applications are in
+// manifest order.
+//
+// Dependencies on applications that are not in the manifest are ignored, so
+// that a single application can be pushed from a manifest that lists several.
+func (m Manifest) StartGroups() ([][]string, error) {
+	return m.startGroups(false)
+}
+
+// ValidateDependencies returns an error when an application depends on an
+// application that is not in the manifest or when the dependencies form a
+// cycle.
+func (m Manifest) ValidateDependencies() error {
+	_, err := m.startGroups(true)
+	return err
+}
+
+func (m Manifest) startGroups(strict bool) ([][]string, error) {
+	inManifest := map[string]bool{}
+	for _, app := range m.Applications {
+		inManifest[app.Name] = true
+	}
+
+	remaining := map[string]int{}
+	dependents := map[string][]string{}
+	for _, app := range m.Applications {
+		seen := map[string]bool{}
+		for _, dependency := range app.DependsOn {
+			if !inManifest[dependency] {
+				if strict {
+					return nil, DependencyNotFoundError{App: app.Name, Dependency: dependency}
+				}
+				continue
+			}
+			if seen[dependency] {
+				continue
+			}
+			seen[dependency] = true
+
+			remaining[app.Name]++
+			dependents[dependency] = append(dependents[dependency], app.Name)
+		}
+	}
+
+	var groups [][]string
+	started := map[string]bool{}
+	for len(started) < len(m.Applications) {
+		var group []string
+		for _, app := range m.Applications {
+			if !started[app.Name] && remaining[app.Name] == 0 {
+				group = append(group, app.Name)
+			}
+		}
+
+		if len(group) == 0 {
+			var cycle []string
+			for _, app := range m.Applications {
+				if !started[app.Name] {
+					cycle = append(cycle, app.Name)
+				}
+			}
+			return nil, DependencyCycleError{Apps: cycle}
+		}
+
+		for _, name := range group {
+			started[name] = true
+			for _, dependent := range dependents[name] {
+				remaining[dependent]--
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
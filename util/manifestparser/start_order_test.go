@@ -0,0 +1,86 @@
+package manifestparser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StartOrder", func() {
+	var manifest Manifest
+
+	Describe("HasDependencies", func() {
+		It("returns true when an app sets depends_on", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"worker"}},
+				{Name: "worker"},
+			}}
+			Expect(manifest.HasDependencies()).To(BeTrue())
+		})
+
+		It("returns false when no app sets depends_on", func() {
+			manifest = Manifest{Applications: []Application{{Name: "frontend"}, {Name: "worker"}}}
+			Expect(manifest.HasDependencies()).To(BeFalse())
+		})
+	})
+
+	Describe("StartGroups", func() {
+		It("puts apps after the apps they depend on and groups independent apps", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"api", "worker"}},
+				{Name: "api", DependsOn: []string{"db-migrator"}},
+				{Name: "worker", DependsOn: []string{"db-migrator", "db-migrator"}},
+				{Name: "db-migrator"},
+				{Name: "docs"},
+			}}
+
+			groups, err := manifest.StartGroups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([][]string{
+				{"db-migrator", "docs"},
+				{"api", "worker"},
+				{"frontend"},
+			}))
+		})
+
+		It("ignores dependencies on apps that are not in the manifest", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"worker"}},
+			}}
+
+			groups, err := manifest.StartGroups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([][]string{{"frontend"}}))
+		})
+
+		It("returns an error when the dependencies form a cycle", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"api"}},
+				{Name: "api", DependsOn: []string{"frontend"}},
+				{Name: "worker"},
+			}}
+
+			_, err := manifest.StartGroups()
+			Expect(err).To(MatchError(DependencyCycleError{Apps: []string{"frontend", "api"}}))
+		})
+	})
+
+	Describe("ValidateDependencies", func() {
+		It("returns an error when an app depends on an app that is not in the manifest", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"wroker"}},
+				{Name: "worker"},
+			}}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(DependencyNotFoundError{App: "frontend", Dependency: "wroker"}))
+		})
+
+		It("returns an error when an app depends on itself", func() {
+			manifest = Manifest{Applications: []Application{
+				{Name: "frontend", DependsOn: []string{"frontend"}},
+			}}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(DependencyCycleError{Apps: []string{"frontend"}}))
+		})
+	})
+})
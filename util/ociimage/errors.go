@@ -0,0 +1,45 @@
+package ociimage
+
+import "fmt"
+
+// InvalidReferenceError is returned when an image reference cannot be parsed.
+type InvalidReferenceError struct {
+	Reference string
+	Err       error
+}
+
+func (e InvalidReferenceError) Error() string {
+	return fmt.Sprintf("invalid image reference '%s': %s", e.Reference, e.Err)
+}
+
+// RegistryError is returned when a registry responds with a 4xx or 5xx status
+// code.
+type RegistryError struct {
+	Method      string
+	URL         string
+	Status      string
+	RawResponse []byte
+}
+
+func (e RegistryError) Error() string {
+	return fmt.Sprintf("%s %s: %s\n%s", e.Method, e.URL, e.Status, e.RawResponse)
+}
+
+// PlatformNotFoundError is returned when a multi-platform base image has no
+// image for the platform droplets run on.
+type PlatformNotFoundError struct {
+	Reference string
+	Platform  Platform
+}
+
+func (e PlatformNotFoundError) Error() string {
+	return fmt.Sprintf("image %s has no %s/%s variant", e.Reference, e.Platform.OS, e.Platform.Architecture)
+}
+
+// MissingStartCommandError is returned when a droplet has no start command to
+// run the image with.
+type MissingStartCommandError struct{}
+
+func (MissingStartCommandError) Error() string {
+	return "the droplet does not have a start command"
+}
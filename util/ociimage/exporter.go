@@ -0,0 +1,164 @@
+package ociimage
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util"
+	log "github.com/sirupsen/logrus"
+)
+
+// Exporter builds images from droplets and exports them to registries or OCI
+// layout directories.
+type Exporter struct {
+	Registry *Registry
+}
+
+// NewExporter returns an Exporter that connects to registries with the dialer
+// and presents the client certificate, if set.
+func NewExporter(dialer *util.Dialer, clientCertificate util.ClientCertificate) *Exporter {
+	tr := &http.Transport{
+		TLSClientConfig: util.NewClientTLSConfig(false, clientCertificate),
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext:     dialer.DialContext,
+	}
+
+	return &Exporter{
+		Registry: NewRegistry(&http.Client{Transport: tr}),
+	}
+}
+
+// Push uploads the image to the destination, copying the layers of the base
+// image the destination does not have yet, and returns the digest of the
+// image.
+func (exporter Exporter) Push(image Image, dest Reference, credentials Credentials) (string, error) {
+	exporter.Registry.SetCredentials(dest.Host, credentials)
+
+	for _, layer := range image.BaseLayers {
+		exists, err := exporter.Registry.BlobExists(dest, layer.Digest)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			log.WithField("digest", layer.Digest).Debug("layer already in destination")
+			continue
+		}
+
+		layerDigest := layer.Digest
+		err = exporter.Registry.PutBlob(dest, layerDigest, layer.Size, func() (io.ReadCloser, error) {
+			return exporter.Registry.GetBlob(image.Base, layerDigest)
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, blob := range [][]byte{image.AppLayer, image.Config} {
+		contents := blob
+		err := exporter.Registry.PutBlob(dest, digestOf(contents), int64(len(contents)), func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(contents)), nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	identifier := dest.Tag
+	if identifier == "" {
+		identifier = image.Digest()
+	}
+	err := exporter.Registry.PutManifest(dest, identifier, MediaTypeOCIManifest, image.Manifest)
+	if err != nil {
+		return "", err
+	}
+
+	return image.Digest(), nil
+}
+
+// WriteLayout writes the image, including the layers of the base image, to an
+// OCI image layout in dir, and returns the digest of the image. name is
+// recorded as the reference name of the image in the layout index.
+func (exporter Exporter) WriteLayout(image Image, dir string, name string) (string, error) {
+	blobsDir := filepath.Join(dir, "blobs", "sha256")
+	err := os.MkdirAll(blobsDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	writeBlob := func(digest string, open func() (io.ReadCloser, error)) error {
+		blobPath := filepath.Join(blobsDir, strings.TrimPrefix(digest, "sha256:"))
+		if _, err := os.Stat(blobPath); err == nil {
+			return nil
+		}
+
+		source, err := open()
+		if err != nil {
+			return err
+		}
+		defer source.Close()
+
+		file, err := os.Create(blobPath)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(file, source)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(blobPath)
+		}
+		return err
+	}
+
+	for _, layer := range image.BaseLayers {
+		layerDigest := layer.Digest
+		err = writeBlob(layerDigest, func() (io.ReadCloser, error) {
+			return exporter.Registry.GetBlob(image.Base, layerDigest)
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, blob := range [][]byte{image.AppLayer, image.Config, image.Manifest} {
+		contents := blob
+		err = writeBlob(digestOf(contents), func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(contents)), nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	index, err := json.Marshal(Index{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIIndex,
+		Manifests: []Descriptor{{
+			MediaType:   MediaTypeOCIManifest,
+			Digest:      image.Digest(),
+			Size:        int64(len(image.Manifest)),
+			Annotations: map[string]string{RefNameAnnotation: name},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "index.json"), index, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return image.Digest(), nil
+}
@@ -0,0 +1,397 @@
+package ociimage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+	. "code.cloudfoundry.org/cli/util/ociimage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeRegistry is an in-memory registry. Pushing to it requires a bearer
+// token, which is handed out for the pusher credentials.
+type fakeRegistry struct {
+	sync.Mutex
+	server    *httptest.Server
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	types     map[string]string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	registry := &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+		types:     map[string]string{},
+	}
+	registry.server = httptest.NewServer(http.HandlerFunc(registry.serve))
+	return registry
+}
+
+func (registry *fakeRegistry) host() string {
+	return strings.TrimPrefix(registry.server.URL, "http://")
+}
+
+func (registry *fakeRegistry) addBlob(contents []byte) string {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(contents))
+	registry.blobs[digest] = contents
+	return digest
+}
+
+func (registry *fakeRegistry) addManifest(repo string, tag string, mediaType string, manifest interface{}) string {
+	contents, err := json.Marshal(manifest)
+	Expect(err).ToNot(HaveOccurred())
+	digest := registry.addBlob(contents)
+	for _, identifier := range []string{tag, digest} {
+		registry.manifests[repo+":"+identifier] = contents
+		registry.types[repo+":"+identifier] = mediaType
+	}
+	return digest
+}
+
+func (registry *fakeRegistry) serve(w http.ResponseWriter, r *http.Request) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if r.URL.Path == "/token" {
+		username, password, _ := r.BasicAuth()
+		if username != "pusher" || password != "secret" || !strings.Contains(r.URL.Query().Get("scope"), "push") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"push-token"}`))
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Header.Get("Authorization") != "Bearer push-token" {
+		_, _ = ioutil.ReadAll(r.Body)
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, registry.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/")
+	switch {
+	case strings.HasSuffix(path, "/blobs/uploads/") && r.Method == http.MethodPost:
+		w.Header().Set("Location", "/upload/some-session?state=abc")
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasPrefix(r.URL.Path, "/upload/") && r.Method == http.MethodPut:
+		contents, _ := ioutil.ReadAll(r.Body)
+		digest := fmt.Sprintf("sha256:%x", sha256.Sum256(contents))
+		if digest != r.URL.Query().Get("digest") || r.URL.Query().Get("state") != "abc" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		registry.blobs[digest] = contents
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(path, "/blobs/"):
+		contents, ok := registry.blobs[path[strings.LastIndex(path, "/")+1:]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(contents)
+		}
+	case strings.Contains(path, "/manifests/"):
+		i := strings.Index(path, "/manifests/")
+		key := path[:i] + ":" + path[i+len("/manifests/"):]
+		if r.Method == http.MethodPut {
+			contents, _ := ioutil.ReadAll(r.Body)
+			registry.manifests[key] = contents
+			registry.types[key] = r.Header.Get("Content-Type")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		contents, ok := registry.manifests[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", registry.types[key])
+		_, _ = w.Write(contents)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func tgz(files map[string]string) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	Expect(tarWriter.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0755})).To(Succeed())
+	for _, name := range []string{"./app/", "./app/run.sh", "./staging_info.yml"} {
+		contents, ok := files[name]
+		if !ok {
+			continue
+		}
+		header := &tar.Header{Name: name, Mode: 0755, Size: int64(len(contents)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(name, "/") {
+			header.Typeflag = tar.TypeDir
+		}
+		Expect(tarWriter.WriteHeader(header)).To(Succeed())
+		_, err := tarWriter.Write([]byte(contents))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
+
+func layerFiles(layer []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(layer))
+	Expect(err).ToNot(HaveOccurred())
+	tarReader := tar.NewReader(gzipReader)
+
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).ToNot(HaveOccurred())
+		contents, err := ioutil.ReadAll(tarReader)
+		Expect(err).ToNot(HaveOccurred())
+		files[header.Name] = string(contents)
+	}
+}
+
+var _ = Describe("Exporter", func() {
+	var (
+		registry   *fakeRegistry
+		exporter   *Exporter
+		base       Reference
+		droplet    []byte
+		baseLayer  string
+		baseConfig ConfigFile
+	)
+
+	BeforeEach(func() {
+		registry = newFakeRegistry()
+		exporter = NewExporter(util.NewDialer(5*time.Second, util.IPFamilyAny, nil), util.ClientCertificate{})
+
+		baseConfig = ConfigFile{
+			Architecture: "amd64",
+			OS:           "linux",
+			Config:       ContainerConfig{Env: []string{"PATH=/usr/bin:/bin", "HOME=/root"}, Cmd: []string{"/bin/bash"}},
+			RootFS:       RootFS{Type: "layers", DiffIDs: []string{"sha256:base-diff-id"}},
+		}
+		rawConfig, err := json.Marshal(baseConfig)
+		Expect(err).ToNot(HaveOccurred())
+		configDigest := registry.addBlob(rawConfig)
+		baseLayer = registry.addBlob([]byte("base-layer"))
+
+		manifestDigest := registry.addManifest("cloudfoundry/cflinuxfs4", "amd64", MediaTypeDockerManifest, Manifest{
+			SchemaVersion: 2,
+			MediaType:     MediaTypeDockerManifest,
+			Config:        Descriptor{MediaType: "application/vnd.docker.container.image.v1+json", Digest: configDigest, Size: int64(len(rawConfig))},
+			Layers:        []Descriptor{{MediaType: MediaTypeDockerLayer, Digest: baseLayer, Size: int64(len("base-layer"))}},
+		})
+		registry.addManifest("cloudfoundry/cflinuxfs4", "latest", MediaTypeDockerList, Index{
+			SchemaVersion: 2,
+			MediaType:     MediaTypeDockerList,
+			Manifests: []Descriptor{
+				{MediaType: MediaTypeDockerManifest, Digest: "sha256:arm", Platform: &Platform{OS: "linux", Architecture: "arm64"}},
+				{MediaType: MediaTypeDockerManifest, Digest: manifestDigest, Platform: &Platform{OS: "linux", Architecture: "amd64"}},
+			},
+		})
+
+		base, err = ParseReference(registry.host() + "/cloudfoundry/cflinuxfs4")
+		Expect(err).ToNot(HaveOccurred())
+
+		droplet = tgz(map[string]string{
+			"./app/":             "",
+			"./app/run.sh":       "echo hi",
+			"./staging_info.yml": `{"detected_buildpack":"","start_command":"./run.sh"}`,
+		})
+	})
+
+	AfterEach(func() {
+		registry.server.Close()
+	})
+
+	Describe("Build", func() {
+		var (
+			image      Image
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			image, executeErr = exporter.Build(base, droplet)
+		})
+
+		It("layers the droplet under the vcap home directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(layerFiles(image.AppLayer)).To(Equal(map[string]string{
+				"home/vcap/app/":             "",
+				"home/vcap/app/run.sh":       "echo hi",
+				"home/vcap/staging_info.yml": `{"detected_buildpack":"","start_command":"./run.sh"}`,
+			}))
+		})
+
+		It("adds the droplet layer to the layers of the base image", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var manifest Manifest
+			Expect(json.Unmarshal(image.Manifest, &manifest)).To(Succeed())
+			Expect(manifest.MediaType).To(Equal(MediaTypeOCIManifest))
+			Expect(manifest.Layers).To(HaveLen(2))
+			Expect(manifest.Layers[0]).To(Equal(Descriptor{MediaType: MediaTypeOCILayerGzip, Digest: baseLayer, Size: int64(len("base-layer"))}))
+			Expect(manifest.Layers[1].Size).To(Equal(int64(len(image.AppLayer))))
+			Expect(manifest.Config.Digest).To(Equal(fmt.Sprintf("sha256:%x", sha256.Sum256(image.Config))))
+			Expect(image.Digest()).To(Equal(fmt.Sprintf("sha256:%x", sha256.Sum256(image.Manifest))))
+		})
+
+		It("configures the image to run the start command as vcap", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var config ConfigFile
+			Expect(json.Unmarshal(image.Config, &config)).To(Succeed())
+			Expect(config.RootFS.DiffIDs).To(HaveLen(2))
+			Expect(config.Config.User).To(Equal("vcap"))
+			Expect(config.Config.WorkingDir).To(Equal("/home/vcap/app"))
+			Expect(config.Config.Env).To(ConsistOf("PATH=/usr/bin:/bin", "HOME=/home/vcap/app", "DEPS_DIR=/home/vcap/deps", "PORT=8080"))
+			Expect(config.Config.Cmd).To(HaveLen(3))
+			Expect(config.Config.Cmd[2]).To(HaveSuffix("exec ./run.sh"))
+		})
+
+		When("the droplet has no start command", func() {
+			BeforeEach(func() {
+				droplet = tgz(map[string]string{"./app/run.sh": "echo hi"})
+			})
+
+			It("returns a MissingStartCommandError", func() {
+				Expect(executeErr).To(MatchError(MissingStartCommandError{}))
+			})
+		})
+
+		When("the base image has no amd64 variant", func() {
+			BeforeEach(func() {
+				registry.addManifest("cloudfoundry/cflinuxfs4", "latest", MediaTypeOCIIndex, Index{SchemaVersion: 2})
+			})
+
+			It("returns a PlatformNotFoundError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(PlatformNotFoundError{}))
+			})
+		})
+
+		When("the base image does not exist", func() {
+			BeforeEach(func() {
+				base.Tag = "missing"
+			})
+
+			It("returns a RegistryError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(RegistryError{}))
+			})
+		})
+	})
+
+	Describe("Push", func() {
+		var (
+			image       Image
+			dest        Reference
+			credentials Credentials
+			digest      string
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			var err error
+			image, err = exporter.Build(base, droplet)
+			Expect(err).ToNot(HaveOccurred())
+
+			dest, err = ParseReference(registry.host() + "/team/app:v1")
+			Expect(err).ToNot(HaveOccurred())
+			credentials = Credentials{Username: "pusher", Password: "secret"}
+		})
+
+		JustBeforeEach(func() {
+			digest, executeErr = exporter.Push(image, dest, credentials)
+		})
+
+		It("uploads the blobs and tags the manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(digest).To(Equal(image.Digest()))
+			Expect(registry.manifests["team/app:v1"]).To(Equal(image.Manifest))
+			Expect(registry.types["team/app:v1"]).To(Equal(MediaTypeOCIManifest))
+			Expect(registry.blobs[fmt.Sprintf("sha256:%x", sha256.Sum256(image.AppLayer))]).To(Equal(image.AppLayer))
+			Expect(registry.blobs[fmt.Sprintf("sha256:%x", sha256.Sum256(image.Config))]).To(Equal(image.Config))
+		})
+
+		When("the credentials are wrong", func() {
+			BeforeEach(func() {
+				credentials.Password = "wrong"
+			})
+
+			It("returns a RegistryError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(RegistryError{}))
+				Expect(registry.manifests).ToNot(HaveKey("team/app:v1"))
+			})
+		})
+	})
+
+	Describe("WriteLayout", func() {
+		var (
+			image  Image
+			dir    string
+			digest string
+		)
+
+		BeforeEach(func() {
+			var err error
+			image, err = exporter.Build(base, droplet)
+			Expect(err).ToNot(HaveOccurred())
+
+			dir, err = ioutil.TempDir("", "oci-layout")
+			Expect(err).ToNot(HaveOccurred())
+
+			digest, err = exporter.WriteLayout(image, dir, "app:v1")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes every blob of the image", func() {
+			Expect(digest).To(Equal(image.Digest()))
+			for _, contents := range [][]byte{[]byte("base-layer"), image.AppLayer, image.Config, image.Manifest} {
+				written, err := ioutil.ReadFile(filepath.Join(dir, "blobs", "sha256", fmt.Sprintf("%x", sha256.Sum256(contents))))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(written).To(Equal(contents))
+			}
+		})
+
+		It("indexes the image under the given name", func() {
+			layout, err := ioutil.ReadFile(filepath.Join(dir, "oci-layout"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(layout).To(MatchJSON(`{"imageLayoutVersion":"1.0.0"}`))
+
+			rawIndex, err := ioutil.ReadFile(filepath.Join(dir, "index.json"))
+			Expect(err).ToNot(HaveOccurred())
+			var index Index
+			Expect(json.Unmarshal(rawIndex, &index)).To(Succeed())
+			Expect(index.Manifests).To(ConsistOf(Descriptor{
+				MediaType:   MediaTypeOCIManifest,
+				Digest:      image.Digest(),
+				Size:        int64(len(image.Manifest)),
+				Annotations: map[string]string{RefNameAnnotation: "app:v1"},
+			}))
+		})
+	})
+})
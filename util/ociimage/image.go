@@ -0,0 +1,270 @@
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// AppDir is where the droplet is extracted to in the image, as it is in
+	// Cloud Foundry containers.
+	AppDir = "/home/vcap"
+
+	// AppPort is the port the app is told to listen on.
+	AppPort = "8080"
+)
+
+// DropletPlatform is the platform Cloud Foundry droplets run on.
+var DropletPlatform = Platform{OS: "linux", Architecture: "amd64"}
+
+// launchScript sources the environment set up by the buildpacks before
+// running the start command, standing in for the Cloud Foundry launcher.
+const launchScript = `for f in /home/vcap/profile.d/*.sh /home/vcap/app/.profile.d/*.sh; do [ -f "$f" ] && . "$f"; done; [ -f /home/vcap/app/.profile ] && . /home/vcap/app/.profile; exec `
+
+// Image is an image built from a droplet on top of a base image.
+type Image struct {
+	// Base is the image the droplet was layered on.
+	Base Reference
+	// Manifest is the manifest of the image.
+	Manifest []byte
+	// Config is the configuration of the image.
+	Config []byte
+	// BaseLayers are the layers of the base image.
+	BaseLayers []Descriptor
+	// AppLayer is the compressed layer holding the droplet.
+	AppLayer []byte
+}
+
+// Digest returns the digest of the manifest of the image.
+func (image Image) Digest() string {
+	return digestOf(image.Manifest)
+}
+
+// Build fetches the base image and layers the droplet on top of it. The image
+// runs the start command of the droplet as the vcap user.
+func (exporter Exporter) Build(base Reference, droplet []byte) (Image, error) {
+	baseManifest, err := exporter.resolveManifest(base)
+	if err != nil {
+		return Image{}, err
+	}
+
+	rawConfig, err := exporter.readBlob(base, baseManifest.Config.Digest)
+	if err != nil {
+		return Image{}, err
+	}
+	var config ConfigFile
+	err = json.Unmarshal(rawConfig, &config)
+	if err != nil {
+		return Image{}, err
+	}
+
+	appLayer, diffID, startCommand, err := dropletLayer(droplet)
+	if err != nil {
+		return Image{}, err
+	}
+	if startCommand == "" {
+		return Image{}, MissingStartCommandError{}
+	}
+
+	config.RootFS.DiffIDs = append(config.RootFS.DiffIDs, diffID)
+	config.History = append(config.History, History{CreatedBy: "cf export-image", Comment: "droplet"})
+	config.Config.User = "vcap"
+	config.Config.WorkingDir = path.Join(AppDir, "app")
+	config.Config.Env = setEnv(config.Config.Env, "HOME", path.Join(AppDir, "app"))
+	config.Config.Env = setEnv(config.Config.Env, "DEPS_DIR", path.Join(AppDir, "deps"))
+	config.Config.Env = setEnv(config.Config.Env, "PORT", AppPort)
+	config.Config.ExposedPorts = map[string]struct{}{AppPort + "/tcp": {}}
+	config.Config.Entrypoint = nil
+	config.Config.Cmd = []string{"/bin/bash", "-c", launchScript + startCommand}
+
+	image := Image{Base: base, AppLayer: appLayer}
+	image.Config, err = json.Marshal(config)
+	if err != nil {
+		return Image{}, err
+	}
+
+	manifest := Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIManifest,
+		Config: Descriptor{
+			MediaType: MediaTypeOCIConfig,
+			Digest:    digestOf(image.Config),
+			Size:      int64(len(image.Config)),
+		},
+	}
+	for _, layer := range baseManifest.Layers {
+		mediaType := layer.MediaType
+		if mediaType == MediaTypeDockerLayer {
+			mediaType = MediaTypeOCILayerGzip
+		}
+		image.BaseLayers = append(image.BaseLayers, Descriptor{MediaType: mediaType, Digest: layer.Digest, Size: layer.Size})
+	}
+	manifest.Layers = append(append([]Descriptor{}, image.BaseLayers...), Descriptor{
+		MediaType: MediaTypeOCILayerGzip,
+		Digest:    digestOf(appLayer),
+		Size:      int64(len(appLayer)),
+	})
+
+	image.Manifest, err = json.Marshal(manifest)
+	if err != nil {
+		return Image{}, err
+	}
+
+	return image, nil
+}
+
+// resolveManifest returns the manifest of the image, picking the variant for
+// the droplet platform when the image supports more than one.
+func (exporter Exporter) resolveManifest(ref Reference) (Manifest, error) {
+	mediaType, body, err := exporter.Registry.GetManifest(ref, ref.Identifier())
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if mediaType == MediaTypeOCIIndex || mediaType == MediaTypeDockerList {
+		var index Index
+		err = json.Unmarshal(body, &index)
+		if err != nil {
+			return Manifest{}, err
+		}
+
+		var found bool
+		for _, descriptor := range index.Manifests {
+			if descriptor.Platform != nil && *descriptor.Platform == DropletPlatform {
+				mediaType, body, err = exporter.Registry.GetManifest(ref, descriptor.Digest)
+				if err != nil {
+					return Manifest{}, err
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Manifest{}, PlatformNotFoundError{Reference: ref.String(), Platform: DropletPlatform}
+		}
+	}
+
+	if mediaType != MediaTypeOCIManifest && mediaType != MediaTypeDockerManifest {
+		return Manifest{}, fmt.Errorf("image %s has unsupported manifest type '%s'", ref, mediaType)
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(body, &manifest)
+	return manifest, err
+}
+
+func (exporter Exporter) readBlob(ref Reference, digest string) ([]byte, error) {
+	blob, err := exporter.Registry.GetBlob(ref, digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+	return ioutil.ReadAll(blob)
+}
+
+// dropletLayer moves the contents of the droplet under AppDir, and returns
+// the compressed layer, the digest of the uncompressed layer and the start
+// command recorded while staging.
+func dropletLayer(droplet []byte) ([]byte, string, string, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(droplet))
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	var layer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&layer)
+	diffID := sha256.New()
+	tarWriter := tar.NewWriter(io.MultiWriter(gzipWriter, diffID))
+
+	var startCommand string
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		name := path.Clean(strings.TrimPrefix(header.Name, "/"))
+		if name == "." {
+			continue
+		}
+		header.Name = path.Join(strings.TrimPrefix(AppDir, "/"), name)
+		if header.Typeflag == tar.TypeDir {
+			header.Name += "/"
+		}
+		if header.Typeflag == tar.TypeLink {
+			header.Linkname = path.Join(strings.TrimPrefix(AppDir, "/"), path.Clean(strings.TrimPrefix(header.Linkname, "/")))
+		}
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		if name == "staging_info.yml" {
+			contents, err := ioutil.ReadAll(tarReader)
+			if err != nil {
+				return nil, "", "", err
+			}
+			var stagingInfo struct {
+				StartCommand string `yaml:"start_command"`
+			}
+			err = yaml.Unmarshal(contents, &stagingInfo)
+			if err != nil {
+				return nil, "", "", err
+			}
+			startCommand = stagingInfo.StartCommand
+
+			_, err = tarWriter.Write(contents)
+			if err != nil {
+				return nil, "", "", err
+			}
+			continue
+		}
+
+		_, err = io.Copy(tarWriter, tarReader)
+		if err != nil {
+			return nil, "", "", err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return layer.Bytes(), fmt.Sprintf("sha256:%x", diffID.Sum(nil)), startCommand, nil
+}
+
+func setEnv(env []string, name string, value string) []string {
+	variable := name + "=" + value
+	for i, existing := range env {
+		if strings.HasPrefix(existing, name+"=") {
+			env[i] = variable
+			return env
+		}
+	}
+	return append(env, variable)
+}
+
+func digestOf(contents []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(contents))
+}
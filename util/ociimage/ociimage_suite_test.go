@@ -0,0 +1,13 @@
+package ociimage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOCIImage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OCI Image Suite")
+}
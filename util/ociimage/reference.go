@@ -0,0 +1,91 @@
+package ociimage
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/docker/distribution/reference"
+)
+
+const (
+	dockerHubDomain      = "docker.io"
+	dockerHubRegistryAPI = "registry-1.docker.io"
+)
+
+// Reference identifies an image in a registry.
+type Reference struct {
+	// Host is the host, and optionally the port, of the registry.
+	Host string
+	// Repository is the path of the image in the registry.
+	Repository string
+	// Tag is the tag of the image; it is empty when Digest is set.
+	Tag string
+	// Digest is the digest of the manifest of the image.
+	Digest string
+}
+
+// ParseReference parses an image reference such as
+// registry.example.com/team/app:v1. Images on Docker Hub may omit the
+// registry, and the tag defaults to latest.
+func ParseReference(ref string) (Reference, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return Reference{}, InvalidReferenceError{Reference: ref, Err: err}
+	}
+
+	parsed := Reference{
+		Host:       reference.Domain(named),
+		Repository: reference.Path(named),
+	}
+
+	if digested, ok := named.(reference.Digested); ok {
+		parsed.Digest = digested.Digest().String()
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		parsed.Tag = tagged.Tag()
+	}
+	if parsed.Tag == "" && parsed.Digest == "" {
+		parsed.Tag = "latest"
+	}
+
+	return parsed, nil
+}
+
+// Identifier returns the digest of the reference, or its tag when it has no
+// digest.
+func (ref Reference) Identifier() string {
+	if ref.Digest != "" {
+		return ref.Digest
+	}
+	return ref.Tag
+}
+
+func (ref Reference) String() string {
+	if ref.Digest != "" {
+		return fmt.Sprintf("%s/%s@%s", ref.Host, ref.Repository, ref.Digest)
+	}
+	return fmt.Sprintf("%s/%s:%s", ref.Host, ref.Repository, ref.Tag)
+}
+
+func (ref Reference) apiHost() string {
+	if ref.Host == dockerHubDomain {
+		return dockerHubRegistryAPI
+	}
+	return ref.Host
+}
+
+// scheme returns http for registries on the local machine, which like the
+// Docker daemon are assumed not to serve TLS, and https otherwise.
+func (ref Reference) scheme() string {
+	host := ref.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return "http"
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return "http"
+	}
+	return "https"
+}
@@ -0,0 +1,38 @@
+package ociimage_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ociimage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reference", func() {
+	DescribeTable("ParseReference",
+		func(ref string, expected Reference) {
+			parsed, err := ParseReference(ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(expected))
+		},
+
+		Entry("defaults to Docker Hub and the latest tag", "cloudfoundry/cflinuxfs4",
+			Reference{Host: "docker.io", Repository: "cloudfoundry/cflinuxfs4", Tag: "latest"}),
+		Entry("adds the library namespace to official images", "ubuntu:jammy",
+			Reference{Host: "docker.io", Repository: "library/ubuntu", Tag: "jammy"}),
+		Entry("parses the registry host and port", "registry.example.com:5000/team/app:v1",
+			Reference{Host: "registry.example.com:5000", Repository: "team/app", Tag: "v1"}),
+		Entry("parses digests", "registry.example.com/app@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			Reference{Host: "registry.example.com", Repository: "app", Digest: "sha256:0000000000000000000000000000000000000000000000000000000000000000"}),
+	)
+
+	It("returns an InvalidReferenceError for invalid references", func() {
+		_, err := ParseReference("Not A Reference")
+		Expect(err).To(BeAssignableToTypeOf(InvalidReferenceError{}))
+	})
+
+	It("formats the reference", func() {
+		ref, err := ParseReference("team/app:v1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ref.String()).To(Equal("docker.io/team/app:v1"))
+	})
+})
@@ -0,0 +1,300 @@
+package ociimage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Credentials authenticate against a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Registry is a client for the OCI distribution API. It authenticates with
+// the basic and bearer token schemes registries challenge for.
+type Registry struct {
+	HTTPClient HTTPClient
+
+	credentials   map[string]Credentials
+	authorization map[string]string
+}
+
+func NewRegistry(httpClient HTTPClient) *Registry {
+	return &Registry{
+		HTTPClient:    httpClient,
+		credentials:   map[string]Credentials{},
+		authorization: map[string]string{},
+	}
+}
+
+// SetCredentials sets the credentials used for the registry on the given host.
+func (registry *Registry) SetCredentials(host string, credentials Credentials) {
+	registry.credentials[host] = credentials
+}
+
+// GetManifest returns the media type and the contents of the manifest or index
+// with the given tag or digest.
+func (registry *Registry) GetManifest(ref Reference, identifier string) (string, []byte, error) {
+	response, err := registry.do(ref, "pull", func() (*http.Request, error) {
+		request, err := http.NewRequest(http.MethodGet, registry.url(ref, "manifests", identifier), nil)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Accept", strings.Join([]string{
+			MediaTypeOCIManifest, MediaTypeOCIIndex, MediaTypeDockerManifest, MediaTypeDockerList,
+		}, ", "))
+		return request, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	mediaType := response.Header.Get("Content-Type")
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = mediaType[:i]
+	}
+	if mediaType == "" || mediaType == "application/json" {
+		var probe struct {
+			MediaType string `json:"mediaType"`
+		}
+		_ = json.Unmarshal(body, &probe)
+		mediaType = probe.MediaType
+	}
+
+	return mediaType, body, nil
+}
+
+// GetBlob returns the contents of the blob with the given digest.
+func (registry *Registry) GetBlob(ref Reference, digest string) (io.ReadCloser, error) {
+	response, err := registry.do(ref, "pull", func() (*http.Request, error) {
+		return http.NewRequest(http.MethodGet, registry.url(ref, "blobs", digest), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// BlobExists returns whether the blob with the given digest is already in the
+// repository.
+func (registry *Registry) BlobExists(ref Reference, digest string) (bool, error) {
+	response, err := registry.do(ref, "pull,push", func() (*http.Request, error) {
+		return http.NewRequest(http.MethodHead, registry.url(ref, "blobs", digest), nil)
+	})
+	if err != nil {
+		if registryErr, ok := err.(RegistryError); ok && strings.HasPrefix(registryErr.Status, "404") {
+			return false, nil
+		}
+		return false, err
+	}
+	response.Body.Close()
+	return true, nil
+}
+
+// PutBlob uploads a blob in a single request. open is called for every
+// attempt, as authenticating may require the upload to be sent again.
+func (registry *Registry) PutBlob(ref Reference, digest string, size int64, open func() (io.ReadCloser, error)) error {
+	response, err := registry.do(ref, "pull,push", func() (*http.Request, error) {
+		return http.NewRequest(http.MethodPost, registry.url(ref, "blobs", "uploads")+"/", nil)
+	})
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	location, err := response.Request.URL.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return err
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	log.WithFields(log.Fields{"digest": digest, "size": size}).Debug("uploading blob")
+	response, err = registry.do(ref, "pull,push", func() (*http.Request, error) {
+		body, err := open()
+		if err != nil {
+			return nil, err
+		}
+		request, err := http.NewRequest(http.MethodPut, location.String(), body)
+		if err != nil {
+			body.Close()
+			return nil, err
+		}
+		request.ContentLength = size
+		request.Header.Set("Content-Type", "application/octet-stream")
+		return request, nil
+	})
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	return nil
+}
+
+// PutManifest uploads a manifest under the given tag or digest.
+func (registry *Registry) PutManifest(ref Reference, identifier string, mediaType string, manifest []byte) error {
+	response, err := registry.do(ref, "pull,push", func() (*http.Request, error) {
+		request, err := http.NewRequest(http.MethodPut, registry.url(ref, "manifests", identifier), bytes.NewReader(manifest))
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Content-Type", mediaType)
+		return request, nil
+	})
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	return nil
+}
+
+func (registry *Registry) url(ref Reference, kind string, identifier string) string {
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s", ref.scheme(), ref.apiHost(), ref.Repository, kind, identifier)
+}
+
+// do sends the request built by newRequest, authenticating and sending it
+// again when the registry challenges for credentials.
+func (registry *Registry) do(ref Reference, actions string, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	scope := fmt.Sprintf("repository:%s:%s", ref.Repository, actions)
+	authKey := ref.apiHost() + " " + scope
+
+	response, err := registry.send(newRequest, registry.authorization[authKey])
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		challenge := response.Header.Get("WWW-Authenticate")
+		response.Body.Close()
+
+		authorization, err := registry.authorize(ref, scope, challenge)
+		if err != nil {
+			return nil, err
+		}
+		registry.authorization[authKey] = authorization
+
+		response, err = registry.send(newRequest, authorization)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if response.StatusCode >= 400 {
+		defer response.Body.Close()
+		rawBytes, _ := ioutil.ReadAll(response.Body)
+		return nil, RegistryError{
+			Method:      response.Request.Method,
+			URL:         response.Request.URL.String(),
+			Status:      response.Status,
+			RawResponse: rawBytes,
+		}
+	}
+
+	return response, nil
+}
+
+func (registry *Registry) send(newRequest func() (*http.Request, error), authorization string) (*http.Response, error) {
+	request, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	return registry.HTTPClient.Do(request)
+}
+
+var challengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+func (registry *Registry) authorize(ref Reference, scope string, challenge string) (string, error) {
+	credentials := registry.credentials[ref.Host]
+
+	scheme := strings.ToLower(strings.SplitN(challenge, " ", 2)[0])
+	switch scheme {
+	case "basic":
+		request, _ := http.NewRequest(http.MethodGet, "/", nil)
+		request.SetBasicAuth(credentials.Username, credentials.Password)
+		return request.Header.Get("Authorization"), nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("unsupported registry authentication challenge '%s'", challenge)
+	}
+
+	params := map[string]string{}
+	for _, match := range challengeParam.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", err
+	}
+	query := tokenURL.Query()
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	request, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if credentials.Username != "" {
+		request.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	response, err := registry.HTTPClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode >= 400 {
+		return "", RegistryError{
+			Method:      request.Method,
+			URL:         tokenURL.String(),
+			Status:      response.Status,
+			RawResponse: rawBytes,
+		}
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.Unmarshal(rawBytes, &token)
+	if err != nil {
+		return "", err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+
+	return "Bearer " + token.Token, nil
+}
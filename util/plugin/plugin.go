@@ -81,7 +81,9 @@ func RunPlugin(plugin configv3.Plugin) error {
 
 func getCFConfigAndCommandUIObjects() (*configv3.Config, *ui.UI, error) {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Verbose:    common.Commands.VerboseOrVersion,
+		PreferIPv4: common.Commands.PreferIPv4,
+		PreferIPv6: common.Commands.PreferIPv6,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -0,0 +1,64 @@
+package progressbar
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+)
+
+// plainReportStep is the percentage of the file between two progress lines.
+const plainReportStep = 25
+
+// PlainReader reports the progress of reading a file as timestamped lines
+// instead of an animated bar, so that logs which do not interpret terminal
+// control characters, such as those of CI systems, stay readable.
+type PlainReader struct {
+	reader   io.Reader
+	out      io.Writer
+	size     int64
+	read     int64
+	reported int
+
+	// Now returns the time that progress lines are stamped with.
+	Now func() time.Time
+}
+
+// NewPlainReader wraps the reader of a file of the given size so that its
+// progress is written to out every 25%.
+func NewPlainReader(out io.Writer, reader io.Reader, size int64) *PlainReader {
+	return &PlainReader{
+		reader:   reader,
+		out:      out,
+		size:     size,
+		reported: -1,
+		Now:      time.Now,
+	}
+}
+
+func (r *PlainReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	percent := 100
+	if r.size > 0 && r.read < r.size {
+		percent = int(r.read * 100 / r.size)
+	}
+	if step := percent / plainReportStep * plainReportStep; step > r.reported && (n > 0 || err == io.EOF) {
+		r.reported = step
+		fmt.Fprintf(r.out, "%s %3d%% (%s of %s)\n",
+			r.Now().UTC().Format(time.RFC3339), step,
+			bytefmt.ByteSize(uint64(r.read)), bytefmt.ByteSize(uint64(r.size)))
+	}
+
+	return n, err
+}
+
+// Close closes the wrapped reader when it is an io.Closer.
+func (r *PlainReader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
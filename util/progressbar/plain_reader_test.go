@@ -0,0 +1,56 @@
+package progressbar_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing/iotest"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/progressbar"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PlainReader", func() {
+	var (
+		out    *Buffer
+		reader *PlainReader
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		// Reading one byte at a time passes every threshold.
+		reader = NewPlainReader(out, iotest.OneByteReader(strings.NewReader(strings.Repeat("a", 2048))), 2048)
+		reader.Now = func() time.Time {
+			return time.Date(2026, 10, 14, 12, 30, 0, 0, time.UTC)
+		}
+	})
+
+	It("passes the content through", func() {
+		content, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(content).To(Equal(bytes.Repeat([]byte("a"), 2048)))
+	})
+
+	It("writes a timestamped line every 25%", func() {
+		_, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(string(out.Contents())).To(Equal(
+			"2026-10-14T12:30:00Z   0% (1B of 2K)\n" +
+				"2026-10-14T12:30:00Z  25% (512B of 2K)\n" +
+				"2026-10-14T12:30:00Z  50% (1K of 2K)\n" +
+				"2026-10-14T12:30:00Z  75% (1.5K of 2K)\n" +
+				"2026-10-14T12:30:00Z 100% (2K of 2K)\n",
+		))
+	})
+
+	It("does not use terminal control characters", func() {
+		_, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out.Contents())).ToNot(ContainSubstring("\r"))
+		Expect(string(out.Contents())).ToNot(ContainSubstring("\x1b"))
+	})
+})
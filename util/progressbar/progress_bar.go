@@ -11,6 +11,9 @@ import (
 type ProgressBar struct {
 	ready chan bool
 	bar   *pb.ProgressBar
+
+	// plainOut is where progress lines are written instead of drawing a bar.
+	plainOut io.Writer
 }
 
 func NewProgressBar() *ProgressBar {
@@ -19,7 +22,20 @@ func NewProgressBar() *ProgressBar {
 	}
 }
 
+// NewPlainProgressBar returns a ProgressBar that writes timestamped progress
+// lines to out instead of drawing an animated bar.
+func NewPlainProgressBar(out io.Writer) *ProgressBar {
+	return &ProgressBar{
+		ready:    make(chan bool),
+		plainOut: out,
+	}
+}
+
 func (p *ProgressBar) Complete() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
@@ -33,6 +49,11 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 		return nil
 	}
 
+	if p.plainOut != nil {
+		log.Debug("plain progress ready")
+		return NewPlainReader(p.plainOut, reader, sizeOfFile)
+	}
+
 	log.Debug("progress bar ready")
 	p.bar = pb.New(int(sizeOfFile)).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
@@ -0,0 +1,13 @@
+package progressbar_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestProgressbar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Progressbar Suite")
+}
@@ -0,0 +1,79 @@
+// Package projectconfig reads the defaults a project sets for its commands in
+// a .cf/config.yml file at the root of the project.
+package projectconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RelativePath is the location of the config file in a project directory.
+var RelativePath = filepath.Join(".cf", "config.yml")
+
+// Config is the content of a project config file.
+type Config struct {
+	// Path is the location the config was read from.
+	Path string `yaml:"-"`
+
+	Push PushDefaults `yaml:"push"`
+}
+
+// PushDefaults are used by push for the flags that are not given. Buildpacks
+// only apply to the apps of the manifest that set neither buildpacks nor a
+// docker image.
+type PushDefaults struct {
+	Manifest   string   `yaml:"manifest"`
+	VarsFiles  []string `yaml:"vars_files"`
+	Strategy   string   `yaml:"strategy"`
+	Buildpacks []string `yaml:"buildpacks"`
+}
+
+// Load returns the config of the project dir belongs to, looking for
+// .cf/config.yml in dir and then in each of its parents. It returns false when
+// none of them has one.
+func Load(dir string) (Config, bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return Config{}, false, err
+	}
+
+	for {
+		path := filepath.Join(dir, RelativePath)
+		raw, err := ioutil.ReadFile(path)
+		if err == nil {
+			config := Config{Path: path}
+			err = yaml.UnmarshalStrict(raw, &config)
+			if err != nil {
+				return Config{}, false, fmt.Errorf("Unable to read project config %s: %s", path, err)
+			}
+			return config, true, nil
+		}
+		if !os.IsNotExist(err) {
+			return Config{}, false, err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return Config{}, false, nil
+		}
+		dir = parent
+	}
+}
+
+// ProjectDir returns the directory of the project the config belongs to.
+func (c Config) ProjectDir() string {
+	return filepath.Dir(filepath.Dir(c.Path))
+}
+
+// ResolvePath returns path relative to the project directory, unless it is
+// absolute already.
+func (c Config) ResolvePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.ProjectDir(), path)
+}
@@ -0,0 +1,13 @@
+package projectconfig_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestProjectconfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Projectconfig Suite")
+}
@@ -0,0 +1,88 @@
+package projectconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/projectconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Load", func() {
+	var (
+		projectDir string
+		workDir    string
+	)
+
+	BeforeEach(func() {
+		var err error
+		projectDir, err = ioutil.TempDir("", "projectconfig")
+		Expect(err).ToNot(HaveOccurred())
+		projectDir, err = filepath.EvalSymlinks(projectDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		workDir = filepath.Join(projectDir, "services", "api")
+		Expect(os.MkdirAll(workDir, 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(projectDir)).To(Succeed())
+	})
+
+	writeConfig := func(dir string, content string) {
+		Expect(os.MkdirAll(filepath.Join(dir, ".cf"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, ".cf", "config.yml"), []byte(content), 0600)).To(Succeed())
+	}
+
+	It("finds the config in a parent directory", func() {
+		writeConfig(projectDir, `
+push:
+  manifest: deploy/manifest.yml
+  vars_files: [deploy/vars.yml]
+  strategy: rolling
+  buildpacks: [go_buildpack]
+`)
+
+		config, found, err := Load(workDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		Expect(config.Path).To(Equal(filepath.Join(projectDir, ".cf", "config.yml")))
+		Expect(config.ProjectDir()).To(Equal(projectDir))
+		Expect(config.Push).To(Equal(PushDefaults{
+			Manifest:   "deploy/manifest.yml",
+			VarsFiles:  []string{"deploy/vars.yml"},
+			Strategy:   "rolling",
+			Buildpacks: []string{"go_buildpack"},
+		}))
+		Expect(config.ResolvePath(config.Push.Manifest)).To(Equal(filepath.Join(projectDir, "deploy", "manifest.yml")))
+		Expect(config.ResolvePath("/abs/vars.yml")).To(Equal("/abs/vars.yml"))
+	})
+
+	It("uses the closest config", func() {
+		writeConfig(projectDir, "push: {strategy: rolling}")
+		writeConfig(workDir, "push: {strategy: canary}")
+
+		config, found, err := Load(workDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(config.Push.Strategy).To(Equal("canary"))
+		Expect(config.ProjectDir()).To(Equal(workDir))
+	})
+
+	It("returns false when there is no config", func() {
+		_, found, err := Load(workDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("returns an error for unknown keys", func() {
+		writeConfig(projectDir, "push: {manfest: manifest.yml}")
+
+		_, _, err := Load(workDir)
+		Expect(err).To(MatchError(ContainSubstring("Unable to read project config " + filepath.Join(projectDir, ".cf", "config.yml"))))
+	})
+})
@@ -0,0 +1,139 @@
+// Package requeststats collects statistics about the API requests made while
+// a command runs, so that they can be summarized when it ends.
+package requeststats
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSlowestEndpoints is the number of endpoints listed in a summary.
+const maxSlowestEndpoints = 5
+
+var guidSegment = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Collector records the API requests made by the API clients. It is safe for
+// concurrent use.
+type Collector struct {
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	mutex     sync.Mutex
+	start     time.Time
+	sent      map[*http.Request]bool
+	summary   Summary
+	endpoints map[string]*EndpointStats
+}
+
+// Summary is the statistics of all the requests recorded by a Collector.
+type Summary struct {
+	// Duration is the time since the collector was created.
+	Duration time.Duration
+	// Calls is the number of requests, including retries.
+	Calls int
+	// Retries is the number of requests that were sent again after failing.
+	Retries       int
+	BytesSent     int64
+	BytesReceived int64
+	// SlowestEndpoints are the endpoints that took the longest in total,
+	// slowest first.
+	SlowestEndpoints []EndpointStats
+}
+
+// EndpointStats is the statistics of the requests to one endpoint. GUIDs in
+// the path are replaced with ":guid" so that requests for different resources
+// of the same kind are counted together.
+type EndpointStats struct {
+	Method        string
+	Endpoint      string
+	Calls         int
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+}
+
+// NewCollector returns a Collector that measures durations from now.
+func NewCollector() *Collector {
+	return &Collector{
+		Now:       time.Now,
+		start:     time.Now(),
+		sent:      map[*http.Request]bool{},
+		endpoints: map[string]*EndpointStats{},
+	}
+}
+
+// Record adds a request that took duration and received the given number of
+// response body bytes. Sending the same request again is counted as a retry.
+func (collector *Collector) Record(request *http.Request, bytesReceived int64, duration time.Duration) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	collector.summary.Calls++
+	if collector.sent[request] {
+		collector.summary.Retries++
+	}
+	collector.sent[request] = true
+
+	if request.ContentLength > 0 {
+		collector.summary.BytesSent += request.ContentLength
+	}
+	if bytesReceived > 0 {
+		collector.summary.BytesReceived += bytesReceived
+	}
+
+	endpoint := normalizeEndpoint(request)
+	key := request.Method + " " + endpoint
+	stats, ok := collector.endpoints[key]
+	if !ok {
+		stats = &EndpointStats{Method: request.Method, Endpoint: endpoint}
+		collector.endpoints[key] = stats
+	}
+	stats.Calls++
+	stats.TotalDuration += duration
+	if duration > stats.MaxDuration {
+		stats.MaxDuration = duration
+	}
+}
+
+// Summary returns the statistics of the requests recorded so far.
+func (collector *Collector) Summary() Summary {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	summary := collector.summary
+	summary.Duration = collector.Now().Sub(collector.start)
+
+	summary.SlowestEndpoints = make([]EndpointStats, 0, len(collector.endpoints))
+	for _, stats := range collector.endpoints {
+		summary.SlowestEndpoints = append(summary.SlowestEndpoints, *stats)
+	}
+	sort.Slice(summary.SlowestEndpoints, func(i, j int) bool {
+		a, b := summary.SlowestEndpoints[i], summary.SlowestEndpoints[j]
+		if a.TotalDuration != b.TotalDuration {
+			return a.TotalDuration > b.TotalDuration
+		}
+		return a.Method+" "+a.Endpoint < b.Method+" "+b.Endpoint
+	})
+	if len(summary.SlowestEndpoints) > maxSlowestEndpoints {
+		summary.SlowestEndpoints = summary.SlowestEndpoints[:maxSlowestEndpoints]
+	}
+
+	return summary
+}
+
+func normalizeEndpoint(request *http.Request) string {
+	if request.URL == nil {
+		return ""
+	}
+
+	segments := strings.Split(request.URL.Path, "/")
+	for i, segment := range segments {
+		if guidSegment.MatchString(segment) {
+			segments[i] = ":guid"
+		}
+	}
+	return request.URL.Host + strings.Join(segments, "/")
+}
@@ -0,0 +1,72 @@
+package requeststats_test
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/requeststats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Collector", func() {
+	var collector *Collector
+
+	newRequest := func(method string, url string, body string) *http.Request {
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		return request
+	}
+
+	BeforeEach(func() {
+		collector = NewCollector()
+	})
+
+	It("measures the duration since the collector was created", func() {
+		collector.Now = func() time.Time { return time.Now().Add(2 * time.Second) }
+		Expect(collector.Summary().Duration).To(BeNumerically(">=", 2*time.Second))
+	})
+
+	It("counts the calls and the bytes sent and received", func() {
+		collector.Record(newRequest(http.MethodPost, "https://api.example.com/v3/apps", "12345"), 100, time.Second)
+		collector.Record(newRequest(http.MethodGet, "https://api.example.com/v3/apps", ""), 50, time.Second)
+
+		summary := collector.Summary()
+		Expect(summary.Calls).To(Equal(2))
+		Expect(summary.Retries).To(Equal(0))
+		Expect(summary.BytesSent).To(Equal(int64(5)))
+		Expect(summary.BytesReceived).To(Equal(int64(150)))
+	})
+
+	It("counts a request that is sent again as a retry", func() {
+		request := newRequest(http.MethodGet, "https://api.example.com/v3/apps", "")
+		collector.Record(request, 0, time.Second)
+		collector.Record(request, 10, time.Second)
+
+		summary := collector.Summary()
+		Expect(summary.Calls).To(Equal(2))
+		Expect(summary.Retries).To(Equal(1))
+	})
+
+	It("groups the requests by endpoint, slowest first", func() {
+		collector.Record(newRequest(http.MethodGet, "https://api.example.com/v3/apps/8e0ee3a1-96a6-4d5c-a899-cbd33e4b4b2c", ""), 0, 300*time.Millisecond)
+		collector.Record(newRequest(http.MethodGet, "https://api.example.com/v3/apps/0b9e8a4c-1b7f-4b8e-9d3e-2d2f8a3c1e5f?include=space", ""), 0, 500*time.Millisecond)
+		collector.Record(newRequest(http.MethodGet, "https://api.example.com/v3/spaces", ""), 0, 600*time.Millisecond)
+		collector.Record(newRequest(http.MethodPost, "https://uaa.example.com/oauth/token", ""), 0, 100*time.Millisecond)
+
+		Expect(collector.Summary().SlowestEndpoints).To(Equal([]EndpointStats{
+			{Method: http.MethodGet, Endpoint: "api.example.com/v3/apps/:guid", Calls: 2, TotalDuration: 800 * time.Millisecond, MaxDuration: 500 * time.Millisecond},
+			{Method: http.MethodGet, Endpoint: "api.example.com/v3/spaces", Calls: 1, TotalDuration: 600 * time.Millisecond, MaxDuration: 600 * time.Millisecond},
+			{Method: http.MethodPost, Endpoint: "uaa.example.com/oauth/token", Calls: 1, TotalDuration: 100 * time.Millisecond, MaxDuration: 100 * time.Millisecond},
+		}))
+	})
+
+	It("lists at most 5 endpoints", func() {
+		for _, path := range []string{"a", "b", "c", "d", "e", "f"} {
+			collector.Record(newRequest(http.MethodGet, "https://api.example.com/"+path, ""), 0, time.Second)
+		}
+
+		Expect(collector.Summary().SlowestEndpoints).To(HaveLen(5))
+	})
+})
@@ -0,0 +1,13 @@
+package requeststats_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRequeststats(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Requeststats Suite")
+}
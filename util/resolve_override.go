@@ -0,0 +1,61 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ResolveOverride pins connections to a host and port to a list of addresses
+// instead of resolving the host through DNS.
+type ResolveOverride struct {
+	Host      string
+	Port      string
+	Addresses []net.IP
+}
+
+// ParseResolveOverride parses an override in the HOST:PORT:ADDRESS[,ADDRESS]
+// form. IPv6 addresses may be enclosed in brackets.
+func ParseResolveOverride(value string) (ResolveOverride, error) {
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return ResolveOverride{}, fmt.Errorf("%q is not in the HOST:PORT:ADDRESS form", value)
+	}
+
+	port, err := strconv.Atoi(parts[1])
+	if err != nil || port < 1 || port > 65535 {
+		return ResolveOverride{}, fmt.Errorf("%q is not a valid port", parts[1])
+	}
+
+	override := ResolveOverride{
+		Host: strings.ToLower(parts[0]),
+		Port: parts[1],
+	}
+	for _, rawAddress := range strings.Split(parts[2], ",") {
+		address := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(rawAddress, "["), "]"))
+		if address == nil {
+			return ResolveOverride{}, fmt.Errorf("%q is not a valid IP address", rawAddress)
+		}
+		override.Addresses = append(override.Addresses, address)
+	}
+	return override, nil
+}
+
+// Matches returns true when the override applies to the host and port.
+func (override ResolveOverride) Matches(host string, port string) bool {
+	return strings.EqualFold(override.Host, host) && override.Port == port
+}
+
+// String returns the override in the HOST:PORT:ADDRESS[,ADDRESS] form.
+func (override ResolveOverride) String() string {
+	addresses := make([]string, 0, len(override.Addresses))
+	for _, address := range override.Addresses {
+		if address.To4() == nil {
+			addresses = append(addresses, "["+address.String()+"]")
+		} else {
+			addresses = append(addresses, address.String())
+		}
+	}
+	return fmt.Sprintf("%s:%s:%s", override.Host, override.Port, strings.Join(addresses, ","))
+}
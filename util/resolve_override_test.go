@@ -0,0 +1,64 @@
+package util_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResolveOverride", func() {
+	Describe("ParseResolveOverride", func() {
+		It("parses the host, port and addresses", func() {
+			override, err := ParseResolveOverride("API.example.com:443:10.0.0.5,[::1]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(override).To(Equal(ResolveOverride{
+				Host:      "api.example.com",
+				Port:      "443",
+				Addresses: []net.IP{net.ParseIP("10.0.0.5"), net.ParseIP("::1")},
+			}))
+		})
+
+		DescribeTable("rejects invalid overrides",
+			func(value string, expectedErr string) {
+				_, err := ParseResolveOverride(value)
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("missing address", "api.example.com:443", `"api.example.com:443" is not in the HOST:PORT:ADDRESS form`),
+			Entry("empty host", ":443:10.0.0.5", `":443:10.0.0.5" is not in the HOST:PORT:ADDRESS form`),
+			Entry("non-numeric port", "api.example.com:https:10.0.0.5", `"https" is not a valid port`),
+			Entry("port out of range", "api.example.com:70000:10.0.0.5", `"70000" is not a valid port`),
+			Entry("invalid address", "api.example.com:443:not-an-ip", `"not-an-ip" is not a valid IP address`),
+		)
+	})
+
+	Describe("Matches", func() {
+		var override ResolveOverride
+
+		BeforeEach(func() {
+			override = ResolveOverride{Host: "api.example.com", Port: "443"}
+		})
+
+		It("matches the host case-insensitively", func() {
+			Expect(override.Matches("API.Example.com", "443")).To(BeTrue())
+		})
+
+		It("does not match other ports or hosts", func() {
+			Expect(override.Matches("api.example.com", "80")).To(BeFalse())
+			Expect(override.Matches("uaa.example.com", "443")).To(BeFalse())
+		})
+	})
+
+	Describe("String", func() {
+		It("returns the override in the form it is parsed from", func() {
+			override := ResolveOverride{
+				Host:      "api.example.com",
+				Port:      "443",
+				Addresses: []net.IP{net.ParseIP("10.0.0.5"), net.ParseIP("::1")},
+			}
+			Expect(override.String()).To(Equal("api.example.com:443:10.0.0.5,[::1]"))
+		})
+	})
+})
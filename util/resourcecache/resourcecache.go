@@ -0,0 +1,167 @@
+// Package resourcecache remembers, between pushes of an app, the SHA1s of its
+// files and which of them the Cloud Controller already had, so that a push
+// does not hash the files that did not change and asks the Cloud Controller
+// only about the files it has not answered for recently.
+package resourcecache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// MatchTTL is how long the answer of the Cloud Controller about whether it
+// has a file is trusted.
+const MatchTTL = 24 * time.Hour
+
+// racyWindow is how much older than its hash a file must be for the hash to
+// be trusted: a file modified in the same tick as it was hashed can change
+// again without its modification time changing.
+const racyWindow = 2 * time.Second
+
+// Fingerprint is the SHA1 of a file when it had the size and modification
+// time.
+type Fingerprint struct {
+	Size     int64     `json:"size"`
+	ModTime  time.Time `json:"mod_time"`
+	SHA1     string    `json:"sha1"`
+	HashedAt time.Time `json:"hashed_at"`
+}
+
+// Match is the answer of the Cloud Controller about whether it has a file.
+type Match struct {
+	Matched   bool      `json:"matched"`
+	Size      int64     `json:"size"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Cache is what is remembered about the files of an app. It is not safe for
+// concurrent use.
+type Cache struct {
+	// Files are the fingerprints of the files by their paths.
+	Files map[string]Fingerprint `json:"files"`
+	// Matches are the answers of the Cloud Controller by SHA1.
+	Matches map[string]Match `json:"matches"`
+
+	now func() time.Time
+}
+
+// New returns an empty cache.
+func New() *Cache {
+	return &Cache{
+		Files:   map[string]Fingerprint{},
+		Matches: map[string]Match{},
+		now:     time.Now,
+	}
+}
+
+// Read reads a cache saved with Write. It returns an empty cache when none
+// has been saved to path.
+func Read(path string) (*Cache, error) {
+	cache := New()
+
+	rawCache, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return cache, err
+	}
+
+	err = json.Unmarshal(rawCache, cache)
+	if err != nil {
+		return New(), err
+	}
+	if cache.Files == nil {
+		cache.Files = map[string]Fingerprint{}
+	}
+	if cache.Matches == nil {
+		cache.Matches = map[string]Match{}
+	}
+	return cache, nil
+}
+
+// Write saves the cache to path, creating its directory, without the matches
+// that expired.
+func (cache *Cache) Write(path string) error {
+	for sha1, match := range cache.Matches {
+		if !cache.fresh(match) {
+			delete(cache.Matches, sha1)
+		}
+	}
+
+	rawCache, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, rawCache, 0600)
+}
+
+// SHA1 returns the SHA1 the file at path had when it was last hashed, if its
+// size and modification time did not change since.
+func (cache *Cache) SHA1(path string, info os.FileInfo) (string, bool) {
+	fingerprint, ok := cache.Files[path]
+	if !ok || fingerprint.Size != info.Size() || !fingerprint.ModTime.Equal(info.ModTime()) {
+		return "", false
+	}
+	if !info.ModTime().Before(fingerprint.HashedAt.Add(-racyWindow)) {
+		return "", false
+	}
+	return fingerprint.SHA1, true
+}
+
+// SetSHA1 remembers the SHA1 of the file at path.
+func (cache *Cache) SetSHA1(path string, info os.FileInfo, sha1 string) {
+	cache.Files[path] = Fingerprint{
+		Size:     info.Size(),
+		ModTime:  info.ModTime(),
+		SHA1:     sha1,
+		HashedAt: cache.now(),
+	}
+}
+
+// KeepFiles forgets the fingerprints of the files that are not in paths, such as
+// the deleted ones.
+func (cache *Cache) KeepFiles(paths []string) {
+	kept := make(map[string]Fingerprint, len(paths))
+	for _, path := range paths {
+		if fingerprint, ok := cache.Files[path]; ok {
+			kept[path] = fingerprint
+		}
+	}
+	cache.Files = kept
+}
+
+// Matched returns whether the Cloud Controller had the file with the SHA1 and
+// size, if it answered less than MatchTTL ago.
+func (cache *Cache) Matched(sha1 string, size int64) (bool, bool) {
+	match, ok := cache.Matches[sha1]
+	if !ok || match.Size != size || !cache.fresh(match) {
+		return false, false
+	}
+	return match.Matched, true
+}
+
+// SetMatched remembers whether the Cloud Controller has the file with the
+// SHA1 and size.
+func (cache *Cache) SetMatched(sha1 string, size int64, matched bool) {
+	cache.Matches[sha1] = Match{Matched: matched, Size: size, CheckedAt: cache.now()}
+}
+
+// ForgetMatches forgets the answers of the Cloud Controller, e.g. because a
+// package made of the files it was supposed to have failed.
+func (cache *Cache) ForgetMatches() {
+	cache.Matches = map[string]Match{}
+}
+
+func (cache *Cache) fresh(match Match) bool {
+	return cache.now().Sub(match.CheckedAt) < MatchTTL
+}
@@ -0,0 +1,13 @@
+package resourcecache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestResourcecache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Resourcecache Suite")
+}
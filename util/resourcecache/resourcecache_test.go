@@ -0,0 +1,161 @@
+package resourcecache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/resourcecache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		dir   string
+		cache *Cache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		cache = New()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeFile := func(name string, contents string, modTime time.Time) os.FileInfo {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		return info
+	}
+
+	Describe("SHA1", func() {
+		It("returns the SHA1 of a file that did not change since it was hashed", func() {
+			info := writeFile("some-file", "contents", time.Now().Add(-time.Hour))
+			cache.SetSHA1("some-file", info, "some-sha")
+
+			sha, ok := cache.SHA1("some-file", info)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		It("does not return the SHA1 of a file whose size or modification time changed", func() {
+			info := writeFile("some-file", "contents", time.Now().Add(-time.Hour))
+			cache.SetSHA1("some-file", info, "some-sha")
+
+			_, ok := cache.SHA1("some-file", writeFile("some-file", "other contents", info.ModTime()))
+			Expect(ok).To(BeFalse())
+
+			_, ok = cache.SHA1("some-file", writeFile("some-file", "contents", info.ModTime().Add(time.Minute)))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not trust the SHA1 of a file modified right before it was hashed", func() {
+			info := writeFile("some-file", "contents", time.Now())
+			cache.SetSHA1("some-file", info, "some-sha")
+
+			_, ok := cache.SHA1("some-file", info)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("forgets the files that are not kept", func() {
+			info := writeFile("some-file", "contents", time.Now().Add(-time.Hour))
+			cache.SetSHA1("some-file", info, "some-sha")
+			cache.SetSHA1("deleted-file", info, "other-sha")
+
+			cache.KeepFiles([]string{"some-file"})
+			Expect(cache.Files).To(HaveLen(1))
+			Expect(cache.Files).To(HaveKey("some-file"))
+		})
+	})
+
+	Describe("Matched", func() {
+		It("returns the recent answers for the SHA1 and size", func() {
+			cache.SetMatched("matched-sha", 10, true)
+			cache.SetMatched("unmatched-sha", 10, false)
+
+			matched, known := cache.Matched("matched-sha", 10)
+			Expect(known).To(BeTrue())
+			Expect(matched).To(BeTrue())
+
+			matched, known = cache.Matched("unmatched-sha", 10)
+			Expect(known).To(BeTrue())
+			Expect(matched).To(BeFalse())
+
+			_, known = cache.Matched("matched-sha", 11)
+			Expect(known).To(BeFalse())
+
+			_, known = cache.Matched("unknown-sha", 10)
+			Expect(known).To(BeFalse())
+		})
+
+		It("does not return the answers older than MatchTTL", func() {
+			cache.Matches["old-sha"] = Match{Matched: true, Size: 10, CheckedAt: time.Now().Add(-MatchTTL)}
+
+			_, known := cache.Matched("old-sha", 10)
+			Expect(known).To(BeFalse())
+		})
+
+		It("forgets every answer", func() {
+			cache.SetMatched("matched-sha", 10, true)
+			cache.ForgetMatches()
+
+			_, known := cache.Matched("matched-sha", 10)
+			Expect(known).To(BeFalse())
+		})
+	})
+
+	Describe("Read and Write", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(dir, "some-space-guid", "some-app.json")
+		})
+
+		It("reads an empty cache when none was written", func() {
+			readCache, err := Read(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readCache.Files).To(BeEmpty())
+			Expect(readCache.Matches).To(BeEmpty())
+		})
+
+		It("reads back what was written, without the expired answers", func() {
+			info := writeFile("some-file", "contents", time.Now().Add(-time.Hour))
+			cache.SetSHA1("some-file", info, "some-sha")
+			cache.SetMatched("matched-sha", 10, true)
+			cache.Matches["old-sha"] = Match{Matched: true, Size: 10, CheckedAt: time.Now().Add(-2 * MatchTTL)}
+			Expect(cache.Write(path)).To(Succeed())
+
+			readCache, err := Read(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			sha, ok := readCache.SHA1("some-file", info)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+
+			matched, known := readCache.Matched("matched-sha", 10)
+			Expect(known).To(BeTrue())
+			Expect(matched).To(BeTrue())
+			Expect(readCache.Matches).ToNot(HaveKey("old-sha"))
+		})
+
+		It("returns an empty cache and the error when the cache is corrupt", func() {
+			Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("{"), 0600)).To(Succeed())
+
+			readCache, err := Read(path)
+			Expect(err).To(HaveOccurred())
+			Expect(readCache.Files).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,152 @@
+// Package routeprobe connects to a route through the platform edge and
+// reports on the TLS certificate and HTTP response it serves.
+package routeprobe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
+
+// Certificate describes the leaf certificate served for a route.
+type Certificate struct {
+	Subject   string
+	Issuer    string
+	DNSNames  []string
+	NotBefore time.Time
+	NotAfter  time.Time
+
+	// CoversHost is true when the certificate's SANs match the route host.
+	CoversHost bool
+	// VerifyError is the reason the certificate chain is not trusted, if any.
+	VerifyError error
+}
+
+// Result is the outcome of probing a route.
+type Result struct {
+	Host         string
+	Certificate  Certificate
+	StatusCode   int
+	Status       string
+	ResponseTime time.Duration
+}
+
+// Prober probes routes over HTTPS.
+type Prober struct {
+	// Dialer connects to the route, with the address family preference and
+	// resolve overrides of the other clients.
+	Dialer *util.Dialer
+
+	// ClientCertificate is presented to routes that require mutual TLS.
+	ClientCertificate util.ClientCertificate
+
+	// RootCAs overrides the pool used to decide whether the served
+	// certificate chain is trusted. The system pool is used when nil.
+	RootCAs *x509.CertPool
+}
+
+// NewProber returns a Prober that connects with the dialer and presents the
+// client certificate, if set.
+func NewProber(dialer *util.Dialer, clientCertificate util.ClientCertificate) Prober {
+	return Prober{Dialer: dialer, ClientCertificate: clientCertificate}
+}
+
+// Probe connects to the host of rawURL, records the certificate presented for
+// that host via SNI and times a GET request of the URL. Redirects are not
+// followed so that the status of the route itself is reported.
+func (p Prober) Probe(rawURL string) (Result, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return Result{}, err
+	}
+
+	host := target.Hostname()
+	address := target.Host
+	if target.Port() == "" {
+		address = net.JoinHostPort(host, "443")
+	}
+
+	certificate, err := p.fetchCertificate(host, address)
+	if err != nil {
+		return Result{}, err
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			// The certificate has already been reported on; the request must
+			// go through even when it is wrong.
+			TLSClientConfig: p.tlsConfig(host),
+			DialContext:     p.Dialer.DialContext,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	response, err := client.Get(target.String())
+	if err != nil {
+		return Result{}, err
+	}
+	responseTime := time.Since(start)
+	_ = response.Body.Close()
+
+	return Result{
+		Host:         host,
+		Certificate:  certificate,
+		StatusCode:   response.StatusCode,
+		Status:       response.Status,
+		ResponseTime: responseTime,
+	}, nil
+}
+
+// tlsConfig does not verify the served certificate, which is reported on
+// rather than trusted.
+func (p Prober) tlsConfig(host string) *tls.Config {
+	config := util.NewClientTLSConfig(true, p.ClientCertificate)
+	config.ServerName = host
+	return config
+}
+
+func (p Prober) fetchCertificate(host string, address string) (Certificate, error) {
+	rawConn, err := p.Dialer.DialContext(context.Background(), "tcp", address)
+	if err != nil {
+		return Certificate{}, err
+	}
+	conn := tls.Client(rawConn, p.tlsConfig(host))
+	defer conn.Close()
+
+	err = conn.Handshake()
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	peerCertificates := conn.ConnectionState().PeerCertificates
+	leaf := peerCertificates[0]
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range peerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, verifyErr := leaf.Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         p.RootCAs,
+		Intermediates: intermediates,
+	})
+
+	return Certificate{
+		Subject:     leaf.Subject.String(),
+		Issuer:      leaf.Issuer.String(),
+		DNSNames:    leaf.DNSNames,
+		NotBefore:   leaf.NotBefore,
+		NotAfter:    leaf.NotAfter,
+		CoversHost:  leaf.VerifyHostname(host) == nil,
+		VerifyError: verifyErr,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package routeprobe_test
+
+import (
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+	. "code.cloudfoundry.org/cli/util/routeprobe"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prober", func() {
+	var (
+		server *httptest.Server
+		prober Prober
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/moved" {
+				http.Redirect(w, r, "/", http.StatusFound)
+				return
+			}
+			w.WriteHeader(http.StatusTeapot)
+		}))
+
+		pool := x509.NewCertPool()
+		pool.AddCert(server.Certificate())
+		prober = NewProber(util.NewDialer(5*time.Second, util.IPFamilyAny, nil), util.ClientCertificate{})
+		prober.RootCAs = pool
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reports the certificate and the HTTP response", func() {
+		result, err := prober.Probe(server.URL + "/")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.Host).To(Equal("127.0.0.1"))
+		Expect(result.StatusCode).To(Equal(http.StatusTeapot))
+		Expect(result.Status).To(Equal("418 I'm a teapot"))
+		Expect(result.ResponseTime).To(BeNumerically(">", 0))
+
+		Expect(result.Certificate.DNSNames).To(ContainElement("example.com"))
+		Expect(result.Certificate.NotAfter).To(Equal(server.Certificate().NotAfter))
+		Expect(result.Certificate.CoversHost).To(BeTrue())
+		Expect(result.Certificate.VerifyError).ToNot(HaveOccurred())
+	})
+
+	It("does not follow redirects", func() {
+		result, err := prober.Probe(server.URL + "/moved")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.StatusCode).To(Equal(http.StatusFound))
+	})
+
+	When("the host has a resolve override", func() {
+		BeforeEach(func() {
+			serverURL, err := url.Parse(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			prober.Dialer.ResolveOverrides = []util.ResolveOverride{{
+				Host:      "myapp.example.com",
+				Port:      serverURL.Port(),
+				Addresses: []net.IP{net.ParseIP("127.0.0.1")},
+			}}
+		})
+
+		It("connects to the overridden address for both the certificate and the request", func() {
+			result, err := prober.Probe(strings.Replace(server.URL, "127.0.0.1", "myapp.example.com", 1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Host).To(Equal("myapp.example.com"))
+			Expect(result.Certificate.CoversHost).To(BeTrue())
+			Expect(result.StatusCode).To(Equal(http.StatusTeapot))
+		})
+	})
+
+	When("the certificate does not cover the host", func() {
+		It("reports it", func() {
+			result, err := prober.Probe(strings.Replace(server.URL, "127.0.0.1", "localhost", 1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Certificate.CoversHost).To(BeFalse())
+			Expect(result.Certificate.VerifyError).To(HaveOccurred())
+		})
+	})
+
+	When("the certificate chain is not trusted", func() {
+		BeforeEach(func() {
+			prober.RootCAs = x509.NewCertPool()
+		})
+
+		It("reports the verification error and still makes the request", func() {
+			result, err := prober.Probe(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Certificate.VerifyError).To(HaveOccurred())
+			Expect(result.StatusCode).To(Equal(http.StatusTeapot))
+		})
+	})
+
+	When("the route cannot be reached", func() {
+		It("returns the error", func() {
+			server.Close()
+			_, err := prober.Probe(server.URL)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
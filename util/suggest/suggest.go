@@ -0,0 +1,96 @@
+// Package suggest finds the valid names closest to a mistyped one, so that
+// the CLI can ask "did you mean?".
+package suggest
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxDistance is the largest number of edits a suggestion may be from the
+// mistyped name, no matter how long the name is.
+const maxDistance = 3
+
+// Nearest returns the candidates that are the fewest edits away from name,
+// ignoring case. Candidates further than a third of the length of name, or
+// more than 3 edits, away are never suggested. The result is sorted
+// alphabetically and is empty when no candidate is close enough.
+func Nearest(name string, candidates []string) []string {
+	name = strings.ToLower(name)
+	if name == "" {
+		return nil
+	}
+
+	threshold := len(name) / 3
+	if threshold < 1 {
+		threshold = 1
+	} else if threshold > maxDistance {
+		threshold = maxDistance
+	}
+
+	var nearest []string
+	best := threshold + 1
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		if candidate == "" || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+
+		distance := Distance(name, strings.ToLower(candidate))
+		switch {
+		case distance < best:
+			best = distance
+			nearest = []string{candidate}
+		case distance == best:
+			nearest = append(nearest, candidate)
+		}
+	}
+
+	sort.Strings(nearest)
+	return nearest
+}
+
+// Distance returns the number of single character insertions, deletions,
+// substitutions and transpositions of adjacent characters needed to turn a
+// into b.
+func Distance(a string, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	// rows[i][j] is the distance between the first i runes of source and the
+	// first j runes of target. Only the last three rows are needed.
+	previousPrevious := make([]int, len(target)+1)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && source[i-1] == target[j-2] && source[i-2] == target[j-1] {
+				current[j] = min(current[j], previousPrevious[j-2]+1)
+			}
+		}
+		previousPrevious, previous, current = previous, current, previousPrevious
+	}
+
+	return previous[len(target)]
+}
+
+func min(values ...int) int {
+	smallest := values[0]
+	for _, value := range values[1:] {
+		if value < smallest {
+			smallest = value
+		}
+	}
+	return smallest
+}
@@ -0,0 +1,13 @@
+package suggest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Suggest Suite")
+}
@@ -0,0 +1,47 @@
+package suggest_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/suggest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Suggest", func() {
+	DescribeTable("Distance",
+		func(a string, b string, expectedDistance int) {
+			Expect(Distance(a, b)).To(Equal(expectedDistance))
+		},
+		Entry("equal strings", "push", "push", 0),
+		Entry("a missing character", "pus", "push", 1),
+		Entry("an extra character", "pushh", "push", 1),
+		Entry("a substituted character", "pish", "push", 1),
+		Entry("transposed characters", "tagret", "target", 1),
+		Entry("an empty string", "", "push", 4),
+		Entry("unrelated strings", "apps", "login", 5),
+	)
+
+	Describe("Nearest", func() {
+		var candidates []string
+
+		BeforeEach(func() {
+			candidates = []string{"push", "pull", "apps", "app", "instances", "target", "targets"}
+		})
+
+		It("returns the closest candidate", func() {
+			Expect(Nearest("pus", candidates)).To(Equal([]string{"push"}))
+			Expect(Nearest("instnces", candidates)).To(Equal([]string{"instances"}))
+			Expect(Nearest("TAGRET", candidates)).To(Equal([]string{"target"}))
+		})
+
+		It("returns all of the equally close candidates in order", func() {
+			Expect(Nearest("targes", candidates)).To(Equal([]string{"target", "targets"}))
+		})
+
+		It("does not suggest candidates that are too far away", func() {
+			Expect(Nearest("login", candidates)).To(BeEmpty())
+			Expect(Nearest("", candidates)).To(BeEmpty())
+		})
+	})
+})
@@ -24,3 +24,40 @@ func NewTLSConfig(trustedCerts []*x509.Certificate, skipTLSValidation bool) *tls
 
 	return config
 }
+
+// ClientCertificate is the certificate and private key, in PEM files, that
+// are presented to servers that require mutual TLS.
+type ClientCertificate struct {
+	CertFile string
+	KeyFile  string
+}
+
+// IsSet returns true when a client certificate is configured.
+func (certificate ClientCertificate) IsSet() bool {
+	return certificate.CertFile != "" || certificate.KeyFile != ""
+}
+
+// Load reads the certificate and private key files.
+func (certificate ClientCertificate) Load() (tls.Certificate, error) {
+	return tls.LoadX509KeyPair(certificate.CertFile, certificate.KeyFile)
+}
+
+// NewClientTLSConfig returns a TLS config like NewTLSConfig that also
+// presents the client certificate, when it is set, to servers that ask for
+// one. The certificate files are read when a connection is made, so that an
+// unreadable certificate fails the request rather than creating the client.
+func NewClientTLSConfig(skipTLSValidation bool, certificate ClientCertificate) *tls.Config {
+	config := NewTLSConfig(nil, skipTLSValidation)
+
+	if certificate.IsSet() {
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			loaded, err := certificate.Load()
+			if err != nil {
+				return nil, err
+			}
+			return &loaded, nil
+		}
+	}
+
+	return config
+}
@@ -1,9 +1,18 @@
 package util_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
 
 	. "code.cloudfoundry.org/cli/util"
 	. "github.com/onsi/ginkgo"
@@ -81,4 +90,80 @@ RVNEplh5ZEYbbWclddUBf46JPRU/eEu4JMqOJOykTdwbByFa3909Bzs=
 
 	})
 
+	Describe("NewClientTLSConfig", func() {
+		var (
+			certificate ClientCertificate
+			tlsConfig   *tls.Config
+		)
+
+		JustBeforeEach(func() {
+			tlsConfig = NewClientTLSConfig(true, certificate)
+		})
+
+		It("is configured like NewTLSConfig", func() {
+			Expect(tlsConfig.MinVersion).To(BeEquivalentTo(tls.VersionTLS12))
+			Expect(tlsConfig.InsecureSkipVerify).To(BeTrue())
+		})
+
+		When("no client certificate is set", func() {
+			It("does not present a certificate", func() {
+				Expect(certificate.IsSet()).To(BeFalse())
+				Expect(tlsConfig.GetClientCertificate).To(BeNil())
+			})
+		})
+
+		When("a client certificate is set", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "client-certificate")
+				Expect(err).ToNot(HaveOccurred())
+
+				key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).ToNot(HaveOccurred())
+				template := &x509.Certificate{
+					SerialNumber: big.NewInt(1),
+					Subject:      pkix.Name{CommonName: "cf-client"},
+					NotBefore:    time.Now(),
+					NotAfter:     time.Now().Add(time.Hour),
+				}
+				der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+				Expect(err).ToNot(HaveOccurred())
+				keyDER, err := x509.MarshalECPrivateKey(key)
+				Expect(err).ToNot(HaveOccurred())
+
+				certificate = ClientCertificate{
+					CertFile: filepath.Join(dir, "client.crt"),
+					KeyFile:  filepath.Join(dir, "client.key"),
+				}
+				Expect(ioutil.WriteFile(certificate.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(certificate.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(dir)).To(Succeed())
+				certificate = ClientCertificate{}
+			})
+
+			It("presents the certificate", func() {
+				clientCertificate, err := tlsConfig.GetClientCertificate(&tls.CertificateRequestInfo{})
+				Expect(err).ToNot(HaveOccurred())
+				leaf, err := x509.ParseCertificate(clientCertificate.Certificate[0])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(leaf.Subject.CommonName).To(Equal("cf-client"))
+			})
+
+			When("the key file cannot be read", func() {
+				BeforeEach(func() {
+					certificate.KeyFile = filepath.Join(dir, "missing.key")
+				})
+
+				It("fails the handshake", func() {
+					_, err := tlsConfig.GetClientCertificate(&tls.CertificateRequestInfo{})
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	})
 })
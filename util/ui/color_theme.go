@@ -0,0 +1,293 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/fatih/color"
+)
+
+// ColorRole is a kind of output that the color theme assigns a color to.
+type ColorRole string
+
+const (
+	// ColorRoleEntity is used for the names of entities, such as apps and
+	// spaces, in flavor text.
+	ColorRoleEntity ColorRole = "entity"
+	// ColorRoleError is used for FAILED.
+	ColorRoleError ColorRole = "error"
+	// ColorRoleHeader is used for headers and table headings.
+	ColorRoleHeader ColorRole = "header"
+	// ColorRoleOK is used for OK.
+	ColorRoleOK ColorRole = "ok"
+	// ColorRoleWarning is used for warnings.
+	ColorRoleWarning ColorRole = "warning"
+)
+
+// ColorTheme maps each role to the attributes its output is displayed with.
+// A role without attributes is displayed without color.
+type ColorTheme map[ColorRole][]color.Attribute
+
+// DefaultColorTheme returns the colors the CLI uses when no theme is set.
+func DefaultColorTheme() ColorTheme {
+	return ColorTheme{
+		ColorRoleEntity:  {color.FgCyan, color.Bold},
+		ColorRoleError:   {color.FgRed, color.Bold},
+		ColorRoleHeader:  {color.Bold},
+		ColorRoleOK:      {color.FgGreen, color.Bold},
+		ColorRoleWarning: nil,
+	}
+}
+
+var colorNames = map[string]int{
+	"black":   0,
+	"red":     1,
+	"green":   2,
+	"yellow":  3,
+	"blue":    4,
+	"magenta": 5,
+	"cyan":    6,
+	"white":   7,
+}
+
+var colorModifiers = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"faint":     color.Faint,
+	"italic":    color.Italic,
+	"underline": color.Underline,
+}
+
+// basicColorRGB is the xterm palette of the 16 basic colors.
+var basicColorRGB = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// colorCubeLevels are the channel values of the 6x6x6 cube of the 256 color
+// palette.
+var colorCubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// LoadColorTheme returns the default theme with the colors of theme applied
+// on top of it. theme is either a comma-separated list of ROLE=COLOR pairs or
+// the path to a JSON file containing an object that maps roles to colors.
+//
+// A COLOR is a color name (black, red, green, yellow, blue, magenta, cyan,
+// white, optionally prefixed with "bright-"), a 256 color palette number, a
+// #RRGGBB value or "none", combined with any of the bold, faint, italic and
+// underline modifiers using "+", such as "cyan+bold". Colors the terminal
+// cannot display are replaced with the closest one it can.
+func LoadColorTheme(theme string, depth configv3.ColorDepth) (ColorTheme, error) {
+	colorTheme := DefaultColorTheme()
+	if theme == "" {
+		return colorTheme, nil
+	}
+
+	colors, err := readColorTheme(theme)
+	if err != nil {
+		return nil, translatableerror.InvalidColorThemeError{Theme: theme, Reason: err.Error()}
+	}
+
+	roles := make([]string, 0, len(colors))
+	for role := range colors {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		colorRole := ColorRole(strings.ToLower(role))
+		if _, ok := colorTheme[colorRole]; !ok {
+			return nil, translatableerror.InvalidColorThemeError{
+				Theme:  theme,
+				Reason: fmt.Sprintf("unknown role %q, expected one of entity, error, header, ok, warning", role),
+			}
+		}
+
+		attributes, err := parseThemeColor(colors[role], depth)
+		if err != nil {
+			return nil, translatableerror.InvalidColorThemeError{Theme: theme, Reason: err.Error()}
+		}
+		colorTheme[colorRole] = attributes
+	}
+
+	return colorTheme, nil
+}
+
+func readColorTheme(theme string) (map[string]string, error) {
+	colors := map[string]string{}
+
+	if !strings.Contains(theme, "=") {
+		raw, err := os.ReadFile(theme)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(raw, &colors)
+		if err != nil {
+			return nil, fmt.Errorf("theme file is not a JSON object of roles to colors: %s", err)
+		}
+		return colors, nil
+	}
+
+	for _, pair := range strings.Split(theme, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%q is not in the ROLE=COLOR form", pair)
+		}
+		colors[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return colors, nil
+}
+
+func parseThemeColor(value string, depth configv3.ColorDepth) ([]color.Attribute, error) {
+	var attributes []color.Attribute
+	hasColor := false
+
+	for _, part := range strings.Split(strings.ToLower(value), "+") {
+		part = strings.TrimSpace(part)
+		if modifier, ok := colorModifiers[part]; ok {
+			attributes = append(attributes, modifier)
+			continue
+		}
+
+		if hasColor {
+			return nil, fmt.Errorf("%q has more than one color", value)
+		}
+		hasColor = true
+
+		colorAttributes, err := parseColor(part, depth)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(colorAttributes, attributes...)
+	}
+
+	return attributes, nil
+}
+
+func parseColor(value string, depth configv3.ColorDepth) ([]color.Attribute, error) {
+	if value == "none" {
+		return nil, nil
+	}
+
+	if index, ok := colorNames[strings.TrimPrefix(value, "bright-")]; ok {
+		if strings.HasPrefix(value, "bright-") {
+			index += 8
+		}
+		return basicColorAttributes(index), nil
+	}
+
+	if strings.HasPrefix(value, "#") {
+		rgb, err := strconv.ParseUint(strings.TrimPrefix(value, "#"), 16, 32)
+		if err != nil || len(value) != 7 {
+			return nil, fmt.Errorf("%q is not a #RRGGBB color", value)
+		}
+		r, g, b := int(rgb>>16), int(rgb>>8&0xff), int(rgb&0xff)
+
+		switch {
+		case depth >= configv3.ColorDepthTrueColor:
+			return []color.Attribute{38, 2, color.Attribute(r), color.Attribute(g), color.Attribute(b)}, nil
+		case depth >= configv3.ColorDepth256:
+			return []color.Attribute{38, 5, color.Attribute(rgbTo256(r, g, b))}, nil
+		default:
+			return basicColorAttributes(rgbToBasic(r, g, b)), nil
+		}
+	}
+
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 || index > 255 {
+		return nil, fmt.Errorf("%q is not a color name, palette number from 0 to 255 or #RRGGBB color", value)
+	}
+
+	switch {
+	case index < 16:
+		return basicColorAttributes(index), nil
+	case depth >= configv3.ColorDepth256:
+		return []color.Attribute{38, 5, color.Attribute(index)}, nil
+	default:
+		r, g, b := paletteRGB(index)
+		return basicColorAttributes(rgbToBasic(r, g, b)), nil
+	}
+}
+
+// basicColorAttributes returns the foreground attribute of one of the 16
+// basic colors.
+func basicColorAttributes(index int) []color.Attribute {
+	if index < 8 {
+		return []color.Attribute{color.FgBlack + color.Attribute(index)}
+	}
+	return []color.Attribute{color.FgHiBlack + color.Attribute(index-8)}
+}
+
+// paletteRGB returns the RGB value of a color of the 256 color palette.
+func paletteRGB(index int) (int, int, int) {
+	switch {
+	case index < 16:
+		return basicColorRGB[index][0], basicColorRGB[index][1], basicColorRGB[index][2]
+	case index < 232:
+		index -= 16
+		return colorCubeLevels[index/36], colorCubeLevels[index/6%6], colorCubeLevels[index%6]
+	default:
+		gray := 8 + 10*(index-232)
+		return gray, gray, gray
+	}
+}
+
+// rgbTo256 returns the closest color of the cube and grayscale ramp of the 256
+// color palette.
+func rgbTo256(r, g, b int) int {
+	nearestLevel := func(value int) int {
+		nearest := 0
+		for i, level := range colorCubeLevels {
+			if abs(level-value) < abs(colorCubeLevels[nearest]-value) {
+				nearest = i
+			}
+		}
+		return nearest
+	}
+
+	cube := 16 + 36*nearestLevel(r) + 6*nearestLevel(g) + nearestLevel(b)
+
+	grayStep := ((r+g+b)/3 - 3) / 10
+	if grayStep < 0 {
+		grayStep = 0
+	} else if grayStep > 23 {
+		grayStep = 23
+	}
+	gray := 232 + grayStep
+
+	grayR, grayG, grayB := paletteRGB(gray)
+	cubeR, cubeG, cubeB := paletteRGB(cube)
+	if colorDistance(grayR, grayG, grayB, r, g, b) < colorDistance(cubeR, cubeG, cubeB, r, g, b) {
+		return gray
+	}
+	return cube
+}
+
+// rgbToBasic returns the closest of the 16 basic colors.
+func rgbToBasic(r, g, b int) int {
+	nearest := 0
+	for i, rgb := range basicColorRGB {
+		if colorDistance(rgb[0], rgb[1], rgb[2], r, g, b) < colorDistance(basicColorRGB[nearest][0], basicColorRGB[nearest][1], basicColorRGB[nearest][2], r, g, b) {
+			nearest = i
+		}
+	}
+	return nearest
+}
+
+func colorDistance(r1, g1, b1, r2, g2, b2 int) int {
+	return (r1-r2)*(r1-r2) + (g1-g2)*(g1-g2) + (b1-b2)*(b1-b2)
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
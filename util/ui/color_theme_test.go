@@ -0,0 +1,153 @@
+package ui_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ColorTheme", func() {
+	Describe("LoadColorTheme", func() {
+		It("returns the default theme when no theme is set", func() {
+			theme, err := LoadColorTheme("", configv3.ColorDepth16)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(theme).To(Equal(DefaultColorTheme()))
+		})
+
+		It("applies the roles and colors on top of the default theme", func() {
+			theme, err := LoadColorTheme("entity=blue+bold, Warning=yellow", configv3.ColorDepth16)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(theme[ColorRoleEntity]).To(Equal([]color.Attribute{color.FgBlue, color.Bold}))
+			Expect(theme[ColorRoleWarning]).To(Equal([]color.Attribute{color.FgYellow}))
+			Expect(theme[ColorRoleOK]).To(Equal(DefaultColorTheme()[ColorRoleOK]))
+		})
+
+		When("the theme is a theme file", func() {
+			var themeDir string
+
+			BeforeEach(func() {
+				var err error
+				themeDir, err = os.MkdirTemp("", "color-theme")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(themeDir)).To(Succeed())
+			})
+
+			It("reads the colors from the file", func() {
+				themePath := filepath.Join(themeDir, "theme.json")
+				Expect(os.WriteFile(themePath, []byte(`{"error": "bright-red+underline", "header": "none"}`), 0600)).To(Succeed())
+
+				theme, err := LoadColorTheme(themePath, configv3.ColorDepth16)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(theme[ColorRoleError]).To(Equal([]color.Attribute{color.FgHiRed, color.Underline}))
+				Expect(theme[ColorRoleHeader]).To(BeEmpty())
+			})
+
+			It("returns an error when the file is not a theme", func() {
+				themePath := filepath.Join(themeDir, "theme.json")
+				Expect(os.WriteFile(themePath, []byte(`["cyan"]`), 0600)).To(Succeed())
+
+				_, err := LoadColorTheme(themePath, configv3.ColorDepth16)
+				Expect(err).To(BeAssignableToTypeOf(translatableerror.InvalidColorThemeError{}))
+			})
+		})
+
+		It("returns an error for an unknown role", func() {
+			_, err := LoadColorTheme("title=cyan", configv3.ColorDepth16)
+			Expect(err).To(MatchError(translatableerror.InvalidColorThemeError{
+				Theme:  "title=cyan",
+				Reason: `unknown role "title", expected one of entity, error, header, ok, warning`,
+			}))
+		})
+
+		It("returns an error for a value with more than one color", func() {
+			_, err := LoadColorTheme("ok=green+blue", configv3.ColorDepth16)
+			Expect(err).To(MatchError(translatableerror.InvalidColorThemeError{
+				Theme:  "ok=green+blue",
+				Reason: `"green+blue" has more than one color`,
+			}))
+		})
+
+		DescribeTable("picks the colors the terminal can display",
+			func(value string, depth configv3.ColorDepth, expected []color.Attribute) {
+				theme, err := LoadColorTheme("ok="+value, depth)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(theme[ColorRoleOK]).To(Equal(expected))
+			},
+			Entry("basic palette numbers on any terminal", "3", configv3.ColorDepth16, []color.Attribute{color.FgYellow}),
+			Entry("bright palette numbers on any terminal", "12", configv3.ColorDepth16, []color.Attribute{color.FgHiBlue}),
+			Entry("palette numbers on 256 color terminals", "214", configv3.ColorDepth256, []color.Attribute{38, 5, 214}),
+			Entry("palette numbers approximated on 16 color terminals", "196", configv3.ColorDepth16, []color.Attribute{color.FgHiRed}),
+			Entry("RGB colors on truecolor terminals", "#005f87", configv3.ColorDepthTrueColor, []color.Attribute{38, 2, 0, 95, 135}),
+			Entry("RGB colors approximated on 256 color terminals", "#005f87", configv3.ColorDepth256, []color.Attribute{38, 5, 24}),
+			Entry("RGB grays approximated on 256 color terminals", "#808080", configv3.ColorDepth256, []color.Attribute{38, 5, 244}),
+			Entry("RGB colors approximated on 16 color terminals", "#00c000", configv3.ColorDepth16, []color.Attribute{color.FgGreen}),
+		)
+	})
+
+	Describe("themed output", func() {
+		var (
+			ui  *UI
+			out *Buffer
+		)
+
+		BeforeEach(func() {
+			fakeConfig := new(uifakes.FakeConfig)
+			fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+			fakeConfig.ColorThemeReturns("ok=blue,warning=yellow")
+			fakeConfig.ColorDepthReturns(configv3.ColorDepth16)
+
+			var err error
+			ui, err = NewUI(fakeConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			out = NewBuffer()
+			ui.Out = out
+			ui.Err = NewBuffer()
+		})
+
+		It("displays OK in the color of the theme", func() {
+			ui.DisplayOK()
+			Expect(out).To(Say("\x1b\\[34mOK\x1b\\[0m"))
+		})
+
+		It("displays warnings in the color of the theme", func() {
+			ui.DisplayWarnings([]string{"some warning"})
+			Expect(ui.Err).To(Say("\x1b\\[33msome warning\x1b\\[0m"))
+		})
+
+		It("keeps the default colors of the other roles", func() {
+			ui.DisplayTextWithFlavor("app {{.AppName}}", map[string]interface{}{"AppName": "some-app"})
+			Expect(out).To(Say("app \x1b\\[36;1msome-app\x1b\\[0;22m"))
+		})
+
+		When("the theme cannot be loaded", func() {
+			BeforeEach(func() {
+				fakeConfig := new(uifakes.FakeConfig)
+				fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+				fakeConfig.ColorThemeReturns("/some/deleted/theme.json")
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+				ui.Out = out
+			})
+
+			It("uses the default theme", func() {
+				ui.DisplayOK()
+				Expect(out).To(Say("\x1b\\[32;1mOK\x1b\\[0;22m"))
+			})
+		})
+	})
+})
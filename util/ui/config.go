@@ -8,6 +8,10 @@ import "code.cloudfoundry.org/cli/util/configv3"
 type Config interface {
 	// ColorEnabled enables or disabled color
 	ColorEnabled() configv3.ColorSetting
+	// ColorDepth is the number of colors the terminal supports
+	ColorDepth() configv3.ColorDepth
+	// ColorTheme is the theme that sets the colors of the output
+	ColorTheme() string
 	// Locale is the language to translate the output to
 	Locale() string
 	// IsTTY returns true when the ui has a TTY
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// pager is a running pager process and the output it replaced.
+type pager struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	out   io.Writer
+}
+
+// StartPager starts the pager command line and sends all further output on
+// ui.Out through it until StopPager is called. The pager is not started when
+// the UI is not attached to a terminal, when the command line is empty or
+// when a pager is already running.
+func (ui *UI) StartPager(commandLine string) error {
+	args := strings.Fields(commandLine)
+	if !ui.IsTTY || len(args) == 0 || ui.pager != nil {
+		return nil
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = ui.Out
+	cmd.Stderr = ui.Err
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	ui.pager = &pager{cmd: cmd, stdin: stdin, out: ui.Out}
+	ui.Out = stdin
+	return nil
+}
+
+// StopPager waits for the user to quit the pager started by StartPager and
+// restores ui.Out. It does nothing when no pager is running.
+func (ui *UI) StopPager() {
+	ui.terminalLock.Lock()
+	running := ui.pager
+	ui.pager = nil
+	if running != nil {
+		ui.Out = running.out
+	}
+	ui.terminalLock.Unlock()
+
+	if running == nil {
+		return
+	}
+
+	_ = running.stdin.Close()
+	_ = running.cmd.Wait()
+}
@@ -0,0 +1,78 @@
+package ui_test
+
+import (
+	"runtime"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Pager", func() {
+	var (
+		ui  *UI
+		out *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig := new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.Err = NewBuffer()
+	})
+
+	When("the UI is attached to a terminal", func() {
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("cat is not available on Windows")
+			}
+			ui.IsTTY = true
+		})
+
+		It("sends the output through the pager until it is stopped", func() {
+			Expect(ui.StartPager("cat -u")).To(Succeed())
+			Expect(ui.Out).ToNot(Equal(out))
+
+			ui.DisplayText("some output")
+			ui.StopPager()
+
+			Expect(ui.Out).To(Equal(out))
+			Expect(out).To(Say("some output"))
+		})
+
+		It("returns an error when the pager cannot be started", func() {
+			Expect(ui.StartPager("some-pager-that-does-not-exist")).ToNot(Succeed())
+			Expect(ui.Out).To(Equal(out))
+		})
+
+		It("does nothing when the pager command line is empty", func() {
+			Expect(ui.StartPager("  ")).To(Succeed())
+			Expect(ui.Out).To(Equal(out))
+		})
+	})
+
+	When("the UI is not attached to a terminal", func() {
+		BeforeEach(func() {
+			ui.IsTTY = false
+		})
+
+		It("writes the output directly", func() {
+			Expect(ui.StartPager("cat")).To(Succeed())
+			Expect(ui.Out).To(Equal(out))
+
+			ui.DisplayText("some output")
+			ui.StopPager()
+
+			Expect(out).To(Say("some output"))
+		})
+	})
+})
@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/lunixbochs/vtclean"
+)
+
+// recordedSecret matches the value of a key whose name suggests a secret,
+// e.g. "password": "abc" in JSON or client_secret=abc in flags and URLs.
+var recordedSecret = regexp.MustCompile(`(?i)("?[\w.-]*(?:password|passwd|secret|token|private_key|credential)[\w.-]*"?\s*[:=]\s*)("[^"]*"|[^\s",&{\[]+)`)
+
+var recordedToken = regexp.MustCompile(`(?i)` + tokenRegexp)
+
+// RecordingEntry is a line of the transcript written by StartRecording.
+type RecordingEntry struct {
+	Time time.Time `json:"time"`
+	// Type is "start" for the command line, "output" for a line of output
+	// and "end" once the command finished.
+	Type string `json:"type"`
+	// Args is the command line, with secrets redacted, of the start entry.
+	Args []string `json:"args,omitempty"`
+	// Stream is "stdout" or "stderr" for an output entry.
+	Stream string `json:"stream,omitempty"`
+	Text   string `json:"text,omitempty"`
+	// Status is "ok" or "failed" for the end entry.
+	Status string `json:"status,omitempty"`
+}
+
+// recording is the transcript file that ui.Out and ui.Err are teed to.
+type recording struct {
+	file    io.WriteCloser
+	lock    sync.Mutex
+	out     io.Writer
+	err     io.Writer
+	writers []*recordingWriter
+	encoder *json.Encoder
+	now     func() time.Time
+}
+
+// StartRecording tees everything that is written to ui.Out and ui.Err to a
+// transcript at path, one JSON RecordingEntry per line: first the command
+// line args, which the caller is expected to have redacted, then every line
+// of output with its time and stream, with colors removed and secrets such as
+// passwords, tokens and URL credentials redacted. An existing file is
+// replaced.
+func (ui *UI) StartRecording(path string, args []string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	rec := &recording{
+		file:    file,
+		out:     ui.Out,
+		err:     ui.Err,
+		encoder: json.NewEncoder(file),
+		now:     time.Now,
+	}
+	outWriter := &recordingWriter{recording: rec, stream: "stdout", next: ui.Out}
+	errWriter := &recordingWriter{recording: rec, stream: "stderr", next: ui.Err}
+	rec.writers = []*recordingWriter{outWriter, errWriter}
+
+	err = rec.write(RecordingEntry{Type: "start", Args: args})
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	ui.recording = rec
+	ui.Out = outWriter
+	ui.Err = errWriter
+	return nil
+}
+
+// StopRecording writes the end entry of the transcript started by
+// StartRecording, with the status of the command, closes it and restores
+// ui.Out and ui.Err. It does nothing when nothing is being recorded.
+func (ui *UI) StopRecording(failed bool) error {
+	ui.terminalLock.Lock()
+	rec := ui.recording
+	ui.recording = nil
+	if rec != nil {
+		ui.Out = rec.out
+		ui.Err = rec.err
+	}
+	ui.terminalLock.Unlock()
+
+	if rec == nil {
+		return nil
+	}
+
+	for _, writer := range rec.writers {
+		writer.flush()
+	}
+
+	status := "ok"
+	if failed {
+		status = "failed"
+	}
+	err := rec.write(RecordingEntry{Type: "end", Status: status})
+	closeErr := rec.file.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+func (rec *recording) write(entry RecordingEntry) error {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+
+	entry.Time = rec.now().UTC()
+	return rec.encoder.Encode(entry)
+}
+
+// RedactRecordedText removes colors from the text and replaces the secrets in
+// it with RedactedValue.
+func RedactRecordedText(text string) string {
+	text = vtclean.Clean(text, false)
+	text = recordedToken.ReplaceAllString(text, "bearer "+RedactedValue)
+	text = sanitizeURL(text)
+	return recordedSecret.ReplaceAllStringFunc(text, func(match string) string {
+		groups := recordedSecret.FindStringSubmatch(match)
+		if len(groups[2]) > 0 && groups[2][0] == '"' {
+			return fmt.Sprintf(`%s"%s"`, groups[1], RedactedValue)
+		}
+		return groups[1] + RedactedValue
+	})
+}
+
+// recordingWriter writes to the next writer and records every complete line.
+type recordingWriter struct {
+	recording *recording
+	stream    string
+	next      io.Writer
+
+	lock    sync.Mutex
+	partial []byte
+}
+
+func (writer *recordingWriter) Write(p []byte) (int, error) {
+	n, err := writer.next.Write(p)
+
+	writer.lock.Lock()
+	writer.partial = append(writer.partial, p[:n]...)
+	var lines [][]byte
+	for {
+		index := bytes.IndexByte(writer.partial, '\n')
+		if index < 0 {
+			break
+		}
+		lines = append(lines, writer.partial[:index])
+		writer.partial = writer.partial[index+1:]
+	}
+	writer.lock.Unlock()
+
+	for _, line := range lines {
+		writer.record(string(bytes.TrimRight(line, "\r")))
+	}
+	return n, err
+}
+
+// flush records the output that did not end with a newline.
+func (writer *recordingWriter) flush() {
+	writer.lock.Lock()
+	partial := string(writer.partial)
+	writer.partial = nil
+	writer.lock.Unlock()
+
+	if partial != "" {
+		writer.record(partial)
+	}
+}
+
+func (writer *recordingWriter) record(line string) {
+	_ = writer.recording.write(RecordingEntry{
+		Type:   "output",
+		Stream: writer.stream,
+		Text:   RedactRecordedText(line),
+	})
+}
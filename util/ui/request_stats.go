@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/util/requeststats"
+)
+
+// DisplayRequestStats outputs the --stats summary of the API requests made
+// by the command to ui.Err, so that it never mixes with output that is piped
+// or parsed.
+func (ui *UI) DisplayRequestStats(summary requeststats.Summary) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Err, "\n%s\n", ui.TranslateText("Stats: {{.Duration}} total, {{.Calls}} API calls, {{.Retries}} retries, {{.Sent}} sent, {{.Received}} received", map[string]interface{}{
+		"Duration": roundDuration(summary.Duration),
+		"Calls":    summary.Calls,
+		"Retries":  summary.Retries,
+		"Sent":     bytefmt.ByteSize(uint64(summary.BytesSent)),
+		"Received": bytefmt.ByteSize(uint64(summary.BytesReceived)),
+	}))
+
+	if len(summary.SlowestEndpoints) == 0 {
+		return
+	}
+
+	fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText("Slowest endpoints:"))
+	for _, endpoint := range summary.SlowestEndpoints {
+		fmt.Fprintf(ui.Err, "  %s\n", ui.TranslateText("{{.Total}} in {{.Calls}} calls (max {{.Max}})  {{.Method}} {{.Endpoint}}", map[string]interface{}{
+			"Total":    roundDuration(endpoint.TotalDuration),
+			"Calls":    endpoint.Calls,
+			"Max":      roundDuration(endpoint.MaxDuration),
+			"Method":   endpoint.Method,
+			"Endpoint": endpoint.Endpoint,
+		}))
+	}
+}
+
+func roundDuration(duration time.Duration) string {
+	return duration.Round(time.Millisecond).String()
+}
@@ -0,0 +1,50 @@
+package ui_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/requeststats"
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayRequestStats", func() {
+	var (
+		ui  *UI
+		out *Buffer
+		err *Buffer
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		err = NewBuffer()
+		ui = NewTestUI(nil, out, err)
+	})
+
+	It("displays the summary and the slowest endpoints to ui.Err", func() {
+		ui.DisplayRequestStats(requeststats.Summary{
+			Duration:      2345678 * time.Microsecond,
+			Calls:         12,
+			Retries:       1,
+			BytesSent:     2048,
+			BytesReceived: 3 * 1024 * 1024,
+			SlowestEndpoints: []requeststats.EndpointStats{
+				{Method: "GET", Endpoint: "api.example.com/v3/apps", Calls: 3, TotalDuration: 1200 * time.Millisecond, MaxDuration: 800 * time.Millisecond},
+			},
+		})
+
+		Expect(err).To(Say(`Stats: 2.346s total, 12 API calls, 1 retries, 2K sent, 3M received\n`))
+		Expect(err).To(Say(`Slowest endpoints:\n`))
+		Expect(err).To(Say(`  1.2s in 3 calls \(max 800ms\)  GET api.example.com/v3/apps\n`))
+		Expect(out.Contents()).To(BeEmpty())
+	})
+
+	It("does not list endpoints when no requests were made", func() {
+		ui.DisplayRequestStats(requeststats.Summary{Duration: time.Second})
+
+		Expect(err).To(Say(`Stats: 1s total, 0 API calls, 0 retries, 0 sent, 0 received\n`))
+		Expect(err).ToNot(Say("Slowest endpoints"))
+	})
+})
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/lunixbochs/vtclean"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	hideCursor = "\x1b[?25l"
+	showCursor = "\x1b[?25h"
+	clearLine  = "\x1b[K"
+	clearBelow = "\x1b[J"
+)
+
+// Screen is a region of the terminal that shows a frame of text lines and a
+// table and redraws it in place whenever the next frame is rendered, e.g. for
+// a dashboard that refreshes. When the UI is not attached to a terminal, the
+// frames are written one after the other instead.
+type Screen struct {
+	ui       *UI
+	rendered bool
+	drawn    int
+}
+
+// NewScreen returns a Screen that renders on ui.Out.
+func (ui *UI) NewScreen() *Screen {
+	return &Screen{ui: ui}
+}
+
+// Render replaces the previous frame with the text lines followed by the
+// table, whose first row is its header. On a terminal, lines wider than the
+// terminal are cut so that each takes a single row and the cursor is hidden
+// until Close.
+func (screen *Screen) Render(lines []string, table [][]string) {
+	ui := screen.ui
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	frame := append([]string{}, lines...)
+	if len(table) > 0 {
+		header := make([]string, len(table[0]))
+		for i, str := range table[0] {
+			header[i] = ui.modifyColor(str, ui.themeColor(ColorRoleHeader))
+		}
+		frame = append(frame, tableLines("", append([][]string{header}, table[1:]...), DefaultTableSpacePadding)...)
+	}
+
+	if !ui.IsTTY {
+		if screen.rendered {
+			fmt.Fprintln(ui.Out)
+		}
+		for _, line := range frame {
+			fmt.Fprintln(ui.Out, line)
+		}
+		screen.rendered = true
+		return
+	}
+
+	if !screen.rendered {
+		fmt.Fprint(ui.Out, hideCursor)
+	} else if screen.drawn > 0 {
+		fmt.Fprintf(ui.Out, "\x1b[%dA\r", screen.drawn)
+	}
+	for _, line := range frame {
+		fmt.Fprintf(ui.Out, "%s%s\n", screen.fit(line), clearLine)
+	}
+	fmt.Fprint(ui.Out, clearBelow)
+
+	screen.rendered = true
+	screen.drawn = len(frame)
+}
+
+// Close leaves the last frame on the terminal and shows the cursor again.
+func (screen *Screen) Close() {
+	ui := screen.ui
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.IsTTY && screen.rendered {
+		fmt.Fprint(ui.Out, showCursor)
+	}
+}
+
+func (screen *Screen) fit(line string) string {
+	width := screen.ui.TerminalWidth
+	if width <= 0 || wordSize(line) <= width {
+		return line
+	}
+	return runewidth.Truncate(vtclean.Clean(line, false), width, "")
+}
@@ -0,0 +1,68 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Screen", func() {
+	var (
+		ui     *UI
+		out    *Buffer
+		screen *Screen
+	)
+
+	BeforeEach(func() {
+		fakeConfig := new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.Err = NewBuffer()
+		screen = ui.NewScreen()
+	})
+
+	When("the UI is attached to a terminal", func() {
+		BeforeEach(func() {
+			ui.IsTTY = true
+			ui.TerminalWidth = 12
+		})
+
+		It("redraws the frames in place and shows the cursor on close", func() {
+			screen.Render([]string{"first"}, [][]string{{"name", "cpu"}, {"app", "1%"}})
+			screen.Render([]string{"a line that is too long"}, nil)
+			screen.Close()
+
+			Expect(string(out.Contents())).To(Equal(
+				"\x1b[?25l" +
+					"first\x1b[K\n" +
+					"name   cpu\x1b[K\n" +
+					"app    1%\x1b[K\n" +
+					"\x1b[J" +
+					"\x1b[3A\r" +
+					"a line that \x1b[K\n" +
+					"\x1b[J" +
+					"\x1b[?25h",
+			))
+		})
+	})
+
+	When("the UI is not attached to a terminal", func() {
+		It("writes the frames one after the other", func() {
+			screen.Render([]string{"first"}, [][]string{{"name", "cpu"}, {"app", "1%"}})
+			screen.Render([]string{"second"}, nil)
+			screen.Close()
+
+			Expect(string(out.Contents())).To(Equal("first\nname   cpu\napp    1%\n\nsecond\n"))
+			Expect(out).NotTo(Say(`\x1b`))
+		})
+	})
+})
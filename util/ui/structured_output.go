@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/lunixbochs/vtclean"
+)
+
+var structuredKeyReplacer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// structuredOutput holds the tables displayed while the output is structured
+// and the output they are written to at the end.
+type structuredOutput struct {
+	out           io.Writer
+	tables        []interface{}
+	displayedJSON bool
+}
+
+// StartStructuredOutput makes the UI collect the tables it is asked to
+// display instead of displaying them, so that they can be written as JSON by
+// StopStructuredOutput. All other output on ui.Out, such as flavor text, is
+// sent to ui.Err in the meantime so that ui.Out only receives the JSON. It
+// does nothing when the output is already structured.
+func (ui *UI) StartStructuredOutput() {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.structured != nil {
+		return
+	}
+
+	ui.structured = &structuredOutput{out: ui.Out}
+	ui.Out = ui.Err
+}
+
+// StopStructuredOutput restores ui.Out and, when display is set, writes the
+// collected tables to it as JSON. A table with a header becomes an array of
+// objects keyed by the column headings, and a key-value table becomes an
+// object. A single table is written on its own and several tables as an
+// array. Nothing is written when the command displayed its own JSON. It does
+// nothing when the output is not structured.
+func (ui *UI) StopStructuredOutput(display bool) error {
+	ui.terminalLock.Lock()
+	structured := ui.structured
+	ui.structured = nil
+	if structured != nil {
+		ui.Out = structured.out
+	}
+	ui.terminalLock.Unlock()
+
+	if structured == nil || !display || structured.displayedJSON {
+		return nil
+	}
+
+	switch len(structured.tables) {
+	case 0:
+		return ui.DisplayJSON("", []interface{}{})
+	case 1:
+		return ui.DisplayJSON("", structured.tables[0])
+	default:
+		return ui.DisplayJSON("", structured.tables)
+	}
+}
+
+// recordTable collects the table when the output is structured and returns
+// whether it did. The caller must hold the terminal lock.
+func (ui *UI) recordTable(table [][]string) bool {
+	if ui.structured == nil {
+		return false
+	}
+
+	if len(table) > 0 {
+		ui.structured.tables = append(ui.structured.tables, structuredTable(table))
+	}
+	return true
+}
+
+// structuredTable turns a table into an object when every row is a "key:"
+// followed by a value, and otherwise into an array of objects keyed by the
+// cells of the first row.
+func structuredTable(table [][]string) interface{} {
+	isKeyValue := true
+	for _, row := range table {
+		if len(row) != 2 || !strings.HasSuffix(cleanCell(row[0]), ":") {
+			isKeyValue = false
+			break
+		}
+	}
+
+	if isKeyValue {
+		object := map[string]string{}
+		for _, row := range table {
+			object[structuredKey(row[0], 0)] = cleanCell(row[1])
+		}
+		return object
+	}
+
+	keys := make([]string, len(table[0]))
+	for i, heading := range table[0] {
+		keys[i] = structuredKey(heading, i)
+	}
+
+	rows := make([]map[string]string, 0, len(table)-1)
+	for _, row := range table[1:] {
+		object := map[string]string{}
+		for i, key := range keys {
+			if i < len(row) {
+				object[key] = cleanCell(row[i])
+			} else {
+				object[key] = ""
+			}
+		}
+		rows = append(rows, object)
+	}
+	return rows
+}
+
+// structuredKey turns a heading such as "requested state:" into a key such as
+// "requested_state". Empty headings are named after their column.
+func structuredKey(heading string, column int) string {
+	key := strings.Trim(structuredKeyReplacer.ReplaceAllString(strings.ToLower(cleanCell(heading)), "_"), "_")
+	if key == "" {
+		return fmt.Sprintf("column_%d", column+1)
+	}
+	return key
+}
+
+func cleanCell(cell string) string {
+	return strings.TrimSpace(vtclean.Clean(cell, false))
+}
@@ -0,0 +1,94 @@
+package ui_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured output", func() {
+	var (
+		ui  *UI
+		out *Buffer
+		err *Buffer
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		err = NewBuffer()
+		ui = NewTestUI(nil, out, err)
+		ui.StartStructuredOutput()
+	})
+
+	decodedOutput := func() interface{} {
+		var decoded interface{}
+		Expect(json.Unmarshal(out.Contents(), &decoded)).To(Succeed())
+		return decoded
+	}
+
+	It("sends text to stderr and writes a table with a header as an array of objects", func() {
+		ui.DisplayTextWithFlavor("Getting apps...")
+		ui.DisplayTableWithHeader("", [][]string{
+			{"name", "requested state", ""},
+			{"app-1", "started", "x"},
+			{"app-2", "stopped"},
+		}, DefaultTableSpacePadding)
+		Expect(ui.StopStructuredOutput(true)).To(Succeed())
+
+		Expect(err).To(Say("Getting apps..."))
+		Expect(decodedOutput()).To(Equal([]interface{}{
+			map[string]interface{}{"name": "app-1", "requested_state": "started", "column_3": "x"},
+			map[string]interface{}{"name": "app-2", "requested_state": "stopped", "column_3": ""},
+		}))
+		Expect(ui.Out).To(Equal(out))
+	})
+
+	It("writes a key-value table as an object", func() {
+		ui.DisplayKeyValueTable("", [][]string{
+			{"name:", "some-app"},
+			{},
+			{"last uploaded:", "today"},
+		}, 3)
+		Expect(ui.StopStructuredOutput(true)).To(Succeed())
+
+		Expect(decodedOutput()).To(Equal(map[string]interface{}{"name": "some-app", "last_uploaded": "today"}))
+	})
+
+	It("writes several tables as an array", func() {
+		ui.DisplayKeyValueTable("", [][]string{{"name:", "some-app"}}, 3)
+		ui.DisplayNonWrappingTable("", [][]string{{"state"}, {"running"}}, 3)
+		Expect(ui.StopStructuredOutput(true)).To(Succeed())
+
+		Expect(decodedOutput()).To(Equal([]interface{}{
+			map[string]interface{}{"name": "some-app"},
+			[]interface{}{map[string]interface{}{"state": "running"}},
+		}))
+	})
+
+	It("writes an empty array when no table was displayed", func() {
+		ui.DisplayText("No apps found")
+		Expect(ui.StopStructuredOutput(true)).To(Succeed())
+
+		Expect(decodedOutput()).To(Equal([]interface{}{}))
+	})
+
+	It("only writes the JSON the command displays itself", func() {
+		ui.DisplayKeyValueTable("", [][]string{{"name:", "some-app"}}, 3)
+		Expect(ui.DisplayJSON("", map[string]string{"own": "json"})).To(Succeed())
+		Expect(ui.StopStructuredOutput(true)).To(Succeed())
+
+		Expect(decodedOutput()).To(Equal(map[string]interface{}{"own": "json"}))
+	})
+
+	It("writes nothing when asked not to display the tables", func() {
+		ui.DisplayKeyValueTable("", [][]string{{"name:", "some-app"}}, 3)
+		Expect(ui.StopStructuredOutput(false)).To(Succeed())
+
+		Expect(out.Contents()).To(BeEmpty())
+		ui.DisplayText("after")
+		Expect(out).To(Say("after"))
+	})
+})
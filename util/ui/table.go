@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/fatih/color"
 	"github.com/lunixbochs/vtclean"
 	runewidth "github.com/mattn/go-runewidth"
 )
@@ -46,10 +45,18 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	if len(table) == 0 {
+	if ui.recordTable(table) || len(table) == 0 {
 		return
 	}
 
+	for _, line := range tableLines(prefix, table, padding) {
+		fmt.Fprintf(ui.Out, "%s\n", line)
+	}
+}
+
+// tableLines lays out a matrix of strings as the lines of a table with
+// padding spaces between the columns.
+func tableLines(prefix string, table [][]string, padding int) []string {
 	var columnPadding []int
 
 	rows := len(table)
@@ -64,28 +71,32 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 		columnPadding = append(columnPadding, max+padding)
 	}
 
+	lines := make([]string, 0, rows)
 	for row := 0; row < rows; row++ {
-		fmt.Fprint(ui.Out, prefix)
+		var line strings.Builder
+		line.WriteString(prefix)
 		for col := 0; col < columns; col++ {
 			data := table[row][col]
 			var addedPadding int
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - wordSize(data)
 			}
-			fmt.Fprintf(ui.Out, "%s%s", data, strings.Repeat(" ", addedPadding))
+			line.WriteString(data)
+			line.WriteString(strings.Repeat(" ", addedPadding))
 		}
-		fmt.Fprintf(ui.Out, "\n")
+		lines = append(lines, line.String())
 	}
+	return lines
 }
 
-// DisplayTableWithHeader outputs a simple non-wrapping table with bolded
-// headers.
+// DisplayTableWithHeader outputs a simple non-wrapping table with the headers
+// in the header color of the theme, bold by default.
 func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
 	}
 	for i, str := range table[0] {
-		table[0][i] = ui.modifyColor(str, color.New(color.Bold))
+		table[0][i] = ui.modifyColor(str, ui.themeColor(ColorRoleHeader))
 	}
 
 	ui.DisplayNonWrappingTable(prefix, table, padding)
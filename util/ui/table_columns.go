@@ -0,0 +1,73 @@
+package ui
+
+import "code.cloudfoundry.org/cli/command/translatableerror"
+
+// TableColumn is a column of a table whose columns can be chosen by the user.
+type TableColumn struct {
+	// Name identifies the column on the command line.
+	Name string
+	// Header is the untranslated heading of the column.
+	Header string
+	// Wide columns are only displayed in wide mode or when asked for by name.
+	Wide bool
+}
+
+// SelectTableColumns returns the indexes of the columns to display. The named
+// columns are selected in the order they are given. Without names, all
+// columns are selected in wide mode and only the columns that are not wide
+// otherwise.
+func SelectTableColumns(columns []TableColumn, names []string, wide bool) ([]int, error) {
+	var selected []int
+
+	if len(names) == 0 {
+		for i, column := range columns {
+			if wide || !column.Wide {
+				selected = append(selected, i)
+			}
+		}
+		return selected, nil
+	}
+
+	for _, name := range names {
+		index := tableColumnIndex(columns, name)
+		if index == -1 {
+			columnNames := make([]string, len(columns))
+			for i, column := range columns {
+				columnNames[i] = column.Name
+			}
+			return nil, translatableerror.UnknownColumnError{Column: name, Columns: columnNames}
+		}
+		selected = append(selected, index)
+	}
+	return selected, nil
+}
+
+func tableColumnIndex(columns []TableColumn, name string) int {
+	for i, column := range columns {
+		if column.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// DisplayTableWithColumns outputs the rows as a table with bolded headers,
+// showing only the selected columns in the selected order. Each row holds a
+// value for every column.
+func (ui *UI) DisplayTableWithColumns(prefix string, columns []TableColumn, rows [][]string, selected []int, padding int) {
+	header := make([]string, len(selected))
+	for i, column := range selected {
+		header[i] = ui.TranslateText(columns[column].Header)
+	}
+
+	table := [][]string{header}
+	for _, row := range rows {
+		tableRow := make([]string, len(selected))
+		for i, column := range selected {
+			tableRow[i] = row[column]
+		}
+		table = append(table, tableRow)
+	}
+
+	ui.DisplayTableWithHeader(prefix, table, padding)
+}
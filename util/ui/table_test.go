@@ -3,6 +3,7 @@ package ui_test
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/util/ui/uifakes"
@@ -81,4 +82,63 @@ var _ = Describe("Table", func() {
 			Expect(out).To(Say("#0  data1    data2    data3"))
 		})
 	})
+
+	Describe("SelectTableColumns", func() {
+		var columns []TableColumn
+
+		BeforeEach(func() {
+			columns = []TableColumn{
+				{Name: "name", Header: "name"},
+				{Name: "state", Header: "requested state"},
+				{Name: "guid", Header: "guid", Wide: true},
+			}
+		})
+
+		When("no columns are named", func() {
+			It("selects the columns that are not wide", func() {
+				Expect(SelectTableColumns(columns, nil, false)).To(Equal([]int{0, 1}))
+			})
+
+			It("selects every column in wide mode", func() {
+				Expect(SelectTableColumns(columns, nil, true)).To(Equal([]int{0, 1, 2}))
+			})
+		})
+
+		When("columns are named", func() {
+			It("selects the named columns in the given order, including wide ones", func() {
+				Expect(SelectTableColumns(columns, []string{"guid", "name"}, false)).To(Equal([]int{2, 0}))
+			})
+		})
+
+		When("an unknown column is named", func() {
+			It("returns an UnknownColumnError listing the available columns", func() {
+				_, err := SelectTableColumns(columns, []string{"name", "memory"}, false)
+				Expect(err).To(MatchError(translatableerror.UnknownColumnError{
+					Column:  "memory",
+					Columns: []string{"name", "state", "guid"},
+				}))
+			})
+		})
+	})
+
+	Describe("DisplayTableWithColumns", func() {
+		It("displays the selected columns with bold headers", func() {
+			ui.DisplayTableWithColumns("",
+				[]TableColumn{
+					{Name: "name", Header: "name"},
+					{Name: "state", Header: "requested state"},
+					{Name: "guid", Header: "guid", Wide: true},
+				},
+				[][]string{
+					{"app-1", "started", "guid-1"},
+					{"app-2", "stopped", "guid-2"},
+				},
+				[]int{2, 0},
+				2)
+			Expect(out).To(Say("\u001B\\[1mguid\u001B\\[22m    \u001B\\[1mname\u001B\\[22m"))
+			Expect(out).To(Say("guid-1  app-1"))
+			Expect(out).To(Say("guid-2  app-2"))
+			Expect(out).NotTo(Say("started"))
+		})
+	})
 })
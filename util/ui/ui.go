@@ -82,6 +82,7 @@ type UI struct {
 	Err io.Writer
 
 	colorEnabled configv3.ColorSetting
+	colorTheme   ColorTheme
 	translate    TranslateFunc
 	Exiter       Exiter
 
@@ -96,6 +97,12 @@ type UI struct {
 	TimezoneLocation *time.Location
 
 	deferred []string
+
+	pager *pager
+
+	structured *structuredOutput
+
+	recording *recording
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -108,12 +115,20 @@ func NewUI(config Config) (*UI, error) {
 
 	location := time.Now().Location()
 
+	// An invalid theme is rejected when it is configured, so one that fails
+	// to load here (e.g. a deleted theme file) falls back to the default.
+	colorTheme, err := LoadColorTheme(config.ColorTheme(), config.ColorDepth())
+	if err != nil {
+		colorTheme = DefaultColorTheme()
+	}
+
 	return &UI{
 		In:                os.Stdin,
 		Out:               color.Output,
 		OutForInteraction: os.Stdout,
 		Err:               os.Stderr,
 		colorEnabled:      config.ColorEnabled(),
+		colorTheme:        colorTheme,
 		translate:         translateFunc,
 		terminalLock:      &sync.Mutex{},
 		Exiter:            realExiter,
@@ -204,7 +219,7 @@ func (ui *UI) DisplayError(err error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
+	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), ui.themeColor(ColorRoleError)))
 }
 
 func (ui *UI) DisplayFileDeprecationWarning() {
@@ -214,13 +229,13 @@ func (ui *UI) DisplayFileDeprecationWarning() {
 	fmt.Fprintf(ui.Err, "Deprecation warning: This command has been deprecated and will be removed in the future. For similar functionality, please use the `cf ssh` command instead.\n")
 }
 
-// DisplayHeader translates the header, bolds and adds the default color to the
-// header, and outputs the result to ui.Out.
+// DisplayHeader translates the header, adds the header color of the theme to
+// it, and outputs the result to ui.Out.
 func (ui *UI) DisplayHeader(text string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
+	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText(text), ui.themeColor(ColorRoleHeader)))
 }
 
 // DisplayNewline outputs a newline to UI.Out.
@@ -231,12 +246,13 @@ func (ui *UI) DisplayNewline() {
 	fmt.Fprintf(ui.Out, "\n")
 }
 
-// DisplayOK outputs a bold green translated "OK" to UI.Out.
+// DisplayOK outputs a translated "OK" in the OK color of the theme, bold
+// green by default, to UI.Out.
 func (ui *UI) DisplayOK() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
+	fmt.Fprintf(ui.Out, "%s\n\n", ui.modifyColor(ui.TranslateText("OK"), ui.themeColor(ColorRoleOK)))
 }
 
 // DisplayText translates the template, substitutes in templateValues, and
@@ -262,8 +278,8 @@ func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]
 	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
 
-// DisplayTextWithFlavor translates the template, bolds and adds cyan color to
-// templateValues, substitutes templateValues into the template, and outputs
+// DisplayTextWithFlavor translates the template, adds the entity color of the
+// theme, bold cyan by default, to templateValues, substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[string]interface{}) {
 	ui.terminalLock.Lock()
@@ -271,7 +287,7 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 
 	firstTemplateValues := getFirstSet(templateValues)
 	for key, value := range firstTemplateValues {
-		firstTemplateValues[key] = ui.modifyColor(fmt.Sprint(value), color.New(color.FgCyan, color.Bold))
+		firstTemplateValues[key] = ui.modifyColor(fmt.Sprint(value), ui.themeColor(ColorRoleEntity))
 	}
 	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
@@ -366,15 +382,47 @@ func (ui *UI) DisplayJSON(name string, jsonData interface{}) error {
 		return err
 	}
 
+	// JSON displayed by the command replaces the collected tables.
+	out := ui.Out
+	if ui.structured != nil {
+		out = ui.structured.out
+		ui.structured.displayedJSON = true
+	}
+
 	if name != "" {
-		fmt.Fprintf(ui.Out, "%s\n", fmt.Sprintf("%s: %s", name, buff))
+		fmt.Fprintf(out, "%s\n", fmt.Sprintf("%s: %s", name, buff))
 	} else {
-		fmt.Fprintf(ui.Out, "%s\n", buff)
+		fmt.Fprintf(out, "%s\n", buff)
 	}
 
 	return nil
 }
 
+// DisplayJSONLine encodes the given object as JSON on a single line, so that
+// a stream of objects can be read one line at a time.
+func (ui *UI) DisplayJSONLine(jsonData interface{}) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	buff := new(bytes.Buffer)
+	encoder := json.NewEncoder(buff)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(jsonData)
+	if err != nil {
+		return err
+	}
+
+	out := ui.Out
+	if ui.structured != nil {
+		out = ui.structured.out
+		ui.structured.displayedJSON = true
+	}
+
+	_, err = out.Write(buff.Bytes())
+	return err
+}
+
 // FlushDeferred displays text previously deferred (using DeferText) to the UI's
 // `Out`.
 func (ui *UI) FlushDeferred() {
@@ -423,6 +471,10 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	if ui.recordTable(table) {
+		return
+	}
+
 	var columnPadding []int
 
 	rows := len(table)
@@ -477,7 +529,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 }
 
 func (ui *UI) modifyColor(text string, colorPrinter *color.Color) string {
-	if len(text) == 0 {
+	if len(text) == 0 || colorPrinter == nil {
 		return text
 	}
 
@@ -491,6 +543,20 @@ func (ui *UI) modifyColor(text string, colorPrinter *color.Color) string {
 	return colorPrinter.SprintFunc()(text)
 }
 
+// themeColor returns the color of the role in the theme, or nil when the role
+// is displayed without color.
+func (ui *UI) themeColor(role ColorRole) *color.Color {
+	theme := ui.colorTheme
+	if theme == nil {
+		theme = DefaultColorTheme()
+	}
+
+	if len(theme[role]) == 0 {
+		return nil
+	}
+	return color.New(theme[role]...)
+}
+
 // getFirstSet returns the first map if 1 or more maps are provided. Otherwise
 // it returns the empty map.
 func getFirstSet(list []map[string]interface{}) map[string]interface{} {
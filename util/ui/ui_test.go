@@ -218,6 +218,15 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayJSONLine", func() {
+		It("displays the JSON object on a single line", func() {
+			Expect(ui.DisplayJSONLine(map[string]interface{}{"message": "a<b", "index": 1})).To(Succeed())
+			Expect(ui.DisplayJSONLine(map[string]interface{}{"message": "second"})).To(Succeed())
+
+			Expect(out).To(Say(`^\{"index":1,"message":"a<b"\}\n\{"message":"second"\}\n$`))
+		})
+	})
+
 	Describe("DeferText", func() {
 		It("defers the template with map values substituted into ui.Out with a newline", func() {
 			ui.DeferText(
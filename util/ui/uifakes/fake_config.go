@@ -9,6 +9,16 @@ import (
 )
 
 type FakeConfig struct {
+	ColorDepthStub        func() configv3.ColorDepth
+	colorDepthMutex       sync.RWMutex
+	colorDepthArgsForCall []struct {
+	}
+	colorDepthReturns struct {
+		result1 configv3.ColorDepth
+	}
+	colorDepthReturnsOnCall map[int]struct {
+		result1 configv3.ColorDepth
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -19,6 +29,16 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ColorThemeStub        func() string
+	colorThemeMutex       sync.RWMutex
+	colorThemeArgsForCall []struct {
+	}
+	colorThemeReturns struct {
+		result1 string
+	}
+	colorThemeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	IsTTYStub        func() bool
 	isTTYMutex       sync.RWMutex
 	isTTYArgsForCall []struct {
@@ -53,6 +73,59 @@ type FakeConfig struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeConfig) ColorDepth() configv3.ColorDepth {
+	fake.colorDepthMutex.Lock()
+	ret, specificReturn := fake.colorDepthReturnsOnCall[len(fake.colorDepthArgsForCall)]
+	fake.colorDepthArgsForCall = append(fake.colorDepthArgsForCall, struct {
+	}{})
+	stub := fake.ColorDepthStub
+	fakeReturns := fake.colorDepthReturns
+	fake.recordInvocation("ColorDepth", []interface{}{})
+	fake.colorDepthMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ColorDepthCallCount() int {
+	fake.colorDepthMutex.RLock()
+	defer fake.colorDepthMutex.RUnlock()
+	return len(fake.colorDepthArgsForCall)
+}
+
+func (fake *FakeConfig) ColorDepthCalls(stub func() configv3.ColorDepth) {
+	fake.colorDepthMutex.Lock()
+	defer fake.colorDepthMutex.Unlock()
+	fake.ColorDepthStub = stub
+}
+
+func (fake *FakeConfig) ColorDepthReturns(result1 configv3.ColorDepth) {
+	fake.colorDepthMutex.Lock()
+	defer fake.colorDepthMutex.Unlock()
+	fake.ColorDepthStub = nil
+	fake.colorDepthReturns = struct {
+		result1 configv3.ColorDepth
+	}{result1}
+}
+
+func (fake *FakeConfig) ColorDepthReturnsOnCall(i int, result1 configv3.ColorDepth) {
+	fake.colorDepthMutex.Lock()
+	defer fake.colorDepthMutex.Unlock()
+	fake.ColorDepthStub = nil
+	if fake.colorDepthReturnsOnCall == nil {
+		fake.colorDepthReturnsOnCall = make(map[int]struct {
+			result1 configv3.ColorDepth
+		})
+	}
+	fake.colorDepthReturnsOnCall[i] = struct {
+		result1 configv3.ColorDepth
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -106,6 +179,59 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) ColorTheme() string {
+	fake.colorThemeMutex.Lock()
+	ret, specificReturn := fake.colorThemeReturnsOnCall[len(fake.colorThemeArgsForCall)]
+	fake.colorThemeArgsForCall = append(fake.colorThemeArgsForCall, struct {
+	}{})
+	stub := fake.ColorThemeStub
+	fakeReturns := fake.colorThemeReturns
+	fake.recordInvocation("ColorTheme", []interface{}{})
+	fake.colorThemeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ColorThemeCallCount() int {
+	fake.colorThemeMutex.RLock()
+	defer fake.colorThemeMutex.RUnlock()
+	return len(fake.colorThemeArgsForCall)
+}
+
+func (fake *FakeConfig) ColorThemeCalls(stub func() string) {
+	fake.colorThemeMutex.Lock()
+	defer fake.colorThemeMutex.Unlock()
+	fake.ColorThemeStub = stub
+}
+
+func (fake *FakeConfig) ColorThemeReturns(result1 string) {
+	fake.colorThemeMutex.Lock()
+	defer fake.colorThemeMutex.Unlock()
+	fake.ColorThemeStub = nil
+	fake.colorThemeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ColorThemeReturnsOnCall(i int, result1 string) {
+	fake.colorThemeMutex.Lock()
+	defer fake.colorThemeMutex.Unlock()
+	fake.ColorThemeStub = nil
+	if fake.colorThemeReturnsOnCall == nil {
+		fake.colorThemeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.colorThemeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) IsTTY() bool {
 	fake.isTTYMutex.Lock()
 	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
@@ -268,8 +394,12 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.colorDepthMutex.RLock()
+	defer fake.colorDepthMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.colorThemeMutex.RLock()
+	defer fake.colorThemeMutex.RUnlock()
 	fake.isTTYMutex.RLock()
 	defer fake.isTTYMutex.RUnlock()
 	fake.localeMutex.RLock()